@@ -0,0 +1,418 @@
+// Code generated by protoc-gen-go.
+// source: analytics.proto
+// DO NOT EDIT!
+
+package api
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import _ "github.com/grpc-ecosystem/grpc-gateway/third_party/googleapis/google/api"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// AggregationInterval defines the interval used for aggregating the
+// distribution.
+type AggregationInterval int32
+
+const (
+	// aggregate over the whole period
+	AggregationInterval_PERIOD AggregationInterval = 0
+	AggregationInterval_HOUR   AggregationInterval = 1
+	AggregationInterval_DAY    AggregationInterval = 2
+)
+
+var AggregationInterval_name = map[int32]string{
+	0: "PERIOD",
+	1: "HOUR",
+	2: "DAY",
+}
+var AggregationInterval_value = map[string]int32{
+	"PERIOD": 0,
+	"HOUR":   1,
+	"DAY":    2,
+}
+
+func (x AggregationInterval) String() string {
+	return proto.EnumName(AggregationInterval_name, int32(x))
+}
+func (AggregationInterval) EnumDescriptor() ([]byte, []int) { return fileDescriptor8, []int{0} }
+
+type GetApplicationDistributionRequest struct {
+	// hex encoded AppEUI
+	AppEUI string `protobuf:"bytes,1,opt,name=appEUI" json:"appEUI,omitempty"`
+	// start of the period (RFC3339)
+	Start string `protobuf:"bytes,2,opt,name=start" json:"start,omitempty"`
+	// end of the period (RFC3339, default now)
+	End string `protobuf:"bytes,3,opt,name=end" json:"end,omitempty"`
+	// aggregation interval
+	Interval AggregationInterval `protobuf:"varint,4,opt,name=interval,enum=api.AggregationInterval" json:"interval,omitempty"`
+}
+
+func (m *GetApplicationDistributionRequest) Reset()         { *m = GetApplicationDistributionRequest{} }
+func (m *GetApplicationDistributionRequest) String() string { return proto.CompactTextString(m) }
+func (*GetApplicationDistributionRequest) ProtoMessage()    {}
+func (*GetApplicationDistributionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor8, []int{0}
+}
+
+func (m *GetApplicationDistributionRequest) GetAppEUI() string {
+	if m != nil {
+		return m.AppEUI
+	}
+	return ""
+}
+
+func (m *GetApplicationDistributionRequest) GetStart() string {
+	if m != nil {
+		return m.Start
+	}
+	return ""
+}
+
+func (m *GetApplicationDistributionRequest) GetEnd() string {
+	if m != nil {
+		return m.End
+	}
+	return ""
+}
+
+func (m *GetApplicationDistributionRequest) GetInterval() AggregationInterval {
+	if m != nil {
+		return m.Interval
+	}
+	return AggregationInterval_PERIOD
+}
+
+type GetGatewayDistributionRequest struct {
+	// hex encoded MAC of the gateway
+	Mac string `protobuf:"bytes,1,opt,name=mac" json:"mac,omitempty"`
+	// start of the period (RFC3339)
+	Start string `protobuf:"bytes,2,opt,name=start" json:"start,omitempty"`
+	// end of the period (RFC3339, default now)
+	End string `protobuf:"bytes,3,opt,name=end" json:"end,omitempty"`
+	// aggregation interval
+	Interval AggregationInterval `protobuf:"varint,4,opt,name=interval,enum=api.AggregationInterval" json:"interval,omitempty"`
+}
+
+func (m *GetGatewayDistributionRequest) Reset()                    { *m = GetGatewayDistributionRequest{} }
+func (m *GetGatewayDistributionRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetGatewayDistributionRequest) ProtoMessage()               {}
+func (*GetGatewayDistributionRequest) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{1} }
+
+func (m *GetGatewayDistributionRequest) GetMac() string {
+	if m != nil {
+		return m.Mac
+	}
+	return ""
+}
+
+func (m *GetGatewayDistributionRequest) GetStart() string {
+	if m != nil {
+		return m.Start
+	}
+	return ""
+}
+
+func (m *GetGatewayDistributionRequest) GetEnd() string {
+	if m != nil {
+		return m.End
+	}
+	return ""
+}
+
+func (m *GetGatewayDistributionRequest) GetInterval() AggregationInterval {
+	if m != nil {
+		return m.Interval
+	}
+	return AggregationInterval_PERIOD
+}
+
+type DataRateCount struct {
+	// start of the aggregation interval (RFC3339)
+	Timestamp string `protobuf:"bytes,1,opt,name=timestamp" json:"timestamp,omitempty"`
+	// modulation (LORA or FSK)
+	Modulation string `protobuf:"bytes,2,opt,name=modulation" json:"modulation,omitempty"`
+	// spreading-factor (LORA)
+	SpreadFactor uint32 `protobuf:"varint,3,opt,name=spreadFactor" json:"spreadFactor,omitempty"`
+	// bandwidth
+	Bandwidth uint32 `protobuf:"varint,4,opt,name=bandwidth" json:"bandwidth,omitempty"`
+	// bitrate (FSK)
+	Bitrate uint32 `protobuf:"varint,5,opt,name=bitrate" json:"bitrate,omitempty"`
+	// number of uplinks
+	Count int64 `protobuf:"varint,6,opt,name=count" json:"count,omitempty"`
+}
+
+func (m *DataRateCount) Reset()                    { *m = DataRateCount{} }
+func (m *DataRateCount) String() string            { return proto.CompactTextString(m) }
+func (*DataRateCount) ProtoMessage()               {}
+func (*DataRateCount) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{2} }
+
+func (m *DataRateCount) GetTimestamp() string {
+	if m != nil {
+		return m.Timestamp
+	}
+	return ""
+}
+
+func (m *DataRateCount) GetModulation() string {
+	if m != nil {
+		return m.Modulation
+	}
+	return ""
+}
+
+func (m *DataRateCount) GetSpreadFactor() uint32 {
+	if m != nil {
+		return m.SpreadFactor
+	}
+	return 0
+}
+
+func (m *DataRateCount) GetBandwidth() uint32 {
+	if m != nil {
+		return m.Bandwidth
+	}
+	return 0
+}
+
+func (m *DataRateCount) GetBitrate() uint32 {
+	if m != nil {
+		return m.Bitrate
+	}
+	return 0
+}
+
+func (m *DataRateCount) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type ChannelCount struct {
+	// start of the aggregation interval (RFC3339)
+	Timestamp string `protobuf:"bytes,1,opt,name=timestamp" json:"timestamp,omitempty"`
+	// frequency (Hz)
+	Frequency uint32 `protobuf:"varint,2,opt,name=frequency" json:"frequency,omitempty"`
+	// number of uplinks
+	Count int64 `protobuf:"varint,3,opt,name=count" json:"count,omitempty"`
+}
+
+func (m *ChannelCount) Reset()                    { *m = ChannelCount{} }
+func (m *ChannelCount) String() string            { return proto.CompactTextString(m) }
+func (*ChannelCount) ProtoMessage()               {}
+func (*ChannelCount) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{3} }
+
+func (m *ChannelCount) GetTimestamp() string {
+	if m != nil {
+		return m.Timestamp
+	}
+	return ""
+}
+
+func (m *ChannelCount) GetFrequency() uint32 {
+	if m != nil {
+		return m.Frequency
+	}
+	return 0
+}
+
+func (m *ChannelCount) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type UplinkDistributionResponse struct {
+	// number of uplinks per data-rate
+	DataRates []*DataRateCount `protobuf:"bytes,1,rep,name=dataRates" json:"dataRates,omitempty"`
+	// number of uplinks per channel
+	Channels []*ChannelCount `protobuf:"bytes,2,rep,name=channels" json:"channels,omitempty"`
+}
+
+func (m *UplinkDistributionResponse) Reset()                    { *m = UplinkDistributionResponse{} }
+func (m *UplinkDistributionResponse) String() string            { return proto.CompactTextString(m) }
+func (*UplinkDistributionResponse) ProtoMessage()               {}
+func (*UplinkDistributionResponse) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{4} }
+
+func (m *UplinkDistributionResponse) GetDataRates() []*DataRateCount {
+	if m != nil {
+		return m.DataRates
+	}
+	return nil
+}
+
+func (m *UplinkDistributionResponse) GetChannels() []*ChannelCount {
+	if m != nil {
+		return m.Channels
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*GetApplicationDistributionRequest)(nil), "api.GetApplicationDistributionRequest")
+	proto.RegisterType((*GetGatewayDistributionRequest)(nil), "api.GetGatewayDistributionRequest")
+	proto.RegisterType((*DataRateCount)(nil), "api.DataRateCount")
+	proto.RegisterType((*ChannelCount)(nil), "api.ChannelCount")
+	proto.RegisterType((*UplinkDistributionResponse)(nil), "api.UplinkDistributionResponse")
+	proto.RegisterEnum("api.AggregationInterval", AggregationInterval_name, AggregationInterval_value)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for Analytics service
+
+type AnalyticsClient interface {
+	// GetApplicationDistribution returns the data-rate and channel distribution of the uplinks of the given application.
+	GetApplicationDistribution(ctx context.Context, in *GetApplicationDistributionRequest, opts ...grpc.CallOption) (*UplinkDistributionResponse, error)
+	// GetGatewayDistribution returns the data-rate and channel distribution of the uplinks received by the given gateway.
+	GetGatewayDistribution(ctx context.Context, in *GetGatewayDistributionRequest, opts ...grpc.CallOption) (*UplinkDistributionResponse, error)
+}
+
+type analyticsClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewAnalyticsClient(cc *grpc.ClientConn) AnalyticsClient {
+	return &analyticsClient{cc}
+}
+
+func (c *analyticsClient) GetApplicationDistribution(ctx context.Context, in *GetApplicationDistributionRequest, opts ...grpc.CallOption) (*UplinkDistributionResponse, error) {
+	out := new(UplinkDistributionResponse)
+	err := grpc.Invoke(ctx, "/api.Analytics/GetApplicationDistribution", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyticsClient) GetGatewayDistribution(ctx context.Context, in *GetGatewayDistributionRequest, opts ...grpc.CallOption) (*UplinkDistributionResponse, error) {
+	out := new(UplinkDistributionResponse)
+	err := grpc.Invoke(ctx, "/api.Analytics/GetGatewayDistribution", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for Analytics service
+
+type AnalyticsServer interface {
+	// GetApplicationDistribution returns the data-rate and channel distribution of the uplinks of the given application.
+	GetApplicationDistribution(context.Context, *GetApplicationDistributionRequest) (*UplinkDistributionResponse, error)
+	// GetGatewayDistribution returns the data-rate and channel distribution of the uplinks received by the given gateway.
+	GetGatewayDistribution(context.Context, *GetGatewayDistributionRequest) (*UplinkDistributionResponse, error)
+}
+
+func RegisterAnalyticsServer(s *grpc.Server, srv AnalyticsServer) {
+	s.RegisterService(&_Analytics_serviceDesc, srv)
+}
+
+func _Analytics_GetApplicationDistribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetApplicationDistributionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyticsServer).GetApplicationDistribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Analytics/GetApplicationDistribution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyticsServer).GetApplicationDistribution(ctx, req.(*GetApplicationDistributionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Analytics_GetGatewayDistribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGatewayDistributionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyticsServer).GetGatewayDistribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Analytics/GetGatewayDistribution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyticsServer).GetGatewayDistribution(ctx, req.(*GetGatewayDistributionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Analytics_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.Analytics",
+	HandlerType: (*AnalyticsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetApplicationDistribution",
+			Handler:    _Analytics_GetApplicationDistribution_Handler,
+		},
+		{
+			MethodName: "GetGatewayDistribution",
+			Handler:    _Analytics_GetGatewayDistribution_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "analytics.proto",
+}
+
+func init() { proto.RegisterFile("analytics.proto", fileDescriptor8) }
+
+var fileDescriptor8 = []byte{
+	// 532 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x86, 0xd9, 0xb8, 0x4d, 0x9b, 0xa1, 0x81, 0xb0, 0xa0, 0xca, 0x8a, 0x0a, 0x04, 0x1f, 0x50,
+	0x00, 0x51, 0x97, 0xd0, 0x03, 0xd7, 0xa8, 0x29, 0x21, 0xa7, 0xa2, 0x95, 0x72, 0xe0, 0xc6, 0xc4,
+	0x5e, 0xdc, 0x15, 0xce, 0x7a, 0xb1, 0x27, 0x54, 0x51, 0xd5, 0x0b, 0x2f, 0x00, 0x12, 0x27, 0x4e,
+	0x3c, 0x0a, 0xbc, 0x03, 0xaf, 0xc0, 0x83, 0x20, 0x6f, 0x9c, 0x3a, 0x41, 0x89, 0xca, 0x85, 0xdb,
+	0xce, 0xbf, 0xb3, 0x3b, 0x9f, 0xff, 0xd5, 0x6f, 0xb8, 0x89, 0x1a, 0xe3, 0x29, 0xa9, 0x20, 0xdb,
+	0x37, 0x69, 0x42, 0x09, 0x77, 0xd0, 0xa8, 0xe6, 0x5e, 0x94, 0x24, 0x51, 0x2c, 0x7d, 0x34, 0xca,
+	0x47, 0xad, 0x13, 0x42, 0x52, 0x89, 0x2e, 0x5a, 0xbc, 0x6f, 0x0c, 0x1e, 0xf4, 0x25, 0x75, 0x8d,
+	0x89, 0x55, 0x60, 0x77, 0x7a, 0x2a, 0xa3, 0x54, 0x8d, 0x26, 0xf9, 0x5a, 0xc8, 0x0f, 0x13, 0x99,
+	0x11, 0xdf, 0x85, 0x2a, 0x1a, 0x73, 0x3c, 0x1c, 0xb8, 0xac, 0xc5, 0xda, 0x35, 0x51, 0x54, 0xfc,
+	0x0e, 0x6c, 0x66, 0x84, 0x29, 0xb9, 0x15, 0x2b, 0xcf, 0x0a, 0xde, 0x00, 0x47, 0xea, 0xd0, 0x75,
+	0xac, 0x96, 0x2f, 0xf9, 0x21, 0x6c, 0x2b, 0x4d, 0x32, 0xfd, 0x88, 0xb1, 0xbb, 0xd1, 0x62, 0xed,
+	0x1b, 0x1d, 0x77, 0x1f, 0x8d, 0xda, 0xef, 0x46, 0x51, 0x2a, 0x23, 0x3b, 0x76, 0x50, 0xec, 0x8b,
+	0xcb, 0x4e, 0xef, 0x33, 0x83, 0xbb, 0x7d, 0x49, 0x7d, 0x24, 0x79, 0x86, 0xd3, 0x55, 0x5c, 0x0d,
+	0x70, 0xc6, 0x18, 0x14, 0x50, 0xf9, 0xf2, 0x3f, 0x13, 0xfd, 0x60, 0x50, 0xef, 0x21, 0xa1, 0x40,
+	0x92, 0x47, 0xc9, 0x44, 0x13, 0xdf, 0x83, 0x1a, 0xa9, 0xb1, 0xcc, 0x08, 0xc7, 0xa6, 0xe0, 0x28,
+	0x05, 0x7e, 0x0f, 0x60, 0x9c, 0x84, 0x93, 0xd8, 0xde, 0x57, 0x20, 0x2d, 0x28, 0xdc, 0x83, 0x9d,
+	0xcc, 0xa4, 0x12, 0xc3, 0x97, 0x18, 0x50, 0x92, 0x5a, 0xc0, 0xba, 0x58, 0xd2, 0xf2, 0x09, 0x23,
+	0xd4, 0xe1, 0x99, 0x0a, 0xe9, 0xd4, 0xa2, 0xd6, 0x45, 0x29, 0x70, 0x17, 0xb6, 0x46, 0x8a, 0x52,
+	0x24, 0xe9, 0x6e, 0xda, 0xbd, 0x79, 0x99, 0x3b, 0x11, 0xe4, 0x88, 0x6e, 0xb5, 0xc5, 0xda, 0x8e,
+	0x98, 0x15, 0xde, 0x5b, 0xd8, 0x39, 0x3a, 0x45, 0xad, 0x65, 0xfc, 0x2f, 0xfc, 0x7b, 0x50, 0x7b,
+	0x97, 0xe6, 0x5e, 0xeb, 0x60, 0x6a, 0xf1, 0xeb, 0xa2, 0x14, 0xca, 0x09, 0xce, 0xe2, 0x84, 0x0b,
+	0x68, 0x0e, 0x4d, 0xac, 0xf4, 0xfb, 0xe5, 0x07, 0xcb, 0x4c, 0xa2, 0x33, 0xc9, 0x0f, 0xa0, 0x16,
+	0x16, 0x06, 0x66, 0x2e, 0x6b, 0x39, 0xed, 0xeb, 0x1d, 0x6e, 0x8d, 0x5f, 0xb2, 0x55, 0x94, 0x4d,
+	0xfc, 0x29, 0x6c, 0x07, 0x33, 0xe2, 0xcc, 0xad, 0xd8, 0x03, 0xb7, 0xec, 0x81, 0xc5, 0xcf, 0x10,
+	0x97, 0x2d, 0x8f, 0x0f, 0xe1, 0xf6, 0x8a, 0x37, 0xe4, 0x00, 0xd5, 0xd7, 0xc7, 0x62, 0x70, 0xd2,
+	0x6b, 0x5c, 0xe3, 0xdb, 0xb0, 0xf1, 0xea, 0x64, 0x28, 0x1a, 0x8c, 0x6f, 0x81, 0xd3, 0xeb, 0xbe,
+	0x69, 0x54, 0x3a, 0x3f, 0x2b, 0x50, 0xeb, 0xce, 0xd3, 0xc3, 0xbf, 0x33, 0x68, 0xae, 0x0f, 0x05,
+	0x7f, 0x68, 0xe7, 0x5f, 0x99, 0x9a, 0xe6, 0x7d, 0xdb, 0xb7, 0xde, 0x0c, 0xef, 0xc5, 0xa7, 0x5f,
+	0xbf, 0xbf, 0x56, 0x3a, 0xfc, 0xa0, 0x08, 0x67, 0x31, 0xdf, 0xc7, 0xf2, 0x5e, 0xff, 0x7c, 0x16,
+	0xb5, 0x0b, 0x3f, 0x5c, 0x44, 0xf8, 0xc2, 0x60, 0x77, 0x75, 0x34, 0xb8, 0x37, 0xa7, 0x5b, 0x9f,
+	0x9b, 0xab, 0xc9, 0x9e, 0x59, 0xb2, 0x27, 0xfc, 0xd1, 0x5f, 0x64, 0xd1, 0xec, 0x4e, 0xff, 0x7c,
+	0x8c, 0xc1, 0x32, 0xd2, 0xa8, 0x6a, 0x7f, 0x28, 0xcf, 0xff, 0x0c, 0x00, 0xf9, 0x4b, 0x55, 0x9b,
+	0x86, 0x04, 0x00, 0x00,
+}
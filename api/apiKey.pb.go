@@ -0,0 +1,403 @@
+// Code generated by protoc-gen-go.
+// source: apiKey.proto
+// DO NOT EDIT!
+
+package api
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import _ "github.com/grpc-ecosystem/grpc-gateway/third_party/googleapis/google/api"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+type CreateAPIKeyRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	// id of the organization
+	OrganizationID int64 `protobuf:"varint,2,opt,name=organizationID" json:"organizationID,omitempty"`
+	// hex encoded AppEUI of the application of the organization to scope
+	// the key to (optional, all applications of the organization when empty)
+	AppEUI string           `protobuf:"bytes,3,opt,name=appEUI" json:"appEUI,omitempty"`
+	Role   OrganizationRole `protobuf:"varint,4,opt,name=role,enum=api.OrganizationRole" json:"role,omitempty"`
+}
+
+func (m *CreateAPIKeyRequest) Reset()                    { *m = CreateAPIKeyRequest{} }
+func (m *CreateAPIKeyRequest) String() string            { return proto.CompactTextString(m) }
+func (*CreateAPIKeyRequest) ProtoMessage()               {}
+func (*CreateAPIKeyRequest) Descriptor() ([]byte, []int) { return fileDescriptor20, []int{0} }
+
+func (m *CreateAPIKeyRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CreateAPIKeyRequest) GetOrganizationID() int64 {
+	if m != nil {
+		return m.OrganizationID
+	}
+	return 0
+}
+
+func (m *CreateAPIKeyRequest) GetAppEUI() string {
+	if m != nil {
+		return m.AppEUI
+	}
+	return ""
+}
+
+func (m *CreateAPIKeyRequest) GetRole() OrganizationRole {
+	if m != nil {
+		return m.Role
+	}
+	return OrganizationRole_READ_ONLY
+}
+
+type CreateAPIKeyResponse struct {
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	// the API key, to be used as authorization token
+	Key string `protobuf:"bytes,2,opt,name=key" json:"key,omitempty"`
+}
+
+func (m *CreateAPIKeyResponse) Reset()                    { *m = CreateAPIKeyResponse{} }
+func (m *CreateAPIKeyResponse) String() string            { return proto.CompactTextString(m) }
+func (*CreateAPIKeyResponse) ProtoMessage()               {}
+func (*CreateAPIKeyResponse) Descriptor() ([]byte, []int) { return fileDescriptor20, []int{1} }
+
+func (m *CreateAPIKeyResponse) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *CreateAPIKeyResponse) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+type ListAPIKeyRequest struct {
+	// id of the organization
+	OrganizationID int64 `protobuf:"varint,1,opt,name=organizationID" json:"organizationID,omitempty"`
+}
+
+func (m *ListAPIKeyRequest) Reset()                    { *m = ListAPIKeyRequest{} }
+func (m *ListAPIKeyRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListAPIKeyRequest) ProtoMessage()               {}
+func (*ListAPIKeyRequest) Descriptor() ([]byte, []int) { return fileDescriptor20, []int{2} }
+
+func (m *ListAPIKeyRequest) GetOrganizationID() int64 {
+	if m != nil {
+		return m.OrganizationID
+	}
+	return 0
+}
+
+type GetAPIKeyResponse struct {
+	Id             int64  `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	Name           string `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	OrganizationID int64  `protobuf:"varint,3,opt,name=organizationID" json:"organizationID,omitempty"`
+	// hex encoded AppEUI (empty when scoped to the organization)
+	AppEUI    string           `protobuf:"bytes,4,opt,name=appEUI" json:"appEUI,omitempty"`
+	Role      OrganizationRole `protobuf:"varint,5,opt,name=role,enum=api.OrganizationRole" json:"role,omitempty"`
+	CreatedAt string           `protobuf:"bytes,6,opt,name=createdAt" json:"createdAt,omitempty"`
+	// empty when not revoked
+	RevokedAt string `protobuf:"bytes,7,opt,name=revokedAt" json:"revokedAt,omitempty"`
+}
+
+func (m *GetAPIKeyResponse) Reset()                    { *m = GetAPIKeyResponse{} }
+func (m *GetAPIKeyResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetAPIKeyResponse) ProtoMessage()               {}
+func (*GetAPIKeyResponse) Descriptor() ([]byte, []int) { return fileDescriptor20, []int{3} }
+
+func (m *GetAPIKeyResponse) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *GetAPIKeyResponse) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *GetAPIKeyResponse) GetOrganizationID() int64 {
+	if m != nil {
+		return m.OrganizationID
+	}
+	return 0
+}
+
+func (m *GetAPIKeyResponse) GetAppEUI() string {
+	if m != nil {
+		return m.AppEUI
+	}
+	return ""
+}
+
+func (m *GetAPIKeyResponse) GetRole() OrganizationRole {
+	if m != nil {
+		return m.Role
+	}
+	return OrganizationRole_READ_ONLY
+}
+
+func (m *GetAPIKeyResponse) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return ""
+}
+
+func (m *GetAPIKeyResponse) GetRevokedAt() string {
+	if m != nil {
+		return m.RevokedAt
+	}
+	return ""
+}
+
+type ListAPIKeyResponse struct {
+	Result []*GetAPIKeyResponse `protobuf:"bytes,1,rep,name=result" json:"result,omitempty"`
+}
+
+func (m *ListAPIKeyResponse) Reset()                    { *m = ListAPIKeyResponse{} }
+func (m *ListAPIKeyResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListAPIKeyResponse) ProtoMessage()               {}
+func (*ListAPIKeyResponse) Descriptor() ([]byte, []int) { return fileDescriptor20, []int{4} }
+
+func (m *ListAPIKeyResponse) GetResult() []*GetAPIKeyResponse {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type RevokeAPIKeyRequest struct {
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *RevokeAPIKeyRequest) Reset()                    { *m = RevokeAPIKeyRequest{} }
+func (m *RevokeAPIKeyRequest) String() string            { return proto.CompactTextString(m) }
+func (*RevokeAPIKeyRequest) ProtoMessage()               {}
+func (*RevokeAPIKeyRequest) Descriptor() ([]byte, []int) { return fileDescriptor20, []int{5} }
+
+func (m *RevokeAPIKeyRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type RevokeAPIKeyResponse struct {
+}
+
+func (m *RevokeAPIKeyResponse) Reset()                    { *m = RevokeAPIKeyResponse{} }
+func (m *RevokeAPIKeyResponse) String() string            { return proto.CompactTextString(m) }
+func (*RevokeAPIKeyResponse) ProtoMessage()               {}
+func (*RevokeAPIKeyResponse) Descriptor() ([]byte, []int) { return fileDescriptor20, []int{6} }
+
+func init() {
+	proto.RegisterType((*CreateAPIKeyRequest)(nil), "api.CreateAPIKeyRequest")
+	proto.RegisterType((*CreateAPIKeyResponse)(nil), "api.CreateAPIKeyResponse")
+	proto.RegisterType((*ListAPIKeyRequest)(nil), "api.ListAPIKeyRequest")
+	proto.RegisterType((*GetAPIKeyResponse)(nil), "api.GetAPIKeyResponse")
+	proto.RegisterType((*ListAPIKeyResponse)(nil), "api.ListAPIKeyResponse")
+	proto.RegisterType((*RevokeAPIKeyRequest)(nil), "api.RevokeAPIKeyRequest")
+	proto.RegisterType((*RevokeAPIKeyResponse)(nil), "api.RevokeAPIKeyResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for APIKey service
+
+type APIKeyClient interface {
+	// Create creates the given API key. The key is only returned on
+	// creation.
+	Create(ctx context.Context, in *CreateAPIKeyRequest, opts ...grpc.CallOption) (*CreateAPIKeyResponse, error)
+	// List lists the API keys of the given organization (including the
+	// revoked keys).
+	List(ctx context.Context, in *ListAPIKeyRequest, opts ...grpc.CallOption) (*ListAPIKeyResponse, error)
+	// Revoke revokes the API key matching the given id.
+	Revoke(ctx context.Context, in *RevokeAPIKeyRequest, opts ...grpc.CallOption) (*RevokeAPIKeyResponse, error)
+}
+
+type aPIKeyClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewAPIKeyClient(cc *grpc.ClientConn) APIKeyClient {
+	return &aPIKeyClient{cc}
+}
+
+func (c *aPIKeyClient) Create(ctx context.Context, in *CreateAPIKeyRequest, opts ...grpc.CallOption) (*CreateAPIKeyResponse, error) {
+	out := new(CreateAPIKeyResponse)
+	err := grpc.Invoke(ctx, "/api.APIKey/Create", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIKeyClient) List(ctx context.Context, in *ListAPIKeyRequest, opts ...grpc.CallOption) (*ListAPIKeyResponse, error) {
+	out := new(ListAPIKeyResponse)
+	err := grpc.Invoke(ctx, "/api.APIKey/List", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIKeyClient) Revoke(ctx context.Context, in *RevokeAPIKeyRequest, opts ...grpc.CallOption) (*RevokeAPIKeyResponse, error) {
+	out := new(RevokeAPIKeyResponse)
+	err := grpc.Invoke(ctx, "/api.APIKey/Revoke", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for APIKey service
+
+type APIKeyServer interface {
+	// Create creates the given API key. The key is only returned on
+	// creation.
+	Create(context.Context, *CreateAPIKeyRequest) (*CreateAPIKeyResponse, error)
+	// List lists the API keys of the given organization (including the
+	// revoked keys).
+	List(context.Context, *ListAPIKeyRequest) (*ListAPIKeyResponse, error)
+	// Revoke revokes the API key matching the given id.
+	Revoke(context.Context, *RevokeAPIKeyRequest) (*RevokeAPIKeyResponse, error)
+}
+
+func RegisterAPIKeyServer(s *grpc.Server, srv APIKeyServer) {
+	s.RegisterService(&_APIKey_serviceDesc, srv)
+}
+
+func _APIKey_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIKeyServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.APIKey/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIKeyServer).Create(ctx, req.(*CreateAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIKey_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIKeyServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.APIKey/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIKeyServer).List(ctx, req.(*ListAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIKey_Revoke_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIKeyServer).Revoke(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.APIKey/Revoke",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIKeyServer).Revoke(ctx, req.(*RevokeAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _APIKey_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.APIKey",
+	HandlerType: (*APIKeyServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _APIKey_Create_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _APIKey_List_Handler,
+		},
+		{
+			MethodName: "Revoke",
+			Handler:    _APIKey_Revoke_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "apiKey.proto",
+}
+
+func init() { proto.RegisterFile("apiKey.proto", fileDescriptor20) }
+
+var fileDescriptor20 = []byte{
+	// 435 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0x41, 0x8b, 0xd3, 0x40,
+	0x14, 0x66, 0x92, 0x18, 0xe9, 0x73, 0x29, 0xf6, 0xb5, 0x76, 0xd3, 0xb0, 0x87, 0x32, 0xa0, 0xd4,
+	0x3d, 0xb4, 0x50, 0x2f, 0xa2, 0xa7, 0xc5, 0x15, 0x29, 0xab, 0x28, 0x03, 0x8a, 0xd7, 0xd1, 0x0e,
+	0x65, 0xd8, 0x98, 0x19, 0x93, 0x59, 0xa1, 0x8a, 0x17, 0x6f, 0x9e, 0xf5, 0x9f, 0x79, 0xf7, 0xe4,
+	0x0f, 0x91, 0xbe, 0x89, 0x9a, 0x34, 0xc1, 0xde, 0x26, 0xdf, 0x7b, 0xf3, 0xbd, 0xef, 0xfb, 0xf2,
+	0x06, 0x8e, 0xa4, 0xd5, 0x17, 0x6a, 0x3b, 0xb7, 0x85, 0x71, 0x06, 0x43, 0x69, 0x75, 0x7a, 0xb2,
+	0x31, 0x66, 0x93, 0xa9, 0x85, 0xb4, 0x7a, 0x21, 0xf3, 0xdc, 0x38, 0xe9, 0xb4, 0xc9, 0x4b, 0xdf,
+	0x92, 0xa2, 0x29, 0x36, 0x32, 0xd7, 0x1f, 0x09, 0xf4, 0x18, 0xff, 0xce, 0x60, 0xf8, 0xa8, 0x50,
+	0xd2, 0xa9, 0xb3, 0x17, 0xab, 0x0b, 0xb5, 0x15, 0xea, 0xfd, 0x95, 0x2a, 0x1d, 0x22, 0x44, 0xb9,
+	0x7c, 0xa7, 0x12, 0x36, 0x65, 0xb3, 0x9e, 0xa0, 0x33, 0xde, 0x81, 0x7e, 0x9d, 0x61, 0x75, 0x9e,
+	0x04, 0x53, 0x36, 0x0b, 0xc5, 0x1e, 0x8a, 0x63, 0x88, 0xa5, 0xb5, 0x8f, 0x5f, 0xae, 0x92, 0x90,
+	0x6e, 0x57, 0x5f, 0x78, 0x17, 0xa2, 0xc2, 0x64, 0x2a, 0x89, 0xa6, 0x6c, 0xd6, 0x5f, 0xde, 0x9a,
+	0x4b, 0xab, 0xe7, 0xcf, 0x6b, 0x57, 0x85, 0xc9, 0x94, 0xa0, 0x16, 0x7e, 0x1f, 0x46, 0x4d, 0x55,
+	0xa5, 0x35, 0x79, 0xa9, 0xb0, 0x0f, 0x81, 0x5e, 0x93, 0xa8, 0x50, 0x04, 0x7a, 0x8d, 0x37, 0x21,
+	0xbc, 0x54, 0x5b, 0xd2, 0xd1, 0x13, 0xbb, 0x23, 0x7f, 0x08, 0x83, 0xa7, 0xba, 0x74, 0x4d, 0x37,
+	0x6d, 0xe5, 0xac, 0x4b, 0x39, 0xff, 0xc9, 0x60, 0xf0, 0x44, 0xb9, 0x03, 0x43, 0xff, 0x64, 0x13,
+	0xfc, 0x37, 0x9b, 0xf0, 0x40, 0x36, 0x51, 0x67, 0x36, 0xd7, 0x0e, 0x66, 0x83, 0x27, 0xd0, 0x7b,
+	0x4b, 0xd9, 0xac, 0xcf, 0x5c, 0x12, 0x13, 0xcb, 0x3f, 0x60, 0x57, 0x2d, 0xd4, 0x07, 0x73, 0x49,
+	0xd5, 0xeb, 0xbe, 0xfa, 0x17, 0xe0, 0xe7, 0x80, 0xf5, 0x74, 0x2a, 0x83, 0x73, 0x88, 0x0b, 0x55,
+	0x5e, 0x65, 0x2e, 0x61, 0xd3, 0x70, 0x76, 0x63, 0x39, 0xa6, 0xf1, 0xad, 0x20, 0x44, 0xd5, 0xc5,
+	0x6f, 0xc3, 0x50, 0x10, 0x65, 0x33, 0xe5, 0xbd, 0x9c, 0xf8, 0x18, 0x46, 0xcd, 0x36, 0x4f, 0xb3,
+	0xfc, 0x1a, 0x40, 0xec, 0x21, 0x7c, 0x05, 0xb1, 0xff, 0xcf, 0x98, 0xd0, 0xcc, 0x8e, 0x55, 0x4c,
+	0x27, 0x1d, 0x15, 0xcf, 0xc4, 0x8f, 0xbf, 0xfc, 0xf8, 0xf5, 0x2d, 0x18, 0xf0, 0x23, 0xbf, 0xf1,
+	0xf4, 0x1e, 0xca, 0x07, 0xec, 0x14, 0x9f, 0x41, 0xb4, 0xf3, 0x89, 0xde, 0x49, 0x6b, 0x21, 0xd2,
+	0xe3, 0x16, 0x5e, 0x31, 0x8e, 0x88, 0xb1, 0x8f, 0x0d, 0x46, 0x7c, 0x0d, 0xb1, 0x77, 0x52, 0xc9,
+	0xec, 0x70, 0x9f, 0x4e, 0x3a, 0x2a, 0x15, 0xe9, 0x84, 0x48, 0x87, 0xa7, 0x83, 0x3a, 0xe9, 0xe2,
+	0x93, 0x5e, 0x7f, 0x7e, 0x13, 0xd3, 0x33, 0xbc, 0xf7, 0x7b, 0x00, 0x5a, 0xac, 0x10, 0xfe, 0xcd,
+	0x03, 0x00, 0x00,
+}
@@ -0,0 +1,317 @@
+// Code generated by protoc-gen-go.
+// source: auditLog.proto
+// DO NOT EDIT!
+
+package api
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import _ "github.com/grpc-ecosystem/grpc-gateway/third_party/googleapis/google/api"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+type ListAuditLogRequest struct {
+	// only return the entries of this actor (e.g. a username, apikey:<id> or mqtt, optional)
+	Actor string `protobuf:"bytes,1,opt,name=actor" json:"actor,omitempty"`
+	// only return the entries of this object type (node, application, downlink, node-session, signing-key or api-key, optional)
+	ObjectType string `protobuf:"bytes,2,opt,name=objectType" json:"objectType,omitempty"`
+	// only return the entries of this object id (e.g. the DevEUI, optional)
+	ObjectID string `protobuf:"bytes,3,opt,name=objectID" json:"objectID,omitempty"`
+	// only return the entries of this application (hex encoded AppEUI, optional)
+	AppEUI string `protobuf:"bytes,4,opt,name=appEUI" json:"appEUI,omitempty"`
+	// only return the entries created at or after this time (RFC3339, optional)
+	Start string `protobuf:"bytes,5,opt,name=start" json:"start,omitempty"`
+	// only return the entries created before this time (RFC3339, optional)
+	End string `protobuf:"bytes,6,opt,name=end" json:"end,omitempty"`
+	// max number of entries to return
+	Limit int64 `protobuf:"varint,7,opt,name=limit" json:"limit,omitempty"`
+	// offset in the result-set (for pagination)
+	Offset int64 `protobuf:"varint,8,opt,name=offset" json:"offset,omitempty"`
+}
+
+func (m *ListAuditLogRequest) Reset()                    { *m = ListAuditLogRequest{} }
+func (m *ListAuditLogRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListAuditLogRequest) ProtoMessage()               {}
+func (*ListAuditLogRequest) Descriptor() ([]byte, []int) { return fileDescriptor31, []int{0} }
+
+func (m *ListAuditLogRequest) GetActor() string {
+	if m != nil {
+		return m.Actor
+	}
+	return ""
+}
+
+func (m *ListAuditLogRequest) GetObjectType() string {
+	if m != nil {
+		return m.ObjectType
+	}
+	return ""
+}
+
+func (m *ListAuditLogRequest) GetObjectID() string {
+	if m != nil {
+		return m.ObjectID
+	}
+	return ""
+}
+
+func (m *ListAuditLogRequest) GetAppEUI() string {
+	if m != nil {
+		return m.AppEUI
+	}
+	return ""
+}
+
+func (m *ListAuditLogRequest) GetStart() string {
+	if m != nil {
+		return m.Start
+	}
+	return ""
+}
+
+func (m *ListAuditLogRequest) GetEnd() string {
+	if m != nil {
+		return m.End
+	}
+	return ""
+}
+
+func (m *ListAuditLogRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListAuditLogRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+type AuditLogEntry struct {
+	// id of the entry
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	// timestamp of the action (RFC3339)
+	CreatedAt string `protobuf:"bytes,2,opt,name=createdAt" json:"createdAt,omitempty"`
+	// user, API key or integration which performed the action
+	Actor string `protobuf:"bytes,3,opt,name=actor" json:"actor,omitempty"`
+	// action (e.g. Node.Create or DownlinkQueue.Enqueue)
+	Action string `protobuf:"bytes,4,opt,name=action" json:"action,omitempty"`
+	// type of the object of the action
+	ObjectType string `protobuf:"bytes,5,opt,name=objectType" json:"objectType,omitempty"`
+	// id of the object of the action
+	ObjectID string `protobuf:"bytes,6,opt,name=objectID" json:"objectID,omitempty"`
+	// hex encoded AppEUI (when related to an application)
+	AppEUI string `protobuf:"bytes,7,opt,name=appEUI" json:"appEUI,omitempty"`
+	// JSON encoded details of the action
+	DetailsJSON string `protobuf:"bytes,8,opt,name=detailsJSON" json:"detailsJSON,omitempty"`
+}
+
+func (m *AuditLogEntry) Reset()                    { *m = AuditLogEntry{} }
+func (m *AuditLogEntry) String() string            { return proto.CompactTextString(m) }
+func (*AuditLogEntry) ProtoMessage()               {}
+func (*AuditLogEntry) Descriptor() ([]byte, []int) { return fileDescriptor31, []int{1} }
+
+func (m *AuditLogEntry) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *AuditLogEntry) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return ""
+}
+
+func (m *AuditLogEntry) GetActor() string {
+	if m != nil {
+		return m.Actor
+	}
+	return ""
+}
+
+func (m *AuditLogEntry) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *AuditLogEntry) GetObjectType() string {
+	if m != nil {
+		return m.ObjectType
+	}
+	return ""
+}
+
+func (m *AuditLogEntry) GetObjectID() string {
+	if m != nil {
+		return m.ObjectID
+	}
+	return ""
+}
+
+func (m *AuditLogEntry) GetAppEUI() string {
+	if m != nil {
+		return m.AppEUI
+	}
+	return ""
+}
+
+func (m *AuditLogEntry) GetDetailsJSON() string {
+	if m != nil {
+		return m.DetailsJSON
+	}
+	return ""
+}
+
+type ListAuditLogResponse struct {
+	// total number of entries matching the filters
+	TotalCount int64            `protobuf:"varint,1,opt,name=totalCount" json:"totalCount,omitempty"`
+	Result     []*AuditLogEntry `protobuf:"bytes,2,rep,name=result" json:"result,omitempty"`
+}
+
+func (m *ListAuditLogResponse) Reset()                    { *m = ListAuditLogResponse{} }
+func (m *ListAuditLogResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListAuditLogResponse) ProtoMessage()               {}
+func (*ListAuditLogResponse) Descriptor() ([]byte, []int) { return fileDescriptor31, []int{2} }
+
+func (m *ListAuditLogResponse) GetTotalCount() int64 {
+	if m != nil {
+		return m.TotalCount
+	}
+	return 0
+}
+
+func (m *ListAuditLogResponse) GetResult() []*AuditLogEntry {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ListAuditLogRequest)(nil), "api.ListAuditLogRequest")
+	proto.RegisterType((*AuditLogEntry)(nil), "api.AuditLogEntry")
+	proto.RegisterType((*ListAuditLogResponse)(nil), "api.ListAuditLogResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for AuditLog service
+
+type AuditLogClient interface {
+	// List lists the audit log entries matching the given filters, newest
+	// first. Without appEUI, access to the whole audit log is required.
+	List(ctx context.Context, in *ListAuditLogRequest, opts ...grpc.CallOption) (*ListAuditLogResponse, error)
+}
+
+type auditLogClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewAuditLogClient(cc *grpc.ClientConn) AuditLogClient {
+	return &auditLogClient{cc}
+}
+
+func (c *auditLogClient) List(ctx context.Context, in *ListAuditLogRequest, opts ...grpc.CallOption) (*ListAuditLogResponse, error) {
+	out := new(ListAuditLogResponse)
+	err := grpc.Invoke(ctx, "/api.AuditLog/List", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for AuditLog service
+
+type AuditLogServer interface {
+	// List lists the audit log entries matching the given filters, newest
+	// first. Without appEUI, access to the whole audit log is required.
+	List(context.Context, *ListAuditLogRequest) (*ListAuditLogResponse, error)
+}
+
+func RegisterAuditLogServer(s *grpc.Server, srv AuditLogServer) {
+	s.RegisterService(&_AuditLog_serviceDesc, srv)
+}
+
+func _AuditLog_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuditLogServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.AuditLog/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuditLogServer).List(ctx, req.(*ListAuditLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _AuditLog_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.AuditLog",
+	HandlerType: (*AuditLogServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "List",
+			Handler:    _AuditLog_List_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "auditLog.proto",
+}
+
+func init() { proto.RegisterFile("auditLog.proto", fileDescriptor31) }
+
+var fileDescriptor31 = []byte{
+	// 379 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xd1, 0x6a, 0xe2, 0x40,
+	0x14, 0x86, 0x49, 0xa2, 0x51, 0x8f, 0xe8, 0x2e, 0xb3, 0xee, 0x32, 0x2b, 0xb2, 0x48, 0xae, 0x64,
+	0x2f, 0x14, 0xdc, 0x27, 0x90, 0xad, 0x17, 0x16, 0x69, 0x21, 0x6d, 0x6f, 0x0b, 0x63, 0x32, 0xca,
+	0x94, 0x34, 0x33, 0xcd, 0x9c, 0x5c, 0x78, 0xdb, 0x57, 0xe8, 0xa3, 0x95, 0x3e, 0x40, 0xa1, 0x0f,
+	0x52, 0x66, 0x12, 0x35, 0x16, 0xdb, 0xbb, 0xfc, 0xff, 0x7f, 0x92, 0x99, 0xef, 0xcf, 0x81, 0x2e,
+	0xcb, 0x63, 0x81, 0x4b, 0xb9, 0x19, 0xab, 0x4c, 0xa2, 0x24, 0x1e, 0x53, 0xa2, 0x3f, 0xd8, 0x48,
+	0xb9, 0x49, 0xf8, 0x84, 0x29, 0x31, 0x61, 0x69, 0x2a, 0x91, 0xa1, 0x90, 0xa9, 0x2e, 0x46, 0x82,
+	0x17, 0x07, 0x7e, 0x2c, 0x85, 0xc6, 0x59, 0xf9, 0x66, 0xc8, 0x1f, 0x72, 0xae, 0x91, 0xf4, 0xa0,
+	0xce, 0x22, 0x94, 0x19, 0x75, 0x86, 0xce, 0xa8, 0x15, 0x16, 0x82, 0xfc, 0x01, 0x90, 0xab, 0x3b,
+	0x1e, 0xe1, 0xf5, 0x56, 0x71, 0xea, 0xda, 0xa8, 0xe2, 0x90, 0x3e, 0x34, 0x0b, 0xb5, 0x38, 0xa3,
+	0x9e, 0x4d, 0xf7, 0x9a, 0xfc, 0x02, 0x9f, 0x29, 0x35, 0xbf, 0x59, 0xd0, 0x9a, 0x4d, 0x4a, 0x65,
+	0x4e, 0xd2, 0xc8, 0x32, 0xa4, 0xf5, 0xe2, 0x24, 0x2b, 0xc8, 0x77, 0xf0, 0x78, 0x1a, 0x53, 0xdf,
+	0x7a, 0xe6, 0xd1, 0xcc, 0x25, 0xe2, 0x5e, 0x20, 0x6d, 0x0c, 0x9d, 0x91, 0x17, 0x16, 0xc2, 0x7c,
+	0x55, 0xae, 0xd7, 0x9a, 0x23, 0x6d, 0x5a, 0xbb, 0x54, 0xc1, 0xab, 0x03, 0x9d, 0x1d, 0xd3, 0x3c,
+	0xc5, 0x6c, 0x4b, 0xba, 0xe0, 0x8a, 0xd8, 0xe2, 0x78, 0xa1, 0x2b, 0x62, 0x32, 0x80, 0x56, 0x94,
+	0x71, 0x86, 0x3c, 0x9e, 0x61, 0x89, 0x72, 0x30, 0x0e, 0xfc, 0x5e, 0x95, 0xdf, 0x30, 0x44, 0xa6,
+	0xbe, 0x3d, 0x83, 0x55, 0x1f, 0x7a, 0xa9, 0x7f, 0xd9, 0x8b, 0xff, 0x69, 0x2f, 0x8d, 0xa3, 0x5e,
+	0x86, 0xd0, 0x8e, 0x39, 0x32, 0x91, 0xe8, 0xf3, 0xab, 0xcb, 0x0b, 0x8b, 0xd7, 0x0a, 0xab, 0x56,
+	0xb0, 0x82, 0xde, 0xf1, 0xaf, 0xd3, 0x4a, 0xa6, 0x9a, 0x9b, 0xdb, 0xa0, 0x44, 0x96, 0xfc, 0x97,
+	0x79, 0x8a, 0x25, 0x71, 0xc5, 0x21, 0x7f, 0xc1, 0xcf, 0xb8, 0xce, 0x13, 0x83, 0xed, 0x8d, 0xda,
+	0x53, 0x32, 0x66, 0x4a, 0x8c, 0x8f, 0xda, 0x0a, 0xcb, 0x89, 0xe9, 0x2d, 0x34, 0x77, 0x01, 0x09,
+	0xa1, 0x66, 0xce, 0x23, 0xd4, 0xce, 0x9f, 0xd8, 0x9a, 0xfe, 0xef, 0x13, 0x49, 0x71, 0xa9, 0xe0,
+	0xe7, 0xe3, 0xf3, 0xdb, 0x93, 0xfb, 0x8d, 0x74, 0x8a, 0x45, 0x2c, 0xe3, 0x95, 0x6f, 0xd7, 0xf0,
+	0xdf, 0xfb, 0x00, 0x1d, 0x0f, 0x58, 0xc3, 0xbb, 0x02, 0x00, 0x00,
+}
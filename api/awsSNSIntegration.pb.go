@@ -0,0 +1,483 @@
+// Code generated by protoc-gen-go.
+// source: awsSNSIntegration.proto
+// DO NOT EDIT!
+
+package api
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import _ "github.com/grpc-ecosystem/grpc-gateway/third_party/googleapis/google/api"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+type CreateAWSSNSIntegrationRequest struct {
+	// hex encoded AppEUI
+	AppEUI string `protobuf:"bytes,1,opt,name=appEUI" json:"appEUI,omitempty"`
+	// AWS region (e.g. eu-west-1)
+	Region string `protobuf:"bytes,2,opt,name=region" json:"region,omitempty"`
+	// access key id of the credentials
+	AccessKeyID string `protobuf:"bytes,3,opt,name=accessKeyID" json:"accessKeyID,omitempty"`
+	// secret access key of the credentials
+	SecretAccessKey string `protobuf:"bytes,4,opt,name=secretAccessKey" json:"secretAccessKey,omitempty"`
+	// ARN of the SNS topic to publish the events to
+	TopicARN string `protobuf:"bytes,5,opt,name=topicARN" json:"topicARN,omitempty"`
+	// URL of the SQS queue to consume the downlink payloads from (optional)
+	QueueURL string `protobuf:"bytes,6,opt,name=queueURL" json:"queueURL,omitempty"`
+}
+
+func (m *CreateAWSSNSIntegrationRequest) Reset()                    { *m = CreateAWSSNSIntegrationRequest{} }
+func (m *CreateAWSSNSIntegrationRequest) String() string            { return proto.CompactTextString(m) }
+func (*CreateAWSSNSIntegrationRequest) ProtoMessage()               {}
+func (*CreateAWSSNSIntegrationRequest) Descriptor() ([]byte, []int) { return fileDescriptor23, []int{0} }
+
+func (m *CreateAWSSNSIntegrationRequest) GetAppEUI() string {
+	if m != nil {
+		return m.AppEUI
+	}
+	return ""
+}
+
+func (m *CreateAWSSNSIntegrationRequest) GetRegion() string {
+	if m != nil {
+		return m.Region
+	}
+	return ""
+}
+
+func (m *CreateAWSSNSIntegrationRequest) GetAccessKeyID() string {
+	if m != nil {
+		return m.AccessKeyID
+	}
+	return ""
+}
+
+func (m *CreateAWSSNSIntegrationRequest) GetSecretAccessKey() string {
+	if m != nil {
+		return m.SecretAccessKey
+	}
+	return ""
+}
+
+func (m *CreateAWSSNSIntegrationRequest) GetTopicARN() string {
+	if m != nil {
+		return m.TopicARN
+	}
+	return ""
+}
+
+func (m *CreateAWSSNSIntegrationRequest) GetQueueURL() string {
+	if m != nil {
+		return m.QueueURL
+	}
+	return ""
+}
+
+type CreateAWSSNSIntegrationResponse struct {
+}
+
+func (m *CreateAWSSNSIntegrationResponse) Reset()         { *m = CreateAWSSNSIntegrationResponse{} }
+func (m *CreateAWSSNSIntegrationResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateAWSSNSIntegrationResponse) ProtoMessage()    {}
+func (*CreateAWSSNSIntegrationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor23, []int{1}
+}
+
+type GetAWSSNSIntegrationRequest struct {
+	// hex encoded AppEUI
+	AppEUI string `protobuf:"bytes,1,opt,name=appEUI" json:"appEUI,omitempty"`
+}
+
+func (m *GetAWSSNSIntegrationRequest) Reset()                    { *m = GetAWSSNSIntegrationRequest{} }
+func (m *GetAWSSNSIntegrationRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetAWSSNSIntegrationRequest) ProtoMessage()               {}
+func (*GetAWSSNSIntegrationRequest) Descriptor() ([]byte, []int) { return fileDescriptor23, []int{2} }
+
+func (m *GetAWSSNSIntegrationRequest) GetAppEUI() string {
+	if m != nil {
+		return m.AppEUI
+	}
+	return ""
+}
+
+type GetAWSSNSIntegrationResponse struct {
+	// hex encoded AppEUI
+	AppEUI string `protobuf:"bytes,1,opt,name=appEUI" json:"appEUI,omitempty"`
+	// AWS region (e.g. eu-west-1)
+	Region string `protobuf:"bytes,2,opt,name=region" json:"region,omitempty"`
+	// access key id of the credentials (the secret access key is not returned)
+	AccessKeyID string `protobuf:"bytes,3,opt,name=accessKeyID" json:"accessKeyID,omitempty"`
+	// ARN of the SNS topic to publish the events to
+	TopicARN string `protobuf:"bytes,4,opt,name=topicARN" json:"topicARN,omitempty"`
+	// URL of the SQS queue to consume the downlink payloads from
+	QueueURL string `protobuf:"bytes,5,opt,name=queueURL" json:"queueURL,omitempty"`
+}
+
+func (m *GetAWSSNSIntegrationResponse) Reset()                    { *m = GetAWSSNSIntegrationResponse{} }
+func (m *GetAWSSNSIntegrationResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetAWSSNSIntegrationResponse) ProtoMessage()               {}
+func (*GetAWSSNSIntegrationResponse) Descriptor() ([]byte, []int) { return fileDescriptor23, []int{3} }
+
+func (m *GetAWSSNSIntegrationResponse) GetAppEUI() string {
+	if m != nil {
+		return m.AppEUI
+	}
+	return ""
+}
+
+func (m *GetAWSSNSIntegrationResponse) GetRegion() string {
+	if m != nil {
+		return m.Region
+	}
+	return ""
+}
+
+func (m *GetAWSSNSIntegrationResponse) GetAccessKeyID() string {
+	if m != nil {
+		return m.AccessKeyID
+	}
+	return ""
+}
+
+func (m *GetAWSSNSIntegrationResponse) GetTopicARN() string {
+	if m != nil {
+		return m.TopicARN
+	}
+	return ""
+}
+
+func (m *GetAWSSNSIntegrationResponse) GetQueueURL() string {
+	if m != nil {
+		return m.QueueURL
+	}
+	return ""
+}
+
+type UpdateAWSSNSIntegrationRequest struct {
+	// hex encoded AppEUI
+	AppEUI string `protobuf:"bytes,1,opt,name=appEUI" json:"appEUI,omitempty"`
+	// AWS region (e.g. eu-west-1)
+	Region string `protobuf:"bytes,2,opt,name=region" json:"region,omitempty"`
+	// access key id of the credentials
+	AccessKeyID string `protobuf:"bytes,3,opt,name=accessKeyID" json:"accessKeyID,omitempty"`
+	// secret access key of the credentials (when empty, the current secret is kept)
+	SecretAccessKey string `protobuf:"bytes,4,opt,name=secretAccessKey" json:"secretAccessKey,omitempty"`
+	// ARN of the SNS topic to publish the events to
+	TopicARN string `protobuf:"bytes,5,opt,name=topicARN" json:"topicARN,omitempty"`
+	// URL of the SQS queue to consume the downlink payloads from (optional)
+	QueueURL string `protobuf:"bytes,6,opt,name=queueURL" json:"queueURL,omitempty"`
+}
+
+func (m *UpdateAWSSNSIntegrationRequest) Reset()                    { *m = UpdateAWSSNSIntegrationRequest{} }
+func (m *UpdateAWSSNSIntegrationRequest) String() string            { return proto.CompactTextString(m) }
+func (*UpdateAWSSNSIntegrationRequest) ProtoMessage()               {}
+func (*UpdateAWSSNSIntegrationRequest) Descriptor() ([]byte, []int) { return fileDescriptor23, []int{4} }
+
+func (m *UpdateAWSSNSIntegrationRequest) GetAppEUI() string {
+	if m != nil {
+		return m.AppEUI
+	}
+	return ""
+}
+
+func (m *UpdateAWSSNSIntegrationRequest) GetRegion() string {
+	if m != nil {
+		return m.Region
+	}
+	return ""
+}
+
+func (m *UpdateAWSSNSIntegrationRequest) GetAccessKeyID() string {
+	if m != nil {
+		return m.AccessKeyID
+	}
+	return ""
+}
+
+func (m *UpdateAWSSNSIntegrationRequest) GetSecretAccessKey() string {
+	if m != nil {
+		return m.SecretAccessKey
+	}
+	return ""
+}
+
+func (m *UpdateAWSSNSIntegrationRequest) GetTopicARN() string {
+	if m != nil {
+		return m.TopicARN
+	}
+	return ""
+}
+
+func (m *UpdateAWSSNSIntegrationRequest) GetQueueURL() string {
+	if m != nil {
+		return m.QueueURL
+	}
+	return ""
+}
+
+type UpdateAWSSNSIntegrationResponse struct {
+}
+
+func (m *UpdateAWSSNSIntegrationResponse) Reset()         { *m = UpdateAWSSNSIntegrationResponse{} }
+func (m *UpdateAWSSNSIntegrationResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateAWSSNSIntegrationResponse) ProtoMessage()    {}
+func (*UpdateAWSSNSIntegrationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor23, []int{5}
+}
+
+type DeleteAWSSNSIntegrationRequest struct {
+	// hex encoded AppEUI
+	AppEUI string `protobuf:"bytes,1,opt,name=appEUI" json:"appEUI,omitempty"`
+}
+
+func (m *DeleteAWSSNSIntegrationRequest) Reset()                    { *m = DeleteAWSSNSIntegrationRequest{} }
+func (m *DeleteAWSSNSIntegrationRequest) String() string            { return proto.CompactTextString(m) }
+func (*DeleteAWSSNSIntegrationRequest) ProtoMessage()               {}
+func (*DeleteAWSSNSIntegrationRequest) Descriptor() ([]byte, []int) { return fileDescriptor23, []int{6} }
+
+func (m *DeleteAWSSNSIntegrationRequest) GetAppEUI() string {
+	if m != nil {
+		return m.AppEUI
+	}
+	return ""
+}
+
+type DeleteAWSSNSIntegrationResponse struct {
+}
+
+func (m *DeleteAWSSNSIntegrationResponse) Reset()         { *m = DeleteAWSSNSIntegrationResponse{} }
+func (m *DeleteAWSSNSIntegrationResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteAWSSNSIntegrationResponse) ProtoMessage()    {}
+func (*DeleteAWSSNSIntegrationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor23, []int{7}
+}
+
+func init() {
+	proto.RegisterType((*CreateAWSSNSIntegrationRequest)(nil), "api.CreateAWSSNSIntegrationRequest")
+	proto.RegisterType((*CreateAWSSNSIntegrationResponse)(nil), "api.CreateAWSSNSIntegrationResponse")
+	proto.RegisterType((*GetAWSSNSIntegrationRequest)(nil), "api.GetAWSSNSIntegrationRequest")
+	proto.RegisterType((*GetAWSSNSIntegrationResponse)(nil), "api.GetAWSSNSIntegrationResponse")
+	proto.RegisterType((*UpdateAWSSNSIntegrationRequest)(nil), "api.UpdateAWSSNSIntegrationRequest")
+	proto.RegisterType((*UpdateAWSSNSIntegrationResponse)(nil), "api.UpdateAWSSNSIntegrationResponse")
+	proto.RegisterType((*DeleteAWSSNSIntegrationRequest)(nil), "api.DeleteAWSSNSIntegrationRequest")
+	proto.RegisterType((*DeleteAWSSNSIntegrationResponse)(nil), "api.DeleteAWSSNSIntegrationResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for AWSSNSIntegration service
+
+type AWSSNSIntegrationClient interface {
+	// Create creates the AWS SNS integration of the given application.
+	Create(ctx context.Context, in *CreateAWSSNSIntegrationRequest, opts ...grpc.CallOption) (*CreateAWSSNSIntegrationResponse, error)
+	// Get returns the AWS SNS integration of the given application.
+	Get(ctx context.Context, in *GetAWSSNSIntegrationRequest, opts ...grpc.CallOption) (*GetAWSSNSIntegrationResponse, error)
+	// Update updates the AWS SNS integration of the given application.
+	Update(ctx context.Context, in *UpdateAWSSNSIntegrationRequest, opts ...grpc.CallOption) (*UpdateAWSSNSIntegrationResponse, error)
+	// Delete deletes the AWS SNS integration of the given application.
+	Delete(ctx context.Context, in *DeleteAWSSNSIntegrationRequest, opts ...grpc.CallOption) (*DeleteAWSSNSIntegrationResponse, error)
+}
+
+type aWSSNSIntegrationClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewAWSSNSIntegrationClient(cc *grpc.ClientConn) AWSSNSIntegrationClient {
+	return &aWSSNSIntegrationClient{cc}
+}
+
+func (c *aWSSNSIntegrationClient) Create(ctx context.Context, in *CreateAWSSNSIntegrationRequest, opts ...grpc.CallOption) (*CreateAWSSNSIntegrationResponse, error) {
+	out := new(CreateAWSSNSIntegrationResponse)
+	err := grpc.Invoke(ctx, "/api.AWSSNSIntegration/Create", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aWSSNSIntegrationClient) Get(ctx context.Context, in *GetAWSSNSIntegrationRequest, opts ...grpc.CallOption) (*GetAWSSNSIntegrationResponse, error) {
+	out := new(GetAWSSNSIntegrationResponse)
+	err := grpc.Invoke(ctx, "/api.AWSSNSIntegration/Get", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aWSSNSIntegrationClient) Update(ctx context.Context, in *UpdateAWSSNSIntegrationRequest, opts ...grpc.CallOption) (*UpdateAWSSNSIntegrationResponse, error) {
+	out := new(UpdateAWSSNSIntegrationResponse)
+	err := grpc.Invoke(ctx, "/api.AWSSNSIntegration/Update", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aWSSNSIntegrationClient) Delete(ctx context.Context, in *DeleteAWSSNSIntegrationRequest, opts ...grpc.CallOption) (*DeleteAWSSNSIntegrationResponse, error) {
+	out := new(DeleteAWSSNSIntegrationResponse)
+	err := grpc.Invoke(ctx, "/api.AWSSNSIntegration/Delete", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for AWSSNSIntegration service
+
+type AWSSNSIntegrationServer interface {
+	// Create creates the AWS SNS integration of the given application.
+	Create(context.Context, *CreateAWSSNSIntegrationRequest) (*CreateAWSSNSIntegrationResponse, error)
+	// Get returns the AWS SNS integration of the given application.
+	Get(context.Context, *GetAWSSNSIntegrationRequest) (*GetAWSSNSIntegrationResponse, error)
+	// Update updates the AWS SNS integration of the given application.
+	Update(context.Context, *UpdateAWSSNSIntegrationRequest) (*UpdateAWSSNSIntegrationResponse, error)
+	// Delete deletes the AWS SNS integration of the given application.
+	Delete(context.Context, *DeleteAWSSNSIntegrationRequest) (*DeleteAWSSNSIntegrationResponse, error)
+}
+
+func RegisterAWSSNSIntegrationServer(s *grpc.Server, srv AWSSNSIntegrationServer) {
+	s.RegisterService(&_AWSSNSIntegration_serviceDesc, srv)
+}
+
+func _AWSSNSIntegration_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAWSSNSIntegrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AWSSNSIntegrationServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.AWSSNSIntegration/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AWSSNSIntegrationServer).Create(ctx, req.(*CreateAWSSNSIntegrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AWSSNSIntegration_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAWSSNSIntegrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AWSSNSIntegrationServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.AWSSNSIntegration/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AWSSNSIntegrationServer).Get(ctx, req.(*GetAWSSNSIntegrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AWSSNSIntegration_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAWSSNSIntegrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AWSSNSIntegrationServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.AWSSNSIntegration/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AWSSNSIntegrationServer).Update(ctx, req.(*UpdateAWSSNSIntegrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AWSSNSIntegration_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAWSSNSIntegrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AWSSNSIntegrationServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.AWSSNSIntegration/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AWSSNSIntegrationServer).Delete(ctx, req.(*DeleteAWSSNSIntegrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _AWSSNSIntegration_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.AWSSNSIntegration",
+	HandlerType: (*AWSSNSIntegrationServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _AWSSNSIntegration_Create_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _AWSSNSIntegration_Get_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _AWSSNSIntegration_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _AWSSNSIntegration_Delete_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "awsSNSIntegration.proto",
+}
+
+func init() { proto.RegisterFile("awsSNSIntegration.proto", fileDescriptor23) }
+
+var fileDescriptor23 = []byte{
+	// 407 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x54, 0xcd, 0x4a, 0xf3, 0x40,
+	0x14, 0x25, 0xfd, 0x09, 0xdf, 0x37, 0x2e, 0xc4, 0x59, 0xd8, 0x10, 0x4b, 0x4d, 0x63, 0x85, 0x52,
+	0xa1, 0x05, 0x45, 0x10, 0x77, 0xc5, 0x4a, 0x29, 0x4a, 0x17, 0x29, 0xc5, 0xf5, 0x18, 0x2f, 0x21,
+	0x50, 0x32, 0xd3, 0xcc, 0x94, 0x22, 0x52, 0x10, 0xf1, 0x0d, 0x7c, 0x09, 0xdf, 0x47, 0x1f, 0xc1,
+	0x07, 0x91, 0xcc, 0xa4, 0xd2, 0x56, 0x33, 0xa5, 0x0b, 0x37, 0x2e, 0xef, 0xbd, 0x27, 0xf7, 0xcc,
+	0x39, 0x67, 0x26, 0xa8, 0x44, 0xa6, 0x7c, 0xd0, 0x1f, 0xf4, 0x22, 0x01, 0x41, 0x4c, 0x44, 0x48,
+	0xa3, 0x26, 0x8b, 0xa9, 0xa0, 0x38, 0x4f, 0x58, 0x68, 0x97, 0x03, 0x4a, 0x83, 0x11, 0xb4, 0x08,
+	0x0b, 0x5b, 0x24, 0x8a, 0xa8, 0x90, 0x08, 0xae, 0x20, 0xee, 0xbb, 0x81, 0x2a, 0x17, 0x31, 0x10,
+	0x01, 0xed, 0x9b, 0xc1, 0xf2, 0x12, 0x0f, 0xc6, 0x13, 0xe0, 0x02, 0xef, 0x22, 0x93, 0x30, 0x76,
+	0x39, 0xec, 0x59, 0x86, 0x63, 0xd4, 0xff, 0x7b, 0x69, 0x95, 0xf4, 0x63, 0x08, 0x42, 0x1a, 0x59,
+	0x39, 0xd5, 0x57, 0x15, 0x76, 0xd0, 0x16, 0xf1, 0x7d, 0xe0, 0xfc, 0x0a, 0xee, 0x7b, 0x1d, 0x2b,
+	0x2f, 0x87, 0x8b, 0x2d, 0x5c, 0x47, 0xdb, 0x1c, 0xfc, 0x18, 0x44, 0x7b, 0xde, 0xb4, 0x0a, 0x12,
+	0xb5, 0xda, 0xc6, 0x36, 0xfa, 0x27, 0x28, 0x0b, 0xfd, 0xb6, 0xd7, 0xb7, 0x8a, 0x12, 0xf2, 0x55,
+	0x27, 0xb3, 0xf1, 0x04, 0x26, 0x30, 0xf4, 0xae, 0x2d, 0x53, 0xcd, 0xe6, 0xb5, 0x5b, 0x45, 0xfb,
+	0x99, 0xaa, 0x38, 0xa3, 0x11, 0x07, 0xf7, 0x14, 0xed, 0x75, 0x41, 0x6c, 0xaa, 0xda, 0x7d, 0x35,
+	0x50, 0xf9, 0xe7, 0xef, 0xd4, 0xde, 0x5f, 0xb0, 0x6b, 0xd1, 0x84, 0x82, 0xc6, 0x84, 0xe2, 0x8a,
+	0x09, 0x49, 0xb6, 0x43, 0x76, 0xf7, 0x07, 0xb3, 0xcd, 0x54, 0x95, 0x66, 0x7b, 0x86, 0x2a, 0x1d,
+	0x18, 0xc1, 0xe6, 0xc2, 0x93, 0xe5, 0x99, 0x5f, 0xaa, 0xe5, 0xc7, 0xcf, 0x05, 0xb4, 0xf3, 0x6d,
+	0x8a, 0xa7, 0xc8, 0x54, 0x37, 0x0e, 0x1f, 0x34, 0x09, 0x0b, 0x9b, 0xfa, 0x47, 0x65, 0xd7, 0xf4,
+	0xa0, 0x54, 0x87, 0xfb, 0xf4, 0xf6, 0xf1, 0x92, 0x2b, 0xbb, 0x25, 0xf5, 0x7a, 0x57, 0x9f, 0x39,
+	0x3f, 0x37, 0x1a, 0x58, 0xa0, 0x7c, 0x17, 0x04, 0x76, 0xe4, 0x42, 0xcd, 0x8d, 0xb6, 0xab, 0x1a,
+	0x44, 0xca, 0x57, 0x97, 0x7c, 0x2e, 0x76, 0x32, 0xf8, 0x5a, 0x0f, 0xca, 0xa6, 0x19, 0x7e, 0x34,
+	0x90, 0xa9, 0x52, 0x48, 0xf5, 0xea, 0x2f, 0x9a, 0x5d, 0xd3, 0x83, 0x52, 0xfe, 0x23, 0xc9, 0x7f,
+	0x68, 0xaf, 0xe5, 0x4f, 0x84, 0xcf, 0x90, 0xa9, 0xa2, 0x4a, 0x4f, 0xa0, 0x4f, 0xdc, 0xae, 0xe9,
+	0x41, 0xcb, 0x0e, 0x34, 0xd6, 0x9e, 0xe0, 0xd6, 0x94, 0x3f, 0xd0, 0x93, 0xcf, 0x01, 0x00, 0x50,
+	0x04, 0xe1, 0x71, 0x7e, 0x05, 0x00, 0x00,
+}
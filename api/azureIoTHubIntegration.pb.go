@@ -0,0 +1,430 @@
+// Code generated by protoc-gen-go.
+// source: azureIoTHubIntegration.proto
+// DO NOT EDIT!
+
+package api
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import _ "github.com/grpc-ecosystem/grpc-gateway/third_party/googleapis/google/api"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+type CreateAzureIoTHubIntegrationRequest struct {
+	// hex encoded AppEUI
+	AppEUI string `protobuf:"bytes,1,opt,name=appEUI" json:"appEUI,omitempty"`
+	// connection string of an IoT Hub shared access policy with the DeviceConnect permission
+	ConnectionString string `protobuf:"bytes,2,opt,name=connectionString" json:"connectionString,omitempty"`
+	// receive the cloud-to-device messages of the devices as downlink payloads
+	C2DEnabled bool `protobuf:"varint,3,opt,name=c2dEnabled" json:"c2dEnabled,omitempty"`
+}
+
+func (m *CreateAzureIoTHubIntegrationRequest) Reset()         { *m = CreateAzureIoTHubIntegrationRequest{} }
+func (m *CreateAzureIoTHubIntegrationRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateAzureIoTHubIntegrationRequest) ProtoMessage()    {}
+func (*CreateAzureIoTHubIntegrationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor24, []int{0}
+}
+
+func (m *CreateAzureIoTHubIntegrationRequest) GetAppEUI() string {
+	if m != nil {
+		return m.AppEUI
+	}
+	return ""
+}
+
+func (m *CreateAzureIoTHubIntegrationRequest) GetConnectionString() string {
+	if m != nil {
+		return m.ConnectionString
+	}
+	return ""
+}
+
+func (m *CreateAzureIoTHubIntegrationRequest) GetC2DEnabled() bool {
+	if m != nil {
+		return m.C2DEnabled
+	}
+	return false
+}
+
+type CreateAzureIoTHubIntegrationResponse struct {
+}
+
+func (m *CreateAzureIoTHubIntegrationResponse) Reset()         { *m = CreateAzureIoTHubIntegrationResponse{} }
+func (m *CreateAzureIoTHubIntegrationResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateAzureIoTHubIntegrationResponse) ProtoMessage()    {}
+func (*CreateAzureIoTHubIntegrationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor24, []int{1}
+}
+
+type GetAzureIoTHubIntegrationRequest struct {
+	// hex encoded AppEUI
+	AppEUI string `protobuf:"bytes,1,opt,name=appEUI" json:"appEUI,omitempty"`
+}
+
+func (m *GetAzureIoTHubIntegrationRequest) Reset()         { *m = GetAzureIoTHubIntegrationRequest{} }
+func (m *GetAzureIoTHubIntegrationRequest) String() string { return proto.CompactTextString(m) }
+func (*GetAzureIoTHubIntegrationRequest) ProtoMessage()    {}
+func (*GetAzureIoTHubIntegrationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor24, []int{2}
+}
+
+func (m *GetAzureIoTHubIntegrationRequest) GetAppEUI() string {
+	if m != nil {
+		return m.AppEUI
+	}
+	return ""
+}
+
+type GetAzureIoTHubIntegrationResponse struct {
+	// hex encoded AppEUI
+	AppEUI string `protobuf:"bytes,1,opt,name=appEUI" json:"appEUI,omitempty"`
+	// hostname of the IoT Hub
+	HostName string `protobuf:"bytes,2,opt,name=hostName" json:"hostName,omitempty"`
+	// name of the shared access policy (the connection string is not returned)
+	SharedAccessKeyName string `protobuf:"bytes,3,opt,name=sharedAccessKeyName" json:"sharedAccessKeyName,omitempty"`
+	// receive the cloud-to-device messages of the devices as downlink payloads
+	C2DEnabled bool `protobuf:"varint,4,opt,name=c2dEnabled" json:"c2dEnabled,omitempty"`
+}
+
+func (m *GetAzureIoTHubIntegrationResponse) Reset()         { *m = GetAzureIoTHubIntegrationResponse{} }
+func (m *GetAzureIoTHubIntegrationResponse) String() string { return proto.CompactTextString(m) }
+func (*GetAzureIoTHubIntegrationResponse) ProtoMessage()    {}
+func (*GetAzureIoTHubIntegrationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor24, []int{3}
+}
+
+func (m *GetAzureIoTHubIntegrationResponse) GetAppEUI() string {
+	if m != nil {
+		return m.AppEUI
+	}
+	return ""
+}
+
+func (m *GetAzureIoTHubIntegrationResponse) GetHostName() string {
+	if m != nil {
+		return m.HostName
+	}
+	return ""
+}
+
+func (m *GetAzureIoTHubIntegrationResponse) GetSharedAccessKeyName() string {
+	if m != nil {
+		return m.SharedAccessKeyName
+	}
+	return ""
+}
+
+func (m *GetAzureIoTHubIntegrationResponse) GetC2DEnabled() bool {
+	if m != nil {
+		return m.C2DEnabled
+	}
+	return false
+}
+
+type UpdateAzureIoTHubIntegrationRequest struct {
+	// hex encoded AppEUI
+	AppEUI string `protobuf:"bytes,1,opt,name=appEUI" json:"appEUI,omitempty"`
+	// connection string of an IoT Hub shared access policy with the DeviceConnect permission (when empty, the current connection string is kept)
+	ConnectionString string `protobuf:"bytes,2,opt,name=connectionString" json:"connectionString,omitempty"`
+	// receive the cloud-to-device messages of the devices as downlink payloads
+	C2DEnabled bool `protobuf:"varint,3,opt,name=c2dEnabled" json:"c2dEnabled,omitempty"`
+}
+
+func (m *UpdateAzureIoTHubIntegrationRequest) Reset()         { *m = UpdateAzureIoTHubIntegrationRequest{} }
+func (m *UpdateAzureIoTHubIntegrationRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateAzureIoTHubIntegrationRequest) ProtoMessage()    {}
+func (*UpdateAzureIoTHubIntegrationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor24, []int{4}
+}
+
+func (m *UpdateAzureIoTHubIntegrationRequest) GetAppEUI() string {
+	if m != nil {
+		return m.AppEUI
+	}
+	return ""
+}
+
+func (m *UpdateAzureIoTHubIntegrationRequest) GetConnectionString() string {
+	if m != nil {
+		return m.ConnectionString
+	}
+	return ""
+}
+
+func (m *UpdateAzureIoTHubIntegrationRequest) GetC2DEnabled() bool {
+	if m != nil {
+		return m.C2DEnabled
+	}
+	return false
+}
+
+type UpdateAzureIoTHubIntegrationResponse struct {
+}
+
+func (m *UpdateAzureIoTHubIntegrationResponse) Reset()         { *m = UpdateAzureIoTHubIntegrationResponse{} }
+func (m *UpdateAzureIoTHubIntegrationResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateAzureIoTHubIntegrationResponse) ProtoMessage()    {}
+func (*UpdateAzureIoTHubIntegrationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor24, []int{5}
+}
+
+type DeleteAzureIoTHubIntegrationRequest struct {
+	// hex encoded AppEUI
+	AppEUI string `protobuf:"bytes,1,opt,name=appEUI" json:"appEUI,omitempty"`
+}
+
+func (m *DeleteAzureIoTHubIntegrationRequest) Reset()         { *m = DeleteAzureIoTHubIntegrationRequest{} }
+func (m *DeleteAzureIoTHubIntegrationRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteAzureIoTHubIntegrationRequest) ProtoMessage()    {}
+func (*DeleteAzureIoTHubIntegrationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor24, []int{6}
+}
+
+func (m *DeleteAzureIoTHubIntegrationRequest) GetAppEUI() string {
+	if m != nil {
+		return m.AppEUI
+	}
+	return ""
+}
+
+type DeleteAzureIoTHubIntegrationResponse struct {
+}
+
+func (m *DeleteAzureIoTHubIntegrationResponse) Reset()         { *m = DeleteAzureIoTHubIntegrationResponse{} }
+func (m *DeleteAzureIoTHubIntegrationResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteAzureIoTHubIntegrationResponse) ProtoMessage()    {}
+func (*DeleteAzureIoTHubIntegrationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor24, []int{7}
+}
+
+func init() {
+	proto.RegisterType((*CreateAzureIoTHubIntegrationRequest)(nil), "api.CreateAzureIoTHubIntegrationRequest")
+	proto.RegisterType((*CreateAzureIoTHubIntegrationResponse)(nil), "api.CreateAzureIoTHubIntegrationResponse")
+	proto.RegisterType((*GetAzureIoTHubIntegrationRequest)(nil), "api.GetAzureIoTHubIntegrationRequest")
+	proto.RegisterType((*GetAzureIoTHubIntegrationResponse)(nil), "api.GetAzureIoTHubIntegrationResponse")
+	proto.RegisterType((*UpdateAzureIoTHubIntegrationRequest)(nil), "api.UpdateAzureIoTHubIntegrationRequest")
+	proto.RegisterType((*UpdateAzureIoTHubIntegrationResponse)(nil), "api.UpdateAzureIoTHubIntegrationResponse")
+	proto.RegisterType((*DeleteAzureIoTHubIntegrationRequest)(nil), "api.DeleteAzureIoTHubIntegrationRequest")
+	proto.RegisterType((*DeleteAzureIoTHubIntegrationResponse)(nil), "api.DeleteAzureIoTHubIntegrationResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for AzureIoTHubIntegration service
+
+type AzureIoTHubIntegrationClient interface {
+	// Create creates the Azure IoT Hub integration of the given application.
+	Create(ctx context.Context, in *CreateAzureIoTHubIntegrationRequest, opts ...grpc.CallOption) (*CreateAzureIoTHubIntegrationResponse, error)
+	// Get returns the Azure IoT Hub integration of the given application.
+	Get(ctx context.Context, in *GetAzureIoTHubIntegrationRequest, opts ...grpc.CallOption) (*GetAzureIoTHubIntegrationResponse, error)
+	// Update updates the Azure IoT Hub integration of the given application.
+	Update(ctx context.Context, in *UpdateAzureIoTHubIntegrationRequest, opts ...grpc.CallOption) (*UpdateAzureIoTHubIntegrationResponse, error)
+	// Delete deletes the Azure IoT Hub integration of the given application.
+	Delete(ctx context.Context, in *DeleteAzureIoTHubIntegrationRequest, opts ...grpc.CallOption) (*DeleteAzureIoTHubIntegrationResponse, error)
+}
+
+type azureIoTHubIntegrationClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewAzureIoTHubIntegrationClient(cc *grpc.ClientConn) AzureIoTHubIntegrationClient {
+	return &azureIoTHubIntegrationClient{cc}
+}
+
+func (c *azureIoTHubIntegrationClient) Create(ctx context.Context, in *CreateAzureIoTHubIntegrationRequest, opts ...grpc.CallOption) (*CreateAzureIoTHubIntegrationResponse, error) {
+	out := new(CreateAzureIoTHubIntegrationResponse)
+	err := grpc.Invoke(ctx, "/api.AzureIoTHubIntegration/Create", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *azureIoTHubIntegrationClient) Get(ctx context.Context, in *GetAzureIoTHubIntegrationRequest, opts ...grpc.CallOption) (*GetAzureIoTHubIntegrationResponse, error) {
+	out := new(GetAzureIoTHubIntegrationResponse)
+	err := grpc.Invoke(ctx, "/api.AzureIoTHubIntegration/Get", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *azureIoTHubIntegrationClient) Update(ctx context.Context, in *UpdateAzureIoTHubIntegrationRequest, opts ...grpc.CallOption) (*UpdateAzureIoTHubIntegrationResponse, error) {
+	out := new(UpdateAzureIoTHubIntegrationResponse)
+	err := grpc.Invoke(ctx, "/api.AzureIoTHubIntegration/Update", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *azureIoTHubIntegrationClient) Delete(ctx context.Context, in *DeleteAzureIoTHubIntegrationRequest, opts ...grpc.CallOption) (*DeleteAzureIoTHubIntegrationResponse, error) {
+	out := new(DeleteAzureIoTHubIntegrationResponse)
+	err := grpc.Invoke(ctx, "/api.AzureIoTHubIntegration/Delete", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for AzureIoTHubIntegration service
+
+type AzureIoTHubIntegrationServer interface {
+	// Create creates the Azure IoT Hub integration of the given application.
+	Create(context.Context, *CreateAzureIoTHubIntegrationRequest) (*CreateAzureIoTHubIntegrationResponse, error)
+	// Get returns the Azure IoT Hub integration of the given application.
+	Get(context.Context, *GetAzureIoTHubIntegrationRequest) (*GetAzureIoTHubIntegrationResponse, error)
+	// Update updates the Azure IoT Hub integration of the given application.
+	Update(context.Context, *UpdateAzureIoTHubIntegrationRequest) (*UpdateAzureIoTHubIntegrationResponse, error)
+	// Delete deletes the Azure IoT Hub integration of the given application.
+	Delete(context.Context, *DeleteAzureIoTHubIntegrationRequest) (*DeleteAzureIoTHubIntegrationResponse, error)
+}
+
+func RegisterAzureIoTHubIntegrationServer(s *grpc.Server, srv AzureIoTHubIntegrationServer) {
+	s.RegisterService(&_AzureIoTHubIntegration_serviceDesc, srv)
+}
+
+func _AzureIoTHubIntegration_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAzureIoTHubIntegrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AzureIoTHubIntegrationServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.AzureIoTHubIntegration/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AzureIoTHubIntegrationServer).Create(ctx, req.(*CreateAzureIoTHubIntegrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AzureIoTHubIntegration_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAzureIoTHubIntegrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AzureIoTHubIntegrationServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.AzureIoTHubIntegration/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AzureIoTHubIntegrationServer).Get(ctx, req.(*GetAzureIoTHubIntegrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AzureIoTHubIntegration_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAzureIoTHubIntegrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AzureIoTHubIntegrationServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.AzureIoTHubIntegration/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AzureIoTHubIntegrationServer).Update(ctx, req.(*UpdateAzureIoTHubIntegrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AzureIoTHubIntegration_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAzureIoTHubIntegrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AzureIoTHubIntegrationServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.AzureIoTHubIntegration/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AzureIoTHubIntegrationServer).Delete(ctx, req.(*DeleteAzureIoTHubIntegrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _AzureIoTHubIntegration_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.AzureIoTHubIntegration",
+	HandlerType: (*AzureIoTHubIntegrationServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _AzureIoTHubIntegration_Create_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _AzureIoTHubIntegration_Get_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _AzureIoTHubIntegration_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _AzureIoTHubIntegration_Delete_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "azureIoTHubIntegration.proto",
+}
+
+func init() { proto.RegisterFile("azureIoTHubIntegration.proto", fileDescriptor24) }
+
+var fileDescriptor24 = []byte{
+	// 414 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x94, 0xc1, 0x8a, 0x13, 0x31,
+	0x18, 0xc7, 0xc9, 0xce, 0x32, 0xac, 0xdf, 0x49, 0x22, 0x2c, 0x65, 0x28, 0xd2, 0x9d, 0x75, 0xb7,
+	0xed, 0x80, 0x6d, 0xa9, 0xb7, 0x82, 0x87, 0xa2, 0xa5, 0x16, 0xc1, 0x43, 0xb5, 0x0f, 0x90, 0xce,
+	0x7c, 0x4c, 0x07, 0x6a, 0x12, 0x27, 0xe9, 0x41, 0xc5, 0x8b, 0x82, 0x20, 0x08, 0x1e, 0x7c, 0x0a,
+	0xdf, 0xc4, 0xbb, 0xaf, 0xe0, 0x83, 0xc8, 0x24, 0x41, 0xd4, 0x8e, 0xd3, 0xd0, 0xd3, 0x1e, 0x93,
+	0xf9, 0x25, 0xff, 0xff, 0xf7, 0xff, 0xbe, 0x09, 0xb4, 0xd9, 0x9b, 0x5d, 0x89, 0x0b, 0xf1, 0xe2,
+	0xc9, 0x6e, 0xbd, 0xe0, 0x1a, 0xf3, 0x92, 0xe9, 0x42, 0xf0, 0x81, 0x2c, 0x85, 0x16, 0x34, 0x60,
+	0xb2, 0x88, 0xda, 0xb9, 0x10, 0xf9, 0x16, 0x87, 0x4c, 0x16, 0x43, 0xc6, 0xb9, 0xd0, 0x86, 0x50,
+	0x16, 0x89, 0x3f, 0x11, 0xb8, 0x7c, 0x54, 0x22, 0xd3, 0x38, 0xad, 0xbd, 0x69, 0x89, 0xaf, 0x76,
+	0xa8, 0x34, 0x3d, 0x87, 0x90, 0x49, 0x39, 0x5b, 0x2d, 0x5a, 0xa4, 0x43, 0x7a, 0xb7, 0x96, 0x6e,
+	0x45, 0x13, 0xb8, 0x9d, 0x0a, 0xce, 0x31, 0xad, 0xe0, 0xe7, 0xba, 0x2c, 0x78, 0xde, 0x3a, 0x31,
+	0xc4, 0xde, 0x3e, 0xbd, 0x0b, 0x90, 0x8e, 0xb3, 0x19, 0x67, 0xeb, 0x2d, 0x66, 0xad, 0xa0, 0x43,
+	0x7a, 0x67, 0xcb, 0x3f, 0x76, 0xe2, 0x6b, 0xb8, 0xd7, 0x6c, 0x45, 0x49, 0xc1, 0x15, 0xc6, 0x13,
+	0xe8, 0xcc, 0x51, 0x1f, 0xe5, 0x37, 0xfe, 0x46, 0xe0, 0xa2, 0xe1, 0xb0, 0x55, 0xf8, 0x6f, 0xb5,
+	0x11, 0x9c, 0x6d, 0x84, 0xd2, 0xcf, 0xd8, 0x4b, 0x74, 0x55, 0xfe, 0x5e, 0xd3, 0x11, 0xdc, 0x51,
+	0x1b, 0x56, 0x62, 0x36, 0x4d, 0x53, 0x54, 0xea, 0x29, 0xbe, 0x36, 0x58, 0x60, 0xb0, 0xba, 0x4f,
+	0xff, 0xe4, 0x71, 0xba, 0x97, 0x47, 0xd5, 0x9b, 0x95, 0xcc, 0x6e, 0x4a, 0x6f, 0x9a, 0xad, 0xb8,
+	0xde, 0x3c, 0x84, 0xcb, 0xc7, 0xb8, 0xc5, 0x23, 0x2d, 0x57, 0x32, 0xcd, 0xc7, 0xad, 0xcc, 0xf8,
+	0xfb, 0x29, 0x9c, 0xd7, 0x23, 0xf4, 0x23, 0x81, 0xd0, 0x8e, 0x11, 0xed, 0x0d, 0x98, 0x2c, 0x06,
+	0x1e, 0xe3, 0x1d, 0xf5, 0x3d, 0x48, 0x57, 0x61, 0xf7, 0xfd, 0x8f, 0x9f, 0x5f, 0x4f, 0x2e, 0xe2,
+	0xb6, 0xfd, 0xa3, 0x6a, 0x61, 0x35, 0x21, 0x09, 0xfd, 0x40, 0x20, 0x98, 0xa3, 0xa6, 0x57, 0xe6,
+	0xee, 0x43, 0x13, 0x1b, 0x5d, 0x1f, 0xc2, 0x9c, 0xfe, 0x7d, 0xa3, 0xdf, 0xa5, 0x57, 0x4d, 0xfa,
+	0xc3, 0xb7, 0x36, 0xd0, 0x77, 0xf4, 0x0b, 0x81, 0xd0, 0x76, 0xce, 0xc5, 0xe1, 0x31, 0x51, 0x51,
+	0xdf, 0x83, 0x74, 0x76, 0x46, 0xc6, 0x4e, 0x12, 0xf9, 0xd9, 0xa9, 0x72, 0xf9, 0x4c, 0x20, 0xb4,
+	0x4d, 0x76, 0x8e, 0x3c, 0x06, 0x26, 0xea, 0x7b, 0x90, 0x7f, 0x07, 0x94, 0xf8, 0x39, 0x5a, 0x87,
+	0xe6, 0x21, 0x7c, 0xf0, 0x6b, 0x00, 0xe5, 0xe8, 0xc9, 0x1f, 0x4b, 0x05, 0x00, 0x00,
+}
@@ -11,6 +11,34 @@ It is generated from these files:
 	downlinkQueue.proto
 	nodeSession.proto
 	common.proto
+	signingKey.proto
+	downlinkFPortPolicy.proto
+	sla.proto
+	analytics.proto
+	dutyCycle.proto
+	notificationPreference.proto
+	scheduledReport.proto
+	httpIntegration.proto
+	payloadCodec.proto
+	eventStream.proto
+	influxDBIntegration.proto
+	multicastGroup.proto
+	fuotaDeployment.proto
+	gateway.proto
+	organization.proto
+	apiKey.proto
+	eventLog.proto
+	gcpPubSubIntegration.proto
+	awsSNSIntegration.proto
+	azureIoTHubIntegration.proto
+	deviceStatusAlert.proto
+	downlinkRule.proto
+	deadLetter.proto
+	thingsBoardIntegration.proto
+	handlerBackend.proto
+	eventFilter.proto
+	auditLog.proto
+	usageStats.proto
 
 It has these top-level messages:
 	CreateChannelListRequest
@@ -23,6 +51,7 @@ It has these top-level messages:
 	ListChannelListResponse
 	DeleteChannelListRequest
 	DeleteChannelListResponse
+	NodeVariable
 	CreateNodeRequest
 	CreateNodeResponse
 	GetNodeRequest
@@ -34,6 +63,11 @@ It has these top-level messages:
 	ListNodeByAppEUIRequest
 	UpdateNodeRequest
 	UpdateNodeResponse
+	ExportNodesRequest
+	ExportNodesResponse
+	ImportNodesRequest
+	ImportNodesError
+	ImportNodesResponse
 	EnqueueDownlinkQueueItemRequest
 	EnqueueDownlinkQueueItemResponse
 	DeleteDownlinkQeueueItemRequest
@@ -41,6 +75,12 @@ It has these top-level messages:
 	DownlinkQueueItem
 	ListDownlinkQueueItemsRequest
 	ListDownlinkQueueItemsResponse
+	FlushDownlinkQueueRequest
+	FlushDownlinkQueueResponse
+	DownlinkDelivery
+	ListDownlinkDeliveriesRequest
+	ListDownlinkDeliveriesResponse
+	KeyEnvelope
 	CreateNodeSessionRequest
 	CreateNodeSessionResponse
 	GetNodeSessionRequest
@@ -51,6 +91,246 @@ It has these top-level messages:
 	DeleteNodeSessionResponse
 	GetRandomDevAddrRequest
 	GetRandomDevAddrResponse
+	CreateSigningKeyRequest
+	CreateSigningKeyResponse
+	ListSigningKeyRequest
+	SigningKeyItem
+	ListSigningKeyResponse
+	RotateSigningKeyRequest
+	RotateSigningKeyResponse
+	DeleteSigningKeyRequest
+	DeleteSigningKeyResponse
+	CreateDownlinkFPortPolicyRequest
+	CreateDownlinkFPortPolicyResponse
+	ListDownlinkFPortPolicyRequest
+	DownlinkFPortPolicyItem
+	ListDownlinkFPortPolicyResponse
+	DeleteDownlinkFPortPolicyRequest
+	DeleteDownlinkFPortPolicyResponse
+	GetNodeSLAReportRequest
+	NodeSLAReport
+	GetApplicationSLAReportRequest
+	ApplicationSLAReport
+	GetApplicationDistributionRequest
+	GetGatewayDistributionRequest
+	DataRateCount
+	ChannelCount
+	UplinkDistributionResponse
+	GetGatewayDutyCycleRequest
+	SubBandUtilization
+	GetGatewayDutyCycleResponse
+	CreateNotificationPreferenceRequest
+	CreateNotificationPreferenceResponse
+	GetNotificationPreferenceRequest
+	GetNotificationPreferenceResponse
+	UpdateNotificationPreferenceRequest
+	UpdateNotificationPreferenceResponse
+	DeleteNotificationPreferenceRequest
+	DeleteNotificationPreferenceResponse
+	CreateScheduledReportRequest
+	CreateScheduledReportResponse
+	GetScheduledReportRequest
+	GetScheduledReportResponse
+	ListScheduledReportByAppEUIRequest
+	ListScheduledReportResponse
+	UpdateScheduledReportRequest
+	UpdateScheduledReportResponse
+	DeleteScheduledReportRequest
+	DeleteScheduledReportResponse
+	HTTPIntegrationHeader
+	CreateHTTPIntegrationRequest
+	CreateHTTPIntegrationResponse
+	GetHTTPIntegrationRequest
+	GetHTTPIntegrationResponse
+	UpdateHTTPIntegrationRequest
+	UpdateHTTPIntegrationResponse
+	DeleteHTTPIntegrationRequest
+	DeleteHTTPIntegrationResponse
+	CreatePayloadCodecRequest
+	CreatePayloadCodecResponse
+	GetPayloadCodecRequest
+	GetPayloadCodecResponse
+	UpdatePayloadCodecRequest
+	UpdatePayloadCodecResponse
+	DeletePayloadCodecRequest
+	DeletePayloadCodecResponse
+	SubscribeEventStreamRequest
+	EventStreamEvent
+	CreateInfluxDBIntegrationRequest
+	CreateInfluxDBIntegrationResponse
+	GetInfluxDBIntegrationRequest
+	GetInfluxDBIntegrationResponse
+	UpdateInfluxDBIntegrationRequest
+	UpdateInfluxDBIntegrationResponse
+	DeleteInfluxDBIntegrationRequest
+	DeleteInfluxDBIntegrationResponse
+	CreateMulticastGroupRequest
+	CreateMulticastGroupResponse
+	GetMulticastGroupRequest
+	GetMulticastGroupResponse
+	ListMulticastGroupByAppEUIRequest
+	ListMulticastGroupResponse
+	UpdateMulticastGroupRequest
+	UpdateMulticastGroupResponse
+	DeleteMulticastGroupRequest
+	DeleteMulticastGroupResponse
+	AddNodeToMulticastGroupRequest
+	AddNodeToMulticastGroupResponse
+	RemoveNodeFromMulticastGroupRequest
+	RemoveNodeFromMulticastGroupResponse
+	ListMulticastGroupNodesRequest
+	ListMulticastGroupNodesResponse
+	EnqueueMulticastQueueItemRequest
+	EnqueueMulticastQueueItemResponse
+	CreateFUOTADeploymentRequest
+	CreateFUOTADeploymentResponse
+	GetFUOTADeploymentRequest
+	GetFUOTADeploymentResponse
+	ListFUOTADeploymentByAppEUIRequest
+	ListFUOTADeploymentResponse
+	DeleteFUOTADeploymentRequest
+	DeleteFUOTADeploymentResponse
+	FUOTADeploymentNode
+	ListFUOTADeploymentNodesRequest
+	ListFUOTADeploymentNodesResponse
+	CreateGatewayRequest
+	CreateGatewayResponse
+	GetGatewayRequest
+	GetGatewayResponse
+	ListGatewayRequest
+	ListGatewayResponse
+	UpdateGatewayRequest
+	UpdateGatewayResponse
+	DeleteGatewayRequest
+	DeleteGatewayResponse
+	GetGatewayStatsRequest
+	GatewayStats
+	GetGatewayStatsResponse
+	CreateOrganizationRequest
+	CreateOrganizationResponse
+	GetOrganizationRequest
+	GetOrganizationResponse
+	ListOrganizationRequest
+	ListOrganizationResponse
+	UpdateOrganizationRequest
+	UpdateOrganizationResponse
+	DeleteOrganizationRequest
+	DeleteOrganizationResponse
+	AddOrganizationApplicationRequest
+	AddOrganizationApplicationResponse
+	RemoveOrganizationApplicationRequest
+	RemoveOrganizationApplicationResponse
+	ListOrganizationApplicationsRequest
+	ListOrganizationApplicationsResponse
+	AddOrganizationUserRequest
+	AddOrganizationUserResponse
+	UpdateOrganizationUserRequest
+	UpdateOrganizationUserResponse
+	RemoveOrganizationUserRequest
+	RemoveOrganizationUserResponse
+	ListOrganizationUsersRequest
+	OrganizationUser
+	ListOrganizationUsersResponse
+	CreateAPIKeyRequest
+	CreateAPIKeyResponse
+	ListAPIKeyRequest
+	GetAPIKeyResponse
+	ListAPIKeyResponse
+	RevokeAPIKeyRequest
+	RevokeAPIKeyResponse
+	ListEventLogRequest
+	EventLogEntry
+	ListEventLogResponse
+	CreateGCPPubSubIntegrationRequest
+	CreateGCPPubSubIntegrationResponse
+	GetGCPPubSubIntegrationRequest
+	GetGCPPubSubIntegrationResponse
+	UpdateGCPPubSubIntegrationRequest
+	UpdateGCPPubSubIntegrationResponse
+	DeleteGCPPubSubIntegrationRequest
+	DeleteGCPPubSubIntegrationResponse
+	CreateAWSSNSIntegrationRequest
+	CreateAWSSNSIntegrationResponse
+	GetAWSSNSIntegrationRequest
+	GetAWSSNSIntegrationResponse
+	UpdateAWSSNSIntegrationRequest
+	UpdateAWSSNSIntegrationResponse
+	DeleteAWSSNSIntegrationRequest
+	DeleteAWSSNSIntegrationResponse
+	CreateAzureIoTHubIntegrationRequest
+	CreateAzureIoTHubIntegrationResponse
+	GetAzureIoTHubIntegrationRequest
+	GetAzureIoTHubIntegrationResponse
+	UpdateAzureIoTHubIntegrationRequest
+	UpdateAzureIoTHubIntegrationResponse
+	DeleteAzureIoTHubIntegrationRequest
+	DeleteAzureIoTHubIntegrationResponse
+	CreateDeviceStatusAlertRequest
+	CreateDeviceStatusAlertResponse
+	GetDeviceStatusAlertRequest
+	GetDeviceStatusAlertResponse
+	UpdateDeviceStatusAlertRequest
+	UpdateDeviceStatusAlertResponse
+	DeleteDeviceStatusAlertRequest
+	DeleteDeviceStatusAlertResponse
+	CreateDownlinkRuleRequest
+	CreateDownlinkRuleResponse
+	GetDownlinkRuleRequest
+	GetDownlinkRuleResponse
+	ListDownlinkRuleByAppEUIRequest
+	ListDownlinkRuleByDevEUIRequest
+	ListDownlinkRuleResponse
+	UpdateDownlinkRuleRequest
+	UpdateDownlinkRuleResponse
+	DeleteDownlinkRuleRequest
+	DeleteDownlinkRuleResponse
+	ListDeadLetterRequest
+	DeadLetterEntry
+	ListDeadLetterResponse
+	GetDeadLetterRequest
+	ReplayDeadLetterRequest
+	ReplayDeadLetterResponse
+	ReplayDeadLettersByAppEUIRequest
+	ReplayDeadLettersByAppEUIResponse
+	DeleteDeadLetterRequest
+	DeleteDeadLetterResponse
+	CreateThingsBoardIntegrationRequest
+	CreateThingsBoardIntegrationResponse
+	GetThingsBoardIntegrationRequest
+	GetThingsBoardIntegrationResponse
+	UpdateThingsBoardIntegrationRequest
+	UpdateThingsBoardIntegrationResponse
+	DeleteThingsBoardIntegrationRequest
+	DeleteThingsBoardIntegrationResponse
+	ListHandlerBackendRequest
+	ListHandlerBackendResponse
+	CreateHandlerBackendRequest
+	CreateHandlerBackendResponse
+	GetHandlerBackendRequest
+	GetHandlerBackendResponse
+	UpdateHandlerBackendRequest
+	UpdateHandlerBackendResponse
+	DeleteHandlerBackendRequest
+	DeleteHandlerBackendResponse
+	CreateEventFilterRequest
+	CreateEventFilterResponse
+	ListEventFilterRequest
+	EventFilterItem
+	ListEventFilterResponse
+	UpdateEventFilterRequest
+	UpdateEventFilterResponse
+	DeleteEventFilterRequest
+	DeleteEventFilterResponse
+	ListAuditLogRequest
+	AuditLogEntry
+	ListAuditLogResponse
+	GetNodeUsageStatsRequest
+	GetApplicationUsageStatsRequest
+	UsageStatsEntry
+	GetUsageStatsResponse
+	ListNodeUsageStatsRequest
+	NodeUsageStats
+	ListNodeUsageStatsResponse
 */
 package api
 
@@ -41,10 +41,10 @@ func init() {
 func init() { proto.RegisterFile("common.proto", fileDescriptor4) }
 
 var fileDescriptor4 = []byte{
-	// 74 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xe2, 0xe2, 0x49, 0xce, 0xcf, 0xcd,
+	// 71 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x49, 0xce, 0xcf, 0xcd,
 	0xcd, 0xcf, 0xd3, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0x4e, 0x2c, 0xc8, 0xd4, 0x92, 0xe1,
 	0xe2, 0x08, 0x8a, 0x08, 0xcf, 0xcc, 0x4b, 0xc9, 0x2f, 0x17, 0x62, 0xe7, 0x62, 0x0e, 0x8a, 0x30,
-	0x14, 0x60, 0x80, 0x30, 0x8c, 0x04, 0x18, 0x93, 0xd8, 0xc0, 0x2a, 0x8d, 0x01, 0x01, 0x00, 0x00,
-	0xff, 0xff, 0x27, 0xd4, 0x4d, 0x6a, 0x39, 0x00, 0x00, 0x00,
+	0x14, 0x60, 0x80, 0x30, 0x8c, 0x04, 0x18, 0x93, 0xd8, 0xc0, 0x2a, 0x8d, 0x01, 0x03, 0x00, 0x27,
+	0xd4, 0x4d, 0x6a, 0x39, 0x00, 0x00, 0x00,
 }
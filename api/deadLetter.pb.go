@@ -0,0 +1,563 @@
+// Code generated by protoc-gen-go.
+// source: deadLetter.proto
+// DO NOT EDIT!
+
+package api
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import _ "github.com/grpc-ecosystem/grpc-gateway/third_party/googleapis/google/api"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+type ListDeadLetterRequest struct {
+	// hex encoded AppEUI
+	AppEUI string `protobuf:"bytes,1,opt,name=appEUI" json:"appEUI,omitempty"`
+	// only return the dead letters of this integration (e.g. mqtt or http, optional)
+	Handler string `protobuf:"bytes,2,opt,name=handler" json:"handler,omitempty"`
+	// max number of dead letters to return
+	Limit int64 `protobuf:"varint,3,opt,name=limit" json:"limit,omitempty"`
+	// offset in the result-set (for pagination)
+	Offset int64 `protobuf:"varint,4,opt,name=offset" json:"offset,omitempty"`
+}
+
+func (m *ListDeadLetterRequest) Reset()                    { *m = ListDeadLetterRequest{} }
+func (m *ListDeadLetterRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListDeadLetterRequest) ProtoMessage()               {}
+func (*ListDeadLetterRequest) Descriptor() ([]byte, []int) { return fileDescriptor27, []int{0} }
+
+func (m *ListDeadLetterRequest) GetAppEUI() string {
+	if m != nil {
+		return m.AppEUI
+	}
+	return ""
+}
+
+func (m *ListDeadLetterRequest) GetHandler() string {
+	if m != nil {
+		return m.Handler
+	}
+	return ""
+}
+
+func (m *ListDeadLetterRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListDeadLetterRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+type DeadLetterEntry struct {
+	// id of the dead letter
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	// timestamp of the last attempt (RFC3339)
+	CreatedAt string `protobuf:"bytes,2,opt,name=createdAt" json:"createdAt,omitempty"`
+	// integration which failed to deliver the event (e.g. mqtt or http)
+	Handler string `protobuf:"bytes,3,opt,name=handler" json:"handler,omitempty"`
+	// hex encoded AppEUI
+	AppEUI string `protobuf:"bytes,4,opt,name=appEUI" json:"appEUI,omitempty"`
+	// hex encoded DevEUI
+	DevEUI string `protobuf:"bytes,5,opt,name=devEUI" json:"devEUI,omitempty"`
+	// event type (rx, join, ack, error, linkquality, lifecycle, firmware, status, location, scheduled)
+	Type string `protobuf:"bytes,6,opt,name=type" json:"type,omitempty"`
+	// JSON encoded payload (same format as published on the MQTT topics)
+	PayloadJSON string `protobuf:"bytes,7,opt,name=payloadJSON" json:"payloadJSON,omitempty"`
+	// error of the last attempt
+	Error string `protobuf:"bytes,8,opt,name=error" json:"error,omitempty"`
+	// number of attempts
+	Attempts int64 `protobuf:"varint,9,opt,name=attempts" json:"attempts,omitempty"`
+}
+
+func (m *DeadLetterEntry) Reset()                    { *m = DeadLetterEntry{} }
+func (m *DeadLetterEntry) String() string            { return proto.CompactTextString(m) }
+func (*DeadLetterEntry) ProtoMessage()               {}
+func (*DeadLetterEntry) Descriptor() ([]byte, []int) { return fileDescriptor27, []int{1} }
+
+func (m *DeadLetterEntry) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *DeadLetterEntry) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return ""
+}
+
+func (m *DeadLetterEntry) GetHandler() string {
+	if m != nil {
+		return m.Handler
+	}
+	return ""
+}
+
+func (m *DeadLetterEntry) GetAppEUI() string {
+	if m != nil {
+		return m.AppEUI
+	}
+	return ""
+}
+
+func (m *DeadLetterEntry) GetDevEUI() string {
+	if m != nil {
+		return m.DevEUI
+	}
+	return ""
+}
+
+func (m *DeadLetterEntry) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *DeadLetterEntry) GetPayloadJSON() string {
+	if m != nil {
+		return m.PayloadJSON
+	}
+	return ""
+}
+
+func (m *DeadLetterEntry) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *DeadLetterEntry) GetAttempts() int64 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+type ListDeadLetterResponse struct {
+	// total number of dead letters
+	TotalCount int64              `protobuf:"varint,1,opt,name=totalCount" json:"totalCount,omitempty"`
+	Result     []*DeadLetterEntry `protobuf:"bytes,2,rep,name=result" json:"result,omitempty"`
+}
+
+func (m *ListDeadLetterResponse) Reset()                    { *m = ListDeadLetterResponse{} }
+func (m *ListDeadLetterResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListDeadLetterResponse) ProtoMessage()               {}
+func (*ListDeadLetterResponse) Descriptor() ([]byte, []int) { return fileDescriptor27, []int{2} }
+
+func (m *ListDeadLetterResponse) GetTotalCount() int64 {
+	if m != nil {
+		return m.TotalCount
+	}
+	return 0
+}
+
+func (m *ListDeadLetterResponse) GetResult() []*DeadLetterEntry {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type GetDeadLetterRequest struct {
+	// id of the dead letter
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *GetDeadLetterRequest) Reset()                    { *m = GetDeadLetterRequest{} }
+func (m *GetDeadLetterRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetDeadLetterRequest) ProtoMessage()               {}
+func (*GetDeadLetterRequest) Descriptor() ([]byte, []int) { return fileDescriptor27, []int{3} }
+
+func (m *GetDeadLetterRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type ReplayDeadLetterRequest struct {
+	// id of the dead letter
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *ReplayDeadLetterRequest) Reset()                    { *m = ReplayDeadLetterRequest{} }
+func (m *ReplayDeadLetterRequest) String() string            { return proto.CompactTextString(m) }
+func (*ReplayDeadLetterRequest) ProtoMessage()               {}
+func (*ReplayDeadLetterRequest) Descriptor() ([]byte, []int) { return fileDescriptor27, []int{4} }
+
+func (m *ReplayDeadLetterRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type ReplayDeadLetterResponse struct {
+}
+
+func (m *ReplayDeadLetterResponse) Reset()                    { *m = ReplayDeadLetterResponse{} }
+func (m *ReplayDeadLetterResponse) String() string            { return proto.CompactTextString(m) }
+func (*ReplayDeadLetterResponse) ProtoMessage()               {}
+func (*ReplayDeadLetterResponse) Descriptor() ([]byte, []int) { return fileDescriptor27, []int{5} }
+
+type ReplayDeadLettersByAppEUIRequest struct {
+	// hex encoded AppEUI
+	AppEUI string `protobuf:"bytes,1,opt,name=appEUI" json:"appEUI,omitempty"`
+	// only replay the dead letters of this integration (e.g. mqtt or http, optional)
+	Handler string `protobuf:"bytes,2,opt,name=handler" json:"handler,omitempty"`
+}
+
+func (m *ReplayDeadLettersByAppEUIRequest) Reset()         { *m = ReplayDeadLettersByAppEUIRequest{} }
+func (m *ReplayDeadLettersByAppEUIRequest) String() string { return proto.CompactTextString(m) }
+func (*ReplayDeadLettersByAppEUIRequest) ProtoMessage()    {}
+func (*ReplayDeadLettersByAppEUIRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor27, []int{6}
+}
+
+func (m *ReplayDeadLettersByAppEUIRequest) GetAppEUI() string {
+	if m != nil {
+		return m.AppEUI
+	}
+	return ""
+}
+
+func (m *ReplayDeadLettersByAppEUIRequest) GetHandler() string {
+	if m != nil {
+		return m.Handler
+	}
+	return ""
+}
+
+type ReplayDeadLettersByAppEUIResponse struct {
+	// number of replayed dead letters
+	Count int64 `protobuf:"varint,1,opt,name=count" json:"count,omitempty"`
+}
+
+func (m *ReplayDeadLettersByAppEUIResponse) Reset()         { *m = ReplayDeadLettersByAppEUIResponse{} }
+func (m *ReplayDeadLettersByAppEUIResponse) String() string { return proto.CompactTextString(m) }
+func (*ReplayDeadLettersByAppEUIResponse) ProtoMessage()    {}
+func (*ReplayDeadLettersByAppEUIResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor27, []int{7}
+}
+
+func (m *ReplayDeadLettersByAppEUIResponse) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type DeleteDeadLetterRequest struct {
+	// id of the dead letter
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *DeleteDeadLetterRequest) Reset()                    { *m = DeleteDeadLetterRequest{} }
+func (m *DeleteDeadLetterRequest) String() string            { return proto.CompactTextString(m) }
+func (*DeleteDeadLetterRequest) ProtoMessage()               {}
+func (*DeleteDeadLetterRequest) Descriptor() ([]byte, []int) { return fileDescriptor27, []int{8} }
+
+func (m *DeleteDeadLetterRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type DeleteDeadLetterResponse struct {
+}
+
+func (m *DeleteDeadLetterResponse) Reset()                    { *m = DeleteDeadLetterResponse{} }
+func (m *DeleteDeadLetterResponse) String() string            { return proto.CompactTextString(m) }
+func (*DeleteDeadLetterResponse) ProtoMessage()               {}
+func (*DeleteDeadLetterResponse) Descriptor() ([]byte, []int) { return fileDescriptor27, []int{9} }
+
+func init() {
+	proto.RegisterType((*ListDeadLetterRequest)(nil), "api.ListDeadLetterRequest")
+	proto.RegisterType((*DeadLetterEntry)(nil), "api.DeadLetterEntry")
+	proto.RegisterType((*ListDeadLetterResponse)(nil), "api.ListDeadLetterResponse")
+	proto.RegisterType((*GetDeadLetterRequest)(nil), "api.GetDeadLetterRequest")
+	proto.RegisterType((*ReplayDeadLetterRequest)(nil), "api.ReplayDeadLetterRequest")
+	proto.RegisterType((*ReplayDeadLetterResponse)(nil), "api.ReplayDeadLetterResponse")
+	proto.RegisterType((*ReplayDeadLettersByAppEUIRequest)(nil), "api.ReplayDeadLettersByAppEUIRequest")
+	proto.RegisterType((*ReplayDeadLettersByAppEUIResponse)(nil), "api.ReplayDeadLettersByAppEUIResponse")
+	proto.RegisterType((*DeleteDeadLetterRequest)(nil), "api.DeleteDeadLetterRequest")
+	proto.RegisterType((*DeleteDeadLetterResponse)(nil), "api.DeleteDeadLetterResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for DeadLetter service
+
+type DeadLetterClient interface {
+	// List lists the dead letters of the given application, oldest first.
+	List(ctx context.Context, in *ListDeadLetterRequest, opts ...grpc.CallOption) (*ListDeadLetterResponse, error)
+	// Get returns the dead letter matching the given id.
+	Get(ctx context.Context, in *GetDeadLetterRequest, opts ...grpc.CallOption) (*DeadLetterEntry, error)
+	// Replay sends the event of the given dead letter again to its
+	// integration and deletes the dead letter.
+	Replay(ctx context.Context, in *ReplayDeadLetterRequest, opts ...grpc.CallOption) (*ReplayDeadLetterResponse, error)
+	// ReplayByAppEUI replays all the dead letters of the given application.
+	ReplayByAppEUI(ctx context.Context, in *ReplayDeadLettersByAppEUIRequest, opts ...grpc.CallOption) (*ReplayDeadLettersByAppEUIResponse, error)
+	// Delete deletes the dead letter matching the given id.
+	Delete(ctx context.Context, in *DeleteDeadLetterRequest, opts ...grpc.CallOption) (*DeleteDeadLetterResponse, error)
+}
+
+type deadLetterClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewDeadLetterClient(cc *grpc.ClientConn) DeadLetterClient {
+	return &deadLetterClient{cc}
+}
+
+func (c *deadLetterClient) List(ctx context.Context, in *ListDeadLetterRequest, opts ...grpc.CallOption) (*ListDeadLetterResponse, error) {
+	out := new(ListDeadLetterResponse)
+	err := grpc.Invoke(ctx, "/api.DeadLetter/List", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deadLetterClient) Get(ctx context.Context, in *GetDeadLetterRequest, opts ...grpc.CallOption) (*DeadLetterEntry, error) {
+	out := new(DeadLetterEntry)
+	err := grpc.Invoke(ctx, "/api.DeadLetter/Get", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deadLetterClient) Replay(ctx context.Context, in *ReplayDeadLetterRequest, opts ...grpc.CallOption) (*ReplayDeadLetterResponse, error) {
+	out := new(ReplayDeadLetterResponse)
+	err := grpc.Invoke(ctx, "/api.DeadLetter/Replay", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deadLetterClient) ReplayByAppEUI(ctx context.Context, in *ReplayDeadLettersByAppEUIRequest, opts ...grpc.CallOption) (*ReplayDeadLettersByAppEUIResponse, error) {
+	out := new(ReplayDeadLettersByAppEUIResponse)
+	err := grpc.Invoke(ctx, "/api.DeadLetter/ReplayByAppEUI", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deadLetterClient) Delete(ctx context.Context, in *DeleteDeadLetterRequest, opts ...grpc.CallOption) (*DeleteDeadLetterResponse, error) {
+	out := new(DeleteDeadLetterResponse)
+	err := grpc.Invoke(ctx, "/api.DeadLetter/Delete", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for DeadLetter service
+
+type DeadLetterServer interface {
+	// List lists the dead letters of the given application, oldest first.
+	List(context.Context, *ListDeadLetterRequest) (*ListDeadLetterResponse, error)
+	// Get returns the dead letter matching the given id.
+	Get(context.Context, *GetDeadLetterRequest) (*DeadLetterEntry, error)
+	// Replay sends the event of the given dead letter again to its
+	// integration and deletes the dead letter.
+	Replay(context.Context, *ReplayDeadLetterRequest) (*ReplayDeadLetterResponse, error)
+	// ReplayByAppEUI replays all the dead letters of the given application.
+	ReplayByAppEUI(context.Context, *ReplayDeadLettersByAppEUIRequest) (*ReplayDeadLettersByAppEUIResponse, error)
+	// Delete deletes the dead letter matching the given id.
+	Delete(context.Context, *DeleteDeadLetterRequest) (*DeleteDeadLetterResponse, error)
+}
+
+func RegisterDeadLetterServer(s *grpc.Server, srv DeadLetterServer) {
+	s.RegisterService(&_DeadLetter_serviceDesc, srv)
+}
+
+func _DeadLetter_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeadLetterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeadLetterServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DeadLetter/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeadLetterServer).List(ctx, req.(*ListDeadLetterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeadLetter_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeadLetterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeadLetterServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DeadLetter/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeadLetterServer).Get(ctx, req.(*GetDeadLetterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeadLetter_Replay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayDeadLetterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeadLetterServer).Replay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DeadLetter/Replay",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeadLetterServer).Replay(ctx, req.(*ReplayDeadLetterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeadLetter_ReplayByAppEUI_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayDeadLettersByAppEUIRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeadLetterServer).ReplayByAppEUI(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DeadLetter/ReplayByAppEUI",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeadLetterServer).ReplayByAppEUI(ctx, req.(*ReplayDeadLettersByAppEUIRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeadLetter_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDeadLetterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeadLetterServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DeadLetter/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeadLetterServer).Delete(ctx, req.(*DeleteDeadLetterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _DeadLetter_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.DeadLetter",
+	HandlerType: (*DeadLetterServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "List",
+			Handler:    _DeadLetter_List_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _DeadLetter_Get_Handler,
+		},
+		{
+			MethodName: "Replay",
+			Handler:    _DeadLetter_Replay_Handler,
+		},
+		{
+			MethodName: "ReplayByAppEUI",
+			Handler:    _DeadLetter_ReplayByAppEUI_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _DeadLetter_Delete_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "deadLetter.proto",
+}
+
+func init() { proto.RegisterFile("deadLetter.proto", fileDescriptor27) }
+
+var fileDescriptor27 = []byte{
+	// 566 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x5d, 0x6e, 0xd3, 0x40,
+	0x10, 0x80, 0xe5, 0x38, 0x71, 0x9b, 0xa9, 0x54, 0xd0, 0x28, 0x4d, 0x8d, 0x49, 0x50, 0x6a, 0xa9,
+	0x6d, 0xa8, 0x4a, 0x22, 0xca, 0x03, 0x82, 0xb7, 0x42, 0xab, 0x0a, 0x54, 0x81, 0x64, 0xe8, 0x01,
+	0x96, 0x78, 0x52, 0x56, 0x72, 0xbd, 0x8b, 0xbd, 0x01, 0x45, 0x55, 0x5f, 0xb8, 0x02, 0x2f, 0x1c,
+	0x88, 0x1b, 0x70, 0x05, 0x2e, 0xc0, 0x0d, 0x90, 0x77, 0xb7, 0x4d, 0x9a, 0x38, 0xb4, 0xea, 0x9b,
+	0xe7, 0x67, 0xe7, 0xe7, 0x9b, 0x19, 0xc3, 0xfd, 0x98, 0x58, 0x7c, 0x4c, 0x4a, 0x51, 0xd6, 0x93,
+	0x99, 0x50, 0x02, 0x5d, 0x26, 0x79, 0xd0, 0x3a, 0x15, 0xe2, 0x34, 0xa1, 0x3e, 0x93, 0xbc, 0xcf,
+	0xd2, 0x54, 0x28, 0xa6, 0xb8, 0x48, 0x73, 0xe3, 0x12, 0x7e, 0x83, 0xb5, 0x63, 0x9e, 0xab, 0x83,
+	0xab, 0xa7, 0x11, 0x7d, 0x19, 0x51, 0xae, 0xb0, 0x09, 0x1e, 0x93, 0xf2, 0xf0, 0xe4, 0x8d, 0xef,
+	0x74, 0x9c, 0x6e, 0x3d, 0xb2, 0x12, 0xfa, 0xb0, 0xf4, 0x99, 0xa5, 0x71, 0x42, 0x99, 0x5f, 0xd1,
+	0x86, 0x4b, 0x11, 0x1b, 0x50, 0x4b, 0xf8, 0x19, 0x57, 0xbe, 0xdb, 0x71, 0xba, 0x6e, 0x64, 0x84,
+	0x22, 0x8e, 0x18, 0x0e, 0x73, 0x52, 0x7e, 0x55, 0xab, 0xad, 0x14, 0xfe, 0x75, 0xe0, 0xde, 0x24,
+	0xeb, 0x61, 0xaa, 0xb2, 0x31, 0xae, 0x42, 0x85, 0xc7, 0x3a, 0x9f, 0x1b, 0x55, 0x78, 0x8c, 0x2d,
+	0xa8, 0x0f, 0x32, 0x62, 0x8a, 0xe2, 0x7d, 0x65, 0xb3, 0x4d, 0x14, 0xd3, 0x95, 0xb8, 0xd7, 0x2b,
+	0x99, 0xd4, 0x5e, 0xbd, 0x56, 0x7b, 0x13, 0xbc, 0x98, 0xbe, 0x16, 0xfa, 0x9a, 0xd1, 0x1b, 0x09,
+	0x11, 0xaa, 0x6a, 0x2c, 0xc9, 0xf7, 0xb4, 0x56, 0x7f, 0x63, 0x07, 0x56, 0x24, 0x1b, 0x27, 0x82,
+	0xc5, 0x6f, 0x3f, 0xbc, 0x7f, 0xe7, 0x2f, 0x69, 0xd3, 0xb4, 0xaa, 0xe8, 0x97, 0xb2, 0x4c, 0x64,
+	0xfe, 0xb2, 0xb6, 0x19, 0x01, 0x03, 0x58, 0x66, 0x4a, 0xd1, 0x99, 0x54, 0xb9, 0x5f, 0xd7, 0x9d,
+	0x5c, 0xc9, 0xe1, 0x10, 0x9a, 0xb3, 0xb0, 0x73, 0x29, 0xd2, 0x9c, 0xf0, 0x11, 0x80, 0x12, 0x8a,
+	0x25, 0xaf, 0xc5, 0x28, 0x55, 0x96, 0xc0, 0x94, 0x06, 0x77, 0xc1, 0xcb, 0x28, 0x1f, 0x25, 0x05,
+	0x06, 0xb7, 0xbb, 0xb2, 0xd7, 0xe8, 0x31, 0xc9, 0x7b, 0x33, 0xfc, 0x22, 0xeb, 0x13, 0x6e, 0x41,
+	0xe3, 0x88, 0x4a, 0x66, 0x3a, 0xc3, 0x37, 0x7c, 0x0c, 0xeb, 0x11, 0xc9, 0x84, 0x8d, 0x6f, 0x76,
+	0x0d, 0xc0, 0x9f, 0x77, 0x35, 0xc5, 0x87, 0x1f, 0xa1, 0x33, 0x6b, 0xcb, 0x5f, 0x8d, 0xf7, 0x35,
+	0xf3, 0x3b, 0xaf, 0x53, 0xf8, 0x02, 0x36, 0xfe, 0x13, 0xd5, 0x72, 0x6b, 0x40, 0x6d, 0x30, 0x85,
+	0xcc, 0x08, 0x45, 0x5f, 0x07, 0x94, 0x90, 0xa2, 0x5b, 0xf5, 0x35, 0xef, 0x6a, 0x82, 0xef, 0xfd,
+	0xaa, 0x02, 0x4c, 0xd4, 0x98, 0x42, 0xb5, 0x98, 0x1e, 0x06, 0x9a, 0x7d, 0xe9, 0xd5, 0x04, 0x0f,
+	0x4b, 0x6d, 0x96, 0xd3, 0x93, 0xef, 0xbf, 0xff, 0xfc, 0xa8, 0x6c, 0xe3, 0xa6, 0xbe, 0xc5, 0xc9,
+	0xb5, 0xe6, 0x7d, 0x26, 0x65, 0xc2, 0x07, 0xfa, 0x30, 0xfb, 0xe7, 0x86, 0xcc, 0x05, 0x9e, 0x80,
+	0x7b, 0x44, 0x0a, 0x1f, 0xe8, 0x90, 0x65, 0xf3, 0x0c, 0x4a, 0xb7, 0x20, 0x6c, 0xeb, 0x34, 0xeb,
+	0xb8, 0x36, 0x97, 0xe6, 0x9c, 0xc7, 0x17, 0x98, 0x82, 0x67, 0xb8, 0x62, 0x4b, 0x3f, 0x5f, 0xb0,
+	0x01, 0x41, 0x7b, 0x81, 0xd5, 0x36, 0xb3, 0xad, 0xb3, 0x6c, 0x84, 0xad, 0xd2, 0x2c, 0xfd, 0x4c,
+	0xbf, 0x7b, 0xe9, 0xec, 0xe0, 0x4f, 0x07, 0x56, 0x4d, 0x94, 0xcb, 0xe9, 0xe1, 0x66, 0x69, 0xe8,
+	0xd9, 0x9d, 0x09, 0xb6, 0x6e, 0x72, 0xb3, 0xa5, 0x3c, 0xd7, 0xa5, 0x3c, 0x0d, 0x77, 0x6f, 0xc5,
+	0x75, 0xaa, 0xb4, 0x18, 0x3c, 0x33, 0x7c, 0x8b, 0x62, 0xc1, 0xd2, 0x04, 0xed, 0x05, 0x56, 0x9b,
+	0xdf, 0x02, 0xdf, 0x29, 0x07, 0xfe, 0xc9, 0xd3, 0x7f, 0xda, 0x67, 0xff, 0x06, 0x00, 0x12, 0xe0,
+	0x08, 0x58, 0xa0, 0x05, 0x00, 0x00,
+}
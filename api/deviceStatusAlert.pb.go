@@ -0,0 +1,408 @@
+// Code generated by protoc-gen-go.
+// source: deviceStatusAlert.proto
+// DO NOT EDIT!
+
+package api
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import _ "github.com/grpc-ecosystem/grpc-gateway/third_party/googleapis/google/api"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+type CreateDeviceStatusAlertRequest struct {
+	// hex encoded AppEUI
+	AppEUI string `protobuf:"bytes,1,opt,name=appEUI" json:"appEUI,omitempty"`
+	// min. battery level in percent (0 = disabled)
+	MinBatteryLevel float64 `protobuf:"fixed64,2,opt,name=minBatteryLevel" json:"minBatteryLevel,omitempty"`
+	// min. link margin in dB (0 = disabled)
+	MinLinkMargin int32 `protobuf:"varint,3,opt,name=minLinkMargin" json:"minLinkMargin,omitempty"`
+}
+
+func (m *CreateDeviceStatusAlertRequest) Reset()                    { *m = CreateDeviceStatusAlertRequest{} }
+func (m *CreateDeviceStatusAlertRequest) String() string            { return proto.CompactTextString(m) }
+func (*CreateDeviceStatusAlertRequest) ProtoMessage()               {}
+func (*CreateDeviceStatusAlertRequest) Descriptor() ([]byte, []int) { return fileDescriptor25, []int{0} }
+
+func (m *CreateDeviceStatusAlertRequest) GetAppEUI() string {
+	if m != nil {
+		return m.AppEUI
+	}
+	return ""
+}
+
+func (m *CreateDeviceStatusAlertRequest) GetMinBatteryLevel() float64 {
+	if m != nil {
+		return m.MinBatteryLevel
+	}
+	return 0
+}
+
+func (m *CreateDeviceStatusAlertRequest) GetMinLinkMargin() int32 {
+	if m != nil {
+		return m.MinLinkMargin
+	}
+	return 0
+}
+
+type CreateDeviceStatusAlertResponse struct {
+}
+
+func (m *CreateDeviceStatusAlertResponse) Reset()         { *m = CreateDeviceStatusAlertResponse{} }
+func (m *CreateDeviceStatusAlertResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateDeviceStatusAlertResponse) ProtoMessage()    {}
+func (*CreateDeviceStatusAlertResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor25, []int{1}
+}
+
+type GetDeviceStatusAlertRequest struct {
+	// hex encoded AppEUI
+	AppEUI string `protobuf:"bytes,1,opt,name=appEUI" json:"appEUI,omitempty"`
+}
+
+func (m *GetDeviceStatusAlertRequest) Reset()                    { *m = GetDeviceStatusAlertRequest{} }
+func (m *GetDeviceStatusAlertRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetDeviceStatusAlertRequest) ProtoMessage()               {}
+func (*GetDeviceStatusAlertRequest) Descriptor() ([]byte, []int) { return fileDescriptor25, []int{2} }
+
+func (m *GetDeviceStatusAlertRequest) GetAppEUI() string {
+	if m != nil {
+		return m.AppEUI
+	}
+	return ""
+}
+
+type GetDeviceStatusAlertResponse struct {
+	// hex encoded AppEUI
+	AppEUI string `protobuf:"bytes,1,opt,name=appEUI" json:"appEUI,omitempty"`
+	// min. battery level in percent (0 = disabled)
+	MinBatteryLevel float64 `protobuf:"fixed64,2,opt,name=minBatteryLevel" json:"minBatteryLevel,omitempty"`
+	// min. link margin in dB (0 = disabled)
+	MinLinkMargin int32 `protobuf:"varint,3,opt,name=minLinkMargin" json:"minLinkMargin,omitempty"`
+}
+
+func (m *GetDeviceStatusAlertResponse) Reset()                    { *m = GetDeviceStatusAlertResponse{} }
+func (m *GetDeviceStatusAlertResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetDeviceStatusAlertResponse) ProtoMessage()               {}
+func (*GetDeviceStatusAlertResponse) Descriptor() ([]byte, []int) { return fileDescriptor25, []int{3} }
+
+func (m *GetDeviceStatusAlertResponse) GetAppEUI() string {
+	if m != nil {
+		return m.AppEUI
+	}
+	return ""
+}
+
+func (m *GetDeviceStatusAlertResponse) GetMinBatteryLevel() float64 {
+	if m != nil {
+		return m.MinBatteryLevel
+	}
+	return 0
+}
+
+func (m *GetDeviceStatusAlertResponse) GetMinLinkMargin() int32 {
+	if m != nil {
+		return m.MinLinkMargin
+	}
+	return 0
+}
+
+type UpdateDeviceStatusAlertRequest struct {
+	// hex encoded AppEUI
+	AppEUI string `protobuf:"bytes,1,opt,name=appEUI" json:"appEUI,omitempty"`
+	// min. battery level in percent (0 = disabled)
+	MinBatteryLevel float64 `protobuf:"fixed64,2,opt,name=minBatteryLevel" json:"minBatteryLevel,omitempty"`
+	// min. link margin in dB (0 = disabled)
+	MinLinkMargin int32 `protobuf:"varint,3,opt,name=minLinkMargin" json:"minLinkMargin,omitempty"`
+}
+
+func (m *UpdateDeviceStatusAlertRequest) Reset()                    { *m = UpdateDeviceStatusAlertRequest{} }
+func (m *UpdateDeviceStatusAlertRequest) String() string            { return proto.CompactTextString(m) }
+func (*UpdateDeviceStatusAlertRequest) ProtoMessage()               {}
+func (*UpdateDeviceStatusAlertRequest) Descriptor() ([]byte, []int) { return fileDescriptor25, []int{4} }
+
+func (m *UpdateDeviceStatusAlertRequest) GetAppEUI() string {
+	if m != nil {
+		return m.AppEUI
+	}
+	return ""
+}
+
+func (m *UpdateDeviceStatusAlertRequest) GetMinBatteryLevel() float64 {
+	if m != nil {
+		return m.MinBatteryLevel
+	}
+	return 0
+}
+
+func (m *UpdateDeviceStatusAlertRequest) GetMinLinkMargin() int32 {
+	if m != nil {
+		return m.MinLinkMargin
+	}
+	return 0
+}
+
+type UpdateDeviceStatusAlertResponse struct {
+}
+
+func (m *UpdateDeviceStatusAlertResponse) Reset()         { *m = UpdateDeviceStatusAlertResponse{} }
+func (m *UpdateDeviceStatusAlertResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateDeviceStatusAlertResponse) ProtoMessage()    {}
+func (*UpdateDeviceStatusAlertResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor25, []int{5}
+}
+
+type DeleteDeviceStatusAlertRequest struct {
+	// hex encoded AppEUI
+	AppEUI string `protobuf:"bytes,1,opt,name=appEUI" json:"appEUI,omitempty"`
+}
+
+func (m *DeleteDeviceStatusAlertRequest) Reset()                    { *m = DeleteDeviceStatusAlertRequest{} }
+func (m *DeleteDeviceStatusAlertRequest) String() string            { return proto.CompactTextString(m) }
+func (*DeleteDeviceStatusAlertRequest) ProtoMessage()               {}
+func (*DeleteDeviceStatusAlertRequest) Descriptor() ([]byte, []int) { return fileDescriptor25, []int{6} }
+
+func (m *DeleteDeviceStatusAlertRequest) GetAppEUI() string {
+	if m != nil {
+		return m.AppEUI
+	}
+	return ""
+}
+
+type DeleteDeviceStatusAlertResponse struct {
+}
+
+func (m *DeleteDeviceStatusAlertResponse) Reset()         { *m = DeleteDeviceStatusAlertResponse{} }
+func (m *DeleteDeviceStatusAlertResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteDeviceStatusAlertResponse) ProtoMessage()    {}
+func (*DeleteDeviceStatusAlertResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor25, []int{7}
+}
+
+func init() {
+	proto.RegisterType((*CreateDeviceStatusAlertRequest)(nil), "api.CreateDeviceStatusAlertRequest")
+	proto.RegisterType((*CreateDeviceStatusAlertResponse)(nil), "api.CreateDeviceStatusAlertResponse")
+	proto.RegisterType((*GetDeviceStatusAlertRequest)(nil), "api.GetDeviceStatusAlertRequest")
+	proto.RegisterType((*GetDeviceStatusAlertResponse)(nil), "api.GetDeviceStatusAlertResponse")
+	proto.RegisterType((*UpdateDeviceStatusAlertRequest)(nil), "api.UpdateDeviceStatusAlertRequest")
+	proto.RegisterType((*UpdateDeviceStatusAlertResponse)(nil), "api.UpdateDeviceStatusAlertResponse")
+	proto.RegisterType((*DeleteDeviceStatusAlertRequest)(nil), "api.DeleteDeviceStatusAlertRequest")
+	proto.RegisterType((*DeleteDeviceStatusAlertResponse)(nil), "api.DeleteDeviceStatusAlertResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for DeviceStatusAlert service
+
+type DeviceStatusAlertClient interface {
+	// Create creates the device status alert of the given application.
+	Create(ctx context.Context, in *CreateDeviceStatusAlertRequest, opts ...grpc.CallOption) (*CreateDeviceStatusAlertResponse, error)
+	// Get returns the device status alert of the given application.
+	Get(ctx context.Context, in *GetDeviceStatusAlertRequest, opts ...grpc.CallOption) (*GetDeviceStatusAlertResponse, error)
+	// Update updates the device status alert of the given application.
+	Update(ctx context.Context, in *UpdateDeviceStatusAlertRequest, opts ...grpc.CallOption) (*UpdateDeviceStatusAlertResponse, error)
+	// Delete deletes the device status alert of the given application.
+	Delete(ctx context.Context, in *DeleteDeviceStatusAlertRequest, opts ...grpc.CallOption) (*DeleteDeviceStatusAlertResponse, error)
+}
+
+type deviceStatusAlertClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewDeviceStatusAlertClient(cc *grpc.ClientConn) DeviceStatusAlertClient {
+	return &deviceStatusAlertClient{cc}
+}
+
+func (c *deviceStatusAlertClient) Create(ctx context.Context, in *CreateDeviceStatusAlertRequest, opts ...grpc.CallOption) (*CreateDeviceStatusAlertResponse, error) {
+	out := new(CreateDeviceStatusAlertResponse)
+	err := grpc.Invoke(ctx, "/api.DeviceStatusAlert/Create", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceStatusAlertClient) Get(ctx context.Context, in *GetDeviceStatusAlertRequest, opts ...grpc.CallOption) (*GetDeviceStatusAlertResponse, error) {
+	out := new(GetDeviceStatusAlertResponse)
+	err := grpc.Invoke(ctx, "/api.DeviceStatusAlert/Get", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceStatusAlertClient) Update(ctx context.Context, in *UpdateDeviceStatusAlertRequest, opts ...grpc.CallOption) (*UpdateDeviceStatusAlertResponse, error) {
+	out := new(UpdateDeviceStatusAlertResponse)
+	err := grpc.Invoke(ctx, "/api.DeviceStatusAlert/Update", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceStatusAlertClient) Delete(ctx context.Context, in *DeleteDeviceStatusAlertRequest, opts ...grpc.CallOption) (*DeleteDeviceStatusAlertResponse, error) {
+	out := new(DeleteDeviceStatusAlertResponse)
+	err := grpc.Invoke(ctx, "/api.DeviceStatusAlert/Delete", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for DeviceStatusAlert service
+
+type DeviceStatusAlertServer interface {
+	// Create creates the device status alert of the given application.
+	Create(context.Context, *CreateDeviceStatusAlertRequest) (*CreateDeviceStatusAlertResponse, error)
+	// Get returns the device status alert of the given application.
+	Get(context.Context, *GetDeviceStatusAlertRequest) (*GetDeviceStatusAlertResponse, error)
+	// Update updates the device status alert of the given application.
+	Update(context.Context, *UpdateDeviceStatusAlertRequest) (*UpdateDeviceStatusAlertResponse, error)
+	// Delete deletes the device status alert of the given application.
+	Delete(context.Context, *DeleteDeviceStatusAlertRequest) (*DeleteDeviceStatusAlertResponse, error)
+}
+
+func RegisterDeviceStatusAlertServer(s *grpc.Server, srv DeviceStatusAlertServer) {
+	s.RegisterService(&_DeviceStatusAlert_serviceDesc, srv)
+}
+
+func _DeviceStatusAlert_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDeviceStatusAlertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceStatusAlertServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DeviceStatusAlert/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceStatusAlertServer).Create(ctx, req.(*CreateDeviceStatusAlertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceStatusAlert_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeviceStatusAlertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceStatusAlertServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DeviceStatusAlert/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceStatusAlertServer).Get(ctx, req.(*GetDeviceStatusAlertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceStatusAlert_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDeviceStatusAlertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceStatusAlertServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DeviceStatusAlert/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceStatusAlertServer).Update(ctx, req.(*UpdateDeviceStatusAlertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceStatusAlert_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDeviceStatusAlertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceStatusAlertServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DeviceStatusAlert/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceStatusAlertServer).Delete(ctx, req.(*DeleteDeviceStatusAlertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _DeviceStatusAlert_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.DeviceStatusAlert",
+	HandlerType: (*DeviceStatusAlertServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _DeviceStatusAlert_Create_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _DeviceStatusAlert_Get_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _DeviceStatusAlert_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _DeviceStatusAlert_Delete_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "deviceStatusAlert.proto",
+}
+
+func init() { proto.RegisterFile("deviceStatusAlert.proto", fileDescriptor25) }
+
+var fileDescriptor25 = []byte{
+	// 368 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x54, 0xc1, 0x4a, 0xeb, 0x40,
+	0x14, 0x65, 0xda, 0xf7, 0x02, 0xef, 0xc2, 0xe3, 0xf1, 0x66, 0x61, 0x4b, 0x2c, 0x35, 0x8d, 0x11,
+	0x42, 0x85, 0x16, 0x14, 0x41, 0xdc, 0xa9, 0x95, 0x22, 0xd4, 0x4d, 0xa4, 0x1f, 0x30, 0xda, 0x4b,
+	0x19, 0x4c, 0x27, 0x63, 0x32, 0xad, 0x88, 0x14, 0x44, 0x44, 0xdc, 0xfb, 0x69, 0xfe, 0x82, 0x1f,
+	0x22, 0xcd, 0xcc, 0xa6, 0xad, 0x99, 0xd2, 0x8d, 0xb8, 0xcc, 0xe4, 0xcc, 0x39, 0xe7, 0x9e, 0x7b,
+	0xef, 0x40, 0x65, 0x80, 0x13, 0x7e, 0x8d, 0x97, 0x8a, 0xa9, 0x71, 0x76, 0x1c, 0x63, 0xaa, 0x5a,
+	0x32, 0x4d, 0x54, 0x42, 0xcb, 0x4c, 0x72, 0xb7, 0x36, 0x4c, 0x92, 0x61, 0x8c, 0x6d, 0x26, 0x79,
+	0x9b, 0x09, 0x91, 0x28, 0xa6, 0x78, 0x22, 0x32, 0x0d, 0xf1, 0x5f, 0x09, 0xd4, 0x4f, 0x53, 0x64,
+	0x0a, 0x3b, 0x8b, 0x24, 0x11, 0xde, 0x8e, 0x31, 0x53, 0x74, 0x03, 0x1c, 0x26, 0xe5, 0x59, 0xff,
+	0xbc, 0x4a, 0x3c, 0x12, 0xfe, 0x89, 0xcc, 0x17, 0x0d, 0xe1, 0xdf, 0x88, 0x8b, 0x13, 0xa6, 0x14,
+	0xa6, 0xf7, 0x3d, 0x9c, 0x60, 0x5c, 0x2d, 0x79, 0x24, 0x24, 0xd1, 0xe2, 0x31, 0x0d, 0xe0, 0xef,
+	0x88, 0x8b, 0x1e, 0x17, 0x37, 0x17, 0x2c, 0x1d, 0x72, 0x51, 0x2d, 0x7b, 0x24, 0xfc, 0x1d, 0xcd,
+	0x1f, 0xfa, 0x0d, 0xd8, 0x2a, 0x74, 0x92, 0xc9, 0x44, 0x64, 0xe8, 0x1f, 0xc0, 0x66, 0x17, 0xd5,
+	0xba, 0x4e, 0xfd, 0x17, 0x02, 0xb5, 0xaf, 0xef, 0x69, 0xde, 0x6f, 0x2b, 0x71, 0x96, 0x76, 0x5f,
+	0x0e, 0x7e, 0x48, 0xda, 0x85, 0x4e, 0x4c, 0xda, 0x87, 0x50, 0xef, 0x60, 0x8c, 0xeb, 0x9b, 0x9d,
+	0x91, 0x17, 0xde, 0xd4, 0xe4, 0x7b, 0xcf, 0xbf, 0xe0, 0xff, 0xd2, 0x5f, 0x7a, 0x07, 0x8e, 0x9e,
+	0x01, 0xba, 0xdd, 0x62, 0x92, 0xb7, 0xec, 0xa3, 0xe9, 0x06, 0x76, 0x90, 0xa9, 0xc3, 0x7f, 0x7a,
+	0xff, 0x78, 0x2b, 0xd5, 0xfc, 0x4a, 0xbe, 0x03, 0x4b, 0xcb, 0x92, 0x1d, 0x91, 0x26, 0x55, 0x50,
+	0xee, 0xa2, 0xa2, 0x5e, 0x4e, 0x68, 0x99, 0x31, 0xb7, 0x61, 0x41, 0x18, 0xbd, 0x30, 0xd7, 0xf3,
+	0xa9, 0x57, 0xa0, 0xd7, 0x7e, 0xd0, 0x31, 0x4d, 0xe9, 0x23, 0x01, 0x47, 0x77, 0xc1, 0xd4, 0x6b,
+	0x1f, 0x0e, 0x37, 0xb0, 0x83, 0x8c, 0xfe, 0x6e, 0xae, 0xbf, 0xe3, 0xae, 0xd4, 0x9f, 0x15, 0x3e,
+	0x05, 0x47, 0xb7, 0xca, 0x38, 0xb0, 0x77, 0xdc, 0x0d, 0xec, 0xa0, 0xf9, 0x04, 0x9a, 0x2b, 0x1d,
+	0x5c, 0x39, 0xf9, 0x33, 0xb4, 0xff, 0x39, 0x00, 0xf4, 0xc6, 0x1c, 0xe8, 0xc4, 0x04, 0x00, 0x00,
+}
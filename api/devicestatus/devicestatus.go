@@ -0,0 +1,3 @@
+//go:generate protoc -I. --go_out=plugins=grpc:. devicestatus.proto
+
+package devicestatus
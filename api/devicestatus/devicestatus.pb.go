@@ -0,0 +1,174 @@
+// Code generated by protoc-gen-go.
+// source: devicestatus.proto
+// DO NOT EDIT!
+
+/*
+Package devicestatus is a generated protocol buffer package.
+
+It is generated from these files:
+	devicestatus.proto
+
+It has these top-level messages:
+	HandleDeviceStatusRequest
+	HandleDeviceStatusResponse
+*/
+package devicestatus
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type HandleDeviceStatusRequest struct {
+	// DevEUI of the node
+	DevEUI []byte `protobuf:"bytes,1,opt,name=devEUI,proto3" json:"devEUI,omitempty"`
+	// battery level (0 = external power source, 1 - 254 = battery level, 255 = unable to measure)
+	Battery uint32 `protobuf:"varint,2,opt,name=battery" json:"battery,omitempty"`
+	// demodulation signal-to-noise ratio (dB) of the last DevStatusReq, as received by the node (-32 - 31)
+	Margin int32 `protobuf:"varint,3,opt,name=margin" json:"margin,omitempty"`
+}
+
+func (m *HandleDeviceStatusRequest) Reset()                    { *m = HandleDeviceStatusRequest{} }
+func (m *HandleDeviceStatusRequest) String() string            { return proto.CompactTextString(m) }
+func (*HandleDeviceStatusRequest) ProtoMessage()               {}
+func (*HandleDeviceStatusRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
+func (m *HandleDeviceStatusRequest) GetDevEUI() []byte {
+	if m != nil {
+		return m.DevEUI
+	}
+	return nil
+}
+
+func (m *HandleDeviceStatusRequest) GetBattery() uint32 {
+	if m != nil {
+		return m.Battery
+	}
+	return 0
+}
+
+func (m *HandleDeviceStatusRequest) GetMargin() int32 {
+	if m != nil {
+		return m.Margin
+	}
+	return 0
+}
+
+type HandleDeviceStatusResponse struct {
+}
+
+func (m *HandleDeviceStatusResponse) Reset()                    { *m = HandleDeviceStatusResponse{} }
+func (m *HandleDeviceStatusResponse) String() string            { return proto.CompactTextString(m) }
+func (*HandleDeviceStatusResponse) ProtoMessage()               {}
+func (*HandleDeviceStatusResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+
+func init() {
+	proto.RegisterType((*HandleDeviceStatusRequest)(nil), "devicestatus.HandleDeviceStatusRequest")
+	proto.RegisterType((*HandleDeviceStatusResponse)(nil), "devicestatus.HandleDeviceStatusResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for DeviceStatus service
+
+type DeviceStatusClient interface {
+	// HandleDeviceStatus handles the device status of the given node.
+	HandleDeviceStatus(ctx context.Context, in *HandleDeviceStatusRequest, opts ...grpc.CallOption) (*HandleDeviceStatusResponse, error)
+}
+
+type deviceStatusClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewDeviceStatusClient(cc *grpc.ClientConn) DeviceStatusClient {
+	return &deviceStatusClient{cc}
+}
+
+func (c *deviceStatusClient) HandleDeviceStatus(ctx context.Context, in *HandleDeviceStatusRequest, opts ...grpc.CallOption) (*HandleDeviceStatusResponse, error) {
+	out := new(HandleDeviceStatusResponse)
+	err := grpc.Invoke(ctx, "/devicestatus.DeviceStatus/HandleDeviceStatus", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for DeviceStatus service
+
+type DeviceStatusServer interface {
+	// HandleDeviceStatus handles the device status of the given node.
+	HandleDeviceStatus(context.Context, *HandleDeviceStatusRequest) (*HandleDeviceStatusResponse, error)
+}
+
+func RegisterDeviceStatusServer(s *grpc.Server, srv DeviceStatusServer) {
+	s.RegisterService(&_DeviceStatus_serviceDesc, srv)
+}
+
+func _DeviceStatus_HandleDeviceStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HandleDeviceStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceStatusServer).HandleDeviceStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/devicestatus.DeviceStatus/HandleDeviceStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceStatusServer).HandleDeviceStatus(ctx, req.(*HandleDeviceStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _DeviceStatus_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "devicestatus.DeviceStatus",
+	HandlerType: (*DeviceStatusServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "HandleDeviceStatus",
+			Handler:    _DeviceStatus_HandleDeviceStatus_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "devicestatus.proto",
+}
+
+func init() { proto.RegisterFile("devicestatus.proto", fileDescriptor0) }
+
+var fileDescriptor0 = []byte{
+	// 167 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4a, 0x49, 0x2d, 0xcb,
+	0x4c, 0x4e, 0x2d, 0x2e, 0x49, 0x2c, 0x29, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2,
+	0x41, 0x16, 0x53, 0x4a, 0xe5, 0x92, 0xf4, 0x48, 0xcc, 0x4b, 0xc9, 0x49, 0x75, 0x01, 0x8b, 0x06,
+	0x83, 0x45, 0x83, 0x52, 0x0b, 0x4b, 0x53, 0x8b, 0x4b, 0x84, 0xc4, 0xb8, 0xd8, 0x52, 0x52, 0xcb,
+	0x5c, 0x43, 0x3d, 0x25, 0x18, 0x15, 0x18, 0x35, 0x78, 0x82, 0xa0, 0x3c, 0x21, 0x09, 0x2e, 0xf6,
+	0xa4, 0xc4, 0x92, 0x92, 0xd4, 0xa2, 0x4a, 0x09, 0x26, 0x05, 0x46, 0x0d, 0xde, 0x20, 0x18, 0x17,
+	0xa4, 0x23, 0x37, 0xb1, 0x28, 0x3d, 0x33, 0x4f, 0x82, 0x59, 0x81, 0x51, 0x83, 0x35, 0x08, 0xca,
+	0x53, 0x92, 0xe1, 0x92, 0xc2, 0x66, 0x4d, 0x71, 0x41, 0x7e, 0x5e, 0x71, 0xaa, 0x51, 0x39, 0x17,
+	0x0f, 0xb2, 0xb8, 0x50, 0x3a, 0x97, 0x10, 0xa6, 0x6a, 0x21, 0x75, 0x3d, 0x14, 0xdf, 0xe0, 0x74,
+	0xb6, 0x94, 0x06, 0x61, 0x85, 0x10, 0x8b, 0x93, 0xd8, 0xc0, 0x41, 0x62, 0x0c, 0x18, 0x00, 0x7a,
+	0xac, 0xf8, 0xfc, 0x28, 0x01, 0x00, 0x00,
+}
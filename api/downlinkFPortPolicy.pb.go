@@ -0,0 +1,386 @@
+// Code generated by protoc-gen-go.
+// source: downlinkFPortPolicy.proto
+// DO NOT EDIT!
+
+package api
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import _ "github.com/grpc-ecosystem/grpc-gateway/third_party/googleapis/google/api"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+type CreateDownlinkFPortPolicyRequest struct {
+	// hex encoded AppEUI
+	AppEUI string `protobuf:"bytes,1,opt,name=appEUI" json:"appEUI,omitempty"`
+	// hex encoded DevEUI (optional, when left blank the policy applies to all the nodes of the application)
+	DevEUI string `protobuf:"bytes,2,opt,name=devEUI" json:"devEUI,omitempty"`
+	// FPort to restrict
+	FPort uint32 `protobuf:"varint,3,opt,name=fPort" json:"fPort,omitempty"`
+	// principals allowed to send downlink data on the FPort (JWT subjects, or mqtt for the MQTT handler)
+	Principals []string `protobuf:"bytes,4,rep,name=principals" json:"principals,omitempty"`
+}
+
+func (m *CreateDownlinkFPortPolicyRequest) Reset()         { *m = CreateDownlinkFPortPolicyRequest{} }
+func (m *CreateDownlinkFPortPolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateDownlinkFPortPolicyRequest) ProtoMessage()    {}
+func (*CreateDownlinkFPortPolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor6, []int{0}
+}
+
+func (m *CreateDownlinkFPortPolicyRequest) GetAppEUI() string {
+	if m != nil {
+		return m.AppEUI
+	}
+	return ""
+}
+
+func (m *CreateDownlinkFPortPolicyRequest) GetDevEUI() string {
+	if m != nil {
+		return m.DevEUI
+	}
+	return ""
+}
+
+func (m *CreateDownlinkFPortPolicyRequest) GetFPort() uint32 {
+	if m != nil {
+		return m.FPort
+	}
+	return 0
+}
+
+func (m *CreateDownlinkFPortPolicyRequest) GetPrincipals() []string {
+	if m != nil {
+		return m.Principals
+	}
+	return nil
+}
+
+type CreateDownlinkFPortPolicyResponse struct {
+	// ID of the created policy
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *CreateDownlinkFPortPolicyResponse) Reset()         { *m = CreateDownlinkFPortPolicyResponse{} }
+func (m *CreateDownlinkFPortPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateDownlinkFPortPolicyResponse) ProtoMessage()    {}
+func (*CreateDownlinkFPortPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor6, []int{1}
+}
+
+func (m *CreateDownlinkFPortPolicyResponse) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type ListDownlinkFPortPolicyRequest struct {
+	// hex encoded AppEUI
+	AppEUI string `protobuf:"bytes,1,opt,name=appEUI" json:"appEUI,omitempty"`
+}
+
+func (m *ListDownlinkFPortPolicyRequest) Reset()                    { *m = ListDownlinkFPortPolicyRequest{} }
+func (m *ListDownlinkFPortPolicyRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListDownlinkFPortPolicyRequest) ProtoMessage()               {}
+func (*ListDownlinkFPortPolicyRequest) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{2} }
+
+func (m *ListDownlinkFPortPolicyRequest) GetAppEUI() string {
+	if m != nil {
+		return m.AppEUI
+	}
+	return ""
+}
+
+type DownlinkFPortPolicyItem struct {
+	// ID of the policy
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	// hex encoded AppEUI
+	AppEUI string `protobuf:"bytes,2,opt,name=appEUI" json:"appEUI,omitempty"`
+	// hex encoded DevEUI (empty when the policy applies to all the nodes of the application)
+	DevEUI string `protobuf:"bytes,3,opt,name=devEUI" json:"devEUI,omitempty"`
+	// restricted FPort
+	FPort uint32 `protobuf:"varint,4,opt,name=fPort" json:"fPort,omitempty"`
+	// principals allowed to send downlink data on the FPort
+	Principals []string `protobuf:"bytes,5,rep,name=principals" json:"principals,omitempty"`
+}
+
+func (m *DownlinkFPortPolicyItem) Reset()                    { *m = DownlinkFPortPolicyItem{} }
+func (m *DownlinkFPortPolicyItem) String() string            { return proto.CompactTextString(m) }
+func (*DownlinkFPortPolicyItem) ProtoMessage()               {}
+func (*DownlinkFPortPolicyItem) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{3} }
+
+func (m *DownlinkFPortPolicyItem) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *DownlinkFPortPolicyItem) GetAppEUI() string {
+	if m != nil {
+		return m.AppEUI
+	}
+	return ""
+}
+
+func (m *DownlinkFPortPolicyItem) GetDevEUI() string {
+	if m != nil {
+		return m.DevEUI
+	}
+	return ""
+}
+
+func (m *DownlinkFPortPolicyItem) GetFPort() uint32 {
+	if m != nil {
+		return m.FPort
+	}
+	return 0
+}
+
+func (m *DownlinkFPortPolicyItem) GetPrincipals() []string {
+	if m != nil {
+		return m.Principals
+	}
+	return nil
+}
+
+type ListDownlinkFPortPolicyResponse struct {
+	Result []*DownlinkFPortPolicyItem `protobuf:"bytes,1,rep,name=result" json:"result,omitempty"`
+}
+
+func (m *ListDownlinkFPortPolicyResponse) Reset()                    { *m = ListDownlinkFPortPolicyResponse{} }
+func (m *ListDownlinkFPortPolicyResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListDownlinkFPortPolicyResponse) ProtoMessage()               {}
+func (*ListDownlinkFPortPolicyResponse) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{4} }
+
+func (m *ListDownlinkFPortPolicyResponse) GetResult() []*DownlinkFPortPolicyItem {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type DeleteDownlinkFPortPolicyRequest struct {
+	// ID of the policy
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *DeleteDownlinkFPortPolicyRequest) Reset()         { *m = DeleteDownlinkFPortPolicyRequest{} }
+func (m *DeleteDownlinkFPortPolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteDownlinkFPortPolicyRequest) ProtoMessage()    {}
+func (*DeleteDownlinkFPortPolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor6, []int{5}
+}
+
+func (m *DeleteDownlinkFPortPolicyRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type DeleteDownlinkFPortPolicyResponse struct {
+}
+
+func (m *DeleteDownlinkFPortPolicyResponse) Reset()         { *m = DeleteDownlinkFPortPolicyResponse{} }
+func (m *DeleteDownlinkFPortPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteDownlinkFPortPolicyResponse) ProtoMessage()    {}
+func (*DeleteDownlinkFPortPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor6, []int{6}
+}
+
+func init() {
+	proto.RegisterType((*CreateDownlinkFPortPolicyRequest)(nil), "api.CreateDownlinkFPortPolicyRequest")
+	proto.RegisterType((*CreateDownlinkFPortPolicyResponse)(nil), "api.CreateDownlinkFPortPolicyResponse")
+	proto.RegisterType((*ListDownlinkFPortPolicyRequest)(nil), "api.ListDownlinkFPortPolicyRequest")
+	proto.RegisterType((*DownlinkFPortPolicyItem)(nil), "api.DownlinkFPortPolicyItem")
+	proto.RegisterType((*ListDownlinkFPortPolicyResponse)(nil), "api.ListDownlinkFPortPolicyResponse")
+	proto.RegisterType((*DeleteDownlinkFPortPolicyRequest)(nil), "api.DeleteDownlinkFPortPolicyRequest")
+	proto.RegisterType((*DeleteDownlinkFPortPolicyResponse)(nil), "api.DeleteDownlinkFPortPolicyResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for DownlinkFPortPolicy service
+
+type DownlinkFPortPolicyClient interface {
+	// Create creates the given policy.
+	Create(ctx context.Context, in *CreateDownlinkFPortPolicyRequest, opts ...grpc.CallOption) (*CreateDownlinkFPortPolicyResponse, error)
+	// List lists the policies of the given application.
+	List(ctx context.Context, in *ListDownlinkFPortPolicyRequest, opts ...grpc.CallOption) (*ListDownlinkFPortPolicyResponse, error)
+	// Delete deletes the policy matching the given id.
+	Delete(ctx context.Context, in *DeleteDownlinkFPortPolicyRequest, opts ...grpc.CallOption) (*DeleteDownlinkFPortPolicyResponse, error)
+}
+
+type downlinkFPortPolicyClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewDownlinkFPortPolicyClient(cc *grpc.ClientConn) DownlinkFPortPolicyClient {
+	return &downlinkFPortPolicyClient{cc}
+}
+
+func (c *downlinkFPortPolicyClient) Create(ctx context.Context, in *CreateDownlinkFPortPolicyRequest, opts ...grpc.CallOption) (*CreateDownlinkFPortPolicyResponse, error) {
+	out := new(CreateDownlinkFPortPolicyResponse)
+	err := grpc.Invoke(ctx, "/api.DownlinkFPortPolicy/Create", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *downlinkFPortPolicyClient) List(ctx context.Context, in *ListDownlinkFPortPolicyRequest, opts ...grpc.CallOption) (*ListDownlinkFPortPolicyResponse, error) {
+	out := new(ListDownlinkFPortPolicyResponse)
+	err := grpc.Invoke(ctx, "/api.DownlinkFPortPolicy/List", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *downlinkFPortPolicyClient) Delete(ctx context.Context, in *DeleteDownlinkFPortPolicyRequest, opts ...grpc.CallOption) (*DeleteDownlinkFPortPolicyResponse, error) {
+	out := new(DeleteDownlinkFPortPolicyResponse)
+	err := grpc.Invoke(ctx, "/api.DownlinkFPortPolicy/Delete", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for DownlinkFPortPolicy service
+
+type DownlinkFPortPolicyServer interface {
+	// Create creates the given policy.
+	Create(context.Context, *CreateDownlinkFPortPolicyRequest) (*CreateDownlinkFPortPolicyResponse, error)
+	// List lists the policies of the given application.
+	List(context.Context, *ListDownlinkFPortPolicyRequest) (*ListDownlinkFPortPolicyResponse, error)
+	// Delete deletes the policy matching the given id.
+	Delete(context.Context, *DeleteDownlinkFPortPolicyRequest) (*DeleteDownlinkFPortPolicyResponse, error)
+}
+
+func RegisterDownlinkFPortPolicyServer(s *grpc.Server, srv DownlinkFPortPolicyServer) {
+	s.RegisterService(&_DownlinkFPortPolicy_serviceDesc, srv)
+}
+
+func _DownlinkFPortPolicy_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDownlinkFPortPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DownlinkFPortPolicyServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DownlinkFPortPolicy/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DownlinkFPortPolicyServer).Create(ctx, req.(*CreateDownlinkFPortPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DownlinkFPortPolicy_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDownlinkFPortPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DownlinkFPortPolicyServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DownlinkFPortPolicy/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DownlinkFPortPolicyServer).List(ctx, req.(*ListDownlinkFPortPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DownlinkFPortPolicy_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDownlinkFPortPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DownlinkFPortPolicyServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DownlinkFPortPolicy/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DownlinkFPortPolicyServer).Delete(ctx, req.(*DeleteDownlinkFPortPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _DownlinkFPortPolicy_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.DownlinkFPortPolicy",
+	HandlerType: (*DownlinkFPortPolicyServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _DownlinkFPortPolicy_Create_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _DownlinkFPortPolicy_List_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _DownlinkFPortPolicy_Delete_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "downlinkFPortPolicy.proto",
+}
+
+func init() { proto.RegisterFile("downlinkFPortPolicy.proto", fileDescriptor6) }
+
+var fileDescriptor6 = []byte{
+	// 402 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xc1, 0x6a, 0xdb, 0x40,
+	0x10, 0x65, 0x25, 0x5b, 0xe0, 0x29, 0xed, 0x61, 0x5b, 0x5a, 0x55, 0x18, 0x5b, 0x96, 0x6b, 0x57,
+	0xb8, 0x60, 0x83, 0xdd, 0x43, 0xe9, 0xb5, 0x6e, 0xc1, 0xd0, 0x83, 0x11, 0x84, 0x9c, 0x37, 0xd6,
+	0xc6, 0x2c, 0x51, 0xb4, 0x1b, 0xed, 0x3a, 0x21, 0x04, 0x9b, 0x90, 0x53, 0x6e, 0x39, 0xe4, 0x92,
+	0xff, 0xca, 0x2f, 0xe4, 0x43, 0x82, 0xb4, 0x3a, 0x18, 0xdb, 0x92, 0xc8, 0x71, 0x46, 0x6f, 0xe7,
+	0xbd, 0x79, 0xf3, 0x04, 0x5f, 0x43, 0x7e, 0x15, 0x47, 0x2c, 0x3e, 0xfb, 0x37, 0xe7, 0x89, 0x9a,
+	0xf3, 0x88, 0x2d, 0xae, 0x87, 0x22, 0xe1, 0x8a, 0x63, 0x93, 0x08, 0xe6, 0x34, 0x97, 0x9c, 0x2f,
+	0x23, 0x3a, 0x22, 0x82, 0x8d, 0x48, 0x1c, 0x73, 0x45, 0x14, 0xe3, 0xb1, 0xd4, 0x10, 0xef, 0x1e,
+	0x81, 0xfb, 0x27, 0xa1, 0x44, 0xd1, 0xe9, 0xfe, 0x98, 0x80, 0x5e, 0xac, 0xa8, 0x54, 0xf8, 0x33,
+	0x58, 0x44, 0x88, 0xbf, 0x47, 0x33, 0x1b, 0xb9, 0xc8, 0x6f, 0x04, 0x79, 0x95, 0xf6, 0x43, 0x7a,
+	0x99, 0xf6, 0x0d, 0xdd, 0xd7, 0x15, 0xfe, 0x04, 0xf5, 0xd3, 0x74, 0x8a, 0x6d, 0xba, 0xc8, 0x7f,
+	0x1f, 0xe8, 0x02, 0xb7, 0x00, 0x44, 0xc2, 0xe2, 0x05, 0x13, 0x24, 0x92, 0x76, 0xcd, 0x35, 0xfd,
+	0x46, 0xb0, 0xd5, 0xf1, 0x26, 0xd0, 0x29, 0x51, 0x22, 0x05, 0x8f, 0x25, 0xc5, 0x1f, 0xc0, 0x60,
+	0x61, 0x26, 0xc3, 0x0c, 0x0c, 0x16, 0x7a, 0xbf, 0xa0, 0xf5, 0x9f, 0x49, 0xf5, 0x76, 0xf1, 0xde,
+	0x03, 0x82, 0x2f, 0x07, 0x9e, 0xcd, 0x14, 0x3d, 0xdf, 0x65, 0xd9, 0x9a, 0x61, 0x14, 0x18, 0x60,
+	0x1e, 0x36, 0xa0, 0x56, 0x6c, 0x40, 0x7d, 0xcf, 0x80, 0x63, 0x68, 0x17, 0xee, 0x92, 0xaf, 0xff,
+	0x13, 0xac, 0x84, 0xca, 0x55, 0xa4, 0x6c, 0xe4, 0x9a, 0xfe, 0xbb, 0x71, 0x73, 0x48, 0x04, 0x1b,
+	0x16, 0xac, 0x11, 0xe4, 0x58, 0x6f, 0x0c, 0xee, 0x94, 0x46, 0xb4, 0xf4, 0xc6, 0xbb, 0xc6, 0x76,
+	0xa1, 0x53, 0xf2, 0x46, 0xcb, 0x19, 0x3f, 0x99, 0xf0, 0xf1, 0xc0, 0x77, 0xbc, 0x01, 0x4b, 0x9f,
+	0x12, 0xf7, 0x32, 0x81, 0x55, 0x09, 0x73, 0xfa, 0x55, 0x30, 0x4d, 0xe8, 0xf5, 0xee, 0x9e, 0x5f,
+	0x1e, 0x8d, 0xb6, 0xe7, 0x64, 0x71, 0xde, 0x4f, 0x3e, 0xa3, 0xf2, 0x37, 0x1a, 0xe0, 0x0d, 0xd4,
+	0x52, 0x27, 0x71, 0x37, 0x1b, 0x5b, 0x1e, 0x10, 0xe7, 0x5b, 0x39, 0x28, 0x67, 0xfe, 0x91, 0x31,
+	0xf7, 0x70, 0xb7, 0x98, 0x79, 0x74, 0xa3, 0x63, 0xb1, 0xc6, 0xb7, 0x08, 0x2c, 0xed, 0x5e, 0x6e,
+	0x40, 0x95, 0xfd, 0x4e, 0xbf, 0x0a, 0x96, 0xcb, 0xf8, 0x9e, 0xc9, 0xe8, 0x0c, 0xda, 0x65, 0x32,
+	0x58, 0xb8, 0x3e, 0xb1, 0xb2, 0xff, 0x7b, 0xf2, 0x3a, 0x00, 0x54, 0xe0, 0x1a, 0x26, 0x1f, 0x04,
+	0x00, 0x00,
+}
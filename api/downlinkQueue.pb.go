@@ -30,6 +30,16 @@ type EnqueueDownlinkQueueItemRequest struct {
 	FPort uint32 `protobuf:"varint,4,opt,name=fPort" json:"fPort,omitempty"`
 	// base64 encoded data
 	Data []byte `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
+	// RFC3339 timestamp before which the item is not sent (optional)
+	DelayUntil string `protobuf:"bytes,6,opt,name=delayUntil" json:"delayUntil,omitempty"`
+	// max. number of retransmissions of a confirmed item (0 = no limit)
+	MaxRetries uint32 `protobuf:"varint,7,opt,name=maxRetries" json:"maxRetries,omitempty"`
+	// data contains the FRMPayload encrypted by the application (required for nodes using end-to-end encryption)
+	Encrypted bool `protobuf:"varint,8,opt,name=encrypted" json:"encrypted,omitempty"`
+	// downlink frame-counter used for encrypting the data (when encrypted)
+	FCnt uint32 `protobuf:"varint,9,opt,name=fCnt" json:"fCnt,omitempty"`
+	// JSON encoded object, encoded by the payload codec of the application (when data is empty)
+	Object string `protobuf:"bytes,10,opt,name=object" json:"object,omitempty"`
 }
 
 func (m *EnqueueDownlinkQueueItemRequest) Reset()                    { *m = EnqueueDownlinkQueueItemRequest{} }
@@ -72,6 +82,41 @@ func (m *EnqueueDownlinkQueueItemRequest) GetData() []byte {
 	return nil
 }
 
+func (m *EnqueueDownlinkQueueItemRequest) GetDelayUntil() string {
+	if m != nil {
+		return m.DelayUntil
+	}
+	return ""
+}
+
+func (m *EnqueueDownlinkQueueItemRequest) GetMaxRetries() uint32 {
+	if m != nil {
+		return m.MaxRetries
+	}
+	return 0
+}
+
+func (m *EnqueueDownlinkQueueItemRequest) GetEncrypted() bool {
+	if m != nil {
+		return m.Encrypted
+	}
+	return false
+}
+
+func (m *EnqueueDownlinkQueueItemRequest) GetFCnt() uint32 {
+	if m != nil {
+		return m.FCnt
+	}
+	return 0
+}
+
+func (m *EnqueueDownlinkQueueItemRequest) GetObject() string {
+	if m != nil {
+		return m.Object
+	}
+	return ""
+}
+
 type EnqueueDownlinkQueueItemResponse struct {
 }
 
@@ -122,6 +167,16 @@ type DownlinkQueueItem struct {
 	FPort uint32 `protobuf:"varint,6,opt,name=fPort" json:"fPort,omitempty"`
 	// base64 encoded data
 	Data []byte `protobuf:"bytes,7,opt,name=data,proto3" json:"data,omitempty"`
+	// RFC3339 timestamp before which the item is not sent (empty when not delayed)
+	DelayUntil string `protobuf:"bytes,8,opt,name=delayUntil" json:"delayUntil,omitempty"`
+	// max. number of retransmissions of a confirmed item (0 = no limit)
+	MaxRetries uint32 `protobuf:"varint,9,opt,name=maxRetries" json:"maxRetries,omitempty"`
+	// number of retransmissions of a confirmed item
+	Retries uint32 `protobuf:"varint,10,opt,name=retries" json:"retries,omitempty"`
+	// data contains the FRMPayload encrypted by the application
+	Encrypted bool `protobuf:"varint,11,opt,name=encrypted" json:"encrypted,omitempty"`
+	// downlink frame-counter used for encrypting the data (when encrypted)
+	FCnt uint32 `protobuf:"varint,12,opt,name=fCnt" json:"fCnt,omitempty"`
 }
 
 func (m *DownlinkQueueItem) Reset()                    { *m = DownlinkQueueItem{} }
@@ -178,6 +233,41 @@ func (m *DownlinkQueueItem) GetData() []byte {
 	return nil
 }
 
+func (m *DownlinkQueueItem) GetDelayUntil() string {
+	if m != nil {
+		return m.DelayUntil
+	}
+	return ""
+}
+
+func (m *DownlinkQueueItem) GetMaxRetries() uint32 {
+	if m != nil {
+		return m.MaxRetries
+	}
+	return 0
+}
+
+func (m *DownlinkQueueItem) GetRetries() uint32 {
+	if m != nil {
+		return m.Retries
+	}
+	return 0
+}
+
+func (m *DownlinkQueueItem) GetEncrypted() bool {
+	if m != nil {
+		return m.Encrypted
+	}
+	return false
+}
+
+func (m *DownlinkQueueItem) GetFCnt() uint32 {
+	if m != nil {
+		return m.FCnt
+	}
+	return 0
+}
+
 type ListDownlinkQueueItemsRequest struct {
 	// hex encoded DevEUI
 	DevEUI string `protobuf:"bytes,1,opt,name=devEUI" json:"devEUI,omitempty"`
@@ -211,6 +301,180 @@ func (m *ListDownlinkQueueItemsResponse) GetItems() []*DownlinkQueueItem {
 	return nil
 }
 
+type FlushDownlinkQueueRequest struct {
+	// hex encoded DevEUI
+	DevEUI string `protobuf:"bytes,1,opt,name=devEUI" json:"devEUI,omitempty"`
+}
+
+func (m *FlushDownlinkQueueRequest) Reset()                    { *m = FlushDownlinkQueueRequest{} }
+func (m *FlushDownlinkQueueRequest) String() string            { return proto.CompactTextString(m) }
+func (*FlushDownlinkQueueRequest) ProtoMessage()               {}
+func (*FlushDownlinkQueueRequest) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{7} }
+
+func (m *FlushDownlinkQueueRequest) GetDevEUI() string {
+	if m != nil {
+		return m.DevEUI
+	}
+	return ""
+}
+
+type FlushDownlinkQueueResponse struct {
+}
+
+func (m *FlushDownlinkQueueResponse) Reset()                    { *m = FlushDownlinkQueueResponse{} }
+func (m *FlushDownlinkQueueResponse) String() string            { return proto.CompactTextString(m) }
+func (*FlushDownlinkQueueResponse) ProtoMessage()               {}
+func (*FlushDownlinkQueueResponse) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{8} }
+
+type DownlinkDelivery struct {
+	// id of the queue item
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	// random reference (used on ack and error notifications)
+	Reference string `protobuf:"bytes,2,opt,name=reference" json:"reference,omitempty"`
+	// requires an ack from the node
+	Confirmed bool `protobuf:"varint,3,opt,name=confirmed" json:"confirmed,omitempty"`
+	// delivery status (QUEUED, SENT, PENDING, ACKNOWLEDGED, NACK, TIMEOUT or CANCELLED)
+	Status string `protobuf:"bytes,4,opt,name=status" json:"status,omitempty"`
+	// downlink frame-counter of the first transmission
+	FCnt uint32 `protobuf:"varint,5,opt,name=fCnt" json:"fCnt,omitempty"`
+	// timestamp of the first transmission (RFC3339, empty when not sent)
+	SentAt string `protobuf:"bytes,6,opt,name=sentAt" json:"sentAt,omitempty"`
+	// timestamp of creation (RFC3339)
+	CreatedAt string `protobuf:"bytes,7,opt,name=createdAt" json:"createdAt,omitempty"`
+	// timestamp of the last status change (RFC3339)
+	UpdatedAt string `protobuf:"bytes,8,opt,name=updatedAt" json:"updatedAt,omitempty"`
+}
+
+func (m *DownlinkDelivery) Reset()                    { *m = DownlinkDelivery{} }
+func (m *DownlinkDelivery) String() string            { return proto.CompactTextString(m) }
+func (*DownlinkDelivery) ProtoMessage()               {}
+func (*DownlinkDelivery) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{9} }
+
+func (m *DownlinkDelivery) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *DownlinkDelivery) GetReference() string {
+	if m != nil {
+		return m.Reference
+	}
+	return ""
+}
+
+func (m *DownlinkDelivery) GetConfirmed() bool {
+	if m != nil {
+		return m.Confirmed
+	}
+	return false
+}
+
+func (m *DownlinkDelivery) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *DownlinkDelivery) GetFCnt() uint32 {
+	if m != nil {
+		return m.FCnt
+	}
+	return 0
+}
+
+func (m *DownlinkDelivery) GetSentAt() string {
+	if m != nil {
+		return m.SentAt
+	}
+	return ""
+}
+
+func (m *DownlinkDelivery) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return ""
+}
+
+func (m *DownlinkDelivery) GetUpdatedAt() string {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return ""
+}
+
+type ListDownlinkDeliveriesRequest struct {
+	// hex encoded DevEUI
+	DevEUI string `protobuf:"bytes,1,opt,name=devEUI" json:"devEUI,omitempty"`
+	// only return the deliveries with the given reference (optional)
+	Reference string `protobuf:"bytes,2,opt,name=reference" json:"reference,omitempty"`
+	// max number of deliveries to return
+	Limit int64 `protobuf:"varint,3,opt,name=limit" json:"limit,omitempty"`
+	// offset in the result-set (for pagination)
+	Offset int64 `protobuf:"varint,4,opt,name=offset" json:"offset,omitempty"`
+}
+
+func (m *ListDownlinkDeliveriesRequest) Reset()                    { *m = ListDownlinkDeliveriesRequest{} }
+func (m *ListDownlinkDeliveriesRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListDownlinkDeliveriesRequest) ProtoMessage()               {}
+func (*ListDownlinkDeliveriesRequest) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{10} }
+
+func (m *ListDownlinkDeliveriesRequest) GetDevEUI() string {
+	if m != nil {
+		return m.DevEUI
+	}
+	return ""
+}
+
+func (m *ListDownlinkDeliveriesRequest) GetReference() string {
+	if m != nil {
+		return m.Reference
+	}
+	return ""
+}
+
+func (m *ListDownlinkDeliveriesRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListDownlinkDeliveriesRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+type ListDownlinkDeliveriesResponse struct {
+	// total number of deliveries
+	TotalCount int64               `protobuf:"varint,1,opt,name=totalCount" json:"totalCount,omitempty"`
+	Result     []*DownlinkDelivery `protobuf:"bytes,2,rep,name=result" json:"result,omitempty"`
+}
+
+func (m *ListDownlinkDeliveriesResponse) Reset()                    { *m = ListDownlinkDeliveriesResponse{} }
+func (m *ListDownlinkDeliveriesResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListDownlinkDeliveriesResponse) ProtoMessage()               {}
+func (*ListDownlinkDeliveriesResponse) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{11} }
+
+func (m *ListDownlinkDeliveriesResponse) GetTotalCount() int64 {
+	if m != nil {
+		return m.TotalCount
+	}
+	return 0
+}
+
+func (m *ListDownlinkDeliveriesResponse) GetResult() []*DownlinkDelivery {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*EnqueueDownlinkQueueItemRequest)(nil), "api.EnqueueDownlinkQueueItemRequest")
 	proto.RegisterType((*EnqueueDownlinkQueueItemResponse)(nil), "api.EnqueueDownlinkQueueItemResponse")
@@ -219,6 +483,11 @@ func init() {
 	proto.RegisterType((*DownlinkQueueItem)(nil), "api.DownlinkQueueItem")
 	proto.RegisterType((*ListDownlinkQueueItemsRequest)(nil), "api.ListDownlinkQueueItemsRequest")
 	proto.RegisterType((*ListDownlinkQueueItemsResponse)(nil), "api.ListDownlinkQueueItemsResponse")
+	proto.RegisterType((*FlushDownlinkQueueRequest)(nil), "api.FlushDownlinkQueueRequest")
+	proto.RegisterType((*FlushDownlinkQueueResponse)(nil), "api.FlushDownlinkQueueResponse")
+	proto.RegisterType((*DownlinkDelivery)(nil), "api.DownlinkDelivery")
+	proto.RegisterType((*ListDownlinkDeliveriesRequest)(nil), "api.ListDownlinkDeliveriesRequest")
+	proto.RegisterType((*ListDownlinkDeliveriesResponse)(nil), "api.ListDownlinkDeliveriesResponse")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -238,6 +507,11 @@ type DownlinkQueueClient interface {
 	Delete(ctx context.Context, in *DeleteDownlinkQeueueItemRequest, opts ...grpc.CallOption) (*DeleteDownlinkQueueItemResponse, error)
 	// List lists the items in the queue for the given devEUI.
 	List(ctx context.Context, in *ListDownlinkQueueItemsRequest, opts ...grpc.CallOption) (*ListDownlinkQueueItemsResponse, error)
+	// Flush deletes all the items in the queue for the given devEUI.
+	Flush(ctx context.Context, in *FlushDownlinkQueueRequest, opts ...grpc.CallOption) (*FlushDownlinkQueueResponse, error)
+	// ListDeliveries lists the delivery status of the (queued and sent)
+	// items for the given devEUI, newest first.
+	ListDeliveries(ctx context.Context, in *ListDownlinkDeliveriesRequest, opts ...grpc.CallOption) (*ListDownlinkDeliveriesResponse, error)
 }
 
 type downlinkQueueClient struct {
@@ -275,6 +549,24 @@ func (c *downlinkQueueClient) List(ctx context.Context, in *ListDownlinkQueueIte
 	return out, nil
 }
 
+func (c *downlinkQueueClient) Flush(ctx context.Context, in *FlushDownlinkQueueRequest, opts ...grpc.CallOption) (*FlushDownlinkQueueResponse, error) {
+	out := new(FlushDownlinkQueueResponse)
+	err := grpc.Invoke(ctx, "/api.DownlinkQueue/Flush", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *downlinkQueueClient) ListDeliveries(ctx context.Context, in *ListDownlinkDeliveriesRequest, opts ...grpc.CallOption) (*ListDownlinkDeliveriesResponse, error) {
+	out := new(ListDownlinkDeliveriesResponse)
+	err := grpc.Invoke(ctx, "/api.DownlinkQueue/ListDeliveries", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for DownlinkQueue service
 
 type DownlinkQueueServer interface {
@@ -284,6 +576,11 @@ type DownlinkQueueServer interface {
 	Delete(context.Context, *DeleteDownlinkQeueueItemRequest) (*DeleteDownlinkQueueItemResponse, error)
 	// List lists the items in the queue for the given devEUI.
 	List(context.Context, *ListDownlinkQueueItemsRequest) (*ListDownlinkQueueItemsResponse, error)
+	// Flush deletes all the items in the queue for the given devEUI.
+	Flush(context.Context, *FlushDownlinkQueueRequest) (*FlushDownlinkQueueResponse, error)
+	// ListDeliveries lists the delivery status of the (queued and sent)
+	// items for the given devEUI, newest first.
+	ListDeliveries(context.Context, *ListDownlinkDeliveriesRequest) (*ListDownlinkDeliveriesResponse, error)
 }
 
 func RegisterDownlinkQueueServer(s *grpc.Server, srv DownlinkQueueServer) {
@@ -344,6 +641,42 @@ func _DownlinkQueue_List_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DownlinkQueue_Flush_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FlushDownlinkQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DownlinkQueueServer).Flush(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DownlinkQueue/Flush",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DownlinkQueueServer).Flush(ctx, req.(*FlushDownlinkQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DownlinkQueue_ListDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDownlinkDeliveriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DownlinkQueueServer).ListDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DownlinkQueue/ListDeliveries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DownlinkQueueServer).ListDeliveries(ctx, req.(*ListDownlinkDeliveriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _DownlinkQueue_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "api.DownlinkQueue",
 	HandlerType: (*DownlinkQueueServer)(nil),
@@ -360,6 +693,14 @@ var _DownlinkQueue_serviceDesc = grpc.ServiceDesc{
 			MethodName: "List",
 			Handler:    _DownlinkQueue_List_Handler,
 		},
+		{
+			MethodName: "Flush",
+			Handler:    _DownlinkQueue_Flush_Handler,
+		},
+		{
+			MethodName: "ListDeliveries",
+			Handler:    _DownlinkQueue_ListDeliveries_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "downlinkQueue.proto",
@@ -368,32 +709,52 @@ var _DownlinkQueue_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("downlinkQueue.proto", fileDescriptor2) }
 
 var fileDescriptor2 = []byte{
-	// 430 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x84, 0x53, 0x51, 0x8e, 0xd3, 0x30,
-	0x10, 0x95, 0x93, 0x34, 0xdd, 0x1d, 0x58, 0x24, 0x0c, 0xda, 0x0d, 0x61, 0x4b, 0x83, 0x17, 0xa4,
-	0x68, 0x85, 0x5a, 0x51, 0x3e, 0x90, 0xf8, 0x6e, 0x3f, 0x2a, 0x21, 0x04, 0x91, 0x38, 0x40, 0xa8,
-	0xa7, 0x95, 0x45, 0x6b, 0xa7, 0x89, 0x03, 0x1f, 0xa8, 0x3f, 0x5c, 0x81, 0x03, 0x70, 0x10, 0x8e,
-	0xc1, 0x15, 0xb8, 0x01, 0x17, 0x40, 0x71, 0x82, 0xd2, 0x36, 0x6d, 0xf3, 0x97, 0x99, 0x79, 0x33,
-	0x2f, 0xf3, 0xde, 0x18, 0x1e, 0x70, 0xf5, 0x55, 0x2e, 0x85, 0xfc, 0xfc, 0x21, 0xc7, 0x1c, 0x07,
-	0x49, 0xaa, 0xb4, 0xa2, 0x76, 0x9c, 0x08, 0xff, 0x7a, 0xa1, 0xd4, 0x62, 0x89, 0xc3, 0x38, 0x11,
-	0xc3, 0x58, 0x4a, 0xa5, 0x63, 0x2d, 0x94, 0xcc, 0x4a, 0x08, 0xfb, 0x49, 0xa0, 0x3f, 0x91, 0xeb,
-	0xa2, 0x69, 0xbc, 0x3d, 0x61, 0xaa, 0x71, 0x15, 0xe1, 0x3a, 0xc7, 0x4c, 0xd3, 0x4b, 0x70, 0x39,
-	0x7e, 0x99, 0x7c, 0x9c, 0x7a, 0x24, 0x20, 0xe1, 0x79, 0x54, 0x45, 0xf4, 0x1a, 0xce, 0x53, 0x9c,
-	0x63, 0x8a, 0x72, 0x86, 0x9e, 0x65, 0x4a, 0x75, 0xa2, 0xa8, 0xce, 0x94, 0x9c, 0x8b, 0x74, 0x85,
-	0xdc, 0xb3, 0x03, 0x12, 0x9e, 0x45, 0x75, 0x82, 0x3e, 0x84, 0xce, 0xfc, 0xbd, 0x4a, 0xb5, 0xe7,
-	0x04, 0x24, 0xbc, 0x88, 0xca, 0x80, 0x52, 0x70, 0x78, 0xac, 0x63, 0xaf, 0x13, 0x90, 0xf0, 0x6e,
-	0x64, 0xbe, 0x19, 0x83, 0xe0, 0xf8, 0x0f, 0x66, 0x89, 0x92, 0x19, 0xb2, 0x97, 0xd0, 0x1f, 0xe3,
-	0x12, 0x75, 0x0d, 0xc1, 0xfd, 0x25, 0xee, 0x81, 0x25, 0xb8, 0x59, 0xc0, 0x8e, 0x2c, 0xc1, 0xd9,
-	0xd3, 0x46, 0x4b, 0x63, 0xea, 0x2f, 0x02, 0xf7, 0x1b, 0xd5, 0xfd, 0x41, 0x5b, 0xea, 0x58, 0xc7,
-	0xd5, 0xb1, 0x4f, 0xaa, 0xe3, 0xec, 0xab, 0xe3, 0x41, 0x37, 0x41, 0xc9, 0x85, 0x5c, 0x18, 0x29,
-	0xce, 0xa2, 0xff, 0x61, 0xad, 0x9b, 0x7b, 0x48, 0xb7, 0xee, 0x96, 0x6e, 0xaf, 0xa1, 0xf7, 0x56,
-	0x64, 0xba, 0xb1, 0x40, 0xd6, 0x62, 0x2b, 0x7b, 0x07, 0x4f, 0x8e, 0x35, 0x96, 0xc2, 0xd0, 0x17,
-	0xd0, 0x11, 0x45, 0xc2, 0x23, 0x81, 0x1d, 0xde, 0x19, 0x5d, 0x0e, 0xe2, 0x44, 0x0c, 0x9a, 0x3a,
-	0x96, 0xa0, 0xd1, 0x5f, 0x0b, 0x2e, 0x76, 0x8a, 0x34, 0x87, 0x6e, 0x65, 0x29, 0x7d, 0x66, 0x7a,
-	0x5b, 0x2e, 0xd0, 0x7f, 0xde, 0x82, 0xaa, 0x0c, 0xeb, 0x7d, 0xff, 0xfd, 0xe7, 0x87, 0x75, 0xc5,
-	0xa8, 0x39, 0xf6, 0x9d, 0x17, 0xf1, 0x86, 0xdc, 0xd2, 0x1c, 0xdc, 0xd2, 0xf2, 0x8a, 0xb5, 0xe5,
-	0x64, 0xfc, 0x83, 0xa8, 0x06, 0x69, 0xdf, 0x90, 0x3e, 0xba, 0xbd, 0x6a, 0x92, 0x0e, 0xbf, 0x09,
-	0xbe, 0xa1, 0x1a, 0x9c, 0x42, 0x4f, 0xca, 0xcc, 0xb8, 0x93, 0x9e, 0xf8, 0x37, 0x27, 0x31, 0x15,
-	0xe3, 0x8d, 0x61, 0xec, 0xd1, 0xc7, 0x87, 0x18, 0x4b, 0x13, 0x37, 0x9f, 0x5c, 0xf3, 0xbe, 0x5f,
-	0xfd, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x18, 0xa7, 0x22, 0x52, 0x19, 0x04, 0x00, 0x00,
+	// 743 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xcd, 0x4e, 0xdb, 0x4a,
+	0x14, 0x96, 0x9d, 0xff, 0xc3, 0x8f, 0xee, 0x9d, 0xcb, 0x85, 0x21, 0x17, 0x92, 0x60, 0x6e, 0x51,
+	0x84, 0x68, 0xa2, 0xc2, 0xa2, 0x52, 0x77, 0x08, 0xa8, 0x84, 0x54, 0x55, 0xad, 0x25, 0x1e, 0xc0,
+	0xc4, 0x27, 0x74, 0x5a, 0x67, 0xc6, 0x78, 0xc6, 0xb4, 0xa8, 0x62, 0xd3, 0xae, 0xba, 0xee, 0x53,
+	0xf4, 0x49, 0xfa, 0x00, 0xdd, 0x77, 0xd5, 0x77, 0xe8, 0xb6, 0xf2, 0x78, 0xf2, 0x87, 0xe3, 0x64,
+	0xc1, 0x2e, 0xe7, 0x6f, 0xbe, 0x33, 0xe7, 0xfb, 0xe6, 0x38, 0xf0, 0x8f, 0x2f, 0xde, 0xf3, 0x80,
+	0xf1, 0x77, 0xaf, 0x63, 0x8c, 0xb1, 0x13, 0x46, 0x42, 0x09, 0x52, 0xf0, 0x42, 0x56, 0xdf, 0xba,
+	0x12, 0xe2, 0x2a, 0xc0, 0xae, 0x17, 0xb2, 0xae, 0xc7, 0xb9, 0x50, 0x9e, 0x62, 0x82, 0xcb, 0x34,
+	0xc5, 0xf9, 0x66, 0x43, 0xf3, 0x8c, 0x5f, 0x27, 0x45, 0xa7, 0x93, 0x27, 0x9c, 0x2b, 0x1c, 0xb8,
+	0x78, 0x1d, 0xa3, 0x54, 0x64, 0x1d, 0xca, 0x3e, 0xde, 0x9c, 0x5d, 0x9c, 0x53, 0xab, 0x65, 0xb5,
+	0x6b, 0xae, 0xb1, 0xc8, 0x16, 0xd4, 0x22, 0xec, 0x63, 0x84, 0xbc, 0x87, 0xd4, 0xd6, 0xa1, 0xb1,
+	0x23, 0x89, 0xf6, 0x04, 0xef, 0xb3, 0x68, 0x80, 0x3e, 0x2d, 0xb4, 0xac, 0x76, 0xd5, 0x1d, 0x3b,
+	0xc8, 0x1a, 0x94, 0xfa, 0xaf, 0x44, 0xa4, 0x68, 0xb1, 0x65, 0xb5, 0x57, 0xdc, 0xd4, 0x20, 0x04,
+	0x8a, 0xbe, 0xa7, 0x3c, 0x5a, 0x6a, 0x59, 0xed, 0x65, 0x57, 0xff, 0x26, 0x0d, 0x00, 0x1f, 0x03,
+	0xef, 0xf6, 0x82, 0x2b, 0x16, 0xd0, 0xb2, 0x86, 0x99, 0xf0, 0x24, 0xf1, 0x81, 0xf7, 0xc1, 0x45,
+	0x15, 0x31, 0x94, 0xb4, 0xa2, 0x8f, 0x9b, 0xf0, 0x24, 0x7d, 0x20, 0xef, 0x45, 0xb7, 0xa1, 0x42,
+	0x9f, 0x56, 0xd3, 0x3e, 0x46, 0x8e, 0x04, 0xb1, 0x7f, 0xc2, 0x15, 0xad, 0xe9, 0x3a, 0xfd, 0x3b,
+	0xb9, 0xaf, 0xb8, 0x7c, 0x8b, 0x3d, 0x45, 0x21, 0xbd, 0x6f, 0x6a, 0x39, 0x0e, 0xb4, 0xf2, 0x47,
+	0x25, 0x43, 0xc1, 0x25, 0x3a, 0x4f, 0xa0, 0x79, 0x8a, 0x01, 0xaa, 0x71, 0x0a, 0xde, 0x1f, 0xe7,
+	0x2a, 0xd8, 0xcc, 0xd7, 0xa3, 0x2c, 0xb8, 0x36, 0xf3, 0x9d, 0x9d, 0x4c, 0x49, 0xe6, 0xd4, 0xef,
+	0x36, 0xfc, 0x9d, 0x89, 0xde, 0x3f, 0x68, 0x82, 0x27, 0x3b, 0x9f, 0xa7, 0xc2, 0x5c, 0x9e, 0x8a,
+	0xf7, 0x79, 0xa2, 0x50, 0x09, 0x91, 0xfb, 0x8c, 0x5f, 0x69, 0x52, 0xaa, 0xee, 0xd0, 0x1c, 0x33,
+	0x58, 0x9e, 0xc5, 0x60, 0x25, 0x97, 0xc1, 0xea, 0x02, 0x06, 0x6b, 0x19, 0x06, 0x29, 0x54, 0x22,
+	0x13, 0x04, 0x1d, 0x1c, 0x9a, 0xd3, 0xdc, 0x2e, 0xe5, 0x71, 0xbb, 0x3c, 0xe6, 0xd6, 0x79, 0x0a,
+	0xdb, 0x2f, 0x98, 0x54, 0x99, 0x61, 0xca, 0x05, 0x62, 0x77, 0x5e, 0x42, 0x23, 0xaf, 0x30, 0x25,
+	0x89, 0x1c, 0x40, 0x89, 0x25, 0x0e, 0x6a, 0xb5, 0x0a, 0xed, 0xa5, 0xc3, 0xf5, 0x8e, 0x17, 0xb2,
+	0x4e, 0x96, 0xd3, 0x34, 0xc9, 0x39, 0x82, 0xcd, 0xe7, 0x41, 0x2c, 0xdf, 0x4c, 0x25, 0x2c, 0x6a,
+	0x62, 0x0b, 0xea, 0xb3, 0x8a, 0x8c, 0x4a, 0x7e, 0x5a, 0xf0, 0xd7, 0x30, 0x72, 0x8a, 0x01, 0xbb,
+	0xc1, 0xe8, 0x36, 0x23, 0x92, 0x87, 0x3c, 0xda, 0x75, 0x28, 0x4b, 0xe5, 0xa9, 0x58, 0x6a, 0x9d,
+	0xd4, 0x5c, 0x63, 0x8d, 0x06, 0x5d, 0x9a, 0x7e, 0x44, 0x12, 0xb9, 0x3a, 0x56, 0xe6, 0xc9, 0x1a,
+	0x4b, 0x23, 0x44, 0xe8, 0x29, 0xf4, 0x8f, 0x95, 0x56, 0x49, 0xcd, 0x1d, 0x3b, 0x92, 0x68, 0x1c,
+	0xfa, 0x26, 0x9a, 0x2a, 0x65, 0xec, 0x70, 0x3e, 0x5b, 0xd3, 0xec, 0x99, 0x4b, 0x32, 0x94, 0x0f,
+	0x5b, 0x55, 0x6b, 0x50, 0x0a, 0xd8, 0x80, 0x29, 0x7d, 0xe3, 0x82, 0x9b, 0x1a, 0x7a, 0x0d, 0xf4,
+	0xfb, 0x12, 0xd3, 0x1d, 0x55, 0x70, 0x8d, 0xe5, 0x08, 0x68, 0xe4, 0x35, 0x61, 0x94, 0xd0, 0x00,
+	0x50, 0x42, 0x79, 0xc1, 0x89, 0x88, 0xb9, 0x32, 0xb3, 0x9f, 0xf0, 0x90, 0xc7, 0x50, 0x8e, 0x50,
+	0xc6, 0x81, 0xa2, 0xb6, 0x96, 0xca, 0xbf, 0x53, 0x52, 0x19, 0x52, 0xe7, 0x9a, 0xa4, 0xc3, 0xdf,
+	0x45, 0x58, 0x99, 0x62, 0x9c, 0xc4, 0x50, 0x31, 0x9b, 0x88, 0xfc, 0xaf, 0x6b, 0x17, 0xac, 0xf0,
+	0xfa, 0xa3, 0x05, 0x59, 0x46, 0x41, 0xdb, 0x9f, 0x7e, 0xfc, 0xfa, 0x6a, 0x6f, 0x38, 0x44, 0x7f,
+	0x2d, 0xa6, 0x3e, 0x29, 0xcf, 0xac, 0x7d, 0x12, 0x43, 0x39, 0xdd, 0x54, 0x06, 0x75, 0xc1, 0xa6,
+	0xab, 0xcf, 0xcc, 0xca, 0x80, 0x36, 0x35, 0xe8, 0xe6, 0xfe, 0x46, 0x16, 0xb4, 0xfb, 0x91, 0xf9,
+	0x77, 0x44, 0x41, 0x31, 0x19, 0x38, 0x71, 0xf4, 0x71, 0x73, 0x9f, 0x6f, 0x7d, 0x77, 0x6e, 0x8e,
+	0x41, 0xdc, 0xd5, 0x88, 0xdb, 0xe4, 0xbf, 0x59, 0x88, 0xa9, 0x62, 0xee, 0xc8, 0x0d, 0x94, 0xf4,
+	0x5b, 0x23, 0x0d, 0x7d, 0x64, 0xee, 0x63, 0xad, 0x37, 0x73, 0xe3, 0x06, 0xee, 0x40, 0xc3, 0xed,
+	0x39, 0x3b, 0x73, 0xe0, 0xba, 0xfd, 0xa4, 0x3e, 0x19, 0xf2, 0x17, 0x0b, 0x56, 0x75, 0xff, 0x23,
+	0x5d, 0xcd, 0xb8, 0x78, 0x46, 0xf9, 0xf5, 0xdd, 0xb9, 0x39, 0xa6, 0x93, 0x8e, 0xee, 0xa4, 0x4d,
+	0xf6, 0xe6, 0x75, 0xe2, 0x8f, 0xea, 0x2e, 0xcb, 0xfa, 0x4f, 0xc2, 0xd1, 0x9f, 0x01, 0x00, 0x58,
+	0xe5, 0x42, 0xa6, 0x5e, 0x08, 0x00, 0x00,
 }
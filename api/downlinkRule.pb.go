@@ -0,0 +1,748 @@
+// Code generated by protoc-gen-go.
+// source: downlinkRule.proto
+// DO NOT EDIT!
+
+package api
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import _ "github.com/grpc-ecosystem/grpc-gateway/third_party/googleapis/google/api"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+type CreateDownlinkRuleRequest struct {
+	// name of the rule (used as reference of the enqueued payloads)
+	Name string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	// hex encoded AppEUI of the application
+	AppEUI string `protobuf:"bytes,2,opt,name=appEUI" json:"appEUI,omitempty"`
+	// hex encoded DevEUI of the node (all the nodes of the application when empty)
+	DevEUI string `protobuf:"bytes,3,opt,name=devEUI" json:"devEUI,omitempty"`
+	// cron expression (minute, hour, day of month, month, day of week, e.g. 0 3 * * *)
+	Schedule string `protobuf:"bytes,4,opt,name=schedule" json:"schedule,omitempty"`
+	// IANA timezone of the schedule (e.g. Europe/Amsterdam, default UTC)
+	Timezone string `protobuf:"bytes,5,opt,name=timezone" json:"timezone,omitempty"`
+	// FPort to use (must be > 0)
+	FPort uint32 `protobuf:"varint,6,opt,name=fPort" json:"fPort,omitempty"`
+	// the payload must be sent as confirmed data down
+	Confirmed bool `protobuf:"varint,7,opt,name=confirmed" json:"confirmed,omitempty"`
+	// base64 encoded data (plaintext, will be encrypted by LoRa App Server)
+	Data []byte `protobuf:"bytes,8,opt,name=data,proto3" json:"data,omitempty"`
+	// JSON encoded object, encoded by the payload codec of the application (when data is empty)
+	Object string `protobuf:"bytes,9,opt,name=object" json:"object,omitempty"`
+}
+
+func (m *CreateDownlinkRuleRequest) Reset()                    { *m = CreateDownlinkRuleRequest{} }
+func (m *CreateDownlinkRuleRequest) String() string            { return proto.CompactTextString(m) }
+func (*CreateDownlinkRuleRequest) ProtoMessage()               {}
+func (*CreateDownlinkRuleRequest) Descriptor() ([]byte, []int) { return fileDescriptor26, []int{0} }
+
+func (m *CreateDownlinkRuleRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CreateDownlinkRuleRequest) GetAppEUI() string {
+	if m != nil {
+		return m.AppEUI
+	}
+	return ""
+}
+
+func (m *CreateDownlinkRuleRequest) GetDevEUI() string {
+	if m != nil {
+		return m.DevEUI
+	}
+	return ""
+}
+
+func (m *CreateDownlinkRuleRequest) GetSchedule() string {
+	if m != nil {
+		return m.Schedule
+	}
+	return ""
+}
+
+func (m *CreateDownlinkRuleRequest) GetTimezone() string {
+	if m != nil {
+		return m.Timezone
+	}
+	return ""
+}
+
+func (m *CreateDownlinkRuleRequest) GetFPort() uint32 {
+	if m != nil {
+		return m.FPort
+	}
+	return 0
+}
+
+func (m *CreateDownlinkRuleRequest) GetConfirmed() bool {
+	if m != nil {
+		return m.Confirmed
+	}
+	return false
+}
+
+func (m *CreateDownlinkRuleRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *CreateDownlinkRuleRequest) GetObject() string {
+	if m != nil {
+		return m.Object
+	}
+	return ""
+}
+
+type CreateDownlinkRuleResponse struct {
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *CreateDownlinkRuleResponse) Reset()                    { *m = CreateDownlinkRuleResponse{} }
+func (m *CreateDownlinkRuleResponse) String() string            { return proto.CompactTextString(m) }
+func (*CreateDownlinkRuleResponse) ProtoMessage()               {}
+func (*CreateDownlinkRuleResponse) Descriptor() ([]byte, []int) { return fileDescriptor26, []int{1} }
+
+func (m *CreateDownlinkRuleResponse) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type GetDownlinkRuleRequest struct {
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *GetDownlinkRuleRequest) Reset()                    { *m = GetDownlinkRuleRequest{} }
+func (m *GetDownlinkRuleRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetDownlinkRuleRequest) ProtoMessage()               {}
+func (*GetDownlinkRuleRequest) Descriptor() ([]byte, []int) { return fileDescriptor26, []int{2} }
+
+func (m *GetDownlinkRuleRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type GetDownlinkRuleResponse struct {
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	// name of the rule
+	Name string `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	// hex encoded AppEUI of the application
+	AppEUI string `protobuf:"bytes,3,opt,name=appEUI" json:"appEUI,omitempty"`
+	// hex encoded DevEUI of the node (empty for all the nodes of the application)
+	DevEUI string `protobuf:"bytes,4,opt,name=devEUI" json:"devEUI,omitempty"`
+	// cron expression
+	Schedule string `protobuf:"bytes,5,opt,name=schedule" json:"schedule,omitempty"`
+	// IANA timezone of the schedule
+	Timezone string `protobuf:"bytes,6,opt,name=timezone" json:"timezone,omitempty"`
+	// FPort
+	FPort uint32 `protobuf:"varint,7,opt,name=fPort" json:"fPort,omitempty"`
+	// the payload is sent as confirmed data down
+	Confirmed bool `protobuf:"varint,8,opt,name=confirmed" json:"confirmed,omitempty"`
+	// base64 encoded data
+	Data []byte `protobuf:"bytes,9,opt,name=data,proto3" json:"data,omitempty"`
+	// JSON encoded object
+	Object string `protobuf:"bytes,10,opt,name=object" json:"object,omitempty"`
+	// next run (RFC3339 timestamp)
+	NextRunAt string `protobuf:"bytes,11,opt,name=nextRunAt" json:"nextRunAt,omitempty"`
+	// last run (RFC3339 timestamp, empty when never run)
+	LastRunAt string `protobuf:"bytes,12,opt,name=lastRunAt" json:"lastRunAt,omitempty"`
+}
+
+func (m *GetDownlinkRuleResponse) Reset()                    { *m = GetDownlinkRuleResponse{} }
+func (m *GetDownlinkRuleResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetDownlinkRuleResponse) ProtoMessage()               {}
+func (*GetDownlinkRuleResponse) Descriptor() ([]byte, []int) { return fileDescriptor26, []int{3} }
+
+func (m *GetDownlinkRuleResponse) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *GetDownlinkRuleResponse) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *GetDownlinkRuleResponse) GetAppEUI() string {
+	if m != nil {
+		return m.AppEUI
+	}
+	return ""
+}
+
+func (m *GetDownlinkRuleResponse) GetDevEUI() string {
+	if m != nil {
+		return m.DevEUI
+	}
+	return ""
+}
+
+func (m *GetDownlinkRuleResponse) GetSchedule() string {
+	if m != nil {
+		return m.Schedule
+	}
+	return ""
+}
+
+func (m *GetDownlinkRuleResponse) GetTimezone() string {
+	if m != nil {
+		return m.Timezone
+	}
+	return ""
+}
+
+func (m *GetDownlinkRuleResponse) GetFPort() uint32 {
+	if m != nil {
+		return m.FPort
+	}
+	return 0
+}
+
+func (m *GetDownlinkRuleResponse) GetConfirmed() bool {
+	if m != nil {
+		return m.Confirmed
+	}
+	return false
+}
+
+func (m *GetDownlinkRuleResponse) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *GetDownlinkRuleResponse) GetObject() string {
+	if m != nil {
+		return m.Object
+	}
+	return ""
+}
+
+func (m *GetDownlinkRuleResponse) GetNextRunAt() string {
+	if m != nil {
+		return m.NextRunAt
+	}
+	return ""
+}
+
+func (m *GetDownlinkRuleResponse) GetLastRunAt() string {
+	if m != nil {
+		return m.LastRunAt
+	}
+	return ""
+}
+
+type ListDownlinkRuleByAppEUIRequest struct {
+	// hex encoded AppEUI of the application
+	AppEUI string `protobuf:"bytes,1,opt,name=appEUI" json:"appEUI,omitempty"`
+}
+
+func (m *ListDownlinkRuleByAppEUIRequest) Reset()         { *m = ListDownlinkRuleByAppEUIRequest{} }
+func (m *ListDownlinkRuleByAppEUIRequest) String() string { return proto.CompactTextString(m) }
+func (*ListDownlinkRuleByAppEUIRequest) ProtoMessage()    {}
+func (*ListDownlinkRuleByAppEUIRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor26, []int{4}
+}
+
+func (m *ListDownlinkRuleByAppEUIRequest) GetAppEUI() string {
+	if m != nil {
+		return m.AppEUI
+	}
+	return ""
+}
+
+type ListDownlinkRuleByDevEUIRequest struct {
+	// hex encoded DevEUI of the node
+	DevEUI string `protobuf:"bytes,1,opt,name=devEUI" json:"devEUI,omitempty"`
+}
+
+func (m *ListDownlinkRuleByDevEUIRequest) Reset()         { *m = ListDownlinkRuleByDevEUIRequest{} }
+func (m *ListDownlinkRuleByDevEUIRequest) String() string { return proto.CompactTextString(m) }
+func (*ListDownlinkRuleByDevEUIRequest) ProtoMessage()    {}
+func (*ListDownlinkRuleByDevEUIRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor26, []int{5}
+}
+
+func (m *ListDownlinkRuleByDevEUIRequest) GetDevEUI() string {
+	if m != nil {
+		return m.DevEUI
+	}
+	return ""
+}
+
+type ListDownlinkRuleResponse struct {
+	Result []*GetDownlinkRuleResponse `protobuf:"bytes,1,rep,name=result" json:"result,omitempty"`
+}
+
+func (m *ListDownlinkRuleResponse) Reset()                    { *m = ListDownlinkRuleResponse{} }
+func (m *ListDownlinkRuleResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListDownlinkRuleResponse) ProtoMessage()               {}
+func (*ListDownlinkRuleResponse) Descriptor() ([]byte, []int) { return fileDescriptor26, []int{6} }
+
+func (m *ListDownlinkRuleResponse) GetResult() []*GetDownlinkRuleResponse {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type UpdateDownlinkRuleRequest struct {
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	// name of the rule (used as reference of the enqueued payloads)
+	Name string `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	// hex encoded AppEUI of the application
+	AppEUI string `protobuf:"bytes,3,opt,name=appEUI" json:"appEUI,omitempty"`
+	// hex encoded DevEUI of the node (all the nodes of the application when empty)
+	DevEUI string `protobuf:"bytes,4,opt,name=devEUI" json:"devEUI,omitempty"`
+	// cron expression (minute, hour, day of month, month, day of week, e.g. 0 3 * * *)
+	Schedule string `protobuf:"bytes,5,opt,name=schedule" json:"schedule,omitempty"`
+	// IANA timezone of the schedule (e.g. Europe/Amsterdam, default UTC)
+	Timezone string `protobuf:"bytes,6,opt,name=timezone" json:"timezone,omitempty"`
+	// FPort to use (must be > 0)
+	FPort uint32 `protobuf:"varint,7,opt,name=fPort" json:"fPort,omitempty"`
+	// the payload must be sent as confirmed data down
+	Confirmed bool `protobuf:"varint,8,opt,name=confirmed" json:"confirmed,omitempty"`
+	// base64 encoded data (plaintext, will be encrypted by LoRa App Server)
+	Data []byte `protobuf:"bytes,9,opt,name=data,proto3" json:"data,omitempty"`
+	// JSON encoded object, encoded by the payload codec of the application (when data is empty)
+	Object string `protobuf:"bytes,10,opt,name=object" json:"object,omitempty"`
+}
+
+func (m *UpdateDownlinkRuleRequest) Reset()                    { *m = UpdateDownlinkRuleRequest{} }
+func (m *UpdateDownlinkRuleRequest) String() string            { return proto.CompactTextString(m) }
+func (*UpdateDownlinkRuleRequest) ProtoMessage()               {}
+func (*UpdateDownlinkRuleRequest) Descriptor() ([]byte, []int) { return fileDescriptor26, []int{7} }
+
+func (m *UpdateDownlinkRuleRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *UpdateDownlinkRuleRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *UpdateDownlinkRuleRequest) GetAppEUI() string {
+	if m != nil {
+		return m.AppEUI
+	}
+	return ""
+}
+
+func (m *UpdateDownlinkRuleRequest) GetDevEUI() string {
+	if m != nil {
+		return m.DevEUI
+	}
+	return ""
+}
+
+func (m *UpdateDownlinkRuleRequest) GetSchedule() string {
+	if m != nil {
+		return m.Schedule
+	}
+	return ""
+}
+
+func (m *UpdateDownlinkRuleRequest) GetTimezone() string {
+	if m != nil {
+		return m.Timezone
+	}
+	return ""
+}
+
+func (m *UpdateDownlinkRuleRequest) GetFPort() uint32 {
+	if m != nil {
+		return m.FPort
+	}
+	return 0
+}
+
+func (m *UpdateDownlinkRuleRequest) GetConfirmed() bool {
+	if m != nil {
+		return m.Confirmed
+	}
+	return false
+}
+
+func (m *UpdateDownlinkRuleRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *UpdateDownlinkRuleRequest) GetObject() string {
+	if m != nil {
+		return m.Object
+	}
+	return ""
+}
+
+type UpdateDownlinkRuleResponse struct {
+}
+
+func (m *UpdateDownlinkRuleResponse) Reset()                    { *m = UpdateDownlinkRuleResponse{} }
+func (m *UpdateDownlinkRuleResponse) String() string            { return proto.CompactTextString(m) }
+func (*UpdateDownlinkRuleResponse) ProtoMessage()               {}
+func (*UpdateDownlinkRuleResponse) Descriptor() ([]byte, []int) { return fileDescriptor26, []int{8} }
+
+type DeleteDownlinkRuleRequest struct {
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *DeleteDownlinkRuleRequest) Reset()                    { *m = DeleteDownlinkRuleRequest{} }
+func (m *DeleteDownlinkRuleRequest) String() string            { return proto.CompactTextString(m) }
+func (*DeleteDownlinkRuleRequest) ProtoMessage()               {}
+func (*DeleteDownlinkRuleRequest) Descriptor() ([]byte, []int) { return fileDescriptor26, []int{9} }
+
+func (m *DeleteDownlinkRuleRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type DeleteDownlinkRuleResponse struct {
+}
+
+func (m *DeleteDownlinkRuleResponse) Reset()                    { *m = DeleteDownlinkRuleResponse{} }
+func (m *DeleteDownlinkRuleResponse) String() string            { return proto.CompactTextString(m) }
+func (*DeleteDownlinkRuleResponse) ProtoMessage()               {}
+func (*DeleteDownlinkRuleResponse) Descriptor() ([]byte, []int) { return fileDescriptor26, []int{10} }
+
+func init() {
+	proto.RegisterType((*CreateDownlinkRuleRequest)(nil), "api.CreateDownlinkRuleRequest")
+	proto.RegisterType((*CreateDownlinkRuleResponse)(nil), "api.CreateDownlinkRuleResponse")
+	proto.RegisterType((*GetDownlinkRuleRequest)(nil), "api.GetDownlinkRuleRequest")
+	proto.RegisterType((*GetDownlinkRuleResponse)(nil), "api.GetDownlinkRuleResponse")
+	proto.RegisterType((*ListDownlinkRuleByAppEUIRequest)(nil), "api.ListDownlinkRuleByAppEUIRequest")
+	proto.RegisterType((*ListDownlinkRuleByDevEUIRequest)(nil), "api.ListDownlinkRuleByDevEUIRequest")
+	proto.RegisterType((*ListDownlinkRuleResponse)(nil), "api.ListDownlinkRuleResponse")
+	proto.RegisterType((*UpdateDownlinkRuleRequest)(nil), "api.UpdateDownlinkRuleRequest")
+	proto.RegisterType((*UpdateDownlinkRuleResponse)(nil), "api.UpdateDownlinkRuleResponse")
+	proto.RegisterType((*DeleteDownlinkRuleRequest)(nil), "api.DeleteDownlinkRuleRequest")
+	proto.RegisterType((*DeleteDownlinkRuleResponse)(nil), "api.DeleteDownlinkRuleResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for DownlinkRule service
+
+type DownlinkRuleClient interface {
+	// Create creates the given downlink rule.
+	Create(ctx context.Context, in *CreateDownlinkRuleRequest, opts ...grpc.CallOption) (*CreateDownlinkRuleResponse, error)
+	// Get returns the downlink rule matching the given id.
+	Get(ctx context.Context, in *GetDownlinkRuleRequest, opts ...grpc.CallOption) (*GetDownlinkRuleResponse, error)
+	// ListByAppEUI lists the downlink rules of the given application, including the rules of its nodes.
+	ListByAppEUI(ctx context.Context, in *ListDownlinkRuleByAppEUIRequest, opts ...grpc.CallOption) (*ListDownlinkRuleResponse, error)
+	// ListByDevEUI lists the downlink rules of the given node.
+	ListByDevEUI(ctx context.Context, in *ListDownlinkRuleByDevEUIRequest, opts ...grpc.CallOption) (*ListDownlinkRuleResponse, error)
+	// Update updates the downlink rule matching the given id.
+	Update(ctx context.Context, in *UpdateDownlinkRuleRequest, opts ...grpc.CallOption) (*UpdateDownlinkRuleResponse, error)
+	// Delete deletes the downlink rule matching the given id.
+	Delete(ctx context.Context, in *DeleteDownlinkRuleRequest, opts ...grpc.CallOption) (*DeleteDownlinkRuleResponse, error)
+}
+
+type downlinkRuleClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewDownlinkRuleClient(cc *grpc.ClientConn) DownlinkRuleClient {
+	return &downlinkRuleClient{cc}
+}
+
+func (c *downlinkRuleClient) Create(ctx context.Context, in *CreateDownlinkRuleRequest, opts ...grpc.CallOption) (*CreateDownlinkRuleResponse, error) {
+	out := new(CreateDownlinkRuleResponse)
+	err := grpc.Invoke(ctx, "/api.DownlinkRule/Create", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *downlinkRuleClient) Get(ctx context.Context, in *GetDownlinkRuleRequest, opts ...grpc.CallOption) (*GetDownlinkRuleResponse, error) {
+	out := new(GetDownlinkRuleResponse)
+	err := grpc.Invoke(ctx, "/api.DownlinkRule/Get", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *downlinkRuleClient) ListByAppEUI(ctx context.Context, in *ListDownlinkRuleByAppEUIRequest, opts ...grpc.CallOption) (*ListDownlinkRuleResponse, error) {
+	out := new(ListDownlinkRuleResponse)
+	err := grpc.Invoke(ctx, "/api.DownlinkRule/ListByAppEUI", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *downlinkRuleClient) ListByDevEUI(ctx context.Context, in *ListDownlinkRuleByDevEUIRequest, opts ...grpc.CallOption) (*ListDownlinkRuleResponse, error) {
+	out := new(ListDownlinkRuleResponse)
+	err := grpc.Invoke(ctx, "/api.DownlinkRule/ListByDevEUI", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *downlinkRuleClient) Update(ctx context.Context, in *UpdateDownlinkRuleRequest, opts ...grpc.CallOption) (*UpdateDownlinkRuleResponse, error) {
+	out := new(UpdateDownlinkRuleResponse)
+	err := grpc.Invoke(ctx, "/api.DownlinkRule/Update", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *downlinkRuleClient) Delete(ctx context.Context, in *DeleteDownlinkRuleRequest, opts ...grpc.CallOption) (*DeleteDownlinkRuleResponse, error) {
+	out := new(DeleteDownlinkRuleResponse)
+	err := grpc.Invoke(ctx, "/api.DownlinkRule/Delete", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for DownlinkRule service
+
+type DownlinkRuleServer interface {
+	// Create creates the given downlink rule.
+	Create(context.Context, *CreateDownlinkRuleRequest) (*CreateDownlinkRuleResponse, error)
+	// Get returns the downlink rule matching the given id.
+	Get(context.Context, *GetDownlinkRuleRequest) (*GetDownlinkRuleResponse, error)
+	// ListByAppEUI lists the downlink rules of the given application, including the rules of its nodes.
+	ListByAppEUI(context.Context, *ListDownlinkRuleByAppEUIRequest) (*ListDownlinkRuleResponse, error)
+	// ListByDevEUI lists the downlink rules of the given node.
+	ListByDevEUI(context.Context, *ListDownlinkRuleByDevEUIRequest) (*ListDownlinkRuleResponse, error)
+	// Update updates the downlink rule matching the given id.
+	Update(context.Context, *UpdateDownlinkRuleRequest) (*UpdateDownlinkRuleResponse, error)
+	// Delete deletes the downlink rule matching the given id.
+	Delete(context.Context, *DeleteDownlinkRuleRequest) (*DeleteDownlinkRuleResponse, error)
+}
+
+func RegisterDownlinkRuleServer(s *grpc.Server, srv DownlinkRuleServer) {
+	s.RegisterService(&_DownlinkRule_serviceDesc, srv)
+}
+
+func _DownlinkRule_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDownlinkRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DownlinkRuleServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DownlinkRule/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DownlinkRuleServer).Create(ctx, req.(*CreateDownlinkRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DownlinkRule_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDownlinkRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DownlinkRuleServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DownlinkRule/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DownlinkRuleServer).Get(ctx, req.(*GetDownlinkRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DownlinkRule_ListByAppEUI_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDownlinkRuleByAppEUIRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DownlinkRuleServer).ListByAppEUI(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DownlinkRule/ListByAppEUI",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DownlinkRuleServer).ListByAppEUI(ctx, req.(*ListDownlinkRuleByAppEUIRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DownlinkRule_ListByDevEUI_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDownlinkRuleByDevEUIRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DownlinkRuleServer).ListByDevEUI(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DownlinkRule/ListByDevEUI",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DownlinkRuleServer).ListByDevEUI(ctx, req.(*ListDownlinkRuleByDevEUIRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DownlinkRule_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDownlinkRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DownlinkRuleServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DownlinkRule/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DownlinkRuleServer).Update(ctx, req.(*UpdateDownlinkRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DownlinkRule_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDownlinkRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DownlinkRuleServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DownlinkRule/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DownlinkRuleServer).Delete(ctx, req.(*DeleteDownlinkRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _DownlinkRule_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.DownlinkRule",
+	HandlerType: (*DownlinkRuleServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _DownlinkRule_Create_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _DownlinkRule_Get_Handler,
+		},
+		{
+			MethodName: "ListByAppEUI",
+			Handler:    _DownlinkRule_ListByAppEUI_Handler,
+		},
+		{
+			MethodName: "ListByDevEUI",
+			Handler:    _DownlinkRule_ListByDevEUI_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _DownlinkRule_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _DownlinkRule_Delete_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "downlinkRule.proto",
+}
+
+func init() { proto.RegisterFile("downlinkRule.proto", fileDescriptor26) }
+
+var fileDescriptor26 = []byte{
+	// 627 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x95, 0xcf, 0x6e, 0xd3, 0x4e,
+	0x10, 0xc7, 0x65, 0xbb, 0x71, 0x93, 0x69, 0x7e, 0xbf, 0xc3, 0x0a, 0xb5, 0x8e, 0x49, 0x89, 0xb5,
+	0x42, 0xc2, 0x14, 0x54, 0x4b, 0x85, 0x0b, 0xdc, 0x0a, 0x45, 0x15, 0x12, 0x87, 0xca, 0x52, 0x1f,
+	0x60, 0x1b, 0x6f, 0xdb, 0x2d, 0xce, 0xae, 0x89, 0x37, 0xe5, 0x4f, 0x94, 0x0b, 0x42, 0xe2, 0x01,
+	0x78, 0x1d, 0x2e, 0x3c, 0x03, 0xaf, 0xc0, 0x3b, 0x70, 0x45, 0xde, 0x75, 0x12, 0xa7, 0xdd, 0x6d,
+	0xb9, 0x73, 0xf3, 0xcc, 0x77, 0x76, 0x67, 0xfc, 0x99, 0x19, 0x1b, 0x50, 0x26, 0xde, 0xf3, 0x9c,
+	0xf1, 0xb7, 0xe9, 0x24, 0xa7, 0xbb, 0xc5, 0x58, 0x48, 0x81, 0x3c, 0x52, 0xb0, 0xb0, 0x7f, 0x26,
+	0xc4, 0x59, 0x4e, 0x13, 0x52, 0xb0, 0x84, 0x70, 0x2e, 0x24, 0x91, 0x4c, 0xf0, 0x52, 0x87, 0xe0,
+	0xdf, 0x0e, 0xf4, 0x5e, 0x8e, 0x29, 0x91, 0xf4, 0xa0, 0x71, 0x3e, 0xa5, 0xef, 0x26, 0xb4, 0x94,
+	0x08, 0xc1, 0x1a, 0x27, 0x23, 0x1a, 0x38, 0x91, 0x13, 0x77, 0x52, 0xf5, 0x8c, 0x36, 0xc1, 0x27,
+	0x45, 0xf1, 0xea, 0xf8, 0x75, 0xe0, 0x2a, 0x6f, 0x6d, 0x55, 0xfe, 0x8c, 0x5e, 0x56, 0x7e, 0x4f,
+	0xfb, 0xb5, 0x85, 0x42, 0x68, 0x97, 0xc3, 0x73, 0x9a, 0x4d, 0x72, 0x1a, 0xac, 0x29, 0x65, 0x61,
+	0x57, 0x9a, 0x64, 0x23, 0xfa, 0x49, 0x70, 0x1a, 0xb4, 0xb4, 0x36, 0xb7, 0xd1, 0x1d, 0x68, 0x9d,
+	0x1e, 0x89, 0xb1, 0x0c, 0xfc, 0xc8, 0x89, 0xff, 0x4b, 0xb5, 0x81, 0xfa, 0xd0, 0x19, 0x0a, 0x7e,
+	0xca, 0xc6, 0x23, 0x9a, 0x05, 0xeb, 0x91, 0x13, 0xb7, 0xd3, 0xa5, 0xa3, 0xaa, 0x37, 0x23, 0x92,
+	0x04, 0xed, 0xc8, 0x89, 0xbb, 0xa9, 0x7a, 0xae, 0xea, 0x12, 0x27, 0x17, 0x74, 0x28, 0x83, 0x8e,
+	0xae, 0x4b, 0x5b, 0xf8, 0x31, 0x84, 0xa6, 0x17, 0x2f, 0x0b, 0xc1, 0x4b, 0x8a, 0xfe, 0x07, 0x97,
+	0x65, 0xea, 0xbd, 0xbd, 0xd4, 0x65, 0x19, 0x8e, 0x61, 0xf3, 0x90, 0x4a, 0x13, 0xa3, 0xab, 0x91,
+	0x3f, 0x5c, 0xd8, 0xba, 0x16, 0x6a, 0xbe, 0x75, 0xc1, 0xd7, 0x35, 0xf2, 0xf5, 0x2c, 0x7c, 0xd7,
+	0xac, 0x7c, 0x5b, 0x37, 0xf0, 0xf5, 0x6d, 0x7c, 0xd7, 0xad, 0x7c, 0xdb, 0x36, 0xbe, 0x1d, 0x23,
+	0x5f, 0x68, 0xf2, 0xad, 0x6e, 0xe2, 0xf4, 0x83, 0x4c, 0x27, 0x7c, 0x5f, 0x06, 0x1b, 0x4a, 0x5a,
+	0x3a, 0x2a, 0x35, 0x27, 0x65, 0xad, 0x76, 0xb5, 0xba, 0x70, 0xe0, 0x67, 0x30, 0x78, 0xc3, 0xca,
+	0x15, 0x86, 0x2f, 0x3e, 0xee, 0x2b, 0x0e, 0x73, 0xec, 0x4b, 0x4c, 0x4e, 0x13, 0x93, 0xf9, 0xe8,
+	0x01, 0xbd, 0x5c, 0x3d, 0x5a, 0x93, 0x74, 0x9a, 0x24, 0xf1, 0x11, 0x04, 0x57, 0x8f, 0x2e, 0x3a,
+	0xf7, 0x14, 0xfc, 0x31, 0x2d, 0x27, 0xb9, 0x0c, 0x9c, 0xc8, 0x8b, 0x37, 0xf6, 0xfa, 0xbb, 0xa4,
+	0x60, 0xbb, 0x96, 0x3e, 0xa7, 0x75, 0x2c, 0xfe, 0xea, 0x42, 0xef, 0xb8, 0xc8, 0x2c, 0xdb, 0xf5,
+	0x0f, 0x4d, 0x03, 0xee, 0x43, 0x68, 0x02, 0xa1, 0x79, 0xe1, 0x47, 0xd0, 0x3b, 0xa0, 0x39, 0xfd,
+	0x2b, 0x4c, 0xd5, 0x55, 0xa6, 0x60, 0x7d, 0xd5, 0xde, 0xf7, 0x16, 0x74, 0x9b, 0x02, 0x3a, 0x07,
+	0x5f, 0xef, 0x39, 0xba, 0xa7, 0x7a, 0x66, 0xfd, 0xda, 0x85, 0x03, 0xab, 0x5e, 0x97, 0xb9, 0xfd,
+	0xf9, 0xe7, 0xaf, 0x6f, 0xee, 0x16, 0x46, 0xea, 0x63, 0xda, 0xfc, 0xe0, 0x96, 0xcf, 0x9d, 0x1d,
+	0x44, 0xc0, 0x3b, 0xa4, 0x12, 0xdd, 0x35, 0x8f, 0x86, 0xce, 0x71, 0xe3, 0xdc, 0xe0, 0x81, 0x4a,
+	0xd0, 0x43, 0x5b, 0xd7, 0x13, 0x24, 0x53, 0x96, 0xcd, 0xd0, 0x17, 0x07, 0xba, 0xd5, 0x8c, 0xce,
+	0xb7, 0x01, 0xdd, 0x57, 0xf7, 0xdd, 0xb2, 0x2c, 0xe1, 0xb6, 0x31, 0x6a, 0x91, 0x36, 0x51, 0x69,
+	0x1f, 0xa2, 0x07, 0x86, 0xb4, 0xa4, 0x28, 0x72, 0x36, 0x54, 0xff, 0x8c, 0x64, 0xaa, 0x87, 0x6f,
+	0x86, 0x66, 0xf3, 0x2a, 0xf4, 0x62, 0x59, 0xab, 0x58, 0xd9, 0xbb, 0xdb, 0xaa, 0x88, 0x55, 0x15,
+	0x18, 0x45, 0x86, 0x2a, 0xb8, 0xc8, 0x68, 0x32, 0xd5, 0x33, 0x3e, 0x43, 0x23, 0xf0, 0xf5, 0x30,
+	0xd5, 0x2d, 0xb5, 0xae, 0x58, 0x38, 0xb0, 0xea, 0x75, 0x52, 0xac, 0x92, 0xf6, 0x43, 0x1b, 0xf1,
+	0xaa, 0xaf, 0x17, 0xe0, 0xeb, 0x81, 0xab, 0xd3, 0x59, 0x47, 0x35, 0x1c, 0x58, 0xf5, 0xd5, 0x06,
+	0xef, 0xd8, 0xd2, 0x9d, 0xf8, 0xea, 0xb7, 0xfc, 0xe4, 0xcf, 0x00, 0xf0, 0x75, 0x58, 0xb8, 0xcf,
+	0x07, 0x00, 0x00,
+}
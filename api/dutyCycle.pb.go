@@ -0,0 +1,271 @@
+// Code generated by protoc-gen-go.
+// source: dutyCycle.proto
+// DO NOT EDIT!
+
+package api
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import _ "github.com/grpc-ecosystem/grpc-gateway/third_party/googleapis/google/api"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+type GetGatewayDutyCycleRequest struct {
+	// hex encoded MAC of the gateway
+	Mac string `protobuf:"bytes,1,opt,name=mac" json:"mac,omitempty"`
+	// start of the period (RFC3339)
+	Start string `protobuf:"bytes,2,opt,name=start" json:"start,omitempty"`
+	// end of the period (RFC3339, default now)
+	End string `protobuf:"bytes,3,opt,name=end" json:"end,omitempty"`
+	// aggregation interval
+	Interval AggregationInterval `protobuf:"varint,4,opt,name=interval,enum=api.AggregationInterval" json:"interval,omitempty"`
+}
+
+func (m *GetGatewayDutyCycleRequest) Reset()                    { *m = GetGatewayDutyCycleRequest{} }
+func (m *GetGatewayDutyCycleRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetGatewayDutyCycleRequest) ProtoMessage()               {}
+func (*GetGatewayDutyCycleRequest) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{0} }
+
+func (m *GetGatewayDutyCycleRequest) GetMac() string {
+	if m != nil {
+		return m.Mac
+	}
+	return ""
+}
+
+func (m *GetGatewayDutyCycleRequest) GetStart() string {
+	if m != nil {
+		return m.Start
+	}
+	return ""
+}
+
+func (m *GetGatewayDutyCycleRequest) GetEnd() string {
+	if m != nil {
+		return m.End
+	}
+	return ""
+}
+
+func (m *GetGatewayDutyCycleRequest) GetInterval() AggregationInterval {
+	if m != nil {
+		return m.Interval
+	}
+	return AggregationInterval_PERIOD
+}
+
+type SubBandUtilization struct {
+	// start of the aggregation interval (RFC3339)
+	Timestamp string `protobuf:"bytes,1,opt,name=timestamp" json:"timestamp,omitempty"`
+	// name of the sub-band (empty when the frequency is outside the known sub-bands)
+	SubBand string `protobuf:"bytes,2,opt,name=subBand" json:"subBand,omitempty"`
+	// min. frequency of the sub-band (Hz)
+	MinFrequency uint32 `protobuf:"varint,3,opt,name=minFrequency" json:"minFrequency,omitempty"`
+	// max. frequency of the sub-band (Hz)
+	MaxFrequency uint32 `protobuf:"varint,4,opt,name=maxFrequency" json:"maxFrequency,omitempty"`
+	// duty-cycle limit of the sub-band (fraction)
+	DutyCycle float64 `protobuf:"fixed64,5,opt,name=dutyCycle" json:"dutyCycle,omitempty"`
+	// total downlink airtime (ms)
+	Airtime uint32 `protobuf:"varint,6,opt,name=airtime" json:"airtime,omitempty"`
+	// duty-cycle utilization (fraction)
+	Utilization float64 `protobuf:"fixed64,7,opt,name=utilization" json:"utilization,omitempty"`
+	// utilization is approaching the duty-cycle limit
+	Warning bool `protobuf:"varint,8,opt,name=warning" json:"warning,omitempty"`
+}
+
+func (m *SubBandUtilization) Reset()                    { *m = SubBandUtilization{} }
+func (m *SubBandUtilization) String() string            { return proto.CompactTextString(m) }
+func (*SubBandUtilization) ProtoMessage()               {}
+func (*SubBandUtilization) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{1} }
+
+func (m *SubBandUtilization) GetTimestamp() string {
+	if m != nil {
+		return m.Timestamp
+	}
+	return ""
+}
+
+func (m *SubBandUtilization) GetSubBand() string {
+	if m != nil {
+		return m.SubBand
+	}
+	return ""
+}
+
+func (m *SubBandUtilization) GetMinFrequency() uint32 {
+	if m != nil {
+		return m.MinFrequency
+	}
+	return 0
+}
+
+func (m *SubBandUtilization) GetMaxFrequency() uint32 {
+	if m != nil {
+		return m.MaxFrequency
+	}
+	return 0
+}
+
+func (m *SubBandUtilization) GetDutyCycle() float64 {
+	if m != nil {
+		return m.DutyCycle
+	}
+	return 0
+}
+
+func (m *SubBandUtilization) GetAirtime() uint32 {
+	if m != nil {
+		return m.Airtime
+	}
+	return 0
+}
+
+func (m *SubBandUtilization) GetUtilization() float64 {
+	if m != nil {
+		return m.Utilization
+	}
+	return 0
+}
+
+func (m *SubBandUtilization) GetWarning() bool {
+	if m != nil {
+		return m.Warning
+	}
+	return false
+}
+
+type GetGatewayDutyCycleResponse struct {
+	Result []*SubBandUtilization `protobuf:"bytes,1,rep,name=result" json:"result,omitempty"`
+}
+
+func (m *GetGatewayDutyCycleResponse) Reset()                    { *m = GetGatewayDutyCycleResponse{} }
+func (m *GetGatewayDutyCycleResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetGatewayDutyCycleResponse) ProtoMessage()               {}
+func (*GetGatewayDutyCycleResponse) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{2} }
+
+func (m *GetGatewayDutyCycleResponse) GetResult() []*SubBandUtilization {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*GetGatewayDutyCycleRequest)(nil), "api.GetGatewayDutyCycleRequest")
+	proto.RegisterType((*SubBandUtilization)(nil), "api.SubBandUtilization")
+	proto.RegisterType((*GetGatewayDutyCycleResponse)(nil), "api.GetGatewayDutyCycleResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for DutyCycle service
+
+type DutyCycleClient interface {
+	// GetGatewayDutyCycle returns the downlink airtime and duty-cycle utilization per sub-band of the given gateway.
+	GetGatewayDutyCycle(ctx context.Context, in *GetGatewayDutyCycleRequest, opts ...grpc.CallOption) (*GetGatewayDutyCycleResponse, error)
+}
+
+type dutyCycleClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewDutyCycleClient(cc *grpc.ClientConn) DutyCycleClient {
+	return &dutyCycleClient{cc}
+}
+
+func (c *dutyCycleClient) GetGatewayDutyCycle(ctx context.Context, in *GetGatewayDutyCycleRequest, opts ...grpc.CallOption) (*GetGatewayDutyCycleResponse, error) {
+	out := new(GetGatewayDutyCycleResponse)
+	err := grpc.Invoke(ctx, "/api.DutyCycle/GetGatewayDutyCycle", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for DutyCycle service
+
+type DutyCycleServer interface {
+	// GetGatewayDutyCycle returns the downlink airtime and duty-cycle utilization per sub-band of the given gateway.
+	GetGatewayDutyCycle(context.Context, *GetGatewayDutyCycleRequest) (*GetGatewayDutyCycleResponse, error)
+}
+
+func RegisterDutyCycleServer(s *grpc.Server, srv DutyCycleServer) {
+	s.RegisterService(&_DutyCycle_serviceDesc, srv)
+}
+
+func _DutyCycle_GetGatewayDutyCycle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGatewayDutyCycleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DutyCycleServer).GetGatewayDutyCycle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DutyCycle/GetGatewayDutyCycle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DutyCycleServer).GetGatewayDutyCycle(ctx, req.(*GetGatewayDutyCycleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _DutyCycle_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.DutyCycle",
+	HandlerType: (*DutyCycleServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetGatewayDutyCycle",
+			Handler:    _DutyCycle_GetGatewayDutyCycle_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "dutyCycle.proto",
+}
+
+func init() { proto.RegisterFile("dutyCycle.proto", fileDescriptor9) }
+
+var fileDescriptor9 = []byte{
+	// 397 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x4b, 0x6e, 0xdb, 0x30,
+	0x10, 0x86, 0x41, 0xcb, 0x4f, 0xba, 0xad, 0x0b, 0xb6, 0x40, 0x09, 0xd5, 0x68, 0x05, 0xa1, 0x0b,
+	0xad, 0x2c, 0xc0, 0xed, 0x05, 0xfa, 0x40, 0x8d, 0x6e, 0xba, 0x50, 0xd1, 0x03, 0x8c, 0x25, 0x42,
+	0x20, 0x20, 0x51, 0x0a, 0x49, 0xc5, 0x51, 0x82, 0x64, 0x91, 0x55, 0x80, 0x64, 0x97, 0xa3, 0xe5,
+	0x0a, 0x39, 0x48, 0x20, 0xea, 0xe5, 0x20, 0xf6, 0x4e, 0xf3, 0xeb, 0xfb, 0x87, 0x3f, 0x87, 0x83,
+	0x17, 0x51, 0xa1, 0xcb, 0x9f, 0x65, 0x98, 0xb0, 0x55, 0x2e, 0x33, 0x9d, 0x11, 0x0b, 0x72, 0x6e,
+	0x2f, 0xe3, 0x2c, 0x8b, 0x13, 0xe6, 0x43, 0xce, 0x7d, 0x10, 0x22, 0xd3, 0xa0, 0x79, 0x26, 0x54,
+	0x8d, 0xd8, 0x0b, 0x10, 0x90, 0x94, 0x9a, 0x87, 0x8d, 0xe0, 0xde, 0x21, 0x6c, 0x6f, 0x98, 0xde,
+	0x80, 0x66, 0x3b, 0x28, 0x7f, 0xb5, 0x1d, 0x03, 0x76, 0x52, 0x30, 0xa5, 0xc9, 0x5b, 0x6c, 0xa5,
+	0x10, 0x52, 0xe4, 0x20, 0x6f, 0x16, 0x54, 0x9f, 0xe4, 0x3d, 0x1e, 0x29, 0x0d, 0x52, 0xd3, 0x81,
+	0xd1, 0xea, 0xa2, 0xe2, 0x98, 0x88, 0xa8, 0x55, 0x73, 0x4c, 0x44, 0xe4, 0x1b, 0x9e, 0x72, 0xa1,
+	0x99, 0x3c, 0x85, 0x84, 0x0e, 0x1d, 0xe4, 0xbd, 0x59, 0xd3, 0x15, 0xe4, 0x7c, 0xf5, 0x3d, 0x8e,
+	0x25, 0x8b, 0x4d, 0xa8, 0x3f, 0xcd, 0xff, 0xa0, 0x23, 0xdd, 0x9b, 0x01, 0x26, 0xff, 0x8a, 0xed,
+	0x0f, 0x10, 0xd1, 0x7f, 0xcd, 0x13, 0x7e, 0x6e, 0x40, 0xb2, 0xc4, 0x33, 0xcd, 0x53, 0xa6, 0x34,
+	0xa4, 0x79, 0x13, 0xa6, 0x17, 0x08, 0xc5, 0x13, 0x55, 0x7b, 0x9a, 0x50, 0x6d, 0x49, 0x5c, 0xfc,
+	0x2a, 0xe5, 0xe2, 0xb7, 0xac, 0x6e, 0x23, 0xc2, 0xd2, 0xe4, 0x7b, 0x1d, 0x3c, 0xd3, 0x0c, 0x03,
+	0x67, 0x3d, 0x33, 0x6c, 0x98, 0x3d, 0xad, 0x3a, 0xbf, 0x1b, 0x36, 0x1d, 0x39, 0xc8, 0x43, 0x41,
+	0x2f, 0x54, 0xe7, 0x03, 0x97, 0x55, 0x1e, 0x3a, 0x36, 0xe6, 0xb6, 0x24, 0x0e, 0x9e, 0x17, 0xfd,
+	0x35, 0xe8, 0xc4, 0x38, 0xf7, 0xa5, 0xca, 0xbb, 0x03, 0x29, 0xb8, 0x88, 0xe9, 0xd4, 0x41, 0xde,
+	0x34, 0x68, 0x4b, 0xf7, 0x2f, 0xfe, 0x78, 0xf0, 0x61, 0x54, 0x9e, 0x09, 0xc5, 0x88, 0x8f, 0xc7,
+	0x92, 0xa9, 0x22, 0xd1, 0x14, 0x39, 0x96, 0x37, 0x5f, 0x7f, 0x30, 0xd3, 0x7d, 0x39, 0xbb, 0xa0,
+	0xc1, 0xd6, 0xb7, 0x08, 0xcf, 0xba, 0x36, 0xe4, 0x0a, 0xbf, 0x3b, 0xd0, 0x9d, 0x7c, 0x36, 0x5d,
+	0x8e, 0x2f, 0x84, 0xed, 0x1c, 0x07, 0xea, 0x60, 0xee, 0x97, 0xeb, 0x87, 0xc7, 0xfb, 0xc1, 0x27,
+	0xb2, 0x34, 0x2b, 0xd8, 0x4d, 0xc9, 0x8f, 0x6b, 0x83, 0x7f, 0x91, 0x42, 0x78, 0xb9, 0x1d, 0x9b,
+	0xf5, 0xfb, 0xfa, 0x34, 0x00, 0xc4, 0x0e, 0x4c, 0x36, 0xc5, 0x02, 0x00, 0x00,
+}
@@ -0,0 +1,459 @@
+// Code generated by protoc-gen-go.
+// source: eventFilter.proto
+// DO NOT EDIT!
+
+package api
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import _ "github.com/grpc-ecosystem/grpc-gateway/third_party/googleapis/google/api"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+type CreateEventFilterRequest struct {
+	// hex encoded AppEUI
+	AppEUI string `protobuf:"bytes,1,opt,name=appEUI" json:"appEUI,omitempty"`
+	// integration to which the filter applies (optional, when left blank the filter applies to all the integrations)
+	Integration string `protobuf:"bytes,2,opt,name=integration" json:"integration,omitempty"`
+	// event types which are published (rx, join, ack, error, ...), when empty all the event types are published
+	Events []string `protobuf:"bytes,3,rep,name=events" json:"events,omitempty"`
+	// FPorts of the uplink data which is published, when empty all the FPorts are published
+	FPorts []uint32 `protobuf:"varint,4,rep,packed,name=fPorts" json:"fPorts,omitempty"`
+}
+
+func (m *CreateEventFilterRequest) Reset()                    { *m = CreateEventFilterRequest{} }
+func (m *CreateEventFilterRequest) String() string            { return proto.CompactTextString(m) }
+func (*CreateEventFilterRequest) ProtoMessage()               {}
+func (*CreateEventFilterRequest) Descriptor() ([]byte, []int) { return fileDescriptor30, []int{0} }
+
+func (m *CreateEventFilterRequest) GetAppEUI() string {
+	if m != nil {
+		return m.AppEUI
+	}
+	return ""
+}
+
+func (m *CreateEventFilterRequest) GetIntegration() string {
+	if m != nil {
+		return m.Integration
+	}
+	return ""
+}
+
+func (m *CreateEventFilterRequest) GetEvents() []string {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+func (m *CreateEventFilterRequest) GetFPorts() []uint32 {
+	if m != nil {
+		return m.FPorts
+	}
+	return nil
+}
+
+type CreateEventFilterResponse struct {
+	// ID of the created filter
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *CreateEventFilterResponse) Reset()                    { *m = CreateEventFilterResponse{} }
+func (m *CreateEventFilterResponse) String() string            { return proto.CompactTextString(m) }
+func (*CreateEventFilterResponse) ProtoMessage()               {}
+func (*CreateEventFilterResponse) Descriptor() ([]byte, []int) { return fileDescriptor30, []int{1} }
+
+func (m *CreateEventFilterResponse) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type ListEventFilterRequest struct {
+	// hex encoded AppEUI
+	AppEUI string `protobuf:"bytes,1,opt,name=appEUI" json:"appEUI,omitempty"`
+}
+
+func (m *ListEventFilterRequest) Reset()                    { *m = ListEventFilterRequest{} }
+func (m *ListEventFilterRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListEventFilterRequest) ProtoMessage()               {}
+func (*ListEventFilterRequest) Descriptor() ([]byte, []int) { return fileDescriptor30, []int{2} }
+
+func (m *ListEventFilterRequest) GetAppEUI() string {
+	if m != nil {
+		return m.AppEUI
+	}
+	return ""
+}
+
+type EventFilterItem struct {
+	// ID of the filter
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	// hex encoded AppEUI
+	AppEUI string `protobuf:"bytes,2,opt,name=appEUI" json:"appEUI,omitempty"`
+	// integration to which the filter applies (empty when the filter applies to all the integrations)
+	Integration string `protobuf:"bytes,3,opt,name=integration" json:"integration,omitempty"`
+	// event types which are published
+	Events []string `protobuf:"bytes,4,rep,name=events" json:"events,omitempty"`
+	// FPorts of the uplink data which is published
+	FPorts []uint32 `protobuf:"varint,5,rep,packed,name=fPorts" json:"fPorts,omitempty"`
+}
+
+func (m *EventFilterItem) Reset()                    { *m = EventFilterItem{} }
+func (m *EventFilterItem) String() string            { return proto.CompactTextString(m) }
+func (*EventFilterItem) ProtoMessage()               {}
+func (*EventFilterItem) Descriptor() ([]byte, []int) { return fileDescriptor30, []int{3} }
+
+func (m *EventFilterItem) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *EventFilterItem) GetAppEUI() string {
+	if m != nil {
+		return m.AppEUI
+	}
+	return ""
+}
+
+func (m *EventFilterItem) GetIntegration() string {
+	if m != nil {
+		return m.Integration
+	}
+	return ""
+}
+
+func (m *EventFilterItem) GetEvents() []string {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+func (m *EventFilterItem) GetFPorts() []uint32 {
+	if m != nil {
+		return m.FPorts
+	}
+	return nil
+}
+
+type ListEventFilterResponse struct {
+	Result []*EventFilterItem `protobuf:"bytes,1,rep,name=result" json:"result,omitempty"`
+}
+
+func (m *ListEventFilterResponse) Reset()                    { *m = ListEventFilterResponse{} }
+func (m *ListEventFilterResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListEventFilterResponse) ProtoMessage()               {}
+func (*ListEventFilterResponse) Descriptor() ([]byte, []int) { return fileDescriptor30, []int{4} }
+
+func (m *ListEventFilterResponse) GetResult() []*EventFilterItem {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type UpdateEventFilterRequest struct {
+	// ID of the filter
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	// event types which are published, when empty all the event types are published
+	Events []string `protobuf:"bytes,2,rep,name=events" json:"events,omitempty"`
+	// FPorts of the uplink data which is published, when empty all the FPorts are published
+	FPorts []uint32 `protobuf:"varint,3,rep,packed,name=fPorts" json:"fPorts,omitempty"`
+}
+
+func (m *UpdateEventFilterRequest) Reset()                    { *m = UpdateEventFilterRequest{} }
+func (m *UpdateEventFilterRequest) String() string            { return proto.CompactTextString(m) }
+func (*UpdateEventFilterRequest) ProtoMessage()               {}
+func (*UpdateEventFilterRequest) Descriptor() ([]byte, []int) { return fileDescriptor30, []int{5} }
+
+func (m *UpdateEventFilterRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *UpdateEventFilterRequest) GetEvents() []string {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+func (m *UpdateEventFilterRequest) GetFPorts() []uint32 {
+	if m != nil {
+		return m.FPorts
+	}
+	return nil
+}
+
+type UpdateEventFilterResponse struct {
+}
+
+func (m *UpdateEventFilterResponse) Reset()                    { *m = UpdateEventFilterResponse{} }
+func (m *UpdateEventFilterResponse) String() string            { return proto.CompactTextString(m) }
+func (*UpdateEventFilterResponse) ProtoMessage()               {}
+func (*UpdateEventFilterResponse) Descriptor() ([]byte, []int) { return fileDescriptor30, []int{6} }
+
+type DeleteEventFilterRequest struct {
+	// ID of the filter
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *DeleteEventFilterRequest) Reset()                    { *m = DeleteEventFilterRequest{} }
+func (m *DeleteEventFilterRequest) String() string            { return proto.CompactTextString(m) }
+func (*DeleteEventFilterRequest) ProtoMessage()               {}
+func (*DeleteEventFilterRequest) Descriptor() ([]byte, []int) { return fileDescriptor30, []int{7} }
+
+func (m *DeleteEventFilterRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type DeleteEventFilterResponse struct {
+}
+
+func (m *DeleteEventFilterResponse) Reset()                    { *m = DeleteEventFilterResponse{} }
+func (m *DeleteEventFilterResponse) String() string            { return proto.CompactTextString(m) }
+func (*DeleteEventFilterResponse) ProtoMessage()               {}
+func (*DeleteEventFilterResponse) Descriptor() ([]byte, []int) { return fileDescriptor30, []int{8} }
+
+func init() {
+	proto.RegisterType((*CreateEventFilterRequest)(nil), "api.CreateEventFilterRequest")
+	proto.RegisterType((*CreateEventFilterResponse)(nil), "api.CreateEventFilterResponse")
+	proto.RegisterType((*ListEventFilterRequest)(nil), "api.ListEventFilterRequest")
+	proto.RegisterType((*EventFilterItem)(nil), "api.EventFilterItem")
+	proto.RegisterType((*ListEventFilterResponse)(nil), "api.ListEventFilterResponse")
+	proto.RegisterType((*UpdateEventFilterRequest)(nil), "api.UpdateEventFilterRequest")
+	proto.RegisterType((*UpdateEventFilterResponse)(nil), "api.UpdateEventFilterResponse")
+	proto.RegisterType((*DeleteEventFilterRequest)(nil), "api.DeleteEventFilterRequest")
+	proto.RegisterType((*DeleteEventFilterResponse)(nil), "api.DeleteEventFilterResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for EventFilter service
+
+type EventFilterClient interface {
+	// Create creates the given filter.
+	Create(ctx context.Context, in *CreateEventFilterRequest, opts ...grpc.CallOption) (*CreateEventFilterResponse, error)
+	// List lists the filters of the given application.
+	List(ctx context.Context, in *ListEventFilterRequest, opts ...grpc.CallOption) (*ListEventFilterResponse, error)
+	// Update updates the filter matching the given id.
+	Update(ctx context.Context, in *UpdateEventFilterRequest, opts ...grpc.CallOption) (*UpdateEventFilterResponse, error)
+	// Delete deletes the filter matching the given id.
+	Delete(ctx context.Context, in *DeleteEventFilterRequest, opts ...grpc.CallOption) (*DeleteEventFilterResponse, error)
+}
+
+type eventFilterClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewEventFilterClient(cc *grpc.ClientConn) EventFilterClient {
+	return &eventFilterClient{cc}
+}
+
+func (c *eventFilterClient) Create(ctx context.Context, in *CreateEventFilterRequest, opts ...grpc.CallOption) (*CreateEventFilterResponse, error) {
+	out := new(CreateEventFilterResponse)
+	err := grpc.Invoke(ctx, "/api.EventFilter/Create", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventFilterClient) List(ctx context.Context, in *ListEventFilterRequest, opts ...grpc.CallOption) (*ListEventFilterResponse, error) {
+	out := new(ListEventFilterResponse)
+	err := grpc.Invoke(ctx, "/api.EventFilter/List", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventFilterClient) Update(ctx context.Context, in *UpdateEventFilterRequest, opts ...grpc.CallOption) (*UpdateEventFilterResponse, error) {
+	out := new(UpdateEventFilterResponse)
+	err := grpc.Invoke(ctx, "/api.EventFilter/Update", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventFilterClient) Delete(ctx context.Context, in *DeleteEventFilterRequest, opts ...grpc.CallOption) (*DeleteEventFilterResponse, error) {
+	out := new(DeleteEventFilterResponse)
+	err := grpc.Invoke(ctx, "/api.EventFilter/Delete", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for EventFilter service
+
+type EventFilterServer interface {
+	// Create creates the given filter.
+	Create(context.Context, *CreateEventFilterRequest) (*CreateEventFilterResponse, error)
+	// List lists the filters of the given application.
+	List(context.Context, *ListEventFilterRequest) (*ListEventFilterResponse, error)
+	// Update updates the filter matching the given id.
+	Update(context.Context, *UpdateEventFilterRequest) (*UpdateEventFilterResponse, error)
+	// Delete deletes the filter matching the given id.
+	Delete(context.Context, *DeleteEventFilterRequest) (*DeleteEventFilterResponse, error)
+}
+
+func RegisterEventFilterServer(s *grpc.Server, srv EventFilterServer) {
+	s.RegisterService(&_EventFilter_serviceDesc, srv)
+}
+
+func _EventFilter_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateEventFilterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventFilterServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.EventFilter/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventFilterServer).Create(ctx, req.(*CreateEventFilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventFilter_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEventFilterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventFilterServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.EventFilter/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventFilterServer).List(ctx, req.(*ListEventFilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventFilter_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateEventFilterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventFilterServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.EventFilter/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventFilterServer).Update(ctx, req.(*UpdateEventFilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventFilter_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteEventFilterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventFilterServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.EventFilter/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventFilterServer).Delete(ctx, req.(*DeleteEventFilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _EventFilter_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.EventFilter",
+	HandlerType: (*EventFilterServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _EventFilter_Create_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _EventFilter_List_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _EventFilter_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _EventFilter_Delete_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "eventFilter.proto",
+}
+
+func init() { proto.RegisterFile("eventFilter.proto", fileDescriptor30) }
+
+var fileDescriptor30 = []byte{
+	// 423 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x4f, 0x8b, 0xd4, 0x30,
+	0x14, 0xa7, 0xcd, 0x58, 0xf0, 0x0d, 0x2a, 0x1b, 0x64, 0xcc, 0x76, 0x6a, 0xa9, 0x39, 0x0d, 0xa3,
+	0xcc, 0xc8, 0x7a, 0xf3, 0xaa, 0xab, 0x2c, 0x78, 0x90, 0xc2, 0x5e, 0xbc, 0x55, 0xfa, 0x2c, 0x91,
+	0xda, 0xc4, 0x26, 0xeb, 0x45, 0xf6, 0x22, 0xde, 0x3c, 0xfa, 0x45, 0xfc, 0x2e, 0x7e, 0x05, 0x3f,
+	0x88, 0x34, 0x09, 0x6c, 0x77, 0x37, 0x59, 0xf6, 0xf8, 0xfe, 0xfd, 0xfe, 0xe4, 0xbd, 0x16, 0x0e,
+	0xf0, 0x1b, 0x0e, 0xe6, 0x8d, 0xe8, 0x0d, 0x8e, 0x3b, 0x35, 0x4a, 0x23, 0x29, 0x69, 0x94, 0xc8,
+	0x8b, 0x4e, 0xca, 0xae, 0xc7, 0x7d, 0xa3, 0xc4, 0xbe, 0x19, 0x06, 0x69, 0x1a, 0x23, 0xe4, 0xa0,
+	0x5d, 0x0b, 0xff, 0x99, 0x00, 0x7b, 0x35, 0x62, 0x63, 0xf0, 0xf8, 0x62, 0xbc, 0xc6, 0xaf, 0x67,
+	0xa8, 0x0d, 0x5d, 0x41, 0xd6, 0x28, 0x75, 0x7c, 0x7a, 0xc2, 0x92, 0x2a, 0xd9, 0xdc, 0xad, 0x7d,
+	0x44, 0x2b, 0x58, 0x8a, 0xc1, 0x60, 0x37, 0x5a, 0x28, 0x96, 0xda, 0xe2, 0x3c, 0x35, 0x4d, 0x5a,
+	0x39, 0x9a, 0x91, 0x8a, 0x4c, 0x93, 0x2e, 0x9a, 0xf2, 0x9f, 0xde, 0xcb, 0xd1, 0x68, 0xb6, 0xa8,
+	0xc8, 0xe6, 0x5e, 0xed, 0x23, 0xfe, 0x14, 0x0e, 0x03, 0x2a, 0xb4, 0x92, 0x83, 0x46, 0x7a, 0x1f,
+	0x52, 0xd1, 0x5a, 0x09, 0xa4, 0x4e, 0x45, 0xcb, 0x9f, 0xc3, 0xea, 0x9d, 0xd0, 0xe6, 0xf6, 0x82,
+	0xf9, 0xaf, 0x04, 0x1e, 0xcc, 0xda, 0x4f, 0x0c, 0x7e, 0xb9, 0x8a, 0x3a, 0x9b, 0x4d, 0x6f, 0x32,
+	0x4b, 0x6e, 0x32, 0xbb, 0x88, 0x98, 0xbd, 0x73, 0xc9, 0xec, 0x5b, 0x78, 0x74, 0x4d, 0xbf, 0xb7,
+	0xfa, 0x0c, 0xb2, 0x11, 0xf5, 0x59, 0x6f, 0x58, 0x52, 0x91, 0xcd, 0xf2, 0xe8, 0xe1, 0xae, 0x51,
+	0x62, 0x77, 0x45, 0x7a, 0xed, 0x7b, 0xf8, 0x07, 0x60, 0xa7, 0xaa, 0x0d, 0xef, 0x2e, 0x60, 0xcf,
+	0x8b, 0x4c, 0x23, 0x22, 0xc9, 0x25, 0x91, 0x6b, 0x38, 0x0c, 0x60, 0x3b, 0x99, 0x7c, 0x0b, 0xec,
+	0x35, 0xf6, 0x78, 0x1b, 0xe2, 0x09, 0x28, 0xd0, 0xeb, 0x80, 0x8e, 0xfe, 0x10, 0x58, 0xce, 0xf2,
+	0x14, 0x21, 0x73, 0x77, 0x40, 0x1f, 0x5b, 0xe7, 0xb1, 0xd3, 0xcc, 0xcb, 0x58, 0xd9, 0x2b, 0x2c,
+	0x7e, 0xfc, 0xfd, 0xf7, 0x3b, 0x5d, 0xf1, 0x03, 0x7b, 0xf7, 0xb3, 0x4f, 0x43, 0xbf, 0x4c, 0xb6,
+	0x14, 0x61, 0x31, 0x6d, 0x80, 0xae, 0x2d, 0x4a, 0xf8, 0x98, 0xf2, 0x22, 0x5c, 0xf4, 0x04, 0xdc,
+	0x12, 0x14, 0x34, 0xbf, 0x46, 0xb0, 0xff, 0xee, 0x2e, 0xe7, 0x9c, 0x7e, 0x86, 0xcc, 0xbd, 0xa1,
+	0x77, 0x13, 0x5b, 0x56, 0x5e, 0xc6, 0xca, 0x9e, 0xec, 0x89, 0x25, 0x5b, 0xe7, 0xab, 0x00, 0x99,
+	0x68, 0xcf, 0x27, 0x4b, 0x1d, 0x64, 0xee, 0x99, 0x3d, 0x57, 0x6c, 0x3f, 0x79, 0x19, 0x2b, 0x7b,
+	0xae, 0xd2, 0x72, 0xb1, 0x6d, 0x84, 0xeb, 0x63, 0x66, 0x7f, 0x1c, 0x2f, 0xfe, 0x0f, 0x00, 0x6e,
+	0xa2, 0x86, 0x25, 0x70, 0x04, 0x00, 0x00,
+}
@@ -0,0 +1,260 @@
+// Code generated by protoc-gen-go.
+// source: eventLog.proto
+// DO NOT EDIT!
+
+package api
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import _ "github.com/grpc-ecosystem/grpc-gateway/third_party/googleapis/google/api"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+type ListEventLogRequest struct {
+	// hex encoded DevEUI
+	DevEUI string `protobuf:"bytes,1,opt,name=devEUI" json:"devEUI,omitempty"`
+	// only return the events created at or after this time (RFC3339, optional)
+	Start string `protobuf:"bytes,2,opt,name=start" json:"start,omitempty"`
+	// only return the events created before this time (RFC3339, optional)
+	End string `protobuf:"bytes,3,opt,name=end" json:"end,omitempty"`
+	// event types to return (rx, join, ack, error, linkquality, lifecycle, firmware, status, location, scheduled), all when empty
+	Types []string `protobuf:"bytes,4,rep,name=types" json:"types,omitempty"`
+	// max number of events to return
+	Limit int64 `protobuf:"varint,5,opt,name=limit" json:"limit,omitempty"`
+	// offset in the result-set (for pagination)
+	Offset int64 `protobuf:"varint,6,opt,name=offset" json:"offset,omitempty"`
+}
+
+func (m *ListEventLogRequest) Reset()                    { *m = ListEventLogRequest{} }
+func (m *ListEventLogRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListEventLogRequest) ProtoMessage()               {}
+func (*ListEventLogRequest) Descriptor() ([]byte, []int) { return fileDescriptor21, []int{0} }
+
+func (m *ListEventLogRequest) GetDevEUI() string {
+	if m != nil {
+		return m.DevEUI
+	}
+	return ""
+}
+
+func (m *ListEventLogRequest) GetStart() string {
+	if m != nil {
+		return m.Start
+	}
+	return ""
+}
+
+func (m *ListEventLogRequest) GetEnd() string {
+	if m != nil {
+		return m.End
+	}
+	return ""
+}
+
+func (m *ListEventLogRequest) GetTypes() []string {
+	if m != nil {
+		return m.Types
+	}
+	return nil
+}
+
+func (m *ListEventLogRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListEventLogRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+type EventLogEntry struct {
+	// id of the event
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	// event type (rx, join, ack, error, linkquality, lifecycle, firmware, status, location, scheduled)
+	Type string `protobuf:"bytes,2,opt,name=type" json:"type,omitempty"`
+	// timestamp of the event (RFC3339)
+	CreatedAt string `protobuf:"bytes,3,opt,name=createdAt" json:"createdAt,omitempty"`
+	// JSON encoded payload (same format as published on the MQTT topics)
+	PayloadJSON string `protobuf:"bytes,4,opt,name=payloadJSON" json:"payloadJSON,omitempty"`
+}
+
+func (m *EventLogEntry) Reset()                    { *m = EventLogEntry{} }
+func (m *EventLogEntry) String() string            { return proto.CompactTextString(m) }
+func (*EventLogEntry) ProtoMessage()               {}
+func (*EventLogEntry) Descriptor() ([]byte, []int) { return fileDescriptor21, []int{1} }
+
+func (m *EventLogEntry) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *EventLogEntry) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *EventLogEntry) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return ""
+}
+
+func (m *EventLogEntry) GetPayloadJSON() string {
+	if m != nil {
+		return m.PayloadJSON
+	}
+	return ""
+}
+
+type ListEventLogResponse struct {
+	// total number of events matching the filters
+	TotalCount int64            `protobuf:"varint,1,opt,name=totalCount" json:"totalCount,omitempty"`
+	Result     []*EventLogEntry `protobuf:"bytes,2,rep,name=result" json:"result,omitempty"`
+}
+
+func (m *ListEventLogResponse) Reset()                    { *m = ListEventLogResponse{} }
+func (m *ListEventLogResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListEventLogResponse) ProtoMessage()               {}
+func (*ListEventLogResponse) Descriptor() ([]byte, []int) { return fileDescriptor21, []int{2} }
+
+func (m *ListEventLogResponse) GetTotalCount() int64 {
+	if m != nil {
+		return m.TotalCount
+	}
+	return 0
+}
+
+func (m *ListEventLogResponse) GetResult() []*EventLogEntry {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ListEventLogRequest)(nil), "api.ListEventLogRequest")
+	proto.RegisterType((*EventLogEntry)(nil), "api.EventLogEntry")
+	proto.RegisterType((*ListEventLogResponse)(nil), "api.ListEventLogResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for EventLog service
+
+type EventLogClient interface {
+	// List lists the logged events (uplink data, join, ack, error, ...) of
+	// the given node, newest first.
+	List(ctx context.Context, in *ListEventLogRequest, opts ...grpc.CallOption) (*ListEventLogResponse, error)
+}
+
+type eventLogClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewEventLogClient(cc *grpc.ClientConn) EventLogClient {
+	return &eventLogClient{cc}
+}
+
+func (c *eventLogClient) List(ctx context.Context, in *ListEventLogRequest, opts ...grpc.CallOption) (*ListEventLogResponse, error) {
+	out := new(ListEventLogResponse)
+	err := grpc.Invoke(ctx, "/api.EventLog/List", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for EventLog service
+
+type EventLogServer interface {
+	// List lists the logged events (uplink data, join, ack, error, ...) of
+	// the given node, newest first.
+	List(context.Context, *ListEventLogRequest) (*ListEventLogResponse, error)
+}
+
+func RegisterEventLogServer(s *grpc.Server, srv EventLogServer) {
+	s.RegisterService(&_EventLog_serviceDesc, srv)
+}
+
+func _EventLog_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEventLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventLogServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.EventLog/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventLogServer).List(ctx, req.(*ListEventLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _EventLog_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.EventLog",
+	HandlerType: (*EventLogServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "List",
+			Handler:    _EventLog_List_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "eventLog.proto",
+}
+
+func init() { proto.RegisterFile("eventLog.proto", fileDescriptor21) }
+
+var fileDescriptor21 = []byte{
+	// 336 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xcf, 0x4a, 0xc3, 0x40,
+	0x10, 0xc6, 0xc9, 0x9f, 0x06, 0x3b, 0xc5, 0x22, 0x63, 0x29, 0x6b, 0x29, 0x25, 0xe4, 0x54, 0x3c,
+	0xb4, 0x50, 0x9f, 0x40, 0xa4, 0x07, 0xa5, 0x28, 0x44, 0x3c, 0x79, 0xda, 0x9a, 0x6d, 0x58, 0x88,
+	0xbb, 0x31, 0x3b, 0x2d, 0x14, 0xf1, 0xe2, 0x2b, 0x78, 0xf2, 0xb9, 0x7c, 0x05, 0x1f, 0x44, 0x76,
+	0x93, 0x62, 0x0b, 0xbd, 0xed, 0xf7, 0xcd, 0x97, 0xcc, 0x8f, 0xf9, 0xa0, 0x2b, 0x36, 0x42, 0xd1,
+	0x42, 0xe7, 0x93, 0xb2, 0xd2, 0xa4, 0x31, 0xe0, 0xa5, 0x1c, 0x0c, 0x73, 0xad, 0xf3, 0x42, 0x4c,
+	0x79, 0x29, 0xa7, 0x5c, 0x29, 0x4d, 0x9c, 0xa4, 0x56, 0xa6, 0x8e, 0x24, 0xdf, 0x1e, 0x9c, 0x2f,
+	0xa4, 0xa1, 0x79, 0xf3, 0x65, 0x2a, 0xde, 0xd6, 0xc2, 0x10, 0xf6, 0x21, 0xca, 0xc4, 0x66, 0xfe,
+	0x74, 0xcb, 0xbc, 0xd8, 0x1b, 0xb7, 0xd3, 0x46, 0x61, 0x0f, 0x5a, 0x86, 0x78, 0x45, 0xcc, 0x77,
+	0x76, 0x2d, 0xf0, 0x0c, 0x02, 0xa1, 0x32, 0x16, 0x38, 0xcf, 0x3e, 0x6d, 0x8e, 0xb6, 0xa5, 0x30,
+	0x2c, 0x8c, 0x03, 0x9b, 0x73, 0xc2, 0xba, 0x85, 0x7c, 0x95, 0xc4, 0x5a, 0xb1, 0x37, 0x0e, 0xd2,
+	0x5a, 0xd8, 0x5d, 0x7a, 0xb5, 0x32, 0x82, 0x58, 0xe4, 0xec, 0x46, 0x25, 0x06, 0x4e, 0x77, 0x58,
+	0x73, 0x45, 0xd5, 0x16, 0xbb, 0xe0, 0xcb, 0xcc, 0x01, 0x05, 0xa9, 0x2f, 0x33, 0x44, 0x08, 0xed,
+	0x7f, 0x1b, 0x16, 0xf7, 0xc6, 0x21, 0xb4, 0x5f, 0x2a, 0xc1, 0x49, 0x64, 0xd7, 0xd4, 0x00, 0xfd,
+	0x1b, 0x18, 0x43, 0xa7, 0xe4, 0xdb, 0x42, 0xf3, 0xec, 0xee, 0xf1, 0xe1, 0x9e, 0x85, 0x6e, 0xbe,
+	0x6f, 0x25, 0x4b, 0xe8, 0x1d, 0xde, 0xc3, 0x94, 0x5a, 0x19, 0x81, 0x23, 0x00, 0xd2, 0xc4, 0x8b,
+	0x1b, 0xbd, 0x56, 0xd4, 0x30, 0xec, 0x39, 0x78, 0x09, 0x51, 0x25, 0xcc, 0xba, 0xb0, 0x97, 0x09,
+	0xc6, 0x9d, 0x19, 0x4e, 0x78, 0x29, 0x27, 0x07, 0xfc, 0x69, 0x93, 0x98, 0xe5, 0x70, 0xb2, 0x1b,
+	0xe0, 0x33, 0x84, 0x76, 0x1f, 0x32, 0x97, 0x3f, 0x52, 0xc5, 0xe0, 0xe2, 0xc8, 0xa4, 0x86, 0x4a,
+	0x46, 0x9f, 0x3f, 0xbf, 0x5f, 0x3e, 0xc3, 0xbe, 0x6b, 0x77, 0xd7, 0xfe, 0xf4, 0xbd, 0x2e, 0xeb,
+	0x63, 0x19, 0xb9, 0x92, 0xaf, 0xfe, 0x06, 0x00, 0xad, 0x7a, 0x42, 0x80, 0x19, 0x02, 0x00, 0x00,
+}
@@ -0,0 +1,229 @@
+// Code generated by protoc-gen-go.
+// source: eventStream.proto
+// DO NOT EDIT!
+
+package api
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import _ "github.com/grpc-ecosystem/grpc-gateway/third_party/googleapis/google/api"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+type SubscribeEventStreamRequest struct {
+	// hex encoded AppEUI
+	AppEUI string `protobuf:"bytes,1,opt,name=appEUI" json:"appEUI,omitempty"`
+	// hex encoded DevEUI (optional, when set only the events of this node are streamed)
+	DevEUI string `protobuf:"bytes,2,opt,name=devEUI" json:"devEUI,omitempty"`
+	// event types to stream (rx, join, ack, error, linkquality, lifecycle, status), all when empty
+	Types []string `protobuf:"bytes,3,rep,name=types" json:"types,omitempty"`
+}
+
+func (m *SubscribeEventStreamRequest) Reset()                    { *m = SubscribeEventStreamRequest{} }
+func (m *SubscribeEventStreamRequest) String() string            { return proto.CompactTextString(m) }
+func (*SubscribeEventStreamRequest) ProtoMessage()               {}
+func (*SubscribeEventStreamRequest) Descriptor() ([]byte, []int) { return fileDescriptor14, []int{0} }
+
+func (m *SubscribeEventStreamRequest) GetAppEUI() string {
+	if m != nil {
+		return m.AppEUI
+	}
+	return ""
+}
+
+func (m *SubscribeEventStreamRequest) GetDevEUI() string {
+	if m != nil {
+		return m.DevEUI
+	}
+	return ""
+}
+
+func (m *SubscribeEventStreamRequest) GetTypes() []string {
+	if m != nil {
+		return m.Types
+	}
+	return nil
+}
+
+type EventStreamEvent struct {
+	// event type (rx, join, ack, error, linkquality, lifecycle, status)
+	Type string `protobuf:"bytes,1,opt,name=type" json:"type,omitempty"`
+	// hex encoded AppEUI
+	AppEUI string `protobuf:"bytes,2,opt,name=appEUI" json:"appEUI,omitempty"`
+	// hex encoded DevEUI
+	DevEUI string `protobuf:"bytes,3,opt,name=devEUI" json:"devEUI,omitempty"`
+	// JSON encoded payload (same format as published on the MQTT topics)
+	PayloadJSON string `protobuf:"bytes,4,opt,name=payloadJSON" json:"payloadJSON,omitempty"`
+}
+
+func (m *EventStreamEvent) Reset()                    { *m = EventStreamEvent{} }
+func (m *EventStreamEvent) String() string            { return proto.CompactTextString(m) }
+func (*EventStreamEvent) ProtoMessage()               {}
+func (*EventStreamEvent) Descriptor() ([]byte, []int) { return fileDescriptor14, []int{1} }
+
+func (m *EventStreamEvent) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *EventStreamEvent) GetAppEUI() string {
+	if m != nil {
+		return m.AppEUI
+	}
+	return ""
+}
+
+func (m *EventStreamEvent) GetDevEUI() string {
+	if m != nil {
+		return m.DevEUI
+	}
+	return ""
+}
+
+func (m *EventStreamEvent) GetPayloadJSON() string {
+	if m != nil {
+		return m.PayloadJSON
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*SubscribeEventStreamRequest)(nil), "api.SubscribeEventStreamRequest")
+	proto.RegisterType((*EventStreamEvent)(nil), "api.EventStreamEvent")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for EventStream service
+
+type EventStreamClient interface {
+	// Subscribe streams the events (uplink data, join, ack, error, ...) of
+	// the nodes of the given application, until the request is cancelled.
+	Subscribe(ctx context.Context, in *SubscribeEventStreamRequest, opts ...grpc.CallOption) (EventStream_SubscribeClient, error)
+}
+
+type eventStreamClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewEventStreamClient(cc *grpc.ClientConn) EventStreamClient {
+	return &eventStreamClient{cc}
+}
+
+func (c *eventStreamClient) Subscribe(ctx context.Context, in *SubscribeEventStreamRequest, opts ...grpc.CallOption) (EventStream_SubscribeClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_EventStream_serviceDesc.Streams[0], c.cc, "/api.EventStream/Subscribe", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &eventStreamSubscribeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type EventStream_SubscribeClient interface {
+	Recv() (*EventStreamEvent, error)
+	grpc.ClientStream
+}
+
+type eventStreamSubscribeClient struct {
+	grpc.ClientStream
+}
+
+func (x *eventStreamSubscribeClient) Recv() (*EventStreamEvent, error) {
+	m := new(EventStreamEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Server API for EventStream service
+
+type EventStreamServer interface {
+	// Subscribe streams the events (uplink data, join, ack, error, ...) of
+	// the nodes of the given application, until the request is cancelled.
+	Subscribe(*SubscribeEventStreamRequest, EventStream_SubscribeServer) error
+}
+
+func RegisterEventStreamServer(s *grpc.Server, srv EventStreamServer) {
+	s.RegisterService(&_EventStream_serviceDesc, srv)
+}
+
+func _EventStream_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeEventStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(EventStreamServer).Subscribe(m, &eventStreamSubscribeServer{stream})
+}
+
+type EventStream_SubscribeServer interface {
+	Send(*EventStreamEvent) error
+	grpc.ServerStream
+}
+
+type eventStreamSubscribeServer struct {
+	grpc.ServerStream
+}
+
+func (x *eventStreamSubscribeServer) Send(m *EventStreamEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _EventStream_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.EventStream",
+	HandlerType: (*EventStreamServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Subscribe",
+			Handler:       _EventStream_Subscribe_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "eventStream.proto",
+}
+
+func init() { proto.RegisterFile("eventStream.proto", fileDescriptor14) }
+
+var fileDescriptor14 = []byte{
+	// 245 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4c, 0x2d, 0x4b, 0xcd,
+	0x2b, 0x09, 0x2e, 0x29, 0x4a, 0x4d, 0xcc, 0xd5, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0x4e,
+	0x2c, 0xc8, 0x94, 0x92, 0x49, 0xcf, 0xcf, 0x4f, 0xcf, 0x49, 0xd5, 0x4f, 0x2c, 0xc8, 0xd4, 0x4f,
+	0xcc, 0xcb, 0xcb, 0x2f, 0x49, 0x2c, 0xc9, 0xcc, 0xcf, 0x2b, 0x86, 0x28, 0x51, 0x4a, 0xe6, 0x92,
+	0x0e, 0x2e, 0x4d, 0x2a, 0x4e, 0x2e, 0xca, 0x4c, 0x4a, 0x75, 0x45, 0x18, 0x10, 0x94, 0x5a, 0x58,
+	0x9a, 0x5a, 0x5c, 0x22, 0x24, 0xc6, 0xc5, 0x96, 0x58, 0x50, 0xe0, 0x1a, 0xea, 0x29, 0xc1, 0xa8,
+	0xc0, 0xa8, 0xc1, 0x19, 0x04, 0xe5, 0x81, 0xc4, 0x53, 0x52, 0xcb, 0x40, 0xe2, 0x4c, 0x10, 0x71,
+	0x08, 0x4f, 0x48, 0x84, 0x8b, 0xb5, 0xa4, 0xb2, 0x20, 0xb5, 0x58, 0x82, 0x59, 0x81, 0x59, 0x83,
+	0x33, 0x08, 0xc2, 0x51, 0xaa, 0xe0, 0x12, 0x40, 0x32, 0x1b, 0xcc, 0x14, 0x12, 0xe2, 0x62, 0x01,
+	0x49, 0x42, 0xcd, 0x05, 0xb3, 0x91, 0x6c, 0x63, 0xc2, 0x61, 0x1b, 0x33, 0x8a, 0x6d, 0x0a, 0x5c,
+	0xdc, 0x05, 0x89, 0x95, 0x39, 0xf9, 0x89, 0x29, 0x5e, 0xc1, 0xfe, 0x7e, 0x12, 0x2c, 0x60, 0x49,
+	0x64, 0x21, 0xa3, 0x0a, 0x2e, 0x6e, 0x24, 0x9b, 0x85, 0x32, 0xb9, 0x38, 0xe1, 0xbe, 0x15, 0x52,
+	0xd0, 0x4b, 0x2c, 0xc8, 0xd4, 0xc3, 0xe3, 0x7b, 0x29, 0x51, 0xb0, 0x0a, 0x74, 0xa7, 0x2b, 0x29,
+	0x36, 0x5d, 0x7e, 0x32, 0x99, 0x49, 0x5a, 0x48, 0x12, 0x1c, 0xa6, 0x48, 0xc1, 0xae, 0x5f, 0x0d,
+	0x71, 0x70, 0xad, 0x01, 0x63, 0x12, 0x1b, 0x38, 0x7c, 0x8d, 0x01, 0x03, 0x00, 0xe1, 0x2d, 0xb3,
+	0x18, 0x97, 0x01, 0x00, 0x00,
+}
@@ -0,0 +1,723 @@
+// Code generated by protoc-gen-go.
+// source: fuotaDeployment.proto
+// DO NOT EDIT!
+
+package api
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import _ "github.com/grpc-ecosystem/grpc-gateway/third_party/googleapis/google/api"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+type CreateFUOTADeploymentRequest struct {
+	// name of the deployment
+	Name string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	// id of the multicast group containing the nodes to update
+	MulticastGroupID int64 `protobuf:"varint,2,opt,name=multicastGroupID" json:"multicastGroupID,omitempty"`
+	// base64 encoded firmware image
+	Firmware []byte `protobuf:"bytes,3,opt,name=firmware,proto3" json:"firmware,omitempty"`
+	// descriptor of the firmware (passed to the nodes in the session setup)
+	FirmwareDescriptor uint32 `protobuf:"varint,4,opt,name=firmwareDescriptor" json:"firmwareDescriptor,omitempty"`
+	// size (bytes) of each fragment
+	FragSize uint32 `protobuf:"varint,5,opt,name=fragSize" json:"fragSize,omitempty"`
+	// number of redundancy fragments
+	Redundancy uint32 `protobuf:"varint,6,opt,name=redundancy" json:"redundancy,omitempty"`
+	// FPort of the fragmentation messages (default 201)
+	FPort uint32 `protobuf:"varint,7,opt,name=fPort" json:"fPort,omitempty"`
+	// start of the deployment (RFC3339 timestamp, empty = now)
+	StartAt string `protobuf:"bytes,8,opt,name=startAt" json:"startAt,omitempty"`
+	// timeout (in seconds) after which the nodes that did not complete the update are set to TIMEOUT
+	Timeout uint32 `protobuf:"varint,9,opt,name=timeout" json:"timeout,omitempty"`
+}
+
+func (m *CreateFUOTADeploymentRequest) Reset()                    { *m = CreateFUOTADeploymentRequest{} }
+func (m *CreateFUOTADeploymentRequest) String() string            { return proto.CompactTextString(m) }
+func (*CreateFUOTADeploymentRequest) ProtoMessage()               {}
+func (*CreateFUOTADeploymentRequest) Descriptor() ([]byte, []int) { return fileDescriptor17, []int{0} }
+
+func (m *CreateFUOTADeploymentRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CreateFUOTADeploymentRequest) GetMulticastGroupID() int64 {
+	if m != nil {
+		return m.MulticastGroupID
+	}
+	return 0
+}
+
+func (m *CreateFUOTADeploymentRequest) GetFirmware() []byte {
+	if m != nil {
+		return m.Firmware
+	}
+	return nil
+}
+
+func (m *CreateFUOTADeploymentRequest) GetFirmwareDescriptor() uint32 {
+	if m != nil {
+		return m.FirmwareDescriptor
+	}
+	return 0
+}
+
+func (m *CreateFUOTADeploymentRequest) GetFragSize() uint32 {
+	if m != nil {
+		return m.FragSize
+	}
+	return 0
+}
+
+func (m *CreateFUOTADeploymentRequest) GetRedundancy() uint32 {
+	if m != nil {
+		return m.Redundancy
+	}
+	return 0
+}
+
+func (m *CreateFUOTADeploymentRequest) GetFPort() uint32 {
+	if m != nil {
+		return m.FPort
+	}
+	return 0
+}
+
+func (m *CreateFUOTADeploymentRequest) GetStartAt() string {
+	if m != nil {
+		return m.StartAt
+	}
+	return ""
+}
+
+func (m *CreateFUOTADeploymentRequest) GetTimeout() uint32 {
+	if m != nil {
+		return m.Timeout
+	}
+	return 0
+}
+
+type CreateFUOTADeploymentResponse struct {
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *CreateFUOTADeploymentResponse) Reset()                    { *m = CreateFUOTADeploymentResponse{} }
+func (m *CreateFUOTADeploymentResponse) String() string            { return proto.CompactTextString(m) }
+func (*CreateFUOTADeploymentResponse) ProtoMessage()               {}
+func (*CreateFUOTADeploymentResponse) Descriptor() ([]byte, []int) { return fileDescriptor17, []int{1} }
+
+func (m *CreateFUOTADeploymentResponse) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type GetFUOTADeploymentRequest struct {
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *GetFUOTADeploymentRequest) Reset()                    { *m = GetFUOTADeploymentRequest{} }
+func (m *GetFUOTADeploymentRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetFUOTADeploymentRequest) ProtoMessage()               {}
+func (*GetFUOTADeploymentRequest) Descriptor() ([]byte, []int) { return fileDescriptor17, []int{2} }
+
+func (m *GetFUOTADeploymentRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type GetFUOTADeploymentResponse struct {
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	// name of the deployment
+	Name string `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	// hex encoded AppEUI of the application
+	AppEUI string `protobuf:"bytes,3,opt,name=appEUI" json:"appEUI,omitempty"`
+	// id of the multicast group containing the nodes to update
+	MulticastGroupID int64 `protobuf:"varint,4,opt,name=multicastGroupID" json:"multicastGroupID,omitempty"`
+	// size (bytes) of the firmware image
+	FirmwareSize uint32 `protobuf:"varint,5,opt,name=firmwareSize" json:"firmwareSize,omitempty"`
+	// descriptor of the firmware
+	FirmwareDescriptor uint32 `protobuf:"varint,6,opt,name=firmwareDescriptor" json:"firmwareDescriptor,omitempty"`
+	// size (bytes) of each fragment
+	FragSize uint32 `protobuf:"varint,7,opt,name=fragSize" json:"fragSize,omitempty"`
+	// number of redundancy fragments
+	Redundancy uint32 `protobuf:"varint,8,opt,name=redundancy" json:"redundancy,omitempty"`
+	// FPort of the fragmentation messages
+	FPort uint32 `protobuf:"varint,9,opt,name=fPort" json:"fPort,omitempty"`
+	// state of the deployment (SCHEDULED, IN_PROGRESS or DONE)
+	State string `protobuf:"bytes,10,opt,name=state" json:"state,omitempty"`
+	// start of the deployment (RFC3339 timestamp)
+	StartAt string `protobuf:"bytes,11,opt,name=startAt" json:"startAt,omitempty"`
+	// timeout (in seconds)
+	Timeout uint32 `protobuf:"varint,12,opt,name=timeout" json:"timeout,omitempty"`
+	// deadline of the deployment (RFC3339 timestamp, empty when not started)
+	Deadline string `protobuf:"bytes,13,opt,name=deadline" json:"deadline,omitempty"`
+	// timestamp of creation (RFC3339)
+	CreatedAt string `protobuf:"bytes,14,opt,name=createdAt" json:"createdAt,omitempty"`
+	// timestamp of the last state change (RFC3339)
+	UpdatedAt string `protobuf:"bytes,15,opt,name=updatedAt" json:"updatedAt,omitempty"`
+}
+
+func (m *GetFUOTADeploymentResponse) Reset()                    { *m = GetFUOTADeploymentResponse{} }
+func (m *GetFUOTADeploymentResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetFUOTADeploymentResponse) ProtoMessage()               {}
+func (*GetFUOTADeploymentResponse) Descriptor() ([]byte, []int) { return fileDescriptor17, []int{3} }
+
+func (m *GetFUOTADeploymentResponse) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *GetFUOTADeploymentResponse) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *GetFUOTADeploymentResponse) GetAppEUI() string {
+	if m != nil {
+		return m.AppEUI
+	}
+	return ""
+}
+
+func (m *GetFUOTADeploymentResponse) GetMulticastGroupID() int64 {
+	if m != nil {
+		return m.MulticastGroupID
+	}
+	return 0
+}
+
+func (m *GetFUOTADeploymentResponse) GetFirmwareSize() uint32 {
+	if m != nil {
+		return m.FirmwareSize
+	}
+	return 0
+}
+
+func (m *GetFUOTADeploymentResponse) GetFirmwareDescriptor() uint32 {
+	if m != nil {
+		return m.FirmwareDescriptor
+	}
+	return 0
+}
+
+func (m *GetFUOTADeploymentResponse) GetFragSize() uint32 {
+	if m != nil {
+		return m.FragSize
+	}
+	return 0
+}
+
+func (m *GetFUOTADeploymentResponse) GetRedundancy() uint32 {
+	if m != nil {
+		return m.Redundancy
+	}
+	return 0
+}
+
+func (m *GetFUOTADeploymentResponse) GetFPort() uint32 {
+	if m != nil {
+		return m.FPort
+	}
+	return 0
+}
+
+func (m *GetFUOTADeploymentResponse) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *GetFUOTADeploymentResponse) GetStartAt() string {
+	if m != nil {
+		return m.StartAt
+	}
+	return ""
+}
+
+func (m *GetFUOTADeploymentResponse) GetTimeout() uint32 {
+	if m != nil {
+		return m.Timeout
+	}
+	return 0
+}
+
+func (m *GetFUOTADeploymentResponse) GetDeadline() string {
+	if m != nil {
+		return m.Deadline
+	}
+	return ""
+}
+
+func (m *GetFUOTADeploymentResponse) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return ""
+}
+
+func (m *GetFUOTADeploymentResponse) GetUpdatedAt() string {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return ""
+}
+
+type ListFUOTADeploymentByAppEUIRequest struct {
+	// hex encoded AppEUI of the application
+	AppEUI string `protobuf:"bytes,1,opt,name=appEUI" json:"appEUI,omitempty"`
+}
+
+func (m *ListFUOTADeploymentByAppEUIRequest) Reset()         { *m = ListFUOTADeploymentByAppEUIRequest{} }
+func (m *ListFUOTADeploymentByAppEUIRequest) String() string { return proto.CompactTextString(m) }
+func (*ListFUOTADeploymentByAppEUIRequest) ProtoMessage()    {}
+func (*ListFUOTADeploymentByAppEUIRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor17, []int{4}
+}
+
+func (m *ListFUOTADeploymentByAppEUIRequest) GetAppEUI() string {
+	if m != nil {
+		return m.AppEUI
+	}
+	return ""
+}
+
+type ListFUOTADeploymentResponse struct {
+	Result []*GetFUOTADeploymentResponse `protobuf:"bytes,1,rep,name=result" json:"result,omitempty"`
+}
+
+func (m *ListFUOTADeploymentResponse) Reset()                    { *m = ListFUOTADeploymentResponse{} }
+func (m *ListFUOTADeploymentResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListFUOTADeploymentResponse) ProtoMessage()               {}
+func (*ListFUOTADeploymentResponse) Descriptor() ([]byte, []int) { return fileDescriptor17, []int{5} }
+
+func (m *ListFUOTADeploymentResponse) GetResult() []*GetFUOTADeploymentResponse {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type DeleteFUOTADeploymentRequest struct {
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *DeleteFUOTADeploymentRequest) Reset()                    { *m = DeleteFUOTADeploymentRequest{} }
+func (m *DeleteFUOTADeploymentRequest) String() string            { return proto.CompactTextString(m) }
+func (*DeleteFUOTADeploymentRequest) ProtoMessage()               {}
+func (*DeleteFUOTADeploymentRequest) Descriptor() ([]byte, []int) { return fileDescriptor17, []int{6} }
+
+func (m *DeleteFUOTADeploymentRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type DeleteFUOTADeploymentResponse struct {
+}
+
+func (m *DeleteFUOTADeploymentResponse) Reset()                    { *m = DeleteFUOTADeploymentResponse{} }
+func (m *DeleteFUOTADeploymentResponse) String() string            { return proto.CompactTextString(m) }
+func (*DeleteFUOTADeploymentResponse) ProtoMessage()               {}
+func (*DeleteFUOTADeploymentResponse) Descriptor() ([]byte, []int) { return fileDescriptor17, []int{7} }
+
+type FUOTADeploymentNode struct {
+	// hex encoded DevEUI of the node
+	DevEUI string `protobuf:"bytes,1,opt,name=devEUI" json:"devEUI,omitempty"`
+	// update state (IN_PROGRESS, COMPLETED, FAILED or TIMEOUT)
+	State string `protobuf:"bytes,2,opt,name=state" json:"state,omitempty"`
+	// number of fragments received by the node (as last reported)
+	NbFragReceived uint32 `protobuf:"varint,3,opt,name=nbFragReceived" json:"nbFragReceived,omitempty"`
+	// number of missing fragments (as last reported)
+	MissingFrag uint32 `protobuf:"varint,4,opt,name=missingFrag" json:"missingFrag,omitempty"`
+	// error reported by the node (FAILED state)
+	Error string `protobuf:"bytes,5,opt,name=error" json:"error,omitempty"`
+	// timestamp of the last update (RFC3339)
+	UpdatedAt string `protobuf:"bytes,6,opt,name=updatedAt" json:"updatedAt,omitempty"`
+}
+
+func (m *FUOTADeploymentNode) Reset()                    { *m = FUOTADeploymentNode{} }
+func (m *FUOTADeploymentNode) String() string            { return proto.CompactTextString(m) }
+func (*FUOTADeploymentNode) ProtoMessage()               {}
+func (*FUOTADeploymentNode) Descriptor() ([]byte, []int) { return fileDescriptor17, []int{8} }
+
+func (m *FUOTADeploymentNode) GetDevEUI() string {
+	if m != nil {
+		return m.DevEUI
+	}
+	return ""
+}
+
+func (m *FUOTADeploymentNode) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *FUOTADeploymentNode) GetNbFragReceived() uint32 {
+	if m != nil {
+		return m.NbFragReceived
+	}
+	return 0
+}
+
+func (m *FUOTADeploymentNode) GetMissingFrag() uint32 {
+	if m != nil {
+		return m.MissingFrag
+	}
+	return 0
+}
+
+func (m *FUOTADeploymentNode) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *FUOTADeploymentNode) GetUpdatedAt() string {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return ""
+}
+
+type ListFUOTADeploymentNodesRequest struct {
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *ListFUOTADeploymentNodesRequest) Reset()         { *m = ListFUOTADeploymentNodesRequest{} }
+func (m *ListFUOTADeploymentNodesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListFUOTADeploymentNodesRequest) ProtoMessage()    {}
+func (*ListFUOTADeploymentNodesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor17, []int{9}
+}
+
+func (m *ListFUOTADeploymentNodesRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type ListFUOTADeploymentNodesResponse struct {
+	Result []*FUOTADeploymentNode `protobuf:"bytes,1,rep,name=result" json:"result,omitempty"`
+}
+
+func (m *ListFUOTADeploymentNodesResponse) Reset()         { *m = ListFUOTADeploymentNodesResponse{} }
+func (m *ListFUOTADeploymentNodesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListFUOTADeploymentNodesResponse) ProtoMessage()    {}
+func (*ListFUOTADeploymentNodesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor17, []int{10}
+}
+
+func (m *ListFUOTADeploymentNodesResponse) GetResult() []*FUOTADeploymentNode {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*CreateFUOTADeploymentRequest)(nil), "api.CreateFUOTADeploymentRequest")
+	proto.RegisterType((*CreateFUOTADeploymentResponse)(nil), "api.CreateFUOTADeploymentResponse")
+	proto.RegisterType((*GetFUOTADeploymentRequest)(nil), "api.GetFUOTADeploymentRequest")
+	proto.RegisterType((*GetFUOTADeploymentResponse)(nil), "api.GetFUOTADeploymentResponse")
+	proto.RegisterType((*ListFUOTADeploymentByAppEUIRequest)(nil), "api.ListFUOTADeploymentByAppEUIRequest")
+	proto.RegisterType((*ListFUOTADeploymentResponse)(nil), "api.ListFUOTADeploymentResponse")
+	proto.RegisterType((*DeleteFUOTADeploymentRequest)(nil), "api.DeleteFUOTADeploymentRequest")
+	proto.RegisterType((*DeleteFUOTADeploymentResponse)(nil), "api.DeleteFUOTADeploymentResponse")
+	proto.RegisterType((*FUOTADeploymentNode)(nil), "api.FUOTADeploymentNode")
+	proto.RegisterType((*ListFUOTADeploymentNodesRequest)(nil), "api.ListFUOTADeploymentNodesRequest")
+	proto.RegisterType((*ListFUOTADeploymentNodesResponse)(nil), "api.ListFUOTADeploymentNodesResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for FUOTADeployment service
+
+type FUOTADeploymentClient interface {
+	// Create creates the given deployment.
+	Create(ctx context.Context, in *CreateFUOTADeploymentRequest, opts ...grpc.CallOption) (*CreateFUOTADeploymentResponse, error)
+	// Get returns the deployment matching the given id.
+	Get(ctx context.Context, in *GetFUOTADeploymentRequest, opts ...grpc.CallOption) (*GetFUOTADeploymentResponse, error)
+	// ListByAppEUI lists the deployments of the given application, newest first.
+	ListByAppEUI(ctx context.Context, in *ListFUOTADeploymentByAppEUIRequest, opts ...grpc.CallOption) (*ListFUOTADeploymentResponse, error)
+	// Delete deletes the deployment matching the given id. The remaining
+	// items of the deployment are removed from the queues of the nodes.
+	Delete(ctx context.Context, in *DeleteFUOTADeploymentRequest, opts ...grpc.CallOption) (*DeleteFUOTADeploymentResponse, error)
+	// ListNodes lists the update state of the nodes of the deployment.
+	ListNodes(ctx context.Context, in *ListFUOTADeploymentNodesRequest, opts ...grpc.CallOption) (*ListFUOTADeploymentNodesResponse, error)
+}
+
+type fUOTADeploymentClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewFUOTADeploymentClient(cc *grpc.ClientConn) FUOTADeploymentClient {
+	return &fUOTADeploymentClient{cc}
+}
+
+func (c *fUOTADeploymentClient) Create(ctx context.Context, in *CreateFUOTADeploymentRequest, opts ...grpc.CallOption) (*CreateFUOTADeploymentResponse, error) {
+	out := new(CreateFUOTADeploymentResponse)
+	err := grpc.Invoke(ctx, "/api.FUOTADeployment/Create", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fUOTADeploymentClient) Get(ctx context.Context, in *GetFUOTADeploymentRequest, opts ...grpc.CallOption) (*GetFUOTADeploymentResponse, error) {
+	out := new(GetFUOTADeploymentResponse)
+	err := grpc.Invoke(ctx, "/api.FUOTADeployment/Get", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fUOTADeploymentClient) ListByAppEUI(ctx context.Context, in *ListFUOTADeploymentByAppEUIRequest, opts ...grpc.CallOption) (*ListFUOTADeploymentResponse, error) {
+	out := new(ListFUOTADeploymentResponse)
+	err := grpc.Invoke(ctx, "/api.FUOTADeployment/ListByAppEUI", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fUOTADeploymentClient) Delete(ctx context.Context, in *DeleteFUOTADeploymentRequest, opts ...grpc.CallOption) (*DeleteFUOTADeploymentResponse, error) {
+	out := new(DeleteFUOTADeploymentResponse)
+	err := grpc.Invoke(ctx, "/api.FUOTADeployment/Delete", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fUOTADeploymentClient) ListNodes(ctx context.Context, in *ListFUOTADeploymentNodesRequest, opts ...grpc.CallOption) (*ListFUOTADeploymentNodesResponse, error) {
+	out := new(ListFUOTADeploymentNodesResponse)
+	err := grpc.Invoke(ctx, "/api.FUOTADeployment/ListNodes", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for FUOTADeployment service
+
+type FUOTADeploymentServer interface {
+	// Create creates the given deployment.
+	Create(context.Context, *CreateFUOTADeploymentRequest) (*CreateFUOTADeploymentResponse, error)
+	// Get returns the deployment matching the given id.
+	Get(context.Context, *GetFUOTADeploymentRequest) (*GetFUOTADeploymentResponse, error)
+	// ListByAppEUI lists the deployments of the given application, newest first.
+	ListByAppEUI(context.Context, *ListFUOTADeploymentByAppEUIRequest) (*ListFUOTADeploymentResponse, error)
+	// Delete deletes the deployment matching the given id. The remaining
+	// items of the deployment are removed from the queues of the nodes.
+	Delete(context.Context, *DeleteFUOTADeploymentRequest) (*DeleteFUOTADeploymentResponse, error)
+	// ListNodes lists the update state of the nodes of the deployment.
+	ListNodes(context.Context, *ListFUOTADeploymentNodesRequest) (*ListFUOTADeploymentNodesResponse, error)
+}
+
+func RegisterFUOTADeploymentServer(s *grpc.Server, srv FUOTADeploymentServer) {
+	s.RegisterService(&_FUOTADeployment_serviceDesc, srv)
+}
+
+func _FUOTADeployment_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFUOTADeploymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FUOTADeploymentServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.FUOTADeployment/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FUOTADeploymentServer).Create(ctx, req.(*CreateFUOTADeploymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FUOTADeployment_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFUOTADeploymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FUOTADeploymentServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.FUOTADeployment/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FUOTADeploymentServer).Get(ctx, req.(*GetFUOTADeploymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FUOTADeployment_ListByAppEUI_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFUOTADeploymentByAppEUIRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FUOTADeploymentServer).ListByAppEUI(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.FUOTADeployment/ListByAppEUI",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FUOTADeploymentServer).ListByAppEUI(ctx, req.(*ListFUOTADeploymentByAppEUIRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FUOTADeployment_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFUOTADeploymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FUOTADeploymentServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.FUOTADeployment/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FUOTADeploymentServer).Delete(ctx, req.(*DeleteFUOTADeploymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FUOTADeployment_ListNodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFUOTADeploymentNodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FUOTADeploymentServer).ListNodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.FUOTADeployment/ListNodes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FUOTADeploymentServer).ListNodes(ctx, req.(*ListFUOTADeploymentNodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _FUOTADeployment_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.FUOTADeployment",
+	HandlerType: (*FUOTADeploymentServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _FUOTADeployment_Create_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _FUOTADeployment_Get_Handler,
+		},
+		{
+			MethodName: "ListByAppEUI",
+			Handler:    _FUOTADeployment_ListByAppEUI_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _FUOTADeployment_Delete_Handler,
+		},
+		{
+			MethodName: "ListNodes",
+			Handler:    _FUOTADeployment_ListNodes_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "fuotaDeployment.proto",
+}
+
+func init() { proto.RegisterFile("fuotaDeployment.proto", fileDescriptor17) }
+
+var fileDescriptor17 = []byte{
+	// 752 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x96, 0xcd, 0x6e, 0xd3, 0x4a,
+	0x14, 0xc7, 0xe5, 0x24, 0x75, 0x9b, 0xd3, 0xb4, 0xbd, 0x9a, 0xdb, 0x5e, 0xf9, 0xfa, 0xa6, 0xad,
+	0xef, 0x88, 0x8f, 0x28, 0xa0, 0x04, 0xca, 0x02, 0x09, 0xb1, 0x09, 0x84, 0x56, 0x95, 0x10, 0x20,
+	0xd3, 0xb2, 0x9f, 0xc6, 0xd3, 0x68, 0x50, 0xe2, 0x71, 0x67, 0xc6, 0x45, 0xa5, 0xea, 0xa6, 0x7b,
+	0x56, 0xbc, 0x08, 0xaf, 0xc0, 0x9e, 0x1d, 0xaf, 0xc0, 0x53, 0xb0, 0x42, 0x1e, 0x3b, 0x89, 0x63,
+	0x3c, 0x29, 0x3b, 0x9f, 0xaf, 0x39, 0x39, 0xe7, 0xf7, 0x1f, 0x3b, 0xb0, 0x75, 0x1a, 0x73, 0x45,
+	0xfa, 0x34, 0x1a, 0xf1, 0x8b, 0x31, 0x0d, 0x55, 0x27, 0x12, 0x5c, 0x71, 0x54, 0x25, 0x11, 0x73,
+	0x9b, 0x43, 0xce, 0x87, 0x23, 0xda, 0x25, 0x11, 0xeb, 0x92, 0x30, 0xe4, 0x8a, 0x28, 0xc6, 0x43,
+	0x99, 0xa6, 0xe0, 0x2f, 0x15, 0x68, 0x3e, 0x17, 0x94, 0x28, 0xba, 0x7f, 0xfc, 0xfa, 0xa8, 0x37,
+	0x3b, 0xc2, 0xa7, 0x67, 0x31, 0x95, 0x0a, 0x21, 0xa8, 0x85, 0x64, 0x4c, 0x1d, 0xcb, 0xb3, 0x5a,
+	0x75, 0x5f, 0x3f, 0xa3, 0x36, 0xfc, 0x35, 0x8e, 0x47, 0x8a, 0x0d, 0x88, 0x54, 0x07, 0x82, 0xc7,
+	0xd1, 0x61, 0xdf, 0xa9, 0x78, 0x56, 0xab, 0xea, 0xff, 0xe6, 0x47, 0x2e, 0xac, 0x9c, 0x32, 0x31,
+	0xfe, 0x40, 0x04, 0x75, 0xaa, 0x9e, 0xd5, 0x6a, 0xf8, 0x53, 0x1b, 0x75, 0x00, 0x4d, 0x9e, 0xfb,
+	0x54, 0x0e, 0x04, 0x8b, 0x14, 0x17, 0x4e, 0xcd, 0xb3, 0x5a, 0x6b, 0x7e, 0x49, 0x44, 0x9f, 0x25,
+	0xc8, 0xf0, 0x2d, 0xfb, 0x48, 0x9d, 0x25, 0x9d, 0x35, 0xb5, 0xd1, 0x0e, 0x80, 0xa0, 0x41, 0x1c,
+	0x06, 0x24, 0x1c, 0x5c, 0x38, 0xb6, 0x8e, 0xe6, 0x3c, 0x68, 0x13, 0x96, 0x4e, 0xdf, 0x70, 0xa1,
+	0x9c, 0x65, 0x1d, 0x4a, 0x0d, 0xe4, 0xc0, 0xb2, 0x54, 0x44, 0xa8, 0x9e, 0x72, 0x56, 0xf4, 0x80,
+	0x13, 0x33, 0x89, 0x28, 0x36, 0xa6, 0x3c, 0x56, 0x4e, 0x5d, 0x57, 0x4c, 0x4c, 0xdc, 0x85, 0x6d,
+	0xc3, 0xc6, 0x64, 0xc4, 0x43, 0x49, 0xd1, 0x3a, 0x54, 0x58, 0xa0, 0x17, 0x56, 0xf5, 0x2b, 0x2c,
+	0xc0, 0xf7, 0xe0, 0xdf, 0x03, 0xaa, 0x0c, 0xfb, 0x2d, 0x26, 0x7f, 0xab, 0x82, 0x5b, 0x96, 0x5d,
+	0x7e, 0xf6, 0x14, 0x4f, 0x25, 0x87, 0xe7, 0x1f, 0xb0, 0x49, 0x14, 0xbd, 0x38, 0x3e, 0xd4, 0x0b,
+	0xaf, 0xfb, 0x99, 0x55, 0x8a, 0xad, 0x66, 0xc0, 0x86, 0xa1, 0x31, 0x01, 0x90, 0x5b, 0xf7, 0x9c,
+	0xcf, 0x80, 0xcf, 0xfe, 0x23, 0x7c, 0xcb, 0x0b, 0xf1, 0xad, 0x98, 0xf1, 0xd5, 0xf3, 0xf8, 0x36,
+	0x61, 0x49, 0x2a, 0xa2, 0xa8, 0x03, 0x7a, 0xd0, 0xd4, 0xc8, 0x43, 0x5d, 0x35, 0x42, 0x6d, 0xcc,
+	0x41, 0x4d, 0x7e, 0x5b, 0x40, 0x49, 0x30, 0x62, 0x21, 0x75, 0xd6, 0x74, 0xd1, 0xd4, 0x46, 0x4d,
+	0xa8, 0x0f, 0x34, 0xf0, 0xa0, 0xa7, 0x9c, 0x75, 0x1d, 0x9c, 0x39, 0x92, 0x68, 0x1c, 0x05, 0x59,
+	0x74, 0x23, 0x8d, 0x4e, 0x1d, 0xf8, 0x29, 0xe0, 0x97, 0x4c, 0x16, 0x71, 0x3e, 0xbb, 0xe8, 0x69,
+	0x24, 0x13, 0x11, 0xcc, 0x88, 0x59, 0x79, 0x62, 0xf8, 0x1d, 0xfc, 0x57, 0x52, 0x3d, 0x15, 0xc3,
+	0x63, 0xb0, 0x05, 0x95, 0xf1, 0x48, 0x39, 0x96, 0x57, 0x6d, 0xad, 0xee, 0xed, 0x76, 0x48, 0xc4,
+	0x3a, 0x66, 0xf5, 0xf8, 0x59, 0x3a, 0xee, 0x40, 0xb3, 0x4f, 0x47, 0xd4, 0x78, 0xe9, 0x8b, 0xa2,
+	0xdc, 0x85, 0x6d, 0x43, 0x7e, 0x7a, 0x30, 0xfe, 0x6a, 0xc1, 0xdf, 0x85, 0xd8, 0x2b, 0x1e, 0x68,
+	0x29, 0x06, 0xf4, 0x3c, 0x37, 0x58, 0x6a, 0xcd, 0xc0, 0x55, 0xf2, 0xe0, 0xee, 0xc0, 0x7a, 0x78,
+	0xb2, 0x2f, 0xc8, 0xd0, 0xa7, 0x03, 0xca, 0xce, 0x69, 0xa0, 0x05, 0xbc, 0xe6, 0x17, 0xbc, 0xc8,
+	0x83, 0xd5, 0x31, 0x93, 0x92, 0x85, 0xc3, 0xc4, 0x9d, 0xbd, 0x30, 0xf2, 0xae, 0xe4, 0x7c, 0x2a,
+	0x04, 0x17, 0x5a, 0xb7, 0x75, 0x3f, 0x35, 0xe6, 0x51, 0xd9, 0x45, 0x54, 0x0f, 0x61, 0xb7, 0x64,
+	0xd9, 0xc9, 0x18, 0xd2, 0xb4, 0x97, 0x23, 0xf0, 0xcc, 0x25, 0x19, 0xa4, 0x07, 0x05, 0x48, 0x8e,
+	0x86, 0x54, 0x52, 0x32, 0xa1, 0xb3, 0xf7, 0xb3, 0x06, 0x1b, 0x85, 0x38, 0x3a, 0x03, 0x3b, 0x7d,
+	0xe9, 0xa0, 0xff, 0x75, 0xfd, 0xa2, 0x77, 0xb6, 0x8b, 0x17, 0xa5, 0x64, 0xc4, 0xbc, 0xeb, 0xef,
+	0x3f, 0x3e, 0x57, 0x5c, 0xbc, 0xa5, 0x3f, 0x0c, 0x85, 0xef, 0x87, 0x7c, 0x62, 0xb5, 0xd1, 0x7b,
+	0xa8, 0x1e, 0x50, 0x85, 0x76, 0x8c, 0xa2, 0x4a, 0x9b, 0xdd, 0x24, 0x3a, 0x8c, 0x75, 0xa7, 0x26,
+	0x72, 0x4b, 0x3b, 0x75, 0x2f, 0x59, 0x70, 0x85, 0x3e, 0x59, 0xd0, 0x48, 0x36, 0x39, 0xb9, 0x18,
+	0xe8, 0xae, 0x3e, 0xf5, 0xe6, 0xab, 0xe3, 0x7a, 0xa6, 0xc4, 0x69, 0xff, 0x3d, 0xdd, 0xff, 0x3e,
+	0x6a, 0x97, 0xf7, 0x27, 0x51, 0x34, 0x62, 0x03, 0xfd, 0x51, 0xec, 0x5e, 0xa6, 0xf7, 0xee, 0x0a,
+	0x49, 0xb0, 0x53, 0xc1, 0x67, 0xeb, 0x5e, 0x74, 0x5b, 0x5c, 0xbc, 0x28, 0x65, 0x7e, 0x09, 0xed,
+	0x45, 0x4b, 0xb8, 0xb6, 0xa0, 0x9e, 0x0c, 0xa2, 0xf5, 0x83, 0x6e, 0x99, 0x06, 0xcb, 0x2b, 0xd2,
+	0xbd, 0x7d, 0x43, 0x56, 0xd6, 0xbe, 0xa5, 0xdb, 0x63, 0xe4, 0x99, 0xdb, 0x77, 0xc3, 0xa4, 0xe2,
+	0xc4, 0xd6, 0xff, 0x0b, 0x1e, 0xfd, 0x1a, 0x00, 0xc1, 0x33, 0x39, 0x5b, 0x53, 0x08, 0x00, 0x00,
+}
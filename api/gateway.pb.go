@@ -0,0 +1,804 @@
+// Code generated by protoc-gen-go.
+// source: gateway.proto
+// DO NOT EDIT!
+
+package api
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import _ "github.com/grpc-ecosystem/grpc-gateway/third_party/googleapis/google/api"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+type CreateGatewayRequest struct {
+	// hex encoded MAC of the gateway
+	Mac         string  `protobuf:"bytes,1,opt,name=mac" json:"mac,omitempty"`
+	Name        string  `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	Description string  `protobuf:"bytes,3,opt,name=description" json:"description,omitempty"`
+	Latitude    float64 `protobuf:"fixed64,4,opt,name=latitude" json:"latitude,omitempty"`
+	Longitude   float64 `protobuf:"fixed64,5,opt,name=longitude" json:"longitude,omitempty"`
+	// altitude (meters)
+	Altitude float64 `protobuf:"fixed64,6,opt,name=altitude" json:"altitude,omitempty"`
+	// id of the organization owning the gateway
+	OrganizationID int64 `protobuf:"varint,7,opt,name=organizationID" json:"organizationID,omitempty"`
+}
+
+func (m *CreateGatewayRequest) Reset()                    { *m = CreateGatewayRequest{} }
+func (m *CreateGatewayRequest) String() string            { return proto.CompactTextString(m) }
+func (*CreateGatewayRequest) ProtoMessage()               {}
+func (*CreateGatewayRequest) Descriptor() ([]byte, []int) { return fileDescriptor18, []int{0} }
+
+func (m *CreateGatewayRequest) GetMac() string {
+	if m != nil {
+		return m.Mac
+	}
+	return ""
+}
+
+func (m *CreateGatewayRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CreateGatewayRequest) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *CreateGatewayRequest) GetLatitude() float64 {
+	if m != nil {
+		return m.Latitude
+	}
+	return 0
+}
+
+func (m *CreateGatewayRequest) GetLongitude() float64 {
+	if m != nil {
+		return m.Longitude
+	}
+	return 0
+}
+
+func (m *CreateGatewayRequest) GetAltitude() float64 {
+	if m != nil {
+		return m.Altitude
+	}
+	return 0
+}
+
+func (m *CreateGatewayRequest) GetOrganizationID() int64 {
+	if m != nil {
+		return m.OrganizationID
+	}
+	return 0
+}
+
+type CreateGatewayResponse struct {
+}
+
+func (m *CreateGatewayResponse) Reset()                    { *m = CreateGatewayResponse{} }
+func (m *CreateGatewayResponse) String() string            { return proto.CompactTextString(m) }
+func (*CreateGatewayResponse) ProtoMessage()               {}
+func (*CreateGatewayResponse) Descriptor() ([]byte, []int) { return fileDescriptor18, []int{1} }
+
+type GetGatewayRequest struct {
+	// hex encoded MAC of the gateway
+	Mac string `protobuf:"bytes,1,opt,name=mac" json:"mac,omitempty"`
+}
+
+func (m *GetGatewayRequest) Reset()                    { *m = GetGatewayRequest{} }
+func (m *GetGatewayRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetGatewayRequest) ProtoMessage()               {}
+func (*GetGatewayRequest) Descriptor() ([]byte, []int) { return fileDescriptor18, []int{2} }
+
+func (m *GetGatewayRequest) GetMac() string {
+	if m != nil {
+		return m.Mac
+	}
+	return ""
+}
+
+type GetGatewayResponse struct {
+	// hex encoded MAC of the gateway
+	Mac         string  `protobuf:"bytes,1,opt,name=mac" json:"mac,omitempty"`
+	Name        string  `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	Description string  `protobuf:"bytes,3,opt,name=description" json:"description,omitempty"`
+	Latitude    float64 `protobuf:"fixed64,4,opt,name=latitude" json:"latitude,omitempty"`
+	Longitude   float64 `protobuf:"fixed64,5,opt,name=longitude" json:"longitude,omitempty"`
+	// altitude (meters)
+	Altitude float64 `protobuf:"fixed64,6,opt,name=altitude" json:"altitude,omitempty"`
+	// created at timestamp (RFC3339)
+	CreatedAt string `protobuf:"bytes,7,opt,name=createdAt" json:"createdAt,omitempty"`
+	// updated at timestamp (RFC3339)
+	UpdatedAt string `protobuf:"bytes,8,opt,name=updatedAt" json:"updatedAt,omitempty"`
+	// timestamp of the last received uplink (RFC3339, empty when never seen)
+	LastSeenAt string `protobuf:"bytes,9,opt,name=lastSeenAt" json:"lastSeenAt,omitempty"`
+	// id of the organization owning the gateway (0 when not set)
+	OrganizationID int64 `protobuf:"varint,10,opt,name=organizationID" json:"organizationID,omitempty"`
+}
+
+func (m *GetGatewayResponse) Reset()                    { *m = GetGatewayResponse{} }
+func (m *GetGatewayResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetGatewayResponse) ProtoMessage()               {}
+func (*GetGatewayResponse) Descriptor() ([]byte, []int) { return fileDescriptor18, []int{3} }
+
+func (m *GetGatewayResponse) GetMac() string {
+	if m != nil {
+		return m.Mac
+	}
+	return ""
+}
+
+func (m *GetGatewayResponse) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *GetGatewayResponse) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *GetGatewayResponse) GetLatitude() float64 {
+	if m != nil {
+		return m.Latitude
+	}
+	return 0
+}
+
+func (m *GetGatewayResponse) GetLongitude() float64 {
+	if m != nil {
+		return m.Longitude
+	}
+	return 0
+}
+
+func (m *GetGatewayResponse) GetAltitude() float64 {
+	if m != nil {
+		return m.Altitude
+	}
+	return 0
+}
+
+func (m *GetGatewayResponse) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return ""
+}
+
+func (m *GetGatewayResponse) GetUpdatedAt() string {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return ""
+}
+
+func (m *GetGatewayResponse) GetLastSeenAt() string {
+	if m != nil {
+		return m.LastSeenAt
+	}
+	return ""
+}
+
+func (m *GetGatewayResponse) GetOrganizationID() int64 {
+	if m != nil {
+		return m.OrganizationID
+	}
+	return 0
+}
+
+type ListGatewayRequest struct {
+	Limit  int64 `protobuf:"varint,1,opt,name=limit" json:"limit,omitempty"`
+	Offset int64 `protobuf:"varint,2,opt,name=offset" json:"offset,omitempty"`
+	// id of the organization of which the gateways are listed (0 = all
+	// gateways, admin users only)
+	OrganizationID int64 `protobuf:"varint,3,opt,name=organizationID" json:"organizationID,omitempty"`
+}
+
+func (m *ListGatewayRequest) Reset()                    { *m = ListGatewayRequest{} }
+func (m *ListGatewayRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListGatewayRequest) ProtoMessage()               {}
+func (*ListGatewayRequest) Descriptor() ([]byte, []int) { return fileDescriptor18, []int{4} }
+
+func (m *ListGatewayRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListGatewayRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *ListGatewayRequest) GetOrganizationID() int64 {
+	if m != nil {
+		return m.OrganizationID
+	}
+	return 0
+}
+
+type ListGatewayResponse struct {
+	TotalCount int64                 `protobuf:"varint,1,opt,name=totalCount" json:"totalCount,omitempty"`
+	Result     []*GetGatewayResponse `protobuf:"bytes,2,rep,name=result" json:"result,omitempty"`
+}
+
+func (m *ListGatewayResponse) Reset()                    { *m = ListGatewayResponse{} }
+func (m *ListGatewayResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListGatewayResponse) ProtoMessage()               {}
+func (*ListGatewayResponse) Descriptor() ([]byte, []int) { return fileDescriptor18, []int{5} }
+
+func (m *ListGatewayResponse) GetTotalCount() int64 {
+	if m != nil {
+		return m.TotalCount
+	}
+	return 0
+}
+
+func (m *ListGatewayResponse) GetResult() []*GetGatewayResponse {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type UpdateGatewayRequest struct {
+	// hex encoded MAC of the gateway
+	Mac         string  `protobuf:"bytes,1,opt,name=mac" json:"mac,omitempty"`
+	Name        string  `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	Description string  `protobuf:"bytes,3,opt,name=description" json:"description,omitempty"`
+	Latitude    float64 `protobuf:"fixed64,4,opt,name=latitude" json:"latitude,omitempty"`
+	Longitude   float64 `protobuf:"fixed64,5,opt,name=longitude" json:"longitude,omitempty"`
+	// altitude (meters)
+	Altitude float64 `protobuf:"fixed64,6,opt,name=altitude" json:"altitude,omitempty"`
+	// id of the organization owning the gateway
+	OrganizationID int64 `protobuf:"varint,7,opt,name=organizationID" json:"organizationID,omitempty"`
+}
+
+func (m *UpdateGatewayRequest) Reset()                    { *m = UpdateGatewayRequest{} }
+func (m *UpdateGatewayRequest) String() string            { return proto.CompactTextString(m) }
+func (*UpdateGatewayRequest) ProtoMessage()               {}
+func (*UpdateGatewayRequest) Descriptor() ([]byte, []int) { return fileDescriptor18, []int{6} }
+
+func (m *UpdateGatewayRequest) GetMac() string {
+	if m != nil {
+		return m.Mac
+	}
+	return ""
+}
+
+func (m *UpdateGatewayRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *UpdateGatewayRequest) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *UpdateGatewayRequest) GetLatitude() float64 {
+	if m != nil {
+		return m.Latitude
+	}
+	return 0
+}
+
+func (m *UpdateGatewayRequest) GetLongitude() float64 {
+	if m != nil {
+		return m.Longitude
+	}
+	return 0
+}
+
+func (m *UpdateGatewayRequest) GetAltitude() float64 {
+	if m != nil {
+		return m.Altitude
+	}
+	return 0
+}
+
+func (m *UpdateGatewayRequest) GetOrganizationID() int64 {
+	if m != nil {
+		return m.OrganizationID
+	}
+	return 0
+}
+
+type UpdateGatewayResponse struct {
+}
+
+func (m *UpdateGatewayResponse) Reset()                    { *m = UpdateGatewayResponse{} }
+func (m *UpdateGatewayResponse) String() string            { return proto.CompactTextString(m) }
+func (*UpdateGatewayResponse) ProtoMessage()               {}
+func (*UpdateGatewayResponse) Descriptor() ([]byte, []int) { return fileDescriptor18, []int{7} }
+
+type DeleteGatewayRequest struct {
+	// hex encoded MAC of the gateway
+	Mac string `protobuf:"bytes,1,opt,name=mac" json:"mac,omitempty"`
+}
+
+func (m *DeleteGatewayRequest) Reset()                    { *m = DeleteGatewayRequest{} }
+func (m *DeleteGatewayRequest) String() string            { return proto.CompactTextString(m) }
+func (*DeleteGatewayRequest) ProtoMessage()               {}
+func (*DeleteGatewayRequest) Descriptor() ([]byte, []int) { return fileDescriptor18, []int{8} }
+
+func (m *DeleteGatewayRequest) GetMac() string {
+	if m != nil {
+		return m.Mac
+	}
+	return ""
+}
+
+type DeleteGatewayResponse struct {
+}
+
+func (m *DeleteGatewayResponse) Reset()                    { *m = DeleteGatewayResponse{} }
+func (m *DeleteGatewayResponse) String() string            { return proto.CompactTextString(m) }
+func (*DeleteGatewayResponse) ProtoMessage()               {}
+func (*DeleteGatewayResponse) Descriptor() ([]byte, []int) { return fileDescriptor18, []int{9} }
+
+type GetGatewayStatsRequest struct {
+	// hex encoded MAC of the gateway
+	Mac string `protobuf:"bytes,1,opt,name=mac" json:"mac,omitempty"`
+	// start of the period (RFC3339)
+	Start string `protobuf:"bytes,2,opt,name=start" json:"start,omitempty"`
+	// end of the period (RFC3339, default now)
+	End string `protobuf:"bytes,3,opt,name=end" json:"end,omitempty"`
+	// aggregation interval
+	Interval AggregationInterval `protobuf:"varint,4,opt,name=interval,enum=api.AggregationInterval" json:"interval,omitempty"`
+}
+
+func (m *GetGatewayStatsRequest) Reset()                    { *m = GetGatewayStatsRequest{} }
+func (m *GetGatewayStatsRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetGatewayStatsRequest) ProtoMessage()               {}
+func (*GetGatewayStatsRequest) Descriptor() ([]byte, []int) { return fileDescriptor18, []int{10} }
+
+func (m *GetGatewayStatsRequest) GetMac() string {
+	if m != nil {
+		return m.Mac
+	}
+	return ""
+}
+
+func (m *GetGatewayStatsRequest) GetStart() string {
+	if m != nil {
+		return m.Start
+	}
+	return ""
+}
+
+func (m *GetGatewayStatsRequest) GetEnd() string {
+	if m != nil {
+		return m.End
+	}
+	return ""
+}
+
+func (m *GetGatewayStatsRequest) GetInterval() AggregationInterval {
+	if m != nil {
+		return m.Interval
+	}
+	return AggregationInterval_PERIOD
+}
+
+type GatewayStats struct {
+	// start of the aggregation interval (RFC3339)
+	Timestamp string `protobuf:"bytes,1,opt,name=timestamp" json:"timestamp,omitempty"`
+	// number of received uplink packets
+	RxPacketsReceived uint32 `protobuf:"varint,2,opt,name=rxPacketsReceived" json:"rxPacketsReceived,omitempty"`
+	// average RSSI of the received packets
+	AvgRSSI float64 `protobuf:"fixed64,3,opt,name=avgRSSI" json:"avgRSSI,omitempty"`
+	// average SNR of the received packets
+	AvgLoRaSNR float64 `protobuf:"fixed64,4,opt,name=avgLoRaSNR" json:"avgLoRaSNR,omitempty"`
+	// (estimated) number of transmitted downlink packets
+	TxPacketsEmitted uint32 `protobuf:"varint,5,opt,name=txPacketsEmitted" json:"txPacketsEmitted,omitempty"`
+	// (estimated) total downlink airtime (ms)
+	TxAirtime uint32 `protobuf:"varint,6,opt,name=txAirtime" json:"txAirtime,omitempty"`
+}
+
+func (m *GatewayStats) Reset()                    { *m = GatewayStats{} }
+func (m *GatewayStats) String() string            { return proto.CompactTextString(m) }
+func (*GatewayStats) ProtoMessage()               {}
+func (*GatewayStats) Descriptor() ([]byte, []int) { return fileDescriptor18, []int{11} }
+
+func (m *GatewayStats) GetTimestamp() string {
+	if m != nil {
+		return m.Timestamp
+	}
+	return ""
+}
+
+func (m *GatewayStats) GetRxPacketsReceived() uint32 {
+	if m != nil {
+		return m.RxPacketsReceived
+	}
+	return 0
+}
+
+func (m *GatewayStats) GetAvgRSSI() float64 {
+	if m != nil {
+		return m.AvgRSSI
+	}
+	return 0
+}
+
+func (m *GatewayStats) GetAvgLoRaSNR() float64 {
+	if m != nil {
+		return m.AvgLoRaSNR
+	}
+	return 0
+}
+
+func (m *GatewayStats) GetTxPacketsEmitted() uint32 {
+	if m != nil {
+		return m.TxPacketsEmitted
+	}
+	return 0
+}
+
+func (m *GatewayStats) GetTxAirtime() uint32 {
+	if m != nil {
+		return m.TxAirtime
+	}
+	return 0
+}
+
+type GetGatewayStatsResponse struct {
+	Result []*GatewayStats `protobuf:"bytes,1,rep,name=result" json:"result,omitempty"`
+}
+
+func (m *GetGatewayStatsResponse) Reset()                    { *m = GetGatewayStatsResponse{} }
+func (m *GetGatewayStatsResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetGatewayStatsResponse) ProtoMessage()               {}
+func (*GetGatewayStatsResponse) Descriptor() ([]byte, []int) { return fileDescriptor18, []int{12} }
+
+func (m *GetGatewayStatsResponse) GetResult() []*GatewayStats {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*CreateGatewayRequest)(nil), "api.CreateGatewayRequest")
+	proto.RegisterType((*CreateGatewayResponse)(nil), "api.CreateGatewayResponse")
+	proto.RegisterType((*GetGatewayRequest)(nil), "api.GetGatewayRequest")
+	proto.RegisterType((*GetGatewayResponse)(nil), "api.GetGatewayResponse")
+	proto.RegisterType((*ListGatewayRequest)(nil), "api.ListGatewayRequest")
+	proto.RegisterType((*ListGatewayResponse)(nil), "api.ListGatewayResponse")
+	proto.RegisterType((*UpdateGatewayRequest)(nil), "api.UpdateGatewayRequest")
+	proto.RegisterType((*UpdateGatewayResponse)(nil), "api.UpdateGatewayResponse")
+	proto.RegisterType((*DeleteGatewayRequest)(nil), "api.DeleteGatewayRequest")
+	proto.RegisterType((*DeleteGatewayResponse)(nil), "api.DeleteGatewayResponse")
+	proto.RegisterType((*GetGatewayStatsRequest)(nil), "api.GetGatewayStatsRequest")
+	proto.RegisterType((*GatewayStats)(nil), "api.GatewayStats")
+	proto.RegisterType((*GetGatewayStatsResponse)(nil), "api.GetGatewayStatsResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for Gateway service
+
+type GatewayClient interface {
+	// Create creates the given gateway.
+	Create(ctx context.Context, in *CreateGatewayRequest, opts ...grpc.CallOption) (*CreateGatewayResponse, error)
+	// Get returns the gateway for the requested MAC.
+	Get(ctx context.Context, in *GetGatewayRequest, opts ...grpc.CallOption) (*GetGatewayResponse, error)
+	// List lists the gateways.
+	List(ctx context.Context, in *ListGatewayRequest, opts ...grpc.CallOption) (*ListGatewayResponse, error)
+	// Update updates the gateway matching the given MAC.
+	Update(ctx context.Context, in *UpdateGatewayRequest, opts ...grpc.CallOption) (*UpdateGatewayResponse, error)
+	// Delete deletes the gateway matching the given MAC.
+	Delete(ctx context.Context, in *DeleteGatewayRequest, opts ...grpc.CallOption) (*DeleteGatewayResponse, error)
+	// GetStats returns the statistics of the gateway matching the given MAC.
+	GetStats(ctx context.Context, in *GetGatewayStatsRequest, opts ...grpc.CallOption) (*GetGatewayStatsResponse, error)
+}
+
+type gatewayClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewGatewayClient(cc *grpc.ClientConn) GatewayClient {
+	return &gatewayClient{cc}
+}
+
+func (c *gatewayClient) Create(ctx context.Context, in *CreateGatewayRequest, opts ...grpc.CallOption) (*CreateGatewayResponse, error) {
+	out := new(CreateGatewayResponse)
+	err := grpc.Invoke(ctx, "/api.Gateway/Create", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayClient) Get(ctx context.Context, in *GetGatewayRequest, opts ...grpc.CallOption) (*GetGatewayResponse, error) {
+	out := new(GetGatewayResponse)
+	err := grpc.Invoke(ctx, "/api.Gateway/Get", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayClient) List(ctx context.Context, in *ListGatewayRequest, opts ...grpc.CallOption) (*ListGatewayResponse, error) {
+	out := new(ListGatewayResponse)
+	err := grpc.Invoke(ctx, "/api.Gateway/List", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayClient) Update(ctx context.Context, in *UpdateGatewayRequest, opts ...grpc.CallOption) (*UpdateGatewayResponse, error) {
+	out := new(UpdateGatewayResponse)
+	err := grpc.Invoke(ctx, "/api.Gateway/Update", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayClient) Delete(ctx context.Context, in *DeleteGatewayRequest, opts ...grpc.CallOption) (*DeleteGatewayResponse, error) {
+	out := new(DeleteGatewayResponse)
+	err := grpc.Invoke(ctx, "/api.Gateway/Delete", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayClient) GetStats(ctx context.Context, in *GetGatewayStatsRequest, opts ...grpc.CallOption) (*GetGatewayStatsResponse, error) {
+	out := new(GetGatewayStatsResponse)
+	err := grpc.Invoke(ctx, "/api.Gateway/GetStats", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for Gateway service
+
+type GatewayServer interface {
+	// Create creates the given gateway.
+	Create(context.Context, *CreateGatewayRequest) (*CreateGatewayResponse, error)
+	// Get returns the gateway for the requested MAC.
+	Get(context.Context, *GetGatewayRequest) (*GetGatewayResponse, error)
+	// List lists the gateways.
+	List(context.Context, *ListGatewayRequest) (*ListGatewayResponse, error)
+	// Update updates the gateway matching the given MAC.
+	Update(context.Context, *UpdateGatewayRequest) (*UpdateGatewayResponse, error)
+	// Delete deletes the gateway matching the given MAC.
+	Delete(context.Context, *DeleteGatewayRequest) (*DeleteGatewayResponse, error)
+	// GetStats returns the statistics of the gateway matching the given MAC.
+	GetStats(context.Context, *GetGatewayStatsRequest) (*GetGatewayStatsResponse, error)
+}
+
+func RegisterGatewayServer(s *grpc.Server, srv GatewayServer) {
+	s.RegisterService(&_Gateway_serviceDesc, srv)
+}
+
+func _Gateway_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGatewayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Gateway/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServer).Create(ctx, req.(*CreateGatewayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gateway_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGatewayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Gateway/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServer).Get(ctx, req.(*GetGatewayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gateway_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGatewayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Gateway/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServer).List(ctx, req.(*ListGatewayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gateway_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGatewayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Gateway/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServer).Update(ctx, req.(*UpdateGatewayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gateway_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGatewayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Gateway/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServer).Delete(ctx, req.(*DeleteGatewayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gateway_GetStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGatewayStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServer).GetStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Gateway/GetStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServer).GetStats(ctx, req.(*GetGatewayStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Gateway_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.Gateway",
+	HandlerType: (*GatewayServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _Gateway_Create_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _Gateway_Get_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _Gateway_List_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _Gateway_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _Gateway_Delete_Handler,
+		},
+		{
+			MethodName: "GetStats",
+			Handler:    _Gateway_GetStats_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "gateway.proto",
+}
+
+func init() { proto.RegisterFile("gateway.proto", fileDescriptor18) }
+
+var fileDescriptor18 = []byte{
+	// 752 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x56, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0x96, 0xeb, 0x34, 0x4d, 0xa6, 0xa4, 0x3f, 0xdb, 0xb4, 0x75, 0xdd, 0xa8, 0x0a, 0x96, 0x40,
+	0xa1, 0x42, 0x8d, 0x54, 0x38, 0x71, 0x8b, 0x5a, 0x14, 0x55, 0xaa, 0x50, 0xb5, 0x11, 0x07, 0x24,
+	0x24, 0xb4, 0x24, 0x5b, 0xb3, 0xe0, 0xd8, 0xc6, 0x9e, 0x84, 0x16, 0xc4, 0x85, 0x13, 0x77, 0x5e,
+	0x05, 0xf1, 0x22, 0x5c, 0x11, 0x27, 0x1e, 0x04, 0xed, 0x4f, 0x12, 0x27, 0x76, 0xd5, 0x2b, 0xe2,
+	0xe6, 0xfd, 0xbe, 0xd9, 0xf9, 0xf9, 0x66, 0x67, 0xd7, 0x50, 0xf3, 0x19, 0xf2, 0x0f, 0xec, 0xfa,
+	0x28, 0x4e, 0x22, 0x8c, 0x88, 0xcd, 0x62, 0xe1, 0x36, 0xfc, 0x28, 0xf2, 0x03, 0xde, 0x66, 0xb1,
+	0x68, 0xb3, 0x30, 0x8c, 0x90, 0xa1, 0x88, 0xc2, 0x54, 0x9b, 0xb8, 0xeb, 0x2c, 0x64, 0xc1, 0x35,
+	0x8a, 0xbe, 0x01, 0xbc, 0x5f, 0x16, 0xd4, 0x4f, 0x12, 0xce, 0x90, 0x77, 0xb5, 0x2f, 0xca, 0xdf,
+	0x8f, 0x78, 0x8a, 0x64, 0x03, 0xec, 0x21, 0xeb, 0x3b, 0x56, 0xd3, 0x6a, 0x55, 0xa9, 0xfc, 0x24,
+	0x04, 0x4a, 0x21, 0x1b, 0x72, 0x67, 0x49, 0x41, 0xea, 0x9b, 0x34, 0x61, 0x75, 0xc0, 0xd3, 0x7e,
+	0x22, 0x62, 0x19, 0xc5, 0xb1, 0x15, 0x95, 0x85, 0x88, 0x0b, 0x95, 0x80, 0xa1, 0xc0, 0xd1, 0x80,
+	0x3b, 0xa5, 0xa6, 0xd5, 0xb2, 0xe8, 0x74, 0x4d, 0x1a, 0x50, 0x0d, 0xa2, 0xd0, 0xd7, 0xe4, 0xb2,
+	0x22, 0x67, 0x80, 0xdc, 0xc9, 0x02, 0xb3, 0xb3, 0xac, 0x77, 0x4e, 0xd6, 0xe4, 0x3e, 0xac, 0x45,
+	0x89, 0xcf, 0x42, 0xf1, 0x51, 0x95, 0x77, 0x76, 0xea, 0xac, 0x34, 0xad, 0x96, 0x4d, 0x17, 0x50,
+	0x6f, 0x17, 0xb6, 0x17, 0xaa, 0x4b, 0xe3, 0x28, 0x4c, 0xb9, 0x77, 0x0f, 0x36, 0xbb, 0x1c, 0x6f,
+	0xab, 0xd9, 0xfb, 0xb1, 0x04, 0x24, 0x6b, 0xa7, 0x77, 0xff, 0xe3, 0xe2, 0x34, 0xa0, 0xda, 0x57,
+	0x45, 0x0f, 0x3a, 0xa8, 0x74, 0xa9, 0xd2, 0x19, 0x20, 0xd9, 0x51, 0x3c, 0x30, 0x6c, 0x45, 0xb3,
+	0x53, 0x80, 0x1c, 0x00, 0x04, 0x2c, 0xc5, 0x1e, 0xe7, 0x61, 0x07, 0x9d, 0xaa, 0xa2, 0x33, 0x48,
+	0x81, 0xf0, 0x50, 0x28, 0xfc, 0x5b, 0x20, 0xe7, 0x22, 0x5d, 0x14, 0xb8, 0x0e, 0xcb, 0x81, 0x18,
+	0x0a, 0x54, 0xca, 0xd9, 0x54, 0x2f, 0xc8, 0x0e, 0x94, 0xa3, 0xcb, 0xcb, 0x94, 0xa3, 0x52, 0xcf,
+	0xa6, 0x66, 0x55, 0x10, 0xcb, 0x2e, 0x8c, 0x75, 0x09, 0x5b, 0x73, 0xb1, 0x4c, 0x93, 0x0e, 0x00,
+	0x30, 0x42, 0x16, 0x9c, 0x44, 0xa3, 0x70, 0x12, 0x31, 0x83, 0x90, 0x36, 0x94, 0x13, 0x9e, 0x8e,
+	0x02, 0x19, 0xd6, 0x6e, 0xad, 0x1e, 0xef, 0x1e, 0xb1, 0x58, 0x1c, 0xe5, 0xbb, 0x4d, 0x8d, 0x99,
+	0x9a, 0x95, 0xe7, 0x4a, 0xa9, 0xff, 0x75, 0x56, 0x16, 0xaa, 0x33, 0xb3, 0xd2, 0x82, 0xfa, 0x29,
+	0x0f, 0xf8, 0xed, 0x65, 0x4b, 0x17, 0x0b, 0x96, 0xc6, 0xc5, 0x57, 0x0b, 0x76, 0x66, 0xca, 0xf6,
+	0x90, 0x61, 0x7a, 0xb3, 0x78, 0x75, 0x58, 0x4e, 0x91, 0x25, 0x68, 0xd4, 0xd3, 0x0b, 0x69, 0xc7,
+	0xc3, 0x81, 0x91, 0x4d, 0x7e, 0x92, 0xc7, 0x50, 0x11, 0x21, 0xf2, 0x64, 0xcc, 0x02, 0x25, 0xd7,
+	0xda, 0xb1, 0xa3, 0x5a, 0xd8, 0xf1, 0xfd, 0x84, 0xfb, 0xba, 0x2c, 0xc3, 0xd3, 0xa9, 0xa5, 0xf7,
+	0xdb, 0x82, 0x3b, 0xd9, 0x3c, 0xa4, 0xb2, 0x28, 0x86, 0x3c, 0x45, 0x36, 0x8c, 0x4d, 0x1a, 0x33,
+	0x80, 0x3c, 0x84, 0xcd, 0xe4, 0xea, 0x82, 0xf5, 0xdf, 0x71, 0x99, 0x72, 0x9f, 0x8b, 0x31, 0x1f,
+	0xa8, 0xc4, 0x6a, 0x34, 0x4f, 0x10, 0x07, 0x56, 0xd8, 0xd8, 0xa7, 0xbd, 0xde, 0x99, 0x4a, 0xd4,
+	0xa2, 0x93, 0xa5, 0x3c, 0x8d, 0x6c, 0xec, 0x9f, 0x47, 0x94, 0xf5, 0x9e, 0x51, 0xd3, 0xdd, 0x0c,
+	0x42, 0x0e, 0x61, 0x03, 0x27, 0xee, 0x9e, 0x0e, 0x05, 0x22, 0x1f, 0xa8, 0x36, 0xd7, 0x68, 0x0e,
+	0x57, 0x19, 0x5f, 0x75, 0x44, 0x22, 0xb3, 0x54, 0xed, 0xae, 0xd1, 0x19, 0xe0, 0x9d, 0xc2, 0x6e,
+	0x4e, 0x6a, 0x33, 0x12, 0x0f, 0xa6, 0x47, 0xde, 0x52, 0x47, 0x7e, 0x53, 0x1f, 0xf9, 0xac, 0xa9,
+	0x31, 0x38, 0xfe, 0x5e, 0x82, 0x15, 0x43, 0x90, 0x17, 0x50, 0xd6, 0xb7, 0x28, 0xd9, 0x53, 0x1b,
+	0x8a, 0x1e, 0x0c, 0xd7, 0x2d, 0xa2, 0x4c, 0xfb, 0x9d, 0x2f, 0x3f, 0xff, 0x7c, 0x5b, 0x22, 0x5e,
+	0x4d, 0x3d, 0x4b, 0xe6, 0xd5, 0x4a, 0x9f, 0x58, 0x87, 0xa4, 0x07, 0x76, 0x97, 0x23, 0xd9, 0xc9,
+	0xcd, 0x9e, 0x76, 0x7a, 0xd3, 0x4c, 0x7a, 0xfb, 0xca, 0xe3, 0x36, 0xd9, 0x9a, 0xf3, 0xd8, 0xfe,
+	0x34, 0x64, 0xfd, 0xcf, 0xe4, 0x02, 0x4a, 0xf2, 0x42, 0x20, 0x7a, 0x77, 0xfe, 0x1e, 0x72, 0x9d,
+	0x3c, 0x61, 0xfc, 0x6e, 0x2b, 0xbf, 0xeb, 0x64, 0x3e, 0x53, 0xf2, 0x0a, 0xca, 0x7a, 0x36, 0x8c,
+	0x02, 0x45, 0xd7, 0x80, 0xeb, 0x16, 0x51, 0xc6, 0xef, 0x81, 0xf2, 0xeb, 0xb8, 0x45, 0xf9, 0x4a,
+	0x1d, 0x5e, 0x42, 0x59, 0x4f, 0x8e, 0x09, 0x50, 0x34, 0x70, 0xae, 0x5b, 0x44, 0xcd, 0x0b, 0x72,
+	0x58, 0x28, 0xc8, 0x1b, 0xa8, 0x74, 0x39, 0xea, 0xe3, 0xbe, 0xbf, 0x20, 0x69, 0x76, 0x18, 0xdd,
+	0x46, 0x31, 0x69, 0x62, 0xdc, 0x55, 0x31, 0xf6, 0xc9, 0x5e, 0x41, 0x8c, 0x76, 0x2a, 0x4d, 0x5f,
+	0x97, 0xd5, 0x6f, 0xc5, 0xa3, 0xbf, 0x03, 0x00, 0x33, 0x9a, 0x43, 0x7d, 0x9b, 0x08, 0x00, 0x00,
+}
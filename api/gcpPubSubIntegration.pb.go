@@ -0,0 +1,445 @@
+// Code generated by protoc-gen-go.
+// source: gcpPubSubIntegration.proto
+// DO NOT EDIT!
+
+package api
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import _ "github.com/grpc-ecosystem/grpc-gateway/third_party/googleapis/google/api"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+type CreateGCPPubSubIntegrationRequest struct {
+	// hex encoded AppEUI
+	AppEUI string `protobuf:"bytes,1,opt,name=appEUI" json:"appEUI,omitempty"`
+	// id of the Google Cloud project
+	ProjectID string `protobuf:"bytes,2,opt,name=projectID" json:"projectID,omitempty"`
+	// name of the topic to publish the events to
+	Topic string `protobuf:"bytes,3,opt,name=topic" json:"topic,omitempty"`
+	// JSON key file of the service account used for publishing
+	CredentialsJSON string `protobuf:"bytes,4,opt,name=credentialsJSON" json:"credentialsJSON,omitempty"`
+}
+
+func (m *CreateGCPPubSubIntegrationRequest) Reset()         { *m = CreateGCPPubSubIntegrationRequest{} }
+func (m *CreateGCPPubSubIntegrationRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateGCPPubSubIntegrationRequest) ProtoMessage()    {}
+func (*CreateGCPPubSubIntegrationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor22, []int{0}
+}
+
+func (m *CreateGCPPubSubIntegrationRequest) GetAppEUI() string {
+	if m != nil {
+		return m.AppEUI
+	}
+	return ""
+}
+
+func (m *CreateGCPPubSubIntegrationRequest) GetProjectID() string {
+	if m != nil {
+		return m.ProjectID
+	}
+	return ""
+}
+
+func (m *CreateGCPPubSubIntegrationRequest) GetTopic() string {
+	if m != nil {
+		return m.Topic
+	}
+	return ""
+}
+
+func (m *CreateGCPPubSubIntegrationRequest) GetCredentialsJSON() string {
+	if m != nil {
+		return m.CredentialsJSON
+	}
+	return ""
+}
+
+type CreateGCPPubSubIntegrationResponse struct {
+}
+
+func (m *CreateGCPPubSubIntegrationResponse) Reset()         { *m = CreateGCPPubSubIntegrationResponse{} }
+func (m *CreateGCPPubSubIntegrationResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateGCPPubSubIntegrationResponse) ProtoMessage()    {}
+func (*CreateGCPPubSubIntegrationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor22, []int{1}
+}
+
+type GetGCPPubSubIntegrationRequest struct {
+	// hex encoded AppEUI
+	AppEUI string `protobuf:"bytes,1,opt,name=appEUI" json:"appEUI,omitempty"`
+}
+
+func (m *GetGCPPubSubIntegrationRequest) Reset()                    { *m = GetGCPPubSubIntegrationRequest{} }
+func (m *GetGCPPubSubIntegrationRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetGCPPubSubIntegrationRequest) ProtoMessage()               {}
+func (*GetGCPPubSubIntegrationRequest) Descriptor() ([]byte, []int) { return fileDescriptor22, []int{2} }
+
+func (m *GetGCPPubSubIntegrationRequest) GetAppEUI() string {
+	if m != nil {
+		return m.AppEUI
+	}
+	return ""
+}
+
+type GetGCPPubSubIntegrationResponse struct {
+	// hex encoded AppEUI
+	AppEUI string `protobuf:"bytes,1,opt,name=appEUI" json:"appEUI,omitempty"`
+	// id of the Google Cloud project
+	ProjectID string `protobuf:"bytes,2,opt,name=projectID" json:"projectID,omitempty"`
+	// name of the topic to publish the events to
+	Topic string `protobuf:"bytes,3,opt,name=topic" json:"topic,omitempty"`
+	// email address of the service account (the credentials are not returned)
+	ClientEmail string `protobuf:"bytes,4,opt,name=clientEmail" json:"clientEmail,omitempty"`
+}
+
+func (m *GetGCPPubSubIntegrationResponse) Reset()         { *m = GetGCPPubSubIntegrationResponse{} }
+func (m *GetGCPPubSubIntegrationResponse) String() string { return proto.CompactTextString(m) }
+func (*GetGCPPubSubIntegrationResponse) ProtoMessage()    {}
+func (*GetGCPPubSubIntegrationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor22, []int{3}
+}
+
+func (m *GetGCPPubSubIntegrationResponse) GetAppEUI() string {
+	if m != nil {
+		return m.AppEUI
+	}
+	return ""
+}
+
+func (m *GetGCPPubSubIntegrationResponse) GetProjectID() string {
+	if m != nil {
+		return m.ProjectID
+	}
+	return ""
+}
+
+func (m *GetGCPPubSubIntegrationResponse) GetTopic() string {
+	if m != nil {
+		return m.Topic
+	}
+	return ""
+}
+
+func (m *GetGCPPubSubIntegrationResponse) GetClientEmail() string {
+	if m != nil {
+		return m.ClientEmail
+	}
+	return ""
+}
+
+type UpdateGCPPubSubIntegrationRequest struct {
+	// hex encoded AppEUI
+	AppEUI string `protobuf:"bytes,1,opt,name=appEUI" json:"appEUI,omitempty"`
+	// id of the Google Cloud project
+	ProjectID string `protobuf:"bytes,2,opt,name=projectID" json:"projectID,omitempty"`
+	// name of the topic to publish the events to
+	Topic string `protobuf:"bytes,3,opt,name=topic" json:"topic,omitempty"`
+	// JSON key file of the service account used for publishing (when empty, the current credentials are kept)
+	CredentialsJSON string `protobuf:"bytes,4,opt,name=credentialsJSON" json:"credentialsJSON,omitempty"`
+}
+
+func (m *UpdateGCPPubSubIntegrationRequest) Reset()         { *m = UpdateGCPPubSubIntegrationRequest{} }
+func (m *UpdateGCPPubSubIntegrationRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateGCPPubSubIntegrationRequest) ProtoMessage()    {}
+func (*UpdateGCPPubSubIntegrationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor22, []int{4}
+}
+
+func (m *UpdateGCPPubSubIntegrationRequest) GetAppEUI() string {
+	if m != nil {
+		return m.AppEUI
+	}
+	return ""
+}
+
+func (m *UpdateGCPPubSubIntegrationRequest) GetProjectID() string {
+	if m != nil {
+		return m.ProjectID
+	}
+	return ""
+}
+
+func (m *UpdateGCPPubSubIntegrationRequest) GetTopic() string {
+	if m != nil {
+		return m.Topic
+	}
+	return ""
+}
+
+func (m *UpdateGCPPubSubIntegrationRequest) GetCredentialsJSON() string {
+	if m != nil {
+		return m.CredentialsJSON
+	}
+	return ""
+}
+
+type UpdateGCPPubSubIntegrationResponse struct {
+}
+
+func (m *UpdateGCPPubSubIntegrationResponse) Reset()         { *m = UpdateGCPPubSubIntegrationResponse{} }
+func (m *UpdateGCPPubSubIntegrationResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateGCPPubSubIntegrationResponse) ProtoMessage()    {}
+func (*UpdateGCPPubSubIntegrationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor22, []int{5}
+}
+
+type DeleteGCPPubSubIntegrationRequest struct {
+	// hex encoded AppEUI
+	AppEUI string `protobuf:"bytes,1,opt,name=appEUI" json:"appEUI,omitempty"`
+}
+
+func (m *DeleteGCPPubSubIntegrationRequest) Reset()         { *m = DeleteGCPPubSubIntegrationRequest{} }
+func (m *DeleteGCPPubSubIntegrationRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteGCPPubSubIntegrationRequest) ProtoMessage()    {}
+func (*DeleteGCPPubSubIntegrationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor22, []int{6}
+}
+
+func (m *DeleteGCPPubSubIntegrationRequest) GetAppEUI() string {
+	if m != nil {
+		return m.AppEUI
+	}
+	return ""
+}
+
+type DeleteGCPPubSubIntegrationResponse struct {
+}
+
+func (m *DeleteGCPPubSubIntegrationResponse) Reset()         { *m = DeleteGCPPubSubIntegrationResponse{} }
+func (m *DeleteGCPPubSubIntegrationResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteGCPPubSubIntegrationResponse) ProtoMessage()    {}
+func (*DeleteGCPPubSubIntegrationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor22, []int{7}
+}
+
+func init() {
+	proto.RegisterType((*CreateGCPPubSubIntegrationRequest)(nil), "api.CreateGCPPubSubIntegrationRequest")
+	proto.RegisterType((*CreateGCPPubSubIntegrationResponse)(nil), "api.CreateGCPPubSubIntegrationResponse")
+	proto.RegisterType((*GetGCPPubSubIntegrationRequest)(nil), "api.GetGCPPubSubIntegrationRequest")
+	proto.RegisterType((*GetGCPPubSubIntegrationResponse)(nil), "api.GetGCPPubSubIntegrationResponse")
+	proto.RegisterType((*UpdateGCPPubSubIntegrationRequest)(nil), "api.UpdateGCPPubSubIntegrationRequest")
+	proto.RegisterType((*UpdateGCPPubSubIntegrationResponse)(nil), "api.UpdateGCPPubSubIntegrationResponse")
+	proto.RegisterType((*DeleteGCPPubSubIntegrationRequest)(nil), "api.DeleteGCPPubSubIntegrationRequest")
+	proto.RegisterType((*DeleteGCPPubSubIntegrationResponse)(nil), "api.DeleteGCPPubSubIntegrationResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for GCPPubSubIntegration service
+
+type GCPPubSubIntegrationClient interface {
+	// Create creates the Pub/Sub integration of the given application.
+	Create(ctx context.Context, in *CreateGCPPubSubIntegrationRequest, opts ...grpc.CallOption) (*CreateGCPPubSubIntegrationResponse, error)
+	// Get returns the Pub/Sub integration of the given application.
+	Get(ctx context.Context, in *GetGCPPubSubIntegrationRequest, opts ...grpc.CallOption) (*GetGCPPubSubIntegrationResponse, error)
+	// Update updates the Pub/Sub integration of the given application.
+	Update(ctx context.Context, in *UpdateGCPPubSubIntegrationRequest, opts ...grpc.CallOption) (*UpdateGCPPubSubIntegrationResponse, error)
+	// Delete deletes the Pub/Sub integration of the given application.
+	Delete(ctx context.Context, in *DeleteGCPPubSubIntegrationRequest, opts ...grpc.CallOption) (*DeleteGCPPubSubIntegrationResponse, error)
+}
+
+type gCPPubSubIntegrationClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewGCPPubSubIntegrationClient(cc *grpc.ClientConn) GCPPubSubIntegrationClient {
+	return &gCPPubSubIntegrationClient{cc}
+}
+
+func (c *gCPPubSubIntegrationClient) Create(ctx context.Context, in *CreateGCPPubSubIntegrationRequest, opts ...grpc.CallOption) (*CreateGCPPubSubIntegrationResponse, error) {
+	out := new(CreateGCPPubSubIntegrationResponse)
+	err := grpc.Invoke(ctx, "/api.GCPPubSubIntegration/Create", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gCPPubSubIntegrationClient) Get(ctx context.Context, in *GetGCPPubSubIntegrationRequest, opts ...grpc.CallOption) (*GetGCPPubSubIntegrationResponse, error) {
+	out := new(GetGCPPubSubIntegrationResponse)
+	err := grpc.Invoke(ctx, "/api.GCPPubSubIntegration/Get", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gCPPubSubIntegrationClient) Update(ctx context.Context, in *UpdateGCPPubSubIntegrationRequest, opts ...grpc.CallOption) (*UpdateGCPPubSubIntegrationResponse, error) {
+	out := new(UpdateGCPPubSubIntegrationResponse)
+	err := grpc.Invoke(ctx, "/api.GCPPubSubIntegration/Update", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gCPPubSubIntegrationClient) Delete(ctx context.Context, in *DeleteGCPPubSubIntegrationRequest, opts ...grpc.CallOption) (*DeleteGCPPubSubIntegrationResponse, error) {
+	out := new(DeleteGCPPubSubIntegrationResponse)
+	err := grpc.Invoke(ctx, "/api.GCPPubSubIntegration/Delete", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for GCPPubSubIntegration service
+
+type GCPPubSubIntegrationServer interface {
+	// Create creates the Pub/Sub integration of the given application.
+	Create(context.Context, *CreateGCPPubSubIntegrationRequest) (*CreateGCPPubSubIntegrationResponse, error)
+	// Get returns the Pub/Sub integration of the given application.
+	Get(context.Context, *GetGCPPubSubIntegrationRequest) (*GetGCPPubSubIntegrationResponse, error)
+	// Update updates the Pub/Sub integration of the given application.
+	Update(context.Context, *UpdateGCPPubSubIntegrationRequest) (*UpdateGCPPubSubIntegrationResponse, error)
+	// Delete deletes the Pub/Sub integration of the given application.
+	Delete(context.Context, *DeleteGCPPubSubIntegrationRequest) (*DeleteGCPPubSubIntegrationResponse, error)
+}
+
+func RegisterGCPPubSubIntegrationServer(s *grpc.Server, srv GCPPubSubIntegrationServer) {
+	s.RegisterService(&_GCPPubSubIntegration_serviceDesc, srv)
+}
+
+func _GCPPubSubIntegration_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGCPPubSubIntegrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GCPPubSubIntegrationServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.GCPPubSubIntegration/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GCPPubSubIntegrationServer).Create(ctx, req.(*CreateGCPPubSubIntegrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GCPPubSubIntegration_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGCPPubSubIntegrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GCPPubSubIntegrationServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.GCPPubSubIntegration/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GCPPubSubIntegrationServer).Get(ctx, req.(*GetGCPPubSubIntegrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GCPPubSubIntegration_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGCPPubSubIntegrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GCPPubSubIntegrationServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.GCPPubSubIntegration/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GCPPubSubIntegrationServer).Update(ctx, req.(*UpdateGCPPubSubIntegrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GCPPubSubIntegration_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGCPPubSubIntegrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GCPPubSubIntegrationServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.GCPPubSubIntegration/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GCPPubSubIntegrationServer).Delete(ctx, req.(*DeleteGCPPubSubIntegrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _GCPPubSubIntegration_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.GCPPubSubIntegration",
+	HandlerType: (*GCPPubSubIntegrationServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _GCPPubSubIntegration_Create_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _GCPPubSubIntegration_Get_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _GCPPubSubIntegration_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _GCPPubSubIntegration_Delete_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "gcpPubSubIntegration.proto",
+}
+
+func init() { proto.RegisterFile("gcpPubSubIntegration.proto", fileDescriptor22) }
+
+var fileDescriptor22 = []byte{
+	// 399 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x54, 0xcd, 0x8a, 0x1a, 0x41,
+	0x10, 0xa6, 0xd5, 0x0c, 0x58, 0x39, 0x04, 0x1a, 0x09, 0x43, 0x23, 0x51, 0x47, 0x8d, 0x62, 0x60,
+	0x84, 0xe4, 0x12, 0x92, 0xa3, 0x8a, 0x98, 0x43, 0x22, 0x8a, 0x0f, 0xd0, 0x8e, 0xcd, 0xd0, 0x61,
+	0x32, 0xdd, 0x99, 0x69, 0x4f, 0x8b, 0xb0, 0xec, 0x61, 0x61, 0x4f, 0x7b, 0xd8, 0xd3, 0x3e, 0xc4,
+	0x3e, 0xcd, 0xbe, 0xc2, 0x3e, 0xc8, 0x62, 0x77, 0xc3, 0xfe, 0xe9, 0x8c, 0xc8, 0x1e, 0xf6, 0xd8,
+	0x55, 0x5f, 0x57, 0x7d, 0x55, 0xdf, 0xd7, 0x0d, 0x24, 0x0c, 0xe4, 0x74, 0xbd, 0x9c, 0xaf, 0x97,
+	0x93, 0x58, 0xb1, 0x30, 0xa1, 0x8a, 0x8b, 0xd8, 0x97, 0x89, 0x50, 0x02, 0x17, 0xa9, 0xe4, 0xa4,
+	0x1a, 0x0a, 0x11, 0x46, 0xac, 0x4f, 0x25, 0xef, 0xd3, 0x38, 0x16, 0x4a, 0x23, 0x52, 0x03, 0xf1,
+	0xae, 0x11, 0x34, 0x06, 0x09, 0xa3, 0x8a, 0x8d, 0x07, 0xd3, 0x17, 0x75, 0x66, 0xec, 0xff, 0x9a,
+	0xa5, 0x0a, 0x7f, 0x04, 0x87, 0x4a, 0x39, 0x5a, 0x4c, 0x5c, 0x54, 0x47, 0xdd, 0xf2, 0xcc, 0x9e,
+	0x70, 0x15, 0xca, 0x32, 0x11, 0x7f, 0x59, 0xa0, 0x26, 0x43, 0xb7, 0xa0, 0x53, 0x0f, 0x01, 0x5c,
+	0x81, 0x77, 0x4a, 0x48, 0x1e, 0xb8, 0x45, 0x9d, 0x31, 0x07, 0xdc, 0x85, 0x0f, 0x41, 0xc2, 0x56,
+	0x2c, 0x56, 0x9c, 0x46, 0xe9, 0xaf, 0xf9, 0x9f, 0xdf, 0x6e, 0x49, 0xe7, 0x9f, 0x87, 0xbd, 0x16,
+	0x78, 0x59, 0xd4, 0x52, 0x29, 0xe2, 0x94, 0x79, 0xdf, 0xe1, 0xd3, 0x98, 0xa9, 0x23, 0xd8, 0x7b,
+	0x97, 0x08, 0x6a, 0x7b, 0xaf, 0x9a, 0xea, 0xaf, 0x3a, 0x79, 0x1d, 0xde, 0x07, 0x11, 0x67, 0xb1,
+	0x1a, 0xfd, 0xa3, 0x3c, 0xb2, 0x53, 0x3f, 0x0e, 0x69, 0x35, 0x16, 0x72, 0xf5, 0x56, 0xd5, 0xc8,
+	0xa2, 0x66, 0xd5, 0xf8, 0x09, 0x8d, 0x21, 0x8b, 0xd8, 0x51, 0x03, 0x6c, 0x5b, 0x64, 0x5d, 0x36,
+	0x2d, 0xbe, 0xde, 0x94, 0xa0, 0xb2, 0x0b, 0x80, 0x4f, 0x11, 0x38, 0xc6, 0x30, 0xf8, 0xb3, 0x4f,
+	0x25, 0xf7, 0x73, 0x8d, 0x4d, 0x3a, 0xb9, 0x38, 0x3b, 0x57, 0xfb, 0xec, 0xf6, 0xee, 0xaa, 0x50,
+	0xf3, 0x88, 0x7e, 0x47, 0xbb, 0xde, 0x5c, 0xfa, 0x03, 0xf5, 0xf0, 0x06, 0x8a, 0x63, 0xa6, 0x70,
+	0x53, 0x97, 0xcd, 0xb6, 0x25, 0x69, 0x65, 0x83, 0x6c, 0xe3, 0x2f, 0xba, 0x71, 0x1b, 0x37, 0xf7,
+	0x37, 0xee, 0x9f, 0x98, 0xfd, 0x6d, 0xf0, 0x05, 0x02, 0xc7, 0x88, 0x64, 0x37, 0x90, 0x6b, 0x26,
+	0xd2, 0xc9, 0xc5, 0x59, 0x22, 0xbe, 0x26, 0xd2, 0x25, 0x87, 0x10, 0xd9, 0xae, 0xe2, 0x1c, 0x81,
+	0x63, 0xd4, 0xb4, 0x5c, 0x72, 0x7d, 0x41, 0x3a, 0xb9, 0xb8, 0xa7, 0x4b, 0xe9, 0x1d, 0xc2, 0x65,
+	0xe9, 0xe8, 0x9f, 0xee, 0xdb, 0xfd, 0x00, 0xbd, 0x07, 0x41, 0x1c, 0x2a, 0x05, 0x00, 0x00,
+}
@@ -1,10 +1,10 @@
 #!/usr/bin/env bash
 
 # generate the gRPC code
-protoc -I. -I$GOPATH/src -I$GOPATH/src/github.com/grpc-ecosystem/grpc-gateway/third_party/googleapis --go_out=Mgoogle/api/annotations.proto=github.com/grpc-ecosystem/grpc-gateway/third_party/googleapis/google/api,plugins=grpc:. channelList.proto node.proto downlinkQueue.proto nodeSession.proto common.proto
+protoc -I. -I$GOPATH/src -I$GOPATH/src/github.com/grpc-ecosystem/grpc-gateway/third_party/googleapis --go_out=Mgoogle/api/annotations.proto=github.com/grpc-ecosystem/grpc-gateway/third_party/googleapis/google/api,plugins=grpc:. channelList.proto node.proto downlinkQueue.proto nodeSession.proto common.proto signingKey.proto downlinkFPortPolicy.proto sla.proto analytics.proto dutyCycle.proto notificationPreference.proto scheduledReport.proto httpIntegration.proto payloadCodec.proto eventStream.proto influxDBIntegration.proto multicastGroup.proto fuotaDeployment.proto gateway.proto organization.proto apiKey.proto eventLog.proto gcpPubSubIntegration.proto awsSNSIntegration.proto azureIoTHubIntegration.proto deviceStatusAlert.proto downlinkRule.proto deadLetter.proto thingsBoardIntegration.proto handlerBackend.proto eventFilter.proto auditLog.proto usageStats.proto
 # generate the JSON interface code
-protoc -I/usr/local/include -I. -I$GOPATH/src -I$GOPATH/src/github.com/grpc-ecosystem/grpc-gateway/third_party/googleapis --grpc-gateway_out=logtostderr=true:. channelList.proto node.proto downlinkQueue.proto nodeSession.proto common.proto
+protoc -I/usr/local/include -I. -I$GOPATH/src -I$GOPATH/src/github.com/grpc-ecosystem/grpc-gateway/third_party/googleapis --grpc-gateway_out=logtostderr=true:. channelList.proto node.proto downlinkQueue.proto nodeSession.proto common.proto signingKey.proto downlinkFPortPolicy.proto sla.proto analytics.proto dutyCycle.proto notificationPreference.proto scheduledReport.proto httpIntegration.proto payloadCodec.proto eventStream.proto influxDBIntegration.proto multicastGroup.proto fuotaDeployment.proto gateway.proto organization.proto apiKey.proto eventLog.proto gcpPubSubIntegration.proto awsSNSIntegration.proto azureIoTHubIntegration.proto deviceStatusAlert.proto downlinkRule.proto deadLetter.proto thingsBoardIntegration.proto handlerBackend.proto eventFilter.proto auditLog.proto usageStats.proto
 # generate the swagger definitions
-protoc -I/usr/local/include -I. -I$GOPATH/src -I$GOPATH/src/github.com/grpc-ecosystem/grpc-gateway/third_party/googleapis --swagger_out=logtostderr=true:./swagger channelList.proto node.proto downlinkQueue.proto nodeSession.proto common.proto
+protoc -I/usr/local/include -I. -I$GOPATH/src -I$GOPATH/src/github.com/grpc-ecosystem/grpc-gateway/third_party/googleapis --swagger_out=logtostderr=true:./swagger channelList.proto node.proto downlinkQueue.proto nodeSession.proto common.proto signingKey.proto downlinkFPortPolicy.proto sla.proto analytics.proto dutyCycle.proto notificationPreference.proto scheduledReport.proto httpIntegration.proto payloadCodec.proto eventStream.proto influxDBIntegration.proto multicastGroup.proto fuotaDeployment.proto gateway.proto organization.proto apiKey.proto eventLog.proto gcpPubSubIntegration.proto awsSNSIntegration.proto azureIoTHubIntegration.proto deviceStatusAlert.proto downlinkRule.proto deadLetter.proto thingsBoardIntegration.proto handlerBackend.proto eventFilter.proto auditLog.proto usageStats.proto
 # merge the swagger code into one file
 go run swagger/main.go swagger > ../static/swagger/api.swagger.json
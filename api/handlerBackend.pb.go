@@ -0,0 +1,437 @@
+// Code generated by protoc-gen-go.
+// source: handlerBackend.proto
+// DO NOT EDIT!
+
+package api
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import _ "github.com/grpc-ecosystem/grpc-gateway/third_party/googleapis/google/api"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+type ListHandlerBackendRequest struct {
+}
+
+func (m *ListHandlerBackendRequest) Reset()                    { *m = ListHandlerBackendRequest{} }
+func (m *ListHandlerBackendRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListHandlerBackendRequest) ProtoMessage()               {}
+func (*ListHandlerBackendRequest) Descriptor() ([]byte, []int) { return fileDescriptor29, []int{0} }
+
+type ListHandlerBackendResponse struct {
+	// names of the enabled handler backends (e.g. mqtt)
+	Backends []string `protobuf:"bytes,1,rep,name=backends" json:"backends,omitempty"`
+}
+
+func (m *ListHandlerBackendResponse) Reset()                    { *m = ListHandlerBackendResponse{} }
+func (m *ListHandlerBackendResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListHandlerBackendResponse) ProtoMessage()               {}
+func (*ListHandlerBackendResponse) Descriptor() ([]byte, []int) { return fileDescriptor29, []int{1} }
+
+func (m *ListHandlerBackendResponse) GetBackends() []string {
+	if m != nil {
+		return m.Backends
+	}
+	return nil
+}
+
+type CreateHandlerBackendRequest struct {
+	// hex encoded AppEUI
+	AppEUI string `protobuf:"bytes,1,opt,name=appEUI" json:"appEUI,omitempty"`
+	// names of the handler backends to publish the events of the application to
+	Backends []string `protobuf:"bytes,2,rep,name=backends" json:"backends,omitempty"`
+}
+
+func (m *CreateHandlerBackendRequest) Reset()                    { *m = CreateHandlerBackendRequest{} }
+func (m *CreateHandlerBackendRequest) String() string            { return proto.CompactTextString(m) }
+func (*CreateHandlerBackendRequest) ProtoMessage()               {}
+func (*CreateHandlerBackendRequest) Descriptor() ([]byte, []int) { return fileDescriptor29, []int{2} }
+
+func (m *CreateHandlerBackendRequest) GetAppEUI() string {
+	if m != nil {
+		return m.AppEUI
+	}
+	return ""
+}
+
+func (m *CreateHandlerBackendRequest) GetBackends() []string {
+	if m != nil {
+		return m.Backends
+	}
+	return nil
+}
+
+type CreateHandlerBackendResponse struct {
+}
+
+func (m *CreateHandlerBackendResponse) Reset()                    { *m = CreateHandlerBackendResponse{} }
+func (m *CreateHandlerBackendResponse) String() string            { return proto.CompactTextString(m) }
+func (*CreateHandlerBackendResponse) ProtoMessage()               {}
+func (*CreateHandlerBackendResponse) Descriptor() ([]byte, []int) { return fileDescriptor29, []int{3} }
+
+type GetHandlerBackendRequest struct {
+	// hex encoded AppEUI
+	AppEUI string `protobuf:"bytes,1,opt,name=appEUI" json:"appEUI,omitempty"`
+}
+
+func (m *GetHandlerBackendRequest) Reset()                    { *m = GetHandlerBackendRequest{} }
+func (m *GetHandlerBackendRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetHandlerBackendRequest) ProtoMessage()               {}
+func (*GetHandlerBackendRequest) Descriptor() ([]byte, []int) { return fileDescriptor29, []int{4} }
+
+func (m *GetHandlerBackendRequest) GetAppEUI() string {
+	if m != nil {
+		return m.AppEUI
+	}
+	return ""
+}
+
+type GetHandlerBackendResponse struct {
+	// hex encoded AppEUI
+	AppEUI string `protobuf:"bytes,1,opt,name=appEUI" json:"appEUI,omitempty"`
+	// names of the handler backends to publish the events of the application to
+	Backends []string `protobuf:"bytes,2,rep,name=backends" json:"backends,omitempty"`
+}
+
+func (m *GetHandlerBackendResponse) Reset()                    { *m = GetHandlerBackendResponse{} }
+func (m *GetHandlerBackendResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetHandlerBackendResponse) ProtoMessage()               {}
+func (*GetHandlerBackendResponse) Descriptor() ([]byte, []int) { return fileDescriptor29, []int{5} }
+
+func (m *GetHandlerBackendResponse) GetAppEUI() string {
+	if m != nil {
+		return m.AppEUI
+	}
+	return ""
+}
+
+func (m *GetHandlerBackendResponse) GetBackends() []string {
+	if m != nil {
+		return m.Backends
+	}
+	return nil
+}
+
+type UpdateHandlerBackendRequest struct {
+	// hex encoded AppEUI
+	AppEUI string `protobuf:"bytes,1,opt,name=appEUI" json:"appEUI,omitempty"`
+	// names of the handler backends to publish the events of the application to
+	Backends []string `protobuf:"bytes,2,rep,name=backends" json:"backends,omitempty"`
+}
+
+func (m *UpdateHandlerBackendRequest) Reset()                    { *m = UpdateHandlerBackendRequest{} }
+func (m *UpdateHandlerBackendRequest) String() string            { return proto.CompactTextString(m) }
+func (*UpdateHandlerBackendRequest) ProtoMessage()               {}
+func (*UpdateHandlerBackendRequest) Descriptor() ([]byte, []int) { return fileDescriptor29, []int{6} }
+
+func (m *UpdateHandlerBackendRequest) GetAppEUI() string {
+	if m != nil {
+		return m.AppEUI
+	}
+	return ""
+}
+
+func (m *UpdateHandlerBackendRequest) GetBackends() []string {
+	if m != nil {
+		return m.Backends
+	}
+	return nil
+}
+
+type UpdateHandlerBackendResponse struct {
+}
+
+func (m *UpdateHandlerBackendResponse) Reset()                    { *m = UpdateHandlerBackendResponse{} }
+func (m *UpdateHandlerBackendResponse) String() string            { return proto.CompactTextString(m) }
+func (*UpdateHandlerBackendResponse) ProtoMessage()               {}
+func (*UpdateHandlerBackendResponse) Descriptor() ([]byte, []int) { return fileDescriptor29, []int{7} }
+
+type DeleteHandlerBackendRequest struct {
+	// hex encoded AppEUI
+	AppEUI string `protobuf:"bytes,1,opt,name=appEUI" json:"appEUI,omitempty"`
+}
+
+func (m *DeleteHandlerBackendRequest) Reset()                    { *m = DeleteHandlerBackendRequest{} }
+func (m *DeleteHandlerBackendRequest) String() string            { return proto.CompactTextString(m) }
+func (*DeleteHandlerBackendRequest) ProtoMessage()               {}
+func (*DeleteHandlerBackendRequest) Descriptor() ([]byte, []int) { return fileDescriptor29, []int{8} }
+
+func (m *DeleteHandlerBackendRequest) GetAppEUI() string {
+	if m != nil {
+		return m.AppEUI
+	}
+	return ""
+}
+
+type DeleteHandlerBackendResponse struct {
+}
+
+func (m *DeleteHandlerBackendResponse) Reset()                    { *m = DeleteHandlerBackendResponse{} }
+func (m *DeleteHandlerBackendResponse) String() string            { return proto.CompactTextString(m) }
+func (*DeleteHandlerBackendResponse) ProtoMessage()               {}
+func (*DeleteHandlerBackendResponse) Descriptor() ([]byte, []int) { return fileDescriptor29, []int{9} }
+
+func init() {
+	proto.RegisterType((*ListHandlerBackendRequest)(nil), "api.ListHandlerBackendRequest")
+	proto.RegisterType((*ListHandlerBackendResponse)(nil), "api.ListHandlerBackendResponse")
+	proto.RegisterType((*CreateHandlerBackendRequest)(nil), "api.CreateHandlerBackendRequest")
+	proto.RegisterType((*CreateHandlerBackendResponse)(nil), "api.CreateHandlerBackendResponse")
+	proto.RegisterType((*GetHandlerBackendRequest)(nil), "api.GetHandlerBackendRequest")
+	proto.RegisterType((*GetHandlerBackendResponse)(nil), "api.GetHandlerBackendResponse")
+	proto.RegisterType((*UpdateHandlerBackendRequest)(nil), "api.UpdateHandlerBackendRequest")
+	proto.RegisterType((*UpdateHandlerBackendResponse)(nil), "api.UpdateHandlerBackendResponse")
+	proto.RegisterType((*DeleteHandlerBackendRequest)(nil), "api.DeleteHandlerBackendRequest")
+	proto.RegisterType((*DeleteHandlerBackendResponse)(nil), "api.DeleteHandlerBackendResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for HandlerBackend service
+
+type HandlerBackendClient interface {
+	// List lists the enabled handler backends.
+	List(ctx context.Context, in *ListHandlerBackendRequest, opts ...grpc.CallOption) (*ListHandlerBackendResponse, error)
+	// Create sets the handler backends of the given application.
+	Create(ctx context.Context, in *CreateHandlerBackendRequest, opts ...grpc.CallOption) (*CreateHandlerBackendResponse, error)
+	// Get returns the handler backends of the given application.
+	Get(ctx context.Context, in *GetHandlerBackendRequest, opts ...grpc.CallOption) (*GetHandlerBackendResponse, error)
+	// Update updates the handler backends of the given application.
+	Update(ctx context.Context, in *UpdateHandlerBackendRequest, opts ...grpc.CallOption) (*UpdateHandlerBackendResponse, error)
+	// Delete deletes the handler backends of the given application, after which the events are published to all the enabled handler backends.
+	Delete(ctx context.Context, in *DeleteHandlerBackendRequest, opts ...grpc.CallOption) (*DeleteHandlerBackendResponse, error)
+}
+
+type handlerBackendClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewHandlerBackendClient(cc *grpc.ClientConn) HandlerBackendClient {
+	return &handlerBackendClient{cc}
+}
+
+func (c *handlerBackendClient) List(ctx context.Context, in *ListHandlerBackendRequest, opts ...grpc.CallOption) (*ListHandlerBackendResponse, error) {
+	out := new(ListHandlerBackendResponse)
+	err := grpc.Invoke(ctx, "/api.HandlerBackend/List", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *handlerBackendClient) Create(ctx context.Context, in *CreateHandlerBackendRequest, opts ...grpc.CallOption) (*CreateHandlerBackendResponse, error) {
+	out := new(CreateHandlerBackendResponse)
+	err := grpc.Invoke(ctx, "/api.HandlerBackend/Create", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *handlerBackendClient) Get(ctx context.Context, in *GetHandlerBackendRequest, opts ...grpc.CallOption) (*GetHandlerBackendResponse, error) {
+	out := new(GetHandlerBackendResponse)
+	err := grpc.Invoke(ctx, "/api.HandlerBackend/Get", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *handlerBackendClient) Update(ctx context.Context, in *UpdateHandlerBackendRequest, opts ...grpc.CallOption) (*UpdateHandlerBackendResponse, error) {
+	out := new(UpdateHandlerBackendResponse)
+	err := grpc.Invoke(ctx, "/api.HandlerBackend/Update", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *handlerBackendClient) Delete(ctx context.Context, in *DeleteHandlerBackendRequest, opts ...grpc.CallOption) (*DeleteHandlerBackendResponse, error) {
+	out := new(DeleteHandlerBackendResponse)
+	err := grpc.Invoke(ctx, "/api.HandlerBackend/Delete", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for HandlerBackend service
+
+type HandlerBackendServer interface {
+	// List lists the enabled handler backends.
+	List(context.Context, *ListHandlerBackendRequest) (*ListHandlerBackendResponse, error)
+	// Create sets the handler backends of the given application.
+	Create(context.Context, *CreateHandlerBackendRequest) (*CreateHandlerBackendResponse, error)
+	// Get returns the handler backends of the given application.
+	Get(context.Context, *GetHandlerBackendRequest) (*GetHandlerBackendResponse, error)
+	// Update updates the handler backends of the given application.
+	Update(context.Context, *UpdateHandlerBackendRequest) (*UpdateHandlerBackendResponse, error)
+	// Delete deletes the handler backends of the given application, after which the events are published to all the enabled handler backends.
+	Delete(context.Context, *DeleteHandlerBackendRequest) (*DeleteHandlerBackendResponse, error)
+}
+
+func RegisterHandlerBackendServer(s *grpc.Server, srv HandlerBackendServer) {
+	s.RegisterService(&_HandlerBackend_serviceDesc, srv)
+}
+
+func _HandlerBackend_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHandlerBackendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HandlerBackendServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.HandlerBackend/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HandlerBackendServer).List(ctx, req.(*ListHandlerBackendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HandlerBackend_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateHandlerBackendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HandlerBackendServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.HandlerBackend/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HandlerBackendServer).Create(ctx, req.(*CreateHandlerBackendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HandlerBackend_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHandlerBackendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HandlerBackendServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.HandlerBackend/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HandlerBackendServer).Get(ctx, req.(*GetHandlerBackendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HandlerBackend_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateHandlerBackendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HandlerBackendServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.HandlerBackend/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HandlerBackendServer).Update(ctx, req.(*UpdateHandlerBackendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HandlerBackend_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteHandlerBackendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HandlerBackendServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.HandlerBackend/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HandlerBackendServer).Delete(ctx, req.(*DeleteHandlerBackendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _HandlerBackend_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.HandlerBackend",
+	HandlerType: (*HandlerBackendServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "List",
+			Handler:    _HandlerBackend_List_Handler,
+		},
+		{
+			MethodName: "Create",
+			Handler:    _HandlerBackend_Create_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _HandlerBackend_Get_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _HandlerBackend_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _HandlerBackend_Delete_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "handlerBackend.proto",
+}
+
+func init() { proto.RegisterFile("handlerBackend.proto", fileDescriptor29) }
+
+var fileDescriptor29 = []byte{
+	// 368 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x4f, 0x4a, 0x03, 0x31,
+	0x14, 0xc6, 0x99, 0xb6, 0x0c, 0xf6, 0x2d, 0x5c, 0x84, 0x52, 0xda, 0x4c, 0xff, 0x06, 0x84, 0xd2,
+	0x45, 0x0b, 0x15, 0x41, 0x5c, 0xfa, 0x87, 0x2a, 0x08, 0xd2, 0x42, 0x0f, 0x90, 0xda, 0x50, 0x87,
+	0x96, 0x24, 0x36, 0x11, 0x17, 0xe2, 0xc6, 0x2b, 0x78, 0x24, 0x8f, 0xe0, 0x15, 0x3c, 0x88, 0x4c,
+	0x12, 0x84, 0x91, 0x4c, 0xa0, 0xe2, 0x32, 0x7d, 0xef, 0x7d, 0xbf, 0xf7, 0xf5, 0x7d, 0x0c, 0xd4,
+	0x1e, 0x28, 0x5f, 0x6d, 0xd9, 0xee, 0x9c, 0xde, 0x6f, 0x18, 0x5f, 0x8d, 0xe4, 0x4e, 0x68, 0x81,
+	0xca, 0x54, 0xa6, 0xb8, 0xb5, 0x16, 0x62, 0xbd, 0x65, 0x63, 0x2a, 0xd3, 0x31, 0xe5, 0x5c, 0x68,
+	0xaa, 0x53, 0xc1, 0x95, 0x6d, 0x21, 0x09, 0x34, 0x6f, 0x53, 0xa5, 0xaf, 0x73, 0xe3, 0x73, 0xf6,
+	0xf8, 0xc4, 0x94, 0x26, 0xa7, 0x80, 0x7d, 0x45, 0x25, 0x05, 0x57, 0x0c, 0x61, 0x38, 0x58, 0xda,
+	0x9f, 0x54, 0x23, 0xea, 0x95, 0x07, 0xd5, 0xf9, 0xcf, 0x9b, 0xcc, 0x20, 0xb9, 0xd8, 0x31, 0xaa,
+	0x99, 0x57, 0x18, 0xd5, 0x21, 0xa6, 0x52, 0x5e, 0x2d, 0x6e, 0x1a, 0x51, 0x2f, 0x1a, 0x54, 0xe7,
+	0xee, 0x95, 0x93, 0x2c, 0xfd, 0x92, 0xec, 0x40, 0xcb, 0x2f, 0x69, 0xd7, 0x21, 0x13, 0x68, 0x4c,
+	0x99, 0xde, 0x8b, 0x47, 0xee, 0xa0, 0xe9, 0x99, 0x71, 0xfe, 0xfe, 0xb2, 0xe4, 0x0c, 0x92, 0x85,
+	0x5c, 0xfd, 0xb7, 0x6f, 0xbf, 0xa4, 0xf3, 0x7d, 0x02, 0xc9, 0x25, 0xdb, 0xb2, 0x3d, 0x91, 0x99,
+	0xac, 0x7f, 0xcc, 0xca, 0x4e, 0x3e, 0x2a, 0x70, 0x98, 0x2f, 0x21, 0x06, 0x95, 0x2c, 0x0e, 0xa8,
+	0x33, 0xa2, 0x32, 0x1d, 0x15, 0xc6, 0x06, 0x77, 0x0b, 0xeb, 0x6e, 0xe5, 0xd6, 0xdb, 0xe7, 0xd7,
+	0x7b, 0xa9, 0x8e, 0x6a, 0x26, 0x94, 0xf9, 0xe8, 0x2a, 0xc4, 0x21, 0xb6, 0x87, 0x46, 0x3d, 0x23,
+	0x14, 0x08, 0x12, 0xee, 0x07, 0x3a, 0x1c, 0xac, 0x6b, 0x60, 0x4d, 0xe2, 0x85, 0x9d, 0x45, 0x43,
+	0xb4, 0x81, 0xf2, 0x94, 0x69, 0xd4, 0x36, 0x52, 0x45, 0x11, 0xc2, 0x9d, 0xa2, 0xb2, 0xc3, 0x1c,
+	0x19, 0x4c, 0x17, 0xb5, 0x7d, 0x98, 0xf1, 0x8b, 0xfd, 0xd7, 0x5f, 0xd1, 0x33, 0xc4, 0xf6, 0x9a,
+	0xce, 0x5c, 0x20, 0x2d, 0xb8, 0x1f, 0xe8, 0x70, 0xd4, 0x81, 0xa1, 0x12, 0x1c, 0xa6, 0x66, 0x2e,
+	0x35, 0xc4, 0xf6, 0xde, 0x0e, 0x1c, 0xc8, 0x0c, 0xee, 0x07, 0x3a, 0xf2, 0x76, 0x87, 0x61, 0xf0,
+	0x32, 0x36, 0x5f, 0x99, 0xe3, 0xef, 0x01, 0x00, 0x44, 0x45, 0xf8, 0xfc, 0xa0, 0x04, 0x00, 0x00,
+}
@@ -0,0 +1,515 @@
+// Code generated by protoc-gen-go.
+// source: httpIntegration.proto
+// DO NOT EDIT!
+
+package api
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import _ "github.com/grpc-ecosystem/grpc-gateway/third_party/googleapis/google/api"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+type HTTPIntegrationHeader struct {
+	// name of the header
+	Key string `protobuf:"bytes,1,opt,name=key" json:"key,omitempty"`
+	// value of the header
+	Value string `protobuf:"bytes,2,opt,name=value" json:"value,omitempty"`
+}
+
+func (m *HTTPIntegrationHeader) Reset()                    { *m = HTTPIntegrationHeader{} }
+func (m *HTTPIntegrationHeader) String() string            { return proto.CompactTextString(m) }
+func (*HTTPIntegrationHeader) ProtoMessage()               {}
+func (*HTTPIntegrationHeader) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{0} }
+
+func (m *HTTPIntegrationHeader) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *HTTPIntegrationHeader) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+type CreateHTTPIntegrationRequest struct {
+	// hex encoded AppEUI
+	AppEUI string `protobuf:"bytes,1,opt,name=appEUI" json:"appEUI,omitempty"`
+	// additional headers to set on the requests (e.g. Authorization)
+	Headers []*HTTPIntegrationHeader `protobuf:"bytes,2,rep,name=headers" json:"headers,omitempty"`
+	// endpoint receiving the uplink data (empty to disable)
+	DataUpURL string `protobuf:"bytes,3,opt,name=dataUpURL" json:"dataUpURL,omitempty"`
+	// endpoint receiving the join notifications (empty to disable)
+	JoinNotificationURL string `protobuf:"bytes,4,opt,name=joinNotificationURL" json:"joinNotificationURL,omitempty"`
+	// endpoint receiving the ack notifications (empty to disable)
+	AckNotificationURL string `protobuf:"bytes,5,opt,name=ackNotificationURL" json:"ackNotificationURL,omitempty"`
+	// endpoint receiving the error notifications (empty to disable)
+	ErrorNotificationURL string `protobuf:"bytes,6,opt,name=errorNotificationURL" json:"errorNotificationURL,omitempty"`
+}
+
+func (m *CreateHTTPIntegrationRequest) Reset()                    { *m = CreateHTTPIntegrationRequest{} }
+func (m *CreateHTTPIntegrationRequest) String() string            { return proto.CompactTextString(m) }
+func (*CreateHTTPIntegrationRequest) ProtoMessage()               {}
+func (*CreateHTTPIntegrationRequest) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{1} }
+
+func (m *CreateHTTPIntegrationRequest) GetAppEUI() string {
+	if m != nil {
+		return m.AppEUI
+	}
+	return ""
+}
+
+func (m *CreateHTTPIntegrationRequest) GetHeaders() []*HTTPIntegrationHeader {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
+
+func (m *CreateHTTPIntegrationRequest) GetDataUpURL() string {
+	if m != nil {
+		return m.DataUpURL
+	}
+	return ""
+}
+
+func (m *CreateHTTPIntegrationRequest) GetJoinNotificationURL() string {
+	if m != nil {
+		return m.JoinNotificationURL
+	}
+	return ""
+}
+
+func (m *CreateHTTPIntegrationRequest) GetAckNotificationURL() string {
+	if m != nil {
+		return m.AckNotificationURL
+	}
+	return ""
+}
+
+func (m *CreateHTTPIntegrationRequest) GetErrorNotificationURL() string {
+	if m != nil {
+		return m.ErrorNotificationURL
+	}
+	return ""
+}
+
+type CreateHTTPIntegrationResponse struct {
+}
+
+func (m *CreateHTTPIntegrationResponse) Reset()                    { *m = CreateHTTPIntegrationResponse{} }
+func (m *CreateHTTPIntegrationResponse) String() string            { return proto.CompactTextString(m) }
+func (*CreateHTTPIntegrationResponse) ProtoMessage()               {}
+func (*CreateHTTPIntegrationResponse) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{2} }
+
+type GetHTTPIntegrationRequest struct {
+	// hex encoded AppEUI
+	AppEUI string `protobuf:"bytes,1,opt,name=appEUI" json:"appEUI,omitempty"`
+}
+
+func (m *GetHTTPIntegrationRequest) Reset()                    { *m = GetHTTPIntegrationRequest{} }
+func (m *GetHTTPIntegrationRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetHTTPIntegrationRequest) ProtoMessage()               {}
+func (*GetHTTPIntegrationRequest) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{3} }
+
+func (m *GetHTTPIntegrationRequest) GetAppEUI() string {
+	if m != nil {
+		return m.AppEUI
+	}
+	return ""
+}
+
+type GetHTTPIntegrationResponse struct {
+	// hex encoded AppEUI
+	AppEUI string `protobuf:"bytes,1,opt,name=appEUI" json:"appEUI,omitempty"`
+	// additional headers to set on the requests (e.g. Authorization)
+	Headers []*HTTPIntegrationHeader `protobuf:"bytes,2,rep,name=headers" json:"headers,omitempty"`
+	// endpoint receiving the uplink data (empty to disable)
+	DataUpURL string `protobuf:"bytes,3,opt,name=dataUpURL" json:"dataUpURL,omitempty"`
+	// endpoint receiving the join notifications (empty to disable)
+	JoinNotificationURL string `protobuf:"bytes,4,opt,name=joinNotificationURL" json:"joinNotificationURL,omitempty"`
+	// endpoint receiving the ack notifications (empty to disable)
+	AckNotificationURL string `protobuf:"bytes,5,opt,name=ackNotificationURL" json:"ackNotificationURL,omitempty"`
+	// endpoint receiving the error notifications (empty to disable)
+	ErrorNotificationURL string `protobuf:"bytes,6,opt,name=errorNotificationURL" json:"errorNotificationURL,omitempty"`
+}
+
+func (m *GetHTTPIntegrationResponse) Reset()                    { *m = GetHTTPIntegrationResponse{} }
+func (m *GetHTTPIntegrationResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetHTTPIntegrationResponse) ProtoMessage()               {}
+func (*GetHTTPIntegrationResponse) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{4} }
+
+func (m *GetHTTPIntegrationResponse) GetAppEUI() string {
+	if m != nil {
+		return m.AppEUI
+	}
+	return ""
+}
+
+func (m *GetHTTPIntegrationResponse) GetHeaders() []*HTTPIntegrationHeader {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
+
+func (m *GetHTTPIntegrationResponse) GetDataUpURL() string {
+	if m != nil {
+		return m.DataUpURL
+	}
+	return ""
+}
+
+func (m *GetHTTPIntegrationResponse) GetJoinNotificationURL() string {
+	if m != nil {
+		return m.JoinNotificationURL
+	}
+	return ""
+}
+
+func (m *GetHTTPIntegrationResponse) GetAckNotificationURL() string {
+	if m != nil {
+		return m.AckNotificationURL
+	}
+	return ""
+}
+
+func (m *GetHTTPIntegrationResponse) GetErrorNotificationURL() string {
+	if m != nil {
+		return m.ErrorNotificationURL
+	}
+	return ""
+}
+
+type UpdateHTTPIntegrationRequest struct {
+	// hex encoded AppEUI
+	AppEUI string `protobuf:"bytes,1,opt,name=appEUI" json:"appEUI,omitempty"`
+	// additional headers to set on the requests (e.g. Authorization)
+	Headers []*HTTPIntegrationHeader `protobuf:"bytes,2,rep,name=headers" json:"headers,omitempty"`
+	// endpoint receiving the uplink data (empty to disable)
+	DataUpURL string `protobuf:"bytes,3,opt,name=dataUpURL" json:"dataUpURL,omitempty"`
+	// endpoint receiving the join notifications (empty to disable)
+	JoinNotificationURL string `protobuf:"bytes,4,opt,name=joinNotificationURL" json:"joinNotificationURL,omitempty"`
+	// endpoint receiving the ack notifications (empty to disable)
+	AckNotificationURL string `protobuf:"bytes,5,opt,name=ackNotificationURL" json:"ackNotificationURL,omitempty"`
+	// endpoint receiving the error notifications (empty to disable)
+	ErrorNotificationURL string `protobuf:"bytes,6,opt,name=errorNotificationURL" json:"errorNotificationURL,omitempty"`
+}
+
+func (m *UpdateHTTPIntegrationRequest) Reset()                    { *m = UpdateHTTPIntegrationRequest{} }
+func (m *UpdateHTTPIntegrationRequest) String() string            { return proto.CompactTextString(m) }
+func (*UpdateHTTPIntegrationRequest) ProtoMessage()               {}
+func (*UpdateHTTPIntegrationRequest) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{5} }
+
+func (m *UpdateHTTPIntegrationRequest) GetAppEUI() string {
+	if m != nil {
+		return m.AppEUI
+	}
+	return ""
+}
+
+func (m *UpdateHTTPIntegrationRequest) GetHeaders() []*HTTPIntegrationHeader {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
+
+func (m *UpdateHTTPIntegrationRequest) GetDataUpURL() string {
+	if m != nil {
+		return m.DataUpURL
+	}
+	return ""
+}
+
+func (m *UpdateHTTPIntegrationRequest) GetJoinNotificationURL() string {
+	if m != nil {
+		return m.JoinNotificationURL
+	}
+	return ""
+}
+
+func (m *UpdateHTTPIntegrationRequest) GetAckNotificationURL() string {
+	if m != nil {
+		return m.AckNotificationURL
+	}
+	return ""
+}
+
+func (m *UpdateHTTPIntegrationRequest) GetErrorNotificationURL() string {
+	if m != nil {
+		return m.ErrorNotificationURL
+	}
+	return ""
+}
+
+type UpdateHTTPIntegrationResponse struct {
+}
+
+func (m *UpdateHTTPIntegrationResponse) Reset()                    { *m = UpdateHTTPIntegrationResponse{} }
+func (m *UpdateHTTPIntegrationResponse) String() string            { return proto.CompactTextString(m) }
+func (*UpdateHTTPIntegrationResponse) ProtoMessage()               {}
+func (*UpdateHTTPIntegrationResponse) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{6} }
+
+type DeleteHTTPIntegrationRequest struct {
+	// hex encoded AppEUI
+	AppEUI string `protobuf:"bytes,1,opt,name=appEUI" json:"appEUI,omitempty"`
+}
+
+func (m *DeleteHTTPIntegrationRequest) Reset()                    { *m = DeleteHTTPIntegrationRequest{} }
+func (m *DeleteHTTPIntegrationRequest) String() string            { return proto.CompactTextString(m) }
+func (*DeleteHTTPIntegrationRequest) ProtoMessage()               {}
+func (*DeleteHTTPIntegrationRequest) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{7} }
+
+func (m *DeleteHTTPIntegrationRequest) GetAppEUI() string {
+	if m != nil {
+		return m.AppEUI
+	}
+	return ""
+}
+
+type DeleteHTTPIntegrationResponse struct {
+}
+
+func (m *DeleteHTTPIntegrationResponse) Reset()                    { *m = DeleteHTTPIntegrationResponse{} }
+func (m *DeleteHTTPIntegrationResponse) String() string            { return proto.CompactTextString(m) }
+func (*DeleteHTTPIntegrationResponse) ProtoMessage()               {}
+func (*DeleteHTTPIntegrationResponse) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{8} }
+
+func init() {
+	proto.RegisterType((*HTTPIntegrationHeader)(nil), "api.HTTPIntegrationHeader")
+	proto.RegisterType((*CreateHTTPIntegrationRequest)(nil), "api.CreateHTTPIntegrationRequest")
+	proto.RegisterType((*CreateHTTPIntegrationResponse)(nil), "api.CreateHTTPIntegrationResponse")
+	proto.RegisterType((*GetHTTPIntegrationRequest)(nil), "api.GetHTTPIntegrationRequest")
+	proto.RegisterType((*GetHTTPIntegrationResponse)(nil), "api.GetHTTPIntegrationResponse")
+	proto.RegisterType((*UpdateHTTPIntegrationRequest)(nil), "api.UpdateHTTPIntegrationRequest")
+	proto.RegisterType((*UpdateHTTPIntegrationResponse)(nil), "api.UpdateHTTPIntegrationResponse")
+	proto.RegisterType((*DeleteHTTPIntegrationRequest)(nil), "api.DeleteHTTPIntegrationRequest")
+	proto.RegisterType((*DeleteHTTPIntegrationResponse)(nil), "api.DeleteHTTPIntegrationResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for HTTPIntegration service
+
+type HTTPIntegrationClient interface {
+	// Create creates the HTTP integration of the given application.
+	Create(ctx context.Context, in *CreateHTTPIntegrationRequest, opts ...grpc.CallOption) (*CreateHTTPIntegrationResponse, error)
+	// Get returns the HTTP integration of the given application.
+	Get(ctx context.Context, in *GetHTTPIntegrationRequest, opts ...grpc.CallOption) (*GetHTTPIntegrationResponse, error)
+	// Update updates the HTTP integration of the given application.
+	Update(ctx context.Context, in *UpdateHTTPIntegrationRequest, opts ...grpc.CallOption) (*UpdateHTTPIntegrationResponse, error)
+	// Delete deletes the HTTP integration of the given application.
+	Delete(ctx context.Context, in *DeleteHTTPIntegrationRequest, opts ...grpc.CallOption) (*DeleteHTTPIntegrationResponse, error)
+}
+
+type hTTPIntegrationClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewHTTPIntegrationClient(cc *grpc.ClientConn) HTTPIntegrationClient {
+	return &hTTPIntegrationClient{cc}
+}
+
+func (c *hTTPIntegrationClient) Create(ctx context.Context, in *CreateHTTPIntegrationRequest, opts ...grpc.CallOption) (*CreateHTTPIntegrationResponse, error) {
+	out := new(CreateHTTPIntegrationResponse)
+	err := grpc.Invoke(ctx, "/api.HTTPIntegration/Create", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hTTPIntegrationClient) Get(ctx context.Context, in *GetHTTPIntegrationRequest, opts ...grpc.CallOption) (*GetHTTPIntegrationResponse, error) {
+	out := new(GetHTTPIntegrationResponse)
+	err := grpc.Invoke(ctx, "/api.HTTPIntegration/Get", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hTTPIntegrationClient) Update(ctx context.Context, in *UpdateHTTPIntegrationRequest, opts ...grpc.CallOption) (*UpdateHTTPIntegrationResponse, error) {
+	out := new(UpdateHTTPIntegrationResponse)
+	err := grpc.Invoke(ctx, "/api.HTTPIntegration/Update", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hTTPIntegrationClient) Delete(ctx context.Context, in *DeleteHTTPIntegrationRequest, opts ...grpc.CallOption) (*DeleteHTTPIntegrationResponse, error) {
+	out := new(DeleteHTTPIntegrationResponse)
+	err := grpc.Invoke(ctx, "/api.HTTPIntegration/Delete", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for HTTPIntegration service
+
+type HTTPIntegrationServer interface {
+	// Create creates the HTTP integration of the given application.
+	Create(context.Context, *CreateHTTPIntegrationRequest) (*CreateHTTPIntegrationResponse, error)
+	// Get returns the HTTP integration of the given application.
+	Get(context.Context, *GetHTTPIntegrationRequest) (*GetHTTPIntegrationResponse, error)
+	// Update updates the HTTP integration of the given application.
+	Update(context.Context, *UpdateHTTPIntegrationRequest) (*UpdateHTTPIntegrationResponse, error)
+	// Delete deletes the HTTP integration of the given application.
+	Delete(context.Context, *DeleteHTTPIntegrationRequest) (*DeleteHTTPIntegrationResponse, error)
+}
+
+func RegisterHTTPIntegrationServer(s *grpc.Server, srv HTTPIntegrationServer) {
+	s.RegisterService(&_HTTPIntegration_serviceDesc, srv)
+}
+
+func _HTTPIntegration_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateHTTPIntegrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HTTPIntegrationServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.HTTPIntegration/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HTTPIntegrationServer).Create(ctx, req.(*CreateHTTPIntegrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HTTPIntegration_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHTTPIntegrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HTTPIntegrationServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.HTTPIntegration/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HTTPIntegrationServer).Get(ctx, req.(*GetHTTPIntegrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HTTPIntegration_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateHTTPIntegrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HTTPIntegrationServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.HTTPIntegration/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HTTPIntegrationServer).Update(ctx, req.(*UpdateHTTPIntegrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HTTPIntegration_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteHTTPIntegrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HTTPIntegrationServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.HTTPIntegration/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HTTPIntegrationServer).Delete(ctx, req.(*DeleteHTTPIntegrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _HTTPIntegration_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.HTTPIntegration",
+	HandlerType: (*HTTPIntegrationServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _HTTPIntegration_Create_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _HTTPIntegration_Get_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _HTTPIntegration_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _HTTPIntegration_Delete_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "httpIntegration.proto",
+}
+
+func init() { proto.RegisterFile("httpIntegration.proto", fileDescriptor12) }
+
+var fileDescriptor12 = []byte{
+	// 440 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x55, 0xcf, 0x6e, 0xd3, 0x30,
+	0x18, 0x57, 0x13, 0x16, 0xb4, 0x8f, 0x03, 0xe8, 0x63, 0x45, 0xc1, 0x0a, 0x5b, 0x30, 0x12, 0x1a,
+	0x3b, 0xa4, 0xa8, 0x43, 0x1c, 0xb8, 0x70, 0x00, 0xb4, 0x4d, 0x42, 0x08, 0x45, 0xcb, 0x03, 0x98,
+	0xf5, 0xa3, 0x0d, 0xad, 0x62, 0x37, 0x71, 0x41, 0x80, 0xb8, 0xf0, 0x0a, 0xdc, 0x11, 0xef, 0xc4,
+	0x2b, 0x70, 0xe1, 0x2d, 0x50, 0x6c, 0x23, 0x58, 0x94, 0xa4, 0xea, 0xbd, 0xb7, 0xd8, 0xdf, 0xef,
+	0x8f, 0xfd, 0xf3, 0x67, 0x07, 0x86, 0x33, 0xad, 0xd5, 0x59, 0xa1, 0x69, 0x5a, 0x0a, 0x9d, 0xcb,
+	0x22, 0x51, 0xa5, 0xd4, 0x12, 0x7d, 0xa1, 0x72, 0x16, 0x4d, 0xa5, 0x9c, 0x2e, 0x68, 0x24, 0x54,
+	0x3e, 0x12, 0x45, 0x21, 0xb5, 0x41, 0x54, 0x16, 0xc2, 0x9f, 0xc2, 0xf0, 0xf4, 0xfc, 0xfc, 0xf5,
+	0x7f, 0xdc, 0x53, 0x12, 0x13, 0x2a, 0xf1, 0x06, 0xf8, 0x73, 0xfa, 0x18, 0x0e, 0xe2, 0xc1, 0xe1,
+	0x6e, 0x5a, 0x7f, 0xe2, 0x1e, 0xec, 0xbc, 0x17, 0x8b, 0x15, 0x85, 0x9e, 0x99, 0xb3, 0x03, 0xfe,
+	0xc3, 0x83, 0xe8, 0x59, 0x49, 0x42, 0x53, 0x43, 0x27, 0xa5, 0xe5, 0x8a, 0x2a, 0x8d, 0xb7, 0x20,
+	0x10, 0x4a, 0xbd, 0xc8, 0xce, 0x9c, 0x96, 0x1b, 0xe1, 0x23, 0xb8, 0x3a, 0x33, 0x56, 0x55, 0xe8,
+	0xc5, 0xfe, 0xe1, 0xb5, 0x31, 0x4b, 0x84, 0xca, 0x93, 0xd6, 0xd5, 0xa4, 0x7f, 0xa1, 0x18, 0xc1,
+	0xee, 0x44, 0x68, 0x91, 0xa9, 0x2c, 0x7d, 0x19, 0xfa, 0x46, 0xf0, 0xdf, 0x04, 0x3e, 0x84, 0x9b,
+	0xef, 0x64, 0x5e, 0xbc, 0x92, 0x3a, 0x7f, 0x9b, 0x5f, 0x18, 0x81, 0x1a, 0x77, 0xc5, 0xe0, 0xda,
+	0x4a, 0x98, 0x00, 0x8a, 0x8b, 0x79, 0x93, 0xb0, 0x63, 0x08, 0x2d, 0x15, 0x1c, 0xc3, 0x1e, 0x95,
+	0xa5, 0x2c, 0x9b, 0x8c, 0xc0, 0x30, 0x5a, 0x6b, 0xfc, 0x00, 0xee, 0x74, 0x24, 0x54, 0x29, 0x59,
+	0x54, 0xc4, 0x8f, 0xe1, 0xf6, 0x09, 0xe9, 0xcd, 0xf2, 0xe3, 0xdf, 0x3d, 0x60, 0x6d, 0x2c, 0xab,
+	0xb9, 0x8d, 0xdd, 0x74, 0x66, 0xa6, 0x26, 0xdb, 0xce, 0xec, 0xed, 0xcc, 0x8e, 0x84, 0x5c, 0x67,
+	0x3e, 0x86, 0xe8, 0x39, 0x2d, 0x68, 0xd3, 0x08, 0x6b, 0xe1, 0x0e, 0x9e, 0x15, 0x1e, 0xff, 0xf6,
+	0xe1, 0x7a, 0xa3, 0x86, 0x4b, 0x08, 0xec, 0x3d, 0xc1, 0xbb, 0x26, 0xf0, 0xbe, 0x67, 0x85, 0xf1,
+	0x3e, 0x88, 0x5b, 0x7d, 0xfc, 0xf5, 0xe7, 0xaf, 0x6f, 0x1e, 0xe3, 0x43, 0xf3, 0xf8, 0x35, 0xde,
+	0xc8, 0xea, 0xc9, 0xe0, 0x08, 0x0b, 0xf0, 0x4f, 0x48, 0xe3, 0xbe, 0x11, 0xeb, 0xbc, 0x83, 0xec,
+	0xa0, 0xb3, 0xee, 0x9c, 0xee, 0x1b, 0xa7, 0x18, 0xf7, 0x5b, 0x9d, 0x46, 0x9f, 0x6d, 0x2c, 0x5f,
+	0xf0, 0x13, 0x04, 0x36, 0x70, 0xb7, 0xc5, 0xbe, 0xfe, 0x64, 0xbc, 0x0f, 0xe2, 0x8c, 0x1f, 0x18,
+	0xe3, 0x7b, 0x6c, 0x8d, 0x71, 0xbd, 0xd7, 0x0f, 0x10, 0xd8, 0x33, 0x71, 0xde, 0x7d, 0x07, 0xcb,
+	0x78, 0x1f, 0xe4, 0xf2, 0xa6, 0x8f, 0xd6, 0x78, 0xbf, 0x09, 0xcc, 0xaf, 0xe6, 0xf8, 0xcf, 0x00,
+	0x91, 0xb8, 0x91, 0x17, 0xa6, 0x06, 0x00, 0x00,
+}
@@ -0,0 +1,497 @@
+// Code generated by protoc-gen-go.
+// source: influxDBIntegration.proto
+// DO NOT EDIT!
+
+package api
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import _ "github.com/grpc-ecosystem/grpc-gateway/third_party/googleapis/google/api"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+type CreateInfluxDBIntegrationRequest struct {
+	// hex encoded AppEUI
+	AppEUI string `protobuf:"bytes,1,opt,name=appEUI" json:"appEUI,omitempty"`
+	// InfluxDB endpoint (e.g. http://localhost:8086)
+	Endpoint string `protobuf:"bytes,2,opt,name=endpoint" json:"endpoint,omitempty"`
+	// organization of the bucket
+	Organization string `protobuf:"bytes,3,opt,name=organization" json:"organization,omitempty"`
+	// bucket to write the points to
+	Bucket string `protobuf:"bytes,4,opt,name=bucket" json:"bucket,omitempty"`
+	// token used for authentication (optional)
+	Token string `protobuf:"bytes,5,opt,name=token" json:"token,omitempty"`
+	// measurement of the uplink points (default: uplink), the gateway points are written to this measurement suffixed by _rx
+	Measurement string `protobuf:"bytes,6,opt,name=measurement" json:"measurement,omitempty"`
+}
+
+func (m *CreateInfluxDBIntegrationRequest) Reset()         { *m = CreateInfluxDBIntegrationRequest{} }
+func (m *CreateInfluxDBIntegrationRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateInfluxDBIntegrationRequest) ProtoMessage()    {}
+func (*CreateInfluxDBIntegrationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor15, []int{0}
+}
+
+func (m *CreateInfluxDBIntegrationRequest) GetAppEUI() string {
+	if m != nil {
+		return m.AppEUI
+	}
+	return ""
+}
+
+func (m *CreateInfluxDBIntegrationRequest) GetEndpoint() string {
+	if m != nil {
+		return m.Endpoint
+	}
+	return ""
+}
+
+func (m *CreateInfluxDBIntegrationRequest) GetOrganization() string {
+	if m != nil {
+		return m.Organization
+	}
+	return ""
+}
+
+func (m *CreateInfluxDBIntegrationRequest) GetBucket() string {
+	if m != nil {
+		return m.Bucket
+	}
+	return ""
+}
+
+func (m *CreateInfluxDBIntegrationRequest) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *CreateInfluxDBIntegrationRequest) GetMeasurement() string {
+	if m != nil {
+		return m.Measurement
+	}
+	return ""
+}
+
+type CreateInfluxDBIntegrationResponse struct {
+}
+
+func (m *CreateInfluxDBIntegrationResponse) Reset()         { *m = CreateInfluxDBIntegrationResponse{} }
+func (m *CreateInfluxDBIntegrationResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateInfluxDBIntegrationResponse) ProtoMessage()    {}
+func (*CreateInfluxDBIntegrationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor15, []int{1}
+}
+
+type GetInfluxDBIntegrationRequest struct {
+	// hex encoded AppEUI
+	AppEUI string `protobuf:"bytes,1,opt,name=appEUI" json:"appEUI,omitempty"`
+}
+
+func (m *GetInfluxDBIntegrationRequest) Reset()                    { *m = GetInfluxDBIntegrationRequest{} }
+func (m *GetInfluxDBIntegrationRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetInfluxDBIntegrationRequest) ProtoMessage()               {}
+func (*GetInfluxDBIntegrationRequest) Descriptor() ([]byte, []int) { return fileDescriptor15, []int{2} }
+
+func (m *GetInfluxDBIntegrationRequest) GetAppEUI() string {
+	if m != nil {
+		return m.AppEUI
+	}
+	return ""
+}
+
+type GetInfluxDBIntegrationResponse struct {
+	// hex encoded AppEUI
+	AppEUI string `protobuf:"bytes,1,opt,name=appEUI" json:"appEUI,omitempty"`
+	// InfluxDB endpoint (e.g. http://localhost:8086)
+	Endpoint string `protobuf:"bytes,2,opt,name=endpoint" json:"endpoint,omitempty"`
+	// organization of the bucket
+	Organization string `protobuf:"bytes,3,opt,name=organization" json:"organization,omitempty"`
+	// bucket to write the points to
+	Bucket string `protobuf:"bytes,4,opt,name=bucket" json:"bucket,omitempty"`
+	// token used for authentication (optional)
+	Token string `protobuf:"bytes,5,opt,name=token" json:"token,omitempty"`
+	// measurement of the uplink points, the gateway points are written to this measurement suffixed by _rx
+	Measurement string `protobuf:"bytes,6,opt,name=measurement" json:"measurement,omitempty"`
+}
+
+func (m *GetInfluxDBIntegrationResponse) Reset()                    { *m = GetInfluxDBIntegrationResponse{} }
+func (m *GetInfluxDBIntegrationResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetInfluxDBIntegrationResponse) ProtoMessage()               {}
+func (*GetInfluxDBIntegrationResponse) Descriptor() ([]byte, []int) { return fileDescriptor15, []int{3} }
+
+func (m *GetInfluxDBIntegrationResponse) GetAppEUI() string {
+	if m != nil {
+		return m.AppEUI
+	}
+	return ""
+}
+
+func (m *GetInfluxDBIntegrationResponse) GetEndpoint() string {
+	if m != nil {
+		return m.Endpoint
+	}
+	return ""
+}
+
+func (m *GetInfluxDBIntegrationResponse) GetOrganization() string {
+	if m != nil {
+		return m.Organization
+	}
+	return ""
+}
+
+func (m *GetInfluxDBIntegrationResponse) GetBucket() string {
+	if m != nil {
+		return m.Bucket
+	}
+	return ""
+}
+
+func (m *GetInfluxDBIntegrationResponse) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *GetInfluxDBIntegrationResponse) GetMeasurement() string {
+	if m != nil {
+		return m.Measurement
+	}
+	return ""
+}
+
+type UpdateInfluxDBIntegrationRequest struct {
+	// hex encoded AppEUI
+	AppEUI string `protobuf:"bytes,1,opt,name=appEUI" json:"appEUI,omitempty"`
+	// InfluxDB endpoint (e.g. http://localhost:8086)
+	Endpoint string `protobuf:"bytes,2,opt,name=endpoint" json:"endpoint,omitempty"`
+	// organization of the bucket
+	Organization string `protobuf:"bytes,3,opt,name=organization" json:"organization,omitempty"`
+	// bucket to write the points to
+	Bucket string `protobuf:"bytes,4,opt,name=bucket" json:"bucket,omitempty"`
+	// token used for authentication (optional)
+	Token string `protobuf:"bytes,5,opt,name=token" json:"token,omitempty"`
+	// measurement of the uplink points (default: uplink), the gateway points are written to this measurement suffixed by _rx
+	Measurement string `protobuf:"bytes,6,opt,name=measurement" json:"measurement,omitempty"`
+}
+
+func (m *UpdateInfluxDBIntegrationRequest) Reset()         { *m = UpdateInfluxDBIntegrationRequest{} }
+func (m *UpdateInfluxDBIntegrationRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateInfluxDBIntegrationRequest) ProtoMessage()    {}
+func (*UpdateInfluxDBIntegrationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor15, []int{4}
+}
+
+func (m *UpdateInfluxDBIntegrationRequest) GetAppEUI() string {
+	if m != nil {
+		return m.AppEUI
+	}
+	return ""
+}
+
+func (m *UpdateInfluxDBIntegrationRequest) GetEndpoint() string {
+	if m != nil {
+		return m.Endpoint
+	}
+	return ""
+}
+
+func (m *UpdateInfluxDBIntegrationRequest) GetOrganization() string {
+	if m != nil {
+		return m.Organization
+	}
+	return ""
+}
+
+func (m *UpdateInfluxDBIntegrationRequest) GetBucket() string {
+	if m != nil {
+		return m.Bucket
+	}
+	return ""
+}
+
+func (m *UpdateInfluxDBIntegrationRequest) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *UpdateInfluxDBIntegrationRequest) GetMeasurement() string {
+	if m != nil {
+		return m.Measurement
+	}
+	return ""
+}
+
+type UpdateInfluxDBIntegrationResponse struct {
+}
+
+func (m *UpdateInfluxDBIntegrationResponse) Reset()         { *m = UpdateInfluxDBIntegrationResponse{} }
+func (m *UpdateInfluxDBIntegrationResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateInfluxDBIntegrationResponse) ProtoMessage()    {}
+func (*UpdateInfluxDBIntegrationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor15, []int{5}
+}
+
+type DeleteInfluxDBIntegrationRequest struct {
+	// hex encoded AppEUI
+	AppEUI string `protobuf:"bytes,1,opt,name=appEUI" json:"appEUI,omitempty"`
+}
+
+func (m *DeleteInfluxDBIntegrationRequest) Reset()         { *m = DeleteInfluxDBIntegrationRequest{} }
+func (m *DeleteInfluxDBIntegrationRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteInfluxDBIntegrationRequest) ProtoMessage()    {}
+func (*DeleteInfluxDBIntegrationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor15, []int{6}
+}
+
+func (m *DeleteInfluxDBIntegrationRequest) GetAppEUI() string {
+	if m != nil {
+		return m.AppEUI
+	}
+	return ""
+}
+
+type DeleteInfluxDBIntegrationResponse struct {
+}
+
+func (m *DeleteInfluxDBIntegrationResponse) Reset()         { *m = DeleteInfluxDBIntegrationResponse{} }
+func (m *DeleteInfluxDBIntegrationResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteInfluxDBIntegrationResponse) ProtoMessage()    {}
+func (*DeleteInfluxDBIntegrationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor15, []int{7}
+}
+
+func init() {
+	proto.RegisterType((*CreateInfluxDBIntegrationRequest)(nil), "api.CreateInfluxDBIntegrationRequest")
+	proto.RegisterType((*CreateInfluxDBIntegrationResponse)(nil), "api.CreateInfluxDBIntegrationResponse")
+	proto.RegisterType((*GetInfluxDBIntegrationRequest)(nil), "api.GetInfluxDBIntegrationRequest")
+	proto.RegisterType((*GetInfluxDBIntegrationResponse)(nil), "api.GetInfluxDBIntegrationResponse")
+	proto.RegisterType((*UpdateInfluxDBIntegrationRequest)(nil), "api.UpdateInfluxDBIntegrationRequest")
+	proto.RegisterType((*UpdateInfluxDBIntegrationResponse)(nil), "api.UpdateInfluxDBIntegrationResponse")
+	proto.RegisterType((*DeleteInfluxDBIntegrationRequest)(nil), "api.DeleteInfluxDBIntegrationRequest")
+	proto.RegisterType((*DeleteInfluxDBIntegrationResponse)(nil), "api.DeleteInfluxDBIntegrationResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for InfluxDBIntegration service
+
+type InfluxDBIntegrationClient interface {
+	// Create creates the InfluxDB integration of the given application.
+	Create(ctx context.Context, in *CreateInfluxDBIntegrationRequest, opts ...grpc.CallOption) (*CreateInfluxDBIntegrationResponse, error)
+	// Get returns the InfluxDB integration of the given application.
+	Get(ctx context.Context, in *GetInfluxDBIntegrationRequest, opts ...grpc.CallOption) (*GetInfluxDBIntegrationResponse, error)
+	// Update updates the InfluxDB integration of the given application.
+	Update(ctx context.Context, in *UpdateInfluxDBIntegrationRequest, opts ...grpc.CallOption) (*UpdateInfluxDBIntegrationResponse, error)
+	// Delete deletes the InfluxDB integration of the given application.
+	Delete(ctx context.Context, in *DeleteInfluxDBIntegrationRequest, opts ...grpc.CallOption) (*DeleteInfluxDBIntegrationResponse, error)
+}
+
+type influxDBIntegrationClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewInfluxDBIntegrationClient(cc *grpc.ClientConn) InfluxDBIntegrationClient {
+	return &influxDBIntegrationClient{cc}
+}
+
+func (c *influxDBIntegrationClient) Create(ctx context.Context, in *CreateInfluxDBIntegrationRequest, opts ...grpc.CallOption) (*CreateInfluxDBIntegrationResponse, error) {
+	out := new(CreateInfluxDBIntegrationResponse)
+	err := grpc.Invoke(ctx, "/api.InfluxDBIntegration/Create", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *influxDBIntegrationClient) Get(ctx context.Context, in *GetInfluxDBIntegrationRequest, opts ...grpc.CallOption) (*GetInfluxDBIntegrationResponse, error) {
+	out := new(GetInfluxDBIntegrationResponse)
+	err := grpc.Invoke(ctx, "/api.InfluxDBIntegration/Get", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *influxDBIntegrationClient) Update(ctx context.Context, in *UpdateInfluxDBIntegrationRequest, opts ...grpc.CallOption) (*UpdateInfluxDBIntegrationResponse, error) {
+	out := new(UpdateInfluxDBIntegrationResponse)
+	err := grpc.Invoke(ctx, "/api.InfluxDBIntegration/Update", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *influxDBIntegrationClient) Delete(ctx context.Context, in *DeleteInfluxDBIntegrationRequest, opts ...grpc.CallOption) (*DeleteInfluxDBIntegrationResponse, error) {
+	out := new(DeleteInfluxDBIntegrationResponse)
+	err := grpc.Invoke(ctx, "/api.InfluxDBIntegration/Delete", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for InfluxDBIntegration service
+
+type InfluxDBIntegrationServer interface {
+	// Create creates the InfluxDB integration of the given application.
+	Create(context.Context, *CreateInfluxDBIntegrationRequest) (*CreateInfluxDBIntegrationResponse, error)
+	// Get returns the InfluxDB integration of the given application.
+	Get(context.Context, *GetInfluxDBIntegrationRequest) (*GetInfluxDBIntegrationResponse, error)
+	// Update updates the InfluxDB integration of the given application.
+	Update(context.Context, *UpdateInfluxDBIntegrationRequest) (*UpdateInfluxDBIntegrationResponse, error)
+	// Delete deletes the InfluxDB integration of the given application.
+	Delete(context.Context, *DeleteInfluxDBIntegrationRequest) (*DeleteInfluxDBIntegrationResponse, error)
+}
+
+func RegisterInfluxDBIntegrationServer(s *grpc.Server, srv InfluxDBIntegrationServer) {
+	s.RegisterService(&_InfluxDBIntegration_serviceDesc, srv)
+}
+
+func _InfluxDBIntegration_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateInfluxDBIntegrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InfluxDBIntegrationServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.InfluxDBIntegration/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InfluxDBIntegrationServer).Create(ctx, req.(*CreateInfluxDBIntegrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InfluxDBIntegration_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInfluxDBIntegrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InfluxDBIntegrationServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.InfluxDBIntegration/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InfluxDBIntegrationServer).Get(ctx, req.(*GetInfluxDBIntegrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InfluxDBIntegration_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateInfluxDBIntegrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InfluxDBIntegrationServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.InfluxDBIntegration/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InfluxDBIntegrationServer).Update(ctx, req.(*UpdateInfluxDBIntegrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InfluxDBIntegration_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteInfluxDBIntegrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InfluxDBIntegrationServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.InfluxDBIntegration/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InfluxDBIntegrationServer).Delete(ctx, req.(*DeleteInfluxDBIntegrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _InfluxDBIntegration_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.InfluxDBIntegration",
+	HandlerType: (*InfluxDBIntegrationServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _InfluxDBIntegration_Create_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _InfluxDBIntegration_Get_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _InfluxDBIntegration_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _InfluxDBIntegration_Delete_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "influxDBIntegration.proto",
+}
+
+func init() { proto.RegisterFile("influxDBIntegration.proto", fileDescriptor15) }
+
+var fileDescriptor15 = []byte{
+	// 400 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x54, 0xcd, 0x4a, 0x23, 0x41,
+	0x10, 0x66, 0xf2, 0x33, 0xec, 0xd6, 0xee, 0xa9, 0x77, 0x59, 0x26, 0xc3, 0x1a, 0xc6, 0x4e, 0x8c,
+	0x12, 0x30, 0x01, 0x3d, 0x08, 0x39, 0x6a, 0x24, 0xe4, 0x1a, 0xc8, 0x03, 0x74, 0x4c, 0x39, 0x0c,
+	0x49, 0xba, 0xdb, 0x99, 0x1e, 0x90, 0x48, 0x2e, 0x0a, 0xbe, 0x80, 0x0f, 0xe0, 0xeb, 0x88, 0x57,
+	0x5f, 0xc1, 0x07, 0x91, 0xe9, 0x1e, 0xc4, 0x60, 0x32, 0x13, 0xbd, 0xe9, 0xb1, 0xba, 0xbe, 0xfe,
+	0xaa, 0xbe, 0xfa, 0xaa, 0x1b, 0x2a, 0x01, 0x3f, 0x9f, 0xc6, 0x97, 0xdd, 0xe3, 0x3e, 0x57, 0xe8,
+	0x87, 0x4c, 0x05, 0x82, 0xb7, 0x64, 0x28, 0x94, 0x20, 0x45, 0x26, 0x03, 0xf7, 0xbf, 0x2f, 0x84,
+	0x3f, 0xc5, 0x36, 0x93, 0x41, 0x9b, 0x71, 0x2e, 0x94, 0x46, 0x44, 0x06, 0x42, 0x1f, 0x2d, 0xf0,
+	0x4e, 0x42, 0x64, 0x0a, 0xfb, 0xef, 0x69, 0x06, 0x78, 0x11, 0x63, 0xa4, 0xc8, 0x3f, 0xb0, 0x99,
+	0x94, 0xa7, 0xc3, 0xbe, 0x63, 0x79, 0xd6, 0xde, 0xcf, 0x41, 0x1a, 0x11, 0x17, 0x7e, 0x20, 0x1f,
+	0x4b, 0x11, 0x70, 0xe5, 0x14, 0x74, 0xe6, 0x35, 0x26, 0x14, 0x7e, 0x8b, 0xd0, 0x67, 0x3c, 0x98,
+	0x6b, 0x2a, 0xa7, 0xa8, 0xf3, 0x4b, 0x67, 0x09, 0xef, 0x28, 0x3e, 0x9b, 0xa0, 0x72, 0x4a, 0x86,
+	0xd7, 0x44, 0xe4, 0x2f, 0x94, 0x95, 0x98, 0x20, 0x77, 0xca, 0xfa, 0xd8, 0x04, 0xc4, 0x83, 0x5f,
+	0x33, 0x64, 0x51, 0x1c, 0xe2, 0x0c, 0xb9, 0x72, 0x6c, 0x9d, 0x7b, 0x7b, 0x44, 0x6b, 0xb0, 0x9d,
+	0xa1, 0x25, 0x92, 0x82, 0x47, 0x48, 0x8f, 0x60, 0xab, 0x87, 0xea, 0xe3, 0x6a, 0xe9, 0x83, 0x05,
+	0xd5, 0x75, 0x37, 0x0d, 0xf7, 0x97, 0x19, 0x54, 0xe2, 0xfa, 0x50, 0x8e, 0xbf, 0x8d, 0xeb, 0x19,
+	0x5a, 0x52, 0xd7, 0x3b, 0xe0, 0x75, 0x71, 0x8a, 0x9f, 0x11, 0x9c, 0x14, 0xc8, 0xb8, 0x6b, 0x0a,
+	0x1c, 0xdc, 0x97, 0xe0, 0xcf, 0x8a, 0x3c, 0x59, 0x80, 0x6d, 0x76, 0x92, 0xec, 0xb4, 0x98, 0x0c,
+	0x5a, 0x79, 0x8f, 0xcd, 0x6d, 0xe4, 0xc1, 0x52, 0x45, 0xf5, 0xeb, 0xa7, 0xe7, 0xbb, 0x42, 0x95,
+	0x56, 0xf4, 0xcb, 0x5e, 0xf1, 0x09, 0x44, 0x1d, 0xab, 0x49, 0xe6, 0x50, 0xec, 0xa1, 0x22, 0x54,
+	0x93, 0x66, 0xee, 0xbd, 0x5b, 0xcb, 0xc4, 0xa4, 0x55, 0x9b, 0xba, 0x6a, 0x9d, 0xd0, 0xb5, 0x55,
+	0xdb, 0x57, 0x66, 0x6c, 0x0b, 0x72, 0x6b, 0x81, 0x6d, 0x9c, 0x49, 0xb5, 0xe7, 0xad, 0x9c, 0xdb,
+	0xc8, 0x83, 0xa5, 0x5d, 0xec, 0xeb, 0x2e, 0x76, 0xdd, 0x0d, 0xba, 0x48, 0x86, 0x70, 0x63, 0x81,
+	0x6d, 0x1c, 0x4c, 0x1b, 0xc9, 0x5b, 0x05, 0xb7, 0x91, 0x07, 0x5b, 0x1e, 0x47, 0x73, 0x83, 0x46,
+	0x46, 0xb6, 0xfe, 0x71, 0x0f, 0x5f, 0x06, 0x00, 0xb9, 0x76, 0xca, 0x70, 0xb1, 0x05, 0x00, 0x00,
+}
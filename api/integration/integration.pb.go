@@ -0,0 +1,1258 @@
+// Code generated by protoc-gen-go.
+// source: integration.proto
+// DO NOT EDIT!
+
+/*
+Package integration is a generated protocol buffer package.
+
+It is generated from these files:
+	integration.proto
+
+It has these top-level messages:
+	DataRate
+	RXInfo
+	TXInfo
+	DataUpPayload
+	DataDownPayload
+	JoinNotification
+	ACKNotification
+	ErrorNotification
+	LinkQualityNotification
+	LifecycleNotification
+	FirmwareNotification
+	DeviceStatus
+	LocationNotification
+	ScheduledDownlinkNotification
+	GatewayStats
+	UsageStats
+*/
+package integration
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type DataRate struct {
+	// modulation (LORA or FSK)
+	Modulation string `protobuf:"bytes,1,opt,name=modulation" json:"modulation,omitempty"`
+	// bandwidth (kHz)
+	Bandwidth uint32 `protobuf:"varint,2,opt,name=bandwidth" json:"bandwidth,omitempty"`
+	// spread-factor (LoRa modulation)
+	SpreadFactor uint32 `protobuf:"varint,3,opt,name=spreadFactor" json:"spreadFactor,omitempty"`
+	// bitrate (FSK modulation)
+	Bitrate uint32 `protobuf:"varint,4,opt,name=bitrate" json:"bitrate,omitempty"`
+}
+
+func (m *DataRate) Reset()                    { *m = DataRate{} }
+func (m *DataRate) String() string            { return proto.CompactTextString(m) }
+func (*DataRate) ProtoMessage()               {}
+func (*DataRate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
+func (m *DataRate) GetModulation() string {
+	if m != nil {
+		return m.Modulation
+	}
+	return ""
+}
+
+func (m *DataRate) GetBandwidth() uint32 {
+	if m != nil {
+		return m.Bandwidth
+	}
+	return 0
+}
+
+func (m *DataRate) GetSpreadFactor() uint32 {
+	if m != nil {
+		return m.SpreadFactor
+	}
+	return 0
+}
+
+func (m *DataRate) GetBitrate() uint32 {
+	if m != nil {
+		return m.Bitrate
+	}
+	return 0
+}
+
+type RXInfo struct {
+	// MAC of the receiving gateway
+	Mac string `protobuf:"bytes,1,opt,name=mac" json:"mac,omitempty"`
+	// time of receiving (empty when unknown)
+	Time string `protobuf:"bytes,2,opt,name=time" json:"time,omitempty"`
+	// RSSI (dBm)
+	Rssi int32 `protobuf:"varint,3,opt,name=rssi" json:"rssi,omitempty"`
+	// LoRa SNR (dB)
+	LoRaSNR float64 `protobuf:"fixed64,4,opt,name=loRaSNR" json:"loRaSNR,omitempty"`
+}
+
+func (m *RXInfo) Reset()                    { *m = RXInfo{} }
+func (m *RXInfo) String() string            { return proto.CompactTextString(m) }
+func (*RXInfo) ProtoMessage()               {}
+func (*RXInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+
+func (m *RXInfo) GetMac() string {
+	if m != nil {
+		return m.Mac
+	}
+	return ""
+}
+
+func (m *RXInfo) GetTime() string {
+	if m != nil {
+		return m.Time
+	}
+	return ""
+}
+
+func (m *RXInfo) GetRssi() int32 {
+	if m != nil {
+		return m.Rssi
+	}
+	return 0
+}
+
+func (m *RXInfo) GetLoRaSNR() float64 {
+	if m != nil {
+		return m.LoRaSNR
+	}
+	return 0
+}
+
+type TXInfo struct {
+	// frequency (Hz)
+	Frequency uint32 `protobuf:"varint,1,opt,name=frequency" json:"frequency,omitempty"`
+	// data-rate
+	DataRate *DataRate `protobuf:"bytes,2,opt,name=dataRate" json:"dataRate,omitempty"`
+	// ADR enabled
+	Adr bool `protobuf:"varint,3,opt,name=adr" json:"adr,omitempty"`
+	// code-rate
+	CodeRate string `protobuf:"bytes,4,opt,name=codeRate" json:"codeRate,omitempty"`
+}
+
+func (m *TXInfo) Reset()                    { *m = TXInfo{} }
+func (m *TXInfo) String() string            { return proto.CompactTextString(m) }
+func (*TXInfo) ProtoMessage()               {}
+func (*TXInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+
+func (m *TXInfo) GetFrequency() uint32 {
+	if m != nil {
+		return m.Frequency
+	}
+	return 0
+}
+
+func (m *TXInfo) GetDataRate() *DataRate {
+	if m != nil {
+		return m.DataRate
+	}
+	return nil
+}
+
+func (m *TXInfo) GetAdr() bool {
+	if m != nil {
+		return m.Adr
+	}
+	return false
+}
+
+func (m *TXInfo) GetCodeRate() string {
+	if m != nil {
+		return m.CodeRate
+	}
+	return ""
+}
+
+type DataUpPayload struct {
+	// DevEUI of the node
+	DevEUI string `protobuf:"bytes,1,opt,name=devEUI" json:"devEUI,omitempty"`
+	// RX information of the receiving gateways
+	RxInfo []*RXInfo `protobuf:"bytes,2,rep,name=rxInfo" json:"rxInfo,omitempty"`
+	// TX information
+	TxInfo *TXInfo `protobuf:"bytes,3,opt,name=txInfo" json:"txInfo,omitempty"`
+	// frame-counter
+	FCnt uint32 `protobuf:"varint,4,opt,name=fCnt" json:"fCnt,omitempty"`
+	// FPort
+	FPort uint32 `protobuf:"varint,5,opt,name=fPort" json:"fPort,omitempty"`
+	// (decrypted) payload, or the encrypted FRMPayload when encrypted is set
+	Data []byte `protobuf:"bytes,6,opt,name=data,proto3" json:"data,omitempty"`
+	// JSON encoded object, as decoded by the payload codec of the application
+	ObjectJSON string `protobuf:"bytes,7,opt,name=objectJSON" json:"objectJSON,omitempty"`
+	// the node uses end-to-end encryption, data must be decrypted by the application
+	Encrypted bool `protobuf:"varint,8,opt,name=encrypted" json:"encrypted,omitempty"`
+	// DevAddr of the node (only set when encrypted)
+	DevAddr string `protobuf:"bytes,9,opt,name=devAddr" json:"devAddr,omitempty"`
+}
+
+func (m *DataUpPayload) Reset()                    { *m = DataUpPayload{} }
+func (m *DataUpPayload) String() string            { return proto.CompactTextString(m) }
+func (*DataUpPayload) ProtoMessage()               {}
+func (*DataUpPayload) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+
+func (m *DataUpPayload) GetDevEUI() string {
+	if m != nil {
+		return m.DevEUI
+	}
+	return ""
+}
+
+func (m *DataUpPayload) GetRxInfo() []*RXInfo {
+	if m != nil {
+		return m.RxInfo
+	}
+	return nil
+}
+
+func (m *DataUpPayload) GetTxInfo() *TXInfo {
+	if m != nil {
+		return m.TxInfo
+	}
+	return nil
+}
+
+func (m *DataUpPayload) GetFCnt() uint32 {
+	if m != nil {
+		return m.FCnt
+	}
+	return 0
+}
+
+func (m *DataUpPayload) GetFPort() uint32 {
+	if m != nil {
+		return m.FPort
+	}
+	return 0
+}
+
+func (m *DataUpPayload) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *DataUpPayload) GetObjectJSON() string {
+	if m != nil {
+		return m.ObjectJSON
+	}
+	return ""
+}
+
+func (m *DataUpPayload) GetEncrypted() bool {
+	if m != nil {
+		return m.Encrypted
+	}
+	return false
+}
+
+func (m *DataUpPayload) GetDevAddr() string {
+	if m != nil {
+		return m.DevAddr
+	}
+	return ""
+}
+
+type DataDownPayload struct {
+	// reference, returned in the ack and error notifications
+	Reference string `protobuf:"bytes,1,opt,name=reference" json:"reference,omitempty"`
+	// confirmed downlink
+	Confirmed bool `protobuf:"varint,2,opt,name=confirmed" json:"confirmed,omitempty"`
+	// DevEUI of the node
+	DevEUI string `protobuf:"bytes,3,opt,name=devEUI" json:"devEUI,omitempty"`
+	// FPort
+	FPort uint32 `protobuf:"varint,4,opt,name=fPort" json:"fPort,omitempty"`
+	// payload
+	Data []byte `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
+	// JSON encoded object, encoded by the payload codec of the application (when data is empty)
+	ObjectJSON string `protobuf:"bytes,6,opt,name=objectJSON" json:"objectJSON,omitempty"`
+	// nonce
+	Nonce string `protobuf:"bytes,7,opt,name=nonce" json:"nonce,omitempty"`
+	// expiration time (empty when the payload does not expire)
+	ExpiresAt string `protobuf:"bytes,8,opt,name=expiresAt" json:"expiresAt,omitempty"`
+	// time before which the payload is not sent (empty when not delayed)
+	DelayUntil string `protobuf:"bytes,9,opt,name=delayUntil" json:"delayUntil,omitempty"`
+	// max. number of retransmissions of a confirmed payload (0 = no limit)
+	MaxRetries uint32 `protobuf:"varint,10,opt,name=maxRetries" json:"maxRetries,omitempty"`
+	// data contains the FRMPayload encrypted by the application (end-to-end encryption)
+	Encrypted bool `protobuf:"varint,11,opt,name=encrypted" json:"encrypted,omitempty"`
+	// downlink frame-counter used for encrypting data (only used when encrypted)
+	FCnt uint32 `protobuf:"varint,12,opt,name=fCnt" json:"fCnt,omitempty"`
+}
+
+func (m *DataDownPayload) Reset()                    { *m = DataDownPayload{} }
+func (m *DataDownPayload) String() string            { return proto.CompactTextString(m) }
+func (*DataDownPayload) ProtoMessage()               {}
+func (*DataDownPayload) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+
+func (m *DataDownPayload) GetReference() string {
+	if m != nil {
+		return m.Reference
+	}
+	return ""
+}
+
+func (m *DataDownPayload) GetConfirmed() bool {
+	if m != nil {
+		return m.Confirmed
+	}
+	return false
+}
+
+func (m *DataDownPayload) GetDevEUI() string {
+	if m != nil {
+		return m.DevEUI
+	}
+	return ""
+}
+
+func (m *DataDownPayload) GetFPort() uint32 {
+	if m != nil {
+		return m.FPort
+	}
+	return 0
+}
+
+func (m *DataDownPayload) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *DataDownPayload) GetObjectJSON() string {
+	if m != nil {
+		return m.ObjectJSON
+	}
+	return ""
+}
+
+func (m *DataDownPayload) GetNonce() string {
+	if m != nil {
+		return m.Nonce
+	}
+	return ""
+}
+
+func (m *DataDownPayload) GetExpiresAt() string {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return ""
+}
+
+func (m *DataDownPayload) GetDelayUntil() string {
+	if m != nil {
+		return m.DelayUntil
+	}
+	return ""
+}
+
+func (m *DataDownPayload) GetMaxRetries() uint32 {
+	if m != nil {
+		return m.MaxRetries
+	}
+	return 0
+}
+
+func (m *DataDownPayload) GetEncrypted() bool {
+	if m != nil {
+		return m.Encrypted
+	}
+	return false
+}
+
+func (m *DataDownPayload) GetFCnt() uint32 {
+	if m != nil {
+		return m.FCnt
+	}
+	return 0
+}
+
+type JoinNotification struct {
+	// DevAddr of the node
+	DevAddr string `protobuf:"bytes,1,opt,name=devAddr" json:"devAddr,omitempty"`
+	// DevEUI of the node
+	DevEUI string `protobuf:"bytes,2,opt,name=devEUI" json:"devEUI,omitempty"`
+}
+
+func (m *JoinNotification) Reset()                    { *m = JoinNotification{} }
+func (m *JoinNotification) String() string            { return proto.CompactTextString(m) }
+func (*JoinNotification) ProtoMessage()               {}
+func (*JoinNotification) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+
+func (m *JoinNotification) GetDevAddr() string {
+	if m != nil {
+		return m.DevAddr
+	}
+	return ""
+}
+
+func (m *JoinNotification) GetDevEUI() string {
+	if m != nil {
+		return m.DevEUI
+	}
+	return ""
+}
+
+type ACKNotification struct {
+	// reference of the acknowledged data-down payload
+	Reference string `protobuf:"bytes,1,opt,name=reference" json:"reference,omitempty"`
+	// DevEUI of the node
+	DevEUI string `protobuf:"bytes,2,opt,name=devEUI" json:"devEUI,omitempty"`
+}
+
+func (m *ACKNotification) Reset()                    { *m = ACKNotification{} }
+func (m *ACKNotification) String() string            { return proto.CompactTextString(m) }
+func (*ACKNotification) ProtoMessage()               {}
+func (*ACKNotification) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+
+func (m *ACKNotification) GetReference() string {
+	if m != nil {
+		return m.Reference
+	}
+	return ""
+}
+
+func (m *ACKNotification) GetDevEUI() string {
+	if m != nil {
+		return m.DevEUI
+	}
+	return ""
+}
+
+type ErrorNotification struct {
+	// DevEUI of the node
+	DevEUI string `protobuf:"bytes,1,opt,name=devEUI" json:"devEUI,omitempty"`
+	// reference of the related data-down payload
+	Reference string `protobuf:"bytes,2,opt,name=reference" json:"reference,omitempty"`
+	// error type
+	Type string `protobuf:"bytes,3,opt,name=type" json:"type,omitempty"`
+	// error message
+	Error string `protobuf:"bytes,4,opt,name=error" json:"error,omitempty"`
+}
+
+func (m *ErrorNotification) Reset()                    { *m = ErrorNotification{} }
+func (m *ErrorNotification) String() string            { return proto.CompactTextString(m) }
+func (*ErrorNotification) ProtoMessage()               {}
+func (*ErrorNotification) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
+
+func (m *ErrorNotification) GetDevEUI() string {
+	if m != nil {
+		return m.DevEUI
+	}
+	return ""
+}
+
+func (m *ErrorNotification) GetReference() string {
+	if m != nil {
+		return m.Reference
+	}
+	return ""
+}
+
+func (m *ErrorNotification) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *ErrorNotification) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type LinkQualityNotification struct {
+	// DevEUI of the node
+	DevEUI string `protobuf:"bytes,1,opt,name=devEUI" json:"devEUI,omitempty"`
+	// link-quality score
+	Score int32 `protobuf:"varint,2,opt,name=score" json:"score,omitempty"`
+	// previous link-quality score
+	PreviousScore int32 `protobuf:"varint,3,opt,name=previousScore" json:"previousScore,omitempty"`
+	// link-quality grade
+	Grade string `protobuf:"bytes,4,opt,name=grade" json:"grade,omitempty"`
+	// previous link-quality grade
+	PreviousGrade string `protobuf:"bytes,5,opt,name=previousGrade" json:"previousGrade,omitempty"`
+	// average RSSI (dBm)
+	Rssi float64 `protobuf:"fixed64,6,opt,name=rssi" json:"rssi,omitempty"`
+	// average LoRa SNR (dB)
+	LoRaSNR float64 `protobuf:"fixed64,7,opt,name=loRaSNR" json:"loRaSNR,omitempty"`
+	// SNR trend
+	SnrTrend float64 `protobuf:"fixed64,8,opt,name=snrTrend" json:"snrTrend,omitempty"`
+	// packet-loss
+	PacketLoss float64 `protobuf:"fixed64,9,opt,name=packetLoss" json:"packetLoss,omitempty"`
+	// retransmissions
+	Retransmissions float64 `protobuf:"fixed64,10,opt,name=retransmissions" json:"retransmissions,omitempty"`
+}
+
+func (m *LinkQualityNotification) Reset()                    { *m = LinkQualityNotification{} }
+func (m *LinkQualityNotification) String() string            { return proto.CompactTextString(m) }
+func (*LinkQualityNotification) ProtoMessage()               {}
+func (*LinkQualityNotification) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+
+func (m *LinkQualityNotification) GetDevEUI() string {
+	if m != nil {
+		return m.DevEUI
+	}
+	return ""
+}
+
+func (m *LinkQualityNotification) GetScore() int32 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
+}
+
+func (m *LinkQualityNotification) GetPreviousScore() int32 {
+	if m != nil {
+		return m.PreviousScore
+	}
+	return 0
+}
+
+func (m *LinkQualityNotification) GetGrade() string {
+	if m != nil {
+		return m.Grade
+	}
+	return ""
+}
+
+func (m *LinkQualityNotification) GetPreviousGrade() string {
+	if m != nil {
+		return m.PreviousGrade
+	}
+	return ""
+}
+
+func (m *LinkQualityNotification) GetRssi() float64 {
+	if m != nil {
+		return m.Rssi
+	}
+	return 0
+}
+
+func (m *LinkQualityNotification) GetLoRaSNR() float64 {
+	if m != nil {
+		return m.LoRaSNR
+	}
+	return 0
+}
+
+func (m *LinkQualityNotification) GetSnrTrend() float64 {
+	if m != nil {
+		return m.SnrTrend
+	}
+	return 0
+}
+
+func (m *LinkQualityNotification) GetPacketLoss() float64 {
+	if m != nil {
+		return m.PacketLoss
+	}
+	return 0
+}
+
+func (m *LinkQualityNotification) GetRetransmissions() float64 {
+	if m != nil {
+		return m.Retransmissions
+	}
+	return 0
+}
+
+type LifecycleNotification struct {
+	// entity (NODE)
+	Entity string `protobuf:"bytes,1,opt,name=entity" json:"entity,omitempty"`
+	// action (CREATE, UPDATE or DELETE)
+	Action string `protobuf:"bytes,2,opt,name=action" json:"action,omitempty"`
+	// DevEUI of the node
+	DevEUI string `protobuf:"bytes,3,opt,name=devEUI" json:"devEUI,omitempty"`
+	// AppEUI of the application
+	AppEUI string `protobuf:"bytes,4,opt,name=appEUI" json:"appEUI,omitempty"`
+	// AppEUI of the previous application (when the node moved to an other application)
+	PreviousAppEUI string `protobuf:"bytes,5,opt,name=previousAppEUI" json:"previousAppEUI,omitempty"`
+	// name of the node
+	Name string `protobuf:"bytes,6,opt,name=name" json:"name,omitempty"`
+	// time of the action
+	Time string `protobuf:"bytes,7,opt,name=time" json:"time,omitempty"`
+}
+
+func (m *LifecycleNotification) Reset()                    { *m = LifecycleNotification{} }
+func (m *LifecycleNotification) String() string            { return proto.CompactTextString(m) }
+func (*LifecycleNotification) ProtoMessage()               {}
+func (*LifecycleNotification) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
+
+func (m *LifecycleNotification) GetEntity() string {
+	if m != nil {
+		return m.Entity
+	}
+	return ""
+}
+
+func (m *LifecycleNotification) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *LifecycleNotification) GetDevEUI() string {
+	if m != nil {
+		return m.DevEUI
+	}
+	return ""
+}
+
+func (m *LifecycleNotification) GetAppEUI() string {
+	if m != nil {
+		return m.AppEUI
+	}
+	return ""
+}
+
+func (m *LifecycleNotification) GetPreviousAppEUI() string {
+	if m != nil {
+		return m.PreviousAppEUI
+	}
+	return ""
+}
+
+func (m *LifecycleNotification) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *LifecycleNotification) GetTime() string {
+	if m != nil {
+		return m.Time
+	}
+	return ""
+}
+
+type FirmwareNotification struct {
+	// id of the firmware deployment
+	DeploymentID int64 `protobuf:"varint,1,opt,name=deploymentID" json:"deploymentID,omitempty"`
+	// DevEUI of the node
+	DevEUI string `protobuf:"bytes,2,opt,name=devEUI" json:"devEUI,omitempty"`
+	// firmware update state
+	State string `protobuf:"bytes,3,opt,name=state" json:"state,omitempty"`
+	// number of (uncoded) fragments of the firmware image
+	NbFrag uint32 `protobuf:"varint,4,opt,name=nbFrag" json:"nbFrag,omitempty"`
+	// number of received fragments, as last reported by the node
+	NbFragReceived uint32 `protobuf:"varint,5,opt,name=nbFragReceived" json:"nbFragReceived,omitempty"`
+	// number of missing fragments, as last reported by the node
+	MissingFrag uint32 `protobuf:"varint,6,opt,name=missingFrag" json:"missingFrag,omitempty"`
+	// error
+	Error string `protobuf:"bytes,7,opt,name=error" json:"error,omitempty"`
+	// time of the state change or report
+	Time string `protobuf:"bytes,8,opt,name=time" json:"time,omitempty"`
+}
+
+func (m *FirmwareNotification) Reset()                    { *m = FirmwareNotification{} }
+func (m *FirmwareNotification) String() string            { return proto.CompactTextString(m) }
+func (*FirmwareNotification) ProtoMessage()               {}
+func (*FirmwareNotification) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
+
+func (m *FirmwareNotification) GetDeploymentID() int64 {
+	if m != nil {
+		return m.DeploymentID
+	}
+	return 0
+}
+
+func (m *FirmwareNotification) GetDevEUI() string {
+	if m != nil {
+		return m.DevEUI
+	}
+	return ""
+}
+
+func (m *FirmwareNotification) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *FirmwareNotification) GetNbFrag() uint32 {
+	if m != nil {
+		return m.NbFrag
+	}
+	return 0
+}
+
+func (m *FirmwareNotification) GetNbFragReceived() uint32 {
+	if m != nil {
+		return m.NbFragReceived
+	}
+	return 0
+}
+
+func (m *FirmwareNotification) GetMissingFrag() uint32 {
+	if m != nil {
+		return m.MissingFrag
+	}
+	return 0
+}
+
+func (m *FirmwareNotification) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *FirmwareNotification) GetTime() string {
+	if m != nil {
+		return m.Time
+	}
+	return ""
+}
+
+type DeviceStatus struct {
+	// DevEUI of the node
+	DevEUI string `protobuf:"bytes,1,opt,name=devEUI" json:"devEUI,omitempty"`
+	// time of the last received uplink
+	LastSeen string `protobuf:"bytes,2,opt,name=lastSeen" json:"lastSeen,omitempty"`
+	// frame-counter of the last received uplink
+	FCnt uint32 `protobuf:"varint,3,opt,name=fCnt" json:"fCnt,omitempty"`
+	// RSSI of the best gateway (dBm)
+	Rssi int32 `protobuf:"varint,4,opt,name=rssi" json:"rssi,omitempty"`
+	// LoRa SNR of the best gateway (dB)
+	LoRaSNR float64 `protobuf:"fixed64,5,opt,name=loRaSNR" json:"loRaSNR,omitempty"`
+	// SNR above the demodulation floor of the spread-factor (LoRa only)
+	Margin float64 `protobuf:"fixed64,6,opt,name=margin" json:"margin,omitempty"`
+	// margin is set
+	HasMargin bool `protobuf:"varint,7,opt,name=hasMargin" json:"hasMargin,omitempty"`
+	// battery, as reported by the node (0 = external power source, 1 - 254 = battery level, 255 = unable to measure)
+	Battery uint32 `protobuf:"varint,8,opt,name=battery" json:"battery,omitempty"`
+	// battery is set
+	HasBattery bool `protobuf:"varint,9,opt,name=hasBattery" json:"hasBattery,omitempty"`
+	// battery level in percent
+	BatteryLevel float64 `protobuf:"fixed64,10,opt,name=batteryLevel" json:"batteryLevel,omitempty"`
+	// battery level is set
+	HasBatteryLevel bool `protobuf:"varint,11,opt,name=hasBatteryLevel" json:"hasBatteryLevel,omitempty"`
+	// demodulation margin (dB) of the last DevStatusReq, as reported by the node
+	LinkMargin int32 `protobuf:"varint,12,opt,name=linkMargin" json:"linkMargin,omitempty"`
+	// link margin is set
+	HasLinkMargin bool `protobuf:"varint,13,opt,name=hasLinkMargin" json:"hasLinkMargin,omitempty"`
+}
+
+func (m *DeviceStatus) Reset()                    { *m = DeviceStatus{} }
+func (m *DeviceStatus) String() string            { return proto.CompactTextString(m) }
+func (*DeviceStatus) ProtoMessage()               {}
+func (*DeviceStatus) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
+
+func (m *DeviceStatus) GetDevEUI() string {
+	if m != nil {
+		return m.DevEUI
+	}
+	return ""
+}
+
+func (m *DeviceStatus) GetLastSeen() string {
+	if m != nil {
+		return m.LastSeen
+	}
+	return ""
+}
+
+func (m *DeviceStatus) GetFCnt() uint32 {
+	if m != nil {
+		return m.FCnt
+	}
+	return 0
+}
+
+func (m *DeviceStatus) GetRssi() int32 {
+	if m != nil {
+		return m.Rssi
+	}
+	return 0
+}
+
+func (m *DeviceStatus) GetLoRaSNR() float64 {
+	if m != nil {
+		return m.LoRaSNR
+	}
+	return 0
+}
+
+func (m *DeviceStatus) GetMargin() float64 {
+	if m != nil {
+		return m.Margin
+	}
+	return 0
+}
+
+func (m *DeviceStatus) GetHasMargin() bool {
+	if m != nil {
+		return m.HasMargin
+	}
+	return false
+}
+
+func (m *DeviceStatus) GetBattery() uint32 {
+	if m != nil {
+		return m.Battery
+	}
+	return 0
+}
+
+func (m *DeviceStatus) GetHasBattery() bool {
+	if m != nil {
+		return m.HasBattery
+	}
+	return false
+}
+
+func (m *DeviceStatus) GetBatteryLevel() float64 {
+	if m != nil {
+		return m.BatteryLevel
+	}
+	return 0
+}
+
+func (m *DeviceStatus) GetHasBatteryLevel() bool {
+	if m != nil {
+		return m.HasBatteryLevel
+	}
+	return false
+}
+
+func (m *DeviceStatus) GetLinkMargin() int32 {
+	if m != nil {
+		return m.LinkMargin
+	}
+	return 0
+}
+
+func (m *DeviceStatus) GetHasLinkMargin() bool {
+	if m != nil {
+		return m.HasLinkMargin
+	}
+	return false
+}
+
+type LocationNotification struct {
+	// DevEUI of the node
+	DevEUI string `protobuf:"bytes,1,opt,name=devEUI" json:"devEUI,omitempty"`
+	// latitude
+	Latitude float64 `protobuf:"fixed64,2,opt,name=latitude" json:"latitude,omitempty"`
+	// longitude
+	Longitude float64 `protobuf:"fixed64,3,opt,name=longitude" json:"longitude,omitempty"`
+	// altitude (meters)
+	Altitude float64 `protobuf:"fixed64,4,opt,name=altitude" json:"altitude,omitempty"`
+	// accuracy (meters, 0 = unknown)
+	Accuracy float64 `protobuf:"fixed64,5,opt,name=accuracy" json:"accuracy,omitempty"`
+	// resolver used (e.g. loracloud)
+	Source string `protobuf:"bytes,6,opt,name=source" json:"source,omitempty"`
+	// number of gateways used for resolving the location
+	NbGateways uint32 `protobuf:"varint,7,opt,name=nbGateways" json:"nbGateways,omitempty"`
+	// time the uplink was received
+	Time string `protobuf:"bytes,8,opt,name=time" json:"time,omitempty"`
+}
+
+func (m *LocationNotification) Reset()                    { *m = LocationNotification{} }
+func (m *LocationNotification) String() string            { return proto.CompactTextString(m) }
+func (*LocationNotification) ProtoMessage()               {}
+func (*LocationNotification) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
+
+func (m *LocationNotification) GetDevEUI() string {
+	if m != nil {
+		return m.DevEUI
+	}
+	return ""
+}
+
+func (m *LocationNotification) GetLatitude() float64 {
+	if m != nil {
+		return m.Latitude
+	}
+	return 0
+}
+
+func (m *LocationNotification) GetLongitude() float64 {
+	if m != nil {
+		return m.Longitude
+	}
+	return 0
+}
+
+func (m *LocationNotification) GetAltitude() float64 {
+	if m != nil {
+		return m.Altitude
+	}
+	return 0
+}
+
+func (m *LocationNotification) GetAccuracy() float64 {
+	if m != nil {
+		return m.Accuracy
+	}
+	return 0
+}
+
+func (m *LocationNotification) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *LocationNotification) GetNbGateways() uint32 {
+	if m != nil {
+		return m.NbGateways
+	}
+	return 0
+}
+
+func (m *LocationNotification) GetTime() string {
+	if m != nil {
+		return m.Time
+	}
+	return ""
+}
+
+type ScheduledDownlinkNotification struct {
+	// DevEUI of the node
+	DevEUI string `protobuf:"bytes,1,opt,name=devEUI" json:"devEUI,omitempty"`
+	// id of the downlink rule
+	RuleID int64 `protobuf:"varint,2,opt,name=ruleID" json:"ruleID,omitempty"`
+	// name of the downlink rule
+	Name string `protobuf:"bytes,3,opt,name=name" json:"name,omitempty"`
+	// FPort
+	FPort uint32 `protobuf:"varint,4,opt,name=fPort" json:"fPort,omitempty"`
+	// frame-counter used for the transmission
+	FCnt uint32 `protobuf:"varint,5,opt,name=fCnt" json:"fCnt,omitempty"`
+	// the payload was sent as confirmed data down
+	Confirmed bool `protobuf:"varint,6,opt,name=confirmed" json:"confirmed,omitempty"`
+	// time the payload was transmitted
+	Time string `protobuf:"bytes,7,opt,name=time" json:"time,omitempty"`
+}
+
+func (m *ScheduledDownlinkNotification) Reset()                    { *m = ScheduledDownlinkNotification{} }
+func (m *ScheduledDownlinkNotification) String() string            { return proto.CompactTextString(m) }
+func (*ScheduledDownlinkNotification) ProtoMessage()               {}
+func (*ScheduledDownlinkNotification) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
+
+func (m *ScheduledDownlinkNotification) GetDevEUI() string {
+	if m != nil {
+		return m.DevEUI
+	}
+	return ""
+}
+
+func (m *ScheduledDownlinkNotification) GetRuleID() int64 {
+	if m != nil {
+		return m.RuleID
+	}
+	return 0
+}
+
+func (m *ScheduledDownlinkNotification) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ScheduledDownlinkNotification) GetFPort() uint32 {
+	if m != nil {
+		return m.FPort
+	}
+	return 0
+}
+
+func (m *ScheduledDownlinkNotification) GetFCnt() uint32 {
+	if m != nil {
+		return m.FCnt
+	}
+	return 0
+}
+
+func (m *ScheduledDownlinkNotification) GetConfirmed() bool {
+	if m != nil {
+		return m.Confirmed
+	}
+	return false
+}
+
+func (m *ScheduledDownlinkNotification) GetTime() string {
+	if m != nil {
+		return m.Time
+	}
+	return ""
+}
+
+type GatewayStats struct {
+	// MAC of the gateway
+	Mac string `protobuf:"bytes,1,opt,name=mac" json:"mac,omitempty"`
+	// start of the interval
+	Time string `protobuf:"bytes,2,opt,name=time" json:"time,omitempty"`
+	// number of received packets
+	RxPacketsReceived uint32 `protobuf:"varint,3,opt,name=rxPacketsReceived" json:"rxPacketsReceived,omitempty"`
+	// average RSSI of the received packets
+	AvgRSSI float64 `protobuf:"fixed64,4,opt,name=avgRSSI" json:"avgRSSI,omitempty"`
+	// average SNR of the received packets
+	AvgLoRaSNR float64 `protobuf:"fixed64,5,opt,name=avgLoRaSNR" json:"avgLoRaSNR,omitempty"`
+	// number of emitted packets (estimated)
+	TxPacketsEmitted uint32 `protobuf:"varint,6,opt,name=txPacketsEmitted" json:"txPacketsEmitted,omitempty"`
+	// downlink airtime in ms (estimated)
+	TxAirtime uint32 `protobuf:"varint,7,opt,name=txAirtime" json:"txAirtime,omitempty"`
+}
+
+func (m *GatewayStats) Reset()                    { *m = GatewayStats{} }
+func (m *GatewayStats) String() string            { return proto.CompactTextString(m) }
+func (*GatewayStats) ProtoMessage()               {}
+func (*GatewayStats) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
+
+func (m *GatewayStats) GetMac() string {
+	if m != nil {
+		return m.Mac
+	}
+	return ""
+}
+
+func (m *GatewayStats) GetTime() string {
+	if m != nil {
+		return m.Time
+	}
+	return ""
+}
+
+func (m *GatewayStats) GetRxPacketsReceived() uint32 {
+	if m != nil {
+		return m.RxPacketsReceived
+	}
+	return 0
+}
+
+func (m *GatewayStats) GetAvgRSSI() float64 {
+	if m != nil {
+		return m.AvgRSSI
+	}
+	return 0
+}
+
+func (m *GatewayStats) GetAvgLoRaSNR() float64 {
+	if m != nil {
+		return m.AvgLoRaSNR
+	}
+	return 0
+}
+
+func (m *GatewayStats) GetTxPacketsEmitted() uint32 {
+	if m != nil {
+		return m.TxPacketsEmitted
+	}
+	return 0
+}
+
+func (m *GatewayStats) GetTxAirtime() uint32 {
+	if m != nil {
+		return m.TxAirtime
+	}
+	return 0
+}
+
+type UsageStats struct {
+	// AppEUI of the application
+	AppEUI string `protobuf:"bytes,1,opt,name=appEUI" json:"appEUI,omitempty"`
+	// start of the interval
+	Time string `protobuf:"bytes,2,opt,name=time" json:"time,omitempty"`
+	// number of uplinks
+	RxPackets uint32 `protobuf:"varint,3,opt,name=rxPackets" json:"rxPackets,omitempty"`
+	// average RSSI of the uplinks (best gateway)
+	AvgRSSI float64 `protobuf:"fixed64,4,opt,name=avgRSSI" json:"avgRSSI,omitempty"`
+	// average SNR of the uplinks (best gateway)
+	AvgLoRaSNR float64 `protobuf:"fixed64,5,opt,name=avgLoRaSNR" json:"avgLoRaSNR,omitempty"`
+	// number of downlinks
+	TxPackets uint32 `protobuf:"varint,6,opt,name=txPackets" json:"txPackets,omitempty"`
+	// number of errors
+	Errors uint32 `protobuf:"varint,7,opt,name=errors" json:"errors,omitempty"`
+	// number of nodes which sent at least one uplink
+	ActiveNodes uint32 `protobuf:"varint,8,opt,name=activeNodes" json:"activeNodes,omitempty"`
+}
+
+func (m *UsageStats) Reset()                    { *m = UsageStats{} }
+func (m *UsageStats) String() string            { return proto.CompactTextString(m) }
+func (*UsageStats) ProtoMessage()               {}
+func (*UsageStats) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
+
+func (m *UsageStats) GetAppEUI() string {
+	if m != nil {
+		return m.AppEUI
+	}
+	return ""
+}
+
+func (m *UsageStats) GetTime() string {
+	if m != nil {
+		return m.Time
+	}
+	return ""
+}
+
+func (m *UsageStats) GetRxPackets() uint32 {
+	if m != nil {
+		return m.RxPackets
+	}
+	return 0
+}
+
+func (m *UsageStats) GetAvgRSSI() float64 {
+	if m != nil {
+		return m.AvgRSSI
+	}
+	return 0
+}
+
+func (m *UsageStats) GetAvgLoRaSNR() float64 {
+	if m != nil {
+		return m.AvgLoRaSNR
+	}
+	return 0
+}
+
+func (m *UsageStats) GetTxPackets() uint32 {
+	if m != nil {
+		return m.TxPackets
+	}
+	return 0
+}
+
+func (m *UsageStats) GetErrors() uint32 {
+	if m != nil {
+		return m.Errors
+	}
+	return 0
+}
+
+func (m *UsageStats) GetActiveNodes() uint32 {
+	if m != nil {
+		return m.ActiveNodes
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*DataRate)(nil), "integration.DataRate")
+	proto.RegisterType((*RXInfo)(nil), "integration.RXInfo")
+	proto.RegisterType((*TXInfo)(nil), "integration.TXInfo")
+	proto.RegisterType((*DataUpPayload)(nil), "integration.DataUpPayload")
+	proto.RegisterType((*DataDownPayload)(nil), "integration.DataDownPayload")
+	proto.RegisterType((*JoinNotification)(nil), "integration.JoinNotification")
+	proto.RegisterType((*ACKNotification)(nil), "integration.ACKNotification")
+	proto.RegisterType((*ErrorNotification)(nil), "integration.ErrorNotification")
+	proto.RegisterType((*LinkQualityNotification)(nil), "integration.LinkQualityNotification")
+	proto.RegisterType((*LifecycleNotification)(nil), "integration.LifecycleNotification")
+	proto.RegisterType((*FirmwareNotification)(nil), "integration.FirmwareNotification")
+	proto.RegisterType((*DeviceStatus)(nil), "integration.DeviceStatus")
+	proto.RegisterType((*LocationNotification)(nil), "integration.LocationNotification")
+	proto.RegisterType((*ScheduledDownlinkNotification)(nil), "integration.ScheduledDownlinkNotification")
+	proto.RegisterType((*GatewayStats)(nil), "integration.GatewayStats")
+	proto.RegisterType((*UsageStats)(nil), "integration.UsageStats")
+}
+
+func init() { proto.RegisterFile("integration.proto", fileDescriptor0) }
+
+var fileDescriptor0 = []byte{
+	// 1266 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0xdd, 0x6e, 0x23, 0x35,
+	0x14, 0xd6, 0x24, 0xcd, 0x34, 0x71, 0x13, 0x76, 0x77, 0xe8, 0x2e, 0xa3, 0xd5, 0x82, 0xaa, 0x11,
+	0x42, 0x15, 0xa0, 0x95, 0x58, 0x9e, 0xa0, 0x6c, 0x76, 0x57, 0x5d, 0x42, 0x59, 0x9c, 0xad, 0xc4,
+	0x05, 0x37, 0xce, 0xcc, 0x49, 0x6a, 0x3a, 0xb1, 0x83, 0xed, 0xa4, 0x9d, 0x17, 0x00, 0xde, 0x80,
+	0xa7, 0x41, 0x5c, 0xf2, 0x04, 0xbc, 0x02, 0xe2, 0x82, 0x4b, 0x1e, 0x00, 0x1d, 0xdb, 0xf3, 0x97,
+	0x36, 0xa8, 0x12, 0x77, 0x3e, 0x9f, 0xcf, 0xd8, 0xfe, 0xbe, 0xf3, 0x63, 0x0f, 0x79, 0xc0, 0x85,
+	0x81, 0x85, 0x62, 0x86, 0x4b, 0xf1, 0x74, 0xa5, 0xa4, 0x91, 0xd1, 0x41, 0x03, 0x4a, 0x7e, 0x0c,
+	0x48, 0x7f, 0xcc, 0x0c, 0xa3, 0xcc, 0x40, 0xf4, 0x01, 0x21, 0x4b, 0x99, 0xad, 0x73, 0x3b, 0x15,
+	0x07, 0x47, 0xc1, 0xf1, 0x80, 0x36, 0x90, 0xe8, 0x09, 0x19, 0xcc, 0x98, 0xc8, 0xae, 0x78, 0x66,
+	0x2e, 0xe2, 0xce, 0x51, 0x70, 0x3c, 0xa2, 0x35, 0x10, 0x25, 0x64, 0xa8, 0x57, 0x0a, 0x58, 0xf6,
+	0x92, 0xa5, 0x46, 0xaa, 0xb8, 0x6b, 0x1d, 0x5a, 0x58, 0x14, 0x93, 0xfd, 0x19, 0x37, 0x8a, 0x19,
+	0x88, 0xf7, 0xec, 0x74, 0x69, 0x26, 0xdf, 0x91, 0x90, 0x7e, 0x7b, 0x2a, 0xe6, 0x32, 0xba, 0x4f,
+	0xba, 0x4b, 0x96, 0xfa, 0xed, 0x71, 0x18, 0x45, 0x64, 0xcf, 0xf0, 0x25, 0xd8, 0x2d, 0x07, 0xd4,
+	0x8e, 0x11, 0x53, 0x5a, 0x73, 0xbb, 0x4b, 0x8f, 0xda, 0x31, 0xae, 0x9e, 0x4b, 0xca, 0xa6, 0x67,
+	0xd4, 0xae, 0x1e, 0xd0, 0xd2, 0x4c, 0x7e, 0x0a, 0x48, 0xf8, 0xd6, 0x2d, 0xff, 0x84, 0x0c, 0xe6,
+	0x0a, 0x7e, 0x58, 0x83, 0x48, 0x0b, 0xbb, 0xc9, 0x88, 0xd6, 0x40, 0xf4, 0x19, 0xe9, 0x67, 0x5e,
+	0x0e, 0xbb, 0xdd, 0xc1, 0xb3, 0x87, 0x4f, 0x9b, 0x12, 0x96, 0x5a, 0xd1, 0xca, 0x0d, 0xcf, 0xcb,
+	0x32, 0x47, 0xb7, 0x4f, 0x71, 0x18, 0x3d, 0x26, 0xfd, 0x54, 0x66, 0x40, 0x4b, 0x9a, 0x03, 0x5a,
+	0xd9, 0xc9, 0x2f, 0x1d, 0x32, 0xc2, 0x45, 0xce, 0x57, 0x6f, 0x58, 0x91, 0x4b, 0x96, 0x45, 0x8f,
+	0x48, 0x98, 0xc1, 0xe6, 0xc5, 0xf9, 0xa9, 0xa7, 0xec, 0xad, 0xe8, 0x13, 0x12, 0xaa, 0x6b, 0x3c,
+	0x72, 0xdc, 0x39, 0xea, 0x1e, 0x1f, 0x3c, 0x7b, 0xb7, 0x75, 0x10, 0x27, 0x16, 0xf5, 0x2e, 0xe8,
+	0x6c, 0x9c, 0x73, 0xf7, 0x28, 0xb8, 0xe1, 0xfc, 0xd6, 0x3b, 0x3b, 0x17, 0xd4, 0x6e, 0xfe, 0x5c,
+	0x18, 0x1f, 0x02, 0x3b, 0x8e, 0x0e, 0x49, 0x6f, 0xfe, 0x46, 0x2a, 0x13, 0xf7, 0x2c, 0xe8, 0x0c,
+	0xf4, 0x44, 0x9e, 0x71, 0x78, 0x14, 0x1c, 0x0f, 0xa9, 0x1d, 0x63, 0x96, 0xc8, 0xd9, 0xf7, 0x90,
+	0x9a, 0xd7, 0xd3, 0xaf, 0xcf, 0xe2, 0x7d, 0x97, 0x25, 0x35, 0x82, 0x02, 0x83, 0x48, 0x55, 0xb1,
+	0x32, 0x90, 0xc5, 0x7d, 0xab, 0x4a, 0x0d, 0x60, 0x8c, 0x32, 0xd8, 0x9c, 0x64, 0x99, 0x8a, 0x07,
+	0xf6, 0xd3, 0xd2, 0x4c, 0xfe, 0xe8, 0x90, 0x7b, 0xa8, 0xcc, 0x58, 0x5e, 0x89, 0x52, 0x9b, 0x27,
+	0x64, 0xa0, 0x60, 0x0e, 0x0a, 0x44, 0x0a, 0x5e, 0x9e, 0x1a, 0xc0, 0xd9, 0x54, 0x8a, 0x39, 0x57,
+	0x4b, 0xc8, 0x6c, 0xb4, 0xfa, 0xb4, 0x06, 0x1a, 0xba, 0x76, 0x5b, 0xba, 0x56, 0x4c, 0xf7, 0x6e,
+	0x63, 0xda, 0xdb, 0xc9, 0x34, 0xbc, 0xc1, 0xf4, 0x90, 0xf4, 0x84, 0xc4, 0x93, 0x39, 0x11, 0x9c,
+	0x61, 0xf9, 0x5f, 0xaf, 0xb8, 0x02, 0x7d, 0x62, 0x2c, 0xff, 0x01, 0xad, 0x01, 0x5c, 0x33, 0x83,
+	0x9c, 0x15, 0xe7, 0xc2, 0xf0, 0xdc, 0x4b, 0xd0, 0x40, 0x6c, 0x0d, 0xb2, 0x6b, 0x0a, 0x46, 0x71,
+	0xd0, 0x31, 0xb1, 0x47, 0x6c, 0x20, 0x6d, 0x75, 0x0f, 0xb6, 0xd5, 0x2d, 0x23, 0x3b, 0xac, 0x23,
+	0x9b, 0x8c, 0xc9, 0xfd, 0xd7, 0x92, 0x8b, 0x33, 0x69, 0xf8, 0x9c, 0xa7, 0xae, 0x92, 0x1b, 0x51,
+	0x08, 0x5a, 0x51, 0x68, 0xa8, 0xd6, 0x69, 0xaa, 0x96, 0xbc, 0x22, 0xf7, 0x4e, 0x9e, 0x7f, 0xd9,
+	0x5a, 0xe4, 0xbf, 0x83, 0xb3, 0x6b, 0x21, 0x4d, 0x1e, 0xbc, 0x50, 0x4a, 0xaa, 0xd6, 0x52, 0xbb,
+	0x6a, 0xa0, 0xb5, 0x45, 0x67, 0x7b, 0x0b, 0xec, 0x0b, 0xc5, 0x0a, 0x7c, 0x7c, 0xed, 0x18, 0x63,
+	0x02, 0xb8, 0xbc, 0x2f, 0x3c, 0x67, 0x24, 0xbf, 0x76, 0xc8, 0x7b, 0x13, 0x2e, 0x2e, 0xbf, 0x59,
+	0xb3, 0x9c, 0x9b, 0xe2, 0x4e, 0x7b, 0x1f, 0x92, 0x9e, 0x4e, 0xa5, 0x72, 0xfb, 0xf6, 0xa8, 0x33,
+	0xa2, 0x0f, 0xc9, 0x68, 0xa5, 0x60, 0xc3, 0xe5, 0x5a, 0x4f, 0xed, 0xac, 0x6b, 0x40, 0x6d, 0x10,
+	0xbf, 0x5d, 0x28, 0x96, 0x95, 0xe5, 0xef, 0x8c, 0xe6, 0xb7, 0xaf, 0xec, 0x6c, 0xcf, 0xce, 0xb6,
+	0xc1, 0xaa, 0xb3, 0x85, 0xb6, 0x85, 0xdd, 0xe8, 0x6c, 0xfb, 0xad, 0xce, 0x86, 0xbd, 0x46, 0x0b,
+	0xf5, 0x56, 0x81, 0x70, 0xc5, 0x16, 0xd0, 0xca, 0xc6, 0x5c, 0x5a, 0xb1, 0xf4, 0x12, 0xcc, 0x44,
+	0x6a, 0x6d, 0x73, 0x2d, 0xa0, 0x0d, 0x24, 0x3a, 0x26, 0xf7, 0x14, 0x18, 0xc5, 0x84, 0x5e, 0x72,
+	0xad, 0xb9, 0x14, 0x2e, 0xe1, 0x02, 0xba, 0x0d, 0x27, 0xbf, 0x07, 0xe4, 0xe1, 0x84, 0xcf, 0x21,
+	0x2d, 0xd2, 0x1c, 0xb6, 0xd5, 0x03, 0x61, 0xb8, 0x29, 0x4a, 0xf5, 0x9c, 0x85, 0x38, 0x4b, 0xd1,
+	0xa3, 0x0c, 0xbf, 0xb3, 0x76, 0x56, 0x25, 0xfa, 0xaf, 0x56, 0x88, 0xef, 0x79, 0x7f, 0x6b, 0x45,
+	0x1f, 0x91, 0x77, 0x4a, 0x79, 0x4e, 0xdc, 0xbc, 0x13, 0x6d, 0x0b, 0x45, 0xd5, 0x04, 0x5b, 0x82,
+	0xaf, 0x52, 0x3b, 0xae, 0xee, 0x8d, 0xfd, 0xfa, 0xde, 0x48, 0xfe, 0x09, 0xc8, 0xe1, 0x4b, 0xae,
+	0x96, 0x57, 0x4c, 0xb5, 0x89, 0x24, 0x64, 0x98, 0xc1, 0x2a, 0x97, 0xc5, 0x12, 0x84, 0x39, 0x1d,
+	0x5b, 0x3a, 0x5d, 0xda, 0xc2, 0x76, 0xe5, 0xb4, 0x4d, 0x15, 0x83, 0xdd, 0xde, 0x71, 0x72, 0x06,
+	0x7a, 0x8b, 0xd9, 0x4b, 0xc5, 0x16, 0xbe, 0xd3, 0x78, 0x0b, 0x29, 0xb9, 0x11, 0x85, 0x14, 0xf8,
+	0x06, 0x32, 0xdf, 0x73, 0xb7, 0xd0, 0xe8, 0x88, 0x1c, 0xd8, 0x00, 0x88, 0x85, 0x5d, 0x24, 0xb4,
+	0x4e, 0x4d, 0xa8, 0x4e, 0xf6, 0xfd, 0x46, 0xb2, 0x57, 0xb4, 0xfb, 0x0d, 0xda, 0x3f, 0x77, 0xc9,
+	0x70, 0x0c, 0x1b, 0x9e, 0xc2, 0xd4, 0x30, 0xb3, 0xd6, 0x3b, 0xb3, 0xfe, 0x31, 0xe9, 0xe7, 0x4c,
+	0x9b, 0x29, 0x40, 0x19, 0xb9, 0xca, 0xae, 0xba, 0x4b, 0xb7, 0x71, 0x6f, 0x94, 0xd9, 0xba, 0x77,
+	0xfb, 0x3d, 0xdc, 0x6b, 0x67, 0xeb, 0x23, 0x12, 0x2e, 0x99, 0x5a, 0x70, 0xe1, 0xb3, 0xdb, 0x5b,
+	0x58, 0xe7, 0x17, 0x4c, 0x7f, 0xe5, 0xa6, 0xf6, 0x5d, 0x57, 0xab, 0x00, 0xfb, 0x6a, 0x60, 0xc6,
+	0x80, 0x2a, 0xe2, 0xbe, 0x7f, 0x35, 0x38, 0x13, 0x33, 0xfc, 0x82, 0xe9, 0x2f, 0xfc, 0xe4, 0xc0,
+	0x7e, 0xd8, 0x40, 0x30, 0xa8, 0xde, 0x75, 0x02, 0x1b, 0xc8, 0x7d, 0x7a, 0xb7, 0x30, 0xac, 0x82,
+	0xfa, 0x0b, 0xe7, 0xe6, 0xfa, 0xea, 0x36, 0x8c, 0xbb, 0xe5, 0x5c, 0x5c, 0xfa, 0x63, 0x0e, 0x2d,
+	0xe3, 0x06, 0x82, 0xf5, 0x7d, 0xc1, 0xf4, 0xa4, 0x76, 0x19, 0xd9, 0x75, 0xda, 0x60, 0xf2, 0x77,
+	0x40, 0x0e, 0x27, 0xd2, 0x65, 0xdd, 0x9d, 0x1a, 0x91, 0x0d, 0x89, 0xe1, 0x66, 0x9d, 0xb9, 0x5e,
+	0x14, 0xd0, 0xca, 0x46, 0xe1, 0x72, 0x29, 0x16, 0x6e, 0xb2, 0x6b, 0x27, 0x6b, 0x00, 0xbf, 0x64,
+	0xb9, 0xff, 0xd2, 0xbd, 0x88, 0x2a, 0xdb, 0xce, 0xa5, 0xe9, 0x5a, 0xb1, 0xb4, 0xf0, 0x51, 0xaa,
+	0x6c, 0x3c, 0x89, 0x96, 0x6b, 0x95, 0x96, 0xe5, 0xe4, 0x2d, 0x14, 0x40, 0xcc, 0x5e, 0x31, 0x03,
+	0x57, 0xac, 0xd0, 0x36, 0x4e, 0x23, 0xda, 0x40, 0x6e, 0xcd, 0xbc, 0xdf, 0x02, 0xf2, 0xfe, 0x34,
+	0xbd, 0x80, 0x6c, 0x9d, 0x43, 0x86, 0x77, 0x3b, 0x0a, 0x76, 0x27, 0xde, 0x8f, 0x48, 0xa8, 0xd6,
+	0x39, 0x9c, 0x8e, 0x2d, 0xeb, 0x2e, 0xf5, 0x56, 0x55, 0xea, 0xdd, 0x46, 0xa9, 0xef, 0xbc, 0xd4,
+	0x6d, 0xc2, 0xf6, 0x1a, 0x09, 0xdb, 0x7a, 0x34, 0x84, 0xdb, 0x8f, 0x86, 0xdb, 0x5a, 0xc6, 0x9f,
+	0x01, 0x19, 0x7a, 0x8a, 0x58, 0x3c, 0xfa, 0x8e, 0x2f, 0xd4, 0x4f, 0xc9, 0x03, 0x75, 0xfd, 0xc6,
+	0x76, 0x5b, 0x5d, 0x55, 0xba, 0x2b, 0x9d, 0x9b, 0x13, 0x98, 0xe3, 0x6c, 0xb3, 0xa0, 0xd3, 0xe9,
+	0x69, 0xf9, 0x76, 0xf5, 0x26, 0x8a, 0xce, 0x36, 0x8b, 0x49, 0xab, 0xa0, 0x1a, 0x48, 0xf4, 0x31,
+	0xb9, 0x6f, 0xca, 0xe5, 0x5e, 0x2c, 0xb9, 0x31, 0x9e, 0xd7, 0x88, 0xde, 0xc0, 0x91, 0xbc, 0xb9,
+	0x3e, 0xe1, 0xaa, 0xe2, 0x38, 0xa2, 0x35, 0x90, 0xfc, 0x15, 0x10, 0x72, 0xae, 0xd9, 0x02, 0x1c,
+	0xcd, 0xba, 0x25, 0x07, 0xad, 0x96, 0x7c, 0x1b, 0x59, 0xbc, 0xa8, 0xcb, 0xcd, 0x3c, 0xc9, 0x1a,
+	0xf8, 0x1f, 0xe4, 0xec, 0x81, 0xcb, 0x75, 0xc3, 0xf2, 0xc0, 0xe5, 0xba, 0x78, 0xf9, 0x60, 0xcb,
+	0x2b, 0x73, 0xd1, 0x5b, 0xd8, 0x39, 0xf1, 0xba, 0xd9, 0xc0, 0x99, 0xcc, 0x40, 0xfb, 0xa6, 0xd1,
+	0x84, 0x66, 0xa1, 0xfd, 0x17, 0xfa, 0xfc, 0xdf, 0x01, 0x00, 0xe4, 0x71, 0x39, 0x18, 0x20, 0x0d,
+	0x00, 0x00,
+}
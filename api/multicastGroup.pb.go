@@ -0,0 +1,947 @@
+// Code generated by protoc-gen-go.
+// source: multicastGroup.proto
+// DO NOT EDIT!
+
+package api
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import _ "github.com/grpc-ecosystem/grpc-gateway/third_party/googleapis/google/api"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+type CreateMulticastGroupRequest struct {
+	// name of the multicast group
+	Name string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	// hex encoded AppEUI of the application
+	AppEUI string `protobuf:"bytes,2,opt,name=appEUI" json:"appEUI,omitempty"`
+	// hex encoded multicast address
+	McAddr string `protobuf:"bytes,3,opt,name=mcAddr" json:"mcAddr,omitempty"`
+	// hex encoded multicast network session key
+	McNwkSKey string `protobuf:"bytes,4,opt,name=mcNwkSKey" json:"mcNwkSKey,omitempty"`
+	// hex encoded multicast application session key
+	McAppSKey string `protobuf:"bytes,5,opt,name=mcAppSKey" json:"mcAppSKey,omitempty"`
+	// data-rate of the multicast transmissions
+	Dr uint32 `protobuf:"varint,6,opt,name=dr" json:"dr,omitempty"`
+	// frequency (Hz) of the multicast transmissions
+	Frequency uint32 `protobuf:"varint,7,opt,name=frequency" json:"frequency,omitempty"`
+}
+
+func (m *CreateMulticastGroupRequest) Reset()                    { *m = CreateMulticastGroupRequest{} }
+func (m *CreateMulticastGroupRequest) String() string            { return proto.CompactTextString(m) }
+func (*CreateMulticastGroupRequest) ProtoMessage()               {}
+func (*CreateMulticastGroupRequest) Descriptor() ([]byte, []int) { return fileDescriptor16, []int{0} }
+
+func (m *CreateMulticastGroupRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CreateMulticastGroupRequest) GetAppEUI() string {
+	if m != nil {
+		return m.AppEUI
+	}
+	return ""
+}
+
+func (m *CreateMulticastGroupRequest) GetMcAddr() string {
+	if m != nil {
+		return m.McAddr
+	}
+	return ""
+}
+
+func (m *CreateMulticastGroupRequest) GetMcNwkSKey() string {
+	if m != nil {
+		return m.McNwkSKey
+	}
+	return ""
+}
+
+func (m *CreateMulticastGroupRequest) GetMcAppSKey() string {
+	if m != nil {
+		return m.McAppSKey
+	}
+	return ""
+}
+
+func (m *CreateMulticastGroupRequest) GetDr() uint32 {
+	if m != nil {
+		return m.Dr
+	}
+	return 0
+}
+
+func (m *CreateMulticastGroupRequest) GetFrequency() uint32 {
+	if m != nil {
+		return m.Frequency
+	}
+	return 0
+}
+
+type CreateMulticastGroupResponse struct {
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *CreateMulticastGroupResponse) Reset()                    { *m = CreateMulticastGroupResponse{} }
+func (m *CreateMulticastGroupResponse) String() string            { return proto.CompactTextString(m) }
+func (*CreateMulticastGroupResponse) ProtoMessage()               {}
+func (*CreateMulticastGroupResponse) Descriptor() ([]byte, []int) { return fileDescriptor16, []int{1} }
+
+func (m *CreateMulticastGroupResponse) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type GetMulticastGroupRequest struct {
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *GetMulticastGroupRequest) Reset()                    { *m = GetMulticastGroupRequest{} }
+func (m *GetMulticastGroupRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetMulticastGroupRequest) ProtoMessage()               {}
+func (*GetMulticastGroupRequest) Descriptor() ([]byte, []int) { return fileDescriptor16, []int{2} }
+
+func (m *GetMulticastGroupRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type GetMulticastGroupResponse struct {
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	// name of the multicast group
+	Name string `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	// hex encoded AppEUI of the application
+	AppEUI string `protobuf:"bytes,3,opt,name=appEUI" json:"appEUI,omitempty"`
+	// hex encoded multicast address
+	McAddr string `protobuf:"bytes,4,opt,name=mcAddr" json:"mcAddr,omitempty"`
+	// hex encoded multicast network session key
+	McNwkSKey string `protobuf:"bytes,5,opt,name=mcNwkSKey" json:"mcNwkSKey,omitempty"`
+	// hex encoded multicast application session key
+	McAppSKey string `protobuf:"bytes,6,opt,name=mcAppSKey" json:"mcAppSKey,omitempty"`
+	// data-rate of the multicast transmissions
+	Dr uint32 `protobuf:"varint,7,opt,name=dr" json:"dr,omitempty"`
+	// frequency (Hz) of the multicast transmissions
+	Frequency uint32 `protobuf:"varint,8,opt,name=frequency" json:"frequency,omitempty"`
+}
+
+func (m *GetMulticastGroupResponse) Reset()                    { *m = GetMulticastGroupResponse{} }
+func (m *GetMulticastGroupResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetMulticastGroupResponse) ProtoMessage()               {}
+func (*GetMulticastGroupResponse) Descriptor() ([]byte, []int) { return fileDescriptor16, []int{3} }
+
+func (m *GetMulticastGroupResponse) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *GetMulticastGroupResponse) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *GetMulticastGroupResponse) GetAppEUI() string {
+	if m != nil {
+		return m.AppEUI
+	}
+	return ""
+}
+
+func (m *GetMulticastGroupResponse) GetMcAddr() string {
+	if m != nil {
+		return m.McAddr
+	}
+	return ""
+}
+
+func (m *GetMulticastGroupResponse) GetMcNwkSKey() string {
+	if m != nil {
+		return m.McNwkSKey
+	}
+	return ""
+}
+
+func (m *GetMulticastGroupResponse) GetMcAppSKey() string {
+	if m != nil {
+		return m.McAppSKey
+	}
+	return ""
+}
+
+func (m *GetMulticastGroupResponse) GetDr() uint32 {
+	if m != nil {
+		return m.Dr
+	}
+	return 0
+}
+
+func (m *GetMulticastGroupResponse) GetFrequency() uint32 {
+	if m != nil {
+		return m.Frequency
+	}
+	return 0
+}
+
+type ListMulticastGroupByAppEUIRequest struct {
+	// hex encoded AppEUI of the application
+	AppEUI string `protobuf:"bytes,1,opt,name=appEUI" json:"appEUI,omitempty"`
+}
+
+func (m *ListMulticastGroupByAppEUIRequest) Reset()         { *m = ListMulticastGroupByAppEUIRequest{} }
+func (m *ListMulticastGroupByAppEUIRequest) String() string { return proto.CompactTextString(m) }
+func (*ListMulticastGroupByAppEUIRequest) ProtoMessage()    {}
+func (*ListMulticastGroupByAppEUIRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor16, []int{4}
+}
+
+func (m *ListMulticastGroupByAppEUIRequest) GetAppEUI() string {
+	if m != nil {
+		return m.AppEUI
+	}
+	return ""
+}
+
+type ListMulticastGroupResponse struct {
+	Result []*GetMulticastGroupResponse `protobuf:"bytes,1,rep,name=result" json:"result,omitempty"`
+}
+
+func (m *ListMulticastGroupResponse) Reset()                    { *m = ListMulticastGroupResponse{} }
+func (m *ListMulticastGroupResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListMulticastGroupResponse) ProtoMessage()               {}
+func (*ListMulticastGroupResponse) Descriptor() ([]byte, []int) { return fileDescriptor16, []int{5} }
+
+func (m *ListMulticastGroupResponse) GetResult() []*GetMulticastGroupResponse {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type UpdateMulticastGroupRequest struct {
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	// name of the multicast group
+	Name string `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	// hex encoded multicast address
+	McAddr string `protobuf:"bytes,3,opt,name=mcAddr" json:"mcAddr,omitempty"`
+	// hex encoded multicast network session key
+	McNwkSKey string `protobuf:"bytes,4,opt,name=mcNwkSKey" json:"mcNwkSKey,omitempty"`
+	// hex encoded multicast application session key
+	McAppSKey string `protobuf:"bytes,5,opt,name=mcAppSKey" json:"mcAppSKey,omitempty"`
+	// data-rate of the multicast transmissions
+	Dr uint32 `protobuf:"varint,6,opt,name=dr" json:"dr,omitempty"`
+	// frequency (Hz) of the multicast transmissions
+	Frequency uint32 `protobuf:"varint,7,opt,name=frequency" json:"frequency,omitempty"`
+}
+
+func (m *UpdateMulticastGroupRequest) Reset()                    { *m = UpdateMulticastGroupRequest{} }
+func (m *UpdateMulticastGroupRequest) String() string            { return proto.CompactTextString(m) }
+func (*UpdateMulticastGroupRequest) ProtoMessage()               {}
+func (*UpdateMulticastGroupRequest) Descriptor() ([]byte, []int) { return fileDescriptor16, []int{6} }
+
+func (m *UpdateMulticastGroupRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *UpdateMulticastGroupRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *UpdateMulticastGroupRequest) GetMcAddr() string {
+	if m != nil {
+		return m.McAddr
+	}
+	return ""
+}
+
+func (m *UpdateMulticastGroupRequest) GetMcNwkSKey() string {
+	if m != nil {
+		return m.McNwkSKey
+	}
+	return ""
+}
+
+func (m *UpdateMulticastGroupRequest) GetMcAppSKey() string {
+	if m != nil {
+		return m.McAppSKey
+	}
+	return ""
+}
+
+func (m *UpdateMulticastGroupRequest) GetDr() uint32 {
+	if m != nil {
+		return m.Dr
+	}
+	return 0
+}
+
+func (m *UpdateMulticastGroupRequest) GetFrequency() uint32 {
+	if m != nil {
+		return m.Frequency
+	}
+	return 0
+}
+
+type UpdateMulticastGroupResponse struct {
+}
+
+func (m *UpdateMulticastGroupResponse) Reset()                    { *m = UpdateMulticastGroupResponse{} }
+func (m *UpdateMulticastGroupResponse) String() string            { return proto.CompactTextString(m) }
+func (*UpdateMulticastGroupResponse) ProtoMessage()               {}
+func (*UpdateMulticastGroupResponse) Descriptor() ([]byte, []int) { return fileDescriptor16, []int{7} }
+
+type DeleteMulticastGroupRequest struct {
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *DeleteMulticastGroupRequest) Reset()                    { *m = DeleteMulticastGroupRequest{} }
+func (m *DeleteMulticastGroupRequest) String() string            { return proto.CompactTextString(m) }
+func (*DeleteMulticastGroupRequest) ProtoMessage()               {}
+func (*DeleteMulticastGroupRequest) Descriptor() ([]byte, []int) { return fileDescriptor16, []int{8} }
+
+func (m *DeleteMulticastGroupRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type DeleteMulticastGroupResponse struct {
+}
+
+func (m *DeleteMulticastGroupResponse) Reset()                    { *m = DeleteMulticastGroupResponse{} }
+func (m *DeleteMulticastGroupResponse) String() string            { return proto.CompactTextString(m) }
+func (*DeleteMulticastGroupResponse) ProtoMessage()               {}
+func (*DeleteMulticastGroupResponse) Descriptor() ([]byte, []int) { return fileDescriptor16, []int{9} }
+
+type AddNodeToMulticastGroupRequest struct {
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	// hex encoded DevEUI of the node
+	DevEUI string `protobuf:"bytes,2,opt,name=devEUI" json:"devEUI,omitempty"`
+}
+
+func (m *AddNodeToMulticastGroupRequest) Reset()         { *m = AddNodeToMulticastGroupRequest{} }
+func (m *AddNodeToMulticastGroupRequest) String() string { return proto.CompactTextString(m) }
+func (*AddNodeToMulticastGroupRequest) ProtoMessage()    {}
+func (*AddNodeToMulticastGroupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor16, []int{10}
+}
+
+func (m *AddNodeToMulticastGroupRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *AddNodeToMulticastGroupRequest) GetDevEUI() string {
+	if m != nil {
+		return m.DevEUI
+	}
+	return ""
+}
+
+type AddNodeToMulticastGroupResponse struct {
+}
+
+func (m *AddNodeToMulticastGroupResponse) Reset()         { *m = AddNodeToMulticastGroupResponse{} }
+func (m *AddNodeToMulticastGroupResponse) String() string { return proto.CompactTextString(m) }
+func (*AddNodeToMulticastGroupResponse) ProtoMessage()    {}
+func (*AddNodeToMulticastGroupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor16, []int{11}
+}
+
+type RemoveNodeFromMulticastGroupRequest struct {
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	// hex encoded DevEUI of the node
+	DevEUI string `protobuf:"bytes,2,opt,name=devEUI" json:"devEUI,omitempty"`
+}
+
+func (m *RemoveNodeFromMulticastGroupRequest) Reset()         { *m = RemoveNodeFromMulticastGroupRequest{} }
+func (m *RemoveNodeFromMulticastGroupRequest) String() string { return proto.CompactTextString(m) }
+func (*RemoveNodeFromMulticastGroupRequest) ProtoMessage()    {}
+func (*RemoveNodeFromMulticastGroupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor16, []int{12}
+}
+
+func (m *RemoveNodeFromMulticastGroupRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *RemoveNodeFromMulticastGroupRequest) GetDevEUI() string {
+	if m != nil {
+		return m.DevEUI
+	}
+	return ""
+}
+
+type RemoveNodeFromMulticastGroupResponse struct {
+}
+
+func (m *RemoveNodeFromMulticastGroupResponse) Reset()         { *m = RemoveNodeFromMulticastGroupResponse{} }
+func (m *RemoveNodeFromMulticastGroupResponse) String() string { return proto.CompactTextString(m) }
+func (*RemoveNodeFromMulticastGroupResponse) ProtoMessage()    {}
+func (*RemoveNodeFromMulticastGroupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor16, []int{13}
+}
+
+type ListMulticastGroupNodesRequest struct {
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *ListMulticastGroupNodesRequest) Reset()         { *m = ListMulticastGroupNodesRequest{} }
+func (m *ListMulticastGroupNodesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListMulticastGroupNodesRequest) ProtoMessage()    {}
+func (*ListMulticastGroupNodesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor16, []int{14}
+}
+
+func (m *ListMulticastGroupNodesRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type ListMulticastGroupNodesResponse struct {
+	// hex encoded DevEUIs of the nodes
+	DevEUIs []string `protobuf:"bytes,1,rep,name=devEUIs" json:"devEUIs,omitempty"`
+}
+
+func (m *ListMulticastGroupNodesResponse) Reset()         { *m = ListMulticastGroupNodesResponse{} }
+func (m *ListMulticastGroupNodesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListMulticastGroupNodesResponse) ProtoMessage()    {}
+func (*ListMulticastGroupNodesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor16, []int{15}
+}
+
+func (m *ListMulticastGroupNodesResponse) GetDevEUIs() []string {
+	if m != nil {
+		return m.DevEUIs
+	}
+	return nil
+}
+
+type EnqueueMulticastQueueItemRequest struct {
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	// random reference (used on ack and error notifications)
+	Reference string `protobuf:"bytes,2,opt,name=reference" json:"reference,omitempty"`
+	// FPort to be used
+	FPort uint32 `protobuf:"varint,3,opt,name=fPort" json:"fPort,omitempty"`
+	// base64 encoded data
+	Data []byte `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *EnqueueMulticastQueueItemRequest) Reset()         { *m = EnqueueMulticastQueueItemRequest{} }
+func (m *EnqueueMulticastQueueItemRequest) String() string { return proto.CompactTextString(m) }
+func (*EnqueueMulticastQueueItemRequest) ProtoMessage()    {}
+func (*EnqueueMulticastQueueItemRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor16, []int{16}
+}
+
+func (m *EnqueueMulticastQueueItemRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *EnqueueMulticastQueueItemRequest) GetReference() string {
+	if m != nil {
+		return m.Reference
+	}
+	return ""
+}
+
+func (m *EnqueueMulticastQueueItemRequest) GetFPort() uint32 {
+	if m != nil {
+		return m.FPort
+	}
+	return 0
+}
+
+func (m *EnqueueMulticastQueueItemRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type EnqueueMulticastQueueItemResponse struct {
+	// hex encoded DevEUIs of the nodes for which the payload was enqueued
+	DevEUIs []string `protobuf:"bytes,1,rep,name=devEUIs" json:"devEUIs,omitempty"`
+}
+
+func (m *EnqueueMulticastQueueItemResponse) Reset()         { *m = EnqueueMulticastQueueItemResponse{} }
+func (m *EnqueueMulticastQueueItemResponse) String() string { return proto.CompactTextString(m) }
+func (*EnqueueMulticastQueueItemResponse) ProtoMessage()    {}
+func (*EnqueueMulticastQueueItemResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor16, []int{17}
+}
+
+func (m *EnqueueMulticastQueueItemResponse) GetDevEUIs() []string {
+	if m != nil {
+		return m.DevEUIs
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*CreateMulticastGroupRequest)(nil), "api.CreateMulticastGroupRequest")
+	proto.RegisterType((*CreateMulticastGroupResponse)(nil), "api.CreateMulticastGroupResponse")
+	proto.RegisterType((*GetMulticastGroupRequest)(nil), "api.GetMulticastGroupRequest")
+	proto.RegisterType((*GetMulticastGroupResponse)(nil), "api.GetMulticastGroupResponse")
+	proto.RegisterType((*ListMulticastGroupByAppEUIRequest)(nil), "api.ListMulticastGroupByAppEUIRequest")
+	proto.RegisterType((*ListMulticastGroupResponse)(nil), "api.ListMulticastGroupResponse")
+	proto.RegisterType((*UpdateMulticastGroupRequest)(nil), "api.UpdateMulticastGroupRequest")
+	proto.RegisterType((*UpdateMulticastGroupResponse)(nil), "api.UpdateMulticastGroupResponse")
+	proto.RegisterType((*DeleteMulticastGroupRequest)(nil), "api.DeleteMulticastGroupRequest")
+	proto.RegisterType((*DeleteMulticastGroupResponse)(nil), "api.DeleteMulticastGroupResponse")
+	proto.RegisterType((*AddNodeToMulticastGroupRequest)(nil), "api.AddNodeToMulticastGroupRequest")
+	proto.RegisterType((*AddNodeToMulticastGroupResponse)(nil), "api.AddNodeToMulticastGroupResponse")
+	proto.RegisterType((*RemoveNodeFromMulticastGroupRequest)(nil), "api.RemoveNodeFromMulticastGroupRequest")
+	proto.RegisterType((*RemoveNodeFromMulticastGroupResponse)(nil), "api.RemoveNodeFromMulticastGroupResponse")
+	proto.RegisterType((*ListMulticastGroupNodesRequest)(nil), "api.ListMulticastGroupNodesRequest")
+	proto.RegisterType((*ListMulticastGroupNodesResponse)(nil), "api.ListMulticastGroupNodesResponse")
+	proto.RegisterType((*EnqueueMulticastQueueItemRequest)(nil), "api.EnqueueMulticastQueueItemRequest")
+	proto.RegisterType((*EnqueueMulticastQueueItemResponse)(nil), "api.EnqueueMulticastQueueItemResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for MulticastGroup service
+
+type MulticastGroupClient interface {
+	// Create creates the given multicast group.
+	Create(ctx context.Context, in *CreateMulticastGroupRequest, opts ...grpc.CallOption) (*CreateMulticastGroupResponse, error)
+	// Get returns the multicast group matching the given id.
+	Get(ctx context.Context, in *GetMulticastGroupRequest, opts ...grpc.CallOption) (*GetMulticastGroupResponse, error)
+	// ListByAppEUI lists the multicast groups of the given application.
+	ListByAppEUI(ctx context.Context, in *ListMulticastGroupByAppEUIRequest, opts ...grpc.CallOption) (*ListMulticastGroupResponse, error)
+	// Update updates the multicast group matching the given id.
+	Update(ctx context.Context, in *UpdateMulticastGroupRequest, opts ...grpc.CallOption) (*UpdateMulticastGroupResponse, error)
+	// Delete deletes the multicast group matching the given id.
+	Delete(ctx context.Context, in *DeleteMulticastGroupRequest, opts ...grpc.CallOption) (*DeleteMulticastGroupResponse, error)
+	// AddNode assigns the given node to the multicast group.
+	AddNode(ctx context.Context, in *AddNodeToMulticastGroupRequest, opts ...grpc.CallOption) (*AddNodeToMulticastGroupResponse, error)
+	// RemoveNode removes the given node from the multicast group.
+	RemoveNode(ctx context.Context, in *RemoveNodeFromMulticastGroupRequest, opts ...grpc.CallOption) (*RemoveNodeFromMulticastGroupResponse, error)
+	// ListNodes lists the nodes assigned to the multicast group.
+	ListNodes(ctx context.Context, in *ListMulticastGroupNodesRequest, opts ...grpc.CallOption) (*ListMulticastGroupNodesResponse, error)
+	// Enqueue adds the given payload to the downlink queue of the nodes
+	// assigned to the multicast group.
+	Enqueue(ctx context.Context, in *EnqueueMulticastQueueItemRequest, opts ...grpc.CallOption) (*EnqueueMulticastQueueItemResponse, error)
+}
+
+type multicastGroupClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewMulticastGroupClient(cc *grpc.ClientConn) MulticastGroupClient {
+	return &multicastGroupClient{cc}
+}
+
+func (c *multicastGroupClient) Create(ctx context.Context, in *CreateMulticastGroupRequest, opts ...grpc.CallOption) (*CreateMulticastGroupResponse, error) {
+	out := new(CreateMulticastGroupResponse)
+	err := grpc.Invoke(ctx, "/api.MulticastGroup/Create", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multicastGroupClient) Get(ctx context.Context, in *GetMulticastGroupRequest, opts ...grpc.CallOption) (*GetMulticastGroupResponse, error) {
+	out := new(GetMulticastGroupResponse)
+	err := grpc.Invoke(ctx, "/api.MulticastGroup/Get", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multicastGroupClient) ListByAppEUI(ctx context.Context, in *ListMulticastGroupByAppEUIRequest, opts ...grpc.CallOption) (*ListMulticastGroupResponse, error) {
+	out := new(ListMulticastGroupResponse)
+	err := grpc.Invoke(ctx, "/api.MulticastGroup/ListByAppEUI", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multicastGroupClient) Update(ctx context.Context, in *UpdateMulticastGroupRequest, opts ...grpc.CallOption) (*UpdateMulticastGroupResponse, error) {
+	out := new(UpdateMulticastGroupResponse)
+	err := grpc.Invoke(ctx, "/api.MulticastGroup/Update", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multicastGroupClient) Delete(ctx context.Context, in *DeleteMulticastGroupRequest, opts ...grpc.CallOption) (*DeleteMulticastGroupResponse, error) {
+	out := new(DeleteMulticastGroupResponse)
+	err := grpc.Invoke(ctx, "/api.MulticastGroup/Delete", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multicastGroupClient) AddNode(ctx context.Context, in *AddNodeToMulticastGroupRequest, opts ...grpc.CallOption) (*AddNodeToMulticastGroupResponse, error) {
+	out := new(AddNodeToMulticastGroupResponse)
+	err := grpc.Invoke(ctx, "/api.MulticastGroup/AddNode", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multicastGroupClient) RemoveNode(ctx context.Context, in *RemoveNodeFromMulticastGroupRequest, opts ...grpc.CallOption) (*RemoveNodeFromMulticastGroupResponse, error) {
+	out := new(RemoveNodeFromMulticastGroupResponse)
+	err := grpc.Invoke(ctx, "/api.MulticastGroup/RemoveNode", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multicastGroupClient) ListNodes(ctx context.Context, in *ListMulticastGroupNodesRequest, opts ...grpc.CallOption) (*ListMulticastGroupNodesResponse, error) {
+	out := new(ListMulticastGroupNodesResponse)
+	err := grpc.Invoke(ctx, "/api.MulticastGroup/ListNodes", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multicastGroupClient) Enqueue(ctx context.Context, in *EnqueueMulticastQueueItemRequest, opts ...grpc.CallOption) (*EnqueueMulticastQueueItemResponse, error) {
+	out := new(EnqueueMulticastQueueItemResponse)
+	err := grpc.Invoke(ctx, "/api.MulticastGroup/Enqueue", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for MulticastGroup service
+
+type MulticastGroupServer interface {
+	// Create creates the given multicast group.
+	Create(context.Context, *CreateMulticastGroupRequest) (*CreateMulticastGroupResponse, error)
+	// Get returns the multicast group matching the given id.
+	Get(context.Context, *GetMulticastGroupRequest) (*GetMulticastGroupResponse, error)
+	// ListByAppEUI lists the multicast groups of the given application.
+	ListByAppEUI(context.Context, *ListMulticastGroupByAppEUIRequest) (*ListMulticastGroupResponse, error)
+	// Update updates the multicast group matching the given id.
+	Update(context.Context, *UpdateMulticastGroupRequest) (*UpdateMulticastGroupResponse, error)
+	// Delete deletes the multicast group matching the given id.
+	Delete(context.Context, *DeleteMulticastGroupRequest) (*DeleteMulticastGroupResponse, error)
+	// AddNode assigns the given node to the multicast group.
+	AddNode(context.Context, *AddNodeToMulticastGroupRequest) (*AddNodeToMulticastGroupResponse, error)
+	// RemoveNode removes the given node from the multicast group.
+	RemoveNode(context.Context, *RemoveNodeFromMulticastGroupRequest) (*RemoveNodeFromMulticastGroupResponse, error)
+	// ListNodes lists the nodes assigned to the multicast group.
+	ListNodes(context.Context, *ListMulticastGroupNodesRequest) (*ListMulticastGroupNodesResponse, error)
+	// Enqueue adds the given payload to the downlink queue of the nodes
+	// assigned to the multicast group.
+	Enqueue(context.Context, *EnqueueMulticastQueueItemRequest) (*EnqueueMulticastQueueItemResponse, error)
+}
+
+func RegisterMulticastGroupServer(s *grpc.Server, srv MulticastGroupServer) {
+	s.RegisterService(&_MulticastGroup_serviceDesc, srv)
+}
+
+func _MulticastGroup_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMulticastGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MulticastGroupServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.MulticastGroup/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MulticastGroupServer).Create(ctx, req.(*CreateMulticastGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MulticastGroup_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMulticastGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MulticastGroupServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.MulticastGroup/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MulticastGroupServer).Get(ctx, req.(*GetMulticastGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MulticastGroup_ListByAppEUI_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMulticastGroupByAppEUIRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MulticastGroupServer).ListByAppEUI(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.MulticastGroup/ListByAppEUI",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MulticastGroupServer).ListByAppEUI(ctx, req.(*ListMulticastGroupByAppEUIRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MulticastGroup_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMulticastGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MulticastGroupServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.MulticastGroup/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MulticastGroupServer).Update(ctx, req.(*UpdateMulticastGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MulticastGroup_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMulticastGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MulticastGroupServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.MulticastGroup/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MulticastGroupServer).Delete(ctx, req.(*DeleteMulticastGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MulticastGroup_AddNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddNodeToMulticastGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MulticastGroupServer).AddNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.MulticastGroup/AddNode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MulticastGroupServer).AddNode(ctx, req.(*AddNodeToMulticastGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MulticastGroup_RemoveNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveNodeFromMulticastGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MulticastGroupServer).RemoveNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.MulticastGroup/RemoveNode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MulticastGroupServer).RemoveNode(ctx, req.(*RemoveNodeFromMulticastGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MulticastGroup_ListNodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMulticastGroupNodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MulticastGroupServer).ListNodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.MulticastGroup/ListNodes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MulticastGroupServer).ListNodes(ctx, req.(*ListMulticastGroupNodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MulticastGroup_Enqueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnqueueMulticastQueueItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MulticastGroupServer).Enqueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.MulticastGroup/Enqueue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MulticastGroupServer).Enqueue(ctx, req.(*EnqueueMulticastQueueItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _MulticastGroup_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.MulticastGroup",
+	HandlerType: (*MulticastGroupServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _MulticastGroup_Create_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _MulticastGroup_Get_Handler,
+		},
+		{
+			MethodName: "ListByAppEUI",
+			Handler:    _MulticastGroup_ListByAppEUI_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _MulticastGroup_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _MulticastGroup_Delete_Handler,
+		},
+		{
+			MethodName: "AddNode",
+			Handler:    _MulticastGroup_AddNode_Handler,
+		},
+		{
+			MethodName: "RemoveNode",
+			Handler:    _MulticastGroup_RemoveNode_Handler,
+		},
+		{
+			MethodName: "ListNodes",
+			Handler:    _MulticastGroup_ListNodes_Handler,
+		},
+		{
+			MethodName: "Enqueue",
+			Handler:    _MulticastGroup_Enqueue_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "multicastGroup.proto",
+}
+
+func init() { proto.RegisterFile("multicastGroup.proto", fileDescriptor16) }
+
+var fileDescriptor16 = []byte{
+	// 785 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x4d, 0x4f, 0xdb, 0x4a,
+	0x14, 0xd5, 0x24, 0xe0, 0x90, 0xfb, 0x80, 0xc5, 0x08, 0x21, 0xe3, 0xe4, 0x25, 0xb1, 0xc9, 0xe3,
+	0x85, 0x3c, 0xbd, 0xa4, 0xa5, 0x52, 0x17, 0xa0, 0x2e, 0xd2, 0x96, 0x52, 0xd4, 0x82, 0x5a, 0x17,
+	0x7e, 0x80, 0x1b, 0x0f, 0xc8, 0x6a, 0x6c, 0x0f, 0xb6, 0x43, 0x45, 0x11, 0x6a, 0x55, 0x75, 0xd5,
+	0x2d, 0x3f, 0xa9, 0xcb, 0x2e, 0xbb, 0xea, 0xbe, 0xbf, 0xa3, 0xaa, 0xe6, 0x23, 0x84, 0x80, 0x3d,
+	0x8e, 0xd4, 0x45, 0x77, 0x99, 0x3b, 0xc7, 0xf7, 0xdc, 0x7b, 0x7c, 0xef, 0x71, 0x60, 0xc9, 0x1f,
+	0x0e, 0x12, 0xaf, 0xef, 0xc4, 0xc9, 0x4e, 0x14, 0x0e, 0x69, 0x87, 0x46, 0x61, 0x12, 0xe2, 0xa2,
+	0x43, 0x3d, 0xa3, 0x7a, 0x1c, 0x86, 0xc7, 0x03, 0xd2, 0x75, 0xa8, 0xd7, 0x75, 0x82, 0x20, 0x4c,
+	0x9c, 0xc4, 0x0b, 0x83, 0x58, 0x40, 0xac, 0xaf, 0x08, 0x2a, 0x8f, 0x22, 0xe2, 0x24, 0x64, 0x6f,
+	0x22, 0x83, 0x4d, 0x4e, 0x86, 0x24, 0x4e, 0x30, 0x86, 0x99, 0xc0, 0xf1, 0x89, 0x8e, 0x1a, 0xa8,
+	0x55, 0xb6, 0xf9, 0x6f, 0xbc, 0x0c, 0x9a, 0x43, 0xe9, 0xf6, 0xe1, 0xae, 0x5e, 0xe0, 0x51, 0x79,
+	0x62, 0x71, 0xbf, 0xdf, 0x73, 0xdd, 0x48, 0x2f, 0x8a, 0xb8, 0x38, 0xe1, 0x2a, 0x94, 0xfd, 0xfe,
+	0xfe, 0xdb, 0x37, 0xaf, 0x9e, 0x91, 0x33, 0x7d, 0x86, 0x5f, 0x8d, 0x03, 0xe2, 0xb6, 0x47, 0x29,
+	0xbf, 0x9d, 0x1d, 0xdd, 0xca, 0x00, 0x5e, 0x84, 0x82, 0x1b, 0xe9, 0x5a, 0x03, 0xb5, 0x16, 0xec,
+	0x82, 0xc8, 0x75, 0x14, 0xb1, 0xda, 0x82, 0xfe, 0x99, 0x5e, 0xe2, 0xe1, 0x71, 0xc0, 0xea, 0x40,
+	0x35, 0xbd, 0x99, 0x98, 0x86, 0x41, 0x4c, 0x58, 0x36, 0xcf, 0xe5, 0xbd, 0x14, 0xed, 0x82, 0xe7,
+	0x5a, 0x6d, 0xd0, 0x77, 0x48, 0x92, 0xde, 0xf9, 0x4d, 0xec, 0x77, 0x04, 0x2b, 0x29, 0xe0, 0xf4,
+	0xcc, 0x57, 0xba, 0x15, 0x52, 0x75, 0x2b, 0x66, 0xe8, 0x36, 0x93, 0xad, 0xdb, 0xac, 0x52, 0x37,
+	0x2d, 0x5d, 0xb7, 0x52, 0xba, 0x6e, 0x73, 0x37, 0x75, 0xdb, 0x02, 0xf3, 0xb9, 0x17, 0xdf, 0xe8,
+	0xed, 0xe1, 0x59, 0x8f, 0xd7, 0x37, 0x12, 0x64, 0x5c, 0x3e, 0xba, 0x5e, 0xbe, 0x75, 0x00, 0xc6,
+	0xed, 0x87, 0xaf, 0x84, 0xb9, 0x0f, 0x5a, 0x44, 0xe2, 0xe1, 0x20, 0xd1, 0x51, 0xa3, 0xd8, 0xfa,
+	0x6b, 0xa3, 0xd6, 0x71, 0xa8, 0xd7, 0xc9, 0x14, 0xd2, 0x96, 0x68, 0xeb, 0x0b, 0x82, 0xca, 0x21,
+	0x75, 0x33, 0x07, 0x73, 0x4a, 0xc1, 0xff, 0xf0, 0x40, 0xd6, 0xa0, 0x9a, 0xde, 0x84, 0xe8, 0xd6,
+	0xfa, 0x1f, 0x2a, 0x8f, 0xc9, 0x80, 0x4c, 0xd9, 0x24, 0x4b, 0x97, 0x0e, 0x97, 0xe9, 0x9e, 0x42,
+	0xad, 0xe7, 0xba, 0xfb, 0xa1, 0x4b, 0x0e, 0xc2, 0xe9, 0x64, 0x5b, 0x06, 0xcd, 0x25, 0xa7, 0xd7,
+	0x76, 0x59, 0x9c, 0x2c, 0x13, 0xea, 0x99, 0x99, 0x24, 0xd9, 0x1e, 0xac, 0xda, 0xc4, 0x0f, 0x4f,
+	0x09, 0x43, 0x3d, 0x89, 0x42, 0xff, 0xf7, 0x18, 0xd7, 0xa0, 0xa9, 0x4e, 0x27, 0x69, 0xef, 0x40,
+	0xed, 0xf6, 0xb8, 0xb1, 0x67, 0xe2, 0x2c, 0xd5, 0xb6, 0xa0, 0x9e, 0xf9, 0x84, 0x9c, 0x52, 0x1d,
+	0x4a, 0xa2, 0x8c, 0x98, 0x8f, 0x69, 0xd9, 0x1e, 0x1d, 0xad, 0x77, 0xd0, 0xd8, 0x0e, 0x4e, 0x86,
+	0x64, 0x38, 0xd6, 0xfc, 0x25, 0x3b, 0xed, 0x26, 0xc4, 0xcf, 0x6a, 0xb1, 0x0a, 0xe5, 0x88, 0x1c,
+	0x91, 0x88, 0x04, 0xfd, 0xd1, 0x40, 0x8e, 0x03, 0x78, 0x09, 0x66, 0x8f, 0x5e, 0x84, 0x51, 0xc2,
+	0x87, 0x72, 0xc1, 0x16, 0x07, 0x36, 0xbf, 0xae, 0x93, 0x38, 0x7c, 0x1c, 0xe7, 0x6d, 0xfe, 0xdb,
+	0x7a, 0x00, 0xa6, 0x82, 0x3b, 0xaf, 0xf4, 0x8d, 0x9f, 0x73, 0xb0, 0x38, 0xd9, 0x34, 0x0e, 0x40,
+	0x13, 0x06, 0x89, 0x1b, 0x7c, 0x0f, 0x15, 0xd6, 0x6f, 0x98, 0x0a, 0x84, 0x7c, 0x17, 0xf5, 0x8f,
+	0xdf, 0x7e, 0x5c, 0x16, 0x56, 0xac, 0x25, 0xfe, 0x75, 0x99, 0xfc, 0x06, 0xc5, 0x9b, 0xa8, 0x8d,
+	0x8f, 0xa1, 0xb8, 0x43, 0x12, 0xfc, 0x77, 0xd6, 0xd2, 0x0b, 0xa6, 0x1c, 0x4f, 0xb0, 0x4c, 0x4e,
+	0x53, 0xc1, 0x2b, 0x69, 0x34, 0xdd, 0x73, 0xcf, 0xbd, 0xc0, 0x9f, 0x11, 0xcc, 0xb3, 0x97, 0x3c,
+	0x32, 0x2d, 0xbc, 0xc6, 0x73, 0xe6, 0xba, 0x9a, 0x51, 0xcf, 0xc0, 0x5d, 0x91, 0xdf, 0xe5, 0xe4,
+	0xff, 0xe1, 0xf5, 0x54, 0x72, 0x87, 0xd2, 0x81, 0xd7, 0xe7, 0x9f, 0xd4, 0xee, 0xb9, 0x30, 0xc4,
+	0x0b, 0x1c, 0x83, 0x26, 0xb6, 0x5e, 0xaa, 0xac, 0xf0, 0x31, 0xc3, 0x54, 0x20, 0x64, 0x05, 0x4d,
+	0x5e, 0x41, 0xcd, 0xc8, 0x6e, 0x9f, 0x49, 0x4d, 0x41, 0x13, 0xde, 0x20, 0x49, 0x15, 0xbe, 0x62,
+	0x98, 0x0a, 0xc4, 0xa4, 0xe6, 0x6d, 0x85, 0xe6, 0x1f, 0x10, 0x94, 0xa4, 0x49, 0xe0, 0x55, 0x9e,
+	0x51, 0x6d, 0x3e, 0x46, 0x53, 0x0d, 0x92, 0xcc, 0x6d, 0xce, 0xdc, 0xb4, 0xea, 0x99, 0xcc, 0xdd,
+	0x80, 0x2d, 0x2f, 0x6b, 0xfa, 0x12, 0x01, 0x8c, 0x5d, 0x03, 0xb7, 0x38, 0xc1, 0x14, 0xae, 0x64,
+	0xac, 0x4f, 0x81, 0x1c, 0x19, 0x0e, 0xaf, 0xa7, 0xdd, 0x6e, 0xe5, 0xd4, 0xd3, 0x3d, 0x17, 0x8b,
+	0x77, 0x81, 0xdf, 0x43, 0x99, 0x0d, 0x14, 0xcb, 0x1b, 0x4b, 0x65, 0xd4, 0x96, 0x65, 0x34, 0xd5,
+	0x20, 0x59, 0xc9, 0xbf, 0xbc, 0x12, 0x13, 0xe7, 0x29, 0x83, 0x3f, 0x21, 0x28, 0x49, 0xe7, 0xc0,
+	0xff, 0xf0, 0xd4, 0x79, 0x1e, 0x66, 0xac, 0xe5, 0xc1, 0xa6, 0x7f, 0x3b, 0x3c, 0xc5, 0x26, 0x6a,
+	0xbf, 0xd6, 0xf8, 0x7f, 0xcc, 0x7b, 0xbf, 0x06, 0x00, 0xfd, 0x0e, 0x1c, 0x44, 0x9e, 0x0a, 0x00,
+	0x00,
+}
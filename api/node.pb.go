@@ -19,6 +19,58 @@ var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
 
+// NodeOrderBy defines the order of the listed nodes.
+type NodeOrderBy int32
+
+const (
+	NodeOrderBy_DEV_EUI NodeOrderBy = 0
+	NodeOrderBy_NAME    NodeOrderBy = 1
+	// link-quality score (worst first)
+	NodeOrderBy_LINK_SCORE NodeOrderBy = 2
+)
+
+var NodeOrderBy_name = map[int32]string{
+	0: "DEV_EUI",
+	1: "NAME",
+	2: "LINK_SCORE",
+}
+var NodeOrderBy_value = map[string]int32{
+	"DEV_EUI":    0,
+	"NAME":       1,
+	"LINK_SCORE": 2,
+}
+
+func (x NodeOrderBy) String() string {
+	return proto.EnumName(NodeOrderBy_name, int32(x))
+}
+func (NodeOrderBy) EnumDescriptor() ([]byte, []int) { return fileDescriptor1, []int{0} }
+
+type NodeVariable struct {
+	// name of the variable
+	Key string `protobuf:"bytes,1,opt,name=key" json:"key,omitempty"`
+	// value of the variable
+	Value string `protobuf:"bytes,2,opt,name=value" json:"value,omitempty"`
+}
+
+func (m *NodeVariable) Reset()                    { *m = NodeVariable{} }
+func (m *NodeVariable) String() string            { return proto.CompactTextString(m) }
+func (*NodeVariable) ProtoMessage()               {}
+func (*NodeVariable) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{0} }
+
+func (m *NodeVariable) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *NodeVariable) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
 type CreateNodeRequest struct {
 	// hex encoded DevEUI
 	DevEUI string `protobuf:"bytes,1,opt,name=devEUI" json:"devEUI,omitempty"`
@@ -35,12 +87,18 @@ type CreateNodeRequest struct {
 	RelaxFCnt          bool     `protobuf:"varint,10,opt,name=relaxFCnt" json:"relaxFCnt,omitempty"`
 	AdrInterval        uint32   `protobuf:"varint,11,opt,name=adrInterval" json:"adrInterval,omitempty"`
 	InstallationMargin float64  `protobuf:"fixed64,12,opt,name=installationMargin" json:"installationMargin,omitempty"`
+	// expected interval (in seconds) between uplink transmissions (used for availability reporting, 0 = unknown)
+	UplinkInterval uint32 `protobuf:"varint,13,opt,name=uplinkInterval" json:"uplinkInterval,omitempty"`
+	// variables of the node used by the integrations (e.g. ThingsBoardAccessToken)
+	Variables []*NodeVariable `protobuf:"bytes,16,rep,name=variables" json:"variables,omitempty"`
+	// node uses end-to-end encryption (the payloads are encrypted and decrypted by the application, OTAA is not supported)
+	E2EEncryption bool `protobuf:"varint,17,opt,name=e2eEncryption" json:"e2eEncryption,omitempty"`
 }
 
 func (m *CreateNodeRequest) Reset()                    { *m = CreateNodeRequest{} }
 func (m *CreateNodeRequest) String() string            { return proto.CompactTextString(m) }
 func (*CreateNodeRequest) ProtoMessage()               {}
-func (*CreateNodeRequest) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{0} }
+func (*CreateNodeRequest) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{1} }
 
 func (m *CreateNodeRequest) GetDevEUI() string {
 	if m != nil {
@@ -126,13 +184,34 @@ func (m *CreateNodeRequest) GetInstallationMargin() float64 {
 	return 0
 }
 
+func (m *CreateNodeRequest) GetUplinkInterval() uint32 {
+	if m != nil {
+		return m.UplinkInterval
+	}
+	return 0
+}
+
+func (m *CreateNodeRequest) GetVariables() []*NodeVariable {
+	if m != nil {
+		return m.Variables
+	}
+	return nil
+}
+
+func (m *CreateNodeRequest) GetE2EEncryption() bool {
+	if m != nil {
+		return m.E2EEncryption
+	}
+	return false
+}
+
 type CreateNodeResponse struct {
 }
 
 func (m *CreateNodeResponse) Reset()                    { *m = CreateNodeResponse{} }
 func (m *CreateNodeResponse) String() string            { return proto.CompactTextString(m) }
 func (*CreateNodeResponse) ProtoMessage()               {}
-func (*CreateNodeResponse) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{1} }
+func (*CreateNodeResponse) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{2} }
 
 type GetNodeRequest struct {
 	// hex encoded DevEUI
@@ -142,7 +221,7 @@ type GetNodeRequest struct {
 func (m *GetNodeRequest) Reset()                    { *m = GetNodeRequest{} }
 func (m *GetNodeRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetNodeRequest) ProtoMessage()               {}
-func (*GetNodeRequest) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{2} }
+func (*GetNodeRequest) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{3} }
 
 func (m *GetNodeRequest) GetDevEUI() string {
 	if m != nil {
@@ -167,12 +246,38 @@ type GetNodeResponse struct {
 	RelaxFCnt          bool     `protobuf:"varint,10,opt,name=relaxFCnt" json:"relaxFCnt,omitempty"`
 	AdrInterval        uint32   `protobuf:"varint,11,opt,name=adrInterval" json:"adrInterval,omitempty"`
 	InstallationMargin float64  `protobuf:"fixed64,12,opt,name=installationMargin" json:"installationMargin,omitempty"`
+	// expected interval (in seconds) between uplink transmissions (used for availability reporting, 0 = unknown)
+	UplinkInterval uint32 `protobuf:"varint,13,opt,name=uplinkInterval" json:"uplinkInterval,omitempty"`
+	// link-quality score (0 - 100, -1 when unknown)
+	LinkScore int32 `protobuf:"varint,14,opt,name=linkScore" json:"linkScore,omitempty"`
+	// battery value of the last device status (0 = external power source, 1 - 254 = battery level, 255 = unable to measure, -1 when unknown)
+	Battery int32 `protobuf:"varint,16,opt,name=battery" json:"battery,omitempty"`
+	// battery level in percent of the last device status (-1 when unknown, external power source or unable to measure)
+	BatteryLevel float64 `protobuf:"fixed64,17,opt,name=batteryLevel" json:"batteryLevel,omitempty"`
+	// link margin (dB) of the last device status (only set when deviceStatusAt is set)
+	LinkMargin int32 `protobuf:"varint,18,opt,name=linkMargin" json:"linkMargin,omitempty"`
+	// time of the last device status (RFC3339, empty when unknown)
+	DeviceStatusAt string `protobuf:"bytes,19,opt,name=deviceStatusAt" json:"deviceStatusAt,omitempty"`
+	// latitude of the last resolved location (only set when locationAt is set)
+	Latitude float64 `protobuf:"fixed64,20,opt,name=latitude" json:"latitude,omitempty"`
+	// longitude of the last resolved location (only set when locationAt is set)
+	Longitude float64 `protobuf:"fixed64,21,opt,name=longitude" json:"longitude,omitempty"`
+	// altitude (meters) of the last resolved location (only set when locationAt is set)
+	Altitude float64 `protobuf:"fixed64,22,opt,name=altitude" json:"altitude,omitempty"`
+	// accuracy (meters) of the last resolved location (0 = unknown)
+	LocationAccuracy float64 `protobuf:"fixed64,23,opt,name=locationAccuracy" json:"locationAccuracy,omitempty"`
+	// time of the uplink of the last resolved location (RFC3339, empty when unknown)
+	LocationAt string `protobuf:"bytes,24,opt,name=locationAt" json:"locationAt,omitempty"`
+	// variables of the node used by the integrations (e.g. ThingsBoardAccessToken)
+	Variables []*NodeVariable `protobuf:"bytes,25,rep,name=variables" json:"variables,omitempty"`
+	// node uses end-to-end encryption (the payloads are encrypted and decrypted by the application, OTAA is not supported)
+	E2EEncryption bool `protobuf:"varint,26,opt,name=e2eEncryption" json:"e2eEncryption,omitempty"`
 }
 
 func (m *GetNodeResponse) Reset()                    { *m = GetNodeResponse{} }
 func (m *GetNodeResponse) String() string            { return proto.CompactTextString(m) }
 func (*GetNodeResponse) ProtoMessage()               {}
-func (*GetNodeResponse) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{3} }
+func (*GetNodeResponse) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{4} }
 
 func (m *GetNodeResponse) GetDevEUI() string {
 	if m != nil {
@@ -258,6 +363,97 @@ func (m *GetNodeResponse) GetInstallationMargin() float64 {
 	return 0
 }
 
+func (m *GetNodeResponse) GetUplinkInterval() uint32 {
+	if m != nil {
+		return m.UplinkInterval
+	}
+	return 0
+}
+
+func (m *GetNodeResponse) GetLinkScore() int32 {
+	if m != nil {
+		return m.LinkScore
+	}
+	return 0
+}
+
+func (m *GetNodeResponse) GetBattery() int32 {
+	if m != nil {
+		return m.Battery
+	}
+	return 0
+}
+
+func (m *GetNodeResponse) GetBatteryLevel() float64 {
+	if m != nil {
+		return m.BatteryLevel
+	}
+	return 0
+}
+
+func (m *GetNodeResponse) GetLinkMargin() int32 {
+	if m != nil {
+		return m.LinkMargin
+	}
+	return 0
+}
+
+func (m *GetNodeResponse) GetDeviceStatusAt() string {
+	if m != nil {
+		return m.DeviceStatusAt
+	}
+	return ""
+}
+
+func (m *GetNodeResponse) GetLatitude() float64 {
+	if m != nil {
+		return m.Latitude
+	}
+	return 0
+}
+
+func (m *GetNodeResponse) GetLongitude() float64 {
+	if m != nil {
+		return m.Longitude
+	}
+	return 0
+}
+
+func (m *GetNodeResponse) GetAltitude() float64 {
+	if m != nil {
+		return m.Altitude
+	}
+	return 0
+}
+
+func (m *GetNodeResponse) GetLocationAccuracy() float64 {
+	if m != nil {
+		return m.LocationAccuracy
+	}
+	return 0
+}
+
+func (m *GetNodeResponse) GetLocationAt() string {
+	if m != nil {
+		return m.LocationAt
+	}
+	return ""
+}
+
+func (m *GetNodeResponse) GetVariables() []*NodeVariable {
+	if m != nil {
+		return m.Variables
+	}
+	return nil
+}
+
+func (m *GetNodeResponse) GetE2EEncryption() bool {
+	if m != nil {
+		return m.E2EEncryption
+	}
+	return false
+}
+
 type DeleteNodeRequest struct {
 	// hex encoded DevEUI
 	DevEUI string `protobuf:"bytes,1,opt,name=devEUI" json:"devEUI,omitempty"`
@@ -266,7 +462,7 @@ type DeleteNodeRequest struct {
 func (m *DeleteNodeRequest) Reset()                    { *m = DeleteNodeRequest{} }
 func (m *DeleteNodeRequest) String() string            { return proto.CompactTextString(m) }
 func (*DeleteNodeRequest) ProtoMessage()               {}
-func (*DeleteNodeRequest) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{4} }
+func (*DeleteNodeRequest) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{5} }
 
 func (m *DeleteNodeRequest) GetDevEUI() string {
 	if m != nil {
@@ -281,17 +477,18 @@ type DeleteNodeResponse struct {
 func (m *DeleteNodeResponse) Reset()                    { *m = DeleteNodeResponse{} }
 func (m *DeleteNodeResponse) String() string            { return proto.CompactTextString(m) }
 func (*DeleteNodeResponse) ProtoMessage()               {}
-func (*DeleteNodeResponse) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{5} }
+func (*DeleteNodeResponse) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{6} }
 
 type ListNodeRequest struct {
-	Limit  int64 `protobuf:"varint,1,opt,name=limit" json:"limit,omitempty"`
-	Offset int64 `protobuf:"varint,2,opt,name=offset" json:"offset,omitempty"`
+	Limit   int64       `protobuf:"varint,1,opt,name=limit" json:"limit,omitempty"`
+	Offset  int64       `protobuf:"varint,2,opt,name=offset" json:"offset,omitempty"`
+	OrderBy NodeOrderBy `protobuf:"varint,3,opt,name=orderBy,enum=api.NodeOrderBy" json:"orderBy,omitempty"`
 }
 
 func (m *ListNodeRequest) Reset()                    { *m = ListNodeRequest{} }
 func (m *ListNodeRequest) String() string            { return proto.CompactTextString(m) }
 func (*ListNodeRequest) ProtoMessage()               {}
-func (*ListNodeRequest) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{6} }
+func (*ListNodeRequest) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{7} }
 
 func (m *ListNodeRequest) GetLimit() int64 {
 	if m != nil {
@@ -307,6 +504,13 @@ func (m *ListNodeRequest) GetOffset() int64 {
 	return 0
 }
 
+func (m *ListNodeRequest) GetOrderBy() NodeOrderBy {
+	if m != nil {
+		return m.OrderBy
+	}
+	return NodeOrderBy_DEV_EUI
+}
+
 type ListNodeResponse struct {
 	TotalCount int64              `protobuf:"varint,1,opt,name=totalCount" json:"totalCount,omitempty"`
 	Result     []*GetNodeResponse `protobuf:"bytes,2,rep,name=result" json:"result,omitempty"`
@@ -315,7 +519,7 @@ type ListNodeResponse struct {
 func (m *ListNodeResponse) Reset()                    { *m = ListNodeResponse{} }
 func (m *ListNodeResponse) String() string            { return proto.CompactTextString(m) }
 func (*ListNodeResponse) ProtoMessage()               {}
-func (*ListNodeResponse) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{7} }
+func (*ListNodeResponse) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{8} }
 
 func (m *ListNodeResponse) GetTotalCount() int64 {
 	if m != nil {
@@ -341,7 +545,7 @@ type ListNodeByAppEUIRequest struct {
 func (m *ListNodeByAppEUIRequest) Reset()                    { *m = ListNodeByAppEUIRequest{} }
 func (m *ListNodeByAppEUIRequest) String() string            { return proto.CompactTextString(m) }
 func (*ListNodeByAppEUIRequest) ProtoMessage()               {}
-func (*ListNodeByAppEUIRequest) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{8} }
+func (*ListNodeByAppEUIRequest) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{9} }
 
 func (m *ListNodeByAppEUIRequest) GetLimit() int64 {
 	if m != nil {
@@ -380,12 +584,18 @@ type UpdateNodeRequest struct {
 	RelaxFCnt          bool     `protobuf:"varint,10,opt,name=relaxFCnt" json:"relaxFCnt,omitempty"`
 	AdrInterval        uint32   `protobuf:"varint,11,opt,name=adrInterval" json:"adrInterval,omitempty"`
 	InstallationMargin float64  `protobuf:"fixed64,12,opt,name=installationMargin" json:"installationMargin,omitempty"`
+	// expected interval (in seconds) between uplink transmissions (used for availability reporting, 0 = unknown)
+	UplinkInterval uint32 `protobuf:"varint,13,opt,name=uplinkInterval" json:"uplinkInterval,omitempty"`
+	// variables of the node used by the integrations (e.g. ThingsBoardAccessToken)
+	Variables []*NodeVariable `protobuf:"bytes,16,rep,name=variables" json:"variables,omitempty"`
+	// node uses end-to-end encryption (the payloads are encrypted and decrypted by the application, OTAA is not supported)
+	E2EEncryption bool `protobuf:"varint,17,opt,name=e2eEncryption" json:"e2eEncryption,omitempty"`
 }
 
 func (m *UpdateNodeRequest) Reset()                    { *m = UpdateNodeRequest{} }
 func (m *UpdateNodeRequest) String() string            { return proto.CompactTextString(m) }
 func (*UpdateNodeRequest) ProtoMessage()               {}
-func (*UpdateNodeRequest) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{9} }
+func (*UpdateNodeRequest) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{10} }
 
 func (m *UpdateNodeRequest) GetDevEUI() string {
 	if m != nil {
@@ -471,15 +681,174 @@ func (m *UpdateNodeRequest) GetInstallationMargin() float64 {
 	return 0
 }
 
+func (m *UpdateNodeRequest) GetUplinkInterval() uint32 {
+	if m != nil {
+		return m.UplinkInterval
+	}
+	return 0
+}
+
+func (m *UpdateNodeRequest) GetVariables() []*NodeVariable {
+	if m != nil {
+		return m.Variables
+	}
+	return nil
+}
+
+func (m *UpdateNodeRequest) GetE2EEncryption() bool {
+	if m != nil {
+		return m.E2EEncryption
+	}
+	return false
+}
+
 type UpdateNodeResponse struct {
 }
 
 func (m *UpdateNodeResponse) Reset()                    { *m = UpdateNodeResponse{} }
 func (m *UpdateNodeResponse) String() string            { return proto.CompactTextString(m) }
 func (*UpdateNodeResponse) ProtoMessage()               {}
-func (*UpdateNodeResponse) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{10} }
+func (*UpdateNodeResponse) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{11} }
+
+type ExportNodesRequest struct {
+	// hex encoded AppEUI
+	AppEUI string `protobuf:"bytes,1,opt,name=appEUI" json:"appEUI,omitempty"`
+}
+
+func (m *ExportNodesRequest) Reset()                    { *m = ExportNodesRequest{} }
+func (m *ExportNodesRequest) String() string            { return proto.CompactTextString(m) }
+func (*ExportNodesRequest) ProtoMessage()               {}
+func (*ExportNodesRequest) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{12} }
+
+func (m *ExportNodesRequest) GetAppEUI() string {
+	if m != nil {
+		return m.AppEUI
+	}
+	return ""
+}
+
+type ExportNodesResponse struct {
+	// CSV containing a row per node (with header)
+	Csv []byte `protobuf:"bytes,1,opt,name=csv,proto3" json:"csv,omitempty"`
+}
+
+func (m *ExportNodesResponse) Reset()                    { *m = ExportNodesResponse{} }
+func (m *ExportNodesResponse) String() string            { return proto.CompactTextString(m) }
+func (*ExportNodesResponse) ProtoMessage()               {}
+func (*ExportNodesResponse) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{13} }
+
+func (m *ExportNodesResponse) GetCsv() []byte {
+	if m != nil {
+		return m.Csv
+	}
+	return nil
+}
+
+type ImportNodesRequest struct {
+	// hex encoded AppEUI
+	AppEUI string `protobuf:"bytes,1,opt,name=appEUI" json:"appEUI,omitempty"`
+	// CSV containing a row per node (with header)
+	Csv []byte `protobuf:"bytes,2,opt,name=csv,proto3" json:"csv,omitempty"`
+	// validate the rows without creating the nodes
+	DryRun bool `protobuf:"varint,3,opt,name=dryRun" json:"dryRun,omitempty"`
+}
+
+func (m *ImportNodesRequest) Reset()                    { *m = ImportNodesRequest{} }
+func (m *ImportNodesRequest) String() string            { return proto.CompactTextString(m) }
+func (*ImportNodesRequest) ProtoMessage()               {}
+func (*ImportNodesRequest) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{14} }
+
+func (m *ImportNodesRequest) GetAppEUI() string {
+	if m != nil {
+		return m.AppEUI
+	}
+	return ""
+}
+
+func (m *ImportNodesRequest) GetCsv() []byte {
+	if m != nil {
+		return m.Csv
+	}
+	return nil
+}
+
+func (m *ImportNodesRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+type ImportNodesError struct {
+	// row number (1 = first row after the header)
+	Row uint32 `protobuf:"varint,1,opt,name=row" json:"row,omitempty"`
+	// hex encoded DevEUI (when it could be parsed)
+	DevEUI string `protobuf:"bytes,2,opt,name=devEUI" json:"devEUI,omitempty"`
+	Error  string `protobuf:"bytes,3,opt,name=error" json:"error,omitempty"`
+}
+
+func (m *ImportNodesError) Reset()                    { *m = ImportNodesError{} }
+func (m *ImportNodesError) String() string            { return proto.CompactTextString(m) }
+func (*ImportNodesError) ProtoMessage()               {}
+func (*ImportNodesError) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{15} }
+
+func (m *ImportNodesError) GetRow() uint32 {
+	if m != nil {
+		return m.Row
+	}
+	return 0
+}
+
+func (m *ImportNodesError) GetDevEUI() string {
+	if m != nil {
+		return m.DevEUI
+	}
+	return ""
+}
+
+func (m *ImportNodesError) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type ImportNodesResponse struct {
+	// number of rows
+	Total uint32 `protobuf:"varint,1,opt,name=total" json:"total,omitempty"`
+	// number of imported nodes (or nodes that would be imported on dryRun)
+	Imported uint32              `protobuf:"varint,2,opt,name=imported" json:"imported,omitempty"`
+	Errors   []*ImportNodesError `protobuf:"bytes,3,rep,name=errors" json:"errors,omitempty"`
+}
+
+func (m *ImportNodesResponse) Reset()                    { *m = ImportNodesResponse{} }
+func (m *ImportNodesResponse) String() string            { return proto.CompactTextString(m) }
+func (*ImportNodesResponse) ProtoMessage()               {}
+func (*ImportNodesResponse) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{16} }
+
+func (m *ImportNodesResponse) GetTotal() uint32 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *ImportNodesResponse) GetImported() uint32 {
+	if m != nil {
+		return m.Imported
+	}
+	return 0
+}
+
+func (m *ImportNodesResponse) GetErrors() []*ImportNodesError {
+	if m != nil {
+		return m.Errors
+	}
+	return nil
+}
 
 func init() {
+	proto.RegisterType((*NodeVariable)(nil), "api.NodeVariable")
 	proto.RegisterType((*CreateNodeRequest)(nil), "api.CreateNodeRequest")
 	proto.RegisterType((*CreateNodeResponse)(nil), "api.CreateNodeResponse")
 	proto.RegisterType((*GetNodeRequest)(nil), "api.GetNodeRequest")
@@ -491,6 +860,12 @@ func init() {
 	proto.RegisterType((*ListNodeByAppEUIRequest)(nil), "api.ListNodeByAppEUIRequest")
 	proto.RegisterType((*UpdateNodeRequest)(nil), "api.UpdateNodeRequest")
 	proto.RegisterType((*UpdateNodeResponse)(nil), "api.UpdateNodeResponse")
+	proto.RegisterType((*ExportNodesRequest)(nil), "api.ExportNodesRequest")
+	proto.RegisterType((*ExportNodesResponse)(nil), "api.ExportNodesResponse")
+	proto.RegisterType((*ImportNodesRequest)(nil), "api.ImportNodesRequest")
+	proto.RegisterType((*ImportNodesError)(nil), "api.ImportNodesError")
+	proto.RegisterType((*ImportNodesResponse)(nil), "api.ImportNodesResponse")
+	proto.RegisterEnum("api.NodeOrderBy", NodeOrderBy_name, NodeOrderBy_value)
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -516,6 +891,12 @@ type NodeClient interface {
 	List(ctx context.Context, in *ListNodeRequest, opts ...grpc.CallOption) (*ListNodeResponse, error)
 	// Update updates the node matching the given DevEUI.
 	Update(ctx context.Context, in *UpdateNodeRequest, opts ...grpc.CallOption) (*UpdateNodeResponse, error)
+	// Export exports the nodes of the given application as CSV.
+	Export(ctx context.Context, in *ExportNodesRequest, opts ...grpc.CallOption) (*ExportNodesResponse, error)
+	// Import creates the nodes of the given CSV within the given application.
+	// Invalid rows are skipped and returned as errors, the valid rows are
+	// imported (unless dryRun is set).
+	Import(ctx context.Context, in *ImportNodesRequest, opts ...grpc.CallOption) (*ImportNodesResponse, error)
 }
 
 type nodeClient struct {
@@ -571,6 +952,24 @@ func (c *nodeClient) Update(ctx context.Context, in *UpdateNodeRequest, opts ...
 	return out, nil
 }
 
+func (c *nodeClient) Export(ctx context.Context, in *ExportNodesRequest, opts ...grpc.CallOption) (*ExportNodesResponse, error) {
+	out := new(ExportNodesResponse)
+	err := grpc.Invoke(ctx, "/api.Node/Export", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeClient) Import(ctx context.Context, in *ImportNodesRequest, opts ...grpc.CallOption) (*ImportNodesResponse, error) {
+	out := new(ImportNodesResponse)
+	err := grpc.Invoke(ctx, "/api.Node/Import", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for Node service
 
 type NodeServer interface {
@@ -586,6 +985,12 @@ type NodeServer interface {
 	List(context.Context, *ListNodeRequest) (*ListNodeResponse, error)
 	// Update updates the node matching the given DevEUI.
 	Update(context.Context, *UpdateNodeRequest) (*UpdateNodeResponse, error)
+	// Export exports the nodes of the given application as CSV.
+	Export(context.Context, *ExportNodesRequest) (*ExportNodesResponse, error)
+	// Import creates the nodes of the given CSV within the given application.
+	// Invalid rows are skipped and returned as errors, the valid rows are
+	// imported (unless dryRun is set).
+	Import(context.Context, *ImportNodesRequest) (*ImportNodesResponse, error)
 }
 
 func RegisterNodeServer(s *grpc.Server, srv NodeServer) {
@@ -682,6 +1087,42 @@ func _Node_Update_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Node_Export_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportNodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).Export(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Node/Export",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).Export(ctx, req.(*ExportNodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Node_Import_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportNodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).Import(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Node/Import",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).Import(ctx, req.(*ImportNodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Node_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "api.Node",
 	HandlerType: (*NodeServer)(nil),
@@ -706,6 +1147,14 @@ var _Node_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Update",
 			Handler:    _Node_Update_Handler,
 		},
+		{
+			MethodName: "Export",
+			Handler:    _Node_Export_Handler,
+		},
+		{
+			MethodName: "Import",
+			Handler:    _Node_Import_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "node.proto",
@@ -714,44 +1163,74 @@ var _Node_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("node.proto", fileDescriptor1) }
 
 var fileDescriptor1 = []byte{
-	// 617 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xec, 0x55, 0xcd, 0x6e, 0xd3, 0x4c,
-	0x14, 0x95, 0xeb, 0xd4, 0x6d, 0x6e, 0xfa, 0xf3, 0xf5, 0x7e, 0xa6, 0x1d, 0x59, 0x05, 0x59, 0x16,
-	0x0b, 0x53, 0x50, 0x2a, 0xc2, 0xae, 0x1b, 0x04, 0x0d, 0xad, 0xaa, 0x16, 0x2a, 0x8d, 0x54, 0xd1,
-	0x1d, 0x0c, 0xf5, 0xb4, 0x58, 0x72, 0x66, 0x8c, 0x3d, 0x2d, 0x89, 0x10, 0x12, 0xea, 0x2b, 0xb0,
-	0xe7, 0xa5, 0x78, 0x05, 0x1e, 0x04, 0x79, 0x66, 0x92, 0xb8, 0x49, 0x16, 0x15, 0x0b, 0xd8, 0x74,
-	0xe7, 0x7b, 0x66, 0x7c, 0x7c, 0x7c, 0xcf, 0xb9, 0x33, 0x00, 0x42, 0x26, 0xbc, 0x9d, 0x17, 0x52,
-	0x49, 0x74, 0x59, 0x9e, 0x06, 0x9b, 0x17, 0x52, 0x5e, 0x64, 0x7c, 0x9b, 0xe5, 0xe9, 0x36, 0x13,
-	0x42, 0x2a, 0xa6, 0x52, 0x29, 0x4a, 0xb3, 0x25, 0x58, 0x3a, 0x93, 0xbd, 0x9e, 0x14, 0xa6, 0x8a,
-	0xae, 0x5d, 0x58, 0xdb, 0x2d, 0x38, 0x53, 0xfc, 0x8d, 0x4c, 0x38, 0xe5, 0x9f, 0x2e, 0x79, 0xa9,
-	0x70, 0x1d, 0xbc, 0x84, 0x5f, 0xbd, 0x3a, 0x39, 0x20, 0x4e, 0xe8, 0xc4, 0x4d, 0x6a, 0xab, 0x0a,
-	0x67, 0x79, 0x5e, 0xe1, 0x73, 0x06, 0x37, 0x95, 0xc5, 0x0f, 0xf9, 0x80, 0xb8, 0x23, 0xfc, 0x90,
-	0x0f, 0x90, 0xc0, 0x42, 0xd1, 0xef, 0xf2, 0x8c, 0x0d, 0x48, 0x23, 0x74, 0xe2, 0x65, 0x3a, 0x2c,
-	0x31, 0x84, 0x56, 0xd1, 0x7f, 0xda, 0xa5, 0xc7, 0xe7, 0xe7, 0x25, 0x57, 0x64, 0x5e, 0xaf, 0xd6,
-	0x21, 0x7c, 0x08, 0xcb, 0x67, 0x1f, 0x99, 0x10, 0x3c, 0x3b, 0x4a, 0x4b, 0x75, 0xd0, 0x25, 0x5e,
-	0xe8, 0xc4, 0x2e, 0xbd, 0x09, 0xe2, 0x23, 0x58, 0x2c, 0xfa, 0x6f, 0x53, 0x91, 0xc8, 0xcf, 0x64,
-	0x21, 0x74, 0xe2, 0x95, 0xce, 0x72, 0x9b, 0xe5, 0x69, 0x9b, 0x9e, 0x1a, 0x90, 0x8e, 0x96, 0xd1,
-	0x87, 0xf9, 0xa2, 0xdf, 0xe9, 0x52, 0xb2, 0xa8, 0x3f, 0x66, 0x0a, 0x44, 0x68, 0x08, 0xd6, 0xe3,
-	0xa4, 0xa9, 0x85, 0xeb, 0x67, 0xdc, 0x84, 0x66, 0xc1, 0x33, 0xd6, 0xdf, 0xdb, 0x15, 0x8a, 0x40,
-	0xe8, 0xc4, 0x8b, 0x74, 0x0c, 0x54, 0xd2, 0x59, 0x52, 0x1c, 0x08, 0xc5, 0x8b, 0x2b, 0x96, 0x91,
-	0x96, 0x91, 0x5e, 0x83, 0xb0, 0x0d, 0x98, 0x8a, 0x52, 0xb1, 0x2c, 0xd3, 0x9d, 0x7f, 0xcd, 0x8a,
-	0x8b, 0x54, 0x90, 0xa5, 0xd0, 0x89, 0x1d, 0x3a, 0x63, 0x25, 0xf2, 0x01, 0xeb, 0x1e, 0x94, 0xb9,
-	0x14, 0x25, 0x8f, 0x62, 0x58, 0xd9, 0xe7, 0xea, 0x16, 0xb6, 0x44, 0xdf, 0x5c, 0x58, 0x1d, 0x6d,
-	0x35, 0x6f, 0xdf, 0x59, 0xf8, 0x77, 0x2d, 0x7c, 0x0c, 0x6b, 0x5d, 0x9e, 0xf1, 0x5b, 0x8d, 0x51,
-	0xe5, 0x77, 0x7d, 0xb3, 0xf5, 0xfb, 0x39, 0xac, 0x56, 0x1d, 0xa9, 0x13, 0xf8, 0x30, 0x9f, 0xa5,
-	0xbd, 0x54, 0xe9, 0xf7, 0x5d, 0x6a, 0x8a, 0x8a, 0x56, 0x9a, 0x9e, 0xcf, 0x69, 0xd8, 0x56, 0xd1,
-	0x7b, 0xf8, 0x6f, 0x4c, 0x60, 0x63, 0xf0, 0x00, 0x40, 0x49, 0xc5, 0xb2, 0x5d, 0x79, 0x29, 0x86,
-	0x34, 0x35, 0x04, 0x9f, 0x80, 0x57, 0xf0, 0xf2, 0x32, 0xab, 0xb8, 0xdc, 0xb8, 0xd5, 0xf1, 0x75,
-	0xeb, 0x27, 0xc2, 0x44, 0xed, 0x9e, 0xe8, 0x1d, 0x6c, 0x0c, 0xbf, 0xf0, 0x72, 0xf0, 0x42, 0x07,
-	0xe7, 0x8f, 0xa4, 0xd6, 0x52, 0xe8, 0xd6, 0x53, 0xa8, 0x8f, 0xa3, 0x93, 0x3c, 0xb9, 0x3b, 0x8e,
-	0xfe, 0xf1, 0x71, 0x54, 0xf7, 0xc0, 0x64, 0xa0, 0xf3, 0xc3, 0x85, 0x46, 0x05, 0xe0, 0x31, 0x78,
-	0xe6, 0xb4, 0xc2, 0x75, 0xfd, 0x6f, 0x53, 0xd7, 0x47, 0xb0, 0x31, 0x85, 0xdb, 0x88, 0xfb, 0xd7,
-	0x3f, 0x7f, 0x7d, 0x9f, 0x5b, 0x89, 0x9a, 0xfa, 0x6e, 0xaa, 0xee, 0xad, 0x1d, 0x67, 0x0b, 0x8f,
-	0xc0, 0xdd, 0xe7, 0x0a, 0xff, 0xbf, 0x19, 0x3d, 0x43, 0x35, 0x33, 0x8f, 0x51, 0xa0, 0x79, 0x7c,
-	0xc4, 0x11, 0xcf, 0xf6, 0x17, 0x93, 0x89, 0xaf, 0x78, 0x02, 0x9e, 0x19, 0x2e, 0x2b, 0x6f, 0x6a,
-	0x2c, 0xad, 0xbc, 0x19, 0x13, 0x68, 0x69, 0xb7, 0x66, 0xd1, 0xee, 0x41, 0xa3, 0xf2, 0x18, 0x8d,
-	0xa0, 0x89, 0x41, 0x0d, 0xee, 0x4d, 0xa0, 0x96, 0x70, 0x4d, 0x13, 0xb6, 0x70, 0xfc, 0xbf, 0x78,
-	0x0a, 0x9e, 0x69, 0xae, 0x95, 0x37, 0x95, 0x76, 0x2b, 0x6f, 0xda, 0x81, 0xe8, 0xbe, 0x66, 0xdb,
-	0x08, 0x66, 0xc8, 0xdb, 0x71, 0xb6, 0x3e, 0x78, 0xfa, 0x46, 0x7f, 0xf6, 0x3b, 0x00, 0x00, 0xff,
-	0xff, 0x88, 0x1f, 0x5a, 0x1b, 0x10, 0x08, 0x00, 0x00,
+	// 1094 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0x5d, 0x6e, 0x1b, 0x37,
+	0x10, 0xee, 0xea, 0xcf, 0xd2, 0xc8, 0x52, 0x64, 0x5a, 0x89, 0x58, 0x21, 0x0d, 0x84, 0x45, 0xd0,
+	0xa8, 0x6a, 0x62, 0xa1, 0x6a, 0xd1, 0x87, 0xbc, 0x39, 0x96, 0x12, 0x08, 0x76, 0x6c, 0x80, 0x86,
+	0xdd, 0xbc, 0xb9, 0xb4, 0xc4, 0xb8, 0x5b, 0xaf, 0x76, 0xb7, 0x5c, 0x4a, 0x91, 0x10, 0xe4, 0xa1,
+	0xbd, 0x42, 0xcf, 0xd4, 0x13, 0xf4, 0x0a, 0xbd, 0x41, 0x2f, 0x50, 0x70, 0x48, 0x49, 0xab, 0x9f,
+	0x02, 0x46, 0x9e, 0x0a, 0x34, 0x6f, 0x9c, 0x8f, 0x33, 0xdf, 0x0c, 0x87, 0x33, 0xc3, 0x5d, 0x80,
+	0x20, 0x1c, 0x8a, 0x83, 0x48, 0x86, 0x2a, 0x24, 0x69, 0x1e, 0x79, 0xf5, 0x87, 0x37, 0x61, 0x78,
+	0xe3, 0x8b, 0x36, 0x8f, 0xbc, 0x36, 0x0f, 0x82, 0x50, 0x71, 0xe5, 0x85, 0x41, 0x6c, 0x54, 0xea,
+	0xbb, 0x83, 0x70, 0x34, 0x0a, 0x03, 0x23, 0xb9, 0xdf, 0xc3, 0xee, 0x69, 0x38, 0x14, 0x97, 0x5c,
+	0x7a, 0xfc, 0xda, 0x17, 0xa4, 0x02, 0xe9, 0x5b, 0x31, 0xa3, 0x4e, 0xc3, 0x69, 0x16, 0x98, 0x5e,
+	0x92, 0x2a, 0x64, 0x27, 0xdc, 0x1f, 0x0b, 0x9a, 0x42, 0xcc, 0x08, 0xee, 0xaf, 0x19, 0xd8, 0x3b,
+	0x92, 0x82, 0x2b, 0xa1, 0xcd, 0x99, 0xf8, 0x65, 0x2c, 0x62, 0x45, 0x1e, 0x40, 0x6e, 0x28, 0x26,
+	0xbd, 0x8b, 0xbe, 0x25, 0xb0, 0x92, 0xc6, 0x79, 0x14, 0x69, 0xdc, 0x90, 0x58, 0xc9, 0xe2, 0xc7,
+	0x62, 0x46, 0xd3, 0x0b, 0xfc, 0x58, 0xcc, 0x08, 0x85, 0x1d, 0x39, 0xed, 0x0a, 0x9f, 0xcf, 0x68,
+	0xa6, 0xe1, 0x34, 0x4b, 0x6c, 0x2e, 0x92, 0x06, 0x14, 0xe5, 0xf4, 0x9b, 0x2e, 0x3b, 0x7b, 0xfb,
+	0x36, 0x16, 0x8a, 0x66, 0x71, 0x37, 0x09, 0x91, 0xc7, 0x50, 0x1a, 0xfc, 0xc4, 0x83, 0x40, 0xf8,
+	0x27, 0x5e, 0xac, 0xfa, 0x5d, 0x9a, 0x6b, 0x38, 0xcd, 0x34, 0x5b, 0x05, 0xc9, 0x57, 0x90, 0x97,
+	0xd3, 0x1f, 0xbc, 0x60, 0x18, 0xbe, 0xa3, 0x3b, 0x0d, 0xa7, 0x59, 0xee, 0x94, 0x0e, 0x78, 0xe4,
+	0x1d, 0xb0, 0x37, 0x06, 0x64, 0x8b, 0x6d, 0x9d, 0x00, 0x39, 0xed, 0x74, 0x19, 0xcd, 0xa3, 0x33,
+	0x23, 0x10, 0x02, 0x99, 0x80, 0x8f, 0x04, 0x2d, 0x60, 0xe0, 0xb8, 0x26, 0x0f, 0xa1, 0x20, 0x85,
+	0xcf, 0xa7, 0x2f, 0x8f, 0x02, 0x45, 0xa1, 0xe1, 0x34, 0xf3, 0x6c, 0x09, 0xe8, 0xd0, 0xf9, 0x50,
+	0xf6, 0x03, 0x25, 0xe4, 0x84, 0xfb, 0xb4, 0x68, 0x42, 0x4f, 0x40, 0xe4, 0x00, 0x88, 0x17, 0xc4,
+	0x8a, 0xfb, 0x3e, 0xde, 0xd8, 0x6b, 0x2e, 0x6f, 0xbc, 0x80, 0xee, 0x36, 0x9c, 0xa6, 0xc3, 0xb6,
+	0xec, 0x90, 0x2f, 0xa1, 0x3c, 0x8e, 0x7c, 0x2f, 0xb8, 0x5d, 0x90, 0x96, 0x90, 0x74, 0x0d, 0x25,
+	0x6d, 0x28, 0x4c, 0xec, 0x05, 0xc7, 0xb4, 0xd2, 0x48, 0x37, 0x8b, 0x9d, 0x3d, 0x3c, 0x6d, 0xf2,
+	0xea, 0xd9, 0x52, 0x47, 0xe7, 0x50, 0x74, 0x44, 0x2f, 0x18, 0xc8, 0x59, 0xa4, 0xfd, 0xd1, 0x3d,
+	0x3c, 0xcc, 0x2a, 0xe8, 0x56, 0x81, 0x24, 0x4b, 0x20, 0x8e, 0xc2, 0x20, 0x16, 0x6e, 0x13, 0xca,
+	0xaf, 0x84, 0xba, 0x43, 0x55, 0xb8, 0x7f, 0xe4, 0xe0, 0xde, 0x42, 0xd5, 0x58, 0x7f, 0xaa, 0xa0,
+	0xff, 0x66, 0x05, 0x3d, 0x84, 0x82, 0x96, 0xcf, 0x07, 0xa1, 0x14, 0xb4, 0xdc, 0x70, 0x9a, 0x59,
+	0xb6, 0x04, 0x74, 0xb2, 0xaf, 0xb9, 0x52, 0x42, 0xce, 0x68, 0x05, 0xf7, 0xe6, 0x22, 0x71, 0x61,
+	0xd7, 0x2e, 0x4f, 0xc4, 0x44, 0xf8, 0x58, 0x47, 0x0e, 0x5b, 0xc1, 0xc8, 0x23, 0x00, 0x4d, 0x65,
+	0x63, 0x25, 0x48, 0x90, 0x40, 0x74, 0x8c, 0x43, 0x31, 0xf1, 0x06, 0xe2, 0x5c, 0x71, 0x35, 0x8e,
+	0x0f, 0x15, 0xdd, 0xc7, 0x8c, 0xad, 0xa1, 0xa4, 0x0e, 0x79, 0x7d, 0x36, 0x35, 0x1e, 0x0a, 0x5a,
+	0x45, 0x3f, 0x0b, 0x19, 0xe3, 0x0f, 0x83, 0x1b, 0xb3, 0x79, 0x1f, 0x37, 0x97, 0x80, 0xb6, 0xe4,
+	0xbe, 0xb5, 0x7c, 0x60, 0x2c, 0xe7, 0x32, 0x69, 0x41, 0xc5, 0x0f, 0x07, 0x98, 0xb3, 0xc3, 0xc1,
+	0x60, 0x2c, 0xf9, 0x60, 0x46, 0x6b, 0xa8, 0xb3, 0x81, 0xe3, 0x49, 0xe6, 0x98, 0xa2, 0x14, 0xa3,
+	0x4c, 0x20, 0xab, 0x7d, 0xf8, 0xf9, 0xc7, 0xf4, 0x61, 0x7d, 0x5b, 0x1f, 0x7e, 0x0d, 0x7b, 0x5d,
+	0xe1, 0x8b, 0x3b, 0x8d, 0x62, 0xdd, 0xb4, 0x49, 0x65, 0xdb, 0xb4, 0xb7, 0x70, 0x4f, 0x97, 0x75,
+	0x92, 0xa0, 0x0a, 0x59, 0xdf, 0x1b, 0x79, 0x0a, 0xed, 0xd3, 0xcc, 0x08, 0x9a, 0x36, 0x34, 0x8d,
+	0x93, 0x42, 0xd8, 0x4a, 0xa4, 0x05, 0x3b, 0xa1, 0x1c, 0x0a, 0xf9, 0xc2, 0x34, 0x62, 0xb9, 0x53,
+	0x59, 0x1c, 0xec, 0xcc, 0xe0, 0x6c, 0xae, 0xe0, 0xfe, 0x08, 0x95, 0xa5, 0x33, 0xdb, 0xf7, 0x8f,
+	0x00, 0x54, 0xa8, 0xb8, 0x7f, 0x14, 0x8e, 0x83, 0xb9, 0xcb, 0x04, 0x42, 0x9e, 0x42, 0x4e, 0x8a,
+	0x78, 0xec, 0x6b, 0xbf, 0x3a, 0x6f, 0x55, 0xa4, 0x5f, 0x9b, 0x1e, 0xcc, 0xea, 0xb8, 0x57, 0x50,
+	0x9b, 0x7b, 0x78, 0x31, 0x3b, 0xc4, 0x49, 0xf1, 0x71, 0xc7, 0x5a, 0x8e, 0x9d, 0x74, 0x72, 0xec,
+	0xe0, 0xf3, 0x77, 0x11, 0x0d, 0x3f, 0x3d, 0x7f, 0xff, 0xef, 0xe7, 0x2f, 0x59, 0x02, 0xb6, 0x93,
+	0x9e, 0x02, 0xe9, 0x4d, 0xa3, 0x50, 0x62, 0xf1, 0xc5, 0x89, 0xca, 0xb0, 0x15, 0xe0, 0xac, 0xd4,
+	0xd1, 0x13, 0xd8, 0x5f, 0xd1, 0xb6, 0xdd, 0x50, 0x81, 0xf4, 0x20, 0x9e, 0xa0, 0xee, 0x2e, 0xd3,
+	0x4b, 0xf7, 0x12, 0x48, 0x7f, 0x74, 0x57, 0xda, 0xb9, 0x7d, 0x6a, 0x61, 0x8f, 0xa5, 0x29, 0x67,
+	0x6c, 0x1c, 0x60, 0xa9, 0xe5, 0x99, 0x95, 0x5c, 0x06, 0x95, 0x04, 0x6f, 0x4f, 0xca, 0x50, 0x6a,
+	0x6b, 0x19, 0xbe, 0x43, 0xca, 0x12, 0xd3, 0xcb, 0x44, 0x61, 0xa7, 0x56, 0x0a, 0xbb, 0x0a, 0x59,
+	0xa1, 0x4d, 0x6c, 0xfd, 0x1a, 0xc1, 0x9d, 0xc0, 0x7e, 0x7f, 0xb4, 0x79, 0xa8, 0x2a, 0x64, 0xb1,
+	0xa1, 0x2d, 0xb1, 0x11, 0xf4, 0xec, 0xf5, 0x50, 0x59, 0x0c, 0x91, 0xbc, 0xc4, 0x16, 0x32, 0x79,
+	0x06, 0x39, 0x64, 0x8c, 0x69, 0x1a, 0x6f, 0xed, 0x3e, 0xde, 0xda, 0x7a, 0xbc, 0xcc, 0x2a, 0xb5,
+	0xbe, 0x83, 0x62, 0x62, 0xde, 0x90, 0x22, 0xec, 0x74, 0x7b, 0x97, 0x57, 0xbd, 0x8b, 0x7e, 0xe5,
+	0x33, 0x92, 0x87, 0xcc, 0xe9, 0xe1, 0xeb, 0x5e, 0xc5, 0x21, 0x65, 0x80, 0x93, 0xfe, 0xe9, 0xf1,
+	0xd5, 0xf9, 0xd1, 0x19, 0xeb, 0x55, 0x52, 0x9d, 0xbf, 0x33, 0x90, 0xd1, 0x66, 0xe4, 0x0c, 0x72,
+	0xe6, 0x73, 0x86, 0x3c, 0x40, 0x3f, 0x1b, 0x9f, 0xb7, 0xf5, 0xda, 0x06, 0x6e, 0x2f, 0xbd, 0xfa,
+	0xdb, 0x9f, 0x7f, 0xfd, 0x9e, 0x2a, 0xbb, 0x05, 0xfc, 0xe6, 0xd6, 0xdf, 0xe3, 0xcf, 0x9d, 0x16,
+	0x39, 0x81, 0xf4, 0x2b, 0xa1, 0xc8, 0xfe, 0xea, 0xa8, 0x32, 0x54, 0x5b, 0xe7, 0x97, 0x5b, 0x47,
+	0x9e, 0x2a, 0x21, 0x0b, 0x9e, 0xf6, 0x7b, 0x93, 0xea, 0x0f, 0xe4, 0x02, 0x72, 0x66, 0x70, 0xdb,
+	0xf0, 0x36, 0x46, 0x7e, 0xbd, 0xb6, 0x81, 0xaf, 0xd2, 0xb6, 0xb6, 0xd1, 0xbe, 0x84, 0x8c, 0x9e,
+	0x09, 0xc4, 0x04, 0xb4, 0xf6, 0x08, 0xd4, 0xef, 0xaf, 0xa1, 0x96, 0x70, 0x0f, 0x09, 0x8b, 0x64,
+	0x79, 0x5e, 0xf2, 0x06, 0x72, 0xa6, 0x1b, 0x6c, 0x78, 0x1b, 0xd3, 0xb1, 0x5e, 0xdb, 0xc0, 0x2d,
+	0xdb, 0x17, 0xc8, 0x56, 0xab, 0x6f, 0x09, 0x4f, 0xa7, 0xf1, 0x06, 0x72, 0xa6, 0x47, 0x88, 0x61,
+	0xd8, 0x6c, 0xaf, 0x3a, 0xdd, 0xdc, 0xb0, 0xdc, 0x2d, 0xe4, 0x7e, 0x4c, 0xdc, 0x25, 0x37, 0x8f,
+	0x22, 0xdf, 0x33, 0x6f, 0x72, 0xfb, 0xbd, 0x69, 0x98, 0x0f, 0x6d, 0xdd, 0x23, 0x3f, 0x43, 0xae,
+	0x3f, 0x4a, 0x38, 0xea, 0x8f, 0xfe, 0xc5, 0xd1, 0x96, 0xea, 0x76, 0x9f, 0xa1, 0xa3, 0x27, 0xee,
+	0x1d, 0x1c, 0x3d, 0x77, 0x5a, 0xd7, 0x39, 0xfc, 0xfd, 0xfa, 0xf6, 0x9f, 0x01, 0x00, 0x18, 0xa5,
+	0xbc, 0x0d, 0xbd, 0x0d, 0x00, 0x00,
 }
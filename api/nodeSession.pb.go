@@ -19,6 +19,32 @@ var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
 
+type KeyEnvelope struct {
+	// label of the KEK used for wrapping the key
+	KekLabel string `protobuf:"bytes,1,opt,name=kekLabel" json:"kekLabel,omitempty"`
+	// wrapped key
+	Key []byte `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (m *KeyEnvelope) Reset()                    { *m = KeyEnvelope{} }
+func (m *KeyEnvelope) String() string            { return proto.CompactTextString(m) }
+func (*KeyEnvelope) ProtoMessage()               {}
+func (*KeyEnvelope) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{0} }
+
+func (m *KeyEnvelope) GetKekLabel() string {
+	if m != nil {
+		return m.KekLabel
+	}
+	return ""
+}
+
+func (m *KeyEnvelope) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
 type CreateNodeSessionRequest struct {
 	// hex encoded DevAddr
 	DevAddr string `protobuf:"bytes,1,opt,name=devAddr" json:"devAddr,omitempty"`
@@ -26,7 +52,7 @@ type CreateNodeSessionRequest struct {
 	AppEUI string `protobuf:"bytes,2,opt,name=appEUI" json:"appEUI,omitempty"`
 	// hex encoded DevEUI
 	DevEUI string `protobuf:"bytes,3,opt,name=devEUI" json:"devEUI,omitempty"`
-	// hex encoded AppSKey
+	// hex encoded AppSKey (empty for nodes using end-to-end encryption)
 	AppSKey string `protobuf:"bytes,4,opt,name=appSKey" json:"appSKey,omitempty"`
 	// hex encoded NwkSKey
 	NwkSKey            string   `protobuf:"bytes,5,opt,name=nwkSKey" json:"nwkSKey,omitempty"`
@@ -40,12 +66,14 @@ type CreateNodeSessionRequest struct {
 	RelaxFCnt          bool     `protobuf:"varint,13,opt,name=relaxFCnt" json:"relaxFCnt,omitempty"`
 	AdrInterval        uint32   `protobuf:"varint,14,opt,name=adrInterval" json:"adrInterval,omitempty"`
 	InstallationMargin float64  `protobuf:"fixed64,15,opt,name=installationMargin" json:"installationMargin,omitempty"`
+	// AppSKey wrapped by a KEK of the application (optional, only for nodes using end-to-end encryption)
+	AppSKeyEnvelope *KeyEnvelope `protobuf:"bytes,16,opt,name=appSKeyEnvelope" json:"appSKeyEnvelope,omitempty"`
 }
 
 func (m *CreateNodeSessionRequest) Reset()                    { *m = CreateNodeSessionRequest{} }
 func (m *CreateNodeSessionRequest) String() string            { return proto.CompactTextString(m) }
 func (*CreateNodeSessionRequest) ProtoMessage()               {}
-func (*CreateNodeSessionRequest) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{0} }
+func (*CreateNodeSessionRequest) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{1} }
 
 func (m *CreateNodeSessionRequest) GetDevAddr() string {
 	if m != nil {
@@ -152,13 +180,20 @@ func (m *CreateNodeSessionRequest) GetInstallationMargin() float64 {
 	return 0
 }
 
+func (m *CreateNodeSessionRequest) GetAppSKeyEnvelope() *KeyEnvelope {
+	if m != nil {
+		return m.AppSKeyEnvelope
+	}
+	return nil
+}
+
 type CreateNodeSessionResponse struct {
 }
 
 func (m *CreateNodeSessionResponse) Reset()                    { *m = CreateNodeSessionResponse{} }
 func (m *CreateNodeSessionResponse) String() string            { return proto.CompactTextString(m) }
 func (*CreateNodeSessionResponse) ProtoMessage()               {}
-func (*CreateNodeSessionResponse) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{1} }
+func (*CreateNodeSessionResponse) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{2} }
 
 type GetNodeSessionRequest struct {
 	// hex encoded DevEUI
@@ -168,7 +203,7 @@ type GetNodeSessionRequest struct {
 func (m *GetNodeSessionRequest) Reset()                    { *m = GetNodeSessionRequest{} }
 func (m *GetNodeSessionRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetNodeSessionRequest) ProtoMessage()               {}
-func (*GetNodeSessionRequest) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{2} }
+func (*GetNodeSessionRequest) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{3} }
 
 func (m *GetNodeSessionRequest) GetDevEUI() string {
 	if m != nil {
@@ -184,7 +219,7 @@ type GetNodeSessionResponse struct {
 	AppEUI string `protobuf:"bytes,2,opt,name=appEUI" json:"appEUI,omitempty"`
 	// hex encoded DevEUI
 	DevEUI string `protobuf:"bytes,3,opt,name=devEUI" json:"devEUI,omitempty"`
-	// hex encoded AppSKey
+	// hex encoded AppSKey (empty for nodes using end-to-end encryption)
 	AppSKey string `protobuf:"bytes,4,opt,name=appSKey" json:"appSKey,omitempty"`
 	// hex encoded NwkSKey
 	NwkSKey            string   `protobuf:"bytes,5,opt,name=nwkSKey" json:"nwkSKey,omitempty"`
@@ -200,12 +235,14 @@ type GetNodeSessionResponse struct {
 	InstallationMargin float64  `protobuf:"fixed64,15,opt,name=installationMargin" json:"installationMargin,omitempty"`
 	NbTrans            uint32   `protobuf:"varint,16,opt,name=nbTrans" json:"nbTrans,omitempty"`
 	TxPower            uint32   `protobuf:"varint,17,opt,name=txPower" json:"txPower,omitempty"`
+	// AppSKey wrapped by a KEK of the application (optional, only for nodes using end-to-end encryption)
+	AppSKeyEnvelope *KeyEnvelope `protobuf:"bytes,18,opt,name=appSKeyEnvelope" json:"appSKeyEnvelope,omitempty"`
 }
 
 func (m *GetNodeSessionResponse) Reset()                    { *m = GetNodeSessionResponse{} }
 func (m *GetNodeSessionResponse) String() string            { return proto.CompactTextString(m) }
 func (*GetNodeSessionResponse) ProtoMessage()               {}
-func (*GetNodeSessionResponse) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{3} }
+func (*GetNodeSessionResponse) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{4} }
 
 func (m *GetNodeSessionResponse) GetDevAddr() string {
 	if m != nil {
@@ -326,6 +363,13 @@ func (m *GetNodeSessionResponse) GetTxPower() uint32 {
 	return 0
 }
 
+func (m *GetNodeSessionResponse) GetAppSKeyEnvelope() *KeyEnvelope {
+	if m != nil {
+		return m.AppSKeyEnvelope
+	}
+	return nil
+}
+
 type UpdateNodeSessionRequest struct {
 	// hex encoded DevAddr
 	DevAddr string `protobuf:"bytes,1,opt,name=devAddr" json:"devAddr,omitempty"`
@@ -333,7 +377,7 @@ type UpdateNodeSessionRequest struct {
 	AppEUI string `protobuf:"bytes,2,opt,name=appEUI" json:"appEUI,omitempty"`
 	// hex encoded DevEUI
 	DevEUI string `protobuf:"bytes,3,opt,name=devEUI" json:"devEUI,omitempty"`
-	// hex encoded AppSKey
+	// hex encoded AppSKey (empty for nodes using end-to-end encryption)
 	AppSKey string `protobuf:"bytes,4,opt,name=appSKey" json:"appSKey,omitempty"`
 	// hex encoded NwkSKey
 	NwkSKey            string   `protobuf:"bytes,5,opt,name=nwkSKey" json:"nwkSKey,omitempty"`
@@ -347,12 +391,14 @@ type UpdateNodeSessionRequest struct {
 	RelaxFCnt          bool     `protobuf:"varint,13,opt,name=relaxFCnt" json:"relaxFCnt,omitempty"`
 	AdrInterval        uint32   `protobuf:"varint,14,opt,name=adrInterval" json:"adrInterval,omitempty"`
 	InstallationMargin float64  `protobuf:"fixed64,15,opt,name=installationMargin" json:"installationMargin,omitempty"`
+	// AppSKey wrapped by a KEK of the application (optional, only for nodes using end-to-end encryption)
+	AppSKeyEnvelope *KeyEnvelope `protobuf:"bytes,16,opt,name=appSKeyEnvelope" json:"appSKeyEnvelope,omitempty"`
 }
 
 func (m *UpdateNodeSessionRequest) Reset()                    { *m = UpdateNodeSessionRequest{} }
 func (m *UpdateNodeSessionRequest) String() string            { return proto.CompactTextString(m) }
 func (*UpdateNodeSessionRequest) ProtoMessage()               {}
-func (*UpdateNodeSessionRequest) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{4} }
+func (*UpdateNodeSessionRequest) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{5} }
 
 func (m *UpdateNodeSessionRequest) GetDevAddr() string {
 	if m != nil {
@@ -459,13 +505,20 @@ func (m *UpdateNodeSessionRequest) GetInstallationMargin() float64 {
 	return 0
 }
 
+func (m *UpdateNodeSessionRequest) GetAppSKeyEnvelope() *KeyEnvelope {
+	if m != nil {
+		return m.AppSKeyEnvelope
+	}
+	return nil
+}
+
 type UpdateNodeSessionResponse struct {
 }
 
 func (m *UpdateNodeSessionResponse) Reset()                    { *m = UpdateNodeSessionResponse{} }
 func (m *UpdateNodeSessionResponse) String() string            { return proto.CompactTextString(m) }
 func (*UpdateNodeSessionResponse) ProtoMessage()               {}
-func (*UpdateNodeSessionResponse) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{5} }
+func (*UpdateNodeSessionResponse) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{6} }
 
 type DeleteNodeSessionRequest struct {
 	// hex encoded DevEUI
@@ -475,7 +528,7 @@ type DeleteNodeSessionRequest struct {
 func (m *DeleteNodeSessionRequest) Reset()                    { *m = DeleteNodeSessionRequest{} }
 func (m *DeleteNodeSessionRequest) String() string            { return proto.CompactTextString(m) }
 func (*DeleteNodeSessionRequest) ProtoMessage()               {}
-func (*DeleteNodeSessionRequest) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{6} }
+func (*DeleteNodeSessionRequest) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{7} }
 
 func (m *DeleteNodeSessionRequest) GetDevEUI() string {
 	if m != nil {
@@ -490,7 +543,7 @@ type DeleteNodeSessionResponse struct {
 func (m *DeleteNodeSessionResponse) Reset()                    { *m = DeleteNodeSessionResponse{} }
 func (m *DeleteNodeSessionResponse) String() string            { return proto.CompactTextString(m) }
 func (*DeleteNodeSessionResponse) ProtoMessage()               {}
-func (*DeleteNodeSessionResponse) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{7} }
+func (*DeleteNodeSessionResponse) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{8} }
 
 type GetRandomDevAddrRequest struct {
 }
@@ -498,7 +551,7 @@ type GetRandomDevAddrRequest struct {
 func (m *GetRandomDevAddrRequest) Reset()                    { *m = GetRandomDevAddrRequest{} }
 func (m *GetRandomDevAddrRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetRandomDevAddrRequest) ProtoMessage()               {}
-func (*GetRandomDevAddrRequest) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{8} }
+func (*GetRandomDevAddrRequest) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{9} }
 
 type GetRandomDevAddrResponse struct {
 	// hex encoded DevAddr
@@ -508,7 +561,7 @@ type GetRandomDevAddrResponse struct {
 func (m *GetRandomDevAddrResponse) Reset()                    { *m = GetRandomDevAddrResponse{} }
 func (m *GetRandomDevAddrResponse) String() string            { return proto.CompactTextString(m) }
 func (*GetRandomDevAddrResponse) ProtoMessage()               {}
-func (*GetRandomDevAddrResponse) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{9} }
+func (*GetRandomDevAddrResponse) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{10} }
 
 func (m *GetRandomDevAddrResponse) GetDevAddr() string {
 	if m != nil {
@@ -518,6 +571,7 @@ func (m *GetRandomDevAddrResponse) GetDevAddr() string {
 }
 
 func init() {
+	proto.RegisterType((*KeyEnvelope)(nil), "api.KeyEnvelope")
 	proto.RegisterType((*CreateNodeSessionRequest)(nil), "api.CreateNodeSessionRequest")
 	proto.RegisterType((*CreateNodeSessionResponse)(nil), "api.CreateNodeSessionResponse")
 	proto.RegisterType((*GetNodeSessionRequest)(nil), "api.GetNodeSessionRequest")
@@ -747,45 +801,49 @@ var _NodeSession_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("nodeSession.proto", fileDescriptor3) }
 
 var fileDescriptor3 = []byte{
-	// 627 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xec, 0x56, 0xcd, 0x6e, 0xd3, 0x4c,
-	0x14, 0x95, 0x9b, 0xd6, 0x4d, 0x6f, 0x7f, 0xbe, 0x76, 0xf4, 0xb5, 0x4c, 0x9c, 0xb6, 0x72, 0x0d,
-	0x8b, 0xb4, 0x8b, 0x44, 0x04, 0x56, 0xec, 0x50, 0x4d, 0xab, 0x8a, 0x5f, 0xb9, 0x54, 0xb0, 0x9d,
-	0xd6, 0x93, 0xc8, 0xe0, 0xcc, 0x98, 0xf1, 0x90, 0xb8, 0x20, 0x36, 0xf0, 0x08, 0x3c, 0x07, 0x0f,
-	0x83, 0x78, 0x05, 0x1e, 0x04, 0xcd, 0x4f, 0x9a, 0x28, 0xb5, 0x61, 0x0d, 0xea, 0x2e, 0xe7, 0x9e,
-	0xcc, 0x39, 0x33, 0xf7, 0x4c, 0xee, 0x04, 0x36, 0x18, 0x8f, 0xe9, 0x29, 0xcd, 0xf3, 0x84, 0xb3,
-	0x76, 0x26, 0xb8, 0xe4, 0xa8, 0x46, 0xb2, 0xc4, 0xdb, 0xee, 0x73, 0xde, 0x4f, 0x69, 0x87, 0x64,
-	0x49, 0x87, 0x30, 0xc6, 0x25, 0x91, 0x09, 0x67, 0xb9, 0xf9, 0x8a, 0xb7, 0x72, 0xc1, 0x07, 0x83,
-	0xf1, 0x82, 0xe0, 0x7b, 0x0d, 0xf0, 0xa1, 0xa0, 0x44, 0xd2, 0x67, 0x13, 0xb1, 0x88, 0xbe, 0x7b,
-	0x4f, 0x73, 0x89, 0x30, 0x2c, 0xc6, 0x74, 0xf8, 0x30, 0x8e, 0x05, 0x76, 0x7c, 0xa7, 0xb5, 0x14,
-	0x8d, 0x21, 0xda, 0x02, 0x97, 0x64, 0xd9, 0xa3, 0xb3, 0x13, 0x3c, 0xa7, 0x09, 0x8b, 0x54, 0x3d,
-	0xa6, 0x43, 0x55, 0xaf, 0x99, 0xba, 0x41, 0x4a, 0x89, 0x64, 0xd9, 0xe9, 0x63, 0x7a, 0x89, 0xe7,
-	0x8d, 0x92, 0x85, 0x8a, 0x61, 0xa3, 0xb7, 0x9a, 0x59, 0x30, 0x8c, 0x85, 0x4a, 0xab, 0x77, 0xc8,
-	0xe4, 0x59, 0x86, 0x5d, 0xdf, 0x69, 0xad, 0x46, 0x16, 0x21, 0x0f, 0xea, 0xea, 0x53, 0xc8, 0x47,
-	0x0c, 0x2f, 0x6a, 0xe6, 0x0a, 0x2b, 0x35, 0x51, 0x84, 0x34, 0x25, 0x97, 0xb8, 0xae, 0xa9, 0x31,
-	0x44, 0x3e, 0x2c, 0x8b, 0xe2, 0x6e, 0x18, 0x3d, 0xef, 0xf5, 0x72, 0x2a, 0xf1, 0x92, 0x66, 0xa7,
-	0x4b, 0xca, 0xef, 0xe2, 0xe8, 0x49, 0x92, 0x4b, 0x0c, 0x7e, 0x4d, 0xf9, 0x19, 0x84, 0xf6, 0xa1,
-	0x2e, 0x8a, 0x57, 0x09, 0x8b, 0xf9, 0x08, 0x2f, 0xfb, 0x4e, 0x6b, 0xad, 0xbb, 0xda, 0x26, 0x59,
-	0xd2, 0x8e, 0x5e, 0x9b, 0x62, 0x74, 0x45, 0xa3, 0xff, 0x61, 0x41, 0x14, 0xdd, 0x30, 0xc2, 0x2b,
-	0x5a, 0xde, 0x00, 0xb4, 0x0d, 0x4b, 0x82, 0xa6, 0xa4, 0x38, 0x3a, 0x64, 0x12, 0xaf, 0xfa, 0x4e,
-	0xab, 0x1e, 0x4d, 0x0a, 0x6a, 0x63, 0x24, 0x16, 0x27, 0x4c, 0x52, 0x31, 0x24, 0x29, 0x5e, 0x33,
-	0x1b, 0x9b, 0x2a, 0xa1, 0x36, 0xa0, 0x84, 0xe5, 0x92, 0xa4, 0xa9, 0x0e, 0xf2, 0x29, 0x11, 0xfd,
-	0x84, 0xe1, 0xff, 0x7c, 0xa7, 0xe5, 0x44, 0x25, 0x4c, 0xd0, 0x84, 0x46, 0x49, 0xa4, 0x79, 0xc6,
-	0x59, 0x4e, 0x83, 0x0e, 0x6c, 0x1e, 0x53, 0x59, 0x12, 0xf6, 0x24, 0x3a, 0x67, 0x3a, 0xba, 0xe0,
-	0xcb, 0x3c, 0x6c, 0xcd, 0xae, 0x30, 0x5a, 0x37, 0xf7, 0xe3, 0xaf, 0xbc, 0x1f, 0xba, 0xa5, 0xe7,
-	0x2f, 0x05, 0x61, 0x39, 0x5e, 0x37, 0x4d, 0xb0, 0x50, 0x31, 0xb2, 0x78, 0xc1, 0x47, 0x54, 0xe0,
-	0x0d, 0xc3, 0x58, 0xa8, 0xe7, 0xc4, 0x59, 0x16, 0xdf, 0xcc, 0x89, 0x7f, 0x6b, 0x4e, 0x94, 0x44,
-	0x6a, 0xe7, 0x44, 0x17, 0x70, 0x48, 0x53, 0x5a, 0x9a, 0x77, 0xd5, 0xa8, 0x68, 0x42, 0xa3, 0x64,
-	0x8d, 0x15, 0x6c, 0xc0, 0xad, 0x63, 0x2a, 0x23, 0xc2, 0x62, 0x3e, 0x08, 0xcd, 0xf5, 0xb0, 0x7a,
-	0xc1, 0x7d, 0xc0, 0xd7, 0xa9, 0x3f, 0xcd, 0x98, 0xee, 0xb7, 0x79, 0x58, 0x9e, 0x32, 0x42, 0x31,
-	0xb8, 0x66, 0xec, 0xa1, 0x1d, 0xdd, 0xf7, 0xaa, 0x67, 0xcd, 0xdb, 0xad, 0xa2, 0xed, 0x4e, 0x9b,
-	0x9f, 0x7f, 0xfc, 0xfc, 0x3a, 0xb7, 0x19, 0xac, 0xeb, 0x17, 0x74, 0xea, 0x91, 0x7d, 0xe0, 0x1c,
-	0x20, 0x02, 0xb5, 0x63, 0x2a, 0x91, 0xa7, 0x35, 0x4a, 0x27, 0xa9, 0xd7, 0x2c, 0xe5, 0xac, 0xf8,
-	0x9e, 0x16, 0x6f, 0xa2, 0xc6, 0xac, 0x78, 0xe7, 0xa3, 0xe9, 0xe2, 0x27, 0x34, 0x00, 0xd7, 0xe4,
-	0x62, 0x0f, 0x52, 0xf5, 0xbb, 0xb3, 0x07, 0xa9, 0xce, 0xf0, 0x8e, 0xf6, 0xda, 0xf5, 0xaa, 0xbd,
-	0xd4, 0x89, 0xde, 0x80, 0x6b, 0x52, 0xb3, 0x76, 0x55, 0xb1, 0x5b, 0xbb, 0xea, 0x84, 0xed, 0xd1,
-	0x0e, 0x7e, 0x73, 0xb4, 0x0f, 0xb0, 0x3e, 0x9b, 0x34, 0xda, 0x1e, 0xb7, 0xab, 0xec, 0x6e, 0x78,
-	0x3b, 0x15, 0xac, 0xf5, 0xdc, 0xd7, 0x9e, 0xb7, 0x83, 0xbd, 0x6b, 0x9e, 0xfd, 0x99, 0x25, 0xe7,
-	0xae, 0xfe, 0xc7, 0x73, 0xef, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0x8d, 0x0c, 0x3f, 0xd6, 0x37,
-	0x09, 0x00, 0x00,
+	// 695 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0xcd, 0x4e, 0xdb, 0x4c,
+	0x14, 0x95, 0x49, 0x08, 0xc9, 0x84, 0x40, 0x18, 0x7d, 0xf0, 0x4d, 0x1c, 0x88, 0x8c, 0xdb, 0x85,
+	0x61, 0x91, 0xa8, 0x69, 0x57, 0x74, 0x55, 0x11, 0x40, 0x08, 0xfa, 0xa3, 0xa1, 0xa8, 0xdd, 0x0e,
+	0x78, 0x88, 0xdc, 0x38, 0x33, 0xae, 0x3d, 0xcd, 0x4f, 0xab, 0x6e, 0xba, 0xef, 0xaa, 0xcf, 0xd1,
+	0x67, 0xe8, 0x43, 0xf4, 0x15, 0xfa, 0x20, 0xd5, 0xfc, 0x18, 0xd2, 0x60, 0xb7, 0xdd, 0x56, 0x62,
+	0xe7, 0x73, 0x8f, 0xe7, 0xdc, 0x7b, 0xe7, 0x9e, 0xdc, 0x18, 0xac, 0x31, 0xee, 0xd3, 0x33, 0x9a,
+	0x24, 0x01, 0x67, 0xed, 0x28, 0xe6, 0x82, 0xc3, 0x02, 0x89, 0x02, 0x7b, 0xb3, 0xcf, 0x79, 0x3f,
+	0xa4, 0x1d, 0x12, 0x05, 0x1d, 0xc2, 0x18, 0x17, 0x44, 0x04, 0x9c, 0x25, 0xfa, 0x15, 0x7b, 0xf9,
+	0x92, 0x0f, 0x87, 0xe9, 0x01, 0xf7, 0x31, 0xa8, 0x9e, 0xd0, 0xe9, 0x01, 0x1b, 0xd1, 0x90, 0x47,
+	0x14, 0xda, 0xa0, 0x3c, 0xa0, 0x83, 0x53, 0x72, 0x41, 0x43, 0x64, 0x39, 0x96, 0x57, 0xc1, 0xd7,
+	0x18, 0xd6, 0x41, 0x61, 0x40, 0xa7, 0x68, 0xc1, 0xb1, 0xbc, 0x65, 0x2c, 0x1f, 0xdd, 0xcf, 0x45,
+	0x80, 0xf6, 0x63, 0x4a, 0x04, 0x7d, 0x76, 0x53, 0x09, 0xa6, 0x6f, 0xdf, 0xd1, 0x44, 0x40, 0x04,
+	0x96, 0x7c, 0x3a, 0x7a, 0xe2, 0xfb, 0xb1, 0x51, 0x4a, 0x21, 0xdc, 0x00, 0x25, 0x12, 0x45, 0x07,
+	0xe7, 0xc7, 0x4a, 0xab, 0x82, 0x0d, 0x92, 0x71, 0x9f, 0x8e, 0x64, 0xbc, 0xa0, 0xe3, 0x1a, 0x49,
+	0x25, 0x12, 0x45, 0x67, 0x27, 0x74, 0x8a, 0x8a, 0x5a, 0xc9, 0x40, 0xc9, 0xb0, 0xf1, 0x40, 0x31,
+	0x8b, 0x9a, 0x31, 0x50, 0x6a, 0x5d, 0xed, 0x33, 0x71, 0x1e, 0xa1, 0x92, 0x63, 0x79, 0x35, 0x6c,
+	0x90, 0x6c, 0x50, 0x3e, 0xf5, 0xf8, 0x98, 0xa1, 0x25, 0xc5, 0x5c, 0x63, 0xa9, 0x16, 0x4f, 0x7a,
+	0x34, 0x24, 0x53, 0x54, 0x56, 0x54, 0x0a, 0xa1, 0x03, 0xaa, 0xf1, 0xe4, 0x41, 0x0f, 0x3f, 0xbf,
+	0xba, 0x4a, 0xa8, 0x40, 0x15, 0xc5, 0xce, 0x86, 0x64, 0xbe, 0xcb, 0xc3, 0xd3, 0x20, 0x11, 0x08,
+	0x38, 0x05, 0x99, 0x4f, 0x23, 0xb8, 0x03, 0xca, 0xf1, 0xe4, 0x55, 0xc0, 0x7c, 0x3e, 0x46, 0x55,
+	0xc7, 0xf2, 0x56, 0xba, 0xb5, 0x36, 0x89, 0x82, 0x36, 0x7e, 0xad, 0x83, 0xf8, 0x9a, 0x86, 0xff,
+	0x81, 0xc5, 0x78, 0xd2, 0xed, 0x61, 0xb4, 0xac, 0xe4, 0x35, 0x80, 0x9b, 0xa0, 0x12, 0xd3, 0x90,
+	0x4c, 0x0e, 0xf7, 0x99, 0x40, 0x35, 0xc7, 0xf2, 0xca, 0xf8, 0x26, 0x20, 0x0b, 0x23, 0x7e, 0x7c,
+	0xcc, 0x04, 0x8d, 0x47, 0x24, 0x44, 0x2b, 0xba, 0xb0, 0x99, 0x10, 0x6c, 0x03, 0x18, 0xb0, 0x44,
+	0x90, 0x30, 0x54, 0x2e, 0x78, 0x4a, 0xe2, 0x7e, 0xc0, 0xd0, 0xaa, 0x63, 0x79, 0x16, 0xce, 0x60,
+	0xe0, 0x1e, 0x58, 0x35, 0xb7, 0x9b, 0x9a, 0x02, 0xd5, 0x1d, 0xcb, 0xab, 0x76, 0xeb, 0xaa, 0xee,
+	0x99, 0x38, 0x9e, 0x7f, 0xd1, 0x6d, 0x82, 0x46, 0x86, 0x1d, 0x92, 0x88, 0xb3, 0x84, 0xba, 0x1d,
+	0xb0, 0x7e, 0x44, 0x45, 0x86, 0x51, 0x6e, 0xc6, 0x6e, 0xcd, 0x8e, 0xdd, 0xfd, 0x56, 0x04, 0x1b,
+	0xf3, 0x27, 0xb4, 0xd6, 0x9d, 0xb7, 0xfe, 0x4d, 0x6f, 0xc9, 0x2b, 0xbd, 0x78, 0x19, 0x13, 0x96,
+	0x28, 0x4f, 0xd5, 0x70, 0x0a, 0x25, 0x23, 0x26, 0x2f, 0xf8, 0x98, 0xc6, 0x68, 0x4d, 0x33, 0x06,
+	0x66, 0xf9, 0x11, 0xfe, 0xad, 0x1f, 0xe5, 0x7e, 0x3a, 0x8f, 0xfc, 0xbb, 0xfd, 0x74, 0xb7, 0x9f,
+	0xd2, 0xfd, 0x94, 0x61, 0x07, 0xb3, 0x9f, 0xba, 0x00, 0xf5, 0x68, 0x48, 0x33, 0xbd, 0x92, 0xb7,
+	0xa2, 0x9a, 0xa0, 0x91, 0x71, 0xc6, 0x08, 0x36, 0xc0, 0xff, 0x47, 0x54, 0x60, 0xc2, 0x7c, 0x3e,
+	0xec, 0x69, 0x6b, 0x19, 0x3d, 0xf7, 0x11, 0x40, 0xb7, 0xa9, 0x3f, 0xed, 0xb6, 0xee, 0xd7, 0x22,
+	0xa8, 0xce, 0x24, 0x82, 0x3e, 0x28, 0xe9, 0x75, 0x0b, 0xb7, 0x54, 0xef, 0x79, 0x7f, 0xc5, 0x76,
+	0x2b, 0x8f, 0x36, 0x95, 0x36, 0x3f, 0x7d, 0xff, 0xf1, 0x65, 0x61, 0xdd, 0xad, 0xab, 0x4f, 0x86,
+	0x99, 0xaf, 0x8a, 0x3d, 0x6b, 0x17, 0x12, 0x50, 0x38, 0xa2, 0x02, 0xda, 0x4a, 0x23, 0x73, 0x83,
+	0xdb, 0xcd, 0x4c, 0xce, 0x88, 0x6f, 0x2b, 0xf1, 0x26, 0x6c, 0xcc, 0x8b, 0x77, 0x3e, 0xe8, 0x5b,
+	0xfc, 0x08, 0x87, 0xa0, 0xa4, 0xe7, 0x62, 0x1a, 0xc9, 0xfb, 0xcd, 0xda, 0xad, 0x3c, 0xda, 0xe4,
+	0xba, 0xaf, 0x72, 0xb5, 0xec, 0xfc, 0x5c, 0xb2, 0xa3, 0x37, 0xa0, 0xa4, 0xa7, 0x66, 0xd2, 0xe5,
+	0x8d, 0xdd, 0x6e, 0xe5, 0xd1, 0xbf, 0xb6, 0xb6, 0xfb, 0x9b, 0xd6, 0xde, 0x83, 0xfa, 0xfc, 0xa4,
+	0xe1, 0x66, 0x7a, 0x5d, 0x59, 0xde, 0xb0, 0xb7, 0x72, 0x58, 0x93, 0x73, 0x47, 0xe5, 0xbc, 0xe7,
+	0x6e, 0xdf, 0xca, 0xd9, 0x9f, 0x3b, 0x72, 0x51, 0x52, 0x9f, 0x78, 0x0f, 0x7f, 0x0e, 0x00, 0x3c,
+	0x43, 0x1c, 0xa4, 0x28, 0x0a, 0x00, 0x00,
 }
@@ -0,0 +1,642 @@
+// Code generated by protoc-gen-go.
+// source: notificationPreference.proto
+// DO NOT EDIT!
+
+package api
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import _ "github.com/grpc-ecosystem/grpc-gateway/third_party/googleapis/google/api"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+type CreateNotificationPreferenceRequest struct {
+	// name of the user (the subject of the JWT token)
+	Username string `protobuf:"bytes,1,opt,name=username" json:"username,omitempty"`
+	// alert types to notify about (LINK_QUALITY, GATEWAY_DUTY_CYCLE, BATTERY_LOW, LINK_MARGIN_LOW)
+	AlertTypes []string `protobuf:"bytes,2,rep,name=alertTypes" json:"alertTypes,omitempty"`
+	// channels to notify over (EMAIL, WEBHOOK, SLACK)
+	Channels []string `protobuf:"bytes,3,rep,name=channels" json:"channels,omitempty"`
+	// hex encoded AppEUIs of the applications to receive node alerts for
+	Applications []string `protobuf:"bytes,4,rep,name=applications" json:"applications,omitempty"`
+	// hex encoded MACs of the gateways to receive gateway alerts for ("*" for all gateways)
+	Gateways []string `protobuf:"bytes,5,rep,name=gateways" json:"gateways,omitempty"`
+	// email address (EMAIL channel)
+	Email string `protobuf:"bytes,6,opt,name=email" json:"email,omitempty"`
+	// webhook url (WEBHOOK channel)
+	WebhookURL string `protobuf:"bytes,7,opt,name=webhookURL" json:"webhookURL,omitempty"`
+	// slack incoming webhook url (SLACK channel)
+	SlackWebhookURL string `protobuf:"bytes,8,opt,name=slackWebhookURL" json:"slackWebhookURL,omitempty"`
+	// start of the quiet hours (HH:MM, empty when not set)
+	QuietHoursStart string `protobuf:"bytes,9,opt,name=quietHoursStart" json:"quietHoursStart,omitempty"`
+	// end of the quiet hours (HH:MM, empty when not set)
+	QuietHoursEnd string `protobuf:"bytes,10,opt,name=quietHoursEnd" json:"quietHoursEnd,omitempty"`
+	// IANA timezone of the quiet hours (e.g. Europe/Amsterdam, default UTC)
+	Timezone string `protobuf:"bytes,11,opt,name=timezone" json:"timezone,omitempty"`
+}
+
+func (m *CreateNotificationPreferenceRequest) Reset()         { *m = CreateNotificationPreferenceRequest{} }
+func (m *CreateNotificationPreferenceRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateNotificationPreferenceRequest) ProtoMessage()    {}
+func (*CreateNotificationPreferenceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor10, []int{0}
+}
+
+func (m *CreateNotificationPreferenceRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *CreateNotificationPreferenceRequest) GetAlertTypes() []string {
+	if m != nil {
+		return m.AlertTypes
+	}
+	return nil
+}
+
+func (m *CreateNotificationPreferenceRequest) GetChannels() []string {
+	if m != nil {
+		return m.Channels
+	}
+	return nil
+}
+
+func (m *CreateNotificationPreferenceRequest) GetApplications() []string {
+	if m != nil {
+		return m.Applications
+	}
+	return nil
+}
+
+func (m *CreateNotificationPreferenceRequest) GetGateways() []string {
+	if m != nil {
+		return m.Gateways
+	}
+	return nil
+}
+
+func (m *CreateNotificationPreferenceRequest) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+func (m *CreateNotificationPreferenceRequest) GetWebhookURL() string {
+	if m != nil {
+		return m.WebhookURL
+	}
+	return ""
+}
+
+func (m *CreateNotificationPreferenceRequest) GetSlackWebhookURL() string {
+	if m != nil {
+		return m.SlackWebhookURL
+	}
+	return ""
+}
+
+func (m *CreateNotificationPreferenceRequest) GetQuietHoursStart() string {
+	if m != nil {
+		return m.QuietHoursStart
+	}
+	return ""
+}
+
+func (m *CreateNotificationPreferenceRequest) GetQuietHoursEnd() string {
+	if m != nil {
+		return m.QuietHoursEnd
+	}
+	return ""
+}
+
+func (m *CreateNotificationPreferenceRequest) GetTimezone() string {
+	if m != nil {
+		return m.Timezone
+	}
+	return ""
+}
+
+type CreateNotificationPreferenceResponse struct {
+}
+
+func (m *CreateNotificationPreferenceResponse) Reset()         { *m = CreateNotificationPreferenceResponse{} }
+func (m *CreateNotificationPreferenceResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateNotificationPreferenceResponse) ProtoMessage()    {}
+func (*CreateNotificationPreferenceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor10, []int{1}
+}
+
+type GetNotificationPreferenceRequest struct {
+	// name of the user
+	Username string `protobuf:"bytes,1,opt,name=username" json:"username,omitempty"`
+}
+
+func (m *GetNotificationPreferenceRequest) Reset()         { *m = GetNotificationPreferenceRequest{} }
+func (m *GetNotificationPreferenceRequest) String() string { return proto.CompactTextString(m) }
+func (*GetNotificationPreferenceRequest) ProtoMessage()    {}
+func (*GetNotificationPreferenceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor10, []int{2}
+}
+
+func (m *GetNotificationPreferenceRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+type GetNotificationPreferenceResponse struct {
+	// name of the user (the subject of the JWT token)
+	Username string `protobuf:"bytes,1,opt,name=username" json:"username,omitempty"`
+	// alert types to notify about (LINK_QUALITY, GATEWAY_DUTY_CYCLE, BATTERY_LOW, LINK_MARGIN_LOW)
+	AlertTypes []string `protobuf:"bytes,2,rep,name=alertTypes" json:"alertTypes,omitempty"`
+	// channels to notify over (EMAIL, WEBHOOK, SLACK)
+	Channels []string `protobuf:"bytes,3,rep,name=channels" json:"channels,omitempty"`
+	// hex encoded AppEUIs of the applications to receive node alerts for
+	Applications []string `protobuf:"bytes,4,rep,name=applications" json:"applications,omitempty"`
+	// hex encoded MACs of the gateways to receive gateway alerts for ("*" for all gateways)
+	Gateways []string `protobuf:"bytes,5,rep,name=gateways" json:"gateways,omitempty"`
+	// email address (EMAIL channel)
+	Email string `protobuf:"bytes,6,opt,name=email" json:"email,omitempty"`
+	// webhook url (WEBHOOK channel)
+	WebhookURL string `protobuf:"bytes,7,opt,name=webhookURL" json:"webhookURL,omitempty"`
+	// slack incoming webhook url (SLACK channel)
+	SlackWebhookURL string `protobuf:"bytes,8,opt,name=slackWebhookURL" json:"slackWebhookURL,omitempty"`
+	// start of the quiet hours (HH:MM, empty when not set)
+	QuietHoursStart string `protobuf:"bytes,9,opt,name=quietHoursStart" json:"quietHoursStart,omitempty"`
+	// end of the quiet hours (HH:MM, empty when not set)
+	QuietHoursEnd string `protobuf:"bytes,10,opt,name=quietHoursEnd" json:"quietHoursEnd,omitempty"`
+	// IANA timezone of the quiet hours (e.g. Europe/Amsterdam, default UTC)
+	Timezone string `protobuf:"bytes,11,opt,name=timezone" json:"timezone,omitempty"`
+}
+
+func (m *GetNotificationPreferenceResponse) Reset()         { *m = GetNotificationPreferenceResponse{} }
+func (m *GetNotificationPreferenceResponse) String() string { return proto.CompactTextString(m) }
+func (*GetNotificationPreferenceResponse) ProtoMessage()    {}
+func (*GetNotificationPreferenceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor10, []int{3}
+}
+
+func (m *GetNotificationPreferenceResponse) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *GetNotificationPreferenceResponse) GetAlertTypes() []string {
+	if m != nil {
+		return m.AlertTypes
+	}
+	return nil
+}
+
+func (m *GetNotificationPreferenceResponse) GetChannels() []string {
+	if m != nil {
+		return m.Channels
+	}
+	return nil
+}
+
+func (m *GetNotificationPreferenceResponse) GetApplications() []string {
+	if m != nil {
+		return m.Applications
+	}
+	return nil
+}
+
+func (m *GetNotificationPreferenceResponse) GetGateways() []string {
+	if m != nil {
+		return m.Gateways
+	}
+	return nil
+}
+
+func (m *GetNotificationPreferenceResponse) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+func (m *GetNotificationPreferenceResponse) GetWebhookURL() string {
+	if m != nil {
+		return m.WebhookURL
+	}
+	return ""
+}
+
+func (m *GetNotificationPreferenceResponse) GetSlackWebhookURL() string {
+	if m != nil {
+		return m.SlackWebhookURL
+	}
+	return ""
+}
+
+func (m *GetNotificationPreferenceResponse) GetQuietHoursStart() string {
+	if m != nil {
+		return m.QuietHoursStart
+	}
+	return ""
+}
+
+func (m *GetNotificationPreferenceResponse) GetQuietHoursEnd() string {
+	if m != nil {
+		return m.QuietHoursEnd
+	}
+	return ""
+}
+
+func (m *GetNotificationPreferenceResponse) GetTimezone() string {
+	if m != nil {
+		return m.Timezone
+	}
+	return ""
+}
+
+type UpdateNotificationPreferenceRequest struct {
+	// name of the user (the subject of the JWT token)
+	Username string `protobuf:"bytes,1,opt,name=username" json:"username,omitempty"`
+	// alert types to notify about (LINK_QUALITY, GATEWAY_DUTY_CYCLE, BATTERY_LOW, LINK_MARGIN_LOW)
+	AlertTypes []string `protobuf:"bytes,2,rep,name=alertTypes" json:"alertTypes,omitempty"`
+	// channels to notify over (EMAIL, WEBHOOK, SLACK)
+	Channels []string `protobuf:"bytes,3,rep,name=channels" json:"channels,omitempty"`
+	// hex encoded AppEUIs of the applications to receive node alerts for
+	Applications []string `protobuf:"bytes,4,rep,name=applications" json:"applications,omitempty"`
+	// hex encoded MACs of the gateways to receive gateway alerts for ("*" for all gateways)
+	Gateways []string `protobuf:"bytes,5,rep,name=gateways" json:"gateways,omitempty"`
+	// email address (EMAIL channel)
+	Email string `protobuf:"bytes,6,opt,name=email" json:"email,omitempty"`
+	// webhook url (WEBHOOK channel)
+	WebhookURL string `protobuf:"bytes,7,opt,name=webhookURL" json:"webhookURL,omitempty"`
+	// slack incoming webhook url (SLACK channel)
+	SlackWebhookURL string `protobuf:"bytes,8,opt,name=slackWebhookURL" json:"slackWebhookURL,omitempty"`
+	// start of the quiet hours (HH:MM, empty when not set)
+	QuietHoursStart string `protobuf:"bytes,9,opt,name=quietHoursStart" json:"quietHoursStart,omitempty"`
+	// end of the quiet hours (HH:MM, empty when not set)
+	QuietHoursEnd string `protobuf:"bytes,10,opt,name=quietHoursEnd" json:"quietHoursEnd,omitempty"`
+	// IANA timezone of the quiet hours (e.g. Europe/Amsterdam, default UTC)
+	Timezone string `protobuf:"bytes,11,opt,name=timezone" json:"timezone,omitempty"`
+}
+
+func (m *UpdateNotificationPreferenceRequest) Reset()         { *m = UpdateNotificationPreferenceRequest{} }
+func (m *UpdateNotificationPreferenceRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateNotificationPreferenceRequest) ProtoMessage()    {}
+func (*UpdateNotificationPreferenceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor10, []int{4}
+}
+
+func (m *UpdateNotificationPreferenceRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *UpdateNotificationPreferenceRequest) GetAlertTypes() []string {
+	if m != nil {
+		return m.AlertTypes
+	}
+	return nil
+}
+
+func (m *UpdateNotificationPreferenceRequest) GetChannels() []string {
+	if m != nil {
+		return m.Channels
+	}
+	return nil
+}
+
+func (m *UpdateNotificationPreferenceRequest) GetApplications() []string {
+	if m != nil {
+		return m.Applications
+	}
+	return nil
+}
+
+func (m *UpdateNotificationPreferenceRequest) GetGateways() []string {
+	if m != nil {
+		return m.Gateways
+	}
+	return nil
+}
+
+func (m *UpdateNotificationPreferenceRequest) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+func (m *UpdateNotificationPreferenceRequest) GetWebhookURL() string {
+	if m != nil {
+		return m.WebhookURL
+	}
+	return ""
+}
+
+func (m *UpdateNotificationPreferenceRequest) GetSlackWebhookURL() string {
+	if m != nil {
+		return m.SlackWebhookURL
+	}
+	return ""
+}
+
+func (m *UpdateNotificationPreferenceRequest) GetQuietHoursStart() string {
+	if m != nil {
+		return m.QuietHoursStart
+	}
+	return ""
+}
+
+func (m *UpdateNotificationPreferenceRequest) GetQuietHoursEnd() string {
+	if m != nil {
+		return m.QuietHoursEnd
+	}
+	return ""
+}
+
+func (m *UpdateNotificationPreferenceRequest) GetTimezone() string {
+	if m != nil {
+		return m.Timezone
+	}
+	return ""
+}
+
+type UpdateNotificationPreferenceResponse struct {
+}
+
+func (m *UpdateNotificationPreferenceResponse) Reset()         { *m = UpdateNotificationPreferenceResponse{} }
+func (m *UpdateNotificationPreferenceResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateNotificationPreferenceResponse) ProtoMessage()    {}
+func (*UpdateNotificationPreferenceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor10, []int{5}
+}
+
+type DeleteNotificationPreferenceRequest struct {
+	// name of the user
+	Username string `protobuf:"bytes,1,opt,name=username" json:"username,omitempty"`
+}
+
+func (m *DeleteNotificationPreferenceRequest) Reset()         { *m = DeleteNotificationPreferenceRequest{} }
+func (m *DeleteNotificationPreferenceRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteNotificationPreferenceRequest) ProtoMessage()    {}
+func (*DeleteNotificationPreferenceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor10, []int{6}
+}
+
+func (m *DeleteNotificationPreferenceRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+type DeleteNotificationPreferenceResponse struct {
+}
+
+func (m *DeleteNotificationPreferenceResponse) Reset()         { *m = DeleteNotificationPreferenceResponse{} }
+func (m *DeleteNotificationPreferenceResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteNotificationPreferenceResponse) ProtoMessage()    {}
+func (*DeleteNotificationPreferenceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor10, []int{7}
+}
+
+func init() {
+	proto.RegisterType((*CreateNotificationPreferenceRequest)(nil), "api.CreateNotificationPreferenceRequest")
+	proto.RegisterType((*CreateNotificationPreferenceResponse)(nil), "api.CreateNotificationPreferenceResponse")
+	proto.RegisterType((*GetNotificationPreferenceRequest)(nil), "api.GetNotificationPreferenceRequest")
+	proto.RegisterType((*GetNotificationPreferenceResponse)(nil), "api.GetNotificationPreferenceResponse")
+	proto.RegisterType((*UpdateNotificationPreferenceRequest)(nil), "api.UpdateNotificationPreferenceRequest")
+	proto.RegisterType((*UpdateNotificationPreferenceResponse)(nil), "api.UpdateNotificationPreferenceResponse")
+	proto.RegisterType((*DeleteNotificationPreferenceRequest)(nil), "api.DeleteNotificationPreferenceRequest")
+	proto.RegisterType((*DeleteNotificationPreferenceResponse)(nil), "api.DeleteNotificationPreferenceResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for NotificationPreference service
+
+type NotificationPreferenceClient interface {
+	// Create creates the notification preferences of the given user.
+	Create(ctx context.Context, in *CreateNotificationPreferenceRequest, opts ...grpc.CallOption) (*CreateNotificationPreferenceResponse, error)
+	// Get returns the notification preferences of the given user.
+	Get(ctx context.Context, in *GetNotificationPreferenceRequest, opts ...grpc.CallOption) (*GetNotificationPreferenceResponse, error)
+	// Update updates the notification preferences of the given user.
+	Update(ctx context.Context, in *UpdateNotificationPreferenceRequest, opts ...grpc.CallOption) (*UpdateNotificationPreferenceResponse, error)
+	// Delete deletes the notification preferences of the given user.
+	Delete(ctx context.Context, in *DeleteNotificationPreferenceRequest, opts ...grpc.CallOption) (*DeleteNotificationPreferenceResponse, error)
+}
+
+type notificationPreferenceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewNotificationPreferenceClient(cc *grpc.ClientConn) NotificationPreferenceClient {
+	return &notificationPreferenceClient{cc}
+}
+
+func (c *notificationPreferenceClient) Create(ctx context.Context, in *CreateNotificationPreferenceRequest, opts ...grpc.CallOption) (*CreateNotificationPreferenceResponse, error) {
+	out := new(CreateNotificationPreferenceResponse)
+	err := grpc.Invoke(ctx, "/api.NotificationPreference/Create", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationPreferenceClient) Get(ctx context.Context, in *GetNotificationPreferenceRequest, opts ...grpc.CallOption) (*GetNotificationPreferenceResponse, error) {
+	out := new(GetNotificationPreferenceResponse)
+	err := grpc.Invoke(ctx, "/api.NotificationPreference/Get", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationPreferenceClient) Update(ctx context.Context, in *UpdateNotificationPreferenceRequest, opts ...grpc.CallOption) (*UpdateNotificationPreferenceResponse, error) {
+	out := new(UpdateNotificationPreferenceResponse)
+	err := grpc.Invoke(ctx, "/api.NotificationPreference/Update", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationPreferenceClient) Delete(ctx context.Context, in *DeleteNotificationPreferenceRequest, opts ...grpc.CallOption) (*DeleteNotificationPreferenceResponse, error) {
+	out := new(DeleteNotificationPreferenceResponse)
+	err := grpc.Invoke(ctx, "/api.NotificationPreference/Delete", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for NotificationPreference service
+
+type NotificationPreferenceServer interface {
+	// Create creates the notification preferences of the given user.
+	Create(context.Context, *CreateNotificationPreferenceRequest) (*CreateNotificationPreferenceResponse, error)
+	// Get returns the notification preferences of the given user.
+	Get(context.Context, *GetNotificationPreferenceRequest) (*GetNotificationPreferenceResponse, error)
+	// Update updates the notification preferences of the given user.
+	Update(context.Context, *UpdateNotificationPreferenceRequest) (*UpdateNotificationPreferenceResponse, error)
+	// Delete deletes the notification preferences of the given user.
+	Delete(context.Context, *DeleteNotificationPreferenceRequest) (*DeleteNotificationPreferenceResponse, error)
+}
+
+func RegisterNotificationPreferenceServer(s *grpc.Server, srv NotificationPreferenceServer) {
+	s.RegisterService(&_NotificationPreference_serviceDesc, srv)
+}
+
+func _NotificationPreference_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateNotificationPreferenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationPreferenceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.NotificationPreference/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationPreferenceServer).Create(ctx, req.(*CreateNotificationPreferenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationPreference_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNotificationPreferenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationPreferenceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.NotificationPreference/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationPreferenceServer).Get(ctx, req.(*GetNotificationPreferenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationPreference_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateNotificationPreferenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationPreferenceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.NotificationPreference/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationPreferenceServer).Update(ctx, req.(*UpdateNotificationPreferenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationPreference_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteNotificationPreferenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationPreferenceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.NotificationPreference/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationPreferenceServer).Delete(ctx, req.(*DeleteNotificationPreferenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _NotificationPreference_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.NotificationPreference",
+	HandlerType: (*NotificationPreferenceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _NotificationPreference_Create_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _NotificationPreference_Get_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _NotificationPreference_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _NotificationPreference_Delete_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "notificationPreference.proto",
+}
+
+func init() { proto.RegisterFile("notificationPreference.proto", fileDescriptor10) }
+
+var fileDescriptor10 = []byte{
+	// 492 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x55, 0x41, 0x6b, 0x14, 0x31,
+	0x14, 0x66, 0x3a, 0xed, 0xd8, 0x7d, 0x2a, 0x42, 0x10, 0x09, 0xc3, 0x22, 0xdb, 0x69, 0x6d, 0x77,
+	0x8b, 0xec, 0x42, 0xbd, 0x79, 0x10, 0x44, 0xa5, 0x1e, 0x44, 0x64, 0xb5, 0x78, 0x4e, 0xb7, 0xaf,
+	0xdb, 0xd0, 0xd9, 0x24, 0x4d, 0xb2, 0x94, 0x2a, 0x5e, 0x04, 0x15, 0x2f, 0x82, 0xf8, 0xbf, 0xbc,
+	0xf8, 0x17, 0xfc, 0x21, 0x92, 0x64, 0xb6, 0xed, 0x96, 0x65, 0x1a, 0x14, 0xa4, 0x87, 0x3d, 0xbe,
+	0x2f, 0x5f, 0xf2, 0x7d, 0xf3, 0xbe, 0x79, 0x09, 0x34, 0x85, 0xb4, 0x7c, 0x9f, 0x0f, 0x98, 0xe5,
+	0x52, 0xbc, 0xd2, 0xb8, 0x8f, 0x1a, 0xc5, 0x00, 0xbb, 0x4a, 0x4b, 0x2b, 0x49, 0xca, 0x14, 0xcf,
+	0x9b, 0x43, 0x29, 0x87, 0x25, 0xf6, 0x98, 0xe2, 0x3d, 0x26, 0x84, 0xb4, 0x9e, 0x6b, 0x02, 0xa5,
+	0xf8, 0x9a, 0xc2, 0xea, 0x13, 0x8d, 0xcc, 0xe2, 0xcb, 0x99, 0x27, 0xf5, 0xf1, 0x68, 0x8c, 0xc6,
+	0x92, 0x1c, 0x96, 0xc7, 0x06, 0xb5, 0x60, 0x23, 0xa4, 0x49, 0x2b, 0x69, 0x37, 0xfa, 0xa7, 0x35,
+	0xb9, 0x0b, 0xc0, 0x4a, 0xd4, 0xf6, 0xcd, 0x89, 0x42, 0x43, 0x17, 0x5a, 0x69, 0xbb, 0xd1, 0x3f,
+	0x87, 0xb8, 0xbd, 0x83, 0x03, 0x26, 0x04, 0x96, 0x86, 0xa6, 0x7e, 0xf5, 0xb4, 0x26, 0x05, 0xdc,
+	0x60, 0x4a, 0x95, 0x95, 0xae, 0xa1, 0x8b, 0x7e, 0x7d, 0x0a, 0x73, 0xfb, 0x87, 0xcc, 0xe2, 0x31,
+	0x3b, 0x31, 0x74, 0x29, 0xec, 0x9f, 0xd4, 0xe4, 0x36, 0x2c, 0xe1, 0x88, 0xf1, 0x92, 0x66, 0xde,
+	0x54, 0x28, 0x9c, 0xa3, 0x63, 0xdc, 0x3d, 0x90, 0xf2, 0x70, 0xa7, 0xff, 0x82, 0x5e, 0xf3, 0x4b,
+	0xe7, 0x10, 0xd2, 0x86, 0x5b, 0xa6, 0x64, 0x83, 0xc3, 0xb7, 0x67, 0xa4, 0x65, 0x4f, 0xba, 0x08,
+	0x3b, 0xe6, 0xd1, 0x98, 0xa3, 0x7d, 0x2e, 0xc7, 0xda, 0xbc, 0xb6, 0x4c, 0x5b, 0xda, 0x08, 0xcc,
+	0x0b, 0x30, 0x59, 0x83, 0x9b, 0x67, 0xd0, 0x33, 0xb1, 0x47, 0xc1, 0xf3, 0xa6, 0x41, 0xf7, 0x2d,
+	0x96, 0x8f, 0xf0, 0x9d, 0x14, 0x48, 0xaf, 0x87, 0x3e, 0x4e, 0xea, 0x62, 0x1d, 0xd6, 0xea, 0xa3,
+	0x30, 0x4a, 0x0a, 0x83, 0xc5, 0x23, 0x68, 0x6d, 0xa3, 0xfd, 0xeb, 0xbc, 0x8a, 0x2f, 0x29, 0xac,
+	0xd4, 0x1c, 0x10, 0x54, 0xe6, 0x89, 0xff, 0x87, 0xc4, 0xdd, 0xf4, 0xed, 0xa8, 0xbd, 0xf9, 0xf4,
+	0x5d, 0x8d, 0xe9, 0xab, 0x8f, 0xa2, 0x9a, 0xbe, 0xc7, 0xb0, 0xfa, 0x14, 0x4b, 0xfc, 0x87, 0xc8,
+	0x9c, 0x54, 0xfd, 0x11, 0x41, 0x6a, 0xeb, 0xe7, 0x22, 0xdc, 0x99, 0x4d, 0x21, 0x9f, 0x13, 0xc8,
+	0xc2, 0x65, 0x41, 0xda, 0x5d, 0xa6, 0x78, 0x37, 0xe2, 0x12, 0xcf, 0x3b, 0x11, 0xcc, 0xea, 0x2b,
+	0x37, 0x3e, 0xfe, 0xfa, 0xfd, 0x63, 0x61, 0xa5, 0x68, 0xfa, 0x77, 0x63, 0xf6, 0x2b, 0x63, 0x1e,
+	0x26, 0x9b, 0xe4, 0x53, 0x02, 0xe9, 0x36, 0x5a, 0x72, 0xcf, 0x9f, 0x7d, 0xd9, 0xbd, 0x94, 0xaf,
+	0x5f, 0x46, 0xab, 0xf4, 0x7b, 0x5e, 0xbf, 0x43, 0x36, 0xea, 0xf4, 0x7b, 0xef, 0x27, 0x2d, 0xfd,
+	0x40, 0xbe, 0x27, 0x90, 0x85, 0xfc, 0xaa, 0x86, 0x44, 0xcc, 0x55, 0xde, 0x89, 0x60, 0x56, 0x86,
+	0xb6, 0xbc, 0xa1, 0xfb, 0x79, 0xac, 0x21, 0xd7, 0x9b, 0x6f, 0x09, 0x64, 0x21, 0xe8, 0xca, 0x53,
+	0xc4, 0x8f, 0x93, 0x77, 0x22, 0x98, 0xd3, 0x4d, 0xda, 0x8c, 0xf5, 0xb4, 0x9b, 0xf9, 0x47, 0xff,
+	0xc1, 0x9f, 0x01, 0x00, 0xc3, 0x5f, 0x30, 0xa9, 0x37, 0x08, 0x00, 0x00,
+}
@@ -0,0 +1,1173 @@
+// Code generated by protoc-gen-go.
+// source: organization.proto
+// DO NOT EDIT!
+
+package api
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import _ "github.com/grpc-ecosystem/grpc-gateway/third_party/googleapis/google/api"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+type OrganizationRole int32
+
+const (
+	// read the nodes of the applications
+	OrganizationRole_READ_ONLY OrganizationRole = 0
+	// manage the nodes of the applications
+	OrganizationRole_DEVICE_ADMIN OrganizationRole = 1
+	// all permissions within the organization
+	OrganizationRole_ADMIN OrganizationRole = 2
+)
+
+var OrganizationRole_name = map[int32]string{
+	0: "READ_ONLY",
+	1: "DEVICE_ADMIN",
+	2: "ADMIN",
+}
+var OrganizationRole_value = map[string]int32{
+	"READ_ONLY":    0,
+	"DEVICE_ADMIN": 1,
+	"ADMIN":        2,
+}
+
+func (x OrganizationRole) String() string {
+	return proto.EnumName(OrganizationRole_name, int32(x))
+}
+func (OrganizationRole) EnumDescriptor() ([]byte, []int) { return fileDescriptor19, []int{0} }
+
+type CreateOrganizationRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	// maximum number of nodes (0 = unlimited)
+	MaxNodes uint32 `protobuf:"varint,2,opt,name=maxNodes" json:"maxNodes,omitempty"`
+	// maximum number of downlink payloads enqueued per day, UTC (0 = unlimited)
+	MaxDownlinksPerDay uint32 `protobuf:"varint,3,opt,name=maxDownlinksPerDay" json:"maxDownlinksPerDay,omitempty"`
+}
+
+func (m *CreateOrganizationRequest) Reset()                    { *m = CreateOrganizationRequest{} }
+func (m *CreateOrganizationRequest) String() string            { return proto.CompactTextString(m) }
+func (*CreateOrganizationRequest) ProtoMessage()               {}
+func (*CreateOrganizationRequest) Descriptor() ([]byte, []int) { return fileDescriptor19, []int{0} }
+
+func (m *CreateOrganizationRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CreateOrganizationRequest) GetMaxNodes() uint32 {
+	if m != nil {
+		return m.MaxNodes
+	}
+	return 0
+}
+
+func (m *CreateOrganizationRequest) GetMaxDownlinksPerDay() uint32 {
+	if m != nil {
+		return m.MaxDownlinksPerDay
+	}
+	return 0
+}
+
+type CreateOrganizationResponse struct {
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *CreateOrganizationResponse) Reset()                    { *m = CreateOrganizationResponse{} }
+func (m *CreateOrganizationResponse) String() string            { return proto.CompactTextString(m) }
+func (*CreateOrganizationResponse) ProtoMessage()               {}
+func (*CreateOrganizationResponse) Descriptor() ([]byte, []int) { return fileDescriptor19, []int{1} }
+
+func (m *CreateOrganizationResponse) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type GetOrganizationRequest struct {
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *GetOrganizationRequest) Reset()                    { *m = GetOrganizationRequest{} }
+func (m *GetOrganizationRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetOrganizationRequest) ProtoMessage()               {}
+func (*GetOrganizationRequest) Descriptor() ([]byte, []int) { return fileDescriptor19, []int{2} }
+
+func (m *GetOrganizationRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type GetOrganizationResponse struct {
+	Id   int64  `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	// maximum number of nodes (0 = unlimited)
+	MaxNodes uint32 `protobuf:"varint,3,opt,name=maxNodes" json:"maxNodes,omitempty"`
+	// maximum number of downlink payloads enqueued per day, UTC (0 = unlimited)
+	MaxDownlinksPerDay uint32 `protobuf:"varint,4,opt,name=maxDownlinksPerDay" json:"maxDownlinksPerDay,omitempty"`
+	// number of nodes within the applications of the organization
+	NodeCount uint32 `protobuf:"varint,5,opt,name=nodeCount" json:"nodeCount,omitempty"`
+	// number of downlink payloads enqueued today (UTC)
+	DownlinkCount uint32 `protobuf:"varint,6,opt,name=downlinkCount" json:"downlinkCount,omitempty"`
+	CreatedAt     string `protobuf:"bytes,7,opt,name=createdAt" json:"createdAt,omitempty"`
+	UpdatedAt     string `protobuf:"bytes,8,opt,name=updatedAt" json:"updatedAt,omitempty"`
+}
+
+func (m *GetOrganizationResponse) Reset()                    { *m = GetOrganizationResponse{} }
+func (m *GetOrganizationResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetOrganizationResponse) ProtoMessage()               {}
+func (*GetOrganizationResponse) Descriptor() ([]byte, []int) { return fileDescriptor19, []int{3} }
+
+func (m *GetOrganizationResponse) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *GetOrganizationResponse) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *GetOrganizationResponse) GetMaxNodes() uint32 {
+	if m != nil {
+		return m.MaxNodes
+	}
+	return 0
+}
+
+func (m *GetOrganizationResponse) GetMaxDownlinksPerDay() uint32 {
+	if m != nil {
+		return m.MaxDownlinksPerDay
+	}
+	return 0
+}
+
+func (m *GetOrganizationResponse) GetNodeCount() uint32 {
+	if m != nil {
+		return m.NodeCount
+	}
+	return 0
+}
+
+func (m *GetOrganizationResponse) GetDownlinkCount() uint32 {
+	if m != nil {
+		return m.DownlinkCount
+	}
+	return 0
+}
+
+func (m *GetOrganizationResponse) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return ""
+}
+
+func (m *GetOrganizationResponse) GetUpdatedAt() string {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return ""
+}
+
+type ListOrganizationRequest struct {
+	Limit  int64 `protobuf:"varint,1,opt,name=limit" json:"limit,omitempty"`
+	Offset int64 `protobuf:"varint,2,opt,name=offset" json:"offset,omitempty"`
+}
+
+func (m *ListOrganizationRequest) Reset()                    { *m = ListOrganizationRequest{} }
+func (m *ListOrganizationRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListOrganizationRequest) ProtoMessage()               {}
+func (*ListOrganizationRequest) Descriptor() ([]byte, []int) { return fileDescriptor19, []int{4} }
+
+func (m *ListOrganizationRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListOrganizationRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+type ListOrganizationResponse struct {
+	TotalCount int64                      `protobuf:"varint,1,opt,name=totalCount" json:"totalCount,omitempty"`
+	Result     []*GetOrganizationResponse `protobuf:"bytes,2,rep,name=result" json:"result,omitempty"`
+}
+
+func (m *ListOrganizationResponse) Reset()                    { *m = ListOrganizationResponse{} }
+func (m *ListOrganizationResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListOrganizationResponse) ProtoMessage()               {}
+func (*ListOrganizationResponse) Descriptor() ([]byte, []int) { return fileDescriptor19, []int{5} }
+
+func (m *ListOrganizationResponse) GetTotalCount() int64 {
+	if m != nil {
+		return m.TotalCount
+	}
+	return 0
+}
+
+func (m *ListOrganizationResponse) GetResult() []*GetOrganizationResponse {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type UpdateOrganizationRequest struct {
+	Id   int64  `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	// maximum number of nodes (0 = unlimited)
+	MaxNodes uint32 `protobuf:"varint,3,opt,name=maxNodes" json:"maxNodes,omitempty"`
+	// maximum number of downlink payloads enqueued per day, UTC (0 = unlimited)
+	MaxDownlinksPerDay uint32 `protobuf:"varint,4,opt,name=maxDownlinksPerDay" json:"maxDownlinksPerDay,omitempty"`
+}
+
+func (m *UpdateOrganizationRequest) Reset()                    { *m = UpdateOrganizationRequest{} }
+func (m *UpdateOrganizationRequest) String() string            { return proto.CompactTextString(m) }
+func (*UpdateOrganizationRequest) ProtoMessage()               {}
+func (*UpdateOrganizationRequest) Descriptor() ([]byte, []int) { return fileDescriptor19, []int{6} }
+
+func (m *UpdateOrganizationRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *UpdateOrganizationRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *UpdateOrganizationRequest) GetMaxNodes() uint32 {
+	if m != nil {
+		return m.MaxNodes
+	}
+	return 0
+}
+
+func (m *UpdateOrganizationRequest) GetMaxDownlinksPerDay() uint32 {
+	if m != nil {
+		return m.MaxDownlinksPerDay
+	}
+	return 0
+}
+
+type UpdateOrganizationResponse struct {
+}
+
+func (m *UpdateOrganizationResponse) Reset()                    { *m = UpdateOrganizationResponse{} }
+func (m *UpdateOrganizationResponse) String() string            { return proto.CompactTextString(m) }
+func (*UpdateOrganizationResponse) ProtoMessage()               {}
+func (*UpdateOrganizationResponse) Descriptor() ([]byte, []int) { return fileDescriptor19, []int{7} }
+
+type DeleteOrganizationRequest struct {
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *DeleteOrganizationRequest) Reset()                    { *m = DeleteOrganizationRequest{} }
+func (m *DeleteOrganizationRequest) String() string            { return proto.CompactTextString(m) }
+func (*DeleteOrganizationRequest) ProtoMessage()               {}
+func (*DeleteOrganizationRequest) Descriptor() ([]byte, []int) { return fileDescriptor19, []int{8} }
+
+func (m *DeleteOrganizationRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type DeleteOrganizationResponse struct {
+}
+
+func (m *DeleteOrganizationResponse) Reset()                    { *m = DeleteOrganizationResponse{} }
+func (m *DeleteOrganizationResponse) String() string            { return proto.CompactTextString(m) }
+func (*DeleteOrganizationResponse) ProtoMessage()               {}
+func (*DeleteOrganizationResponse) Descriptor() ([]byte, []int) { return fileDescriptor19, []int{9} }
+
+type AddOrganizationApplicationRequest struct {
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	// hex encoded AppEUI of the application
+	AppEUI string `protobuf:"bytes,2,opt,name=appEUI" json:"appEUI,omitempty"`
+}
+
+func (m *AddOrganizationApplicationRequest) Reset()         { *m = AddOrganizationApplicationRequest{} }
+func (m *AddOrganizationApplicationRequest) String() string { return proto.CompactTextString(m) }
+func (*AddOrganizationApplicationRequest) ProtoMessage()    {}
+func (*AddOrganizationApplicationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor19, []int{10}
+}
+
+func (m *AddOrganizationApplicationRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *AddOrganizationApplicationRequest) GetAppEUI() string {
+	if m != nil {
+		return m.AppEUI
+	}
+	return ""
+}
+
+type AddOrganizationApplicationResponse struct {
+}
+
+func (m *AddOrganizationApplicationResponse) Reset()         { *m = AddOrganizationApplicationResponse{} }
+func (m *AddOrganizationApplicationResponse) String() string { return proto.CompactTextString(m) }
+func (*AddOrganizationApplicationResponse) ProtoMessage()    {}
+func (*AddOrganizationApplicationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor19, []int{11}
+}
+
+type RemoveOrganizationApplicationRequest struct {
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	// hex encoded AppEUI of the application
+	AppEUI string `protobuf:"bytes,2,opt,name=appEUI" json:"appEUI,omitempty"`
+}
+
+func (m *RemoveOrganizationApplicationRequest) Reset()         { *m = RemoveOrganizationApplicationRequest{} }
+func (m *RemoveOrganizationApplicationRequest) String() string { return proto.CompactTextString(m) }
+func (*RemoveOrganizationApplicationRequest) ProtoMessage()    {}
+func (*RemoveOrganizationApplicationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor19, []int{12}
+}
+
+func (m *RemoveOrganizationApplicationRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *RemoveOrganizationApplicationRequest) GetAppEUI() string {
+	if m != nil {
+		return m.AppEUI
+	}
+	return ""
+}
+
+type RemoveOrganizationApplicationResponse struct {
+}
+
+func (m *RemoveOrganizationApplicationResponse) Reset()         { *m = RemoveOrganizationApplicationResponse{} }
+func (m *RemoveOrganizationApplicationResponse) String() string { return proto.CompactTextString(m) }
+func (*RemoveOrganizationApplicationResponse) ProtoMessage()    {}
+func (*RemoveOrganizationApplicationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor19, []int{13}
+}
+
+type ListOrganizationApplicationsRequest struct {
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *ListOrganizationApplicationsRequest) Reset()         { *m = ListOrganizationApplicationsRequest{} }
+func (m *ListOrganizationApplicationsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListOrganizationApplicationsRequest) ProtoMessage()    {}
+func (*ListOrganizationApplicationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor19, []int{14}
+}
+
+func (m *ListOrganizationApplicationsRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type ListOrganizationApplicationsResponse struct {
+	// hex encoded AppEUIs of the applications
+	AppEUIs []string `protobuf:"bytes,1,rep,name=appEUIs" json:"appEUIs,omitempty"`
+}
+
+func (m *ListOrganizationApplicationsResponse) Reset()         { *m = ListOrganizationApplicationsResponse{} }
+func (m *ListOrganizationApplicationsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListOrganizationApplicationsResponse) ProtoMessage()    {}
+func (*ListOrganizationApplicationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor19, []int{15}
+}
+
+func (m *ListOrganizationApplicationsResponse) GetAppEUIs() []string {
+	if m != nil {
+		return m.AppEUIs
+	}
+	return nil
+}
+
+type AddOrganizationUserRequest struct {
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	// username (the subject of the API token)
+	Username string           `protobuf:"bytes,2,opt,name=username" json:"username,omitempty"`
+	Role     OrganizationRole `protobuf:"varint,3,opt,name=role,enum=api.OrganizationRole" json:"role,omitempty"`
+}
+
+func (m *AddOrganizationUserRequest) Reset()                    { *m = AddOrganizationUserRequest{} }
+func (m *AddOrganizationUserRequest) String() string            { return proto.CompactTextString(m) }
+func (*AddOrganizationUserRequest) ProtoMessage()               {}
+func (*AddOrganizationUserRequest) Descriptor() ([]byte, []int) { return fileDescriptor19, []int{16} }
+
+func (m *AddOrganizationUserRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *AddOrganizationUserRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *AddOrganizationUserRequest) GetRole() OrganizationRole {
+	if m != nil {
+		return m.Role
+	}
+	return OrganizationRole_READ_ONLY
+}
+
+type AddOrganizationUserResponse struct {
+}
+
+func (m *AddOrganizationUserResponse) Reset()                    { *m = AddOrganizationUserResponse{} }
+func (m *AddOrganizationUserResponse) String() string            { return proto.CompactTextString(m) }
+func (*AddOrganizationUserResponse) ProtoMessage()               {}
+func (*AddOrganizationUserResponse) Descriptor() ([]byte, []int) { return fileDescriptor19, []int{17} }
+
+type UpdateOrganizationUserRequest struct {
+	Id       int64            `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	Username string           `protobuf:"bytes,2,opt,name=username" json:"username,omitempty"`
+	Role     OrganizationRole `protobuf:"varint,3,opt,name=role,enum=api.OrganizationRole" json:"role,omitempty"`
+}
+
+func (m *UpdateOrganizationUserRequest) Reset()                    { *m = UpdateOrganizationUserRequest{} }
+func (m *UpdateOrganizationUserRequest) String() string            { return proto.CompactTextString(m) }
+func (*UpdateOrganizationUserRequest) ProtoMessage()               {}
+func (*UpdateOrganizationUserRequest) Descriptor() ([]byte, []int) { return fileDescriptor19, []int{18} }
+
+func (m *UpdateOrganizationUserRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *UpdateOrganizationUserRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *UpdateOrganizationUserRequest) GetRole() OrganizationRole {
+	if m != nil {
+		return m.Role
+	}
+	return OrganizationRole_READ_ONLY
+}
+
+type UpdateOrganizationUserResponse struct {
+}
+
+func (m *UpdateOrganizationUserResponse) Reset()         { *m = UpdateOrganizationUserResponse{} }
+func (m *UpdateOrganizationUserResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateOrganizationUserResponse) ProtoMessage()    {}
+func (*UpdateOrganizationUserResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor19, []int{19}
+}
+
+type RemoveOrganizationUserRequest struct {
+	Id       int64  `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	Username string `protobuf:"bytes,2,opt,name=username" json:"username,omitempty"`
+}
+
+func (m *RemoveOrganizationUserRequest) Reset()                    { *m = RemoveOrganizationUserRequest{} }
+func (m *RemoveOrganizationUserRequest) String() string            { return proto.CompactTextString(m) }
+func (*RemoveOrganizationUserRequest) ProtoMessage()               {}
+func (*RemoveOrganizationUserRequest) Descriptor() ([]byte, []int) { return fileDescriptor19, []int{20} }
+
+func (m *RemoveOrganizationUserRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *RemoveOrganizationUserRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+type RemoveOrganizationUserResponse struct {
+}
+
+func (m *RemoveOrganizationUserResponse) Reset()         { *m = RemoveOrganizationUserResponse{} }
+func (m *RemoveOrganizationUserResponse) String() string { return proto.CompactTextString(m) }
+func (*RemoveOrganizationUserResponse) ProtoMessage()    {}
+func (*RemoveOrganizationUserResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor19, []int{21}
+}
+
+type ListOrganizationUsersRequest struct {
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *ListOrganizationUsersRequest) Reset()                    { *m = ListOrganizationUsersRequest{} }
+func (m *ListOrganizationUsersRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListOrganizationUsersRequest) ProtoMessage()               {}
+func (*ListOrganizationUsersRequest) Descriptor() ([]byte, []int) { return fileDescriptor19, []int{22} }
+
+func (m *ListOrganizationUsersRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type OrganizationUser struct {
+	Username  string           `protobuf:"bytes,1,opt,name=username" json:"username,omitempty"`
+	Role      OrganizationRole `protobuf:"varint,2,opt,name=role,enum=api.OrganizationRole" json:"role,omitempty"`
+	CreatedAt string           `protobuf:"bytes,3,opt,name=createdAt" json:"createdAt,omitempty"`
+	UpdatedAt string           `protobuf:"bytes,4,opt,name=updatedAt" json:"updatedAt,omitempty"`
+}
+
+func (m *OrganizationUser) Reset()                    { *m = OrganizationUser{} }
+func (m *OrganizationUser) String() string            { return proto.CompactTextString(m) }
+func (*OrganizationUser) ProtoMessage()               {}
+func (*OrganizationUser) Descriptor() ([]byte, []int) { return fileDescriptor19, []int{23} }
+
+func (m *OrganizationUser) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *OrganizationUser) GetRole() OrganizationRole {
+	if m != nil {
+		return m.Role
+	}
+	return OrganizationRole_READ_ONLY
+}
+
+func (m *OrganizationUser) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return ""
+}
+
+func (m *OrganizationUser) GetUpdatedAt() string {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return ""
+}
+
+type ListOrganizationUsersResponse struct {
+	Result []*OrganizationUser `protobuf:"bytes,1,rep,name=result" json:"result,omitempty"`
+}
+
+func (m *ListOrganizationUsersResponse) Reset()                    { *m = ListOrganizationUsersResponse{} }
+func (m *ListOrganizationUsersResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListOrganizationUsersResponse) ProtoMessage()               {}
+func (*ListOrganizationUsersResponse) Descriptor() ([]byte, []int) { return fileDescriptor19, []int{24} }
+
+func (m *ListOrganizationUsersResponse) GetResult() []*OrganizationUser {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*CreateOrganizationRequest)(nil), "api.CreateOrganizationRequest")
+	proto.RegisterType((*CreateOrganizationResponse)(nil), "api.CreateOrganizationResponse")
+	proto.RegisterType((*GetOrganizationRequest)(nil), "api.GetOrganizationRequest")
+	proto.RegisterType((*GetOrganizationResponse)(nil), "api.GetOrganizationResponse")
+	proto.RegisterType((*ListOrganizationRequest)(nil), "api.ListOrganizationRequest")
+	proto.RegisterType((*ListOrganizationResponse)(nil), "api.ListOrganizationResponse")
+	proto.RegisterType((*UpdateOrganizationRequest)(nil), "api.UpdateOrganizationRequest")
+	proto.RegisterType((*UpdateOrganizationResponse)(nil), "api.UpdateOrganizationResponse")
+	proto.RegisterType((*DeleteOrganizationRequest)(nil), "api.DeleteOrganizationRequest")
+	proto.RegisterType((*DeleteOrganizationResponse)(nil), "api.DeleteOrganizationResponse")
+	proto.RegisterType((*AddOrganizationApplicationRequest)(nil), "api.AddOrganizationApplicationRequest")
+	proto.RegisterType((*AddOrganizationApplicationResponse)(nil), "api.AddOrganizationApplicationResponse")
+	proto.RegisterType((*RemoveOrganizationApplicationRequest)(nil), "api.RemoveOrganizationApplicationRequest")
+	proto.RegisterType((*RemoveOrganizationApplicationResponse)(nil), "api.RemoveOrganizationApplicationResponse")
+	proto.RegisterType((*ListOrganizationApplicationsRequest)(nil), "api.ListOrganizationApplicationsRequest")
+	proto.RegisterType((*ListOrganizationApplicationsResponse)(nil), "api.ListOrganizationApplicationsResponse")
+	proto.RegisterType((*AddOrganizationUserRequest)(nil), "api.AddOrganizationUserRequest")
+	proto.RegisterType((*AddOrganizationUserResponse)(nil), "api.AddOrganizationUserResponse")
+	proto.RegisterType((*UpdateOrganizationUserRequest)(nil), "api.UpdateOrganizationUserRequest")
+	proto.RegisterType((*UpdateOrganizationUserResponse)(nil), "api.UpdateOrganizationUserResponse")
+	proto.RegisterType((*RemoveOrganizationUserRequest)(nil), "api.RemoveOrganizationUserRequest")
+	proto.RegisterType((*RemoveOrganizationUserResponse)(nil), "api.RemoveOrganizationUserResponse")
+	proto.RegisterType((*ListOrganizationUsersRequest)(nil), "api.ListOrganizationUsersRequest")
+	proto.RegisterType((*OrganizationUser)(nil), "api.OrganizationUser")
+	proto.RegisterType((*ListOrganizationUsersResponse)(nil), "api.ListOrganizationUsersResponse")
+	proto.RegisterEnum("api.OrganizationRole", OrganizationRole_name, OrganizationRole_value)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for Organization service
+
+type OrganizationClient interface {
+	// Create creates the given organization.
+	Create(ctx context.Context, in *CreateOrganizationRequest, opts ...grpc.CallOption) (*CreateOrganizationResponse, error)
+	// Get returns the organization for the requested id, including its
+	// usage of the quotas.
+	Get(ctx context.Context, in *GetOrganizationRequest, opts ...grpc.CallOption) (*GetOrganizationResponse, error)
+	// List lists the organizations.
+	List(ctx context.Context, in *ListOrganizationRequest, opts ...grpc.CallOption) (*ListOrganizationResponse, error)
+	// Update updates the organization matching the given id.
+	Update(ctx context.Context, in *UpdateOrganizationRequest, opts ...grpc.CallOption) (*UpdateOrganizationResponse, error)
+	// Delete deletes the organization matching the given id. Its
+	// applications are not deleted.
+	Delete(ctx context.Context, in *DeleteOrganizationRequest, opts ...grpc.CallOption) (*DeleteOrganizationResponse, error)
+	// AddApplication assigns the given application to the organization.
+	AddApplication(ctx context.Context, in *AddOrganizationApplicationRequest, opts ...grpc.CallOption) (*AddOrganizationApplicationResponse, error)
+	// RemoveApplication removes the given application from the
+	// organization.
+	RemoveApplication(ctx context.Context, in *RemoveOrganizationApplicationRequest, opts ...grpc.CallOption) (*RemoveOrganizationApplicationResponse, error)
+	// ListApplications lists the applications of the organization.
+	ListApplications(ctx context.Context, in *ListOrganizationApplicationsRequest, opts ...grpc.CallOption) (*ListOrganizationApplicationsResponse, error)
+	// AddUser adds the given user to the organization.
+	AddUser(ctx context.Context, in *AddOrganizationUserRequest, opts ...grpc.CallOption) (*AddOrganizationUserResponse, error)
+	// UpdateUser updates the role of the given user.
+	UpdateUser(ctx context.Context, in *UpdateOrganizationUserRequest, opts ...grpc.CallOption) (*UpdateOrganizationUserResponse, error)
+	// RemoveUser removes the given user from the organization.
+	RemoveUser(ctx context.Context, in *RemoveOrganizationUserRequest, opts ...grpc.CallOption) (*RemoveOrganizationUserResponse, error)
+	// ListUsers lists the users of the organization.
+	ListUsers(ctx context.Context, in *ListOrganizationUsersRequest, opts ...grpc.CallOption) (*ListOrganizationUsersResponse, error)
+}
+
+type organizationClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewOrganizationClient(cc *grpc.ClientConn) OrganizationClient {
+	return &organizationClient{cc}
+}
+
+func (c *organizationClient) Create(ctx context.Context, in *CreateOrganizationRequest, opts ...grpc.CallOption) (*CreateOrganizationResponse, error) {
+	out := new(CreateOrganizationResponse)
+	err := grpc.Invoke(ctx, "/api.Organization/Create", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationClient) Get(ctx context.Context, in *GetOrganizationRequest, opts ...grpc.CallOption) (*GetOrganizationResponse, error) {
+	out := new(GetOrganizationResponse)
+	err := grpc.Invoke(ctx, "/api.Organization/Get", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationClient) List(ctx context.Context, in *ListOrganizationRequest, opts ...grpc.CallOption) (*ListOrganizationResponse, error) {
+	out := new(ListOrganizationResponse)
+	err := grpc.Invoke(ctx, "/api.Organization/List", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationClient) Update(ctx context.Context, in *UpdateOrganizationRequest, opts ...grpc.CallOption) (*UpdateOrganizationResponse, error) {
+	out := new(UpdateOrganizationResponse)
+	err := grpc.Invoke(ctx, "/api.Organization/Update", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationClient) Delete(ctx context.Context, in *DeleteOrganizationRequest, opts ...grpc.CallOption) (*DeleteOrganizationResponse, error) {
+	out := new(DeleteOrganizationResponse)
+	err := grpc.Invoke(ctx, "/api.Organization/Delete", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationClient) AddApplication(ctx context.Context, in *AddOrganizationApplicationRequest, opts ...grpc.CallOption) (*AddOrganizationApplicationResponse, error) {
+	out := new(AddOrganizationApplicationResponse)
+	err := grpc.Invoke(ctx, "/api.Organization/AddApplication", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationClient) RemoveApplication(ctx context.Context, in *RemoveOrganizationApplicationRequest, opts ...grpc.CallOption) (*RemoveOrganizationApplicationResponse, error) {
+	out := new(RemoveOrganizationApplicationResponse)
+	err := grpc.Invoke(ctx, "/api.Organization/RemoveApplication", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationClient) ListApplications(ctx context.Context, in *ListOrganizationApplicationsRequest, opts ...grpc.CallOption) (*ListOrganizationApplicationsResponse, error) {
+	out := new(ListOrganizationApplicationsResponse)
+	err := grpc.Invoke(ctx, "/api.Organization/ListApplications", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationClient) AddUser(ctx context.Context, in *AddOrganizationUserRequest, opts ...grpc.CallOption) (*AddOrganizationUserResponse, error) {
+	out := new(AddOrganizationUserResponse)
+	err := grpc.Invoke(ctx, "/api.Organization/AddUser", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationClient) UpdateUser(ctx context.Context, in *UpdateOrganizationUserRequest, opts ...grpc.CallOption) (*UpdateOrganizationUserResponse, error) {
+	out := new(UpdateOrganizationUserResponse)
+	err := grpc.Invoke(ctx, "/api.Organization/UpdateUser", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationClient) RemoveUser(ctx context.Context, in *RemoveOrganizationUserRequest, opts ...grpc.CallOption) (*RemoveOrganizationUserResponse, error) {
+	out := new(RemoveOrganizationUserResponse)
+	err := grpc.Invoke(ctx, "/api.Organization/RemoveUser", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationClient) ListUsers(ctx context.Context, in *ListOrganizationUsersRequest, opts ...grpc.CallOption) (*ListOrganizationUsersResponse, error) {
+	out := new(ListOrganizationUsersResponse)
+	err := grpc.Invoke(ctx, "/api.Organization/ListUsers", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for Organization service
+
+type OrganizationServer interface {
+	// Create creates the given organization.
+	Create(context.Context, *CreateOrganizationRequest) (*CreateOrganizationResponse, error)
+	// Get returns the organization for the requested id, including its
+	// usage of the quotas.
+	Get(context.Context, *GetOrganizationRequest) (*GetOrganizationResponse, error)
+	// List lists the organizations.
+	List(context.Context, *ListOrganizationRequest) (*ListOrganizationResponse, error)
+	// Update updates the organization matching the given id.
+	Update(context.Context, *UpdateOrganizationRequest) (*UpdateOrganizationResponse, error)
+	// Delete deletes the organization matching the given id. Its
+	// applications are not deleted.
+	Delete(context.Context, *DeleteOrganizationRequest) (*DeleteOrganizationResponse, error)
+	// AddApplication assigns the given application to the organization.
+	AddApplication(context.Context, *AddOrganizationApplicationRequest) (*AddOrganizationApplicationResponse, error)
+	// RemoveApplication removes the given application from the
+	// organization.
+	RemoveApplication(context.Context, *RemoveOrganizationApplicationRequest) (*RemoveOrganizationApplicationResponse, error)
+	// ListApplications lists the applications of the organization.
+	ListApplications(context.Context, *ListOrganizationApplicationsRequest) (*ListOrganizationApplicationsResponse, error)
+	// AddUser adds the given user to the organization.
+	AddUser(context.Context, *AddOrganizationUserRequest) (*AddOrganizationUserResponse, error)
+	// UpdateUser updates the role of the given user.
+	UpdateUser(context.Context, *UpdateOrganizationUserRequest) (*UpdateOrganizationUserResponse, error)
+	// RemoveUser removes the given user from the organization.
+	RemoveUser(context.Context, *RemoveOrganizationUserRequest) (*RemoveOrganizationUserResponse, error)
+	// ListUsers lists the users of the organization.
+	ListUsers(context.Context, *ListOrganizationUsersRequest) (*ListOrganizationUsersResponse, error)
+}
+
+func RegisterOrganizationServer(s *grpc.Server, srv OrganizationServer) {
+	s.RegisterService(&_Organization_serviceDesc, srv)
+}
+
+func _Organization_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOrganizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Organization/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServer).Create(ctx, req.(*CreateOrganizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Organization_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrganizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Organization/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServer).Get(ctx, req.(*GetOrganizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Organization_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOrganizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Organization/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServer).List(ctx, req.(*ListOrganizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Organization_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateOrganizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Organization/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServer).Update(ctx, req.(*UpdateOrganizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Organization_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteOrganizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Organization/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServer).Delete(ctx, req.(*DeleteOrganizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Organization_AddApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddOrganizationApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServer).AddApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Organization/AddApplication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServer).AddApplication(ctx, req.(*AddOrganizationApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Organization_RemoveApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveOrganizationApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServer).RemoveApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Organization/RemoveApplication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServer).RemoveApplication(ctx, req.(*RemoveOrganizationApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Organization_ListApplications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOrganizationApplicationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServer).ListApplications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Organization/ListApplications",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServer).ListApplications(ctx, req.(*ListOrganizationApplicationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Organization_AddUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddOrganizationUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServer).AddUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Organization/AddUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServer).AddUser(ctx, req.(*AddOrganizationUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Organization_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateOrganizationUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServer).UpdateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Organization/UpdateUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServer).UpdateUser(ctx, req.(*UpdateOrganizationUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Organization_RemoveUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveOrganizationUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServer).RemoveUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Organization/RemoveUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServer).RemoveUser(ctx, req.(*RemoveOrganizationUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Organization_ListUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOrganizationUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServer).ListUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Organization/ListUsers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServer).ListUsers(ctx, req.(*ListOrganizationUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Organization_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.Organization",
+	HandlerType: (*OrganizationServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _Organization_Create_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _Organization_Get_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _Organization_List_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _Organization_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _Organization_Delete_Handler,
+		},
+		{
+			MethodName: "AddApplication",
+			Handler:    _Organization_AddApplication_Handler,
+		},
+		{
+			MethodName: "RemoveApplication",
+			Handler:    _Organization_RemoveApplication_Handler,
+		},
+		{
+			MethodName: "ListApplications",
+			Handler:    _Organization_ListApplications_Handler,
+		},
+		{
+			MethodName: "AddUser",
+			Handler:    _Organization_AddUser_Handler,
+		},
+		{
+			MethodName: "UpdateUser",
+			Handler:    _Organization_UpdateUser_Handler,
+		},
+		{
+			MethodName: "RemoveUser",
+			Handler:    _Organization_RemoveUser_Handler,
+		},
+		{
+			MethodName: "ListUsers",
+			Handler:    _Organization_ListUsers_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "organization.proto",
+}
+
+func init() { proto.RegisterFile("organization.proto", fileDescriptor19) }
+
+var fileDescriptor19 = []byte{
+	// 988 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x5f, 0x6f, 0xdb, 0x54,
+	0x14, 0xc7, 0x49, 0x9a, 0x36, 0x67, 0x6b, 0x15, 0x8e, 0x46, 0x9b, 0xde, 0x25, 0x4d, 0x76, 0x9b,
+	0x6d, 0x69, 0x58, 0x13, 0xd4, 0xc1, 0x0b, 0x0f, 0x88, 0xa8, 0xa9, 0xaa, 0x8a, 0x91, 0x21, 0x4b,
+	0x45, 0xe2, 0xa9, 0x32, 0xf3, 0x5d, 0x31, 0x38, 0xb6, 0x89, 0x9d, 0xb2, 0x51, 0xf5, 0x85, 0x17,
+	0x04, 0xbc, 0x81, 0xf8, 0x00, 0x7c, 0x26, 0xbe, 0x02, 0x9f, 0x80, 0x4f, 0x80, 0x7c, 0xee, 0x6d,
+	0xea, 0x24, 0xbe, 0xae, 0x85, 0x60, 0x6f, 0xb9, 0xf7, 0xfc, 0x7c, 0x7e, 0xbf, 0xf3, 0xc7, 0xe7,
+	0x38, 0x80, 0xfe, 0xe4, 0xdc, 0xf2, 0x9c, 0xef, 0xad, 0xc8, 0xf1, 0xbd, 0x5e, 0x30, 0xf1, 0x23,
+	0x1f, 0x8b, 0x56, 0xe0, 0xb0, 0xfa, 0xb9, 0xef, 0x9f, 0xbb, 0xa2, 0x6f, 0x05, 0x4e, 0xdf, 0xf2,
+	0x3c, 0x3f, 0x22, 0x44, 0x28, 0x21, 0xfc, 0x12, 0xb6, 0x0f, 0x27, 0xc2, 0x8a, 0xc4, 0xf3, 0xc4,
+	0xe3, 0xa6, 0xf8, 0x76, 0x2a, 0xc2, 0x08, 0x11, 0x4a, 0x9e, 0x35, 0x16, 0x35, 0xa3, 0x65, 0x74,
+	0x2a, 0x26, 0xfd, 0x46, 0x06, 0x6b, 0x63, 0xeb, 0xd5, 0xc8, 0xb7, 0x45, 0x58, 0x2b, 0xb4, 0x8c,
+	0xce, 0xba, 0x39, 0x3b, 0x63, 0x0f, 0x70, 0x6c, 0xbd, 0x1a, 0xfa, 0xdf, 0x79, 0xae, 0xe3, 0x7d,
+	0x13, 0x7e, 0x26, 0x26, 0x43, 0xeb, 0x75, 0xad, 0x48, 0xa8, 0x14, 0x0b, 0x7f, 0x02, 0x2c, 0x8d,
+	0x3c, 0x0c, 0x7c, 0x2f, 0x14, 0xb8, 0x01, 0x05, 0xc7, 0x26, 0xee, 0xa2, 0x59, 0x70, 0x6c, 0xde,
+	0x81, 0xcd, 0x63, 0x11, 0xa5, 0xe9, 0x5c, 0x44, 0xfe, 0x54, 0x80, 0xad, 0x25, 0x68, 0xba, 0xd7,
+	0x59, 0x8c, 0x05, 0x4d, 0x8c, 0xc5, 0x5c, 0x31, 0x96, 0x74, 0x31, 0x62, 0x1d, 0x2a, 0x9e, 0x6f,
+	0x8b, 0x43, 0x7f, 0xea, 0x45, 0xb5, 0x15, 0x82, 0xdd, 0x5c, 0x60, 0x1b, 0xd6, 0x6d, 0xf5, 0x80,
+	0x44, 0x94, 0x09, 0x31, 0x7f, 0x19, 0xfb, 0x78, 0x41, 0x79, 0xb2, 0x07, 0x51, 0x6d, 0x95, 0x84,
+	0xde, 0x5c, 0xc4, 0xd6, 0x69, 0x60, 0x2b, 0xeb, 0x9a, 0xb4, 0xce, 0x2e, 0xf8, 0x31, 0x6c, 0x3d,
+	0x73, 0xc2, 0xd4, 0xb4, 0xdd, 0x83, 0x15, 0xd7, 0x19, 0x3b, 0x91, 0xca, 0x86, 0x3c, 0xe0, 0x26,
+	0x94, 0xfd, 0x97, 0x2f, 0x43, 0x11, 0x51, 0x4a, 0x8a, 0xa6, 0x3a, 0xf1, 0x00, 0x6a, 0xcb, 0x8e,
+	0x54, 0x52, 0x77, 0x00, 0x22, 0x3f, 0xb2, 0x5c, 0x19, 0x83, 0x74, 0x97, 0xb8, 0xc1, 0xf7, 0xa1,
+	0x3c, 0x11, 0xe1, 0xd4, 0x8d, 0x7d, 0x16, 0x3b, 0x77, 0x0e, 0xea, 0x3d, 0x2b, 0x70, 0x7a, 0x9a,
+	0x12, 0x99, 0x0a, 0xcb, 0x7f, 0x31, 0x60, 0xfb, 0x94, 0x02, 0xc9, 0x51, 0xf4, 0xff, 0xbb, 0x90,
+	0xbc, 0x0e, 0x2c, 0x4d, 0x8c, 0xd4, 0xcc, 0xdf, 0x85, 0xed, 0xa1, 0x70, 0x45, 0x2e, 0xa9, 0xb1,
+	0xab, 0x34, 0xb0, 0x72, 0xf5, 0x09, 0x3c, 0x18, 0xd8, 0x76, 0xd2, 0x34, 0x08, 0x02, 0xd7, 0x79,
+	0x91, 0x19, 0xfd, 0x26, 0x94, 0xad, 0x20, 0x38, 0x3a, 0x3d, 0x51, 0xf1, 0xab, 0x13, 0x6f, 0x03,
+	0xcf, 0x72, 0xa6, 0x28, 0x47, 0xd0, 0x36, 0xc5, 0xd8, 0xbf, 0x10, 0xff, 0x11, 0xeb, 0x63, 0x78,
+	0x78, 0x8b, 0x3f, 0x45, 0xfc, 0x01, 0xec, 0x2e, 0x36, 0x55, 0x02, 0x16, 0xea, 0x12, 0xf8, 0x31,
+	0xb4, 0xb3, 0x1f, 0x53, 0x7d, 0x59, 0x83, 0x55, 0xa9, 0x28, 0xac, 0x19, 0xad, 0x62, 0xa7, 0x62,
+	0x5e, 0x1f, 0x79, 0x08, 0x6c, 0x21, 0x2f, 0xa7, 0xa1, 0x98, 0xe8, 0xe2, 0x64, 0xb0, 0x36, 0x0d,
+	0xc5, 0x24, 0xd1, 0x5f, 0xb3, 0x33, 0xee, 0x41, 0x69, 0xe2, 0xbb, 0x82, 0xfa, 0x6b, 0xe3, 0xe0,
+	0x1d, 0xea, 0xec, 0xb9, 0xba, 0xfa, 0xae, 0x30, 0x09, 0xc2, 0x1b, 0x70, 0x3f, 0x95, 0x54, 0x25,
+	0xe3, 0x02, 0x1a, 0xcb, 0x1d, 0xf6, 0x06, 0x64, 0xb5, 0x60, 0x47, 0xc7, 0x3b, 0x6b, 0xc9, 0xc6,
+	0x72, 0x3d, 0xff, 0xa5, 0xb2, 0x98, 0x4e, 0xe7, 0x4c, 0xd1, 0xf5, 0xa0, 0xbe, 0x58, 0xde, 0xd8,
+	0xae, 0x6d, 0x87, 0xdf, 0x0d, 0xa8, 0x2e, 0x82, 0xe7, 0x24, 0x18, 0x9a, 0xe4, 0x14, 0x6e, 0x4d,
+	0xce, 0xfc, 0xec, 0x2d, 0x66, 0xce, 0xde, 0xd2, 0xe2, 0xec, 0x1d, 0x41, 0x43, 0x13, 0x87, 0xea,
+	0xcf, 0xfd, 0xd9, 0x5c, 0x34, 0x68, 0x2e, 0x2e, 0x2b, 0xa1, 0xbc, 0x28, 0x50, 0xf7, 0x23, 0xa8,
+	0x2e, 0xaa, 0xc4, 0x75, 0xa8, 0x98, 0x47, 0x83, 0xe1, 0xd9, 0xf3, 0xd1, 0xb3, 0x2f, 0xaa, 0x6f,
+	0x61, 0x15, 0xee, 0x0e, 0x8f, 0x3e, 0x3f, 0x39, 0x3c, 0x3a, 0x1b, 0x0c, 0x3f, 0x3d, 0x19, 0x55,
+	0x0d, 0xac, 0xc0, 0x8a, 0xfc, 0x59, 0x38, 0xf8, 0xfb, 0x0e, 0xdc, 0x4d, 0x3a, 0xc0, 0xaf, 0xa0,
+	0x2c, 0x17, 0x30, 0xee, 0x10, 0xb3, 0xf6, 0x53, 0x80, 0x35, 0xb5, 0x76, 0x55, 0xb3, 0xc6, 0x0f,
+	0x7f, 0xfe, 0xf5, 0x5b, 0x61, 0x8b, 0x23, 0x7d, 0x68, 0x24, 0x3f, 0x46, 0xc2, 0x0f, 0x8d, 0x2e,
+	0x5a, 0x50, 0x3c, 0x16, 0x11, 0xde, 0x4f, 0x1f, 0xfc, 0x92, 0x23, 0x73, 0x2b, 0xf0, 0x26, 0x11,
+	0x6c, 0xe3, 0xd6, 0x32, 0x41, 0xff, 0xd2, 0xb1, 0xaf, 0xf0, 0x0c, 0x4a, 0x71, 0xb6, 0x51, 0xba,
+	0xd1, 0x2c, 0x3d, 0xd6, 0xd0, 0x58, 0x15, 0x0b, 0x23, 0x96, 0x7b, 0x98, 0x12, 0x06, 0x8e, 0xa1,
+	0x2c, 0xdf, 0x13, 0x95, 0x2d, 0xed, 0x6e, 0x62, 0x4d, 0xad, 0x5d, 0xd1, 0x70, 0xa2, 0xa9, 0x33,
+	0x5d, 0x30, 0x71, 0xca, 0xbe, 0x86, 0xb2, 0xdc, 0x12, 0x8a, 0x4e, 0xbb, 0x5f, 0x58, 0x53, 0x6b,
+	0x9f, 0xcf, 0x5d, 0x57, 0x9b, 0xbb, 0x5f, 0x0d, 0xd8, 0x18, 0xd8, 0x76, 0x62, 0x88, 0xe2, 0x23,
+	0x72, 0x7a, 0xeb, 0x26, 0x62, 0x8f, 0x6f, 0xc5, 0x29, 0x11, 0x7d, 0x12, 0xb1, 0xc7, 0xdb, 0x1a,
+	0x11, 0x7d, 0xeb, 0xe6, 0x21, 0xea, 0x99, 0x3f, 0x0c, 0x78, 0x5b, 0x4e, 0x8a, 0xa4, 0xae, 0x3d,
+	0xe2, 0xcb, 0xb3, 0xae, 0x58, 0x37, 0x0f, 0xf4, 0x7a, 0x13, 0x91, 0xba, 0x7e, 0x77, 0x3f, 0x8f,
+	0xba, 0xfe, 0xa5, 0xdc, 0x23, 0x57, 0x18, 0x8f, 0x9e, 0xb8, 0x99, 0x12, 0x2e, 0x43, 0xec, 0xa4,
+	0xf6, 0x58, 0xca, 0x62, 0x63, 0x7b, 0x39, 0x90, 0x4a, 0xe0, 0x13, 0x12, 0xf8, 0x08, 0x73, 0xa5,
+	0x0f, 0x2f, 0x60, 0x75, 0x60, 0xdb, 0x34, 0x08, 0x9b, 0x69, 0x05, 0x4a, 0x0c, 0x6f, 0xd6, 0xd2,
+	0x03, 0x14, 0x77, 0x87, 0xb8, 0x39, 0x6f, 0xe8, 0xb8, 0xe3, 0xc9, 0x4a, 0x35, 0xfb, 0xd9, 0x00,
+	0x90, 0x7d, 0x4f, 0xdc, 0x5c, 0xf3, 0x22, 0x24, 0xe9, 0x77, 0x33, 0x31, 0x4a, 0xc1, 0x53, 0x52,
+	0xb0, 0xcf, 0x3a, 0x99, 0x0a, 0xfa, 0x97, 0xd7, 0x23, 0x9e, 0xde, 0xa0, 0x1f, 0x0d, 0x00, 0x59,
+	0xfd, 0x84, 0x98, 0xcc, 0x45, 0xc6, 0x76, 0x33, 0x31, 0x4a, 0xcc, 0x7b, 0x24, 0xa6, 0xdb, 0xcd,
+	0x2d, 0x06, 0x5f, 0x43, 0x25, 0x2e, 0x72, 0xec, 0x25, 0xc4, 0x07, 0xa9, 0x45, 0x4f, 0x6e, 0x38,
+	0xc6, 0xb3, 0x20, 0x4a, 0xc5, 0x43, 0x52, 0xd1, 0xc4, 0xec, 0xa2, 0x7c, 0x59, 0xa6, 0x3f, 0x7a,
+	0x4f, 0xff, 0x19, 0x00, 0x55, 0xcb, 0x0d, 0x5c, 0x21, 0x0e, 0x00, 0x00,
+}
@@ -0,0 +1,429 @@
+// Code generated by protoc-gen-go.
+// source: payloadCodec.proto
+// DO NOT EDIT!
+
+package api
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import _ "github.com/grpc-ecosystem/grpc-gateway/third_party/googleapis/google/api"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+type CreatePayloadCodecRequest struct {
+	// hex encoded AppEUI
+	AppEUI string `protobuf:"bytes,1,opt,name=appEUI" json:"appEUI,omitempty"`
+	// codec type (CAYENNE_LPP, CUSTOM_JS)
+	CodecType string `protobuf:"bytes,2,opt,name=codecType" json:"codecType,omitempty"`
+	// script defining the Decode(fPort, bytes) function (CUSTOM_JS)
+	DecodeScript string `protobuf:"bytes,3,opt,name=decodeScript" json:"decodeScript,omitempty"`
+	// script defining the Encode(fPort, obj) function (CUSTOM_JS)
+	EncodeScript string `protobuf:"bytes,4,opt,name=encodeScript" json:"encodeScript,omitempty"`
+}
+
+func (m *CreatePayloadCodecRequest) Reset()                    { *m = CreatePayloadCodecRequest{} }
+func (m *CreatePayloadCodecRequest) String() string            { return proto.CompactTextString(m) }
+func (*CreatePayloadCodecRequest) ProtoMessage()               {}
+func (*CreatePayloadCodecRequest) Descriptor() ([]byte, []int) { return fileDescriptor13, []int{0} }
+
+func (m *CreatePayloadCodecRequest) GetAppEUI() string {
+	if m != nil {
+		return m.AppEUI
+	}
+	return ""
+}
+
+func (m *CreatePayloadCodecRequest) GetCodecType() string {
+	if m != nil {
+		return m.CodecType
+	}
+	return ""
+}
+
+func (m *CreatePayloadCodecRequest) GetDecodeScript() string {
+	if m != nil {
+		return m.DecodeScript
+	}
+	return ""
+}
+
+func (m *CreatePayloadCodecRequest) GetEncodeScript() string {
+	if m != nil {
+		return m.EncodeScript
+	}
+	return ""
+}
+
+type CreatePayloadCodecResponse struct {
+}
+
+func (m *CreatePayloadCodecResponse) Reset()                    { *m = CreatePayloadCodecResponse{} }
+func (m *CreatePayloadCodecResponse) String() string            { return proto.CompactTextString(m) }
+func (*CreatePayloadCodecResponse) ProtoMessage()               {}
+func (*CreatePayloadCodecResponse) Descriptor() ([]byte, []int) { return fileDescriptor13, []int{1} }
+
+type GetPayloadCodecRequest struct {
+	// hex encoded AppEUI
+	AppEUI string `protobuf:"bytes,1,opt,name=appEUI" json:"appEUI,omitempty"`
+}
+
+func (m *GetPayloadCodecRequest) Reset()                    { *m = GetPayloadCodecRequest{} }
+func (m *GetPayloadCodecRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetPayloadCodecRequest) ProtoMessage()               {}
+func (*GetPayloadCodecRequest) Descriptor() ([]byte, []int) { return fileDescriptor13, []int{2} }
+
+func (m *GetPayloadCodecRequest) GetAppEUI() string {
+	if m != nil {
+		return m.AppEUI
+	}
+	return ""
+}
+
+type GetPayloadCodecResponse struct {
+	// hex encoded AppEUI
+	AppEUI string `protobuf:"bytes,1,opt,name=appEUI" json:"appEUI,omitempty"`
+	// codec type (CAYENNE_LPP, CUSTOM_JS)
+	CodecType string `protobuf:"bytes,2,opt,name=codecType" json:"codecType,omitempty"`
+	// script defining the Decode(fPort, bytes) function (CUSTOM_JS)
+	DecodeScript string `protobuf:"bytes,3,opt,name=decodeScript" json:"decodeScript,omitempty"`
+	// script defining the Encode(fPort, obj) function (CUSTOM_JS)
+	EncodeScript string `protobuf:"bytes,4,opt,name=encodeScript" json:"encodeScript,omitempty"`
+}
+
+func (m *GetPayloadCodecResponse) Reset()                    { *m = GetPayloadCodecResponse{} }
+func (m *GetPayloadCodecResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetPayloadCodecResponse) ProtoMessage()               {}
+func (*GetPayloadCodecResponse) Descriptor() ([]byte, []int) { return fileDescriptor13, []int{3} }
+
+func (m *GetPayloadCodecResponse) GetAppEUI() string {
+	if m != nil {
+		return m.AppEUI
+	}
+	return ""
+}
+
+func (m *GetPayloadCodecResponse) GetCodecType() string {
+	if m != nil {
+		return m.CodecType
+	}
+	return ""
+}
+
+func (m *GetPayloadCodecResponse) GetDecodeScript() string {
+	if m != nil {
+		return m.DecodeScript
+	}
+	return ""
+}
+
+func (m *GetPayloadCodecResponse) GetEncodeScript() string {
+	if m != nil {
+		return m.EncodeScript
+	}
+	return ""
+}
+
+type UpdatePayloadCodecRequest struct {
+	// hex encoded AppEUI
+	AppEUI string `protobuf:"bytes,1,opt,name=appEUI" json:"appEUI,omitempty"`
+	// codec type (CAYENNE_LPP, CUSTOM_JS)
+	CodecType string `protobuf:"bytes,2,opt,name=codecType" json:"codecType,omitempty"`
+	// script defining the Decode(fPort, bytes) function (CUSTOM_JS)
+	DecodeScript string `protobuf:"bytes,3,opt,name=decodeScript" json:"decodeScript,omitempty"`
+	// script defining the Encode(fPort, obj) function (CUSTOM_JS)
+	EncodeScript string `protobuf:"bytes,4,opt,name=encodeScript" json:"encodeScript,omitempty"`
+}
+
+func (m *UpdatePayloadCodecRequest) Reset()                    { *m = UpdatePayloadCodecRequest{} }
+func (m *UpdatePayloadCodecRequest) String() string            { return proto.CompactTextString(m) }
+func (*UpdatePayloadCodecRequest) ProtoMessage()               {}
+func (*UpdatePayloadCodecRequest) Descriptor() ([]byte, []int) { return fileDescriptor13, []int{4} }
+
+func (m *UpdatePayloadCodecRequest) GetAppEUI() string {
+	if m != nil {
+		return m.AppEUI
+	}
+	return ""
+}
+
+func (m *UpdatePayloadCodecRequest) GetCodecType() string {
+	if m != nil {
+		return m.CodecType
+	}
+	return ""
+}
+
+func (m *UpdatePayloadCodecRequest) GetDecodeScript() string {
+	if m != nil {
+		return m.DecodeScript
+	}
+	return ""
+}
+
+func (m *UpdatePayloadCodecRequest) GetEncodeScript() string {
+	if m != nil {
+		return m.EncodeScript
+	}
+	return ""
+}
+
+type UpdatePayloadCodecResponse struct {
+}
+
+func (m *UpdatePayloadCodecResponse) Reset()                    { *m = UpdatePayloadCodecResponse{} }
+func (m *UpdatePayloadCodecResponse) String() string            { return proto.CompactTextString(m) }
+func (*UpdatePayloadCodecResponse) ProtoMessage()               {}
+func (*UpdatePayloadCodecResponse) Descriptor() ([]byte, []int) { return fileDescriptor13, []int{5} }
+
+type DeletePayloadCodecRequest struct {
+	// hex encoded AppEUI
+	AppEUI string `protobuf:"bytes,1,opt,name=appEUI" json:"appEUI,omitempty"`
+}
+
+func (m *DeletePayloadCodecRequest) Reset()                    { *m = DeletePayloadCodecRequest{} }
+func (m *DeletePayloadCodecRequest) String() string            { return proto.CompactTextString(m) }
+func (*DeletePayloadCodecRequest) ProtoMessage()               {}
+func (*DeletePayloadCodecRequest) Descriptor() ([]byte, []int) { return fileDescriptor13, []int{6} }
+
+func (m *DeletePayloadCodecRequest) GetAppEUI() string {
+	if m != nil {
+		return m.AppEUI
+	}
+	return ""
+}
+
+type DeletePayloadCodecResponse struct {
+}
+
+func (m *DeletePayloadCodecResponse) Reset()                    { *m = DeletePayloadCodecResponse{} }
+func (m *DeletePayloadCodecResponse) String() string            { return proto.CompactTextString(m) }
+func (*DeletePayloadCodecResponse) ProtoMessage()               {}
+func (*DeletePayloadCodecResponse) Descriptor() ([]byte, []int) { return fileDescriptor13, []int{7} }
+
+func init() {
+	proto.RegisterType((*CreatePayloadCodecRequest)(nil), "api.CreatePayloadCodecRequest")
+	proto.RegisterType((*CreatePayloadCodecResponse)(nil), "api.CreatePayloadCodecResponse")
+	proto.RegisterType((*GetPayloadCodecRequest)(nil), "api.GetPayloadCodecRequest")
+	proto.RegisterType((*GetPayloadCodecResponse)(nil), "api.GetPayloadCodecResponse")
+	proto.RegisterType((*UpdatePayloadCodecRequest)(nil), "api.UpdatePayloadCodecRequest")
+	proto.RegisterType((*UpdatePayloadCodecResponse)(nil), "api.UpdatePayloadCodecResponse")
+	proto.RegisterType((*DeletePayloadCodecRequest)(nil), "api.DeletePayloadCodecRequest")
+	proto.RegisterType((*DeletePayloadCodecResponse)(nil), "api.DeletePayloadCodecResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for PayloadCodec service
+
+type PayloadCodecClient interface {
+	// Create creates the payload codec of the given application.
+	Create(ctx context.Context, in *CreatePayloadCodecRequest, opts ...grpc.CallOption) (*CreatePayloadCodecResponse, error)
+	// Get returns the payload codec of the given application.
+	Get(ctx context.Context, in *GetPayloadCodecRequest, opts ...grpc.CallOption) (*GetPayloadCodecResponse, error)
+	// Update updates the payload codec of the given application.
+	Update(ctx context.Context, in *UpdatePayloadCodecRequest, opts ...grpc.CallOption) (*UpdatePayloadCodecResponse, error)
+	// Delete deletes the payload codec of the given application.
+	Delete(ctx context.Context, in *DeletePayloadCodecRequest, opts ...grpc.CallOption) (*DeletePayloadCodecResponse, error)
+}
+
+type payloadCodecClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewPayloadCodecClient(cc *grpc.ClientConn) PayloadCodecClient {
+	return &payloadCodecClient{cc}
+}
+
+func (c *payloadCodecClient) Create(ctx context.Context, in *CreatePayloadCodecRequest, opts ...grpc.CallOption) (*CreatePayloadCodecResponse, error) {
+	out := new(CreatePayloadCodecResponse)
+	err := grpc.Invoke(ctx, "/api.PayloadCodec/Create", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *payloadCodecClient) Get(ctx context.Context, in *GetPayloadCodecRequest, opts ...grpc.CallOption) (*GetPayloadCodecResponse, error) {
+	out := new(GetPayloadCodecResponse)
+	err := grpc.Invoke(ctx, "/api.PayloadCodec/Get", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *payloadCodecClient) Update(ctx context.Context, in *UpdatePayloadCodecRequest, opts ...grpc.CallOption) (*UpdatePayloadCodecResponse, error) {
+	out := new(UpdatePayloadCodecResponse)
+	err := grpc.Invoke(ctx, "/api.PayloadCodec/Update", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *payloadCodecClient) Delete(ctx context.Context, in *DeletePayloadCodecRequest, opts ...grpc.CallOption) (*DeletePayloadCodecResponse, error) {
+	out := new(DeletePayloadCodecResponse)
+	err := grpc.Invoke(ctx, "/api.PayloadCodec/Delete", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for PayloadCodec service
+
+type PayloadCodecServer interface {
+	// Create creates the payload codec of the given application.
+	Create(context.Context, *CreatePayloadCodecRequest) (*CreatePayloadCodecResponse, error)
+	// Get returns the payload codec of the given application.
+	Get(context.Context, *GetPayloadCodecRequest) (*GetPayloadCodecResponse, error)
+	// Update updates the payload codec of the given application.
+	Update(context.Context, *UpdatePayloadCodecRequest) (*UpdatePayloadCodecResponse, error)
+	// Delete deletes the payload codec of the given application.
+	Delete(context.Context, *DeletePayloadCodecRequest) (*DeletePayloadCodecResponse, error)
+}
+
+func RegisterPayloadCodecServer(s *grpc.Server, srv PayloadCodecServer) {
+	s.RegisterService(&_PayloadCodec_serviceDesc, srv)
+}
+
+func _PayloadCodec_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePayloadCodecRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PayloadCodecServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.PayloadCodec/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PayloadCodecServer).Create(ctx, req.(*CreatePayloadCodecRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PayloadCodec_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPayloadCodecRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PayloadCodecServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.PayloadCodec/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PayloadCodecServer).Get(ctx, req.(*GetPayloadCodecRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PayloadCodec_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePayloadCodecRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PayloadCodecServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.PayloadCodec/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PayloadCodecServer).Update(ctx, req.(*UpdatePayloadCodecRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PayloadCodec_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePayloadCodecRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PayloadCodecServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.PayloadCodec/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PayloadCodecServer).Delete(ctx, req.(*DeletePayloadCodecRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _PayloadCodec_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.PayloadCodec",
+	HandlerType: (*PayloadCodecServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _PayloadCodec_Create_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _PayloadCodec_Get_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _PayloadCodec_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _PayloadCodec_Delete_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "payloadCodec.proto",
+}
+
+func init() { proto.RegisterFile("payloadCodec.proto", fileDescriptor13) }
+
+var fileDescriptor13 = []byte{
+	// 354 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x54, 0xcd, 0x4a, 0x03, 0x31,
+	0x10, 0x66, 0xbb, 0xb2, 0xd0, 0xa1, 0xa7, 0x39, 0xf4, 0x27, 0xdd, 0xda, 0xb2, 0x82, 0x48, 0x0f,
+	0xad, 0xd8, 0x9b, 0xd7, 0x2a, 0xc5, 0x9b, 0x54, 0xfb, 0x00, 0xb1, 0x3b, 0xd4, 0x85, 0xb2, 0x49,
+	0xbb, 0xf1, 0x50, 0xc4, 0x8b, 0xaf, 0xe0, 0x41, 0x7c, 0x1d, 0x5f, 0xc1, 0x57, 0xf0, 0x41, 0x64,
+	0x93, 0x80, 0x5b, 0xba, 0x29, 0xf4, 0xa4, 0xc7, 0xcc, 0xf7, 0xcd, 0x7c, 0x33, 0xf9, 0x26, 0x01,
+	0x94, 0x7c, 0xb3, 0x14, 0x3c, 0x1e, 0x8b, 0x98, 0xe6, 0x03, 0xb9, 0x16, 0x4a, 0xa0, 0xcf, 0x65,
+	0xc2, 0xc2, 0x85, 0x10, 0x8b, 0x25, 0x0d, 0xb9, 0x4c, 0x86, 0x3c, 0x4d, 0x85, 0xe2, 0x2a, 0x11,
+	0x69, 0x66, 0x28, 0xd1, 0x87, 0x07, 0xad, 0xf1, 0x9a, 0xb8, 0xa2, 0xdb, 0x42, 0xfe, 0x94, 0x56,
+	0x4f, 0x94, 0x29, 0xac, 0x43, 0xc0, 0xa5, 0xbc, 0x9e, 0xdd, 0x34, 0xbd, 0x9e, 0x77, 0x56, 0x9d,
+	0xda, 0x13, 0x86, 0x50, 0x9d, 0xe7, 0xbc, 0xfb, 0x8d, 0xa4, 0x66, 0x45, 0x43, 0xbf, 0x01, 0x8c,
+	0xa0, 0x16, 0x53, 0x7e, 0xbc, 0x9b, 0xaf, 0x13, 0xa9, 0x9a, 0xbe, 0x26, 0x6c, 0xc5, 0x72, 0x0e,
+	0xa5, 0x05, 0xce, 0x91, 0xe1, 0x14, 0x63, 0x51, 0x08, 0xac, 0xac, 0xb5, 0x4c, 0x8a, 0x34, 0xa3,
+	0xe8, 0x1c, 0xea, 0x13, 0x52, 0x07, 0x74, 0x1d, 0xbd, 0x7b, 0xd0, 0xd8, 0x49, 0x31, 0xd5, 0xfe,
+	0x78, 0xd2, 0xdc, 0x85, 0x99, 0x8c, 0xff, 0xab, 0x0b, 0x65, 0xad, 0x59, 0x17, 0x46, 0xd0, 0xba,
+	0xa2, 0x25, 0x1d, 0xd4, 0x78, 0x5e, 0xb2, 0x2c, 0xc9, 0x94, 0xbc, 0xf8, 0xf4, 0xa1, 0x56, 0x04,
+	0xf0, 0x11, 0x02, 0xb3, 0x07, 0x78, 0x3c, 0xe0, 0x32, 0x19, 0x38, 0xf7, 0x95, 0x75, 0x9d, 0xb8,
+	0x6d, 0xb7, 0xf3, 0xfa, 0xf5, 0xfd, 0x56, 0x69, 0x44, 0xa8, 0x9f, 0x43, 0xf1, 0xc9, 0x64, 0x97,
+	0x5e, 0x1f, 0x09, 0xfc, 0x09, 0x29, 0x6c, 0xeb, 0x32, 0xe5, 0xdb, 0xc5, 0xc2, 0x72, 0xd0, 0x0a,
+	0x9c, 0x68, 0x81, 0x0e, 0xb6, 0x77, 0x05, 0x86, 0xcf, 0x66, 0xfc, 0x17, 0x5c, 0x41, 0x60, 0xae,
+	0xd4, 0x0e, 0xe4, 0xb4, 0x9e, 0x75, 0x9d, 0xb8, 0xd5, 0x3b, 0xd5, 0x7a, 0x3d, 0xb6, 0x4f, 0x2f,
+	0x9f, 0x2c, 0x85, 0xc0, 0x5c, 0xb9, 0x95, 0x74, 0x9a, 0xc6, 0xba, 0x4e, 0x7c, 0x7b, 0xc4, 0xfe,
+	0x3e, 0xc9, 0x87, 0x40, 0x7f, 0x2f, 0xa3, 0x9f, 0x01, 0x00, 0xd7, 0xef, 0xcd, 0x01, 0x97, 0x04,
+	0x00, 0x00,
+}
@@ -0,0 +1,183 @@
+// Code generated by protoc-gen-go.
+// source: plugin.proto
+// DO NOT EDIT!
+
+/*
+Package plugin is a generated protocol buffer package.
+
+It is generated from these files:
+	plugin.proto
+
+It has these top-level messages:
+	HandleEventRequest
+	HandleEventResponse
+*/
+package plugin
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type HandleEventRequest struct {
+	// event type (rx, join, ack, error, linkquality, lifecycle, firmware, status, location or scheduled, matching the MQTT topic suffix)
+	Type string `protobuf:"bytes,1,opt,name=type" json:"type,omitempty"`
+	// hex encoded AppEUI
+	AppEUI string `protobuf:"bytes,2,opt,name=appEUI" json:"appEUI,omitempty"`
+	// hex encoded DevEUI
+	DevEUI string `protobuf:"bytes,3,opt,name=devEUI" json:"devEUI,omitempty"`
+	// encoded payload (JSON or protobuf, as published over MQTT)
+	Payload []byte `protobuf:"bytes,4,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+func (m *HandleEventRequest) Reset()                    { *m = HandleEventRequest{} }
+func (m *HandleEventRequest) String() string            { return proto.CompactTextString(m) }
+func (*HandleEventRequest) ProtoMessage()               {}
+func (*HandleEventRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
+func (m *HandleEventRequest) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *HandleEventRequest) GetAppEUI() string {
+	if m != nil {
+		return m.AppEUI
+	}
+	return ""
+}
+
+func (m *HandleEventRequest) GetDevEUI() string {
+	if m != nil {
+		return m.DevEUI
+	}
+	return ""
+}
+
+func (m *HandleEventRequest) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+type HandleEventResponse struct {
+}
+
+func (m *HandleEventResponse) Reset()                    { *m = HandleEventResponse{} }
+func (m *HandleEventResponse) String() string            { return proto.CompactTextString(m) }
+func (*HandleEventResponse) ProtoMessage()               {}
+func (*HandleEventResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+
+func init() {
+	proto.RegisterType((*HandleEventRequest)(nil), "plugin.HandleEventRequest")
+	proto.RegisterType((*HandleEventResponse)(nil), "plugin.HandleEventResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for Plugin service
+
+type PluginClient interface {
+	// HandleEvent handles the given event.
+	HandleEvent(ctx context.Context, in *HandleEventRequest, opts ...grpc.CallOption) (*HandleEventResponse, error)
+}
+
+type pluginClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewPluginClient(cc *grpc.ClientConn) PluginClient {
+	return &pluginClient{cc}
+}
+
+func (c *pluginClient) HandleEvent(ctx context.Context, in *HandleEventRequest, opts ...grpc.CallOption) (*HandleEventResponse, error) {
+	out := new(HandleEventResponse)
+	err := grpc.Invoke(ctx, "/plugin.Plugin/HandleEvent", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for Plugin service
+
+type PluginServer interface {
+	// HandleEvent handles the given event.
+	HandleEvent(context.Context, *HandleEventRequest) (*HandleEventResponse, error)
+}
+
+func RegisterPluginServer(s *grpc.Server, srv PluginServer) {
+	s.RegisterService(&_Plugin_serviceDesc, srv)
+}
+
+func _Plugin_HandleEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HandleEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PluginServer).HandleEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/plugin.Plugin/HandleEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PluginServer).HandleEvent(ctx, req.(*HandleEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Plugin_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "plugin.Plugin",
+	HandlerType: (*PluginServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "HandleEvent",
+			Handler:    _Plugin_HandleEvent_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "plugin.proto",
+}
+
+func init() { proto.RegisterFile("plugin.proto", fileDescriptor0) }
+
+var fileDescriptor0 = []byte{
+	// 169 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x29, 0xc8, 0x29, 0x4d,
+	0xcf, 0xcc, 0xd3, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0x83, 0xf0, 0x94, 0x8a, 0xb8, 0x84,
+	0x3c, 0x12, 0xf3, 0x52, 0x72, 0x52, 0x5d, 0xcb, 0x52, 0xf3, 0x4a, 0x82, 0x52, 0x0b, 0x4b, 0x53,
+	0x8b, 0x4b, 0x84, 0x84, 0xb8, 0x58, 0x4a, 0x2a, 0x0b, 0x52, 0x25, 0x18, 0x15, 0x18, 0x35, 0x38,
+	0x83, 0xc0, 0x6c, 0x21, 0x31, 0x2e, 0xb6, 0xc4, 0x82, 0x02, 0xd7, 0x50, 0x4f, 0x09, 0x26, 0xb0,
+	0x28, 0x94, 0x07, 0x12, 0x4f, 0x49, 0x2d, 0x03, 0x89, 0x33, 0x43, 0xc4, 0x21, 0x3c, 0x21, 0x09,
+	0x2e, 0xf6, 0x82, 0xc4, 0xca, 0x9c, 0xfc, 0xc4, 0x14, 0x09, 0x16, 0x05, 0x46, 0x0d, 0x9e, 0x20,
+	0x18, 0x57, 0x49, 0x94, 0x4b, 0x18, 0xc5, 0xce, 0xe2, 0x82, 0xfc, 0xbc, 0xe2, 0x54, 0xa3, 0x00,
+	0x2e, 0xb6, 0x00, 0xb0, 0xa3, 0x84, 0xdc, 0xb8, 0xb8, 0x91, 0x14, 0x08, 0x49, 0xe9, 0x41, 0x9d,
+	0x8e, 0xe9, 0x52, 0x29, 0x69, 0xac, 0x72, 0x10, 0x13, 0x93, 0xd8, 0xc0, 0x7e, 0x35, 0x06, 0x0c,
+	0x00, 0xc5, 0xd8, 0xaf, 0x49, 0xfb, 0x00, 0x00, 0x00,
+}
@@ -0,0 +1,747 @@
+// Code generated by protoc-gen-go.
+// source: scheduledReport.proto
+// DO NOT EDIT!
+
+package api
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import _ "github.com/grpc-ecosystem/grpc-gateway/third_party/googleapis/google/api"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+type CreateScheduledReportRequest struct {
+	// name of the report
+	Name string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	// hex encoded AppEUI of the application
+	AppEUI string `protobuf:"bytes,2,opt,name=appEUI" json:"appEUI,omitempty"`
+	// report type (DEVICE_ACTIVITY, OFFLINE_DEVICES)
+	ReportType string `protobuf:"bytes,3,opt,name=reportType" json:"reportType,omitempty"`
+	// report format (CSV, PDF)
+	Format string `protobuf:"bytes,4,opt,name=format" json:"format,omitempty"`
+	// schedule (DAILY, WEEKLY)
+	Schedule string `protobuf:"bytes,5,opt,name=schedule" json:"schedule,omitempty"`
+	// day of the week (0 = sunday, WEEKLY schedule)
+	Weekday uint32 `protobuf:"varint,6,opt,name=weekday" json:"weekday,omitempty"`
+	// hour of the day (0 - 23)
+	Hour uint32 `protobuf:"varint,7,opt,name=hour" json:"hour,omitempty"`
+	// IANA timezone of the schedule (e.g. Europe/Amsterdam, default UTC)
+	Timezone string `protobuf:"bytes,8,opt,name=timezone" json:"timezone,omitempty"`
+	// delivery channel (EMAIL, WEBHOOK)
+	Channel string `protobuf:"bytes,9,opt,name=channel" json:"channel,omitempty"`
+	// email addresses of the recipients (EMAIL channel)
+	Recipients []string `protobuf:"bytes,10,rep,name=recipients" json:"recipients,omitempty"`
+	// webhook url (WEBHOOK channel)
+	WebhookURL string `protobuf:"bytes,11,opt,name=webhookURL" json:"webhookURL,omitempty"`
+}
+
+func (m *CreateScheduledReportRequest) Reset()                    { *m = CreateScheduledReportRequest{} }
+func (m *CreateScheduledReportRequest) String() string            { return proto.CompactTextString(m) }
+func (*CreateScheduledReportRequest) ProtoMessage()               {}
+func (*CreateScheduledReportRequest) Descriptor() ([]byte, []int) { return fileDescriptor11, []int{0} }
+
+func (m *CreateScheduledReportRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CreateScheduledReportRequest) GetAppEUI() string {
+	if m != nil {
+		return m.AppEUI
+	}
+	return ""
+}
+
+func (m *CreateScheduledReportRequest) GetReportType() string {
+	if m != nil {
+		return m.ReportType
+	}
+	return ""
+}
+
+func (m *CreateScheduledReportRequest) GetFormat() string {
+	if m != nil {
+		return m.Format
+	}
+	return ""
+}
+
+func (m *CreateScheduledReportRequest) GetSchedule() string {
+	if m != nil {
+		return m.Schedule
+	}
+	return ""
+}
+
+func (m *CreateScheduledReportRequest) GetWeekday() uint32 {
+	if m != nil {
+		return m.Weekday
+	}
+	return 0
+}
+
+func (m *CreateScheduledReportRequest) GetHour() uint32 {
+	if m != nil {
+		return m.Hour
+	}
+	return 0
+}
+
+func (m *CreateScheduledReportRequest) GetTimezone() string {
+	if m != nil {
+		return m.Timezone
+	}
+	return ""
+}
+
+func (m *CreateScheduledReportRequest) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *CreateScheduledReportRequest) GetRecipients() []string {
+	if m != nil {
+		return m.Recipients
+	}
+	return nil
+}
+
+func (m *CreateScheduledReportRequest) GetWebhookURL() string {
+	if m != nil {
+		return m.WebhookURL
+	}
+	return ""
+}
+
+type CreateScheduledReportResponse struct {
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *CreateScheduledReportResponse) Reset()                    { *m = CreateScheduledReportResponse{} }
+func (m *CreateScheduledReportResponse) String() string            { return proto.CompactTextString(m) }
+func (*CreateScheduledReportResponse) ProtoMessage()               {}
+func (*CreateScheduledReportResponse) Descriptor() ([]byte, []int) { return fileDescriptor11, []int{1} }
+
+func (m *CreateScheduledReportResponse) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type GetScheduledReportRequest struct {
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *GetScheduledReportRequest) Reset()                    { *m = GetScheduledReportRequest{} }
+func (m *GetScheduledReportRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetScheduledReportRequest) ProtoMessage()               {}
+func (*GetScheduledReportRequest) Descriptor() ([]byte, []int) { return fileDescriptor11, []int{2} }
+
+func (m *GetScheduledReportRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type GetScheduledReportResponse struct {
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	// name of the report
+	Name string `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	// hex encoded AppEUI of the application
+	AppEUI string `protobuf:"bytes,3,opt,name=appEUI" json:"appEUI,omitempty"`
+	// report type (DEVICE_ACTIVITY, OFFLINE_DEVICES)
+	ReportType string `protobuf:"bytes,4,opt,name=reportType" json:"reportType,omitempty"`
+	// report format (CSV, PDF)
+	Format string `protobuf:"bytes,5,opt,name=format" json:"format,omitempty"`
+	// schedule (DAILY, WEEKLY)
+	Schedule string `protobuf:"bytes,6,opt,name=schedule" json:"schedule,omitempty"`
+	// day of the week (0 = sunday, WEEKLY schedule)
+	Weekday uint32 `protobuf:"varint,7,opt,name=weekday" json:"weekday,omitempty"`
+	// hour of the day (0 - 23)
+	Hour uint32 `protobuf:"varint,8,opt,name=hour" json:"hour,omitempty"`
+	// IANA timezone of the schedule
+	Timezone string `protobuf:"bytes,9,opt,name=timezone" json:"timezone,omitempty"`
+	// delivery channel (EMAIL, WEBHOOK)
+	Channel string `protobuf:"bytes,10,opt,name=channel" json:"channel,omitempty"`
+	// email addresses of the recipients (EMAIL channel)
+	Recipients []string `protobuf:"bytes,11,rep,name=recipients" json:"recipients,omitempty"`
+	// webhook url (WEBHOOK channel)
+	WebhookURL string `protobuf:"bytes,12,opt,name=webhookURL" json:"webhookURL,omitempty"`
+	// next run (RFC3339 timestamp)
+	NextRunAt string `protobuf:"bytes,13,opt,name=nextRunAt" json:"nextRunAt,omitempty"`
+	// last run (RFC3339 timestamp, empty when never run)
+	LastRunAt string `protobuf:"bytes,14,opt,name=lastRunAt" json:"lastRunAt,omitempty"`
+}
+
+func (m *GetScheduledReportResponse) Reset()                    { *m = GetScheduledReportResponse{} }
+func (m *GetScheduledReportResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetScheduledReportResponse) ProtoMessage()               {}
+func (*GetScheduledReportResponse) Descriptor() ([]byte, []int) { return fileDescriptor11, []int{3} }
+
+func (m *GetScheduledReportResponse) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *GetScheduledReportResponse) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *GetScheduledReportResponse) GetAppEUI() string {
+	if m != nil {
+		return m.AppEUI
+	}
+	return ""
+}
+
+func (m *GetScheduledReportResponse) GetReportType() string {
+	if m != nil {
+		return m.ReportType
+	}
+	return ""
+}
+
+func (m *GetScheduledReportResponse) GetFormat() string {
+	if m != nil {
+		return m.Format
+	}
+	return ""
+}
+
+func (m *GetScheduledReportResponse) GetSchedule() string {
+	if m != nil {
+		return m.Schedule
+	}
+	return ""
+}
+
+func (m *GetScheduledReportResponse) GetWeekday() uint32 {
+	if m != nil {
+		return m.Weekday
+	}
+	return 0
+}
+
+func (m *GetScheduledReportResponse) GetHour() uint32 {
+	if m != nil {
+		return m.Hour
+	}
+	return 0
+}
+
+func (m *GetScheduledReportResponse) GetTimezone() string {
+	if m != nil {
+		return m.Timezone
+	}
+	return ""
+}
+
+func (m *GetScheduledReportResponse) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *GetScheduledReportResponse) GetRecipients() []string {
+	if m != nil {
+		return m.Recipients
+	}
+	return nil
+}
+
+func (m *GetScheduledReportResponse) GetWebhookURL() string {
+	if m != nil {
+		return m.WebhookURL
+	}
+	return ""
+}
+
+func (m *GetScheduledReportResponse) GetNextRunAt() string {
+	if m != nil {
+		return m.NextRunAt
+	}
+	return ""
+}
+
+func (m *GetScheduledReportResponse) GetLastRunAt() string {
+	if m != nil {
+		return m.LastRunAt
+	}
+	return ""
+}
+
+type ListScheduledReportByAppEUIRequest struct {
+	// hex encoded AppEUI of the application
+	AppEUI string `protobuf:"bytes,1,opt,name=appEUI" json:"appEUI,omitempty"`
+}
+
+func (m *ListScheduledReportByAppEUIRequest) Reset()         { *m = ListScheduledReportByAppEUIRequest{} }
+func (m *ListScheduledReportByAppEUIRequest) String() string { return proto.CompactTextString(m) }
+func (*ListScheduledReportByAppEUIRequest) ProtoMessage()    {}
+func (*ListScheduledReportByAppEUIRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor11, []int{4}
+}
+
+func (m *ListScheduledReportByAppEUIRequest) GetAppEUI() string {
+	if m != nil {
+		return m.AppEUI
+	}
+	return ""
+}
+
+type ListScheduledReportResponse struct {
+	Result []*GetScheduledReportResponse `protobuf:"bytes,1,rep,name=result" json:"result,omitempty"`
+}
+
+func (m *ListScheduledReportResponse) Reset()                    { *m = ListScheduledReportResponse{} }
+func (m *ListScheduledReportResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListScheduledReportResponse) ProtoMessage()               {}
+func (*ListScheduledReportResponse) Descriptor() ([]byte, []int) { return fileDescriptor11, []int{5} }
+
+func (m *ListScheduledReportResponse) GetResult() []*GetScheduledReportResponse {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type UpdateScheduledReportRequest struct {
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	// name of the report
+	Name string `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	// hex encoded AppEUI of the application
+	AppEUI string `protobuf:"bytes,3,opt,name=appEUI" json:"appEUI,omitempty"`
+	// report type (DEVICE_ACTIVITY, OFFLINE_DEVICES)
+	ReportType string `protobuf:"bytes,4,opt,name=reportType" json:"reportType,omitempty"`
+	// report format (CSV, PDF)
+	Format string `protobuf:"bytes,5,opt,name=format" json:"format,omitempty"`
+	// schedule (DAILY, WEEKLY)
+	Schedule string `protobuf:"bytes,6,opt,name=schedule" json:"schedule,omitempty"`
+	// day of the week (0 = sunday, WEEKLY schedule)
+	Weekday uint32 `protobuf:"varint,7,opt,name=weekday" json:"weekday,omitempty"`
+	// hour of the day (0 - 23)
+	Hour uint32 `protobuf:"varint,8,opt,name=hour" json:"hour,omitempty"`
+	// IANA timezone of the schedule (e.g. Europe/Amsterdam, default UTC)
+	Timezone string `protobuf:"bytes,9,opt,name=timezone" json:"timezone,omitempty"`
+	// delivery channel (EMAIL, WEBHOOK)
+	Channel string `protobuf:"bytes,10,opt,name=channel" json:"channel,omitempty"`
+	// email addresses of the recipients (EMAIL channel)
+	Recipients []string `protobuf:"bytes,11,rep,name=recipients" json:"recipients,omitempty"`
+	// webhook url (WEBHOOK channel)
+	WebhookURL string `protobuf:"bytes,12,opt,name=webhookURL" json:"webhookURL,omitempty"`
+}
+
+func (m *UpdateScheduledReportRequest) Reset()                    { *m = UpdateScheduledReportRequest{} }
+func (m *UpdateScheduledReportRequest) String() string            { return proto.CompactTextString(m) }
+func (*UpdateScheduledReportRequest) ProtoMessage()               {}
+func (*UpdateScheduledReportRequest) Descriptor() ([]byte, []int) { return fileDescriptor11, []int{6} }
+
+func (m *UpdateScheduledReportRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *UpdateScheduledReportRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *UpdateScheduledReportRequest) GetAppEUI() string {
+	if m != nil {
+		return m.AppEUI
+	}
+	return ""
+}
+
+func (m *UpdateScheduledReportRequest) GetReportType() string {
+	if m != nil {
+		return m.ReportType
+	}
+	return ""
+}
+
+func (m *UpdateScheduledReportRequest) GetFormat() string {
+	if m != nil {
+		return m.Format
+	}
+	return ""
+}
+
+func (m *UpdateScheduledReportRequest) GetSchedule() string {
+	if m != nil {
+		return m.Schedule
+	}
+	return ""
+}
+
+func (m *UpdateScheduledReportRequest) GetWeekday() uint32 {
+	if m != nil {
+		return m.Weekday
+	}
+	return 0
+}
+
+func (m *UpdateScheduledReportRequest) GetHour() uint32 {
+	if m != nil {
+		return m.Hour
+	}
+	return 0
+}
+
+func (m *UpdateScheduledReportRequest) GetTimezone() string {
+	if m != nil {
+		return m.Timezone
+	}
+	return ""
+}
+
+func (m *UpdateScheduledReportRequest) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *UpdateScheduledReportRequest) GetRecipients() []string {
+	if m != nil {
+		return m.Recipients
+	}
+	return nil
+}
+
+func (m *UpdateScheduledReportRequest) GetWebhookURL() string {
+	if m != nil {
+		return m.WebhookURL
+	}
+	return ""
+}
+
+type UpdateScheduledReportResponse struct {
+}
+
+func (m *UpdateScheduledReportResponse) Reset()                    { *m = UpdateScheduledReportResponse{} }
+func (m *UpdateScheduledReportResponse) String() string            { return proto.CompactTextString(m) }
+func (*UpdateScheduledReportResponse) ProtoMessage()               {}
+func (*UpdateScheduledReportResponse) Descriptor() ([]byte, []int) { return fileDescriptor11, []int{7} }
+
+type DeleteScheduledReportRequest struct {
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *DeleteScheduledReportRequest) Reset()                    { *m = DeleteScheduledReportRequest{} }
+func (m *DeleteScheduledReportRequest) String() string            { return proto.CompactTextString(m) }
+func (*DeleteScheduledReportRequest) ProtoMessage()               {}
+func (*DeleteScheduledReportRequest) Descriptor() ([]byte, []int) { return fileDescriptor11, []int{8} }
+
+func (m *DeleteScheduledReportRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type DeleteScheduledReportResponse struct {
+}
+
+func (m *DeleteScheduledReportResponse) Reset()                    { *m = DeleteScheduledReportResponse{} }
+func (m *DeleteScheduledReportResponse) String() string            { return proto.CompactTextString(m) }
+func (*DeleteScheduledReportResponse) ProtoMessage()               {}
+func (*DeleteScheduledReportResponse) Descriptor() ([]byte, []int) { return fileDescriptor11, []int{9} }
+
+func init() {
+	proto.RegisterType((*CreateScheduledReportRequest)(nil), "api.CreateScheduledReportRequest")
+	proto.RegisterType((*CreateScheduledReportResponse)(nil), "api.CreateScheduledReportResponse")
+	proto.RegisterType((*GetScheduledReportRequest)(nil), "api.GetScheduledReportRequest")
+	proto.RegisterType((*GetScheduledReportResponse)(nil), "api.GetScheduledReportResponse")
+	proto.RegisterType((*ListScheduledReportByAppEUIRequest)(nil), "api.ListScheduledReportByAppEUIRequest")
+	proto.RegisterType((*ListScheduledReportResponse)(nil), "api.ListScheduledReportResponse")
+	proto.RegisterType((*UpdateScheduledReportRequest)(nil), "api.UpdateScheduledReportRequest")
+	proto.RegisterType((*UpdateScheduledReportResponse)(nil), "api.UpdateScheduledReportResponse")
+	proto.RegisterType((*DeleteScheduledReportRequest)(nil), "api.DeleteScheduledReportRequest")
+	proto.RegisterType((*DeleteScheduledReportResponse)(nil), "api.DeleteScheduledReportResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for ScheduledReport service
+
+type ScheduledReportClient interface {
+	// Create creates the given scheduled report.
+	Create(ctx context.Context, in *CreateScheduledReportRequest, opts ...grpc.CallOption) (*CreateScheduledReportResponse, error)
+	// Get returns the scheduled report matching the given id.
+	Get(ctx context.Context, in *GetScheduledReportRequest, opts ...grpc.CallOption) (*GetScheduledReportResponse, error)
+	// ListByAppEUI lists the scheduled reports of the given application.
+	ListByAppEUI(ctx context.Context, in *ListScheduledReportByAppEUIRequest, opts ...grpc.CallOption) (*ListScheduledReportResponse, error)
+	// Update updates the scheduled report matching the given id.
+	Update(ctx context.Context, in *UpdateScheduledReportRequest, opts ...grpc.CallOption) (*UpdateScheduledReportResponse, error)
+	// Delete deletes the scheduled report matching the given id.
+	Delete(ctx context.Context, in *DeleteScheduledReportRequest, opts ...grpc.CallOption) (*DeleteScheduledReportResponse, error)
+}
+
+type scheduledReportClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewScheduledReportClient(cc *grpc.ClientConn) ScheduledReportClient {
+	return &scheduledReportClient{cc}
+}
+
+func (c *scheduledReportClient) Create(ctx context.Context, in *CreateScheduledReportRequest, opts ...grpc.CallOption) (*CreateScheduledReportResponse, error) {
+	out := new(CreateScheduledReportResponse)
+	err := grpc.Invoke(ctx, "/api.ScheduledReport/Create", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scheduledReportClient) Get(ctx context.Context, in *GetScheduledReportRequest, opts ...grpc.CallOption) (*GetScheduledReportResponse, error) {
+	out := new(GetScheduledReportResponse)
+	err := grpc.Invoke(ctx, "/api.ScheduledReport/Get", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scheduledReportClient) ListByAppEUI(ctx context.Context, in *ListScheduledReportByAppEUIRequest, opts ...grpc.CallOption) (*ListScheduledReportResponse, error) {
+	out := new(ListScheduledReportResponse)
+	err := grpc.Invoke(ctx, "/api.ScheduledReport/ListByAppEUI", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scheduledReportClient) Update(ctx context.Context, in *UpdateScheduledReportRequest, opts ...grpc.CallOption) (*UpdateScheduledReportResponse, error) {
+	out := new(UpdateScheduledReportResponse)
+	err := grpc.Invoke(ctx, "/api.ScheduledReport/Update", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scheduledReportClient) Delete(ctx context.Context, in *DeleteScheduledReportRequest, opts ...grpc.CallOption) (*DeleteScheduledReportResponse, error) {
+	out := new(DeleteScheduledReportResponse)
+	err := grpc.Invoke(ctx, "/api.ScheduledReport/Delete", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for ScheduledReport service
+
+type ScheduledReportServer interface {
+	// Create creates the given scheduled report.
+	Create(context.Context, *CreateScheduledReportRequest) (*CreateScheduledReportResponse, error)
+	// Get returns the scheduled report matching the given id.
+	Get(context.Context, *GetScheduledReportRequest) (*GetScheduledReportResponse, error)
+	// ListByAppEUI lists the scheduled reports of the given application.
+	ListByAppEUI(context.Context, *ListScheduledReportByAppEUIRequest) (*ListScheduledReportResponse, error)
+	// Update updates the scheduled report matching the given id.
+	Update(context.Context, *UpdateScheduledReportRequest) (*UpdateScheduledReportResponse, error)
+	// Delete deletes the scheduled report matching the given id.
+	Delete(context.Context, *DeleteScheduledReportRequest) (*DeleteScheduledReportResponse, error)
+}
+
+func RegisterScheduledReportServer(s *grpc.Server, srv ScheduledReportServer) {
+	s.RegisterService(&_ScheduledReport_serviceDesc, srv)
+}
+
+func _ScheduledReport_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateScheduledReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScheduledReportServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ScheduledReport/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScheduledReportServer).Create(ctx, req.(*CreateScheduledReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScheduledReport_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetScheduledReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScheduledReportServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ScheduledReport/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScheduledReportServer).Get(ctx, req.(*GetScheduledReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScheduledReport_ListByAppEUI_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListScheduledReportByAppEUIRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScheduledReportServer).ListByAppEUI(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ScheduledReport/ListByAppEUI",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScheduledReportServer).ListByAppEUI(ctx, req.(*ListScheduledReportByAppEUIRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScheduledReport_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateScheduledReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScheduledReportServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ScheduledReport/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScheduledReportServer).Update(ctx, req.(*UpdateScheduledReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScheduledReport_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteScheduledReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScheduledReportServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ScheduledReport/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScheduledReportServer).Delete(ctx, req.(*DeleteScheduledReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ScheduledReport_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.ScheduledReport",
+	HandlerType: (*ScheduledReportServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _ScheduledReport_Create_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _ScheduledReport_Get_Handler,
+		},
+		{
+			MethodName: "ListByAppEUI",
+			Handler:    _ScheduledReport_ListByAppEUI_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _ScheduledReport_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _ScheduledReport_Delete_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "scheduledReport.proto",
+}
+
+func init() { proto.RegisterFile("scheduledReport.proto", fileDescriptor11) }
+
+var fileDescriptor11 = []byte{
+	// 625 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0xcf, 0x6e, 0xd4, 0x3e,
+	0x10, 0x56, 0x92, 0x6d, 0xda, 0x9d, 0xfe, 0xf9, 0x49, 0x96, 0xfa, 0x93, 0x09, 0xdb, 0x76, 0xb1,
+	0x84, 0xa8, 0x0a, 0xda, 0x95, 0xca, 0x01, 0x09, 0x71, 0x29, 0x7f, 0x54, 0x21, 0xf5, 0x14, 0x28,
+	0x77, 0x77, 0x33, 0x74, 0x4d, 0xd3, 0xd8, 0x4d, 0xbc, 0x94, 0x52, 0xf5, 0xc2, 0x19, 0x4e, 0xbc,
+	0x11, 0x8f, 0x00, 0xaf, 0xc0, 0x95, 0x77, 0x40, 0xb1, 0x93, 0x6d, 0xbb, 0x8a, 0x53, 0xb8, 0x73,
+	0xcb, 0xcc, 0x7c, 0x9e, 0x99, 0x7c, 0xdf, 0x4c, 0x1c, 0x58, 0x2d, 0x46, 0x63, 0x4c, 0x26, 0x29,
+	0x26, 0x31, 0x2a, 0x99, 0xeb, 0x81, 0xca, 0xa5, 0x96, 0x24, 0xe0, 0x4a, 0x44, 0xbd, 0x43, 0x29,
+	0x0f, 0x53, 0x1c, 0x72, 0x25, 0x86, 0x3c, 0xcb, 0xa4, 0xe6, 0x5a, 0xc8, 0xac, 0xb0, 0x10, 0xf6,
+	0xcd, 0x87, 0xde, 0xb3, 0x1c, 0xb9, 0xc6, 0x57, 0xd7, 0x53, 0xc4, 0x78, 0x32, 0xc1, 0x42, 0x13,
+	0x02, 0x9d, 0x8c, 0x1f, 0x23, 0xf5, 0xfa, 0xde, 0x66, 0x37, 0x36, 0xcf, 0xe4, 0x7f, 0x08, 0xb9,
+	0x52, 0x2f, 0xf6, 0x5f, 0x52, 0xdf, 0x78, 0x2b, 0x8b, 0xac, 0x03, 0xe4, 0xe6, 0xf0, 0xeb, 0x33,
+	0x85, 0x34, 0x30, 0xb1, 0x2b, 0x9e, 0xf2, 0xdc, 0x5b, 0x99, 0x1f, 0x73, 0x4d, 0x3b, 0xf6, 0x9c,
+	0xb5, 0x48, 0x04, 0x0b, 0xf5, 0x0b, 0xd0, 0x39, 0x13, 0x99, 0xda, 0x84, 0xc2, 0xfc, 0x29, 0xe2,
+	0x51, 0xc2, 0xcf, 0x68, 0xd8, 0xf7, 0x36, 0x97, 0xe3, 0xda, 0x2c, 0x3b, 0x1b, 0xcb, 0x49, 0x4e,
+	0xe7, 0x8d, 0xdb, 0x3c, 0x97, 0x99, 0xb4, 0x38, 0xc6, 0x8f, 0x32, 0x43, 0xba, 0x60, 0x33, 0xd5,
+	0x76, 0x99, 0x69, 0x34, 0xe6, 0x59, 0x86, 0x29, 0xed, 0x9a, 0x50, 0x6d, 0xda, 0xbe, 0x47, 0x42,
+	0x09, 0xcc, 0x74, 0x41, 0xa1, 0x1f, 0xd8, 0xbe, 0x6b, 0x4f, 0x19, 0x3f, 0xc5, 0x83, 0xb1, 0x94,
+	0x47, 0xfb, 0xf1, 0x1e, 0x5d, 0xb4, 0xef, 0x75, 0xe9, 0x61, 0x43, 0x58, 0x73, 0x70, 0x58, 0x28,
+	0x99, 0x15, 0x48, 0x56, 0xc0, 0x17, 0x89, 0xa1, 0x30, 0x88, 0x7d, 0x91, 0xb0, 0xfb, 0x70, 0x6b,
+	0x17, 0xb5, 0x83, 0xf1, 0x59, 0xf0, 0xe7, 0x00, 0xa2, 0x26, 0x74, 0x73, 0xee, 0xa9, 0x60, 0x7e,
+	0xa3, 0x60, 0x41, 0x8b, 0x60, 0x9d, 0x16, 0xc1, 0xe6, 0x9c, 0x82, 0x85, 0x6e, 0xc1, 0xe6, 0x9b,
+	0x05, 0x5b, 0x70, 0x08, 0xd6, 0x75, 0x0b, 0x06, 0x6d, 0x82, 0x2d, 0xde, 0x20, 0xd8, 0xd2, 0xac,
+	0x60, 0xa4, 0x07, 0xdd, 0x0c, 0x3f, 0xe8, 0x78, 0x92, 0xed, 0x68, 0xba, 0x6c, 0xc2, 0x97, 0x8e,
+	0x32, 0x9a, 0xf2, 0xa2, 0x8a, 0xae, 0xd8, 0xe8, 0xd4, 0xc1, 0x9e, 0x00, 0xdb, 0x13, 0xc5, 0xac,
+	0x1c, 0x4f, 0xcf, 0x76, 0x0c, 0xa5, 0xb5, 0x88, 0x97, 0x8c, 0x7b, 0x57, 0x19, 0x67, 0x6f, 0xe0,
+	0x76, 0xc3, 0xe9, 0xa9, 0x98, 0x8f, 0x20, 0xcc, 0xb1, 0x98, 0xa4, 0x9a, 0x7a, 0xfd, 0x60, 0x73,
+	0x71, 0x7b, 0x63, 0xc0, 0x95, 0x18, 0xb8, 0xd5, 0x8f, 0x2b, 0x38, 0xfb, 0xee, 0x43, 0x6f, 0x5f,
+	0x25, 0xee, 0x3d, 0xfe, 0x37, 0x26, 0x7f, 0x3d, 0x26, 0x6c, 0x03, 0xd6, 0x1c, 0x9c, 0x5a, 0xf6,
+	0xd9, 0x00, 0x7a, 0xcf, 0x31, 0xc5, 0x3f, 0x25, 0xbd, 0x4c, 0xe8, 0xc0, 0xdb, 0x84, 0xdb, 0xbf,
+	0x3a, 0xf0, 0xdf, 0x4c, 0x8c, 0x9c, 0x40, 0x68, 0xbf, 0x2e, 0xe4, 0x8e, 0x99, 0x86, 0xb6, 0xcf,
+	0x75, 0xc4, 0xda, 0x20, 0x55, 0xd7, 0xfd, 0x4f, 0x3f, 0x7e, 0x7e, 0xf5, 0x23, 0xb6, 0x6a, 0xee,
+	0x84, 0x99, 0xab, 0xa3, 0x78, 0xec, 0x6d, 0x91, 0x77, 0x10, 0xec, 0xa2, 0x26, 0xeb, 0xce, 0xe9,
+	0xb3, 0xc5, 0x6e, 0x9a, 0x4e, 0xc6, 0x4c, 0xa5, 0x1e, 0x89, 0x1a, 0x2b, 0x0d, 0xcf, 0x45, 0x72,
+	0x41, 0xbe, 0x78, 0xb0, 0x54, 0xae, 0x44, 0xbd, 0x41, 0xe4, 0x9e, 0xc9, 0x7a, 0xf3, 0x8e, 0x45,
+	0x7d, 0x17, 0x70, 0x5a, 0x7f, 0xdb, 0xd4, 0x7f, 0x40, 0xb6, 0x9a, 0xeb, 0x73, 0xa5, 0x52, 0x31,
+	0x32, 0xf7, 0xe1, 0xf0, 0xdc, 0xce, 0xfa, 0x05, 0x79, 0x0f, 0xa1, 0x15, 0xbd, 0xa2, 0xbb, 0x6d,
+	0xab, 0x22, 0xd6, 0x06, 0xa9, 0x9a, 0xb8, 0x6b, 0x9a, 0xd8, 0x88, 0x5a, 0x48, 0x28, 0x39, 0x2f,
+	0x20, 0xb4, 0xb3, 0x51, 0xd5, 0x6d, 0x1b, 0xac, 0x88, 0xb5, 0x41, 0xae, 0x93, 0xbf, 0xd5, 0x52,
+	0xf7, 0x20, 0x34, 0x7f, 0x01, 0x0f, 0x7f, 0x0f, 0x00, 0x52, 0xee, 0xe3, 0x25, 0x41, 0x08, 0x00,
+	0x00,
+}
@@ -0,0 +1,464 @@
+// Code generated by protoc-gen-go.
+// source: signingKey.proto
+// DO NOT EDIT!
+
+package api
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import _ "github.com/grpc-ecosystem/grpc-gateway/third_party/googleapis/google/api"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+type CreateSigningKeyRequest struct {
+	// hex encoded AppEUI
+	AppEUI string `protobuf:"bytes,1,opt,name=appEUI" json:"appEUI,omitempty"`
+	// signing algorithm (ES256 or HS256, default ES256)
+	Algorithm string `protobuf:"bytes,2,opt,name=algorithm" json:"algorithm,omitempty"`
+}
+
+func (m *CreateSigningKeyRequest) Reset()                    { *m = CreateSigningKeyRequest{} }
+func (m *CreateSigningKeyRequest) String() string            { return proto.CompactTextString(m) }
+func (*CreateSigningKeyRequest) ProtoMessage()               {}
+func (*CreateSigningKeyRequest) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{0} }
+
+func (m *CreateSigningKeyRequest) GetAppEUI() string {
+	if m != nil {
+		return m.AppEUI
+	}
+	return ""
+}
+
+func (m *CreateSigningKeyRequest) GetAlgorithm() string {
+	if m != nil {
+		return m.Algorithm
+	}
+	return ""
+}
+
+type CreateSigningKeyResponse struct {
+	// ID of the created key (used as kid in the JWS header)
+	KeyID string `protobuf:"bytes,1,opt,name=keyID" json:"keyID,omitempty"`
+	// hex encoded HMAC secret (only returned for HS256 keys)
+	Secret string `protobuf:"bytes,2,opt,name=secret" json:"secret,omitempty"`
+}
+
+func (m *CreateSigningKeyResponse) Reset()                    { *m = CreateSigningKeyResponse{} }
+func (m *CreateSigningKeyResponse) String() string            { return proto.CompactTextString(m) }
+func (*CreateSigningKeyResponse) ProtoMessage()               {}
+func (*CreateSigningKeyResponse) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{1} }
+
+func (m *CreateSigningKeyResponse) GetKeyID() string {
+	if m != nil {
+		return m.KeyID
+	}
+	return ""
+}
+
+func (m *CreateSigningKeyResponse) GetSecret() string {
+	if m != nil {
+		return m.Secret
+	}
+	return ""
+}
+
+type ListSigningKeyRequest struct {
+	// hex encoded AppEUI
+	AppEUI string `protobuf:"bytes,1,opt,name=appEUI" json:"appEUI,omitempty"`
+}
+
+func (m *ListSigningKeyRequest) Reset()                    { *m = ListSigningKeyRequest{} }
+func (m *ListSigningKeyRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListSigningKeyRequest) ProtoMessage()               {}
+func (*ListSigningKeyRequest) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{2} }
+
+func (m *ListSigningKeyRequest) GetAppEUI() string {
+	if m != nil {
+		return m.AppEUI
+	}
+	return ""
+}
+
+type SigningKeyItem struct {
+	// ID of the key (used as kid in the JWS header)
+	KeyID string `protobuf:"bytes,1,opt,name=keyID" json:"keyID,omitempty"`
+	// signing algorithm
+	Algorithm string `protobuf:"bytes,2,opt,name=algorithm" json:"algorithm,omitempty"`
+	// creation timestamp (RFC3339)
+	CreatedAt string `protobuf:"bytes,3,opt,name=createdAt" json:"createdAt,omitempty"`
+	// expiration timestamp (RFC3339, empty when the key does not expire)
+	ExpiresAt string `protobuf:"bytes,4,opt,name=expiresAt" json:"expiresAt,omitempty"`
+}
+
+func (m *SigningKeyItem) Reset()                    { *m = SigningKeyItem{} }
+func (m *SigningKeyItem) String() string            { return proto.CompactTextString(m) }
+func (*SigningKeyItem) ProtoMessage()               {}
+func (*SigningKeyItem) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{3} }
+
+func (m *SigningKeyItem) GetKeyID() string {
+	if m != nil {
+		return m.KeyID
+	}
+	return ""
+}
+
+func (m *SigningKeyItem) GetAlgorithm() string {
+	if m != nil {
+		return m.Algorithm
+	}
+	return ""
+}
+
+func (m *SigningKeyItem) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return ""
+}
+
+func (m *SigningKeyItem) GetExpiresAt() string {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return ""
+}
+
+type ListSigningKeyResponse struct {
+	Result []*SigningKeyItem `protobuf:"bytes,1,rep,name=result" json:"result,omitempty"`
+}
+
+func (m *ListSigningKeyResponse) Reset()                    { *m = ListSigningKeyResponse{} }
+func (m *ListSigningKeyResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListSigningKeyResponse) ProtoMessage()               {}
+func (*ListSigningKeyResponse) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{4} }
+
+func (m *ListSigningKeyResponse) GetResult() []*SigningKeyItem {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type RotateSigningKeyRequest struct {
+	// hex encoded AppEUI
+	AppEUI string `protobuf:"bytes,1,opt,name=appEUI" json:"appEUI,omitempty"`
+	// signing algorithm of the new key (ES256 or HS256, default ES256)
+	Algorithm string `protobuf:"bytes,2,opt,name=algorithm" json:"algorithm,omitempty"`
+	// number of seconds the existing keys remain valid
+	Overlap uint32 `protobuf:"varint,3,opt,name=overlap" json:"overlap,omitempty"`
+}
+
+func (m *RotateSigningKeyRequest) Reset()                    { *m = RotateSigningKeyRequest{} }
+func (m *RotateSigningKeyRequest) String() string            { return proto.CompactTextString(m) }
+func (*RotateSigningKeyRequest) ProtoMessage()               {}
+func (*RotateSigningKeyRequest) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{5} }
+
+func (m *RotateSigningKeyRequest) GetAppEUI() string {
+	if m != nil {
+		return m.AppEUI
+	}
+	return ""
+}
+
+func (m *RotateSigningKeyRequest) GetAlgorithm() string {
+	if m != nil {
+		return m.Algorithm
+	}
+	return ""
+}
+
+func (m *RotateSigningKeyRequest) GetOverlap() uint32 {
+	if m != nil {
+		return m.Overlap
+	}
+	return 0
+}
+
+type RotateSigningKeyResponse struct {
+	// ID of the created key (used as kid in the JWS header)
+	KeyID string `protobuf:"bytes,1,opt,name=keyID" json:"keyID,omitempty"`
+	// hex encoded HMAC secret (only returned for HS256 keys)
+	Secret string `protobuf:"bytes,2,opt,name=secret" json:"secret,omitempty"`
+}
+
+func (m *RotateSigningKeyResponse) Reset()                    { *m = RotateSigningKeyResponse{} }
+func (m *RotateSigningKeyResponse) String() string            { return proto.CompactTextString(m) }
+func (*RotateSigningKeyResponse) ProtoMessage()               {}
+func (*RotateSigningKeyResponse) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{6} }
+
+func (m *RotateSigningKeyResponse) GetKeyID() string {
+	if m != nil {
+		return m.KeyID
+	}
+	return ""
+}
+
+func (m *RotateSigningKeyResponse) GetSecret() string {
+	if m != nil {
+		return m.Secret
+	}
+	return ""
+}
+
+type DeleteSigningKeyRequest struct {
+	// ID of the key
+	KeyID string `protobuf:"bytes,1,opt,name=keyID" json:"keyID,omitempty"`
+}
+
+func (m *DeleteSigningKeyRequest) Reset()                    { *m = DeleteSigningKeyRequest{} }
+func (m *DeleteSigningKeyRequest) String() string            { return proto.CompactTextString(m) }
+func (*DeleteSigningKeyRequest) ProtoMessage()               {}
+func (*DeleteSigningKeyRequest) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{7} }
+
+func (m *DeleteSigningKeyRequest) GetKeyID() string {
+	if m != nil {
+		return m.KeyID
+	}
+	return ""
+}
+
+type DeleteSigningKeyResponse struct {
+}
+
+func (m *DeleteSigningKeyResponse) Reset()                    { *m = DeleteSigningKeyResponse{} }
+func (m *DeleteSigningKeyResponse) String() string            { return proto.CompactTextString(m) }
+func (*DeleteSigningKeyResponse) ProtoMessage()               {}
+func (*DeleteSigningKeyResponse) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{8} }
+
+func init() {
+	proto.RegisterType((*CreateSigningKeyRequest)(nil), "api.CreateSigningKeyRequest")
+	proto.RegisterType((*CreateSigningKeyResponse)(nil), "api.CreateSigningKeyResponse")
+	proto.RegisterType((*ListSigningKeyRequest)(nil), "api.ListSigningKeyRequest")
+	proto.RegisterType((*SigningKeyItem)(nil), "api.SigningKeyItem")
+	proto.RegisterType((*ListSigningKeyResponse)(nil), "api.ListSigningKeyResponse")
+	proto.RegisterType((*RotateSigningKeyRequest)(nil), "api.RotateSigningKeyRequest")
+	proto.RegisterType((*RotateSigningKeyResponse)(nil), "api.RotateSigningKeyResponse")
+	proto.RegisterType((*DeleteSigningKeyRequest)(nil), "api.DeleteSigningKeyRequest")
+	proto.RegisterType((*DeleteSigningKeyResponse)(nil), "api.DeleteSigningKeyResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for SigningKey service
+
+type SigningKeyClient interface {
+	// Create creates a new signing key for the given application.
+	Create(ctx context.Context, in *CreateSigningKeyRequest, opts ...grpc.CallOption) (*CreateSigningKeyResponse, error)
+	// List lists the signing keys of the given application.
+	List(ctx context.Context, in *ListSigningKeyRequest, opts ...grpc.CallOption) (*ListSigningKeyResponse, error)
+	// Rotate creates a new signing key for the given application and sets the
+	// expiration of the existing keys, so that they remain valid during the
+	// given overlap.
+	Rotate(ctx context.Context, in *RotateSigningKeyRequest, opts ...grpc.CallOption) (*RotateSigningKeyResponse, error)
+	// Delete deletes the signing key matching the given key ID.
+	Delete(ctx context.Context, in *DeleteSigningKeyRequest, opts ...grpc.CallOption) (*DeleteSigningKeyResponse, error)
+}
+
+type signingKeyClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewSigningKeyClient(cc *grpc.ClientConn) SigningKeyClient {
+	return &signingKeyClient{cc}
+}
+
+func (c *signingKeyClient) Create(ctx context.Context, in *CreateSigningKeyRequest, opts ...grpc.CallOption) (*CreateSigningKeyResponse, error) {
+	out := new(CreateSigningKeyResponse)
+	err := grpc.Invoke(ctx, "/api.SigningKey/Create", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *signingKeyClient) List(ctx context.Context, in *ListSigningKeyRequest, opts ...grpc.CallOption) (*ListSigningKeyResponse, error) {
+	out := new(ListSigningKeyResponse)
+	err := grpc.Invoke(ctx, "/api.SigningKey/List", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *signingKeyClient) Rotate(ctx context.Context, in *RotateSigningKeyRequest, opts ...grpc.CallOption) (*RotateSigningKeyResponse, error) {
+	out := new(RotateSigningKeyResponse)
+	err := grpc.Invoke(ctx, "/api.SigningKey/Rotate", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *signingKeyClient) Delete(ctx context.Context, in *DeleteSigningKeyRequest, opts ...grpc.CallOption) (*DeleteSigningKeyResponse, error) {
+	out := new(DeleteSigningKeyResponse)
+	err := grpc.Invoke(ctx, "/api.SigningKey/Delete", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for SigningKey service
+
+type SigningKeyServer interface {
+	// Create creates a new signing key for the given application.
+	Create(context.Context, *CreateSigningKeyRequest) (*CreateSigningKeyResponse, error)
+	// List lists the signing keys of the given application.
+	List(context.Context, *ListSigningKeyRequest) (*ListSigningKeyResponse, error)
+	// Rotate creates a new signing key for the given application and sets the
+	// expiration of the existing keys, so that they remain valid during the
+	// given overlap.
+	Rotate(context.Context, *RotateSigningKeyRequest) (*RotateSigningKeyResponse, error)
+	// Delete deletes the signing key matching the given key ID.
+	Delete(context.Context, *DeleteSigningKeyRequest) (*DeleteSigningKeyResponse, error)
+}
+
+func RegisterSigningKeyServer(s *grpc.Server, srv SigningKeyServer) {
+	s.RegisterService(&_SigningKey_serviceDesc, srv)
+}
+
+func _SigningKey_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSigningKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SigningKeyServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.SigningKey/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SigningKeyServer).Create(ctx, req.(*CreateSigningKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SigningKey_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSigningKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SigningKeyServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.SigningKey/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SigningKeyServer).List(ctx, req.(*ListSigningKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SigningKey_Rotate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateSigningKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SigningKeyServer).Rotate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.SigningKey/Rotate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SigningKeyServer).Rotate(ctx, req.(*RotateSigningKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SigningKey_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSigningKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SigningKeyServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.SigningKey/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SigningKeyServer).Delete(ctx, req.(*DeleteSigningKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _SigningKey_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.SigningKey",
+	HandlerType: (*SigningKeyServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _SigningKey_Create_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _SigningKey_List_Handler,
+		},
+		{
+			MethodName: "Rotate",
+			Handler:    _SigningKey_Rotate_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _SigningKey_Delete_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "signingKey.proto",
+}
+
+func init() { proto.RegisterFile("signingKey.proto", fileDescriptor5) }
+
+var fileDescriptor5 = []byte{
+	// 437 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xd1, 0x6a, 0x14, 0x31,
+	0x14, 0x65, 0xba, 0x75, 0xa4, 0x57, 0x94, 0x12, 0x6d, 0x37, 0x66, 0x77, 0x61, 0x0d, 0x08, 0xa5,
+	0x85, 0x1d, 0xa8, 0x6f, 0xbe, 0x15, 0x5b, 0x70, 0x51, 0x10, 0x46, 0xfc, 0x80, 0x6c, 0xbd, 0x8c,
+	0xc1, 0xe9, 0x24, 0x26, 0xa9, 0x58, 0xa4, 0x20, 0xfe, 0x82, 0x5f, 0xe2, 0xb7, 0xf8, 0x0b, 0x7e,
+	0x88, 0x4c, 0x12, 0x77, 0x70, 0x67, 0x22, 0x62, 0x1f, 0xef, 0x3d, 0xc9, 0x39, 0xf7, 0xdc, 0x93,
+	0x19, 0xd8, 0xb5, 0xb2, 0x6a, 0x64, 0x53, 0xbd, 0xc0, 0xab, 0x85, 0x36, 0xca, 0x29, 0x32, 0x12,
+	0x5a, 0xb2, 0x69, 0xa5, 0x54, 0x55, 0x63, 0x21, 0xb4, 0x2c, 0x44, 0xd3, 0x28, 0x27, 0x9c, 0x54,
+	0x8d, 0x0d, 0x47, 0xf8, 0x2b, 0x18, 0x3f, 0x33, 0x28, 0x1c, 0xbe, 0x5e, 0x5f, 0x2e, 0xf1, 0xc3,
+	0x25, 0x5a, 0x47, 0xf6, 0x21, 0x17, 0x5a, 0x9f, 0xbd, 0x59, 0xd2, 0x6c, 0x9e, 0x1d, 0xec, 0x94,
+	0xb1, 0x22, 0x53, 0xd8, 0x11, 0x75, 0xa5, 0x8c, 0x74, 0xef, 0x2e, 0xe8, 0x96, 0x87, 0xba, 0x06,
+	0x7f, 0x0e, 0xb4, 0x4f, 0x68, 0xb5, 0x6a, 0x2c, 0x92, 0x07, 0x70, 0xeb, 0x3d, 0x5e, 0x2d, 0x4f,
+	0x23, 0x61, 0x28, 0x5a, 0x1d, 0x8b, 0xe7, 0x06, 0x5d, 0x24, 0x8b, 0x15, 0x2f, 0x60, 0xef, 0xa5,
+	0xb4, 0xee, 0x9f, 0x07, 0xe3, 0x5f, 0x32, 0xb8, 0xd7, 0x9d, 0x5e, 0x3a, 0xbc, 0x48, 0x28, 0xfe,
+	0xd5, 0x41, 0x8b, 0x9e, 0x7b, 0x07, 0x6f, 0x4f, 0x1c, 0x1d, 0x05, 0x74, 0xdd, 0x68, 0x51, 0xfc,
+	0xa4, 0xa5, 0x41, 0x7b, 0xe2, 0xe8, 0x76, 0x40, 0xd7, 0x0d, 0x7e, 0x06, 0xfb, 0x9b, 0x33, 0x47,
+	0xef, 0x47, 0x90, 0x1b, 0xb4, 0x97, 0xb5, 0xa3, 0xd9, 0x7c, 0x74, 0x70, 0xe7, 0xf8, 0xfe, 0x42,
+	0x68, 0xb9, 0xf8, 0x73, 0xdc, 0x32, 0x1e, 0xe1, 0x12, 0xc6, 0xa5, 0x72, 0x1b, 0x4b, 0xbc, 0x41,
+	0x2a, 0x84, 0xc2, 0x6d, 0xf5, 0x11, 0x4d, 0x2d, 0xb4, 0x77, 0x74, 0xb7, 0xfc, 0x5d, 0xb6, 0x79,
+	0xf5, 0xa5, 0xfe, 0x33, 0xaf, 0xf1, 0x29, 0xd6, 0x38, 0x34, 0xf4, 0x20, 0x11, 0x67, 0x40, 0xfb,
+	0x17, 0x82, 0xf4, 0xf1, 0xf7, 0x11, 0x40, 0xd7, 0x26, 0x2b, 0xc8, 0xc3, 0xab, 0x22, 0x53, 0xbf,
+	0xb7, 0xc4, 0x9b, 0x65, 0xb3, 0x04, 0x1a, 0x58, 0xf9, 0xe4, 0xeb, 0x8f, 0x9f, 0xdf, 0xb6, 0xf6,
+	0xf8, 0xae, 0xff, 0x1a, 0xba, 0xef, 0xc5, 0x3e, 0xcd, 0x0e, 0xc9, 0x0a, 0xb6, 0xdb, 0xec, 0x08,
+	0xf3, 0x1c, 0x83, 0x4f, 0x8f, 0x4d, 0x06, 0xb1, 0xc8, 0xfe, 0xc8, 0xb3, 0x4f, 0xc8, 0xc3, 0x4d,
+	0xf6, 0xe2, 0x73, 0x08, 0xe9, 0x9a, 0x18, 0xc8, 0xc3, 0xb6, 0xa3, 0x8f, 0x44, 0xca, 0x6c, 0x96,
+	0x40, 0xa3, 0xd2, 0x91, 0x57, 0x7a, 0xcc, 0xe7, 0x49, 0xa5, 0xc2, 0xf8, 0xbb, 0xad, 0xaf, 0x0a,
+	0xf2, 0xb0, 0xe6, 0xa8, 0x99, 0x08, 0x89, 0xcd, 0x12, 0x68, 0xd4, 0x9c, 0x7b, 0x4d, 0x76, 0x48,
+	0xfb, 0x9a, 0x3e, 0xce, 0xeb, 0x55, 0xee, 0x7f, 0x29, 0x4f, 0x7e, 0x0d, 0x00, 0xd0, 0xc6, 0xd3,
+	0x01, 0x89, 0x04, 0x00, 0x00,
+}
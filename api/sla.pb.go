@@ -0,0 +1,361 @@
+// Code generated by protoc-gen-go.
+// source: sla.proto
+// DO NOT EDIT!
+
+package api
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import _ "github.com/grpc-ecosystem/grpc-gateway/third_party/googleapis/google/api"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+type GetNodeSLAReportRequest struct {
+	// hex encoded DevEUI
+	DevEUI string `protobuf:"bytes,1,opt,name=devEUI" json:"devEUI,omitempty"`
+	// start of the period (RFC3339)
+	Start string `protobuf:"bytes,2,opt,name=start" json:"start,omitempty"`
+	// end of the period (RFC3339, default now)
+	End string `protobuf:"bytes,3,opt,name=end" json:"end,omitempty"`
+}
+
+func (m *GetNodeSLAReportRequest) Reset()                    { *m = GetNodeSLAReportRequest{} }
+func (m *GetNodeSLAReportRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetNodeSLAReportRequest) ProtoMessage()               {}
+func (*GetNodeSLAReportRequest) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{0} }
+
+func (m *GetNodeSLAReportRequest) GetDevEUI() string {
+	if m != nil {
+		return m.DevEUI
+	}
+	return ""
+}
+
+func (m *GetNodeSLAReportRequest) GetStart() string {
+	if m != nil {
+		return m.Start
+	}
+	return ""
+}
+
+func (m *GetNodeSLAReportRequest) GetEnd() string {
+	if m != nil {
+		return m.End
+	}
+	return ""
+}
+
+type NodeSLAReport struct {
+	// hex encoded DevEUI
+	DevEUI string `protobuf:"bytes,1,opt,name=devEUI" json:"devEUI,omitempty"`
+	// expected interval (in seconds) between uplink transmissions
+	UplinkInterval uint32 `protobuf:"varint,2,opt,name=uplinkInterval" json:"uplinkInterval,omitempty"`
+	// number of expected uplinks
+	ExpectedUplinks int64 `protobuf:"varint,3,opt,name=expectedUplinks" json:"expectedUplinks,omitempty"`
+	// number of received uplinks
+	ReceivedUplinks int64 `protobuf:"varint,4,opt,name=receivedUplinks" json:"receivedUplinks,omitempty"`
+	// fraction (0 - 1) of the expected uplinks that was received
+	Availability float64 `protobuf:"fixed64,5,opt,name=availability" json:"availability,omitempty"`
+}
+
+func (m *NodeSLAReport) Reset()                    { *m = NodeSLAReport{} }
+func (m *NodeSLAReport) String() string            { return proto.CompactTextString(m) }
+func (*NodeSLAReport) ProtoMessage()               {}
+func (*NodeSLAReport) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{1} }
+
+func (m *NodeSLAReport) GetDevEUI() string {
+	if m != nil {
+		return m.DevEUI
+	}
+	return ""
+}
+
+func (m *NodeSLAReport) GetUplinkInterval() uint32 {
+	if m != nil {
+		return m.UplinkInterval
+	}
+	return 0
+}
+
+func (m *NodeSLAReport) GetExpectedUplinks() int64 {
+	if m != nil {
+		return m.ExpectedUplinks
+	}
+	return 0
+}
+
+func (m *NodeSLAReport) GetReceivedUplinks() int64 {
+	if m != nil {
+		return m.ReceivedUplinks
+	}
+	return 0
+}
+
+func (m *NodeSLAReport) GetAvailability() float64 {
+	if m != nil {
+		return m.Availability
+	}
+	return 0
+}
+
+type GetApplicationSLAReportRequest struct {
+	// hex encoded AppEUI
+	AppEUI string `protobuf:"bytes,1,opt,name=appEUI" json:"appEUI,omitempty"`
+	// start of the period (RFC3339)
+	Start string `protobuf:"bytes,2,opt,name=start" json:"start,omitempty"`
+	// end of the period (RFC3339, default now)
+	End string `protobuf:"bytes,3,opt,name=end" json:"end,omitempty"`
+}
+
+func (m *GetApplicationSLAReportRequest) Reset()                    { *m = GetApplicationSLAReportRequest{} }
+func (m *GetApplicationSLAReportRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetApplicationSLAReportRequest) ProtoMessage()               {}
+func (*GetApplicationSLAReportRequest) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{2} }
+
+func (m *GetApplicationSLAReportRequest) GetAppEUI() string {
+	if m != nil {
+		return m.AppEUI
+	}
+	return ""
+}
+
+func (m *GetApplicationSLAReportRequest) GetStart() string {
+	if m != nil {
+		return m.Start
+	}
+	return ""
+}
+
+func (m *GetApplicationSLAReportRequest) GetEnd() string {
+	if m != nil {
+		return m.End
+	}
+	return ""
+}
+
+type ApplicationSLAReport struct {
+	// hex encoded AppEUI
+	AppEUI string `protobuf:"bytes,1,opt,name=appEUI" json:"appEUI,omitempty"`
+	// start of the period (RFC3339)
+	Start string `protobuf:"bytes,2,opt,name=start" json:"start,omitempty"`
+	// end of the period (RFC3339)
+	End string `protobuf:"bytes,3,opt,name=end" json:"end,omitempty"`
+	// number of expected uplinks (nodes having an uplink interval)
+	ExpectedUplinks int64 `protobuf:"varint,4,opt,name=expectedUplinks" json:"expectedUplinks,omitempty"`
+	// number of received uplinks (nodes having an uplink interval)
+	ReceivedUplinks int64 `protobuf:"varint,5,opt,name=receivedUplinks" json:"receivedUplinks,omitempty"`
+	// fraction (0 - 1) of the expected uplinks that was received
+	Availability float64 `protobuf:"fixed64,6,opt,name=availability" json:"availability,omitempty"`
+	// reports of the nodes of the application
+	Nodes []*NodeSLAReport `protobuf:"bytes,7,rep,name=nodes" json:"nodes,omitempty"`
+}
+
+func (m *ApplicationSLAReport) Reset()                    { *m = ApplicationSLAReport{} }
+func (m *ApplicationSLAReport) String() string            { return proto.CompactTextString(m) }
+func (*ApplicationSLAReport) ProtoMessage()               {}
+func (*ApplicationSLAReport) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{3} }
+
+func (m *ApplicationSLAReport) GetAppEUI() string {
+	if m != nil {
+		return m.AppEUI
+	}
+	return ""
+}
+
+func (m *ApplicationSLAReport) GetStart() string {
+	if m != nil {
+		return m.Start
+	}
+	return ""
+}
+
+func (m *ApplicationSLAReport) GetEnd() string {
+	if m != nil {
+		return m.End
+	}
+	return ""
+}
+
+func (m *ApplicationSLAReport) GetExpectedUplinks() int64 {
+	if m != nil {
+		return m.ExpectedUplinks
+	}
+	return 0
+}
+
+func (m *ApplicationSLAReport) GetReceivedUplinks() int64 {
+	if m != nil {
+		return m.ReceivedUplinks
+	}
+	return 0
+}
+
+func (m *ApplicationSLAReport) GetAvailability() float64 {
+	if m != nil {
+		return m.Availability
+	}
+	return 0
+}
+
+func (m *ApplicationSLAReport) GetNodes() []*NodeSLAReport {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*GetNodeSLAReportRequest)(nil), "api.GetNodeSLAReportRequest")
+	proto.RegisterType((*NodeSLAReport)(nil), "api.NodeSLAReport")
+	proto.RegisterType((*GetApplicationSLAReportRequest)(nil), "api.GetApplicationSLAReportRequest")
+	proto.RegisterType((*ApplicationSLAReport)(nil), "api.ApplicationSLAReport")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for SLA service
+
+type SLAClient interface {
+	// GetNodeReport returns the availability report of the given node.
+	GetNodeReport(ctx context.Context, in *GetNodeSLAReportRequest, opts ...grpc.CallOption) (*NodeSLAReport, error)
+	// GetApplicationReport returns the availability report of the given application.
+	GetApplicationReport(ctx context.Context, in *GetApplicationSLAReportRequest, opts ...grpc.CallOption) (*ApplicationSLAReport, error)
+}
+
+type sLAClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewSLAClient(cc *grpc.ClientConn) SLAClient {
+	return &sLAClient{cc}
+}
+
+func (c *sLAClient) GetNodeReport(ctx context.Context, in *GetNodeSLAReportRequest, opts ...grpc.CallOption) (*NodeSLAReport, error) {
+	out := new(NodeSLAReport)
+	err := grpc.Invoke(ctx, "/api.SLA/GetNodeReport", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sLAClient) GetApplicationReport(ctx context.Context, in *GetApplicationSLAReportRequest, opts ...grpc.CallOption) (*ApplicationSLAReport, error) {
+	out := new(ApplicationSLAReport)
+	err := grpc.Invoke(ctx, "/api.SLA/GetApplicationReport", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for SLA service
+
+type SLAServer interface {
+	// GetNodeReport returns the availability report of the given node.
+	GetNodeReport(context.Context, *GetNodeSLAReportRequest) (*NodeSLAReport, error)
+	// GetApplicationReport returns the availability report of the given application.
+	GetApplicationReport(context.Context, *GetApplicationSLAReportRequest) (*ApplicationSLAReport, error)
+}
+
+func RegisterSLAServer(s *grpc.Server, srv SLAServer) {
+	s.RegisterService(&_SLA_serviceDesc, srv)
+}
+
+func _SLA_GetNodeReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNodeSLAReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SLAServer).GetNodeReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.SLA/GetNodeReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SLAServer).GetNodeReport(ctx, req.(*GetNodeSLAReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SLA_GetApplicationReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetApplicationSLAReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SLAServer).GetApplicationReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.SLA/GetApplicationReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SLAServer).GetApplicationReport(ctx, req.(*GetApplicationSLAReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _SLA_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.SLA",
+	HandlerType: (*SLAServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetNodeReport",
+			Handler:    _SLA_GetNodeReport_Handler,
+		},
+		{
+			MethodName: "GetApplicationReport",
+			Handler:    _SLA_GetApplicationReport_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "sla.proto",
+}
+
+func init() { proto.RegisterFile("sla.proto", fileDescriptor7) }
+
+var fileDescriptor7 = []byte{
+	// 391 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0xc1, 0x6a, 0xe2, 0x50,
+	0x14, 0x25, 0xc6, 0x38, 0xf8, 0x66, 0x9c, 0x19, 0x1e, 0xe2, 0x64, 0xc4, 0x5a, 0x49, 0x69, 0xc9,
+	0xca, 0x80, 0xfd, 0x02, 0x17, 0x45, 0x04, 0xe9, 0x22, 0xe2, 0xa2, 0xbb, 0x5e, 0xcd, 0x45, 0x1e,
+	0x7d, 0xe4, 0xbd, 0x26, 0xcf, 0xd0, 0x22, 0x6e, 0xfa, 0x0b, 0xfd, 0xa8, 0x7e, 0x40, 0x7f, 0xa1,
+	0xd0, 0x5f, 0xe8, 0xb2, 0xf8, 0x92, 0xd6, 0xc6, 0xc6, 0x22, 0x74, 0x97, 0x7b, 0x72, 0x72, 0x0e,
+	0xf7, 0xdc, 0x13, 0x52, 0x8d, 0x39, 0x74, 0x65, 0x24, 0x94, 0xa0, 0x26, 0x48, 0xd6, 0x6c, 0xcd,
+	0x85, 0x98, 0x73, 0xf4, 0x40, 0x32, 0x0f, 0xc2, 0x50, 0x28, 0x50, 0x4c, 0x84, 0x71, 0x4a, 0x71,
+	0x2e, 0xc8, 0xbf, 0x01, 0xaa, 0x73, 0x11, 0xe0, 0x78, 0xd4, 0xf7, 0x51, 0x8a, 0x48, 0xf9, 0x78,
+	0xbd, 0xc0, 0x58, 0xd1, 0x06, 0xa9, 0x04, 0x98, 0x9c, 0x4d, 0x86, 0xb6, 0xd1, 0x31, 0xdc, 0xaa,
+	0x9f, 0x4d, 0xb4, 0x4e, 0xac, 0x58, 0x41, 0xa4, 0xec, 0x92, 0x86, 0xd3, 0x81, 0xfe, 0x25, 0x26,
+	0x86, 0x81, 0x6d, 0x6a, 0x6c, 0xfd, 0xe8, 0x3c, 0x18, 0xa4, 0x96, 0x13, 0xde, 0xa9, 0x78, 0x42,
+	0x7e, 0x2f, 0x24, 0x67, 0xe1, 0xd5, 0x30, 0x54, 0x18, 0x25, 0xc0, 0xb5, 0x74, 0xcd, 0xdf, 0x42,
+	0xa9, 0x4b, 0xfe, 0xe0, 0x8d, 0xc4, 0x99, 0xc2, 0x60, 0xa2, 0xdf, 0xc4, 0xda, 0xcf, 0xf4, 0xb7,
+	0xe1, 0x35, 0x33, 0xc2, 0x19, 0xb2, 0x64, 0xc3, 0x2c, 0xa7, 0xcc, 0x2d, 0x98, 0x3a, 0xe4, 0x17,
+	0x24, 0xc0, 0x38, 0x4c, 0x19, 0x67, 0xea, 0xd6, 0xb6, 0x3a, 0x86, 0x6b, 0xf8, 0x39, 0xcc, 0xb9,
+	0x24, 0xed, 0x01, 0xaa, 0xbe, 0x94, 0x9c, 0xcd, 0x74, 0x7a, 0x45, 0x59, 0x81, 0x94, 0x1f, 0x36,
+	0x4b, 0xa7, 0xbd, 0xb3, 0x7a, 0x31, 0x48, 0xbd, 0x48, 0xff, 0xbb, 0xc2, 0x45, 0x91, 0x95, 0xf7,
+	0x8e, 0xcc, 0xda, 0x2f, 0xb2, 0xca, 0xe7, 0xc8, 0xa8, 0x4b, 0xac, 0x50, 0x04, 0x18, 0xdb, 0x3f,
+	0x3a, 0xa6, 0xfb, 0xb3, 0x47, 0xbb, 0x20, 0x59, 0x37, 0x5f, 0xb3, 0x94, 0xd0, 0x7b, 0x36, 0x88,
+	0x39, 0x1e, 0xf5, 0x29, 0x90, 0x5a, 0xd6, 0xc4, 0x6c, 0xf5, 0x96, 0xfe, 0x66, 0x47, 0x3b, 0x9b,
+	0x05, 0x8a, 0x4e, 0xfb, 0xee, 0xf1, 0xe9, 0xbe, 0x64, 0xd3, 0x86, 0x2e, 0x7b, 0xcc, 0xc1, 0x5b,
+	0x5b, 0x78, 0xcb, 0xb4, 0x66, 0x2b, 0xba, 0x22, 0xf5, 0xfc, 0x1d, 0x33, 0xa7, 0xa3, 0x37, 0xa7,
+	0x2f, 0x4e, 0xdc, 0xfc, 0xaf, 0x49, 0x45, 0x0c, 0xe7, 0x58, 0xfb, 0x1e, 0xd2, 0x83, 0x77, 0x5f,
+	0xd8, 0xd0, 0xbc, 0x65, 0x7a, 0xb2, 0xd5, 0xb4, 0xa2, 0x7f, 0xb9, 0xd3, 0xd7, 0x01, 0x00, 0x77,
+	0x47, 0x87, 0x58, 0xa2, 0x03, 0x00, 0x00,
+}
@@ -0,0 +1,389 @@
+// Code generated by protoc-gen-go.
+// source: thingsBoardIntegration.proto
+// DO NOT EDIT!
+
+package api
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import _ "github.com/grpc-ecosystem/grpc-gateway/third_party/googleapis/google/api"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+type CreateThingsBoardIntegrationRequest struct {
+	// hex encoded AppEUI
+	AppEUI string `protobuf:"bytes,1,opt,name=appEUI" json:"appEUI,omitempty"`
+	// ThingsBoard server (e.g. https://thingsboard.example.com)
+	Server string `protobuf:"bytes,2,opt,name=server" json:"server,omitempty"`
+}
+
+func (m *CreateThingsBoardIntegrationRequest) Reset()         { *m = CreateThingsBoardIntegrationRequest{} }
+func (m *CreateThingsBoardIntegrationRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateThingsBoardIntegrationRequest) ProtoMessage()    {}
+func (*CreateThingsBoardIntegrationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor28, []int{0}
+}
+
+func (m *CreateThingsBoardIntegrationRequest) GetAppEUI() string {
+	if m != nil {
+		return m.AppEUI
+	}
+	return ""
+}
+
+func (m *CreateThingsBoardIntegrationRequest) GetServer() string {
+	if m != nil {
+		return m.Server
+	}
+	return ""
+}
+
+type CreateThingsBoardIntegrationResponse struct {
+}
+
+func (m *CreateThingsBoardIntegrationResponse) Reset()         { *m = CreateThingsBoardIntegrationResponse{} }
+func (m *CreateThingsBoardIntegrationResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateThingsBoardIntegrationResponse) ProtoMessage()    {}
+func (*CreateThingsBoardIntegrationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor28, []int{1}
+}
+
+type GetThingsBoardIntegrationRequest struct {
+	// hex encoded AppEUI
+	AppEUI string `protobuf:"bytes,1,opt,name=appEUI" json:"appEUI,omitempty"`
+}
+
+func (m *GetThingsBoardIntegrationRequest) Reset()         { *m = GetThingsBoardIntegrationRequest{} }
+func (m *GetThingsBoardIntegrationRequest) String() string { return proto.CompactTextString(m) }
+func (*GetThingsBoardIntegrationRequest) ProtoMessage()    {}
+func (*GetThingsBoardIntegrationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor28, []int{2}
+}
+
+func (m *GetThingsBoardIntegrationRequest) GetAppEUI() string {
+	if m != nil {
+		return m.AppEUI
+	}
+	return ""
+}
+
+type GetThingsBoardIntegrationResponse struct {
+	// hex encoded AppEUI
+	AppEUI string `protobuf:"bytes,1,opt,name=appEUI" json:"appEUI,omitempty"`
+	// ThingsBoard server (e.g. https://thingsboard.example.com)
+	Server string `protobuf:"bytes,2,opt,name=server" json:"server,omitempty"`
+}
+
+func (m *GetThingsBoardIntegrationResponse) Reset()         { *m = GetThingsBoardIntegrationResponse{} }
+func (m *GetThingsBoardIntegrationResponse) String() string { return proto.CompactTextString(m) }
+func (*GetThingsBoardIntegrationResponse) ProtoMessage()    {}
+func (*GetThingsBoardIntegrationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor28, []int{3}
+}
+
+func (m *GetThingsBoardIntegrationResponse) GetAppEUI() string {
+	if m != nil {
+		return m.AppEUI
+	}
+	return ""
+}
+
+func (m *GetThingsBoardIntegrationResponse) GetServer() string {
+	if m != nil {
+		return m.Server
+	}
+	return ""
+}
+
+type UpdateThingsBoardIntegrationRequest struct {
+	// hex encoded AppEUI
+	AppEUI string `protobuf:"bytes,1,opt,name=appEUI" json:"appEUI,omitempty"`
+	// ThingsBoard server (e.g. https://thingsboard.example.com)
+	Server string `protobuf:"bytes,2,opt,name=server" json:"server,omitempty"`
+}
+
+func (m *UpdateThingsBoardIntegrationRequest) Reset()         { *m = UpdateThingsBoardIntegrationRequest{} }
+func (m *UpdateThingsBoardIntegrationRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateThingsBoardIntegrationRequest) ProtoMessage()    {}
+func (*UpdateThingsBoardIntegrationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor28, []int{4}
+}
+
+func (m *UpdateThingsBoardIntegrationRequest) GetAppEUI() string {
+	if m != nil {
+		return m.AppEUI
+	}
+	return ""
+}
+
+func (m *UpdateThingsBoardIntegrationRequest) GetServer() string {
+	if m != nil {
+		return m.Server
+	}
+	return ""
+}
+
+type UpdateThingsBoardIntegrationResponse struct {
+}
+
+func (m *UpdateThingsBoardIntegrationResponse) Reset()         { *m = UpdateThingsBoardIntegrationResponse{} }
+func (m *UpdateThingsBoardIntegrationResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateThingsBoardIntegrationResponse) ProtoMessage()    {}
+func (*UpdateThingsBoardIntegrationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor28, []int{5}
+}
+
+type DeleteThingsBoardIntegrationRequest struct {
+	// hex encoded AppEUI
+	AppEUI string `protobuf:"bytes,1,opt,name=appEUI" json:"appEUI,omitempty"`
+}
+
+func (m *DeleteThingsBoardIntegrationRequest) Reset()         { *m = DeleteThingsBoardIntegrationRequest{} }
+func (m *DeleteThingsBoardIntegrationRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteThingsBoardIntegrationRequest) ProtoMessage()    {}
+func (*DeleteThingsBoardIntegrationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor28, []int{6}
+}
+
+func (m *DeleteThingsBoardIntegrationRequest) GetAppEUI() string {
+	if m != nil {
+		return m.AppEUI
+	}
+	return ""
+}
+
+type DeleteThingsBoardIntegrationResponse struct {
+}
+
+func (m *DeleteThingsBoardIntegrationResponse) Reset()         { *m = DeleteThingsBoardIntegrationResponse{} }
+func (m *DeleteThingsBoardIntegrationResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteThingsBoardIntegrationResponse) ProtoMessage()    {}
+func (*DeleteThingsBoardIntegrationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor28, []int{7}
+}
+
+func init() {
+	proto.RegisterType((*CreateThingsBoardIntegrationRequest)(nil), "api.CreateThingsBoardIntegrationRequest")
+	proto.RegisterType((*CreateThingsBoardIntegrationResponse)(nil), "api.CreateThingsBoardIntegrationResponse")
+	proto.RegisterType((*GetThingsBoardIntegrationRequest)(nil), "api.GetThingsBoardIntegrationRequest")
+	proto.RegisterType((*GetThingsBoardIntegrationResponse)(nil), "api.GetThingsBoardIntegrationResponse")
+	proto.RegisterType((*UpdateThingsBoardIntegrationRequest)(nil), "api.UpdateThingsBoardIntegrationRequest")
+	proto.RegisterType((*UpdateThingsBoardIntegrationResponse)(nil), "api.UpdateThingsBoardIntegrationResponse")
+	proto.RegisterType((*DeleteThingsBoardIntegrationRequest)(nil), "api.DeleteThingsBoardIntegrationRequest")
+	proto.RegisterType((*DeleteThingsBoardIntegrationResponse)(nil), "api.DeleteThingsBoardIntegrationResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for ThingsBoardIntegration service
+
+type ThingsBoardIntegrationClient interface {
+	// Create creates the ThingsBoard integration of the given application.
+	Create(ctx context.Context, in *CreateThingsBoardIntegrationRequest, opts ...grpc.CallOption) (*CreateThingsBoardIntegrationResponse, error)
+	// Get returns the ThingsBoard integration of the given application.
+	Get(ctx context.Context, in *GetThingsBoardIntegrationRequest, opts ...grpc.CallOption) (*GetThingsBoardIntegrationResponse, error)
+	// Update updates the ThingsBoard integration of the given application.
+	Update(ctx context.Context, in *UpdateThingsBoardIntegrationRequest, opts ...grpc.CallOption) (*UpdateThingsBoardIntegrationResponse, error)
+	// Delete deletes the ThingsBoard integration of the given application.
+	Delete(ctx context.Context, in *DeleteThingsBoardIntegrationRequest, opts ...grpc.CallOption) (*DeleteThingsBoardIntegrationResponse, error)
+}
+
+type thingsBoardIntegrationClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewThingsBoardIntegrationClient(cc *grpc.ClientConn) ThingsBoardIntegrationClient {
+	return &thingsBoardIntegrationClient{cc}
+}
+
+func (c *thingsBoardIntegrationClient) Create(ctx context.Context, in *CreateThingsBoardIntegrationRequest, opts ...grpc.CallOption) (*CreateThingsBoardIntegrationResponse, error) {
+	out := new(CreateThingsBoardIntegrationResponse)
+	err := grpc.Invoke(ctx, "/api.ThingsBoardIntegration/Create", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *thingsBoardIntegrationClient) Get(ctx context.Context, in *GetThingsBoardIntegrationRequest, opts ...grpc.CallOption) (*GetThingsBoardIntegrationResponse, error) {
+	out := new(GetThingsBoardIntegrationResponse)
+	err := grpc.Invoke(ctx, "/api.ThingsBoardIntegration/Get", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *thingsBoardIntegrationClient) Update(ctx context.Context, in *UpdateThingsBoardIntegrationRequest, opts ...grpc.CallOption) (*UpdateThingsBoardIntegrationResponse, error) {
+	out := new(UpdateThingsBoardIntegrationResponse)
+	err := grpc.Invoke(ctx, "/api.ThingsBoardIntegration/Update", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *thingsBoardIntegrationClient) Delete(ctx context.Context, in *DeleteThingsBoardIntegrationRequest, opts ...grpc.CallOption) (*DeleteThingsBoardIntegrationResponse, error) {
+	out := new(DeleteThingsBoardIntegrationResponse)
+	err := grpc.Invoke(ctx, "/api.ThingsBoardIntegration/Delete", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for ThingsBoardIntegration service
+
+type ThingsBoardIntegrationServer interface {
+	// Create creates the ThingsBoard integration of the given application.
+	Create(context.Context, *CreateThingsBoardIntegrationRequest) (*CreateThingsBoardIntegrationResponse, error)
+	// Get returns the ThingsBoard integration of the given application.
+	Get(context.Context, *GetThingsBoardIntegrationRequest) (*GetThingsBoardIntegrationResponse, error)
+	// Update updates the ThingsBoard integration of the given application.
+	Update(context.Context, *UpdateThingsBoardIntegrationRequest) (*UpdateThingsBoardIntegrationResponse, error)
+	// Delete deletes the ThingsBoard integration of the given application.
+	Delete(context.Context, *DeleteThingsBoardIntegrationRequest) (*DeleteThingsBoardIntegrationResponse, error)
+}
+
+func RegisterThingsBoardIntegrationServer(s *grpc.Server, srv ThingsBoardIntegrationServer) {
+	s.RegisterService(&_ThingsBoardIntegration_serviceDesc, srv)
+}
+
+func _ThingsBoardIntegration_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateThingsBoardIntegrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThingsBoardIntegrationServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ThingsBoardIntegration/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThingsBoardIntegrationServer).Create(ctx, req.(*CreateThingsBoardIntegrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThingsBoardIntegration_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetThingsBoardIntegrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThingsBoardIntegrationServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ThingsBoardIntegration/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThingsBoardIntegrationServer).Get(ctx, req.(*GetThingsBoardIntegrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThingsBoardIntegration_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateThingsBoardIntegrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThingsBoardIntegrationServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ThingsBoardIntegration/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThingsBoardIntegrationServer).Update(ctx, req.(*UpdateThingsBoardIntegrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThingsBoardIntegration_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteThingsBoardIntegrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThingsBoardIntegrationServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ThingsBoardIntegration/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThingsBoardIntegrationServer).Delete(ctx, req.(*DeleteThingsBoardIntegrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ThingsBoardIntegration_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.ThingsBoardIntegration",
+	HandlerType: (*ThingsBoardIntegrationServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _ThingsBoardIntegration_Create_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _ThingsBoardIntegration_Get_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _ThingsBoardIntegration_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _ThingsBoardIntegration_Delete_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "thingsBoardIntegration.proto",
+}
+
+func init() { proto.RegisterFile("thingsBoardIntegration.proto", fileDescriptor28) }
+
+var fileDescriptor28 = []byte{
+	// 332 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0xcd, 0x4a, 0x03, 0x31,
+	0x14, 0x85, 0x49, 0x2b, 0x03, 0xde, 0x65, 0x16, 0xa5, 0x84, 0x2e, 0xda, 0xa9, 0xfd, 0x05, 0x5b,
+	0xd1, 0x5d, 0xc1, 0x8d, 0x3f, 0x94, 0x6e, 0xab, 0xf3, 0x00, 0x91, 0x5e, 0xc6, 0x81, 0x92, 0xc4,
+	0x24, 0xba, 0x11, 0x37, 0x0a, 0xae, 0x04, 0x17, 0xbe, 0x94, 0x7b, 0x5f, 0xc1, 0x07, 0x91, 0x26,
+	0xd9, 0x08, 0xc3, 0x34, 0x16, 0x5c, 0x26, 0x9c, 0xdc, 0xef, 0xcc, 0xb9, 0x87, 0x81, 0x96, 0xbd,
+	0x2d, 0x44, 0x6e, 0xce, 0x24, 0xd7, 0xab, 0x85, 0xb0, 0x98, 0x6b, 0x6e, 0x0b, 0x29, 0x26, 0x4a,
+	0x4b, 0x2b, 0x69, 0x9d, 0xab, 0x82, 0xb5, 0x72, 0x29, 0xf3, 0x35, 0x4e, 0xb9, 0x2a, 0xa6, 0x5c,
+	0x08, 0x69, 0x9d, 0xc2, 0x78, 0x49, 0x9a, 0x41, 0xf7, 0x5c, 0x23, 0xb7, 0x78, 0x5d, 0x3a, 0x68,
+	0x89, 0x77, 0xf7, 0x68, 0x2c, 0x6d, 0x40, 0xc2, 0x95, 0xba, 0xcc, 0x16, 0x4d, 0xd2, 0x26, 0xc3,
+	0xfd, 0x65, 0x38, 0x6d, 0xee, 0x0d, 0xea, 0x07, 0xd4, 0xcd, 0x9a, 0xbf, 0xf7, 0xa7, 0xb4, 0x0f,
+	0x07, 0xd5, 0x63, 0x8d, 0x92, 0xc2, 0x60, 0x3a, 0x83, 0xf6, 0x1c, 0xed, 0x4e, 0xec, 0xf4, 0x0a,
+	0x3a, 0x15, 0x6f, 0x3d, 0xe0, 0xcf, 0xc6, 0x33, 0xe8, 0x66, 0x6a, 0xf5, 0x1f, 0x79, 0x54, 0x8f,
+	0x0d, 0x79, 0x9c, 0x42, 0xf7, 0x02, 0xd7, 0xb8, 0x23, 0x7e, 0x83, 0xa9, 0x7e, 0xee, 0x31, 0xc7,
+	0x9f, 0x7b, 0xd0, 0x28, 0x97, 0xd0, 0x57, 0x02, 0x89, 0x5f, 0x1d, 0x1d, 0x4e, 0xb8, 0x2a, 0x26,
+	0x11, 0xf5, 0x60, 0xa3, 0x08, 0x65, 0xf8, 0xc2, 0xc1, 0xf3, 0xd7, 0xf7, 0x47, 0xad, 0x93, 0xb6,
+	0x5c, 0x21, 0xcb, 0xeb, 0x6b, 0x66, 0x64, 0x4c, 0x5f, 0x08, 0xd4, 0xe7, 0x68, 0x69, 0xcf, 0xcd,
+	0xde, 0xd6, 0x12, 0xd6, 0xdf, 0x26, 0x0b, 0xfc, 0x43, 0xc7, 0x1f, 0xd0, 0x5e, 0x15, 0x7f, 0xfa,
+	0xe8, 0x03, 0x7d, 0xa2, 0xef, 0x04, 0x12, 0xbf, 0xb9, 0x10, 0x47, 0x44, 0x3b, 0xd8, 0x28, 0x42,
+	0x19, 0xec, 0x1c, 0x39, 0x3b, 0x63, 0x16, 0x67, 0x67, 0x93, 0xcb, 0x1b, 0x81, 0xc4, 0x2f, 0x39,
+	0x38, 0x8a, 0x28, 0x0c, 0x1b, 0x45, 0x28, 0x7f, 0x07, 0x34, 0x8e, 0x73, 0x74, 0x93, 0xb8, 0xff,
+	0xc8, 0xc9, 0xcf, 0x00, 0x83, 0xf5, 0x48, 0x8a, 0x8a, 0x04, 0x00, 0x00,
+}
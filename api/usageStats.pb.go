@@ -0,0 +1,469 @@
+// Code generated by protoc-gen-go.
+// source: usageStats.proto
+// DO NOT EDIT!
+
+package api
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import _ "github.com/grpc-ecosystem/grpc-gateway/third_party/googleapis/google/api"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+type GetNodeUsageStatsRequest struct {
+	// hex encoded DevEUI
+	DevEUI string `protobuf:"bytes,1,opt,name=devEUI" json:"devEUI,omitempty"`
+	// start of the period (RFC3339)
+	Start string `protobuf:"bytes,2,opt,name=start" json:"start,omitempty"`
+	// end of the period (RFC3339, default now)
+	End string `protobuf:"bytes,3,opt,name=end" json:"end,omitempty"`
+	// aggregation interval
+	Interval AggregationInterval `protobuf:"varint,4,opt,name=interval,enum=api.AggregationInterval" json:"interval,omitempty"`
+}
+
+func (m *GetNodeUsageStatsRequest) Reset()                    { *m = GetNodeUsageStatsRequest{} }
+func (m *GetNodeUsageStatsRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetNodeUsageStatsRequest) ProtoMessage()               {}
+func (*GetNodeUsageStatsRequest) Descriptor() ([]byte, []int) { return fileDescriptor32, []int{0} }
+
+func (m *GetNodeUsageStatsRequest) GetDevEUI() string {
+	if m != nil {
+		return m.DevEUI
+	}
+	return ""
+}
+
+func (m *GetNodeUsageStatsRequest) GetStart() string {
+	if m != nil {
+		return m.Start
+	}
+	return ""
+}
+
+func (m *GetNodeUsageStatsRequest) GetEnd() string {
+	if m != nil {
+		return m.End
+	}
+	return ""
+}
+
+func (m *GetNodeUsageStatsRequest) GetInterval() AggregationInterval {
+	if m != nil {
+		return m.Interval
+	}
+	return AggregationInterval_PERIOD
+}
+
+type GetApplicationUsageStatsRequest struct {
+	// hex encoded AppEUI
+	AppEUI string `protobuf:"bytes,1,opt,name=appEUI" json:"appEUI,omitempty"`
+	// start of the period (RFC3339)
+	Start string `protobuf:"bytes,2,opt,name=start" json:"start,omitempty"`
+	// end of the period (RFC3339, default now)
+	End string `protobuf:"bytes,3,opt,name=end" json:"end,omitempty"`
+	// aggregation interval
+	Interval AggregationInterval `protobuf:"varint,4,opt,name=interval,enum=api.AggregationInterval" json:"interval,omitempty"`
+}
+
+func (m *GetApplicationUsageStatsRequest) Reset()         { *m = GetApplicationUsageStatsRequest{} }
+func (m *GetApplicationUsageStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetApplicationUsageStatsRequest) ProtoMessage()    {}
+func (*GetApplicationUsageStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor32, []int{1}
+}
+
+func (m *GetApplicationUsageStatsRequest) GetAppEUI() string {
+	if m != nil {
+		return m.AppEUI
+	}
+	return ""
+}
+
+func (m *GetApplicationUsageStatsRequest) GetStart() string {
+	if m != nil {
+		return m.Start
+	}
+	return ""
+}
+
+func (m *GetApplicationUsageStatsRequest) GetEnd() string {
+	if m != nil {
+		return m.End
+	}
+	return ""
+}
+
+func (m *GetApplicationUsageStatsRequest) GetInterval() AggregationInterval {
+	if m != nil {
+		return m.Interval
+	}
+	return AggregationInterval_PERIOD
+}
+
+type UsageStatsEntry struct {
+	// start of the aggregation interval (RFC3339)
+	Timestamp string `protobuf:"bytes,1,opt,name=timestamp" json:"timestamp,omitempty"`
+	// number of uplinks
+	RxPackets uint32 `protobuf:"varint,2,opt,name=rxPackets" json:"rxPackets,omitempty"`
+	// average RSSI of the uplinks (best gateway)
+	AvgRSSI float64 `protobuf:"fixed64,3,opt,name=avgRSSI" json:"avgRSSI,omitempty"`
+	// average SNR of the uplinks (best gateway)
+	AvgLoRaSNR float64 `protobuf:"fixed64,4,opt,name=avgLoRaSNR" json:"avgLoRaSNR,omitempty"`
+	// number of downlinks
+	TxPackets uint32 `protobuf:"varint,5,opt,name=txPackets" json:"txPackets,omitempty"`
+	// number of errors
+	Errors uint32 `protobuf:"varint,6,opt,name=errors" json:"errors,omitempty"`
+	// number of nodes which sent at least one uplink
+	ActiveNodes uint32 `protobuf:"varint,7,opt,name=activeNodes" json:"activeNodes,omitempty"`
+}
+
+func (m *UsageStatsEntry) Reset()                    { *m = UsageStatsEntry{} }
+func (m *UsageStatsEntry) String() string            { return proto.CompactTextString(m) }
+func (*UsageStatsEntry) ProtoMessage()               {}
+func (*UsageStatsEntry) Descriptor() ([]byte, []int) { return fileDescriptor32, []int{2} }
+
+func (m *UsageStatsEntry) GetTimestamp() string {
+	if m != nil {
+		return m.Timestamp
+	}
+	return ""
+}
+
+func (m *UsageStatsEntry) GetRxPackets() uint32 {
+	if m != nil {
+		return m.RxPackets
+	}
+	return 0
+}
+
+func (m *UsageStatsEntry) GetAvgRSSI() float64 {
+	if m != nil {
+		return m.AvgRSSI
+	}
+	return 0
+}
+
+func (m *UsageStatsEntry) GetAvgLoRaSNR() float64 {
+	if m != nil {
+		return m.AvgLoRaSNR
+	}
+	return 0
+}
+
+func (m *UsageStatsEntry) GetTxPackets() uint32 {
+	if m != nil {
+		return m.TxPackets
+	}
+	return 0
+}
+
+func (m *UsageStatsEntry) GetErrors() uint32 {
+	if m != nil {
+		return m.Errors
+	}
+	return 0
+}
+
+func (m *UsageStatsEntry) GetActiveNodes() uint32 {
+	if m != nil {
+		return m.ActiveNodes
+	}
+	return 0
+}
+
+type GetUsageStatsResponse struct {
+	Result []*UsageStatsEntry `protobuf:"bytes,1,rep,name=result" json:"result,omitempty"`
+}
+
+func (m *GetUsageStatsResponse) Reset()                    { *m = GetUsageStatsResponse{} }
+func (m *GetUsageStatsResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetUsageStatsResponse) ProtoMessage()               {}
+func (*GetUsageStatsResponse) Descriptor() ([]byte, []int) { return fileDescriptor32, []int{3} }
+
+func (m *GetUsageStatsResponse) GetResult() []*UsageStatsEntry {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type ListNodeUsageStatsRequest struct {
+	// hex encoded AppEUI
+	AppEUI string `protobuf:"bytes,1,opt,name=appEUI" json:"appEUI,omitempty"`
+	// start of the period (RFC3339)
+	Start string `protobuf:"bytes,2,opt,name=start" json:"start,omitempty"`
+	// end of the period (RFC3339, default now)
+	End string `protobuf:"bytes,3,opt,name=end" json:"end,omitempty"`
+}
+
+func (m *ListNodeUsageStatsRequest) Reset()                    { *m = ListNodeUsageStatsRequest{} }
+func (m *ListNodeUsageStatsRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListNodeUsageStatsRequest) ProtoMessage()               {}
+func (*ListNodeUsageStatsRequest) Descriptor() ([]byte, []int) { return fileDescriptor32, []int{4} }
+
+func (m *ListNodeUsageStatsRequest) GetAppEUI() string {
+	if m != nil {
+		return m.AppEUI
+	}
+	return ""
+}
+
+func (m *ListNodeUsageStatsRequest) GetStart() string {
+	if m != nil {
+		return m.Start
+	}
+	return ""
+}
+
+func (m *ListNodeUsageStatsRequest) GetEnd() string {
+	if m != nil {
+		return m.End
+	}
+	return ""
+}
+
+type NodeUsageStats struct {
+	// hex encoded DevEUI
+	DevEUI string `protobuf:"bytes,1,opt,name=devEUI" json:"devEUI,omitempty"`
+	// usage statistics of the node for the whole period
+	Stats *UsageStatsEntry `protobuf:"bytes,2,opt,name=stats" json:"stats,omitempty"`
+}
+
+func (m *NodeUsageStats) Reset()                    { *m = NodeUsageStats{} }
+func (m *NodeUsageStats) String() string            { return proto.CompactTextString(m) }
+func (*NodeUsageStats) ProtoMessage()               {}
+func (*NodeUsageStats) Descriptor() ([]byte, []int) { return fileDescriptor32, []int{5} }
+
+func (m *NodeUsageStats) GetDevEUI() string {
+	if m != nil {
+		return m.DevEUI
+	}
+	return ""
+}
+
+func (m *NodeUsageStats) GetStats() *UsageStatsEntry {
+	if m != nil {
+		return m.Stats
+	}
+	return nil
+}
+
+type ListNodeUsageStatsResponse struct {
+	Result []*NodeUsageStats `protobuf:"bytes,1,rep,name=result" json:"result,omitempty"`
+}
+
+func (m *ListNodeUsageStatsResponse) Reset()                    { *m = ListNodeUsageStatsResponse{} }
+func (m *ListNodeUsageStatsResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListNodeUsageStatsResponse) ProtoMessage()               {}
+func (*ListNodeUsageStatsResponse) Descriptor() ([]byte, []int) { return fileDescriptor32, []int{6} }
+
+func (m *ListNodeUsageStatsResponse) GetResult() []*NodeUsageStats {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*GetNodeUsageStatsRequest)(nil), "api.GetNodeUsageStatsRequest")
+	proto.RegisterType((*GetApplicationUsageStatsRequest)(nil), "api.GetApplicationUsageStatsRequest")
+	proto.RegisterType((*UsageStatsEntry)(nil), "api.UsageStatsEntry")
+	proto.RegisterType((*GetUsageStatsResponse)(nil), "api.GetUsageStatsResponse")
+	proto.RegisterType((*ListNodeUsageStatsRequest)(nil), "api.ListNodeUsageStatsRequest")
+	proto.RegisterType((*NodeUsageStats)(nil), "api.NodeUsageStats")
+	proto.RegisterType((*ListNodeUsageStatsResponse)(nil), "api.ListNodeUsageStatsResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for UsageStats service
+
+type UsageStatsClient interface {
+	// GetNodeStats returns the usage statistics of the given node.
+	GetNodeStats(ctx context.Context, in *GetNodeUsageStatsRequest, opts ...grpc.CallOption) (*GetUsageStatsResponse, error)
+	// GetApplicationStats returns the usage statistics of the given application.
+	GetApplicationStats(ctx context.Context, in *GetApplicationUsageStatsRequest, opts ...grpc.CallOption) (*GetUsageStatsResponse, error)
+	// ListNodeStats returns the usage statistics of each node of the given
+	// application for the whole period, least uplinks (e.g. silent nodes) first.
+	ListNodeStats(ctx context.Context, in *ListNodeUsageStatsRequest, opts ...grpc.CallOption) (*ListNodeUsageStatsResponse, error)
+}
+
+type usageStatsClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewUsageStatsClient(cc *grpc.ClientConn) UsageStatsClient {
+	return &usageStatsClient{cc}
+}
+
+func (c *usageStatsClient) GetNodeStats(ctx context.Context, in *GetNodeUsageStatsRequest, opts ...grpc.CallOption) (*GetUsageStatsResponse, error) {
+	out := new(GetUsageStatsResponse)
+	err := grpc.Invoke(ctx, "/api.UsageStats/GetNodeStats", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usageStatsClient) GetApplicationStats(ctx context.Context, in *GetApplicationUsageStatsRequest, opts ...grpc.CallOption) (*GetUsageStatsResponse, error) {
+	out := new(GetUsageStatsResponse)
+	err := grpc.Invoke(ctx, "/api.UsageStats/GetApplicationStats", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usageStatsClient) ListNodeStats(ctx context.Context, in *ListNodeUsageStatsRequest, opts ...grpc.CallOption) (*ListNodeUsageStatsResponse, error) {
+	out := new(ListNodeUsageStatsResponse)
+	err := grpc.Invoke(ctx, "/api.UsageStats/ListNodeStats", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for UsageStats service
+
+type UsageStatsServer interface {
+	// GetNodeStats returns the usage statistics of the given node.
+	GetNodeStats(context.Context, *GetNodeUsageStatsRequest) (*GetUsageStatsResponse, error)
+	// GetApplicationStats returns the usage statistics of the given application.
+	GetApplicationStats(context.Context, *GetApplicationUsageStatsRequest) (*GetUsageStatsResponse, error)
+	// ListNodeStats returns the usage statistics of each node of the given
+	// application for the whole period, least uplinks (e.g. silent nodes) first.
+	ListNodeStats(context.Context, *ListNodeUsageStatsRequest) (*ListNodeUsageStatsResponse, error)
+}
+
+func RegisterUsageStatsServer(s *grpc.Server, srv UsageStatsServer) {
+	s.RegisterService(&_UsageStats_serviceDesc, srv)
+}
+
+func _UsageStats_GetNodeStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNodeUsageStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsageStatsServer).GetNodeStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.UsageStats/GetNodeStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsageStatsServer).GetNodeStats(ctx, req.(*GetNodeUsageStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsageStats_GetApplicationStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetApplicationUsageStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsageStatsServer).GetApplicationStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.UsageStats/GetApplicationStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsageStatsServer).GetApplicationStats(ctx, req.(*GetApplicationUsageStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsageStats_ListNodeStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNodeUsageStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsageStatsServer).ListNodeStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.UsageStats/ListNodeStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsageStatsServer).ListNodeStats(ctx, req.(*ListNodeUsageStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _UsageStats_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.UsageStats",
+	HandlerType: (*UsageStatsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetNodeStats",
+			Handler:    _UsageStats_GetNodeStats_Handler,
+		},
+		{
+			MethodName: "GetApplicationStats",
+			Handler:    _UsageStats_GetApplicationStats_Handler,
+		},
+		{
+			MethodName: "ListNodeStats",
+			Handler:    _UsageStats_ListNodeStats_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "usageStats.proto",
+}
+
+func init() { proto.RegisterFile("usageStats.proto", fileDescriptor32) }
+
+var fileDescriptor32 = []byte{
+	// 528 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0xcf, 0x6a, 0x1b, 0x31,
+	0x10, 0xc6, 0x51, 0xdc, 0x38, 0xcd, 0xa4, 0xf9, 0x83, 0x92, 0x16, 0x75, 0x49, 0x62, 0xb3, 0xa4,
+	0xc5, 0xa4, 0xc6, 0x06, 0xb7, 0x2f, 0x90, 0x83, 0x31, 0x86, 0x10, 0x8a, 0xdc, 0x9c, 0x7a, 0x52,
+	0x6d, 0xb1, 0x88, 0x3a, 0x2b, 0x55, 0x92, 0x4d, 0x43, 0xc8, 0xa5, 0xb4, 0xc7, 0x42, 0xa1, 0x87,
+	0x3e, 0x58, 0x1f, 0xa0, 0x97, 0x3e, 0x48, 0xf1, 0xac, 0xec, 0xdd, 0xb8, 0xd9, 0x50, 0x28, 0xb9,
+	0xed, 0x7c, 0x33, 0xfb, 0xe9, 0xa7, 0x91, 0x46, 0xb0, 0x33, 0x71, 0x22, 0x91, 0x03, 0x2f, 0xbc,
+	0x6b, 0x19, 0xab, 0xbd, 0xa6, 0x15, 0x61, 0x54, 0xb4, 0x9f, 0x68, 0x9d, 0x8c, 0x65, 0x5b, 0x18,
+	0xd5, 0x16, 0x69, 0xaa, 0xbd, 0xf0, 0x4a, 0xa7, 0xa1, 0x24, 0xda, 0x16, 0xa9, 0x18, 0x5f, 0x7a,
+	0x35, 0x0c, 0x42, 0xfc, 0x8d, 0x00, 0xeb, 0x49, 0x7f, 0xa6, 0x47, 0xf2, 0x7c, 0xe1, 0xc7, 0xe5,
+	0x87, 0x89, 0x74, 0x9e, 0x3e, 0x81, 0xea, 0x48, 0x4e, 0xbb, 0xe7, 0x7d, 0x46, 0xea, 0xa4, 0xb1,
+	0xce, 0x43, 0x44, 0xf7, 0x60, 0xd5, 0x79, 0x61, 0x3d, 0x5b, 0x41, 0x39, 0x0b, 0xe8, 0x0e, 0x54,
+	0x64, 0x3a, 0x62, 0x15, 0xd4, 0x66, 0x9f, 0xf4, 0x15, 0x3c, 0x54, 0xa9, 0x97, 0x76, 0x2a, 0xc6,
+	0xec, 0x41, 0x9d, 0x34, 0xb6, 0x3a, 0xac, 0x25, 0x8c, 0x6a, 0x9d, 0x24, 0x89, 0x95, 0x09, 0x82,
+	0xf5, 0x43, 0x9e, 0x2f, 0x2a, 0xe3, 0x1f, 0x04, 0x6a, 0x3d, 0xe9, 0x4f, 0x8c, 0x19, 0xab, 0x21,
+	0x16, 0xdd, 0x4a, 0x26, 0x8c, 0x29, 0x90, 0x65, 0xd1, 0x3d, 0x93, 0xfd, 0x22, 0xb0, 0x9d, 0xb3,
+	0x74, 0x53, 0x6f, 0x2f, 0xe9, 0x3e, 0xac, 0x7b, 0x75, 0x21, 0x9d, 0x17, 0x17, 0x26, 0xc0, 0xe4,
+	0xc2, 0x2c, 0x6b, 0x3f, 0xbe, 0x16, 0xc3, 0xf7, 0xd2, 0x3b, 0x64, 0xda, 0xe4, 0xb9, 0x40, 0x19,
+	0xac, 0x89, 0x69, 0xc2, 0x07, 0x83, 0x3e, 0xb2, 0x11, 0x3e, 0x0f, 0xe9, 0x21, 0x80, 0x98, 0x26,
+	0xa7, 0x9a, 0x8b, 0xc1, 0x19, 0x47, 0x42, 0xc2, 0x0b, 0x0a, 0xae, 0xba, 0xf0, 0x5d, 0xcd, 0x7c,
+	0x17, 0xc2, 0xac, 0x3b, 0xd2, 0x5a, 0x6d, 0x1d, 0xab, 0x62, 0x2a, 0x44, 0xb4, 0x0e, 0x1b, 0x62,
+	0xe8, 0xd5, 0x54, 0xce, 0x8e, 0xdb, 0xb1, 0x35, 0x4c, 0x16, 0xa5, 0xb8, 0x0b, 0x8f, 0x7b, 0xd2,
+	0x17, 0xfb, 0xed, 0x8c, 0x4e, 0x9d, 0xa4, 0x4d, 0xa8, 0x5a, 0xe9, 0x26, 0x63, 0xcf, 0x48, 0xbd,
+	0xd2, 0xd8, 0xe8, 0xec, 0x61, 0xbb, 0x96, 0x9a, 0xc1, 0x43, 0x4d, 0xfc, 0x16, 0x9e, 0x9e, 0x2a,
+	0x57, 0x7e, 0xab, 0xfe, 0xe7, 0xec, 0xe2, 0x37, 0xb0, 0x75, 0xd3, 0xb8, 0xf4, 0x9e, 0x1e, 0xa3,
+	0x63, 0xe8, 0x7c, 0x19, 0x73, 0x56, 0x12, 0xf7, 0x21, 0xba, 0x0d, 0x39, 0x6c, 0xff, 0xc5, 0xd2,
+	0xf6, 0x77, 0xd1, 0x6a, 0xa9, 0x38, 0x94, 0x74, 0xbe, 0x56, 0x00, 0x0a, 0x74, 0x06, 0x1e, 0x85,
+	0x09, 0xcb, 0xe2, 0x03, 0xfc, 0xb7, 0x6c, 0xe8, 0xa2, 0x68, 0x9e, 0xfe, 0x1b, 0x23, 0x7e, 0xf6,
+	0xe9, 0xe7, 0xef, 0xef, 0x2b, 0x35, 0x7a, 0x80, 0xe3, 0x9d, 0x3f, 0x00, 0xed, 0x54, 0x8f, 0x64,
+	0xfb, 0x2a, 0xdb, 0xf6, 0x35, 0xfd, 0x42, 0x60, 0xf7, 0xe6, 0x04, 0x65, 0x2b, 0x1f, 0xcd, 0xad,
+	0xef, 0x9a, 0xad, 0x3b, 0x01, 0x9a, 0x08, 0xf0, 0x9c, 0x1e, 0x2d, 0x03, 0x88, 0xdc, 0xb1, 0x7d,
+	0x95, 0x1d, 0xe8, 0x35, 0xfd, 0x4c, 0x60, 0x73, 0xde, 0xd4, 0x8c, 0xe0, 0x10, 0xbd, 0x4b, 0xef,
+	0x46, 0x54, 0x2b, 0xcd, 0x07, 0x80, 0x0e, 0x02, 0x34, 0xe9, 0xf1, 0xbf, 0x00, 0x60, 0x5b, 0xdc,
+	0xbb, 0x2a, 0x3e, 0x75, 0x2f, 0xff, 0x0c, 0x00, 0x04, 0x92, 0xff, 0xe6, 0x32, 0x05, 0x00, 0x00,
+}
@@ -0,0 +1,135 @@
+// Package integration defines the interface and the payloads of the
+// integrations (handlers) of LoRa App Server. It can be used to build
+// out-of-tree integrations, e.g. as a plugin (see the api/plugin package).
+//
+// # Compatibility
+//
+// Within a major version, the JSON encoding of the payloads is kept
+// backwards compatible: fields and event types might be added, but are not
+// removed or renamed. Methods might be added to the Handler interface, so
+// integrations should embed NopHandler to remain compatible with new
+// releases.
+package integration
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/brocaar/lorawan"
+)
+
+// Handler defines the interface of an integration.
+type Handler interface {
+	Close() error                                                                                                // closes the handler
+	SendDataUp(appEUI, devEUI lorawan.EUI64, payload DataUpPayload) error                                        // send data-up payload
+	SendJoinNotification(appEUI, devEUI lorawan.EUI64, payload JoinNotification) error                           // send join notification
+	SendACKNotification(appEUI, devEUI lorawan.EUI64, payload ACKNotification) error                             // send ack notification
+	SendErrorNotification(appEUI, devEUI lorawan.EUI64, payload ErrorNotification) error                         // send error notification
+	SendLinkQualityNotification(appEUI, devEUI lorawan.EUI64, payload LinkQualityNotification) error             // send link-quality notification
+	SendLifecycleNotification(appEUI, devEUI lorawan.EUI64, payload LifecycleNotification) error                 // send lifecycle notification
+	SendFirmwareNotification(appEUI, devEUI lorawan.EUI64, payload FirmwareNotification) error                   // send firmware (update) notification
+	SendDeviceStatus(appEUI, devEUI lorawan.EUI64, payload DeviceStatus) error                                   // send device status
+	SendLocationNotification(appEUI, devEUI lorawan.EUI64, payload LocationNotification) error                   // send location notification
+	SendScheduledDownlinkNotification(appEUI, devEUI lorawan.EUI64, payload ScheduledDownlinkNotification) error // send scheduled downlink notification
+	DataDownChan() chan DataDownPayload                                                                          // returns DataDownPayload channel
+}
+
+// NopHandler implements a Handler ignoring all events and never receiving
+// downlink payloads. Embed it to implement only the methods of interest.
+type NopHandler struct {
+	once         sync.Once
+	dataDownChan chan DataDownPayload
+}
+
+// Close closes the DataDownPayload channel.
+func (h *NopHandler) Close() error {
+	close(h.DataDownChan())
+	return nil
+}
+
+// SendDataUp ignores the given payload.
+func (h *NopHandler) SendDataUp(appEUI, devEUI lorawan.EUI64, payload DataUpPayload) error {
+	return nil
+}
+
+// SendJoinNotification ignores the given notification.
+func (h *NopHandler) SendJoinNotification(appEUI, devEUI lorawan.EUI64, payload JoinNotification) error {
+	return nil
+}
+
+// SendACKNotification ignores the given notification.
+func (h *NopHandler) SendACKNotification(appEUI, devEUI lorawan.EUI64, payload ACKNotification) error {
+	return nil
+}
+
+// SendErrorNotification ignores the given notification.
+func (h *NopHandler) SendErrorNotification(appEUI, devEUI lorawan.EUI64, payload ErrorNotification) error {
+	return nil
+}
+
+// SendLinkQualityNotification ignores the given notification.
+func (h *NopHandler) SendLinkQualityNotification(appEUI, devEUI lorawan.EUI64, payload LinkQualityNotification) error {
+	return nil
+}
+
+// SendLifecycleNotification ignores the given notification.
+func (h *NopHandler) SendLifecycleNotification(appEUI, devEUI lorawan.EUI64, payload LifecycleNotification) error {
+	return nil
+}
+
+// SendFirmwareNotification ignores the given notification.
+func (h *NopHandler) SendFirmwareNotification(appEUI, devEUI lorawan.EUI64, payload FirmwareNotification) error {
+	return nil
+}
+
+// SendDeviceStatus ignores the given device status.
+func (h *NopHandler) SendDeviceStatus(appEUI, devEUI lorawan.EUI64, payload DeviceStatus) error {
+	return nil
+}
+
+// SendLocationNotification ignores the given notification.
+func (h *NopHandler) SendLocationNotification(appEUI, devEUI lorawan.EUI64, payload LocationNotification) error {
+	return nil
+}
+
+// SendScheduledDownlinkNotification ignores the given notification.
+func (h *NopHandler) SendScheduledDownlinkNotification(appEUI, devEUI lorawan.EUI64, payload ScheduledDownlinkNotification) error {
+	return nil
+}
+
+// DataDownChan returns a channel which is only closed on Close.
+func (h *NopHandler) DataDownChan() chan DataDownPayload {
+	h.once.Do(func() {
+		h.dataDownChan = make(chan DataDownPayload)
+	})
+	return h.dataDownChan
+}
+
+// SendEvent sends the given payload (e.g. as returned by UnmarshalEvent) to
+// the given handler, using the Send method matching the payload type.
+func SendEvent(h Handler, appEUI, devEUI lorawan.EUI64, payload interface{}) error {
+	switch pl := payload.(type) {
+	case DataUpPayload:
+		return h.SendDataUp(appEUI, devEUI, pl)
+	case JoinNotification:
+		return h.SendJoinNotification(appEUI, devEUI, pl)
+	case ACKNotification:
+		return h.SendACKNotification(appEUI, devEUI, pl)
+	case ErrorNotification:
+		return h.SendErrorNotification(appEUI, devEUI, pl)
+	case LinkQualityNotification:
+		return h.SendLinkQualityNotification(appEUI, devEUI, pl)
+	case LifecycleNotification:
+		return h.SendLifecycleNotification(appEUI, devEUI, pl)
+	case DeviceStatus:
+		return h.SendDeviceStatus(appEUI, devEUI, pl)
+	case FirmwareNotification:
+		return h.SendFirmwareNotification(appEUI, devEUI, pl)
+	case LocationNotification:
+		return h.SendLocationNotification(appEUI, devEUI, pl)
+	case ScheduledDownlinkNotification:
+		return h.SendScheduledDownlinkNotification(appEUI, devEUI, pl)
+	default:
+		return fmt.Errorf("unknown payload type: %T", payload)
+	}
+}
@@ -0,0 +1,196 @@
+package integration
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/brocaar/lorawan"
+)
+
+func TestMarshalEvent(t *testing.T) {
+	Convey("Given a set of test payloads", t, func() {
+		now := time.Date(2016, 12, 1, 12, 0, 0, 0, time.UTC)
+		devEUI := lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}
+		battery, batteryLevel, linkMargin := 128, 50.2, -3
+
+		tests := []struct {
+			Payload   interface{}
+			EventType string
+		}{
+			{
+				Payload: DataUpPayload{
+					DevEUI: devEUI,
+					FCnt:   10,
+					FPort:  3,
+					Data:   []byte{1, 2, 3},
+					RXInfo: []RXInfo{{MAC: lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1}, Time: &now, RSSI: -60, LoRaSNR: 5}},
+				},
+				EventType: EventDataUp,
+			},
+			{
+				Payload: DataUpPayload{
+					DevEUI:    devEUI,
+					FCnt:      11,
+					FPort:     3,
+					Data:      []byte{4, 5, 6},
+					RXInfo:    []RXInfo{{MAC: lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1}, Time: &now, RSSI: -60, LoRaSNR: 5}},
+					Encrypted: true,
+					DevAddr:   &lorawan.DevAddr{1, 2, 3, 4},
+				},
+				EventType: EventDataUp,
+			},
+			{
+				Payload:   JoinNotification{DevAddr: lorawan.DevAddr{1, 2, 3, 4}, DevEUI: devEUI},
+				EventType: EventJoin,
+			},
+			{
+				Payload:   ACKNotification{Reference: "abcd", DevEUI: devEUI},
+				EventType: EventACK,
+			},
+			{
+				Payload:   ErrorNotification{Type: "BUFFER", Error: "test error", DevEUI: devEUI},
+				EventType: EventError,
+			},
+			{
+				Payload:   LifecycleNotification{Entity: LifecycleEntityNode, Action: LifecycleActionDelete, DevEUI: devEUI, Time: now},
+				EventType: EventLifecycle,
+			},
+			{
+				Payload:   DeviceStatus{DevEUI: devEUI, LastSeen: now, FCnt: 10, RSSI: -60, LoRaSNR: 5},
+				EventType: EventStatus,
+			},
+			{
+				Payload:   DeviceStatus{DevEUI: devEUI, LastSeen: now, Battery: &battery, BatteryLevel: &batteryLevel, LinkMargin: &linkMargin},
+				EventType: EventStatus,
+			},
+			{
+				Payload:   FirmwareNotification{DeploymentID: 1, DevEUI: devEUI, State: FirmwareStateCompleted, NbFrag: 10, NbFragReceived: 10, Time: now},
+				EventType: EventFirmware,
+			},
+			{
+				Payload:   LocationNotification{DevEUI: devEUI, Latitude: 52.3740364, Longitude: 4.9144401, Altitude: 10, Accuracy: 50, Source: "loracloud", NbGateways: 3, Time: now},
+				EventType: EventLocation,
+			},
+			{
+				Payload:   ScheduledDownlinkNotification{DevEUI: devEUI, RuleID: 1, Name: "clock-sync", FPort: 10, FCnt: 12, Confirmed: true, Time: now},
+				EventType: EventScheduled,
+			},
+		}
+
+		for i, test := range tests {
+			Convey(fmt.Sprintf("Testing: %T [%d]", test.Payload, i), func() {
+				Convey("Then MarshalEvent returns the expected event type", func() {
+					eventType, b, err := MarshalEvent(test.Payload)
+					So(err, ShouldBeNil)
+					So(eventType, ShouldEqual, test.EventType)
+
+					Convey("Then UnmarshalEvent returns the original payload", func() {
+						payload, err := UnmarshalEvent(eventType, b)
+						So(err, ShouldBeNil)
+						So(payload, ShouldResemble, test.Payload)
+					})
+				})
+
+				Convey("Then the protobuf marshaler returns the original payload", func() {
+					eventType, b, err := MarshalerProtobuf.MarshalEvent(test.Payload)
+					So(err, ShouldBeNil)
+					So(eventType, ShouldEqual, test.EventType)
+
+					payload, err := MarshalerProtobuf.UnmarshalEvent(eventType, b)
+					So(err, ShouldBeNil)
+					So(payload, ShouldResemble, test.Payload)
+				})
+			})
+		}
+
+		Convey("Given a DataDownPayload", func() {
+			pl := DataDownPayload{
+				Reference:  "abcd",
+				Confirmed:  true,
+				DevEUI:     devEUI,
+				FPort:      10,
+				Object:     json.RawMessage(`{"temperature":21.5}`),
+				Nonce:      "1234",
+				ExpiresAt:  &now,
+				DelayUntil: &now,
+				MaxRetries: 3,
+			}
+
+			for _, m := range []Marshaler{MarshalerJSON, MarshalerProtobuf} {
+				Convey(fmt.Sprintf("Then the %s marshaler returns the original payload", m), func() {
+					b, err := m.Marshal(pl)
+					So(err, ShouldBeNil)
+
+					dataDown, err := m.UnmarshalDataDown(b)
+					So(err, ShouldBeNil)
+					So(dataDown, ShouldResemble, pl)
+				})
+			}
+		})
+
+		Convey("Given an encrypted DataDownPayload", func() {
+			fCnt := uint32(12)
+			pl := DataDownPayload{
+				Reference: "abcd",
+				DevEUI:    devEUI,
+				FPort:     10,
+				Data:      []byte{1, 2, 3},
+				Encrypted: true,
+				FCnt:      &fCnt,
+			}
+
+			for _, m := range []Marshaler{MarshalerJSON, MarshalerProtobuf} {
+				Convey(fmt.Sprintf("Then the %s marshaler returns the original payload", m), func() {
+					b, err := m.Marshal(pl)
+					So(err, ShouldBeNil)
+
+					dataDown, err := m.UnmarshalDataDown(b)
+					So(err, ShouldBeNil)
+					So(dataDown, ShouldResemble, pl)
+				})
+			}
+		})
+
+		Convey("Then ParseMarshaler only accepts json and protobuf", func() {
+			m, err := ParseMarshaler("protobuf")
+			So(err, ShouldBeNil)
+			So(m, ShouldEqual, MarshalerProtobuf)
+
+			_, err = ParseMarshaler("xml")
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("Then MarshalEvent returns an error for an unknown payload", func() {
+			_, _, err := MarshalEvent(struct{}{})
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("Then UnmarshalEvent returns an error for an unknown event type", func() {
+			_, err := UnmarshalEvent("unknown", []byte("{}"))
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
+
+func TestNopHandler(t *testing.T) {
+	Convey("Given a NopHandler", t, func() {
+		var h Handler = &NopHandler{}
+
+		Convey("Then sending a payload does not return an error", func() {
+			So(h.SendDataUp(lorawan.EUI64{}, lorawan.EUI64{}, DataUpPayload{}), ShouldBeNil)
+		})
+
+		Convey("When closing the handler", func() {
+			So(h.Close(), ShouldBeNil)
+
+			Convey("Then the data-down channel is closed", func() {
+				_, ok := <-h.DataDownChan()
+				So(ok, ShouldBeFalse)
+			})
+		})
+	})
+}
@@ -0,0 +1,190 @@
+package integration
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// Event types (matching the last part of the MQTT topics).
+const (
+	EventDataUp      = "rx"
+	EventJoin        = "join"
+	EventACK         = "ack"
+	EventError       = "error"
+	EventLinkQuality = "linkquality"
+	EventLifecycle   = "lifecycle"
+	EventStatus      = "status"
+	EventFirmware    = "firmware"
+	EventLocation    = "location"
+	EventScheduled   = "scheduled"
+)
+
+// EventTypes contains all the event types.
+var EventTypes = []string{
+	EventDataUp,
+	EventJoin,
+	EventACK,
+	EventError,
+	EventLinkQuality,
+	EventLifecycle,
+	EventStatus,
+	EventFirmware,
+	EventLocation,
+	EventScheduled,
+}
+
+// EventType returns the event type of the given payload.
+func EventType(payload interface{}) (string, error) {
+	switch payload.(type) {
+	case DataUpPayload, *DataUpPayload:
+		return EventDataUp, nil
+	case JoinNotification, *JoinNotification:
+		return EventJoin, nil
+	case ACKNotification, *ACKNotification:
+		return EventACK, nil
+	case ErrorNotification, *ErrorNotification:
+		return EventError, nil
+	case LinkQualityNotification, *LinkQualityNotification:
+		return EventLinkQuality, nil
+	case LifecycleNotification, *LifecycleNotification:
+		return EventLifecycle, nil
+	case DeviceStatus, *DeviceStatus:
+		return EventStatus, nil
+	case FirmwareNotification, *FirmwareNotification:
+		return EventFirmware, nil
+	case LocationNotification, *LocationNotification:
+		return EventLocation, nil
+	case ScheduledDownlinkNotification, *ScheduledDownlinkNotification:
+		return EventScheduled, nil
+	default:
+		return "", fmt.Errorf("unknown payload type: %T", payload)
+	}
+}
+
+// Marshaler defines the encoding of the payloads sent to and received from
+// the applications.
+type Marshaler string
+
+// Available marshalers.
+const (
+	MarshalerJSON     Marshaler = "json"
+	MarshalerProtobuf Marshaler = "protobuf" // see api/integration/integration.proto
+)
+
+// ParseMarshaler returns the Marshaler for the given name.
+func ParseMarshaler(s string) (Marshaler, error) {
+	switch m := Marshaler(s); m {
+	case MarshalerJSON, MarshalerProtobuf:
+		return m, nil
+	default:
+		return "", fmt.Errorf("invalid marshaler: %s (json or protobuf expected)", s)
+	}
+}
+
+// ContentType returns the MIME type of the payloads encoded by the
+// Marshaler.
+func (m Marshaler) ContentType() string {
+	if m == MarshalerProtobuf {
+		return "application/x-protobuf"
+	}
+	return "application/json"
+}
+
+// Marshal encodes the given payload (e.g. a DataUpPayload or GatewayStats).
+func (m Marshaler) Marshal(payload interface{}) ([]byte, error) {
+	if m != MarshalerProtobuf {
+		return json.Marshal(payload)
+	}
+	msg, err := payloadToProto(payload)
+	if err != nil {
+		return nil, err
+	}
+	return proto.Marshal(msg)
+}
+
+// MarshalEvent returns the event type and the encoding of the given payload.
+func (m Marshaler) MarshalEvent(payload interface{}) (string, []byte, error) {
+	eventType, err := EventType(payload)
+	if err != nil {
+		return "", nil, err
+	}
+	b, err := m.Marshal(payload)
+	if err != nil {
+		return "", nil, fmt.Errorf("marshal %s payload error: %s", eventType, err)
+	}
+	return eventType, b, nil
+}
+
+// UnmarshalEvent decodes the given payload of the given event type. It
+// returns the payload as value (e.g. DataUpPayload for the rx event).
+func (m Marshaler) UnmarshalEvent(eventType string, b []byte) (interface{}, error) {
+	var payload interface{}
+	switch eventType {
+	case EventDataUp:
+		payload = &DataUpPayload{}
+	case EventJoin:
+		payload = &JoinNotification{}
+	case EventACK:
+		payload = &ACKNotification{}
+	case EventError:
+		payload = &ErrorNotification{}
+	case EventLinkQuality:
+		payload = &LinkQualityNotification{}
+	case EventLifecycle:
+		payload = &LifecycleNotification{}
+	case EventStatus:
+		payload = &DeviceStatus{}
+	case EventFirmware:
+		payload = &FirmwareNotification{}
+	case EventLocation:
+		payload = &LocationNotification{}
+	case EventScheduled:
+		payload = &ScheduledDownlinkNotification{}
+	default:
+		return nil, fmt.Errorf("unknown event type: %s", eventType)
+	}
+
+	if err := m.unmarshal(b, payload); err != nil {
+		return nil, fmt.Errorf("unmarshal %s payload error: %s", eventType, err)
+	}
+
+	return reflect.ValueOf(payload).Elem().Interface(), nil
+}
+
+// UnmarshalDataDown decodes the given DataDownPayload.
+func (m Marshaler) UnmarshalDataDown(b []byte) (DataDownPayload, error) {
+	var pl DataDownPayload
+	if err := m.unmarshal(b, &pl); err != nil {
+		return pl, fmt.Errorf("unmarshal data-down payload error: %s", err)
+	}
+	return pl, nil
+}
+
+// unmarshal decodes b into the given payload pointer.
+func (m Marshaler) unmarshal(b []byte, payload interface{}) error {
+	if m != MarshalerProtobuf {
+		return json.Unmarshal(b, payload)
+	}
+	return unmarshalProto(b, payload)
+}
+
+// MarshalEvent returns the event type and the JSON encoding of the given
+// payload.
+func MarshalEvent(payload interface{}) (string, []byte, error) {
+	return MarshalerJSON.MarshalEvent(payload)
+}
+
+// UnmarshalEvent decodes the given JSON encoded payload of the given event
+// type. It returns the payload as value (e.g. DataUpPayload for the rx
+// event).
+func UnmarshalEvent(eventType string, b []byte) (interface{}, error) {
+	return MarshalerJSON.UnmarshalEvent(eventType, b)
+}
+
+// UnmarshalDataDown decodes the given JSON encoded DataDownPayload.
+func UnmarshalDataDown(b []byte) (DataDownPayload, error) {
+	return MarshalerJSON.UnmarshalDataDown(b)
+}
@@ -0,0 +1,223 @@
+package integration
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/brocaar/lorawan"
+)
+
+// DataRate contains the data-rate related fields.
+type DataRate struct {
+	Modulation   string `json:"modulation"`
+	Bandwidth    int    `json:"bandwidth"`
+	SpreadFactor int    `json:"spreadFactor,omitempty"`
+	Bitrate      int    `json:"bitrate,omitempty"`
+}
+
+// RXInfo contains the RX information.
+type RXInfo struct {
+	MAC     lorawan.EUI64 `json:"mac"`
+	Time    *time.Time    `json:"time,omitempty"`
+	RSSI    int           `json:"rssi"`
+	LoRaSNR float64       `json:"loRaSNR"`
+}
+
+// TXInfo contains the TX information.
+type TXInfo struct {
+	Frequency int      `json:"frequency"`
+	DataRate  DataRate `json:"dataRate"`
+	ADR       bool     `json:"adr"`
+	CodeRate  string   `json:"codeRate"`
+}
+
+// DataUpPayload represents a data-up payload.
+type DataUpPayload struct {
+	DevEUI lorawan.EUI64 `json:"devEUI"`
+	RXInfo []RXInfo      `json:"rxInfo"`
+	TXInfo TXInfo        `json:"txInfo"`
+	FCnt   uint32        `json:"fCnt"`
+	FPort  uint8         `json:"fPort"`
+	Data   []byte        `json:"data"`
+	Object interface{}   `json:"object,omitempty"` // decoded by the payload codec of the application
+
+	// Encrypted is set when the node uses end-to-end encryption, in which
+	// case Data contains the encrypted FRMPayload. DevAddr and FCnt are
+	// needed by the application for decrypting the payload.
+	Encrypted bool             `json:"encrypted,omitempty"`
+	DevAddr   *lorawan.DevAddr `json:"devAddr,omitempty"`
+}
+
+// DataDownPayload represents a data-down payload.
+type DataDownPayload struct {
+	Reference  string          `json:"reference"`
+	Confirmed  bool            `json:"confirmed"`
+	DevEUI     lorawan.EUI64   `json:"devEUI"`
+	FPort      uint8           `json:"fPort"`
+	Data       []byte          `json:"data"`
+	Object     json.RawMessage `json:"object,omitempty"` // encoded by the payload codec of the application (when data is empty)
+	Nonce      string          `json:"nonce,omitempty"`
+	ExpiresAt  *time.Time      `json:"expiresAt,omitempty"`
+	DelayUntil *time.Time      `json:"delayUntil,omitempty"` // the payload is not sent before this time
+	MaxRetries uint32          `json:"maxRetries,omitempty"` // max. number of retransmissions of a confirmed payload (0 = no limit)
+	Principal  string          `json:"-"`                    // principal of the handler which received the payload (recorded in the audit log)
+
+	// Encrypted is set when Data contains the FRMPayload encrypted by the
+	// application (end-to-end encryption), using the given FCnt. The
+	// payload is only sent when FCnt matches the downlink frame-counter of
+	// the node.
+	Encrypted bool    `json:"encrypted,omitempty"`
+	FCnt      *uint32 `json:"fCnt,omitempty"`
+}
+
+// JoinNotification defines the payload sent to the application on
+// a JoinNotificationType event.
+type JoinNotification struct {
+	DevAddr lorawan.DevAddr `json:"devAddr"`
+	DevEUI  lorawan.EUI64   `json:"devEUI"`
+}
+
+// ACKNotification defines the payload sent to the application
+// on an ACK event.
+type ACKNotification struct {
+	Reference string        `json:"reference"`
+	DevEUI    lorawan.EUI64 `json:"devEUI"`
+}
+
+// ErrorNotification defines the payload sent to the application
+// on an error event.
+type ErrorNotification struct {
+	DevEUI    lorawan.EUI64 `json:"devEUI"`
+	Reference string        `json:"reference,omitempty"`
+	Type      string        `json:"type"`
+	Error     string        `json:"error"`
+}
+
+// LinkQualityNotification defines the payload sent to the application
+// when the link-quality of a node degrades.
+type LinkQualityNotification struct {
+	DevEUI          lorawan.EUI64 `json:"devEUI"`
+	Score           int           `json:"score"`
+	PreviousScore   int           `json:"previousScore"`
+	Grade           string        `json:"grade"`
+	PreviousGrade   string        `json:"previousGrade"`
+	RSSI            float64       `json:"rssi"`
+	LoRaSNR         float64       `json:"loRaSNR"`
+	SNRTrend        float64       `json:"snrTrend"`
+	PacketLoss      float64       `json:"packetLoss"`
+	Retransmissions float64       `json:"retransmissions"`
+}
+
+// Lifecycle entities.
+const (
+	LifecycleEntityNode = "NODE"
+)
+
+// Lifecycle actions.
+const (
+	LifecycleActionCreate = "CREATE"
+	LifecycleActionUpdate = "UPDATE"
+	LifecycleActionDelete = "DELETE"
+)
+
+// LifecycleNotification defines the payload sent to the application when
+// a node is created, updated or deleted using the API.
+type LifecycleNotification struct {
+	Entity         string         `json:"entity"`
+	Action         string         `json:"action"`
+	DevEUI         lorawan.EUI64  `json:"devEUI"`
+	AppEUI         lorawan.EUI64  `json:"appEUI"`
+	PreviousAppEUI *lorawan.EUI64 `json:"previousAppEUI,omitempty"` // set when the node moved to an other application
+	Name           string         `json:"name"`
+	Time           time.Time      `json:"time"`
+}
+
+// Firmware update states of a node.
+const (
+	FirmwareStatePending    = "PENDING"     // waiting for the deployment to start
+	FirmwareStateInProgress = "IN_PROGRESS" // the fragments have been enqueued
+	FirmwareStateCompleted  = "COMPLETED"   // all the fragments have been received
+	FirmwareStateFailed     = "FAILED"      // the node reported an error
+	FirmwareStateTimeout    = "TIMEOUT"     // not completed before the deployment timeout
+)
+
+// FirmwareNotification defines the payload sent to the application when the
+// firmware update state of a node changes, or when the node reports its
+// progress.
+type FirmwareNotification struct {
+	DeploymentID   int64         `json:"deploymentID"`
+	DevEUI         lorawan.EUI64 `json:"devEUI"`
+	State          string        `json:"state"`
+	NbFrag         int           `json:"nbFrag"`         // number of (uncoded) fragments of the firmware image
+	NbFragReceived int           `json:"nbFragReceived"` // as last reported by the node
+	MissingFrag    int           `json:"missingFrag"`    // as last reported by the node
+	Error          string        `json:"error,omitempty"`
+	Time           time.Time     `json:"time"`
+}
+
+// DeviceStatus represents the last known status of a node, derived from its
+// last received uplink. When the node reported its device status
+// (DevStatusAns), the battery and link margin are set too.
+type DeviceStatus struct {
+	DevEUI       lorawan.EUI64 `json:"devEUI"`
+	LastSeen     time.Time     `json:"lastSeen"`
+	FCnt         uint32        `json:"fCnt"`
+	RSSI         int           `json:"rssi"`                   // RSSI of the best gateway
+	LoRaSNR      float64       `json:"loRaSNR"`                // SNR of the best gateway
+	Margin       *float64      `json:"margin,omitempty"`       // SNR above the demodulation floor of the spread-factor (LoRa only)
+	Battery      *int          `json:"battery,omitempty"`      // 0 = external power source, 1 - 254 = battery level, 255 = unable to measure
+	BatteryLevel *float64      `json:"batteryLevel,omitempty"` // battery level in percent
+	LinkMargin   *int          `json:"linkMargin,omitempty"`   // demodulation margin (dB) of the last DevStatusReq, as reported by the node
+}
+
+// LocationNotification defines the payload sent to the application when the
+// location of a node has been resolved, based on the meta-data of an uplink
+// received by multiple gateways.
+type LocationNotification struct {
+	DevEUI     lorawan.EUI64 `json:"devEUI"`
+	Latitude   float64       `json:"latitude"`
+	Longitude  float64       `json:"longitude"`
+	Altitude   float64       `json:"altitude"`   // meters
+	Accuracy   float64       `json:"accuracy"`   // meters (0 = unknown)
+	Source     string        `json:"source"`     // resolver used (e.g. loracloud)
+	NbGateways int           `json:"nbGateways"` // number of gateways used for resolving the location
+	Time       time.Time     `json:"time"`       // time the uplink was received
+}
+
+// ScheduledDownlinkNotification defines the payload sent to the application
+// when a payload enqueued by a downlink rule has been transmitted to the
+// node.
+type ScheduledDownlinkNotification struct {
+	DevEUI    lorawan.EUI64 `json:"devEUI"`
+	RuleID    int64         `json:"ruleID"`
+	Name      string        `json:"name"` // name of the rule (reference of the payload)
+	FPort     uint8         `json:"fPort"`
+	FCnt      uint32        `json:"fCnt"`
+	Confirmed bool          `json:"confirmed"`
+	Time      time.Time     `json:"time"` // time the payload was transmitted
+}
+
+// GatewayStats defines the (hourly) statistics of a gateway, as published to
+// the gateway/[MAC]/stats topic.
+type GatewayStats struct {
+	MAC               lorawan.EUI64 `json:"mac"`
+	Time              time.Time     `json:"time"` // start of the interval
+	RXPacketsReceived int           `json:"rxPacketsReceived"`
+	AvgRSSI           float64       `json:"avgRSSI"`          // average RSSI of the received packets
+	AvgLoRaSNR        float64       `json:"avgLoRaSNR"`       // average SNR of the received packets
+	TXPacketsEmitted  int           `json:"txPacketsEmitted"` // estimated
+	TXAirtime         int           `json:"txAirtime"`        // estimated downlink airtime (ms)
+}
+
+// UsageStats defines the (hourly) usage statistics of an application, as
+// published to the application/[AppEUI]/stats topic.
+type UsageStats struct {
+	AppEUI      lorawan.EUI64 `json:"appEUI"`
+	Time        time.Time     `json:"time"` // start of the interval
+	RXPackets   int           `json:"rxPackets"`
+	AvgRSSI     float64       `json:"avgRSSI"`    // average RSSI of the uplinks (best gateway)
+	AvgLoRaSNR  float64       `json:"avgLoRaSNR"` // average SNR of the uplinks (best gateway)
+	TXPackets   int           `json:"txPackets"`
+	Errors      int           `json:"errors"`
+	ActiveNodes int           `json:"activeNodes"` // number of nodes which sent at least one uplink
+}
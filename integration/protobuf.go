@@ -0,0 +1,508 @@
+package integration
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	pb "github.com/brocaar/lora-app-server/api/integration"
+	"github.com/brocaar/lorawan"
+)
+
+// payloadToProto returns the protobuf message of the given payload.
+func payloadToProto(payload interface{}) (proto.Message, error) {
+	switch pl := payload.(type) {
+	case *DataUpPayload:
+		return payloadToProto(*pl)
+	case DataUpPayload:
+		msg := pb.DataUpPayload{
+			DevEUI: pl.DevEUI.String(),
+			TxInfo: &pb.TXInfo{
+				Frequency: uint32(pl.TXInfo.Frequency),
+				DataRate: &pb.DataRate{
+					Modulation:   pl.TXInfo.DataRate.Modulation,
+					Bandwidth:    uint32(pl.TXInfo.DataRate.Bandwidth),
+					SpreadFactor: uint32(pl.TXInfo.DataRate.SpreadFactor),
+					Bitrate:      uint32(pl.TXInfo.DataRate.Bitrate),
+				},
+				Adr:      pl.TXInfo.ADR,
+				CodeRate: pl.TXInfo.CodeRate,
+			},
+			FCnt:      pl.FCnt,
+			FPort:     uint32(pl.FPort),
+			Data:      pl.Data,
+			Encrypted: pl.Encrypted,
+		}
+		if pl.DevAddr != nil {
+			msg.DevAddr = pl.DevAddr.String()
+		}
+		for _, rxInfo := range pl.RXInfo {
+			msg.RxInfo = append(msg.RxInfo, &pb.RXInfo{
+				Mac:     rxInfo.MAC.String(),
+				Time:    formatTime(rxInfo.Time),
+				Rssi:    int32(rxInfo.RSSI),
+				LoRaSNR: rxInfo.LoRaSNR,
+			})
+		}
+		if pl.Object != nil {
+			b, err := json.Marshal(pl.Object)
+			if err != nil {
+				return nil, fmt.Errorf("marshal object error: %s", err)
+			}
+			msg.ObjectJSON = string(b)
+		}
+		return &msg, nil
+	case *DataDownPayload:
+		return payloadToProto(*pl)
+	case DataDownPayload:
+		msg := pb.DataDownPayload{
+			Reference:  pl.Reference,
+			Confirmed:  pl.Confirmed,
+			DevEUI:     pl.DevEUI.String(),
+			FPort:      uint32(pl.FPort),
+			Data:       pl.Data,
+			ObjectJSON: string(pl.Object),
+			Nonce:      pl.Nonce,
+			ExpiresAt:  formatTime(pl.ExpiresAt),
+			DelayUntil: formatTime(pl.DelayUntil),
+			MaxRetries: pl.MaxRetries,
+			Encrypted:  pl.Encrypted,
+		}
+		if pl.FCnt != nil {
+			msg.FCnt = *pl.FCnt
+		}
+		return &msg, nil
+	case *JoinNotification:
+		return payloadToProto(*pl)
+	case JoinNotification:
+		return &pb.JoinNotification{
+			DevAddr: pl.DevAddr.String(),
+			DevEUI:  pl.DevEUI.String(),
+		}, nil
+	case *ACKNotification:
+		return payloadToProto(*pl)
+	case ACKNotification:
+		return &pb.ACKNotification{
+			Reference: pl.Reference,
+			DevEUI:    pl.DevEUI.String(),
+		}, nil
+	case *ErrorNotification:
+		return payloadToProto(*pl)
+	case ErrorNotification:
+		return &pb.ErrorNotification{
+			DevEUI:    pl.DevEUI.String(),
+			Reference: pl.Reference,
+			Type:      pl.Type,
+			Error:     pl.Error,
+		}, nil
+	case *LinkQualityNotification:
+		return payloadToProto(*pl)
+	case LinkQualityNotification:
+		return &pb.LinkQualityNotification{
+			DevEUI:          pl.DevEUI.String(),
+			Score:           int32(pl.Score),
+			PreviousScore:   int32(pl.PreviousScore),
+			Grade:           pl.Grade,
+			PreviousGrade:   pl.PreviousGrade,
+			Rssi:            pl.RSSI,
+			LoRaSNR:         pl.LoRaSNR,
+			SnrTrend:        pl.SNRTrend,
+			PacketLoss:      pl.PacketLoss,
+			Retransmissions: pl.Retransmissions,
+		}, nil
+	case *LifecycleNotification:
+		return payloadToProto(*pl)
+	case LifecycleNotification:
+		msg := pb.LifecycleNotification{
+			Entity: pl.Entity,
+			Action: pl.Action,
+			DevEUI: pl.DevEUI.String(),
+			AppEUI: pl.AppEUI.String(),
+			Name:   pl.Name,
+			Time:   formatTime(&pl.Time),
+		}
+		if pl.PreviousAppEUI != nil {
+			msg.PreviousAppEUI = pl.PreviousAppEUI.String()
+		}
+		return &msg, nil
+	case *FirmwareNotification:
+		return payloadToProto(*pl)
+	case FirmwareNotification:
+		return &pb.FirmwareNotification{
+			DeploymentID:   pl.DeploymentID,
+			DevEUI:         pl.DevEUI.String(),
+			State:          pl.State,
+			NbFrag:         uint32(pl.NbFrag),
+			NbFragReceived: uint32(pl.NbFragReceived),
+			MissingFrag:    uint32(pl.MissingFrag),
+			Error:          pl.Error,
+			Time:           formatTime(&pl.Time),
+		}, nil
+	case *DeviceStatus:
+		return payloadToProto(*pl)
+	case DeviceStatus:
+		msg := pb.DeviceStatus{
+			DevEUI:   pl.DevEUI.String(),
+			LastSeen: formatTime(&pl.LastSeen),
+			FCnt:     pl.FCnt,
+			Rssi:     int32(pl.RSSI),
+			LoRaSNR:  pl.LoRaSNR,
+		}
+		if pl.Margin != nil {
+			msg.Margin = *pl.Margin
+			msg.HasMargin = true
+		}
+		if pl.Battery != nil {
+			msg.Battery = uint32(*pl.Battery)
+			msg.HasBattery = true
+		}
+		if pl.BatteryLevel != nil {
+			msg.BatteryLevel = *pl.BatteryLevel
+			msg.HasBatteryLevel = true
+		}
+		if pl.LinkMargin != nil {
+			msg.LinkMargin = int32(*pl.LinkMargin)
+			msg.HasLinkMargin = true
+		}
+		return &msg, nil
+	case *LocationNotification:
+		return payloadToProto(*pl)
+	case LocationNotification:
+		return &pb.LocationNotification{
+			DevEUI:     pl.DevEUI.String(),
+			Latitude:   pl.Latitude,
+			Longitude:  pl.Longitude,
+			Altitude:   pl.Altitude,
+			Accuracy:   pl.Accuracy,
+			Source:     pl.Source,
+			NbGateways: uint32(pl.NbGateways),
+			Time:       formatTime(&pl.Time),
+		}, nil
+	case *ScheduledDownlinkNotification:
+		return payloadToProto(*pl)
+	case ScheduledDownlinkNotification:
+		return &pb.ScheduledDownlinkNotification{
+			DevEUI:    pl.DevEUI.String(),
+			RuleID:    pl.RuleID,
+			Name:      pl.Name,
+			FPort:     uint32(pl.FPort),
+			FCnt:      pl.FCnt,
+			Confirmed: pl.Confirmed,
+			Time:      formatTime(&pl.Time),
+		}, nil
+	case *GatewayStats:
+		return payloadToProto(*pl)
+	case GatewayStats:
+		return &pb.GatewayStats{
+			Mac:               pl.MAC.String(),
+			Time:              formatTime(&pl.Time),
+			RxPacketsReceived: uint32(pl.RXPacketsReceived),
+			AvgRSSI:           pl.AvgRSSI,
+			AvgLoRaSNR:        pl.AvgLoRaSNR,
+			TxPacketsEmitted:  uint32(pl.TXPacketsEmitted),
+			TxAirtime:         uint32(pl.TXAirtime),
+		}, nil
+	case *UsageStats:
+		return payloadToProto(*pl)
+	case UsageStats:
+		return &pb.UsageStats{
+			AppEUI:      pl.AppEUI.String(),
+			Time:        formatTime(&pl.Time),
+			RxPackets:   uint32(pl.RXPackets),
+			AvgRSSI:     pl.AvgRSSI,
+			AvgLoRaSNR:  pl.AvgLoRaSNR,
+			TxPackets:   uint32(pl.TXPackets),
+			Errors:      uint32(pl.Errors),
+			ActiveNodes: uint32(pl.ActiveNodes),
+		}, nil
+	default:
+		return nil, fmt.Errorf("unknown payload type: %T", payload)
+	}
+}
+
+// unmarshalProto decodes the given protobuf encoded message into the given
+// payload pointer.
+func unmarshalProto(b []byte, payload interface{}) error {
+	var err error
+	switch pl := payload.(type) {
+	case *DataUpPayload:
+		var msg pb.DataUpPayload
+		if err = proto.Unmarshal(b, &msg); err != nil {
+			return err
+		}
+		*pl = DataUpPayload{
+			FCnt:      msg.FCnt,
+			FPort:     uint8(msg.FPort),
+			Data:      msg.Data,
+			Encrypted: msg.Encrypted,
+		}
+		if err = parseEUI(msg.DevEUI, &pl.DevEUI); err != nil {
+			return err
+		}
+		if msg.DevAddr != "" {
+			pl.DevAddr = &lorawan.DevAddr{}
+			if err = pl.DevAddr.UnmarshalText([]byte(msg.DevAddr)); err != nil {
+				return err
+			}
+		}
+		for _, rx := range msg.RxInfo {
+			rxInfo := RXInfo{
+				RSSI:    int(rx.Rssi),
+				LoRaSNR: rx.LoRaSNR,
+			}
+			if err = parseEUI(rx.Mac, &rxInfo.MAC); err != nil {
+				return err
+			}
+			if rxInfo.Time, err = parseTime(rx.Time); err != nil {
+				return err
+			}
+			pl.RXInfo = append(pl.RXInfo, rxInfo)
+		}
+		if tx := msg.TxInfo; tx != nil {
+			pl.TXInfo = TXInfo{
+				Frequency: int(tx.Frequency),
+				ADR:       tx.Adr,
+				CodeRate:  tx.CodeRate,
+			}
+			if dr := tx.DataRate; dr != nil {
+				pl.TXInfo.DataRate = DataRate{
+					Modulation:   dr.Modulation,
+					Bandwidth:    int(dr.Bandwidth),
+					SpreadFactor: int(dr.SpreadFactor),
+					Bitrate:      int(dr.Bitrate),
+				}
+			}
+		}
+		if msg.ObjectJSON != "" {
+			if err = json.Unmarshal([]byte(msg.ObjectJSON), &pl.Object); err != nil {
+				return fmt.Errorf("unmarshal object error: %s", err)
+			}
+		}
+	case *DataDownPayload:
+		var msg pb.DataDownPayload
+		if err = proto.Unmarshal(b, &msg); err != nil {
+			return err
+		}
+		*pl = DataDownPayload{
+			Reference:  msg.Reference,
+			Confirmed:  msg.Confirmed,
+			FPort:      uint8(msg.FPort),
+			Data:       msg.Data,
+			Nonce:      msg.Nonce,
+			MaxRetries: msg.MaxRetries,
+			Encrypted:  msg.Encrypted,
+		}
+		// the frame-counter is only meaningful for encrypted payloads
+		if msg.Encrypted {
+			fCnt := msg.FCnt
+			pl.FCnt = &fCnt
+		}
+		if msg.ObjectJSON != "" {
+			pl.Object = json.RawMessage(msg.ObjectJSON)
+		}
+		if err = parseEUI(msg.DevEUI, &pl.DevEUI); err != nil {
+			return err
+		}
+		if pl.ExpiresAt, err = parseTime(msg.ExpiresAt); err != nil {
+			return err
+		}
+		if pl.DelayUntil, err = parseTime(msg.DelayUntil); err != nil {
+			return err
+		}
+	case *JoinNotification:
+		var msg pb.JoinNotification
+		if err = proto.Unmarshal(b, &msg); err != nil {
+			return err
+		}
+		*pl = JoinNotification{}
+		if err = pl.DevAddr.UnmarshalText([]byte(msg.DevAddr)); err != nil {
+			return err
+		}
+		return parseEUI(msg.DevEUI, &pl.DevEUI)
+	case *ACKNotification:
+		var msg pb.ACKNotification
+		if err = proto.Unmarshal(b, &msg); err != nil {
+			return err
+		}
+		*pl = ACKNotification{Reference: msg.Reference}
+		return parseEUI(msg.DevEUI, &pl.DevEUI)
+	case *ErrorNotification:
+		var msg pb.ErrorNotification
+		if err = proto.Unmarshal(b, &msg); err != nil {
+			return err
+		}
+		*pl = ErrorNotification{
+			Reference: msg.Reference,
+			Type:      msg.Type,
+			Error:     msg.Error,
+		}
+		return parseEUI(msg.DevEUI, &pl.DevEUI)
+	case *LinkQualityNotification:
+		var msg pb.LinkQualityNotification
+		if err = proto.Unmarshal(b, &msg); err != nil {
+			return err
+		}
+		*pl = LinkQualityNotification{
+			Score:           int(msg.Score),
+			PreviousScore:   int(msg.PreviousScore),
+			Grade:           msg.Grade,
+			PreviousGrade:   msg.PreviousGrade,
+			RSSI:            msg.Rssi,
+			LoRaSNR:         msg.LoRaSNR,
+			SNRTrend:        msg.SnrTrend,
+			PacketLoss:      msg.PacketLoss,
+			Retransmissions: msg.Retransmissions,
+		}
+		return parseEUI(msg.DevEUI, &pl.DevEUI)
+	case *LifecycleNotification:
+		var msg pb.LifecycleNotification
+		if err = proto.Unmarshal(b, &msg); err != nil {
+			return err
+		}
+		*pl = LifecycleNotification{
+			Entity: msg.Entity,
+			Action: msg.Action,
+			Name:   msg.Name,
+		}
+		if err = parseEUI(msg.DevEUI, &pl.DevEUI); err != nil {
+			return err
+		}
+		if err = parseEUI(msg.AppEUI, &pl.AppEUI); err != nil {
+			return err
+		}
+		if msg.PreviousAppEUI != "" {
+			pl.PreviousAppEUI = &lorawan.EUI64{}
+			if err = parseEUI(msg.PreviousAppEUI, pl.PreviousAppEUI); err != nil {
+				return err
+			}
+		}
+		return parseTimeValue(msg.Time, &pl.Time)
+	case *FirmwareNotification:
+		var msg pb.FirmwareNotification
+		if err = proto.Unmarshal(b, &msg); err != nil {
+			return err
+		}
+		*pl = FirmwareNotification{
+			DeploymentID:   msg.DeploymentID,
+			State:          msg.State,
+			NbFrag:         int(msg.NbFrag),
+			NbFragReceived: int(msg.NbFragReceived),
+			MissingFrag:    int(msg.MissingFrag),
+			Error:          msg.Error,
+		}
+		if err = parseEUI(msg.DevEUI, &pl.DevEUI); err != nil {
+			return err
+		}
+		return parseTimeValue(msg.Time, &pl.Time)
+	case *DeviceStatus:
+		var msg pb.DeviceStatus
+		if err = proto.Unmarshal(b, &msg); err != nil {
+			return err
+		}
+		*pl = DeviceStatus{
+			FCnt:    msg.FCnt,
+			RSSI:    int(msg.Rssi),
+			LoRaSNR: msg.LoRaSNR,
+		}
+		if msg.HasMargin {
+			margin := msg.Margin
+			pl.Margin = &margin
+		}
+		if msg.HasBattery {
+			battery := int(msg.Battery)
+			pl.Battery = &battery
+		}
+		if msg.HasBatteryLevel {
+			level := msg.BatteryLevel
+			pl.BatteryLevel = &level
+		}
+		if msg.HasLinkMargin {
+			linkMargin := int(msg.LinkMargin)
+			pl.LinkMargin = &linkMargin
+		}
+		if err = parseEUI(msg.DevEUI, &pl.DevEUI); err != nil {
+			return err
+		}
+		return parseTimeValue(msg.LastSeen, &pl.LastSeen)
+	case *LocationNotification:
+		var msg pb.LocationNotification
+		if err = proto.Unmarshal(b, &msg); err != nil {
+			return err
+		}
+		*pl = LocationNotification{
+			Latitude:   msg.Latitude,
+			Longitude:  msg.Longitude,
+			Altitude:   msg.Altitude,
+			Accuracy:   msg.Accuracy,
+			Source:     msg.Source,
+			NbGateways: int(msg.NbGateways),
+		}
+		if err = parseEUI(msg.DevEUI, &pl.DevEUI); err != nil {
+			return err
+		}
+		return parseTimeValue(msg.Time, &pl.Time)
+	case *ScheduledDownlinkNotification:
+		var msg pb.ScheduledDownlinkNotification
+		if err = proto.Unmarshal(b, &msg); err != nil {
+			return err
+		}
+		*pl = ScheduledDownlinkNotification{
+			RuleID:    msg.RuleID,
+			Name:      msg.Name,
+			FPort:     uint8(msg.FPort),
+			FCnt:      msg.FCnt,
+			Confirmed: msg.Confirmed,
+		}
+		if err = parseEUI(msg.DevEUI, &pl.DevEUI); err != nil {
+			return err
+		}
+		return parseTimeValue(msg.Time, &pl.Time)
+	default:
+		return fmt.Errorf("unknown payload type: %T", payload)
+	}
+	return nil
+}
+
+// formatTime returns the RFC3339 (nano) formatted time or an empty string
+// when the given time is nil.
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(time.RFC3339Nano)
+}
+
+// parseTime parses the given RFC3339 (nano) formatted time. It returns nil
+// for an empty string.
+func parseTime(s string) (*time.Time, error) {
+	if s == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+// parseTimeValue parses the given RFC3339 (nano) formatted time into t. An
+// empty string results in the zero time.
+func parseTimeValue(s string, t *time.Time) error {
+	ts, err := parseTime(s)
+	if err != nil || ts == nil {
+		return err
+	}
+	*t = *ts
+	return nil
+}
+
+// parseEUI parses the given hex encoded EUI. An empty string results in
+// the zero EUI.
+func parseEUI(s string, eui *lorawan.EUI64) error {
+	if s == "" {
+		return nil
+	}
+	return eui.UnmarshalText([]byte(s))
+}
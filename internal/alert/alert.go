@@ -0,0 +1,41 @@
+// Package alert defines the device and gateway alerts sent to the users.
+// See the notification package for the delivery of the alerts.
+package alert
+
+import (
+	"time"
+
+	"github.com/brocaar/lorawan"
+)
+
+// Alert types.
+const (
+	TypeLinkQuality      = "LINK_QUALITY"       // link-quality of a node degraded
+	TypeGatewayDutyCycle = "GATEWAY_DUTY_CYCLE" // duty-cycle utilization of a gateway approaching the limit
+	TypeBatteryLow       = "BATTERY_LOW"        // battery level of a node below the threshold of the application
+	TypeLinkMarginLow    = "LINK_MARGIN_LOW"    // link margin of a node below the threshold of the application
+)
+
+// Types contains all the alert types.
+var Types = []string{
+	TypeLinkQuality,
+	TypeGatewayDutyCycle,
+	TypeBatteryLow,
+	TypeLinkMarginLow,
+}
+
+// Alert contains a device or gateway alert. Node alerts have the AppEUI
+// and DevEUI set, gateway alerts the MAC.
+type Alert struct {
+	Type    string         `json:"type"`
+	AppEUI  *lorawan.EUI64 `json:"appEUI,omitempty"`
+	DevEUI  *lorawan.EUI64 `json:"devEUI,omitempty"`
+	MAC     *lorawan.EUI64 `json:"mac,omitempty"`
+	Message string         `json:"message"`
+	Time    time.Time      `json:"time"`
+}
+
+// Alerter defines the interface for sending alerts.
+type Alerter interface {
+	Send(a Alert) error
+}
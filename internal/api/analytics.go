@@ -0,0 +1,118 @@
+package api
+
+import (
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/brocaar/lora-app-server/api"
+	"github.com/brocaar/lora-app-server/internal/api/auth"
+	"github.com/brocaar/lora-app-server/internal/common"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+// AnalyticsAPI exports the uplink analytics functions.
+type AnalyticsAPI struct {
+	ctx       common.Context
+	validator auth.Validator
+}
+
+// NewAnalyticsAPI creates a new AnalyticsAPI.
+func NewAnalyticsAPI(ctx common.Context, validator auth.Validator) *AnalyticsAPI {
+	return &AnalyticsAPI{
+		ctx:       ctx,
+		validator: validator,
+	}
+}
+
+// GetApplicationDistribution returns the data-rate and channel distribution
+// of the uplinks of the given application.
+func (a *AnalyticsAPI) GetApplicationDistribution(ctx context.Context, req *pb.GetApplicationDistributionRequest) (*pb.UplinkDistributionResponse, error) {
+	var appEUI lorawan.EUI64
+	if err := appEUI.UnmarshalText([]byte(req.AppEUI)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("Analytics.GetApplicationDistribution"),
+		auth.ValidateApplication(appEUI),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	return a.getDistribution(storage.DistributionFilter{AppEUI: &appEUI}, req.Start, req.End, req.Interval)
+}
+
+// GetGatewayDistribution returns the data-rate and channel distribution of
+// the uplinks received by the given gateway.
+func (a *AnalyticsAPI) GetGatewayDistribution(ctx context.Context, req *pb.GetGatewayDistributionRequest) (*pb.UplinkDistributionResponse, error) {
+	var mac lorawan.EUI64
+	if err := mac.UnmarshalText([]byte(req.Mac)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	organizationID, err := storage.GetGatewayOrganizationID(a.ctx.DB, mac)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("Analytics.GetGatewayDistribution"),
+		auth.ValidateGatewayOrganization(organizationID),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	return a.getDistribution(storage.DistributionFilter{GatewayMAC: &mac}, req.Start, req.End, req.Interval)
+}
+
+func (a *AnalyticsAPI) getDistribution(filter storage.DistributionFilter, startStr, endStr string, interval pb.AggregationInterval) (*pb.UplinkDistributionResponse, error) {
+	start, end, err := parsePeriod(startStr, endStr)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	var aggInterval storage.AggregationInterval
+	switch interval {
+	case pb.AggregationInterval_PERIOD:
+		aggInterval = storage.AggregatePeriod
+	case pb.AggregationInterval_HOUR:
+		aggInterval = storage.AggregateHour
+	case pb.AggregationInterval_DAY:
+		aggInterval = storage.AggregateDay
+	default:
+		return nil, grpc.Errorf(codes.InvalidArgument, "invalid interval: %s", interval)
+	}
+
+	dataRates, err := storage.GetDataRateDistribution(a.ctx.DB, filter, start, end, aggInterval)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+	channels, err := storage.GetChannelDistribution(a.ctx.DB, filter, start, end, aggInterval)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+
+	var resp pb.UplinkDistributionResponse
+	for _, dr := range dataRates {
+		resp.DataRates = append(resp.DataRates, &pb.DataRateCount{
+			Timestamp:    dr.Timestamp.Format(time.RFC3339),
+			Modulation:   dr.Modulation,
+			SpreadFactor: uint32(dr.SpreadFactor),
+			Bandwidth:    uint32(dr.Bandwidth),
+			Bitrate:      uint32(dr.Bitrate),
+			Count:        int64(dr.Count),
+		})
+	}
+	for _, ch := range channels {
+		resp.Channels = append(resp.Channels, &pb.ChannelCount{
+			Timestamp: ch.Timestamp.Format(time.RFC3339),
+			Frequency: uint32(ch.Frequency),
+			Count:     int64(ch.Count),
+		})
+	}
+	return &resp, nil
+}
@@ -0,0 +1,152 @@
+package api
+
+import (
+	"strconv"
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/brocaar/lora-app-server/api"
+	"github.com/brocaar/lora-app-server/internal/api/auth"
+	"github.com/brocaar/lora-app-server/internal/common"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+// APIKeyAPI exports the API key related functions.
+type APIKeyAPI struct {
+	ctx       common.Context
+	validator auth.Validator
+}
+
+// NewAPIKeyAPI creates a new APIKeyAPI.
+func NewAPIKeyAPI(ctx common.Context, validator auth.Validator) *APIKeyAPI {
+	return &APIKeyAPI{
+		ctx:       ctx,
+		validator: validator,
+	}
+}
+
+// Create creates the given API key and returns the key. Only the hash of
+// the key is stored.
+func (a *APIKeyAPI) Create(ctx context.Context, req *pb.CreateAPIKeyRequest) (*pb.CreateAPIKeyResponse, error) {
+	var actor string
+	if err := a.validator.Validate(ctx,
+		auth.SetSubject(&actor),
+		auth.ValidateAPIMethod("APIKey.Create"),
+		auth.ValidateOrganization(req.OrganizationID),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	k := storage.APIKey{
+		Name:           req.Name,
+		OrganizationID: req.OrganizationID,
+		Role:           storage.OrganizationRole(req.Role.String()),
+	}
+	if req.AppEUI != "" {
+		var appEUI lorawan.EUI64
+		if err := appEUI.UnmarshalText([]byte(req.AppEUI)); err != nil {
+			return nil, grpc.Errorf(codes.InvalidArgument, err.Error())
+		}
+		k.AppEUI = &appEUI
+	}
+	if err := k.Validate(); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	secret, hash, err := auth.NewAPIKeySecret()
+	if err != nil {
+		return nil, grpc.Errorf(codes.Internal, err.Error())
+	}
+	k.KeyHash = hash
+
+	if err := storage.CreateAPIKey(a.ctx.DB, &k); err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+
+	recordAudit(a.ctx.DB, storage.AuditLog{
+		Actor:      actor,
+		Action:     "APIKey.Create",
+		ObjectType: storage.AuditObjectAPIKey,
+		ObjectID:   strconv.FormatInt(k.ID, 10),
+		AppEUI:     k.AppEUI,
+	}, map[string]interface{}{
+		"name":           k.Name,
+		"organizationID": k.OrganizationID,
+		"role":           k.Role,
+	})
+
+	return &pb.CreateAPIKeyResponse{
+		Id:  k.ID,
+		Key: auth.FormatAPIKey(k.ID, secret),
+	}, nil
+}
+
+// List lists the API keys of the given organization.
+func (a *APIKeyAPI) List(ctx context.Context, req *pb.ListAPIKeyRequest) (*pb.ListAPIKeyResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("APIKey.List"),
+		auth.ValidateOrganization(req.OrganizationID),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	keys, err := storage.GetAPIKeys(a.ctx.DB, req.OrganizationID)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+
+	var resp pb.ListAPIKeyResponse
+	for _, k := range keys {
+		r := pb.GetAPIKeyResponse{
+			Id:             k.ID,
+			Name:           k.Name,
+			OrganizationID: k.OrganizationID,
+			Role:           pb.OrganizationRole(pb.OrganizationRole_value[string(k.Role)]),
+			CreatedAt:      k.CreatedAt.Format(time.RFC3339),
+		}
+		if k.AppEUI != nil {
+			r.AppEUI = k.AppEUI.String()
+		}
+		if k.RevokedAt != nil {
+			r.RevokedAt = k.RevokedAt.Format(time.RFC3339)
+		}
+		resp.Result = append(resp.Result, &r)
+	}
+	return &resp, nil
+}
+
+// Revoke revokes the API key matching the given id.
+func (a *APIKeyAPI) Revoke(ctx context.Context, req *pb.RevokeAPIKeyRequest) (*pb.RevokeAPIKeyResponse, error) {
+	k, err := storage.GetAPIKey(a.ctx.DB, req.Id)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+
+	var actor string
+	if err := a.validator.Validate(ctx,
+		auth.SetSubject(&actor),
+		auth.ValidateAPIMethod("APIKey.Revoke"),
+		auth.ValidateOrganization(k.OrganizationID),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if err := storage.RevokeAPIKey(a.ctx.DB, k.ID); err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+
+	recordAudit(a.ctx.DB, storage.AuditLog{
+		Actor:      actor,
+		Action:     "APIKey.Revoke",
+		ObjectType: storage.AuditObjectAPIKey,
+		ObjectID:   strconv.FormatInt(k.ID, 10),
+		AppEUI:     k.AppEUI,
+	}, map[string]interface{}{
+		"organizationID": k.OrganizationID,
+	})
+	return &pb.RevokeAPIKeyResponse{}, nil
+}
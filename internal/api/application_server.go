@@ -11,16 +11,26 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 
+	"github.com/brocaar/lora-app-server/integration"
+	"github.com/brocaar/lora-app-server/internal/codec"
 	"github.com/brocaar/lora-app-server/internal/common"
-	"github.com/brocaar/lora-app-server/internal/handler"
+	"github.com/brocaar/lora-app-server/internal/downlink"
+	"github.com/brocaar/lora-app-server/internal/dutycycle"
+	"github.com/brocaar/lora-app-server/internal/fuota"
+	"github.com/brocaar/lora-app-server/internal/gateway"
+	"github.com/brocaar/lora-app-server/internal/geolocation"
+	"github.com/brocaar/lora-app-server/internal/linkquality"
 	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lora-app-server/internal/uplink"
+	"github.com/brocaar/lora-app-server/internal/usage"
 	"github.com/brocaar/loraserver/api/as"
 	"github.com/brocaar/lorawan"
 )
 
 // ApplicationServerAPI implements the as.ApplicationServerServer interface.
 type ApplicationServerAPI struct {
-	ctx common.Context
+	ctx         common.Context
+	geolocation geolocation.Resolver
 }
 
 // NewApplicationServerAPI returns a new ApplicationServerAPI.
@@ -30,6 +40,12 @@ func NewApplicationServerAPI(ctx common.Context) *ApplicationServerAPI {
 	}
 }
 
+// SetGeolocationResolver sets the resolver used for resolving the location
+// of the nodes. When not set, the location is not resolved.
+func (a *ApplicationServerAPI) SetGeolocationResolver(r geolocation.Resolver) {
+	a.geolocation = r
+}
+
 // JoinRequest handles a join-request.
 func (a *ApplicationServerAPI) JoinRequest(ctx context.Context, req *as.JoinRequestRequest) (*as.JoinRequestResponse, error) {
 	var phy lorawan.PHYPayload
@@ -68,8 +84,19 @@ func (a *ApplicationServerAPI) JoinRequest(ctx context.Context, req *as.JoinRequ
 		return nil, grpc.Errorf(codes.Unknown, "DevEUI exists, but with a different AppEUI")
 	}
 
+	// the AppSKey must not be known when using end-to-end encryption, the
+	// session must be activated by the application (ABP)
+	if node.E2EEncryption {
+		log.WithFields(log.Fields{
+			"dev_eui": node.DevEUI,
+			"app_eui": node.AppEUI,
+		}).Error("join-request for node using end-to-end encryption")
+		return nil, grpc.Errorf(codes.FailedPrecondition, "node uses end-to-end encryption, OTAA is not supported")
+	}
+
 	// validate MIC
-	ok, err = phy.ValidateMIC(node.AppKey)
+	appKey := lorawan.AES128Key(node.AppKey)
+	ok, err = phy.ValidateMIC(appKey)
 	if err != nil {
 		log.WithFields(log.Fields{
 			"dev_eui": node.DevEUI,
@@ -114,19 +141,19 @@ func (a *ApplicationServerAPI) JoinRequest(ctx context.Context, req *as.JoinRequ
 	}
 
 	// get keys
-	nwkSKey, err := getNwkSKey(node.AppKey, netID, appNonce, jrPL.DevNonce)
+	nwkSKey, err := getNwkSKey(appKey, netID, appNonce, jrPL.DevNonce)
 	if err != nil {
 		return nil, grpc.Errorf(codes.Unknown, err.Error())
 	}
-	appSKey, err := getAppSKey(node.AppKey, netID, appNonce, jrPL.DevNonce)
+	appSKey, err := getAppSKey(appKey, netID, appNonce, jrPL.DevNonce)
 	if err != nil {
 		return nil, grpc.Errorf(codes.Unknown, err.Error())
 	}
 
 	// update the node
 	node.DevAddr = devAddr
-	node.NwkSKey = nwkSKey
-	node.AppSKey = appSKey
+	node.NwkSKey = storage.EncryptedKey(nwkSKey)
+	node.AppSKey = storage.EncryptedKey(appSKey)
 	if err = storage.UpdateNode(a.ctx.DB, node); err != nil {
 		return nil, grpc.Errorf(codes.Unknown, err.Error())
 	}
@@ -149,10 +176,10 @@ func (a *ApplicationServerAPI) JoinRequest(ctx context.Context, req *as.JoinRequ
 			CFList: cFList,
 		},
 	}
-	if err = jaPHY.SetMIC(node.AppKey); err != nil {
+	if err = jaPHY.SetMIC(appKey); err != nil {
 		return nil, grpc.Errorf(codes.Unknown, err.Error())
 	}
-	if err = jaPHY.EncryptJoinAcceptPayload(node.AppKey); err != nil {
+	if err = jaPHY.EncryptJoinAcceptPayload(appKey); err != nil {
 		return nil, grpc.Errorf(codes.Unknown, err.Error())
 	}
 
@@ -183,7 +210,7 @@ func (a *ApplicationServerAPI) JoinRequest(ctx context.Context, req *as.JoinRequ
 		"dev_addr": node.DevAddr,
 	}).Info("join-request accepted")
 
-	err = a.ctx.Handler.SendJoinNotification(node.AppEUI, node.DevEUI, handler.JoinNotification{
+	err = a.ctx.Handler.SendJoinNotification(node.AppEUI, node.DevEUI, integration.JoinNotification{
 		DevAddr: node.DevAddr,
 		DevEUI:  node.DevEUI,
 	})
@@ -211,21 +238,26 @@ func (a *ApplicationServerAPI) HandleDataUp(ctx context.Context, req *as.HandleD
 		return nil, grpc.Errorf(codes.Internal, errStr)
 	}
 
-	b, err := lorawan.EncryptFRMPayload(node.AppSKey, true, node.DevAddr, req.FCnt, req.Data)
-	if err != nil {
-		log.WithFields(log.Fields{
-			"dev_eui": devEUI,
-			"f_cnt":   req.FCnt,
-		}).Errorf("decrypt payload error: %s", err)
-		return nil, grpc.Errorf(codes.Internal, "decrypt payload error: %s", err)
+	// the payload is decrypted by the application when using end-to-end
+	// encryption
+	b := req.Data
+	if !node.E2EEncryption {
+		b, err = lorawan.EncryptFRMPayload(lorawan.AES128Key(node.AppSKey), true, node.DevAddr, req.FCnt, req.Data)
+		if err != nil {
+			log.WithFields(log.Fields{
+				"dev_eui": devEUI,
+				"f_cnt":   req.FCnt,
+			}).Errorf("decrypt payload error: %s", err)
+			return nil, grpc.Errorf(codes.Internal, "decrypt payload error: %s", err)
+		}
 	}
 
-	pl := handler.DataUpPayload{
+	pl := integration.DataUpPayload{
 		DevEUI: devEUI,
-		RXInfo: []handler.RXInfo{},
-		TXInfo: handler.TXInfo{
+		RXInfo: []integration.RXInfo{},
+		TXInfo: integration.TXInfo{
 			Frequency: int(req.TxInfo.Frequency),
-			DataRate: handler.DataRate{
+			DataRate: integration.DataRate{
 				Modulation:   req.TxInfo.DataRate.Modulation,
 				Bandwidth:    int(req.TxInfo.DataRate.BandWidth),
 				SpreadFactor: int(req.TxInfo.DataRate.SpreadFactor),
@@ -238,6 +270,10 @@ func (a *ApplicationServerAPI) HandleDataUp(ctx context.Context, req *as.HandleD
 		FPort: uint8(req.FPort),
 		Data:  b,
 	}
+	if node.E2EEncryption {
+		pl.Encrypted = true
+		pl.DevAddr = &node.DevAddr
+	}
 
 	for _, rxInfo := range req.RxInfo {
 		var timestamp *time.Time
@@ -255,7 +291,7 @@ func (a *ApplicationServerAPI) HandleDataUp(ctx context.Context, req *as.HandleD
 				timestamp = &ts
 			}
 		}
-		pl.RXInfo = append(pl.RXInfo, handler.RXInfo{
+		pl.RXInfo = append(pl.RXInfo, integration.RXInfo{
 			MAC:     mac,
 			Time:    timestamp,
 			RSSI:    int(rxInfo.Rssi),
@@ -263,16 +299,79 @@ func (a *ApplicationServerAPI) HandleDataUp(ctx context.Context, req *as.HandleD
 		})
 	}
 
-	err = a.ctx.Handler.SendDataUp(appEUI, devEUI, pl)
+	// merge the rx-info of the receipts of the same uplink, only the first
+	// receipt is handled (after the deduplication window)
+	err = uplink.Deduplicate(a.ctx.Locks, devEUI, req.FCnt, pl.RXInfo, func(rxInfo []integration.RXInfo) error {
+		pl.RXInfo = rxInfo
+		return a.handleDataUp(node, appEUI, pl)
+	})
 	if err != nil {
-		errStr := fmt.Sprintf("send data up to mqtt handler error: %s", err)
-		log.Error(errStr)
-		return nil, grpc.Errorf(codes.Internal, errStr)
+		log.WithField("dev_eui", devEUI).Error(err)
+		return nil, grpc.Errorf(codes.Internal, err.Error())
 	}
 
 	return &as.HandleDataUpResponse{}, nil
 }
 
+// handleDataUp stores the given (deduplicated) uplink of the given node,
+// updates the statistics and sends the payload to the handler.
+func (a *ApplicationServerAPI) handleDataUp(node storage.Node, appEUI lorawan.EUI64, pl integration.DataUpPayload) error {
+	devEUI := pl.DevEUI
+
+	if err := storage.CreateNodeUplink(a.ctx.DB, newNodeUplink(node, pl)); err != nil {
+		log.WithField("dev_eui", devEUI).Errorf("store node uplink error: %s", err)
+	} else if err := linkquality.UpdateNodeScore(a.ctx, node); err != nil {
+		log.WithField("dev_eui", devEUI).Errorf("update link-quality score error: %s", err)
+	}
+
+	// update the statistics of the receiving gateways
+	if err := gateway.RecordUplink(a.ctx.DB, pl.RXInfo, time.Now()); err != nil {
+		log.WithField("dev_eui", devEUI).Errorf("record gateway stats error: %s", err)
+	}
+
+	// update the usage statistics of the node
+	if err := usage.RecordUplink(a.ctx.DB, appEUI, devEUI, pl.RXInfo, time.Now()); err != nil {
+		log.WithField("dev_eui", devEUI).Errorf("record usage stats error: %s", err)
+	}
+
+	// resolve the location of the node (asynchronously, as this requires a
+	// request to the resolver)
+	if a.geolocation != nil {
+		go func(rxInfo []integration.RXInfo) {
+			if err := geolocation.HandleUplink(a.ctx, a.geolocation, node, rxInfo); err != nil {
+				log.WithField("dev_eui", devEUI).Errorf("handle geolocation uplink error: %s", err)
+			}
+		}(pl.RXInfo)
+	}
+
+	// track the progress of a firmware update
+	if len(pl.Data) > 0 && !pl.Encrypted {
+		if err := fuota.HandleUplink(a.ctx, devEUI, pl.FPort, pl.Data); err != nil {
+			log.WithField("dev_eui", devEUI).Errorf("handle fuota uplink error: %s", err)
+		}
+	}
+
+	// decode the payload using the payload codec of the application
+	if len(pl.Data) > 0 && !pl.Encrypted {
+		if c, err := codec.GetCodec(a.ctx.DB, appEUI); err != nil {
+			log.WithField("app_eui", appEUI).Errorf("get payload codec error: %s", err)
+		} else if c != nil {
+			if pl.Object, err = c.Decode(pl.FPort, pl.Data); err != nil {
+				log.WithFields(log.Fields{
+					"dev_eui": devEUI,
+					"f_cnt":   pl.FCnt,
+				}).Errorf("decode payload error: %s", err)
+			}
+		}
+	}
+
+	if err := a.ctx.Handler.SendDataUp(appEUI, devEUI, pl); err != nil {
+		return fmt.Errorf("send data up to mqtt handler error: %s", err)
+	}
+
+	return nil
+}
+
 // GetDataDown returns the first payload from the datadown queue.
 func (a *ApplicationServerAPI) GetDataDown(ctx context.Context, req *as.GetDataDownRequest) (*as.GetDataDownResponse, error) {
 	var devEUI lorawan.EUI64
@@ -301,17 +400,67 @@ func (a *ApplicationServerAPI) GetDataDown(ctx context.Context, req *as.GetDataD
 		return nil, grpc.Errorf(codes.Internal, errStr)
 	}
 
-	b, err := lorawan.EncryptFRMPayload(node.AppSKey, false, node.DevAddr, req.FCnt, qi.Data)
+	// remove the pending item when it has not been acknowledged within the
+	// frame-counter gap and continue with the next item
+	nack, err := downlink.CheckNACK(a.ctx, node.AppEUI, *qi, req.FCnt)
+	if err != nil {
+		errStr := fmt.Sprintf("check downlink nack error: %s", err)
+		log.WithFields(log.Fields{
+			"dev_eui": devEUI,
+			"id":      qi.ID,
+		}).Error(errStr)
+		return nil, grpc.Errorf(codes.Internal, errStr)
+	}
+	if nack {
+		return a.GetDataDown(ctx, req)
+	}
+
+	// remove the pending item when it has been retransmitted the max. number
+	// of times requested by the application and continue with the next item
+	exceeded, err := downlink.CheckRetries(a.ctx, node.AppEUI, *qi)
 	if err != nil {
-		errStr := fmt.Sprintf("encrypt payload error: %s", err)
+		errStr := fmt.Sprintf("check downlink retries error: %s", err)
 		log.WithFields(log.Fields{
 			"dev_eui": devEUI,
 			"id":      qi.ID,
 		}).Error(errStr)
 		return nil, grpc.Errorf(codes.Internal, errStr)
 	}
+	if exceeded {
+		return a.GetDataDown(ctx, req)
+	}
 
-	queueSize, err := storage.GetDownlinkQueueSize(a.ctx.DB, devEUI)
+	// remove the item when it can't be sent with the current frame-counter
+	// (end-to-end encryption) and continue with the next item
+	mismatch, err := downlink.CheckFCnt(a.ctx, node, *qi, req.FCnt)
+	if err != nil {
+		errStr := fmt.Sprintf("check downlink frame-counter error: %s", err)
+		log.WithFields(log.Fields{
+			"dev_eui": devEUI,
+			"id":      qi.ID,
+		}).Error(errStr)
+		return nil, grpc.Errorf(codes.Internal, errStr)
+	}
+	if mismatch {
+		return a.GetDataDown(ctx, req)
+	}
+
+	// the payload is already encrypted by the application when using
+	// end-to-end encryption
+	b := qi.Data
+	if !qi.Encrypted {
+		b, err = lorawan.EncryptFRMPayload(lorawan.AES128Key(node.AppSKey), false, node.DevAddr, req.FCnt, qi.Data)
+		if err != nil {
+			errStr := fmt.Sprintf("encrypt payload error: %s", err)
+			log.WithFields(log.Fields{
+				"dev_eui": devEUI,
+				"id":      qi.ID,
+			}).Error(errStr)
+			return nil, grpc.Errorf(codes.Internal, errStr)
+		}
+	}
+
+	queueSize, err := storage.GetReadyDownlinkQueueSize(a.ctx.DB, devEUI)
 	if err != nil {
 		errStr := fmt.Sprintf("get downlink queue size error: %s", err)
 		log.WithField("dev_eui", devEUI).Error(errStr)
@@ -328,6 +477,10 @@ func (a *ApplicationServerAPI) GetDataDown(ctx context.Context, req *as.GetDataD
 			return nil, grpc.Errorf(codes.Internal, errStr)
 		}
 	} else {
+		// the item is retransmitted when it is already pending
+		if qi.Pending {
+			qi.Retries++
+		}
 		qi.Pending = true
 		if err := storage.UpdateDownlinkQueueItem(a.ctx.DB, *qi); err != nil {
 			errStr := fmt.Sprintf("update downlink queue item error: %s", err)
@@ -339,6 +492,13 @@ func (a *ApplicationServerAPI) GetDataDown(ctx context.Context, req *as.GetDataD
 		}
 	}
 
+	if err := downlink.RecordTransmission(a.ctx, *qi, req.FCnt); err != nil {
+		log.WithFields(log.Fields{
+			"dev_eui": devEUI,
+			"id":      qi.ID,
+		}).Errorf("record downlink transmission error: %s", err)
+	}
+
 	log.WithFields(log.Fields{
 		"dev_eui":   devEUI,
 		"confirmed": qi.Confirmed,
@@ -346,6 +506,14 @@ func (a *ApplicationServerAPI) GetDataDown(ctx context.Context, req *as.GetDataD
 		"fcnt":      req.FCnt,
 	}).Info("data-down item requested by network-server")
 
+	if err := dutycycle.RecordDownlink(a.ctx, node, len(b)); err != nil {
+		log.WithField("dev_eui", devEUI).Errorf("record gateway downlink error: %s", err)
+	}
+
+	if err := usage.RecordDownlink(a.ctx.DB, node.AppEUI, devEUI, time.Now()); err != nil {
+		log.WithField("dev_eui", devEUI).Errorf("record usage stats error: %s", err)
+	}
+
 	return &as.GetDataDownResponse{
 		Data:      b,
 		Confirmed: qi.Confirmed,
@@ -372,7 +540,14 @@ func (a *ApplicationServerAPI) HandleDataDownACK(ctx context.Context, req *as.Ha
 		"dev_eui": qi.DevEUI,
 	}).Info("downlink queue item acknowledged")
 
-	err = a.ctx.Handler.SendACKNotification(appEUI, devEUI, handler.ACKNotification{
+	if err := downlink.RecordACK(a.ctx, qi); err != nil {
+		log.WithFields(log.Fields{
+			"dev_eui": qi.DevEUI,
+			"id":      qi.ID,
+		}).Errorf("record downlink ack error: %s", err)
+	}
+
+	err = a.ctx.Handler.SendACKNotification(appEUI, devEUI, integration.ACKNotification{
 		DevEUI:    devEUI,
 		Reference: qi.Reference,
 	})
@@ -394,7 +569,11 @@ func (a *ApplicationServerAPI) HandleError(ctx context.Context, req *as.HandleEr
 		"dev_eui": devEUI,
 	}).Error(req.Error)
 
-	err := a.ctx.Handler.SendErrorNotification(appEUI, devEUI, handler.ErrorNotification{
+	if err := usage.RecordError(a.ctx.DB, appEUI, devEUI, time.Now()); err != nil {
+		log.WithField("dev_eui", devEUI).Errorf("record usage stats error: %s", err)
+	}
+
+	err := a.ctx.Handler.SendErrorNotification(appEUI, devEUI, integration.ErrorNotification{
 		DevEUI: devEUI,
 		Type:   req.Type.String(),
 		Error:  req.Error,
@@ -455,3 +634,36 @@ func getSKey(typ byte, appkey lorawan.AES128Key, netID lorawan.NetID, appNonce [
 	block.Encrypt(key[:], b)
 	return key, nil
 }
+
+// newNodeUplink returns the uplink meta-data to store for the given
+// data-up payload.
+func newNodeUplink(node storage.Node, pl integration.DataUpPayload) *storage.NodeUplink {
+	u := storage.NodeUplink{
+		DevEUI:       node.DevEUI,
+		AppEUI:       node.AppEUI,
+		FCnt:         pl.FCnt,
+		FPort:        pl.FPort,
+		Frequency:    pl.TXInfo.Frequency,
+		Modulation:   pl.TXInfo.DataRate.Modulation,
+		SpreadFactor: pl.TXInfo.DataRate.SpreadFactor,
+		Bandwidth:    pl.TXInfo.DataRate.Bandwidth,
+		Bitrate:      pl.TXInfo.DataRate.Bitrate,
+		GatewayCount: len(pl.RXInfo),
+	}
+
+	for i, rxInfo := range pl.RXInfo {
+		u.RXInfo = append(u.RXInfo, storage.NodeUplinkRXInfo{
+			MAC:     rxInfo.MAC,
+			RSSI:    rxInfo.RSSI,
+			LoRaSNR: rxInfo.LoRaSNR,
+		})
+
+		if i == 0 || rxInfo.RSSI > u.RSSI {
+			u.RSSI = rxInfo.RSSI
+		}
+		if i == 0 || rxInfo.LoRaSNR > u.LoRaSNR {
+			u.LoRaSNR = rxInfo.LoRaSNR
+		}
+	}
+	return &u
+}
@@ -5,8 +5,9 @@ import (
 	"testing"
 	"time"
 
+	"github.com/brocaar/lora-app-server/integration"
 	"github.com/brocaar/lora-app-server/internal/common"
-	"github.com/brocaar/lora-app-server/internal/handler"
+	"github.com/brocaar/lora-app-server/internal/downlink"
 	"github.com/brocaar/lora-app-server/internal/storage"
 	"github.com/brocaar/lora-app-server/internal/test"
 	"github.com/brocaar/lora-app-server/internal/test/testhandler"
@@ -44,7 +45,7 @@ func TestApplicationServerAPI(t *testing.T) {
 
 			Convey("Then the error has been sent to the handler", func() {
 				So(h.SendErrorNotificationChan, ShouldHaveLength, 1)
-				So(<-h.SendErrorNotificationChan, ShouldResemble, handler.ErrorNotification{
+				So(<-h.SendErrorNotificationChan, ShouldResemble, integration.ErrorNotification{
 					DevEUI: [8]byte{1, 2, 3, 4, 5, 6, 7, 8},
 					Type:   "DATA_UP_FCNT",
 					Error:  "BOOM!",
@@ -80,7 +81,7 @@ func TestApplicationServerAPI(t *testing.T) {
 					DevNonce: [2]byte{1, 2},
 				},
 			}
-			So(phy.SetMIC(node.AppKey), ShouldBeNil)
+			So(phy.SetMIC(lorawan.AES128Key(node.AppKey)), ShouldBeNil)
 
 			b, err := phy.MarshalBinary()
 			So(err, ShouldBeNil)
@@ -120,9 +121,9 @@ func TestApplicationServerAPI(t *testing.T) {
 
 				Convey("Then the expected payload was sent to the handler", func() {
 					So(h.SendDataUpChan, ShouldHaveLength, 1)
-					So(<-h.SendDataUpChan, ShouldResemble, handler.DataUpPayload{
+					So(<-h.SendDataUpChan, ShouldResemble, integration.DataUpPayload{
 						DevEUI: node.DevEUI,
-						RXInfo: []handler.RXInfo{
+						RXInfo: []integration.RXInfo{
 							{
 								MAC:     mac,
 								Time:    &now,
@@ -130,9 +131,9 @@ func TestApplicationServerAPI(t *testing.T) {
 								LoRaSNR: 5,
 							},
 						},
-						TXInfo: handler.TXInfo{
+						TXInfo: integration.TXInfo{
 							Frequency: 868100000,
-							DataRate: handler.DataRate{
+							DataRate: integration.DataRate{
 								Modulation:   "LORA",
 								Bandwidth:    250,
 								SpreadFactor: 5,
@@ -177,8 +178,8 @@ func TestApplicationServerAPI(t *testing.T) {
 					So(phy.UnmarshalBinary(resp.PhyPayload), ShouldBeNil)
 
 					So(phy.MHDR.MType, ShouldEqual, lorawan.JoinAccept)
-					So(phy.DecryptJoinAcceptPayload(node.AppKey), ShouldBeNil)
-					ok, err := phy.ValidateMIC(node.AppKey)
+					So(phy.DecryptJoinAcceptPayload(lorawan.AES128Key(node.AppKey)), ShouldBeNil)
+					ok, err := phy.ValidateMIC(lorawan.AES128Key(node.AppKey))
 					So(err, ShouldBeNil)
 					So(ok, ShouldBeTrue)
 
@@ -201,7 +202,7 @@ func TestApplicationServerAPI(t *testing.T) {
 
 				Convey("Then a notification was sent to the handler", func() {
 					So(h.SendJoinNotificationChan, ShouldHaveLength, 1)
-					So(<-h.SendJoinNotificationChan, ShouldResemble, handler.JoinNotification{
+					So(<-h.SendJoinNotificationChan, ShouldResemble, integration.JoinNotification{
 						DevAddr: [4]byte{1, 2, 3, 4},
 						DevEUI:  node.DevEUI,
 					})
@@ -239,8 +240,8 @@ func TestApplicationServerAPI(t *testing.T) {
 						var phy lorawan.PHYPayload
 						So(phy.UnmarshalBinary(resp.PhyPayload), ShouldBeNil)
 
-						So(phy.DecryptJoinAcceptPayload(node.AppKey), ShouldBeNil)
-						ok, err := phy.ValidateMIC(node.AppKey)
+						So(phy.DecryptJoinAcceptPayload(lorawan.AES128Key(node.AppKey)), ShouldBeNil)
+						ok, err := phy.ValidateMIC(lorawan.AES128Key(node.AppKey))
 						So(err, ShouldBeNil)
 						So(ok, ShouldBeTrue)
 
@@ -258,7 +259,7 @@ func TestApplicationServerAPI(t *testing.T) {
 
 					Convey("Then a notification was sent to the handler", func() {
 						So(h.SendJoinNotificationChan, ShouldHaveLength, 1)
-						So(<-h.SendJoinNotificationChan, ShouldResemble, handler.JoinNotification{
+						So(<-h.SendJoinNotificationChan, ShouldResemble, integration.JoinNotification{
 							DevAddr: [4]byte{1, 2, 3, 4},
 							DevEUI:  node.DevEUI,
 						})
@@ -288,7 +289,7 @@ func TestApplicationServerAPI(t *testing.T) {
 
 					Convey("Then an ack notification was sent to the handler", func() {
 						So(h.SendACKNotificationChan, ShouldHaveLength, 1)
-						So(<-h.SendACKNotificationChan, ShouldResemble, handler.ACKNotification{
+						So(<-h.SendACKNotificationChan, ShouldResemble, integration.ACKNotification{
 							DevEUI:    qi.DevEUI,
 							Reference: qi.Reference,
 						})
@@ -315,7 +316,7 @@ func TestApplicationServerAPI(t *testing.T) {
 					So(err, ShouldBeNil)
 
 					Convey("Then the expected response is returned", func() {
-						b, err := lorawan.EncryptFRMPayload(node.AppSKey, false, node.DevAddr, 10, resp.Data)
+						b, err := lorawan.EncryptFRMPayload(lorawan.AES128Key(node.AppSKey), false, node.DevAddr, 10, resp.Data)
 						So(err, ShouldBeNil)
 
 						resp.Data = b
@@ -355,7 +356,7 @@ func TestApplicationServerAPI(t *testing.T) {
 					So(err, ShouldBeNil)
 
 					Convey("Then the expected response is returned", func() {
-						b, err := lorawan.EncryptFRMPayload(node.AppSKey, false, node.DevAddr, 10, resp.Data)
+						b, err := lorawan.EncryptFRMPayload(lorawan.AES128Key(node.AppSKey), false, node.DevAddr, 10, resp.Data)
 						So(err, ShouldBeNil)
 
 						resp.Data = b
@@ -375,6 +376,97 @@ func TestApplicationServerAPI(t *testing.T) {
 					})
 				})
 			})
+
+			Convey("Given the node uses end-to-end encryption", func() {
+				node.E2EEncryption = true
+				So(storage.UpdateNode(db, node), ShouldBeNil)
+
+				Convey("Then JoinRequest returns an error", func() {
+					_, err := api.JoinRequest(ctx, &as.JoinRequestRequest{
+						PhyPayload: b,
+						DevAddr:    []byte{1, 2, 3, 4},
+						NetID:      []byte{1, 2, 3},
+					})
+					So(err, ShouldNotBeNil)
+				})
+
+				Convey("When calling HandleDataUp", func() {
+					_, err := api.HandleDataUp(ctx, &as.HandleDataUpRequest{
+						DevEUI: node.DevEUI[:],
+						AppEUI: node.AppEUI[:],
+						FCnt:   10,
+						FPort:  3,
+						Data:   []byte{1, 2, 3, 4},
+						RxInfo: []*as.RXInfo{{Mac: []byte{1, 2, 3, 4, 5, 6, 7, 8}, Rssi: -60}},
+						TxInfo: &as.TXInfo{DataRate: &as.DataRate{}},
+					})
+					So(err, ShouldBeNil)
+
+					Convey("Then the encrypted payload was sent to the handler", func() {
+						So(h.SendDataUpChan, ShouldHaveLength, 1)
+						pl := <-h.SendDataUpChan
+						So(pl.Data, ShouldResemble, []byte{1, 2, 3, 4})
+						So(pl.Encrypted, ShouldBeTrue)
+						So(pl.DevAddr, ShouldResemble, &node.DevAddr)
+					})
+				})
+
+				Convey("Given an encrypted and a plaintext downlink queue item", func() {
+					fCnt := uint32(11)
+					qi1 := storage.DownlinkQueueItem{
+						DevEUI:    node.DevEUI,
+						Reference: "encrypted",
+						FPort:     1,
+						Data:      []byte{1, 2, 3, 4},
+						Encrypted: true,
+						FCnt:      &fCnt,
+					}
+					qi2 := storage.DownlinkQueueItem{
+						DevEUI:    node.DevEUI,
+						Reference: "plaintext",
+						FPort:     1,
+						Data:      []byte{5, 6, 7, 8},
+					}
+					So(storage.CreateDownlinkQueueItem(db, &qi1), ShouldBeNil)
+					So(storage.CreateDownlinkQueueItem(db, &qi2), ShouldBeNil)
+
+					Convey("When calling GetDataDown with the frame-counter of the encrypted item", func() {
+						resp, err := api.GetDataDown(ctx, &as.GetDataDownRequest{
+							DevEUI:         node.DevEUI[:],
+							MaxPayloadSize: 100,
+							FCnt:           11,
+						})
+						So(err, ShouldBeNil)
+
+						Convey("Then the payload is returned as-is", func() {
+							So(resp.Data, ShouldResemble, qi1.Data)
+							So(resp.MoreData, ShouldBeTrue)
+						})
+					})
+
+					Convey("When calling GetDataDown with an other frame-counter", func() {
+						resp, err := api.GetDataDown(ctx, &as.GetDataDownRequest{
+							DevEUI:         node.DevEUI[:],
+							MaxPayloadSize: 100,
+							FCnt:           10,
+						})
+						So(err, ShouldBeNil)
+
+						Convey("Then both items were removed and nothing is returned", func() {
+							So(resp, ShouldResemble, &as.GetDataDownResponse{})
+
+							size, err := storage.GetDownlinkQueueSize(db, node.DevEUI)
+							So(err, ShouldBeNil)
+							So(size, ShouldEqual, 0)
+						})
+
+						Convey("Then an error notification was sent for both items", func() {
+							So(h.SendErrorNotificationChan, ShouldHaveLength, 2)
+							So((<-h.SendErrorNotificationChan).Type, ShouldEqual, downlink.ErrorTypeDataDownFCnt)
+						})
+					})
+				})
+			})
 		})
 	})
 }
@@ -0,0 +1,118 @@
+package api
+
+import (
+	"encoding/json"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/jmoiron/sqlx"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/brocaar/lora-app-server/api"
+	"github.com/brocaar/lora-app-server/internal/api/auth"
+	"github.com/brocaar/lora-app-server/internal/common"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+// AuditLogAPI exports the audit-log related functions.
+type AuditLogAPI struct {
+	ctx       common.Context
+	validator auth.Validator
+}
+
+// NewAuditLogAPI creates a new AuditLogAPI.
+func NewAuditLogAPI(ctx common.Context, validator auth.Validator) *AuditLogAPI {
+	return &AuditLogAPI{
+		ctx:       ctx,
+		validator: validator,
+	}
+}
+
+// List lists the audit log entries matching the given filters, newest
+// first. Without application, access to the whole audit log is required.
+func (a *AuditLogAPI) List(ctx context.Context, req *pb.ListAuditLogRequest) (*pb.ListAuditLogResponse, error) {
+	f := storage.AuditLogFilter{
+		Actor:      req.Actor,
+		ObjectType: req.ObjectType,
+		ObjectID:   req.ObjectID,
+	}
+
+	validators := []auth.ValidatorFunc{auth.ValidateAPIMethod("AuditLog.List")}
+	if req.AppEUI != "" {
+		var appEUI lorawan.EUI64
+		if err := appEUI.UnmarshalText([]byte(req.AppEUI)); err != nil {
+			return nil, grpc.Errorf(codes.InvalidArgument, err.Error())
+		}
+		f.AppEUI = &appEUI
+		validators = append(validators, auth.ValidateApplication(appEUI))
+	}
+
+	if err := a.validator.Validate(ctx, validators...); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	var err error
+	if req.Start != "" {
+		if f.Start, err = time.Parse(time.RFC3339, req.Start); err != nil {
+			return nil, grpc.Errorf(codes.InvalidArgument, "start: %s", err)
+		}
+	}
+	if req.End != "" {
+		if f.End, err = time.Parse(time.RFC3339, req.End); err != nil {
+			return nil, grpc.Errorf(codes.InvalidArgument, "end: %s", err)
+		}
+	}
+
+	count, err := storage.GetAuditLogCount(a.ctx.DB, f)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+	entries, err := storage.GetAuditLogs(a.ctx.DB, f, int(req.Limit), int(req.Offset))
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+
+	resp := pb.ListAuditLogResponse{
+		TotalCount: int64(count),
+	}
+	for _, e := range entries {
+		entry := pb.AuditLogEntry{
+			Id:          e.ID,
+			CreatedAt:   e.CreatedAt.Format(time.RFC3339Nano),
+			Actor:       e.Actor,
+			Action:      e.Action,
+			ObjectType:  e.ObjectType,
+			ObjectID:    e.ObjectID,
+			DetailsJSON: string(e.Details),
+		}
+		if e.AppEUI != nil {
+			entry.AppEUI = e.AppEUI.String()
+		}
+		resp.Result = append(resp.Result, &entry)
+	}
+	return &resp, nil
+}
+
+// recordAudit records the given entry in the audit log, including the
+// given (JSON encoded) details. As the action itself already succeeded,
+// errors are logged.
+func recordAudit(db *sqlx.DB, e storage.AuditLog, details map[string]interface{}) {
+	if len(details) != 0 {
+		b, err := json.Marshal(details)
+		if err != nil {
+			log.WithField("action", e.Action).Errorf("marshal audit log details error: %s", err)
+		}
+		e.Details = b
+	}
+
+	if err := storage.CreateAuditLog(db, &e); err != nil {
+		log.WithFields(log.Fields{
+			"actor":     e.Actor,
+			"action":    e.Action,
+			"object_id": e.ObjectID,
+		}).Errorf("record audit log error: %s", err)
+	}
+}
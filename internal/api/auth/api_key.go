@@ -0,0 +1,113 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+// APIKeyPrefix is the prefix of the API keys, used to distinguish them from
+// JWT tokens. An API key has the format lak_[ID]_[SECRET].
+const APIKeyPrefix = "lak_"
+
+// apiKeySecretBytes defines the number of random bytes of the secret.
+const apiKeySecretBytes = 32
+
+// NewAPIKeySecret returns a new random (hex encoded) API key secret and its
+// hash, which must be stored as KeyHash of the storage.APIKey.
+func NewAPIKeySecret() (string, []byte, error) {
+	b := make([]byte, apiKeySecretBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", nil, fmt.Errorf("read random bytes error: %s", err)
+	}
+	secret := hex.EncodeToString(b)
+	return secret, hashAPIKeySecret(secret), nil
+}
+
+// FormatAPIKey returns the API key for the given id and secret, as used in
+// the authorization metadata / header.
+func FormatAPIKey(id int64, secret string) string {
+	return fmt.Sprintf("%s%d_%s", APIKeyPrefix, id, secret)
+}
+
+// APIKeySubject returns the subject (principal) of the given API key id.
+func APIKeySubject(id int64) string {
+	return fmt.Sprintf("apikey:%d", id)
+}
+
+// isAPIKey returns true when the given token is an API key.
+func isAPIKey(token string) bool {
+	return strings.HasPrefix(token, APIKeyPrefix)
+}
+
+// parseAPIKey returns the id and secret of the given API key.
+func parseAPIKey(key string) (int64, string, error) {
+	parts := strings.SplitN(strings.TrimPrefix(key, APIKeyPrefix), "_", 2)
+	if len(parts) != 2 || parts[1] == "" {
+		return 0, "", errors.New("invalid api key format")
+	}
+	id, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return 0, "", errors.New("invalid api key format")
+	}
+	return id, parts[1], nil
+}
+
+// hashAPIKeySecret returns the hash of the given secret.
+func hashAPIKeySecret(secret string) []byte {
+	h := sha256.Sum256([]byte(secret))
+	return h[:]
+}
+
+// getAPIKeyClaims validates the given API key and returns the claims,
+// granting the role of the key within its organization or application.
+func getAPIKeyClaims(db *sqlx.DB, key string) (*Claims, error) {
+	id, secret, err := parseAPIKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	k, err := storage.GetAPIKey(db, id)
+	if err != nil {
+		return nil, errors.New("invalid api key")
+	}
+	if subtle.ConstantTimeCompare(k.KeyHash, hashAPIKeySecret(secret)) != 1 {
+		return nil, errors.New("invalid api key")
+	}
+	if k.RevokedAt != nil {
+		return nil, errors.New("api key has been revoked")
+	}
+
+	claims := Claims{
+		appRoles: make(map[lorawan.EUI64]storage.OrganizationRole),
+	}
+	claims.Subject = APIKeySubject(k.ID)
+
+	appEUIs, err := storage.GetOrganizationApplications(db, k.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+	for _, appEUI := range appEUIs {
+		if k.AppEUI == nil || *k.AppEUI == appEUI {
+			claims.appRoles[appEUI] = k.Role
+		}
+	}
+
+	// only keys scoped to the organization have permissions on the
+	// organization itself
+	if k.AppEUI == nil {
+		claims.orgRoles = map[int64]storage.OrganizationRole{k.OrganizationID: k.Role}
+	}
+
+	return &claims, nil
+}
@@ -0,0 +1,52 @@
+package auth
+
+import (
+	"testing"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/metadata"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestAPIKey(t *testing.T) {
+	Convey("Given a new API key secret", t, func() {
+		secret, hash, err := NewAPIKeySecret()
+		So(err, ShouldBeNil)
+		So(secret, ShouldHaveLength, 2*apiKeySecretBytes)
+		So(hash, ShouldResemble, hashAPIKeySecret(secret))
+
+		Convey("Then the formatted key can be parsed", func() {
+			key := FormatAPIKey(12, secret)
+			So(isAPIKey(key), ShouldBeTrue)
+
+			id, s, err := parseAPIKey(key)
+			So(err, ShouldBeNil)
+			So(id, ShouldEqual, 12)
+			So(s, ShouldEqual, secret)
+		})
+
+		Convey("Then an invalid key can not be parsed", func() {
+			for _, key := range []string{"lak_", "lak_12", "lak_12_", "lak_abc_" + secret} {
+				_, _, err := parseAPIKey(key)
+				So(err, ShouldNotBeNil)
+			}
+		})
+
+		Convey("Then a JWT token is not an API key", func() {
+			So(isAPIKey("eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9.e30.abc"), ShouldBeFalse)
+		})
+	})
+
+	Convey("Given an authorization header using the Bearer scheme", t, func() {
+		ctx := metadata.NewContext(context.Background(), metadata.MD{
+			"authorization": []string{"Bearer lak_1_abc"},
+		})
+
+		Convey("Then the token is returned without the scheme", func() {
+			token, err := getTokenFromContext(ctx)
+			So(err, ShouldBeNil)
+			So(token, ShouldEqual, "lak_1_abc")
+		})
+	})
+}
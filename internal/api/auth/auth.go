@@ -7,12 +7,40 @@ import (
 	"strings"
 
 	jwt "github.com/dgrijalva/jwt-go"
+	"github.com/jmoiron/sqlx"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc/metadata"
 
+	"github.com/brocaar/lora-app-server/internal/storage"
 	"github.com/brocaar/lorawan"
 )
 
+// readMethods contains the (application scoped) api methods which don't
+// modify any data and don't expose credentials of external systems.
+const readMethods = `(Node|NodeSession|DownlinkQueue|MulticastGroup|FUOTADeployment|Analytics|SLA|ScheduledReport|DownlinkRule|DownlinkFPortPolicy|PayloadCodec|DeviceStatusAlert|EventLog|DeadLetter|HandlerBackend|EventFilter)\.(Get|List)[A-Za-z]*|Node\.Export`
+
+// applicationRolePermissions defines the api methods each organization role
+// grants within the applications of the organization.
+var applicationRolePermissions = map[storage.OrganizationRole]*regexp.Regexp{
+	storage.OrganizationRoleAdmin:       regexp.MustCompile(`^.+$`),
+	storage.OrganizationRoleDeviceAdmin: regexp.MustCompile(`^((Node|NodeSession|DownlinkQueue|MulticastGroup|FUOTADeployment)\..+|` + readMethods + `)$`),
+	storage.OrganizationRoleReadOnly:    regexp.MustCompile(`^(` + readMethods + `)$`),
+}
+
+// gatewayReadMethods contains the (organization scoped) api methods
+// returning the gateways and their statistics.
+const gatewayReadMethods = `Gateway\.(Get|List|GetStats)|Analytics\.GetGatewayDistribution|DutyCycle\.GetGatewayDutyCycle`
+
+// organizationRolePermissions defines the api methods each organization
+// role grants on the organization itself and its gateways. Creating,
+// updating (e.g. the quotas) and deleting organizations and assigning
+// applications requires the admin (claim) permission.
+var organizationRolePermissions = map[storage.OrganizationRole]*regexp.Regexp{
+	storage.OrganizationRoleAdmin:       regexp.MustCompile(`^(Organization\.(Get|ListApplications|ListUsers|AddUser|UpdateUser|RemoveUser)|APIKey\.(Create|List|Revoke)|Gateway\..+|` + gatewayReadMethods + `)$`),
+	storage.OrganizationRoleDeviceAdmin: regexp.MustCompile(`^(Organization\.(Get|ListApplications|ListUsers)|` + gatewayReadMethods + `)$`),
+	storage.OrganizationRoleReadOnly:    regexp.MustCompile(`^(Organization\.(Get|ListApplications|ListUsers)|` + gatewayReadMethods + `)$`),
+}
+
 // Claims defines the struct containing the token claims.
 type Claims struct {
 	jwt.StandardClaims
@@ -38,6 +66,41 @@ type Claims struct {
 	// Nodes defines the nodes the user has access to. It follows the same
 	// logic as the applications.
 	Nodes []string `json:"nodes"`
+
+	// The fields below are not part of the token. They are set by the
+	// validator and the validator funcs during the validation.
+
+	// orgRoles and appRoles contain the role of the user (the subject of
+	// the token) per organization and per application of these
+	// organizations.
+	orgRoles map[int64]storage.OrganizationRole
+	appRoles map[lorawan.EUI64]storage.OrganizationRole
+
+	// apiMethod contains the api method validated by ValidateAPIMethod.
+	// When the api method is not granted by the token, but could be granted
+	// by an organization role, it is also set as pendingAPIMethod until
+	// granted by ValidateApplication or ValidateOrganization.
+	apiMethod        string
+	pendingAPIMethod string
+
+	// orgApplication is set when the access to the application was granted
+	// by an organization role, which implies access to its nodes.
+	orgApplication bool
+}
+
+// hasOrganizationRoles returns true when the user has a role within any
+// organization.
+func (c *Claims) hasOrganizationRoles() bool {
+	return len(c.orgRoles) != 0 || len(c.appRoles) != 0
+}
+
+// roleGrants returns true when the given permissions grant the validated
+// api method. Without api method, the role itself grants the access.
+func (c *Claims) roleGrants(permissions *regexp.Regexp) bool {
+	if permissions == nil {
+		return false
+	}
+	return c.apiMethod == "" || permissions.MatchString(c.apiMethod)
 }
 
 // Validator defines the interface a validator needs to implement.
@@ -57,28 +120,64 @@ func (v NopValidator) Validate(ctx context.Context, funcs ...ValidatorFunc) erro
 	return nil
 }
 
-// JWTValidator validates JWT tokens.
+// JWTValidator validates JWT tokens. When a database is set, the
+// organization roles of the subject of the token are taken into account.
 type JWTValidator struct {
+	db        *sqlx.DB
 	secret    string
 	algorithm string
 }
 
 // NewJWTValidator creates a new JWTValidator.
-func NewJWTValidator(algorithm, secret string) *JWTValidator {
+func NewJWTValidator(db *sqlx.DB, algorithm, secret string) *JWTValidator {
 	return &JWTValidator{
+		db:        db,
 		secret:    secret,
 		algorithm: algorithm,
 	}
 }
 
 // Validate validates the token from the given context against the given
-// validator funcs.
+// validator funcs. Besides JWT tokens, API keys are accepted when a
+// database is set.
 func (v JWTValidator) Validate(ctx context.Context, funcs ...ValidatorFunc) error {
 	tokenStr, err := getTokenFromContext(ctx)
 	if err != nil {
 		return err
 	}
 
+	var claims *Claims
+	if isAPIKey(tokenStr) {
+		if v.db == nil {
+			return errors.New("api/auth: api keys are not supported")
+		}
+		if claims, err = getAPIKeyClaims(v.db, tokenStr); err != nil {
+			return fmt.Errorf("api/auth: %s", err)
+		}
+	} else {
+		if claims, err = v.getJWTClaims(tokenStr); err != nil {
+			return err
+		}
+	}
+
+	for _, f := range funcs {
+		if err := f(claims); err != nil {
+			return fmt.Errorf("auth/api: %s", err)
+		}
+	}
+
+	// the api method was not granted by the token nor by an organization
+	// role
+	if claims.pendingAPIMethod != "" {
+		return fmt.Errorf("auth/api: no permission to api method: %s", claims.pendingAPIMethod)
+	}
+
+	return nil
+}
+
+// getJWTClaims validates the given JWT token and returns its claims,
+// including the organization roles of the subject.
+func (v JWTValidator) getJWTClaims(tokenStr string) (*Claims, error) {
 	token, err := jwt.ParseWithClaims(tokenStr, &Claims{}, func(token *jwt.Token) (interface{}, error) {
 		if token.Header["alg"] != v.algorithm {
 			return nil, fmt.Errorf("api/auth: unexpected algorithm %s, expected %s", token.Header["alg"], v.algorithm)
@@ -86,48 +185,104 @@ func (v JWTValidator) Validate(ctx context.Context, funcs ...ValidatorFunc) erro
 		return []byte(v.secret), nil
 	})
 	if err != nil {
-		return fmt.Errorf("api/auth: jwt parse error: %s", err)
+		return nil, fmt.Errorf("api/auth: jwt parse error: %s", err)
 	}
 
 	if !token.Valid {
-		return errors.New("api/auth: invalid token")
+		return nil, errors.New("api/auth: invalid token")
 	}
 
 	claims, ok := token.Claims.(*Claims)
 	if !ok {
-		return fmt.Errorf("api/auth: expected *Claims, got %T", token.Claims)
+		return nil, fmt.Errorf("api/auth: expected *Claims, got %T", token.Claims)
 	}
 
-	for _, f := range funcs {
-		if err := f(claims); err != nil {
-			return fmt.Errorf("auth/api: %s", err)
+	if v.db != nil && !claims.Admin && claims.Subject != "" {
+		if claims.orgRoles, err = storage.GetUserOrganizationRoles(v.db, claims.Subject); err != nil {
+			return nil, fmt.Errorf("api/auth: %s", err)
+		}
+		if claims.appRoles, err = storage.GetUserApplicationRoles(v.db, claims.Subject); err != nil {
+			return nil, fmt.Errorf("api/auth: %s", err)
 		}
 	}
 
-	return nil
+	return claims, nil
 }
 
 // ValidateApplication validates if the user has permission to the given AppEUI.
+// The permission is granted by the token or by the role of the user within
+// the organization owning the application. In the latter case, the role
+// must grant the validated api method.
 func ValidateApplication(appEUI lorawan.EUI64) ValidatorFunc {
 	return func(claims *Claims) error {
 		if claims.Admin {
 			return nil
 		}
 
-		for _, app := range claims.Applications {
-			if app == "*" {
-				return nil
-			}
+		if claims.pendingAPIMethod == "" {
+			for _, app := range claims.Applications {
+				if app == "*" {
+					return nil
+				}
 
-			if appEUI.String() == app {
-				return nil
+				if appEUI.String() == app {
+					return nil
+				}
 			}
 		}
 
+		if role, ok := claims.appRoles[appEUI]; ok && claims.roleGrants(applicationRolePermissions[role]) {
+			claims.pendingAPIMethod = ""
+			claims.orgApplication = true
+			return nil
+		}
+
+		if claims.pendingAPIMethod != "" {
+			return fmt.Errorf("no permission to api method: %s", claims.pendingAPIMethod)
+		}
 		return fmt.Errorf("no permission to application %s", appEUI)
 	}
 }
 
+// ValidateOrganization validates if the user has permission to the given
+// organization, based on the role of the user within the organization.
+// The role must grant the validated api method.
+func ValidateOrganization(id int64) ValidatorFunc {
+	return func(claims *Claims) error {
+		if claims.Admin {
+			return nil
+		}
+
+		if role, ok := claims.orgRoles[id]; ok && claims.roleGrants(organizationRolePermissions[role]) {
+			claims.pendingAPIMethod = ""
+			return nil
+		}
+
+		if claims.pendingAPIMethod != "" {
+			return fmt.Errorf("no permission to api method: %s", claims.pendingAPIMethod)
+		}
+		return fmt.Errorf("no permission to organization %d", id)
+	}
+}
+
+// ValidateGatewayOrganization validates if the user has permission to the
+// gateways of the given organization (see ValidateOrganization). Without
+// organization (e.g. gateways created before the organizations, gateways
+// which are not managed or all the gateways), only admin users have
+// permission.
+func ValidateGatewayOrganization(id *int64) ValidatorFunc {
+	return func(claims *Claims) error {
+		if id != nil {
+			return ValidateOrganization(*id)(claims)
+		}
+
+		if claims.Admin {
+			return nil
+		}
+		return errors.New("no permission to gateways without organization")
+	}
+}
+
 // ValidateNode validates if the user has permission to the given DevEUI.
 func ValidateNode(devEUI lorawan.EUI64) ValidatorFunc {
 	return func(claims *Claims) error {
@@ -135,6 +290,11 @@ func ValidateNode(devEUI lorawan.EUI64) ValidatorFunc {
 			return nil
 		}
 
+		// the nodes of the application are granted by the organization role
+		if claims.orgApplication {
+			return nil
+		}
+
 		for _, node := range claims.Nodes {
 			if node == "*" {
 				return nil
@@ -149,13 +309,61 @@ func ValidateNode(devEUI lorawan.EUI64) ValidatorFunc {
 	}
 }
 
+// ValidatePrincipal validates if the user (the subject of the token) is one
+// of the given principals.
+func ValidatePrincipal(principals []string) ValidatorFunc {
+	return func(claims *Claims) error {
+		if claims.Admin {
+			return nil
+		}
+
+		for _, p := range principals {
+			if claims.Subject != "" && claims.Subject == p {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("principal %s is not allowed", claims.Subject)
+	}
+}
+
+// ValidateUser validates if the user (the subject of the token) is the
+// given user.
+func ValidateUser(username string) ValidatorFunc {
+	return func(claims *Claims) error {
+		if claims.Admin {
+			return nil
+		}
+
+		if claims.Subject != "" && claims.Subject == username {
+			return nil
+		}
+
+		return fmt.Errorf("no permission to user %s", username)
+	}
+}
+
+// SetSubject sets the given subject to the subject of the token (e.g. the
+// username or the subject of the API key), to record the user performing
+// the action. It never fails.
+func SetSubject(subject *string) ValidatorFunc {
+	return func(claims *Claims) error {
+		*subject = claims.Subject
+		return nil
+	}
+}
+
 // ValidateAPIMethod validates if the user has permission to the given api method.
+// When the user has a role within an organization, the api method might be
+// granted by the role instead. This is validated by ValidateApplication or
+// ValidateOrganization, which must follow this validator func.
 func ValidateAPIMethod(apiMethod string) ValidatorFunc {
 	return func(claims *Claims) error {
 		methodParts := strings.SplitN(apiMethod, ".", 2)
 		if len(methodParts) != 2 {
 			return fmt.Errorf("invalid api method: %s", apiMethod)
 		}
+		claims.apiMethod = apiMethod
 
 		if claims.Admin {
 			return nil
@@ -181,6 +389,11 @@ func ValidateAPIMethod(apiMethod string) ValidatorFunc {
 			}
 		}
 
+		if claims.hasOrganizationRoles() {
+			claims.pendingAPIMethod = apiMethod
+			return nil
+		}
+
 		return fmt.Errorf("no permission to api method: %s", apiMethod)
 	}
 }
@@ -196,5 +409,7 @@ func getTokenFromContext(ctx context.Context) (string, error) {
 		return "", errors.New("authorization missing in metadata")
 	}
 
-	return token[0], nil
+	// the Authorization header of the RESTful JSON interface might use the
+	// Bearer scheme
+	return strings.TrimPrefix(token[0], "Bearer "), nil
 }
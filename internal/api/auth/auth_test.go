@@ -9,6 +9,7 @@ import (
 
 	"golang.org/x/net/context"
 
+	"github.com/brocaar/lora-app-server/internal/storage"
 	"github.com/brocaar/lorawan"
 	jwt "github.com/dgrijalva/jwt-go"
 	. "github.com/smartystreets/goconvey/convey"
@@ -100,6 +101,98 @@ func TestValidateNode(t *testing.T) {
 	})
 }
 
+func TestValidatePrincipal(t *testing.T) {
+	Convey("Given a test table", t, func() {
+		testTable := []struct {
+			Description string
+			Principals  []string
+			Claims      Claims
+			Error       error
+		}{
+			{
+				Description: "User is admin",
+				Principals:  []string{"controller"},
+				Claims:      Claims{Admin: true},
+				Error:       nil,
+			},
+			{
+				Description: "User is one of the principals",
+				Principals:  []string{"operator", "controller"},
+				Claims:      Claims{StandardClaims: jwt.StandardClaims{Subject: "controller"}},
+				Error:       nil,
+			},
+			{
+				Description: "User is not one of the principals",
+				Principals:  []string{"controller"},
+				Claims:      Claims{StandardClaims: jwt.StandardClaims{Subject: "user"}},
+				Error:       errors.New("principal user is not allowed"),
+			},
+		}
+
+		for _, test := range testTable {
+			Convey("Test: "+test.Description, func() {
+				v := ValidatePrincipal(test.Principals)
+				So(v(&test.Claims), ShouldResemble, test.Error)
+			})
+		}
+	})
+}
+
+func TestValidateUser(t *testing.T) {
+	Convey("Given a test table", t, func() {
+		testTable := []struct {
+			Description string
+			Username    string
+			Claims      Claims
+			Error       error
+		}{
+			{
+				Description: "User is admin",
+				Username:    "user",
+				Claims:      Claims{Admin: true},
+				Error:       nil,
+			},
+			{
+				Description: "User is the given user",
+				Username:    "user",
+				Claims:      Claims{StandardClaims: jwt.StandardClaims{Subject: "user"}},
+				Error:       nil,
+			},
+			{
+				Description: "User is not the given user",
+				Username:    "other",
+				Claims:      Claims{StandardClaims: jwt.StandardClaims{Subject: "user"}},
+				Error:       errors.New("no permission to user other"),
+			},
+			{
+				Description: "Token without subject",
+				Username:    "",
+				Claims:      Claims{},
+				Error:       errors.New("no permission to user "),
+			},
+		}
+
+		for _, test := range testTable {
+			Convey("Test: "+test.Description, func() {
+				v := ValidateUser(test.Username)
+				So(v(&test.Claims), ShouldResemble, test.Error)
+			})
+		}
+	})
+}
+
+func TestSetSubject(t *testing.T) {
+	Convey("Given the claims of a user", t, func() {
+		claims := Claims{StandardClaims: jwt.StandardClaims{Subject: "user"}}
+
+		Convey("Then SetSubject sets the subject of the token", func() {
+			var subject string
+			So(SetSubject(&subject)(&claims), ShouldBeNil)
+			So(subject, ShouldEqual, "user")
+		})
+	})
+}
+
 func TestValidateAPIMethod(t *testing.T) {
 	Convey("Given a test table", t, func() {
 		testTable := []struct {
@@ -155,6 +248,138 @@ func TestValidateAPIMethod(t *testing.T) {
 	})
 }
 
+func TestOrganizationRoles(t *testing.T) {
+	Convey("Given a test table", t, func() {
+		appEUI := lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}
+		devEUI := lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1}
+
+		orgID := int64(1)
+
+		claimsForRole := func(role storage.OrganizationRole) Claims {
+			return Claims{
+				orgRoles: map[int64]storage.OrganizationRole{1: role},
+				appRoles: map[lorawan.EUI64]storage.OrganizationRole{appEUI: role},
+			}
+		}
+
+		testTable := []struct {
+			Description    string
+			Claims         Claims
+			ValidatorFuncs []ValidatorFunc
+			Error          error
+		}{
+			{
+				Description:    "Read-only user reads a node of the organization",
+				Claims:         claimsForRole(storage.OrganizationRoleReadOnly),
+				ValidatorFuncs: []ValidatorFunc{ValidateAPIMethod("Node.Get"), ValidateApplication(appEUI), ValidateNode(devEUI)},
+				Error:          nil,
+			},
+			{
+				Description:    "Read-only user creates a node of the organization",
+				Claims:         claimsForRole(storage.OrganizationRoleReadOnly),
+				ValidatorFuncs: []ValidatorFunc{ValidateAPIMethod("Node.Create"), ValidateApplication(appEUI), ValidateNode(devEUI)},
+				Error:          errors.New("no permission to api method: Node.Create"),
+			},
+			{
+				Description:    "Device admin creates a node of the organization",
+				Claims:         claimsForRole(storage.OrganizationRoleDeviceAdmin),
+				ValidatorFuncs: []ValidatorFunc{ValidateAPIMethod("Node.Create"), ValidateApplication(appEUI), ValidateNode(devEUI)},
+				Error:          nil,
+			},
+			{
+				Description:    "Device admin creates a http integration",
+				Claims:         claimsForRole(storage.OrganizationRoleDeviceAdmin),
+				ValidatorFuncs: []ValidatorFunc{ValidateAPIMethod("HTTPIntegration.Create"), ValidateApplication(appEUI)},
+				Error:          errors.New("no permission to api method: HTTPIntegration.Create"),
+			},
+			{
+				Description:    "Admin creates a http integration",
+				Claims:         claimsForRole(storage.OrganizationRoleAdmin),
+				ValidatorFuncs: []ValidatorFunc{ValidateAPIMethod("HTTPIntegration.Create"), ValidateApplication(appEUI)},
+				Error:          nil,
+			},
+			{
+				Description:    "Admin reads a node of an other application",
+				Claims:         claimsForRole(storage.OrganizationRoleAdmin),
+				ValidatorFuncs: []ValidatorFunc{ValidateAPIMethod("Node.Get"), ValidateApplication(lorawan.EUI64{1})},
+				Error:          errors.New("no permission to api method: Node.Get"),
+			},
+			{
+				Description:    "Admin adds a user to the organization",
+				Claims:         claimsForRole(storage.OrganizationRoleAdmin),
+				ValidatorFuncs: []ValidatorFunc{ValidateAPIMethod("Organization.AddUser"), ValidateOrganization(1)},
+				Error:          nil,
+			},
+			{
+				Description:    "Admin updates the quotas of the organization",
+				Claims:         claimsForRole(storage.OrganizationRoleAdmin),
+				ValidatorFuncs: []ValidatorFunc{ValidateAPIMethod("Organization.Update"), ValidateOrganization(1)},
+				Error:          errors.New("no permission to api method: Organization.Update"),
+			},
+			{
+				Description:    "Read-only user reads an other organization",
+				Claims:         claimsForRole(storage.OrganizationRoleReadOnly),
+				ValidatorFuncs: []ValidatorFunc{ValidateAPIMethod("Organization.Get"), ValidateOrganization(2)},
+				Error:          errors.New("no permission to api method: Organization.Get"),
+			},
+			{
+				Description:    "Read-only user reads the statistics of a gateway of the organization",
+				Claims:         claimsForRole(storage.OrganizationRoleReadOnly),
+				ValidatorFuncs: []ValidatorFunc{ValidateAPIMethod("Gateway.GetStats"), ValidateGatewayOrganization(&orgID)},
+				Error:          nil,
+			},
+			{
+				Description:    "Read-only user updates a gateway of the organization",
+				Claims:         claimsForRole(storage.OrganizationRoleReadOnly),
+				ValidatorFuncs: []ValidatorFunc{ValidateAPIMethod("Gateway.Update"), ValidateGatewayOrganization(&orgID)},
+				Error:          errors.New("no permission to api method: Gateway.Update"),
+			},
+			{
+				Description:    "Admin creates a gateway for the organization",
+				Claims:         claimsForRole(storage.OrganizationRoleAdmin),
+				ValidatorFuncs: []ValidatorFunc{ValidateAPIMethod("Gateway.Create"), ValidateOrganization(1)},
+				Error:          nil,
+			},
+			{
+				Description:    "Admin moves a gateway to an other organization",
+				Claims:         claimsForRole(storage.OrganizationRoleAdmin),
+				ValidatorFuncs: []ValidatorFunc{ValidateAPIMethod("Gateway.Update"), ValidateGatewayOrganization(&orgID), ValidateOrganization(2)},
+				Error:          errors.New("no permission to organization 2"),
+			},
+			{
+				Description:    "Admin reads the duty-cycle of a gateway without organization",
+				Claims:         claimsForRole(storage.OrganizationRoleAdmin),
+				ValidatorFuncs: []ValidatorFunc{ValidateAPIMethod("DutyCycle.GetGatewayDutyCycle"), ValidateGatewayOrganization(nil)},
+				Error:          errors.New("no permission to gateways without organization"),
+			},
+			{
+				Description:    "Admin user lists all the gateways",
+				Claims:         Claims{Admin: true},
+				ValidatorFuncs: []ValidatorFunc{ValidateAPIMethod("Gateway.List"), ValidateGatewayOrganization(nil)},
+				Error:          nil,
+			},
+		}
+
+		for _, test := range testTable {
+			Convey("Test: "+test.Description, func() {
+				var err error
+				for _, f := range test.ValidatorFuncs {
+					if err = f(&test.Claims); err != nil {
+						break
+					}
+				}
+				So(err, ShouldResemble, test.Error)
+			})
+		}
+
+		Convey("Then an api method without application or organization is not granted by a role", func() {
+			claims := claimsForRole(storage.OrganizationRoleAdmin)
+			So(ValidateAPIMethod("Gateway.List")(&claims), ShouldBeNil)
+			So(claims.pendingAPIMethod, ShouldEqual, "Gateway.List")
+		})
+	})
+}
+
 func TestJWTValidator(t *testing.T) {
 	Convey("Given a JWT validator", t, func() {
 		v := JWTValidator{
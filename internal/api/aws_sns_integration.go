@@ -0,0 +1,179 @@
+package api
+
+import (
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/brocaar/lora-app-server/api"
+	"github.com/brocaar/lora-app-server/internal/api/auth"
+	"github.com/brocaar/lora-app-server/internal/common"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+// awsSNSIntegrationRequest defines the (shared) fields of the create and
+// update requests.
+type awsSNSIntegrationRequest interface {
+	GetAppEUI() string
+	GetRegion() string
+	GetAccessKeyID() string
+	GetSecretAccessKey() string
+	GetTopicARN() string
+	GetQueueURL() string
+}
+
+// AWSSNSIntegrationAPI exports the AWS SNS / SQS integration related
+// functions.
+type AWSSNSIntegrationAPI struct {
+	ctx       common.Context
+	validator auth.Validator
+}
+
+// NewAWSSNSIntegrationAPI creates a new AWSSNSIntegrationAPI.
+func NewAWSSNSIntegrationAPI(ctx common.Context, validator auth.Validator) *AWSSNSIntegrationAPI {
+	return &AWSSNSIntegrationAPI{
+		ctx:       ctx,
+		validator: validator,
+	}
+}
+
+// Create creates the AWS SNS integration of the given application.
+func (a *AWSSNSIntegrationAPI) Create(ctx context.Context, req *pb.CreateAWSSNSIntegrationRequest) (*pb.CreateAWSSNSIntegrationResponse, error) {
+	i, err := getAWSSNSIntegration(req)
+	if err != nil {
+		return nil, err
+	}
+	if i.SecretAccessKey == "" {
+		return nil, grpc.Errorf(codes.InvalidArgument, "secretAccessKey must be set")
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("AWSSNSIntegration.Create"),
+		auth.ValidateApplication(i.AppEUI),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if err := storage.CreateAWSSNSIntegration(a.ctx.DB, i); err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+	return &pb.CreateAWSSNSIntegrationResponse{}, nil
+}
+
+// Get returns the AWS SNS integration of the given application. The secret
+// access key is not returned.
+func (a *AWSSNSIntegrationAPI) Get(ctx context.Context, req *pb.GetAWSSNSIntegrationRequest) (*pb.GetAWSSNSIntegrationResponse, error) {
+	var appEUI lorawan.EUI64
+	if err := appEUI.UnmarshalText([]byte(req.AppEUI)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("AWSSNSIntegration.Get"),
+		auth.ValidateApplication(appEUI),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	i, err := storage.GetAWSSNSIntegration(a.ctx.DB, appEUI)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+	if i == nil {
+		return nil, grpc.Errorf(codes.NotFound, "aws sns integration %s does not exist", appEUI)
+	}
+
+	return &pb.GetAWSSNSIntegrationResponse{
+		AppEUI:      i.AppEUI.String(),
+		Region:      i.Region,
+		AccessKeyID: i.AccessKeyID,
+		TopicARN:    i.TopicARN,
+		QueueURL:    i.QueueURL,
+	}, nil
+}
+
+// Update updates the AWS SNS integration of the given application. When no
+// secret access key is given, the current secret is kept.
+func (a *AWSSNSIntegrationAPI) Update(ctx context.Context, req *pb.UpdateAWSSNSIntegrationRequest) (*pb.UpdateAWSSNSIntegrationResponse, error) {
+	i, err := getAWSSNSIntegration(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("AWSSNSIntegration.Update"),
+		auth.ValidateApplication(i.AppEUI),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if i.SecretAccessKey == "" {
+		current, err := storage.GetAWSSNSIntegration(a.ctx.DB, i.AppEUI)
+		if err != nil {
+			return nil, grpc.Errorf(codes.Unknown, err.Error())
+		}
+		if current == nil {
+			return nil, grpc.Errorf(codes.NotFound, "aws sns integration %s does not exist", i.AppEUI)
+		}
+		i.SecretAccessKey = current.SecretAccessKey
+	}
+
+	if err := storage.UpdateAWSSNSIntegration(a.ctx.DB, i); err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+	return &pb.UpdateAWSSNSIntegrationResponse{}, nil
+}
+
+// Delete deletes the AWS SNS integration of the given application.
+func (a *AWSSNSIntegrationAPI) Delete(ctx context.Context, req *pb.DeleteAWSSNSIntegrationRequest) (*pb.DeleteAWSSNSIntegrationResponse, error) {
+	var appEUI lorawan.EUI64
+	if err := appEUI.UnmarshalText([]byte(req.AppEUI)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("AWSSNSIntegration.Delete"),
+		auth.ValidateApplication(appEUI),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if err := storage.DeleteAWSSNSIntegration(a.ctx.DB, appEUI); err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+	return &pb.DeleteAWSSNSIntegrationResponse{}, nil
+}
+
+// getAWSSNSIntegration validates the given request and returns the
+// AWSSNSIntegration.
+func getAWSSNSIntegration(req awsSNSIntegrationRequest) (storage.AWSSNSIntegration, error) {
+	i := storage.AWSSNSIntegration{
+		Region:          req.GetRegion(),
+		AccessKeyID:     req.GetAccessKeyID(),
+		SecretAccessKey: storage.EncryptedString(req.GetSecretAccessKey()),
+		TopicARN:        req.GetTopicARN(),
+		QueueURL:        req.GetQueueURL(),
+	}
+
+	if err := i.AppEUI.UnmarshalText([]byte(req.GetAppEUI())); err != nil {
+		return i, grpc.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	if i.Region == "" {
+		return i, grpc.Errorf(codes.InvalidArgument, "region must be set")
+	}
+	if i.AccessKeyID == "" {
+		return i, grpc.Errorf(codes.InvalidArgument, "accessKeyID must be set")
+	}
+	if i.TopicARN == "" && i.QueueURL == "" {
+		return i, grpc.Errorf(codes.InvalidArgument, "topicARN and / or queueURL must be set")
+	}
+	if i.QueueURL != "" {
+		if err := validateNotificationURL(i.QueueURL); err != nil {
+			return i, grpc.Errorf(codes.InvalidArgument, "queueURL: %s", err)
+		}
+	}
+
+	return i, nil
+}
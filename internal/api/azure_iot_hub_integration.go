@@ -0,0 +1,167 @@
+package api
+
+import (
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/brocaar/lora-app-server/api"
+	"github.com/brocaar/lora-app-server/internal/api/auth"
+	"github.com/brocaar/lora-app-server/internal/common"
+	"github.com/brocaar/lora-app-server/internal/handler"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+// azureIoTHubIntegrationRequest defines the (shared) fields of the create
+// and update requests.
+type azureIoTHubIntegrationRequest interface {
+	GetAppEUI() string
+	GetConnectionString() string
+	GetC2DEnabled() bool
+}
+
+// AzureIoTHubIntegrationAPI exports the Azure IoT Hub integration related
+// functions.
+type AzureIoTHubIntegrationAPI struct {
+	ctx       common.Context
+	validator auth.Validator
+}
+
+// NewAzureIoTHubIntegrationAPI creates a new AzureIoTHubIntegrationAPI.
+func NewAzureIoTHubIntegrationAPI(ctx common.Context, validator auth.Validator) *AzureIoTHubIntegrationAPI {
+	return &AzureIoTHubIntegrationAPI{
+		ctx:       ctx,
+		validator: validator,
+	}
+}
+
+// Create creates the Azure IoT Hub integration of the given application.
+func (a *AzureIoTHubIntegrationAPI) Create(ctx context.Context, req *pb.CreateAzureIoTHubIntegrationRequest) (*pb.CreateAzureIoTHubIntegrationResponse, error) {
+	i, err := getAzureIoTHubIntegration(req)
+	if err != nil {
+		return nil, err
+	}
+	if i.ConnectionString == "" {
+		return nil, grpc.Errorf(codes.InvalidArgument, "connectionString must be set")
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("AzureIoTHubIntegration.Create"),
+		auth.ValidateApplication(i.AppEUI),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if err := storage.CreateAzureIoTHubIntegration(a.ctx.DB, i); err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+	return &pb.CreateAzureIoTHubIntegrationResponse{}, nil
+}
+
+// Get returns the Azure IoT Hub integration of the given application. The
+// connection string is not returned.
+func (a *AzureIoTHubIntegrationAPI) Get(ctx context.Context, req *pb.GetAzureIoTHubIntegrationRequest) (*pb.GetAzureIoTHubIntegrationResponse, error) {
+	var appEUI lorawan.EUI64
+	if err := appEUI.UnmarshalText([]byte(req.AppEUI)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("AzureIoTHubIntegration.Get"),
+		auth.ValidateApplication(appEUI),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	i, err := storage.GetAzureIoTHubIntegration(a.ctx.DB, appEUI)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+	if i == nil {
+		return nil, grpc.Errorf(codes.NotFound, "azure iot hub integration %s does not exist", appEUI)
+	}
+
+	hostName, keyName, _ := handler.AzureConnectionStringInfo(string(i.ConnectionString))
+
+	return &pb.GetAzureIoTHubIntegrationResponse{
+		AppEUI:              i.AppEUI.String(),
+		HostName:            hostName,
+		SharedAccessKeyName: keyName,
+		C2DEnabled:          i.C2DEnabled,
+	}, nil
+}
+
+// Update updates the Azure IoT Hub integration of the given application.
+// When no connection string is given, the current connection string is
+// kept.
+func (a *AzureIoTHubIntegrationAPI) Update(ctx context.Context, req *pb.UpdateAzureIoTHubIntegrationRequest) (*pb.UpdateAzureIoTHubIntegrationResponse, error) {
+	i, err := getAzureIoTHubIntegration(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("AzureIoTHubIntegration.Update"),
+		auth.ValidateApplication(i.AppEUI),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if i.ConnectionString == "" {
+		current, err := storage.GetAzureIoTHubIntegration(a.ctx.DB, i.AppEUI)
+		if err != nil {
+			return nil, grpc.Errorf(codes.Unknown, err.Error())
+		}
+		if current == nil {
+			return nil, grpc.Errorf(codes.NotFound, "azure iot hub integration %s does not exist", i.AppEUI)
+		}
+		i.ConnectionString = current.ConnectionString
+	}
+
+	if err := storage.UpdateAzureIoTHubIntegration(a.ctx.DB, i); err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+	return &pb.UpdateAzureIoTHubIntegrationResponse{}, nil
+}
+
+// Delete deletes the Azure IoT Hub integration of the given application.
+func (a *AzureIoTHubIntegrationAPI) Delete(ctx context.Context, req *pb.DeleteAzureIoTHubIntegrationRequest) (*pb.DeleteAzureIoTHubIntegrationResponse, error) {
+	var appEUI lorawan.EUI64
+	if err := appEUI.UnmarshalText([]byte(req.AppEUI)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("AzureIoTHubIntegration.Delete"),
+		auth.ValidateApplication(appEUI),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if err := storage.DeleteAzureIoTHubIntegration(a.ctx.DB, appEUI); err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+	return &pb.DeleteAzureIoTHubIntegrationResponse{}, nil
+}
+
+// getAzureIoTHubIntegration validates the given request and returns the
+// AzureIoTHubIntegration.
+func getAzureIoTHubIntegration(req azureIoTHubIntegrationRequest) (storage.AzureIoTHubIntegration, error) {
+	i := storage.AzureIoTHubIntegration{
+		ConnectionString: storage.EncryptedString(req.GetConnectionString()),
+		C2DEnabled:       req.GetC2DEnabled(),
+	}
+
+	if err := i.AppEUI.UnmarshalText([]byte(req.GetAppEUI())); err != nil {
+		return i, grpc.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	if i.ConnectionString != "" {
+		if err := handler.ValidateAzureConnectionString(string(i.ConnectionString)); err != nil {
+			return i, grpc.Errorf(codes.InvalidArgument, "connectionString: %s", err)
+		}
+	}
+
+	return i, nil
+}
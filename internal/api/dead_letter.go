@@ -0,0 +1,161 @@
+package api
+
+import (
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/brocaar/lora-app-server/api"
+	"github.com/brocaar/lora-app-server/internal/api/auth"
+	"github.com/brocaar/lora-app-server/internal/common"
+	"github.com/brocaar/lora-app-server/internal/handler"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+// DeadLetterAPI exports the dead-letter related functions.
+type DeadLetterAPI struct {
+	ctx       common.Context
+	validator auth.Validator
+	retrier   *handler.Retrier
+}
+
+// NewDeadLetterAPI creates a new DeadLetterAPI.
+func NewDeadLetterAPI(ctx common.Context, validator auth.Validator, retrier *handler.Retrier) *DeadLetterAPI {
+	return &DeadLetterAPI{
+		ctx:       ctx,
+		validator: validator,
+		retrier:   retrier,
+	}
+}
+
+// List lists the dead letters of the given application, oldest first.
+func (a *DeadLetterAPI) List(ctx context.Context, req *pb.ListDeadLetterRequest) (*pb.ListDeadLetterResponse, error) {
+	var appEUI lorawan.EUI64
+	if err := appEUI.UnmarshalText([]byte(req.AppEUI)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("DeadLetter.List"),
+		auth.ValidateApplication(appEUI),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	letters, count, err := a.retrier.DeadLetters().List(appEUI, req.Handler, int(req.Limit), int(req.Offset))
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+
+	resp := pb.ListDeadLetterResponse{
+		TotalCount: int64(count),
+	}
+	for _, d := range letters {
+		resp.Result = append(resp.Result, deadLetterToEntry(d))
+	}
+	return &resp, nil
+}
+
+// Get returns the dead letter matching the given id.
+func (a *DeadLetterAPI) Get(ctx context.Context, req *pb.GetDeadLetterRequest) (*pb.DeadLetterEntry, error) {
+	d, err := a.getDeadLetter(ctx, "DeadLetter.Get", req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return deadLetterToEntry(d), nil
+}
+
+// Replay sends the event of the given dead letter again to its integration
+// and deletes the dead letter.
+func (a *DeadLetterAPI) Replay(ctx context.Context, req *pb.ReplayDeadLetterRequest) (*pb.ReplayDeadLetterResponse, error) {
+	d, err := a.getDeadLetter(ctx, "DeadLetter.Replay", req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := a.retrier.Replay(d); err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+	return &pb.ReplayDeadLetterResponse{}, nil
+}
+
+// ReplayByAppEUI replays all the dead letters of the given application.
+func (a *DeadLetterAPI) ReplayByAppEUI(ctx context.Context, req *pb.ReplayDeadLettersByAppEUIRequest) (*pb.ReplayDeadLettersByAppEUIResponse, error) {
+	var appEUI lorawan.EUI64
+	if err := appEUI.UnmarshalText([]byte(req.AppEUI)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("DeadLetter.ReplayByAppEUI"),
+		auth.ValidateApplication(appEUI),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	// the replayed dead letters are deleted, so first fetch all of them
+	store := a.retrier.DeadLetters()
+	_, count, err := store.List(appEUI, req.Handler, 0, 0)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+	letters, _, err := store.List(appEUI, req.Handler, count, 0)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+
+	var resp pb.ReplayDeadLettersByAppEUIResponse
+	for _, d := range letters {
+		if err := a.retrier.Replay(d); err != nil {
+			return nil, grpc.Errorf(codes.Unknown, "replay dead letter %d error (%d replayed): %s", d.ID, resp.Count, err)
+		}
+		resp.Count++
+	}
+	return &resp, nil
+}
+
+// Delete deletes the dead letter matching the given id.
+func (a *DeadLetterAPI) Delete(ctx context.Context, req *pb.DeleteDeadLetterRequest) (*pb.DeleteDeadLetterResponse, error) {
+	if _, err := a.getDeadLetter(ctx, "DeadLetter.Delete", req.Id); err != nil {
+		return nil, err
+	}
+
+	if err := a.retrier.DeadLetters().Delete(req.Id); err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+	return &pb.DeleteDeadLetterResponse{}, nil
+}
+
+// getDeadLetter returns the dead letter matching the given id, after
+// validating the access to its application for the given API method.
+func (a *DeadLetterAPI) getDeadLetter(ctx context.Context, method string, id int64) (storage.DeadLetter, error) {
+	d, err := a.retrier.DeadLetters().Get(id)
+	if err != nil {
+		return d, grpc.Errorf(codes.Unknown, err.Error())
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod(method),
+		auth.ValidateApplication(d.AppEUI),
+	); err != nil {
+		return d, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+	return d, nil
+}
+
+func deadLetterToEntry(d storage.DeadLetter) *pb.DeadLetterEntry {
+	return &pb.DeadLetterEntry{
+		Id:          d.ID,
+		CreatedAt:   d.CreatedAt.Format(time.RFC3339),
+		Handler:     d.Handler,
+		AppEUI:      d.AppEUI.String(),
+		DevEUI:      d.DevEUI.String(),
+		Type:        d.Type,
+		PayloadJSON: string(d.Payload),
+		Error:       d.Error,
+		Attempts:    int64(d.Attempts),
+	}
+}
@@ -0,0 +1,46 @@
+package api
+
+import (
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/brocaar/lora-app-server/api/devicestatus"
+	"github.com/brocaar/lora-app-server/internal/common"
+	"github.com/brocaar/lora-app-server/internal/devicestatus"
+	"github.com/brocaar/lorawan"
+)
+
+// DeviceStatusAPI implements the devicestatus.DeviceStatusServer interface,
+// used by the network-server to forward the device status of the nodes.
+// Note that LoRa Server does not call this service yet (see
+// api/devicestatus/devicestatus.proto).
+type DeviceStatusAPI struct {
+	ctx common.Context
+}
+
+// NewDeviceStatusAPI returns a new DeviceStatusAPI.
+func NewDeviceStatusAPI(ctx common.Context) *DeviceStatusAPI {
+	return &DeviceStatusAPI{
+		ctx: ctx,
+	}
+}
+
+// HandleDeviceStatus handles the device status (DevStatusAns) of a node.
+func (a *DeviceStatusAPI) HandleDeviceStatus(ctx context.Context, req *pb.HandleDeviceStatusRequest) (*pb.HandleDeviceStatusResponse, error) {
+	var devEUI lorawan.EUI64
+	copy(devEUI[:], req.DevEUI)
+
+	if req.Battery > 255 {
+		return nil, grpc.Errorf(codes.InvalidArgument, "battery must be between 0 and 255")
+	}
+	if req.Margin < -32 || req.Margin > 31 {
+		return nil, grpc.Errorf(codes.InvalidArgument, "margin must be between -32 and 31")
+	}
+
+	if err := devicestatus.HandleDeviceStatus(a.ctx, devEUI, int(req.Battery), int(req.Margin)); err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+
+	return &pb.HandleDeviceStatusResponse{}, nil
+}
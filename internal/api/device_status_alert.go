@@ -0,0 +1,142 @@
+package api
+
+import (
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/brocaar/lora-app-server/api"
+	"github.com/brocaar/lora-app-server/internal/api/auth"
+	"github.com/brocaar/lora-app-server/internal/common"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+// deviceStatusAlertRequest defines the (shared) fields of the create and
+// update requests.
+type deviceStatusAlertRequest interface {
+	GetAppEUI() string
+	GetMinBatteryLevel() float64
+	GetMinLinkMargin() int32
+}
+
+// DeviceStatusAlertAPI exports the device status alert related functions.
+type DeviceStatusAlertAPI struct {
+	ctx       common.Context
+	validator auth.Validator
+}
+
+// NewDeviceStatusAlertAPI creates a new DeviceStatusAlertAPI.
+func NewDeviceStatusAlertAPI(ctx common.Context, validator auth.Validator) *DeviceStatusAlertAPI {
+	return &DeviceStatusAlertAPI{
+		ctx:       ctx,
+		validator: validator,
+	}
+}
+
+// Create creates the device status alert of the given application.
+func (a *DeviceStatusAlertAPI) Create(ctx context.Context, req *pb.CreateDeviceStatusAlertRequest) (*pb.CreateDeviceStatusAlertResponse, error) {
+	da, err := getDeviceStatusAlert(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("DeviceStatusAlert.Create"),
+		auth.ValidateApplication(da.AppEUI),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if err := storage.CreateDeviceStatusAlert(a.ctx.DB, da); err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+	return &pb.CreateDeviceStatusAlertResponse{}, nil
+}
+
+// Get returns the device status alert of the given application.
+func (a *DeviceStatusAlertAPI) Get(ctx context.Context, req *pb.GetDeviceStatusAlertRequest) (*pb.GetDeviceStatusAlertResponse, error) {
+	var appEUI lorawan.EUI64
+	if err := appEUI.UnmarshalText([]byte(req.AppEUI)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("DeviceStatusAlert.Get"),
+		auth.ValidateApplication(appEUI),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	da, err := storage.GetDeviceStatusAlert(a.ctx.DB, appEUI)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+	if da == nil {
+		return nil, grpc.Errorf(codes.NotFound, "device status alert %s does not exist", appEUI)
+	}
+
+	return &pb.GetDeviceStatusAlertResponse{
+		AppEUI:          da.AppEUI.String(),
+		MinBatteryLevel: da.MinBatteryLevel,
+		MinLinkMargin:   int32(da.MinLinkMargin),
+	}, nil
+}
+
+// Update updates the device status alert of the given application.
+func (a *DeviceStatusAlertAPI) Update(ctx context.Context, req *pb.UpdateDeviceStatusAlertRequest) (*pb.UpdateDeviceStatusAlertResponse, error) {
+	da, err := getDeviceStatusAlert(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("DeviceStatusAlert.Update"),
+		auth.ValidateApplication(da.AppEUI),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if err := storage.UpdateDeviceStatusAlert(a.ctx.DB, da); err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+	return &pb.UpdateDeviceStatusAlertResponse{}, nil
+}
+
+// Delete deletes the device status alert of the given application.
+func (a *DeviceStatusAlertAPI) Delete(ctx context.Context, req *pb.DeleteDeviceStatusAlertRequest) (*pb.DeleteDeviceStatusAlertResponse, error) {
+	var appEUI lorawan.EUI64
+	if err := appEUI.UnmarshalText([]byte(req.AppEUI)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("DeviceStatusAlert.Delete"),
+		auth.ValidateApplication(appEUI),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if err := storage.DeleteDeviceStatusAlert(a.ctx.DB, appEUI); err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+	return &pb.DeleteDeviceStatusAlertResponse{}, nil
+}
+
+// getDeviceStatusAlert validates the given request and returns the
+// DeviceStatusAlert.
+func getDeviceStatusAlert(req deviceStatusAlertRequest) (storage.DeviceStatusAlert, error) {
+	da := storage.DeviceStatusAlert{
+		MinBatteryLevel: req.GetMinBatteryLevel(),
+		MinLinkMargin:   int(req.GetMinLinkMargin()),
+	}
+
+	if err := da.AppEUI.UnmarshalText([]byte(req.GetAppEUI())); err != nil {
+		return da, grpc.Errorf(codes.InvalidArgument, err.Error())
+	}
+	if err := da.Validate(); err != nil {
+		return da, grpc.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	return da, nil
+}
@@ -0,0 +1,113 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	pb "github.com/brocaar/lora-app-server/api/devicestatus"
+	"github.com/brocaar/lora-app-server/internal/common"
+	"github.com/brocaar/lora-app-server/internal/devicestatus"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lora-app-server/internal/test"
+	"github.com/brocaar/lora-app-server/internal/test/testhandler"
+)
+
+func TestDeviceStatusAPI(t *testing.T) {
+	conf := test.GetConfig()
+
+	Convey("Given a clean database and api instance", t, func() {
+		db, err := storage.OpenDatabase(conf.PostgresDSN)
+		So(err, ShouldBeNil)
+		test.MustResetDB(db)
+
+		h := testhandler.NewTestHandler()
+
+		ctx := context.Background()
+		lsCtx := common.Context{
+			DB:      db,
+			Handler: h,
+		}
+		api := NewDeviceStatusAPI(lsCtx)
+
+		Convey("Given a node and a device status alert in the database", func() {
+			node := storage.Node{
+				AppEUI: [8]byte{1, 1, 1, 1, 1, 1, 1, 1},
+				DevEUI: [8]byte{2, 2, 2, 2, 2, 2, 2, 2},
+			}
+			So(storage.CreateNode(db, node), ShouldBeNil)
+			So(storage.CreateDeviceStatusAlert(db, storage.DeviceStatusAlert{
+				AppEUI:          node.AppEUI,
+				MinBatteryLevel: 50,
+			}), ShouldBeNil)
+
+			Convey("When calling HandleDeviceStatus with a battery level below the threshold", func() {
+				_, err := api.HandleDeviceStatus(ctx, &pb.HandleDeviceStatusRequest{
+					DevEUI:  node.DevEUI[:],
+					Battery: 100,
+					Margin:  10,
+				})
+				So(err, ShouldBeNil)
+
+				Convey("Then the device status is stored", func() {
+					n, err := storage.GetNode(db, node.DevEUI)
+					So(err, ShouldBeNil)
+					So(n.Battery, ShouldNotBeNil)
+					So(*n.Battery, ShouldEqual, 100)
+					So(n.LinkMargin, ShouldNotBeNil)
+					So(*n.LinkMargin, ShouldEqual, 10)
+					So(n.DeviceStatusAt, ShouldNotBeNil)
+				})
+
+				Convey("Then the device status is sent to the handler", func() {
+					status := <-h.SendDeviceStatusChan
+					So(status.DevEUI, ShouldEqual, node.DevEUI)
+					So(*status.Battery, ShouldEqual, 100)
+					So(*status.LinkMargin, ShouldEqual, 10)
+				})
+
+				Convey("Then a BATTERY_LOW error notification is sent to the handler", func() {
+					n := <-h.SendErrorNotificationChan
+					So(n.DevEUI, ShouldEqual, node.DevEUI)
+					So(n.Type, ShouldEqual, devicestatus.ErrorTypeBatteryLow)
+				})
+
+				Convey("When calling HandleDeviceStatus again with a battery level below the threshold", func() {
+					_, err := api.HandleDeviceStatus(ctx, &pb.HandleDeviceStatusRequest{
+						DevEUI:  node.DevEUI[:],
+						Battery: 90,
+						Margin:  10,
+					})
+					So(err, ShouldBeNil)
+
+					Convey("Then no second error notification is sent", func() {
+						<-h.SendErrorNotificationChan
+						So(h.SendErrorNotificationChan, ShouldHaveLength, 0)
+					})
+				})
+			})
+
+			Convey("When calling HandleDeviceStatus with an invalid margin", func() {
+				_, err := api.HandleDeviceStatus(ctx, &pb.HandleDeviceStatusRequest{
+					DevEUI:  node.DevEUI[:],
+					Battery: 100,
+					Margin:  32,
+				})
+
+				Convey("Then an InvalidArgument error is returned", func() {
+					So(err, ShouldResemble, grpc.Errorf(codes.InvalidArgument, "margin must be between -32 and 31"))
+				})
+
+				Convey("Then the device status is not stored", func() {
+					n, err := storage.GetNode(db, node.DevEUI)
+					So(err, ShouldBeNil)
+					So(n.Battery, ShouldBeNil)
+				})
+			})
+		})
+	})
+}
@@ -0,0 +1,118 @@
+package api
+
+import (
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/brocaar/lora-app-server/api"
+	"github.com/brocaar/lora-app-server/internal/api/auth"
+	"github.com/brocaar/lora-app-server/internal/common"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+// DownlinkFPortPolicyAPI exports the downlink fport policy related functions.
+type DownlinkFPortPolicyAPI struct {
+	ctx       common.Context
+	validator auth.Validator
+}
+
+// NewDownlinkFPortPolicyAPI creates a new DownlinkFPortPolicyAPI.
+func NewDownlinkFPortPolicyAPI(ctx common.Context, validator auth.Validator) *DownlinkFPortPolicyAPI {
+	return &DownlinkFPortPolicyAPI{
+		ctx:       ctx,
+		validator: validator,
+	}
+}
+
+// Create creates the given policy.
+func (a *DownlinkFPortPolicyAPI) Create(ctx context.Context, req *pb.CreateDownlinkFPortPolicyRequest) (*pb.CreateDownlinkFPortPolicyResponse, error) {
+	var p storage.DownlinkFPortPolicy
+	if err := p.AppEUI.UnmarshalText([]byte(req.AppEUI)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, err.Error())
+	}
+	if req.DevEUI != "" {
+		var devEUI lorawan.EUI64
+		if err := devEUI.UnmarshalText([]byte(req.DevEUI)); err != nil {
+			return nil, grpc.Errorf(codes.InvalidArgument, err.Error())
+		}
+		p.DevEUI = &devEUI
+	}
+	if req.FPort == 0 || req.FPort > 255 {
+		return nil, grpc.Errorf(codes.InvalidArgument, "fPort must be between 1 and 255")
+	}
+	if len(req.Principals) == 0 {
+		return nil, grpc.Errorf(codes.InvalidArgument, "at least one principal is required")
+	}
+	p.FPort = uint8(req.FPort)
+	p.Principals = req.Principals
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("DownlinkFPortPolicy.Create"),
+		auth.ValidateApplication(p.AppEUI),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if err := storage.CreateDownlinkFPortPolicy(a.ctx.DB, &p); err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+
+	return &pb.CreateDownlinkFPortPolicyResponse{Id: p.ID}, nil
+}
+
+// List lists the policies of the given application.
+func (a *DownlinkFPortPolicyAPI) List(ctx context.Context, req *pb.ListDownlinkFPortPolicyRequest) (*pb.ListDownlinkFPortPolicyResponse, error) {
+	var appEUI lorawan.EUI64
+	if err := appEUI.UnmarshalText([]byte(req.AppEUI)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("DownlinkFPortPolicy.List"),
+		auth.ValidateApplication(appEUI),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	policies, err := storage.GetDownlinkFPortPoliciesForAppEUI(a.ctx.DB, appEUI)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+
+	var resp pb.ListDownlinkFPortPolicyResponse
+	for _, p := range policies {
+		item := pb.DownlinkFPortPolicyItem{
+			Id:         p.ID,
+			AppEUI:     p.AppEUI.String(),
+			FPort:      uint32(p.FPort),
+			Principals: p.Principals,
+		}
+		if p.DevEUI != nil {
+			item.DevEUI = p.DevEUI.String()
+		}
+		resp.Result = append(resp.Result, &item)
+	}
+	return &resp, nil
+}
+
+// Delete deletes the policy matching the given id.
+func (a *DownlinkFPortPolicyAPI) Delete(ctx context.Context, req *pb.DeleteDownlinkFPortPolicyRequest) (*pb.DeleteDownlinkFPortPolicyResponse, error) {
+	p, err := storage.GetDownlinkFPortPolicy(a.ctx.DB, req.Id)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("DownlinkFPortPolicy.Delete"),
+		auth.ValidateApplication(p.AppEUI),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if err := storage.DeleteDownlinkFPortPolicy(a.ctx.DB, p.ID); err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+	return &pb.DeleteDownlinkFPortPolicyResponse{}, nil
+}
@@ -2,14 +2,18 @@ package api
 
 import (
 	"encoding/hex"
+	"encoding/json"
+	"time"
 
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 
 	pb "github.com/brocaar/lora-app-server/api"
+	"github.com/brocaar/lora-app-server/integration"
 	"github.com/brocaar/lora-app-server/internal/api/auth"
 	"github.com/brocaar/lora-app-server/internal/common"
+	"github.com/brocaar/lora-app-server/internal/downlink"
 	"github.com/brocaar/lora-app-server/internal/storage"
 	"github.com/brocaar/lorawan"
 )
@@ -40,28 +44,76 @@ func (d *DownlinkQueueAPI) Enqueue(ctx context.Context, req *pb.EnqueueDownlinkQ
 		return nil, grpc.Errorf(codes.Unknown, err.Error())
 	}
 
-	if err := d.validator.Validate(ctx,
+	var actor string
+	validators := []auth.ValidatorFunc{
+		auth.SetSubject(&actor),
 		auth.ValidateAPIMethod("DownlinkQueue.Enqueue"),
 		auth.ValidateApplication(node.AppEUI),
 		auth.ValidateNode(node.DevEUI),
-	); err != nil {
-		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
 	}
 
-	qi := storage.DownlinkQueueItem{
-		Reference: req.Reference,
-		Confirmed: req.Confirmed,
-		FPort:     uint8(req.FPort),
-		Data:      req.Data,
+	// when the fport is restricted by a policy, the user must be one of the
+	// allowed principals
+	principals, err := storage.GetDownlinkFPortPrincipals(d.ctx.DB, node.AppEUI, node.DevEUI, uint8(req.FPort))
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+	if principals != nil {
+		validators = append(validators, auth.ValidatePrincipal(principals))
 	}
 
-	if err := qi.DevEUI.UnmarshalText([]byte(req.DevEUI)); err != nil {
+	if err := d.validator.Validate(ctx, validators...); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	pl := integration.DataDownPayload{
+		Principal:  actor,
+		Reference:  req.Reference,
+		Confirmed:  req.Confirmed,
+		DevEUI:     node.DevEUI,
+		FPort:      uint8(req.FPort),
+		Data:       req.Data,
+		MaxRetries: req.MaxRetries,
+		Encrypted:  req.Encrypted,
+	}
+	if req.Encrypted {
+		pl.FCnt = &req.FCnt
+	}
+	if err := downlink.ValidateEncryption(node, pl.Encrypted, pl.FCnt); err != nil {
 		return nil, grpc.Errorf(codes.InvalidArgument, err.Error())
 	}
+	if req.Object != "" {
+		if req.Encrypted {
+			return nil, grpc.Errorf(codes.InvalidArgument, "an encrypted payload can't contain an object")
+		}
+		if !json.Valid([]byte(req.Object)) {
+			return nil, grpc.Errorf(codes.InvalidArgument, "object must be valid JSON")
+		}
+		pl.Object = json.RawMessage(req.Object)
+	}
+	if req.DelayUntil != "" {
+		t, err := time.Parse(time.RFC3339, req.DelayUntil)
+		if err != nil {
+			return nil, grpc.Errorf(codes.InvalidArgument, "delayUntil: %s", err)
+		}
+		pl.DelayUntil = &t
+	}
 
-	if err := storage.CreateDownlinkQueueItem(d.ctx.DB, &qi); err != nil {
-		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	if d.ctx.DownlinkLimiter != nil {
+		if err := d.ctx.DownlinkLimiter.LimitDownlink(node.AppEUI, pl); err != nil {
+			return nil, grpc.Errorf(codes.ResourceExhausted, err.Error())
+		}
 	}
+
+	// the payload is enqueued like the payloads received by the handlers
+	// (including the object encoding, downlink quota and audit log)
+	if err := downlink.NewQueue(d.ctx.DB).Enqueue(pl); err != nil {
+		if err == downlink.ErrPayloadTooLarge {
+			return nil, grpc.Errorf(codes.InvalidArgument, err.Error())
+		}
+		return nil, quotaError(err)
+	}
+
 	return &pb.EnqueueDownlinkQueueItemResponse{}, nil
 }
 
@@ -76,7 +128,9 @@ func (d *DownlinkQueueAPI) Delete(ctx context.Context, req *pb.DeleteDownlinkQeu
 		return nil, grpc.Errorf(codes.Unknown, err.Error())
 	}
 
+	var actor string
 	if err := d.validator.Validate(ctx,
+		auth.SetSubject(&actor),
 		auth.ValidateAPIMethod("DownlinkQueue.Delete"),
 		auth.ValidateApplication(node.AppEUI),
 		auth.ValidateNode(node.DevEUI),
@@ -87,6 +141,20 @@ func (d *DownlinkQueueAPI) Delete(ctx context.Context, req *pb.DeleteDownlinkQeu
 	if err := storage.DeleteDownlinkQueueItem(d.ctx.DB, req.Id); err != nil {
 		return nil, grpc.Errorf(codes.Unknown, err.Error())
 	}
+	if err := storage.CancelDownlinkDeliveries(d.ctx.DB, qi.DevEUI, qi.ID); err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+
+	recordAudit(d.ctx.DB, storage.AuditLog{
+		Actor:      actor,
+		Action:     "DownlinkQueue.Delete",
+		ObjectType: storage.AuditObjectDownlink,
+		ObjectID:   node.DevEUI.String(),
+		AppEUI:     &node.AppEUI,
+	}, map[string]interface{}{
+		"id":        qi.ID,
+		"reference": qi.Reference,
+	})
 
 	return &pb.DeleteDownlinkQueueItemResponse{}, nil
 }
@@ -119,16 +187,121 @@ func (d *DownlinkQueueAPI) List(ctx context.Context, req *pb.ListDownlinkQueueIt
 	var resp pb.ListDownlinkQueueItemsResponse
 	for _, item := range items {
 		qi := pb.DownlinkQueueItem{
-			Id:        item.ID,
-			Reference: item.Reference,
-			DevEUI:    hex.EncodeToString(item.DevEUI[:]),
-			Confirmed: item.Confirmed,
-			Pending:   item.Pending,
-			FPort:     uint32(item.FPort),
-			Data:      item.Data,
+			Id:         item.ID,
+			Reference:  item.Reference,
+			DevEUI:     hex.EncodeToString(item.DevEUI[:]),
+			Confirmed:  item.Confirmed,
+			Pending:    item.Pending,
+			FPort:      uint32(item.FPort),
+			Data:       item.Data,
+			MaxRetries: uint32(item.MaxRetries),
+			Retries:    uint32(item.Retries),
+			Encrypted:  item.Encrypted,
+		}
+		if item.FCnt != nil {
+			qi.FCnt = *item.FCnt
+		}
+		if item.DelayUntil != nil {
+			qi.DelayUntil = item.DelayUntil.Format(time.RFC3339)
 		}
 		resp.Items = append(resp.Items, &qi)
 	}
 
 	return &resp, nil
 }
+
+// Flush deletes all the items in the queue for the given devEUI.
+func (d *DownlinkQueueAPI) Flush(ctx context.Context, req *pb.FlushDownlinkQueueRequest) (*pb.FlushDownlinkQueueResponse, error) {
+	var devEUI lorawan.EUI64
+
+	if err := devEUI.UnmarshalText([]byte(req.DevEUI)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	node, err := storage.GetNode(d.ctx.DB, devEUI)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+
+	var actor string
+	if err := d.validator.Validate(ctx,
+		auth.SetSubject(&actor),
+		auth.ValidateAPIMethod("DownlinkQueue.Flush"),
+		auth.ValidateApplication(node.AppEUI),
+		auth.ValidateNode(node.DevEUI),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if err := storage.FlushDownlinkQueue(d.ctx.DB, node.DevEUI); err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+	if err := storage.CancelDownlinkDeliveries(d.ctx.DB, node.DevEUI, 0); err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+
+	recordAudit(d.ctx.DB, storage.AuditLog{
+		Actor:      actor,
+		Action:     "DownlinkQueue.Flush",
+		ObjectType: storage.AuditObjectDownlink,
+		ObjectID:   node.DevEUI.String(),
+		AppEUI:     &node.AppEUI,
+	}, nil)
+
+	return &pb.FlushDownlinkQueueResponse{}, nil
+}
+
+// ListDeliveries lists the delivery status of the (queued and sent) items
+// for the given devEUI, newest first.
+func (d *DownlinkQueueAPI) ListDeliveries(ctx context.Context, req *pb.ListDownlinkDeliveriesRequest) (*pb.ListDownlinkDeliveriesResponse, error) {
+	var devEUI lorawan.EUI64
+
+	if err := devEUI.UnmarshalText([]byte(req.DevEUI)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	node, err := storage.GetNode(d.ctx.DB, devEUI)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+
+	if err := d.validator.Validate(ctx,
+		auth.ValidateAPIMethod("DownlinkQueue.ListDeliveries"),
+		auth.ValidateApplication(node.AppEUI),
+		auth.ValidateNode(node.DevEUI),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	count, err := storage.GetDownlinkDeliveryCount(d.ctx.DB, node.DevEUI, req.Reference)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+	deliveries, err := storage.GetDownlinkDeliveries(d.ctx.DB, node.DevEUI, req.Reference, int(req.Limit), int(req.Offset))
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+
+	resp := pb.ListDownlinkDeliveriesResponse{
+		TotalCount: int64(count),
+	}
+	for _, dd := range deliveries {
+		item := pb.DownlinkDelivery{
+			Id:        dd.ID,
+			Reference: dd.Reference,
+			Confirmed: dd.Confirmed,
+			Status:    dd.Status,
+			CreatedAt: dd.CreatedAt.Format(time.RFC3339),
+			UpdatedAt: dd.UpdatedAt.Format(time.RFC3339),
+		}
+		if dd.FCnt != nil {
+			item.FCnt = *dd.FCnt
+		}
+		if dd.SentAt != nil {
+			item.SentAt = dd.SentAt.Format(time.RFC3339)
+		}
+		resp.Result = append(resp.Result, &item)
+	}
+
+	return &resp, nil
+}
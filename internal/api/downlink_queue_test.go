@@ -2,15 +2,33 @@ package api
 
 import (
 	"context"
+	"errors"
 	"testing"
 
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
 	pb "github.com/brocaar/lora-app-server/api"
+	"github.com/brocaar/lora-app-server/integration"
+	"github.com/brocaar/lora-app-server/internal/codec"
 	"github.com/brocaar/lora-app-server/internal/common"
+	"github.com/brocaar/lora-app-server/internal/downlink"
 	"github.com/brocaar/lora-app-server/internal/storage"
 	"github.com/brocaar/lora-app-server/internal/test"
+	"github.com/brocaar/lorawan"
 	. "github.com/smartystreets/goconvey/convey"
 )
 
+// testDownlinkLimiter implements a common.DownlinkLimiter returning the
+// given error.
+type testDownlinkLimiter struct {
+	err error
+}
+
+func (l testDownlinkLimiter) LimitDownlink(appEUI lorawan.EUI64, pl integration.DataDownPayload) error {
+	return l.err
+}
+
 func TestDownlinkQueueAPI(t *testing.T) {
 	conf := test.GetConfig()
 
@@ -59,6 +77,81 @@ func TestDownlinkQueueAPI(t *testing.T) {
 				})
 			})
 
+			Convey("When the FPort is restricted by a policy", func() {
+				So(storage.CreateDownlinkFPortPolicy(db, &storage.DownlinkFPortPolicy{
+					AppEUI:     node.AppEUI,
+					FPort:      10,
+					Principals: []string{"controller"},
+				}), ShouldBeNil)
+
+				Convey("Then enqueueing validates the principal", func() {
+					_, err := api.Enqueue(ctx, &pb.EnqueueDownlinkQueueItemRequest{
+						DevEUI: "0102030405060708",
+						FPort:  10,
+						Data:   []byte{1, 2, 3, 4},
+					})
+					So(err, ShouldBeNil)
+					So(validator.validatorFuncs, ShouldHaveLength, 4)
+				})
+			})
+
+			Convey("When enqueueing an invalid object", func() {
+				_, err := api.Enqueue(ctx, &pb.EnqueueDownlinkQueueItemRequest{
+					DevEUI: "0102030405060708",
+					FPort:  10,
+					Object: "{",
+				})
+
+				Convey("Then an InvalidArgument error is returned", func() {
+					So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
+				})
+			})
+
+			Convey("When the downlink rate limit is exceeded", func() {
+				lsCtx.DownlinkLimiter = testDownlinkLimiter{err: errors.New("downlink rate limit exceeded")}
+				api := NewDownlinkQueueAPI(lsCtx, validator)
+				_, err := api.Enqueue(ctx, &pb.EnqueueDownlinkQueueItemRequest{
+					DevEUI: "0102030405060708",
+					FPort:  10,
+					Data:   []byte{1, 2, 3, 4},
+				})
+
+				Convey("Then a ResourceExhausted error is returned and the item is not enqueued", func() {
+					So(grpc.Code(err), ShouldEqual, codes.ResourceExhausted)
+
+					items, err := storage.GetDownlinkQueueItems(db, node.DevEUI)
+					So(err, ShouldBeNil)
+					So(items, ShouldHaveLength, 1)
+				})
+			})
+
+			Convey("Given a max. payload size of 2 bytes and a Cayenne LPP codec", func() {
+				downlink.MaxPayloadSize = 2
+				Reset(func() {
+					downlink.MaxPayloadSize = 0
+				})
+				So(storage.CreatePayloadCodec(db, storage.PayloadCodec{
+					AppEUI:    node.AppEUI,
+					CodecType: codec.TypeCayenneLPP,
+				}), ShouldBeNil)
+
+				Convey("When enqueueing an object encoded into 3 bytes", func() {
+					_, err := api.Enqueue(ctx, &pb.EnqueueDownlinkQueueItemRequest{
+						DevEUI: "0102030405060708",
+						FPort:  10,
+						Object: `{"digitalOutput":{"2":1}}`,
+					})
+
+					Convey("Then an InvalidArgument error is returned and the item is not enqueued", func() {
+						So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
+
+						items, err := storage.GetDownlinkQueueItems(db, node.DevEUI)
+						So(err, ShouldBeNil)
+						So(items, ShouldHaveLength, 1)
+					})
+				})
+			})
+
 			Convey("When removing the queue item", func() {
 				_, err := api.Delete(ctx, &pb.DeleteDownlinkQeueueItemRequest{
 					Id: 1,
@@ -75,6 +168,57 @@ func TestDownlinkQueueAPI(t *testing.T) {
 					So(resp.Items, ShouldHaveLength, 0)
 				})
 			})
+
+			Convey("When flushing the downlink queue", func() {
+				_, err := api.Flush(ctx, &pb.FlushDownlinkQueueRequest{
+					DevEUI: "0102030405060708",
+				})
+				So(err, ShouldBeNil)
+				So(validator.ctx, ShouldResemble, ctx)
+				So(validator.validatorFuncs, ShouldHaveLength, 3)
+
+				Convey("Then the downlink queue is empty", func() {
+					items, err := storage.GetDownlinkQueueItems(db, node.DevEUI)
+					So(err, ShouldBeNil)
+					So(items, ShouldHaveLength, 0)
+				})
+			})
+
+			Convey("When flushing the downlink queue without permission", func() {
+				validator.returnError = errors.New("boom")
+				_, err := api.Flush(ctx, &pb.FlushDownlinkQueueRequest{
+					DevEUI: "0102030405060708",
+				})
+
+				Convey("Then an Unauthenticated error is returned and the queue is not flushed", func() {
+					So(grpc.Code(err), ShouldEqual, codes.Unauthenticated)
+
+					items, err := storage.GetDownlinkQueueItems(db, node.DevEUI)
+					So(err, ShouldBeNil)
+					So(items, ShouldHaveLength, 1)
+				})
+			})
+		})
+
+		Convey("When flushing the downlink queue using an invalid DevEUI", func() {
+			_, err := api.Flush(ctx, &pb.FlushDownlinkQueueRequest{
+				DevEUI: "0102",
+			})
+
+			Convey("Then an InvalidArgument error is returned", func() {
+				So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
+			})
+		})
+
+		Convey("When flushing the downlink queue of an unknown node", func() {
+			_, err := api.Flush(ctx, &pb.FlushDownlinkQueueRequest{
+				DevEUI: "0807060504030201",
+			})
+
+			Convey("Then an error is returned before validating the request", func() {
+				So(err, ShouldNotBeNil)
+				So(validator.validatorFuncs, ShouldHaveLength, 0)
+			})
 		})
 	})
 }
@@ -0,0 +1,320 @@
+package api
+
+import (
+	"encoding/json"
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/brocaar/lora-app-server/api"
+	"github.com/brocaar/lora-app-server/internal/api/auth"
+	"github.com/brocaar/lora-app-server/internal/common"
+	"github.com/brocaar/lora-app-server/internal/downlink"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+// downlinkRuleRequest defines the (shared) fields of the create and update
+// requests.
+type downlinkRuleRequest interface {
+	GetName() string
+	GetAppEUI() string
+	GetDevEUI() string
+	GetSchedule() string
+	GetTimezone() string
+	GetFPort() uint32
+	GetConfirmed() bool
+	GetData() []byte
+	GetObject() string
+}
+
+// DownlinkRuleAPI exports the downlink rule related functions.
+type DownlinkRuleAPI struct {
+	ctx       common.Context
+	validator auth.Validator
+}
+
+// NewDownlinkRuleAPI creates a new DownlinkRuleAPI.
+func NewDownlinkRuleAPI(ctx common.Context, validator auth.Validator) *DownlinkRuleAPI {
+	return &DownlinkRuleAPI{
+		ctx:       ctx,
+		validator: validator,
+	}
+}
+
+// Create creates the given downlink rule.
+func (a *DownlinkRuleAPI) Create(ctx context.Context, req *pb.CreateDownlinkRuleRequest) (*pb.CreateDownlinkRuleResponse, error) {
+	r, err := a.getDownlinkRule(req)
+	if err != nil {
+		return nil, err
+	}
+
+	validators, err := a.ruleValidators("DownlinkRule.Create", r)
+	if err != nil {
+		return nil, err
+	}
+	if err := a.validator.Validate(ctx, validators...); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if err := storage.CreateDownlinkRule(a.ctx.DB, &r); err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+	return &pb.CreateDownlinkRuleResponse{Id: r.ID}, nil
+}
+
+// Get returns the downlink rule matching the given id.
+func (a *DownlinkRuleAPI) Get(ctx context.Context, req *pb.GetDownlinkRuleRequest) (*pb.GetDownlinkRuleResponse, error) {
+	r, err := storage.GetDownlinkRule(a.ctx.DB, req.Id)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+
+	validators := []auth.ValidatorFunc{
+		auth.ValidateAPIMethod("DownlinkRule.Get"),
+		auth.ValidateApplication(r.AppEUI),
+	}
+	if r.DevEUI != nil {
+		validators = append(validators, auth.ValidateNode(*r.DevEUI))
+	}
+	if err := a.validator.Validate(ctx, validators...); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	return downlinkRuleToResponse(r), nil
+}
+
+// ListByAppEUI lists the downlink rules of the given application, including
+// the rules of its nodes.
+func (a *DownlinkRuleAPI) ListByAppEUI(ctx context.Context, req *pb.ListDownlinkRuleByAppEUIRequest) (*pb.ListDownlinkRuleResponse, error) {
+	var appEUI lorawan.EUI64
+	if err := appEUI.UnmarshalText([]byte(req.AppEUI)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("DownlinkRule.ListByAppEUI"),
+		auth.ValidateApplication(appEUI),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	rules, err := storage.GetDownlinkRulesForAppEUI(a.ctx.DB, appEUI)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+
+	var resp pb.ListDownlinkRuleResponse
+	for _, r := range rules {
+		resp.Result = append(resp.Result, downlinkRuleToResponse(r))
+	}
+	return &resp, nil
+}
+
+// ListByDevEUI lists the downlink rules of the given node.
+func (a *DownlinkRuleAPI) ListByDevEUI(ctx context.Context, req *pb.ListDownlinkRuleByDevEUIRequest) (*pb.ListDownlinkRuleResponse, error) {
+	var devEUI lorawan.EUI64
+	if err := devEUI.UnmarshalText([]byte(req.DevEUI)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	node, err := storage.GetNode(a.ctx.DB, devEUI)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("DownlinkRule.ListByDevEUI"),
+		auth.ValidateApplication(node.AppEUI),
+		auth.ValidateNode(node.DevEUI),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	rules, err := storage.GetDownlinkRulesForDevEUI(a.ctx.DB, node.DevEUI)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+
+	var resp pb.ListDownlinkRuleResponse
+	for _, r := range rules {
+		resp.Result = append(resp.Result, downlinkRuleToResponse(r))
+	}
+	return &resp, nil
+}
+
+// Update updates the downlink rule matching the given id. The next run is
+// re-calculated from the (updated) schedule.
+func (a *DownlinkRuleAPI) Update(ctx context.Context, req *pb.UpdateDownlinkRuleRequest) (*pb.UpdateDownlinkRuleResponse, error) {
+	current, err := storage.GetDownlinkRule(a.ctx.DB, req.Id)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+
+	r, err := a.getDownlinkRule(req)
+	if err != nil {
+		return nil, err
+	}
+	r.ID = current.ID
+
+	// the user must have access to both the current and the new rule
+	validators, err := a.ruleValidators("DownlinkRule.Update", r)
+	if err != nil {
+		return nil, err
+	}
+	validators = append(validators, auth.ValidateApplication(current.AppEUI))
+	if current.DevEUI != nil {
+		validators = append(validators, auth.ValidateNode(*current.DevEUI))
+	}
+	if err := a.validator.Validate(ctx, validators...); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if err := storage.UpdateDownlinkRule(a.ctx.DB, r); err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+	return &pb.UpdateDownlinkRuleResponse{}, nil
+}
+
+// Delete deletes the downlink rule matching the given id.
+func (a *DownlinkRuleAPI) Delete(ctx context.Context, req *pb.DeleteDownlinkRuleRequest) (*pb.DeleteDownlinkRuleResponse, error) {
+	r, err := storage.GetDownlinkRule(a.ctx.DB, req.Id)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+
+	validators := []auth.ValidatorFunc{
+		auth.ValidateAPIMethod("DownlinkRule.Delete"),
+		auth.ValidateApplication(r.AppEUI),
+	}
+	if r.DevEUI != nil {
+		validators = append(validators, auth.ValidateNode(*r.DevEUI))
+	}
+	if err := a.validator.Validate(ctx, validators...); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if err := storage.DeleteDownlinkRule(a.ctx.DB, r.ID); err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+	return &pb.DeleteDownlinkRuleResponse{}, nil
+}
+
+// ruleValidators returns the validators for creating or updating the given
+// rule. When the FPort is restricted by a policy for any of the nodes of
+// the rule, the user must be one of the allowed principals.
+func (a *DownlinkRuleAPI) ruleValidators(method string, r storage.DownlinkRule) ([]auth.ValidatorFunc, error) {
+	validators := []auth.ValidatorFunc{
+		auth.ValidateAPIMethod(method),
+		auth.ValidateApplication(r.AppEUI),
+	}
+
+	var devEUIs []lorawan.EUI64
+	if r.DevEUI != nil {
+		validators = append(validators, auth.ValidateNode(*r.DevEUI))
+		devEUIs = []lorawan.EUI64{*r.DevEUI}
+	} else {
+		var err error
+		if devEUIs, err = storage.GetDevEUIsForAppEUI(a.ctx.DB, r.AppEUI); err != nil {
+			return nil, grpc.Errorf(codes.Unknown, err.Error())
+		}
+	}
+
+	for _, devEUI := range devEUIs {
+		principals, err := storage.GetDownlinkFPortPrincipals(a.ctx.DB, r.AppEUI, devEUI, r.FPort)
+		if err != nil {
+			return nil, grpc.Errorf(codes.Unknown, err.Error())
+		}
+		if principals != nil {
+			validators = append(validators, auth.ValidatePrincipal(principals))
+		}
+	}
+
+	return validators, nil
+}
+
+// getDownlinkRule validates the given request and returns the DownlinkRule,
+// with its next run set.
+func (a *DownlinkRuleAPI) getDownlinkRule(req downlinkRuleRequest) (storage.DownlinkRule, error) {
+	r := storage.DownlinkRule{
+		Name:      req.GetName(),
+		Schedule:  req.GetSchedule(),
+		Timezone:  req.GetTimezone(),
+		Confirmed: req.GetConfirmed(),
+		Data:      req.GetData(),
+	}
+
+	if err := r.AppEUI.UnmarshalText([]byte(req.GetAppEUI())); err != nil {
+		return r, grpc.Errorf(codes.InvalidArgument, err.Error())
+	}
+	if req.GetDevEUI() != "" {
+		var devEUI lorawan.EUI64
+		if err := devEUI.UnmarshalText([]byte(req.GetDevEUI())); err != nil {
+			return r, grpc.Errorf(codes.InvalidArgument, err.Error())
+		}
+
+		// the node must belong to the application of the rule
+		node, err := storage.GetNode(a.ctx.DB, devEUI)
+		if err != nil {
+			return r, grpc.Errorf(codes.Unknown, err.Error())
+		}
+		if node.AppEUI != r.AppEUI {
+			return r, grpc.Errorf(codes.InvalidArgument, "node %s does not belong to application %s", devEUI, r.AppEUI)
+		}
+		r.DevEUI = &devEUI
+	}
+
+	if r.Name == "" {
+		return r, grpc.Errorf(codes.InvalidArgument, "name must be set")
+	}
+	if req.GetFPort() == 0 || req.GetFPort() > 223 {
+		return r, grpc.Errorf(codes.InvalidArgument, "fPort must be between 1 and 223")
+	}
+	r.FPort = uint8(req.GetFPort())
+
+	if object := req.GetObject(); object != "" {
+		if !json.Valid([]byte(object)) {
+			return r, grpc.Errorf(codes.InvalidArgument, "object must be valid JSON")
+		}
+		r.Object = []byte(object)
+	}
+	if len(r.Data) == 0 && len(r.Object) == 0 {
+		return r, grpc.Errorf(codes.InvalidArgument, "data or object must be set")
+	}
+
+	if r.Timezone == "" {
+		r.Timezone = "UTC"
+	}
+	var err error
+	if r.NextRunAt, err = downlink.NextRuleRun(r, time.Now()); err != nil {
+		return r, grpc.Errorf(codes.InvalidArgument, "schedule: %s", err)
+	}
+
+	return r, nil
+}
+
+// downlinkRuleToResponse returns the API representation of the given
+// DownlinkRule.
+func downlinkRuleToResponse(r storage.DownlinkRule) *pb.GetDownlinkRuleResponse {
+	resp := pb.GetDownlinkRuleResponse{
+		Id:        r.ID,
+		Name:      r.Name,
+		AppEUI:    r.AppEUI.String(),
+		Schedule:  r.Schedule,
+		Timezone:  r.Timezone,
+		FPort:     uint32(r.FPort),
+		Confirmed: r.Confirmed,
+		Data:      r.Data,
+		Object:    string(r.Object),
+		NextRunAt: r.NextRunAt.Format(time.RFC3339),
+	}
+	if r.DevEUI != nil {
+		resp.DevEUI = r.DevEUI.String()
+	}
+	if r.LastRunAt != nil {
+		resp.LastRunAt = r.LastRunAt.Format(time.RFC3339)
+	}
+	return &resp
+}
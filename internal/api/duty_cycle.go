@@ -0,0 +1,88 @@
+package api
+
+import (
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/brocaar/lora-app-server/api"
+	"github.com/brocaar/lora-app-server/internal/api/auth"
+	"github.com/brocaar/lora-app-server/internal/common"
+	"github.com/brocaar/lora-app-server/internal/dutycycle"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+// DutyCycleAPI exports the gateway duty-cycle related functions.
+type DutyCycleAPI struct {
+	ctx       common.Context
+	validator auth.Validator
+}
+
+// NewDutyCycleAPI creates a new DutyCycleAPI.
+func NewDutyCycleAPI(ctx common.Context, validator auth.Validator) *DutyCycleAPI {
+	return &DutyCycleAPI{
+		ctx:       ctx,
+		validator: validator,
+	}
+}
+
+// GetGatewayDutyCycle returns the downlink airtime and duty-cycle
+// utilization per sub-band of the given gateway.
+func (a *DutyCycleAPI) GetGatewayDutyCycle(ctx context.Context, req *pb.GetGatewayDutyCycleRequest) (*pb.GetGatewayDutyCycleResponse, error) {
+	var mac lorawan.EUI64
+	if err := mac.UnmarshalText([]byte(req.Mac)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	organizationID, err := storage.GetGatewayOrganizationID(a.ctx.DB, mac)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("DutyCycle.GetGatewayDutyCycle"),
+		auth.ValidateGatewayOrganization(organizationID),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	start, end, err := parsePeriod(req.Start, req.End)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	var interval storage.AggregationInterval
+	switch req.Interval {
+	case pb.AggregationInterval_PERIOD:
+		interval = storage.AggregatePeriod
+	case pb.AggregationInterval_HOUR:
+		interval = storage.AggregateHour
+	case pb.AggregationInterval_DAY:
+		interval = storage.AggregateDay
+	default:
+		return nil, grpc.Errorf(codes.InvalidArgument, "invalid interval: %s", req.Interval)
+	}
+
+	report, err := dutycycle.GetGatewayReport(a.ctx.DB, mac, start, end, interval)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+
+	var resp pb.GetGatewayDutyCycleResponse
+	for _, u := range report {
+		resp.Result = append(resp.Result, &pb.SubBandUtilization{
+			Timestamp:    u.Timestamp.Format(time.RFC3339),
+			SubBand:      u.SubBand.Name,
+			MinFrequency: uint32(u.SubBand.MinFrequency),
+			MaxFrequency: uint32(u.SubBand.MaxFrequency),
+			DutyCycle:    u.SubBand.DutyCycle,
+			Airtime:      uint32(u.Airtime / time.Millisecond),
+			Utilization:  u.Utilization,
+			Warning:      u.Warning,
+		})
+	}
+	return &resp, nil
+}
@@ -0,0 +1,169 @@
+package api
+
+import (
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/brocaar/lora-app-server/api"
+	"github.com/brocaar/lora-app-server/integration"
+	"github.com/brocaar/lora-app-server/internal/api/auth"
+	"github.com/brocaar/lora-app-server/internal/common"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+// EventFilterAPI exports the event filter related functions.
+type EventFilterAPI struct {
+	ctx          common.Context
+	validator    auth.Validator
+	integrations []string
+}
+
+// NewEventFilterAPI creates a new EventFilterAPI. The given integrations are
+// the names of the integrations the events can be filtered for.
+func NewEventFilterAPI(ctx common.Context, validator auth.Validator, integrations []string) *EventFilterAPI {
+	return &EventFilterAPI{
+		ctx:          ctx,
+		validator:    validator,
+		integrations: integrations,
+	}
+}
+
+// Create creates the given filter.
+func (a *EventFilterAPI) Create(ctx context.Context, req *pb.CreateEventFilterRequest) (*pb.CreateEventFilterResponse, error) {
+	f := storage.EventFilter{
+		Integration: req.Integration,
+	}
+	if err := f.AppEUI.UnmarshalText([]byte(req.AppEUI)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, err.Error())
+	}
+	if f.Integration != "" && !containsString(a.integrations, f.Integration) {
+		return nil, grpc.Errorf(codes.InvalidArgument, "integration must be one of %v", a.integrations)
+	}
+	if err := setEventFilter(&f, req.Events, req.FPorts); err != nil {
+		return nil, err
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("EventFilter.Create"),
+		auth.ValidateApplication(f.AppEUI),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if err := storage.CreateEventFilter(a.ctx.DB, &f); err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+
+	return &pb.CreateEventFilterResponse{Id: f.ID}, nil
+}
+
+// List lists the filters of the given application.
+func (a *EventFilterAPI) List(ctx context.Context, req *pb.ListEventFilterRequest) (*pb.ListEventFilterResponse, error) {
+	var appEUI lorawan.EUI64
+	if err := appEUI.UnmarshalText([]byte(req.AppEUI)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("EventFilter.List"),
+		auth.ValidateApplication(appEUI),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	filters, err := storage.GetEventFiltersForAppEUI(a.ctx.DB, appEUI)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+
+	var resp pb.ListEventFilterResponse
+	for _, f := range filters {
+		item := pb.EventFilterItem{
+			Id:          f.ID,
+			AppEUI:      f.AppEUI.String(),
+			Integration: f.Integration,
+			Events:      f.Events,
+		}
+		for _, p := range f.FPorts {
+			item.FPorts = append(item.FPorts, uint32(p))
+		}
+		resp.Result = append(resp.Result, &item)
+	}
+	return &resp, nil
+}
+
+// Update updates the filter matching the given id.
+func (a *EventFilterAPI) Update(ctx context.Context, req *pb.UpdateEventFilterRequest) (*pb.UpdateEventFilterResponse, error) {
+	f, err := storage.GetEventFilter(a.ctx.DB, req.Id)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("EventFilter.Update"),
+		auth.ValidateApplication(f.AppEUI),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if err := setEventFilter(&f, req.Events, req.FPorts); err != nil {
+		return nil, err
+	}
+	if err := storage.UpdateEventFilter(a.ctx.DB, f); err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+	return &pb.UpdateEventFilterResponse{}, nil
+}
+
+// Delete deletes the filter matching the given id.
+func (a *EventFilterAPI) Delete(ctx context.Context, req *pb.DeleteEventFilterRequest) (*pb.DeleteEventFilterResponse, error) {
+	f, err := storage.GetEventFilter(a.ctx.DB, req.Id)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("EventFilter.Delete"),
+		auth.ValidateApplication(f.AppEUI),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if err := storage.DeleteEventFilter(a.ctx.DB, f.ID); err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+	return &pb.DeleteEventFilterResponse{}, nil
+}
+
+// setEventFilter validates and sets the given event types and FPorts.
+func setEventFilter(f *storage.EventFilter, events []string, fPorts []uint32) error {
+	f.Events = nil
+	for _, e := range events {
+		if !containsString(integration.EventTypes, e) {
+			return grpc.Errorf(codes.InvalidArgument, "events: %s is not one of %v", e, integration.EventTypes)
+		}
+		f.Events = append(f.Events, e)
+	}
+
+	f.FPorts = nil
+	for _, p := range fPorts {
+		if p == 0 || p > 255 {
+			return grpc.Errorf(codes.InvalidArgument, "fPorts must be between 1 and 255")
+		}
+		f.FPorts = append(f.FPorts, int64(p))
+	}
+	return nil
+}
+
+// containsString returns true when the given slice contains the given
+// string.
+func containsString(slice []string, s string) bool {
+	for _, v := range slice {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
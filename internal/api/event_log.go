@@ -0,0 +1,90 @@
+package api
+
+import (
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/brocaar/lora-app-server/api"
+	"github.com/brocaar/lora-app-server/internal/api/auth"
+	"github.com/brocaar/lora-app-server/internal/common"
+	"github.com/brocaar/lora-app-server/internal/eventlog"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+// EventLogAPI exports the event log related functions.
+type EventLogAPI struct {
+	ctx       common.Context
+	validator auth.Validator
+	store     eventlog.Store
+}
+
+// NewEventLogAPI creates a new EventLogAPI. The store is nil when the event
+// log is disabled.
+func NewEventLogAPI(ctx common.Context, validator auth.Validator, store eventlog.Store) *EventLogAPI {
+	return &EventLogAPI{
+		ctx:       ctx,
+		validator: validator,
+		store:     store,
+	}
+}
+
+// List lists the logged events of the given node, newest first.
+func (a *EventLogAPI) List(ctx context.Context, req *pb.ListEventLogRequest) (*pb.ListEventLogResponse, error) {
+	var devEUI lorawan.EUI64
+	if err := devEUI.UnmarshalText([]byte(req.DevEUI)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	var f storage.EventLogFilter
+	var err error
+	if req.Start != "" {
+		if f.Start, err = time.Parse(time.RFC3339, req.Start); err != nil {
+			return nil, grpc.Errorf(codes.InvalidArgument, err.Error())
+		}
+	}
+	if req.End != "" {
+		if f.End, err = time.Parse(time.RFC3339, req.End); err != nil {
+			return nil, grpc.Errorf(codes.InvalidArgument, err.Error())
+		}
+	}
+	f.Types = req.Types
+
+	node, err := storage.GetNode(a.ctx.DB, devEUI)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("EventLog.List"),
+		auth.ValidateApplication(node.AppEUI),
+		auth.ValidateNode(node.DevEUI),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if a.store == nil {
+		return nil, grpc.Errorf(codes.FailedPrecondition, "the event log is disabled")
+	}
+
+	events, count, err := a.store.List(node.DevEUI, f, int(req.Limit), int(req.Offset))
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+
+	resp := pb.ListEventLogResponse{
+		TotalCount: int64(count),
+	}
+	for _, e := range events {
+		resp.Result = append(resp.Result, &pb.EventLogEntry{
+			Id:          e.ID,
+			Type:        e.Type,
+			CreatedAt:   e.CreatedAt.Format(time.RFC3339),
+			PayloadJSON: string(e.Payload),
+		})
+	}
+	return &resp, nil
+}
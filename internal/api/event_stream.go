@@ -0,0 +1,81 @@
+package api
+
+import (
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/brocaar/lora-app-server/api"
+	"github.com/brocaar/lora-app-server/internal/api/auth"
+	"github.com/brocaar/lora-app-server/internal/common"
+	"github.com/brocaar/lora-app-server/internal/handler"
+	"github.com/brocaar/lorawan"
+)
+
+// EventStreamAPI exports the event stream related functions.
+type EventStreamAPI struct {
+	ctx       common.Context
+	validator auth.Validator
+	stream    *handler.StreamHandler
+}
+
+// NewEventStreamAPI creates a new EventStreamAPI. The events are received
+// from the given StreamHandler.
+func NewEventStreamAPI(ctx common.Context, validator auth.Validator, stream *handler.StreamHandler) *EventStreamAPI {
+	return &EventStreamAPI{
+		ctx:       ctx,
+		validator: validator,
+		stream:    stream,
+	}
+}
+
+// Subscribe streams the events of the given application (and node).
+func (a *EventStreamAPI) Subscribe(req *pb.SubscribeEventStreamRequest, srv pb.EventStream_SubscribeServer) error {
+	var appEUI lorawan.EUI64
+	if err := appEUI.UnmarshalText([]byte(req.AppEUI)); err != nil {
+		return grpc.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	validators := []auth.ValidatorFunc{
+		auth.ValidateAPIMethod("EventStream.Subscribe"),
+		auth.ValidateApplication(appEUI),
+	}
+
+	var devEUI *lorawan.EUI64
+	if req.DevEUI != "" {
+		devEUI = &lorawan.EUI64{}
+		if err := devEUI.UnmarshalText([]byte(req.DevEUI)); err != nil {
+			return grpc.Errorf(codes.InvalidArgument, err.Error())
+		}
+		validators = append(validators, auth.ValidateNode(*devEUI))
+	}
+
+	if err := a.validator.Validate(srv.Context(), validators...); err != nil {
+		return grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	sub, err := a.stream.Subscribe(appEUI, devEUI, req.Types)
+	if err != nil {
+		return grpc.Errorf(codes.Unavailable, err.Error())
+	}
+	defer sub.Close()
+
+	for {
+		select {
+		case <-srv.Context().Done():
+			return nil
+		case e, ok := <-sub.Events():
+			if !ok {
+				return grpc.Errorf(codes.Unavailable, "event stream closed")
+			}
+			err := srv.Send(&pb.EventStreamEvent{
+				Type:        e.Type,
+				AppEUI:      e.AppEUI.String(),
+				DevEUI:      e.DevEUI.String(),
+				PayloadJSON: string(e.Payload),
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,162 @@
+package api
+
+import (
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/gorilla/mux"
+	"golang.org/x/net/context"
+	"golang.org/x/net/websocket"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/brocaar/lora-app-server/internal/api/auth"
+	"github.com/brocaar/lora-app-server/internal/common"
+	"github.com/brocaar/lora-app-server/internal/handler"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+// webSocketWriteTimeout defines the max duration for writing an event to
+// the WebSocket connection.
+const webSocketWriteTimeout = 10 * time.Second
+
+// WebSocketEvent is the (JSON encoded) event sent over the WebSocket
+// connection.
+type WebSocketEvent struct {
+	Type    string          `json:"type"`
+	AppEUI  lorawan.EUI64   `json:"appEUI"`
+	DevEUI  lorawan.EUI64   `json:"devEUI"`
+	Payload json.RawMessage `json:"payload"`
+}
+
+// EventStreamWebSocketHandler implements a http.Handler streaming the live
+// events of a node over a WebSocket connection (e.g. for the live device
+// console of the web interface). The DevEUI is taken from the devEUI route
+// variable.
+type EventStreamWebSocketHandler struct {
+	ctx       common.Context
+	validator auth.Validator
+	stream    *handler.StreamHandler
+}
+
+// NewEventStreamWebSocketHandler creates a new EventStreamWebSocketHandler.
+// The events are received from the given StreamHandler.
+func NewEventStreamWebSocketHandler(ctx common.Context, validator auth.Validator, stream *handler.StreamHandler) *EventStreamWebSocketHandler {
+	return &EventStreamWebSocketHandler{
+		ctx:       ctx,
+		validator: validator,
+		stream:    stream,
+	}
+}
+
+// ServeHTTP validates the request and upgrades it to a WebSocket connection.
+// As browsers can't set headers on WebSocket requests, the token can be
+// given using the token query parameter.
+func (h *EventStreamWebSocketHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var devEUI lorawan.EUI64
+	if err := devEUI.UnmarshalText([]byte(mux.Vars(r)["devEUI"])); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// the token is validated before looking up the node, so that the
+	// response doesn't reveal if the node exists
+	ctx := metadata.NewContext(context.Background(), metadata.Pairs("authorization", webSocketToken(r)))
+	if err := h.validator.Validate(ctx); err != nil {
+		http.Error(w, "authentication failed: "+err.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	node, err := storage.GetNode(h.ctx.DB, devEUI)
+	if err != nil {
+		if err == storage.ErrNodeDoesNotExist {
+			http.Error(w, "authentication failed", http.StatusUnauthorized)
+			return
+		}
+		log.WithField("dev_eui", devEUI).Errorf("get node error: %s", err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	if err := h.validator.Validate(ctx,
+		auth.ValidateAPIMethod("EventStream.Subscribe"),
+		auth.ValidateApplication(node.AppEUI),
+		auth.ValidateNode(node.DevEUI),
+	); err != nil {
+		http.Error(w, "authentication failed", http.StatusUnauthorized)
+		return
+	}
+
+	var types []string
+	for _, t := range r.URL.Query()["types"] {
+		types = append(types, strings.Split(t, ",")...)
+	}
+
+	sub, err := h.stream.Subscribe(node.AppEUI, &node.DevEUI, types)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+	defer sub.Close()
+
+	websocket.Server{
+		// authorization is based on the token, not on the origin
+		Handshake: func(*websocket.Config, *http.Request) error { return nil },
+		Handler: func(ws *websocket.Conn) {
+			h.serve(ws, sub)
+		},
+	}.ServeHTTP(w, r)
+}
+
+// serve writes the events of the given subscription to the given
+// connection, until the connection or the subscription is closed.
+func (h *EventStreamWebSocketHandler) serve(ws *websocket.Conn, sub *handler.StreamSubscription) {
+	// the client is not expected to send data, reading returns an error
+	// once the connection has been closed
+	closed := make(chan struct{})
+	go func() {
+		io.Copy(ioutil.Discard, ws)
+		close(closed)
+	}()
+
+	for {
+		select {
+		case <-closed:
+			return
+		case e, ok := <-sub.Events():
+			if !ok {
+				ws.Close()
+				return
+			}
+			ws.SetWriteDeadline(time.Now().Add(webSocketWriteTimeout))
+			err := websocket.JSON.Send(ws, WebSocketEvent{
+				Type:    e.Type,
+				AppEUI:  e.AppEUI,
+				DevEUI:  e.DevEUI,
+				Payload: e.Payload,
+			})
+			if err != nil {
+				log.WithField("dev_eui", e.DevEUI).Warningf("send websocket event error: %s", err)
+				ws.Close()
+				return
+			}
+		}
+	}
+}
+
+// webSocketToken returns the token of the given WebSocket request, from the
+// token query parameter or the (Grpc-Metadata-)Authorization header.
+func webSocketToken(r *http.Request) string {
+	if token := r.URL.Query().Get("token"); token != "" {
+		return token
+	}
+	if token := r.Header.Get("Grpc-Metadata-Authorization"); token != "" {
+		return token
+	}
+	return r.Header.Get("Authorization")
+}
@@ -0,0 +1,96 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	. "github.com/smartystreets/goconvey/convey"
+	"golang.org/x/net/websocket"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/brocaar/lora-app-server/integration"
+	"github.com/brocaar/lora-app-server/internal/common"
+	"github.com/brocaar/lora-app-server/internal/handler"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lora-app-server/internal/test"
+	"github.com/brocaar/lorawan"
+)
+
+func TestEventStreamWebSocketHandler(t *testing.T) {
+	conf := test.GetConfig()
+
+	Convey("Given a clean database, a node and a websocket server", t, func() {
+		db, err := storage.OpenDatabase(conf.PostgresDSN)
+		So(err, ShouldBeNil)
+		test.MustResetDB(db)
+
+		node := storage.Node{
+			DevEUI: lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+			AppEUI: lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1},
+		}
+		So(storage.CreateNode(db, node), ShouldBeNil)
+
+		validator := &TestValidator{}
+		stream := handler.NewStreamHandler()
+		r := mux.NewRouter()
+		r.Handle("/api/devices/{devEUI}/events/live", NewEventStreamWebSocketHandler(common.Context{DB: db}, validator, stream))
+		server := httptest.NewServer(r)
+		defer server.Close()
+
+		url := "ws" + strings.TrimPrefix(server.URL, "http") + "/api/devices/0102030405060708/events/live?token=secret"
+
+		Convey("When connecting to the websocket endpoint", func() {
+			ws, err := websocket.Dial(url, "", server.URL)
+			So(err, ShouldBeNil)
+			defer ws.Close()
+
+			Convey("Then the token was validated", func() {
+				md, ok := metadata.FromContext(validator.ctx)
+				So(ok, ShouldBeTrue)
+				So(md["authorization"], ShouldResemble, []string{"secret"})
+				So(validator.validatorFuncs, ShouldHaveLength, 3)
+			})
+
+			Convey("Then the events of the node are received", func() {
+				pl := integration.DataUpPayload{DevEUI: node.DevEUI, FCnt: 10}
+				So(stream.SendDataUp(node.AppEUI, node.DevEUI, pl), ShouldBeNil)
+
+				var e WebSocketEvent
+				So(websocket.JSON.Receive(ws, &e), ShouldBeNil)
+				So(e.Type, ShouldEqual, integration.EventDataUp)
+				So(e.DevEUI, ShouldEqual, node.DevEUI)
+
+				var received integration.DataUpPayload
+				So(json.Unmarshal(e.Payload, &received), ShouldBeNil)
+				So(received, ShouldResemble, pl)
+			})
+		})
+
+		Convey("When connecting for a node that does not exist", func() {
+			resp, err := http.Get(server.URL + "/api/devices/0807060504030201/events/live?token=secret")
+			So(err, ShouldBeNil)
+
+			Convey("Then the connection is rejected as unauthorized", func() {
+				So(resp.StatusCode, ShouldEqual, http.StatusUnauthorized)
+			})
+		})
+
+		Convey("When the validation fails", func() {
+			validator.returnError = errors.New("boom")
+
+			Convey("Then the connection is rejected", func() {
+				_, err := websocket.Dial(url, "", server.URL)
+				So(err, ShouldNotBeNil)
+
+				resp, err := http.Get(server.URL + "/api/devices/0102030405060708/events/live")
+				So(err, ShouldBeNil)
+				So(resp.StatusCode, ShouldEqual, http.StatusUnauthorized)
+			})
+		})
+	})
+}
@@ -0,0 +1,224 @@
+package api
+
+import (
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/brocaar/lora-app-server/api"
+	"github.com/brocaar/lora-app-server/internal/api/auth"
+	"github.com/brocaar/lora-app-server/internal/common"
+	"github.com/brocaar/lora-app-server/internal/fuota"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+// maxFragSize defines the maximum fragment size, given the maximum
+// (application) payload size of 242 bytes and the DataFragment header.
+const maxFragSize = 239
+
+// FUOTADeploymentAPI exports the FUOTA deployment related functions.
+type FUOTADeploymentAPI struct {
+	ctx       common.Context
+	validator auth.Validator
+}
+
+// NewFUOTADeploymentAPI creates a new FUOTADeploymentAPI.
+func NewFUOTADeploymentAPI(ctx common.Context, validator auth.Validator) *FUOTADeploymentAPI {
+	return &FUOTADeploymentAPI{
+		ctx:       ctx,
+		validator: validator,
+	}
+}
+
+// Create creates the given deployment.
+func (a *FUOTADeploymentAPI) Create(ctx context.Context, req *pb.CreateFUOTADeploymentRequest) (*pb.CreateFUOTADeploymentResponse, error) {
+	g, err := storage.GetMulticastGroup(a.ctx.DB, req.MulticastGroupID)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("FUOTADeployment.Create"),
+		auth.ValidateApplication(g.AppEUI),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	d := storage.FUOTADeployment{
+		Name:             req.Name,
+		AppEUI:           g.AppEUI,
+		MulticastGroupID: g.ID,
+		FPort:            uint8(req.FPort),
+		Firmware:         req.Firmware,
+		Descriptor:       req.FirmwareDescriptor,
+		FragSize:         int(req.FragSize),
+		Redundancy:       int(req.Redundancy),
+		State:            storage.FUOTADeploymentScheduled,
+		StartAt:          time.Now(),
+		Timeout:          int(req.Timeout),
+	}
+
+	if d.Name == "" {
+		return nil, grpc.Errorf(codes.InvalidArgument, "name must be set")
+	}
+	if req.FPort == 0 {
+		d.FPort = fuota.DefaultFPort
+	} else if req.FPort > 223 {
+		return nil, grpc.Errorf(codes.InvalidArgument, "fPort must be between 1 and 223")
+	}
+	if d.FragSize == 0 || d.FragSize > maxFragSize {
+		return nil, grpc.Errorf(codes.InvalidArgument, "fragSize must be between 1 and %d", maxFragSize)
+	}
+	if _, _, err := fuota.Fragment(d.Firmware, d.FragSize, d.Redundancy); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "firmware: %s", err)
+	}
+	if d.Timeout == 0 {
+		return nil, grpc.Errorf(codes.InvalidArgument, "timeout must be set")
+	}
+	if req.StartAt != "" {
+		if d.StartAt, err = time.Parse(time.RFC3339, req.StartAt); err != nil {
+			return nil, grpc.Errorf(codes.InvalidArgument, "startAt: %s", err)
+		}
+	}
+
+	if err := storage.CreateFUOTADeployment(a.ctx.DB, &d); err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+	return &pb.CreateFUOTADeploymentResponse{Id: d.ID}, nil
+}
+
+// Get returns the deployment matching the given id.
+func (a *FUOTADeploymentAPI) Get(ctx context.Context, req *pb.GetFUOTADeploymentRequest) (*pb.GetFUOTADeploymentResponse, error) {
+	d, err := storage.GetFUOTADeployment(a.ctx.DB, req.Id)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("FUOTADeployment.Get"),
+		auth.ValidateApplication(d.AppEUI),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	return fuotaDeploymentToResponse(d), nil
+}
+
+// ListByAppEUI lists the deployments of the given application, newest
+// first.
+func (a *FUOTADeploymentAPI) ListByAppEUI(ctx context.Context, req *pb.ListFUOTADeploymentByAppEUIRequest) (*pb.ListFUOTADeploymentResponse, error) {
+	var appEUI lorawan.EUI64
+	if err := appEUI.UnmarshalText([]byte(req.AppEUI)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("FUOTADeployment.ListByAppEUI"),
+		auth.ValidateApplication(appEUI),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	deployments, err := storage.GetFUOTADeploymentsForAppEUI(a.ctx.DB, appEUI)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+
+	var resp pb.ListFUOTADeploymentResponse
+	for _, d := range deployments {
+		resp.Result = append(resp.Result, fuotaDeploymentToResponse(d))
+	}
+	return &resp, nil
+}
+
+// Delete deletes the deployment matching the given id. The remaining items
+// of the deployment are removed from the queues of the nodes.
+func (a *FUOTADeploymentAPI) Delete(ctx context.Context, req *pb.DeleteFUOTADeploymentRequest) (*pb.DeleteFUOTADeploymentResponse, error) {
+	d, err := storage.GetFUOTADeployment(a.ctx.DB, req.Id)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("FUOTADeployment.Delete"),
+		auth.ValidateApplication(d.AppEUI),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	nodes, err := storage.GetFUOTADeploymentNodes(a.ctx.DB, d.ID)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+	for _, n := range nodes {
+		if err := storage.FlushFUOTAQueueItems(a.ctx.DB, n.DevEUI, fuota.ReferencePrefix(d.ID)); err != nil {
+			return nil, grpc.Errorf(codes.Unknown, err.Error())
+		}
+	}
+
+	if err := storage.DeleteFUOTADeployment(a.ctx.DB, d.ID); err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+	return &pb.DeleteFUOTADeploymentResponse{}, nil
+}
+
+// ListNodes lists the update state of the nodes of the deployment.
+func (a *FUOTADeploymentAPI) ListNodes(ctx context.Context, req *pb.ListFUOTADeploymentNodesRequest) (*pb.ListFUOTADeploymentNodesResponse, error) {
+	d, err := storage.GetFUOTADeployment(a.ctx.DB, req.Id)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("FUOTADeployment.ListNodes"),
+		auth.ValidateApplication(d.AppEUI),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	nodes, err := storage.GetFUOTADeploymentNodes(a.ctx.DB, d.ID)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+
+	var resp pb.ListFUOTADeploymentNodesResponse
+	for _, n := range nodes {
+		resp.Result = append(resp.Result, &pb.FUOTADeploymentNode{
+			DevEUI:         n.DevEUI.String(),
+			State:          n.State,
+			NbFragReceived: uint32(n.NbFragReceived),
+			MissingFrag:    uint32(n.MissingFrag),
+			Error:          n.Error,
+			UpdatedAt:      n.UpdatedAt.Format(time.RFC3339),
+		})
+	}
+	return &resp, nil
+}
+
+// fuotaDeploymentToResponse returns the API representation of the given
+// FUOTADeployment.
+func fuotaDeploymentToResponse(d storage.FUOTADeployment) *pb.GetFUOTADeploymentResponse {
+	resp := pb.GetFUOTADeploymentResponse{
+		Id:                 d.ID,
+		Name:               d.Name,
+		AppEUI:             d.AppEUI.String(),
+		MulticastGroupID:   d.MulticastGroupID,
+		FirmwareSize:       uint32(d.FirmwareSize),
+		FirmwareDescriptor: d.Descriptor,
+		FragSize:           uint32(d.FragSize),
+		Redundancy:         uint32(d.Redundancy),
+		FPort:              uint32(d.FPort),
+		State:              d.State,
+		StartAt:            d.StartAt.Format(time.RFC3339),
+		Timeout:            uint32(d.Timeout),
+		CreatedAt:          d.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:          d.UpdatedAt.Format(time.RFC3339),
+	}
+	if d.Deadline != nil {
+		resp.Deadline = d.Deadline.Format(time.RFC3339)
+	}
+	return &resp
+}
@@ -0,0 +1,270 @@
+package api
+
+import (
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/brocaar/lora-app-server/api"
+	"github.com/brocaar/lora-app-server/internal/api/auth"
+	"github.com/brocaar/lora-app-server/internal/common"
+	"github.com/brocaar/lora-app-server/internal/gateway"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+// GatewayAPI exports the gateway related functions.
+type GatewayAPI struct {
+	ctx       common.Context
+	validator auth.Validator
+}
+
+// NewGatewayAPI creates a new GatewayAPI.
+func NewGatewayAPI(ctx common.Context, validator auth.Validator) *GatewayAPI {
+	return &GatewayAPI{
+		ctx:       ctx,
+		validator: validator,
+	}
+}
+
+// Create creates the given gateway.
+func (a *GatewayAPI) Create(ctx context.Context, req *pb.CreateGatewayRequest) (*pb.CreateGatewayResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("Gateway.Create"),
+		auth.ValidateOrganization(req.OrganizationID),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	gw := storage.Gateway{
+		Name:           req.Name,
+		Description:    req.Description,
+		Latitude:       req.Latitude,
+		Longitude:      req.Longitude,
+		Altitude:       req.Altitude,
+		OrganizationID: &req.OrganizationID,
+	}
+	if err := gw.MAC.UnmarshalText([]byte(req.Mac)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, err.Error())
+	}
+	if gw.Name == "" {
+		return nil, grpc.Errorf(codes.InvalidArgument, "name must be set")
+	}
+	if req.OrganizationID == 0 {
+		return nil, grpc.Errorf(codes.InvalidArgument, "organizationID must be set")
+	}
+
+	if err := storage.CreateGateway(a.ctx.DB, &gw); err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+	return &pb.CreateGatewayResponse{}, nil
+}
+
+// Get returns the gateway matching the given MAC.
+func (a *GatewayAPI) Get(ctx context.Context, req *pb.GetGatewayRequest) (*pb.GetGatewayResponse, error) {
+	var mac lorawan.EUI64
+	if err := mac.UnmarshalText([]byte(req.Mac)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	gw, err := storage.GetGateway(a.ctx.DB, mac)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("Gateway.Get"),
+		auth.ValidateGatewayOrganization(gw.OrganizationID),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	return gatewayToResponse(gw), nil
+}
+
+// List lists the gateways of the given organization, or all the gateways
+// when no organization is given (given a limit and offset).
+func (a *GatewayAPI) List(ctx context.Context, req *pb.ListGatewayRequest) (*pb.ListGatewayResponse, error) {
+	var organizationID *int64
+	if req.OrganizationID != 0 {
+		organizationID = &req.OrganizationID
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("Gateway.List"),
+		auth.ValidateGatewayOrganization(organizationID),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	var gws []storage.Gateway
+	var count int
+	var err error
+	if organizationID != nil {
+		gws, err = storage.GetOrganizationGateways(a.ctx.DB, *organizationID, int(req.Limit), int(req.Offset))
+		if err == nil {
+			count, err = storage.GetOrganizationGatewaysCount(a.ctx.DB, *organizationID)
+		}
+	} else {
+		gws, err = storage.GetGateways(a.ctx.DB, int(req.Limit), int(req.Offset))
+		if err == nil {
+			count, err = storage.GetGatewaysCount(a.ctx.DB)
+		}
+	}
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+
+	resp := pb.ListGatewayResponse{
+		TotalCount: int64(count),
+	}
+	for _, gw := range gws {
+		resp.Result = append(resp.Result, gatewayToResponse(gw))
+	}
+	return &resp, nil
+}
+
+// Update updates the gateway matching the given MAC.
+func (a *GatewayAPI) Update(ctx context.Context, req *pb.UpdateGatewayRequest) (*pb.UpdateGatewayResponse, error) {
+	var mac lorawan.EUI64
+	if err := mac.UnmarshalText([]byte(req.Mac)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	gw, err := storage.GetGateway(a.ctx.DB, mac)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+
+	// moving the gateway to an other organization requires permission to
+	// both organizations
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("Gateway.Update"),
+		auth.ValidateGatewayOrganization(gw.OrganizationID),
+		auth.ValidateOrganization(req.OrganizationID),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	gw.Name = req.Name
+	gw.Description = req.Description
+	gw.Latitude = req.Latitude
+	gw.Longitude = req.Longitude
+	gw.Altitude = req.Altitude
+	gw.OrganizationID = &req.OrganizationID
+	if gw.Name == "" {
+		return nil, grpc.Errorf(codes.InvalidArgument, "name must be set")
+	}
+	if req.OrganizationID == 0 {
+		return nil, grpc.Errorf(codes.InvalidArgument, "organizationID must be set")
+	}
+
+	if err := storage.UpdateGateway(a.ctx.DB, gw); err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+	return &pb.UpdateGatewayResponse{}, nil
+}
+
+// Delete deletes the gateway matching the given MAC.
+func (a *GatewayAPI) Delete(ctx context.Context, req *pb.DeleteGatewayRequest) (*pb.DeleteGatewayResponse, error) {
+	var mac lorawan.EUI64
+	if err := mac.UnmarshalText([]byte(req.Mac)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	organizationID, err := storage.GetGatewayOrganizationID(a.ctx.DB, mac)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("Gateway.Delete"),
+		auth.ValidateGatewayOrganization(organizationID),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if err := storage.DeleteGateway(a.ctx.DB, mac); err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+	return &pb.DeleteGatewayResponse{}, nil
+}
+
+// GetStats returns the statistics of the gateway matching the given MAC.
+func (a *GatewayAPI) GetStats(ctx context.Context, req *pb.GetGatewayStatsRequest) (*pb.GetGatewayStatsResponse, error) {
+	var mac lorawan.EUI64
+	if err := mac.UnmarshalText([]byte(req.Mac)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	organizationID, err := storage.GetGatewayOrganizationID(a.ctx.DB, mac)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("Gateway.GetStats"),
+		auth.ValidateGatewayOrganization(organizationID),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	start, end, err := parsePeriod(req.Start, req.End)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	var interval storage.AggregationInterval
+	switch req.Interval {
+	case pb.AggregationInterval_PERIOD:
+		interval = storage.AggregatePeriod
+	case pb.AggregationInterval_HOUR:
+		interval = storage.AggregateHour
+	case pb.AggregationInterval_DAY:
+		interval = storage.AggregateDay
+	default:
+		return nil, grpc.Errorf(codes.InvalidArgument, "invalid interval: %s", req.Interval)
+	}
+
+	stats, err := storage.GetGatewayStats(a.ctx.DB, mac, start, end, interval)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+
+	var resp pb.GetGatewayStatsResponse
+	for _, s := range stats {
+		pl := gateway.NewStatsPayload(s)
+		resp.Result = append(resp.Result, &pb.GatewayStats{
+			Timestamp:         pl.Time.Format(time.RFC3339),
+			RxPacketsReceived: uint32(pl.RXPacketsReceived),
+			AvgRSSI:           pl.AvgRSSI,
+			AvgLoRaSNR:        pl.AvgLoRaSNR,
+			TxPacketsEmitted:  uint32(pl.TXPacketsEmitted),
+			TxAirtime:         uint32(pl.TXAirtime),
+		})
+	}
+	return &resp, nil
+}
+
+// gatewayToResponse returns the API representation of the given Gateway.
+func gatewayToResponse(gw storage.Gateway) *pb.GetGatewayResponse {
+	resp := pb.GetGatewayResponse{
+		Mac:         gw.MAC.String(),
+		Name:        gw.Name,
+		Description: gw.Description,
+		Latitude:    gw.Latitude,
+		Longitude:   gw.Longitude,
+		Altitude:    gw.Altitude,
+		CreatedAt:   gw.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:   gw.UpdatedAt.Format(time.RFC3339),
+	}
+	if gw.LastSeenAt != nil {
+		resp.LastSeenAt = gw.LastSeenAt.Format(time.RFC3339)
+	}
+	if gw.OrganizationID != nil {
+		resp.OrganizationID = *gw.OrganizationID
+	}
+	return &resp
+}
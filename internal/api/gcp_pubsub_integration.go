@@ -0,0 +1,179 @@
+package api
+
+import (
+	"encoding/json"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/brocaar/lora-app-server/api"
+	"github.com/brocaar/lora-app-server/internal/api/auth"
+	"github.com/brocaar/lora-app-server/internal/common"
+	"github.com/brocaar/lora-app-server/internal/handler"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+// gcpPubSubIntegrationRequest defines the (shared) fields of the create
+// and update requests.
+type gcpPubSubIntegrationRequest interface {
+	GetAppEUI() string
+	GetProjectID() string
+	GetTopic() string
+	GetCredentialsJSON() string
+}
+
+// GCPPubSubIntegrationAPI exports the Google Cloud Pub/Sub integration
+// related functions.
+type GCPPubSubIntegrationAPI struct {
+	ctx       common.Context
+	validator auth.Validator
+}
+
+// NewGCPPubSubIntegrationAPI creates a new GCPPubSubIntegrationAPI.
+func NewGCPPubSubIntegrationAPI(ctx common.Context, validator auth.Validator) *GCPPubSubIntegrationAPI {
+	return &GCPPubSubIntegrationAPI{
+		ctx:       ctx,
+		validator: validator,
+	}
+}
+
+// Create creates the Pub/Sub integration of the given application.
+func (a *GCPPubSubIntegrationAPI) Create(ctx context.Context, req *pb.CreateGCPPubSubIntegrationRequest) (*pb.CreateGCPPubSubIntegrationResponse, error) {
+	i, err := getGCPPubSubIntegration(req)
+	if err != nil {
+		return nil, err
+	}
+	if i.CredentialsJSON == "" {
+		return nil, grpc.Errorf(codes.InvalidArgument, "credentialsJSON must be set")
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("GCPPubSubIntegration.Create"),
+		auth.ValidateApplication(i.AppEUI),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if err := storage.CreateGCPPubSubIntegration(a.ctx.DB, i); err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+	return &pb.CreateGCPPubSubIntegrationResponse{}, nil
+}
+
+// Get returns the Pub/Sub integration of the given application. The
+// credentials are not returned.
+func (a *GCPPubSubIntegrationAPI) Get(ctx context.Context, req *pb.GetGCPPubSubIntegrationRequest) (*pb.GetGCPPubSubIntegrationResponse, error) {
+	var appEUI lorawan.EUI64
+	if err := appEUI.UnmarshalText([]byte(req.AppEUI)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("GCPPubSubIntegration.Get"),
+		auth.ValidateApplication(appEUI),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	i, err := storage.GetGCPPubSubIntegration(a.ctx.DB, appEUI)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+	if i == nil {
+		return nil, grpc.Errorf(codes.NotFound, "gcp pubsub integration %s does not exist", appEUI)
+	}
+
+	var sa struct {
+		ClientEmail string `json:"client_email"`
+	}
+	json.Unmarshal([]byte(i.CredentialsJSON), &sa)
+
+	return &pb.GetGCPPubSubIntegrationResponse{
+		AppEUI:      i.AppEUI.String(),
+		ProjectID:   i.ProjectID,
+		Topic:       i.Topic,
+		ClientEmail: sa.ClientEmail,
+	}, nil
+}
+
+// Update updates the Pub/Sub integration of the given application. When
+// no credentials are given, the current credentials are kept.
+func (a *GCPPubSubIntegrationAPI) Update(ctx context.Context, req *pb.UpdateGCPPubSubIntegrationRequest) (*pb.UpdateGCPPubSubIntegrationResponse, error) {
+	i, err := getGCPPubSubIntegration(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("GCPPubSubIntegration.Update"),
+		auth.ValidateApplication(i.AppEUI),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if i.CredentialsJSON == "" {
+		current, err := storage.GetGCPPubSubIntegration(a.ctx.DB, i.AppEUI)
+		if err != nil {
+			return nil, grpc.Errorf(codes.Unknown, err.Error())
+		}
+		if current == nil {
+			return nil, grpc.Errorf(codes.NotFound, "gcp pubsub integration %s does not exist", i.AppEUI)
+		}
+		i.CredentialsJSON = current.CredentialsJSON
+	}
+
+	if err := storage.UpdateGCPPubSubIntegration(a.ctx.DB, i); err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+	return &pb.UpdateGCPPubSubIntegrationResponse{}, nil
+}
+
+// Delete deletes the Pub/Sub integration of the given application.
+func (a *GCPPubSubIntegrationAPI) Delete(ctx context.Context, req *pb.DeleteGCPPubSubIntegrationRequest) (*pb.DeleteGCPPubSubIntegrationResponse, error) {
+	var appEUI lorawan.EUI64
+	if err := appEUI.UnmarshalText([]byte(req.AppEUI)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("GCPPubSubIntegration.Delete"),
+		auth.ValidateApplication(appEUI),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if err := storage.DeleteGCPPubSubIntegration(a.ctx.DB, appEUI); err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+	return &pb.DeleteGCPPubSubIntegrationResponse{}, nil
+}
+
+// getGCPPubSubIntegration validates the given request and returns the
+// GCPPubSubIntegration.
+func getGCPPubSubIntegration(req gcpPubSubIntegrationRequest) (storage.GCPPubSubIntegration, error) {
+	i := storage.GCPPubSubIntegration{
+		ProjectID:       req.GetProjectID(),
+		Topic:           req.GetTopic(),
+		CredentialsJSON: storage.EncryptedString(req.GetCredentialsJSON()),
+	}
+
+	if err := i.AppEUI.UnmarshalText([]byte(req.GetAppEUI())); err != nil {
+		return i, grpc.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	if i.ProjectID == "" {
+		return i, grpc.Errorf(codes.InvalidArgument, "projectID must be set")
+	}
+	if i.Topic == "" {
+		return i, grpc.Errorf(codes.InvalidArgument, "topic must be set")
+	}
+	if i.CredentialsJSON != "" {
+		if err := handler.ValidateGCPCredentials(string(i.CredentialsJSON)); err != nil {
+			return i, grpc.Errorf(codes.InvalidArgument, "credentialsJSON: %s", err)
+		}
+	}
+
+	return i, nil
+}
@@ -0,0 +1,169 @@
+package api
+
+import (
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/brocaar/lora-app-server/api"
+	"github.com/brocaar/lora-app-server/internal/api/auth"
+	"github.com/brocaar/lora-app-server/internal/common"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+// handlerBackendRequest defines the (shared) fields of the create and update
+// requests.
+type handlerBackendRequest interface {
+	GetAppEUI() string
+	GetBackends() []string
+}
+
+// HandlerBackendAPI exports the handler backend related functions.
+type HandlerBackendAPI struct {
+	ctx       common.Context
+	validator auth.Validator
+	backends  []string
+}
+
+// NewHandlerBackendAPI creates a new HandlerBackendAPI. The given backends
+// are the names of the enabled handler backends.
+func NewHandlerBackendAPI(ctx common.Context, validator auth.Validator, backends []string) *HandlerBackendAPI {
+	return &HandlerBackendAPI{
+		ctx:       ctx,
+		validator: validator,
+		backends:  backends,
+	}
+}
+
+// List lists the enabled handler backends.
+func (a *HandlerBackendAPI) List(ctx context.Context, req *pb.ListHandlerBackendRequest) (*pb.ListHandlerBackendResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("HandlerBackend.List"),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	return &pb.ListHandlerBackendResponse{
+		Backends: a.backends,
+	}, nil
+}
+
+// Create sets the handler backends of the given application.
+func (a *HandlerBackendAPI) Create(ctx context.Context, req *pb.CreateHandlerBackendRequest) (*pb.CreateHandlerBackendResponse, error) {
+	b, err := a.getHandlerBackend(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("HandlerBackend.Create"),
+		auth.ValidateApplication(b.AppEUI),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if err := storage.CreateHandlerBackend(a.ctx.DB, b); err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+	return &pb.CreateHandlerBackendResponse{}, nil
+}
+
+// Get returns the handler backends of the given application.
+func (a *HandlerBackendAPI) Get(ctx context.Context, req *pb.GetHandlerBackendRequest) (*pb.GetHandlerBackendResponse, error) {
+	var appEUI lorawan.EUI64
+	if err := appEUI.UnmarshalText([]byte(req.AppEUI)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("HandlerBackend.Get"),
+		auth.ValidateApplication(appEUI),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	b, err := storage.GetHandlerBackend(a.ctx.DB, appEUI)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+	if b == nil {
+		return nil, grpc.Errorf(codes.NotFound, "handler backends of application %s are not set", appEUI)
+	}
+
+	return &pb.GetHandlerBackendResponse{
+		AppEUI:   b.AppEUI.String(),
+		Backends: b.Backends,
+	}, nil
+}
+
+// Update updates the handler backends of the given application.
+func (a *HandlerBackendAPI) Update(ctx context.Context, req *pb.UpdateHandlerBackendRequest) (*pb.UpdateHandlerBackendResponse, error) {
+	b, err := a.getHandlerBackend(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("HandlerBackend.Update"),
+		auth.ValidateApplication(b.AppEUI),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if err := storage.UpdateHandlerBackend(a.ctx.DB, b); err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+	return &pb.UpdateHandlerBackendResponse{}, nil
+}
+
+// Delete deletes the handler backends of the given application, after which
+// the events of the application are sent to all the enabled backends.
+func (a *HandlerBackendAPI) Delete(ctx context.Context, req *pb.DeleteHandlerBackendRequest) (*pb.DeleteHandlerBackendResponse, error) {
+	var appEUI lorawan.EUI64
+	if err := appEUI.UnmarshalText([]byte(req.AppEUI)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("HandlerBackend.Delete"),
+		auth.ValidateApplication(appEUI),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if err := storage.DeleteHandlerBackend(a.ctx.DB, appEUI); err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+	return &pb.DeleteHandlerBackendResponse{}, nil
+}
+
+// getHandlerBackend validates the given request and returns the
+// HandlerBackend.
+func (a *HandlerBackendAPI) getHandlerBackend(req handlerBackendRequest) (storage.HandlerBackend, error) {
+	b := storage.HandlerBackend{
+		Backends: req.GetBackends(),
+	}
+
+	if err := b.AppEUI.UnmarshalText([]byte(req.GetAppEUI())); err != nil {
+		return b, grpc.Errorf(codes.InvalidArgument, err.Error())
+	}
+	if len(b.Backends) == 0 {
+		return b, grpc.Errorf(codes.InvalidArgument, "backends: at least one backend is required")
+	}
+
+	for _, name := range b.Backends {
+		var enabled bool
+		for _, n := range a.backends {
+			if n == name {
+				enabled = true
+				break
+			}
+		}
+		if !enabled {
+			return b, grpc.Errorf(codes.InvalidArgument, "backends: %s is not enabled (enabled: %v)", name, a.backends)
+		}
+	}
+
+	return b, nil
+}
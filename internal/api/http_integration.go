@@ -0,0 +1,182 @@
+package api
+
+import (
+	"sort"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/brocaar/lora-app-server/api"
+	"github.com/brocaar/lora-app-server/internal/api/auth"
+	"github.com/brocaar/lora-app-server/internal/common"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+// httpIntegrationRequest defines the (shared) fields of the create and
+// update requests.
+type httpIntegrationRequest interface {
+	GetAppEUI() string
+	GetHeaders() []*pb.HTTPIntegrationHeader
+	GetDataUpURL() string
+	GetJoinNotificationURL() string
+	GetAckNotificationURL() string
+	GetErrorNotificationURL() string
+}
+
+// HTTPIntegrationAPI exports the HTTP integration related functions.
+type HTTPIntegrationAPI struct {
+	ctx       common.Context
+	validator auth.Validator
+}
+
+// NewHTTPIntegrationAPI creates a new HTTPIntegrationAPI.
+func NewHTTPIntegrationAPI(ctx common.Context, validator auth.Validator) *HTTPIntegrationAPI {
+	return &HTTPIntegrationAPI{
+		ctx:       ctx,
+		validator: validator,
+	}
+}
+
+// Create creates the HTTP integration of the given application.
+func (a *HTTPIntegrationAPI) Create(ctx context.Context, req *pb.CreateHTTPIntegrationRequest) (*pb.CreateHTTPIntegrationResponse, error) {
+	i, err := getHTTPIntegration(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("HTTPIntegration.Create"),
+		auth.ValidateApplication(i.AppEUI),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if err := storage.CreateHTTPIntegration(a.ctx.DB, i); err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+	return &pb.CreateHTTPIntegrationResponse{}, nil
+}
+
+// Get returns the HTTP integration of the given application.
+func (a *HTTPIntegrationAPI) Get(ctx context.Context, req *pb.GetHTTPIntegrationRequest) (*pb.GetHTTPIntegrationResponse, error) {
+	var appEUI lorawan.EUI64
+	if err := appEUI.UnmarshalText([]byte(req.AppEUI)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("HTTPIntegration.Get"),
+		auth.ValidateApplication(appEUI),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	i, err := storage.GetHTTPIntegration(a.ctx.DB, appEUI)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+	if i == nil {
+		return nil, grpc.Errorf(codes.NotFound, "http integration %s does not exist", appEUI)
+	}
+
+	resp := pb.GetHTTPIntegrationResponse{
+		AppEUI:               i.AppEUI.String(),
+		DataUpURL:            i.DataUpURL,
+		JoinNotificationURL:  i.JoinNotificationURL,
+		AckNotificationURL:   i.ACKNotificationURL,
+		ErrorNotificationURL: i.ErrorNotificationURL,
+	}
+	var keys []string
+	for k := range i.Headers {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		resp.Headers = append(resp.Headers, &pb.HTTPIntegrationHeader{
+			Key:   k,
+			Value: i.Headers[k],
+		})
+	}
+	return &resp, nil
+}
+
+// Update updates the HTTP integration of the given application.
+func (a *HTTPIntegrationAPI) Update(ctx context.Context, req *pb.UpdateHTTPIntegrationRequest) (*pb.UpdateHTTPIntegrationResponse, error) {
+	i, err := getHTTPIntegration(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("HTTPIntegration.Update"),
+		auth.ValidateApplication(i.AppEUI),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if err := storage.UpdateHTTPIntegration(a.ctx.DB, i); err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+	return &pb.UpdateHTTPIntegrationResponse{}, nil
+}
+
+// Delete deletes the HTTP integration of the given application.
+func (a *HTTPIntegrationAPI) Delete(ctx context.Context, req *pb.DeleteHTTPIntegrationRequest) (*pb.DeleteHTTPIntegrationResponse, error) {
+	var appEUI lorawan.EUI64
+	if err := appEUI.UnmarshalText([]byte(req.AppEUI)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("HTTPIntegration.Delete"),
+		auth.ValidateApplication(appEUI),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if err := storage.DeleteHTTPIntegration(a.ctx.DB, appEUI); err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+	return &pb.DeleteHTTPIntegrationResponse{}, nil
+}
+
+// getHTTPIntegration validates the given request and returns the
+// HTTPIntegration.
+func getHTTPIntegration(req httpIntegrationRequest) (storage.HTTPIntegration, error) {
+	i := storage.HTTPIntegration{
+		Headers:              make(storage.HTTPHeaders),
+		DataUpURL:            req.GetDataUpURL(),
+		JoinNotificationURL:  req.GetJoinNotificationURL(),
+		ACKNotificationURL:   req.GetAckNotificationURL(),
+		ErrorNotificationURL: req.GetErrorNotificationURL(),
+	}
+
+	if err := i.AppEUI.UnmarshalText([]byte(req.GetAppEUI())); err != nil {
+		return i, grpc.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	for name, u := range map[string]string{
+		"dataUpURL":            i.DataUpURL,
+		"joinNotificationURL":  i.JoinNotificationURL,
+		"ackNotificationURL":   i.ACKNotificationURL,
+		"errorNotificationURL": i.ErrorNotificationURL,
+	} {
+		if u == "" {
+			continue
+		}
+		if err := validateNotificationURL(u); err != nil {
+			return i, grpc.Errorf(codes.InvalidArgument, "%s: %s", name, err)
+		}
+	}
+
+	for _, h := range req.GetHeaders() {
+		if h.Key == "" {
+			return i, grpc.Errorf(codes.InvalidArgument, "header key must be set")
+		}
+		i.Headers[h.Key] = h.Value
+	}
+
+	return i, nil
+}
@@ -0,0 +1,165 @@
+package api
+
+import (
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/brocaar/lora-app-server/api"
+	"github.com/brocaar/lora-app-server/internal/api/auth"
+	"github.com/brocaar/lora-app-server/internal/common"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+// defaultInfluxDBMeasurement defines the measurement used when no
+// measurement is given.
+const defaultInfluxDBMeasurement = "uplink"
+
+// influxDBIntegrationRequest defines the (shared) fields of the create and
+// update requests.
+type influxDBIntegrationRequest interface {
+	GetAppEUI() string
+	GetEndpoint() string
+	GetOrganization() string
+	GetBucket() string
+	GetToken() string
+	GetMeasurement() string
+}
+
+// InfluxDBIntegrationAPI exports the InfluxDB integration related functions.
+type InfluxDBIntegrationAPI struct {
+	ctx       common.Context
+	validator auth.Validator
+}
+
+// NewInfluxDBIntegrationAPI creates a new InfluxDBIntegrationAPI.
+func NewInfluxDBIntegrationAPI(ctx common.Context, validator auth.Validator) *InfluxDBIntegrationAPI {
+	return &InfluxDBIntegrationAPI{
+		ctx:       ctx,
+		validator: validator,
+	}
+}
+
+// Create creates the InfluxDB integration of the given application.
+func (a *InfluxDBIntegrationAPI) Create(ctx context.Context, req *pb.CreateInfluxDBIntegrationRequest) (*pb.CreateInfluxDBIntegrationResponse, error) {
+	i, err := getInfluxDBIntegration(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("InfluxDBIntegration.Create"),
+		auth.ValidateApplication(i.AppEUI),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if err := storage.CreateInfluxDBIntegration(a.ctx.DB, i); err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+	return &pb.CreateInfluxDBIntegrationResponse{}, nil
+}
+
+// Get returns the InfluxDB integration of the given application.
+func (a *InfluxDBIntegrationAPI) Get(ctx context.Context, req *pb.GetInfluxDBIntegrationRequest) (*pb.GetInfluxDBIntegrationResponse, error) {
+	var appEUI lorawan.EUI64
+	if err := appEUI.UnmarshalText([]byte(req.AppEUI)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("InfluxDBIntegration.Get"),
+		auth.ValidateApplication(appEUI),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	i, err := storage.GetInfluxDBIntegration(a.ctx.DB, appEUI)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+	if i == nil {
+		return nil, grpc.Errorf(codes.NotFound, "influxdb integration %s does not exist", appEUI)
+	}
+
+	return &pb.GetInfluxDBIntegrationResponse{
+		AppEUI:       i.AppEUI.String(),
+		Endpoint:     i.Endpoint,
+		Organization: i.Organization,
+		Bucket:       i.Bucket,
+		Token:        i.Token,
+		Measurement:  i.Measurement,
+	}, nil
+}
+
+// Update updates the InfluxDB integration of the given application.
+func (a *InfluxDBIntegrationAPI) Update(ctx context.Context, req *pb.UpdateInfluxDBIntegrationRequest) (*pb.UpdateInfluxDBIntegrationResponse, error) {
+	i, err := getInfluxDBIntegration(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("InfluxDBIntegration.Update"),
+		auth.ValidateApplication(i.AppEUI),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if err := storage.UpdateInfluxDBIntegration(a.ctx.DB, i); err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+	return &pb.UpdateInfluxDBIntegrationResponse{}, nil
+}
+
+// Delete deletes the InfluxDB integration of the given application.
+func (a *InfluxDBIntegrationAPI) Delete(ctx context.Context, req *pb.DeleteInfluxDBIntegrationRequest) (*pb.DeleteInfluxDBIntegrationResponse, error) {
+	var appEUI lorawan.EUI64
+	if err := appEUI.UnmarshalText([]byte(req.AppEUI)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("InfluxDBIntegration.Delete"),
+		auth.ValidateApplication(appEUI),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if err := storage.DeleteInfluxDBIntegration(a.ctx.DB, appEUI); err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+	return &pb.DeleteInfluxDBIntegrationResponse{}, nil
+}
+
+// getInfluxDBIntegration validates the given request and returns the
+// InfluxDBIntegration.
+func getInfluxDBIntegration(req influxDBIntegrationRequest) (storage.InfluxDBIntegration, error) {
+	i := storage.InfluxDBIntegration{
+		Endpoint:     req.GetEndpoint(),
+		Organization: req.GetOrganization(),
+		Bucket:       req.GetBucket(),
+		Token:        req.GetToken(),
+		Measurement:  req.GetMeasurement(),
+	}
+
+	if err := i.AppEUI.UnmarshalText([]byte(req.GetAppEUI())); err != nil {
+		return i, grpc.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	if err := validateNotificationURL(i.Endpoint); err != nil {
+		return i, grpc.Errorf(codes.InvalidArgument, "endpoint: %s", err)
+	}
+	if i.Organization == "" {
+		return i, grpc.Errorf(codes.InvalidArgument, "organization must be set")
+	}
+	if i.Bucket == "" {
+		return i, grpc.Errorf(codes.InvalidArgument, "bucket must be set")
+	}
+	if i.Measurement == "" {
+		i.Measurement = defaultInfluxDBMeasurement
+	}
+
+	return i, nil
+}
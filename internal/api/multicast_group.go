@@ -0,0 +1,351 @@
+package api
+
+import (
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/brocaar/lora-app-server/api"
+	"github.com/brocaar/lora-app-server/internal/api/auth"
+	"github.com/brocaar/lora-app-server/internal/common"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+// multicastGroupRequest defines the (shared) fields of the create and update
+// requests.
+type multicastGroupRequest interface {
+	GetName() string
+	GetMcAddr() string
+	GetMcNwkSKey() string
+	GetMcAppSKey() string
+	GetDr() uint32
+	GetFrequency() uint32
+}
+
+// MulticastGroupAPI exports the multicast group related functions.
+type MulticastGroupAPI struct {
+	ctx       common.Context
+	validator auth.Validator
+}
+
+// NewMulticastGroupAPI creates a new MulticastGroupAPI.
+func NewMulticastGroupAPI(ctx common.Context, validator auth.Validator) *MulticastGroupAPI {
+	return &MulticastGroupAPI{
+		ctx:       ctx,
+		validator: validator,
+	}
+}
+
+// Create creates the given multicast group.
+func (a *MulticastGroupAPI) Create(ctx context.Context, req *pb.CreateMulticastGroupRequest) (*pb.CreateMulticastGroupResponse, error) {
+	g, err := getMulticastGroup(req)
+	if err != nil {
+		return nil, err
+	}
+	if err := g.AppEUI.UnmarshalText([]byte(req.AppEUI)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("MulticastGroup.Create"),
+		auth.ValidateApplication(g.AppEUI),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if err := storage.CreateMulticastGroup(a.ctx.DB, &g); err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+	return &pb.CreateMulticastGroupResponse{Id: g.ID}, nil
+}
+
+// Get returns the multicast group matching the given id.
+func (a *MulticastGroupAPI) Get(ctx context.Context, req *pb.GetMulticastGroupRequest) (*pb.GetMulticastGroupResponse, error) {
+	g, err := storage.GetMulticastGroup(a.ctx.DB, req.Id)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("MulticastGroup.Get"),
+		auth.ValidateApplication(g.AppEUI),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	return multicastGroupToResponse(g), nil
+}
+
+// ListByAppEUI lists the multicast groups of the given application.
+func (a *MulticastGroupAPI) ListByAppEUI(ctx context.Context, req *pb.ListMulticastGroupByAppEUIRequest) (*pb.ListMulticastGroupResponse, error) {
+	var appEUI lorawan.EUI64
+	if err := appEUI.UnmarshalText([]byte(req.AppEUI)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("MulticastGroup.ListByAppEUI"),
+		auth.ValidateApplication(appEUI),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	groups, err := storage.GetMulticastGroupsForAppEUI(a.ctx.DB, appEUI)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+
+	var resp pb.ListMulticastGroupResponse
+	for _, g := range groups {
+		resp.Result = append(resp.Result, multicastGroupToResponse(g))
+	}
+	return &resp, nil
+}
+
+// Update updates the multicast group matching the given id.
+func (a *MulticastGroupAPI) Update(ctx context.Context, req *pb.UpdateMulticastGroupRequest) (*pb.UpdateMulticastGroupResponse, error) {
+	current, err := storage.GetMulticastGroup(a.ctx.DB, req.Id)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("MulticastGroup.Update"),
+		auth.ValidateApplication(current.AppEUI),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	g, err := getMulticastGroup(req)
+	if err != nil {
+		return nil, err
+	}
+	g.ID = current.ID
+	g.AppEUI = current.AppEUI
+
+	if err := storage.UpdateMulticastGroup(a.ctx.DB, g); err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+	return &pb.UpdateMulticastGroupResponse{}, nil
+}
+
+// Delete deletes the multicast group matching the given id.
+func (a *MulticastGroupAPI) Delete(ctx context.Context, req *pb.DeleteMulticastGroupRequest) (*pb.DeleteMulticastGroupResponse, error) {
+	g, err := storage.GetMulticastGroup(a.ctx.DB, req.Id)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("MulticastGroup.Delete"),
+		auth.ValidateApplication(g.AppEUI),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if err := storage.DeleteMulticastGroup(a.ctx.DB, g.ID); err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+	return &pb.DeleteMulticastGroupResponse{}, nil
+}
+
+// AddNode assigns the given node to the multicast group. The node must
+// belong to the application of the multicast group.
+func (a *MulticastGroupAPI) AddNode(ctx context.Context, req *pb.AddNodeToMulticastGroupRequest) (*pb.AddNodeToMulticastGroupResponse, error) {
+	var devEUI lorawan.EUI64
+	if err := devEUI.UnmarshalText([]byte(req.DevEUI)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	g, err := storage.GetMulticastGroup(a.ctx.DB, req.Id)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("MulticastGroup.AddNode"),
+		auth.ValidateApplication(g.AppEUI),
+		auth.ValidateNode(devEUI),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	node, err := storage.GetNode(a.ctx.DB, devEUI)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+	if node.AppEUI != g.AppEUI {
+		return nil, grpc.Errorf(codes.InvalidArgument, "node %s does not belong to application %s", devEUI, g.AppEUI)
+	}
+
+	if err := storage.AddNodeToMulticastGroup(a.ctx.DB, g.ID, devEUI); err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+	return &pb.AddNodeToMulticastGroupResponse{}, nil
+}
+
+// RemoveNode removes the given node from the multicast group.
+func (a *MulticastGroupAPI) RemoveNode(ctx context.Context, req *pb.RemoveNodeFromMulticastGroupRequest) (*pb.RemoveNodeFromMulticastGroupResponse, error) {
+	var devEUI lorawan.EUI64
+	if err := devEUI.UnmarshalText([]byte(req.DevEUI)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	g, err := storage.GetMulticastGroup(a.ctx.DB, req.Id)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("MulticastGroup.RemoveNode"),
+		auth.ValidateApplication(g.AppEUI),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if err := storage.RemoveNodeFromMulticastGroup(a.ctx.DB, g.ID, devEUI); err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+	return &pb.RemoveNodeFromMulticastGroupResponse{}, nil
+}
+
+// ListNodes lists the nodes assigned to the multicast group.
+func (a *MulticastGroupAPI) ListNodes(ctx context.Context, req *pb.ListMulticastGroupNodesRequest) (*pb.ListMulticastGroupNodesResponse, error) {
+	g, err := storage.GetMulticastGroup(a.ctx.DB, req.Id)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("MulticastGroup.ListNodes"),
+		auth.ValidateApplication(g.AppEUI),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	devEUIs, err := storage.GetMulticastGroupNodes(a.ctx.DB, g.ID)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+
+	var resp pb.ListMulticastGroupNodesResponse
+	for _, devEUI := range devEUIs {
+		resp.DevEUIs = append(resp.DevEUIs, devEUI.String())
+	}
+	return &resp, nil
+}
+
+// Enqueue adds the given (unconfirmed) payload to the downlink queue of
+// each node assigned to the multicast group. When the FPort is restricted
+// by a policy for any of the nodes, the user must be one of the allowed
+// principals.
+func (a *MulticastGroupAPI) Enqueue(ctx context.Context, req *pb.EnqueueMulticastQueueItemRequest) (*pb.EnqueueMulticastQueueItemResponse, error) {
+	if req.FPort == 0 || req.FPort > 223 {
+		return nil, grpc.Errorf(codes.InvalidArgument, "fPort must be between 1 and 223")
+	}
+
+	g, err := storage.GetMulticastGroup(a.ctx.DB, req.Id)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+
+	validators := []auth.ValidatorFunc{
+		auth.ValidateAPIMethod("MulticastGroup.Enqueue"),
+		auth.ValidateApplication(g.AppEUI),
+	}
+
+	devEUIs, err := storage.GetMulticastGroupNodes(a.ctx.DB, g.ID)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+	for _, devEUI := range devEUIs {
+		principals, err := storage.GetDownlinkFPortPrincipals(a.ctx.DB, g.AppEUI, devEUI, uint8(req.FPort))
+		if err != nil {
+			return nil, grpc.Errorf(codes.Unknown, err.Error())
+		}
+		if principals != nil {
+			validators = append(validators, auth.ValidatePrincipal(principals))
+		}
+	}
+
+	if err := a.validator.Validate(ctx, validators...); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	// the quota is only used when the items are enqueued
+	tx, err := a.ctx.DB.Beginx()
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+	defer tx.Rollback()
+
+	if err := storage.UseDownlinkQuota(tx, g.AppEUI, len(devEUIs)); err != nil {
+		return nil, quotaError(err)
+	}
+
+	devEUIs, err = storage.CreateMulticastQueueItems(tx, g.ID, storage.DownlinkQueueItem{
+		Reference: req.Reference,
+		FPort:     uint8(req.FPort),
+		Data:      req.Data,
+	})
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+
+	var resp pb.EnqueueMulticastQueueItemResponse
+	for _, devEUI := range devEUIs {
+		resp.DevEUIs = append(resp.DevEUIs, devEUI.String())
+	}
+	return &resp, nil
+}
+
+// getMulticastGroup validates the given request and returns the
+// MulticastGroup (without id and AppEUI).
+func getMulticastGroup(req multicastGroupRequest) (storage.MulticastGroup, error) {
+	g := storage.MulticastGroup{
+		Name:      req.GetName(),
+		DR:        int(req.GetDr()),
+		Frequency: int(req.GetFrequency()),
+	}
+
+	if g.Name == "" {
+		return g, grpc.Errorf(codes.InvalidArgument, "name must be set")
+	}
+	if err := g.McAddr.UnmarshalText([]byte(req.GetMcAddr())); err != nil {
+		return g, grpc.Errorf(codes.InvalidArgument, "mcAddr: %s", err)
+	}
+	if err := g.McNwkSKey.UnmarshalText([]byte(req.GetMcNwkSKey())); err != nil {
+		return g, grpc.Errorf(codes.InvalidArgument, "mcNwkSKey: %s", err)
+	}
+	if err := g.McAppSKey.UnmarshalText([]byte(req.GetMcAppSKey())); err != nil {
+		return g, grpc.Errorf(codes.InvalidArgument, "mcAppSKey: %s", err)
+	}
+	if g.DR > 15 {
+		return g, grpc.Errorf(codes.InvalidArgument, "dr must be between 0 and 15")
+	}
+	if g.Frequency == 0 {
+		return g, grpc.Errorf(codes.InvalidArgument, "frequency must be set")
+	}
+
+	return g, nil
+}
+
+// multicastGroupToResponse returns the API representation of the given
+// MulticastGroup.
+func multicastGroupToResponse(g storage.MulticastGroup) *pb.GetMulticastGroupResponse {
+	return &pb.GetMulticastGroupResponse{
+		Id:        g.ID,
+		Name:      g.Name,
+		AppEUI:    g.AppEUI.String(),
+		McAddr:    g.McAddr.String(),
+		McNwkSKey: g.McNwkSKey.String(),
+		McAppSKey: g.McAppSKey.String(),
+		Dr:        uint32(g.DR),
+		Frequency: uint32(g.Frequency),
+	}
+}
@@ -0,0 +1,256 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	pb "github.com/brocaar/lora-app-server/api"
+	"github.com/brocaar/lora-app-server/internal/common"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lora-app-server/internal/test"
+	"github.com/brocaar/lorawan"
+)
+
+func TestMulticastGroupAPI(t *testing.T) {
+	conf := test.GetConfig()
+
+	Convey("Given a clean database with two nodes and api instance", t, func() {
+		db, err := storage.OpenDatabase(conf.PostgresDSN)
+		So(err, ShouldBeNil)
+		test.MustResetDB(db)
+
+		ctx := context.Background()
+		validator := &TestValidator{}
+		api := NewMulticastGroupAPI(common.Context{DB: db}, validator)
+
+		appEUI := lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}
+		node1 := storage.Node{DevEUI: lorawan.EUI64{1, 1, 1, 1, 1, 1, 1, 1}, AppEUI: appEUI}
+		node2 := storage.Node{DevEUI: lorawan.EUI64{2, 2, 2, 2, 2, 2, 2, 2}, AppEUI: appEUI}
+		So(storage.CreateNode(db, node1), ShouldBeNil)
+		So(storage.CreateNode(db, node2), ShouldBeNil)
+
+		createReq := pb.CreateMulticastGroupRequest{
+			Name:      "group-1",
+			AppEUI:    "0102030405060708",
+			McAddr:    "01020304",
+			McNwkSKey: "01020304050607080102030405060708",
+			McAppSKey: "08070605040302010807060504030201",
+			Dr:        5,
+			Frequency: 869525000,
+		}
+
+		Convey("Given a set of invalid create requests", func() {
+			tests := []struct {
+				Name    string
+				Request func(r *pb.CreateMulticastGroupRequest)
+				Error   string
+			}{
+				{"without name", func(r *pb.CreateMulticastGroupRequest) { r.Name = "" }, "name must be set"},
+				{"invalid mcNwkSKey", func(r *pb.CreateMulticastGroupRequest) { r.McNwkSKey = "0102" }, "mcNwkSKey: lorawan: exactly 16 bytes are expected"},
+				{"invalid dr", func(r *pb.CreateMulticastGroupRequest) { r.Dr = 16 }, "dr must be between 0 and 15"},
+				{"without frequency", func(r *pb.CreateMulticastGroupRequest) { r.Frequency = 0 }, "frequency must be set"},
+			}
+
+			for i, test := range tests {
+				Convey(fmt.Sprintf("Test %d: %s", i, test.Name), func() {
+					req := createReq
+					test.Request(&req)
+					_, err := api.Create(ctx, &req)
+					So(err, ShouldResemble, grpc.Errorf(codes.InvalidArgument, test.Error))
+				})
+			}
+		})
+
+		Convey("When creating a multicast group", func() {
+			createResp, err := api.Create(ctx, &createReq)
+			So(err, ShouldBeNil)
+			So(validator.validatorFuncs, ShouldHaveLength, 2)
+
+			Convey("Then the multicast group can be retrieved", func() {
+				resp, err := api.Get(ctx, &pb.GetMulticastGroupRequest{Id: createResp.Id})
+				So(err, ShouldBeNil)
+				So(validator.validatorFuncs, ShouldHaveLength, 2)
+				So(resp, ShouldResemble, &pb.GetMulticastGroupResponse{
+					Id:        createResp.Id,
+					Name:      "group-1",
+					AppEUI:    "0102030405060708",
+					McAddr:    "01020304",
+					McNwkSKey: "01020304050607080102030405060708",
+					McAppSKey: "08070605040302010807060504030201",
+					Dr:        5,
+					Frequency: 869525000,
+				})
+			})
+
+			Convey("Then the multicast group is listed for the application", func() {
+				resp, err := api.ListByAppEUI(ctx, &pb.ListMulticastGroupByAppEUIRequest{AppEUI: "0102030405060708"})
+				So(err, ShouldBeNil)
+				So(resp.Result, ShouldHaveLength, 1)
+				So(resp.Result[0].Id, ShouldEqual, createResp.Id)
+			})
+
+			Convey("When updating the multicast group", func() {
+				_, err := api.Update(ctx, &pb.UpdateMulticastGroupRequest{
+					Id:        createResp.Id,
+					Name:      "group-2",
+					McAddr:    "04030201",
+					McNwkSKey: "01020304050607080102030405060708",
+					McAppSKey: "08070605040302010807060504030201",
+					Dr:        3,
+					Frequency: 869525000,
+				})
+				So(err, ShouldBeNil)
+
+				Convey("Then the multicast group has been updated", func() {
+					resp, err := api.Get(ctx, &pb.GetMulticastGroupRequest{Id: createResp.Id})
+					So(err, ShouldBeNil)
+					So(resp.Name, ShouldEqual, "group-2")
+					So(resp.McAddr, ShouldEqual, "04030201")
+					So(resp.Dr, ShouldEqual, 3)
+					So(resp.AppEUI, ShouldEqual, "0102030405060708")
+				})
+			})
+
+			Convey("When adding a node of an other application", func() {
+				So(storage.CreateNode(db, storage.Node{
+					DevEUI: lorawan.EUI64{3, 3, 3, 3, 3, 3, 3, 3},
+					AppEUI: lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1},
+				}), ShouldBeNil)
+				_, err := api.AddNode(ctx, &pb.AddNodeToMulticastGroupRequest{
+					Id:     createResp.Id,
+					DevEUI: "0303030303030303",
+				})
+
+				Convey("Then an InvalidArgument error is returned", func() {
+					So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
+				})
+			})
+
+			Convey("When enqueueing a payload using an invalid fPort", func() {
+				_, err := api.Enqueue(ctx, &pb.EnqueueMulticastQueueItemRequest{
+					Id:    createResp.Id,
+					FPort: 0,
+				})
+
+				Convey("Then an InvalidArgument error is returned", func() {
+					So(err, ShouldResemble, grpc.Errorf(codes.InvalidArgument, "fPort must be between 1 and 223"))
+				})
+			})
+
+			Convey("When adding both nodes to the multicast group", func() {
+				for _, devEUI := range []string{"0101010101010101", "0202020202020202"} {
+					_, err := api.AddNode(ctx, &pb.AddNodeToMulticastGroupRequest{
+						Id:     createResp.Id,
+						DevEUI: devEUI,
+					})
+					So(err, ShouldBeNil)
+					So(validator.validatorFuncs, ShouldHaveLength, 3)
+				}
+
+				Convey("Then both nodes are listed", func() {
+					resp, err := api.ListNodes(ctx, &pb.ListMulticastGroupNodesRequest{Id: createResp.Id})
+					So(err, ShouldBeNil)
+					So(resp.DevEUIs, ShouldResemble, []string{"0101010101010101", "0202020202020202"})
+				})
+
+				Convey("When enqueueing a payload", func() {
+					resp, err := api.Enqueue(ctx, &pb.EnqueueMulticastQueueItemRequest{
+						Id:        createResp.Id,
+						Reference: "multicast",
+						FPort:     10,
+						Data:      []byte{1, 2, 3},
+					})
+					So(err, ShouldBeNil)
+					So(validator.validatorFuncs, ShouldHaveLength, 2)
+
+					Convey("Then the payload has been enqueued for both nodes", func() {
+						So(resp.DevEUIs, ShouldHaveLength, 2)
+						for _, devEUI := range []lorawan.EUI64{node1.DevEUI, node2.DevEUI} {
+							items, err := storage.GetDownlinkQueueItems(db, devEUI)
+							So(err, ShouldBeNil)
+							So(items, ShouldHaveLength, 1)
+							So(items[0].Reference, ShouldEqual, "multicast")
+							So(items[0].FPort, ShouldEqual, 10)
+							So(items[0].Data, ShouldResemble, []byte{1, 2, 3})
+						}
+					})
+				})
+
+				Convey("When the fPort is restricted by a policy", func() {
+					So(storage.CreateDownlinkFPortPolicy(db, &storage.DownlinkFPortPolicy{
+						AppEUI:     appEUI,
+						FPort:      10,
+						Principals: []string{"controller"},
+					}), ShouldBeNil)
+
+					Convey("Then enqueueing validates the principal for each node", func() {
+						_, err := api.Enqueue(ctx, &pb.EnqueueMulticastQueueItemRequest{
+							Id:    createResp.Id,
+							FPort: 10,
+							Data:  []byte{1, 2, 3},
+						})
+						So(err, ShouldBeNil)
+						So(validator.validatorFuncs, ShouldHaveLength, 4)
+					})
+				})
+
+				Convey("Given the organization owning the application has a downlink quota of 1", func() {
+					o := storage.Organization{
+						Name:               "customer-1",
+						MaxDownlinksPerDay: 1,
+					}
+					So(storage.CreateOrganization(db, &o), ShouldBeNil)
+					So(storage.AddOrganizationApplication(db, o.ID, appEUI), ShouldBeNil)
+
+					Convey("When enqueueing a payload for both nodes", func() {
+						_, err := api.Enqueue(ctx, &pb.EnqueueMulticastQueueItemRequest{
+							Id:    createResp.Id,
+							FPort: 10,
+							Data:  []byte{1, 2, 3},
+						})
+
+						Convey("Then a ResourceExhausted error is returned and nothing is enqueued", func() {
+							So(grpc.Code(err), ShouldEqual, codes.ResourceExhausted)
+
+							for _, devEUI := range []lorawan.EUI64{node1.DevEUI, node2.DevEUI} {
+								items, err := storage.GetDownlinkQueueItems(db, devEUI)
+								So(err, ShouldBeNil)
+								So(items, ShouldHaveLength, 0)
+							}
+						})
+					})
+				})
+
+				Convey("When removing a node", func() {
+					_, err := api.RemoveNode(ctx, &pb.RemoveNodeFromMulticastGroupRequest{
+						Id:     createResp.Id,
+						DevEUI: "0101010101010101",
+					})
+					So(err, ShouldBeNil)
+
+					Convey("Then only the other node is listed", func() {
+						resp, err := api.ListNodes(ctx, &pb.ListMulticastGroupNodesRequest{Id: createResp.Id})
+						So(err, ShouldBeNil)
+						So(resp.DevEUIs, ShouldResemble, []string{"0202020202020202"})
+					})
+				})
+			})
+
+			Convey("When deleting the multicast group", func() {
+				_, err := api.Delete(ctx, &pb.DeleteMulticastGroupRequest{Id: createResp.Id})
+				So(err, ShouldBeNil)
+
+				Convey("Then the multicast group has been deleted", func() {
+					_, err := api.Get(ctx, &pb.GetMulticastGroupRequest{Id: createResp.Id})
+					So(err, ShouldNotBeNil)
+				})
+			})
+		})
+	})
+}
@@ -1,13 +1,21 @@
 package api
 
 import (
+	"fmt"
+	"sort"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/jmoiron/sqlx"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 
 	pb "github.com/brocaar/lora-app-server/api"
+	"github.com/brocaar/lora-app-server/integration"
 	"github.com/brocaar/lora-app-server/internal/api/auth"
 	"github.com/brocaar/lora-app-server/internal/common"
+	"github.com/brocaar/lora-app-server/internal/nodecsv"
 	"github.com/brocaar/lora-app-server/internal/storage"
 	"github.com/brocaar/loraserver/api/ns"
 	"github.com/brocaar/lorawan"
@@ -30,7 +38,7 @@ func NewNodeAPI(ctx common.Context, validator auth.Validator) *NodeAPI {
 // Create creates the given Node.
 func (a *NodeAPI) Create(ctx context.Context, req *pb.CreateNodeRequest) (*pb.CreateNodeResponse, error) {
 	var appEUI, devEUI lorawan.EUI64
-	var appKey lorawan.AES128Key
+	var appKey storage.EncryptedKey
 
 	if err := appEUI.UnmarshalText([]byte(req.AppEUI)); err != nil {
 		return nil, grpc.Errorf(codes.InvalidArgument, err.Error())
@@ -42,7 +50,9 @@ func (a *NodeAPI) Create(ctx context.Context, req *pb.CreateNodeRequest) (*pb.Cr
 		return nil, grpc.Errorf(codes.InvalidArgument, err.Error())
 	}
 
+	var actor string
 	if err := a.validator.Validate(ctx,
+		auth.SetSubject(&actor),
 		auth.ValidateAPIMethod("Node.Create"),
 		auth.ValidateApplication(appEUI),
 		auth.ValidateNode(devEUI),
@@ -64,15 +74,54 @@ func (a *NodeAPI) Create(ctx context.Context, req *pb.CreateNodeRequest) (*pb.Cr
 
 		ADRInterval:        req.AdrInterval,
 		InstallationMargin: req.InstallationMargin,
+		UplinkInterval:     req.UplinkInterval,
+		E2EEncryption:      req.E2EEncryption,
 	}
 	if req.ChannelListID > 0 {
 		node.ChannelListID = &req.ChannelListID
 	}
+	variables, err := nodeVariablesFromPB(req.Variables)
+	if err != nil {
+		return nil, err
+	}
+	node.Variables = variables
+
+	// the quota is checked and the node is created in a single
+	// transaction, see CheckNodeQuota
+	tx, err := a.ctx.DB.Beginx()
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+	defer tx.Rollback()
+
+	if err := storage.CheckNodeQuota(tx, appEUI, 1); err != nil {
+		return nil, quotaError(err)
+	}
 
-	if err := storage.CreateNode(a.ctx.DB, node); err != nil {
+	if err := storage.CreateNode(tx, node); err != nil {
 		return nil, grpc.Errorf(codes.Unknown, err.Error())
 	}
 
+	if err := tx.Commit(); err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+
+	recordAudit(a.ctx.DB, storage.AuditLog{
+		Actor:      actor,
+		Action:     "Node.Create",
+		ObjectType: storage.AuditObjectNode,
+		ObjectID:   node.DevEUI.String(),
+		AppEUI:     &node.AppEUI,
+	}, nil)
+
+	sendLifecycleNotification(a.ctx, integration.LifecycleNotification{
+		Entity: integration.LifecycleEntityNode,
+		Action: integration.LifecycleActionCreate,
+		DevEUI: node.DevEUI,
+		AppEUI: node.AppEUI,
+		Name:   node.Name,
+	})
+
 	return &pb.CreateNodeResponse{}, nil
 }
 
@@ -121,8 +170,15 @@ func (a *NodeAPI) Get(ctx context.Context, req *pb.GetNodeRequest) (*pb.GetNodeR
 		RelaxFCnt:          node.RelaxFCnt,
 		AdrInterval:        node.ADRInterval,
 		InstallationMargin: node.InstallationMargin,
+		UplinkInterval:     node.UplinkInterval,
+		LinkScore:          linkScoreToPB(node.LinkScore),
+		Variables:          nodeVariablesToPB(node.Variables),
+		E2EEncryption:      node.E2EEncryption,
 	}
 
+	setDeviceStatusPB(&resp, node)
+	setLocationPB(&resp, node)
+
 	if node.ChannelListID != nil {
 		resp.ChannelListID = *node.ChannelListID
 	}
@@ -138,7 +194,19 @@ func (a *NodeAPI) List(ctx context.Context, req *pb.ListNodeRequest) (*pb.ListNo
 		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
 	}
 
-	nodes, err := storage.GetNodes(a.ctx.DB, int(req.Limit), int(req.Offset))
+	var order storage.NodeOrder
+	switch req.OrderBy {
+	case pb.NodeOrderBy_DEV_EUI:
+		order = storage.OrderByDevEUI
+	case pb.NodeOrderBy_NAME:
+		order = storage.OrderByName
+	case pb.NodeOrderBy_LINK_SCORE:
+		order = storage.OrderByLinkScore
+	default:
+		return nil, grpc.Errorf(codes.InvalidArgument, "invalid orderBy: %s", req.OrderBy)
+	}
+
+	nodes, err := storage.GetNodes(a.ctx.DB, int(req.Limit), int(req.Offset), order)
 	if err != nil {
 		return nil, grpc.Errorf(codes.Internal, err.Error())
 	}
@@ -149,7 +217,7 @@ func (a *NodeAPI) List(ctx context.Context, req *pb.ListNodeRequest) (*pb.ListNo
 // Update updates the node matching the given DevEUI.
 func (a *NodeAPI) Update(ctx context.Context, req *pb.UpdateNodeRequest) (*pb.UpdateNodeResponse, error) {
 	var appEUI, devEUI lorawan.EUI64
-	var appKey lorawan.AES128Key
+	var appKey storage.EncryptedKey
 
 	if err := appEUI.UnmarshalText([]byte(req.AppEUI)); err != nil {
 		return nil, grpc.Errorf(codes.InvalidArgument, err.Error())
@@ -161,7 +229,9 @@ func (a *NodeAPI) Update(ctx context.Context, req *pb.UpdateNodeRequest) (*pb.Up
 		return nil, grpc.Errorf(codes.InvalidArgument, err.Error())
 	}
 
+	var actor string
 	if err := a.validator.Validate(ctx,
+		auth.SetSubject(&actor),
 		auth.ValidateAPIMethod("Node.Update"),
 		auth.ValidateApplication(appEUI),
 		auth.ValidateNode(devEUI),
@@ -174,6 +244,34 @@ func (a *NodeAPI) Update(ctx context.Context, req *pb.UpdateNodeRequest) (*pb.Up
 		return nil, grpc.Errorf(codes.Unknown, err.Error())
 	}
 
+	previousAppEUI := node.AppEUI
+	appKeyChanged := node.AppKey != appKey
+
+	var checkQuota bool
+
+	// when moving the node to an other application, the user must also have
+	// permission to the current application and the node counts against
+	// the quota of the organization of the new application
+	if previousAppEUI != appEUI {
+		if err := a.validator.Validate(ctx,
+			auth.ValidateAPIMethod("Node.Update"),
+			auth.ValidateApplication(previousAppEUI),
+			auth.ValidateNode(devEUI),
+		); err != nil {
+			return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+		}
+
+		previousOrg, err := storage.GetOrganizationForAppEUI(a.ctx.DB, previousAppEUI)
+		if err != nil {
+			return nil, grpc.Errorf(codes.Unknown, err.Error())
+		}
+		org, err := storage.GetOrganizationForAppEUI(a.ctx.DB, appEUI)
+		if err != nil {
+			return nil, grpc.Errorf(codes.Unknown, err.Error())
+		}
+		checkQuota = org != nil && (previousOrg == nil || previousOrg.ID != org.ID)
+	}
+
 	node.Name = req.Name
 	node.AppEUI = appEUI
 	node.AppKey = appKey
@@ -184,15 +282,75 @@ func (a *NodeAPI) Update(ctx context.Context, req *pb.UpdateNodeRequest) (*pb.Up
 	node.RelaxFCnt = req.RelaxFCnt
 	node.ADRInterval = req.AdrInterval
 	node.InstallationMargin = req.InstallationMargin
+	node.UplinkInterval = req.UplinkInterval
+	// the session keys of the other mode must not be used anymore, the
+	// node-session must be updated by the application
+	e2eChanged := node.E2EEncryption != req.E2EEncryption
+	if e2eChanged {
+		node.E2EEncryption = req.E2EEncryption
+		node.AppSKey = storage.EncryptedKey{}
+		node.AppSKeyEnvelope = nil
+	}
 	if req.ChannelListID > 0 {
 		node.ChannelListID = &req.ChannelListID
 	} else {
 		node.ChannelListID = nil
 	}
+	node.Variables, err = nodeVariablesFromPB(req.Variables)
+	if err != nil {
+		return nil, err
+	}
 
-	if err := storage.UpdateNode(a.ctx.DB, node); err != nil {
+	// the quota is checked and the node is updated in a single
+	// transaction, see CheckNodeQuota
+	tx, err := a.ctx.DB.Beginx()
+	if err != nil {
 		return nil, grpc.Errorf(codes.Unknown, err.Error())
 	}
+	defer tx.Rollback()
+
+	if checkQuota {
+		if err := storage.CheckNodeQuota(tx, appEUI, 1); err != nil {
+			return nil, quotaError(err)
+		}
+	}
+
+	if err := storage.UpdateNode(tx, node); err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+
+	details := map[string]interface{}{
+		"appKeyChanged": appKeyChanged,
+	}
+	if previousAppEUI != node.AppEUI {
+		details["previousAppEUI"] = previousAppEUI
+	}
+	if e2eChanged {
+		details["e2eEncryption"] = node.E2EEncryption
+	}
+	recordAudit(a.ctx.DB, storage.AuditLog{
+		Actor:      actor,
+		Action:     "Node.Update",
+		ObjectType: storage.AuditObjectNode,
+		ObjectID:   node.DevEUI.String(),
+		AppEUI:     &node.AppEUI,
+	}, details)
+
+	n := integration.LifecycleNotification{
+		Entity: integration.LifecycleEntityNode,
+		Action: integration.LifecycleActionUpdate,
+		DevEUI: node.DevEUI,
+		AppEUI: node.AppEUI,
+		Name:   node.Name,
+	}
+	if previousAppEUI != node.AppEUI {
+		n.PreviousAppEUI = &previousAppEUI
+	}
+	sendLifecycleNotification(a.ctx, n)
 
 	return &pb.UpdateNodeResponse{}, nil
 }
@@ -211,7 +369,9 @@ func (a *NodeAPI) Delete(ctx context.Context, req *pb.DeleteNodeRequest) (*pb.De
 		return nil, grpc.Errorf(codes.Unknown, err.Error())
 	}
 
+	var actor string
 	if err := a.validator.Validate(ctx,
+		auth.SetSubject(&actor),
 		auth.ValidateAPIMethod("Node.Delete"),
 		auth.ValidateApplication(node.AppEUI),
 		auth.ValidateNode(node.DevEUI),
@@ -223,14 +383,191 @@ func (a *NodeAPI) Delete(ctx context.Context, req *pb.DeleteNodeRequest) (*pb.De
 		return nil, grpc.Errorf(codes.Unknown, err.Error())
 	}
 
+	recordAudit(a.ctx.DB, storage.AuditLog{
+		Actor:      actor,
+		Action:     "Node.Delete",
+		ObjectType: storage.AuditObjectNode,
+		ObjectID:   node.DevEUI.String(),
+		AppEUI:     &node.AppEUI,
+	}, nil)
+
 	// try to delete the node-session
 	_, _ = a.ctx.NetworkServer.DeleteNodeSession(context.Background(), &ns.DeleteNodeSessionRequest{
 		DevEUI: eui[:],
 	})
 
+	sendLifecycleNotification(a.ctx, integration.LifecycleNotification{
+		Entity: integration.LifecycleEntityNode,
+		Action: integration.LifecycleActionDelete,
+		DevEUI: node.DevEUI,
+		AppEUI: node.AppEUI,
+		Name:   node.Name,
+	})
+
 	return &pb.DeleteNodeResponse{}, nil
 }
 
+// Export exports the nodes of the given application as CSV.
+func (a *NodeAPI) Export(ctx context.Context, req *pb.ExportNodesRequest) (*pb.ExportNodesResponse, error) {
+	var appEUI lorawan.EUI64
+	if err := appEUI.UnmarshalText([]byte(req.AppEUI)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("Node.Export"),
+		auth.ValidateApplication(appEUI),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	nodes, err := storage.GetNodesForAppEUI(a.ctx.DB, appEUI)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+
+	b, err := nodecsv.Export(nodes)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Internal, err.Error())
+	}
+	return &pb.ExportNodesResponse{Csv: b}, nil
+}
+
+// Import creates the nodes of the given CSV within the given application.
+// Each row is validated (including the permission to the DevEUI and the
+// existence of the node and channel-list), invalid rows are skipped and
+// returned as errors.
+func (a *NodeAPI) Import(ctx context.Context, req *pb.ImportNodesRequest) (*pb.ImportNodesResponse, error) {
+	var appEUI lorawan.EUI64
+	if err := appEUI.UnmarshalText([]byte(req.AppEUI)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	var actor string
+	if err := a.validator.Validate(ctx,
+		auth.SetSubject(&actor),
+		auth.ValidateAPIMethod("Node.Import"),
+		auth.ValidateApplication(appEUI),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	rows, err := nodecsv.Parse(req.Csv, appEUI)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "csv: %s", err)
+	}
+
+	var devEUIs []lorawan.EUI64
+	for _, row := range rows {
+		if row.Error == nil {
+			devEUIs = append(devEUIs, row.Node.DevEUI)
+		}
+	}
+	existing, err := storage.GetExistingDevEUIs(a.ctx.DB, devEUIs)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+	exists := make(map[lorawan.EUI64]bool)
+	for _, devEUI := range existing {
+		exists[devEUI] = true
+	}
+
+	// errors of the channel-list lookups, by channel-list id
+	channelLists := make(map[int64]error)
+
+	resp := pb.ImportNodesResponse{
+		Total: uint32(len(rows)),
+	}
+
+	for i := range rows {
+		row := &rows[i]
+
+		if row.Error == nil && exists[row.Node.DevEUI] {
+			row.Error = fmt.Errorf("node %s already exists", row.Node.DevEUI)
+		}
+		if row.Error == nil {
+			if err := a.validator.Validate(ctx,
+				auth.ValidateAPIMethod("Node.Import"),
+				auth.ValidateApplication(appEUI),
+				auth.ValidateNode(row.Node.DevEUI),
+			); err != nil {
+				row.Error = fmt.Errorf("authentication failed: %s", err)
+			}
+		}
+		if row.Error == nil && row.Node.ChannelListID != nil {
+			id := *row.Node.ChannelListID
+			if _, ok := channelLists[id]; !ok {
+				_, channelLists[id] = storage.GetChannelList(a.ctx.DB, id)
+			}
+			row.Error = channelLists[id]
+		}
+		if row.Error == nil && req.DryRun {
+			// on a dry-run, the nodes of the previous rows are not created
+			row.Error = storage.CheckNodeQuota(a.ctx.DB, appEUI, 1+int(resp.Imported))
+		}
+		if row.Error == nil && !req.DryRun {
+			if err := createNodeWithinQuota(a.ctx.DB, row.Node); err != nil {
+				row.Error = err
+			} else {
+				recordAudit(a.ctx.DB, storage.AuditLog{
+					Actor:      actor,
+					Action:     "Node.Import",
+					ObjectType: storage.AuditObjectNode,
+					ObjectID:   row.Node.DevEUI.String(),
+					AppEUI:     &row.Node.AppEUI,
+				}, nil)
+				sendLifecycleNotification(a.ctx, integration.LifecycleNotification{
+					Entity: integration.LifecycleEntityNode,
+					Action: integration.LifecycleActionCreate,
+					DevEUI: row.Node.DevEUI,
+					AppEUI: row.Node.AppEUI,
+					Name:   row.Node.Name,
+				})
+			}
+		}
+
+		if row.Error != nil {
+			e := pb.ImportNodesError{
+				Row:   uint32(row.Row),
+				Error: row.Error.Error(),
+			}
+			if row.Node.DevEUI != (lorawan.EUI64{}) {
+				e.DevEUI = row.Node.DevEUI.String()
+			}
+			resp.Errors = append(resp.Errors, &e)
+			continue
+		}
+		resp.Imported++
+	}
+
+	log.WithFields(log.Fields{
+		"app_eui":  appEUI,
+		"total":    resp.Total,
+		"imported": resp.Imported,
+		"dry_run":  req.DryRun,
+	}).Info("nodes imported")
+
+	return &resp, nil
+}
+
+// createNodeWithinQuota creates the given node when this doesn't exceed
+// the node quota of the organization (see CheckNodeQuota).
+func createNodeWithinQuota(db *sqlx.DB, n storage.Node) error {
+	tx, err := db.Beginx()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := storage.CheckNodeQuota(tx, n.AppEUI, 1); err != nil {
+		return err
+	}
+	if err := storage.CreateNode(tx, n); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
 func (a *NodeAPI) returnList(count int, nodes []storage.Node) (*pb.ListNodeResponse, error) {
 	resp := pb.ListNodeResponse{
 		TotalCount: int64(count),
@@ -261,8 +598,15 @@ func (a *NodeAPI) returnList(count int, nodes []storage.Node) (*pb.ListNodeRespo
 			RelaxFCnt:          node.RelaxFCnt,
 			AdrInterval:        node.ADRInterval,
 			InstallationMargin: node.InstallationMargin,
+			UplinkInterval:     node.UplinkInterval,
+			LinkScore:          linkScoreToPB(node.LinkScore),
+			Variables:          nodeVariablesToPB(node.Variables),
+			E2EEncryption:      node.E2EEncryption,
 		}
 
+		setDeviceStatusPB(&item, node)
+		setLocationPB(&item, node)
+
 		if node.ChannelListID != nil {
 			item.ChannelListID = *node.ChannelListID
 		}
@@ -271,3 +615,97 @@ func (a *NodeAPI) returnList(count int, nodes []storage.Node) (*pb.ListNodeRespo
 	}
 	return &resp, nil
 }
+
+// sendLifecycleNotification sends the given lifecycle notification to the
+// application and to the lifecycle webhooks (when configured). As the change
+// has already been stored, errors are only logged.
+func sendLifecycleNotification(ctx common.Context, n integration.LifecycleNotification) {
+	n.Time = time.Now()
+
+	if err := ctx.Handler.SendLifecycleNotification(n.AppEUI, n.DevEUI, n); err != nil {
+		log.WithField("dev_eui", n.DevEUI).Errorf("send lifecycle notification error: %s", err)
+	}
+	if ctx.Lifecycle != nil {
+		if err := ctx.Lifecycle.Publish(n); err != nil {
+			log.WithField("dev_eui", n.DevEUI).Errorf("publish lifecycle notification error: %s", err)
+		}
+	}
+}
+
+// linkScoreToPB returns the given link-quality score, or -1 when unknown.
+func linkScoreToPB(score *int) int32 {
+	if score == nil {
+		return -1
+	}
+	return int32(*score)
+}
+
+// nodeVariablesFromPB returns the node variables for the given variables.
+func nodeVariablesFromPB(vars []*pb.NodeVariable) (storage.NodeVariables, error) {
+	variables := make(storage.NodeVariables)
+	for _, v := range vars {
+		if v.Key == "" {
+			return nil, grpc.Errorf(codes.InvalidArgument, "variable key must be set")
+		}
+		variables[v.Key] = v.Value
+	}
+	return variables, nil
+}
+
+// nodeVariablesToPB returns the given node variables, sorted by key.
+func nodeVariablesToPB(variables storage.NodeVariables) []*pb.NodeVariable {
+	var keys []string
+	for k := range variables {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var out []*pb.NodeVariable
+	for _, k := range keys {
+		out = append(out, &pb.NodeVariable{
+			Key:   k,
+			Value: variables[k],
+		})
+	}
+	return out
+}
+
+// setDeviceStatusPB sets the device status fields of the given response,
+// using -1 for the unknown battery values.
+func setDeviceStatusPB(resp *pb.GetNodeResponse, node storage.Node) {
+	resp.Battery = -1
+	resp.BatteryLevel = -1
+
+	if node.Battery != nil {
+		resp.Battery = int32(*node.Battery)
+		if level := storage.BatteryLevel(*node.Battery); level != nil {
+			resp.BatteryLevel = *level
+		}
+	}
+	if node.LinkMargin != nil {
+		resp.LinkMargin = int32(*node.LinkMargin)
+	}
+	if node.DeviceStatusAt != nil {
+		resp.DeviceStatusAt = node.DeviceStatusAt.Format(time.RFC3339)
+	}
+}
+
+// setLocationPB sets the location fields of the given response.
+func setLocationPB(resp *pb.GetNodeResponse, node storage.Node) {
+	if node.LocationAt == nil {
+		return
+	}
+	resp.LocationAt = node.LocationAt.Format(time.RFC3339)
+	if node.Latitude != nil {
+		resp.Latitude = *node.Latitude
+	}
+	if node.Longitude != nil {
+		resp.Longitude = *node.Longitude
+	}
+	if node.Altitude != nil {
+		resp.Altitude = *node.Altitude
+	}
+	if node.LocationAccuracy != nil {
+		resp.LocationAccuracy = *node.LocationAccuracy
+	}
+}
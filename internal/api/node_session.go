@@ -1,6 +1,8 @@
 package api
 
 import (
+	"bytes"
+
 	log "github.com/Sirupsen/logrus"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
@@ -32,7 +34,7 @@ func NewNodeSessionAPI(ctx common.Context, validator auth.Validator) *NodeSessio
 func (n *NodeSessionAPI) Create(ctx context.Context, req *pb.CreateNodeSessionRequest) (*pb.CreateNodeSessionResponse, error) {
 	var devAddr lorawan.DevAddr
 	var appEUI, devEUI lorawan.EUI64
-	var appSKey, nwkSKey lorawan.AES128Key
+	var nwkSKey lorawan.AES128Key
 
 	if err := devAddr.UnmarshalText([]byte(req.DevAddr)); err != nil {
 		return nil, grpc.Errorf(codes.InvalidArgument, "devAddr: %s", err)
@@ -43,14 +45,13 @@ func (n *NodeSessionAPI) Create(ctx context.Context, req *pb.CreateNodeSessionRe
 	if err := devEUI.UnmarshalText([]byte(req.DevEUI)); err != nil {
 		return nil, grpc.Errorf(codes.InvalidArgument, "devEUI: %s", err)
 	}
-	if err := appSKey.UnmarshalText([]byte(req.AppSKey)); err != nil {
-		return nil, grpc.Errorf(codes.InvalidArgument, "appSKey: %s", err)
-	}
 	if err := nwkSKey.UnmarshalText([]byte(req.NwkSKey)); err != nil {
 		return nil, grpc.Errorf(codes.InvalidArgument, "nwkSKey: %s", err)
 	}
 
+	var actor string
 	if err := n.validator.Validate(ctx,
+		auth.SetSubject(&actor),
 		auth.ValidateAPIMethod("NodeSession.Create"),
 		auth.ValidateApplication(appEUI),
 		auth.ValidateNode(devEUI),
@@ -67,6 +68,11 @@ func (n *NodeSessionAPI) Create(ctx context.Context, req *pb.CreateNodeSessionRe
 		return nil, grpc.Errorf(codes.InvalidArgument, "node belongs to a different AppEUI")
 	}
 
+	appSKey, appSKeyEnvelope, err := appSKeyFromPB(node, req.AppSKey, req.AppSKeyEnvelope)
+	if err != nil {
+		return nil, err
+	}
+
 	_, err = n.ctx.NetworkServer.CreateNodeSession(context.Background(), &ns.CreateNodeSessionRequest{
 		DevAddr:            devAddr[:],
 		AppEUI:             appEUI[:],
@@ -88,6 +94,7 @@ func (n *NodeSessionAPI) Create(ctx context.Context, req *pb.CreateNodeSessionRe
 	}
 
 	node.AppSKey = appSKey
+	node.AppSKeyEnvelope = appSKeyEnvelope
 	node.DevAddr = devAddr
 	if err := storage.UpdateNode(n.ctx.DB, node); err != nil {
 		return nil, grpc.Errorf(codes.Internal, "update node error: %s", err)
@@ -99,6 +106,16 @@ func (n *NodeSessionAPI) Create(ctx context.Context, req *pb.CreateNodeSessionRe
 		"dev_eui":  devEUI,
 	}).Info("node-session created")
 
+	recordAudit(n.ctx.DB, storage.AuditLog{
+		Actor:      actor,
+		Action:     "NodeSession.Create",
+		ObjectType: storage.AuditObjectNodeSession,
+		ObjectID:   devEUI.String(),
+		AppEUI:     &appEUI,
+	}, map[string]interface{}{
+		"devAddr": devAddr,
+	})
+
 	return &pb.CreateNodeSessionResponse{}, nil
 }
 
@@ -136,7 +153,7 @@ func (n *NodeSessionAPI) Get(ctx context.Context, req *pb.GetNodeSessionRequest)
 		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
 	}
 
-	return &pb.GetNodeSessionResponse{
+	sess := pb.GetNodeSessionResponse{
 		DevAddr:            devAddr.String(),
 		AppEUI:             appEUI.String(),
 		DevEUI:             devEUI.String(),
@@ -154,15 +171,25 @@ func (n *NodeSessionAPI) Get(ctx context.Context, req *pb.GetNodeSessionRequest)
 		InstallationMargin: resp.InstallationMargin,
 		NbTrans:            resp.NbTrans,
 		TxPower:            resp.TxPower,
-	}, nil
+	}
+	if node.E2EEncryption {
+		sess.AppSKey = ""
+	}
+	if node.AppSKeyEnvelope != nil {
+		sess.AppSKeyEnvelope = &pb.KeyEnvelope{
+			KekLabel: node.AppSKeyEnvelope.KEKLabel,
+			Key:      node.AppSKeyEnvelope.Key,
+		}
+	}
 
+	return &sess, nil
 }
 
 // Update updates the given node-session.
 func (n *NodeSessionAPI) Update(ctx context.Context, req *pb.UpdateNodeSessionRequest) (*pb.UpdateNodeSessionResponse, error) {
 	var devAddr lorawan.DevAddr
 	var appEUI, devEUI lorawan.EUI64
-	var appSKey, nwkSKey lorawan.AES128Key
+	var nwkSKey lorawan.AES128Key
 
 	if err := devAddr.UnmarshalText([]byte(req.DevAddr)); err != nil {
 		return nil, grpc.Errorf(codes.InvalidArgument, "devAddr: %s", err)
@@ -173,14 +200,13 @@ func (n *NodeSessionAPI) Update(ctx context.Context, req *pb.UpdateNodeSessionRe
 	if err := devEUI.UnmarshalText([]byte(req.DevEUI)); err != nil {
 		return nil, grpc.Errorf(codes.InvalidArgument, "devEUI: %s", err)
 	}
-	if err := appSKey.UnmarshalText([]byte(req.AppSKey)); err != nil {
-		return nil, grpc.Errorf(codes.InvalidArgument, "appSKey: %s", err)
-	}
 	if err := nwkSKey.UnmarshalText([]byte(req.NwkSKey)); err != nil {
 		return nil, grpc.Errorf(codes.InvalidArgument, "nwkSKey: %s", err)
 	}
 
+	var actor string
 	if err := n.validator.Validate(ctx,
+		auth.SetSubject(&actor),
 		auth.ValidateAPIMethod("NodeSession.Update"),
 		auth.ValidateApplication(appEUI),
 		auth.ValidateNode(devEUI),
@@ -197,6 +223,11 @@ func (n *NodeSessionAPI) Update(ctx context.Context, req *pb.UpdateNodeSessionRe
 		return nil, grpc.Errorf(codes.InvalidArgument, "node belongs to a different AppEUI")
 	}
 
+	appSKey, appSKeyEnvelope, err := appSKeyFromPB(node, req.AppSKey, req.AppSKeyEnvelope)
+	if err != nil {
+		return nil, err
+	}
+
 	_, err = n.ctx.NetworkServer.UpdateNodeSession(context.Background(), &ns.UpdateNodeSessionRequest{
 		DevAddr:            devAddr[:],
 		AppEUI:             appEUI[:],
@@ -217,7 +248,9 @@ func (n *NodeSessionAPI) Update(ctx context.Context, req *pb.UpdateNodeSessionRe
 		return nil, grpc.Errorf(codes.Internal, "create node-session error: %s", err)
 	}
 
+	appSKeyChanged := node.AppSKey != appSKey || !keyEnvelopesEqual(node.AppSKeyEnvelope, appSKeyEnvelope)
 	node.AppSKey = appSKey
+	node.AppSKeyEnvelope = appSKeyEnvelope
 	node.DevAddr = devAddr
 	if err := storage.UpdateNode(n.ctx.DB, node); err != nil {
 		return nil, grpc.Errorf(codes.Internal, "update node error: %s", err)
@@ -229,6 +262,17 @@ func (n *NodeSessionAPI) Update(ctx context.Context, req *pb.UpdateNodeSessionRe
 		"dev_eui":  devEUI,
 	}).Info("node-session updated")
 
+	recordAudit(n.ctx.DB, storage.AuditLog{
+		Actor:      actor,
+		Action:     "NodeSession.Update",
+		ObjectType: storage.AuditObjectNodeSession,
+		ObjectID:   devEUI.String(),
+		AppEUI:     &appEUI,
+	}, map[string]interface{}{
+		"devAddr":        devAddr,
+		"appSKeyChanged": appSKeyChanged,
+	})
+
 	return &pb.UpdateNodeSessionResponse{}, nil
 }
 
@@ -244,7 +288,9 @@ func (n *NodeSessionAPI) Delete(ctx context.Context, req *pb.DeleteNodeSessionRe
 		return nil, grpc.Errorf(codes.Unknown, "get node error: %s", err)
 	}
 
+	var actor string
 	if err := n.validator.Validate(ctx,
+		auth.SetSubject(&actor),
 		auth.ValidateAPIMethod("NodeSession.Delete"),
 		auth.ValidateNode(devEUI),
 		auth.ValidateApplication(node.AppEUI),
@@ -265,6 +311,14 @@ func (n *NodeSessionAPI) Delete(ctx context.Context, req *pb.DeleteNodeSessionRe
 		"dev_eui":  node.DevEUI,
 	}).Info("node-session deleted")
 
+	recordAudit(n.ctx.DB, storage.AuditLog{
+		Actor:      actor,
+		Action:     "NodeSession.Delete",
+		ObjectType: storage.AuditObjectNodeSession,
+		ObjectID:   devEUI.String(),
+		AppEUI:     &node.AppEUI,
+	}, nil)
+
 	return &pb.DeleteNodeSessionResponse{}, nil
 }
 
@@ -288,3 +342,39 @@ func (n *NodeSessionAPI) GetRandomDevAddr(ctx context.Context, req *pb.GetRandom
 		DevAddr: devAddr.String(),
 	}, nil
 }
+
+// appSKeyFromPB returns the AppSKey and the (optional) AppSKey envelope of
+// the given node-session request. For nodes using end-to-end encryption
+// the AppSKey must not be given, for the other nodes it is required.
+func appSKeyFromPB(node storage.Node, appSKeyStr string, env *pb.KeyEnvelope) (storage.EncryptedKey, *storage.KeyEnvelope, error) {
+	var appSKey storage.EncryptedKey
+
+	if node.E2EEncryption {
+		if appSKeyStr != "" {
+			return appSKey, nil, grpc.Errorf(codes.InvalidArgument, "appSKey must not be set for a node using end-to-end encryption")
+		}
+		if env == nil {
+			return appSKey, nil, nil
+		}
+		if len(env.Key) == 0 {
+			return appSKey, nil, grpc.Errorf(codes.InvalidArgument, "appSKeyEnvelope: key must be set")
+		}
+		return appSKey, &storage.KeyEnvelope{KEKLabel: env.KekLabel, Key: env.Key}, nil
+	}
+
+	if env != nil {
+		return appSKey, nil, grpc.Errorf(codes.InvalidArgument, "appSKeyEnvelope can only be set for a node using end-to-end encryption")
+	}
+	if err := appSKey.UnmarshalText([]byte(appSKeyStr)); err != nil {
+		return appSKey, nil, grpc.Errorf(codes.InvalidArgument, "appSKey: %s", err)
+	}
+	return appSKey, nil, nil
+}
+
+// keyEnvelopesEqual returns true when both envelopes are equal.
+func keyEnvelopesEqual(a, b *storage.KeyEnvelope) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.KEKLabel == b.KEKLabel && bytes.Equal(a.Key, b.Key)
+}
@@ -6,10 +6,13 @@ import (
 	"golang.org/x/net/context"
 
 	pb "github.com/brocaar/lora-app-server/api"
+	"github.com/brocaar/lora-app-server/integration"
 	"github.com/brocaar/lora-app-server/internal/common"
 	"github.com/brocaar/lora-app-server/internal/storage"
 	"github.com/brocaar/lora-app-server/internal/test"
+	"github.com/brocaar/lora-app-server/internal/test/testhandler"
 	"github.com/brocaar/loraserver/api/ns"
+	"github.com/brocaar/lorawan"
 	. "github.com/smartystreets/goconvey/convey"
 )
 
@@ -23,7 +26,8 @@ func TestNodeAPI(t *testing.T) {
 
 		nsClient := test.NewNetworkServerClient()
 		ctx := context.Background()
-		lsCtx := common.Context{DB: db, NetworkServer: nsClient}
+		h := testhandler.NewTestHandler()
+		lsCtx := common.Context{DB: db, NetworkServer: nsClient, Handler: h}
 		validator := &TestValidator{}
 		api := NewNodeAPI(lsCtx, validator)
 
@@ -38,11 +42,21 @@ func TestNodeAPI(t *testing.T) {
 				Rx2DR:              3,
 				AdrInterval:        20,
 				InstallationMargin: 5,
+				UplinkInterval:     60,
 			})
 			So(err, ShouldBeNil)
 			So(validator.ctx, ShouldResemble, ctx)
 			So(validator.validatorFuncs, ShouldHaveLength, 3)
 
+			Convey("Then a create lifecycle notification was sent", func() {
+				So(h.SendLifecycleNotificationChan, ShouldHaveLength, 1)
+				n := <-h.SendLifecycleNotificationChan
+				So(n.Entity, ShouldEqual, integration.LifecycleEntityNode)
+				So(n.Action, ShouldEqual, integration.LifecycleActionCreate)
+				So(n.DevEUI, ShouldEqual, lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1})
+				So(n.AppEUI, ShouldEqual, lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8})
+			})
+
 			Convey("The node has been created", func() {
 				node, err := api.Get(ctx, &pb.GetNodeRequest{DevEUI: "0807060504030201"})
 				So(err, ShouldBeNil)
@@ -58,6 +72,8 @@ func TestNodeAPI(t *testing.T) {
 					Rx2DR:              3,
 					AdrInterval:        20,
 					InstallationMargin: 5,
+					UplinkInterval:     60,
+					LinkScore:          -1,
 				})
 			})
 
@@ -80,13 +96,16 @@ func TestNodeAPI(t *testing.T) {
 					Rx2DR:              3,
 					AdrInterval:        20,
 					InstallationMargin: 5,
+					UplinkInterval:     60,
+					LinkScore:          -1,
 				})
 			})
 
 			Convey("When updating the node", func() {
+				<-h.SendLifecycleNotificationChan
 				_, err := api.Update(ctx, &pb.UpdateNodeRequest{
 					DevEUI:             "0807060504030201",
-					AppEUI:             "0102030405060708",
+					AppEUI:             "0102030405060709",
 					AppKey:             "08070605040302010807060504030201",
 					RxDelay:            3,
 					Rx1DROffset:        1,
@@ -94,17 +113,26 @@ func TestNodeAPI(t *testing.T) {
 					Rx2DR:              4,
 					AdrInterval:        30,
 					InstallationMargin: 10,
+					UplinkInterval:     120,
 				})
 				So(err, ShouldBeNil)
 				So(validator.ctx, ShouldResemble, ctx)
 				So(validator.validatorFuncs, ShouldHaveLength, 3)
 
+				Convey("Then an update lifecycle notification was sent", func() {
+					So(h.SendLifecycleNotificationChan, ShouldHaveLength, 1)
+					n := <-h.SendLifecycleNotificationChan
+					So(n.Action, ShouldEqual, integration.LifecycleActionUpdate)
+					So(n.AppEUI, ShouldEqual, lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 9})
+					So(n.PreviousAppEUI, ShouldResemble, &lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8})
+				})
+
 				Convey("Then the node has been updated", func() {
 					node, err := api.Get(ctx, &pb.GetNodeRequest{DevEUI: "0807060504030201"})
 					So(err, ShouldBeNil)
 					So(node, ShouldResemble, &pb.GetNodeResponse{
 						DevEUI:             "0807060504030201",
-						AppEUI:             "0102030405060708",
+						AppEUI:             "0102030405060709",
 						AppKey:             "08070605040302010807060504030201",
 						RxDelay:            3,
 						Rx1DROffset:        1,
@@ -112,16 +140,26 @@ func TestNodeAPI(t *testing.T) {
 						Rx2DR:              4,
 						AdrInterval:        30,
 						InstallationMargin: 10,
+						UplinkInterval:     120,
+						LinkScore:          -1,
 					})
 				})
 			})
 
 			Convey("After deleting the node", func() {
+				<-h.SendLifecycleNotificationChan
 				_, err := api.Delete(ctx, &pb.DeleteNodeRequest{DevEUI: "0807060504030201"})
 				So(err, ShouldBeNil)
 				So(validator.ctx, ShouldResemble, ctx)
 				So(validator.validatorFuncs, ShouldHaveLength, 3)
 
+				Convey("Then a delete lifecycle notification was sent", func() {
+					So(h.SendLifecycleNotificationChan, ShouldHaveLength, 1)
+					n := <-h.SendLifecycleNotificationChan
+					So(n.Action, ShouldEqual, integration.LifecycleActionDelete)
+					So(n.DevEUI, ShouldEqual, lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1})
+				})
+
 				Convey("Then an attempt was made to delete the node-session", func() {
 					So(nsClient.DeleteNodeSessionChan, ShouldHaveLength, 1)
 					So(<-nsClient.DeleteNodeSessionChan, ShouldResemble, ns.DeleteNodeSessionRequest{
@@ -0,0 +1,267 @@
+package api
+
+import (
+	"fmt"
+	"net/mail"
+	"net/url"
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/brocaar/lora-app-server/api"
+	"github.com/brocaar/lora-app-server/internal/alert"
+	"github.com/brocaar/lora-app-server/internal/api/auth"
+	"github.com/brocaar/lora-app-server/internal/common"
+	"github.com/brocaar/lora-app-server/internal/notification"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+// notificationPreferenceRequest defines the (shared) fields of the create
+// and update requests.
+type notificationPreferenceRequest interface {
+	GetUsername() string
+	GetAlertTypes() []string
+	GetChannels() []string
+	GetApplications() []string
+	GetGateways() []string
+	GetEmail() string
+	GetWebhookURL() string
+	GetSlackWebhookURL() string
+	GetQuietHoursStart() string
+	GetQuietHoursEnd() string
+	GetTimezone() string
+}
+
+// NotificationPreferenceAPI exports the notification preference related
+// functions.
+type NotificationPreferenceAPI struct {
+	ctx       common.Context
+	validator auth.Validator
+}
+
+// NewNotificationPreferenceAPI creates a new NotificationPreferenceAPI.
+func NewNotificationPreferenceAPI(ctx common.Context, validator auth.Validator) *NotificationPreferenceAPI {
+	return &NotificationPreferenceAPI{
+		ctx:       ctx,
+		validator: validator,
+	}
+}
+
+// Create creates the notification preferences of the given user.
+func (a *NotificationPreferenceAPI) Create(ctx context.Context, req *pb.CreateNotificationPreferenceRequest) (*pb.CreateNotificationPreferenceResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("NotificationPreference.Create"),
+		auth.ValidateUser(req.Username),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	pref, err := a.getNotificationPreference(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := storage.CreateNotificationPreference(a.ctx.DB, pref); err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+	return &pb.CreateNotificationPreferenceResponse{}, nil
+}
+
+// Get returns the notification preferences of the given user.
+func (a *NotificationPreferenceAPI) Get(ctx context.Context, req *pb.GetNotificationPreferenceRequest) (*pb.GetNotificationPreferenceResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("NotificationPreference.Get"),
+		auth.ValidateUser(req.Username),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	pref, err := storage.GetNotificationPreference(a.ctx.DB, req.Username)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+
+	resp := pb.GetNotificationPreferenceResponse{
+		Username:        pref.Username,
+		AlertTypes:      pref.AlertTypes,
+		Channels:        pref.Channels,
+		Applications:    pref.Applications,
+		Gateways:        pref.Gateways,
+		Email:           pref.Email,
+		WebhookURL:      pref.WebhookURL,
+		SlackWebhookURL: pref.SlackWebhookURL,
+		Timezone:        pref.Timezone,
+	}
+	if pref.QuietHoursStart != nil && pref.QuietHoursEnd != nil {
+		resp.QuietHoursStart = formatMinutes(*pref.QuietHoursStart)
+		resp.QuietHoursEnd = formatMinutes(*pref.QuietHoursEnd)
+	}
+	return &resp, nil
+}
+
+// Update updates the notification preferences of the given user.
+func (a *NotificationPreferenceAPI) Update(ctx context.Context, req *pb.UpdateNotificationPreferenceRequest) (*pb.UpdateNotificationPreferenceResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("NotificationPreference.Update"),
+		auth.ValidateUser(req.Username),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	pref, err := a.getNotificationPreference(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := storage.UpdateNotificationPreference(a.ctx.DB, pref); err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+	return &pb.UpdateNotificationPreferenceResponse{}, nil
+}
+
+// Delete deletes the notification preferences of the given user.
+func (a *NotificationPreferenceAPI) Delete(ctx context.Context, req *pb.DeleteNotificationPreferenceRequest) (*pb.DeleteNotificationPreferenceResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("NotificationPreference.Delete"),
+		auth.ValidateUser(req.Username),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if err := storage.DeleteNotificationPreference(a.ctx.DB, req.Username); err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+	return &pb.DeleteNotificationPreferenceResponse{}, nil
+}
+
+// getNotificationPreference validates the given request and returns the
+// NotificationPreference. The user must have access to the applications
+// to subscribe to.
+func (a *NotificationPreferenceAPI) getNotificationPreference(ctx context.Context, req notificationPreferenceRequest) (storage.NotificationPreference, error) {
+	pref := storage.NotificationPreference{
+		Username:        req.GetUsername(),
+		AlertTypes:      req.GetAlertTypes(),
+		Channels:        req.GetChannels(),
+		Email:           req.GetEmail(),
+		WebhookURL:      req.GetWebhookURL(),
+		SlackWebhookURL: req.GetSlackWebhookURL(),
+		Timezone:        req.GetTimezone(),
+	}
+
+	if pref.Username == "" {
+		return pref, grpc.Errorf(codes.InvalidArgument, "username must be set")
+	}
+
+	for _, t := range pref.AlertTypes {
+		if !stringInSlice(t, alert.Types) {
+			return pref, grpc.Errorf(codes.InvalidArgument, "invalid alert type: %s", t)
+		}
+	}
+
+	for _, ch := range pref.Channels {
+		var err error
+		switch ch {
+		case notification.ChannelEmail:
+			var addr *mail.Address
+			if addr, err = mail.ParseAddress(pref.Email); err == nil {
+				pref.Email = addr.Address
+			}
+		case notification.ChannelWebhook:
+			err = validateNotificationURL(pref.WebhookURL)
+		case notification.ChannelSlack:
+			err = validateNotificationURL(pref.SlackWebhookURL)
+		default:
+			err = fmt.Errorf("invalid channel: %s", ch)
+		}
+		if err != nil {
+			return pref, grpc.Errorf(codes.InvalidArgument, "%s: %s", ch, err)
+		}
+	}
+
+	for _, appEUIStr := range req.GetApplications() {
+		var appEUI lorawan.EUI64
+		if err := appEUI.UnmarshalText([]byte(appEUIStr)); err != nil {
+			return pref, grpc.Errorf(codes.InvalidArgument, err.Error())
+		}
+		if err := a.validator.Validate(ctx,
+			auth.ValidateApplication(appEUI),
+		); err != nil {
+			return pref, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+		}
+		pref.Applications = append(pref.Applications, appEUI.String())
+	}
+
+	for _, macStr := range req.GetGateways() {
+		if macStr == "*" {
+			pref.Gateways = append(pref.Gateways, macStr)
+			continue
+		}
+		var mac lorawan.EUI64
+		if err := mac.UnmarshalText([]byte(macStr)); err != nil {
+			return pref, grpc.Errorf(codes.InvalidArgument, err.Error())
+		}
+		pref.Gateways = append(pref.Gateways, mac.String())
+	}
+
+	if pref.Timezone == "" {
+		pref.Timezone = "UTC"
+	}
+	if _, err := time.LoadLocation(pref.Timezone); err != nil {
+		return pref, grpc.Errorf(codes.InvalidArgument, "invalid timezone: %s", err)
+	}
+
+	if req.GetQuietHoursStart() != "" || req.GetQuietHoursEnd() != "" {
+		start, err := parseMinutes(req.GetQuietHoursStart())
+		if err != nil {
+			return pref, grpc.Errorf(codes.InvalidArgument, "invalid quietHoursStart: %s", err)
+		}
+		end, err := parseMinutes(req.GetQuietHoursEnd())
+		if err != nil {
+			return pref, grpc.Errorf(codes.InvalidArgument, "invalid quietHoursEnd: %s", err)
+		}
+		pref.QuietHoursStart = &start
+		pref.QuietHoursEnd = &end
+	}
+
+	return pref, nil
+}
+
+// validateNotificationURL validates that the given url is a http(s) url.
+func validateNotificationURL(u string) error {
+	parsed, err := url.Parse(u)
+	if err != nil {
+		return err
+	}
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return fmt.Errorf("expected http(s) url, got: '%s'", u)
+	}
+	return nil
+}
+
+// parseMinutes parses the given HH:MM string into the number of minutes
+// after midnight.
+func parseMinutes(s string) (int, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, err
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// formatMinutes formats the given number of minutes after midnight as
+// HH:MM.
+func formatMinutes(minutes int) string {
+	return fmt.Sprintf("%02d:%02d", minutes/60, minutes%60)
+}
+
+func stringInSlice(s string, slice []string) bool {
+	for _, v := range slice {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,349 @@
+package api
+
+import (
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/brocaar/lora-app-server/api"
+	"github.com/brocaar/lora-app-server/internal/api/auth"
+	"github.com/brocaar/lora-app-server/internal/common"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+// OrganizationAPI exports the organization related functions.
+type OrganizationAPI struct {
+	ctx       common.Context
+	validator auth.Validator
+}
+
+// NewOrganizationAPI creates a new OrganizationAPI.
+func NewOrganizationAPI(ctx common.Context, validator auth.Validator) *OrganizationAPI {
+	return &OrganizationAPI{
+		ctx:       ctx,
+		validator: validator,
+	}
+}
+
+// Create creates the given organization.
+func (a *OrganizationAPI) Create(ctx context.Context, req *pb.CreateOrganizationRequest) (*pb.CreateOrganizationResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("Organization.Create"),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	o := storage.Organization{
+		Name:               req.Name,
+		MaxNodes:           int(req.MaxNodes),
+		MaxDownlinksPerDay: int(req.MaxDownlinksPerDay),
+	}
+	if o.Name == "" {
+		return nil, grpc.Errorf(codes.InvalidArgument, "name must be set")
+	}
+
+	if err := storage.CreateOrganization(a.ctx.DB, &o); err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+	return &pb.CreateOrganizationResponse{Id: o.ID}, nil
+}
+
+// Get returns the organization matching the given id.
+func (a *OrganizationAPI) Get(ctx context.Context, req *pb.GetOrganizationRequest) (*pb.GetOrganizationResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("Organization.Get"),
+		auth.ValidateOrganization(req.Id),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	o, err := storage.GetOrganization(a.ctx.DB, req.Id)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+	return a.organizationToResponse(o)
+}
+
+// List lists the organizations (given a limit and offset).
+func (a *OrganizationAPI) List(ctx context.Context, req *pb.ListOrganizationRequest) (*pb.ListOrganizationResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("Organization.List"),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	orgs, err := storage.GetOrganizations(a.ctx.DB, int(req.Limit), int(req.Offset))
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+	count, err := storage.GetOrganizationsCount(a.ctx.DB)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+
+	resp := pb.ListOrganizationResponse{
+		TotalCount: int64(count),
+	}
+	for _, o := range orgs {
+		r, err := a.organizationToResponse(o)
+		if err != nil {
+			return nil, err
+		}
+		resp.Result = append(resp.Result, r)
+	}
+	return &resp, nil
+}
+
+// Update updates the organization matching the given id.
+func (a *OrganizationAPI) Update(ctx context.Context, req *pb.UpdateOrganizationRequest) (*pb.UpdateOrganizationResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("Organization.Update"),
+		auth.ValidateOrganization(req.Id),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	o, err := storage.GetOrganization(a.ctx.DB, req.Id)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+
+	o.Name = req.Name
+	o.MaxNodes = int(req.MaxNodes)
+	o.MaxDownlinksPerDay = int(req.MaxDownlinksPerDay)
+	if o.Name == "" {
+		return nil, grpc.Errorf(codes.InvalidArgument, "name must be set")
+	}
+
+	if err := storage.UpdateOrganization(a.ctx.DB, o); err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+	return &pb.UpdateOrganizationResponse{}, nil
+}
+
+// Delete deletes the organization matching the given id.
+func (a *OrganizationAPI) Delete(ctx context.Context, req *pb.DeleteOrganizationRequest) (*pb.DeleteOrganizationResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("Organization.Delete"),
+		auth.ValidateOrganization(req.Id),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if err := storage.DeleteOrganization(a.ctx.DB, req.Id); err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+	return &pb.DeleteOrganizationResponse{}, nil
+}
+
+// AddApplication assigns the given application to the organization.
+func (a *OrganizationAPI) AddApplication(ctx context.Context, req *pb.AddOrganizationApplicationRequest) (*pb.AddOrganizationApplicationResponse, error) {
+	var appEUI lorawan.EUI64
+	if err := appEUI.UnmarshalText([]byte(req.AppEUI)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	var actor string
+	if err := a.validator.Validate(ctx,
+		auth.SetSubject(&actor),
+		auth.ValidateAPIMethod("Organization.AddApplication"),
+		auth.ValidateOrganization(req.Id),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if err := storage.AddOrganizationApplication(a.ctx.DB, req.Id, appEUI); err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+
+	recordAudit(a.ctx.DB, storage.AuditLog{
+		Actor:      actor,
+		Action:     "Organization.AddApplication",
+		ObjectType: storage.AuditObjectApplication,
+		ObjectID:   appEUI.String(),
+		AppEUI:     &appEUI,
+	}, map[string]interface{}{
+		"organizationID": req.Id,
+	})
+
+	return &pb.AddOrganizationApplicationResponse{}, nil
+}
+
+// RemoveApplication removes the given application from the organization.
+func (a *OrganizationAPI) RemoveApplication(ctx context.Context, req *pb.RemoveOrganizationApplicationRequest) (*pb.RemoveOrganizationApplicationResponse, error) {
+	var appEUI lorawan.EUI64
+	if err := appEUI.UnmarshalText([]byte(req.AppEUI)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	var actor string
+	if err := a.validator.Validate(ctx,
+		auth.SetSubject(&actor),
+		auth.ValidateAPIMethod("Organization.RemoveApplication"),
+		auth.ValidateOrganization(req.Id),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if err := storage.DeleteOrganizationApplication(a.ctx.DB, req.Id, appEUI); err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+
+	recordAudit(a.ctx.DB, storage.AuditLog{
+		Actor:      actor,
+		Action:     "Organization.RemoveApplication",
+		ObjectType: storage.AuditObjectApplication,
+		ObjectID:   appEUI.String(),
+		AppEUI:     &appEUI,
+	}, map[string]interface{}{
+		"organizationID": req.Id,
+	})
+
+	return &pb.RemoveOrganizationApplicationResponse{}, nil
+}
+
+// ListApplications lists the applications of the organization.
+func (a *OrganizationAPI) ListApplications(ctx context.Context, req *pb.ListOrganizationApplicationsRequest) (*pb.ListOrganizationApplicationsResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("Organization.ListApplications"),
+		auth.ValidateOrganization(req.Id),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	appEUIs, err := storage.GetOrganizationApplications(a.ctx.DB, req.Id)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+
+	var resp pb.ListOrganizationApplicationsResponse
+	for _, appEUI := range appEUIs {
+		resp.AppEUIs = append(resp.AppEUIs, appEUI.String())
+	}
+	return &resp, nil
+}
+
+// AddUser adds the given user to the organization.
+func (a *OrganizationAPI) AddUser(ctx context.Context, req *pb.AddOrganizationUserRequest) (*pb.AddOrganizationUserResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("Organization.AddUser"),
+		auth.ValidateOrganization(req.Id),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	u := storage.OrganizationUser{
+		OrganizationID: req.Id,
+		Username:       req.Username,
+		Role:           storage.OrganizationRole(req.Role.String()),
+	}
+	if err := u.Validate(); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	if err := storage.CreateOrganizationUser(a.ctx.DB, &u); err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+	return &pb.AddOrganizationUserResponse{}, nil
+}
+
+// UpdateUser updates the role of the given user.
+func (a *OrganizationAPI) UpdateUser(ctx context.Context, req *pb.UpdateOrganizationUserRequest) (*pb.UpdateOrganizationUserResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("Organization.UpdateUser"),
+		auth.ValidateOrganization(req.Id),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	u := storage.OrganizationUser{
+		OrganizationID: req.Id,
+		Username:       req.Username,
+		Role:           storage.OrganizationRole(req.Role.String()),
+	}
+	if err := u.Validate(); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	if err := storage.UpdateOrganizationUser(a.ctx.DB, u); err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+	return &pb.UpdateOrganizationUserResponse{}, nil
+}
+
+// RemoveUser removes the given user from the organization.
+func (a *OrganizationAPI) RemoveUser(ctx context.Context, req *pb.RemoveOrganizationUserRequest) (*pb.RemoveOrganizationUserResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("Organization.RemoveUser"),
+		auth.ValidateOrganization(req.Id),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if err := storage.DeleteOrganizationUser(a.ctx.DB, req.Id, req.Username); err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+	return &pb.RemoveOrganizationUserResponse{}, nil
+}
+
+// ListUsers lists the users of the organization.
+func (a *OrganizationAPI) ListUsers(ctx context.Context, req *pb.ListOrganizationUsersRequest) (*pb.ListOrganizationUsersResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("Organization.ListUsers"),
+		auth.ValidateOrganization(req.Id),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	users, err := storage.GetOrganizationUsers(a.ctx.DB, req.Id)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+
+	var resp pb.ListOrganizationUsersResponse
+	for _, u := range users {
+		resp.Result = append(resp.Result, &pb.OrganizationUser{
+			Username:  u.Username,
+			Role:      pb.OrganizationRole(pb.OrganizationRole_value[string(u.Role)]),
+			CreatedAt: u.CreatedAt.Format(time.RFC3339),
+			UpdatedAt: u.UpdatedAt.Format(time.RFC3339),
+		})
+	}
+	return &resp, nil
+}
+
+// organizationToResponse returns the API representation of the given
+// Organization, including its usage of the quotas.
+func (a *OrganizationAPI) organizationToResponse(o storage.Organization) (*pb.GetOrganizationResponse, error) {
+	nodeCount, err := storage.GetOrganizationNodesCount(a.ctx.DB, o.ID)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+	downlinkCount, err := storage.GetOrganizationDownlinkCount(a.ctx.DB, o.ID, time.Now())
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+
+	return &pb.GetOrganizationResponse{
+		Id:                 o.ID,
+		Name:               o.Name,
+		MaxNodes:           uint32(o.MaxNodes),
+		MaxDownlinksPerDay: uint32(o.MaxDownlinksPerDay),
+		NodeCount:          uint32(nodeCount),
+		DownlinkCount:      uint32(downlinkCount),
+		CreatedAt:          o.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:          o.UpdatedAt.Format(time.RFC3339),
+	}, nil
+}
+
+// quotaError returns the gRPC error for the given error, using the
+// ResourceExhausted code when a quota of the organization is exceeded.
+func quotaError(err error) error {
+	if err == storage.ErrNodeQuotaExceeded || err == storage.ErrDownlinkQuotaExceeded {
+		return grpc.Errorf(codes.ResourceExhausted, err.Error())
+	}
+	return grpc.Errorf(codes.Unknown, err.Error())
+}
@@ -0,0 +1,151 @@
+package api
+
+import (
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/brocaar/lora-app-server/api"
+	"github.com/brocaar/lora-app-server/internal/api/auth"
+	"github.com/brocaar/lora-app-server/internal/codec"
+	"github.com/brocaar/lora-app-server/internal/common"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+// payloadCodecRequest defines the (shared) fields of the create and update
+// requests.
+type payloadCodecRequest interface {
+	GetAppEUI() string
+	GetCodecType() string
+	GetDecodeScript() string
+	GetEncodeScript() string
+}
+
+// PayloadCodecAPI exports the payload codec related functions.
+type PayloadCodecAPI struct {
+	ctx       common.Context
+	validator auth.Validator
+}
+
+// NewPayloadCodecAPI creates a new PayloadCodecAPI.
+func NewPayloadCodecAPI(ctx common.Context, validator auth.Validator) *PayloadCodecAPI {
+	return &PayloadCodecAPI{
+		ctx:       ctx,
+		validator: validator,
+	}
+}
+
+// Create creates the payload codec of the given application.
+func (a *PayloadCodecAPI) Create(ctx context.Context, req *pb.CreatePayloadCodecRequest) (*pb.CreatePayloadCodecResponse, error) {
+	c, err := getPayloadCodec(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("PayloadCodec.Create"),
+		auth.ValidateApplication(c.AppEUI),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if err := storage.CreatePayloadCodec(a.ctx.DB, c); err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+	return &pb.CreatePayloadCodecResponse{}, nil
+}
+
+// Get returns the payload codec of the given application.
+func (a *PayloadCodecAPI) Get(ctx context.Context, req *pb.GetPayloadCodecRequest) (*pb.GetPayloadCodecResponse, error) {
+	var appEUI lorawan.EUI64
+	if err := appEUI.UnmarshalText([]byte(req.AppEUI)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("PayloadCodec.Get"),
+		auth.ValidateApplication(appEUI),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	c, err := storage.GetPayloadCodec(a.ctx.DB, appEUI)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+	if c == nil {
+		return nil, grpc.Errorf(codes.NotFound, "payload codec %s does not exist", appEUI)
+	}
+
+	return &pb.GetPayloadCodecResponse{
+		AppEUI:       c.AppEUI.String(),
+		CodecType:    c.CodecType,
+		DecodeScript: c.DecodeScript,
+		EncodeScript: c.EncodeScript,
+	}, nil
+}
+
+// Update updates the payload codec of the given application.
+func (a *PayloadCodecAPI) Update(ctx context.Context, req *pb.UpdatePayloadCodecRequest) (*pb.UpdatePayloadCodecResponse, error) {
+	c, err := getPayloadCodec(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("PayloadCodec.Update"),
+		auth.ValidateApplication(c.AppEUI),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if err := storage.UpdatePayloadCodec(a.ctx.DB, c); err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+	return &pb.UpdatePayloadCodecResponse{}, nil
+}
+
+// Delete deletes the payload codec of the given application.
+func (a *PayloadCodecAPI) Delete(ctx context.Context, req *pb.DeletePayloadCodecRequest) (*pb.DeletePayloadCodecResponse, error) {
+	var appEUI lorawan.EUI64
+	if err := appEUI.UnmarshalText([]byte(req.AppEUI)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("PayloadCodec.Delete"),
+		auth.ValidateApplication(appEUI),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if err := storage.DeletePayloadCodec(a.ctx.DB, appEUI); err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+	return &pb.DeletePayloadCodecResponse{}, nil
+}
+
+// getPayloadCodec validates the given request (the scripts must compile)
+// and returns the PayloadCodec.
+func getPayloadCodec(req payloadCodecRequest) (storage.PayloadCodec, error) {
+	c := storage.PayloadCodec{
+		CodecType:    req.GetCodecType(),
+		DecodeScript: req.GetDecodeScript(),
+		EncodeScript: req.GetEncodeScript(),
+	}
+
+	if err := c.AppEUI.UnmarshalText([]byte(req.GetAppEUI())); err != nil {
+		return c, grpc.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	if c.CodecType != codec.TypeCustomJS {
+		c.DecodeScript = ""
+		c.EncodeScript = ""
+	}
+	if _, err := codec.New(c.CodecType, c.DecodeScript, c.EncodeScript); err != nil {
+		return c, grpc.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	return c, nil
+}
@@ -0,0 +1,252 @@
+package api
+
+import (
+	"fmt"
+	"net/mail"
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/brocaar/lora-app-server/api"
+	"github.com/brocaar/lora-app-server/internal/api/auth"
+	"github.com/brocaar/lora-app-server/internal/common"
+	"github.com/brocaar/lora-app-server/internal/report"
+	"github.com/brocaar/lora-app-server/internal/storage"
+)
+
+// scheduledReportRequest defines the (shared) fields of the create and
+// update requests.
+type scheduledReportRequest interface {
+	GetName() string
+	GetAppEUI() string
+	GetReportType() string
+	GetFormat() string
+	GetSchedule() string
+	GetWeekday() uint32
+	GetHour() uint32
+	GetTimezone() string
+	GetChannel() string
+	GetRecipients() []string
+	GetWebhookURL() string
+}
+
+// ScheduledReportAPI exports the scheduled report related functions.
+type ScheduledReportAPI struct {
+	ctx       common.Context
+	validator auth.Validator
+}
+
+// NewScheduledReportAPI creates a new ScheduledReportAPI.
+func NewScheduledReportAPI(ctx common.Context, validator auth.Validator) *ScheduledReportAPI {
+	return &ScheduledReportAPI{
+		ctx:       ctx,
+		validator: validator,
+	}
+}
+
+// Create creates the given scheduled report.
+func (a *ScheduledReportAPI) Create(ctx context.Context, req *pb.CreateScheduledReportRequest) (*pb.CreateScheduledReportResponse, error) {
+	r, err := getScheduledReport(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("ScheduledReport.Create"),
+		auth.ValidateApplication(r.AppEUI),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if err := storage.CreateScheduledReport(a.ctx.DB, &r); err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+	return &pb.CreateScheduledReportResponse{Id: r.ID}, nil
+}
+
+// Get returns the scheduled report matching the given id.
+func (a *ScheduledReportAPI) Get(ctx context.Context, req *pb.GetScheduledReportRequest) (*pb.GetScheduledReportResponse, error) {
+	r, err := storage.GetScheduledReport(a.ctx.DB, req.Id)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("ScheduledReport.Get"),
+		auth.ValidateApplication(r.AppEUI),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	return scheduledReportToResponse(r), nil
+}
+
+// ListByAppEUI lists the scheduled reports of the given application.
+func (a *ScheduledReportAPI) ListByAppEUI(ctx context.Context, req *pb.ListScheduledReportByAppEUIRequest) (*pb.ListScheduledReportResponse, error) {
+	var r storage.ScheduledReport
+	if err := r.AppEUI.UnmarshalText([]byte(req.AppEUI)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("ScheduledReport.ListByAppEUI"),
+		auth.ValidateApplication(r.AppEUI),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	reports, err := storage.GetScheduledReportsForAppEUI(a.ctx.DB, r.AppEUI)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+
+	var resp pb.ListScheduledReportResponse
+	for _, r := range reports {
+		resp.Result = append(resp.Result, scheduledReportToResponse(r))
+	}
+	return &resp, nil
+}
+
+// Update updates the scheduled report matching the given id. The next run
+// is re-calculated from the (updated) schedule.
+func (a *ScheduledReportAPI) Update(ctx context.Context, req *pb.UpdateScheduledReportRequest) (*pb.UpdateScheduledReportResponse, error) {
+	current, err := storage.GetScheduledReport(a.ctx.DB, req.Id)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+
+	r, err := getScheduledReport(req)
+	if err != nil {
+		return nil, err
+	}
+	r.ID = current.ID
+
+	// the user must have access to both the current and the new application
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("ScheduledReport.Update"),
+		auth.ValidateApplication(current.AppEUI),
+		auth.ValidateApplication(r.AppEUI),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if err := storage.UpdateScheduledReport(a.ctx.DB, r); err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+	return &pb.UpdateScheduledReportResponse{}, nil
+}
+
+// Delete deletes the scheduled report matching the given id.
+func (a *ScheduledReportAPI) Delete(ctx context.Context, req *pb.DeleteScheduledReportRequest) (*pb.DeleteScheduledReportResponse, error) {
+	r, err := storage.GetScheduledReport(a.ctx.DB, req.Id)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("ScheduledReport.Delete"),
+		auth.ValidateApplication(r.AppEUI),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if err := storage.DeleteScheduledReport(a.ctx.DB, r.ID); err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+	return &pb.DeleteScheduledReportResponse{}, nil
+}
+
+// getScheduledReport validates the given request and returns the
+// ScheduledReport, with its next run set.
+func getScheduledReport(req scheduledReportRequest) (storage.ScheduledReport, error) {
+	r := storage.ScheduledReport{
+		Name:       req.GetName(),
+		ReportType: req.GetReportType(),
+		Format:     req.GetFormat(),
+		Schedule:   req.GetSchedule(),
+		Weekday:    int(req.GetWeekday()),
+		Hour:       int(req.GetHour()),
+		Timezone:   req.GetTimezone(),
+		Channel:    req.GetChannel(),
+		WebhookURL: req.GetWebhookURL(),
+	}
+
+	if err := r.AppEUI.UnmarshalText([]byte(req.GetAppEUI())); err != nil {
+		return r, grpc.Errorf(codes.InvalidArgument, err.Error())
+	}
+	if r.Name == "" {
+		return r, grpc.Errorf(codes.InvalidArgument, "name must be set")
+	}
+	if r.ReportType != report.TypeDeviceActivity && r.ReportType != report.TypeOfflineDevices {
+		return r, grpc.Errorf(codes.InvalidArgument, "invalid report type: %s", r.ReportType)
+	}
+	if r.Format != report.FormatCSV && r.Format != report.FormatPDF {
+		return r, grpc.Errorf(codes.InvalidArgument, "invalid format: %s", r.Format)
+	}
+	if r.Schedule != report.ScheduleDaily && r.Schedule != report.ScheduleWeekly {
+		return r, grpc.Errorf(codes.InvalidArgument, "invalid schedule: %s", r.Schedule)
+	}
+	if r.Weekday > 6 {
+		return r, grpc.Errorf(codes.InvalidArgument, "weekday must be between 0 and 6")
+	}
+	if r.Hour > 23 {
+		return r, grpc.Errorf(codes.InvalidArgument, "hour must be between 0 and 23")
+	}
+
+	var err error
+	switch r.Channel {
+	case report.ChannelEmail:
+		if len(req.GetRecipients()) == 0 {
+			err = fmt.Errorf("at least one recipient is required")
+		}
+		for _, recipient := range req.GetRecipients() {
+			var addr *mail.Address
+			if addr, err = mail.ParseAddress(recipient); err != nil {
+				break
+			}
+			r.Recipients = append(r.Recipients, addr.Address)
+		}
+	case report.ChannelWebhook:
+		err = validateNotificationURL(r.WebhookURL)
+	default:
+		err = fmt.Errorf("invalid channel: %s", r.Channel)
+	}
+	if err != nil {
+		return r, grpc.Errorf(codes.InvalidArgument, "%s: %s", r.Channel, err)
+	}
+
+	if r.Timezone == "" {
+		r.Timezone = "UTC"
+	}
+	if r.NextRunAt, err = report.NextRun(r, time.Now()); err != nil {
+		return r, grpc.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	return r, nil
+}
+
+// scheduledReportToResponse returns the API representation of the given
+// ScheduledReport.
+func scheduledReportToResponse(r storage.ScheduledReport) *pb.GetScheduledReportResponse {
+	resp := pb.GetScheduledReportResponse{
+		Id:         r.ID,
+		Name:       r.Name,
+		AppEUI:     r.AppEUI.String(),
+		ReportType: r.ReportType,
+		Format:     r.Format,
+		Schedule:   r.Schedule,
+		Weekday:    uint32(r.Weekday),
+		Hour:       uint32(r.Hour),
+		Timezone:   r.Timezone,
+		Channel:    r.Channel,
+		Recipients: r.Recipients,
+		WebhookURL: r.WebhookURL,
+		NextRunAt:  r.NextRunAt.Format(time.RFC3339),
+	}
+	if r.LastRunAt != nil {
+		resp.LastRunAt = r.LastRunAt.Format(time.RFC3339)
+	}
+	return &resp
+}
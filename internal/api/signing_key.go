@@ -0,0 +1,211 @@
+package api
+
+import (
+	"encoding/hex"
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/brocaar/lora-app-server/api"
+	"github.com/brocaar/lora-app-server/internal/api/auth"
+	"github.com/brocaar/lora-app-server/internal/common"
+	"github.com/brocaar/lora-app-server/internal/jws"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+// SigningKeyAPI exports the signing-key related functions.
+type SigningKeyAPI struct {
+	ctx       common.Context
+	validator auth.Validator
+}
+
+// NewSigningKeyAPI creates a new SigningKeyAPI.
+func NewSigningKeyAPI(ctx common.Context, validator auth.Validator) *SigningKeyAPI {
+	return &SigningKeyAPI{
+		ctx:       ctx,
+		validator: validator,
+	}
+}
+
+// Create creates a new signing key for the given application.
+func (a *SigningKeyAPI) Create(ctx context.Context, req *pb.CreateSigningKeyRequest) (*pb.CreateSigningKeyResponse, error) {
+	var appEUI lorawan.EUI64
+	if err := appEUI.UnmarshalText([]byte(req.AppEUI)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	var actor string
+	if err := a.validator.Validate(ctx,
+		auth.SetSubject(&actor),
+		auth.ValidateAPIMethod("SigningKey.Create"),
+		auth.ValidateApplication(appEUI),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	key, err := jws.NewSigningKey(appEUI, req.Algorithm)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, err.Error())
+	}
+	if err := storage.CreateSigningKey(a.ctx.DB, &key); err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+
+	recordAudit(a.ctx.DB, storage.AuditLog{
+		Actor:      actor,
+		Action:     "SigningKey.Create",
+		ObjectType: storage.AuditObjectSigningKey,
+		ObjectID:   key.KeyID,
+		AppEUI:     &appEUI,
+	}, map[string]interface{}{
+		"algorithm": key.Algorithm,
+	})
+
+	return &pb.CreateSigningKeyResponse{
+		KeyID:  key.KeyID,
+		Secret: signingKeySecret(key),
+	}, nil
+}
+
+// List lists the signing keys of the given application.
+func (a *SigningKeyAPI) List(ctx context.Context, req *pb.ListSigningKeyRequest) (*pb.ListSigningKeyResponse, error) {
+	var appEUI lorawan.EUI64
+	if err := appEUI.UnmarshalText([]byte(req.AppEUI)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("SigningKey.List"),
+		auth.ValidateApplication(appEUI),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	keys, err := storage.GetSigningKeysForAppEUI(a.ctx.DB, appEUI)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+
+	var resp pb.ListSigningKeyResponse
+	for _, key := range keys {
+		item := pb.SigningKeyItem{
+			KeyID:     key.KeyID,
+			Algorithm: key.Algorithm,
+			CreatedAt: key.CreatedAt.Format(time.RFC3339),
+		}
+		if key.ExpiresAt != nil {
+			item.ExpiresAt = key.ExpiresAt.Format(time.RFC3339)
+		}
+		resp.Result = append(resp.Result, &item)
+	}
+	return &resp, nil
+}
+
+// Rotate creates a new signing key for the given application and sets the
+// expiration of the existing keys, so that they remain valid during the
+// given overlap.
+func (a *SigningKeyAPI) Rotate(ctx context.Context, req *pb.RotateSigningKeyRequest) (*pb.RotateSigningKeyResponse, error) {
+	var appEUI lorawan.EUI64
+	if err := appEUI.UnmarshalText([]byte(req.AppEUI)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	var actor string
+	if err := a.validator.Validate(ctx,
+		auth.SetSubject(&actor),
+		auth.ValidateAPIMethod("SigningKey.Rotate"),
+		auth.ValidateApplication(appEUI),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	key, err := jws.NewSigningKey(appEUI, req.Algorithm)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	// the new key is created and the previous keys are expired in a single
+	// transaction, so that a failed rotation doesn't leave both keys active
+	tx, err := a.ctx.DB.Beginx()
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+	defer tx.Rollback()
+
+	if err := storage.CreateSigningKey(tx, &key); err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+
+	expiresAt := key.CreatedAt.Add(time.Duration(req.Overlap) * time.Second)
+	if err := storage.ExpireSigningKeys(tx, appEUI, key.KeyID, expiresAt); err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+
+	recordAudit(a.ctx.DB, storage.AuditLog{
+		Actor:      actor,
+		Action:     "SigningKey.Rotate",
+		ObjectType: storage.AuditObjectSigningKey,
+		ObjectID:   key.KeyID,
+		AppEUI:     &appEUI,
+	}, map[string]interface{}{
+		"algorithm":            key.Algorithm,
+		"previousKeysExpireAt": expiresAt,
+	})
+
+	return &pb.RotateSigningKeyResponse{
+		KeyID:  key.KeyID,
+		Secret: signingKeySecret(key),
+	}, nil
+}
+
+// Delete deletes the signing key matching the given key ID.
+func (a *SigningKeyAPI) Delete(ctx context.Context, req *pb.DeleteSigningKeyRequest) (*pb.DeleteSigningKeyResponse, error) {
+	// the caller is validated before looking up the key, the application
+	// of the key is validated once known
+	var actor string
+	if err := a.validator.Validate(ctx,
+		auth.SetSubject(&actor),
+		auth.ValidateAPIMethod("SigningKey.Delete"),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	key, err := storage.GetSigningKey(a.ctx.DB, req.KeyID)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+
+	if err := a.validator.Validate(ctx, auth.ValidateApplication(key.AppEUI)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if err := storage.DeleteSigningKey(a.ctx.DB, key.KeyID); err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+
+	recordAudit(a.ctx.DB, storage.AuditLog{
+		Actor:      actor,
+		Action:     "SigningKey.Delete",
+		ObjectType: storage.AuditObjectSigningKey,
+		ObjectID:   key.KeyID,
+		AppEUI:     &key.AppEUI,
+	}, nil)
+	return &pb.DeleteSigningKeyResponse{}, nil
+}
+
+// signingKeySecret returns the hex encoded secret of the given key in case
+// of a (symmetric) HMAC key. For other keys it returns an empty string, as
+// private keys are never exposed.
+func signingKeySecret(key storage.SigningKey) string {
+	if key.Algorithm != jws.AlgorithmHS256 {
+		return ""
+	}
+	return hex.EncodeToString(key.PrivateKey)
+}
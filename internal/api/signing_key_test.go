@@ -0,0 +1,93 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	pb "github.com/brocaar/lora-app-server/api"
+	"github.com/brocaar/lora-app-server/internal/common"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lora-app-server/internal/test"
+)
+
+func TestSigningKeyAPI(t *testing.T) {
+	conf := test.GetConfig()
+
+	Convey("Given a clean database and api instance", t, func() {
+		db, err := storage.OpenDatabase(conf.PostgresDSN)
+		So(err, ShouldBeNil)
+		test.MustResetDB(db)
+
+		ctx := context.Background()
+		validator := &TestValidator{}
+		api := NewSigningKeyAPI(common.Context{DB: db}, validator)
+
+		Convey("When creating a signing key", func() {
+			resp, err := api.Create(ctx, &pb.CreateSigningKeyRequest{
+				AppEUI: "0102030405060708",
+			})
+			So(err, ShouldBeNil)
+			So(validator.validatorFuncs, ShouldHaveLength, 3)
+
+			Convey("Then the key is listed", func() {
+				list, err := api.List(ctx, &pb.ListSigningKeyRequest{
+					AppEUI: "0102030405060708",
+				})
+				So(err, ShouldBeNil)
+				So(list.Result, ShouldHaveLength, 1)
+				So(list.Result[0].KeyID, ShouldEqual, resp.KeyID)
+			})
+
+			Convey("When deleting the key", func() {
+				_, err := api.Delete(ctx, &pb.DeleteSigningKeyRequest{
+					KeyID: resp.KeyID,
+				})
+				So(err, ShouldBeNil)
+
+				Convey("Then the application of the key has been validated", func() {
+					So(validator.validatorFuncs, ShouldHaveLength, 1)
+				})
+
+				Convey("Then the key has been deleted", func() {
+					list, err := api.List(ctx, &pb.ListSigningKeyRequest{
+						AppEUI: "0102030405060708",
+					})
+					So(err, ShouldBeNil)
+					So(list.Result, ShouldHaveLength, 0)
+				})
+			})
+
+			Convey("When deleting the key without permission", func() {
+				validator.returnError = errors.New("boom")
+				_, err := api.Delete(ctx, &pb.DeleteSigningKeyRequest{
+					KeyID: resp.KeyID,
+				})
+
+				Convey("Then an Unauthenticated error is returned and the key is not deleted", func() {
+					So(grpc.Code(err), ShouldEqual, codes.Unauthenticated)
+
+					_, err := storage.GetSigningKey(db, resp.KeyID)
+					So(err, ShouldBeNil)
+				})
+			})
+		})
+
+		Convey("When deleting an unknown key without permission", func() {
+			validator.returnError = errors.New("boom")
+			_, err := api.Delete(ctx, &pb.DeleteSigningKeyRequest{
+				KeyID: "unknown",
+			})
+
+			Convey("Then an Unauthenticated error is returned before looking up the key", func() {
+				So(grpc.Code(err), ShouldEqual, codes.Unauthenticated)
+				So(validator.validatorFuncs, ShouldHaveLength, 2)
+			})
+		})
+	})
+}
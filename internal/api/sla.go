@@ -0,0 +1,135 @@
+package api
+
+import (
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/brocaar/lora-app-server/api"
+	"github.com/brocaar/lora-app-server/internal/api/auth"
+	"github.com/brocaar/lora-app-server/internal/common"
+	"github.com/brocaar/lora-app-server/internal/sla"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+// SLAAPI exports the availability (SLA) reporting functions.
+type SLAAPI struct {
+	ctx       common.Context
+	validator auth.Validator
+}
+
+// NewSLAAPI creates a new SLAAPI.
+func NewSLAAPI(ctx common.Context, validator auth.Validator) *SLAAPI {
+	return &SLAAPI{
+		ctx:       ctx,
+		validator: validator,
+	}
+}
+
+// GetNodeReport returns the availability report of the given node.
+func (a *SLAAPI) GetNodeReport(ctx context.Context, req *pb.GetNodeSLAReportRequest) (*pb.NodeSLAReport, error) {
+	var devEUI lorawan.EUI64
+	if err := devEUI.UnmarshalText([]byte(req.DevEUI)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	start, end, err := parsePeriod(req.Start, req.End)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	node, err := storage.GetNode(a.ctx.DB, devEUI)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("SLA.GetNodeReport"),
+		auth.ValidateApplication(node.AppEUI),
+		auth.ValidateNode(node.DevEUI),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	report, err := sla.GetNodeReport(a.ctx.DB, node.DevEUI, start, end)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+
+	return nodeSLAReportToPB(report), nil
+}
+
+// GetApplicationReport returns the availability report of the given
+// application.
+func (a *SLAAPI) GetApplicationReport(ctx context.Context, req *pb.GetApplicationSLAReportRequest) (*pb.ApplicationSLAReport, error) {
+	var appEUI lorawan.EUI64
+	if err := appEUI.UnmarshalText([]byte(req.AppEUI)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	start, end, err := parsePeriod(req.Start, req.End)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("SLA.GetApplicationReport"),
+		auth.ValidateApplication(appEUI),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	report, err := sla.GetApplicationReport(a.ctx.DB, appEUI, start, end)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+
+	resp := pb.ApplicationSLAReport{
+		AppEUI:          report.AppEUI.String(),
+		Start:           start.Format(time.RFC3339),
+		End:             end.Format(time.RFC3339),
+		ExpectedUplinks: int64(report.ExpectedUplinks),
+		ReceivedUplinks: int64(report.ReceivedUplinks),
+		Availability:    report.Availability,
+	}
+	for _, nr := range report.Nodes {
+		resp.Nodes = append(resp.Nodes, nodeSLAReportToPB(nr))
+	}
+	return &resp, nil
+}
+
+func nodeSLAReportToPB(report sla.NodeReport) *pb.NodeSLAReport {
+	return &pb.NodeSLAReport{
+		DevEUI:          report.DevEUI.String(),
+		UplinkInterval:  report.UplinkInterval,
+		ExpectedUplinks: int64(report.ExpectedUplinks),
+		ReceivedUplinks: int64(report.ReceivedUplinks),
+		Availability:    report.Availability,
+	}
+}
+
+// parsePeriod parses the given RFC3339 start and end timestamps. When end
+// is empty, the current time is used.
+func parsePeriod(startStr, endStr string) (time.Time, time.Time, error) {
+	var start, end time.Time
+	var err error
+
+	start, err = time.Parse(time.RFC3339, startStr)
+	if err != nil {
+		return start, end, err
+	}
+
+	if endStr == "" {
+		end = time.Now()
+	} else {
+		end, err = time.Parse(time.RFC3339, endStr)
+		if err != nil {
+			return start, end, err
+		}
+	}
+
+	return start, end, nil
+}
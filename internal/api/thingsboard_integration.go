@@ -0,0 +1,140 @@
+package api
+
+import (
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/brocaar/lora-app-server/api"
+	"github.com/brocaar/lora-app-server/internal/api/auth"
+	"github.com/brocaar/lora-app-server/internal/common"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+// thingsBoardIntegrationRequest defines the (shared) fields of the create
+// and update requests.
+type thingsBoardIntegrationRequest interface {
+	GetAppEUI() string
+	GetServer() string
+}
+
+// ThingsBoardIntegrationAPI exports the ThingsBoard integration related
+// functions.
+type ThingsBoardIntegrationAPI struct {
+	ctx       common.Context
+	validator auth.Validator
+}
+
+// NewThingsBoardIntegrationAPI creates a new ThingsBoardIntegrationAPI.
+func NewThingsBoardIntegrationAPI(ctx common.Context, validator auth.Validator) *ThingsBoardIntegrationAPI {
+	return &ThingsBoardIntegrationAPI{
+		ctx:       ctx,
+		validator: validator,
+	}
+}
+
+// Create creates the ThingsBoard integration of the given application.
+func (a *ThingsBoardIntegrationAPI) Create(ctx context.Context, req *pb.CreateThingsBoardIntegrationRequest) (*pb.CreateThingsBoardIntegrationResponse, error) {
+	i, err := getThingsBoardIntegration(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("ThingsBoardIntegration.Create"),
+		auth.ValidateApplication(i.AppEUI),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if err := storage.CreateThingsBoardIntegration(a.ctx.DB, i); err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+	return &pb.CreateThingsBoardIntegrationResponse{}, nil
+}
+
+// Get returns the ThingsBoard integration of the given application.
+func (a *ThingsBoardIntegrationAPI) Get(ctx context.Context, req *pb.GetThingsBoardIntegrationRequest) (*pb.GetThingsBoardIntegrationResponse, error) {
+	var appEUI lorawan.EUI64
+	if err := appEUI.UnmarshalText([]byte(req.AppEUI)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("ThingsBoardIntegration.Get"),
+		auth.ValidateApplication(appEUI),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	i, err := storage.GetThingsBoardIntegration(a.ctx.DB, appEUI)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+	if i == nil {
+		return nil, grpc.Errorf(codes.NotFound, "thingsboard integration %s does not exist", appEUI)
+	}
+
+	return &pb.GetThingsBoardIntegrationResponse{
+		AppEUI: i.AppEUI.String(),
+		Server: i.Server,
+	}, nil
+}
+
+// Update updates the ThingsBoard integration of the given application.
+func (a *ThingsBoardIntegrationAPI) Update(ctx context.Context, req *pb.UpdateThingsBoardIntegrationRequest) (*pb.UpdateThingsBoardIntegrationResponse, error) {
+	i, err := getThingsBoardIntegration(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("ThingsBoardIntegration.Update"),
+		auth.ValidateApplication(i.AppEUI),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if err := storage.UpdateThingsBoardIntegration(a.ctx.DB, i); err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+	return &pb.UpdateThingsBoardIntegrationResponse{}, nil
+}
+
+// Delete deletes the ThingsBoard integration of the given application.
+func (a *ThingsBoardIntegrationAPI) Delete(ctx context.Context, req *pb.DeleteThingsBoardIntegrationRequest) (*pb.DeleteThingsBoardIntegrationResponse, error) {
+	var appEUI lorawan.EUI64
+	if err := appEUI.UnmarshalText([]byte(req.AppEUI)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("ThingsBoardIntegration.Delete"),
+		auth.ValidateApplication(appEUI),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if err := storage.DeleteThingsBoardIntegration(a.ctx.DB, appEUI); err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+	return &pb.DeleteThingsBoardIntegrationResponse{}, nil
+}
+
+// getThingsBoardIntegration validates the given request and returns the
+// ThingsBoardIntegration.
+func getThingsBoardIntegration(req thingsBoardIntegrationRequest) (storage.ThingsBoardIntegration, error) {
+	i := storage.ThingsBoardIntegration{
+		Server: req.GetServer(),
+	}
+
+	if err := i.AppEUI.UnmarshalText([]byte(req.GetAppEUI())); err != nil {
+		return i, grpc.Errorf(codes.InvalidArgument, err.Error())
+	}
+	if err := validateNotificationURL(i.Server); err != nil {
+		return i, grpc.Errorf(codes.InvalidArgument, "server: %s", err)
+	}
+
+	return i, nil
+}
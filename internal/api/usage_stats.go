@@ -0,0 +1,152 @@
+package api
+
+import (
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/brocaar/lora-app-server/api"
+	"github.com/brocaar/lora-app-server/internal/api/auth"
+	"github.com/brocaar/lora-app-server/internal/common"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lora-app-server/internal/usage"
+	"github.com/brocaar/lorawan"
+)
+
+// UsageStatsAPI exports the usage statistics functions.
+type UsageStatsAPI struct {
+	ctx       common.Context
+	validator auth.Validator
+}
+
+// NewUsageStatsAPI creates a new UsageStatsAPI.
+func NewUsageStatsAPI(ctx common.Context, validator auth.Validator) *UsageStatsAPI {
+	return &UsageStatsAPI{
+		ctx:       ctx,
+		validator: validator,
+	}
+}
+
+// GetNodeStats returns the usage statistics of the given node.
+func (a *UsageStatsAPI) GetNodeStats(ctx context.Context, req *pb.GetNodeUsageStatsRequest) (*pb.GetUsageStatsResponse, error) {
+	var devEUI lorawan.EUI64
+	if err := devEUI.UnmarshalText([]byte(req.DevEUI)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	node, err := storage.GetNode(a.ctx.DB, devEUI)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("UsageStats.GetNodeStats"),
+		auth.ValidateApplication(node.AppEUI),
+		auth.ValidateNode(node.DevEUI),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	return a.getStats(req.Start, req.End, req.Interval, func(start, end time.Time, interval storage.AggregationInterval) ([]storage.UsageStats, error) {
+		return storage.GetNodeUsageStats(a.ctx.DB, node.AppEUI, node.DevEUI, start, end, interval)
+	})
+}
+
+// GetApplicationStats returns the usage statistics of the given application.
+func (a *UsageStatsAPI) GetApplicationStats(ctx context.Context, req *pb.GetApplicationUsageStatsRequest) (*pb.GetUsageStatsResponse, error) {
+	var appEUI lorawan.EUI64
+	if err := appEUI.UnmarshalText([]byte(req.AppEUI)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("UsageStats.GetApplicationStats"),
+		auth.ValidateApplication(appEUI),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	return a.getStats(req.Start, req.End, req.Interval, func(start, end time.Time, interval storage.AggregationInterval) ([]storage.UsageStats, error) {
+		return storage.GetApplicationUsageStats(a.ctx.DB, appEUI, start, end, interval)
+	})
+}
+
+// ListNodeStats returns the usage statistics of each node of the given
+// application, sorted by the number of uplinks (least first).
+func (a *UsageStatsAPI) ListNodeStats(ctx context.Context, req *pb.ListNodeUsageStatsRequest) (*pb.ListNodeUsageStatsResponse, error) {
+	var appEUI lorawan.EUI64
+	if err := appEUI.UnmarshalText([]byte(req.AppEUI)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	start, end, err := parsePeriod(req.Start, req.End)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIMethod("UsageStats.ListNodeStats"),
+		auth.ValidateApplication(appEUI),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	stats, err := storage.GetUsageStatsPerNode(a.ctx.DB, appEUI, start, end)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+
+	var resp pb.ListNodeUsageStatsResponse
+	for _, s := range stats {
+		resp.Result = append(resp.Result, &pb.NodeUsageStats{
+			DevEUI: s.DevEUI.String(),
+			Stats:  usageStatsToPB(s),
+		})
+	}
+	return &resp, nil
+}
+
+func (a *UsageStatsAPI) getStats(startStr, endStr string, interval pb.AggregationInterval, get func(start, end time.Time, interval storage.AggregationInterval) ([]storage.UsageStats, error)) (*pb.GetUsageStatsResponse, error) {
+	start, end, err := parsePeriod(startStr, endStr)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	var aggInterval storage.AggregationInterval
+	switch interval {
+	case pb.AggregationInterval_PERIOD:
+		aggInterval = storage.AggregatePeriod
+	case pb.AggregationInterval_HOUR:
+		aggInterval = storage.AggregateHour
+	case pb.AggregationInterval_DAY:
+		aggInterval = storage.AggregateDay
+	default:
+		return nil, grpc.Errorf(codes.InvalidArgument, "invalid interval: %s", interval)
+	}
+
+	stats, err := get(start, end, aggInterval)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unknown, err.Error())
+	}
+
+	var resp pb.GetUsageStatsResponse
+	for _, s := range stats {
+		resp.Result = append(resp.Result, usageStatsToPB(s))
+	}
+	return &resp, nil
+}
+
+func usageStatsToPB(s storage.UsageStats) *pb.UsageStatsEntry {
+	pl := usage.NewStatsPayload(s)
+	return &pb.UsageStatsEntry{
+		Timestamp:   pl.Time.Format(time.RFC3339),
+		RxPackets:   uint32(pl.RXPackets),
+		AvgRSSI:     pl.AvgRSSI,
+		AvgLoRaSNR:  pl.AvgLoRaSNR,
+		TxPackets:   uint32(pl.TXPackets),
+		Errors:      uint32(pl.Errors),
+		ActiveNodes: uint32(pl.ActiveNodes),
+	}
+}
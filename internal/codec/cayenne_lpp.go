@@ -0,0 +1,250 @@
+package codec
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+)
+
+// Cayenne LPP data types (IPSO object id - 3200).
+const (
+	lppDigitalInput      byte = 0
+	lppDigitalOutput     byte = 1
+	lppAnalogInput       byte = 2
+	lppAnalogOutput      byte = 3
+	lppIlluminanceSensor byte = 101
+	lppPresenceSensor    byte = 102
+	lppTemperatureSensor byte = 103
+	lppHumiditySensor    byte = 104
+	lppAccelerometer     byte = 113
+	lppBarometer         byte = 115
+	lppGyrometer         byte = 134
+	lppGPSLocation       byte = 136
+)
+
+// lppSizes contains the size (in bytes) of the value of each data type.
+var lppSizes = map[byte]int{
+	lppDigitalInput:      1,
+	lppDigitalOutput:     1,
+	lppAnalogInput:       2,
+	lppAnalogOutput:      2,
+	lppIlluminanceSensor: 2,
+	lppPresenceSensor:    1,
+	lppTemperatureSensor: 2,
+	lppHumiditySensor:    1,
+	lppAccelerometer:     6,
+	lppBarometer:         2,
+	lppGyrometer:         6,
+	lppGPSLocation:       9,
+}
+
+// Accelerometer contains the accelerometer values (G).
+type Accelerometer struct {
+	X float64 `json:"x"`
+	Y float64 `json:"y"`
+	Z float64 `json:"z"`
+}
+
+// Gyrometer contains the gyrometer values (degrees / second).
+type Gyrometer struct {
+	X float64 `json:"x"`
+	Y float64 `json:"y"`
+	Z float64 `json:"z"`
+}
+
+// GPSLocation contains the GPS location.
+type GPSLocation struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+	Altitude  float64 `json:"altitude"` // meters
+}
+
+// CayenneLPP contains the Cayenne LPP values, per data type by channel.
+type CayenneLPP struct {
+	DigitalInput      map[uint8]uint8         `json:"digitalInput,omitempty"`
+	DigitalOutput     map[uint8]uint8         `json:"digitalOutput,omitempty"`
+	AnalogInput       map[uint8]float64       `json:"analogInput,omitempty"`
+	AnalogOutput      map[uint8]float64       `json:"analogOutput,omitempty"`
+	IlluminanceSensor map[uint8]uint16        `json:"illuminanceSensor,omitempty"` // lux
+	PresenceSensor    map[uint8]uint8         `json:"presenceSensor,omitempty"`
+	TemperatureSensor map[uint8]float64       `json:"temperatureSensor,omitempty"` // degrees celsius
+	HumiditySensor    map[uint8]float64       `json:"humiditySensor,omitempty"`    // percentage
+	Accelerometer     map[uint8]Accelerometer `json:"accelerometer,omitempty"`
+	Barometer         map[uint8]float64       `json:"barometer,omitempty"` // hPa
+	Gyrometer         map[uint8]Gyrometer     `json:"gyrometer,omitempty"`
+	GPSLocation       map[uint8]GPSLocation   `json:"gpsLocation,omitempty"`
+}
+
+// CayenneLPPCodec implements the Cayenne Low Power Payload codec.
+type CayenneLPPCodec struct{}
+
+// Decode decodes the given Cayenne LPP payload into a CayenneLPP object.
+func (c CayenneLPPCodec) Decode(fPort uint8, b []byte) (interface{}, error) {
+	lpp := CayenneLPP{
+		DigitalInput:      make(map[uint8]uint8),
+		DigitalOutput:     make(map[uint8]uint8),
+		AnalogInput:       make(map[uint8]float64),
+		AnalogOutput:      make(map[uint8]float64),
+		IlluminanceSensor: make(map[uint8]uint16),
+		PresenceSensor:    make(map[uint8]uint8),
+		TemperatureSensor: make(map[uint8]float64),
+		HumiditySensor:    make(map[uint8]float64),
+		Accelerometer:     make(map[uint8]Accelerometer),
+		Barometer:         make(map[uint8]float64),
+		Gyrometer:         make(map[uint8]Gyrometer),
+		GPSLocation:       make(map[uint8]GPSLocation),
+	}
+
+	for len(b) > 0 {
+		if len(b) < 2 {
+			return nil, errors.New("cayenne lpp: unexpected end of payload")
+		}
+		channel, dataType := b[0], b[1]
+		size, ok := lppSizes[dataType]
+		if !ok {
+			return nil, fmt.Errorf("cayenne lpp: invalid data type: %d", dataType)
+		}
+		if len(b) < 2+size {
+			return nil, fmt.Errorf("cayenne lpp: expected %d bytes for data type %d", size, dataType)
+		}
+		v := b[2 : 2+size]
+		b = b[2+size:]
+
+		switch dataType {
+		case lppDigitalInput:
+			lpp.DigitalInput[channel] = v[0]
+		case lppDigitalOutput:
+			lpp.DigitalOutput[channel] = v[0]
+		case lppAnalogInput:
+			lpp.AnalogInput[channel] = float64(int16(binary.BigEndian.Uint16(v))) / 100
+		case lppAnalogOutput:
+			lpp.AnalogOutput[channel] = float64(int16(binary.BigEndian.Uint16(v))) / 100
+		case lppIlluminanceSensor:
+			lpp.IlluminanceSensor[channel] = binary.BigEndian.Uint16(v)
+		case lppPresenceSensor:
+			lpp.PresenceSensor[channel] = v[0]
+		case lppTemperatureSensor:
+			lpp.TemperatureSensor[channel] = float64(int16(binary.BigEndian.Uint16(v))) / 10
+		case lppHumiditySensor:
+			lpp.HumiditySensor[channel] = float64(v[0]) / 2
+		case lppAccelerometer:
+			lpp.Accelerometer[channel] = Accelerometer{
+				X: float64(int16(binary.BigEndian.Uint16(v[0:2]))) / 1000,
+				Y: float64(int16(binary.BigEndian.Uint16(v[2:4]))) / 1000,
+				Z: float64(int16(binary.BigEndian.Uint16(v[4:6]))) / 1000,
+			}
+		case lppBarometer:
+			lpp.Barometer[channel] = float64(binary.BigEndian.Uint16(v)) / 10
+		case lppGyrometer:
+			lpp.Gyrometer[channel] = Gyrometer{
+				X: float64(int16(binary.BigEndian.Uint16(v[0:2]))) / 100,
+				Y: float64(int16(binary.BigEndian.Uint16(v[2:4]))) / 100,
+				Z: float64(int16(binary.BigEndian.Uint16(v[4:6]))) / 100,
+			}
+		case lppGPSLocation:
+			lpp.GPSLocation[channel] = GPSLocation{
+				Latitude:  float64(int24(v[0:3])) / 10000,
+				Longitude: float64(int24(v[3:6])) / 10000,
+				Altitude:  float64(int24(v[6:9])) / 100,
+			}
+		}
+	}
+
+	return lpp, nil
+}
+
+// Encode encodes the given CayenneLPP object (JSON encoded) into a Cayenne
+// LPP payload. The values are encoded ordered by data type and channel.
+func (c CayenneLPPCodec) Encode(fPort uint8, obj json.RawMessage) ([]byte, error) {
+	var lpp CayenneLPP
+	if err := json.Unmarshal(obj, &lpp); err != nil {
+		return nil, fmt.Errorf("cayenne lpp: unmarshal object error: %s", err)
+	}
+
+	var b []byte
+	for _, ch := range uint8Keys(lpp.DigitalInput) {
+		b = append(b, ch, lppDigitalInput, lpp.DigitalInput[ch])
+	}
+	for _, ch := range uint8Keys(lpp.DigitalOutput) {
+		b = append(b, ch, lppDigitalOutput, lpp.DigitalOutput[ch])
+	}
+	for _, ch := range uint8Keys(lpp.AnalogInput) {
+		b = append(append(b, ch, lppAnalogInput), int16Bytes(lpp.AnalogInput[ch]*100)...)
+	}
+	for _, ch := range uint8Keys(lpp.AnalogOutput) {
+		b = append(append(b, ch, lppAnalogOutput), int16Bytes(lpp.AnalogOutput[ch]*100)...)
+	}
+	for _, ch := range uint8Keys(lpp.IlluminanceSensor) {
+		b = append(b, ch, lppIlluminanceSensor, byte(lpp.IlluminanceSensor[ch]>>8), byte(lpp.IlluminanceSensor[ch]))
+	}
+	for _, ch := range uint8Keys(lpp.PresenceSensor) {
+		b = append(b, ch, lppPresenceSensor, lpp.PresenceSensor[ch])
+	}
+	for _, ch := range uint8Keys(lpp.TemperatureSensor) {
+		b = append(append(b, ch, lppTemperatureSensor), int16Bytes(lpp.TemperatureSensor[ch]*10)...)
+	}
+	for _, ch := range uint8Keys(lpp.HumiditySensor) {
+		b = append(b, ch, lppHumiditySensor, byte(math.Floor(lpp.HumiditySensor[ch]*2+0.5)))
+	}
+	for _, ch := range uint8Keys(lpp.Accelerometer) {
+		v := lpp.Accelerometer[ch]
+		b = append(b, ch, lppAccelerometer)
+		b = append(b, int16Bytes(v.X*1000)...)
+		b = append(b, int16Bytes(v.Y*1000)...)
+		b = append(b, int16Bytes(v.Z*1000)...)
+	}
+	for _, ch := range uint8Keys(lpp.Barometer) {
+		v := uint16(math.Floor(lpp.Barometer[ch]*10 + 0.5))
+		b = append(b, ch, lppBarometer, byte(v>>8), byte(v))
+	}
+	for _, ch := range uint8Keys(lpp.Gyrometer) {
+		v := lpp.Gyrometer[ch]
+		b = append(b, ch, lppGyrometer)
+		b = append(b, int16Bytes(v.X*100)...)
+		b = append(b, int16Bytes(v.Y*100)...)
+		b = append(b, int16Bytes(v.Z*100)...)
+	}
+	for _, ch := range uint8Keys(lpp.GPSLocation) {
+		v := lpp.GPSLocation[ch]
+		b = append(b, ch, lppGPSLocation)
+		b = append(b, int24Bytes(v.Latitude*10000)...)
+		b = append(b, int24Bytes(v.Longitude*10000)...)
+		b = append(b, int24Bytes(v.Altitude*100)...)
+	}
+
+	return b, nil
+}
+
+// int24 returns the signed (big endian) 24 bit integer of the given bytes.
+func int24(b []byte) int32 {
+	return int32(uint32(b[0])<<24|uint32(b[1])<<16|uint32(b[2])<<8) >> 8
+}
+
+// int16Bytes returns the (big endian) bytes of the given value, rounded
+// to a signed 16 bit integer.
+func int16Bytes(f float64) []byte {
+	v := uint16(int16(math.Floor(f + 0.5)))
+	return []byte{byte(v >> 8), byte(v)}
+}
+
+// int24Bytes returns the (big endian) bytes of the given value, rounded
+// to a signed 24 bit integer.
+func int24Bytes(f float64) []byte {
+	v := uint32(int32(math.Floor(f + 0.5)))
+	return []byte{byte(v >> 16), byte(v >> 8), byte(v)}
+}
+
+// uint8Keys returns the sorted keys of the given map (which must have
+// uint8 keys).
+func uint8Keys(m interface{}) []uint8 {
+	var keys []uint8
+	for _, k := range reflect.ValueOf(m).MapKeys() {
+		keys = append(keys, uint8(k.Uint()))
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	return keys
+}
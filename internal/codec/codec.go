@@ -0,0 +1,75 @@
+// Package codec implements the payload codecs, encoding and decoding the
+// (binary) node payloads to and from JSON objects. The codec is configured
+// per application.
+package codec
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+// Available codec types.
+const (
+	TypeCayenneLPP = "CAYENNE_LPP"
+	TypeCustomJS   = "CUSTOM_JS"
+)
+
+// Types contains all the codec types.
+var Types = []string{
+	TypeCayenneLPP,
+	TypeCustomJS,
+}
+
+// Codec defines the interface of a payload codec.
+type Codec interface {
+	// Decode decodes the given payload into an object which can be
+	// marshaled into JSON.
+	Decode(fPort uint8, b []byte) (interface{}, error)
+	// Encode encodes the given JSON object into a payload.
+	Encode(fPort uint8, obj json.RawMessage) ([]byte, error)
+}
+
+// New returns a new Codec given the codec type and the scripts (only used
+// by the custom JavaScript codec).
+func New(codecType, decodeScript, encodeScript string) (Codec, error) {
+	switch codecType {
+	case TypeCayenneLPP:
+		return CayenneLPPCodec{}, nil
+	case TypeCustomJS:
+		return NewCustomJSCodec(decodeScript, encodeScript)
+	default:
+		return nil, fmt.Errorf("invalid codec type: %s", codecType)
+	}
+}
+
+// GetCodec returns the Codec of the given application. When the
+// application doesn't have a payload codec, nil is returned.
+func GetCodec(db *sqlx.DB, appEUI lorawan.EUI64) (Codec, error) {
+	pc, err := storage.GetPayloadCodec(db, appEUI)
+	if err != nil || pc == nil {
+		return nil, err
+	}
+	return New(pc.CodecType, pc.DecodeScript, pc.EncodeScript)
+}
+
+// EncodeObject encodes the given JSON object into a payload for the given
+// node, using the Codec of the application of the node.
+func EncodeObject(db *sqlx.DB, devEUI lorawan.EUI64, fPort uint8, obj json.RawMessage) ([]byte, error) {
+	node, err := storage.GetNode(db, devEUI)
+	if err != nil {
+		return nil, err
+	}
+	c, err := GetCodec(db, node.AppEUI)
+	if err != nil {
+		return nil, err
+	}
+	if c == nil {
+		return nil, fmt.Errorf("application %s has no payload codec", node.AppEUI)
+	}
+	return c.Encode(fPort, obj)
+}
@@ -0,0 +1,114 @@
+package codec
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestCayenneLPPCodec(t *testing.T) {
+	Convey("Given a CayenneLPPCodec", t, func() {
+		var c CayenneLPPCodec
+
+		Convey("When decoding a payload with two temperature sensors", func() {
+			obj, err := c.Decode(1, []byte{3, 103, 1, 16, 5, 103, 0, 255})
+			So(err, ShouldBeNil)
+
+			Convey("Then the expected object is returned", func() {
+				b, err := json.Marshal(obj)
+				So(err, ShouldBeNil)
+				So(string(b), ShouldEqual, `{"temperatureSensor":{"3":27.2,"5":25.5}}`)
+			})
+		})
+
+		Convey("When decoding a payload with a GPS location", func() {
+			obj, err := c.Decode(1, []byte{1, 136, 6, 118, 95, 242, 150, 10, 0, 3, 232})
+			So(err, ShouldBeNil)
+
+			Convey("Then the expected location is returned", func() {
+				So(obj.(CayenneLPP).GPSLocation[1], ShouldResemble, GPSLocation{
+					Latitude:  42.3519,
+					Longitude: -87.9094,
+					Altitude:  10,
+				})
+			})
+		})
+
+		Convey("When decoding an invalid payload", func() {
+			_, err := c.Decode(1, []byte{1, 103, 1})
+
+			Convey("Then an error is returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When encoding an object", func() {
+			b, err := c.Encode(1, json.RawMessage(`{"digitalOutput":{"2":1},"humiditySensor":{"6":48.5}}`))
+			So(err, ShouldBeNil)
+
+			Convey("Then the expected payload is returned", func() {
+				So(b, ShouldResemble, []byte{2, 1, 1, 6, 104, 97})
+			})
+		})
+	})
+}
+
+func TestCustomJSCodec(t *testing.T) {
+	Convey("Given a CustomJSCodec", t, func() {
+		c, err := NewCustomJSCodec(`
+			function Decode(fPort, bytes) {
+				return {"fPort": fPort, "temperature": (bytes[0] << 8 | bytes[1]) / 10};
+			}`, `
+			function Encode(fPort, obj) {
+				return [obj.led ? 1 : 0];
+			}`,
+		)
+		So(err, ShouldBeNil)
+
+		Convey("When decoding a payload", func() {
+			obj, err := c.Decode(2, []byte{1, 16})
+			So(err, ShouldBeNil)
+
+			Convey("Then the expected object is returned", func() {
+				b, err := json.Marshal(obj)
+				So(err, ShouldBeNil)
+				So(string(b), ShouldEqual, `{"fPort":2,"temperature":27.2}`)
+			})
+		})
+
+		Convey("When encoding an object", func() {
+			b, err := c.Encode(2, json.RawMessage(`{"led": true}`))
+			So(err, ShouldBeNil)
+
+			Convey("Then the expected payload is returned", func() {
+				So(b, ShouldResemble, []byte{1})
+			})
+		})
+	})
+
+	Convey("Given a CustomJSCodec with a never ending Decode function", t, func() {
+		c, err := NewCustomJSCodec(`function Decode(fPort, bytes) { while (true) {} }`, "")
+		So(err, ShouldBeNil)
+
+		Convey("Then decoding a payload returns a timeout error", func() {
+			_, err := c.Decode(1, []byte{1})
+			So(err, ShouldNotBeNil)
+		})
+	})
+
+	Convey("Given a CustomJSCodec with an Encode function returning a huge array", t, func() {
+		c, err := NewCustomJSCodec("", `function Encode(fPort, obj) { var a = []; a.length = 4294967295; return a; }`)
+		So(err, ShouldBeNil)
+
+		Convey("Then encoding an object returns an error", func() {
+			_, err := c.Encode(1, json.RawMessage(`{}`))
+			So(err, ShouldNotBeNil)
+		})
+	})
+
+	Convey("Then creating a CustomJSCodec with an invalid script returns an error", t, func() {
+		_, err := NewCustomJSCodec(`function Decode(`, "")
+		So(err, ShouldNotBeNil)
+	})
+}
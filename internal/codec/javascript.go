@@ -0,0 +1,162 @@
+package codec
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/robertkrimen/otto"
+)
+
+// jsTimeout defines the maximum execution time of a script.
+const jsTimeout = 100 * time.Millisecond
+
+// maxPayloadSize defines the maximum size of an encoded payload (the max
+// LoRaWAN FRMPayload size).
+const maxPayloadSize = 255
+
+var errJSTimeout = errors.New("execution timeout")
+
+// CustomJSCodec implements a codec using user-supplied JavaScript
+// functions. The decode script must define a Decode(fPort, bytes) function
+// returning an object, the encode script an Encode(fPort, obj) function
+// returning an array of bytes.
+type CustomJSCodec struct {
+	decodeScript *otto.Script
+	encodeScript *otto.Script
+}
+
+// NewCustomJSCodec creates a new CustomJSCodec. An empty script disables
+// the decoding or encoding.
+func NewCustomJSCodec(decodeScript, encodeScript string) (*CustomJSCodec, error) {
+	var c CustomJSCodec
+	var err error
+
+	if decodeScript != "" {
+		if c.decodeScript, err = otto.New().Compile("decode.js", decodeScript); err != nil {
+			return nil, fmt.Errorf("custom js: compile decode script error: %s", err)
+		}
+	}
+	if encodeScript != "" {
+		if c.encodeScript, err = otto.New().Compile("encode.js", encodeScript); err != nil {
+			return nil, fmt.Errorf("custom js: compile encode script error: %s", err)
+		}
+	}
+
+	return &c, nil
+}
+
+// Decode calls the Decode function of the decode script.
+func (c *CustomJSCodec) Decode(fPort uint8, b []byte) (interface{}, error) {
+	if c.decodeScript == nil {
+		return nil, errors.New("custom js: no decode script")
+	}
+
+	bytes := make([]interface{}, len(b))
+	for i := range b {
+		bytes[i] = int(b[i])
+	}
+
+	v, err := run(c.decodeScript, func(vm *otto.Otto) (otto.Value, error) {
+		return vm.Call("Decode", nil, int(fPort), bytes)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("custom js: decode error: %s", err)
+	}
+
+	obj, err := v.Export()
+	if err != nil {
+		return nil, fmt.Errorf("custom js: export decoded object error: %s", err)
+	}
+	return obj, nil
+}
+
+// Encode calls the Encode function of the encode script. The returned
+// array is converted within the execution timeout, as reading its elements
+// may call script functions (e.g. getters).
+func (c *CustomJSCodec) Encode(fPort uint8, obj json.RawMessage) ([]byte, error) {
+	if c.encodeScript == nil {
+		return nil, errors.New("custom js: no encode script")
+	}
+
+	var b []byte
+	_, err := run(c.encodeScript, func(vm *otto.Otto) (otto.Value, error) {
+		jsObj, err := vm.Call("JSON.parse", nil, string(obj))
+		if err != nil {
+			return otto.Value{}, fmt.Errorf("parse object error: %s", err)
+		}
+		v, err := vm.Call("Encode", nil, int(fPort), jsObj)
+		if err != nil {
+			return v, err
+		}
+		b, err = arrayToBytes(v)
+		return v, err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("custom js: encode error: %s", err)
+	}
+	return b, nil
+}
+
+// arrayToBytes converts the given array (returned by Encode) to bytes.
+// Arrays exceeding maxPayloadSize are rejected before allocating.
+func arrayToBytes(v otto.Value) ([]byte, error) {
+	if !v.IsObject() || v.Class() != "Array" {
+		return nil, errors.New("Encode must return an array of bytes")
+	}
+	length, err := v.Object().Get("length")
+	if err != nil {
+		return nil, fmt.Errorf("get array length error: %s", err)
+	}
+	n, err := length.ToInteger()
+	if err != nil {
+		return nil, fmt.Errorf("get array length error: %s", err)
+	}
+	if n < 0 || n > maxPayloadSize {
+		return nil, fmt.Errorf("array length %d exceeds the max payload size of %d bytes", n, maxPayloadSize)
+	}
+
+	b := make([]byte, n)
+	for i := range b {
+		el, err := v.Object().Get(fmt.Sprintf("%d", i))
+		if err != nil {
+			return nil, fmt.Errorf("get array element error: %s", err)
+		}
+		el64, err := el.ToInteger()
+		if err != nil || el64 < 0 || el64 > 255 {
+			return nil, fmt.Errorf("array element %d is not a byte: %s", i, el)
+		}
+		b[i] = byte(el64)
+	}
+	return b, nil
+}
+
+// run runs the given script in a new VM and then calls the given function.
+// The execution is interrupted after jsTimeout.
+func run(script *otto.Script, f func(vm *otto.Otto) (otto.Value, error)) (v otto.Value, err error) {
+	vm := otto.New()
+	vm.Interrupt = make(chan func(), 1)
+
+	defer func() {
+		if caught := recover(); caught != nil {
+			if caught == errJSTimeout {
+				err = errJSTimeout
+				return
+			}
+			panic(caught)
+		}
+	}()
+
+	timer := time.AfterFunc(jsTimeout, func() {
+		vm.Interrupt <- func() {
+			panic(errJSTimeout)
+		}
+	})
+	defer timer.Stop()
+
+	if _, err := vm.Run(script); err != nil {
+		return v, err
+	}
+	return f(vm)
+}
@@ -1,15 +1,29 @@
 package common
 
 import (
-	"github.com/brocaar/lora-app-server/internal/handler"
+	"github.com/brocaar/lora-app-server/integration"
+	"github.com/brocaar/lora-app-server/internal/alert"
+	"github.com/brocaar/lora-app-server/internal/lifecycle"
+	"github.com/brocaar/lora-app-server/internal/lockstore"
 	"github.com/brocaar/loraserver/api/ns"
+	"github.com/brocaar/lorawan"
 	"github.com/garyburd/redigo/redis"
 	"github.com/jmoiron/sqlx"
 )
 
+// DownlinkLimiter defines the interface for rate limiting the downlink
+// payloads enqueued by the applications.
+type DownlinkLimiter interface {
+	LimitDownlink(appEUI lorawan.EUI64, pl integration.DataDownPayload) error
+}
+
 type Context struct {
-	DB            *sqlx.DB
-	RedisPool     *redis.Pool
-	NetworkServer ns.NetworkServerClient
-	Handler       handler.Handler
+	DB              *sqlx.DB
+	RedisPool       *redis.Pool
+	Locks           lockstore.Store // optional
+	NetworkServer   ns.NetworkServerClient
+	Handler         integration.Handler
+	Alerter         alert.Alerter       // optional
+	Lifecycle       lifecycle.Publisher // optional
+	DownlinkLimiter DownlinkLimiter     // optional
 }
@@ -0,0 +1,89 @@
+// Package deadletter implements the persistence of the events which could
+// not be delivered by a handler (integration), so that they can be inspected
+// and replayed after the fact.
+package deadletter
+
+import (
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+// Store defines the interface of the dead-letter storage backend.
+type Store interface {
+	// Add stores the given dead letter.
+	Add(d storage.DeadLetter) error
+
+	// List returns the dead letters of the given application (oldest
+	// first) and the total number of dead letters. When handler is not
+	// empty, only the dead letters of the given handler are returned.
+	List(appEUI lorawan.EUI64, handler string, limit, offset int) ([]storage.DeadLetter, int, error)
+
+	// Get returns the dead letter matching the given id.
+	Get(id int64) (storage.DeadLetter, error)
+
+	// Delete deletes the dead letter matching the given id.
+	Delete(id int64) error
+
+	// DeleteBefore deletes the dead letters created before the given time.
+	DeleteBefore(before time.Time) error
+}
+
+// PostgreSQLStore implements a Store using the PostgreSQL database.
+type PostgreSQLStore struct {
+	db *sqlx.DB
+}
+
+// NewPostgreSQLStore creates a new PostgreSQLStore.
+func NewPostgreSQLStore(db *sqlx.DB) *PostgreSQLStore {
+	return &PostgreSQLStore{db: db}
+}
+
+// Add stores the given dead letter.
+func (s *PostgreSQLStore) Add(d storage.DeadLetter) error {
+	return storage.CreateDeadLetter(s.db, &d)
+}
+
+// List returns the dead letters of the given application (oldest first) and
+// the total number of dead letters.
+func (s *PostgreSQLStore) List(appEUI lorawan.EUI64, handler string, limit, offset int) ([]storage.DeadLetter, int, error) {
+	count, err := storage.GetDeadLetterCount(s.db, appEUI, handler)
+	if err != nil {
+		return nil, 0, err
+	}
+	letters, err := storage.GetDeadLetters(s.db, appEUI, handler, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	return letters, count, nil
+}
+
+// Get returns the dead letter matching the given id.
+func (s *PostgreSQLStore) Get(id int64) (storage.DeadLetter, error) {
+	return storage.GetDeadLetter(s.db, id)
+}
+
+// Delete deletes the dead letter matching the given id.
+func (s *PostgreSQLStore) Delete(id int64) error {
+	return storage.DeleteDeadLetter(s.db, id)
+}
+
+// DeleteBefore deletes the dead letters created before the given time.
+func (s *PostgreSQLStore) DeleteBefore(before time.Time) error {
+	return storage.DeleteDeadLettersBefore(s.db, before)
+}
+
+// RunCleanup deletes the dead letters older than the given retention period
+// from the given store, every hour.
+func RunCleanup(s Store, retention time.Duration) {
+	for {
+		if err := s.DeleteBefore(time.Now().Add(-retention)); err != nil {
+			log.Errorf("deadletter: cleanup error: %s", err)
+		}
+		time.Sleep(time.Hour)
+	}
+}
@@ -0,0 +1,200 @@
+package deadletter
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/garyburd/redigo/redis"
+
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+const (
+	redisIDKey         = "lora:as:deadletter:id"
+	redisKeyTempl      = "lora:as:deadletter:%d"
+	redisAppKeyTempl   = "lora:as:deadletter:app:%s"
+	redisAppKeyPattern = "lora:as:deadletter:app:*"
+)
+
+// RedisStore implements a Store using Redis. Each dead letter is stored
+// (JSON encoded) under its own key, which expires after the retention
+// period. Per application, a sorted set (scored by creation time) contains
+// the ids of its dead letters.
+type RedisStore struct {
+	pool      *redis.Pool
+	retention time.Duration
+}
+
+// NewRedisStore creates a new RedisStore.
+func NewRedisStore(p *redis.Pool, retention time.Duration) *RedisStore {
+	return &RedisStore{
+		pool:      p,
+		retention: retention,
+	}
+}
+
+// Add stores the given dead letter.
+func (s *RedisStore) Add(d storage.DeadLetter) error {
+	c := s.pool.Get()
+	defer c.Close()
+
+	id, err := redis.Int64(c.Do("INCR", redisIDKey))
+	if err != nil {
+		return fmt.Errorf("get dead letter id error: %s", err)
+	}
+	d.ID = id
+	d.CreatedAt = time.Now()
+
+	b, err := json.Marshal(d)
+	if err != nil {
+		return fmt.Errorf("marshal dead letter error: %s", err)
+	}
+
+	ttl := int64(s.retention / time.Millisecond)
+	appKey := fmt.Sprintf(redisAppKeyTempl, d.AppEUI)
+	c.Send("MULTI")
+	c.Send("SET", fmt.Sprintf(redisKeyTempl, d.ID), b, "PX", ttl)
+	c.Send("ZADD", appKey, d.CreatedAt.UnixNano(), d.ID)
+	c.Send("PEXPIRE", appKey, ttl)
+	if _, err := c.Do("EXEC"); err != nil {
+		return fmt.Errorf("create dead letter error: %s", err)
+	}
+
+	log.WithFields(log.Fields{
+		"id":      d.ID,
+		"handler": d.Handler,
+		"dev_eui": d.DevEUI,
+	}).Info("dead letter created")
+	return nil
+}
+
+// List returns the dead letters of the given application (oldest first) and
+// the total number of dead letters. As the handler is not indexed, the
+// dead letters are filtered after being fetched.
+func (s *RedisStore) List(appEUI lorawan.EUI64, handler string, limit, offset int) ([]storage.DeadLetter, int, error) {
+	c := s.pool.Get()
+	defer c.Close()
+
+	ids, err := redis.Strings(c.Do("ZRANGE", fmt.Sprintf(redisAppKeyTempl, appEUI), 0, -1))
+	if err != nil {
+		return nil, 0, fmt.Errorf("get dead letter ids error: %s", err)
+	}
+	if len(ids) == 0 {
+		return nil, 0, nil
+	}
+
+	keys := make([]interface{}, len(ids))
+	for i, id := range ids {
+		keys[i] = "lora:as:deadletter:" + id
+	}
+	values, err := redis.ByteSlices(c.Do("MGET", keys...))
+	if err != nil {
+		return nil, 0, fmt.Errorf("get dead letters error: %s", err)
+	}
+
+	var letters []storage.DeadLetter
+	for _, b := range values {
+		// the dead letter expired or was deleted
+		if b == nil {
+			continue
+		}
+		var d storage.DeadLetter
+		if err := json.Unmarshal(b, &d); err != nil {
+			return nil, 0, fmt.Errorf("unmarshal dead letter error: %s", err)
+		}
+		if handler != "" && d.Handler != handler {
+			continue
+		}
+		letters = append(letters, d)
+	}
+
+	count := len(letters)
+	if offset >= count {
+		return nil, count, nil
+	}
+	letters = letters[offset:]
+	if limit < len(letters) {
+		letters = letters[:limit]
+	}
+	return letters, count, nil
+}
+
+// Get returns the dead letter matching the given id.
+func (s *RedisStore) Get(id int64) (storage.DeadLetter, error) {
+	var d storage.DeadLetter
+
+	c := s.pool.Get()
+	defer c.Close()
+
+	b, err := redis.Bytes(c.Do("GET", fmt.Sprintf(redisKeyTempl, id)))
+	if err != nil {
+		if err == redis.ErrNil {
+			return d, fmt.Errorf("dead letter %d does not exist", id)
+		}
+		return d, fmt.Errorf("get dead letter %d error: %s", id, err)
+	}
+	if err := json.Unmarshal(b, &d); err != nil {
+		return d, fmt.Errorf("unmarshal dead letter error: %s", err)
+	}
+	return d, nil
+}
+
+// Delete deletes the dead letter matching the given id.
+func (s *RedisStore) Delete(id int64) error {
+	d, err := s.Get(id)
+	if err != nil {
+		return err
+	}
+
+	c := s.pool.Get()
+	defer c.Close()
+
+	c.Send("MULTI")
+	c.Send("DEL", fmt.Sprintf(redisKeyTempl, id))
+	c.Send("ZREM", fmt.Sprintf(redisAppKeyTempl, d.AppEUI), strconv.FormatInt(id, 10))
+	if _, err := c.Do("EXEC"); err != nil {
+		return fmt.Errorf("delete dead letter error: %s", err)
+	}
+	log.WithField("id", id).Info("dead letter deleted")
+	return nil
+}
+
+// DeleteBefore removes the ids of the dead letters created before the given
+// time from the application sets. The dead letters itself expire after the
+// retention period.
+func (s *RedisStore) DeleteBefore(before time.Time) error {
+	c := s.pool.Get()
+	defer c.Close()
+
+	var count int64
+	cursor := "0"
+	for {
+		values, err := redis.Values(c.Do("SCAN", cursor, "MATCH", redisAppKeyPattern, "COUNT", 100))
+		if err != nil {
+			return fmt.Errorf("scan dead letter keys error: %s", err)
+		}
+		var keys []string
+		if _, err := redis.Scan(values, &cursor, &keys); err != nil {
+			return fmt.Errorf("scan dead letter keys error: %s", err)
+		}
+
+		for _, key := range keys {
+			n, err := redis.Int64(c.Do("ZREMRANGEBYSCORE", key, "-inf", "("+strconv.FormatInt(before.UnixNano(), 10)))
+			if err != nil {
+				return fmt.Errorf("delete dead letters error: %s", err)
+			}
+			count += n
+		}
+
+		if cursor == "0" {
+			break
+		}
+	}
+
+	log.WithField("count", count).Info("dead letters deleted")
+	return nil
+}
@@ -0,0 +1,140 @@
+// Package devicestatus implements the handling of the device status
+// (battery and link margin) reported by the nodes in the DevStatusAns
+// mac-command answer.
+package devicestatus
+
+import (
+	"fmt"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+
+	"github.com/brocaar/lora-app-server/integration"
+	"github.com/brocaar/lora-app-server/internal/alert"
+	"github.com/brocaar/lora-app-server/internal/common"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+// Error types used for the error notifications sent when the device status
+// crosses one of the thresholds of the application. The same types are
+// used for the alerts.
+const (
+	ErrorTypeBatteryLow    = alert.TypeBatteryLow
+	ErrorTypeLinkMarginLow = alert.TypeLinkMarginLow
+)
+
+// Threshold contains a device status threshold crossed by a node.
+type Threshold struct {
+	Type    string // ErrorTypeBatteryLow or ErrorTypeLinkMarginLow
+	Message string
+}
+
+// CrossedThresholds returns the thresholds of the given alert that are
+// crossed by the given (current) device status. A threshold is only
+// returned when the previous device status (nil when unknown) was not
+// already below it, so that a node is only reported once until it
+// recovers.
+func CrossedThresholds(a storage.DeviceStatusAlert, battery, linkMargin int, prevBattery, prevLinkMargin *int) []Threshold {
+	var out []Threshold
+
+	if a.MinBatteryLevel != 0 {
+		if level := storage.BatteryLevel(battery); level != nil && *level < a.MinBatteryLevel && !batteryBelow(prevBattery, a.MinBatteryLevel) {
+			out = append(out, Threshold{
+				Type:    ErrorTypeBatteryLow,
+				Message: fmt.Sprintf("battery level %.1f%% is below %.1f%%", *level, a.MinBatteryLevel),
+			})
+		}
+	}
+
+	if a.MinLinkMargin != 0 {
+		if linkMargin < a.MinLinkMargin && (prevLinkMargin == nil || *prevLinkMargin >= a.MinLinkMargin) {
+			out = append(out, Threshold{
+				Type:    ErrorTypeLinkMarginLow,
+				Message: fmt.Sprintf("link margin %d dB is below %d dB", linkMargin, a.MinLinkMargin),
+			})
+		}
+	}
+
+	return out
+}
+
+// batteryBelow returns true when the given battery value is known and its
+// level is below the given threshold.
+func batteryBelow(battery *int, min float64) bool {
+	if battery == nil {
+		return false
+	}
+	level := storage.BatteryLevel(*battery)
+	return level != nil && *level < min
+}
+
+// HandleDeviceStatus stores the device status reported by the given node,
+// sends it to the handler and sends an error notification for every
+// crossed threshold of the application (see CrossedThresholds).
+func HandleDeviceStatus(ctx common.Context, devEUI lorawan.EUI64, battery, linkMargin int) error {
+	node, err := storage.GetNode(ctx.DB, devEUI)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	if err := storage.UpdateNodeDeviceStatus(ctx.DB, devEUI, battery, linkMargin, now); err != nil {
+		return err
+	}
+
+	log.WithFields(log.Fields{
+		"dev_eui":     devEUI,
+		"battery":     battery,
+		"link_margin": linkMargin,
+	}).Info("device status received")
+
+	err = ctx.Handler.SendDeviceStatus(node.AppEUI, devEUI, integration.DeviceStatus{
+		DevEUI:       devEUI,
+		LastSeen:     now,
+		Battery:      &battery,
+		BatteryLevel: storage.BatteryLevel(battery),
+		LinkMargin:   &linkMargin,
+	})
+	if err != nil {
+		return fmt.Errorf("send device status error: %s", err)
+	}
+
+	a, err := storage.GetDeviceStatusAlert(ctx.DB, node.AppEUI)
+	if err != nil {
+		return err
+	}
+	if a == nil {
+		return nil
+	}
+
+	for _, t := range CrossedThresholds(*a, battery, linkMargin, node.Battery, node.LinkMargin) {
+		log.WithFields(log.Fields{
+			"dev_eui": devEUI,
+			"type":    t.Type,
+		}).Warningf("device status threshold crossed: %s", t.Message)
+
+		err = ctx.Handler.SendErrorNotification(node.AppEUI, devEUI, integration.ErrorNotification{
+			DevEUI: devEUI,
+			Type:   t.Type,
+			Error:  t.Message,
+		})
+		if err != nil {
+			return fmt.Errorf("send error notification error: %s", err)
+		}
+
+		if ctx.Alerter != nil {
+			err = ctx.Alerter.Send(alert.Alert{
+				Type:    t.Type,
+				AppEUI:  &node.AppEUI,
+				DevEUI:  &node.DevEUI,
+				Message: fmt.Sprintf("Device status of node %s (%s): %s.", node.Name, node.DevEUI, t.Message),
+			})
+			if err != nil {
+				return fmt.Errorf("send device status alert error: %s", err)
+			}
+		}
+	}
+
+	return nil
+}
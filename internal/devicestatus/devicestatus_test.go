@@ -0,0 +1,91 @@
+package devicestatus
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/brocaar/lora-app-server/internal/storage"
+)
+
+func TestCrossedThresholds(t *testing.T) {
+	Convey("Given a device status alert with a min. battery level of 20% and link margin of 5 dB", t, func() {
+		a := storage.DeviceStatusAlert{
+			MinBatteryLevel: 20,
+			MinLinkMargin:   5,
+		}
+		intPtr := func(i int) *int { return &i }
+
+		testTable := []struct {
+			Description    string
+			Battery        int
+			LinkMargin     int
+			PrevBattery    *int
+			PrevLinkMargin *int
+			Expected       []string
+		}{
+			{
+				Description: "Above the thresholds",
+				Battery:     128,
+				LinkMargin:  10,
+			},
+			{
+				Description: "Below the thresholds, previous status unknown",
+				Battery:     20,
+				LinkMargin:  2,
+				Expected:    []string{ErrorTypeBatteryLow, ErrorTypeLinkMarginLow},
+			},
+			{
+				Description:    "Below the thresholds, previous status above",
+				Battery:        20,
+				LinkMargin:     2,
+				PrevBattery:    intPtr(128),
+				PrevLinkMargin: intPtr(10),
+				Expected:       []string{ErrorTypeBatteryLow, ErrorTypeLinkMarginLow},
+			},
+			{
+				Description:    "Below the thresholds, previous status already below",
+				Battery:        10,
+				LinkMargin:     1,
+				PrevBattery:    intPtr(20),
+				PrevLinkMargin: intPtr(2),
+			},
+			{
+				Description: "External power source",
+				Battery:     0,
+				LinkMargin:  10,
+			},
+			{
+				Description: "Unable to measure",
+				Battery:     255,
+				LinkMargin:  10,
+			},
+			{
+				Description:    "Previous battery was external power source",
+				Battery:        20,
+				LinkMargin:     10,
+				PrevBattery:    intPtr(0),
+				PrevLinkMargin: intPtr(10),
+				Expected:       []string{ErrorTypeBatteryLow},
+			},
+		}
+
+		for _, test := range testTable {
+			Convey("Test: "+test.Description, func() {
+				var types []string
+				for _, th := range CrossedThresholds(a, test.Battery, test.LinkMargin, test.PrevBattery, test.PrevLinkMargin) {
+					types = append(types, th.Type)
+				}
+				So(types, ShouldResemble, test.Expected)
+			})
+		}
+
+		Convey("Given the thresholds are disabled", func() {
+			a := storage.DeviceStatusAlert{}
+
+			Convey("Then no thresholds are crossed", func() {
+				So(CrossedThresholds(a, 2, -20, nil, nil), ShouldBeEmpty)
+			})
+		})
+	})
+}
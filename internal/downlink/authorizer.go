@@ -0,0 +1,43 @@
+// Package downlink implements the downlink related logic of LoRa App Server.
+package downlink
+
+import (
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+// FPortAuthorizer authorizes downlink transmissions using the downlink
+// fport policies.
+type FPortAuthorizer struct {
+	db *sqlx.DB
+}
+
+// NewFPortAuthorizer creates a new FPortAuthorizer.
+func NewFPortAuthorizer(db *sqlx.DB) *FPortAuthorizer {
+	return &FPortAuthorizer{db: db}
+}
+
+// AuthorizeDownlink returns an error when the given principal is not
+// allowed to send downlink data to the given node on the given FPort.
+func (a *FPortAuthorizer) AuthorizeDownlink(appEUI, devEUI lorawan.EUI64, fPort uint8, principal string) error {
+	principals, err := storage.GetDownlinkFPortPrincipals(a.db, appEUI, devEUI, fPort)
+	if err != nil {
+		return err
+	}
+
+	// the fport is not restricted
+	if principals == nil {
+		return nil
+	}
+
+	for _, p := range principals {
+		if p == principal {
+			return nil
+		}
+	}
+	return fmt.Errorf("%s is not allowed to send downlink data on fport %d", principal, fPort)
+}
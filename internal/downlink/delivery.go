@@ -0,0 +1,214 @@
+package downlink
+
+import (
+	"fmt"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+
+	"github.com/brocaar/lora-app-server/integration"
+	"github.com/brocaar/lora-app-server/internal/common"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lora-app-server/internal/usage"
+	"github.com/brocaar/lorawan"
+)
+
+// Error types used for the error notifications of undelivered payloads.
+const (
+	ErrorTypeDataDownNACK       = "DATA_DOWN_NACK"
+	ErrorTypeDataDownTimeout    = "DATA_DOWN_TIMEOUT"
+	ErrorTypeDataDownMaxRetries = "DATA_DOWN_MAX_RETRIES"
+	ErrorTypeDataDownFCnt       = "DATA_DOWN_FCNT"
+)
+
+// timeoutCheckInterval defines the interval in which the pending
+// deliveries are checked for timeouts.
+const timeoutCheckInterval = 10 * time.Second
+
+// NACKFCntGap defines the number of downlink frame-counts after which a
+// confirmed payload that has not been acknowledged is removed from the
+// queue and reported as nACK. 0 disables this check.
+var NACKFCntGap uint32
+
+// ACKTimeout defines the duration after which a confirmed payload that has
+// not been acknowledged is removed from the queue and reported as timeout.
+// 0 disables this check.
+var ACKTimeout time.Duration
+
+// CheckNACK returns true when the given (pending) queue item has been
+// retransmitted for NACKFCntGap frame-counts without being acknowledged.
+// In this case the item is removed from the queue, its delivery is set to
+// nACK and an error notification is sent.
+func CheckNACK(ctx common.Context, appEUI lorawan.EUI64, qi storage.DownlinkQueueItem, fCnt uint32) (bool, error) {
+	if NACKFCntGap == 0 || !qi.Confirmed || !qi.Pending {
+		return false, nil
+	}
+
+	d, err := storage.GetDownlinkDelivery(ctx.DB, qi.ID)
+	if err != nil {
+		return false, err
+	}
+	// fCnt < d.FCnt happens when the frame-counter has been reset (e.g.
+	// after a rejoin), in which case the timeout still applies
+	if d.FCnt == nil || fCnt < *d.FCnt || fCnt-*d.FCnt < NACKFCntGap {
+		return false, nil
+	}
+
+	reason := fmt.Sprintf("payload not acknowledged within %d frame-counts", fCnt-*d.FCnt)
+	return true, fail(ctx, appEUI, d, storage.DeliveryStatusNACK, ErrorTypeDataDownNACK, reason)
+}
+
+// CheckRetries returns true when the given (pending) queue item has been
+// retransmitted MaxRetries times without being acknowledged. In this case
+// the item is removed from the queue, its delivery is set to nACK and an
+// error notification is sent.
+func CheckRetries(ctx common.Context, appEUI lorawan.EUI64, qi storage.DownlinkQueueItem) (bool, error) {
+	if qi.MaxRetries == 0 || !qi.Confirmed || !qi.Pending || qi.Retries < qi.MaxRetries {
+		return false, nil
+	}
+
+	d, err := storage.GetDownlinkDelivery(ctx.DB, qi.ID)
+	if err != nil {
+		return false, err
+	}
+
+	reason := fmt.Sprintf("payload not acknowledged after %d retries", qi.Retries)
+	return true, fail(ctx, appEUI, d, storage.DeliveryStatusNACK, ErrorTypeDataDownMaxRetries, reason)
+}
+
+// RecordTransmission records the transmission of the given queue item
+// using the given downlink frame-counter. The delivery of an unconfirmed
+// item is set to sent, the delivery of a confirmed item to pending. The
+// frame-counter and time of the first transmission are kept on
+// retransmissions. When the item was enqueued by a downlink rule, a
+// scheduled downlink notification is sent on the first transmission.
+func RecordTransmission(ctx common.Context, qi storage.DownlinkQueueItem, fCnt uint32) error {
+	d, err := storage.GetDownlinkDelivery(ctx.DB, qi.ID)
+	if err != nil {
+		return err
+	}
+	if d.FCnt != nil {
+		return nil
+	}
+
+	now := time.Now()
+	d.FCnt = &fCnt
+	d.SentAt = &now
+	d.Status = storage.DeliveryStatusSent
+	if qi.Confirmed {
+		d.Status = storage.DeliveryStatusPending
+	}
+	if err := storage.UpdateDownlinkDelivery(ctx.DB, &d); err != nil {
+		return err
+	}
+	return notifyRuleTransmission(ctx, qi, fCnt, now)
+}
+
+// RecordACK sets the delivery of the given queue item to acknowledged.
+func RecordACK(ctx common.Context, qi storage.DownlinkQueueItem) error {
+	d, err := storage.GetDownlinkDelivery(ctx.DB, qi.ID)
+	if err != nil {
+		return err
+	}
+	d.Status = storage.DeliveryStatusAcknowledged
+	return storage.UpdateDownlinkDelivery(ctx.DB, &d)
+}
+
+// CheckTimeouts removes the pending queue items that have not been
+// acknowledged within ACKTimeout from the queue, sets their delivery to
+// timeout and sends an error notification for each.
+func CheckTimeouts(ctx common.Context) error {
+	deliveries, err := storage.GetPendingDownlinkDeliveriesSentBefore(ctx.DB, time.Now().Add(-ACKTimeout))
+	if err != nil {
+		return err
+	}
+
+	for _, d := range deliveries {
+		node, err := storage.GetNode(ctx.DB, d.DevEUI)
+		if err != nil {
+			log.WithField("dev_eui", d.DevEUI).Errorf("get node error: %s", err)
+			continue
+		}
+
+		reason := fmt.Sprintf("payload not acknowledged within %s", ACKTimeout)
+		if err := fail(ctx, node.AppEUI, d, storage.DeliveryStatusTimeout, ErrorTypeDataDownTimeout, reason); err != nil {
+			log.WithFields(log.Fields{
+				"dev_eui": d.DevEUI,
+				"id":      d.ID,
+			}).Errorf("handle downlink timeout error: %s", err)
+		}
+	}
+	return nil
+}
+
+// RunTimeoutChecker checks the pending deliveries for timeouts, until the
+// program exits. It returns directly when ACKTimeout is 0.
+func RunTimeoutChecker(ctx common.Context) {
+	if ACKTimeout == 0 {
+		return
+	}
+	for {
+		if err := CheckTimeouts(ctx); err != nil {
+			log.Errorf("check downlink timeouts error: %s", err)
+		}
+		time.Sleep(timeoutCheckInterval)
+	}
+}
+
+// CheckFCnt returns true when the given queue item can't be sent using the
+// given frame-counter. This is the case for an item encrypted by the
+// application using an other frame-counter (e.g. an other item was sent
+// first or a confirmed item is retransmitted) and for a plaintext item of a
+// node using end-to-end encryption. In this case the item is removed from
+// the queue, its delivery is cancelled and an error notification is sent.
+func CheckFCnt(ctx common.Context, n storage.Node, qi storage.DownlinkQueueItem, fCnt uint32) (bool, error) {
+	var reason string
+	switch {
+	case qi.Encrypted && (qi.FCnt == nil || *qi.FCnt != fCnt):
+		reason = fmt.Sprintf("payload not encrypted with the downlink frame-counter %d", fCnt)
+	case !qi.Encrypted && n.E2EEncryption:
+		reason = "plaintext payload for node using end-to-end encryption"
+	default:
+		return false, nil
+	}
+
+	d, err := storage.GetDownlinkDelivery(ctx.DB, qi.ID)
+	if err != nil {
+		return false, err
+	}
+	return true, fail(ctx, n.AppEUI, d, storage.DeliveryStatusCancelled, ErrorTypeDataDownFCnt, reason)
+}
+
+// fail removes the queue item of the given delivery, sets the delivery to
+// the given status and sends an error notification.
+func fail(ctx common.Context, appEUI lorawan.EUI64, d storage.DownlinkDelivery, status, errType, reason string) error {
+	if err := storage.DeleteDownlinkQueueItem(ctx.DB, d.ID); err != nil {
+		return err
+	}
+
+	d.Status = status
+	if err := storage.UpdateDownlinkDelivery(ctx.DB, &d); err != nil {
+		return err
+	}
+
+	log.WithFields(log.Fields{
+		"dev_eui":   d.DevEUI,
+		"id":        d.ID,
+		"reference": d.Reference,
+	}).Warningf("downlink queue item not delivered: %s", reason)
+
+	if err := usage.RecordError(ctx.DB, appEUI, d.DevEUI, time.Now()); err != nil {
+		log.WithField("dev_eui", d.DevEUI).Errorf("record usage stats error: %s", err)
+	}
+
+	err := ctx.Handler.SendErrorNotification(appEUI, d.DevEUI, integration.ErrorNotification{
+		DevEUI:    d.DevEUI,
+		Reference: d.Reference,
+		Type:      errType,
+		Error:     reason,
+	})
+	if err != nil {
+		return fmt.Errorf("send error notification error: %s", err)
+	}
+	return nil
+}
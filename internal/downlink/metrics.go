@@ -0,0 +1,53 @@
+package downlink
+
+import (
+	log "github.com/Sirupsen/logrus"
+	"github.com/jmoiron/sqlx"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/brocaar/lora-app-server/internal/storage"
+)
+
+var (
+	queueItemsDesc = prometheus.NewDesc(
+		"lora_app_server_downlink_queue_items",
+		"Number of items in the downlink queue (per application).",
+		[]string{"app_eui"}, nil,
+	)
+	queuePendingItemsDesc = prometheus.NewDesc(
+		"lora_app_server_downlink_queue_pending_items",
+		"Number of pending (sent, waiting for an ack) items in the downlink queue (per application).",
+		[]string{"app_eui"}, nil,
+	)
+)
+
+// QueueCollector implements a Prometheus collector exporting the downlink
+// queue depths. The queue is read from the database on each scrape.
+type QueueCollector struct {
+	db *sqlx.DB
+}
+
+// NewQueueCollector creates a new QueueCollector.
+func NewQueueCollector(db *sqlx.DB) *QueueCollector {
+	return &QueueCollector{db: db}
+}
+
+// Describe implements prometheus.Collector.
+func (c *QueueCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- queueItemsDesc
+	ch <- queuePendingItemsDesc
+}
+
+// Collect implements prometheus.Collector.
+func (c *QueueCollector) Collect(ch chan<- prometheus.Metric) {
+	sizes, err := storage.GetDownlinkQueueSizePerApplication(c.db)
+	if err != nil {
+		log.Errorf("collect downlink queue metrics error: %s", err)
+		return
+	}
+
+	for _, s := range sizes {
+		ch <- prometheus.MustNewConstMetric(queueItemsDesc, prometheus.GaugeValue, float64(s.Count), s.AppEUI.String())
+		ch <- prometheus.MustNewConstMetric(queuePendingItemsDesc, prometheus.GaugeValue, float64(s.Pending), s.AppEUI.String())
+	}
+}
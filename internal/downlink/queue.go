@@ -0,0 +1,177 @@
+package downlink
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/brocaar/lora-app-server/integration"
+	"github.com/brocaar/lora-app-server/internal/codec"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+// RulePrincipal is the principal of the payloads enqueued by the downlink
+// rules (followed by the id of the rule), as recorded in the audit log.
+const RulePrincipal = "downlink-rule"
+
+// MaxPayloadSize defines the maximum size (in bytes) of the data of an
+// enqueued payload, after encoding the object. 0 disables this check.
+var MaxPayloadSize int
+
+// ErrPayloadTooLarge is returned when the (encoded) payload exceeds
+// MaxPayloadSize.
+var ErrPayloadTooLarge = errors.New("downlink payload exceeds the max. payload size")
+
+// Queue stores the received downlink payloads in the (PostgreSQL) downlink
+// queue of the node. The items are dequeued in the order they were
+// enqueued on the next uplink (or Class-C poll) of the node by the
+// network-server. As the payloads are encrypted just before they are sent
+// to the network-server, using the frame-counter provided by the
+// network-server, the queue order never conflicts with the frame-counter.
+// This does not apply to payloads encrypted by the application (end-to-end
+// encryption), these are only sent with the frame-counter used for the
+// encryption (see CheckFCnt).
+type Queue struct {
+	db *sqlx.DB
+}
+
+// NewQueue creates a new Queue.
+func NewQueue(db *sqlx.DB) *Queue {
+	return &Queue{db: db}
+}
+
+// ValidateEncryption validates the encryption of a payload for the given
+// node. Nodes using end-to-end encryption only accept payloads encrypted
+// by the application, for which the frame-counter must be given. Nodes not
+// using end-to-end encryption only accept plaintext payloads.
+func ValidateEncryption(n storage.Node, encrypted bool, fCnt *uint32) error {
+	if !n.E2EEncryption {
+		if encrypted {
+			return fmt.Errorf("node %s does not use end-to-end encryption", n.DevEUI)
+		}
+		return nil
+	}
+	if !encrypted {
+		return fmt.Errorf("node %s uses end-to-end encryption, the payload must be encrypted", n.DevEUI)
+	}
+	if fCnt == nil {
+		return errors.New("the frame-counter of an encrypted payload must be set")
+	}
+	return nil
+}
+
+// Enqueue adds the given payload to the downlink queue of the node. When
+// the payload has an object instead of data, the object is encoded using
+// the payload codec of the application. Payloads exceeding MaxPayloadSize
+// (after encoding) are rejected with ErrPayloadTooLarge. The payload counts
+// against the downlink quota of the organization owning the application.
+// The payload is not sent before its DelayUntil time and, when confirmed, is
+// retransmitted at most MaxRetries times (see CheckRetries). Payloads for
+// nodes using end-to-end encryption must be encrypted by the application
+// (see ValidateEncryption).
+func (q *Queue) Enqueue(pl integration.DataDownPayload) error {
+	return q.enqueue(pl, nil)
+}
+
+// EnqueueRule adds the payload of the given downlink rule to the downlink
+// queue of the given node. The name of the rule is used as reference.
+func (q *Queue) EnqueueRule(r storage.DownlinkRule, devEUI lorawan.EUI64) error {
+	return q.enqueue(integration.DataDownPayload{
+		Principal: fmt.Sprintf("%s:%d", RulePrincipal, r.ID),
+		Reference: r.Name,
+		Confirmed: r.Confirmed,
+		DevEUI:    devEUI,
+		FPort:     r.FPort,
+		Data:      r.Data,
+		Object:    r.Object,
+	}, &r.ID)
+}
+
+// enqueue adds the given payload to the downlink queue of the node,
+// ruleID is set when the payload was enqueued by a downlink rule.
+func (q *Queue) enqueue(pl integration.DataDownPayload, ruleID *int64) error {
+	n, err := storage.GetNode(q.db, pl.DevEUI)
+	if err != nil {
+		return err
+	}
+	if err := ValidateEncryption(n, pl.Encrypted, pl.FCnt); err != nil {
+		return err
+	}
+	if pl.Encrypted && len(pl.Object) != 0 {
+		return errors.New("an encrypted payload can't contain an object")
+	}
+
+	if len(pl.Data) == 0 && len(pl.Object) != 0 {
+		pl.Data, err = codec.EncodeObject(q.db, pl.DevEUI, pl.FPort, pl.Object)
+		if err != nil {
+			return fmt.Errorf("encode object error: %s", err)
+		}
+	}
+	if MaxPayloadSize > 0 && len(pl.Data) > MaxPayloadSize {
+		return ErrPayloadTooLarge
+	}
+
+	qi := storage.DownlinkQueueItem{
+		Reference:      pl.Reference,
+		DevEUI:         pl.DevEUI,
+		Confirmed:      pl.Confirmed,
+		FPort:          pl.FPort,
+		Data:           pl.Data,
+		DownlinkRuleID: ruleID,
+		DelayUntil:     pl.DelayUntil,
+		MaxRetries:     int(pl.MaxRetries),
+		Encrypted:      pl.Encrypted,
+		FCnt:           pl.FCnt,
+	}
+
+	// the quota is only used when the item is enqueued
+	tx, err := q.db.Beginx()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := storage.UseDownlinkQuota(tx, n.AppEUI, 1); err != nil {
+		return err
+	}
+	if err := storage.CreateDownlinkQueueItem(tx, &qi); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	q.recordAudit(pl.Principal, n.AppEUI, qi)
+	return nil
+}
+
+// recordAudit records the enqueued item in the audit log, with the
+// principal of the handler (or rule) as actor. As the item is already
+// enqueued, errors are logged.
+func (q *Queue) recordAudit(principal string, appEUI lorawan.EUI64, qi storage.DownlinkQueueItem) {
+	details, err := json.Marshal(map[string]interface{}{
+		"id":        qi.ID,
+		"reference": qi.Reference,
+		"fPort":     qi.FPort,
+		"confirmed": qi.Confirmed,
+	})
+	if err != nil {
+		log.WithField("dev_eui", qi.DevEUI).Errorf("downlink: marshal audit log details error: %s", err)
+	}
+
+	err = storage.CreateAuditLog(q.db, &storage.AuditLog{
+		Actor:      principal,
+		Action:     "DownlinkQueue.Enqueue",
+		ObjectType: storage.AuditObjectDownlink,
+		ObjectID:   qi.DevEUI.String(),
+		AppEUI:     &appEUI,
+		Details:    details,
+	})
+	if err != nil {
+		log.WithField("dev_eui", qi.DevEUI).Errorf("downlink: record audit log error: %s", err)
+	}
+}
@@ -0,0 +1,191 @@
+package downlink
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/brocaar/lora-app-server/integration"
+	"github.com/brocaar/lora-app-server/internal/codec"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lora-app-server/internal/test"
+	"github.com/brocaar/lorawan"
+)
+
+func TestValidateEncryption(t *testing.T) {
+	Convey("Given a set of tests", t, func() {
+		fCnt := uint32(10)
+		devEUI := lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}
+
+		tests := []struct {
+			Name      string
+			Node      storage.Node
+			Encrypted bool
+			FCnt      *uint32
+			Error     string
+		}{
+			{"plaintext payload for a plaintext node", storage.Node{DevEUI: devEUI}, false, nil, ""},
+			{"encrypted payload for a plaintext node", storage.Node{DevEUI: devEUI}, true, &fCnt, "node 0102030405060708 does not use end-to-end encryption"},
+			{"plaintext payload for an e2e node", storage.Node{DevEUI: devEUI, E2EEncryption: true}, false, nil, "node 0102030405060708 uses end-to-end encryption, the payload must be encrypted"},
+			{"encrypted payload without frame-counter for an e2e node", storage.Node{DevEUI: devEUI, E2EEncryption: true}, true, nil, "the frame-counter of an encrypted payload must be set"},
+			{"encrypted payload for an e2e node", storage.Node{DevEUI: devEUI, E2EEncryption: true}, true, &fCnt, ""},
+		}
+
+		for i, test := range tests {
+			Convey(fmt.Sprintf("Test %d: %s", i, test.Name), func() {
+				err := ValidateEncryption(test.Node, test.Encrypted, test.FCnt)
+				if test.Error == "" {
+					So(err, ShouldBeNil)
+				} else {
+					So(err, ShouldNotBeNil)
+					So(err.Error(), ShouldEqual, test.Error)
+				}
+			})
+		}
+	})
+}
+
+func TestQueue(t *testing.T) {
+	conf := test.GetConfig()
+
+	Convey("Given a clean database with a node and a Queue", t, func() {
+		db, err := storage.OpenDatabase(conf.PostgresDSN)
+		So(err, ShouldBeNil)
+		test.MustResetDB(db)
+
+		node := storage.Node{
+			AppEUI: lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+			DevEUI: lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1},
+		}
+		So(storage.CreateNode(db, node), ShouldBeNil)
+		q := NewQueue(db)
+
+		Convey("When enqueueing a payload", func() {
+			So(q.Enqueue(integration.DataDownPayload{
+				Principal: "mqtt",
+				Reference: "abcd",
+				Confirmed: true,
+				DevEUI:    node.DevEUI,
+				FPort:     10,
+				Data:      []byte{1, 2, 3},
+			}), ShouldBeNil)
+
+			Convey("Then the payload has been added to the downlink queue", func() {
+				items, err := storage.GetDownlinkQueueItems(db, node.DevEUI)
+				So(err, ShouldBeNil)
+				So(items, ShouldHaveLength, 1)
+				So(items[0].Reference, ShouldEqual, "abcd")
+				So(items[0].Confirmed, ShouldBeTrue)
+				So(items[0].FPort, ShouldEqual, 10)
+				So(items[0].Data, ShouldResemble, []byte{1, 2, 3})
+				So(items[0].DownlinkRuleID, ShouldBeNil)
+			})
+
+			Convey("Then the enqueue is recorded in the audit log with the given principal as actor", func() {
+				logs, err := storage.GetAuditLogs(db, storage.AuditLogFilter{
+					ObjectType: storage.AuditObjectDownlink,
+					ObjectID:   node.DevEUI.String(),
+				}, 10, 0)
+				So(err, ShouldBeNil)
+				So(logs, ShouldHaveLength, 1)
+				So(logs[0].Actor, ShouldEqual, "mqtt")
+				So(logs[0].Action, ShouldEqual, "DownlinkQueue.Enqueue")
+			})
+		})
+
+		Convey("Given a Cayenne LPP codec for the application", func() {
+			So(storage.CreatePayloadCodec(db, storage.PayloadCodec{
+				AppEUI:    node.AppEUI,
+				CodecType: codec.TypeCayenneLPP,
+			}), ShouldBeNil)
+
+			Convey("When enqueueing an object", func() {
+				So(q.Enqueue(integration.DataDownPayload{
+					DevEUI: node.DevEUI,
+					FPort:  10,
+					Object: []byte(`{"digitalOutput":{"2":1}}`),
+				}), ShouldBeNil)
+
+				Convey("Then the encoded object has been added to the downlink queue", func() {
+					items, err := storage.GetDownlinkQueueItems(db, node.DevEUI)
+					So(err, ShouldBeNil)
+					So(items, ShouldHaveLength, 1)
+					So(items[0].Data, ShouldResemble, []byte{2, 1, 1})
+				})
+			})
+
+			Convey("Given a max. payload size of 2 bytes", func() {
+				MaxPayloadSize = 2
+				Reset(func() {
+					MaxPayloadSize = 0
+				})
+
+				Convey("Then enqueueing an object encoded into 3 bytes returns ErrPayloadTooLarge", func() {
+					So(q.Enqueue(integration.DataDownPayload{
+						DevEUI: node.DevEUI,
+						FPort:  10,
+						Object: []byte(`{"digitalOutput":{"2":1}}`),
+					}), ShouldEqual, ErrPayloadTooLarge)
+				})
+
+				Convey("Then enqueueing 2 bytes of data succeeds", func() {
+					So(q.Enqueue(integration.DataDownPayload{
+						DevEUI: node.DevEUI,
+						FPort:  10,
+						Data:   []byte{1, 2},
+					}), ShouldBeNil)
+				})
+			})
+		})
+
+		Convey("Given the organization owning the application has a downlink quota of 1", func() {
+			o := storage.Organization{
+				Name:               "customer-1",
+				MaxDownlinksPerDay: 1,
+			}
+			So(storage.CreateOrganization(db, &o), ShouldBeNil)
+			So(storage.AddOrganizationApplication(db, o.ID, node.AppEUI), ShouldBeNil)
+
+			Convey("When enqueueing two payloads", func() {
+				pl := integration.DataDownPayload{DevEUI: node.DevEUI, FPort: 10, Data: []byte{1}}
+				So(q.Enqueue(pl), ShouldBeNil)
+				err := q.Enqueue(pl)
+
+				Convey("Then the second payload is rejected and not enqueued", func() {
+					So(err, ShouldEqual, storage.ErrDownlinkQuotaExceeded)
+
+					items, err := storage.GetDownlinkQueueItems(db, node.DevEUI)
+					So(err, ShouldBeNil)
+					So(items, ShouldHaveLength, 1)
+				})
+			})
+		})
+
+		Convey("Given a set of invalid payloads", func() {
+			fCnt := uint32(10)
+
+			tests := []struct {
+				Name    string
+				Payload integration.DataDownPayload
+				Error   string
+			}{
+				{"unknown node", integration.DataDownPayload{DevEUI: lorawan.EUI64{1}, FPort: 10, Data: []byte{1}}, storage.ErrNodeDoesNotExist.Error()},
+				{"encrypted payload for a plaintext node", integration.DataDownPayload{DevEUI: node.DevEUI, FPort: 10, Data: []byte{1}, Encrypted: true, FCnt: &fCnt}, "node 0807060504030201 does not use end-to-end encryption"},
+				{"object without payload codec", integration.DataDownPayload{DevEUI: node.DevEUI, FPort: 10, Object: []byte(`{"digitalOutput":{"2":1}}`)}, "encode object error: application 0102030405060708 has no payload codec"},
+			}
+
+			for i, test := range tests {
+				Convey(fmt.Sprintf("Test %d: %s", i, test.Name), func() {
+					err := q.Enqueue(test.Payload)
+					So(err, ShouldNotBeNil)
+					So(err.Error(), ShouldEqual, test.Error)
+
+					items, err := storage.GetDownlinkQueueItems(db, node.DevEUI)
+					So(err, ShouldBeNil)
+					So(items, ShouldHaveLength, 0)
+				})
+			}
+		})
+	})
+}
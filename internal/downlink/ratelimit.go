@@ -0,0 +1,119 @@
+package downlink
+
+import (
+	"fmt"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/garyburd/redigo/redis"
+
+	"github.com/brocaar/lora-app-server/integration"
+	"github.com/brocaar/lora-app-server/internal/lockstore"
+	"github.com/brocaar/lorawan"
+)
+
+// The keys of the buckets of an application and its nodes share the same
+// hash tag, so that these are stored in the same hash slot when using a
+// Redis Cluster.
+const (
+	appRateLimitKeyTempl  = "lora:as:downlink:ratelimit:{%s}:app"
+	nodeRateLimitKeyTempl = "lora:as:downlink:ratelimit:{%s}:node:%s"
+)
+
+// Results of the token bucket script.
+const (
+	rateLimitAllowed = iota
+	rateLimitAppExceeded
+	rateLimitNodeExceeded
+)
+
+// tokenBucketScript takes a token from the application (KEYS[1]) and node
+// (KEYS[2]) token buckets. ARGV contains the rate and burst of the
+// application, the rate and burst of the node and the current time in
+// milliseconds. A bucket with a rate of 0 is unlimited. The tokens are only
+// taken when both buckets contain a token.
+var tokenBucketScript = lockstore.NewScript(`
+local now = tonumber(ARGV[5])
+local buckets = {}
+for i = 1, 2 do
+	local rate = tonumber(ARGV[i * 2 - 1])
+	local burst = tonumber(ARGV[i * 2])
+	if rate > 0 then
+		local b = redis.call("HMGET", KEYS[i], "tokens", "ts")
+		local tokens = tonumber(b[1]) or burst
+		local ts = tonumber(b[2]) or now
+		tokens = math.min(burst, tokens + math.max(0, now - ts) / 1000 * rate)
+		if tokens < 1 then
+			return i
+		end
+		buckets[i] = {tokens = tokens, ttl = math.ceil(burst / rate * 1000) + 1000}
+	end
+end
+for i, b in pairs(buckets) do
+	redis.call("HMSET", KEYS[i], "tokens", tostring(b.tokens - 1), "ts", now)
+	redis.call("PEXPIRE", KEYS[i], b.ttl)
+end
+return 0
+`)
+
+// RateLimit defines a token bucket, refilled with Rate tokens per second up
+// to Burst tokens. A Rate of 0 disables the limit.
+type RateLimit struct {
+	Rate  float64
+	Burst int
+}
+
+// RateLimiter limits the downlink payloads received from the applications,
+// using a token bucket per application and per node stored in the lock store
+// (so that the limits are shared by all LoRa App Server instances). The
+// payload size is checked by the Queue, after encoding (see MaxPayloadSize).
+type RateLimiter struct {
+	locks lockstore.Store
+	app   RateLimit
+	node  RateLimit
+}
+
+// NewRateLimiter creates a new RateLimiter.
+func NewRateLimiter(locks lockstore.Store, app, node RateLimit) *RateLimiter {
+	return &RateLimiter{
+		locks: locks,
+		app:   app,
+		node:  node,
+	}
+}
+
+// LimitDownlink returns an error when the given payload exceeds the
+// downlink rate limit of the application or node. As
+// the downlink path must not depend on the availability of the rate
+// limiting, lock store errors are logged and the payload is allowed.
+func (l *RateLimiter) LimitDownlink(appEUI lorawan.EUI64, pl integration.DataDownPayload) error {
+	if l.app.Rate <= 0 && l.node.Rate <= 0 {
+		return nil
+	}
+
+	res, err := redis.Int(l.locks.Eval(tokenBucketScript, []string{
+		fmt.Sprintf(appRateLimitKeyTempl, appEUI),
+		fmt.Sprintf(nodeRateLimitKeyTempl, appEUI, pl.DevEUI),
+	},
+		l.app.Rate,
+		l.app.Burst,
+		l.node.Rate,
+		l.node.Burst,
+		time.Now().UnixNano()/int64(time.Millisecond),
+	))
+	if err != nil {
+		log.WithFields(log.Fields{
+			"app_eui": appEUI,
+			"dev_eui": pl.DevEUI,
+		}).Errorf("downlink: rate limit error: %s", err)
+		return nil
+	}
+
+	switch res {
+	case rateLimitAppExceeded:
+		return fmt.Errorf("downlink rate limit of application %s exceeded", appEUI)
+	case rateLimitNodeExceeded:
+		return fmt.Errorf("downlink rate limit of node %s exceeded", pl.DevEUI)
+	}
+	return nil
+}
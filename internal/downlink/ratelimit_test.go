@@ -0,0 +1,52 @@
+package downlink
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/brocaar/lora-app-server/integration"
+	"github.com/brocaar/lora-app-server/internal/lockstore"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lora-app-server/internal/test"
+	"github.com/brocaar/lorawan"
+)
+
+func TestRateLimiter(t *testing.T) {
+	conf := test.GetConfig()
+
+	Convey("Given a clean Redis database", t, func() {
+		p := storage.NewRedisPool(conf.RedisURL)
+		test.MustFlushRedis(p)
+		locks := lockstore.NewRedisStore(p)
+
+		appEUI := lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}
+		pl1 := integration.DataDownPayload{DevEUI: lorawan.EUI64{1, 1, 1, 1, 1, 1, 1, 1}, Data: []byte{1, 2, 3}}
+		pl2 := integration.DataDownPayload{DevEUI: lorawan.EUI64{2, 2, 2, 2, 2, 2, 2, 2}, Data: []byte{1, 2, 3}}
+
+		Convey("Given a RateLimiter without limits", func() {
+			l := NewRateLimiter(locks, RateLimit{}, RateLimit{})
+
+			Convey("Then all payloads are allowed", func() {
+				for i := 0; i < 10; i++ {
+					So(l.LimitDownlink(appEUI, pl1), ShouldBeNil)
+				}
+			})
+		})
+
+		Convey("Given a RateLimiter with a node burst of 2 and an application burst of 3", func() {
+			l := NewRateLimiter(locks, RateLimit{Rate: 0.001, Burst: 3}, RateLimit{Rate: 0.001, Burst: 2})
+
+			Convey("Then the third payload for the same node is rejected", func() {
+				So(l.LimitDownlink(appEUI, pl1), ShouldBeNil)
+				So(l.LimitDownlink(appEUI, pl1), ShouldBeNil)
+				So(l.LimitDownlink(appEUI, pl1), ShouldNotBeNil)
+
+				Convey("Then the fourth payload for the application is rejected", func() {
+					So(l.LimitDownlink(appEUI, pl2), ShouldBeNil)
+					So(l.LimitDownlink(appEUI, pl2), ShouldNotBeNil)
+				})
+			})
+		})
+	})
+}
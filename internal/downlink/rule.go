@@ -0,0 +1,123 @@
+package downlink
+
+import (
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/brocaar/lora-app-server/integration"
+	"github.com/brocaar/lora-app-server/internal/common"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+// ruleSchedulerInterval defines the interval in which the scheduler checks
+// for due downlink rules.
+const ruleSchedulerInterval = 15 * time.Second
+
+// RuleScheduler enqueues the payloads of the due downlink rules.
+type RuleScheduler struct {
+	db    *sqlx.DB
+	queue *Queue
+}
+
+// NewRuleScheduler creates a new RuleScheduler.
+func NewRuleScheduler(db *sqlx.DB) *RuleScheduler {
+	return &RuleScheduler{
+		db:    db,
+		queue: NewQueue(db),
+	}
+}
+
+// Run runs the scheduler (blocking).
+func (s *RuleScheduler) Run() {
+	for {
+		if err := s.runDue(time.Now()); err != nil {
+			log.Errorf("downlink: run due downlink rules error: %s", err)
+		}
+		time.Sleep(ruleSchedulerInterval)
+	}
+}
+
+// runDue enqueues the payloads of the downlink rules which are due at the
+// given time. Each rule is claimed first, so that its payload is enqueued
+// only once when running multiple instances. Missed runs (e.g. when LoRa
+// App Server was not running) are not caught up.
+func (s *RuleScheduler) runDue(now time.Time) error {
+	rules, err := storage.GetDueDownlinkRules(s.db, now)
+	if err != nil {
+		return err
+	}
+
+	for _, r := range rules {
+		logFields := log.Fields{
+			"id":      r.ID,
+			"app_eui": r.AppEUI,
+		}
+
+		next, err := NextRuleRun(r, now)
+		if err != nil {
+			log.WithFields(logFields).Errorf("downlink: next run of downlink rule error: %s", err)
+			continue
+		}
+		claimed, err := storage.ClaimDownlinkRule(s.db, r, now, next)
+		if err != nil {
+			log.WithFields(logFields).Errorf("downlink: claim downlink rule error: %s", err)
+			continue
+		}
+		if !claimed {
+			continue
+		}
+
+		devEUIs, err := s.ruleDevEUIs(r)
+		if err != nil {
+			log.WithFields(logFields).Errorf("downlink: get nodes of downlink rule error: %s", err)
+			continue
+		}
+
+		var count int
+		for _, devEUI := range devEUIs {
+			if err := s.queue.EnqueueRule(r, devEUI); err != nil {
+				log.WithFields(logFields).WithField("dev_eui", devEUI).Errorf("downlink: enqueue downlink rule payload error: %s", err)
+				continue
+			}
+			count++
+		}
+		log.WithFields(logFields).WithField("count", count).Info("downlink: downlink rule payload enqueued")
+	}
+
+	return nil
+}
+
+// ruleDevEUIs returns the DevEUIs of the nodes targeted by the given rule.
+func (s *RuleScheduler) ruleDevEUIs(r storage.DownlinkRule) ([]lorawan.EUI64, error) {
+	if r.DevEUI != nil {
+		return []lorawan.EUI64{*r.DevEUI}, nil
+	}
+
+	return storage.GetDevEUIsForAppEUI(s.db, r.AppEUI)
+}
+
+// notifyRuleTransmission sends a ScheduledDownlinkNotification when the
+// given (transmitted) queue item was enqueued by a downlink rule.
+func notifyRuleTransmission(ctx common.Context, qi storage.DownlinkQueueItem, fCnt uint32, sentAt time.Time) error {
+	if qi.DownlinkRuleID == nil {
+		return nil
+	}
+
+	r, err := storage.GetDownlinkRule(ctx.DB, *qi.DownlinkRuleID)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Handler.SendScheduledDownlinkNotification(r.AppEUI, qi.DevEUI, integration.ScheduledDownlinkNotification{
+		DevEUI:    qi.DevEUI,
+		RuleID:    r.ID,
+		Name:      r.Name,
+		FPort:     qi.FPort,
+		FCnt:      fCnt,
+		Confirmed: qi.Confirmed,
+		Time:      sentAt,
+	})
+}
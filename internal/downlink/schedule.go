@@ -0,0 +1,196 @@
+package downlink
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/brocaar/lora-app-server/internal/storage"
+)
+
+// maxScheduleSearch defines how far ahead Next searches for a matching
+// time, before considering the schedule to never match (e.g. 30 February).
+const maxScheduleSearch = 5 * 366 * 24 * time.Hour
+
+// scheduleMacros contains the supported shorthands of the cron expressions.
+var scheduleMacros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// Schedule implements a cron schedule with minute resolution. The
+// expression has five fields (minute, hour, day of month, month and day of
+// week) and each field supports *, lists (1,15), ranges (1-5) and steps
+// (*/15 or 0-30/10). The day of week is 0 - 7, both 0 and 7 are sunday.
+// Like cron, when both the day of month and the day of week are restricted,
+// a day matches when either of them matches.
+type Schedule struct {
+	minute uint64
+	hour   uint64
+	dom    uint64
+	month  uint64
+	dow    uint64
+	anyDOM bool
+	anyDOW bool
+}
+
+// ParseSchedule parses the given cron expression.
+func ParseSchedule(expr string) (Schedule, error) {
+	var s Schedule
+
+	if macro, ok := scheduleMacros[strings.TrimSpace(expr)]; ok {
+		expr = macro
+	}
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return s, fmt.Errorf("schedule must have 5 fields (minute, hour, day of month, month, day of week), got: %d", len(fields))
+	}
+
+	var err error
+	if s.minute, err = parseScheduleField(fields[0], 0, 59); err != nil {
+		return s, fmt.Errorf("minute: %s", err)
+	}
+	if s.hour, err = parseScheduleField(fields[1], 0, 23); err != nil {
+		return s, fmt.Errorf("hour: %s", err)
+	}
+	if s.dom, err = parseScheduleField(fields[2], 1, 31); err != nil {
+		return s, fmt.Errorf("day of month: %s", err)
+	}
+	if s.month, err = parseScheduleField(fields[3], 1, 12); err != nil {
+		return s, fmt.Errorf("month: %s", err)
+	}
+	if s.dow, err = parseScheduleField(fields[4], 0, 7); err != nil {
+		return s, fmt.Errorf("day of week: %s", err)
+	}
+
+	// 7 is an alias for sunday
+	if s.dow&(1<<7) != 0 {
+		s.dow = s.dow&^(1<<7) | 1
+	}
+	s.anyDOM = strings.HasPrefix(fields[2], "*")
+	s.anyDOW = strings.HasPrefix(fields[4], "*")
+
+	return s, nil
+}
+
+// parseScheduleField parses a single field of a cron expression and
+// returns the matching values as bitmask.
+func parseScheduleField(field string, min, max int) (uint64, error) {
+	var bits uint64
+
+	for _, part := range strings.Split(field, ",") {
+		rng, step := part, 1
+		if i := strings.Index(part, "/"); i != -1 {
+			var err error
+			rng = part[:i]
+			if step, err = strconv.Atoi(part[i+1:]); err != nil || step < 1 {
+				return 0, fmt.Errorf("invalid step: %s", part)
+			}
+		}
+
+		var lo, hi int
+		var err error
+		switch {
+		case rng == "*":
+			lo, hi = min, max
+		case strings.Contains(rng, "-"):
+			bounds := strings.SplitN(rng, "-", 2)
+			if lo, err = strconv.Atoi(bounds[0]); err != nil {
+				return 0, fmt.Errorf("invalid range: %s", part)
+			}
+			if hi, err = strconv.Atoi(bounds[1]); err != nil {
+				return 0, fmt.Errorf("invalid range: %s", part)
+			}
+		default:
+			if lo, err = strconv.Atoi(rng); err != nil {
+				return 0, fmt.Errorf("invalid value: %s", part)
+			}
+			hi = lo
+			// e.g. 5/15 means every 15 starting at 5
+			if strings.Contains(part, "/") {
+				hi = max
+			}
+		}
+
+		if lo < min || hi > max || lo > hi {
+			return 0, fmt.Errorf("%s is out of range (%d - %d)", part, min, max)
+		}
+		for v := lo; v <= hi; v += step {
+			bits |= 1 << uint(v)
+		}
+	}
+
+	return bits, nil
+}
+
+// Next returns the first time after the given time matching the schedule,
+// in the location of the given time. It returns the zero time when the
+// schedule never matches.
+func (s Schedule) Next(after time.Time) time.Time {
+	loc := after.Location()
+	t := after.Truncate(time.Minute).Add(time.Minute)
+	limit := t.Add(maxScheduleSearch)
+
+	for t.Before(limit) {
+		if s.month&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc)
+			continue
+		}
+		if !s.dayMatches(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+			continue
+		}
+		if s.hour&(1<<uint(t.Hour())) == 0 {
+			next := time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, loc)
+			// make sure we always move forward around DST transitions
+			if !next.After(t) {
+				next = t.Add(time.Hour)
+			}
+			t = next
+			continue
+		}
+		if s.minute&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+
+	return time.Time{}
+}
+
+// dayMatches returns true when the day of the given time matches the day of
+// month and day of week fields of the schedule.
+func (s Schedule) dayMatches(t time.Time) bool {
+	dom := s.dom&(1<<uint(t.Day())) != 0
+	dow := s.dow&(1<<uint(t.Weekday())) != 0
+	if s.anyDOM || s.anyDOW {
+		return dom && dow
+	}
+	return dom || dow
+}
+
+// NextRuleRun returns the next run of the given downlink rule, after the
+// given time.
+func NextRuleRun(r storage.DownlinkRule, after time.Time) (time.Time, error) {
+	s, err := ParseSchedule(r.Schedule)
+	if err != nil {
+		return time.Time{}, err
+	}
+	loc, err := time.LoadLocation(r.Timezone)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("load timezone %s error: %s", r.Timezone, err)
+	}
+	next := s.Next(after.In(loc))
+	if next.IsZero() {
+		return next, errors.New("schedule never matches")
+	}
+	return next, nil
+}
@@ -0,0 +1,129 @@
+package downlink
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/brocaar/lora-app-server/internal/storage"
+)
+
+func TestSchedule(t *testing.T) {
+	Convey("Given a set of schedules", t, func() {
+		after := time.Date(2017, 3, 15, 10, 20, 30, 0, time.UTC) // wednesday
+
+		tests := []struct {
+			Description string
+			Schedule    string
+			Next        time.Time
+		}{
+			{
+				Description: "every minute",
+				Schedule:    "* * * * *",
+				Next:        time.Date(2017, 3, 15, 10, 21, 0, 0, time.UTC),
+			},
+			{
+				Description: "every 15 minutes",
+				Schedule:    "*/15 * * * *",
+				Next:        time.Date(2017, 3, 15, 10, 30, 0, 0, time.UTC),
+			},
+			{
+				Description: "daily at 03:00",
+				Schedule:    "0 3 * * *",
+				Next:        time.Date(2017, 3, 16, 3, 0, 0, 0, time.UTC),
+			},
+			{
+				Description: "daily shorthand",
+				Schedule:    "@daily",
+				Next:        time.Date(2017, 3, 16, 0, 0, 0, 0, time.UTC),
+			},
+			{
+				Description: "weekdays at 08:30",
+				Schedule:    "30 8 * * 1-5",
+				Next:        time.Date(2017, 3, 16, 8, 30, 0, 0, time.UTC),
+			},
+			{
+				Description: "sundays as 7",
+				Schedule:    "0 12 * * 7",
+				Next:        time.Date(2017, 3, 19, 12, 0, 0, 0, time.UTC),
+			},
+			{
+				Description: "list of hours",
+				Schedule:    "0 6,18 * * *",
+				Next:        time.Date(2017, 3, 15, 18, 0, 0, 0, time.UTC),
+			},
+			{
+				Description: "first day of the month",
+				Schedule:    "0 0 1 * *",
+				Next:        time.Date(2017, 4, 1, 0, 0, 0, 0, time.UTC),
+			},
+			{
+				Description: "day of month or day of week",
+				Schedule:    "0 0 20 * 5",
+				Next:        time.Date(2017, 3, 17, 0, 0, 0, 0, time.UTC),
+			},
+			{
+				Description: "29 february",
+				Schedule:    "0 0 29 2 *",
+				Next:        time.Date(2020, 2, 29, 0, 0, 0, 0, time.UTC),
+			},
+			{
+				Description: "30 february never matches",
+				Schedule:    "0 0 30 2 *",
+			},
+		}
+
+		for _, test := range tests {
+			Convey("Test: "+test.Description, func() {
+				s, err := ParseSchedule(test.Schedule)
+				So(err, ShouldBeNil)
+				So(s.Next(after), ShouldResemble, test.Next)
+			})
+		}
+	})
+
+	Convey("Given a set of invalid schedules", t, func() {
+		for _, schedule := range []string{
+			"* * * *",
+			"60 * * * *",
+			"* 24 * * *",
+			"* * 0 * *",
+			"* * * 13 *",
+			"* * * * 8",
+			"*/0 * * * *",
+			"5-1 * * * *",
+			"a * * * *",
+		} {
+			Convey("Test: "+schedule, func() {
+				_, err := ParseSchedule(schedule)
+				So(err, ShouldNotBeNil)
+			})
+		}
+	})
+
+	Convey("Given a downlink rule with a timezone", t, func() {
+		r := storage.DownlinkRule{
+			Schedule: "0 3 * * *",
+			Timezone: "Europe/Amsterdam",
+		}
+
+		Convey("Then the next run is in the timezone of the rule", func() {
+			next, err := NextRuleRun(r, time.Date(2017, 3, 15, 10, 0, 0, 0, time.UTC))
+			So(err, ShouldBeNil)
+			So(next.UTC(), ShouldResemble, time.Date(2017, 3, 16, 2, 0, 0, 0, time.UTC))
+		})
+
+		Convey("Then the daylight saving time is taken into account", func() {
+			next, err := NextRuleRun(r, time.Date(2017, 3, 26, 0, 0, 0, 0, time.UTC))
+			So(err, ShouldBeNil)
+			So(next.UTC(), ShouldResemble, time.Date(2017, 3, 26, 1, 0, 0, 0, time.UTC))
+		})
+
+		Convey("Then a schedule which never matches returns an error", func() {
+			r.Schedule = "0 0 31 4 *"
+			_, err := NextRuleRun(r, time.Now())
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
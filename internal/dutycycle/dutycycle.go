@@ -0,0 +1,291 @@
+// Package dutycycle implements the estimation of the downlink airtime and
+// duty-cycle utilization of the gateways, per (EU 863-870) sub-band.
+package dutycycle
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/brocaar/lora-app-server/internal/alert"
+	"github.com/brocaar/lora-app-server/internal/common"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+// WarningThreshold defines the fraction (0 - 1) of the duty-cycle limit of
+// a sub-band, above which the utilization of a gateway results in a warning.
+var WarningThreshold = 0.8
+
+// Window defines the (rolling) window over which the duty-cycle utilization
+// is calculated when recording a downlink.
+const Window = time.Hour
+
+// Downlink transmission parameters.
+const (
+	rx2Frequency    = 869525000 // EU 863-870 RX2 frequency
+	preambleSymbols = 8
+	codingRate      = 1  // 4/5
+	frameOverhead   = 13 // MHDR + FHDR (without FOpts) + FPort + MIC
+)
+
+// SubBand defines a regulatory sub-band and its duty-cycle limit.
+type SubBand struct {
+	Name         string
+	MinFrequency int     // Hz (inclusive)
+	MaxFrequency int     // Hz (exclusive)
+	DutyCycle    float64 // fraction (0 - 1)
+}
+
+// SubBands contains the EU 863-870 sub-bands as defined by the LoRaWAN
+// Regional Parameters (ETSI EN 300 220).
+var SubBands = []SubBand{
+	{Name: "g", MinFrequency: 863000000, MaxFrequency: 868000000, DutyCycle: 0.01},
+	{Name: "g1", MinFrequency: 868000000, MaxFrequency: 868600000, DutyCycle: 0.01},
+	{Name: "g2", MinFrequency: 868700000, MaxFrequency: 869200000, DutyCycle: 0.001},
+	{Name: "g3", MinFrequency: 869400000, MaxFrequency: 869650000, DutyCycle: 0.1},
+	{Name: "g4", MinFrequency: 869700000, MaxFrequency: 870000000, DutyCycle: 0.01},
+}
+
+// dataRates contains the EU 863-870 LoRa data-rates (spreading-factor and
+// bandwidth in kHz).
+var dataRates = []struct {
+	SpreadFactor int
+	Bandwidth    int
+}{
+	{12, 125},
+	{11, 125},
+	{10, 125},
+	{9, 125},
+	{8, 125},
+	{7, 125},
+	{7, 250},
+}
+
+// Utilization contains the downlink airtime and duty-cycle utilization of
+// a gateway within a sub-band.
+type Utilization struct {
+	Timestamp   time.Time // start of the aggregation interval
+	SubBand     SubBand
+	Airtime     time.Duration
+	Utilization float64 // fraction (0 - 1) of the time the gateway was transmitting
+	Warning     bool    // utilization >= WarningThreshold * DutyCycle
+}
+
+// GetSubBand returns the sub-band for the given frequency (Hz). It returns
+// false when the frequency is not within a known sub-band.
+func GetSubBand(frequency int) (SubBand, bool) {
+	for _, sb := range SubBands {
+		if frequency >= sb.MinFrequency && frequency < sb.MaxFrequency {
+			return sb, true
+		}
+	}
+	return SubBand{}, false
+}
+
+// Airtime returns the time-on-air of a LoRa downlink transmission given the
+// PHYPayload size (bytes), spreading-factor and bandwidth (kHz). Downlink
+// transmissions use an explicit header, coding-rate 4/5 and no payload CRC.
+func Airtime(payloadSize, spreadFactor, bandwidth int) time.Duration {
+	symbolDuration := float64(int(1)<<uint(spreadFactor)) / float64(bandwidth*1000)
+
+	// low data-rate optimization is mandated for symbol durations > 16ms
+	var de int
+	if symbolDuration > 0.016 {
+		de = 1
+	}
+
+	payloadSymbols := 8 + math.Max(math.Ceil(float64(8*payloadSize-4*spreadFactor+28)/float64(4*(spreadFactor-2*de)))*(codingRate+4), 0)
+	seconds := (preambleSymbols + 4.25 + payloadSymbols) * symbolDuration
+	return time.Duration(seconds * float64(time.Second))
+}
+
+// RecordDownlink estimates the airtime of the downlink with the given
+// FRMPayload size to the given node and stores it for the gateway that
+// (most likely) transmits it, being the gateway that received the last
+// uplink with the best RSSI. A warning is logged when the utilization of
+// the sub-band over the last Window exceeds the WarningThreshold and an
+// alert is sent when the utilization crosses the WarningThreshold.
+func RecordDownlink(ctx common.Context, node storage.Node, payloadSize int) error {
+	uplinks, err := storage.GetLastNodeUplinks(ctx.DB, node.DevEUI, 1)
+	if err != nil {
+		return err
+	}
+	if len(uplinks) == 0 || uplinks[0].Modulation != "LORA" {
+		// unable to estimate the gateway and transmission parameters
+		return nil
+	}
+
+	rxInfo, err := storage.GetNodeUplinkRXInfo(ctx.DB, uplinks[0].ID)
+	if err != nil {
+		return err
+	}
+	if len(rxInfo) == 0 {
+		return nil
+	}
+
+	frequency, dr, err := getTXParameters(node, uplinks[0])
+	if err != nil {
+		return err
+	}
+	sb, _ := GetSubBand(frequency)
+
+	d := storage.GatewayDownlink{
+		MAC:          rxInfo[0].MAC,
+		DevEUI:       node.DevEUI,
+		Frequency:    frequency,
+		SubBand:      sb.Name,
+		SpreadFactor: dataRates[dr].SpreadFactor,
+		Bandwidth:    dataRates[dr].Bandwidth,
+		Airtime:      Airtime(frameOverhead+payloadSize, dataRates[dr].SpreadFactor, dataRates[dr].Bandwidth),
+	}
+	if err := storage.CreateGatewayDownlink(ctx.DB, &d); err != nil {
+		return err
+	}
+	err = storage.AddGatewayStats(ctx.DB, storage.GatewayStats{
+		MAC:       d.MAC,
+		Timestamp: d.CreatedAt,
+		TXPackets: 1,
+		TXAirtime: d.Airtime,
+	})
+	if err != nil {
+		return err
+	}
+
+	if sb.Name == "" {
+		return nil
+	}
+
+	airtime, err := storage.GetGatewaySubBandAirtimeSince(ctx.DB, d.MAC, sb.Name, d.CreatedAt.Add(-Window))
+	if err != nil {
+		return err
+	}
+	utilization := float64(airtime) / float64(Window)
+	if utilization < WarningThreshold*sb.DutyCycle {
+		return nil
+	}
+
+	log.WithFields(log.Fields{
+		"mac":         d.MAC,
+		"sub_band":    sb.Name,
+		"duty_cycle":  sb.DutyCycle,
+		"utilization": utilization,
+	}).Warning("gateway duty-cycle utilization approaching limit")
+
+	// only alert when this downlink made the utilization cross the threshold
+	previous := float64(airtime-d.Airtime) / float64(Window)
+	if ctx.Alerter == nil || previous >= WarningThreshold*sb.DutyCycle {
+		return nil
+	}
+	err = ctx.Alerter.Send(alert.Alert{
+		Type:    alert.TypeGatewayDutyCycle,
+		MAC:     &d.MAC,
+		Message: fmt.Sprintf("Downlink duty-cycle utilization of gateway %s in sub-band %s is %.2f%% (limit: %.1f%%).", d.MAC, sb.Name, utilization*100, sb.DutyCycle*100),
+	})
+	if err != nil {
+		return fmt.Errorf("send duty-cycle alert error: %s", err)
+	}
+	return nil
+}
+
+// GetGatewayReport returns the downlink duty-cycle utilization per sub-band
+// of the given gateway, within the given time range and aggregated by the
+// given interval.
+func GetGatewayReport(db *sqlx.DB, mac lorawan.EUI64, start, end time.Time, interval storage.AggregationInterval) ([]Utilization, error) {
+	if !end.After(start) {
+		return nil, errors.New("end must be after start")
+	}
+
+	airtimes, err := storage.GetGatewaySubBandAirtime(db, mac, start, end, interval)
+	if err != nil {
+		return nil, err
+	}
+
+	var out []Utilization
+	for _, at := range airtimes {
+		u, err := newUtilization(at, start, end, interval)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, u)
+	}
+	return out, nil
+}
+
+// newUtilization returns the Utilization for the given sub-band airtime.
+// The first and last aggregation intervals are limited to the given
+// time range.
+func newUtilization(at storage.SubBandAirtime, start, end time.Time, interval storage.AggregationInterval) (Utilization, error) {
+	u := Utilization{
+		Timestamp: at.Timestamp,
+		Airtime:   at.Airtime,
+	}
+	u.SubBand = getSubBandByName(at.SubBand)
+
+	intervalStart := at.Timestamp
+	var intervalEnd time.Time
+	switch interval {
+	case storage.AggregatePeriod:
+		intervalEnd = end
+	case storage.AggregateHour:
+		intervalEnd = intervalStart.Add(time.Hour)
+	case storage.AggregateDay:
+		intervalEnd = intervalStart.AddDate(0, 0, 1)
+	default:
+		return u, fmt.Errorf("invalid aggregation interval: %s", interval)
+	}
+	if intervalStart.Before(start) {
+		intervalStart = start
+	}
+	if intervalEnd.After(end) {
+		intervalEnd = end
+	}
+
+	if duration := intervalEnd.Sub(intervalStart); duration > 0 {
+		u.Utilization = float64(u.Airtime) / float64(duration)
+	}
+	u.Warning = u.SubBand.DutyCycle > 0 && u.Utilization >= WarningThreshold*u.SubBand.DutyCycle
+	return u, nil
+}
+
+// getSubBandByName returns the sub-band with the given name. For unknown
+// names, a sub-band without frequency range and duty-cycle limit is
+// returned.
+func getSubBandByName(name string) SubBand {
+	for _, sb := range SubBands {
+		if sb.Name == name {
+			return sb
+		}
+	}
+	return SubBand{Name: name}
+}
+
+// getTXParameters returns the (estimated) frequency and data-rate of the
+// downlink transmission to the given node, based on its RX window settings
+// and the last received uplink.
+func getTXParameters(node storage.Node, uplink storage.NodeUplink) (int, int, error) {
+	switch node.RXWindow {
+	case storage.RX1:
+		for i, dr := range dataRates {
+			if dr.SpreadFactor == uplink.SpreadFactor && dr.Bandwidth == uplink.Bandwidth {
+				i -= int(node.RX1DROffset)
+				if i < 0 {
+					i = 0
+				}
+				return uplink.Frequency, i, nil
+			}
+		}
+		return 0, 0, fmt.Errorf("unknown uplink data-rate: SF%d BW%d", uplink.SpreadFactor, uplink.Bandwidth)
+	case storage.RX2:
+		if int(node.RX2DR) >= len(dataRates) {
+			return 0, 0, fmt.Errorf("unknown rx2 data-rate: %d", node.RX2DR)
+		}
+		return rx2Frequency, int(node.RX2DR), nil
+	default:
+		return 0, 0, fmt.Errorf("unknown rx window: %d", node.RXWindow)
+	}
+}
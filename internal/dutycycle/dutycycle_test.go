@@ -0,0 +1,102 @@
+package dutycycle
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/brocaar/lora-app-server/internal/storage"
+)
+
+func TestAirtime(t *testing.T) {
+	Convey("Given a set of tests", t, func() {
+		testTable := []struct {
+			PayloadSize  int
+			SpreadFactor int
+			Bandwidth    int
+			ExpectedMS   float64
+		}{
+			{13, 12, 125, 1155.072},
+			{13, 7, 125, 41.216},
+			{63, 7, 250, 56.448},
+		}
+
+		for i, test := range testTable {
+			Convey(fmt.Sprintf("Test %d: SF%d BW%d and %d bytes", i, test.SpreadFactor, test.Bandwidth, test.PayloadSize), func() {
+				airtime := Airtime(test.PayloadSize, test.SpreadFactor, test.Bandwidth)
+				So(float64(airtime)/float64(time.Millisecond), ShouldAlmostEqual, test.ExpectedMS, 0.001)
+			})
+		}
+	})
+}
+
+func TestGetSubBand(t *testing.T) {
+	Convey("Given a set of tests", t, func() {
+		testTable := []struct {
+			Frequency int
+			Expected  string
+			Found     bool
+		}{
+			{868100000, "g1", true},
+			{867100000, "g", true},
+			{869525000, "g3", true},
+			{868800000, "g2", true},
+			{868650000, "", false},
+			{902300000, "", false},
+		}
+
+		for _, test := range testTable {
+			Convey(fmt.Sprintf("Then frequency %d is in sub-band '%s'", test.Frequency, test.Expected), func() {
+				sb, ok := GetSubBand(test.Frequency)
+				So(ok, ShouldEqual, test.Found)
+				So(sb.Name, ShouldEqual, test.Expected)
+			})
+		}
+	})
+}
+
+func TestNewUtilization(t *testing.T) {
+	Convey("Given a period starting halfway an hour", t, func() {
+		start := time.Date(2016, 12, 1, 10, 30, 0, 0, time.UTC)
+		end := start.Add(2 * time.Hour)
+
+		Convey("When aggregating per hour", func() {
+			Convey("Then the first interval is limited to the period", func() {
+				u, err := newUtilization(storage.SubBandAirtime{
+					Timestamp: time.Date(2016, 12, 1, 10, 0, 0, 0, time.UTC),
+					SubBand:   "g1",
+					Airtime:   18 * time.Second,
+				}, start, end, storage.AggregateHour)
+				So(err, ShouldBeNil)
+				So(u.SubBand.Name, ShouldEqual, "g1")
+				So(u.Utilization, ShouldAlmostEqual, 0.01)
+				So(u.Warning, ShouldBeTrue)
+			})
+
+			Convey("Then a full interval below the threshold does not result in a warning", func() {
+				u, err := newUtilization(storage.SubBandAirtime{
+					Timestamp: time.Date(2016, 12, 1, 11, 0, 0, 0, time.UTC),
+					SubBand:   "g3",
+					Airtime:   36 * time.Second,
+				}, start, end, storage.AggregateHour)
+				So(err, ShouldBeNil)
+				So(u.Utilization, ShouldAlmostEqual, 0.01)
+				So(u.Warning, ShouldBeFalse)
+			})
+		})
+
+		Convey("When aggregating over the whole period", func() {
+			Convey("Then an unknown sub-band never results in a warning", func() {
+				u, err := newUtilization(storage.SubBandAirtime{
+					Timestamp: start,
+					Airtime:   time.Hour,
+				}, start, end, storage.AggregatePeriod)
+				So(err, ShouldBeNil)
+				So(u.Utilization, ShouldAlmostEqual, 0.5)
+				So(u.Warning, ShouldBeFalse)
+			})
+		})
+	})
+}
@@ -0,0 +1,72 @@
+// Package eventlog implements the persistence of the node events (uplink
+// data, join, ack, error, ...), so that the event history of a node can be
+// queried after the fact.
+package eventlog
+
+import (
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+// Store defines the interface of the event log storage backend.
+type Store interface {
+	// Add stores the given event.
+	Add(e storage.EventLog) error
+
+	// List returns the events of the given node matching the given filter
+	// (newest first) and the total number of matching events.
+	List(devEUI lorawan.EUI64, f storage.EventLogFilter, limit, offset int) ([]storage.EventLog, int, error)
+
+	// DeleteBefore deletes the events created before the given time.
+	DeleteBefore(before time.Time) error
+}
+
+// PostgreSQLStore implements a Store using the PostgreSQL database.
+type PostgreSQLStore struct {
+	db *sqlx.DB
+}
+
+// NewPostgreSQLStore creates a new PostgreSQLStore.
+func NewPostgreSQLStore(db *sqlx.DB) *PostgreSQLStore {
+	return &PostgreSQLStore{db: db}
+}
+
+// Add stores the given event.
+func (s *PostgreSQLStore) Add(e storage.EventLog) error {
+	return storage.CreateEventLog(s.db, &e)
+}
+
+// List returns the events of the given node matching the given filter
+// (newest first) and the total number of matching events.
+func (s *PostgreSQLStore) List(devEUI lorawan.EUI64, f storage.EventLogFilter, limit, offset int) ([]storage.EventLog, int, error) {
+	count, err := storage.GetEventLogCount(s.db, devEUI, f)
+	if err != nil {
+		return nil, 0, err
+	}
+	events, err := storage.GetEventLogs(s.db, devEUI, f, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	return events, count, nil
+}
+
+// DeleteBefore deletes the events created before the given time.
+func (s *PostgreSQLStore) DeleteBefore(before time.Time) error {
+	return storage.DeleteEventLogsBefore(s.db, before)
+}
+
+// RunCleanup deletes the events older than the given retention period from
+// the given store, every hour.
+func RunCleanup(s Store, retention time.Duration) {
+	for {
+		if err := s.DeleteBefore(time.Now().Add(-retention)); err != nil {
+			log.Errorf("eventlog: cleanup error: %s", err)
+		}
+		time.Sleep(time.Hour)
+	}
+}
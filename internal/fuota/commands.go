@@ -0,0 +1,113 @@
+package fuota
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// DefaultFPort defines the default FPort of the fragmentation messages.
+const DefaultFPort = 201
+
+// Command identifiers of the fragmentation messages.
+const (
+	cidFragSessionStatus = 0x01
+	cidFragSessionSetup  = 0x02
+	cidDataFragment      = 0x08
+)
+
+// FragSessionSetupAns status bits.
+const (
+	setupEncodingUnsupported = 1 << iota
+	setupNotEnoughMemory
+	setupIndexNotSupported
+	setupWrongDescriptor
+)
+
+// FragSessionSetupReq returns the FragSessionSetupReq command for setting
+// up fragmentation session 0 (unicast, no multicast groups) using the
+// default fragmentation matrix.
+func FragSessionSetupReq(nbFrag, fragSize, padding int, descriptor uint32) []byte {
+	b := make([]byte, 11)
+	b[0] = cidFragSessionSetup
+	b[1] = 0 // FragIndex 0, McGroupBitMask 0
+	binary.LittleEndian.PutUint16(b[2:4], uint16(nbFrag))
+	b[4] = uint8(fragSize)
+	b[5] = 0 // fragmentation matrix 0, no block ack delay
+	b[6] = uint8(padding)
+	binary.LittleEndian.PutUint32(b[7:11], descriptor)
+	return b
+}
+
+// DataFragment returns the DataFragment command for the n-th (starting at
+// 1) fragment of session 0.
+func DataFragment(n int, frag []byte) []byte {
+	b := make([]byte, 3, 3+len(frag))
+	b[0] = cidDataFragment
+	binary.LittleEndian.PutUint16(b[1:3], uint16(n&MaxFragments))
+	return append(b, frag...)
+}
+
+// FragSessionStatusReq returns the FragSessionStatusReq command requesting
+// the status of session 0 from all nodes.
+func FragSessionStatusReq() []byte {
+	return []byte{cidFragSessionStatus, 0x01}
+}
+
+// Answer contains the answers parsed from an uplink on the fragmentation
+// FPort.
+type Answer struct {
+	// SetupError is set when the node rejected the session setup.
+	SetupError error
+
+	// HasStatus is set when the payload contained a FragSessionStatusAns.
+	HasStatus      bool
+	NbFragReceived int
+	MissingFrag    int
+	MemoryError    bool // not enough memory to reconstruct the data
+}
+
+// ParseAnswer parses the FragSessionSetupAns and FragSessionStatusAns
+// commands of the given (uplink) payload.
+func ParseAnswer(b []byte) (Answer, error) {
+	var a Answer
+	for len(b) > 0 {
+		switch b[0] {
+		case cidFragSessionSetup:
+			if len(b) < 2 {
+				return a, errors.New("FragSessionSetupAns: expected 1 byte")
+			}
+			a.SetupError = setupError(b[1])
+			b = b[2:]
+		case cidFragSessionStatus:
+			if len(b) < 5 {
+				return a, errors.New("FragSessionStatusAns: expected 4 bytes")
+			}
+			a.HasStatus = true
+			a.NbFragReceived = int(binary.LittleEndian.Uint16(b[1:3]) & MaxFragments)
+			a.MissingFrag = int(b[3])
+			a.MemoryError = b[4]&0x01 != 0
+			b = b[5:]
+		default:
+			return a, fmt.Errorf("unknown command identifier: 0x%02x", b[0])
+		}
+	}
+	return a, nil
+}
+
+// setupError returns the error for the given FragSessionSetupAns status
+// bits or nil when the setup succeeded.
+func setupError(status uint8) error {
+	switch {
+	case status&setupEncodingUnsupported != 0:
+		return errors.New("fragmentation encoding not supported")
+	case status&setupNotEnoughMemory != 0:
+		return errors.New("not enough memory")
+	case status&setupIndexNotSupported != 0:
+		return errors.New("fragmentation session index not supported")
+	case status&setupWrongDescriptor != 0:
+		return errors.New("wrong descriptor")
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,90 @@
+package fuota
+
+import "fmt"
+
+// MaxFragments defines the maximum number of (uncoded and redundancy)
+// fragments of a fragmentation session (14 bit fragment index).
+const MaxFragments = 1<<14 - 1
+
+// Fragment splits the given data into fragments of fragSize bytes, followed
+// by the given number of redundancy fragments, as defined by the LoRaWAN
+// Fragmented Data Block Transport specification. The last uncoded fragment
+// is padded with zeros, the number of padding bytes is returned.
+//
+// Each redundancy fragment is the XOR of the uncoded fragments selected by
+// the parity matrix line of the fragment, so that a node can reconstruct
+// the data when it missed some of the fragments.
+func Fragment(data []byte, fragSize, redundancy int) ([][]byte, int, error) {
+	if len(data) == 0 {
+		return nil, 0, fmt.Errorf("data must not be empty")
+	}
+	if fragSize <= 0 {
+		return nil, 0, fmt.Errorf("fragment size must be greater than 0")
+	}
+	if redundancy < 0 {
+		return nil, 0, fmt.Errorf("redundancy must not be negative")
+	}
+
+	m := (len(data) + fragSize - 1) / fragSize
+	if m+redundancy > MaxFragments {
+		return nil, 0, fmt.Errorf("the number of fragments (%d) exceeds the maximum of %d", m+redundancy, MaxFragments)
+	}
+	padding := m*fragSize - len(data)
+
+	padded := make([]byte, m*fragSize)
+	copy(padded, data)
+
+	fragments := make([][]byte, 0, m+redundancy)
+	for i := 0; i < m; i++ {
+		fragments = append(fragments, padded[i*fragSize:(i+1)*fragSize])
+	}
+
+	for y := 1; y <= redundancy; y++ {
+		frag := make([]byte, fragSize)
+		for x, set := range matrixLine(y, m) {
+			if !set {
+				continue
+			}
+			for i := range frag {
+				frag[i] ^= fragments[x][i]
+			}
+		}
+		fragments = append(fragments, frag)
+	}
+
+	return fragments, padding, nil
+}
+
+// matrixLine returns the n-th (starting at 1) line of the parity matrix for
+// m uncoded fragments. A true value at index x means that uncoded fragment
+// x+1 is part of the redundancy fragment.
+func matrixLine(n, m int) []bool {
+	line := make([]bool, m)
+
+	// when m is a power of 2, the modulo is taken over m+1 to avoid a
+	// bias towards the same fragments
+	mm := 0
+	if m&(m-1) == 0 {
+		mm = 1
+	}
+
+	x := 1 + 1001*n
+	for nbCoeff := 0; nbCoeff < m/2; nbCoeff++ {
+		r := 1 << 16
+		for r >= m {
+			x = prbs23(x)
+			r = x % (m + mm)
+		}
+		line[r] = true
+	}
+
+	return line
+}
+
+// prbs23 returns the next value of the 23 bit pseudo-random binary sequence
+// used for generating the parity matrix.
+func prbs23(x int) int {
+	b0 := x & 1
+	b1 := (x & 32) >> 5
+	return (x >> 1) + ((b0 ^ b1) << 22)
+}
@@ -0,0 +1,141 @@
+package fuota
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestFragment(t *testing.T) {
+	Convey("Given a firmware image of 10 bytes", t, func() {
+		data := []byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+
+		Convey("When fragmenting it in fragments of 4 bytes with 2 redundancy fragments", func() {
+			fragments, padding, err := Fragment(data, 4, 2)
+			So(err, ShouldBeNil)
+
+			Convey("Then the last uncoded fragment is padded", func() {
+				So(padding, ShouldEqual, 2)
+				So(fragments, ShouldHaveLength, 5)
+				So(fragments[:3], ShouldResemble, [][]byte{
+					{1, 2, 3, 4},
+					{5, 6, 7, 8},
+					{9, 10, 0, 0},
+				})
+			})
+
+			Convey("Then each redundancy fragment is the XOR of the fragments of its matrix line", func() {
+				for y := 1; y <= 2; y++ {
+					expected := make([]byte, 4)
+					for x, set := range matrixLine(y, 3) {
+						if !set {
+							continue
+						}
+						for i := range expected {
+							expected[i] ^= fragments[x][i]
+						}
+					}
+					So(fragments[2+y], ShouldResemble, expected)
+				}
+			})
+		})
+
+		Convey("Then fragmenting with an invalid fragment size returns an error", func() {
+			_, _, err := Fragment(data, 0, 0)
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("Then exceeding the maximum number of fragments returns an error", func() {
+			_, _, err := Fragment(data, 1, MaxFragments)
+			So(err, ShouldNotBeNil)
+		})
+	})
+
+	Convey("Then fragmenting an empty image returns an error", t, func() {
+		_, _, err := Fragment(nil, 4, 0)
+		So(err, ShouldNotBeNil)
+	})
+}
+
+func TestMatrixLine(t *testing.T) {
+	Convey("Given a set of tests", t, func() {
+		tests := []struct {
+			N        int
+			M        int
+			Expected []bool
+		}{
+			{1, 4, []bool{true, false, true, false}},
+			{4, 4, []bool{false, true, true, false}},
+			{1, 5, []bool{true, false, true, false, false}},
+			{2, 8, []bool{true, false, false, false, true, false, false, true}},
+		}
+
+		for _, test := range tests {
+			So(matrixLine(test.N, test.M), ShouldResemble, test.Expected)
+		}
+	})
+}
+
+func TestCommands(t *testing.T) {
+	Convey("Then FragSessionSetupReq returns the expected bytes", t, func() {
+		So(FragSessionSetupReq(3, 4, 2, 0x01020304), ShouldResemble, []byte{0x02, 0x00, 0x03, 0x00, 0x04, 0x00, 0x02, 0x04, 0x03, 0x02, 0x01})
+	})
+
+	Convey("Then DataFragment returns the expected bytes", t, func() {
+		So(DataFragment(3, []byte{1, 2}), ShouldResemble, []byte{0x08, 0x03, 0x00, 0x01, 0x02})
+	})
+
+	Convey("Given a set of answers", t, func() {
+		tests := []struct {
+			Name     string
+			Payload  []byte
+			Expected Answer
+			Error    bool
+		}{
+			{
+				Name:     "session status",
+				Payload:  []byte{0x01, 0x05, 0xc0, 0x00, 0x00},
+				Expected: Answer{HasStatus: true, NbFragReceived: 5},
+			},
+			{
+				Name:     "session status with memory error",
+				Payload:  []byte{0x01, 0x05, 0x00, 0x02, 0x01},
+				Expected: Answer{HasStatus: true, NbFragReceived: 5, MissingFrag: 2, MemoryError: true},
+			},
+			{
+				Name:     "successful session setup",
+				Payload:  []byte{0x02, 0x00},
+				Expected: Answer{},
+			},
+			{
+				Name:    "truncated session status",
+				Payload: []byte{0x01, 0x05},
+				Error:   true,
+			},
+			{
+				Name:    "unknown command",
+				Payload: []byte{0x03},
+				Error:   true,
+			},
+		}
+
+		for _, test := range tests {
+			Convey("Testing: "+test.Name, func() {
+				a, err := ParseAnswer(test.Payload)
+				if test.Error {
+					So(err, ShouldNotBeNil)
+					return
+				}
+				So(err, ShouldBeNil)
+				So(a, ShouldResemble, test.Expected)
+			})
+		}
+
+		Convey("Then a failed session setup returns the setup error", func() {
+			a, err := ParseAnswer([]byte{0x02, 0x02})
+			So(err, ShouldBeNil)
+			So(a.SetupError, ShouldNotBeNil)
+			So(a.SetupError.Error(), ShouldEqual, "not enough memory")
+		})
+	})
+}
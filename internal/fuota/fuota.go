@@ -0,0 +1,292 @@
+// Package fuota implements the firmware update over the air (FUOTA)
+// deployments. The firmware image is fragmented and enqueued for the nodes
+// of a multicast group, using the LoRaWAN Fragmented Data Block Transport
+// messages. The progress of each node is tracked using the answers of the
+// nodes and published as firmware notification.
+package fuota
+
+import (
+	"fmt"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+
+	"github.com/brocaar/lora-app-server/integration"
+	"github.com/brocaar/lora-app-server/internal/common"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+// schedulerInterval defines the interval in which the scheduler checks for
+// due and expired deployments.
+const schedulerInterval = 10 * time.Second
+
+// RunScheduler starts the due deployments and times out the expired
+// deployments, until the program exits.
+func RunScheduler(ctx common.Context) {
+	for {
+		now := time.Now()
+		if err := StartDue(ctx, now); err != nil {
+			log.Errorf("fuota: start due deployments error: %s", err)
+		}
+		if err := CheckTimeouts(ctx, now); err != nil {
+			log.Errorf("fuota: check deployment timeouts error: %s", err)
+		}
+		time.Sleep(schedulerInterval)
+	}
+}
+
+// StartDue starts the deployments which are due at the given time. Each
+// deployment is claimed first, so that it is started only once when running
+// multiple instances.
+func StartDue(ctx common.Context, now time.Time) error {
+	deployments, err := storage.GetDueFUOTADeployments(ctx.DB, now)
+	if err != nil {
+		return err
+	}
+
+	for _, d := range deployments {
+		claimed, err := storage.ClaimFUOTADeployment(ctx.DB, d.ID, now.Add(time.Duration(d.Timeout)*time.Second))
+		if err != nil {
+			log.WithField("id", d.ID).Errorf("fuota: claim deployment error: %s", err)
+			continue
+		}
+		if !claimed {
+			continue
+		}
+		if err := start(ctx, d); err != nil {
+			log.WithField("id", d.ID).Errorf("fuota: start deployment error: %s", err)
+		}
+	}
+	return nil
+}
+
+// start adds the nodes of the multicast group to the given deployment and
+// enqueues the session setup, the fragments and the status request for
+// each of them.
+func start(ctx common.Context, d storage.FUOTADeployment) error {
+	fragments, padding, err := Fragment(d.Firmware, d.FragSize, d.Redundancy)
+	if err != nil {
+		return fmt.Errorf("fragment firmware error: %s", err)
+	}
+
+	nodes, err := storage.CreateFUOTADeploymentNodes(ctx.DB, d, integration.FirmwareStateInProgress)
+	if err != nil {
+		return err
+	}
+	if len(nodes) == 0 {
+		log.WithField("id", d.ID).Warning("fuota: multicast group has no nodes")
+		return storage.SetFUOTADeploymentState(ctx.DB, d.ID, storage.FUOTADeploymentDone)
+	}
+
+	payloads := [][]byte{FragSessionSetupReq(len(fragments)-d.Redundancy, d.FragSize, padding, d.Descriptor)}
+	for i, frag := range fragments {
+		payloads = append(payloads, DataFragment(i+1, frag))
+	}
+	payloads = append(payloads, FragSessionStatusReq())
+
+	// the quota is only used when the payloads are enqueued
+	tx, err := ctx.DB.Beginx()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// the update fails for all nodes when the payloads would exceed the
+	// downlink quota of the organization
+	if err := storage.UseDownlinkQuota(tx, d.AppEUI, len(payloads)*len(nodes)); err != nil {
+		if err != storage.ErrDownlinkQuotaExceeded {
+			return err
+		}
+		tx.Rollback()
+		for _, n := range nodes {
+			n.State = integration.FirmwareStateFailed
+			n.Error = err.Error()
+			if err := finish(ctx, d, n); err != nil {
+				log.WithFields(log.Fields{
+					"id":      d.ID,
+					"dev_eui": n.DevEUI,
+				}).Errorf("fuota: fail node error: %s", err)
+			}
+		}
+		return storage.SetFUOTADeploymentState(ctx.DB, d.ID, storage.FUOTADeploymentDone)
+	}
+
+	for i, pl := range payloads {
+		_, err := storage.CreateFUOTAQueueItems(tx, d.ID, storage.DownlinkQueueItem{
+			Reference: fmt.Sprintf("%s%d", ReferencePrefix(d.ID), i),
+			FPort:     d.FPort,
+			Data:      pl,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	log.WithFields(log.Fields{
+		"id":         d.ID,
+		"nodes":      len(nodes),
+		"fragments":  len(fragments),
+		"redundancy": d.Redundancy,
+	}).Info("fuota: deployment started")
+
+	for _, n := range nodes {
+		notify(ctx, d, n)
+	}
+	return nil
+}
+
+// CheckTimeouts sets the nodes of the deployments of which the deadline
+// has passed at the given time, that have not completed the update, to
+// timeout.
+func CheckTimeouts(ctx common.Context, now time.Time) error {
+	deployments, err := storage.GetExpiredFUOTADeployments(ctx.DB, now)
+	if err != nil {
+		return err
+	}
+
+	for _, d := range deployments {
+		nodes, err := storage.GetFUOTADeploymentNodes(ctx.DB, d.ID)
+		if err != nil {
+			log.WithField("id", d.ID).Errorf("fuota: get deployment nodes error: %s", err)
+			continue
+		}
+
+		for _, n := range nodes {
+			if n.State != integration.FirmwareStateInProgress {
+				continue
+			}
+			n.State = integration.FirmwareStateTimeout
+			if err := finish(ctx, d, n); err != nil {
+				log.WithFields(log.Fields{
+					"id":      d.ID,
+					"dev_eui": n.DevEUI,
+				}).Errorf("fuota: timeout node error: %s", err)
+			}
+		}
+
+		if err := storage.SetFUOTADeploymentState(ctx.DB, d.ID, storage.FUOTADeploymentDone); err != nil {
+			log.WithField("id", d.ID).Errorf("fuota: set deployment state error: %s", err)
+		}
+	}
+	return nil
+}
+
+// HandleUplink handles the given (decrypted) uplink payload when the node
+// is part of a deployment in progress using the given FPort. Otherwise the
+// payload is ignored.
+func HandleUplink(ctx common.Context, devEUI lorawan.EUI64, fPort uint8, data []byte) error {
+	n, err := storage.GetInProgressFUOTADeploymentNode(ctx.DB, devEUI, fPort)
+	if err != nil {
+		return err
+	}
+	if n == nil {
+		return nil
+	}
+
+	// the node already completed (or failed) the update
+	if n.State != integration.FirmwareStateInProgress {
+		return nil
+	}
+
+	d, err := storage.GetFUOTADeployment(ctx.DB, n.DeploymentID)
+	if err != nil {
+		return err
+	}
+
+	a, err := ParseAnswer(data)
+	if err != nil {
+		return fmt.Errorf("parse answer error: %s", err)
+	}
+
+	switch {
+	case a.SetupError != nil:
+		n.State = integration.FirmwareStateFailed
+		n.Error = fmt.Sprintf("session setup failed: %s", a.SetupError)
+	case a.HasStatus && a.MemoryError:
+		n.NbFragReceived = a.NbFragReceived
+		n.MissingFrag = a.MissingFrag
+		n.State = integration.FirmwareStateFailed
+		n.Error = "not enough memory to reconstruct the firmware"
+	case a.HasStatus:
+		n.NbFragReceived = a.NbFragReceived
+		n.MissingFrag = a.MissingFrag
+		if n.MissingFrag == 0 && n.NbFragReceived >= nbFrag(d) {
+			n.State = integration.FirmwareStateCompleted
+		}
+	default:
+		return nil
+	}
+
+	if n.State == integration.FirmwareStateInProgress {
+		if err := storage.UpdateFUOTADeploymentNode(ctx.DB, n); err != nil {
+			return err
+		}
+		notify(ctx, d, *n)
+		return nil
+	}
+
+	if err := finish(ctx, d, *n); err != nil {
+		return err
+	}
+
+	// the deployment is done when none of the nodes is in progress
+	nodes, err := storage.GetFUOTADeploymentNodes(ctx.DB, d.ID)
+	if err != nil {
+		return err
+	}
+	for _, n := range nodes {
+		if n.State == integration.FirmwareStateInProgress {
+			return nil
+		}
+	}
+	return storage.SetFUOTADeploymentState(ctx.DB, d.ID, storage.FUOTADeploymentDone)
+}
+
+// finish stores the (final) state of the given node, removes the remaining
+// deployment items from its queue and sends a firmware notification.
+func finish(ctx common.Context, d storage.FUOTADeployment, n storage.FUOTADeploymentNode) error {
+	if err := storage.UpdateFUOTADeploymentNode(ctx.DB, &n); err != nil {
+		return err
+	}
+	if err := storage.FlushFUOTAQueueItems(ctx.DB, n.DevEUI, ReferencePrefix(d.ID)); err != nil {
+		return err
+	}
+	notify(ctx, d, n)
+	return nil
+}
+
+// notify sends a firmware notification for the given node.
+func notify(ctx common.Context, d storage.FUOTADeployment, n storage.FUOTADeploymentNode) {
+	err := ctx.Handler.SendFirmwareNotification(d.AppEUI, n.DevEUI, integration.FirmwareNotification{
+		DeploymentID:   d.ID,
+		DevEUI:         n.DevEUI,
+		State:          n.State,
+		NbFrag:         nbFrag(d),
+		NbFragReceived: n.NbFragReceived,
+		MissingFrag:    n.MissingFrag,
+		Error:          n.Error,
+		Time:           time.Now(),
+	})
+	if err != nil {
+		log.WithFields(log.Fields{
+			"id":      d.ID,
+			"dev_eui": n.DevEUI,
+		}).Errorf("fuota: send firmware notification error: %s", err)
+	}
+}
+
+// nbFrag returns the number of uncoded fragments of the firmware of the
+// given deployment.
+func nbFrag(d storage.FUOTADeployment) int {
+	return (len(d.Firmware) + d.FragSize - 1) / d.FragSize
+}
+
+// ReferencePrefix returns the prefix of the references of the queue items
+// of the given deployment.
+func ReferencePrefix(id int64) string {
+	return fmt.Sprintf("fuota:%d:", id)
+}
@@ -0,0 +1,131 @@
+// Package gateway implements the gathering and publishing of the gateway
+// statistics. The statistics are based on the uplink meta-data received
+// from the network-server and the (estimated) downlink transmissions, and
+// are stored per hour for the gateways managed by LoRa App Server.
+package gateway
+
+import (
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/brocaar/lora-app-server/integration"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+// publishDelay defines the delay after the end of an interval before its
+// statistics are published, so that the uplinks received at the end of
+// the interval have been processed.
+const publishDelay = time.Minute
+
+// publishBatchSize defines the number of gateways retrieved at once when
+// publishing the statistics.
+const publishBatchSize = 100
+
+// StatsPublisher defines the interface for publishing gateway statistics.
+type StatsPublisher interface {
+	SendGatewayStats(mac lorawan.EUI64, payload integration.GatewayStats) error
+}
+
+// RecordUplink adds the given uplink meta-data, received at the given time,
+// to the statistics of the receiving gateways. Gateways that are not
+// managed by LoRa App Server are ignored.
+func RecordUplink(db *sqlx.DB, rxInfo []integration.RXInfo, ts time.Time) error {
+	for _, rx := range rxInfo {
+		err := storage.AddGatewayStats(db, storage.GatewayStats{
+			MAC:       rx.MAC,
+			Timestamp: ts,
+			RXPackets: 1,
+			RSSISum:   int64(rx.RSSI),
+			SNRSum:    rx.LoRaSNR,
+		})
+		if err != nil {
+			return err
+		}
+		if err := storage.SetGatewayLastSeenAt(db, rx.MAC, ts); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RunStatsPublisher publishes the statistics of each gateway after the end
+// of each hour, until the program exits.
+func RunStatsPublisher(db *sqlx.DB, p StatsPublisher) {
+	for {
+		next := time.Now().Truncate(time.Hour).Add(time.Hour)
+		time.Sleep(next.Add(publishDelay).Sub(time.Now()))
+
+		if err := PublishStats(db, p, next.Add(-time.Hour)); err != nil {
+			log.Errorf("gateway: publish stats error: %s", err)
+		}
+	}
+}
+
+// PublishStats publishes the statistics of each gateway for the hour
+// starting at the given time. The statistics of each gateway are claimed
+// first, so that they are published only once when running multiple
+// instances.
+func PublishStats(db *sqlx.DB, p StatsPublisher, start time.Time) error {
+	for offset := 0; ; offset += publishBatchSize {
+		gws, err := storage.GetGateways(db, publishBatchSize, offset)
+		if err != nil {
+			return err
+		}
+
+		for _, gw := range gws {
+			if err := publishGatewayStats(db, p, gw.MAC, start); err != nil {
+				log.WithField("mac", gw.MAC).Errorf("gateway: publish stats error: %s", err)
+			}
+		}
+
+		if len(gws) < publishBatchSize {
+			return nil
+		}
+	}
+}
+
+// publishGatewayStats publishes the statistics of the given gateway for the
+// hour starting at the given time, when not yet claimed.
+func publishGatewayStats(db *sqlx.DB, p StatsPublisher, mac lorawan.EUI64, start time.Time) error {
+	claimed, err := storage.ClaimGatewayStatsPublish(db, mac, start)
+	if err != nil || !claimed {
+		return err
+	}
+
+	stats, err := storage.GetGatewayStats(db, mac, start, start.Add(time.Hour), storage.AggregatePeriod)
+	if err != nil {
+		return err
+	}
+
+	// publish empty statistics when the gateway didn't receive or transmit
+	// anything during the interval
+	s := storage.GatewayStats{
+		MAC:       mac,
+		Timestamp: start,
+	}
+	if len(stats) > 0 {
+		s = stats[0]
+	}
+
+	return p.SendGatewayStats(mac, NewStatsPayload(s))
+}
+
+// NewStatsPayload returns the published representation of the given
+// statistics.
+func NewStatsPayload(s storage.GatewayStats) integration.GatewayStats {
+	pl := integration.GatewayStats{
+		MAC:               s.MAC,
+		Time:              s.Timestamp,
+		RXPacketsReceived: s.RXPackets,
+		TXPacketsEmitted:  s.TXPackets,
+		TXAirtime:         int(s.TXAirtime / time.Millisecond),
+	}
+	if s.RXPackets > 0 {
+		pl.AvgRSSI = float64(s.RSSISum) / float64(s.RXPackets)
+		pl.AvgLoRaSNR = s.SNRSum / float64(s.RXPackets)
+	}
+	return pl
+}
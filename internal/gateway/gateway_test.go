@@ -0,0 +1,46 @@
+package gateway
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/brocaar/lora-app-server/integration"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+func TestNewStatsPayload(t *testing.T) {
+	Convey("Given gateway statistics", t, func() {
+		ts := time.Date(2017, 1, 1, 12, 0, 0, 0, time.UTC)
+		s := storage.GatewayStats{
+			MAC:       lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+			Timestamp: ts,
+			RXPackets: 4,
+			RSSISum:   -400,
+			SNRSum:    10,
+			TXPackets: 2,
+			TXAirtime: 1500 * time.Millisecond,
+		}
+
+		Convey("Then the payload contains the averages", func() {
+			So(NewStatsPayload(s), ShouldResemble, integration.GatewayStats{
+				MAC:               s.MAC,
+				Time:              ts,
+				RXPacketsReceived: 4,
+				AvgRSSI:           -100,
+				AvgLoRaSNR:        2.5,
+				TXPacketsEmitted:  2,
+				TXAirtime:         1500,
+			})
+		})
+
+		Convey("Then the averages are zero without received packets", func() {
+			s.RXPackets = 0
+			pl := NewStatsPayload(s)
+			So(pl.AvgRSSI, ShouldEqual, 0)
+			So(pl.AvgLoRaSNR, ShouldEqual, 0)
+		})
+	})
+}
@@ -0,0 +1,186 @@
+// Package geolocation implements the resolving of the location of the nodes,
+// based on the (fine) reception timestamps of an uplink received by multiple
+// gateways (TDOA), using an external resolver.
+package geolocation
+
+import (
+	"fmt"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+
+	"github.com/brocaar/lora-app-server/integration"
+	"github.com/brocaar/lora-app-server/internal/common"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+// MinGateways defines the min. number of gateways (with a reception
+// timestamp and a known location) required for resolving the location.
+const MinGateways = 3
+
+// Resolver types.
+const (
+	ResolverLoRaCloud = "loracloud"
+	ResolverCollos    = "collos"
+	ResolverHTTP      = "http"
+)
+
+// GatewayRX contains the location of a receiving gateway and the meta-data
+// of the received uplink.
+type GatewayRX struct {
+	MAC       lorawan.EUI64 `json:"mac"`
+	Latitude  float64       `json:"latitude"`
+	Longitude float64       `json:"longitude"`
+	Altitude  float64       `json:"altitude"` // meters
+	Time      time.Time     `json:"time"`     // reception timestamp
+	RSSI      int           `json:"rssi"`
+	LoRaSNR   float64       `json:"loRaSNR"`
+}
+
+// Frame contains the uplink of a node, as received by the gateways.
+type Frame struct {
+	DevEUI lorawan.EUI64 `json:"devEUI"`
+	RXInfo []GatewayRX   `json:"rxInfo"`
+}
+
+// Location contains a resolved location.
+type Location struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+	Altitude  float64 `json:"altitude"` // meters
+	Accuracy  float64 `json:"accuracy"` // meters (0 = unknown)
+}
+
+// Resolver defines the interface of a geolocation resolver.
+type Resolver interface {
+	// Name returns the name of the resolver, used as source of the location
+	// notifications.
+	Name() string
+
+	// Resolve resolves the location of the given frame. It returns nil when
+	// the location could not be resolved.
+	Resolve(f Frame) (*Location, error)
+}
+
+// NewResolver returns the Resolver of the given type, using the given
+// server (the default server is used when empty, except for the http
+// resolver) and token.
+func NewResolver(resolverType, server, token string, timeout time.Duration) (Resolver, error) {
+	switch resolverType {
+	case ResolverLoRaCloud:
+		if server == "" {
+			server = DefaultLoRaCloudServer
+		}
+		return NewTDOAResolver(ResolverLoRaCloud, server, token, timeout), nil
+	case ResolverCollos:
+		if server == "" {
+			server = DefaultCollosServer
+		}
+		return NewTDOAResolver(ResolverCollos, server, token, timeout), nil
+	case ResolverHTTP:
+		if server == "" {
+			return nil, fmt.Errorf("the %s resolver requires a server", resolverType)
+		}
+		return NewHTTPResolver(server, token, timeout), nil
+	default:
+		return nil, fmt.Errorf("unknown resolver: %s", resolverType)
+	}
+}
+
+// NewFrame returns the frame for the given uplink meta-data. Only the
+// gateways with a reception timestamp and a known location (one of the
+// given gateways, with the latitude or longitude set) are included.
+func NewFrame(devEUI lorawan.EUI64, rxInfo []integration.RXInfo, gws []storage.Gateway) Frame {
+	gwMap := make(map[lorawan.EUI64]storage.Gateway)
+	for _, gw := range gws {
+		if gw.Latitude != 0 || gw.Longitude != 0 {
+			gwMap[gw.MAC] = gw
+		}
+	}
+
+	f := Frame{DevEUI: devEUI}
+	for _, rx := range rxInfo {
+		gw, ok := gwMap[rx.MAC]
+		if !ok || rx.Time == nil {
+			continue
+		}
+		f.RXInfo = append(f.RXInfo, GatewayRX{
+			MAC:       rx.MAC,
+			Latitude:  gw.Latitude,
+			Longitude: gw.Longitude,
+			Altitude:  gw.Altitude,
+			Time:      *rx.Time,
+			RSSI:      rx.RSSI,
+			LoRaSNR:   rx.LoRaSNR,
+		})
+	}
+	return f
+}
+
+// HandleUplink resolves the location of the given node using the meta-data
+// of the given uplink. When resolved, the location is stored and a location
+// notification is sent to the handler. Uplinks received by less than
+// MinGateways gateways (with a reception timestamp and known location) are
+// ignored.
+func HandleUplink(ctx common.Context, r Resolver, node storage.Node, rxInfo []integration.RXInfo) error {
+	var macs []lorawan.EUI64
+	for _, rx := range rxInfo {
+		if rx.Time != nil {
+			macs = append(macs, rx.MAC)
+		}
+	}
+	if len(macs) < MinGateways {
+		return nil
+	}
+
+	gws, err := storage.GetGatewaysForMACs(ctx.DB, macs)
+	if err != nil {
+		return err
+	}
+
+	f := NewFrame(node.DevEUI, rxInfo, gws)
+	if len(f.RXInfo) < MinGateways {
+		return nil
+	}
+
+	loc, err := r.Resolve(f)
+	if err != nil {
+		return fmt.Errorf("resolve location error: %s", err)
+	}
+	if loc == nil {
+		log.WithFields(log.Fields{
+			"dev_eui":  node.DevEUI,
+			"resolver": r.Name(),
+		}).Info("geolocation: location could not be resolved")
+		return nil
+	}
+
+	ts := f.RXInfo[0].Time
+	if err := storage.UpdateNodeLocation(ctx.DB, node.DevEUI, loc.Latitude, loc.Longitude, loc.Altitude, loc.Accuracy, ts); err != nil {
+		return err
+	}
+
+	log.WithFields(log.Fields{
+		"dev_eui":   node.DevEUI,
+		"resolver":  r.Name(),
+		"latitude":  loc.Latitude,
+		"longitude": loc.Longitude,
+		"accuracy":  loc.Accuracy,
+	}).Info("geolocation: location resolved")
+
+	err = ctx.Handler.SendLocationNotification(node.AppEUI, node.DevEUI, integration.LocationNotification{
+		DevEUI:     node.DevEUI,
+		Latitude:   loc.Latitude,
+		Longitude:  loc.Longitude,
+		Altitude:   loc.Altitude,
+		Accuracy:   loc.Accuracy,
+		Source:     r.Name(),
+		NbGateways: len(f.RXInfo),
+		Time:       ts,
+	})
+	if err != nil {
+		return fmt.Errorf("send location notification error: %s", err)
+	}
+	return nil
+}
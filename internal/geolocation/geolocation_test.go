@@ -0,0 +1,149 @@
+package geolocation
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/brocaar/lora-app-server/integration"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+func TestNewFrame(t *testing.T) {
+	Convey("Given uplink meta-data received by four gateways", t, func() {
+		devEUI := lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}
+		ts := time.Date(2016, 12, 1, 12, 0, 0, 123456789, time.UTC)
+
+		rxInfo := []integration.RXInfo{
+			{MAC: lorawan.EUI64{1}, Time: &ts, RSSI: -80, LoRaSNR: 5},
+			{MAC: lorawan.EUI64{2}, Time: &ts, RSSI: -90, LoRaSNR: 3},
+			{MAC: lorawan.EUI64{3}, RSSI: -100, LoRaSNR: 1},
+			{MAC: lorawan.EUI64{4}, Time: &ts, RSSI: -110, LoRaSNR: -2},
+		}
+		gws := []storage.Gateway{
+			{MAC: lorawan.EUI64{1}, Latitude: 52.1, Longitude: 4.1, Altitude: 10},
+			{MAC: lorawan.EUI64{2}},
+			{MAC: lorawan.EUI64{3}, Latitude: 52.3, Longitude: 4.3},
+		}
+
+		Convey("Then only the gateways with a timestamp and known location are included", func() {
+			f := NewFrame(devEUI, rxInfo, gws)
+			So(f, ShouldResemble, Frame{
+				DevEUI: devEUI,
+				RXInfo: []GatewayRX{
+					{MAC: lorawan.EUI64{1}, Latitude: 52.1, Longitude: 4.1, Altitude: 10, Time: ts, RSSI: -80, LoRaSNR: 5},
+				},
+			})
+		})
+	})
+}
+
+func TestTDOAResolver(t *testing.T) {
+	Convey("Given a test TDOA server and frame", t, func() {
+		requests := make(chan tdoaRequest, 1)
+		tokens := make(chan string, 1)
+		var response string
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var req tdoaRequest
+			json.NewDecoder(r.Body).Decode(&req)
+			requests <- req
+			tokens <- r.Header.Get(tdoaTokenHeader)
+			w.Write([]byte(response))
+		}))
+		defer server.Close()
+
+		ts := time.Date(2016, 12, 1, 12, 0, 0, 123456789, time.UTC)
+		f := Frame{
+			DevEUI: lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+			RXInfo: []GatewayRX{
+				{MAC: lorawan.EUI64{1}, Latitude: 52.1, Longitude: 4.1, Altitude: 10, Time: ts, RSSI: -80, LoRaSNR: 5},
+			},
+		}
+		r := NewTDOAResolver(ResolverLoRaCloud, server.URL, "secret", time.Second)
+
+		Convey("When the server returns a location", func() {
+			response = `{"result": {"latitude": 52.2, "longitude": 4.2, "altitude": 5, "accuracy": 50}, "errors": [], "warnings": []}`
+			loc, err := r.Resolve(f)
+			So(err, ShouldBeNil)
+
+			Convey("Then the expected request was made", func() {
+				So(<-tokens, ShouldEqual, "secret")
+				So(<-requests, ShouldResemble, tdoaRequest{
+					LoRaWAN: []tdoaUplink{
+						{
+							GatewayID:       "0100000000000000",
+							AntennaLocation: tdoaAntennaLocation{Latitude: 52.1, Longitude: 4.1, Altitude: 10},
+							RSSI:            -80,
+							SNR:             5,
+							TOA:             123456789,
+						},
+					},
+				})
+			})
+
+			Convey("Then the location is returned", func() {
+				So(loc, ShouldResemble, &Location{Latitude: 52.2, Longitude: 4.2, Altitude: 5, Accuracy: 50})
+			})
+		})
+
+		Convey("When the server returns no result", func() {
+			response = `{"result": null, "errors": [], "warnings": ["not enough gateways"]}`
+
+			Convey("Then no location is returned", func() {
+				loc, err := r.Resolve(f)
+				So(err, ShouldBeNil)
+				So(loc, ShouldBeNil)
+			})
+		})
+
+		Convey("When the server returns an error", func() {
+			response = `{"result": null, "errors": ["invalid request"], "warnings": []}`
+
+			Convey("Then an error is returned", func() {
+				_, err := r.Resolve(f)
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestHTTPResolver(t *testing.T) {
+	Convey("Given a test HTTP resolver server", t, func() {
+		var status int
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(status)
+			if status == http.StatusOK {
+				w.Write([]byte(`{"latitude": 52.2, "longitude": 4.2, "altitude": 5, "accuracy": 50}`))
+			}
+		}))
+		defer server.Close()
+
+		r := NewHTTPResolver(server.URL, "", time.Second)
+
+		Convey("When the server returns a location", func() {
+			status = http.StatusOK
+
+			Convey("Then the location is returned", func() {
+				loc, err := r.Resolve(Frame{})
+				So(err, ShouldBeNil)
+				So(loc, ShouldResemble, &Location{Latitude: 52.2, Longitude: 4.2, Altitude: 5, Accuracy: 50})
+			})
+		})
+
+		Convey("When the server returns 204", func() {
+			status = http.StatusNoContent
+
+			Convey("Then no location is returned", func() {
+				loc, err := r.Resolve(Frame{})
+				So(err, ShouldBeNil)
+				So(loc, ShouldBeNil)
+			})
+		})
+	})
+}
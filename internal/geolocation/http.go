@@ -0,0 +1,71 @@
+package geolocation
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// HTTPResolver resolves the location using a custom HTTP endpoint. The
+// Frame is posted as JSON, the endpoint must respond with the Location as
+// JSON (200) or with 204 when the location could not be resolved.
+type HTTPResolver struct {
+	server string
+	token  string
+	client *http.Client
+}
+
+// NewHTTPResolver creates a new HTTPResolver. When the given token is not
+// empty, it is sent as bearer token in the Authorization header.
+func NewHTTPResolver(server, token string, timeout time.Duration) *HTTPResolver {
+	return &HTTPResolver{
+		server: server,
+		token:  token,
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+// Name returns the name of the resolver.
+func (r *HTTPResolver) Name() string {
+	return ResolverHTTP
+}
+
+// Resolve resolves the location of the given frame.
+func (r *HTTPResolver) Resolve(f Frame) (*Location, error) {
+	b, err := json.Marshal(f)
+	if err != nil {
+		return nil, fmt.Errorf("marshal frame error: %s", err)
+	}
+
+	req, err := http.NewRequest("POST", r.server, bytes.NewReader(b))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if r.token != "" {
+		req.Header.Set("Authorization", "Bearer "+r.token)
+	}
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("post error: %s", err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNoContent:
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("expected 200 or 204 response, got: %s", strings.TrimSpace(resp.Status))
+	}
+
+	var loc Location
+	if err := json.NewDecoder(resp.Body).Decode(&loc); err != nil {
+		return nil, fmt.Errorf("decode response error: %s", err)
+	}
+	return &loc, nil
+}
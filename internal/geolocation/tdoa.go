@@ -0,0 +1,141 @@
+package geolocation
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Default servers of the TDOA resolvers.
+const (
+	DefaultLoRaCloudServer = "https://gls.loracloud.com/api/v3/solve/tdoa"
+	DefaultCollosServer    = "https://api.preview.collos.org/localization-alpha/v1/resolveTdoa"
+)
+
+// tdoaTokenHeader defines the header containing the (subscription) token.
+const tdoaTokenHeader = "Ocp-Apim-Subscription-Key"
+
+// tdoaRequest defines the request of the TDOA resolvers.
+type tdoaRequest struct {
+	LoRaWAN []tdoaUplink `json:"lorawan"`
+}
+
+// tdoaUplink defines the uplink, as received by a single gateway.
+type tdoaUplink struct {
+	GatewayID       string              `json:"gatewayId"`
+	AntennaID       int                 `json:"antennaId"`
+	AntennaLocation tdoaAntennaLocation `json:"antennaLocation"`
+	RSSI            int                 `json:"rssi"`
+	SNR             float64             `json:"snr"`
+	TOA             uint32              `json:"toa"` // nanoseconds within the second of the reception timestamp
+}
+
+// tdoaAntennaLocation defines the location of the gateway antenna.
+type tdoaAntennaLocation struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+	Altitude  float64 `json:"altitude"`
+}
+
+// tdoaResponse defines the response of the TDOA resolvers.
+type tdoaResponse struct {
+	Result   *tdoaResult `json:"result"`
+	Errors   []string    `json:"errors"`
+	Warnings []string    `json:"warnings"`
+}
+
+// tdoaResult defines the resolved location.
+type tdoaResult struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+	Altitude  float64 `json:"altitude"`
+	Accuracy  float64 `json:"accuracy"`
+}
+
+// TDOAResolver resolves the location using the TDOA API of LoRa Cloud or
+// Collos, which share the same request and response format.
+type TDOAResolver struct {
+	name   string
+	server string
+	token  string
+	client *http.Client
+}
+
+// NewTDOAResolver creates a new TDOAResolver with the given name, posting
+// the requests to the given server.
+func NewTDOAResolver(name, server, token string, timeout time.Duration) *TDOAResolver {
+	return &TDOAResolver{
+		name:   name,
+		server: server,
+		token:  token,
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+// Name returns the name of the resolver.
+func (r *TDOAResolver) Name() string {
+	return r.name
+}
+
+// Resolve resolves the location of the given frame.
+func (r *TDOAResolver) Resolve(f Frame) (*Location, error) {
+	var tdoaReq tdoaRequest
+	for _, rx := range f.RXInfo {
+		tdoaReq.LoRaWAN = append(tdoaReq.LoRaWAN, tdoaUplink{
+			GatewayID: rx.MAC.String(),
+			AntennaLocation: tdoaAntennaLocation{
+				Latitude:  rx.Latitude,
+				Longitude: rx.Longitude,
+				Altitude:  rx.Altitude,
+			},
+			RSSI: rx.RSSI,
+			SNR:  rx.LoRaSNR,
+			TOA:  uint32(rx.Time.Nanosecond()),
+		})
+	}
+
+	b, err := json.Marshal(tdoaReq)
+	if err != nil {
+		return nil, fmt.Errorf("marshal request error: %s", err)
+	}
+
+	req, err := http.NewRequest("POST", r.server, bytes.NewReader(b))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if r.token != "" {
+		req.Header.Set(tdoaTokenHeader, r.token)
+	}
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("post error: %s", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("expected 200 response, got: %s", strings.TrimSpace(resp.Status))
+	}
+
+	var tdoaResp tdoaResponse
+	if err := json.NewDecoder(resp.Body).Decode(&tdoaResp); err != nil {
+		return nil, fmt.Errorf("decode response error: %s", err)
+	}
+	if len(tdoaResp.Errors) != 0 {
+		return nil, fmt.Errorf("resolver error: %s", strings.Join(tdoaResp.Errors, ", "))
+	}
+	if tdoaResp.Result == nil {
+		return nil, nil
+	}
+
+	return &Location{
+		Latitude:  tdoaResp.Result.Latitude,
+		Longitude: tdoaResp.Result.Longitude,
+		Altitude:  tdoaResp.Result.Altitude,
+		Accuracy:  tdoaResp.Result.Accuracy,
+	}, nil
+}
@@ -0,0 +1,309 @@
+package handler
+
+import (
+	"encoding/base64"
+	"fmt"
+	"regexp"
+	"sync"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/streadway/amqp"
+
+	"github.com/brocaar/lora-app-server/integration"
+	"github.com/brocaar/lora-app-server/internal/lockstore"
+	"github.com/brocaar/lorawan"
+)
+
+// AMQPPrincipal is the principal used for authorizing the downlink payloads
+// received over AMQP.
+const AMQPPrincipal = "amqp"
+
+// amqpTxRoutingKey defines the routing key used for binding the tx queue to
+// the exchange.
+const amqpTxRoutingKey = "application.*.node.*.tx"
+
+// amqpConsumerTag defines the consumer tag used for consuming the tx queue.
+const amqpConsumerTag = "lora-app-server"
+
+var amqpTxRoutingKeyRegex = regexp.MustCompile(`^application\.(\w+)\.node\.(\w+)\.tx$`)
+
+// AMQPHandlerConfig holds the configuration of the AMQPHandler.
+type AMQPHandlerConfig struct {
+	// URL is the url of the AMQP server (amqp:// or amqps://).
+	URL string
+
+	// Exchange is the (topic) exchange to which the events are published.
+	Exchange string
+
+	// TxQueue is the (durable) queue from which the downlink payloads are
+	// consumed. When empty, no downlink payloads are consumed.
+	TxQueue string
+}
+
+// AMQPHandler implements an AMQP 0-9-1 handler for sending and receiving
+// data by an application. Events are published to the configured exchange
+// using application.[AppEUI].node.[DevEUI].[event] as routing key. Downlink
+// payloads are consumed from a queue bound to the exchange using
+// application.*.node.*.tx as routing key. In case the connection is lost,
+// the handler reconnects.
+type AMQPHandler struct {
+	downlinkIntake
+
+	config AMQPHandlerConfig
+	wg     sync.WaitGroup
+
+	mu     sync.RWMutex
+	conn   *amqp.Connection
+	ch     *amqp.Channel
+	closed bool
+}
+
+// NewAMQPHandler creates a new AMQPHandler. The lock store is used for the
+// idempotency and replay checks of the received downlink payloads.
+func NewAMQPHandler(locks lockstore.Store, conf AMQPHandlerConfig) (*AMQPHandler, error) {
+	h := AMQPHandler{
+		downlinkIntake: newDownlinkIntake("amqp", AMQPPrincipal, locks, 0),
+		config:         conf,
+	}
+	h.notifier = &h
+
+	log.WithField("exchange", conf.Exchange).Info("handler/amqp: connecting to amqp server")
+	if err := h.connect(); err != nil {
+		return nil, fmt.Errorf("handler/amqp: %s", err)
+	}
+	return &h, nil
+}
+
+// Close stops the handler.
+func (h *AMQPHandler) Close() error {
+	log.Info("handler/amqp: closing handler")
+	h.mu.Lock()
+	h.closed = true
+	ch := h.ch
+	h.mu.Unlock()
+
+	if h.config.TxQueue != "" {
+		log.WithField("queue", h.config.TxQueue).Info("handler/amqp: cancelling tx queue consumer")
+		if err := ch.Cancel(amqpConsumerTag, false); err != nil {
+			log.Errorf("handler/amqp: cancel consumer error: %s", err)
+		}
+	}
+
+	log.Info("handler/amqp: handling last items in queue")
+	h.wg.Wait()
+
+	h.mu.Lock()
+	err := h.conn.Close()
+	h.mu.Unlock()
+	close(h.dataDownChan)
+	if err != nil && err != amqp.ErrClosed {
+		return fmt.Errorf("handler/amqp: close connection error: %s", err)
+	}
+	return nil
+}
+
+// SendDataUp sends a DataUpPayload.
+func (h *AMQPHandler) SendDataUp(appEUI, devEUI lorawan.EUI64, payload integration.DataUpPayload) error {
+	return h.publish(appEUI, devEUI, payload)
+}
+
+// SendJoinNotification sends a JoinNotification.
+func (h *AMQPHandler) SendJoinNotification(appEUI, devEUI lorawan.EUI64, payload integration.JoinNotification) error {
+	return h.publish(appEUI, devEUI, payload)
+}
+
+// SendACKNotification sends an ACKNotification.
+func (h *AMQPHandler) SendACKNotification(appEUI, devEUI lorawan.EUI64, payload integration.ACKNotification) error {
+	return h.publish(appEUI, devEUI, payload)
+}
+
+// SendErrorNotification sends an ErrorNotification.
+func (h *AMQPHandler) SendErrorNotification(appEUI, devEUI lorawan.EUI64, payload integration.ErrorNotification) error {
+	return h.publish(appEUI, devEUI, payload)
+}
+
+// SendLinkQualityNotification sends a LinkQualityNotification.
+func (h *AMQPHandler) SendLinkQualityNotification(appEUI, devEUI lorawan.EUI64, payload integration.LinkQualityNotification) error {
+	return h.publish(appEUI, devEUI, payload)
+}
+
+// SendLifecycleNotification sends a LifecycleNotification.
+func (h *AMQPHandler) SendLifecycleNotification(appEUI, devEUI lorawan.EUI64, payload integration.LifecycleNotification) error {
+	return h.publish(appEUI, devEUI, payload)
+}
+
+// SendFirmwareNotification sends a FirmwareNotification.
+func (h *AMQPHandler) SendFirmwareNotification(appEUI, devEUI lorawan.EUI64, payload integration.FirmwareNotification) error {
+	return h.publish(appEUI, devEUI, payload)
+}
+
+// SendDeviceStatus sends a DeviceStatus.
+func (h *AMQPHandler) SendDeviceStatus(appEUI, devEUI lorawan.EUI64, payload integration.DeviceStatus) error {
+	return h.publish(appEUI, devEUI, payload)
+}
+
+// SendLocationNotification sends a LocationNotification.
+func (h *AMQPHandler) SendLocationNotification(appEUI, devEUI lorawan.EUI64, payload integration.LocationNotification) error {
+	return h.publish(appEUI, devEUI, payload)
+}
+
+// SendScheduledDownlinkNotification sends a ScheduledDownlinkNotification.
+func (h *AMQPHandler) SendScheduledDownlinkNotification(appEUI, devEUI lorawan.EUI64, payload integration.ScheduledDownlinkNotification) error {
+	return h.publish(appEUI, devEUI, payload)
+}
+
+// publish publishes the given payload to the exchange, using the routing
+// key of its event type.
+func (h *AMQPHandler) publish(appEUI, devEUI lorawan.EUI64, payload interface{}) error {
+	eventType, b, err := marshaler.MarshalEvent(payload)
+	if err != nil {
+		return fmt.Errorf("handler/amqp: %s", err)
+	}
+	start := time.Now()
+	defer func() {
+		observePublish("amqp", eventType, appEUI, start, err)
+	}()
+
+	routingKey := fmt.Sprintf("application.%s.node.%s.%s", appEUI, devEUI, eventType)
+	log.WithField("routing_key", routingKey).Info("handler/amqp: publishing event")
+
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	err = h.ch.Publish(h.config.Exchange, routingKey, false, false, amqp.Publishing{
+		ContentType:  marshaler.ContentType(),
+		DeliveryMode: amqp.Persistent,
+		Type:         eventType,
+		Body:         b,
+	})
+	if err != nil {
+		return fmt.Errorf("handler/amqp: publish %s event error: %s", eventType, err)
+	}
+	return nil
+}
+
+// connect connects to the AMQP server, declares and binds the tx queue and
+// starts consuming it.
+func (h *AMQPHandler) connect() error {
+	conn, err := amqp.Dial(h.config.URL)
+	if err != nil {
+		return fmt.Errorf("dial error: %s", err)
+	}
+
+	ch, err := conn.Channel()
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("open channel error: %s", err)
+	}
+
+	var deliveries <-chan amqp.Delivery
+	if h.config.TxQueue != "" {
+		if _, err := ch.QueueDeclare(h.config.TxQueue, true, false, false, false, nil); err != nil {
+			conn.Close()
+			return fmt.Errorf("declare queue error: %s", err)
+		}
+		if err := ch.QueueBind(h.config.TxQueue, amqpTxRoutingKey, h.config.Exchange, false, nil); err != nil {
+			conn.Close()
+			return fmt.Errorf("bind queue error: %s", err)
+		}
+		deliveries, err = ch.Consume(h.config.TxQueue, amqpConsumerTag, false, false, false, false, nil)
+		if err != nil {
+			conn.Close()
+			return fmt.Errorf("consume queue error: %s", err)
+		}
+	}
+
+	h.mu.Lock()
+	h.conn = conn
+	h.ch = ch
+	h.mu.Unlock()
+
+	if deliveries != nil {
+		h.wg.Add(1)
+		go h.consumeTxPayloads(deliveries)
+	}
+	go h.reconnectOnClose(conn.NotifyClose(make(chan *amqp.Error, 1)))
+
+	return nil
+}
+
+// reconnectOnClose reconnects when the connection was closed by an error.
+func (h *AMQPHandler) reconnectOnClose(closeChan chan *amqp.Error) {
+	reason, ok := <-closeChan
+	if !ok {
+		// closed by Close
+		return
+	}
+	log.Errorf("handler/amqp: amqp connection error: %s", reason)
+
+	for {
+		h.mu.RLock()
+		closed := h.closed
+		h.mu.RUnlock()
+		if closed {
+			return
+		}
+
+		if err := h.connect(); err != nil {
+			log.Errorf("handler/amqp: reconnect error: %s", err)
+			time.Sleep(time.Second)
+			continue
+		}
+		log.Info("handler/amqp: reconnected to amqp server")
+		return
+	}
+}
+
+// consumeTxPayloads handles the given deliveries until the consumer has
+// been cancelled or the channel has been closed.
+func (h *AMQPHandler) consumeTxPayloads(deliveries <-chan amqp.Delivery) {
+	defer h.wg.Done()
+
+	for d := range deliveries {
+		h.txPayloadHandler(d)
+		if err := d.Ack(false); err != nil {
+			log.WithField("routing_key", d.RoutingKey).Errorf("handler/amqp: ack delivery error: %s", err)
+		}
+	}
+}
+
+func (h *AMQPHandler) txPayloadHandler(d amqp.Delivery) {
+	log.WithField("routing_key", d.RoutingKey).Info("handler/amqp: data-down payload received")
+	dataDownReceived.WithLabelValues("amqp").Inc()
+
+	// get the AppEUI and DevEUI from the routing key. we need to be sure
+	// that the routing key DevEUI matches the payload DevEUI.
+	match := amqpTxRoutingKeyRegex.FindStringSubmatch(d.RoutingKey)
+	if len(match) != 3 {
+		log.WithField("routing_key", d.RoutingKey).Error("handler/amqp: routing key regex match error")
+		observeRejectedDataDown("amqp", rejectReasonInvalidTopic)
+		return
+	}
+
+	pl, err := marshaler.UnmarshalDataDown(d.Body)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"data_base64": base64.StdEncoding.EncodeToString(d.Body),
+		}).Errorf("handler/amqp: %s", err)
+		observeRejectedDataDown("amqp", rejectReasonUnmarshal)
+		return
+	}
+
+	if match[2] != pl.DevEUI.String() {
+		log.WithFields(log.Fields{
+			"routing_key_dev_eui": match[2],
+			"payload_dev_eui":     pl.DevEUI,
+		}).Warning("handler/amqp: routing key DevEUI must match payload DevEUI")
+		observeRejectedDataDown("amqp", rejectReasonDevEUIMismatch)
+		return
+	}
+
+	var appEUI lorawan.EUI64
+	if err := appEUI.UnmarshalText([]byte(match[1])); err != nil {
+		log.WithField("routing_key", d.RoutingKey).Errorf("handler/amqp: decode AppEUI error: %s", err)
+		observeRejectedDataDown("amqp", rejectReasonInvalidTopic)
+		return
+	}
+
+	h.handleDataDown(appEUI, pl, d.Body)
+}
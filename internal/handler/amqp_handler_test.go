@@ -0,0 +1,151 @@
+package handler
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"github.com/streadway/amqp"
+
+	"github.com/brocaar/lora-app-server/integration"
+	"github.com/brocaar/lora-app-server/internal/lockstore"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lora-app-server/internal/test"
+	"github.com/brocaar/lorawan"
+)
+
+func TestAMQPHandler(t *testing.T) {
+	conf := test.GetConfig()
+
+	Convey("Given an AMQP channel and Redis database", t, func() {
+		conn, err := amqp.Dial(conf.AMQPURL)
+		So(err, ShouldBeNil)
+		defer conn.Close()
+		ch, err := conn.Channel()
+		So(err, ShouldBeNil)
+
+		p := storage.NewRedisPool(conf.RedisURL)
+		test.MustFlushRedis(p)
+
+		// remove the payloads left behind by a previous run
+		txQueue := "lora-app-server.test.tx"
+		_, err = ch.QueueDelete(txQueue, false, false, false)
+		So(err, ShouldBeNil)
+		defer ch.QueueDelete(txQueue, false, false, false)
+
+		Convey("Given a new AMQPHandler", func() {
+			handler, err := NewAMQPHandler(lockstore.NewRedisStore(p), AMQPHandlerConfig{
+				URL:      conf.AMQPURL,
+				Exchange: "amq.topic",
+				TxQueue:  txQueue,
+			})
+			So(err, ShouldBeNil)
+			defer handler.Close()
+
+			devEUI := lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1}
+			appEUI := lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}
+
+			Convey("Given a queue bound to application.*.node.*.rx", func() {
+				q, err := ch.QueueDeclare("", false, true, true, false, nil)
+				So(err, ShouldBeNil)
+				So(ch.QueueBind(q.Name, "application.*.node.*.rx", "amq.topic", false, nil), ShouldBeNil)
+				deliveries, err := ch.Consume(q.Name, "", true, true, false, false, nil)
+				So(err, ShouldBeNil)
+
+				Convey("When sending a DataUpPayload (from the handler)", func() {
+					pl := integration.DataUpPayload{
+						DevEUI: devEUI,
+						FPort:  1,
+						Data:   []byte("hello"),
+					}
+					So(handler.SendDataUp(appEUI, devEUI, pl), ShouldBeNil)
+
+					Convey("Then the same payload is consumed from the queue", func() {
+						d := <-deliveries
+						So(d.RoutingKey, ShouldEqual, "application.0102030405060708.node.0807060504030201.rx")
+						So(d.Type, ShouldEqual, integration.EventDataUp)
+						So(d.DeliveryMode, ShouldEqual, amqp.Persistent)
+
+						var received integration.DataUpPayload
+						So(json.Unmarshal(d.Body, &received), ShouldBeNil)
+						So(received, ShouldResemble, pl)
+					})
+				})
+
+				Convey("When sending a join notification (from the handler)", func() {
+					So(handler.SendJoinNotification(appEUI, devEUI, integration.JoinNotification{DevEUI: devEUI}), ShouldBeNil)
+
+					Convey("Then it is not routed to the queue", func() {
+						var received bool
+						select {
+						case <-deliveries:
+							received = true
+						case <-time.After(time.Millisecond * 100):
+							// nothing to do
+						}
+						So(received, ShouldBeFalse)
+					})
+				})
+			})
+
+			Convey("Given a DataDownPayload is published to the exchange", func() {
+				pl := integration.DataDownPayload{
+					DevEUI: devEUI,
+					FPort:  1,
+					Data:   []byte("hello"),
+				}
+				b, err := json.Marshal(pl)
+				So(err, ShouldBeNil)
+				So(ch.Publish("amq.topic", "application.0102030405060708.node.0807060504030201.tx", false, false, amqp.Publishing{Body: b}), ShouldBeNil)
+
+				Convey("Then the same payload is received by the handler", func() {
+					pl.Principal = AMQPPrincipal
+					So(<-handler.DataDownChan(), ShouldResemble, pl)
+				})
+			})
+
+			Convey("Given an invalid DataDownPayload is published to the exchange", func() {
+				for _, msg := range []struct {
+					RoutingKey string
+					Body       []byte
+				}{
+					{"application.0102030405060708.node.0807060504030201.tx", []byte("not json")},
+					{"application.0102030405060708.node.0102030405060708.tx", []byte(`{"devEUI":"0807060504030201","fPort":1}`)},
+				} {
+					So(ch.Publish("amq.topic", msg.RoutingKey, false, false, amqp.Publishing{Body: msg.Body}), ShouldBeNil)
+				}
+
+				Convey("Then the payloads are discarded", func() {
+					var received bool
+					select {
+					case <-handler.DataDownChan():
+						received = true
+					case <-time.After(time.Millisecond * 100):
+						// nothing to do
+					}
+					So(received, ShouldBeFalse)
+				})
+			})
+		})
+	})
+
+	Convey("Given an AMQPHandler with a lock store", t, func() {
+		p := storage.NewRedisPool(conf.RedisURL)
+		test.MustFlushRedis(p)
+
+		h := AMQPHandler{
+			downlinkIntake: newDownlinkIntake("amqp", AMQPPrincipal, lockstore.NewRedisStore(p), 0),
+		}
+		devEUI := lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1}
+
+		testDownlinkIntake(&h.downlinkIntake, devEUI, func(pl integration.DataDownPayload) {
+			b, err := json.Marshal(pl)
+			So(err, ShouldBeNil)
+			h.txPayloadHandler(amqp.Delivery{
+				RoutingKey: "application.0102030405060708.node.0807060504030201.tx",
+				Body:       b,
+			})
+		})
+	})
+}
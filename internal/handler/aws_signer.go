@@ -0,0 +1,114 @@
+package handler
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+// awsCredentials contains the credentials for signing the AWS requests.
+type awsCredentials struct {
+	Region          string
+	AccessKeyID     string
+	SecretAccessKey string
+}
+
+// signAWSRequest signs the given request with the given body using the AWS
+// Signature Version 4 signing process, for the given service.
+func signAWSRequest(req *http.Request, body []byte, service string, creds awsCredentials, t time.Time) {
+	t = t.UTC()
+	amzDate := t.Format("20060102T150405Z")
+	date := t.Format("20060102")
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	if req.Header.Get("Host") == "" {
+		req.Header.Set("Host", req.URL.Host)
+	}
+
+	// canonical headers (all headers set on the request are signed)
+	var headerNames []string
+	headers := make(map[string]string)
+	for k, v := range req.Header {
+		name := strings.ToLower(k)
+		headerNames = append(headerNames, name)
+		headers[name] = strings.Join(strings.Fields(strings.Join(v, ",")), " ")
+	}
+	sort.Strings(headerNames)
+	var canonicalHeaders string
+	for _, name := range headerNames {
+		canonicalHeaders += name + ":" + headers[name] + "\n"
+	}
+	signedHeaders := strings.Join(headerNames, ";")
+
+	path := req.URL.EscapedPath()
+	if path == "" {
+		path = "/"
+	}
+
+	payloadHash := sha256.Sum256(body)
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		path,
+		awsCanonicalQuery(req.URL.Query()),
+		canonicalHeaders,
+		signedHeaders,
+		hex.EncodeToString(payloadHash[:]),
+	}, "\n")
+
+	scope := fmt.Sprintf("%s/%s/%s/aws4_request", date, creds.Region, service)
+	requestHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256",
+		amzDate,
+		scope,
+		hex.EncodeToString(requestHash[:]),
+	}, "\n")
+
+	key := awsHMAC([]byte("AWS4"+creds.SecretAccessKey), date)
+	key = awsHMAC(key, creds.Region)
+	key = awsHMAC(key, service)
+	key = awsHMAC(key, "aws4_request")
+	signature := hex.EncodeToString(awsHMAC(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf(
+		"AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		creds.AccessKeyID, scope, signedHeaders, signature,
+	))
+}
+
+// awsCanonicalQuery returns the canonical (sorted and encoded) query string.
+func awsCanonicalQuery(v url.Values) string {
+	var keys []string
+	for k := range v {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var pairs []string
+	for _, k := range keys {
+		values := v[k]
+		sort.Strings(values)
+		for _, value := range values {
+			pairs = append(pairs, awsEscape(k)+"="+awsEscape(value))
+		}
+	}
+	return strings.Join(pairs, "&")
+}
+
+// awsEscape URI encodes the given string, as required by the signing
+// process (spaces as %20, ~ unescaped).
+func awsEscape(s string) string {
+	return strings.Replace(strings.Replace(url.QueryEscape(s), "+", "%20", -1), "%7E", "~", -1)
+}
+
+func awsHMAC(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
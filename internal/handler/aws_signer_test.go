@@ -0,0 +1,30 @@
+package handler
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestSignAWSRequest(t *testing.T) {
+	Convey("Given the request of the AWS Signature Version 4 example", t, func() {
+		req, err := http.NewRequest("GET", "https://iam.amazonaws.com/?Action=ListUsers&Version=2010-05-08", nil)
+		So(err, ShouldBeNil)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded; charset=utf-8")
+
+		Convey("When signing the request", func() {
+			signAWSRequest(req, nil, "iam", awsCredentials{
+				Region:          "us-east-1",
+				AccessKeyID:     "AKIDEXAMPLE",
+				SecretAccessKey: "wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY",
+			}, time.Date(2015, 8, 30, 12, 36, 0, 0, time.UTC))
+
+			Convey("Then the Authorization header equals the documented one", func() {
+				So(req.Header.Get("X-Amz-Date"), ShouldEqual, "20150830T123600Z")
+				So(req.Header.Get("Authorization"), ShouldEqual, "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20150830/us-east-1/iam/aws4_request, SignedHeaders=content-type;host;x-amz-date, Signature=5d672d79c15b13162d9279b0855cfba6789a8edb4c82c400e06b5924a6f2b5d7")
+			})
+		})
+	})
+}
@@ -0,0 +1,398 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/brocaar/lora-app-server/integration"
+	"github.com/brocaar/lora-app-server/internal/lockstore"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+// SQSPrincipal is the principal used for authorizing the downlink payloads
+// received over SQS.
+const SQSPrincipal = "sqs"
+
+const (
+	awsTimeout         = 30 * time.Second // must be greater than the sqs wait time
+	sqsWaitTime        = 20               // long-poll duration (seconds) of the receive requests
+	sqsMaxMessages     = 10               // max number of messages per receive request
+	sqsRefreshInterval = time.Minute      // interval at which the consumed queues are reloaded
+)
+
+// sqsConsumer consumes the queue of an AWS SNS integration.
+type sqsConsumer struct {
+	integration storage.AWSSNSIntegration
+	cancel      context.CancelFunc
+}
+
+// AWSSNSHandler implements a handler publishing the events to the AWS SNS
+// topic configured by the AWS SNS integration of the application. The event
+// type, AppEUI and DevEUI are added as message attributes. When the
+// integration has a SQS queue, the downlink payloads (DataDownPayload) are
+// consumed from this queue. As SNS and SQS messages are text, protobuf
+// encoded payloads are base64 encoded. Applications without AWS SNS
+// integration are ignored.
+type AWSSNSHandler struct {
+	downlinkIntake
+
+	db          *sqlx.DB
+	client      *http.Client
+	snsEndpoint func(region string) string
+
+	mu        sync.Mutex
+	consumers map[lorawan.EUI64]*sqsConsumer
+	wg        sync.WaitGroup
+	closed    chan struct{}
+	done      chan struct{}
+}
+
+// NewAWSSNSHandler creates a new AWSSNSHandler. The SQS queues of the
+// integrations are reloaded every minute. The lock store is used for the
+// idempotency and replay checks of the received downlink payloads.
+func NewAWSSNSHandler(db *sqlx.DB, locks lockstore.Store) *AWSSNSHandler {
+	h := AWSSNSHandler{
+		downlinkIntake: newDownlinkIntake("awssns", SQSPrincipal, locks, 0),
+		db:             db,
+		client:         &http.Client{Timeout: awsTimeout},
+		snsEndpoint: func(region string) string {
+			return fmt.Sprintf("https://sns.%s.amazonaws.com/", region)
+		},
+		consumers: make(map[lorawan.EUI64]*sqsConsumer),
+		closed:    make(chan struct{}),
+		done:      make(chan struct{}),
+	}
+	h.notifier = &h
+	go h.runConsumers()
+	return &h
+}
+
+// Close stops the SQS consumers and closes the handler.
+func (h *AWSSNSHandler) Close() error {
+	log.Info("handler/awssns: closing handler")
+	close(h.closed)
+	<-h.done
+	close(h.dataDownChan)
+	return nil
+}
+
+// SendDataUp sends a DataUpPayload.
+func (h *AWSSNSHandler) SendDataUp(appEUI, devEUI lorawan.EUI64, payload integration.DataUpPayload) error {
+	return h.publish(appEUI, devEUI, payload)
+}
+
+// SendJoinNotification sends a JoinNotification.
+func (h *AWSSNSHandler) SendJoinNotification(appEUI, devEUI lorawan.EUI64, payload integration.JoinNotification) error {
+	return h.publish(appEUI, devEUI, payload)
+}
+
+// SendACKNotification sends an ACKNotification.
+func (h *AWSSNSHandler) SendACKNotification(appEUI, devEUI lorawan.EUI64, payload integration.ACKNotification) error {
+	return h.publish(appEUI, devEUI, payload)
+}
+
+// SendErrorNotification sends an ErrorNotification.
+func (h *AWSSNSHandler) SendErrorNotification(appEUI, devEUI lorawan.EUI64, payload integration.ErrorNotification) error {
+	return h.publish(appEUI, devEUI, payload)
+}
+
+// SendLinkQualityNotification sends a LinkQualityNotification.
+func (h *AWSSNSHandler) SendLinkQualityNotification(appEUI, devEUI lorawan.EUI64, payload integration.LinkQualityNotification) error {
+	return h.publish(appEUI, devEUI, payload)
+}
+
+// SendLifecycleNotification sends a LifecycleNotification.
+func (h *AWSSNSHandler) SendLifecycleNotification(appEUI, devEUI lorawan.EUI64, payload integration.LifecycleNotification) error {
+	return h.publish(appEUI, devEUI, payload)
+}
+
+// SendFirmwareNotification sends a FirmwareNotification.
+func (h *AWSSNSHandler) SendFirmwareNotification(appEUI, devEUI lorawan.EUI64, payload integration.FirmwareNotification) error {
+	return h.publish(appEUI, devEUI, payload)
+}
+
+// SendDeviceStatus sends a DeviceStatus.
+func (h *AWSSNSHandler) SendDeviceStatus(appEUI, devEUI lorawan.EUI64, payload integration.DeviceStatus) error {
+	return h.publish(appEUI, devEUI, payload)
+}
+
+// SendLocationNotification sends a LocationNotification.
+func (h *AWSSNSHandler) SendLocationNotification(appEUI, devEUI lorawan.EUI64, payload integration.LocationNotification) error {
+	return h.publish(appEUI, devEUI, payload)
+}
+
+// SendScheduledDownlinkNotification sends a ScheduledDownlinkNotification.
+func (h *AWSSNSHandler) SendScheduledDownlinkNotification(appEUI, devEUI lorawan.EUI64, payload integration.ScheduledDownlinkNotification) error {
+	return h.publish(appEUI, devEUI, payload)
+}
+
+// publish publishes the given payload to the topic of the AWS SNS
+// integration of the application. The message is published
+// asynchronously, failed attempts are retried (see Retrier).
+func (h *AWSSNSHandler) publish(appEUI, devEUI lorawan.EUI64, payload interface{}) error {
+	i, err := storage.GetAWSSNSIntegration(h.db, appEUI)
+	if err != nil {
+		return fmt.Errorf("handler/awssns: %s", err)
+	}
+	if i == nil || i.TopicARN == "" {
+		return nil
+	}
+
+	eventType, b, err := marshaler.MarshalEvent(payload)
+	if err != nil {
+		return fmt.Errorf("handler/awssns: %s", err)
+	}
+
+	form := url.Values{
+		"Action":   []string{"Publish"},
+		"Version":  []string{"2010-03-31"},
+		"TopicArn": []string{i.TopicARN},
+		"Message":  []string{encodeAWSMessage(b)},
+	}
+	for n, attr := range [][2]string{{"event", eventType}, {"appEUI", appEUI.String()}, {"devEUI", devEUI.String()}} {
+		prefix := "MessageAttributes.entry." + strconv.Itoa(n+1) + "."
+		form.Set(prefix+"Name", attr[0])
+		form.Set(prefix+"Value.DataType", "String")
+		form.Set(prefix+"Value.StringValue", attr[1])
+	}
+
+	log.WithFields(log.Fields{
+		"topic_arn": i.TopicARN,
+		"type":      eventType,
+		"dev_eui":   devEUI,
+	}).Info("handler/awssns: publishing event")
+	retrier.DeliverAsync("awssns", appEUI, devEUI, payload, func() error {
+		start := time.Now()
+		_, err := h.post(context.Background(), *i, "sns", h.snsEndpoint(i.Region), form)
+		observePublish("awssns", eventType, appEUI, start, err)
+		return err
+	})
+	return nil
+}
+
+// post posts the given form (AWS query API) to the given endpoint, signed
+// using the credentials of the given integration, and returns the response
+// body.
+func (h *AWSSNSHandler) post(ctx context.Context, i storage.AWSSNSIntegration, service, endpoint string, form url.Values) ([]byte, error) {
+	body := []byte(form.Encode())
+	req, err := http.NewRequest("POST", endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded; charset=utf-8")
+	signAWSRequest(req, body, service, awsCredentials{
+		Region:          i.Region,
+		AccessKeyID:     i.AccessKeyID,
+		SecretAccessKey: string(i.SecretAccessKey),
+	}, time.Now())
+
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		var errResp struct {
+			Message string `xml:"Error>Message"`
+		}
+		xml.Unmarshal(b, &errResp)
+		return nil, fmt.Errorf("expected 2xx response, got: %s (%s)", strings.TrimSpace(resp.Status), errResp.Message)
+	}
+	return b, nil
+}
+
+// runConsumers (re)loads the integrations having a SQS queue and
+// starts / stops the consumers of these queues, until the handler is
+// closed.
+func (h *AWSSNSHandler) runConsumers() {
+	defer close(h.done)
+	for {
+		if err := h.refreshConsumers(); err != nil {
+			log.Errorf("handler/awssns: refresh sqs consumers error: %s", err)
+		}
+
+		select {
+		case <-h.closed:
+			h.mu.Lock()
+			for appEUI, c := range h.consumers {
+				c.cancel()
+				delete(h.consumers, appEUI)
+			}
+			h.mu.Unlock()
+			h.wg.Wait()
+			return
+		case <-time.After(sqsRefreshInterval):
+		}
+	}
+}
+
+// refreshConsumers starts a consumer for each new or updated integration
+// and stops the consumers of the removed integrations.
+func (h *AWSSNSHandler) refreshConsumers() error {
+	integrations, err := storage.GetAWSSNSIntegrationsWithQueue(h.db)
+	if err != nil {
+		return err
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	current := make(map[lorawan.EUI64]struct{})
+	for _, i := range integrations {
+		current[i.AppEUI] = struct{}{}
+		if c, ok := h.consumers[i.AppEUI]; ok {
+			if c.integration == i {
+				continue
+			}
+			c.cancel()
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		h.consumers[i.AppEUI] = &sqsConsumer{integration: i, cancel: cancel}
+		h.wg.Add(1)
+		go h.consume(ctx, i)
+	}
+
+	for appEUI, c := range h.consumers {
+		if _, ok := current[appEUI]; !ok {
+			c.cancel()
+			delete(h.consumers, appEUI)
+		}
+	}
+	return nil
+}
+
+// consume consumes the downlink payloads from the queue of the given
+// integration until the given context is cancelled. A message is deleted
+// after it has been handled.
+func (h *AWSSNSHandler) consume(ctx context.Context, i storage.AWSSNSIntegration) {
+	defer h.wg.Done()
+
+	log.WithFields(log.Fields{
+		"app_eui":   i.AppEUI,
+		"queue_url": i.QueueURL,
+	}).Info("handler/awssns: consuming sqs queue")
+
+	for {
+		b, err := h.post(ctx, i, "sqs", i.QueueURL, url.Values{
+			"Action":              []string{"ReceiveMessage"},
+			"Version":             []string{"2012-11-05"},
+			"MaxNumberOfMessages": []string{strconv.Itoa(sqsMaxMessages)},
+			"WaitTimeSeconds":     []string{strconv.Itoa(sqsWaitTime)},
+		})
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			log.WithField("queue_url", i.QueueURL).Errorf("handler/awssns: receive sqs messages error: %s", err)
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(time.Second):
+			}
+			continue
+		}
+
+		var resp struct {
+			Messages []struct {
+				ReceiptHandle string `xml:"ReceiptHandle"`
+				Body          string `xml:"Body"`
+			} `xml:"ReceiveMessageResult>Message"`
+		}
+		if err := xml.Unmarshal(b, &resp); err != nil {
+			log.WithField("queue_url", i.QueueURL).Errorf("handler/awssns: unmarshal sqs response error: %s", err)
+			continue
+		}
+
+		for _, msg := range resp.Messages {
+			h.txPayloadHandler(i, []byte(msg.Body))
+			_, err := h.post(context.Background(), i, "sqs", i.QueueURL, url.Values{
+				"Action":        []string{"DeleteMessage"},
+				"Version":       []string{"2012-11-05"},
+				"ReceiptHandle": []string{msg.ReceiptHandle},
+			})
+			if err != nil {
+				log.WithField("queue_url", i.QueueURL).Errorf("handler/awssns: delete sqs message error: %s", err)
+			}
+		}
+	}
+}
+
+func (h *AWSSNSHandler) txPayloadHandler(i storage.AWSSNSIntegration, body []byte) {
+	log.WithFields(log.Fields{
+		"app_eui":   i.AppEUI,
+		"queue_url": i.QueueURL,
+	}).Info("handler/awssns: data-down payload received")
+	dataDownReceived.WithLabelValues("awssns").Inc()
+
+	var pl integration.DataDownPayload
+	b, err := decodeAWSMessage(body)
+	if err == nil {
+		pl, err = marshaler.UnmarshalDataDown(b)
+	}
+	if err != nil {
+		log.WithFields(log.Fields{
+			"data_base64": base64.StdEncoding.EncodeToString(body),
+		}).Errorf("handler/awssns: %s", err)
+		observeRejectedDataDown("awssns", rejectReasonUnmarshal)
+		return
+	}
+
+	node, err := storage.GetNode(h.db, pl.DevEUI)
+	if err != nil {
+		log.WithField("dev_eui", pl.DevEUI).Errorf("handler/awssns: get node error: %s", err)
+		observeRejectedDataDown("awssns", rejectReasonUnknownNode)
+		return
+	}
+
+	// the queue of an application only accepts payloads for its own nodes
+	if node.AppEUI != i.AppEUI {
+		h.rejectDataDown(i.AppEUI, pl, errorTypeDataDownUnauthorized, fmt.Errorf("node %s does not belong to application %s", pl.DevEUI, i.AppEUI))
+		return
+	}
+
+	h.handleDataDown(node.AppEUI, pl, b)
+}
+
+// encodeAWSMessage returns the SNS message of the given (marshaled)
+// payload. As SNS and SQS messages must be text, protobuf encoded payloads
+// are base64 encoded.
+func encodeAWSMessage(b []byte) string {
+	if marshaler == integration.MarshalerProtobuf {
+		return base64.StdEncoding.EncodeToString(b)
+	}
+	return string(b)
+}
+
+// decodeAWSMessage returns the (marshaled) payload of the given SQS
+// message body.
+func decodeAWSMessage(body []byte) ([]byte, error) {
+	if marshaler != integration.MarshalerProtobuf {
+		return body, nil
+	}
+	b, err := base64.StdEncoding.DecodeString(string(body))
+	if err != nil {
+		return nil, fmt.Errorf("decode base64 message error: %s", err)
+	}
+	return b, nil
+}
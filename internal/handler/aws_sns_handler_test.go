@@ -0,0 +1,111 @@
+package handler
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/brocaar/lora-app-server/integration"
+	"github.com/brocaar/lora-app-server/internal/lockstore"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lora-app-server/internal/test"
+	"github.com/brocaar/lorawan"
+)
+
+func TestAWSSNSHandler(t *testing.T) {
+	conf := test.GetConfig()
+
+	Convey("Given a clean database, a credential key and a test SNS server", t, func() {
+		db, err := storage.OpenDatabase(conf.PostgresDSN)
+		So(err, ShouldBeNil)
+		test.MustResetDB(db)
+		So(storage.SetCredentialKey(make([]byte, 32)), ShouldBeNil)
+
+		requests := make(chan *http.Request, 10)
+		forms := make(chan url.Values, 10)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			b, _ := ioutil.ReadAll(r.Body)
+			form, _ := url.ParseQuery(string(b))
+			requests <- r
+			forms <- form
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		p := storage.NewRedisPool(conf.RedisURL)
+		test.MustFlushRedis(p)
+
+		h := NewAWSSNSHandler(db, lockstore.NewRedisStore(p))
+		defer h.Close()
+		h.snsEndpoint = func(region string) string {
+			return server.URL
+		}
+		appEUI := lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}
+		devEUI := lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1}
+
+		Convey("When sending a payload for an application without aws sns integration", func() {
+			So(h.SendDataUp(appEUI, devEUI, integration.DataUpPayload{DevEUI: devEUI}), ShouldBeNil)
+
+			Convey("Then no request was made", func() {
+				So(requests, ShouldHaveLength, 0)
+			})
+		})
+
+		Convey("Given an aws sns integration for the application", func() {
+			i := storage.AWSSNSIntegration{
+				AppEUI:          appEUI,
+				Region:          "eu-west-1",
+				AccessKeyID:     "AKID",
+				SecretAccessKey: "secret",
+				TopicARN:        "arn:aws:sns:eu-west-1:123456789012:uplink",
+			}
+			So(storage.CreateAWSSNSIntegration(db, i), ShouldBeNil)
+
+			Convey("Then GetAWSSNSIntegration returns the integration with decrypted secret", func() {
+				i2, err := storage.GetAWSSNSIntegration(db, appEUI)
+				So(err, ShouldBeNil)
+				So(*i2, ShouldResemble, i)
+			})
+
+			Convey("When sending a data-up payload", func() {
+				So(h.SendDataUp(appEUI, devEUI, integration.DataUpPayload{DevEUI: devEUI, FCnt: 10}), ShouldBeNil)
+
+				Convey("Then the event was published to the topic", func() {
+					req := <-requests
+					So(req.Header.Get("Authorization"), ShouldStartWith, "AWS4-HMAC-SHA256 Credential=AKID/")
+					So(req.Header.Get("Authorization"), ShouldContainSubstring, "/eu-west-1/sns/aws4_request")
+
+					form := <-forms
+					So(form.Get("Action"), ShouldEqual, "Publish")
+					So(form.Get("TopicArn"), ShouldEqual, i.TopicARN)
+					So(form.Get("MessageAttributes.entry.1.Name"), ShouldEqual, "event")
+					So(form.Get("MessageAttributes.entry.1.Value.StringValue"), ShouldEqual, integration.EventDataUp)
+					So(form.Get("MessageAttributes.entry.3.Value.StringValue"), ShouldEqual, devEUI.String())
+				})
+			})
+		})
+
+		Convey("Given an aws sns integration with a queue and a node", func() {
+			i := storage.AWSSNSIntegration{
+				AppEUI:          appEUI,
+				Region:          "eu-west-1",
+				AccessKeyID:     "AKID",
+				SecretAccessKey: "secret",
+				TopicARN:        "arn:aws:sns:eu-west-1:123456789012:uplink",
+				QueueURL:        "https://sqs.eu-west-1.amazonaws.com/123456789012/downlink",
+			}
+			So(storage.CreateNode(db, storage.Node{AppEUI: appEUI, DevEUI: devEUI}), ShouldBeNil)
+
+			testDownlinkIntake(&h.downlinkIntake, devEUI, func(pl integration.DataDownPayload) {
+				b, err := json.Marshal(pl)
+				So(err, ShouldBeNil)
+				h.txPayloadHandler(i, b)
+			})
+		})
+	})
+}
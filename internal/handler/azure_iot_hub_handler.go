@@ -0,0 +1,340 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/brocaar/lora-app-server/integration"
+	"github.com/brocaar/lora-app-server/internal/lockstore"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+// AzureIoTHubPrincipal is the principal used for authorizing the downlink
+// payloads received as cloud-to-device messages.
+const AzureIoTHubPrincipal = "azure"
+
+const (
+	azureAPIVersion     = "2020-03-13"     // api version of the iot hub device endpoints
+	azureTimeout        = 10 * time.Second // timeout of the iot hub requests
+	azureC2DMaxMessages = 10               // max number of c2d messages received per device and poll
+)
+
+// AzureIoTHubHandler implements a handler sending the uplink, join and
+// error events as device-to-cloud messages to the Azure IoT Hub configured
+// by the Azure IoT Hub integration of the application. The IoT Hub device ID
+// is the (hex encoded) DevEUI of the node. The event type, AppEUI and DevEUI
+// are added as application properties. When enabled by the integration, the
+// cloud-to-device messages of the devices (DataDownPayload) are received as
+// downlink payloads.
+// Applications without Azure IoT Hub integration are ignored.
+type AzureIoTHubHandler struct {
+	integration.NopHandler
+
+	downlinkIntake
+
+	db           *sqlx.DB
+	client       *http.Client
+	pollInterval time.Duration
+	baseURL      func(hostName string) string
+
+	closed chan struct{}
+	done   chan struct{}
+}
+
+// NewAzureIoTHubHandler creates a new AzureIoTHubHandler. The
+// cloud-to-device messages are polled at the given interval (disabled when
+// 0). The lock store is used for the idempotency and replay checks of the
+// received downlink payloads.
+func NewAzureIoTHubHandler(db *sqlx.DB, locks lockstore.Store, pollInterval time.Duration) *AzureIoTHubHandler {
+	h := AzureIoTHubHandler{
+		downlinkIntake: newDownlinkIntake("azureiothub", AzureIoTHubPrincipal, locks, 0),
+		db:             db,
+		client:         &http.Client{Timeout: azureTimeout},
+		pollInterval:   pollInterval,
+		baseURL: func(hostName string) string {
+			return "https://" + hostName
+		},
+		closed: make(chan struct{}),
+		done:   make(chan struct{}),
+	}
+	h.notifier = &h
+	go h.runC2DPoller()
+	return &h
+}
+
+// Close stops the cloud-to-device polling and closes the handler.
+func (h *AzureIoTHubHandler) Close() error {
+	log.Info("handler/azureiothub: closing handler")
+	close(h.closed)
+	<-h.done
+	close(h.dataDownChan)
+	return nil
+}
+
+// SendDataUp sends a DataUpPayload.
+func (h *AzureIoTHubHandler) SendDataUp(appEUI, devEUI lorawan.EUI64, payload integration.DataUpPayload) error {
+	return h.publish(appEUI, devEUI, payload)
+}
+
+// SendJoinNotification sends a JoinNotification.
+func (h *AzureIoTHubHandler) SendJoinNotification(appEUI, devEUI lorawan.EUI64, payload integration.JoinNotification) error {
+	return h.publish(appEUI, devEUI, payload)
+}
+
+// SendErrorNotification sends an ErrorNotification.
+func (h *AzureIoTHubHandler) SendErrorNotification(appEUI, devEUI lorawan.EUI64, payload integration.ErrorNotification) error {
+	return h.publish(appEUI, devEUI, payload)
+}
+
+// DataDownChan returns the channel containing the received DataDownPayload.
+func (h *AzureIoTHubHandler) DataDownChan() chan integration.DataDownPayload {
+	return h.dataDownChan
+}
+
+// publish sends the given payload as device-to-cloud message of the device
+// matching the given DevEUI. The message is sent asynchronously, failed
+// attempts are retried (see Retrier).
+func (h *AzureIoTHubHandler) publish(appEUI, devEUI lorawan.EUI64, payload interface{}) error {
+	i, err := storage.GetAzureIoTHubIntegration(h.db, appEUI)
+	if err != nil {
+		return fmt.Errorf("handler/azureiothub: %s", err)
+	}
+	if i == nil {
+		return nil
+	}
+
+	conn, err := parseAzureConnectionString(string(i.ConnectionString))
+	if err != nil {
+		return fmt.Errorf("handler/azureiothub: %s", err)
+	}
+
+	eventType, b, err := marshaler.MarshalEvent(payload)
+	if err != nil {
+		return fmt.Errorf("handler/azureiothub: %s", err)
+	}
+
+	log.WithFields(log.Fields{
+		"host":    conn.HostName,
+		"type":    eventType,
+		"dev_eui": devEUI,
+	}).Info("handler/azureiothub: sending device-to-cloud message")
+	retrier.DeliverAsync("azureiothub", appEUI, devEUI, payload, func() error {
+		start := time.Now()
+		err := h.sendD2C(conn, devEUI, map[string]string{
+			"event":  eventType,
+			"appEUI": appEUI.String(),
+			"devEUI": devEUI.String(),
+		}, b)
+		observePublish("azureiothub", eventType, appEUI, start, err)
+		return err
+	})
+	return nil
+}
+
+// sendD2C sends the given body with the given application properties as
+// device-to-cloud message of the given device.
+func (h *AzureIoTHubHandler) sendD2C(conn azureConnection, devEUI lorawan.EUI64, properties map[string]string, body []byte) error {
+	req, err := h.newRequest("POST", conn, devEUI, "/messages/events", nil, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", marshaler.ContentType())
+	req.Header.Set("iothub-contenttype", marshaler.ContentType())
+	if marshaler == integration.MarshalerJSON {
+		req.Header.Set("iothub-contentencoding", "utf-8")
+	}
+	for k, v := range properties {
+		req.Header.Set("iothub-app-"+k, v)
+	}
+
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("expected 2xx response, got: %s", strings.TrimSpace(resp.Status))
+	}
+	return nil
+}
+
+// newRequest returns a new (authenticated) request for the given path of
+// the device endpoint of the given device.
+func (h *AzureIoTHubHandler) newRequest(method string, conn azureConnection, devEUI lorawan.EUI64, path string, query url.Values, body []byte) (*http.Request, error) {
+	resource := conn.HostName + "/devices/" + azureDeviceID(devEUI)
+	if query == nil {
+		query = url.Values{}
+	}
+	query.Set("api-version", azureAPIVersion)
+
+	u := strings.TrimRight(h.baseURL(conn.HostName), "/") + "/devices/" + azureDeviceID(devEUI) + path + "?" + query.Encode()
+
+	req, err := http.NewRequest(method, u, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", azureSASToken(conn, resource, time.Now().Add(azureSASTokenTTL)))
+	return req, nil
+}
+
+// runC2DPoller polls the cloud-to-device messages of the devices of the
+// integrations having the cloud-to-device messages enabled, until the
+// handler is closed.
+func (h *AzureIoTHubHandler) runC2DPoller() {
+	defer close(h.done)
+	if h.pollInterval == 0 {
+		<-h.closed
+		return
+	}
+
+	for {
+		select {
+		case <-h.closed:
+			return
+		case <-time.After(h.pollInterval):
+		}
+
+		if err := h.pollC2D(); err != nil {
+			log.Errorf("handler/azureiothub: poll cloud-to-device messages error: %s", err)
+		}
+	}
+}
+
+// pollC2D receives the pending cloud-to-device messages of the nodes of
+// each integration having the cloud-to-device messages enabled.
+func (h *AzureIoTHubHandler) pollC2D() error {
+	integrations, err := storage.GetAzureIoTHubIntegrationsWithC2D(h.db)
+	if err != nil {
+		return err
+	}
+
+	for _, i := range integrations {
+		conn, err := parseAzureConnectionString(string(i.ConnectionString))
+		if err != nil {
+			log.WithField("app_eui", i.AppEUI).Errorf("handler/azureiothub: %s", err)
+			continue
+		}
+
+		nodes, err := storage.GetNodesForAppEUI(h.db, i.AppEUI)
+		if err != nil {
+			return err
+		}
+
+		for _, n := range nodes {
+			for j := 0; j < azureC2DMaxMessages; j++ {
+				select {
+				case <-h.closed:
+					return nil
+				default:
+				}
+
+				ok, err := h.receiveC2D(i, conn, n.DevEUI)
+				if err != nil {
+					log.WithFields(log.Fields{
+						"host":    conn.HostName,
+						"dev_eui": n.DevEUI,
+					}).Errorf("handler/azureiothub: receive cloud-to-device message error: %s", err)
+				}
+				if !ok {
+					break
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// receiveC2D receives, handles and completes (or rejects) a single
+// cloud-to-device message of the given device. It returns false when
+// there was no message.
+func (h *AzureIoTHubHandler) receiveC2D(i storage.AzureIoTHubIntegration, conn azureConnection, devEUI lorawan.EUI64) (bool, error) {
+	req, err := h.newRequest("GET", conn, devEUI, "/messages/deviceBound", nil, nil)
+	if err != nil {
+		return false, err
+	}
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNoContent {
+		return false, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return false, fmt.Errorf("expected 200 or 204 response, got: %s", strings.TrimSpace(resp.Status))
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return false, err
+	}
+	etag := strings.Trim(resp.Header.Get("ETag"), `"`)
+
+	// messages which can't be unmarshaled are rejected (dead-lettered),
+	// other messages are completed after they have been handled
+	query := url.Values{}
+	if !h.txPayloadHandler(i.AppEUI, devEUI, body) {
+		query.Set("reject", "")
+	}
+
+	req, err = h.newRequest("DELETE", conn, devEUI, "/messages/deviceBound/"+url.PathEscape(etag), query, nil)
+	if err != nil {
+		return true, err
+	}
+	delResp, err := h.client.Do(req)
+	if err != nil {
+		return true, err
+	}
+	delResp.Body.Close()
+	if delResp.StatusCode < 200 || delResp.StatusCode > 299 {
+		return true, fmt.Errorf("complete message: expected 2xx response, got: %s", strings.TrimSpace(delResp.Status))
+	}
+	return true, nil
+}
+
+// txPayloadHandler handles the given cloud-to-device message body of the
+// given device. It returns false when the message could not be
+// unmarshaled.
+func (h *AzureIoTHubHandler) txPayloadHandler(appEUI, devEUI lorawan.EUI64, body []byte) bool {
+	log.WithFields(log.Fields{
+		"app_eui": appEUI,
+		"dev_eui": devEUI,
+	}).Info("handler/azureiothub: data-down payload received")
+	dataDownReceived.WithLabelValues("azureiothub").Inc()
+
+	pl, err := marshaler.UnmarshalDataDown(body)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"data_base64": base64.StdEncoding.EncodeToString(body),
+		}).Errorf("handler/azureiothub: %s", err)
+		observeRejectedDataDown("azureiothub", rejectReasonUnmarshal)
+		return false
+	}
+
+	// the device id defines the node, the DevEUI of the payload is optional
+	if pl.DevEUI != (lorawan.EUI64{}) && pl.DevEUI != devEUI {
+		h.rejectDataDown(appEUI, pl, errorTypeDataDownUnauthorized, fmt.Errorf("devEUI %s does not match device %s", pl.DevEUI, azureDeviceID(devEUI)))
+		return true
+	}
+	pl.DevEUI = devEUI
+
+	h.handleDataDown(appEUI, pl, body)
+	return true
+}
+
+// azureDeviceID returns the IoT Hub device ID of the given DevEUI.
+func azureDeviceID(devEUI lorawan.EUI64) string {
+	return devEUI.String()
+}
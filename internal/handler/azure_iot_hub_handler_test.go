@@ -0,0 +1,136 @@
+package handler
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/brocaar/lora-app-server/integration"
+	"github.com/brocaar/lora-app-server/internal/lockstore"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lora-app-server/internal/test"
+	"github.com/brocaar/lorawan"
+)
+
+func TestAzureIoTHubHandler(t *testing.T) {
+	conf := test.GetConfig()
+
+	Convey("Given a clean database, a credential key and a test IoT Hub server", t, func() {
+		db, err := storage.OpenDatabase(conf.PostgresDSN)
+		So(err, ShouldBeNil)
+		test.MustResetDB(db)
+		So(storage.SetCredentialKey(make([]byte, 32)), ShouldBeNil)
+
+		requests := make(chan *http.Request, 10)
+		bodies := make(chan []byte, 10)
+		c2d := make(chan string, 1)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			b, _ := ioutil.ReadAll(r.Body)
+			requests <- r
+			bodies <- b
+
+			if r.Method == "GET" {
+				select {
+				case msg := <-c2d:
+					w.Header().Set("ETag", `"msg-1"`)
+					w.Write([]byte(msg))
+				default:
+					w.WriteHeader(http.StatusNoContent)
+				}
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+
+		p := storage.NewRedisPool(conf.RedisURL)
+		test.MustFlushRedis(p)
+
+		h := NewAzureIoTHubHandler(db, lockstore.NewRedisStore(p), 0)
+		defer h.Close()
+		h.baseURL = func(hostName string) string {
+			return server.URL
+		}
+		appEUI := lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}
+		devEUI := lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1}
+
+		Convey("When sending a payload for an application without azure iot hub integration", func() {
+			So(h.SendDataUp(appEUI, devEUI, integration.DataUpPayload{DevEUI: devEUI}), ShouldBeNil)
+
+			Convey("Then no request was made", func() {
+				So(requests, ShouldHaveLength, 0)
+			})
+		})
+
+		Convey("Given an azure iot hub integration for the application and a node", func() {
+			i := storage.AzureIoTHubIntegration{
+				AppEUI:           appEUI,
+				ConnectionString: "HostName=myhub.azure-devices.net;SharedAccessKeyName=device;SharedAccessKey=c2VjcmV0",
+				C2DEnabled:       true,
+			}
+			So(storage.CreateAzureIoTHubIntegration(db, i), ShouldBeNil)
+			So(storage.CreateNode(db, storage.Node{AppEUI: appEUI, DevEUI: devEUI}), ShouldBeNil)
+
+			Convey("Then GetAzureIoTHubIntegrationsWithC2D returns the integration", func() {
+				items, err := storage.GetAzureIoTHubIntegrationsWithC2D(db)
+				So(err, ShouldBeNil)
+				So(items, ShouldResemble, []storage.AzureIoTHubIntegration{i})
+			})
+
+			Convey("When sending a data-up payload", func() {
+				So(h.SendDataUp(appEUI, devEUI, integration.DataUpPayload{DevEUI: devEUI, FCnt: 10}), ShouldBeNil)
+
+				Convey("Then a device-to-cloud message was sent for the device", func() {
+					req := <-requests
+					So(req.Method, ShouldEqual, "POST")
+					So(req.URL.Path, ShouldEqual, "/devices/0807060504030201/messages/events")
+					So(req.URL.Query().Get("api-version"), ShouldEqual, azureAPIVersion)
+					So(req.Header.Get("Authorization"), ShouldStartWith, "SharedAccessSignature sr=myhub.azure-devices.net%2Fdevices%2F0807060504030201&sig=")
+					So(req.Header.Get("iothub-app-event"), ShouldEqual, integration.EventDataUp)
+					So(req.Header.Get("iothub-app-devEUI"), ShouldEqual, devEUI.String())
+					So(string(<-bodies), ShouldContainSubstring, `"fCnt":10`)
+				})
+			})
+
+			Convey("When sending an ack notification", func() {
+				So(h.SendACKNotification(appEUI, devEUI, integration.ACKNotification{DevEUI: devEUI}), ShouldBeNil)
+
+				Convey("Then no request was made", func() {
+					So(requests, ShouldHaveLength, 0)
+				})
+			})
+
+			Convey("When polling a cloud-to-device message", func() {
+				c2d <- `{"confirmed":false,"fPort":10,"data":"AQID"}`
+				go func() {
+					So(h.pollC2D(), ShouldBeNil)
+				}()
+
+				Convey("Then the payload is received for the node and the message was completed", func() {
+					pl := <-h.DataDownChan()
+					So(pl.DevEUI, ShouldEqual, devEUI)
+					So(pl.FPort, ShouldEqual, 10)
+					So(pl.Data, ShouldResemble, []byte{1, 2, 3})
+
+					So((<-requests).Method, ShouldEqual, "GET")
+					req := <-requests
+					So(req.Method, ShouldEqual, "DELETE")
+					So(req.URL.Path, ShouldEqual, "/devices/0807060504030201/messages/deviceBound/msg-1")
+					So(req.URL.Query()["reject"], ShouldBeNil)
+				})
+			})
+		})
+
+		Convey("Given cloud-to-device messages are received for a device", func() {
+			testDownlinkIntake(&h.downlinkIntake, devEUI, func(pl integration.DataDownPayload) {
+				b, err := json.Marshal(pl)
+				So(err, ShouldBeNil)
+				h.txPayloadHandler(appEUI, devEUI, b)
+			})
+		})
+	})
+}
@@ -0,0 +1,90 @@
+package handler
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// azureSASTokenTTL defines the validity of the generated SAS tokens.
+const azureSASTokenTTL = time.Hour
+
+// azureConnection contains the fields of an Azure IoT Hub (shared access
+// policy) connection string.
+type azureConnection struct {
+	HostName            string
+	SharedAccessKeyName string
+	SharedAccessKey     []byte
+}
+
+// parseAzureConnectionString parses the given connection string, e.g.
+// HostName=myhub.azure-devices.net;SharedAccessKeyName=device;SharedAccessKey=...
+// Only connection strings of the shared access policies of the IoT Hub are
+// supported, the policy must have the DeviceConnect permission.
+func parseAzureConnectionString(s string) (azureConnection, error) {
+	var c azureConnection
+	var key string
+	for _, part := range strings.Split(s, ";") {
+		kv := strings.SplitN(part, "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		switch kv[0] {
+		case "HostName":
+			c.HostName = kv[1]
+		case "SharedAccessKeyName":
+			c.SharedAccessKeyName = kv[1]
+		case "SharedAccessKey":
+			key = kv[1]
+		case "DeviceId":
+			return c, errors.New("device connection strings are not supported, use the connection string of a shared access policy")
+		}
+	}
+	if c.HostName == "" || c.SharedAccessKeyName == "" || key == "" {
+		return c, errors.New("connection string must contain HostName, SharedAccessKeyName and SharedAccessKey")
+	}
+
+	b, err := base64.StdEncoding.DecodeString(key)
+	if err != nil {
+		return c, fmt.Errorf("decode shared access key error: %s", err)
+	}
+	c.SharedAccessKey = b
+	return c, nil
+}
+
+// ValidateAzureConnectionString returns an error when the given IoT Hub
+// connection string is invalid.
+func ValidateAzureConnectionString(s string) error {
+	_, err := parseAzureConnectionString(s)
+	return err
+}
+
+// AzureConnectionStringInfo returns the hostname and shared access policy
+// name of the given IoT Hub connection string.
+func AzureConnectionStringInfo(s string) (string, string, error) {
+	c, err := parseAzureConnectionString(s)
+	if err != nil {
+		return "", "", err
+	}
+	return c.HostName, c.SharedAccessKeyName, nil
+}
+
+// azureSASToken returns the shared access signature token for the given
+// resource URI (e.g. myhub.azure-devices.net/devices/0102030405060708),
+// valid until the given expiry.
+func azureSASToken(c azureConnection, resourceURI string, expiry time.Time) string {
+	sr := url.QueryEscape(resourceURI)
+	se := strconv.FormatInt(expiry.Unix(), 10)
+
+	mac := hmac.New(sha256.New, c.SharedAccessKey)
+	mac.Write([]byte(sr + "\n" + se))
+	sig := base64.StdEncoding.EncodeToString(mac.Sum(nil))
+
+	return fmt.Sprintf("SharedAccessSignature sr=%s&sig=%s&se=%s&skn=%s", sr, url.QueryEscape(sig), se, url.QueryEscape(c.SharedAccessKeyName))
+}
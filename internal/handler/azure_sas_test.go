@@ -0,0 +1,48 @@
+package handler
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestAzureConnectionString(t *testing.T) {
+	Convey("Given a set of connection strings", t, func() {
+		tests := []struct {
+			ConnectionString string
+			Error            string
+		}{
+			{"HostName=myhub.azure-devices.net;SharedAccessKeyName=device;SharedAccessKey=c2VjcmV0", ""},
+			{"HostName=myhub.azure-devices.net;SharedAccessKeyName=device", "connection string must contain HostName, SharedAccessKeyName and SharedAccessKey"},
+			{"HostName=myhub.azure-devices.net;DeviceId=0102030405060708;SharedAccessKey=c2VjcmV0", "device connection strings are not supported, use the connection string of a shared access policy"},
+			{"HostName=myhub.azure-devices.net;SharedAccessKeyName=device;SharedAccessKey=%%%", "decode shared access key error: illegal base64 data at input byte 0"},
+		}
+
+		for i, test := range tests {
+			Convey(fmt.Sprintf("Test %d: ValidateAzureConnectionString returns the expected result", i), func() {
+				err := ValidateAzureConnectionString(test.ConnectionString)
+				if test.Error == "" {
+					So(err, ShouldBeNil)
+				} else {
+					So(err, ShouldNotBeNil)
+					So(err.Error(), ShouldEqual, test.Error)
+				}
+			})
+		}
+	})
+
+	Convey("Given a parsed connection string", t, func() {
+		conn, err := parseAzureConnectionString("HostName=myhub.azure-devices.net;SharedAccessKeyName=device;SharedAccessKey=c2VjcmV0")
+		So(err, ShouldBeNil)
+		So(conn.HostName, ShouldEqual, "myhub.azure-devices.net")
+		So(conn.SharedAccessKeyName, ShouldEqual, "device")
+		So(string(conn.SharedAccessKey), ShouldEqual, "secret")
+
+		Convey("Then azureSASToken returns the expected token", func() {
+			token := azureSASToken(conn, "myhub.azure-devices.net/devices/0102030405060708", time.Unix(1600000000, 0))
+			So(token, ShouldEqual, "SharedAccessSignature sr=myhub.azure-devices.net%2Fdevices%2F0102030405060708&sig=eqCGfoxfZh6jAM%2BQvErCJ7c4NIu9BhOxU5fYpgIyIB0%3D&se=1600000000&skn=device")
+		})
+	})
+}
@@ -0,0 +1,50 @@
+package handler
+
+import (
+	"time"
+
+	"github.com/brocaar/lora-app-server/integration"
+)
+
+// demodulationFloor contains per spread-factor the minimum SNR (in dB)
+// required for demodulating a LoRa frame.
+var demodulationFloor = map[int]float64{
+	6:  -5,
+	7:  -7.5,
+	8:  -10,
+	9:  -12.5,
+	10: -15,
+	11: -17.5,
+	12: -20,
+}
+
+// newDeviceStatus returns the device status derived from the given data-up
+// payload, received at the given time. The RSSI and SNR are taken from the
+// gateway with the best SNR.
+func newDeviceStatus(pl integration.DataUpPayload, lastSeen time.Time) integration.DeviceStatus {
+	ds := integration.DeviceStatus{
+		DevEUI:   pl.DevEUI,
+		LastSeen: lastSeen,
+		FCnt:     pl.FCnt,
+	}
+
+	if len(pl.RXInfo) == 0 {
+		return ds
+	}
+
+	best := pl.RXInfo[0]
+	for _, rxInfo := range pl.RXInfo[1:] {
+		if rxInfo.LoRaSNR > best.LoRaSNR {
+			best = rxInfo
+		}
+	}
+	ds.RSSI = best.RSSI
+	ds.LoRaSNR = best.LoRaSNR
+
+	if floor, ok := demodulationFloor[pl.TXInfo.DataRate.SpreadFactor]; ok && pl.TXInfo.DataRate.Modulation == "LORA" {
+		margin := best.LoRaSNR - floor
+		ds.Margin = &margin
+	}
+
+	return ds
+}
@@ -0,0 +1,45 @@
+package handler
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/brocaar/lora-app-server/integration"
+	"github.com/brocaar/lorawan"
+)
+
+func TestNewDeviceStatus(t *testing.T) {
+	Convey("Given a data-up payload received by two gateways", t, func() {
+		now := time.Date(2016, 12, 1, 12, 0, 0, 0, time.UTC)
+		pl := integration.DataUpPayload{
+			DevEUI: lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+			FCnt:   10,
+			TXInfo: integration.TXInfo{
+				DataRate: integration.DataRate{Modulation: "LORA", SpreadFactor: 10},
+			},
+			RXInfo: []integration.RXInfo{
+				{MAC: lorawan.EUI64{1}, RSSI: -110, LoRaSNR: -8},
+				{MAC: lorawan.EUI64{2}, RSSI: -115, LoRaSNR: -3},
+			},
+		}
+
+		Convey("Then the status is derived from the gateway with the best SNR", func() {
+			margin := 12.0
+			So(newDeviceStatus(pl, now), ShouldResemble, integration.DeviceStatus{
+				DevEUI:   pl.DevEUI,
+				LastSeen: now,
+				FCnt:     10,
+				RSSI:     -115,
+				LoRaSNR:  -3,
+				Margin:   &margin,
+			})
+		})
+
+		Convey("Then the margin is not set for FSK", func() {
+			pl.TXInfo.DataRate = integration.DataRate{Modulation: "FSK", Bitrate: 50000}
+			So(newDeviceStatus(pl, now).Margin, ShouldBeNil)
+		})
+	})
+}
@@ -0,0 +1,250 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+
+	"github.com/brocaar/lora-app-server/integration"
+	"github.com/brocaar/lora-app-server/internal/downlink"
+	"github.com/brocaar/lora-app-server/internal/lockstore"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+// defaultNonceTTL defines the default duration a downlink nonce is
+// remembered, when the payload doesn't contain an expiry timestamp.
+const defaultNonceTTL = time.Hour * 24
+
+// errorTypeDataDownUnauthorized is the error type used for error
+// notifications when a downlink payload is rejected by the authorizer.
+const errorTypeDataDownUnauthorized = "DATA_DOWN_UNAUTHORIZED"
+
+// errorTypeDataDownReplay is the error type used for error notifications
+// when a downlink payload is rejected by the replay protection.
+const errorTypeDataDownReplay = "DATA_DOWN_REPLAY"
+
+// errorTypeDataDownEnqueue is the error type used for error notifications
+// when a downlink payload could not be added to the downlink queue.
+const errorTypeDataDownEnqueue = "DATA_DOWN_ENQUEUE"
+
+// errorTypeDataDownQuota is the error type used for error notifications
+// when a downlink payload is rejected because the downlink quota of the
+// organization has been exceeded.
+const errorTypeDataDownQuota = "DATA_DOWN_QUOTA"
+
+// errorTypeRateLimitExceeded is the error type used for error notifications
+// when a downlink payload is rejected by the rate limiter (see
+// DownlinkLimiter).
+const errorTypeRateLimitExceeded = "RATE_LIMIT_EXCEEDED"
+
+// enqueueErrorType returns the error type for the given error returned by
+// the downlink queue.
+func enqueueErrorType(err error) string {
+	switch err {
+	case storage.ErrDownlinkQuotaExceeded:
+		return errorTypeDataDownQuota
+	case downlink.ErrPayloadTooLarge:
+		return errorTypeRateLimitExceeded
+	}
+	return errorTypeDataDownEnqueue
+}
+
+// errorNotifier defines the interface for publishing the error
+// notifications of the rejected downlink payloads.
+type errorNotifier interface {
+	SendErrorNotification(appEUI, devEUI lorawan.EUI64, payload integration.ErrorNotification) error
+}
+
+// downlinkIntake implements the handling of the downlink payloads received
+// by a handler backend, shared by all the backends so that every payload
+// goes through the same checks (idempotency, fport authorization, replay
+// protection and rate limiting) before it is added to the downlink queue.
+// It is embedded by the handler backends, which set the notifier used for
+// publishing the rejections.
+type downlinkIntake struct {
+	backend      string // name of the backend, used for logging and metrics
+	principal    string
+	notifier     errorNotifier
+	dataDownChan chan integration.DataDownPayload
+
+	locks              lockstore.Store
+	requireNonce       bool
+	nonceTTL           time.Duration
+	lockTTL            time.Duration
+	referenceRetention time.Duration
+	authorizer         DownlinkAuthorizer
+	limiter            DownlinkLimiter
+	queue              DownlinkQueue
+}
+
+// newDownlinkIntake returns a new downlinkIntake for the given backend. The
+// given lock store is shared by the instances for the idempotency and
+// replay checks. Without lock store, the payloads are not de-duplicated
+// and payloads containing a nonce are rejected.
+func newDownlinkIntake(backend, principal string, locks lockstore.Store, bufferSize int) downlinkIntake {
+	return downlinkIntake{
+		backend:            backend,
+		principal:          principal,
+		dataDownChan:       make(chan integration.DataDownPayload, bufferSize),
+		locks:              locks,
+		nonceTTL:           defaultNonceTTL,
+		lockTTL:            defaultDownlinkLockTTL,
+		referenceRetention: defaultReferenceRetention,
+	}
+}
+
+// SetReplayProtection configures the replay protection of the downlink
+// payloads. When required is true, payloads without nonce and expiry
+// timestamp are rejected. The nonceTTL defines how long a nonce is
+// remembered in case the payload doesn't have an expiry timestamp.
+func (d *downlinkIntake) SetReplayProtection(required bool, nonceTTL time.Duration) {
+	d.requireNonce = required
+	d.nonceTTL = nonceTTL
+}
+
+// SetIdempotency configures the idempotency of the downlink payloads. Copies
+// of a payload received (by any instance) within lockTTL are ignored, a
+// payload of which the reference was already handled within the retention
+// window is rejected.
+func (d *downlinkIntake) SetIdempotency(lockTTL, retention time.Duration) {
+	d.lockTTL = lockTTL
+	d.referenceRetention = retention
+}
+
+// SetDownlinkAuthorizer sets the authorizer used for authorizing the
+// received downlink payloads (using the principal of the backend).
+func (d *downlinkIntake) SetDownlinkAuthorizer(a DownlinkAuthorizer) {
+	d.authorizer = a
+}
+
+// SetDownlinkLimiter sets the limiter used for rate limiting the received
+// downlink payloads.
+func (d *downlinkIntake) SetDownlinkLimiter(l DownlinkLimiter) {
+	d.limiter = l
+}
+
+// SetDownlinkQueue sets the queue to which the received downlink payloads
+// are added. When set, the payloads are enqueued before the message is
+// acknowledged and errors are published as error notification. When not
+// set, the payloads are sent to the DataDownChan.
+func (d *downlinkIntake) SetDownlinkQueue(q DownlinkQueue) {
+	d.queue = q
+}
+
+// DataDownChan returns the channel containing the received DataDownPayload.
+func (d *downlinkIntake) DataDownChan() chan integration.DataDownPayload {
+	return d.dataDownChan
+}
+
+// handleDataDown handles the given downlink payload, received for the
+// given application. The raw payload is used for the idempotency check of
+// payloads without reference.
+func (d *downlinkIntake) handleDataDown(appEUI lorawan.EUI64, pl integration.DataDownPayload, raw []byte) {
+	// Since with MQTT all subscribers will receive the downlink messages sent
+	// by the application (and the other backends deliver at-least-once),
+	// the first receipt of a payload is handled and the copies must be
+	// ignored. As an unique id, the Reference field is used.
+	res, err := d.checkIdempotency(pl.DevEUI, pl.Reference, raw)
+	if err != nil {
+		log.Errorf("handler/%s: check downlink idempotency error: %s", d.backend, err)
+		observeRejectedDataDown(d.backend, rejectReasonRedis)
+		return
+	}
+	switch res {
+	case idempotencyCopy:
+		// the payload is being (or has been) handled by an other instance
+		observeRejectedDataDown(d.backend, rejectReasonLocked)
+		return
+	case idempotencyDuplicate:
+		d.rejectDataDown(appEUI, pl, errorTypeDataDownDuplicate, fmt.Errorf("reference %s has already been handled", pl.Reference))
+		return
+	}
+
+	if d.authorizer != nil {
+		if err := d.authorizer.AuthorizeDownlink(appEUI, pl.DevEUI, pl.FPort, d.principal); err != nil {
+			d.rejectDataDown(appEUI, pl, errorTypeDataDownUnauthorized, err)
+			return
+		}
+	}
+
+	if err := d.checkReplay(pl); err != nil {
+		d.rejectDataDown(appEUI, pl, errorTypeDataDownReplay, err)
+		return
+	}
+
+	if d.limiter != nil {
+		if err := d.limiter.LimitDownlink(appEUI, pl); err != nil {
+			d.rejectDataDown(appEUI, pl, errorTypeRateLimitExceeded, err)
+			return
+		}
+	}
+
+	pl.Principal = d.principal
+
+	if d.queue != nil {
+		if err := d.queue.Enqueue(pl); err != nil {
+			d.rejectDataDown(appEUI, pl, enqueueErrorType(err), err)
+		}
+		return
+	}
+
+	d.dataDownChan <- pl
+}
+
+// checkReplay validates the nonce and expiry timestamp of the given payload.
+// It returns an error when the payload has expired, when its nonce has
+// already been used or when these fields are missing while required.
+// Used nonces are stored until the payload expires.
+func (d *downlinkIntake) checkReplay(pl integration.DataDownPayload) error {
+	if d.requireNonce && (pl.Nonce == "" || pl.ExpiresAt == nil) {
+		return errors.New("nonce and expiresAt are required")
+	}
+
+	ttl := d.nonceTTL
+	if pl.ExpiresAt != nil {
+		ttl = pl.ExpiresAt.Sub(time.Now())
+		if ttl <= 0 {
+			return fmt.Errorf("payload expired at %s", pl.ExpiresAt.Format(time.RFC3339))
+		}
+	}
+
+	if pl.Nonce == "" {
+		return nil
+	}
+	if d.locks == nil {
+		return fmt.Errorf("nonce %s can't be verified without lock store", pl.Nonce)
+	}
+
+	key := fmt.Sprintf("lora:as:downlink:nonce:%s:%s", pl.DevEUI, pl.Nonce)
+	ok, err := d.locks.SetNX(key, "", ttl+time.Millisecond)
+	if err != nil {
+		return fmt.Errorf("store nonce error: %s", err)
+	}
+	if !ok {
+		return fmt.Errorf("nonce %s has already been used", pl.Nonce)
+	}
+	return nil
+}
+
+// rejectDataDown logs the rejection of the given payload and publishes an
+// error notification of the given type.
+func (d *downlinkIntake) rejectDataDown(appEUI lorawan.EUI64, pl integration.DataDownPayload, errType string, reason error) {
+	log.WithFields(log.Fields{
+		"dev_eui":   pl.DevEUI,
+		"reference": pl.Reference,
+	}).Warningf("handler/%s: data-down payload rejected: %s", d.backend, reason)
+	observeRejectedDataDown(d.backend, errType)
+
+	err := d.notifier.SendErrorNotification(appEUI, pl.DevEUI, integration.ErrorNotification{
+		DevEUI:    pl.DevEUI,
+		Reference: pl.Reference,
+		Type:      errType,
+		Error:     reason.Error(),
+	})
+	if err != nil {
+		log.Errorf("handler/%s: send error notification error: %s", d.backend, err)
+	}
+}
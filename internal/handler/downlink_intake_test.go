@@ -0,0 +1,196 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/brocaar/lora-app-server/integration"
+	"github.com/brocaar/lora-app-server/internal/downlink"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+// testErrorNotifier records the error notifications of the rejected
+// downlink payloads.
+type testErrorNotifier struct {
+	notifications chan integration.ErrorNotification
+}
+
+func newTestErrorNotifier() *testErrorNotifier {
+	return &testErrorNotifier{
+		notifications: make(chan integration.ErrorNotification, 10),
+	}
+}
+
+func (n *testErrorNotifier) SendErrorNotification(appEUI, devEUI lorawan.EUI64, pl integration.ErrorNotification) error {
+	n.notifications <- pl
+	return nil
+}
+
+// testDownlinkQueue records the enqueued payloads and returns the given
+// error.
+type testDownlinkQueue struct {
+	payloads []integration.DataDownPayload
+	err      error
+}
+
+func (q *testDownlinkQueue) Enqueue(pl integration.DataDownPayload) error {
+	if q.err != nil {
+		return q.err
+	}
+	q.payloads = append(q.payloads, pl)
+	return nil
+}
+
+// testDownlinkIntake tests the idempotency and replay checks of the given
+// downlink intake. The given send function must pass the given payload to
+// the tx payload handler of the backend, as it would have been received.
+func testDownlinkIntake(d *downlinkIntake, devEUI lorawan.EUI64, send func(pl integration.DataDownPayload)) {
+	n := newTestErrorNotifier()
+	d.notifier = n
+	d.SetIdempotency(time.Millisecond*100, time.Hour)
+
+	expiresAt := time.Now().Add(time.Minute).UTC().Truncate(time.Second)
+	pl := integration.DataDownPayload{
+		Reference: "1234",
+		DevEUI:    devEUI,
+		FPort:     1,
+		Data:      []byte("hello"),
+		Nonce:     "abcd",
+		ExpiresAt: &expiresAt,
+	}
+
+	Convey("When a payload with nonce is received", func() {
+		go send(pl)
+
+		Convey("Then the payload is received with the principal of the backend", func() {
+			received := <-d.DataDownChan()
+			So(received.Reference, ShouldEqual, "1234")
+			So(received.Nonce, ShouldEqual, "abcd")
+			So(received.Principal, ShouldEqual, d.principal)
+
+			Convey("When the nonce is replayed using an other reference", func() {
+				pl.Reference = "4321"
+				send(pl)
+
+				Convey("Then the payload is rejected by the replay protection", func() {
+					So((<-n.notifications).Type, ShouldEqual, errorTypeDataDownReplay)
+				})
+			})
+
+			Convey("When the reference is received again after the lock TTL", func() {
+				time.Sleep(time.Millisecond * 100)
+				pl.Nonce = "efgh"
+				send(pl)
+
+				Convey("Then the payload is rejected as duplicate", func() {
+					errPL := <-n.notifications
+					So(errPL.Type, ShouldEqual, errorTypeDataDownDuplicate)
+					So(errPL.Reference, ShouldEqual, "1234")
+				})
+			})
+		})
+	})
+
+	Convey("Given replay protection is required", func() {
+		d.SetReplayProtection(true, time.Hour)
+
+		Convey("When a payload without nonce is received", func() {
+			pl.Reference = "5678"
+			pl.Nonce = ""
+			send(pl)
+
+			Convey("Then the payload is rejected by the replay protection", func() {
+				So((<-n.notifications).Type, ShouldEqual, errorTypeDataDownReplay)
+				So(d.DataDownChan(), ShouldHaveLength, 0)
+			})
+		})
+	})
+}
+
+func TestDownlinkIntake(t *testing.T) {
+	Convey("Given a downlink intake without lock store", t, func() {
+		d := newDownlinkIntake("test", "test", nil, 1)
+		n := newTestErrorNotifier()
+		d.notifier = n
+		devEUI := lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}
+
+		Convey("When a payload without nonce is received twice", func() {
+			pl := integration.DataDownPayload{Reference: "1234", DevEUI: devEUI, FPort: 1}
+			d.handleDataDown(lorawan.EUI64{}, pl, nil)
+			So((<-d.DataDownChan()).Reference, ShouldEqual, "1234")
+			d.handleDataDown(lorawan.EUI64{}, pl, nil)
+
+			Convey("Then both payloads are handled", func() {
+				So((<-d.DataDownChan()).Reference, ShouldEqual, "1234")
+			})
+		})
+
+		Convey("When a payload with nonce is received", func() {
+			d.handleDataDown(lorawan.EUI64{}, integration.DataDownPayload{DevEUI: devEUI, Nonce: "abcd"}, nil)
+
+			Convey("Then it is rejected as the nonce can't be verified", func() {
+				So((<-n.notifications).Type, ShouldEqual, errorTypeDataDownReplay)
+				So(d.DataDownChan(), ShouldHaveLength, 0)
+			})
+		})
+
+		Convey("When an expired payload is received", func() {
+			expiresAt := time.Now().Add(-time.Second)
+			d.handleDataDown(lorawan.EUI64{}, integration.DataDownPayload{DevEUI: devEUI, ExpiresAt: &expiresAt}, nil)
+
+			Convey("Then it is rejected", func() {
+				So((<-n.notifications).Type, ShouldEqual, errorTypeDataDownReplay)
+			})
+		})
+	})
+
+	Convey("Given a downlink intake with a downlink queue", t, func() {
+		d := newDownlinkIntake("test", "test", nil, 1)
+		n := newTestErrorNotifier()
+		d.notifier = n
+		q := &testDownlinkQueue{}
+		d.SetDownlinkQueue(q)
+		pl := integration.DataDownPayload{Reference: "1234", DevEUI: lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}, FPort: 1}
+
+		Convey("When a payload is received", func() {
+			d.handleDataDown(lorawan.EUI64{}, pl, nil)
+
+			Convey("Then it is enqueued with the principal of the backend instead of sent to the DataDownChan", func() {
+				So(q.payloads, ShouldHaveLength, 1)
+				So(q.payloads[0].Reference, ShouldEqual, "1234")
+				So(q.payloads[0].Principal, ShouldEqual, "test")
+				So(d.DataDownChan(), ShouldHaveLength, 0)
+			})
+		})
+
+		Convey("Given a set of enqueue errors", func() {
+			tests := []struct {
+				Name      string
+				Error     error
+				ErrorType string
+			}{
+				{"quota exceeded", storage.ErrDownlinkQuotaExceeded, errorTypeDataDownQuota},
+				{"payload too large", downlink.ErrPayloadTooLarge, errorTypeRateLimitExceeded},
+				{"other error", errors.New("boom"), errorTypeDataDownEnqueue},
+			}
+
+			for i, test := range tests {
+				Convey(fmt.Sprintf("Test %d: %s", i, test.Name), func() {
+					q.err = test.Error
+					d.handleDataDown(lorawan.EUI64{}, pl, nil)
+
+					errPL := <-n.notifications
+					So(errPL.Type, ShouldEqual, test.ErrorType)
+					So(errPL.Reference, ShouldEqual, "1234")
+					So(errPL.Error, ShouldEqual, test.Error.Error())
+					So(d.DataDownChan(), ShouldHaveLength, 0)
+				})
+			}
+		})
+	})
+}
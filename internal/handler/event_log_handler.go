@@ -0,0 +1,93 @@
+package handler
+
+import (
+	"fmt"
+
+	"github.com/brocaar/lora-app-server/integration"
+	"github.com/brocaar/lora-app-server/internal/eventlog"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+// EventLogHandler implements a handler persisting the events to the event
+// log, so that the event history of the nodes can be queried using the
+// EventLog API. It is not an integration of the FilterHandler, the event
+// filters of the application don't apply to the event log.
+type EventLogHandler struct {
+	integration.NopHandler
+
+	store eventlog.Store
+}
+
+// NewEventLogHandler creates a new EventLogHandler.
+func NewEventLogHandler(store eventlog.Store) *EventLogHandler {
+	return &EventLogHandler{store: store}
+}
+
+// SendDataUp logs the given DataUpPayload.
+func (h *EventLogHandler) SendDataUp(appEUI, devEUI lorawan.EUI64, payload integration.DataUpPayload) error {
+	return h.log(appEUI, devEUI, payload)
+}
+
+// SendJoinNotification logs the given JoinNotification.
+func (h *EventLogHandler) SendJoinNotification(appEUI, devEUI lorawan.EUI64, payload integration.JoinNotification) error {
+	return h.log(appEUI, devEUI, payload)
+}
+
+// SendACKNotification logs the given ACKNotification.
+func (h *EventLogHandler) SendACKNotification(appEUI, devEUI lorawan.EUI64, payload integration.ACKNotification) error {
+	return h.log(appEUI, devEUI, payload)
+}
+
+// SendErrorNotification logs the given ErrorNotification.
+func (h *EventLogHandler) SendErrorNotification(appEUI, devEUI lorawan.EUI64, payload integration.ErrorNotification) error {
+	return h.log(appEUI, devEUI, payload)
+}
+
+// SendLinkQualityNotification logs the given LinkQualityNotification.
+func (h *EventLogHandler) SendLinkQualityNotification(appEUI, devEUI lorawan.EUI64, payload integration.LinkQualityNotification) error {
+	return h.log(appEUI, devEUI, payload)
+}
+
+// SendLifecycleNotification logs the given LifecycleNotification.
+func (h *EventLogHandler) SendLifecycleNotification(appEUI, devEUI lorawan.EUI64, payload integration.LifecycleNotification) error {
+	return h.log(appEUI, devEUI, payload)
+}
+
+// SendFirmwareNotification logs the given FirmwareNotification.
+func (h *EventLogHandler) SendFirmwareNotification(appEUI, devEUI lorawan.EUI64, payload integration.FirmwareNotification) error {
+	return h.log(appEUI, devEUI, payload)
+}
+
+// SendDeviceStatus logs the given DeviceStatus.
+func (h *EventLogHandler) SendDeviceStatus(appEUI, devEUI lorawan.EUI64, payload integration.DeviceStatus) error {
+	return h.log(appEUI, devEUI, payload)
+}
+
+// SendLocationNotification logs the given LocationNotification.
+func (h *EventLogHandler) SendLocationNotification(appEUI, devEUI lorawan.EUI64, payload integration.LocationNotification) error {
+	return h.log(appEUI, devEUI, payload)
+}
+
+// SendScheduledDownlinkNotification logs the given ScheduledDownlinkNotification.
+func (h *EventLogHandler) SendScheduledDownlinkNotification(appEUI, devEUI lorawan.EUI64, payload integration.ScheduledDownlinkNotification) error {
+	return h.log(appEUI, devEUI, payload)
+}
+
+// log stores the given payload in the event log.
+func (h *EventLogHandler) log(appEUI, devEUI lorawan.EUI64, payload interface{}) error {
+	eventType, b, err := integration.MarshalEvent(payload)
+	if err != nil {
+		return fmt.Errorf("handler/eventlog: %s", err)
+	}
+
+	if err := h.store.Add(storage.EventLog{
+		AppEUI:  appEUI,
+		DevEUI:  devEUI,
+		Type:    eventType,
+		Payload: b,
+	}); err != nil {
+		return fmt.Errorf("handler/eventlog: %s", err)
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package handler
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/brocaar/lora-app-server/integration"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+type testEventLogStore struct {
+	events []storage.EventLog
+}
+
+func (s *testEventLogStore) Add(e storage.EventLog) error {
+	s.events = append(s.events, e)
+	return nil
+}
+
+func (s *testEventLogStore) List(devEUI lorawan.EUI64, f storage.EventLogFilter, limit, offset int) ([]storage.EventLog, int, error) {
+	return s.events, len(s.events), nil
+}
+
+func (s *testEventLogStore) DeleteBefore(before time.Time) error {
+	return nil
+}
+
+func TestEventLogHandler(t *testing.T) {
+	Convey("Given an EventLogHandler", t, func() {
+		store := testEventLogStore{}
+		h := NewEventLogHandler(&store)
+		appEUI := lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}
+		devEUI := lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1}
+
+		Convey("When sending a data-up payload and an error notification", func() {
+			pl := integration.DataUpPayload{DevEUI: devEUI, FCnt: 10}
+			So(h.SendDataUp(appEUI, devEUI, pl), ShouldBeNil)
+			So(h.SendErrorNotification(appEUI, devEUI, integration.ErrorNotification{DevEUI: devEUI, Error: "boom"}), ShouldBeNil)
+
+			Convey("Then both events are stored", func() {
+				So(store.events, ShouldHaveLength, 2)
+				So(store.events[0].AppEUI, ShouldEqual, appEUI)
+				So(store.events[0].DevEUI, ShouldEqual, devEUI)
+				So(store.events[0].Type, ShouldEqual, integration.EventDataUp)
+				So(store.events[1].Type, ShouldEqual, integration.EventError)
+
+				var received integration.DataUpPayload
+				So(json.Unmarshal(store.events[0].Payload, &received), ShouldBeNil)
+				So(received, ShouldResemble, pl)
+			})
+		})
+	})
+}
@@ -0,0 +1,268 @@
+package handler
+
+import (
+	"errors"
+	"sync"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/brocaar/lora-app-server/integration"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+// errBackendBufferFull is the error used for the dead letters of the events
+// which were dropped because the buffer of the backend was full.
+var errBackendBufferFull = errors.New("backend buffer is full")
+
+// Backend defines a named handler backend.
+type Backend struct {
+	Name    string
+	Handler integration.Handler
+}
+
+// fanOutEvent defines an event to send to a backend.
+type fanOutEvent struct {
+	appEUI  lorawan.EUI64
+	devEUI  lorawan.EUI64
+	payload interface{}
+}
+
+// fanOutTarget defines a backend with its buffer of events to send.
+type fanOutTarget struct {
+	Backend
+	events chan fanOutEvent
+}
+
+// FanOutHandler implements a handler sending the events to multiple handler
+// backends. Each backend has its own buffer, which is sent by a dedicated
+// worker, so that a slow or failing backend doesn't block the others. When
+// the buffer of a backend is full, the event is stored as dead letter (see
+// Retrier). The events of an application are sent to the backends set by
+// its HandlerBackend, or to all the backends when not set.
+type FanOutHandler struct {
+	db           *sqlx.DB
+	targets      []*fanOutTarget
+	dataDownChan chan integration.DataDownPayload
+
+	mu        sync.RWMutex
+	closed    bool
+	closeOnce sync.Once
+	workersWG sync.WaitGroup
+	dataDown  sync.WaitGroup
+}
+
+// NewFanOutHandler creates a new FanOutHandler for the given backends, using
+// a buffer of the given size per backend. The DataDownPayload received by
+// the backends are merged into a single channel.
+func NewFanOutHandler(db *sqlx.DB, backends []Backend, bufferSize int) *FanOutHandler {
+	h := FanOutHandler{
+		db:           db,
+		dataDownChan: make(chan integration.DataDownPayload),
+	}
+
+	for _, b := range backends {
+		t := fanOutTarget{
+			Backend: b,
+			events:  make(chan fanOutEvent, bufferSize),
+		}
+		h.targets = append(h.targets, &t)
+
+		h.workersWG.Add(1)
+		go h.worker(&t)
+
+		h.dataDown.Add(1)
+		go func(c chan integration.DataDownPayload) {
+			defer h.dataDown.Done()
+			for pl := range c {
+				h.dataDownChan <- pl
+			}
+		}(b.Handler.DataDownChan())
+	}
+
+	go func() {
+		h.dataDown.Wait()
+		close(h.dataDownChan)
+	}()
+
+	return &h
+}
+
+// Names returns the names of the backends.
+func (h *FanOutHandler) Names() []string {
+	var names []string
+	for _, t := range h.targets {
+		names = append(names, t.Name)
+	}
+	return names
+}
+
+// Flush stops accepting new events and waits until the buffered events have
+// been sent to the backends (failed deliveries are handed over to the
+// Retrier). The backends are not closed, so that the pending retries can
+// still be made before the Retrier is shut down.
+func (h *FanOutHandler) Flush() {
+	h.mu.Lock()
+	if !h.closed {
+		h.closed = true
+		for _, t := range h.targets {
+			close(t.events)
+		}
+		log.Info("handler/fanout: sending the buffered events")
+	}
+	h.mu.Unlock()
+
+	h.workersWG.Wait()
+}
+
+// Close sends the buffered events (see Flush) and closes the backends.
+func (h *FanOutHandler) Close() error {
+	h.Flush()
+
+	var firstErr error
+	h.closeOnce.Do(func() {
+		for _, t := range h.targets {
+			if err := t.Handler.Close(); err != nil && firstErr == nil {
+				firstErr = err
+			}
+		}
+	})
+	return firstErr
+}
+
+// SendDataUp sends a DataUpPayload.
+func (h *FanOutHandler) SendDataUp(appEUI, devEUI lorawan.EUI64, payload integration.DataUpPayload) error {
+	return h.send(appEUI, devEUI, payload)
+}
+
+// SendJoinNotification sends a JoinNotification.
+func (h *FanOutHandler) SendJoinNotification(appEUI, devEUI lorawan.EUI64, payload integration.JoinNotification) error {
+	return h.send(appEUI, devEUI, payload)
+}
+
+// SendACKNotification sends an ACKNotification.
+func (h *FanOutHandler) SendACKNotification(appEUI, devEUI lorawan.EUI64, payload integration.ACKNotification) error {
+	return h.send(appEUI, devEUI, payload)
+}
+
+// SendErrorNotification sends an ErrorNotification.
+func (h *FanOutHandler) SendErrorNotification(appEUI, devEUI lorawan.EUI64, payload integration.ErrorNotification) error {
+	return h.send(appEUI, devEUI, payload)
+}
+
+// SendLinkQualityNotification sends a LinkQualityNotification.
+func (h *FanOutHandler) SendLinkQualityNotification(appEUI, devEUI lorawan.EUI64, payload integration.LinkQualityNotification) error {
+	return h.send(appEUI, devEUI, payload)
+}
+
+// SendLifecycleNotification sends a LifecycleNotification.
+func (h *FanOutHandler) SendLifecycleNotification(appEUI, devEUI lorawan.EUI64, payload integration.LifecycleNotification) error {
+	return h.send(appEUI, devEUI, payload)
+}
+
+// SendFirmwareNotification sends a FirmwareNotification.
+func (h *FanOutHandler) SendFirmwareNotification(appEUI, devEUI lorawan.EUI64, payload integration.FirmwareNotification) error {
+	return h.send(appEUI, devEUI, payload)
+}
+
+// SendDeviceStatus sends a DeviceStatus.
+func (h *FanOutHandler) SendDeviceStatus(appEUI, devEUI lorawan.EUI64, payload integration.DeviceStatus) error {
+	return h.send(appEUI, devEUI, payload)
+}
+
+// SendLocationNotification sends a LocationNotification.
+func (h *FanOutHandler) SendLocationNotification(appEUI, devEUI lorawan.EUI64, payload integration.LocationNotification) error {
+	return h.send(appEUI, devEUI, payload)
+}
+
+// SendScheduledDownlinkNotification sends a ScheduledDownlinkNotification.
+func (h *FanOutHandler) SendScheduledDownlinkNotification(appEUI, devEUI lorawan.EUI64, payload integration.ScheduledDownlinkNotification) error {
+	return h.send(appEUI, devEUI, payload)
+}
+
+// DataDownChan returns the channel containing the received DataDownPayload.
+func (h *FanOutHandler) DataDownChan() chan integration.DataDownPayload {
+	return h.dataDownChan
+}
+
+// send adds the given payload to the buffers of the backends of the
+// application.
+func (h *FanOutHandler) send(appEUI, devEUI lorawan.EUI64, payload interface{}) error {
+	backends, err := h.applicationBackends(appEUI)
+	if err != nil {
+		return err
+	}
+
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	if h.closed {
+		eventType, _ := integration.EventType(payload)
+		log.WithFields(log.Fields{
+			"type":    eventType,
+			"dev_eui": devEUI,
+		}).Warning("handler/fanout: handler is closed, event dropped")
+		return nil
+	}
+
+	for _, t := range h.targets {
+		if backends != nil && !backends[t.Name] {
+			continue
+		}
+
+		select {
+		case t.events <- fanOutEvent{appEUI: appEUI, devEUI: devEUI, payload: payload}:
+		default:
+			log.WithFields(log.Fields{
+				"backend": t.Name,
+				"dev_eui": devEUI,
+			}).Warning("handler/fanout: backend buffer is full")
+			backendBufferOverflows.WithLabelValues(t.Name).Inc()
+			r, name := retrier, t.Name
+			ok := r.background(name, func() {
+				r.deadLetter(name, appEUI, devEUI, payload, 0, errBackendBufferFull)
+			})
+			if !ok {
+				r.deadLetter(name, appEUI, devEUI, payload, 0, errBackendBufferFull)
+			}
+		}
+	}
+	return nil
+}
+
+// applicationBackends returns the names of the backends of the given
+// application, or nil when the events must be sent to all the backends.
+func (h *FanOutHandler) applicationBackends(appEUI lorawan.EUI64) (map[string]bool, error) {
+	// with a single backend, there is nothing to select
+	if len(h.targets) < 2 {
+		return nil, nil
+	}
+
+	b, err := storage.GetHandlerBackend(h.db, appEUI)
+	if err != nil {
+		return nil, err
+	}
+	if b == nil {
+		return nil, nil
+	}
+
+	backends := make(map[string]bool)
+	for _, name := range b.Backends {
+		backends[name] = true
+	}
+	return backends, nil
+}
+
+// worker sends the buffered events of the given target, until its buffer
+// is closed.
+func (h *FanOutHandler) worker(t *fanOutTarget) {
+	defer h.workersWG.Done()
+	for e := range t.events {
+		if err := integration.SendEvent(t.Handler, e.appEUI, e.devEUI, e.payload); err != nil {
+			log.WithFields(log.Fields{
+				"backend": t.Name,
+				"dev_eui": e.devEUI,
+			}).Errorf("handler/fanout: send event error: %s", err)
+		}
+	}
+}
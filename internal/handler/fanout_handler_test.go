@@ -0,0 +1,140 @@
+package handler
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/brocaar/lora-app-server/integration"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lora-app-server/internal/test"
+	"github.com/brocaar/lorawan"
+)
+
+// blockingHandler implements a handler of which the sends block until
+// unblocked.
+type blockingHandler struct {
+	integration.NopHandler
+	unblock chan struct{}
+	sent    chan integration.DataUpPayload
+}
+
+func (h *blockingHandler) SendDataUp(appEUI, devEUI lorawan.EUI64, payload integration.DataUpPayload) error {
+	<-h.unblock
+	h.sent <- payload
+	return nil
+}
+
+func TestRegistry(t *testing.T) {
+	Convey("Given a Registry with a registered backend", t, func() {
+		r := NewRegistry()
+		r.Register("test", func() (integration.Handler, error) {
+			return &integration.NopHandler{}, nil
+		})
+
+		Convey("Then Names returns the registered backends", func() {
+			So(r.Names(), ShouldResemble, []string{"test"})
+		})
+
+		Convey("Then the registered backend can be created", func() {
+			h, err := r.Create("test")
+			So(err, ShouldBeNil)
+			So(h, ShouldNotBeNil)
+		})
+
+		Convey("Then creating an unknown backend returns an error", func() {
+			_, err := r.Create("unknown")
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
+
+func TestFanOutHandler(t *testing.T) {
+	conf := test.GetConfig()
+
+	Convey("Given a clean database and a FanOutHandler with a slow and a fast backend", t, func() {
+		db, err := storage.OpenDatabase(conf.PostgresDSN)
+		So(err, ShouldBeNil)
+		test.MustResetDB(db)
+
+		store := testDeadLetterStore{}
+		defer SetRetrier(retrier)
+		SetRetrier(NewRetrier(&store, RetryPolicy{}, 0, 10))
+
+		slow := blockingHandler{unblock: make(chan struct{}), sent: make(chan integration.DataUpPayload, 10)}
+		fast := failingHandler{sent: make(chan integration.DataUpPayload, 10)}
+		h := NewFanOutHandler(db, []Backend{
+			{Name: "slow", Handler: &slow},
+			{Name: "fast", Handler: &fast},
+		}, 1)
+
+		appEUI := lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}
+		devEUI := lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1}
+		pl := integration.DataUpPayload{DevEUI: devEUI, FCnt: 10}
+
+		Convey("Then Names returns the backend names", func() {
+			So(h.Names(), ShouldResemble, []string{"slow", "fast"})
+		})
+
+		Convey("When sending payloads while the slow backend is blocked", func() {
+			for i := 0; i < 3; i++ {
+				So(h.SendDataUp(appEUI, devEUI, pl), ShouldBeNil)
+				time.Sleep(10 * time.Millisecond)
+			}
+
+			Convey("Then the fast backend received all the payloads", func() {
+				for i := 0; i < 3; i++ {
+					So(<-fast.sent, ShouldResemble, pl)
+				}
+			})
+
+			Convey("Then the payload that did not fit the slow buffer was stored as dead letter", func() {
+				time.Sleep(10 * time.Millisecond)
+				store.Lock()
+				So(store.letters, ShouldHaveLength, 1)
+				So(store.letters[0].Handler, ShouldEqual, "slow")
+				store.Unlock()
+
+				close(slow.unblock)
+				So(h.Close(), ShouldBeNil)
+				So(slow.sent, ShouldHaveLength, 2)
+			})
+		})
+
+		Convey("When flushing the handler after sending a payload", func() {
+			So(h.SendDataUp(appEUI, devEUI, pl), ShouldBeNil)
+			close(slow.unblock)
+			h.Flush()
+
+			Convey("Then the buffered payload was sent by both backends", func() {
+				So(slow.sent, ShouldHaveLength, 1)
+				So(fast.sent, ShouldHaveLength, 1)
+
+				Convey("Then payloads sent afterwards are dropped", func() {
+					So(h.SendDataUp(appEUI, devEUI, pl), ShouldBeNil)
+					So(h.Close(), ShouldBeNil)
+					So(slow.sent, ShouldHaveLength, 1)
+				})
+			})
+		})
+
+		Convey("Given the application only uses the fast backend", func() {
+			So(storage.CreateHandlerBackend(db, storage.HandlerBackend{
+				AppEUI:   appEUI,
+				Backends: []string{"fast"},
+			}), ShouldBeNil)
+
+			Convey("When sending a payload", func() {
+				So(h.SendDataUp(appEUI, devEUI, pl), ShouldBeNil)
+
+				Convey("Then it was only sent to the fast backend", func() {
+					So(<-fast.sent, ShouldResemble, pl)
+					close(slow.unblock)
+					So(h.Close(), ShouldBeNil)
+					So(slow.sent, ShouldHaveLength, 0)
+				})
+			})
+		})
+	})
+}
@@ -0,0 +1,196 @@
+package handler
+
+import (
+	"fmt"
+	"sync"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/brocaar/lora-app-server/integration"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+// FilterHandler implements a handler forwarding the events to multiple
+// named integrations (e.g. the handler backends and the HTTP integration),
+// after applying the event filters of the application. An event is only
+// forwarded to an integration when it is allowed by both the filter of the
+// application applying to all the integrations and the filter applying to
+// the integration. Applications without event filters are not filtered.
+// The event is sent to the integrations concurrently, so that a slow
+// integration doesn't delay the others.
+type FilterHandler struct {
+	*MultiHandler
+
+	db           *sqlx.DB
+	integrations []Backend
+}
+
+// NewFilterHandler creates a new FilterHandler. The DataDownPayload received
+// by the given integrations are merged into a single channel.
+func NewFilterHandler(db *sqlx.DB, integrations []Backend) *FilterHandler {
+	var handlers []integration.Handler
+	for _, i := range integrations {
+		handlers = append(handlers, i.Handler)
+	}
+
+	return &FilterHandler{
+		MultiHandler: NewMultiHandler(handlers...),
+		db:           db,
+		integrations: integrations,
+	}
+}
+
+// Names returns the (unique) names of the integrations.
+func (h *FilterHandler) Names() []string {
+	var names []string
+	seen := make(map[string]bool)
+	for _, i := range h.integrations {
+		if !seen[i.Name] {
+			seen[i.Name] = true
+			names = append(names, i.Name)
+		}
+	}
+	return names
+}
+
+// SendDataUp sends a DataUpPayload.
+func (h *FilterHandler) SendDataUp(appEUI, devEUI lorawan.EUI64, payload integration.DataUpPayload) error {
+	return h.send(appEUI, devEUI, payload, &payload.FPort)
+}
+
+// SendJoinNotification sends a JoinNotification.
+func (h *FilterHandler) SendJoinNotification(appEUI, devEUI lorawan.EUI64, payload integration.JoinNotification) error {
+	return h.send(appEUI, devEUI, payload, nil)
+}
+
+// SendACKNotification sends an ACKNotification.
+func (h *FilterHandler) SendACKNotification(appEUI, devEUI lorawan.EUI64, payload integration.ACKNotification) error {
+	return h.send(appEUI, devEUI, payload, nil)
+}
+
+// SendErrorNotification sends an ErrorNotification.
+func (h *FilterHandler) SendErrorNotification(appEUI, devEUI lorawan.EUI64, payload integration.ErrorNotification) error {
+	return h.send(appEUI, devEUI, payload, nil)
+}
+
+// SendLinkQualityNotification sends a LinkQualityNotification.
+func (h *FilterHandler) SendLinkQualityNotification(appEUI, devEUI lorawan.EUI64, payload integration.LinkQualityNotification) error {
+	return h.send(appEUI, devEUI, payload, nil)
+}
+
+// SendLifecycleNotification sends a LifecycleNotification.
+func (h *FilterHandler) SendLifecycleNotification(appEUI, devEUI lorawan.EUI64, payload integration.LifecycleNotification) error {
+	return h.send(appEUI, devEUI, payload, nil)
+}
+
+// SendFirmwareNotification sends a FirmwareNotification.
+func (h *FilterHandler) SendFirmwareNotification(appEUI, devEUI lorawan.EUI64, payload integration.FirmwareNotification) error {
+	return h.send(appEUI, devEUI, payload, nil)
+}
+
+// SendDeviceStatus sends a DeviceStatus.
+func (h *FilterHandler) SendDeviceStatus(appEUI, devEUI lorawan.EUI64, payload integration.DeviceStatus) error {
+	return h.send(appEUI, devEUI, payload, nil)
+}
+
+// SendLocationNotification sends a LocationNotification.
+func (h *FilterHandler) SendLocationNotification(appEUI, devEUI lorawan.EUI64, payload integration.LocationNotification) error {
+	return h.send(appEUI, devEUI, payload, nil)
+}
+
+// SendScheduledDownlinkNotification sends a ScheduledDownlinkNotification.
+func (h *FilterHandler) SendScheduledDownlinkNotification(appEUI, devEUI lorawan.EUI64, payload integration.ScheduledDownlinkNotification) error {
+	return h.send(appEUI, devEUI, payload, nil)
+}
+
+// send forwards the given payload to the integrations allowed by the event
+// filters of the application. fPort is set for the uplink data. A failing
+// integration does not prevent the other integrations from being called,
+// the first error is returned.
+func (h *FilterHandler) send(appEUI, devEUI lorawan.EUI64, payload interface{}, fPort *uint8) error {
+	eventType, err := integration.EventType(payload)
+	if err != nil {
+		return err
+	}
+
+	filters, err := storage.GetEventFiltersForAppEUI(h.db, appEUI)
+	if err != nil {
+		return fmt.Errorf("handler/filter: %s", err)
+	}
+
+	var all *storage.EventFilter
+	byIntegration := make(map[string]storage.EventFilter)
+	for i := range filters {
+		if filters[i].Integration == "" {
+			all = &filters[i]
+			continue
+		}
+		byIntegration[filters[i].Integration] = filters[i]
+	}
+
+	if all != nil && !EventAllowed(*all, eventType, fPort) {
+		eventsFiltered.WithLabelValues("all", eventType).Inc()
+		return nil
+	}
+
+	// the errors are stored per integration, so that the first error
+	// (in the order of the integrations) is returned
+	errs := make([]error, len(h.integrations))
+	var wg sync.WaitGroup
+	for idx, i := range h.integrations {
+		if f, ok := byIntegration[i.Name]; ok && !EventAllowed(f, eventType, fPort) {
+			eventsFiltered.WithLabelValues(i.Name, eventType).Inc()
+			log.WithFields(log.Fields{
+				"integration": i.Name,
+				"type":        eventType,
+				"dev_eui":     devEUI,
+			}).Debug("handler/filter: event filtered")
+			continue
+		}
+
+		wg.Add(1)
+		go func(idx int, handler integration.Handler) {
+			defer wg.Done()
+			errs[idx] = integration.SendEvent(handler, appEUI, devEUI, payload)
+		}(idx, i.Handler)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// EventAllowed returns true when the given filter allows the given event
+// type. fPort is set for the uplink data, which must match the FPorts of the
+// filter.
+func EventAllowed(f storage.EventFilter, eventType string, fPort *uint8) bool {
+	if len(f.Events) != 0 {
+		var allowed bool
+		for _, e := range f.Events {
+			if e == eventType {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return false
+		}
+	}
+
+	if fPort != nil && len(f.FPorts) != 0 {
+		for _, p := range f.FPorts {
+			if p == int64(*fPort) {
+				return true
+			}
+		}
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,170 @@
+package handler
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/brocaar/lora-app-server/integration"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lora-app-server/internal/test"
+	"github.com/brocaar/lorawan"
+)
+
+// recordingHandler implements a handler recording the sent data-up and join
+// payloads.
+type recordingHandler struct {
+	integration.NopHandler
+	dataUp []integration.DataUpPayload
+	joins  []integration.JoinNotification
+}
+
+func (h *recordingHandler) SendDataUp(appEUI, devEUI lorawan.EUI64, payload integration.DataUpPayload) error {
+	h.dataUp = append(h.dataUp, payload)
+	return nil
+}
+
+func (h *recordingHandler) SendJoinNotification(appEUI, devEUI lorawan.EUI64, payload integration.JoinNotification) error {
+	h.joins = append(h.joins, payload)
+	return nil
+}
+
+// barrierHandler implements a handler of which the join notifications
+// only succeed when they are sent to all the handlers sharing the wait group
+// at the same time.
+type barrierHandler struct {
+	integration.NopHandler
+	wg *sync.WaitGroup
+}
+
+func (h *barrierHandler) SendJoinNotification(appEUI, devEUI lorawan.EUI64, payload integration.JoinNotification) error {
+	h.wg.Done()
+
+	done := make(chan struct{})
+	go func() {
+		h.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-time.After(time.Second):
+		return errors.New("the other handlers were not called concurrently")
+	}
+}
+
+func TestEventAllowed(t *testing.T) {
+	Convey("Given a set of tests", t, func() {
+		fPort := uint8(10)
+		tests := []struct {
+			Name      string
+			Filter    storage.EventFilter
+			EventType string
+			FPort     *uint8
+			Allowed   bool
+		}{
+			{Name: "empty filter", EventType: integration.EventJoin, Allowed: true},
+			{Name: "allowed event type", Filter: storage.EventFilter{Events: []string{"rx", "join"}}, EventType: integration.EventJoin, Allowed: true},
+			{Name: "filtered event type", Filter: storage.EventFilter{Events: []string{"rx"}}, EventType: integration.EventJoin, Allowed: false},
+			{Name: "allowed fport", Filter: storage.EventFilter{FPorts: []int64{5, 10}}, EventType: integration.EventDataUp, FPort: &fPort, Allowed: true},
+			{Name: "filtered fport", Filter: storage.EventFilter{FPorts: []int64{5}}, EventType: integration.EventDataUp, FPort: &fPort, Allowed: false},
+			{Name: "fports don't apply to other events", Filter: storage.EventFilter{FPorts: []int64{5}}, EventType: integration.EventJoin, Allowed: true},
+		}
+
+		for _, test := range tests {
+			Convey("Test: "+test.Name, func() {
+				So(EventAllowed(test.Filter, test.EventType, test.FPort), ShouldEqual, test.Allowed)
+			})
+		}
+	})
+}
+
+func TestFilterHandler(t *testing.T) {
+	conf := test.GetConfig()
+
+	Convey("Given a clean database and a FilterHandler with two integrations", t, func() {
+		db, err := storage.OpenDatabase(conf.PostgresDSN)
+		So(err, ShouldBeNil)
+		test.MustResetDB(db)
+
+		backend := recordingHandler{}
+		httpIntegration := recordingHandler{}
+		h := NewFilterHandler(db, []Backend{
+			{Name: "backend", Handler: &backend},
+			{Name: "http", Handler: &httpIntegration},
+		})
+
+		appEUI := lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}
+		devEUI := lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1}
+
+		Convey("When the application has no filters", func() {
+			So(h.SendJoinNotification(appEUI, devEUI, integration.JoinNotification{DevEUI: devEUI}), ShouldBeNil)
+
+			Convey("Then the event was sent to both integrations", func() {
+				So(backend.joins, ShouldHaveLength, 1)
+				So(httpIntegration.joins, ShouldHaveLength, 1)
+			})
+		})
+
+		Convey("Given the application only publishes uplinks on FPort 10 and the http integration no joins", func() {
+			So(storage.CreateEventFilter(db, &storage.EventFilter{
+				AppEUI: appEUI,
+				FPorts: []int64{10},
+			}), ShouldBeNil)
+			So(storage.CreateEventFilter(db, &storage.EventFilter{
+				AppEUI:      appEUI,
+				Integration: "http",
+				Events:      []string{integration.EventDataUp},
+			}), ShouldBeNil)
+
+			Convey("When sending uplinks on FPort 10 and 20 and a join notification", func() {
+				So(h.SendDataUp(appEUI, devEUI, integration.DataUpPayload{FPort: 10}), ShouldBeNil)
+				So(h.SendDataUp(appEUI, devEUI, integration.DataUpPayload{FPort: 20}), ShouldBeNil)
+				So(h.SendJoinNotification(appEUI, devEUI, integration.JoinNotification{DevEUI: devEUI}), ShouldBeNil)
+
+				Convey("Then only the allowed events were sent", func() {
+					So(backend.dataUp, ShouldHaveLength, 1)
+					So(backend.dataUp[0].FPort, ShouldEqual, 10)
+					So(backend.joins, ShouldHaveLength, 1)
+
+					So(httpIntegration.dataUp, ShouldHaveLength, 1)
+					So(httpIntegration.joins, ShouldHaveLength, 0)
+				})
+			})
+		})
+
+		Convey("Given a FilterHandler with a failing integration", func() {
+			failing := failingHandler{failures: 1, sent: make(chan integration.DataUpPayload, 1)}
+			h := NewFilterHandler(db, []Backend{
+				{Name: "failing", Handler: &failing},
+				{Name: "backend", Handler: &backend},
+			})
+
+			Convey("When sending an uplink", func() {
+				err := h.SendDataUp(appEUI, devEUI, integration.DataUpPayload{FPort: 10})
+
+				Convey("Then the error is returned and the event was still sent to the other integration", func() {
+					So(err, ShouldResemble, errors.New("publish failed"))
+					So(backend.dataUp, ShouldHaveLength, 1)
+				})
+			})
+		})
+
+		Convey("Given a FilterHandler with two integrations waiting for each other", func() {
+			var wg sync.WaitGroup
+			wg.Add(2)
+			h := NewFilterHandler(db, []Backend{
+				{Name: "backend", Handler: &barrierHandler{wg: &wg}},
+				{Name: "http", Handler: &barrierHandler{wg: &wg}},
+			})
+
+			Convey("Then a join notification is sent to both integrations concurrently", func() {
+				So(h.SendJoinNotification(appEUI, devEUI, integration.JoinNotification{DevEUI: devEUI}), ShouldBeNil)
+			})
+		})
+	})
+}
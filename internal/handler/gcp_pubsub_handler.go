@@ -0,0 +1,173 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/brocaar/lora-app-server/integration"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+// gcpPubSubEndpoint defines the endpoint of the Pub/Sub API.
+const gcpPubSubEndpoint = "https://pubsub.googleapis.com"
+
+// gcpPubSubTimeout defines the timeout of the Pub/Sub (and token) requests.
+const gcpPubSubTimeout = 10 * time.Second
+
+// GCPPubSubHandler implements a handler publishing the events (JSON or
+// protobuf encoded) to the Google Cloud Pub/Sub topic configured by the Pub/Sub
+// integration of the application. The event type, AppEUI and DevEUI are
+// added as message attributes. Applications without Pub/Sub integration
+// are ignored.
+type GCPPubSubHandler struct {
+	integration.NopHandler
+
+	db       *sqlx.DB
+	client   *http.Client
+	tokens   *gcpTokenSource
+	endpoint string
+}
+
+// NewGCPPubSubHandler creates a new GCPPubSubHandler.
+func NewGCPPubSubHandler(db *sqlx.DB) *GCPPubSubHandler {
+	client := &http.Client{Timeout: gcpPubSubTimeout}
+	return &GCPPubSubHandler{
+		db:       db,
+		client:   client,
+		tokens:   newGCPTokenSource(client),
+		endpoint: gcpPubSubEndpoint,
+	}
+}
+
+// SendDataUp sends a DataUpPayload.
+func (h *GCPPubSubHandler) SendDataUp(appEUI, devEUI lorawan.EUI64, payload integration.DataUpPayload) error {
+	return h.publish(appEUI, devEUI, payload)
+}
+
+// SendJoinNotification sends a JoinNotification.
+func (h *GCPPubSubHandler) SendJoinNotification(appEUI, devEUI lorawan.EUI64, payload integration.JoinNotification) error {
+	return h.publish(appEUI, devEUI, payload)
+}
+
+// SendACKNotification sends an ACKNotification.
+func (h *GCPPubSubHandler) SendACKNotification(appEUI, devEUI lorawan.EUI64, payload integration.ACKNotification) error {
+	return h.publish(appEUI, devEUI, payload)
+}
+
+// SendErrorNotification sends an ErrorNotification.
+func (h *GCPPubSubHandler) SendErrorNotification(appEUI, devEUI lorawan.EUI64, payload integration.ErrorNotification) error {
+	return h.publish(appEUI, devEUI, payload)
+}
+
+// SendLinkQualityNotification sends a LinkQualityNotification.
+func (h *GCPPubSubHandler) SendLinkQualityNotification(appEUI, devEUI lorawan.EUI64, payload integration.LinkQualityNotification) error {
+	return h.publish(appEUI, devEUI, payload)
+}
+
+// SendLifecycleNotification sends a LifecycleNotification.
+func (h *GCPPubSubHandler) SendLifecycleNotification(appEUI, devEUI lorawan.EUI64, payload integration.LifecycleNotification) error {
+	return h.publish(appEUI, devEUI, payload)
+}
+
+// SendFirmwareNotification sends a FirmwareNotification.
+func (h *GCPPubSubHandler) SendFirmwareNotification(appEUI, devEUI lorawan.EUI64, payload integration.FirmwareNotification) error {
+	return h.publish(appEUI, devEUI, payload)
+}
+
+// SendDeviceStatus sends a DeviceStatus.
+func (h *GCPPubSubHandler) SendDeviceStatus(appEUI, devEUI lorawan.EUI64, payload integration.DeviceStatus) error {
+	return h.publish(appEUI, devEUI, payload)
+}
+
+// SendLocationNotification sends a LocationNotification.
+func (h *GCPPubSubHandler) SendLocationNotification(appEUI, devEUI lorawan.EUI64, payload integration.LocationNotification) error {
+	return h.publish(appEUI, devEUI, payload)
+}
+
+// SendScheduledDownlinkNotification sends a ScheduledDownlinkNotification.
+func (h *GCPPubSubHandler) SendScheduledDownlinkNotification(appEUI, devEUI lorawan.EUI64, payload integration.ScheduledDownlinkNotification) error {
+	return h.publish(appEUI, devEUI, payload)
+}
+
+// publish publishes the given payload to the topic of the Pub/Sub
+// integration of the application. The message is published asynchronously,
+// failed attempts are retried (see Retrier).
+func (h *GCPPubSubHandler) publish(appEUI, devEUI lorawan.EUI64, payload interface{}) error {
+	i, err := storage.GetGCPPubSubIntegration(h.db, appEUI)
+	if err != nil {
+		return fmt.Errorf("handler/gcppubsub: %s", err)
+	}
+	if i == nil {
+		return nil
+	}
+
+	eventType, b, err := marshaler.MarshalEvent(payload)
+	if err != nil {
+		return fmt.Errorf("handler/gcppubsub: %s", err)
+	}
+
+	log.WithFields(log.Fields{
+		"project": i.ProjectID,
+		"topic":   i.Topic,
+		"type":    eventType,
+		"dev_eui": devEUI,
+	}).Info("handler/gcppubsub: publishing event")
+	retrier.DeliverAsync("gcppubsub", appEUI, devEUI, payload, func() error {
+		start := time.Now()
+		err := h.post(*i, map[string]string{
+			"event":  eventType,
+			"appEUI": appEUI.String(),
+			"devEUI": devEUI.String(),
+		}, b)
+		observePublish("gcppubsub", eventType, appEUI, start, err)
+		return err
+	})
+	return nil
+}
+
+// post publishes the given data with the given attributes to the topic of
+// the given integration, using the Pub/Sub REST API.
+func (h *GCPPubSubHandler) post(i storage.GCPPubSubIntegration, attributes map[string]string, data []byte) error {
+	token, err := h.tokens.Token(string(i.CredentialsJSON))
+	if err != nil {
+		return err
+	}
+
+	// []byte values are base64 encoded by the json package
+	b, err := json.Marshal(map[string]interface{}{
+		"messages": []map[string]interface{}{
+			{"data": data, "attributes": attributes},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	u := fmt.Sprintf("%s/v1/projects/%s/topics/%s:publish", strings.TrimRight(h.endpoint, "/"), url.PathEscape(i.ProjectID), url.PathEscape(i.Topic))
+	req, err := http.NewRequest("POST", u, bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("expected 2xx response, got: %s", strings.TrimSpace(resp.Status))
+	}
+	return nil
+}
@@ -0,0 +1,151 @@
+package handler
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	jwt "github.com/dgrijalva/jwt-go"
+)
+
+// gcpPubSubScope defines the OAuth2 scope of the Pub/Sub access tokens.
+const gcpPubSubScope = "https://www.googleapis.com/auth/pubsub"
+
+// gcpTokenExpiryMargin defines the margin before the expiry of an access
+// token at which it is refreshed.
+const gcpTokenExpiryMargin = time.Minute
+
+// gcpServiceAccount contains the (used) fields of the JSON key file of a
+// Google Cloud service account.
+type gcpServiceAccount struct {
+	ClientEmail string `json:"client_email"`
+	PrivateKey  string `json:"private_key"`
+	TokenURI    string `json:"token_uri"`
+}
+
+type gcpToken struct {
+	accessToken string
+	expiry      time.Time
+}
+
+// gcpTokenSource returns (cached) OAuth2 access tokens for service
+// accounts, using the JWT bearer grant.
+type gcpTokenSource struct {
+	sync.Mutex
+	client *http.Client
+	tokens map[[sha256.Size]byte]gcpToken
+}
+
+func newGCPTokenSource(client *http.Client) *gcpTokenSource {
+	return &gcpTokenSource{
+		client: client,
+		tokens: make(map[[sha256.Size]byte]gcpToken),
+	}
+}
+
+// Token returns an access token for the service account of the given
+// credentials (JSON key file). Tokens are cached by the hash of the
+// credentials, so that updated credentials result in a new token.
+func (s *gcpTokenSource) Token(credentialsJSON string) (string, error) {
+	key := sha256.Sum256([]byte(credentialsJSON))
+
+	s.Lock()
+	t, ok := s.tokens[key]
+	s.Unlock()
+	if ok && time.Now().Add(gcpTokenExpiryMargin).Before(t.expiry) {
+		return t.accessToken, nil
+	}
+
+	t, err := s.requestToken(credentialsJSON)
+	if err != nil {
+		return "", err
+	}
+
+	s.Lock()
+	s.tokens[key] = t
+	s.Unlock()
+	return t.accessToken, nil
+}
+
+func (s *gcpTokenSource) requestToken(credentialsJSON string) (gcpToken, error) {
+	var t gcpToken
+	sa, err := parseGCPServiceAccount(credentialsJSON)
+	if err != nil {
+		return t, err
+	}
+	privateKey, err := jwt.ParseRSAPrivateKeyFromPEM([]byte(sa.PrivateKey))
+	if err != nil {
+		return t, fmt.Errorf("parse private key error: %s", err)
+	}
+
+	now := time.Now()
+	assertion, err := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+		"iss":   sa.ClientEmail,
+		"scope": gcpPubSubScope,
+		"aud":   sa.TokenURI,
+		"iat":   now.Unix(),
+		"exp":   now.Add(time.Hour).Unix(),
+	}).SignedString(privateKey)
+	if err != nil {
+		return t, fmt.Errorf("sign assertion error: %s", err)
+	}
+
+	resp, err := s.client.PostForm(sa.TokenURI, url.Values{
+		"grant_type": []string{"urn:ietf:params:oauth:grant-type:jwt-bearer"},
+		"assertion":  []string{assertion},
+	})
+	if err != nil {
+		return t, fmt.Errorf("request access token error: %s", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return t, fmt.Errorf("request access token error: expected 2xx response, got: %s", strings.TrimSpace(resp.Status))
+	}
+
+	var tokenResp struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int    `json:"expires_in"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&tokenResp); err != nil {
+		return t, fmt.Errorf("decode access token error: %s", err)
+	}
+	if tokenResp.AccessToken == "" {
+		return t, errors.New("token response does not contain an access token")
+	}
+
+	t.accessToken = tokenResp.AccessToken
+	t.expiry = now.Add(time.Duration(tokenResp.ExpiresIn) * time.Second)
+	return t, nil
+}
+
+// parseGCPServiceAccount parses and validates the given service account
+// JSON key file.
+func parseGCPServiceAccount(credentialsJSON string) (gcpServiceAccount, error) {
+	var sa gcpServiceAccount
+	if err := json.Unmarshal([]byte(credentialsJSON), &sa); err != nil {
+		return sa, fmt.Errorf("parse service account credentials error: %s", err)
+	}
+	if sa.ClientEmail == "" || sa.PrivateKey == "" || sa.TokenURI == "" {
+		return sa, errors.New("service account credentials must contain client_email, private_key and token_uri")
+	}
+	return sa, nil
+}
+
+// ValidateGCPCredentials returns an error when the given service account
+// credentials (JSON key file) are invalid.
+func ValidateGCPCredentials(credentialsJSON string) error {
+	sa, err := parseGCPServiceAccount(credentialsJSON)
+	if err != nil {
+		return err
+	}
+	if _, err := jwt.ParseRSAPrivateKeyFromPEM([]byte(sa.PrivateKey)); err != nil {
+		return fmt.Errorf("parse private key error: %s", err)
+	}
+	return nil
+}
@@ -1,13 +1,61 @@
 package handler
 
-import "github.com/brocaar/lorawan"
-
-// Handler defines the interface of a handler backend.
-type Handler interface {
-	Close() error                                                                        // closes the handler
-	SendDataUp(appEUI, devEUI lorawan.EUI64, payload DataUpPayload) error                // send data-up payload
-	SendJoinNotification(appEUI, devEUI lorawan.EUI64, payload JoinNotification) error   // send join notification
-	SendACKNotification(appEUI, devEUI lorawan.EUI64, payload ACKNotification) error     // send ack notification
-	SendErrorNotification(appEUI, devEUI lorawan.EUI64, payload ErrorNotification) error // send error notification
-	DataDownChan() chan DataDownPayload                                                  // returns DataDownPayload channel
+import (
+	"time"
+
+	"github.com/brocaar/lora-app-server/integration"
+	"github.com/brocaar/lorawan"
+)
+
+// EventSigner defines the interface for signing the payloads published by
+// a handler.
+type EventSigner interface {
+	// Sign returns the JWS of the given payload, signed by the current
+	// signing key of the application. In case the application doesn't have
+	// a signing key, an empty string is returned.
+	Sign(appEUI lorawan.EUI64, payload []byte, detached bool) (string, error)
+}
+
+// DownlinkAuthorizer defines the interface for authorizing the received
+// downlink payloads.
+type DownlinkAuthorizer interface {
+	// AuthorizeDownlink returns an error when the given principal is not
+	// allowed to send downlink data to the given node on the given FPort.
+	AuthorizeDownlink(appEUI, devEUI lorawan.EUI64, fPort uint8, principal string) error
+}
+
+// DownlinkLimiter defines the interface for rate limiting the received
+// downlink payloads.
+type DownlinkLimiter interface {
+	// LimitDownlink returns an error when the given payload exceeds the
+	// maximum payload size or the downlink rate limit.
+	LimitDownlink(appEUI lorawan.EUI64, pl integration.DataDownPayload) error
+}
+
+// DownlinkQueue defines the interface for enqueueing the received downlink
+// payloads.
+type DownlinkQueue interface {
+	// Enqueue adds the given payload to the downlink queue of the node.
+	Enqueue(pl integration.DataDownPayload) error
+}
+
+// DownlinkIntake defines the interface for configuring the handling of the
+// downlink payloads received by a handler backend (see downlinkIntake).
+type DownlinkIntake interface {
+	SetReplayProtection(required bool, nonceTTL time.Duration)
+	SetIdempotency(lockTTL, retention time.Duration)
+	SetDownlinkAuthorizer(a DownlinkAuthorizer)
+	SetDownlinkLimiter(l DownlinkLimiter)
+	SetDownlinkQueue(q DownlinkQueue)
+}
+
+// marshaler defines the encoding of the payloads sent to and received from
+// the applications by the handler backends.
+var marshaler = integration.MarshalerJSON
+
+// SetMarshaler sets the Marshaler used by the handler backends. The event
+// log and stream handlers always use JSON, as these serve the
+// web-interface.
+func SetMarshaler(m integration.Marshaler) {
+	marshaler = m
 }
@@ -0,0 +1,120 @@
+package handler
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/brocaar/lora-app-server/integration"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+// httpTimeout defines the timeout of the HTTP integration requests.
+const httpTimeout = 10 * time.Second
+
+// HTTPHandler implements a handler posting the events to the endpoints
+// configured by the HTTP integration of the application.
+// Applications without HTTP integration are ignored. As the HTTP
+// integration can't receive downlink payloads, the DataDownChan only gets
+// closed on Close.
+type HTTPHandler struct {
+	integration.NopHandler
+
+	db     *sqlx.DB
+	client *http.Client
+}
+
+// NewHTTPHandler creates a new HTTPHandler. Failed requests are retried
+// using the retry policy of the http handler (see Retrier).
+func NewHTTPHandler(db *sqlx.DB) *HTTPHandler {
+	return &HTTPHandler{
+		db:     db,
+		client: &http.Client{Timeout: httpTimeout},
+	}
+}
+
+// SendDataUp sends a DataUpPayload.
+func (h *HTTPHandler) SendDataUp(appEUI, devEUI lorawan.EUI64, payload integration.DataUpPayload) error {
+	return h.send(appEUI, devEUI, payload, func(i storage.HTTPIntegration) string {
+		return i.DataUpURL
+	})
+}
+
+// SendJoinNotification sends a JoinNotification.
+func (h *HTTPHandler) SendJoinNotification(appEUI, devEUI lorawan.EUI64, payload integration.JoinNotification) error {
+	return h.send(appEUI, devEUI, payload, func(i storage.HTTPIntegration) string {
+		return i.JoinNotificationURL
+	})
+}
+
+// SendACKNotification sends an ACKNotification.
+func (h *HTTPHandler) SendACKNotification(appEUI, devEUI lorawan.EUI64, payload integration.ACKNotification) error {
+	return h.send(appEUI, devEUI, payload, func(i storage.HTTPIntegration) string {
+		return i.ACKNotificationURL
+	})
+}
+
+// SendErrorNotification sends an ErrorNotification.
+func (h *HTTPHandler) SendErrorNotification(appEUI, devEUI lorawan.EUI64, payload integration.ErrorNotification) error {
+	return h.send(appEUI, devEUI, payload, func(i storage.HTTPIntegration) string {
+		return i.ErrorNotificationURL
+	})
+}
+
+// send posts the given payload to the url (returned by the given function)
+// of the HTTP integration of the application. The request is posted
+// asynchronously, failed attempts are retried (see Retrier).
+func (h *HTTPHandler) send(appEUI, devEUI lorawan.EUI64, payload interface{}, getURL func(storage.HTTPIntegration) string) error {
+	i, err := storage.GetHTTPIntegration(h.db, appEUI)
+	if err != nil {
+		return fmt.Errorf("handler/http: %s", err)
+	}
+	if i == nil || getURL(*i) == "" {
+		return nil
+	}
+	url := getURL(*i)
+
+	eventType, b, err := marshaler.MarshalEvent(payload)
+	if err != nil {
+		return fmt.Errorf("handler/http: %s", err)
+	}
+
+	log.WithFields(log.Fields{
+		"url":     url,
+		"type":    eventType,
+		"dev_eui": devEUI,
+	}).Info("handler/http: posting event")
+	retrier.DeliverAsync("http", appEUI, devEUI, payload, func() error {
+		return h.post(url, i.Headers, b)
+	})
+	return nil
+}
+
+// post posts the given body to the given url, including the given headers.
+func (h *HTTPHandler) post(url string, headers storage.HTTPHeaders, b []byte) error {
+	req, err := http.NewRequest("POST", url, bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	req.Header.Set("Content-Type", marshaler.ContentType())
+
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("expected 2xx response, got: %s", strings.TrimSpace(resp.Status))
+	}
+	return nil
+}
@@ -0,0 +1,114 @@
+package handler
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/brocaar/lora-app-server/integration"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lora-app-server/internal/test"
+	"github.com/brocaar/lorawan"
+)
+
+func TestHTTPHandler(t *testing.T) {
+	conf := test.GetConfig()
+
+	Convey("Given a clean database and a test HTTP server", t, func() {
+		db, err := storage.OpenDatabase(conf.PostgresDSN)
+		So(err, ShouldBeNil)
+		test.MustResetDB(db)
+
+		type request struct {
+			Path   string
+			Header http.Header
+			Body   []byte
+		}
+		requests := make(chan request, 10)
+		failures := 1
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			b, _ := ioutil.ReadAll(r.Body)
+			if r.URL.Path == "/fail" && failures > 0 {
+				failures--
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			requests <- request{Path: r.URL.Path, Header: r.Header, Body: b}
+		}))
+		defer server.Close()
+
+		defer SetRetrier(retrier)
+		SetRetrier(NewRetrier(nil, RetryPolicy{Retries: 1, Backoff: time.Millisecond}, 0, 10))
+		h := NewHTTPHandler(db)
+		appEUI := lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}
+		devEUI := lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1}
+
+		Convey("When sending a payload for an application without http integration", func() {
+			So(h.SendDataUp(appEUI, devEUI, integration.DataUpPayload{DevEUI: devEUI}), ShouldBeNil)
+
+			Convey("Then no request was made", func() {
+				So(requests, ShouldHaveLength, 0)
+			})
+		})
+
+		Convey("Given a http integration for the application", func() {
+			i := storage.HTTPIntegration{
+				AppEUI:               appEUI,
+				Headers:              storage.HTTPHeaders{"Authorization": "Bearer secret"},
+				DataUpURL:            server.URL + "/rx",
+				JoinNotificationURL:  server.URL + "/join",
+				ErrorNotificationURL: server.URL + "/fail",
+			}
+			So(storage.CreateHTTPIntegration(db, i), ShouldBeNil)
+
+			Convey("Then GetHTTPIntegration returns the integration", func() {
+				i2, err := storage.GetHTTPIntegration(db, appEUI)
+				So(err, ShouldBeNil)
+				So(*i2, ShouldResemble, i)
+			})
+
+			Convey("When sending a data-up payload", func() {
+				pl := integration.DataUpPayload{
+					DevEUI: devEUI,
+					FCnt:   10,
+					FPort:  1,
+					Data:   []byte{1, 2, 3},
+				}
+				So(h.SendDataUp(appEUI, devEUI, pl), ShouldBeNil)
+
+				Convey("Then the payload was posted to the data-up url", func() {
+					req := <-requests
+					So(req.Path, ShouldEqual, "/rx")
+					So(req.Header.Get("Authorization"), ShouldEqual, "Bearer secret")
+					So(req.Header.Get("Content-Type"), ShouldEqual, "application/json")
+
+					var received integration.DataUpPayload
+					So(json.Unmarshal(req.Body, &received), ShouldBeNil)
+					So(received, ShouldResemble, pl)
+				})
+			})
+
+			Convey("When sending an ack notification (no url configured)", func() {
+				So(h.SendACKNotification(appEUI, devEUI, integration.ACKNotification{DevEUI: devEUI}), ShouldBeNil)
+
+				Convey("Then no request was made", func() {
+					So(requests, ShouldHaveLength, 0)
+				})
+			})
+
+			Convey("When sending an error notification to a failing endpoint", func() {
+				So(h.SendErrorNotification(appEUI, devEUI, integration.ErrorNotification{DevEUI: devEUI, Error: "test"}), ShouldBeNil)
+
+				Convey("Then the request was retried", func() {
+					req := <-requests
+					So(req.Path, ShouldEqual, "/fail")
+				})
+			})
+		})
+	})
+}
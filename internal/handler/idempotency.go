@@ -0,0 +1,104 @@
+package handler
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/brocaar/lora-app-server/internal/lockstore"
+	"github.com/brocaar/lorawan"
+)
+
+// defaultDownlinkLockTTL defines the default duration in which the copies of
+// a downlink payload (received by all subscribed instances) are ignored.
+const defaultDownlinkLockTTL = time.Second * 5
+
+// defaultReferenceRetention defines the default duration the reference of a
+// handled downlink payload is remembered.
+const defaultReferenceRetention = time.Hour * 24
+
+// errorTypeDataDownDuplicate is the error type used for error notifications
+// when a downlink payload is rejected because its reference has already been
+// handled.
+const errorTypeDataDownDuplicate = "DATA_DOWN_DUPLICATE"
+
+// idempotencyResult defines the outcome of the idempotency check of a
+// downlink payload.
+type idempotencyResult int
+
+// Idempotency check results.
+const (
+	idempotencyNew       idempotencyResult = iota // first receipt, handle the payload
+	idempotencyCopy                               // copy of a payload handled by an other instance, ignore
+	idempotencyDuplicate                          // reference has already been handled, reject
+)
+
+// checkIdempotency records the reference of the given downlink payload and
+// returns if the payload must be handled, ignored or rejected.
+//
+// As with MQTT all instances receive the same message, the first receipt of
+// a reference stores the time it was received. Receipts within the lock TTL
+// are considered to be copies of the same message and are ignored. Receipts
+// after the lock TTL (but within the retention window) are duplicates. To
+// publish only one error notification per duplicate (of which all instances
+// receive a copy too), the first instance reporting the duplicate takes an
+// other lock.
+//
+// Payloads without reference can't be tracked, for these the hash of the
+// raw payload is used as reference and is only remembered for the lock TTL.
+// Without lock store, all payloads are handled.
+func (d *downlinkIntake) checkIdempotency(devEUI lorawan.EUI64, reference string, raw []byte) (idempotencyResult, error) {
+	if d.locks == nil {
+		return idempotencyNew, nil
+	}
+
+	retention := d.referenceRetention
+	if reference == "" {
+		sum := sha1.Sum(raw)
+		reference = "sha1:" + hex.EncodeToString(sum[:])
+		retention = d.lockTTL
+	}
+	if retention < d.lockTTL {
+		retention = d.lockTTL
+	}
+
+	now := time.Now()
+	key := fmt.Sprintf("lora:as:downlink:reference:%s:%s", devEUI, reference)
+	ok, err := d.locks.SetNX(key, strconv.FormatInt(now.UnixNano(), 10), retention)
+	if err != nil {
+		return idempotencyNew, fmt.Errorf("store reference error: %s", err)
+	}
+	if ok {
+		return idempotencyNew, nil
+	}
+
+	v, err := d.locks.Get(key)
+	if err != nil {
+		if err == lockstore.ErrNotFound {
+			// the reference expired in the meantime, as it was just seen
+			// by an other instance it is safe to ignore it
+			return idempotencyCopy, nil
+		}
+		return idempotencyNew, fmt.Errorf("get reference error: %s", err)
+	}
+	firstSeen, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		return idempotencyNew, fmt.Errorf("parse reference error: %s", err)
+	}
+
+	if now.Sub(time.Unix(0, firstSeen)) < d.lockTTL {
+		return idempotencyCopy, nil
+	}
+
+	ok, err = d.locks.SetNX(key+":rejected", "", d.lockTTL)
+	if err != nil {
+		return idempotencyNew, fmt.Errorf("store rejection lock error: %s", err)
+	}
+	if !ok {
+		return idempotencyCopy, nil
+	}
+
+	return idempotencyDuplicate, nil
+}
@@ -0,0 +1,240 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/brocaar/lora-app-server/integration"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+// influxDBTimeout defines the timeout of the InfluxDB write requests.
+const influxDBTimeout = 10 * time.Second
+
+// Line protocol escaping of the measurement, the tag keys and values and the
+// field keys.
+var (
+	influxDBMeasurementEscaper = strings.NewReplacer(",", `\,`, " ", `\ `)
+	influxDBKeyEscaper         = strings.NewReplacer(",", `\,`, "=", `\=`, " ", `\ `)
+	influxDBStringEscaper      = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+)
+
+// InfluxDBHandler implements a handler writing the uplink data to the
+// InfluxDB bucket configured by the InfluxDB integration of the application.
+// For each uplink, a point containing the frame-counter, FPort and the
+// fields of the decoded payload is written to the configured measurement and
+// a point per receiving gateway containing the RSSI and SNR is written to
+// the measurement suffixed by _rx. Applications without InfluxDB integration
+// are ignored.
+type InfluxDBHandler struct {
+	integration.NopHandler
+
+	db     *sqlx.DB
+	client *http.Client
+}
+
+// NewInfluxDBHandler creates a new InfluxDBHandler.
+func NewInfluxDBHandler(db *sqlx.DB) *InfluxDBHandler {
+	return &InfluxDBHandler{
+		db:     db,
+		client: &http.Client{Timeout: influxDBTimeout},
+	}
+}
+
+// SendDataUp writes the given DataUpPayload.
+func (h *InfluxDBHandler) SendDataUp(appEUI, devEUI lorawan.EUI64, payload integration.DataUpPayload) error {
+	i, err := storage.GetInfluxDBIntegration(h.db, appEUI)
+	if err != nil {
+		return fmt.Errorf("handler/influxdb: %s", err)
+	}
+	if i == nil {
+		return nil
+	}
+
+	b, err := influxDBPoints(i.Measurement, appEUI, payload, time.Now())
+	if err != nil {
+		return fmt.Errorf("handler/influxdb: %s", err)
+	}
+
+	log.WithFields(log.Fields{
+		"endpoint": i.Endpoint,
+		"bucket":   i.Bucket,
+		"dev_eui":  devEUI,
+	}).Info("handler/influxdb: writing uplink data")
+	go func() {
+		if err := h.write(*i, b); err != nil {
+			log.WithFields(log.Fields{
+				"endpoint": i.Endpoint,
+				"bucket":   i.Bucket,
+				"dev_eui":  devEUI,
+			}).Errorf("handler/influxdb: write uplink data error: %s", err)
+		}
+	}()
+	return nil
+}
+
+// write writes the given points (line protocol) to the bucket of the given
+// integration using the InfluxDB (v2) write API.
+func (h *InfluxDBHandler) write(i storage.InfluxDBIntegration, b []byte) error {
+	v := url.Values{}
+	v.Set("org", i.Organization)
+	v.Set("bucket", i.Bucket)
+	v.Set("precision", "ns")
+
+	req, err := http.NewRequest("POST", strings.TrimRight(i.Endpoint, "/")+"/api/v2/write?"+v.Encode(), bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "text/plain; charset=utf-8")
+	if i.Token != "" {
+		req.Header.Set("Authorization", "Token "+i.Token)
+	}
+
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("expected 2xx response, got: %s", strings.TrimSpace(resp.Status))
+	}
+	return nil
+}
+
+// influxDBPoints returns the points (line protocol) for the given payload.
+// The timestamp of the first gateway providing one is used, else the given
+// fallback.
+func influxDBPoints(measurement string, appEUI lorawan.EUI64, pl integration.DataUpPayload, fallback time.Time) ([]byte, error) {
+	ts := fallback
+	for _, rxInfo := range pl.RXInfo {
+		if rxInfo.Time != nil {
+			ts = *rxInfo.Time
+			break
+		}
+	}
+
+	fields := map[string]interface{}{
+		"f_cnt":  int64(pl.FCnt),
+		"f_port": int64(pl.FPort),
+	}
+	if pl.Object != nil {
+		// encode and decode the object to get a generic representation
+		// of the decoded payload
+		b, err := json.Marshal(pl.Object)
+		if err != nil {
+			return nil, fmt.Errorf("marshal object error: %s", err)
+		}
+		var obj interface{}
+		if err := json.Unmarshal(b, &obj); err != nil {
+			return nil, fmt.Errorf("unmarshal object error: %s", err)
+		}
+		flattenInfluxDBFields(fields, "", obj)
+	}
+
+	var buf bytes.Buffer
+	writeInfluxDBPoint(&buf, measurement, map[string]string{
+		"app_eui": appEUI.String(),
+		"dev_eui": pl.DevEUI.String(),
+	}, fields, ts)
+
+	for _, rxInfo := range pl.RXInfo {
+		writeInfluxDBPoint(&buf, measurement+"_rx", map[string]string{
+			"app_eui":     appEUI.String(),
+			"dev_eui":     pl.DevEUI.String(),
+			"gateway_mac": rxInfo.MAC.String(),
+		}, map[string]interface{}{
+			"f_cnt":    int64(pl.FCnt),
+			"rssi":     int64(rxInfo.RSSI),
+			"lora_snr": rxInfo.LoRaSNR,
+		}, ts)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// flattenInfluxDBFields adds the values of the given (JSON decoded) object to
+// fields. Nested keys and array indices are joined by an underscore, nil
+// values are skipped.
+func flattenInfluxDBFields(fields map[string]interface{}, prefix string, v interface{}) {
+	key := func(k string) string {
+		if prefix == "" {
+			return k
+		}
+		return prefix + "_" + k
+	}
+
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, vv := range v {
+			flattenInfluxDBFields(fields, key(k), vv)
+		}
+	case []interface{}:
+		for i, vv := range v {
+			flattenInfluxDBFields(fields, key(strconv.Itoa(i)), vv)
+		}
+	case nil:
+	default:
+		if prefix == "" {
+			prefix = "value"
+		}
+		fields[prefix] = v
+	}
+}
+
+// writeInfluxDBPoint writes a single point in line protocol to buf. The tags
+// and fields are sorted by key.
+func writeInfluxDBPoint(buf *bytes.Buffer, measurement string, tags map[string]string, fields map[string]interface{}, ts time.Time) {
+	buf.WriteString(influxDBMeasurementEscaper.Replace(measurement))
+
+	var keys []string
+	for k := range tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		if tags[k] == "" {
+			continue
+		}
+		fmt.Fprintf(buf, ",%s=%s", influxDBKeyEscaper.Replace(k), influxDBKeyEscaper.Replace(tags[k]))
+	}
+
+	keys = keys[:0]
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for i, k := range keys {
+		if i == 0 {
+			buf.WriteByte(' ')
+		} else {
+			buf.WriteByte(',')
+		}
+		buf.WriteString(influxDBKeyEscaper.Replace(k))
+		buf.WriteByte('=')
+
+		switch v := fields[k].(type) {
+		case int64:
+			buf.WriteString(strconv.FormatInt(v, 10) + "i")
+		case float64:
+			buf.WriteString(strconv.FormatFloat(v, 'f', -1, 64))
+		case bool:
+			buf.WriteString(strconv.FormatBool(v))
+		default:
+			buf.WriteString(`"` + influxDBStringEscaper.Replace(fmt.Sprint(v)) + `"`)
+		}
+	}
+
+	fmt.Fprintf(buf, " %d\n", ts.UnixNano())
+}
@@ -0,0 +1,118 @@
+package handler
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/brocaar/lora-app-server/integration"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lora-app-server/internal/test"
+	"github.com/brocaar/lorawan"
+)
+
+func TestInfluxDBPoints(t *testing.T) {
+	Convey("Given a data-up payload with decoded object", t, func() {
+		now := time.Unix(0, 1480593600000000000)
+		appEUI := lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}
+		pl := integration.DataUpPayload{
+			DevEUI: lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1},
+			FCnt:   10,
+			FPort:  2,
+			RXInfo: []integration.RXInfo{
+				{MAC: lorawan.EUI64{1, 1, 1, 1, 1, 1, 1, 1}, RSSI: -60, LoRaSNR: 5.5},
+			},
+			Object: map[string]interface{}{
+				"temperature": 21.5,
+				"label":       `room "a"`,
+				"gps":         map[string]interface{}{"lat": 52.1},
+				"flags":       []interface{}{true},
+			},
+		}
+
+		Convey("Then influxDBPoints returns the expected line protocol", func() {
+			b, err := influxDBPoints("my uplink", appEUI, pl, now)
+			So(err, ShouldBeNil)
+			So(string(b), ShouldEqual, `my\ uplink,app_eui=0102030405060708,dev_eui=0807060504030201 f_cnt=10i,f_port=2i,flags_0=true,gps_lat=52.1,label="room \"a\"",temperature=21.5 1480593600000000000
+my\ uplink_rx,app_eui=0102030405060708,dev_eui=0807060504030201,gateway_mac=0101010101010101 f_cnt=10i,lora_snr=5.5,rssi=-60i 1480593600000000000
+`)
+		})
+	})
+}
+
+func TestInfluxDBHandler(t *testing.T) {
+	conf := test.GetConfig()
+
+	Convey("Given a clean database and a test InfluxDB server", t, func() {
+		db, err := storage.OpenDatabase(conf.PostgresDSN)
+		So(err, ShouldBeNil)
+		test.MustResetDB(db)
+
+		requests := make(chan *http.Request, 10)
+		bodies := make(chan []byte, 10)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			b, _ := ioutil.ReadAll(r.Body)
+			requests <- r
+			bodies <- b
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+
+		h := NewInfluxDBHandler(db)
+		appEUI := lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}
+		devEUI := lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1}
+
+		Convey("When sending a payload for an application without influxdb integration", func() {
+			So(h.SendDataUp(appEUI, devEUI, integration.DataUpPayload{DevEUI: devEUI}), ShouldBeNil)
+
+			Convey("Then no request was made", func() {
+				So(requests, ShouldHaveLength, 0)
+			})
+		})
+
+		Convey("Given an influxdb integration for the application", func() {
+			i := storage.InfluxDBIntegration{
+				AppEUI:       appEUI,
+				Endpoint:     server.URL,
+				Organization: "my-org",
+				Bucket:       "my-bucket",
+				Token:        "secret",
+				Measurement:  "uplink",
+			}
+			So(storage.CreateInfluxDBIntegration(db, i), ShouldBeNil)
+
+			Convey("Then GetInfluxDBIntegration returns the integration", func() {
+				i2, err := storage.GetInfluxDBIntegration(db, appEUI)
+				So(err, ShouldBeNil)
+				So(*i2, ShouldResemble, i)
+			})
+
+			Convey("When sending a data-up payload", func() {
+				So(h.SendDataUp(appEUI, devEUI, integration.DataUpPayload{DevEUI: devEUI, FCnt: 10}), ShouldBeNil)
+
+				Convey("Then the points were written to the bucket", func() {
+					req := <-requests
+					So(req.URL.Path, ShouldEqual, "/api/v2/write")
+					So(req.URL.Query().Get("org"), ShouldEqual, "my-org")
+					So(req.URL.Query().Get("bucket"), ShouldEqual, "my-bucket")
+					So(req.Header.Get("Authorization"), ShouldEqual, "Token secret")
+					So(string(<-bodies), ShouldStartWith, "uplink,app_eui=0102030405060708,dev_eui=0807060504030201 f_cnt=10i,f_port=0i ")
+				})
+			})
+
+			Convey("When deleting the integration", func() {
+				So(storage.DeleteInfluxDBIntegration(db, appEUI), ShouldBeNil)
+
+				Convey("Then GetInfluxDBIntegration returns nil", func() {
+					i2, err := storage.GetInfluxDBIntegration(db, appEUI)
+					So(err, ShouldBeNil)
+					So(i2, ShouldBeNil)
+				})
+			})
+		})
+	})
+}
@@ -0,0 +1,272 @@
+package handler
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/jmoiron/sqlx"
+	kafka "github.com/segmentio/kafka-go"
+
+	"github.com/brocaar/lora-app-server/integration"
+	"github.com/brocaar/lora-app-server/internal/lockstore"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+// KafkaPrincipal is the principal used for authorizing the downlink
+// payloads received over Kafka.
+const KafkaPrincipal = "kafka"
+
+// kafkaBatchTimeout defines the maximum time a published event is buffered
+// before it is sent to the Kafka brokers.
+const kafkaBatchTimeout = 10 * time.Millisecond
+
+// KafkaHandlerConfig holds the configuration of the KafkaHandler.
+type KafkaHandlerConfig struct {
+	// Brokers contains the addresses (host:port) of the Kafka brokers.
+	Brokers []string
+
+	// Topics maps the event types (see integration.EventDataUp etc.) to the
+	// topics the events are published to. Events without topic are not
+	// published.
+	Topics map[string]string
+
+	// TxTopic is the topic from which the downlink payloads are consumed.
+	// When empty, no downlink payloads are consumed.
+	TxTopic string
+
+	// ConsumerGroup is the consumer group used for consuming the downlink
+	// payloads.
+	ConsumerGroup string
+}
+
+// KafkaHandler implements a Kafka handler for sending and receiving data by
+// an application. Events are published using the DevEUI as message key, so
+// that the events of a node end up in the same partition.
+type KafkaHandler struct {
+	downlinkIntake
+
+	db      *sqlx.DB
+	wg      sync.WaitGroup
+	topics  map[string]string
+	writers map[string]*kafka.Writer
+	reader  *kafka.Reader
+}
+
+// NewKafkaHandler creates a new KafkaHandler. The database is used for
+// looking up the application of the node of the received downlink payloads,
+// the lock store for their idempotency and replay checks.
+func NewKafkaHandler(db *sqlx.DB, locks lockstore.Store, conf KafkaHandlerConfig) (*KafkaHandler, error) {
+	if len(conf.Brokers) == 0 {
+		return nil, fmt.Errorf("handler/kafka: at least one broker must be given")
+	}
+
+	h := KafkaHandler{
+		downlinkIntake: newDownlinkIntake("kafka", KafkaPrincipal, locks, 0),
+		db:             db,
+		topics:         make(map[string]string),
+		writers:        make(map[string]*kafka.Writer),
+	}
+	h.notifier = &h
+
+	for eventType, topic := range conf.Topics {
+		if topic == "" {
+			continue
+		}
+		h.topics[eventType] = topic
+		if _, ok := h.writers[topic]; ok {
+			continue
+		}
+		h.writers[topic] = kafka.NewWriter(kafka.WriterConfig{
+			Brokers:      conf.Brokers,
+			Topic:        topic,
+			Balancer:     &kafka.Hash{},
+			BatchTimeout: kafkaBatchTimeout,
+		})
+	}
+
+	if conf.TxTopic != "" {
+		if conf.ConsumerGroup == "" {
+			return nil, fmt.Errorf("handler/kafka: consumer group must be given")
+		}
+
+		log.WithFields(log.Fields{
+			"brokers":        conf.Brokers,
+			"topic":          conf.TxTopic,
+			"consumer_group": conf.ConsumerGroup,
+		}).Info("handler/kafka: consuming tx topic")
+		h.reader = kafka.NewReader(kafka.ReaderConfig{
+			Brokers: conf.Brokers,
+			GroupID: conf.ConsumerGroup,
+			Topic:   conf.TxTopic,
+		})
+		go h.consumeTxPayloads()
+	}
+
+	return &h, nil
+}
+
+// Close stops the handler.
+func (h *KafkaHandler) Close() error {
+	log.Info("handler/kafka: closing handler")
+	if h.reader != nil {
+		if err := h.reader.Close(); err != nil {
+			return fmt.Errorf("handler/kafka: close reader error: %s", err)
+		}
+	}
+	log.Info("handler/kafka: handling last items in queue")
+	h.wg.Wait()
+	for topic, w := range h.writers {
+		if err := w.Close(); err != nil {
+			log.WithField("topic", topic).Errorf("handler/kafka: close writer error: %s", err)
+		}
+	}
+	close(h.dataDownChan)
+	return nil
+}
+
+// SendDataUp sends a DataUpPayload.
+func (h *KafkaHandler) SendDataUp(appEUI, devEUI lorawan.EUI64, payload integration.DataUpPayload) error {
+	return h.publish(appEUI, devEUI, payload)
+}
+
+// SendJoinNotification sends a JoinNotification.
+func (h *KafkaHandler) SendJoinNotification(appEUI, devEUI lorawan.EUI64, payload integration.JoinNotification) error {
+	return h.publish(appEUI, devEUI, payload)
+}
+
+// SendACKNotification sends an ACKNotification.
+func (h *KafkaHandler) SendACKNotification(appEUI, devEUI lorawan.EUI64, payload integration.ACKNotification) error {
+	return h.publish(appEUI, devEUI, payload)
+}
+
+// SendErrorNotification sends an ErrorNotification.
+func (h *KafkaHandler) SendErrorNotification(appEUI, devEUI lorawan.EUI64, payload integration.ErrorNotification) error {
+	return h.publish(appEUI, devEUI, payload)
+}
+
+// SendLinkQualityNotification sends a LinkQualityNotification.
+func (h *KafkaHandler) SendLinkQualityNotification(appEUI, devEUI lorawan.EUI64, payload integration.LinkQualityNotification) error {
+	return h.publish(appEUI, devEUI, payload)
+}
+
+// SendLifecycleNotification sends a LifecycleNotification.
+func (h *KafkaHandler) SendLifecycleNotification(appEUI, devEUI lorawan.EUI64, payload integration.LifecycleNotification) error {
+	return h.publish(appEUI, devEUI, payload)
+}
+
+// SendFirmwareNotification sends a FirmwareNotification.
+func (h *KafkaHandler) SendFirmwareNotification(appEUI, devEUI lorawan.EUI64, payload integration.FirmwareNotification) error {
+	return h.publish(appEUI, devEUI, payload)
+}
+
+// SendDeviceStatus sends a DeviceStatus.
+func (h *KafkaHandler) SendDeviceStatus(appEUI, devEUI lorawan.EUI64, payload integration.DeviceStatus) error {
+	return h.publish(appEUI, devEUI, payload)
+}
+
+// SendLocationNotification sends a LocationNotification.
+func (h *KafkaHandler) SendLocationNotification(appEUI, devEUI lorawan.EUI64, payload integration.LocationNotification) error {
+	return h.publish(appEUI, devEUI, payload)
+}
+
+// SendScheduledDownlinkNotification sends a ScheduledDownlinkNotification.
+func (h *KafkaHandler) SendScheduledDownlinkNotification(appEUI, devEUI lorawan.EUI64, payload integration.ScheduledDownlinkNotification) error {
+	return h.publish(appEUI, devEUI, payload)
+}
+
+// publish publishes the given payload to the topic configured for its
+// event type, using the DevEUI as key. The event type and AppEUI are added
+// as message headers.
+func (h *KafkaHandler) publish(appEUI, devEUI lorawan.EUI64, payload interface{}) error {
+	eventType, b, err := marshaler.MarshalEvent(payload)
+	if err != nil {
+		return fmt.Errorf("handler/kafka: %s", err)
+	}
+	topic, ok := h.topics[eventType]
+	if !ok {
+		return nil
+	}
+
+	start := time.Now()
+	defer func() {
+		observePublish("kafka", eventType, appEUI, start, err)
+	}()
+
+	log.WithFields(log.Fields{
+		"topic":   topic,
+		"type":    eventType,
+		"dev_eui": devEUI,
+	}).Info("handler/kafka: publishing event")
+	err = h.writers[topic].WriteMessages(context.Background(), kafka.Message{
+		Key:   []byte(devEUI.String()),
+		Value: b,
+		Headers: []kafka.Header{
+			{Key: "event", Value: []byte(eventType)},
+			{Key: "appEUI", Value: []byte(appEUI.String())},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("handler/kafka: publish %s event error: %s", eventType, err)
+	}
+	return nil
+}
+
+// consumeTxPayloads consumes the downlink payloads from the tx topic until
+// the reader is closed. The offset of a message is committed after it has
+// been handled.
+func (h *KafkaHandler) consumeTxPayloads() {
+	for {
+		msg, err := h.reader.FetchMessage(context.Background())
+		if err != nil {
+			if err == io.EOF {
+				return
+			}
+			log.Errorf("handler/kafka: fetch message error: %s", err)
+			time.Sleep(time.Second)
+			continue
+		}
+
+		h.wg.Add(1)
+		h.txPayloadHandler(msg)
+		if err := h.reader.CommitMessages(context.Background(), msg); err != nil {
+			log.WithFields(log.Fields{
+				"partition": msg.Partition,
+				"offset":    msg.Offset,
+			}).Errorf("handler/kafka: commit message error: %s", err)
+		}
+		h.wg.Done()
+	}
+}
+
+func (h *KafkaHandler) txPayloadHandler(msg kafka.Message) {
+	log.WithFields(log.Fields{
+		"topic":     msg.Topic,
+		"partition": msg.Partition,
+		"offset":    msg.Offset,
+	}).Info("handler/kafka: data-down payload received")
+	dataDownReceived.WithLabelValues("kafka").Inc()
+
+	pl, err := marshaler.UnmarshalDataDown(msg.Value)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"data_base64": base64.StdEncoding.EncodeToString(msg.Value),
+		}).Errorf("handler/kafka: %s", err)
+		observeRejectedDataDown("kafka", rejectReasonUnmarshal)
+		return
+	}
+
+	node, err := storage.GetNode(h.db, pl.DevEUI)
+	if err != nil {
+		log.WithField("dev_eui", pl.DevEUI).Errorf("handler/kafka: get node error: %s", err)
+		observeRejectedDataDown("kafka", rejectReasonUnknownNode)
+		return
+	}
+
+	h.handleDataDown(node.AppEUI, pl, msg.Value)
+}
@@ -0,0 +1,80 @@
+package handler
+
+import (
+	"encoding/json"
+	"testing"
+
+	kafka "github.com/segmentio/kafka-go"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/brocaar/lora-app-server/integration"
+	"github.com/brocaar/lora-app-server/internal/lockstore"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lora-app-server/internal/test"
+	"github.com/brocaar/lorawan"
+)
+
+func TestKafkaHandler(t *testing.T) {
+	Convey("Testing NewKafkaHandler", t, func() {
+		Convey("Then an error is returned when no brokers are given", func() {
+			_, err := NewKafkaHandler(nil, nil, KafkaHandlerConfig{})
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("Then an error is returned when a tx topic is given without consumer group", func() {
+			_, err := NewKafkaHandler(nil, nil, KafkaHandlerConfig{
+				Brokers: []string{"localhost:9092"},
+				TxTopic: "application.tx",
+			})
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("Given a KafkaHandler with only a topic for uplink data", func() {
+			h, err := NewKafkaHandler(nil, nil, KafkaHandlerConfig{
+				Brokers: []string{"localhost:9092"},
+				Topics: map[string]string{
+					integration.EventDataUp: "application.rx",
+					integration.EventJoin:   "",
+				},
+			})
+			So(err, ShouldBeNil)
+			defer h.Close()
+
+			Convey("Then only one writer has been created", func() {
+				So(h.writers, ShouldHaveLength, 1)
+				So(h.writers, ShouldContainKey, "application.rx")
+			})
+
+			Convey("Then events without topic are not published", func() {
+				devEUI := lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}
+				So(h.SendJoinNotification(lorawan.EUI64{}, devEUI, integration.JoinNotification{DevEUI: devEUI}), ShouldBeNil)
+			})
+		})
+
+		Convey("Given a KafkaHandler with a lock store and a node", func() {
+			conf := test.GetConfig()
+			db, err := storage.OpenDatabase(conf.PostgresDSN)
+			So(err, ShouldBeNil)
+			test.MustResetDB(db)
+			p := storage.NewRedisPool(conf.RedisURL)
+			test.MustFlushRedis(p)
+
+			node := storage.Node{
+				AppEUI: lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+				DevEUI: lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1},
+			}
+			So(storage.CreateNode(db, node), ShouldBeNil)
+
+			h, err := NewKafkaHandler(db, lockstore.NewRedisStore(p), KafkaHandlerConfig{
+				Brokers: []string{"localhost:9092"},
+			})
+			So(err, ShouldBeNil)
+
+			testDownlinkIntake(&h.downlinkIntake, node.DevEUI, func(pl integration.DataDownPayload) {
+				b, err := json.Marshal(pl)
+				So(err, ShouldBeNil)
+				h.txPayloadHandler(kafka.Message{Topic: "application.tx", Value: b})
+			})
+		})
+	})
+}
@@ -0,0 +1,155 @@
+package handler
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/brocaar/lorawan"
+)
+
+// Reasons used for the rejected downlink payloads metric.
+const (
+	rejectReasonInvalidTopic   = "invalid_topic"
+	rejectReasonUnmarshal      = "unmarshal"
+	rejectReasonDevEUIMismatch = "dev_eui_mismatch"
+	rejectReasonLocked         = "lock_contention"
+	rejectReasonRedis          = "redis_error"
+	rejectReasonUnknownNode    = "unknown_node"
+)
+
+// rejectReasons maps the error types of the rejected downlink payloads to
+// the reasons used for the rejected downlink payloads metric.
+var rejectReasons = map[string]string{
+	errorTypeDataDownUnauthorized: "unauthorized",
+	errorTypeDataDownReplay:       "replay",
+	errorTypeDataDownEnqueue:      "enqueue_error",
+	errorTypeDataDownDuplicate:    "duplicate",
+	errorTypeDataDownQuota:        "quota",
+	errorTypeDataDownOverflow:     "overflow",
+	errorTypeRateLimitExceeded:    "rate_limit",
+}
+
+var (
+	eventsPublished = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "lora_app_server",
+		Subsystem: "handler",
+		Name:      "events_published_total",
+		Help:      "Number of published events (per handler, event type and application).",
+	}, []string{"handler", "event", "app_eui"})
+
+	publishErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "lora_app_server",
+		Subsystem: "handler",
+		Name:      "publish_errors_total",
+		Help:      "Number of events that could not be published (per handler and event type).",
+	}, []string{"handler", "event"})
+
+	publishDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "lora_app_server",
+		Subsystem: "handler",
+		Name:      "publish_duration_seconds",
+		Help:      "Duration of publishing an event (per handler and event type).",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"handler", "event"})
+
+	dataDownReceived = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "lora_app_server",
+		Subsystem: "handler",
+		Name:      "data_down_received_total",
+		Help:      "Number of received downlink payloads (per handler).",
+	}, []string{"handler"})
+
+	dataDownRejected = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "lora_app_server",
+		Subsystem: "handler",
+		Name:      "data_down_rejected_total",
+		Help:      "Number of rejected or ignored downlink payloads (per handler and reason).",
+	}, []string{"handler", "reason"})
+
+	deliveryRetries = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "lora_app_server",
+		Subsystem: "handler",
+		Name:      "delivery_retries_total",
+		Help:      "Number of retried event deliveries (per handler).",
+	}, []string{"handler"})
+
+	deadLetters = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "lora_app_server",
+		Subsystem: "handler",
+		Name:      "dead_letters_total",
+		Help:      "Number of events that could not be delivered after all retries (per handler).",
+	}, []string{"handler"})
+
+	backendBufferOverflows = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "lora_app_server",
+		Subsystem: "handler",
+		Name:      "backend_buffer_overflows_total",
+		Help:      "Number of events dropped because the buffer of the handler backend was full (per handler).",
+	}, []string{"handler"})
+
+	retryQueueOverflows = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "lora_app_server",
+		Subsystem: "handler",
+		Name:      "retry_queue_overflows_total",
+		Help:      "Number of events not delivered in the background because the max. number of pending deliveries was reached (per handler).",
+	}, []string{"handler"})
+
+	eventsFiltered = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "lora_app_server",
+		Subsystem: "handler",
+		Name:      "events_filtered_total",
+		Help:      "Number of events not published because of the event filters of the application (per integration and event type).",
+	}, []string{"integration", "event"})
+
+	mqttConnects = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "lora_app_server",
+		Subsystem: "handler",
+		Name:      "mqtt_connects_total",
+		Help:      "Number of (re)connects to the MQTT broker.",
+	})
+
+	mqttConnectionLost = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "lora_app_server",
+		Subsystem: "handler",
+		Name:      "mqtt_connection_lost_total",
+		Help:      "Number of times the connection to the MQTT broker was lost.",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(
+		eventsPublished,
+		publishErrors,
+		publishDuration,
+		dataDownReceived,
+		dataDownRejected,
+		deliveryRetries,
+		deadLetters,
+		backendBufferOverflows,
+		retryQueueOverflows,
+		eventsFiltered,
+		mqttConnects,
+		mqttConnectionLost,
+	)
+}
+
+// observePublish records the metrics of publishing an event of the given
+// type, which started at the given time and ended with the given error.
+func observePublish(handler, eventType string, appEUI lorawan.EUI64, start time.Time, err error) {
+	publishDuration.WithLabelValues(handler, eventType).Observe(time.Since(start).Seconds())
+	if err != nil {
+		publishErrors.WithLabelValues(handler, eventType).Inc()
+		return
+	}
+	eventsPublished.WithLabelValues(handler, eventType, appEUI.String()).Inc()
+}
+
+// observeRejectedDataDown increments the rejected downlink payloads metric
+// for the given error type (see rejectReasons) or reason.
+func observeRejectedDataDown(handler, reason string) {
+	if r, ok := rejectReasons[reason]; ok {
+		reason = r
+	}
+	dataDownRejected.WithLabelValues(handler, reason).Inc()
+}
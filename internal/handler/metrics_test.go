@@ -0,0 +1,52 @@
+package handler
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	dto "github.com/prometheus/client_model/go"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/brocaar/lorawan"
+)
+
+func TestMetrics(t *testing.T) {
+	Convey("Testing the handler metrics", t, func() {
+		appEUI := lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}
+		counterValue := func(c interface {
+			Write(*dto.Metric) error
+		}) float64 {
+			var m dto.Metric
+			So(c.Write(&m), ShouldBeNil)
+			return m.GetCounter().GetValue()
+		}
+
+		Convey("When observing a successful publish", func() {
+			before := counterValue(eventsPublished.WithLabelValues("test", "rx", appEUI.String()))
+			observePublish("test", "rx", appEUI, time.Now(), nil)
+
+			Convey("Then the published events counter was incremented", func() {
+				So(counterValue(eventsPublished.WithLabelValues("test", "rx", appEUI.String())), ShouldEqual, before+1)
+			})
+		})
+
+		Convey("When observing a failed publish", func() {
+			before := counterValue(publishErrors.WithLabelValues("test", "rx"))
+			observePublish("test", "rx", appEUI, time.Now(), errors.New("boom"))
+
+			Convey("Then the publish errors counter was incremented", func() {
+				So(counterValue(publishErrors.WithLabelValues("test", "rx")), ShouldEqual, before+1)
+			})
+		})
+
+		Convey("When observing a rejected payload by error type", func() {
+			before := counterValue(dataDownRejected.WithLabelValues("test", "unauthorized"))
+			observeRejectedDataDown("test", errorTypeDataDownUnauthorized)
+
+			Convey("Then the error type was mapped to its reason", func() {
+				So(counterValue(dataDownRejected.WithLabelValues("test", "unauthorized")), ShouldEqual, before+1)
+			})
+		})
+	})
+}
@@ -1,256 +1,662 @@
 package handler
 
 import (
-	"bytes"
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/base64"
-	"encoding/json"
+	"errors"
 	"fmt"
-	"regexp"
+	"io/ioutil"
 	"sync"
 	"time"
 
 	log "github.com/Sirupsen/logrus"
 
+	"github.com/brocaar/lora-app-server/integration"
+	"github.com/brocaar/lora-app-server/internal/lockstore"
+	"github.com/brocaar/lora-app-server/internal/storage"
 	"github.com/brocaar/lorawan"
 	mqtt "github.com/eclipse/paho.mqtt.golang"
-	"github.com/garyburd/redigo/redis"
+	"github.com/jmoiron/sqlx"
 )
 
-const txTopic = "application/+/node/+/tx"
-const downlinkLockTTL = time.Millisecond * 100
+// MQTTPrincipal is the principal used for authorizing the downlink payloads
+// received over MQTT.
+const MQTTPrincipal = "mqtt"
 
-var txTopicRegex = regexp.MustCompile(`application/(\w+)/node/(\w+)/tx`)
+// mqttDisconnectQuiesce defines the time (in ms) to wait for the pending
+// publishes to complete when disconnecting from the broker.
+const mqttDisconnectQuiesce = 1000
 
-// DataRate contains the data-rate related fields.
-type DataRate struct {
-	Modulation   string `json:"modulation"`
-	Bandwidth    int    `json:"bandwidth"`
-	SpreadFactor int    `json:"spreadFactor,omitempty"`
-	Bitrate      int    `json:"bitrate,omitempty"`
-}
-
-// RXInfo contains the RX information.
-type RXInfo struct {
-	MAC     lorawan.EUI64 `json:"mac"`
-	Time    *time.Time    `json:"time,omitempty"`
-	RSSI    int           `json:"rssi"`
-	LoRaSNR float64       `json:"loRaSNR"`
-}
-
-// TXInfo contains the TX information.
-type TXInfo struct {
-	Frequency int      `json:"frequency"`
-	DataRate  DataRate `json:"dataRate"`
-	ADR       bool     `json:"adr"`
-	CodeRate  string   `json:"codeRate"`
-}
-
-// DataUpPayload represents a data-up payload.
-type DataUpPayload struct {
-	DevEUI lorawan.EUI64 `json:"devEUI"`
-	RXInfo []RXInfo      `json:"rxInfo"`
-	TXInfo TXInfo        `json:"txInfo"`
-	FCnt   uint32        `json:"fCnt"`
-	FPort  uint8         `json:"fPort"`
-	Data   []byte        `json:"data"`
-}
+// errorTypeDataDownOverflow is the error type used for error notifications
+// when a downlink payload is dropped because the tx buffer is full.
+const errorTypeDataDownOverflow = "DATA_DOWN_OVERFLOW"
 
-// DataDownPayload represents a data-down payload.
-type DataDownPayload struct {
-	Reference string        `json:"reference"`
-	Confirmed bool          `json:"confirmed"`
-	DevEUI    lorawan.EUI64 `json:"devEUI"`
-	FPort     uint8         `json:"fPort"`
-	Data      []byte        `json:"data"`
-}
+// mqttOverflowBatchSize defines the max. number of stored overflow payloads
+// handled by the persister within a single transaction.
+const mqttOverflowBatchSize = 100
 
-// JoinNotification defines the payload sent to the application on
-// a JoinNotificationType event.
-type JoinNotification struct {
-	DevAddr lorawan.DevAddr `json:"devAddr"`
-	DevEUI  lorawan.EUI64   `json:"devEUI"`
-}
+// mqttOverflowPollInterval defines the interval in which the persister
+// checks for stored overflow payloads (e.g. stored by an other instance).
+var mqttOverflowPollInterval = 10 * time.Second
 
 // MQTTHandler implements a MQTT handler for sending and receiving data by
 // an application.
 type MQTTHandler struct {
-	conn         mqtt.Client
-	dataDownChan chan DataDownPayload
-	wg           sync.WaitGroup
-	redisPool    *redis.Pool
+	downlinkIntake
+
+	conn        mqtt.Client
+	topics      *mqttTopics
+	options     MQTTOptions
+	txChan      chan mqtt.Message
+	dropChan    chan mqtt.Message
+	overflowDB  *sqlx.DB
+	persistChan chan struct{}
+	stopChan    chan struct{}
+	txMux       sync.RWMutex
+	closed      bool
+	wg          sync.WaitGroup
+	signer      EventSigner
+	detachedJWS bool
+	retainLast  bool
 }
 
-// ACKNotification defines the payload sent to the application
-// on an ACK event.
-type ACKNotification struct {
-	Reference string        `json:"reference"`
-	DevEUI    lorawan.EUI64 `json:"devEUI"`
-}
-
-// ErrorNotification defines the payload sent to the application
-// on an error event.
-type ErrorNotification struct {
-	DevEUI lorawan.EUI64 `json:"devEUI"`
-	Type   string        `json:"type"`
-	Error  string        `json:"error"`
-}
+// NewMQTTHandler creates a new MQTTHandler. The given lock store is shared
+// by the instances for the idempotency and replay checks of the downlink
+// payloads. The given TLS configuration (optional) is used when connecting
+// to a ssl:// (or tls://, tcps:// and wss://) server. The topics are
+// generated from the given topic templates (see DefaultMQTTTopicTemplates),
+// the client, session and delivery options are set by the given options
+// (see DefaultMQTTOptions).
+func NewMQTTHandler(locks lockstore.Store, server, username, password string, tlsConfig *tls.Config, topicTemplates MQTTTopicTemplates, options MQTTOptions) (*MQTTHandler, error) {
+	topics, err := newMQTTTopics(topicTemplates)
+	if err != nil {
+		return nil, err
+	}
+	if err := options.validate(); err != nil {
+		return nil, err
+	}
 
-// NewMQTTHandler creates a new MQTTHandler.
-func NewMQTTHandler(p *redis.Pool, server, username, password string) (Handler, error) {
 	h := MQTTHandler{
-		dataDownChan: make(chan DataDownPayload),
-		redisPool:    p,
+		downlinkIntake: newDownlinkIntake("mqtt", MQTTPrincipal, locks, options.TXBufferSize),
+		topics:         topics,
+		options:        options,
+		txChan:         make(chan mqtt.Message, options.TXBufferSize),
+		dropChan:       make(chan mqtt.Message, options.TXBufferSize),
+		persistChan:    make(chan struct{}, 1),
+		stopChan:       make(chan struct{}),
 	}
+	h.notifier = &h
 
 	opts := mqtt.NewClientOptions()
 	opts.AddBroker(server)
 	opts.SetUsername(username)
 	opts.SetPassword(password)
+	opts.SetClientID(options.ClientID)
+	opts.SetCleanSession(options.CleanSession)
+	opts.SetMaxReconnectInterval(options.MaxReconnectInterval)
+	if tlsConfig != nil {
+		opts.SetTLSConfig(tlsConfig)
+	}
 	opts.SetOnConnectHandler(h.onConnected)
 	opts.SetConnectionLostHandler(h.onConnectionLost)
 
+	// the received downlink payloads are handled by a pool of workers, so
+	// that the MQTT client is not blocked while handling a burst
+	for i := 0; i < options.TXWorkers; i++ {
+		h.wg.Add(1)
+		go h.txWorker()
+	}
+
+	// the error notifications of the dropped payloads are published by a
+	// separate notifier, so that the MQTT client is not blocked
+	h.wg.Add(1)
+	go h.dropNotifier()
+
 	log.WithField("server", server).Info("handler/mqtt: connecting to mqtt broker")
 	h.conn = mqtt.NewClient(opts)
 	if token := h.conn.Connect(); token.Wait() && token.Error() != nil {
+		h.closeTXChans()
 		return nil, fmt.Errorf("handler/mqtt: connecting to broker error: %s", token.Error())
 	}
 	return &h, nil
 }
 
-// Close stops the handler.
+// NewTLSConfig returns the TLS configuration for connecting to the MQTT
+// broker. The CA certificate (optional) is used for verifying the server
+// certificate, the client certificate and key (optional) for client
+// certificate authentication. When insecureSkipVerify is true, the server
+// certificate is not verified.
+func NewTLSConfig(caCert, tlsCert, tlsKey string, insecureSkipVerify bool) (*tls.Config, error) {
+	tlsConfig := tls.Config{
+		InsecureSkipVerify: insecureSkipVerify,
+	}
+
+	if caCert != "" {
+		rawCACert, err := ioutil.ReadFile(caCert)
+		if err != nil {
+			return nil, fmt.Errorf("handler/mqtt: load ca cert error: %s", err)
+		}
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(rawCACert) {
+			return nil, fmt.Errorf("handler/mqtt: no certificates found in %s", caCert)
+		}
+	}
+
+	if tlsCert != "" || tlsKey != "" {
+		cert, err := tls.LoadX509KeyPair(tlsCert, tlsKey)
+		if err != nil {
+			return nil, fmt.Errorf("handler/mqtt: load key-pair error: %s", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return &tlsConfig, nil
+}
+
+// SetOverflowDB sets the database in which the downlink payloads received
+// while the tx buffer is full are stored, when the overflow behavior is
+// MQTTOverflowPersist, and starts the persister handling these payloads.
+// As the stored payloads are shared by all instances, the persister also
+// handles the payloads stored by the other instances.
+func (h *MQTTHandler) SetOverflowDB(db *sqlx.DB) {
+	if h.options.TXOverflow != MQTTOverflowPersist {
+		return
+	}
+	h.overflowDB = db
+	h.wg.Add(1)
+	go h.persister()
+}
+
+// SetEventSigner sets the signer used for signing the published payloads.
+// When detached is false, the JWS (containing the payload) is published
+// instead of the plain payload. When detached is true, the payload is
+// published as-is and the detached JWS is published to the same topic
+// suffixed by /jws.
+func (h *MQTTHandler) SetEventSigner(signer EventSigner, detached bool) {
+	h.signer = signer
+	h.detachedJWS = detached
+}
+
+// SetRetainLastUplink configures the publishing of the retained last
+// uplink and device status. When enabled, each data-up payload is also
+// published as retained message to the rx/last topic and the device status
+// derived from it as retained message to the status topic, so that (new)
+// subscribers immediately receive the last known state of a node.
+func (h *MQTTHandler) SetRetainLastUplink(enabled bool) {
+	h.retainLast = enabled
+}
+
+// Close stops the handler. It unsubscribes from the tx topic, handles the
+// buffered downlink payloads and disconnects from the broker.
 func (h *MQTTHandler) Close() error {
 	log.Info("handler/mqtt: closing handler")
-	log.WithField("topic", txTopic).Info("handler/mqtt: unsubscribing from tx topic")
-	if token := h.conn.Unsubscribe(txTopic); token.Wait() && token.Error() != nil {
-		return fmt.Errorf("handler/mqtt: unsubscribe from %s error: %s", txTopic, token.Error())
+	log.WithField("topic", h.topics.txFilter).Info("handler/mqtt: unsubscribing from tx topic")
+	if token := h.conn.Unsubscribe(h.topics.txFilter); token.Wait() && token.Error() != nil {
+		return fmt.Errorf("handler/mqtt: unsubscribe from %s error: %s", h.topics.txFilter, token.Error())
 	}
 	log.Info("handler/mqtt: handling last items in queue")
+	h.txMux.Lock()
+	h.closed = true
+	h.closeTXChans()
+	h.txMux.Unlock()
 	h.wg.Wait()
 	close(h.dataDownChan)
+
+	// give the pending publishes some time to complete
+	log.Info("handler/mqtt: disconnecting from mqtt broker")
+	h.conn.Disconnect(mqttDisconnectQuiesce)
 	return nil
 }
 
 // SendDataUp sends a DataUpPayload.
-func (h *MQTTHandler) SendDataUp(appEUI, devEUI lorawan.EUI64, payload DataUpPayload) error {
-	b, err := json.Marshal(payload)
+func (h *MQTTHandler) SendDataUp(appEUI, devEUI lorawan.EUI64, payload integration.DataUpPayload) error {
+	b, err := marshaler.Marshal(payload)
 	if err != nil {
 		return fmt.Errorf("handler/mqtt: data-up payload marshal error: %s", err)
 	}
 
-	topic := fmt.Sprintf("application/%s/node/%s/rx", appEUI, devEUI)
+	topic, err := h.topics.topic(integration.EventDataUp, appEUI.String(), devEUI.String())
+	if err != nil {
+		return err
+	}
 	log.WithField("topic", topic).Info("handler/mqtt: publishing data-up payload")
-	if token := h.conn.Publish(topic, 0, false, b); token.Wait() && token.Error() != nil {
+	if err := h.publish(appEUI, integration.EventDataUp, topic, false, b); err != nil {
 		return fmt.Errorf("handler/mqtt: publish data-up payload error: %s", err)
 	}
-	return nil
+
+	if !h.retainLast {
+		return nil
+	}
+
+	topic += "/last"
+	log.WithField("topic", topic).Info("handler/mqtt: publishing retained data-up payload")
+	if err := h.publish(appEUI, integration.EventDataUp, topic, true, b); err != nil {
+		return fmt.Errorf("handler/mqtt: publish retained data-up payload error: %s", err)
+	}
+
+	return h.SendDeviceStatus(appEUI, devEUI, newDeviceStatus(payload, time.Now()))
 }
 
 // SendJoinNotification sends a JoinNotification.
-func (h *MQTTHandler) SendJoinNotification(appEUI, devEUI lorawan.EUI64, payload JoinNotification) error {
-	b, err := json.Marshal(payload)
+func (h *MQTTHandler) SendJoinNotification(appEUI, devEUI lorawan.EUI64, payload integration.JoinNotification) error {
+	b, err := marshaler.Marshal(payload)
 	if err != nil {
 		return fmt.Errorf("handler/mqtt: join notification marshal error: %s", err)
 	}
-	topic := fmt.Sprintf("application/%s/node/%s/join", appEUI, devEUI)
+	topic, err := h.topics.topic(integration.EventJoin, appEUI.String(), devEUI.String())
+	if err != nil {
+		return err
+	}
 	log.WithField("topic", topic).Info("handler/mqtt: publishing join notification")
-	if token := h.conn.Publish(topic, 0, false, b); token.Wait() && token.Error() != nil {
+	if err := h.publish(appEUI, integration.EventJoin, topic, false, b); err != nil {
 		return fmt.Errorf("handler/mqtt: publish join notification error: %s", err)
 	}
 	return nil
 }
 
 // SendACKNotification sends an ACKNotification.
-func (h *MQTTHandler) SendACKNotification(appEUI, devEUI lorawan.EUI64, payload ACKNotification) error {
-	b, err := json.Marshal(payload)
+func (h *MQTTHandler) SendACKNotification(appEUI, devEUI lorawan.EUI64, payload integration.ACKNotification) error {
+	b, err := marshaler.Marshal(payload)
 	if err != nil {
 		return fmt.Errorf("handler/mqtt: ack notification marshal error: %s", err)
 	}
-	topic := fmt.Sprintf("application/%s/node/%s/ack", appEUI, devEUI)
+	topic, err := h.topics.topic(integration.EventACK, appEUI.String(), devEUI.String())
+	if err != nil {
+		return err
+	}
 	log.WithField("topic", topic).Info("handler/mqtt: publishing ack notification")
-	if token := h.conn.Publish(topic, 0, false, b); token.Wait() && token.Error() != nil {
+	if err := h.publish(appEUI, integration.EventACK, topic, false, b); err != nil {
 		return fmt.Errorf("handler/mqtt: publish ack notification error: %s", err)
 	}
 	return nil
 }
 
 // SendErrorNotification sends an ErrorNotification.
-func (h *MQTTHandler) SendErrorNotification(appEUI, devEUI lorawan.EUI64, payload ErrorNotification) error {
-	b, err := json.Marshal(payload)
+func (h *MQTTHandler) SendErrorNotification(appEUI, devEUI lorawan.EUI64, payload integration.ErrorNotification) error {
+	b, err := marshaler.Marshal(payload)
 	if err != nil {
 		return fmt.Errorf("handler/mqtt: error notification marshal error: %s", err)
 	}
-	topic := fmt.Sprintf("application/%s/node/%s/error", appEUI, devEUI)
+	topic, err := h.topics.topic(integration.EventError, appEUI.String(), devEUI.String())
+	if err != nil {
+		return err
+	}
 	log.WithField("topic", topic).Info("handler/mqtt: publishing error notification")
-	if token := h.conn.Publish(topic, 0, false, b); token.Wait() && token.Error() != nil {
+	if err := h.publish(appEUI, integration.EventError, topic, false, b); err != nil {
 		return fmt.Errorf("handler/mqtt: publish error notification error: %s", err)
 	}
 	return nil
 }
 
-// DataDownChan returns the channel containing the received DataDownPayload.
-func (h *MQTTHandler) DataDownChan() chan DataDownPayload {
-	return h.dataDownChan
+// SendLinkQualityNotification sends a LinkQualityNotification.
+func (h *MQTTHandler) SendLinkQualityNotification(appEUI, devEUI lorawan.EUI64, payload integration.LinkQualityNotification) error {
+	b, err := marshaler.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("handler/mqtt: link-quality notification marshal error: %s", err)
+	}
+	topic, err := h.topics.topic(integration.EventLinkQuality, appEUI.String(), devEUI.String())
+	if err != nil {
+		return err
+	}
+	log.WithField("topic", topic).Info("handler/mqtt: publishing link-quality notification")
+	if err := h.publish(appEUI, integration.EventLinkQuality, topic, false, b); err != nil {
+		return fmt.Errorf("handler/mqtt: publish link-quality notification error: %s", err)
+	}
+	return nil
+}
+
+// SendLifecycleNotification sends a LifecycleNotification.
+func (h *MQTTHandler) SendLifecycleNotification(appEUI, devEUI lorawan.EUI64, payload integration.LifecycleNotification) error {
+	b, err := marshaler.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("handler/mqtt: lifecycle notification marshal error: %s", err)
+	}
+	topic, err := h.topics.topic(integration.EventLifecycle, appEUI.String(), devEUI.String())
+	if err != nil {
+		return err
+	}
+	log.WithField("topic", topic).Info("handler/mqtt: publishing lifecycle notification")
+	if err := h.publish(appEUI, integration.EventLifecycle, topic, false, b); err != nil {
+		return fmt.Errorf("handler/mqtt: publish lifecycle notification error: %s", err)
+	}
+	return nil
+}
+
+// SendFirmwareNotification sends a FirmwareNotification.
+func (h *MQTTHandler) SendFirmwareNotification(appEUI, devEUI lorawan.EUI64, payload integration.FirmwareNotification) error {
+	b, err := marshaler.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("handler/mqtt: firmware notification marshal error: %s", err)
+	}
+	topic, err := h.topics.topic(integration.EventFirmware, appEUI.String(), devEUI.String())
+	if err != nil {
+		return err
+	}
+	log.WithField("topic", topic).Info("handler/mqtt: publishing firmware notification")
+	if err := h.publish(appEUI, integration.EventFirmware, topic, false, b); err != nil {
+		return fmt.Errorf("handler/mqtt: publish firmware notification error: %s", err)
+	}
+	return nil
 }
 
+// SendLocationNotification sends a LocationNotification.
+func (h *MQTTHandler) SendLocationNotification(appEUI, devEUI lorawan.EUI64, payload integration.LocationNotification) error {
+	b, err := marshaler.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("handler/mqtt: location notification marshal error: %s", err)
+	}
+	topic, err := h.topics.topic(integration.EventLocation, appEUI.String(), devEUI.String())
+	if err != nil {
+		return err
+	}
+	log.WithField("topic", topic).Info("handler/mqtt: publishing location notification")
+	if err := h.publish(appEUI, integration.EventLocation, topic, false, b); err != nil {
+		return fmt.Errorf("handler/mqtt: publish location notification error: %s", err)
+	}
+	return nil
+}
+
+// SendScheduledDownlinkNotification sends a ScheduledDownlinkNotification.
+func (h *MQTTHandler) SendScheduledDownlinkNotification(appEUI, devEUI lorawan.EUI64, payload integration.ScheduledDownlinkNotification) error {
+	b, err := marshaler.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("handler/mqtt: scheduled downlink notification marshal error: %s", err)
+	}
+	topic, err := h.topics.topic(integration.EventScheduled, appEUI.String(), devEUI.String())
+	if err != nil {
+		return err
+	}
+	log.WithField("topic", topic).Info("handler/mqtt: publishing scheduled downlink notification")
+	if err := h.publish(appEUI, integration.EventScheduled, topic, false, b); err != nil {
+		return fmt.Errorf("handler/mqtt: publish scheduled downlink notification error: %s", err)
+	}
+	return nil
+}
+
+// SendDeviceStatus sends a DeviceStatus. The device status is published as
+// retained message when the retained last uplink is enabled (see
+// SetRetainLastUplink).
+func (h *MQTTHandler) SendDeviceStatus(appEUI, devEUI lorawan.EUI64, payload integration.DeviceStatus) error {
+	b, err := marshaler.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("handler/mqtt: device status marshal error: %s", err)
+	}
+	topic, err := h.topics.topic(integration.EventStatus, appEUI.String(), devEUI.String())
+	if err != nil {
+		return err
+	}
+	log.WithField("topic", topic).Info("handler/mqtt: publishing device status")
+	if err := h.publish(appEUI, integration.EventStatus, topic, h.retainLast, b); err != nil {
+		return fmt.Errorf("handler/mqtt: publish device status error: %s", err)
+	}
+	return nil
+}
+
+// SendGatewayStats publishes the given gateway statistics. As the
+// statistics don't belong to an application, they are not signed.
+func (h *MQTTHandler) SendGatewayStats(mac lorawan.EUI64, payload integration.GatewayStats) error {
+	b, err := marshaler.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("handler/mqtt: gateway stats marshal error: %s", err)
+	}
+	topic := fmt.Sprintf("gateway/%s/stats", mac)
+	log.WithField("topic", topic).Info("handler/mqtt: publishing gateway stats")
+	if token := h.conn.Publish(topic, h.options.QoS[MQTTGatewayStats], h.options.Retain[MQTTGatewayStats], b); token.Wait() && token.Error() != nil {
+		return fmt.Errorf("handler/mqtt: publish gateway stats error: %s", token.Error())
+	}
+	return nil
+}
+
+// SendUsageStats publishes the given usage statistics of the given
+// application.
+func (h *MQTTHandler) SendUsageStats(appEUI lorawan.EUI64, payload integration.UsageStats) error {
+	b, err := marshaler.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("handler/mqtt: usage stats marshal error: %s", err)
+	}
+	topic := fmt.Sprintf("application/%s/stats", appEUI)
+	log.WithField("topic", topic).Info("handler/mqtt: publishing usage stats")
+	if err := h.publish(appEUI, MQTTUsageStats, topic, false, b); err != nil {
+		return fmt.Errorf("handler/mqtt: publish usage stats error: %s", err)
+	}
+	return nil
+}
+
+// publish publishes the given payload (of the given event type) to the given
+// topic, using the QoS of the event type. The payload is published as
+// retained message when retain is true or when configured for the event
+// type. When an event signer has been set, the payload will be signed.
+func (h *MQTTHandler) publish(appEUI lorawan.EUI64, eventType, topic string, retain bool, b []byte) (err error) {
+	start := time.Now()
+	defer func() {
+		observePublish("mqtt", eventType, appEUI, start, err)
+	}()
+
+	var jws string
+	if h.signer != nil {
+		jws, err = h.signer.Sign(appEUI, b, h.detachedJWS)
+		if err != nil {
+			return fmt.Errorf("sign payload error: %s", err)
+		}
+		if jws != "" && !h.detachedJWS {
+			b = []byte(jws)
+		}
+	}
+
+	qos := h.options.QoS[eventType]
+	retain = retain || h.options.Retain[eventType]
+
+	if token := h.conn.Publish(topic, qos, retain, b); token.Wait() && token.Error() != nil {
+		return token.Error()
+	}
+
+	if jws != "" && h.detachedJWS {
+		if token := h.conn.Publish(topic+"/jws", qos, retain, []byte(jws)); token.Wait() && token.Error() != nil {
+			return fmt.Errorf("publish jws error: %s", token.Error())
+		}
+	}
+	return nil
+}
+
+// closeTXChans closes the tx and drop buffers and stops the persister,
+// stopping the workers and the notifier.
+func (h *MQTTHandler) closeTXChans() {
+	close(h.txChan)
+	close(h.dropChan)
+	close(h.stopChan)
+}
+
+// txPayloadHandler adds the received message to the tx buffer, to be
+// handled by the workers. When the buffer is full, the message is handled
+// according to the overflow behavior. With MQTTOverflowPersist, the message
+// is stored in the database before it is acknowledged, this is the only
+// case in which the MQTT client waits for the handling of a message.
 func (h *MQTTHandler) txPayloadHandler(c mqtt.Client, msg mqtt.Message) {
-	h.wg.Add(1)
+	h.txMux.RLock()
+	defer h.txMux.RUnlock()
+	if h.closed {
+		return
+	}
+
+	select {
+	case h.txChan <- msg:
+		return
+	default:
+	}
+
+	log.WithFields(log.Fields{
+		"topic":    msg.Topic(),
+		"overflow": h.options.TXOverflow,
+	}).Warning("handler/mqtt: tx buffer is full")
+	if h.overflowDB != nil {
+		err := storage.CreateDownlinkOverflowItem(h.overflowDB, msg.Topic(), msg.Payload())
+		if err == nil {
+			select {
+			case h.persistChan <- struct{}{}:
+			default:
+			}
+			return
+		}
+		log.WithField("topic", msg.Topic()).Errorf("handler/mqtt: %s", err)
+	}
+
+	select {
+	case h.dropChan <- msg:
+	default:
+		// the notifier is busy, the payload is dropped without publishing
+		// an error notification
+		dataDownReceived.WithLabelValues("mqtt").Inc()
+		observeRejectedDataDown("mqtt", errorTypeDataDownOverflow)
+	}
+}
+
+// txWorker handles the messages of the tx buffer, until the buffer is
+// closed.
+func (h *MQTTHandler) txWorker() {
+	defer h.wg.Done()
+	for msg := range h.txChan {
+		h.handleTXPayload(msg.Topic(), msg.Payload())
+	}
+}
+
+// dropNotifier publishes the error notifications of the messages dropped
+// because the tx buffer was full, until the drop buffer is closed.
+func (h *MQTTHandler) dropNotifier() {
 	defer h.wg.Done()
+	for msg := range h.dropChan {
+		h.dropTXPayload(msg)
+	}
+}
 
-	log.WithField("topic", msg.Topic()).Info("handler/mqtt: data-down payload received")
+// persister handles the messages stored in the database while the tx
+// buffer was full, until the handler is closed. The stored messages are
+// checked when a message was stored and periodically.
+func (h *MQTTHandler) persister() {
+	defer h.wg.Done()
+	ticker := time.NewTicker(mqttOverflowPollInterval)
+	defer ticker.Stop()
+
+	for {
+		for h.handleOverflowItems() {
+		}
+
+		select {
+		case <-h.stopChan:
+			return
+		case <-h.persistChan:
+		case <-ticker.C:
+		}
+	}
+}
+
+// handleOverflowItems handles a batch of the messages stored in the
+// database and deletes these. It returns true when the batch was full (more
+// messages might be stored).
+func (h *MQTTHandler) handleOverflowItems() bool {
+	tx, err := h.overflowDB.Beginx()
+	if err != nil {
+		log.Errorf("handler/mqtt: begin overflow transaction error: %s", err)
+		return false
+	}
+	defer tx.Rollback()
+
+	items, err := storage.GetDownlinkOverflowItemsForUpdate(tx, mqttOverflowBatchSize)
+	if err != nil {
+		log.Errorf("handler/mqtt: %s", err)
+		return false
+	}
+	if len(items) == 0 {
+		return false
+	}
+
+	ids := make([]int64, 0, len(items))
+	for _, item := range items {
+		h.handleTXPayload(item.Topic, item.Payload)
+		ids = append(ids, item.ID)
+	}
+
+	if err := storage.DeleteDownlinkOverflowItems(tx, ids); err != nil {
+		log.Errorf("handler/mqtt: %s", err)
+		return false
+	}
+	if err := tx.Commit(); err != nil {
+		log.Errorf("handler/mqtt: commit overflow transaction error: %s", err)
+		return false
+	}
+	return len(items) == mqttOverflowBatchSize
+}
+
+// dropTXPayload drops the given message because the tx buffer is full. When
+// the payload can be decoded, an error notification is published.
+func (h *MQTTHandler) dropTXPayload(msg mqtt.Message) {
+	dataDownReceived.WithLabelValues("mqtt").Inc()
+
+	topicAppEUI, _, ok := h.topics.parseTXTopic(msg.Topic())
+	var appEUI lorawan.EUI64
+	if !ok || appEUI.UnmarshalText([]byte(topicAppEUI)) != nil {
+		observeRejectedDataDown("mqtt", errorTypeDataDownOverflow)
+		return
+	}
+	pl, err := marshaler.UnmarshalDataDown(msg.Payload())
+	if err != nil {
+		observeRejectedDataDown("mqtt", errorTypeDataDownOverflow)
+		return
+	}
+	h.rejectDataDown(appEUI, pl, errorTypeDataDownOverflow, errors.New("tx buffer is full"))
+}
+
+// handleTXPayload handles the given downlink message, received on the given
+// topic.
+func (h *MQTTHandler) handleTXPayload(topic string, payload []byte) {
+	log.WithField("topic", topic).Info("handler/mqtt: data-down payload received")
+	dataDownReceived.WithLabelValues("mqtt").Inc()
 
 	// get the DevEUI from the topic. with mqtt it is possible to perform
 	// authorization on a per topic level. we need to be sure that the
 	// topic DevEUI matches the payload DevEUI.
-	match := txTopicRegex.FindStringSubmatch(msg.Topic())
-	if len(match) != 3 {
-		log.WithField("topic", msg.Topic()).Error("handler/mqtt: topic regex match error")
+	topicAppEUI, topicDevEUI, ok := h.topics.parseTXTopic(topic)
+	if !ok {
+		log.WithField("topic", topic).Error("handler/mqtt: topic regex match error")
+		observeRejectedDataDown("mqtt", rejectReasonInvalidTopic)
 		return
 	}
 
-	var pl DataDownPayload
-	dec := json.NewDecoder(bytes.NewReader(msg.Payload()))
-	if err := dec.Decode(&pl); err != nil {
+	pl, err := marshaler.UnmarshalDataDown(payload)
+	if err != nil {
 		log.WithFields(log.Fields{
-			"data_base64": base64.StdEncoding.EncodeToString(msg.Payload()),
-		}).Errorf("handler/mqtt: tx payload unmarshal error: %s", err)
+			"data_base64": base64.StdEncoding.EncodeToString(payload),
+		}).Errorf("handler/mqtt: %s", err)
+		observeRejectedDataDown("mqtt", rejectReasonUnmarshal)
 		return
 	}
 
-	if match[2] != pl.DevEUI.String() {
+	if topicDevEUI != pl.DevEUI.String() {
 		log.WithFields(log.Fields{
-			"topic_dev_eui":   match[2],
+			"topic_dev_eui":   topicDevEUI,
 			"payload_dev_eui": pl.DevEUI,
 		}).Warning("handler/mqtt: topic DevEUI must match payload DevEUI")
+		observeRejectedDataDown("mqtt", rejectReasonDevEUIMismatch)
 		return
 	}
 
-	// Since with MQTT all subscribers will receive the downlink messages sent
-	// by the application, the first instance receiving the message must lock it,
-	// so that other instances can ignore the message.
-	// As an unique id, the Reference field is used.
-	key := fmt.Sprintf("lora:as:downlink:lock:%s:%s", pl.DevEUI, pl.Reference)
-	redisConn := h.redisPool.Get()
-	defer redisConn.Close()
-
-	_, err := redis.String(redisConn.Do("SET", key, "lock", "PX", int64(downlinkLockTTL/time.Millisecond), "NX"))
-	if err != nil {
-		if err == redis.ErrNil {
-			// the payload is already being processed by an other instance
-			return
-		}
-		log.Errorf("handler/mqtt: acquire downlink payload lock error: %s", err)
+	var appEUI lorawan.EUI64
+	if err := appEUI.UnmarshalText([]byte(topicAppEUI)); err != nil {
+		log.WithField("topic", topic).Errorf("handler/mqtt: decode AppEUI error: %s", err)
+		observeRejectedDataDown("mqtt", rejectReasonInvalidTopic)
 		return
 	}
 
-	h.dataDownChan <- pl
+	h.handleDataDown(appEUI, pl, payload)
 }
 
 func (h *MQTTHandler) onConnected(c mqtt.Client) {
 	log.Info("handler/mqtt: connected to mqtt broker")
+	mqttConnects.Inc()
 	for {
-		log.WithField("topic", txTopic).Info("handler/mqtt: subscribling to tx topic")
-		if token := h.conn.Subscribe(txTopic, 2, h.txPayloadHandler); token.Wait() && token.Error() != nil {
-			log.WithField("topic", txTopic).Errorf("handler/mqtt: subscribe error: %s", token.Error())
+		log.WithField("topic", h.topics.txFilter).Info("handler/mqtt: subscribling to tx topic")
+		if token := h.conn.Subscribe(h.topics.txFilter, h.options.TXQoS, h.txPayloadHandler); token.Wait() && token.Error() != nil {
+			log.WithField("topic", h.topics.txFilter).Errorf("handler/mqtt: subscribe error: %s", token.Error())
 			time.Sleep(time.Second)
 			continue
 		}
@@ -260,4 +666,5 @@ func (h *MQTTHandler) onConnected(c mqtt.Client) {
 
 func (h *MQTTHandler) onConnectionLost(c mqtt.Client, reason error) {
 	log.Errorf("handler/mqtt: mqtt connection error: %s", reason)
+	mqttConnectionLost.Inc()
 }
@@ -5,8 +5,12 @@ import (
 	"testing"
 	"time"
 
+	"github.com/brocaar/lora-app-server/integration"
+	"github.com/brocaar/lora-app-server/internal/downlink"
+	"github.com/brocaar/lora-app-server/internal/lockstore"
 	"github.com/brocaar/lora-app-server/internal/storage"
 	"github.com/brocaar/lora-app-server/internal/test"
+	"github.com/brocaar/lorawan"
 	mqtt "github.com/eclipse/paho.mqtt.golang"
 	. "github.com/smartystreets/goconvey/convey"
 )
@@ -25,15 +29,16 @@ func TestMQTTHandler(t *testing.T) {
 		test.MustFlushRedis(p)
 
 		Convey("Given a new MQTTHandler", func() {
-			handler, err := NewMQTTHandler(p, conf.MQTTServer, conf.MQTTUsername, conf.MQTTPassword)
+			handler, err := NewMQTTHandler(lockstore.NewRedisStore(p), conf.MQTTServer, conf.MQTTUsername, conf.MQTTPassword, nil, DefaultMQTTTopicTemplates, DefaultMQTTOptions)
 			So(err, ShouldBeNil)
+			handler.SetIdempotency(time.Millisecond*100, time.Hour)
 			defer handler.Close()
 			time.Sleep(time.Millisecond * 100) // give the backend some time to connect
 
 			Convey("Given the MQTT client is subscribed to application/+/node/+/rx", func() {
-				dataUpChan := make(chan DataUpPayload)
+				dataUpChan := make(chan integration.DataUpPayload)
 				token := c.Subscribe("application/+/node/+/rx", 0, func(c mqtt.Client, msg mqtt.Message) {
-					var pl DataUpPayload
+					var pl integration.DataUpPayload
 					if err := json.Unmarshal(msg.Payload(), &pl); err != nil {
 						t.Fatal(err)
 					}
@@ -46,7 +51,7 @@ func TestMQTTHandler(t *testing.T) {
 					devEUI := [8]byte{1, 1, 1, 1, 1, 1, 1, 1}
 					appEUI := [8]byte{2, 2, 2, 2, 2, 2, 2, 2}
 
-					pl := DataUpPayload{
+					pl := integration.DataUpPayload{
 						DevEUI: devEUI,
 					}
 					So(handler.SendDataUp(appEUI, devEUI, pl), ShouldBeNil)
@@ -58,9 +63,9 @@ func TestMQTTHandler(t *testing.T) {
 			})
 
 			Convey("Given the MQTT client is subscribed to application/+/node/+/join", func() {
-				joinChan := make(chan JoinNotification)
+				joinChan := make(chan integration.JoinNotification)
 				token := c.Subscribe("application/+/node/+/join", 0, func(c mqtt.Client, msg mqtt.Message) {
-					var pl JoinNotification
+					var pl integration.JoinNotification
 					if err := json.Unmarshal(msg.Payload(), &pl); err != nil {
 						t.Fatal(err)
 					}
@@ -73,7 +78,7 @@ func TestMQTTHandler(t *testing.T) {
 					devEUI := [8]byte{1, 1, 1, 1, 1, 1, 1, 1}
 					appEUI := [8]byte{2, 2, 2, 2, 2, 2, 2, 2}
 
-					pl := JoinNotification{
+					pl := integration.JoinNotification{
 						DevEUI:  devEUI,
 						DevAddr: [4]byte{1, 2, 3, 4},
 					}
@@ -86,9 +91,9 @@ func TestMQTTHandler(t *testing.T) {
 			})
 
 			Convey("Given the MQTT client is subscribed to application/+/node/+/ack", func() {
-				ackChan := make(chan ACKNotification)
+				ackChan := make(chan integration.ACKNotification)
 				token := c.Subscribe("application/+/node/+/ack", 0, func(c mqtt.Client, msg mqtt.Message) {
-					var pl ACKNotification
+					var pl integration.ACKNotification
 					if err := json.Unmarshal(msg.Payload(), &pl); err != nil {
 						t.Fatal(err)
 					}
@@ -101,7 +106,7 @@ func TestMQTTHandler(t *testing.T) {
 					devEUI := [8]byte{1, 1, 1, 1, 1, 1, 1, 1}
 					appEUI := [8]byte{2, 2, 2, 2, 2, 2, 2, 2}
 
-					pl := ACKNotification{
+					pl := integration.ACKNotification{
 						DevEUI:    devEUI,
 						Reference: "1234",
 					}
@@ -114,9 +119,9 @@ func TestMQTTHandler(t *testing.T) {
 			})
 
 			Convey("Given the MQTT client is subscribed to application/+/node/+/error", func() {
-				errChan := make(chan ErrorNotification)
+				errChan := make(chan integration.ErrorNotification)
 				token := c.Subscribe("application/+/node/+/error", 0, func(c mqtt.Client, msg mqtt.Message) {
-					var pl ErrorNotification
+					var pl integration.ErrorNotification
 					if err := json.Unmarshal(msg.Payload(), &pl); err != nil {
 						t.Fatal(err)
 					}
@@ -129,7 +134,7 @@ func TestMQTTHandler(t *testing.T) {
 					devEUI := [8]byte{1, 1, 1, 1, 1, 1, 1, 1}
 					appEUI := [8]byte{2, 2, 2, 2, 2, 2, 2, 2}
 
-					pl := ErrorNotification{
+					pl := integration.ErrorNotification{
 						DevEUI: devEUI,
 						Type:   "BOOM",
 						Error:  "boom boom boom",
@@ -143,7 +148,7 @@ func TestMQTTHandler(t *testing.T) {
 			})
 
 			Convey("Given a DataDownPayload is published by the MQTT client", func() {
-				pl := DataDownPayload{
+				pl := integration.DataDownPayload{
 					Confirmed: false,
 					DevEUI:    [8]byte{8, 7, 6, 5, 4, 3, 2, 1},
 					FPort:     1,
@@ -156,6 +161,7 @@ func TestMQTTHandler(t *testing.T) {
 				So(token.Error(), ShouldBeNil)
 
 				Convey("Then the same payload is received by the handler", func() {
+					pl.Principal = MQTTPrincipal
 					So(<-handler.DataDownChan(), ShouldResemble, pl)
 
 					Convey("When the topic DevEUI does not match the payload DevEUI", func() {
@@ -176,6 +182,321 @@ func TestMQTTHandler(t *testing.T) {
 					})
 				})
 			})
+
+			Convey("Given a DataDownPayload with nonce is published by the MQTT client", func() {
+				expiresAt := time.Now().Add(time.Minute).UTC().Truncate(time.Second)
+				pl := integration.DataDownPayload{
+					Reference: "1234",
+					DevEUI:    [8]byte{8, 7, 6, 5, 4, 3, 2, 1},
+					FPort:     1,
+					Data:      []byte("hello"),
+					Nonce:     "abcd",
+					ExpiresAt: &expiresAt,
+				}
+				b, err := json.Marshal(pl)
+				So(err, ShouldBeNil)
+				token := c.Publish("application/0102030405060708/node/0807060504030201/tx", 0, false, b)
+				token.Wait()
+				So(token.Error(), ShouldBeNil)
+
+				Convey("Then the payload is received by the handler", func() {
+					pl.Principal = MQTTPrincipal
+					So(<-handler.DataDownChan(), ShouldResemble, pl)
+
+					Convey("When the same payload is published again with an other reference (after the downlink lock expired)", func() {
+						time.Sleep(time.Millisecond * 100)
+						pl.Reference = "4321"
+						b, err := json.Marshal(pl)
+						So(err, ShouldBeNil)
+						token := c.Publish("application/0102030405060708/node/0807060504030201/tx", 0, false, b)
+						token.Wait()
+						So(token.Error(), ShouldBeNil)
+
+						Convey("Then the payload is discarded", func() {
+							var received bool
+							select {
+							case <-handler.DataDownChan():
+								received = true
+							case <-time.After(time.Millisecond * 100):
+								// nothing to do
+							}
+							So(received, ShouldBeFalse)
+						})
+					})
+				})
+			})
+
+			Convey("Given a DataDownPayload with reference is published twice by the MQTT client", func() {
+				errChan := make(chan integration.ErrorNotification)
+				token := c.Subscribe("application/+/node/+/error", 0, func(c mqtt.Client, msg mqtt.Message) {
+					var pl integration.ErrorNotification
+					if err := json.Unmarshal(msg.Payload(), &pl); err != nil {
+						t.Fatal(err)
+					}
+					errChan <- pl
+				})
+				token.Wait()
+				So(token.Error(), ShouldBeNil)
+
+				pl := integration.DataDownPayload{
+					Reference: "5678",
+					DevEUI:    [8]byte{8, 7, 6, 5, 4, 3, 2, 1},
+					FPort:     1,
+					Data:      []byte("hello"),
+				}
+				b, err := json.Marshal(pl)
+				So(err, ShouldBeNil)
+				token = c.Publish("application/0102030405060708/node/0807060504030201/tx", 0, false, b)
+				token.Wait()
+				So(token.Error(), ShouldBeNil)
+				pl.Principal = MQTTPrincipal
+				So(<-handler.DataDownChan(), ShouldResemble, pl)
+
+				time.Sleep(time.Millisecond * 100)
+				token = c.Publish("application/0102030405060708/node/0807060504030201/tx", 0, false, b)
+				token.Wait()
+				So(token.Error(), ShouldBeNil)
+
+				Convey("Then the duplicate is rejected with an error notification", func() {
+					errPL := <-errChan
+					So(errPL.Type, ShouldEqual, errorTypeDataDownDuplicate)
+					So(errPL.Reference, ShouldEqual, "5678")
+
+					var received bool
+					select {
+					case <-handler.DataDownChan():
+						received = true
+					case <-time.After(time.Millisecond * 100):
+						// nothing to do
+					}
+					So(received, ShouldBeFalse)
+				})
+			})
+
+			Convey("Given replay protection is required", func() {
+				handler.SetReplayProtection(true, time.Hour)
+
+				Convey("When publishing a DataDownPayload without nonce", func() {
+					pl := integration.DataDownPayload{
+						DevEUI: [8]byte{8, 7, 6, 5, 4, 3, 2, 1},
+						FPort:  1,
+						Data:   []byte("hello"),
+					}
+					b, err := json.Marshal(pl)
+					So(err, ShouldBeNil)
+					token := c.Publish("application/0102030405060708/node/0807060504030201/tx", 0, false, b)
+					token.Wait()
+					So(token.Error(), ShouldBeNil)
+
+					Convey("Then the payload is discarded", func() {
+						var received bool
+						select {
+						case <-handler.DataDownChan():
+							received = true
+						case <-time.After(time.Millisecond * 100):
+							// nothing to do
+						}
+						So(received, ShouldBeFalse)
+					})
+				})
+			})
+
+			Convey("Given a node and a downlink queue", func() {
+				db, err := storage.OpenDatabase(conf.PostgresDSN)
+				So(err, ShouldBeNil)
+				test.MustResetDB(db)
+
+				node := storage.Node{
+					Name:   "test-node",
+					AppEUI: lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+					DevEUI: lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1},
+				}
+				So(storage.CreateNode(db, node), ShouldBeNil)
+				handler.SetDownlinkQueue(downlink.NewQueue(db))
+
+				Convey("When a DataDownPayload is published by the MQTT client", func() {
+					pl := integration.DataDownPayload{
+						Reference: "audit",
+						DevEUI:    node.DevEUI,
+						FPort:     1,
+						Data:      []byte("hello"),
+					}
+					b, err := json.Marshal(pl)
+					So(err, ShouldBeNil)
+					token := c.Publish("application/0102030405060708/node/0807060504030201/tx", 0, false, b)
+					token.Wait()
+					So(token.Error(), ShouldBeNil)
+					time.Sleep(time.Millisecond * 100) // give the handler some time to enqueue the payload
+
+					Convey("Then the enqueue is recorded in the audit log with the MQTT principal as actor", func() {
+						logs, err := storage.GetAuditLogs(db, storage.AuditLogFilter{
+							ObjectType: storage.AuditObjectDownlink,
+							ObjectID:   node.DevEUI.String(),
+						}, 10, 0)
+						So(err, ShouldBeNil)
+						So(logs, ShouldHaveLength, 1)
+						So(logs[0].Actor, ShouldEqual, MQTTPrincipal)
+						So(logs[0].Action, ShouldEqual, "DownlinkQueue.Enqueue")
+					})
+				})
+			})
+		})
+	})
+}
+
+// testMQTTMessage implements a received mqtt.Message.
+type testMQTTMessage struct {
+	topic   string
+	payload []byte
+}
+
+func (m testMQTTMessage) Duplicate() bool   { return false }
+func (m testMQTTMessage) Qos() byte         { return 0 }
+func (m testMQTTMessage) Retained() bool    { return false }
+func (m testMQTTMessage) Topic() string     { return m.topic }
+func (m testMQTTMessage) MessageID() uint16 { return 0 }
+func (m testMQTTMessage) Payload() []byte   { return m.payload }
+
+// newTestOverflowMQTTHandler returns a MQTTHandler (without connection) of
+// which the tx buffer is always full, as there are no workers.
+func newTestOverflowMQTTHandler(overflow string) *MQTTHandler {
+	topics, err := newMQTTTopics(DefaultMQTTTopicTemplates)
+	So(err, ShouldBeNil)
+	options := DefaultMQTTOptions
+	options.TXOverflow = overflow
+	options.TXBufferSize = 0
+
+	return &MQTTHandler{
+		downlinkIntake: newDownlinkIntake("mqtt", MQTTPrincipal, nil, 1),
+		topics:         topics,
+		options:        options,
+		txChan:         make(chan mqtt.Message),
+		dropChan:       make(chan mqtt.Message, 1),
+		persistChan:    make(chan struct{}, 1),
+		stopChan:       make(chan struct{}),
+	}
+}
+
+func TestMQTTHandlerOverflow(t *testing.T) {
+	conf := test.GetConfig()
+
+	Convey("Given a downlink message", t, func() {
+		pl := integration.DataDownPayload{
+			Reference: "overflow",
+			DevEUI:    lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1},
+			FPort:     1,
+			Data:      []byte("hello"),
+		}
+		b, err := json.Marshal(pl)
+		So(err, ShouldBeNil)
+		msg := testMQTTMessage{
+			topic:   "application/0102030405060708/node/0807060504030201/tx",
+			payload: b,
+		}
+
+		Convey("Given a MQTTHandler dropping the payloads on overflow", func() {
+			h := newTestOverflowMQTTHandler(MQTTOverflowDrop)
+			n := newTestErrorNotifier()
+			h.notifier = n
+
+			Convey("When the message is received while the tx buffer is full", func() {
+				h.txPayloadHandler(nil, msg)
+
+				Convey("Then the error notification is published by the notifier", func() {
+					So(n.notifications, ShouldHaveLength, 0)
+
+					h.wg.Add(1)
+					go h.dropNotifier()
+					errPL := <-n.notifications
+					So(errPL.Type, ShouldEqual, errorTypeDataDownOverflow)
+					So(errPL.Reference, ShouldEqual, "overflow")
+
+					h.closeTXChans()
+					h.wg.Wait()
+				})
+
+				Convey("When an other message is received while the notifier is busy", func() {
+					h.txPayloadHandler(nil, msg)
+
+					Convey("Then it is dropped without blocking the MQTT client", func() {
+						So(h.dropChan, ShouldHaveLength, 1)
+						So(n.notifications, ShouldHaveLength, 0)
+					})
+				})
+			})
+		})
+
+		Convey("Given a MQTTHandler persisting the payloads on overflow, a node and a downlink queue", func() {
+			db, err := storage.OpenDatabase(conf.PostgresDSN)
+			So(err, ShouldBeNil)
+			test.MustResetDB(db)
+
+			So(storage.CreateNode(db, storage.Node{
+				AppEUI: lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+				DevEUI: pl.DevEUI,
+			}), ShouldBeNil)
+
+			h := newTestOverflowMQTTHandler(MQTTOverflowPersist)
+			h.notifier = newTestErrorNotifier()
+			h.SetDownlinkQueue(downlink.NewQueue(db))
+			h.overflowDB = db
+
+			Convey("When the message is received while the tx buffer is full", func() {
+				h.txPayloadHandler(nil, msg)
+
+				Convey("Then the message has been stored before returning", func() {
+					tx, err := db.Beginx()
+					So(err, ShouldBeNil)
+					defer tx.Rollback()
+
+					items, err := storage.GetDownlinkOverflowItemsForUpdate(tx, 10)
+					So(err, ShouldBeNil)
+					So(items, ShouldHaveLength, 1)
+					So(items[0].Topic, ShouldEqual, msg.topic)
+					So(items[0].Payload, ShouldResemble, msg.payload)
+				})
+
+				Convey("When the persister handles the stored messages", func() {
+					So(h.handleOverflowItems(), ShouldBeFalse)
+
+					Convey("Then the payload has been added to the downlink queue and removed from the database", func() {
+						items, err := storage.GetDownlinkQueueItems(db, pl.DevEUI)
+						So(err, ShouldBeNil)
+						So(items, ShouldHaveLength, 1)
+						So(items[0].Reference, ShouldEqual, "overflow")
+
+						tx, err := db.Beginx()
+						So(err, ShouldBeNil)
+						defer tx.Rollback()
+
+						stored, err := storage.GetDownlinkOverflowItemsForUpdate(tx, 10)
+						So(err, ShouldBeNil)
+						So(stored, ShouldHaveLength, 0)
+					})
+				})
+			})
+		})
+	})
+}
+
+func TestNewTLSConfig(t *testing.T) {
+	Convey("Testing NewTLSConfig", t, func() {
+		Convey("Then InsecureSkipVerify is set when requested", func() {
+			tlsConfig, err := NewTLSConfig("", "", "", true)
+			So(err, ShouldBeNil)
+			So(tlsConfig.InsecureSkipVerify, ShouldBeTrue)
+			So(tlsConfig.RootCAs, ShouldBeNil)
+			So(tlsConfig.Certificates, ShouldHaveLength, 0)
+		})
+
+		Convey("Then an error is returned when the ca cert does not exist", func() {
+			_, err := NewTLSConfig("/does/not/exist.pem", "", "", false)
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("Then an error is returned when the client key is missing", func() {
+			_, err := NewTLSConfig("", "/does/not/exist.pem", "", false)
+			So(err, ShouldNotBeNil)
 		})
 	})
 }
@@ -0,0 +1,134 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/brocaar/lora-app-server/integration"
+)
+
+// MQTTGatewayStats is the message type of the gateway statistics, used
+// for configuring the QoS and retained flag of these messages.
+const MQTTGatewayStats = "gatewaystats"
+
+// MQTTUsageStats is the message type of the application usage statistics,
+// used for configuring the QoS and retained flag of these messages.
+const MQTTUsageStats = "usagestats"
+
+// Overflow behaviors of the MQTTHandler, defining the handling of the
+// downlink payloads received while the tx buffer is full.
+const (
+	// MQTTOverflowDrop drops the payload and publishes an error
+	// notification (of type DATA_DOWN_OVERFLOW), asynchronously.
+	MQTTOverflowDrop = "drop"
+
+	// MQTTOverflowPersist stores the payload in the database before it is
+	// acknowledged, to be handled by the persister (see SetOverflowDB).
+	// The payload is dropped when it can't be stored, or without database.
+	MQTTOverflowPersist = "persist"
+)
+
+// mqttMessageTypes contains the message types which can be configured by
+// the MQTTOptions.
+var mqttMessageTypes = map[string]struct{}{
+	integration.EventDataUp:      {},
+	integration.EventJoin:        {},
+	integration.EventACK:         {},
+	integration.EventError:       {},
+	integration.EventLinkQuality: {},
+	integration.EventLifecycle:   {},
+	integration.EventFirmware:    {},
+	integration.EventStatus:      {},
+	integration.EventLocation:    {},
+	integration.EventScheduled:   {},
+	MQTTGatewayStats:             {},
+	MQTTUsageStats:               {},
+}
+
+// MQTTOptions contains the client, session and delivery options of the
+// MQTTHandler.
+type MQTTOptions struct {
+	// ClientID defines the client id (when empty, the broker assigns a
+	// client id). Each instance must use an unique client id.
+	ClientID string
+
+	// CleanSession defines if the broker must discard the session (and the
+	// queued tx messages) on disconnect. When false, a ClientID must be set.
+	CleanSession bool
+
+	// MaxReconnectInterval defines the maximum delay between the reconnect
+	// attempts after the connection to the broker was lost.
+	MaxReconnectInterval time.Duration
+
+	// QoS contains the QoS of the published messages by message type (the
+	// event type or MQTTGatewayStats). Message types which are not set are
+	// published with QoS 0.
+	QoS map[string]byte
+
+	// Retain contains the message types which are published as retained
+	// message. The rx/last and status messages (see SetRetainLastUplink)
+	// are always retained.
+	Retain map[string]bool
+
+	// TXQoS defines the QoS of the tx topic subscription.
+	TXQoS byte
+
+	// TXWorkers defines the number of workers handling the received
+	// downlink payloads.
+	TXWorkers int
+
+	// TXBufferSize defines the number of received downlink payloads that
+	// are buffered while waiting for a worker.
+	TXBufferSize int
+
+	// TXOverflow defines the handling of the downlink payloads received
+	// while the buffer is full (MQTTOverflowDrop or MQTTOverflowPersist).
+	TXOverflow string
+}
+
+// DefaultMQTTOptions contains the default MQTT options.
+var DefaultMQTTOptions = MQTTOptions{
+	CleanSession:         true,
+	MaxReconnectInterval: 10 * time.Minute,
+	TXQoS:                2,
+	TXWorkers:            10,
+	TXBufferSize:         1000,
+	TXOverflow:           MQTTOverflowPersist,
+}
+
+// validate validates the options.
+func (o MQTTOptions) validate() error {
+	if !o.CleanSession && o.ClientID == "" {
+		return errors.New("handler/mqtt: a client id must be set when clean session is disabled")
+	}
+	if o.MaxReconnectInterval <= 0 {
+		return errors.New("handler/mqtt: max reconnect interval must be greater than 0")
+	}
+	if o.TXQoS > 2 {
+		return fmt.Errorf("handler/mqtt: invalid tx qos: %d", o.TXQoS)
+	}
+	if o.TXWorkers < 1 {
+		return errors.New("handler/mqtt: at least one tx worker is required")
+	}
+	if o.TXBufferSize < 0 {
+		return errors.New("handler/mqtt: tx buffer size must not be negative")
+	}
+	if o.TXOverflow != MQTTOverflowDrop && o.TXOverflow != MQTTOverflowPersist {
+		return fmt.Errorf("handler/mqtt: invalid tx overflow behavior: %s", o.TXOverflow)
+	}
+	for t, qos := range o.QoS {
+		if _, ok := mqttMessageTypes[t]; !ok {
+			return fmt.Errorf("handler/mqtt: invalid message type: %s", t)
+		}
+		if qos > 2 {
+			return fmt.Errorf("handler/mqtt: invalid qos for message type %s: %d", t, qos)
+		}
+	}
+	for t := range o.Retain {
+		if _, ok := mqttMessageTypes[t]; !ok {
+			return fmt.Errorf("handler/mqtt: invalid message type: %s", t)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+package handler
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestMQTTOptions(t *testing.T) {
+	Convey("Given a set of options", t, func() {
+		tests := []struct {
+			Name    string
+			Options func(o *MQTTOptions)
+			Error   string
+		}{
+			{"default options", func(o *MQTTOptions) {}, ""},
+			{"qos per message type", func(o *MQTTOptions) { o.QoS = map[string]byte{"rx": 1, MQTTGatewayStats: 2} }, ""},
+			{"persistent session without client id", func(o *MQTTOptions) { o.CleanSession = false }, "handler/mqtt: a client id must be set when clean session is disabled"},
+			{"persistent session with client id", func(o *MQTTOptions) { o.CleanSession = false; o.ClientID = "as-1" }, ""},
+			{"invalid tx qos", func(o *MQTTOptions) { o.TXQoS = 3 }, "handler/mqtt: invalid tx qos: 3"},
+			{"invalid qos", func(o *MQTTOptions) { o.QoS = map[string]byte{"rx": 3} }, "handler/mqtt: invalid qos for message type rx: 3"},
+			{"invalid message type", func(o *MQTTOptions) { o.Retain = map[string]bool{"tx": true} }, "handler/mqtt: invalid message type: tx"},
+			{"drop on overflow", func(o *MQTTOptions) { o.TXOverflow = MQTTOverflowDrop }, ""},
+			{"no tx workers", func(o *MQTTOptions) { o.TXWorkers = 0 }, "handler/mqtt: at least one tx worker is required"},
+			{"negative tx buffer size", func(o *MQTTOptions) { o.TXBufferSize = -1 }, "handler/mqtt: tx buffer size must not be negative"},
+			{"invalid overflow behavior", func(o *MQTTOptions) { o.TXOverflow = "block" }, "handler/mqtt: invalid tx overflow behavior: block"},
+		}
+
+		for i, test := range tests {
+			Convey(fmt.Sprintf("Test %d: %s", i, test.Name), func() {
+				o := DefaultMQTTOptions
+				test.Options(&o)
+				err := o.validate()
+				if test.Error == "" {
+					So(err, ShouldBeNil)
+				} else {
+					So(err, ShouldNotBeNil)
+					So(err.Error(), ShouldEqual, test.Error)
+				}
+			})
+		}
+	})
+}
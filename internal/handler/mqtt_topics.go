@@ -0,0 +1,175 @@
+package handler
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strings"
+	"text/template"
+
+	"github.com/brocaar/lora-app-server/integration"
+)
+
+// MQTTTopicTemplates contains the templates (text/template) of the MQTT
+// topics of the nodes. The templates are executed with the (hex encoded)
+// AppEUI and DevEUI as .AppEUI and .DevEUI variables, e.g.
+// tenants/{{ .AppEUI }}/devices/{{ .DevEUI }}/up. The retained last uplink
+// is published to the RX topic suffixed by /last.
+type MQTTTopicTemplates struct {
+	RX          string
+	TX          string
+	Join        string
+	ACK         string
+	Error       string
+	LinkQuality string
+	Lifecycle   string
+	Firmware    string
+	Status      string
+	Location    string
+	Scheduled   string
+}
+
+// DefaultMQTTTopicTemplates contains the default MQTT topic templates.
+var DefaultMQTTTopicTemplates = MQTTTopicTemplates{
+	RX:          "application/{{ .AppEUI }}/node/{{ .DevEUI }}/rx",
+	TX:          "application/{{ .AppEUI }}/node/{{ .DevEUI }}/tx",
+	Join:        "application/{{ .AppEUI }}/node/{{ .DevEUI }}/join",
+	ACK:         "application/{{ .AppEUI }}/node/{{ .DevEUI }}/ack",
+	Error:       "application/{{ .AppEUI }}/node/{{ .DevEUI }}/error",
+	LinkQuality: "application/{{ .AppEUI }}/node/{{ .DevEUI }}/linkquality",
+	Lifecycle:   "application/{{ .AppEUI }}/node/{{ .DevEUI }}/lifecycle",
+	Firmware:    "application/{{ .AppEUI }}/node/{{ .DevEUI }}/firmware",
+	Status:      "application/{{ .AppEUI }}/node/{{ .DevEUI }}/status",
+	Location:    "application/{{ .AppEUI }}/node/{{ .DevEUI }}/location",
+	Scheduled:   "application/{{ .AppEUI }}/node/{{ .DevEUI }}/scheduled",
+}
+
+// markers used for deriving the subscribe filter and regex of the tx topic
+const (
+	mqttAppEUIMarker = "\x00AppEUI\x00"
+	mqttDevEUIMarker = "\x00DevEUI\x00"
+)
+
+// mqttTopicData contains the variables of the topic templates.
+type mqttTopicData struct {
+	AppEUI string
+	DevEUI string
+}
+
+// mqttTopics contains the parsed topic templates, by event type, and the
+// subscribe filter and regex of the tx topic.
+type mqttTopics struct {
+	templates map[string]*template.Template
+	txFilter  string
+	txRegex   *regexp.Regexp
+}
+
+// newMQTTTopics parses the given topic templates. The tx template must
+// contain both the AppEUI and DevEUI, each exactly once.
+func newMQTTTopics(t MQTTTopicTemplates) (*mqttTopics, error) {
+	topics := mqttTopics{
+		templates: make(map[string]*template.Template),
+	}
+
+	for eventType, text := range map[string]string{
+		integration.EventDataUp:      t.RX,
+		integration.EventJoin:        t.Join,
+		integration.EventACK:         t.ACK,
+		integration.EventError:       t.Error,
+		integration.EventLinkQuality: t.LinkQuality,
+		integration.EventLifecycle:   t.Lifecycle,
+		integration.EventFirmware:    t.Firmware,
+		integration.EventStatus:      t.Status,
+		integration.EventLocation:    t.Location,
+		integration.EventScheduled:   t.Scheduled,
+	} {
+		tmpl, err := parseMQTTTopicTemplate(eventType, text)
+		if err != nil {
+			return nil, err
+		}
+		topics.templates[eventType] = tmpl
+	}
+
+	tmpl, err := parseMQTTTopicTemplate("tx", t.TX)
+	if err != nil {
+		return nil, err
+	}
+	tx, err := executeMQTTTopicTemplate(tmpl, mqttAppEUIMarker, mqttDevEUIMarker)
+	if err != nil {
+		return nil, err
+	}
+	if strings.Count(tx, mqttAppEUIMarker) != 1 || strings.Count(tx, mqttDevEUIMarker) != 1 {
+		return nil, fmt.Errorf("handler/mqtt: tx topic template %s must contain the AppEUI and DevEUI exactly once", t.TX)
+	}
+
+	// the levels containing a variable are replaced by a single-level
+	// wildcard, the regex takes care of matching the exact format
+	levels := strings.Split(tx, "/")
+	for i, level := range levels {
+		if strings.Contains(level, mqttAppEUIMarker) || strings.Contains(level, mqttDevEUIMarker) {
+			levels[i] = "+"
+		}
+	}
+	topics.txFilter = strings.Join(levels, "/")
+
+	expr := regexp.QuoteMeta(tx)
+	expr = strings.Replace(expr, regexp.QuoteMeta(mqttAppEUIMarker), `(?P<appEUI>[0-9a-fA-F]{16})`, 1)
+	expr = strings.Replace(expr, regexp.QuoteMeta(mqttDevEUIMarker), `(?P<devEUI>[0-9a-fA-F]{16})`, 1)
+	topics.txRegex, err = regexp.Compile("^" + expr + "$")
+	if err != nil {
+		return nil, fmt.Errorf("handler/mqtt: compile tx topic regex error: %s", err)
+	}
+
+	return &topics, nil
+}
+
+// topic returns the topic of the given event type for the given (hex
+// encoded) AppEUI and DevEUI.
+func (t *mqttTopics) topic(eventType, appEUI, devEUI string) (string, error) {
+	tmpl, ok := t.templates[eventType]
+	if !ok {
+		return "", fmt.Errorf("handler/mqtt: no topic template for event type %s", eventType)
+	}
+	return executeMQTTTopicTemplate(tmpl, appEUI, devEUI)
+}
+
+// parseTXTopic returns the (hex encoded) AppEUI and DevEUI of the given tx
+// topic. It returns false when the topic doesn't match the tx topic
+// template.
+func (t *mqttTopics) parseTXTopic(topic string) (string, string, bool) {
+	match := t.txRegex.FindStringSubmatch(topic)
+	if match == nil {
+		return "", "", false
+	}
+	return match[t.txRegex.SubexpIndex("appEUI")], match[t.txRegex.SubexpIndex("devEUI")], true
+}
+
+// parseMQTTTopicTemplate parses and validates the given topic template.
+func parseMQTTTopicTemplate(name, text string) (*template.Template, error) {
+	if text == "" {
+		return nil, fmt.Errorf("handler/mqtt: %s topic template must be set", name)
+	}
+	tmpl, err := template.New(name).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("handler/mqtt: parse %s topic template error: %s", name, err)
+	}
+
+	topic, err := executeMQTTTopicTemplate(tmpl, mqttAppEUIMarker, mqttDevEUIMarker)
+	if err != nil {
+		return nil, err
+	}
+	if strings.ContainsAny(topic, "+#") {
+		return nil, fmt.Errorf("handler/mqtt: %s topic template %s must not contain wildcards", name, text)
+	}
+	return tmpl, nil
+}
+
+// executeMQTTTopicTemplate returns the topic for the given AppEUI and
+// DevEUI.
+func executeMQTTTopicTemplate(tmpl *template.Template, appEUI, devEUI string) (string, error) {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, mqttTopicData{AppEUI: appEUI, DevEUI: devEUI}); err != nil {
+		return "", fmt.Errorf("handler/mqtt: execute %s topic template error: %s", tmpl.Name(), err)
+	}
+	return buf.String(), nil
+}
@@ -0,0 +1,87 @@
+package handler
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/brocaar/lora-app-server/integration"
+)
+
+func TestMQTTTopics(t *testing.T) {
+	Convey("Given the default topic templates", t, func() {
+		topics, err := newMQTTTopics(DefaultMQTTTopicTemplates)
+		So(err, ShouldBeNil)
+
+		Convey("Then the topics match the default topics", func() {
+			topic, err := topics.topic(integration.EventDataUp, "0102030405060708", "0807060504030201")
+			So(err, ShouldBeNil)
+			So(topic, ShouldEqual, "application/0102030405060708/node/0807060504030201/rx")
+			So(topics.txFilter, ShouldEqual, "application/+/node/+/tx")
+		})
+
+		Convey("Then parseTXTopic returns the AppEUI and DevEUI", func() {
+			appEUI, devEUI, ok := topics.parseTXTopic("application/0102030405060708/node/0807060504030201/tx")
+			So(ok, ShouldBeTrue)
+			So(appEUI, ShouldEqual, "0102030405060708")
+			So(devEUI, ShouldEqual, "0807060504030201")
+
+			_, _, ok = topics.parseTXTopic("application/0102030405060708/node/0807060504030201/rx")
+			So(ok, ShouldBeFalse)
+		})
+	})
+
+	Convey("Given custom topic templates", t, func() {
+		templates := DefaultMQTTTopicTemplates
+		templates.RX = "tenants/{{ .AppEUI }}/devices/{{ .DevEUI }}/up"
+		templates.TX = "tenants/{{ .AppEUI }}/devices/dev-{{ .DevEUI }}/down"
+		topics, err := newMQTTTopics(templates)
+		So(err, ShouldBeNil)
+
+		Convey("Then the topics are generated from the templates", func() {
+			topic, err := topics.topic(integration.EventDataUp, "0102030405060708", "0807060504030201")
+			So(err, ShouldBeNil)
+			So(topic, ShouldEqual, "tenants/0102030405060708/devices/0807060504030201/up")
+		})
+
+		Convey("Then the tx filter and regex match the template", func() {
+			So(topics.txFilter, ShouldEqual, "tenants/+/devices/+/down")
+
+			appEUI, devEUI, ok := topics.parseTXTopic("tenants/0102030405060708/devices/dev-0807060504030201/down")
+			So(ok, ShouldBeTrue)
+			So(appEUI, ShouldEqual, "0102030405060708")
+			So(devEUI, ShouldEqual, "0807060504030201")
+
+			_, _, ok = topics.parseTXTopic("tenants/0102030405060708/devices/0807060504030201/down")
+			So(ok, ShouldBeFalse)
+		})
+	})
+
+	Convey("Given a set of invalid topic templates", t, func() {
+		tests := []struct {
+			Name  string
+			TX    string
+			RX    string
+			Error string
+		}{
+			{"tx without devEUI", "application/{{ .AppEUI }}/tx", "", "handler/mqtt: tx topic template application/{{ .AppEUI }}/tx must contain the AppEUI and DevEUI exactly once"},
+			{"unknown variable", "", "application/{{ .Foo }}/rx", "handler/mqtt: execute rx topic template error: template: rx:1:15: executing \"rx\" at <.Foo>: can't evaluate field Foo in type handler.mqttTopicData"},
+			{"wildcard", "", "application/+/{{ .DevEUI }}/rx", "handler/mqtt: rx topic template application/+/{{ .DevEUI }}/rx must not contain wildcards"},
+		}
+
+		for _, test := range tests {
+			Convey("Then newMQTTTopics returns an error for "+test.Name, func() {
+				templates := DefaultMQTTTopicTemplates
+				if test.TX != "" {
+					templates.TX = test.TX
+				}
+				if test.RX != "" {
+					templates.RX = test.RX
+				}
+				_, err := newMQTTTopics(templates)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, test.Error)
+			})
+		}
+	})
+}
@@ -0,0 +1,138 @@
+package handler
+
+import (
+	"sync"
+
+	"github.com/brocaar/lora-app-server/integration"
+	"github.com/brocaar/lorawan"
+)
+
+// MultiHandler implements a handler forwarding the events to multiple
+// handlers (e.g. the MQTT handler and the plugins).
+type MultiHandler struct {
+	handlers     []integration.Handler
+	dataDownChan chan integration.DataDownPayload
+	wg           sync.WaitGroup
+}
+
+// NewMultiHandler creates a new MultiHandler. The DataDownPayload received
+// by the given handlers are merged into a single channel.
+func NewMultiHandler(handlers ...integration.Handler) *MultiHandler {
+	h := MultiHandler{
+		handlers:     handlers,
+		dataDownChan: make(chan integration.DataDownPayload),
+	}
+
+	for _, handler := range handlers {
+		h.wg.Add(1)
+		go func(c chan integration.DataDownPayload) {
+			defer h.wg.Done()
+			for pl := range c {
+				h.dataDownChan <- pl
+			}
+		}(handler.DataDownChan())
+	}
+
+	go func() {
+		h.wg.Wait()
+		close(h.dataDownChan)
+	}()
+
+	return &h
+}
+
+// Close closes all the handlers.
+func (h *MultiHandler) Close() error {
+	return h.each(func(handler integration.Handler) error {
+		return handler.Close()
+	})
+}
+
+// SendDataUp sends a DataUpPayload.
+func (h *MultiHandler) SendDataUp(appEUI, devEUI lorawan.EUI64, payload integration.DataUpPayload) error {
+	return h.each(func(handler integration.Handler) error {
+		return handler.SendDataUp(appEUI, devEUI, payload)
+	})
+}
+
+// SendJoinNotification sends a JoinNotification.
+func (h *MultiHandler) SendJoinNotification(appEUI, devEUI lorawan.EUI64, payload integration.JoinNotification) error {
+	return h.each(func(handler integration.Handler) error {
+		return handler.SendJoinNotification(appEUI, devEUI, payload)
+	})
+}
+
+// SendACKNotification sends an ACKNotification.
+func (h *MultiHandler) SendACKNotification(appEUI, devEUI lorawan.EUI64, payload integration.ACKNotification) error {
+	return h.each(func(handler integration.Handler) error {
+		return handler.SendACKNotification(appEUI, devEUI, payload)
+	})
+}
+
+// SendErrorNotification sends an ErrorNotification.
+func (h *MultiHandler) SendErrorNotification(appEUI, devEUI lorawan.EUI64, payload integration.ErrorNotification) error {
+	return h.each(func(handler integration.Handler) error {
+		return handler.SendErrorNotification(appEUI, devEUI, payload)
+	})
+}
+
+// SendLinkQualityNotification sends a LinkQualityNotification.
+func (h *MultiHandler) SendLinkQualityNotification(appEUI, devEUI lorawan.EUI64, payload integration.LinkQualityNotification) error {
+	return h.each(func(handler integration.Handler) error {
+		return handler.SendLinkQualityNotification(appEUI, devEUI, payload)
+	})
+}
+
+// SendLifecycleNotification sends a LifecycleNotification.
+func (h *MultiHandler) SendLifecycleNotification(appEUI, devEUI lorawan.EUI64, payload integration.LifecycleNotification) error {
+	return h.each(func(handler integration.Handler) error {
+		return handler.SendLifecycleNotification(appEUI, devEUI, payload)
+	})
+}
+
+// SendFirmwareNotification sends a FirmwareNotification.
+func (h *MultiHandler) SendFirmwareNotification(appEUI, devEUI lorawan.EUI64, payload integration.FirmwareNotification) error {
+	return h.each(func(handler integration.Handler) error {
+		return handler.SendFirmwareNotification(appEUI, devEUI, payload)
+	})
+}
+
+// SendDeviceStatus sends a DeviceStatus.
+func (h *MultiHandler) SendDeviceStatus(appEUI, devEUI lorawan.EUI64, payload integration.DeviceStatus) error {
+	return h.each(func(handler integration.Handler) error {
+		return handler.SendDeviceStatus(appEUI, devEUI, payload)
+	})
+}
+
+// SendLocationNotification sends a LocationNotification.
+func (h *MultiHandler) SendLocationNotification(appEUI, devEUI lorawan.EUI64, payload integration.LocationNotification) error {
+	return h.each(func(handler integration.Handler) error {
+		return handler.SendLocationNotification(appEUI, devEUI, payload)
+	})
+}
+
+// SendScheduledDownlinkNotification sends a ScheduledDownlinkNotification.
+func (h *MultiHandler) SendScheduledDownlinkNotification(appEUI, devEUI lorawan.EUI64, payload integration.ScheduledDownlinkNotification) error {
+	return h.each(func(handler integration.Handler) error {
+		return handler.SendScheduledDownlinkNotification(appEUI, devEUI, payload)
+	})
+}
+
+// DataDownChan returns the channel containing the DataDownPayload received
+// by all the handlers.
+func (h *MultiHandler) DataDownChan() chan integration.DataDownPayload {
+	return h.dataDownChan
+}
+
+// each calls the given function for each handler. A failing handler does
+// not prevent the other handlers from being called, the first error is
+// returned.
+func (h *MultiHandler) each(f func(handler integration.Handler) error) error {
+	var firstErr error
+	for _, handler := range h.handlers {
+		if err := f(handler); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
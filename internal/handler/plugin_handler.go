@@ -0,0 +1,136 @@
+package handler
+
+import (
+	"fmt"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+
+	"github.com/brocaar/lora-app-server/api/plugin"
+	"github.com/brocaar/lora-app-server/integration"
+	"github.com/brocaar/lorawan"
+)
+
+// pluginTimeout defines the timeout of the plugin calls.
+const pluginTimeout = 5 * time.Second
+
+// PluginHandler implements a handler forwarding the events to a plugin
+// implementing the plugin.Plugin gRPC service (e.g. a sidecar process).
+// Plugins can't send downlink payloads over the handler, they must use the
+// API instead.
+type PluginHandler struct {
+	address      string
+	conn         *grpc.ClientConn
+	client       plugin.PluginClient
+	dataDownChan chan integration.DataDownPayload
+}
+
+// NewPluginHandler creates a new PluginHandler given the address
+// (hostname:port) of the plugin and the dial options.
+func NewPluginHandler(address string, opts ...grpc.DialOption) (*PluginHandler, error) {
+	log.WithField("address", address).Info("handler/plugin: connecting to plugin")
+	conn, err := grpc.Dial(address, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("handler/plugin: dial %s error: %s", address, err)
+	}
+
+	return &PluginHandler{
+		address:      address,
+		conn:         conn,
+		client:       plugin.NewPluginClient(conn),
+		dataDownChan: make(chan integration.DataDownPayload),
+	}, nil
+}
+
+// Close closes the connection to the plugin.
+func (h *PluginHandler) Close() error {
+	log.WithField("address", h.address).Info("handler/plugin: closing handler")
+	close(h.dataDownChan)
+	return h.conn.Close()
+}
+
+// SendDataUp sends a DataUpPayload.
+func (h *PluginHandler) SendDataUp(appEUI, devEUI lorawan.EUI64, payload integration.DataUpPayload) error {
+	return h.send(appEUI, devEUI, payload)
+}
+
+// SendJoinNotification sends a JoinNotification.
+func (h *PluginHandler) SendJoinNotification(appEUI, devEUI lorawan.EUI64, payload integration.JoinNotification) error {
+	return h.send(appEUI, devEUI, payload)
+}
+
+// SendACKNotification sends an ACKNotification.
+func (h *PluginHandler) SendACKNotification(appEUI, devEUI lorawan.EUI64, payload integration.ACKNotification) error {
+	return h.send(appEUI, devEUI, payload)
+}
+
+// SendErrorNotification sends an ErrorNotification.
+func (h *PluginHandler) SendErrorNotification(appEUI, devEUI lorawan.EUI64, payload integration.ErrorNotification) error {
+	return h.send(appEUI, devEUI, payload)
+}
+
+// SendLinkQualityNotification sends a LinkQualityNotification.
+func (h *PluginHandler) SendLinkQualityNotification(appEUI, devEUI lorawan.EUI64, payload integration.LinkQualityNotification) error {
+	return h.send(appEUI, devEUI, payload)
+}
+
+// SendLifecycleNotification sends a LifecycleNotification.
+func (h *PluginHandler) SendLifecycleNotification(appEUI, devEUI lorawan.EUI64, payload integration.LifecycleNotification) error {
+	return h.send(appEUI, devEUI, payload)
+}
+
+// SendFirmwareNotification sends a FirmwareNotification.
+func (h *PluginHandler) SendFirmwareNotification(appEUI, devEUI lorawan.EUI64, payload integration.FirmwareNotification) error {
+	return h.send(appEUI, devEUI, payload)
+}
+
+// SendDeviceStatus sends a DeviceStatus.
+func (h *PluginHandler) SendDeviceStatus(appEUI, devEUI lorawan.EUI64, payload integration.DeviceStatus) error {
+	return h.send(appEUI, devEUI, payload)
+}
+
+// SendLocationNotification sends a LocationNotification.
+func (h *PluginHandler) SendLocationNotification(appEUI, devEUI lorawan.EUI64, payload integration.LocationNotification) error {
+	return h.send(appEUI, devEUI, payload)
+}
+
+// SendScheduledDownlinkNotification sends a ScheduledDownlinkNotification.
+func (h *PluginHandler) SendScheduledDownlinkNotification(appEUI, devEUI lorawan.EUI64, payload integration.ScheduledDownlinkNotification) error {
+	return h.send(appEUI, devEUI, payload)
+}
+
+// DataDownChan returns the channel containing the received DataDownPayload.
+// As plugins can't send downlink payloads over the handler, this channel
+// only gets closed on Close.
+func (h *PluginHandler) DataDownChan() chan integration.DataDownPayload {
+	return h.dataDownChan
+}
+
+// send sends the given (marshaled) payload to the plugin.
+func (h *PluginHandler) send(appEUI, devEUI lorawan.EUI64, payload interface{}) error {
+	eventType, b, err := marshaler.MarshalEvent(payload)
+	if err != nil {
+		return fmt.Errorf("handler/plugin: %s", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), pluginTimeout)
+	defer cancel()
+
+	log.WithFields(log.Fields{
+		"address": h.address,
+		"type":    eventType,
+		"dev_eui": devEUI,
+	}).Info("handler/plugin: sending event to plugin")
+	_, err = h.client.HandleEvent(ctx, &plugin.HandleEventRequest{
+		Type:    eventType,
+		AppEUI:  appEUI.String(),
+		DevEUI:  devEUI.String(),
+		Payload: b,
+	})
+	if err != nil {
+		return fmt.Errorf("handler/plugin: send %s event to %s error: %s", eventType, h.address, err)
+	}
+	return nil
+}
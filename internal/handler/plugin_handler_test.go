@@ -0,0 +1,79 @@
+package handler
+
+import (
+	"encoding/json"
+	"net"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+
+	"github.com/brocaar/lora-app-server/api/plugin"
+	"github.com/brocaar/lora-app-server/integration"
+	"github.com/brocaar/lorawan"
+)
+
+type testPlugin struct {
+	events chan plugin.HandleEventRequest
+}
+
+func (p *testPlugin) HandleEvent(ctx context.Context, req *plugin.HandleEventRequest) (*plugin.HandleEventResponse, error) {
+	p.events <- *req
+	return &plugin.HandleEventResponse{}, nil
+}
+
+func TestPluginHandler(t *testing.T) {
+	Convey("Given a test plugin server", t, func() {
+		ln, err := net.Listen("tcp", "127.0.0.1:0")
+		So(err, ShouldBeNil)
+
+		p := testPlugin{events: make(chan plugin.HandleEventRequest, 10)}
+		server := grpc.NewServer()
+		plugin.RegisterPluginServer(server, &p)
+		go server.Serve(ln)
+		defer server.Stop()
+
+		Convey("Given a MultiHandler with two PluginHandlers", func() {
+			h1, err := NewPluginHandler(ln.Addr().String(), grpc.WithInsecure())
+			So(err, ShouldBeNil)
+			h2, err := NewPluginHandler(ln.Addr().String(), grpc.WithInsecure())
+			So(err, ShouldBeNil)
+			h := NewMultiHandler(h1, h2)
+
+			appEUI := lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}
+			devEUI := lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1}
+
+			Convey("When sending a join notification", func() {
+				pl := integration.JoinNotification{
+					DevAddr: lorawan.DevAddr{1, 2, 3, 4},
+					DevEUI:  devEUI,
+				}
+				So(h.SendJoinNotification(appEUI, devEUI, pl), ShouldBeNil)
+
+				Convey("Then both plugin handlers sent the event to the plugin", func() {
+					So(p.events, ShouldHaveLength, 2)
+					for i := 0; i < 2; i++ {
+						e := <-p.events
+						So(e.Type, ShouldEqual, integration.EventJoin)
+						So(e.AppEUI, ShouldEqual, appEUI.String())
+						So(e.DevEUI, ShouldEqual, devEUI.String())
+
+						var received integration.JoinNotification
+						So(json.Unmarshal(e.Payload, &received), ShouldBeNil)
+						So(received, ShouldResemble, pl)
+					}
+				})
+			})
+
+			Convey("When closing the MultiHandler", func() {
+				So(h.Close(), ShouldBeNil)
+
+				Convey("Then the data-down channel is closed", func() {
+					_, ok := <-h.DataDownChan()
+					So(ok, ShouldBeFalse)
+				})
+			})
+		})
+	})
+}
@@ -0,0 +1,62 @@
+package handler
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/brocaar/lora-app-server/integration"
+)
+
+// BackendFactory creates a handler backend.
+type BackendFactory func() (integration.Handler, error)
+
+// Registry contains the handler backends (e.g. mqtt, kafka and amqp) which
+// can be enabled, by name. A backend is only created when it is enabled.
+type Registry struct {
+	mu        sync.RWMutex
+	factories map[string]BackendFactory
+}
+
+// NewRegistry creates a new (empty) Registry.
+func NewRegistry() *Registry {
+	return &Registry{
+		factories: make(map[string]BackendFactory),
+	}
+}
+
+// Register registers the factory of the handler backend with the given name.
+func (r *Registry) Register(name string, f BackendFactory) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.factories[name] = f
+}
+
+// Names returns the sorted names of the registered handler backends.
+func (r *Registry) Names() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var names []string
+	for name := range r.factories {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Create creates the handler backend with the given name.
+func (r *Registry) Create(name string) (integration.Handler, error) {
+	r.mu.RLock()
+	f, ok := r.factories[name]
+	r.mu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("handler backend %s does not exist (available: %v)", name, r.Names())
+	}
+
+	h, err := f()
+	if err != nil {
+		return nil, fmt.Errorf("create handler backend %s error: %s", name, err)
+	}
+	return h, nil
+}
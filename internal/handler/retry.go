@@ -0,0 +1,448 @@
+package handler
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+
+	"github.com/brocaar/lora-app-server/integration"
+	"github.com/brocaar/lora-app-server/internal/deadletter"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+// RetryPolicy defines how often the delivery of an event is retried. The
+// delay between the attempts starts at the backoff and doubles after each
+// attempt.
+type RetryPolicy struct {
+	Retries int
+	Backoff time.Duration
+}
+
+// ParseRetryPolicy parses a retry policy in the format
+// handler=retries/backoff (e.g. http=5/2s) and returns the handler name and
+// its policy.
+func ParseRetryPolicy(s string) (string, RetryPolicy, error) {
+	var p RetryPolicy
+
+	kv := strings.SplitN(s, "=", 2)
+	if len(kv) != 2 || kv[0] == "" {
+		return "", p, fmt.Errorf("retry policy must be in the format handler=retries/backoff, got: %s", s)
+	}
+	parts := strings.SplitN(kv[1], "/", 2)
+	if len(parts) != 2 {
+		return "", p, fmt.Errorf("retry policy must be in the format handler=retries/backoff, got: %s", s)
+	}
+
+	var err error
+	if p.Retries, err = strconv.Atoi(parts[0]); err != nil || p.Retries < 0 {
+		return "", p, fmt.Errorf("invalid number of retries: %s", parts[0])
+	}
+	if p.Backoff, err = time.ParseDuration(parts[1]); err != nil || p.Backoff <= 0 {
+		return "", p, fmt.Errorf("invalid backoff: %s", parts[1])
+	}
+
+	return kv[0], p, nil
+}
+
+// defaultMaxPending defines the max. number of pending deliveries of the
+// Retrier used when none has been set (see SetRetrier).
+const defaultMaxPending = 1000
+
+// Retrier implements the retries of the failed event deliveries of the
+// handlers. After the last attempt, the event is stored as dead letter so
+// that it can be inspected and replayed. The number of deliveries made (or
+// retried) in the background is bounded, so that a slow or failing endpoint
+// can't exhaust the memory.
+type Retrier struct {
+	store         deadletter.Store
+	defaultPolicy RetryPolicy
+	maxBackoff    time.Duration
+	pending       chan struct{}
+
+	mu       sync.RWMutex
+	policies map[string]RetryPolicy
+	handlers map[string]integration.Handler
+
+	wg       sync.WaitGroup
+	stop     chan struct{}
+	stopOnce sync.Once
+}
+
+// NewRetrier creates a new Retrier. The given policy applies to the handlers
+// without policy of their own (see SetPolicy). The delay between the
+// attempts never exceeds the given max backoff. At most maxPending
+// deliveries are made in the background at the same time, the events
+// exceeding this limit are not retried. When store is nil, the failed
+// events are only logged.
+func NewRetrier(store deadletter.Store, defaultPolicy RetryPolicy, maxBackoff time.Duration, maxPending int) *Retrier {
+	return &Retrier{
+		store:         store,
+		defaultPolicy: defaultPolicy,
+		maxBackoff:    maxBackoff,
+		pending:       make(chan struct{}, maxPending),
+		policies:      make(map[string]RetryPolicy),
+		handlers:      make(map[string]integration.Handler),
+		stop:          make(chan struct{}),
+	}
+}
+
+// DeadLetters returns the store of the dead letters.
+func (r *Retrier) DeadLetters() deadletter.Store {
+	return r.store
+}
+
+// SetPolicy sets the retry policy of the given handler.
+func (r *Retrier) SetPolicy(handler string, p RetryPolicy) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.policies[handler] = p
+}
+
+// Policy returns the retry policy of the given handler.
+func (r *Retrier) Policy(handler string) RetryPolicy {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if p, ok := r.policies[handler]; ok {
+		return p
+	}
+	return r.defaultPolicy
+}
+
+// Register registers the given handler under the given name, so that its
+// dead letters can be replayed.
+func (r *Retrier) Register(name string, h integration.Handler) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.handlers[name] = h
+}
+
+// Deliver calls the given send function until it succeeds or the retries of
+// the policy of the given handler are exhausted, in which case the payload
+// is stored as dead letter and the error of the last attempt is returned.
+// It blocks for the duration of the retries, see DeliverAsync for delivering
+// the payload in the background.
+func (r *Retrier) Deliver(handler string, appEUI, devEUI lorawan.EUI64, payload interface{}, send func() error) error {
+	r.wg.Add(1)
+	defer r.wg.Done()
+
+	return r.deliver(handler, appEUI, devEUI, payload, send)
+}
+
+// DeliverAsync delivers the given payload in the background (see Deliver).
+// The delivery is tracked before this function returns, so that a Shutdown
+// started afterwards waits for it. When the max. number of pending
+// deliveries has been reached, a single attempt is made before returning
+// and the payload is stored as dead letter when it fails.
+func (r *Retrier) DeliverAsync(handler string, appEUI, devEUI lorawan.EUI64, payload interface{}, send func() error) {
+	ok := r.background(handler, func() {
+		r.deliver(handler, appEUI, devEUI, payload, send)
+	})
+	if ok {
+		return
+	}
+
+	r.wg.Add(1)
+	defer r.wg.Done()
+	if err := send(); err != nil {
+		r.dropRetry(handler, appEUI, devEUI, payload, err)
+	}
+}
+
+// deliver makes the first attempt of sending the given payload and retries
+// it when it fails.
+func (r *Retrier) deliver(handler string, appEUI, devEUI lorawan.EUI64, payload interface{}, send func() error) error {
+	err := send()
+	if err == nil {
+		return nil
+	}
+	return r.retry(handler, appEUI, devEUI, payload, send, err)
+}
+
+// background runs the given function in a goroutine waited for by Shutdown.
+// The goroutine is added to the wait group by the caller, before it is
+// started, as adding it from within the goroutine could race with Shutdown.
+// It returns false, without running the function, when the max. number of
+// pending deliveries has been reached.
+func (r *Retrier) background(handler string, f func()) bool {
+	select {
+	case r.pending <- struct{}{}:
+	default:
+		retryQueueOverflows.WithLabelValues(handler).Inc()
+		return false
+	}
+
+	r.wg.Add(1)
+	go func() {
+		defer func() {
+			<-r.pending
+			r.wg.Done()
+		}()
+		f()
+	}()
+	return true
+}
+
+// dropRetry stores the given payload, of which the delivery failed with the
+// given error, as dead letter without retrying it as the max. number of
+// pending deliveries has been reached.
+func (r *Retrier) dropRetry(handler string, appEUI, devEUI lorawan.EUI64, payload interface{}, err error) {
+	eventType, _ := integration.EventType(payload)
+	log.WithFields(log.Fields{
+		"handler": handler,
+		"type":    eventType,
+		"dev_eui": devEUI,
+	}).Errorf("handler/%s: deliver event error, too many pending deliveries to retry: %s", handler, err)
+	r.deadLetter(handler, appEUI, devEUI, payload, 1, err)
+}
+
+// retry retries the delivery after a failed first attempt, which failed
+// with the given error.
+func (r *Retrier) retry(handler string, appEUI, devEUI lorawan.EUI64, payload interface{}, send func() error, err error) error {
+	p := r.Policy(handler)
+	backoff := p.Backoff
+	eventType, _ := integration.EventType(payload)
+
+	for attempt := 1; ; attempt++ {
+		logFields := log.Fields{
+			"handler": handler,
+			"type":    eventType,
+			"dev_eui": devEUI,
+			"attempt": attempt,
+		}
+		if attempt > p.Retries || r.stopping() {
+			log.WithFields(logFields).Errorf("handler/%s: deliver event error: %s", handler, err)
+			r.deadLetter(handler, appEUI, devEUI, payload, attempt, err)
+			return err
+		}
+		log.WithFields(logFields).Warningf("handler/%s: deliver event error, retrying in %s: %s", handler, backoff, err)
+		deliveryRetries.WithLabelValues(handler).Inc()
+		select {
+		case <-time.After(backoff):
+		case <-r.stop:
+			// store the event as dead letter, so that it can be
+			// replayed after the restart
+			log.WithFields(logFields).Errorf("handler/%s: deliver event error: %s", handler, err)
+			r.deadLetter(handler, appEUI, devEUI, payload, attempt, err)
+			return err
+		}
+		if backoff *= 2; r.maxBackoff > 0 && backoff > r.maxBackoff {
+			backoff = r.maxBackoff
+		}
+
+		if err = send(); err == nil {
+			return nil
+		}
+	}
+}
+
+// Shutdown stops the retries and waits until the pending deliveries have
+// completed or until the given timeout. The deliveries waiting for a retry
+// are stored as dead letter, so that these can be replayed after the
+// restart. New deliveries are attempted only once. It returns false when
+// the timeout expired.
+func (r *Retrier) Shutdown(timeout time.Duration) bool {
+	r.stopOnce.Do(func() {
+		close(r.stop)
+	})
+
+	done := make(chan struct{})
+	go func() {
+		r.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return true
+	case <-time.After(timeout):
+		return false
+	}
+}
+
+// stopping returns true when the retrier is shutting down.
+func (r *Retrier) stopping() bool {
+	select {
+	case <-r.stop:
+		return true
+	default:
+		return false
+	}
+}
+
+// deadLetter stores the given payload as dead letter.
+func (r *Retrier) deadLetter(handler string, appEUI, devEUI lorawan.EUI64, payload interface{}, attempts int, reason error) {
+	deadLetters.WithLabelValues(handler).Inc()
+	if r.store == nil {
+		return
+	}
+
+	eventType, b, err := integration.MarshalEvent(payload)
+	if err != nil {
+		log.Errorf("handler/%s: marshal dead letter error: %s", handler, err)
+		return
+	}
+
+	err = r.store.Add(storage.DeadLetter{
+		Handler:  handler,
+		AppEUI:   appEUI,
+		DevEUI:   devEUI,
+		Type:     eventType,
+		Payload:  b,
+		Error:    reason.Error(),
+		Attempts: attempts,
+	})
+	if err != nil {
+		log.Errorf("handler/%s: store dead letter error: %s", handler, err)
+	}
+}
+
+// Replay sends the event of the given dead letter again to its handler and
+// deletes the dead letter. The event goes through the retries of the
+// handler again, when it fails again a new dead letter is stored.
+func (r *Retrier) Replay(d storage.DeadLetter) error {
+	r.mu.RLock()
+	h, ok := r.handlers[d.Handler]
+	r.mu.RUnlock()
+	if !ok {
+		return fmt.Errorf("handler %s is not enabled", d.Handler)
+	}
+
+	payload, err := integration.UnmarshalEvent(d.Type, d.Payload)
+	if err != nil {
+		return err
+	}
+	if err := integration.SendEvent(h, d.AppEUI, d.DevEUI, payload); err != nil {
+		return err
+	}
+
+	if r.store == nil {
+		return nil
+	}
+	return r.store.Delete(d.ID)
+}
+
+// retrier is used by the handler backends to deliver the events. By default
+// failed deliveries are not retried.
+var retrier = NewRetrier(nil, RetryPolicy{}, 0, defaultMaxPending)
+
+// SetRetrier sets the Retrier used by the handler backends.
+func SetRetrier(r *Retrier) {
+	retrier = r
+}
+
+// RetryHandler implements a handler retrying the failed deliveries of the
+// handler it wraps (e.g. the MQTT handler). The first attempt is made
+// synchronously, the retries are made in the background so that the
+// failing handler doesn't block the other handlers.
+type RetryHandler struct {
+	integration.Handler
+	name string
+}
+
+// NewRetryHandler creates a new RetryHandler for the given handler, using
+// the retry policy of the given handler name.
+func NewRetryHandler(name string, h integration.Handler) *RetryHandler {
+	return &RetryHandler{
+		Handler: h,
+		name:    name,
+	}
+}
+
+// SendDataUp sends a DataUpPayload.
+func (h *RetryHandler) SendDataUp(appEUI, devEUI lorawan.EUI64, payload integration.DataUpPayload) error {
+	return h.deliver(appEUI, devEUI, payload, func() error {
+		return h.Handler.SendDataUp(appEUI, devEUI, payload)
+	})
+}
+
+// SendJoinNotification sends a JoinNotification.
+func (h *RetryHandler) SendJoinNotification(appEUI, devEUI lorawan.EUI64, payload integration.JoinNotification) error {
+	return h.deliver(appEUI, devEUI, payload, func() error {
+		return h.Handler.SendJoinNotification(appEUI, devEUI, payload)
+	})
+}
+
+// SendACKNotification sends an ACKNotification.
+func (h *RetryHandler) SendACKNotification(appEUI, devEUI lorawan.EUI64, payload integration.ACKNotification) error {
+	return h.deliver(appEUI, devEUI, payload, func() error {
+		return h.Handler.SendACKNotification(appEUI, devEUI, payload)
+	})
+}
+
+// SendErrorNotification sends an ErrorNotification.
+func (h *RetryHandler) SendErrorNotification(appEUI, devEUI lorawan.EUI64, payload integration.ErrorNotification) error {
+	return h.deliver(appEUI, devEUI, payload, func() error {
+		return h.Handler.SendErrorNotification(appEUI, devEUI, payload)
+	})
+}
+
+// SendLinkQualityNotification sends a LinkQualityNotification.
+func (h *RetryHandler) SendLinkQualityNotification(appEUI, devEUI lorawan.EUI64, payload integration.LinkQualityNotification) error {
+	return h.deliver(appEUI, devEUI, payload, func() error {
+		return h.Handler.SendLinkQualityNotification(appEUI, devEUI, payload)
+	})
+}
+
+// SendLifecycleNotification sends a LifecycleNotification.
+func (h *RetryHandler) SendLifecycleNotification(appEUI, devEUI lorawan.EUI64, payload integration.LifecycleNotification) error {
+	return h.deliver(appEUI, devEUI, payload, func() error {
+		return h.Handler.SendLifecycleNotification(appEUI, devEUI, payload)
+	})
+}
+
+// SendFirmwareNotification sends a FirmwareNotification.
+func (h *RetryHandler) SendFirmwareNotification(appEUI, devEUI lorawan.EUI64, payload integration.FirmwareNotification) error {
+	return h.deliver(appEUI, devEUI, payload, func() error {
+		return h.Handler.SendFirmwareNotification(appEUI, devEUI, payload)
+	})
+}
+
+// SendDeviceStatus sends a DeviceStatus.
+func (h *RetryHandler) SendDeviceStatus(appEUI, devEUI lorawan.EUI64, payload integration.DeviceStatus) error {
+	return h.deliver(appEUI, devEUI, payload, func() error {
+		return h.Handler.SendDeviceStatus(appEUI, devEUI, payload)
+	})
+}
+
+// SendLocationNotification sends a LocationNotification.
+func (h *RetryHandler) SendLocationNotification(appEUI, devEUI lorawan.EUI64, payload integration.LocationNotification) error {
+	return h.deliver(appEUI, devEUI, payload, func() error {
+		return h.Handler.SendLocationNotification(appEUI, devEUI, payload)
+	})
+}
+
+// SendScheduledDownlinkNotification sends a ScheduledDownlinkNotification.
+func (h *RetryHandler) SendScheduledDownlinkNotification(appEUI, devEUI lorawan.EUI64, payload integration.ScheduledDownlinkNotification) error {
+	return h.deliver(appEUI, devEUI, payload, func() error {
+		return h.Handler.SendScheduledDownlinkNotification(appEUI, devEUI, payload)
+	})
+}
+
+// deliver makes the first attempt of sending the given payload. When it
+// fails and the policy allows retries, these are made in the background and
+// nil is returned. When the max. number of pending deliveries has been
+// reached, the payload is stored as dead letter and the error is returned.
+func (h *RetryHandler) deliver(appEUI, devEUI lorawan.EUI64, payload interface{}, send func() error) error {
+	err := send()
+	if err == nil {
+		return nil
+	}
+
+	r := retrier
+	if r.Policy(h.name).Retries == 0 {
+		return r.retry(h.name, appEUI, devEUI, payload, send, err)
+	}
+	ok := r.background(h.name, func() {
+		r.retry(h.name, appEUI, devEUI, payload, send, err)
+	})
+	if !ok {
+		r.dropRetry(h.name, appEUI, devEUI, payload, err)
+		return err
+	}
+	return nil
+}
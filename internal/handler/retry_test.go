@@ -0,0 +1,282 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/brocaar/lora-app-server/integration"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+// testDeadLetterStore implements an in-memory deadletter.Store.
+type testDeadLetterStore struct {
+	sync.Mutex
+	letters []storage.DeadLetter
+}
+
+func (s *testDeadLetterStore) Add(d storage.DeadLetter) error {
+	s.Lock()
+	defer s.Unlock()
+	d.ID = int64(len(s.letters) + 1)
+	s.letters = append(s.letters, d)
+	return nil
+}
+
+func (s *testDeadLetterStore) List(appEUI lorawan.EUI64, handler string, limit, offset int) ([]storage.DeadLetter, int, error) {
+	s.Lock()
+	defer s.Unlock()
+	return s.letters, len(s.letters), nil
+}
+
+func (s *testDeadLetterStore) Get(id int64) (storage.DeadLetter, error) {
+	s.Lock()
+	defer s.Unlock()
+	for _, d := range s.letters {
+		if d.ID == id {
+			return d, nil
+		}
+	}
+	return storage.DeadLetter{}, fmt.Errorf("dead letter %d does not exist", id)
+}
+
+func (s *testDeadLetterStore) Delete(id int64) error {
+	s.Lock()
+	defer s.Unlock()
+	for i, d := range s.letters {
+		if d.ID == id {
+			s.letters = append(s.letters[:i], s.letters[i+1:]...)
+			return nil
+		}
+	}
+	return fmt.Errorf("dead letter %d does not exist", id)
+}
+
+func (s *testDeadLetterStore) DeleteBefore(before time.Time) error {
+	return nil
+}
+
+// failingHandler implements a handler of which the first sends fail.
+type failingHandler struct {
+	integration.NopHandler
+	failures int
+	sent     chan integration.DataUpPayload
+}
+
+func (h *failingHandler) SendDataUp(appEUI, devEUI lorawan.EUI64, payload integration.DataUpPayload) error {
+	if h.failures > 0 {
+		h.failures--
+		return errors.New("publish failed")
+	}
+	h.sent <- payload
+	return nil
+}
+
+func TestParseRetryPolicy(t *testing.T) {
+	Convey("Given a set of retry policies", t, func() {
+		tests := []struct {
+			Policy string
+			Name   string
+			Retry  RetryPolicy
+			Error  bool
+		}{
+			{Policy: "http=5/2s", Name: "http", Retry: RetryPolicy{Retries: 5, Backoff: 2 * time.Second}},
+			{Policy: "mqtt=0/500ms", Name: "mqtt", Retry: RetryPolicy{Retries: 0, Backoff: 500 * time.Millisecond}},
+			{Policy: "http", Error: true},
+			{Policy: "=5/2s", Error: true},
+			{Policy: "http=5", Error: true},
+			{Policy: "http=-1/2s", Error: true},
+			{Policy: "http=5/0s", Error: true},
+		}
+
+		for _, test := range tests {
+			Convey("Test: "+test.Policy, func() {
+				name, p, err := ParseRetryPolicy(test.Policy)
+				if test.Error {
+					So(err, ShouldNotBeNil)
+					return
+				}
+				So(err, ShouldBeNil)
+				So(name, ShouldEqual, test.Name)
+				So(p, ShouldResemble, test.Retry)
+			})
+		}
+	})
+}
+
+func TestRetrier(t *testing.T) {
+	Convey("Given a Retrier with a dead-letter store", t, func() {
+		store := testDeadLetterStore{}
+		r := NewRetrier(&store, RetryPolicy{Retries: 2, Backoff: time.Millisecond}, 2*time.Millisecond, 10)
+		r.SetPolicy("http", RetryPolicy{Retries: 0, Backoff: time.Millisecond})
+
+		appEUI := lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}
+		devEUI := lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1}
+		pl := integration.DataUpPayload{DevEUI: devEUI, FCnt: 10, Data: []byte{1, 2, 3}}
+
+		Convey("Then the policy of a handler without policy is the default policy", func() {
+			So(r.Policy("mqtt"), ShouldResemble, RetryPolicy{Retries: 2, Backoff: time.Millisecond})
+			So(r.Policy("http"), ShouldResemble, RetryPolicy{Retries: 0, Backoff: time.Millisecond})
+		})
+
+		Convey("When the delivery succeeds after a retry", func() {
+			var attempts int
+			err := r.Deliver("mqtt", appEUI, devEUI, pl, func() error {
+				attempts++
+				if attempts == 1 {
+					return errors.New("publish failed")
+				}
+				return nil
+			})
+			So(err, ShouldBeNil)
+
+			Convey("Then no dead letter was stored", func() {
+				So(attempts, ShouldEqual, 2)
+				So(store.letters, ShouldHaveLength, 0)
+			})
+		})
+
+		Convey("When all attempts fail", func() {
+			var attempts int
+			err := r.Deliver("mqtt", appEUI, devEUI, pl, func() error {
+				attempts++
+				return errors.New("publish failed")
+			})
+			So(err, ShouldNotBeNil)
+
+			Convey("Then the payload was stored as dead letter", func() {
+				So(attempts, ShouldEqual, 3)
+				So(store.letters, ShouldHaveLength, 1)
+
+				d := store.letters[0]
+				So(d.Handler, ShouldEqual, "mqtt")
+				So(d.AppEUI, ShouldEqual, appEUI)
+				So(d.DevEUI, ShouldEqual, devEUI)
+				So(d.Type, ShouldEqual, integration.EventDataUp)
+				So(d.Error, ShouldEqual, "publish failed")
+				So(d.Attempts, ShouldEqual, 3)
+
+				Convey("When replaying the dead letter", func() {
+					h := failingHandler{sent: make(chan integration.DataUpPayload, 1)}
+					r.Register("mqtt", &h)
+					So(r.Replay(d), ShouldBeNil)
+
+					Convey("Then the payload was sent to the handler and the dead letter was deleted", func() {
+						So(<-h.sent, ShouldResemble, pl)
+						So(store.letters, ShouldHaveLength, 0)
+					})
+				})
+
+				Convey("Then replaying to a handler that is not registered fails", func() {
+					So(r.Replay(d), ShouldNotBeNil)
+					So(store.letters, ShouldHaveLength, 1)
+				})
+			})
+		})
+
+		Convey("When shutting down while a delivery is waiting for a retry", func() {
+			r.SetPolicy("slow", RetryPolicy{Retries: 5, Backoff: time.Hour})
+			started := make(chan struct{})
+			r.DeliverAsync("slow", appEUI, devEUI, pl, func() error {
+				close(started)
+				return errors.New("publish failed")
+			})
+			<-started
+			time.Sleep(10 * time.Millisecond)
+
+			Convey("Then the shutdown completes and the payload was stored as dead letter", func() {
+				So(r.Shutdown(time.Second), ShouldBeTrue)
+				So(store.letters, ShouldHaveLength, 1)
+				So(store.letters[0].Handler, ShouldEqual, "slow")
+			})
+		})
+
+		Convey("When shutting down right after starting an asynchronous delivery", func() {
+			r.DeliverAsync("mqtt", appEUI, devEUI, pl, func() error {
+				time.Sleep(10 * time.Millisecond)
+				return errors.New("publish failed")
+			})
+
+			Convey("Then the shutdown waits for the delivery", func() {
+				So(r.Shutdown(time.Second), ShouldBeTrue)
+				So(store.letters, ShouldHaveLength, 1)
+			})
+		})
+
+		Convey("Given the max. number of pending deliveries is reached", func() {
+			r := NewRetrier(&store, RetryPolicy{Retries: 5, Backoff: time.Hour}, 0, 1)
+			Reset(func() {
+				r.Shutdown(time.Second)
+			})
+			started := make(chan struct{})
+			r.DeliverAsync("slow", appEUI, devEUI, pl, func() error {
+				close(started)
+				return errors.New("publish failed")
+			})
+			<-started
+
+			Convey("When delivering an other payload asynchronously", func() {
+				var attempts int
+				r.DeliverAsync("slow", appEUI, devEUI, pl, func() error {
+					attempts++
+					return errors.New("publish failed")
+				})
+
+				Convey("Then a single attempt was made before returning and the payload was stored as dead letter", func() {
+					So(attempts, ShouldEqual, 1)
+					So(store.letters, ShouldHaveLength, 1)
+					So(store.letters[0].Attempts, ShouldEqual, 1)
+				})
+			})
+
+			Convey("When a RetryHandler fails to deliver a payload", func() {
+				defer SetRetrier(retrier)
+				SetRetrier(r)
+
+				h := failingHandler{failures: 1, sent: make(chan integration.DataUpPayload, 1)}
+				err := NewRetryHandler("slow", &h).SendDataUp(appEUI, devEUI, pl)
+
+				Convey("Then the error is returned and the payload was stored as dead letter", func() {
+					So(err, ShouldNotBeNil)
+					So(store.letters, ShouldHaveLength, 1)
+				})
+			})
+
+			Convey("Then deliveries are made in the background again once the pending delivery has completed", func() {
+				So(r.Shutdown(time.Second), ShouldBeTrue)
+				So(store.letters, ShouldHaveLength, 1)
+				So(r.background("slow", func() {}), ShouldBeTrue)
+			})
+		})
+
+		Convey("Given a RetryHandler wrapping a failing handler", func() {
+			defer SetRetrier(retrier)
+			SetRetrier(r)
+
+			h := failingHandler{failures: 1, sent: make(chan integration.DataUpPayload, 1)}
+			rh := NewRetryHandler("mqtt", &h)
+
+			Convey("When sending a data-up payload", func() {
+				So(rh.SendDataUp(appEUI, devEUI, pl), ShouldBeNil)
+
+				Convey("Then the payload was sent after a retry", func() {
+					So(<-h.sent, ShouldResemble, pl)
+				})
+			})
+
+			Convey("When the handler has no retries", func() {
+				rh := NewRetryHandler("http", &h)
+
+				Convey("Then the error is returned and a dead letter was stored", func() {
+					So(rh.SendDataUp(appEUI, devEUI, pl), ShouldNotBeNil)
+					So(store.letters, ShouldHaveLength, 1)
+				})
+			})
+		})
+	})
+}
@@ -0,0 +1,226 @@
+package handler
+
+import (
+	"fmt"
+	"sync"
+
+	log "github.com/Sirupsen/logrus"
+
+	"github.com/brocaar/lora-app-server/integration"
+	"github.com/brocaar/lorawan"
+)
+
+// streamBufferSize defines the number of events buffered per subscription.
+// Events are dropped for subscribers that don't keep up.
+const streamBufferSize = 100
+
+// StreamEvent represents an event sent to the subscribers of the
+// StreamHandler.
+type StreamEvent struct {
+	AppEUI  lorawan.EUI64
+	DevEUI  lorawan.EUI64
+	Type    string // event type (see integration.EventDataUp etc.)
+	Payload []byte // JSON encoded payload
+}
+
+// StreamSubscription is a subscription to the events of an application.
+type StreamSubscription struct {
+	events chan StreamEvent
+	appEUI lorawan.EUI64
+	devEUI *lorawan.EUI64
+	types  map[string]struct{}
+	h      *StreamHandler
+}
+
+// Events returns the channel containing the events of the subscription.
+// The channel is closed when the subscription or handler is closed.
+func (s *StreamSubscription) Events() <-chan StreamEvent {
+	return s.events
+}
+
+// Close closes the subscription.
+func (s *StreamSubscription) Close() {
+	s.h.unsubscribe(s)
+}
+
+// match returns true when the given event matches the filters of the
+// subscription.
+func (s *StreamSubscription) match(appEUI, devEUI lorawan.EUI64, eventType string) bool {
+	if appEUI != s.appEUI {
+		return false
+	}
+	if s.devEUI != nil && devEUI != *s.devEUI {
+		return false
+	}
+	if len(s.types) > 0 {
+		if _, ok := s.types[eventType]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// StreamHandler implements a handler streaming the events to the
+// subscribers within the same process (e.g. the EventStream API).
+type StreamHandler struct {
+	sync.RWMutex
+	subscriptions map[*StreamSubscription]struct{}
+	dataDownChan  chan integration.DataDownPayload
+	closed        bool
+}
+
+// NewStreamHandler creates a new StreamHandler.
+func NewStreamHandler() *StreamHandler {
+	return &StreamHandler{
+		subscriptions: make(map[*StreamSubscription]struct{}),
+		dataDownChan:  make(chan integration.DataDownPayload),
+	}
+}
+
+// Subscribe subscribes to the events of the given application. When devEUI
+// is not nil, only the events of the given node are streamed. When types
+// are given, only the events of these types are streamed.
+func (h *StreamHandler) Subscribe(appEUI lorawan.EUI64, devEUI *lorawan.EUI64, types []string) (*StreamSubscription, error) {
+	h.Lock()
+	defer h.Unlock()
+
+	if h.closed {
+		return nil, fmt.Errorf("handler/stream: handler is closed")
+	}
+
+	s := StreamSubscription{
+		events: make(chan StreamEvent, streamBufferSize),
+		appEUI: appEUI,
+		devEUI: devEUI,
+		types:  make(map[string]struct{}),
+		h:      h,
+	}
+	for _, t := range types {
+		s.types[t] = struct{}{}
+	}
+	h.subscriptions[&s] = struct{}{}
+
+	return &s, nil
+}
+
+func (h *StreamHandler) unsubscribe(s *StreamSubscription) {
+	h.Lock()
+	defer h.Unlock()
+
+	if _, ok := h.subscriptions[s]; ok {
+		delete(h.subscriptions, s)
+		close(s.events)
+	}
+}
+
+// Close closes all the subscriptions and stops the handler.
+func (h *StreamHandler) Close() error {
+	h.Lock()
+	defer h.Unlock()
+
+	if h.closed {
+		return nil
+	}
+	h.closed = true
+	for s := range h.subscriptions {
+		delete(h.subscriptions, s)
+		close(s.events)
+	}
+	close(h.dataDownChan)
+	return nil
+}
+
+// SendDataUp sends a DataUpPayload.
+func (h *StreamHandler) SendDataUp(appEUI, devEUI lorawan.EUI64, payload integration.DataUpPayload) error {
+	return h.publish(appEUI, devEUI, payload)
+}
+
+// SendJoinNotification sends a JoinNotification.
+func (h *StreamHandler) SendJoinNotification(appEUI, devEUI lorawan.EUI64, payload integration.JoinNotification) error {
+	return h.publish(appEUI, devEUI, payload)
+}
+
+// SendACKNotification sends an ACKNotification.
+func (h *StreamHandler) SendACKNotification(appEUI, devEUI lorawan.EUI64, payload integration.ACKNotification) error {
+	return h.publish(appEUI, devEUI, payload)
+}
+
+// SendErrorNotification sends an ErrorNotification.
+func (h *StreamHandler) SendErrorNotification(appEUI, devEUI lorawan.EUI64, payload integration.ErrorNotification) error {
+	return h.publish(appEUI, devEUI, payload)
+}
+
+// SendLinkQualityNotification sends a LinkQualityNotification.
+func (h *StreamHandler) SendLinkQualityNotification(appEUI, devEUI lorawan.EUI64, payload integration.LinkQualityNotification) error {
+	return h.publish(appEUI, devEUI, payload)
+}
+
+// SendLifecycleNotification sends a LifecycleNotification.
+func (h *StreamHandler) SendLifecycleNotification(appEUI, devEUI lorawan.EUI64, payload integration.LifecycleNotification) error {
+	return h.publish(appEUI, devEUI, payload)
+}
+
+// SendFirmwareNotification sends a FirmwareNotification.
+func (h *StreamHandler) SendFirmwareNotification(appEUI, devEUI lorawan.EUI64, payload integration.FirmwareNotification) error {
+	return h.publish(appEUI, devEUI, payload)
+}
+
+// SendDeviceStatus sends a DeviceStatus.
+func (h *StreamHandler) SendDeviceStatus(appEUI, devEUI lorawan.EUI64, payload integration.DeviceStatus) error {
+	return h.publish(appEUI, devEUI, payload)
+}
+
+// SendLocationNotification sends a LocationNotification.
+func (h *StreamHandler) SendLocationNotification(appEUI, devEUI lorawan.EUI64, payload integration.LocationNotification) error {
+	return h.publish(appEUI, devEUI, payload)
+}
+
+// SendScheduledDownlinkNotification sends a ScheduledDownlinkNotification.
+func (h *StreamHandler) SendScheduledDownlinkNotification(appEUI, devEUI lorawan.EUI64, payload integration.ScheduledDownlinkNotification) error {
+	return h.publish(appEUI, devEUI, payload)
+}
+
+// DataDownChan returns the channel containing the received DataDownPayload.
+// As the StreamHandler doesn't receive downlink payloads, nothing is sent
+// to this channel.
+func (h *StreamHandler) DataDownChan() chan integration.DataDownPayload {
+	return h.dataDownChan
+}
+
+// publish sends the given payload to the matching subscriptions. The
+// payload is only marshaled when there is at least one matching
+// subscription.
+func (h *StreamHandler) publish(appEUI, devEUI lorawan.EUI64, payload interface{}) error {
+	eventType, err := integration.EventType(payload)
+	if err != nil {
+		return fmt.Errorf("handler/stream: %s", err)
+	}
+
+	h.RLock()
+	defer h.RUnlock()
+
+	var b []byte
+	for s := range h.subscriptions {
+		if !s.match(appEUI, devEUI, eventType) {
+			continue
+		}
+
+		if b == nil {
+			if _, b, err = integration.MarshalEvent(payload); err != nil {
+				return fmt.Errorf("handler/stream: %s", err)
+			}
+		}
+
+		select {
+		case s.events <- StreamEvent{AppEUI: appEUI, DevEUI: devEUI, Type: eventType, Payload: b}:
+		default:
+			log.WithFields(log.Fields{
+				"app_eui": appEUI,
+				"dev_eui": devEUI,
+				"type":    eventType,
+			}).Warning("handler/stream: subscription buffer full, event dropped")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,81 @@
+package handler
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/brocaar/lora-app-server/integration"
+	"github.com/brocaar/lorawan"
+)
+
+func TestStreamHandler(t *testing.T) {
+	Convey("Given a StreamHandler", t, func() {
+		h := NewStreamHandler()
+		appEUI := lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}
+		devEUI := lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1}
+
+		Convey("Given a subscription for the application", func() {
+			sub, err := h.Subscribe(appEUI, nil, nil)
+			So(err, ShouldBeNil)
+
+			Convey("When sending a data-up payload", func() {
+				pl := integration.DataUpPayload{DevEUI: devEUI, FCnt: 10}
+				So(h.SendDataUp(appEUI, devEUI, pl), ShouldBeNil)
+
+				Convey("Then the event is received by the subscription", func() {
+					e := <-sub.Events()
+					So(e.AppEUI, ShouldEqual, appEUI)
+					So(e.DevEUI, ShouldEqual, devEUI)
+					So(e.Type, ShouldEqual, integration.EventDataUp)
+
+					var received integration.DataUpPayload
+					So(json.Unmarshal(e.Payload, &received), ShouldBeNil)
+					So(received, ShouldResemble, pl)
+				})
+			})
+
+			Convey("When sending a payload for an other application", func() {
+				So(h.SendJoinNotification(lorawan.EUI64{1}, devEUI, integration.JoinNotification{DevEUI: devEUI}), ShouldBeNil)
+
+				Convey("Then the event is not received by the subscription", func() {
+					So(sub.Events(), ShouldHaveLength, 0)
+				})
+			})
+
+			Convey("When closing the handler", func() {
+				So(h.Close(), ShouldBeNil)
+
+				Convey("Then the subscription channel is closed", func() {
+					_, ok := <-sub.Events()
+					So(ok, ShouldBeFalse)
+				})
+
+				Convey("Then subscribing returns an error", func() {
+					_, err := h.Subscribe(appEUI, nil, nil)
+					So(err, ShouldNotBeNil)
+				})
+			})
+		})
+
+		Convey("Given a subscription for error notifications of a single node", func() {
+			sub, err := h.Subscribe(appEUI, &devEUI, []string{integration.EventError})
+			So(err, ShouldBeNil)
+			defer sub.Close()
+
+			Convey("When sending events of other types and nodes", func() {
+				So(h.SendACKNotification(appEUI, devEUI, integration.ACKNotification{DevEUI: devEUI}), ShouldBeNil)
+				So(h.SendErrorNotification(appEUI, lorawan.EUI64{1}, integration.ErrorNotification{DevEUI: lorawan.EUI64{1}}), ShouldBeNil)
+				So(h.SendErrorNotification(appEUI, devEUI, integration.ErrorNotification{DevEUI: devEUI, Type: "BOOM"}), ShouldBeNil)
+
+				Convey("Then only the matching event is received", func() {
+					So(sub.Events(), ShouldHaveLength, 1)
+					e := <-sub.Events()
+					So(e.Type, ShouldEqual, integration.EventError)
+					So(e.DevEUI, ShouldEqual, devEUI)
+				})
+			})
+		})
+	})
+}
@@ -0,0 +1,188 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/brocaar/lora-app-server/integration"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+// thingsBoardTimeout defines the timeout of the ThingsBoard requests.
+const thingsBoardTimeout = 10 * time.Second
+
+// ThingsBoardHandler implements a handler pushing the uplink data to the
+// ThingsBoard server configured by the ThingsBoard integration of the
+// application. For each uplink, the fields of the decoded payload are posted
+// as telemetry and the name and location of the node are posted as
+// attributes, using the access token stored in the ThingsBoardAccessToken
+// variable of the node. Applications without ThingsBoard integration and
+// nodes without access token are ignored.
+type ThingsBoardHandler struct {
+	integration.NopHandler
+
+	db     *sqlx.DB
+	client *http.Client
+}
+
+// NewThingsBoardHandler creates a new ThingsBoardHandler. Failed requests
+// are retried using the retry policy of the thingsboard handler (see
+// Retrier).
+func NewThingsBoardHandler(db *sqlx.DB) *ThingsBoardHandler {
+	return &ThingsBoardHandler{
+		db:     db,
+		client: &http.Client{Timeout: thingsBoardTimeout},
+	}
+}
+
+// SendDataUp posts the telemetry and attributes of the given DataUpPayload.
+func (h *ThingsBoardHandler) SendDataUp(appEUI, devEUI lorawan.EUI64, payload integration.DataUpPayload) error {
+	server, node, err := h.getIntegration(appEUI, devEUI)
+	if err != nil || server == "" {
+		return err
+	}
+	token := node.Variables[storage.ThingsBoardAccessTokenVariable]
+
+	telemetry, err := thingsBoardTelemetry(payload)
+	if err != nil {
+		return fmt.Errorf("handler/thingsboard: %s", err)
+	}
+
+	attributes := map[string]interface{}{
+		"deviceName": node.Name,
+		"devEUI":     node.DevEUI.String(),
+		"appEUI":     node.AppEUI.String(),
+	}
+	if node.Latitude != nil && node.Longitude != nil {
+		attributes["latitude"] = *node.Latitude
+		attributes["longitude"] = *node.Longitude
+		if node.Altitude != nil {
+			attributes["altitude"] = *node.Altitude
+		}
+	}
+
+	log.WithFields(log.Fields{
+		"server":  server,
+		"dev_eui": devEUI,
+	}).Info("handler/thingsboard: posting telemetry and attributes")
+	retrier.DeliverAsync("thingsboard", appEUI, devEUI, payload, func() error {
+		if err := h.post(server, token, "telemetry", telemetry); err != nil {
+			return err
+		}
+		return h.post(server, token, "attributes", attributes)
+	})
+	return nil
+}
+
+// SendLocationNotification posts the location of the given
+// LocationNotification as attributes.
+func (h *ThingsBoardHandler) SendLocationNotification(appEUI, devEUI lorawan.EUI64, payload integration.LocationNotification) error {
+	server, node, err := h.getIntegration(appEUI, devEUI)
+	if err != nil || server == "" {
+		return err
+	}
+	token := node.Variables[storage.ThingsBoardAccessTokenVariable]
+
+	attributes := map[string]interface{}{
+		"latitude":  payload.Latitude,
+		"longitude": payload.Longitude,
+		"altitude":  payload.Altitude,
+	}
+
+	log.WithFields(log.Fields{
+		"server":  server,
+		"dev_eui": devEUI,
+	}).Info("handler/thingsboard: posting location attributes")
+	retrier.DeliverAsync("thingsboard", appEUI, devEUI, payload, func() error {
+		return h.post(server, token, "attributes", attributes)
+	})
+	return nil
+}
+
+// getIntegration returns the ThingsBoard server of the application and the
+// node. An empty server is returned when the application doesn't have a
+// ThingsBoard integration or when the node doesn't have an access token.
+func (h *ThingsBoardHandler) getIntegration(appEUI, devEUI lorawan.EUI64) (string, storage.Node, error) {
+	i, err := storage.GetThingsBoardIntegration(h.db, appEUI)
+	if err != nil {
+		return "", storage.Node{}, fmt.Errorf("handler/thingsboard: %s", err)
+	}
+	if i == nil {
+		return "", storage.Node{}, nil
+	}
+
+	node, err := storage.GetNode(h.db, devEUI)
+	if err != nil {
+		return "", node, fmt.Errorf("handler/thingsboard: %s", err)
+	}
+	if node.Variables[storage.ThingsBoardAccessTokenVariable] == "" {
+		log.WithField("dev_eui", devEUI).Warningf("handler/thingsboard: node does not have a %s variable", storage.ThingsBoardAccessTokenVariable)
+		return "", node, nil
+	}
+
+	return strings.TrimRight(i.Server, "/"), node, nil
+}
+
+// post posts the given values as JSON to the given ThingsBoard (device API)
+// endpoint, using the given access token.
+func (h *ThingsBoardHandler) post(server, token, endpoint string, values map[string]interface{}) error {
+	b, err := json.Marshal(values)
+	if err != nil {
+		return err
+	}
+
+	resp, err := h.client.Post(fmt.Sprintf("%s/api/v1/%s/%s", server, token, endpoint), "application/json", bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("expected 2xx response, got: %s", strings.TrimSpace(resp.Status))
+	}
+	return nil
+}
+
+// thingsBoardTelemetry returns the telemetry values for the given payload,
+// containing the frame-counter, FPort, the RSSI and SNR of the gateway with
+// the best reception and the flattened fields of the decoded payload.
+func thingsBoardTelemetry(pl integration.DataUpPayload) (map[string]interface{}, error) {
+	values := map[string]interface{}{
+		"fCnt":  pl.FCnt,
+		"fPort": pl.FPort,
+	}
+	if len(pl.RXInfo) > 0 {
+		best := pl.RXInfo[0]
+		for _, rxInfo := range pl.RXInfo[1:] {
+			if rxInfo.RSSI > best.RSSI {
+				best = rxInfo
+			}
+		}
+		values["rssi"] = best.RSSI
+		values["loRaSNR"] = best.LoRaSNR
+	}
+
+	if pl.Object != nil {
+		// encode and decode the object to get a generic representation
+		// of the decoded payload
+		b, err := json.Marshal(pl.Object)
+		if err != nil {
+			return nil, fmt.Errorf("marshal object error: %s", err)
+		}
+		var obj interface{}
+		if err := json.Unmarshal(b, &obj); err != nil {
+			return nil, fmt.Errorf("unmarshal object error: %s", err)
+		}
+		flattenInfluxDBFields(values, "", obj)
+	}
+
+	return values, nil
+}
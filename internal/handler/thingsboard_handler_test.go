@@ -0,0 +1,153 @@
+package handler
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/brocaar/lora-app-server/integration"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lora-app-server/internal/test"
+	"github.com/brocaar/lorawan"
+)
+
+func TestThingsBoardTelemetry(t *testing.T) {
+	Convey("Given a data-up payload with decoded object", t, func() {
+		pl := integration.DataUpPayload{
+			FCnt:  10,
+			FPort: 2,
+			RXInfo: []integration.RXInfo{
+				{MAC: lorawan.EUI64{1, 1, 1, 1, 1, 1, 1, 1}, RSSI: -100, LoRaSNR: 1},
+				{MAC: lorawan.EUI64{2, 2, 2, 2, 2, 2, 2, 2}, RSSI: -60, LoRaSNR: 5.5},
+			},
+			Object: map[string]interface{}{
+				"temperature": 21.5,
+				"gps":         map[string]interface{}{"lat": 52.1},
+			},
+		}
+
+		Convey("Then thingsBoardTelemetry returns the flattened telemetry", func() {
+			values, err := thingsBoardTelemetry(pl)
+			So(err, ShouldBeNil)
+			So(values, ShouldResemble, map[string]interface{}{
+				"fCnt":        uint32(10),
+				"fPort":       uint8(2),
+				"rssi":        -60,
+				"loRaSNR":     5.5,
+				"temperature": 21.5,
+				"gps_lat":     52.1,
+			})
+		})
+	})
+}
+
+func TestThingsBoardHandler(t *testing.T) {
+	conf := test.GetConfig()
+
+	Convey("Given a clean database and a test ThingsBoard server", t, func() {
+		db, err := storage.OpenDatabase(conf.PostgresDSN)
+		So(err, ShouldBeNil)
+		test.MustResetDB(db)
+
+		requests := make(chan *http.Request, 10)
+		bodies := make(chan []byte, 10)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			b, _ := ioutil.ReadAll(r.Body)
+			requests <- r
+			bodies <- b
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		h := NewThingsBoardHandler(db)
+		appEUI := lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}
+		devEUI := lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1}
+		lat := 52.1
+
+		So(storage.CreateNode(db, storage.Node{
+			AppEUI:    appEUI,
+			DevEUI:    devEUI,
+			Name:      "test-node",
+			Latitude:  &lat,
+			Longitude: &lat,
+			Variables: storage.NodeVariables{storage.ThingsBoardAccessTokenVariable: "secret"},
+		}), ShouldBeNil)
+
+		Convey("When sending a payload for an application without thingsboard integration", func() {
+			So(h.SendDataUp(appEUI, devEUI, integration.DataUpPayload{DevEUI: devEUI}), ShouldBeNil)
+
+			Convey("Then no request was made", func() {
+				So(requests, ShouldHaveLength, 0)
+			})
+		})
+
+		Convey("Given a thingsboard integration for the application", func() {
+			i := storage.ThingsBoardIntegration{
+				AppEUI: appEUI,
+				Server: server.URL,
+			}
+			So(storage.CreateThingsBoardIntegration(db, i), ShouldBeNil)
+
+			Convey("Then GetThingsBoardIntegration returns the integration", func() {
+				i2, err := storage.GetThingsBoardIntegration(db, appEUI)
+				So(err, ShouldBeNil)
+				So(*i2, ShouldResemble, i)
+			})
+
+			Convey("When sending a data-up payload", func() {
+				So(h.SendDataUp(appEUI, devEUI, integration.DataUpPayload{DevEUI: devEUI, FCnt: 10}), ShouldBeNil)
+
+				Convey("Then the telemetry and attributes were posted using the access token", func() {
+					req := <-requests
+					So(req.URL.Path, ShouldEqual, "/api/v1/secret/telemetry")
+					var telemetry map[string]interface{}
+					So(json.Unmarshal(<-bodies, &telemetry), ShouldBeNil)
+					So(telemetry["fCnt"], ShouldEqual, 10)
+
+					req = <-requests
+					So(req.URL.Path, ShouldEqual, "/api/v1/secret/attributes")
+					var attributes map[string]interface{}
+					So(json.Unmarshal(<-bodies, &attributes), ShouldBeNil)
+					So(attributes["deviceName"], ShouldEqual, "test-node")
+					So(attributes["latitude"], ShouldEqual, 52.1)
+				})
+			})
+
+			Convey("When sending a location notification", func() {
+				So(h.SendLocationNotification(appEUI, devEUI, integration.LocationNotification{DevEUI: devEUI, Latitude: 1.5, Longitude: 2.5}), ShouldBeNil)
+
+				Convey("Then the location was posted as attributes", func() {
+					req := <-requests
+					So(req.URL.Path, ShouldEqual, "/api/v1/secret/attributes")
+					So(string(<-bodies), ShouldEqual, `{"altitude":0,"latitude":1.5,"longitude":2.5}`)
+				})
+			})
+
+			Convey("Given a node without access token", func() {
+				node, err := storage.GetNode(db, devEUI)
+				So(err, ShouldBeNil)
+				node.Variables = nil
+				So(storage.UpdateNode(db, node), ShouldBeNil)
+
+				Convey("Then no request is made", func() {
+					So(h.SendDataUp(appEUI, devEUI, integration.DataUpPayload{DevEUI: devEUI}), ShouldBeNil)
+					So(requests, ShouldHaveLength, 0)
+				})
+			})
+
+			Convey("When deleting the integration", func() {
+				So(storage.DeleteThingsBoardIntegration(db, appEUI), ShouldBeNil)
+
+				Convey("Then GetThingsBoardIntegration returns nil", func() {
+					i2, err := storage.GetThingsBoardIntegration(db, appEUI)
+					So(err, ShouldBeNil)
+					So(i2, ShouldBeNil)
+				})
+			})
+		})
+	})
+}
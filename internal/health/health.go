@@ -0,0 +1,77 @@
+// Package health implements the liveness and readiness endpoints, used by
+// orchestration systems (e.g. Kubernetes) to monitor LoRa App Server.
+package health
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/garyburd/redigo/redis"
+	"github.com/jmoiron/sqlx"
+)
+
+// Checker implements the liveness and readiness checks. The instance is
+// ready when it has been marked as ready (see SetReady) and the PostgreSQL
+// and Redis databases are reachable.
+type Checker struct {
+	db    *sqlx.DB
+	p     *redis.Pool
+	ready int32
+}
+
+// NewChecker creates a new Checker. The instance is not ready until
+// SetReady is called.
+func NewChecker(db *sqlx.DB, p *redis.Pool) *Checker {
+	return &Checker{
+		db: db,
+		p:  p,
+	}
+}
+
+// SetReady marks the instance as (not) ready, e.g. on startup and shutdown.
+func (c *Checker) SetReady(ready bool) {
+	var v int32
+	if ready {
+		v = 1
+	}
+	atomic.StoreInt32(&c.ready, v)
+}
+
+// Ready returns an error when the instance is not ready to handle requests.
+func (c *Checker) Ready() error {
+	if atomic.LoadInt32(&c.ready) == 0 {
+		return errors.New("not ready")
+	}
+
+	if err := c.db.Ping(); err != nil {
+		return fmt.Errorf("postgresql error: %s", err)
+	}
+
+	conn := c.p.Get()
+	defer conn.Close()
+	if _, err := conn.Do("PING"); err != nil {
+		return fmt.Errorf("redis error: %s", err)
+	}
+
+	return nil
+}
+
+// LiveHandler responds with 200 OK as long as the process is running.
+func (c *Checker) LiveHandler(w http.ResponseWriter, r *http.Request) {
+	w.Write([]byte("ok\n"))
+}
+
+// ReadyHandler responds with 200 OK when the instance is ready and with
+// 503 Service Unavailable otherwise.
+func (c *Checker) ReadyHandler(w http.ResponseWriter, r *http.Request) {
+	if err := c.Ready(); err != nil {
+		log.Warningf("health: readiness check failed: %s", err)
+		w.WriteHeader(http.StatusServiceUnavailable)
+		w.Write([]byte(err.Error() + "\n"))
+		return
+	}
+	w.Write([]byte("ok\n"))
+}
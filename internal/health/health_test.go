@@ -0,0 +1,54 @@
+package health
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lora-app-server/internal/test"
+)
+
+func TestChecker(t *testing.T) {
+	conf := test.GetConfig()
+
+	Convey("Given a Checker", t, func() {
+		db, err := storage.OpenDatabase(conf.PostgresDSN)
+		So(err, ShouldBeNil)
+		c := NewChecker(db, storage.NewRedisPool(conf.RedisURL))
+
+		Convey("Then the liveness endpoint returns 200", func() {
+			w := httptest.NewRecorder()
+			c.LiveHandler(w, httptest.NewRequest("GET", "/health/live", nil))
+			So(w.Code, ShouldEqual, http.StatusOK)
+		})
+
+		Convey("Then the readiness endpoint returns 503 before the instance is ready", func() {
+			w := httptest.NewRecorder()
+			c.ReadyHandler(w, httptest.NewRequest("GET", "/health/ready", nil))
+			So(w.Code, ShouldEqual, http.StatusServiceUnavailable)
+		})
+
+		Convey("When the instance is marked as ready", func() {
+			c.SetReady(true)
+
+			Convey("Then the readiness endpoint returns 200", func() {
+				w := httptest.NewRecorder()
+				c.ReadyHandler(w, httptest.NewRequest("GET", "/health/ready", nil))
+				So(w.Code, ShouldEqual, http.StatusOK)
+			})
+
+			Convey("When the instance is marked as not ready (shutdown)", func() {
+				c.SetReady(false)
+
+				Convey("Then the readiness endpoint returns 503", func() {
+					w := httptest.NewRecorder()
+					c.ReadyHandler(w, httptest.NewRequest("GET", "/health/ready", nil))
+					So(w.Code, ShouldEqual, http.StatusServiceUnavailable)
+				})
+			})
+		})
+	})
+}
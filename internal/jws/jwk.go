@@ -0,0 +1,73 @@
+package jws
+
+import (
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+// JWK represents a JSON Web Key (RFC 7517) containing an EC public key.
+type JWK struct {
+	KeyType   string `json:"kty"`
+	Curve     string `json:"crv"`
+	X         string `json:"x"`
+	Y         string `json:"y"`
+	KeyID     string `json:"kid"`
+	Use       string `json:"use"`
+	Algorithm string `json:"alg"`
+}
+
+// JWKS represents a JSON Web Key Set.
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+// NewJWK returns the JWK (public key) for the given signing key.
+func NewJWK(key storage.SigningKey) (JWK, error) {
+	pub, err := PublicKey(key)
+	if err != nil {
+		return JWK{}, err
+	}
+
+	x := make([]byte, 32)
+	y := make([]byte, 32)
+	xb, yb := pub.X.Bytes(), pub.Y.Bytes()
+	copy(x[32-len(xb):], xb)
+	copy(y[32-len(yb):], yb)
+
+	return JWK{
+		KeyType:   "EC",
+		Curve:     "P-256",
+		X:         encode(x),
+		Y:         encode(y),
+		KeyID:     key.KeyID,
+		Use:       "sig",
+		Algorithm: key.Algorithm,
+	}, nil
+}
+
+// GetJWKS returns the JWKS containing the public keys of the given AppEUI.
+// Expired keys and (symmetric) HMAC keys are not included.
+func GetJWKS(db *sqlx.DB, appEUI lorawan.EUI64) (JWKS, error) {
+	jwks := JWKS{Keys: []JWK{}}
+
+	keys, err := storage.GetValidSigningKeysForAppEUI(db, appEUI)
+	if err != nil {
+		return jwks, err
+	}
+
+	for _, key := range keys {
+		if key.Algorithm != AlgorithmES256 {
+			continue
+		}
+		jwk, err := NewJWK(key)
+		if err != nil {
+			return jwks, fmt.Errorf("get jwk for key %s error: %s", key.KeyID, err)
+		}
+		jwks.Keys = append(jwks.Keys, jwk)
+	}
+	return jwks, nil
+}
@@ -0,0 +1,225 @@
+// Package jws implements the JSON Web Signature (RFC 7515) signing of the
+// events published by LoRa App Server.
+package jws
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+// Supported signing algorithms.
+const (
+	AlgorithmES256 = "ES256" // ECDSA using P-256 and SHA-256
+	AlgorithmHS256 = "HS256" // HMAC using SHA-256
+)
+
+// hmacSecretLength defines the length (in bytes) of generated HMAC secrets.
+const hmacSecretLength = 32
+
+// Header contains the JWS protected header.
+type Header struct {
+	Algorithm string `json:"alg"`
+	KeyID     string `json:"kid"`
+}
+
+// NewSigningKey generates a new signing key for the given AppEUI, using the
+// given algorithm. When algorithm is empty, ES256 is used.
+func NewSigningKey(appEUI lorawan.EUI64, algorithm string) (storage.SigningKey, error) {
+	if algorithm == "" {
+		algorithm = AlgorithmES256
+	}
+
+	sk := storage.SigningKey{
+		AppEUI:    appEUI,
+		Algorithm: algorithm,
+	}
+
+	kid := make([]byte, 16)
+	if _, err := rand.Read(kid); err != nil {
+		return sk, fmt.Errorf("read random bytes error: %s", err)
+	}
+	sk.KeyID = hex.EncodeToString(kid)
+
+	switch algorithm {
+	case AlgorithmES256:
+		priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if err != nil {
+			return sk, fmt.Errorf("generate ecdsa key error: %s", err)
+		}
+		sk.PrivateKey, err = marshalPrivateKey(priv)
+		if err != nil {
+			return sk, err
+		}
+	case AlgorithmHS256:
+		sk.PrivateKey = make([]byte, hmacSecretLength)
+		if _, err := rand.Read(sk.PrivateKey); err != nil {
+			return sk, fmt.Errorf("read random bytes error: %s", err)
+		}
+	default:
+		return sk, fmt.Errorf("unsupported algorithm: %s", algorithm)
+	}
+
+	return sk, nil
+}
+
+// Sign signs the given payload with the given key and returns the JWS
+// compact serialization.
+func Sign(key storage.SigningKey, payload []byte) (string, error) {
+	h, err := json.Marshal(Header{Algorithm: key.Algorithm, KeyID: key.KeyID})
+	if err != nil {
+		return "", fmt.Errorf("marshal header error: %s", err)
+	}
+	signingInput := encode(h) + "." + encode(payload)
+
+	var sig []byte
+	switch key.Algorithm {
+	case AlgorithmES256:
+		priv, err := parsePrivateKey(key.PrivateKey)
+		if err != nil {
+			return "", err
+		}
+
+		digest := sha256.Sum256([]byte(signingInput))
+		r, s, err := ecdsa.Sign(rand.Reader, priv, digest[:])
+		if err != nil {
+			return "", fmt.Errorf("sign error: %s", err)
+		}
+
+		sig = make([]byte, 64)
+		rb, sb := r.Bytes(), s.Bytes()
+		copy(sig[32-len(rb):32], rb)
+		copy(sig[64-len(sb):], sb)
+	case AlgorithmHS256:
+		mac := hmac.New(sha256.New, key.PrivateKey)
+		mac.Write([]byte(signingInput))
+		sig = mac.Sum(nil)
+	default:
+		return "", fmt.Errorf("unsupported algorithm: %s", key.Algorithm)
+	}
+
+	return signingInput + "." + encode(sig), nil
+}
+
+// Detach removes the payload from the given JWS compact serialization
+// (RFC 7515, appendix F).
+func Detach(token string) string {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return token
+	}
+	return parts[0] + ".." + parts[2]
+}
+
+// Verify verifies the given JWS compact serialization against the given
+// key, which must be an *ecdsa.PublicKey (ES256) or the HMAC secret as
+// []byte (HS256). In case of a detached signature, the payload must be given.
+// It returns the (verified) header and payload.
+func Verify(key interface{}, token string, detachedPayload []byte) (Header, []byte, error) {
+	var header Header
+
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return header, nil, errors.New("jws must consist of three parts")
+	}
+	if parts[1] == "" {
+		parts[1] = encode(detachedPayload)
+	}
+
+	h, err := decode(parts[0])
+	if err != nil {
+		return header, nil, fmt.Errorf("decode header error: %s", err)
+	}
+	if err := json.Unmarshal(h, &header); err != nil {
+		return header, nil, fmt.Errorf("unmarshal header error: %s", err)
+	}
+
+	sig, err := decode(parts[2])
+	if err != nil {
+		return header, nil, fmt.Errorf("decode signature error: %s", err)
+	}
+
+	signingInput := []byte(parts[0] + "." + parts[1])
+
+	switch k := key.(type) {
+	case *ecdsa.PublicKey:
+		if header.Algorithm != AlgorithmES256 {
+			return header, nil, fmt.Errorf("unexpected algorithm: %s", header.Algorithm)
+		}
+		if len(sig) != 64 {
+			return header, nil, errors.New("invalid signature length")
+		}
+		digest := sha256.Sum256(signingInput)
+		r := new(big.Int).SetBytes(sig[:32])
+		s := new(big.Int).SetBytes(sig[32:])
+		if !ecdsa.Verify(k, digest[:], r, s) {
+			return header, nil, errors.New("invalid signature")
+		}
+	case []byte:
+		if header.Algorithm != AlgorithmHS256 {
+			return header, nil, fmt.Errorf("unexpected algorithm: %s", header.Algorithm)
+		}
+		mac := hmac.New(sha256.New, k)
+		mac.Write(signingInput)
+		if !hmac.Equal(sig, mac.Sum(nil)) {
+			return header, nil, errors.New("invalid signature")
+		}
+	default:
+		return header, nil, fmt.Errorf("unsupported key type: %T", key)
+	}
+
+	payload, err := decode(parts[1])
+	if err != nil {
+		return header, nil, fmt.Errorf("decode payload error: %s", err)
+	}
+	return header, payload, nil
+}
+
+// PublicKey returns the public key of the given (ES256) signing key.
+func PublicKey(key storage.SigningKey) (*ecdsa.PublicKey, error) {
+	if key.Algorithm != AlgorithmES256 {
+		return nil, fmt.Errorf("algorithm %s has no public key", key.Algorithm)
+	}
+	priv, err := parsePrivateKey(key.PrivateKey)
+	if err != nil {
+		return nil, err
+	}
+	return &priv.PublicKey, nil
+}
+
+func marshalPrivateKey(priv *ecdsa.PrivateKey) ([]byte, error) {
+	b, err := x509.MarshalECPrivateKey(priv)
+	if err != nil {
+		return nil, fmt.Errorf("marshal private key error: %s", err)
+	}
+	return b, nil
+}
+
+func parsePrivateKey(b []byte) (*ecdsa.PrivateKey, error) {
+	priv, err := x509.ParseECPrivateKey(b)
+	if err != nil {
+		return nil, fmt.Errorf("parse private key error: %s", err)
+	}
+	return priv, nil
+}
+
+func encode(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decode(s string) ([]byte, error) {
+	return base64.RawURLEncoding.DecodeString(s)
+}
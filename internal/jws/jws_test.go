@@ -0,0 +1,103 @@
+package jws
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/brocaar/lorawan"
+)
+
+func TestSignVerify(t *testing.T) {
+	Convey("Given a new signing key", t, func() {
+		key, err := NewSigningKey(lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}, "")
+		So(err, ShouldBeNil)
+		So(key.KeyID, ShouldHaveLength, 32)
+		So(key.Algorithm, ShouldEqual, AlgorithmES256)
+
+		pub, err := PublicKey(key)
+		So(err, ShouldBeNil)
+
+		payload := []byte(`{"devEUI":"0102030405060708"}`)
+
+		Convey("When signing a payload", func() {
+			token, err := Sign(key, payload)
+			So(err, ShouldBeNil)
+			So(strings.Split(token, "."), ShouldHaveLength, 3)
+
+			Convey("Then the JWS can be verified", func() {
+				header, pl, err := Verify(pub, token, nil)
+				So(err, ShouldBeNil)
+				So(header.KeyID, ShouldEqual, key.KeyID)
+				So(header.Algorithm, ShouldEqual, AlgorithmES256)
+				So(pl, ShouldResemble, payload)
+			})
+
+			Convey("Then the detached JWS can be verified given the payload", func() {
+				detached := Detach(token)
+				So(strings.Split(detached, ".")[1], ShouldEqual, "")
+				_, pl, err := Verify(pub, detached, payload)
+				So(err, ShouldBeNil)
+				So(pl, ShouldResemble, payload)
+			})
+
+			Convey("Then the detached JWS does not verify with a modified payload", func() {
+				_, _, err := Verify(pub, Detach(token), []byte(`{"devEUI":"0807060504030201"}`))
+				So(err, ShouldNotBeNil)
+			})
+
+			Convey("Then the JWS does not verify with an other key", func() {
+				other, err := NewSigningKey(lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}, "")
+				So(err, ShouldBeNil)
+				otherPub, err := PublicKey(other)
+				So(err, ShouldBeNil)
+				_, _, err = Verify(otherPub, token, nil)
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("Then the JWK contains the public key", func() {
+			jwk, err := NewJWK(key)
+			So(err, ShouldBeNil)
+			So(jwk.KeyID, ShouldEqual, key.KeyID)
+			So(jwk.KeyType, ShouldEqual, "EC")
+			So(jwk.Curve, ShouldEqual, "P-256")
+			So(jwk.X, ShouldHaveLength, 43)
+			So(jwk.Y, ShouldHaveLength, 43)
+		})
+	})
+}
+
+func TestSignVerifyHMAC(t *testing.T) {
+	Convey("Given a new HS256 signing key", t, func() {
+		key, err := NewSigningKey(lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}, AlgorithmHS256)
+		So(err, ShouldBeNil)
+		So(key.PrivateKey, ShouldHaveLength, hmacSecretLength)
+
+		payload := []byte(`{"devEUI":"0102030405060708"}`)
+
+		Convey("When signing a payload", func() {
+			token, err := Sign(key, payload)
+			So(err, ShouldBeNil)
+
+			Convey("Then the JWS can be verified using the secret", func() {
+				header, pl, err := Verify(key.PrivateKey, token, nil)
+				So(err, ShouldBeNil)
+				So(header.KeyID, ShouldEqual, key.KeyID)
+				So(header.Algorithm, ShouldEqual, AlgorithmHS256)
+				So(pl, ShouldResemble, payload)
+			})
+
+			Convey("Then the JWS does not verify using an other secret", func() {
+				_, _, err := Verify([]byte("secret"), token, nil)
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("Then it has no public key", func() {
+			_, err := PublicKey(key)
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
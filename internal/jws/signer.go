@@ -0,0 +1,94 @@
+package jws
+
+import (
+	"sync"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+// signerCacheTTL defines the duration the current signing key of an
+// application is cached by the Signer. After a rotation (or deletion), the
+// previous key is used for at most this duration.
+const signerCacheTTL = 10 * time.Second
+
+// cachedSigningKey contains the current signing key of an application (nil
+// when the application doesn't have a signing key) and the time until which
+// it can be used.
+type cachedSigningKey struct {
+	key       *storage.SigningKey
+	expiresAt time.Time
+}
+
+// Signer signs event payloads using the current signing key of the
+// application. It implements the handler.EventSigner interface.
+type Signer struct {
+	db *sqlx.DB
+
+	mu   sync.Mutex
+	keys map[lorawan.EUI64]cachedSigningKey
+}
+
+// NewSigner creates a new Signer.
+func NewSigner(db *sqlx.DB) *Signer {
+	return &Signer{
+		db:   db,
+		keys: make(map[lorawan.EUI64]cachedSigningKey),
+	}
+}
+
+// Sign returns the JWS of the given payload. When detached is true, the
+// payload is not included in the returned JWS. In case the application
+// doesn't have a signing key, an empty string is returned.
+func (s *Signer) Sign(appEUI lorawan.EUI64, payload []byte, detached bool) (string, error) {
+	key, err := s.currentKey(appEUI)
+	if err != nil {
+		return "", err
+	}
+	if key == nil {
+		return "", nil
+	}
+
+	token, err := Sign(*key, payload)
+	if err != nil {
+		return "", err
+	}
+	if detached {
+		return Detach(token), nil
+	}
+	return token, nil
+}
+
+// currentKey returns the (cached) current signing key of the given
+// application. A key is never cached beyond its expiration.
+func (s *Signer) currentKey(appEUI lorawan.EUI64) (*storage.SigningKey, error) {
+	now := time.Now()
+
+	s.mu.Lock()
+	c, ok := s.keys[appEUI]
+	s.mu.Unlock()
+	if ok && now.Before(c.expiresAt) {
+		return c.key, nil
+	}
+
+	key, err := storage.GetCurrentSigningKey(s.db, appEUI)
+	if err != nil {
+		return nil, err
+	}
+
+	c = cachedSigningKey{
+		key:       key,
+		expiresAt: now.Add(signerCacheTTL),
+	}
+	if key != nil && key.ExpiresAt != nil && key.ExpiresAt.Before(c.expiresAt) {
+		c.expiresAt = *key.ExpiresAt
+	}
+
+	s.mu.Lock()
+	s.keys[appEUI] = c
+	s.mu.Unlock()
+	return key, nil
+}
@@ -0,0 +1,82 @@
+package jws
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lora-app-server/internal/test"
+	"github.com/brocaar/lorawan"
+)
+
+func TestSigner(t *testing.T) {
+	conf := test.GetConfig()
+
+	Convey("Given a clean database and a Signer", t, func() {
+		db, err := storage.OpenDatabase(conf.PostgresDSN)
+		So(err, ShouldBeNil)
+		test.MustResetDB(db)
+
+		appEUI := lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}
+		payload := []byte(`{"devEUI":"0102030405060708"}`)
+		s := NewSigner(db)
+
+		Convey("When the application doesn't have a signing key", func() {
+			token, err := s.Sign(appEUI, payload, false)
+			So(err, ShouldBeNil)
+
+			Convey("Then the payload is not signed", func() {
+				So(token, ShouldEqual, "")
+			})
+		})
+
+		Convey("Given the application has a signing key", func() {
+			key, err := NewSigningKey(appEUI, "")
+			So(err, ShouldBeNil)
+			So(storage.CreateSigningKey(db, &key), ShouldBeNil)
+			pub, err := PublicKey(key)
+			So(err, ShouldBeNil)
+
+			Convey("Then the payload is signed using this key", func() {
+				token, err := s.Sign(appEUI, payload, false)
+				So(err, ShouldBeNil)
+				header, pl, err := Verify(pub, token, nil)
+				So(err, ShouldBeNil)
+				So(header.KeyID, ShouldEqual, key.KeyID)
+				So(pl, ShouldResemble, payload)
+
+				Convey("When the key is deleted", func() {
+					So(storage.DeleteSigningKey(db, key.KeyID), ShouldBeNil)
+
+					Convey("Then the cached key is used until the cache expires", func() {
+						token, err := s.Sign(appEUI, payload, true)
+						So(err, ShouldBeNil)
+						_, _, err = Verify(pub, token, payload)
+						So(err, ShouldBeNil)
+
+						c := s.keys[appEUI]
+						c.expiresAt = time.Now()
+						s.keys[appEUI] = c
+
+						token, err = s.Sign(appEUI, payload, true)
+						So(err, ShouldBeNil)
+						So(token, ShouldEqual, "")
+					})
+				})
+			})
+
+			Convey("Given the key expires before the cache TTL", func() {
+				expiresAt := time.Now().Add(time.Second)
+				So(storage.ExpireSigningKeys(db, appEUI, "", expiresAt), ShouldBeNil)
+
+				Convey("Then the key is not cached beyond its expiration", func() {
+					_, err := s.Sign(appEUI, payload, false)
+					So(err, ShouldBeNil)
+					So(s.keys[appEUI].expiresAt.After(expiresAt), ShouldBeFalse)
+				})
+			})
+		})
+	})
+}
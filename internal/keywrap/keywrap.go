@@ -0,0 +1,135 @@
+// Package keywrap implements the encryption (wrapping) of the root keys of
+// the nodes using a key encryption key (KEK). The KEK is managed by a
+// Backend, e.g. a local KEK set or the transit secrets engine of Vault.
+package keywrap
+
+import (
+	"crypto/aes"
+	"crypto/subtle"
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// envelopeVersion defines the version of the binary envelope format.
+const envelopeVersion = 1
+
+// defaultIV defines the default initial value of RFC 3394.
+var defaultIV = []byte{0xa6, 0xa6, 0xa6, 0xa6, 0xa6, 0xa6, 0xa6, 0xa6}
+
+// Backend defines the interface of a key encryption backend.
+type Backend interface {
+	// Wrap wraps the given key using the active KEK.
+	Wrap(key []byte) (Envelope, error)
+
+	// Unwrap unwraps the key of the given envelope.
+	Unwrap(env Envelope) ([]byte, error)
+
+	// KEKLabel returns the label of the active KEK.
+	KEKLabel() string
+}
+
+// Envelope contains a wrapped key and the label of the KEK used for
+// wrapping the key.
+type Envelope struct {
+	KEKLabel string
+	Key      []byte
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler. The envelope is
+// encoded as [version (1)][label length (1)][label][wrapped key].
+func (e Envelope) MarshalBinary() ([]byte, error) {
+	if len(e.KEKLabel) > 255 {
+		return nil, fmt.Errorf("kek label exceeds 255 bytes: %s", e.KEKLabel)
+	}
+	b := []byte{envelopeVersion, byte(len(e.KEKLabel))}
+	b = append(b, e.KEKLabel...)
+	return append(b, e.Key...), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (e *Envelope) UnmarshalBinary(data []byte) error {
+	if len(data) < 2 {
+		return errors.New("envelope is too short")
+	}
+	if data[0] != envelopeVersion {
+		return fmt.Errorf("unknown envelope version: %d", data[0])
+	}
+	l := int(data[1])
+	if len(data) < 2+l {
+		return errors.New("envelope is too short")
+	}
+	e.KEKLabel = string(data[2 : 2+l])
+	e.Key = append([]byte{}, data[2+l:]...)
+	return nil
+}
+
+// Wrap wraps the given key using the given KEK (RFC 3394 AES key wrap).
+// The key must be a multiple of 8 bytes and at least 16 bytes.
+func Wrap(kek, key []byte) ([]byte, error) {
+	if len(key)%8 != 0 || len(key) < 16 {
+		return nil, fmt.Errorf("key must be a multiple of 8 bytes and at least 16 bytes, got: %d", len(key))
+	}
+	block, err := aes.NewCipher(kek)
+	if err != nil {
+		return nil, err
+	}
+
+	n := len(key) / 8
+	a := make([]byte, 8)
+	copy(a, defaultIV)
+	r := make([]byte, len(key))
+	copy(r, key)
+
+	b := make([]byte, 16)
+	for j := 0; j < 6; j++ {
+		for i := 0; i < n; i++ {
+			copy(b, a)
+			copy(b[8:], r[i*8:(i+1)*8])
+			block.Encrypt(b, b)
+
+			t := uint64(n*j + i + 1)
+			binary.BigEndian.PutUint64(a, binary.BigEndian.Uint64(b[:8])^t)
+			copy(r[i*8:(i+1)*8], b[8:])
+		}
+	}
+
+	return append(a, r...), nil
+}
+
+// Unwrap unwraps the given wrapped key using the given KEK (RFC 3394 AES
+// key unwrap). It returns an error when the integrity check fails, e.g.
+// when using the wrong KEK.
+func Unwrap(kek, wrapped []byte) ([]byte, error) {
+	if len(wrapped)%8 != 0 || len(wrapped) < 24 {
+		return nil, fmt.Errorf("wrapped key must be a multiple of 8 bytes and at least 24 bytes, got: %d", len(wrapped))
+	}
+	block, err := aes.NewCipher(kek)
+	if err != nil {
+		return nil, err
+	}
+
+	n := len(wrapped)/8 - 1
+	a := make([]byte, 8)
+	copy(a, wrapped[:8])
+	r := make([]byte, n*8)
+	copy(r, wrapped[8:])
+
+	b := make([]byte, 16)
+	for j := 5; j >= 0; j-- {
+		for i := n - 1; i >= 0; i-- {
+			t := uint64(n*j + i + 1)
+			binary.BigEndian.PutUint64(b, binary.BigEndian.Uint64(a)^t)
+			copy(b[8:], r[i*8:(i+1)*8])
+			block.Decrypt(b, b)
+
+			copy(a, b[:8])
+			copy(r[i*8:(i+1)*8], b[8:])
+		}
+	}
+
+	if subtle.ConstantTimeCompare(a, defaultIV) != 1 {
+		return nil, errors.New("key unwrap integrity check failed")
+	}
+	return r, nil
+}
@@ -0,0 +1,205 @@
+package keywrap
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestWrap(t *testing.T) {
+	Convey("Given the test vectors of RFC 3394", t, func() {
+		tests := []struct {
+			Description string
+			KEK         string
+			Key         string
+			Wrapped     string
+		}{
+			{
+				Description: "128 bit key with a 128 bit kek",
+				KEK:         "000102030405060708090a0b0c0d0e0f",
+				Key:         "00112233445566778899aabbccddeeff",
+				Wrapped:     "1fa68b0a8112b447aef34bd8fb5a7b829d3e862371d2cfe5",
+			},
+			{
+				Description: "128 bit key with a 192 bit kek",
+				KEK:         "000102030405060708090a0b0c0d0e0f1011121314151617",
+				Key:         "00112233445566778899aabbccddeeff",
+				Wrapped:     "96778b25ae6ca435f92b5b97c050aed2468ab8a17ad84e5d",
+			},
+			{
+				Description: "128 bit key with a 256 bit kek",
+				KEK:         "000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f",
+				Key:         "00112233445566778899aabbccddeeff",
+				Wrapped:     "64e8c3f9ce0f5ba263e9777905818a2a93c8191e7d6e8ae7",
+			},
+			{
+				Description: "256 bit key with a 256 bit kek",
+				KEK:         "000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f",
+				Key:         "00112233445566778899aabbccddeeff000102030405060708090a0b0c0d0e0f",
+				Wrapped:     "28c9f404c4b810f4cbccb35cfb87f8263f5786e2d80ed326cbc7f0e71a99f43bfb988b9b7a02dd21",
+			},
+		}
+
+		for _, test := range tests {
+			Convey("Test: "+test.Description, func() {
+				kek, _ := hex.DecodeString(test.KEK)
+				key, _ := hex.DecodeString(test.Key)
+
+				wrapped, err := Wrap(kek, key)
+				So(err, ShouldBeNil)
+				So(hex.EncodeToString(wrapped), ShouldEqual, test.Wrapped)
+
+				unwrapped, err := Unwrap(kek, wrapped)
+				So(err, ShouldBeNil)
+				So(unwrapped, ShouldResemble, key)
+
+				wrapped[0] ^= 0xff
+				_, err = Unwrap(kek, wrapped)
+				So(err, ShouldNotBeNil)
+			})
+		}
+	})
+}
+
+func TestEnvelope(t *testing.T) {
+	Convey("Given an envelope", t, func() {
+		env := Envelope{
+			KEKLabel: "kek-1",
+			Key:      []byte{1, 2, 3},
+		}
+
+		Convey("Then it can be marshaled and unmarshaled", func() {
+			b, err := env.MarshalBinary()
+			So(err, ShouldBeNil)
+			So(b, ShouldResemble, []byte{1, 5, 'k', 'e', 'k', '-', '1', 1, 2, 3})
+
+			var env2 Envelope
+			So(env2.UnmarshalBinary(b), ShouldBeNil)
+			So(env2, ShouldResemble, env)
+		})
+
+		Convey("Then a truncated envelope can not be unmarshaled", func() {
+			var env2 Envelope
+			So(env2.UnmarshalBinary([]byte{1, 5, 'k'}), ShouldNotBeNil)
+		})
+	})
+}
+
+func TestLocalBackend(t *testing.T) {
+	Convey("Given a local backend with two keks", t, func() {
+		keks := map[string][]byte{
+			"kek-1": make([]byte, 16),
+			"kek-2": make([]byte, 32),
+		}
+		b, err := NewLocalBackend(keks, "kek-1")
+		So(err, ShouldBeNil)
+		key := []byte{1, 2, 3, 4, 5, 6, 7, 8, 1, 2, 3, 4, 5, 6, 7, 8}
+
+		Convey("Then the key is wrapped using the active kek", func() {
+			env, err := b.Wrap(key)
+			So(err, ShouldBeNil)
+			So(env.KEKLabel, ShouldEqual, "kek-1")
+
+			Convey("Then the key can be unwrapped after rotating the kek", func() {
+				b2, err := NewLocalBackend(keks, "kek-2")
+				So(err, ShouldBeNil)
+				k, err := b2.Unwrap(env)
+				So(err, ShouldBeNil)
+				So(k, ShouldResemble, key)
+			})
+		})
+
+		Convey("Then an unknown kek is rejected", func() {
+			_, err := b.Unwrap(Envelope{KEKLabel: "kek-3", Key: make([]byte, 24)})
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("Then an invalid active kek is rejected", func() {
+			_, err := NewLocalBackend(keks, "kek-3")
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("Then a kek with an invalid length is rejected", func() {
+			_, err := NewLocalBackend(map[string][]byte{"kek-1": make([]byte, 8)}, "kek-1")
+			So(err, ShouldNotBeNil)
+		})
+	})
+
+	Convey("Given a set of keks to parse", t, func() {
+		label, kek, err := ParseKEK("kek-1=000102030405060708090a0b0c0d0e0f")
+		So(err, ShouldBeNil)
+		So(label, ShouldEqual, "kek-1")
+		So(kek, ShouldHaveLength, 16)
+
+		_, _, err = ParseKEK("000102030405060708090a0b0c0d0e0f")
+		So(err, ShouldNotBeNil)
+
+		_, _, err = ParseKEK("kek-1=xyz")
+		So(err, ShouldNotBeNil)
+	})
+}
+
+func TestVaultBackend(t *testing.T) {
+	Convey("Given a test Vault server", t, func() {
+		paths := make(chan string, 1)
+		tokens := make(chan string, 1)
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			paths <- r.URL.Path
+			tokens <- r.Header.Get("X-Vault-Token")
+
+			var req map[string]string
+			json.NewDecoder(r.Body).Decode(&req)
+
+			// the test server "encrypts" by prefixing the plaintext
+			switch r.URL.Path {
+			case "/v1/transit/encrypt/lora":
+				json.NewEncoder(w).Encode(map[string]map[string]string{
+					"data": {"ciphertext": "vault:v1:" + req["plaintext"]},
+				})
+			case "/v1/transit/decrypt/lora":
+				json.NewEncoder(w).Encode(map[string]map[string]string{
+					"data": {"plaintext": req["ciphertext"][len("vault:v1:"):]},
+				})
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+		defer server.Close()
+
+		b := NewVaultBackend(server.URL, "token", "transit", "lora", time.Second)
+		key := []byte{1, 2, 3, 4, 5, 6, 7, 8, 1, 2, 3, 4, 5, 6, 7, 8}
+
+		Convey("When wrapping a key", func() {
+			env, err := b.Wrap(key)
+			So(err, ShouldBeNil)
+			So(<-paths, ShouldEqual, "/v1/transit/encrypt/lora")
+			So(<-tokens, ShouldEqual, "token")
+
+			Convey("Then the envelope contains the ciphertext", func() {
+				So(env, ShouldResemble, Envelope{
+					KEKLabel: "vault:lora",
+					Key:      []byte("vault:v1:" + base64.StdEncoding.EncodeToString(key)),
+				})
+			})
+
+			Convey("Then it can be unwrapped", func() {
+				k, err := b.Unwrap(env)
+				So(err, ShouldBeNil)
+				So(<-paths, ShouldEqual, "/v1/transit/decrypt/lora")
+				So(k, ShouldResemble, key)
+			})
+		})
+
+		Convey("Then an envelope of an other backend is rejected", func() {
+			_, err := b.Unwrap(Envelope{KEKLabel: "kek-1"})
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
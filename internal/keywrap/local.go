@@ -0,0 +1,76 @@
+package keywrap
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// LocalBackend wraps the keys using a set of KEKs configured locally. New
+// keys are wrapped using the active KEK, the other KEKs are used to unwrap
+// the keys wrapped before a KEK rotation.
+type LocalBackend struct {
+	keks   map[string][]byte
+	active string
+}
+
+// NewLocalBackend creates a new LocalBackend. The KEKs must be AES-128,
+// AES-192 or AES-256 keys and the active KEK must be part of the set.
+func NewLocalBackend(keks map[string][]byte, active string) (*LocalBackend, error) {
+	for label, kek := range keks {
+		switch len(kek) {
+		case 16, 24, 32:
+		default:
+			return nil, fmt.Errorf("kek %s must be 16, 24 or 32 bytes, got: %d", label, len(kek))
+		}
+	}
+	if _, ok := keks[active]; !ok {
+		return nil, fmt.Errorf("active kek %s is not configured", active)
+	}
+
+	return &LocalBackend{
+		keks:   keks,
+		active: active,
+	}, nil
+}
+
+// ParseKEK parses the given KEK. The KEK has the format label=hexkey.
+func ParseKEK(s string) (string, []byte, error) {
+	parts := strings.SplitN(s, "=", 2)
+	if len(parts) != 2 || parts[0] == "" {
+		return "", nil, errors.New("invalid kek, expected label=hexkey")
+	}
+	kek, err := hex.DecodeString(parts[1])
+	if err != nil {
+		return "", nil, fmt.Errorf("decode kek %s error: %s", parts[0], err)
+	}
+	return parts[0], kek, nil
+}
+
+// KEKLabel returns the label of the active KEK.
+func (b *LocalBackend) KEKLabel() string {
+	return b.active
+}
+
+// Wrap wraps the given key using the active KEK.
+func (b *LocalBackend) Wrap(key []byte) (Envelope, error) {
+	wrapped, err := Wrap(b.keks[b.active], key)
+	if err != nil {
+		return Envelope{}, err
+	}
+	return Envelope{
+		KEKLabel: b.active,
+		Key:      wrapped,
+	}, nil
+}
+
+// Unwrap unwraps the key of the given envelope using the KEK matching its
+// label.
+func (b *LocalBackend) Unwrap(env Envelope) ([]byte, error) {
+	kek, ok := b.keks[env.KEKLabel]
+	if !ok {
+		return nil, fmt.Errorf("unknown kek: %s", env.KEKLabel)
+	}
+	return Unwrap(kek, env.Key)
+}
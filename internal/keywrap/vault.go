@@ -0,0 +1,119 @@
+package keywrap
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// vaultLabelPrefix defines the prefix of the KEK label of the keys wrapped
+// by Vault, the label contains the name of the transit key.
+const vaultLabelPrefix = "vault:"
+
+// VaultBackend wraps the keys using the transit secrets engine of Vault.
+// The KEK never leaves Vault, key rotation is handled by the versioning of
+// the transit key.
+type VaultBackend struct {
+	server  string
+	token   string
+	mount   string
+	keyName string
+	client  *http.Client
+}
+
+// NewVaultBackend creates a new VaultBackend, using the given transit key
+// of the transit secrets engine mounted at the given path (e.g. transit).
+func NewVaultBackend(server, token, mount, keyName string, timeout time.Duration) *VaultBackend {
+	return &VaultBackend{
+		server:  strings.TrimSuffix(server, "/"),
+		token:   token,
+		mount:   strings.Trim(mount, "/"),
+		keyName: keyName,
+		client:  &http.Client{Timeout: timeout},
+	}
+}
+
+// KEKLabel returns the label of the active KEK.
+func (b *VaultBackend) KEKLabel() string {
+	return vaultLabelPrefix + b.keyName
+}
+
+// Wrap wraps the given key using the transit key.
+func (b *VaultBackend) Wrap(key []byte) (Envelope, error) {
+	var resp struct {
+		Data struct {
+			Ciphertext string `json:"ciphertext"`
+		} `json:"data"`
+	}
+	err := b.post("encrypt", b.keyName, map[string]string{
+		"plaintext": base64.StdEncoding.EncodeToString(key),
+	}, &resp)
+	if err != nil {
+		return Envelope{}, err
+	}
+
+	return Envelope{
+		KEKLabel: b.KEKLabel(),
+		Key:      []byte(resp.Data.Ciphertext),
+	}, nil
+}
+
+// Unwrap unwraps the key of the given envelope using the transit key
+// matching its label.
+func (b *VaultBackend) Unwrap(env Envelope) ([]byte, error) {
+	if !strings.HasPrefix(env.KEKLabel, vaultLabelPrefix) {
+		return nil, fmt.Errorf("unknown kek: %s", env.KEKLabel)
+	}
+
+	var resp struct {
+		Data struct {
+			Plaintext string `json:"plaintext"`
+		} `json:"data"`
+	}
+	err := b.post("decrypt", strings.TrimPrefix(env.KEKLabel, vaultLabelPrefix), map[string]string{
+		"ciphertext": string(env.Key),
+	}, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := base64.StdEncoding.DecodeString(resp.Data.Plaintext)
+	if err != nil {
+		return nil, fmt.Errorf("decode plaintext error: %s", err)
+	}
+	return key, nil
+}
+
+// post posts the given request to the given transit endpoint (encrypt or
+// decrypt) and decodes the response into v.
+func (b *VaultBackend) post(endpoint, keyName string, req interface{}, v interface{}) error {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+
+	r, err := http.NewRequest("POST", fmt.Sprintf("%s/v1/%s/%s/%s", b.server, b.mount, endpoint, keyName), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	r.Header.Set("Content-Type", "application/json")
+	r.Header.Set("X-Vault-Token", b.token)
+
+	resp, err := b.client.Do(r)
+	if err != nil {
+		return fmt.Errorf("vault %s error: %s", endpoint, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("expected 200 response from vault %s, got: %s", endpoint, strings.TrimSpace(resp.Status))
+	}
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return fmt.Errorf("decode vault %s response error: %s", endpoint, err)
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+// Package lifecycle implements the delivery of the lifecycle (provisioning)
+// notifications to external systems (e.g. a CMDB or billing system) using
+// webhooks.
+package lifecycle
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+
+	"github.com/brocaar/lora-app-server/integration"
+)
+
+// SignatureHeader defines the header containing the hex encoded
+// HMAC-SHA256 signature of the request body (when a secret is configured).
+const SignatureHeader = "X-Lifecycle-Signature"
+
+// webhookTimeout defines the timeout of the webhook requests.
+const webhookTimeout = 10 * time.Second
+
+// Publisher defines the interface for publishing lifecycle notifications.
+type Publisher interface {
+	Publish(n integration.LifecycleNotification) error
+}
+
+// WebhookPublisher posts the lifecycle notifications (JSON encoded) to the
+// configured webhook URLs.
+type WebhookPublisher struct {
+	urls   []string
+	secret []byte
+	client *http.Client
+}
+
+// NewWebhookPublisher creates a new WebhookPublisher. When the given secret
+// is not empty, the requests are signed using HMAC-SHA256.
+func NewWebhookPublisher(urls []string, secret string) *WebhookPublisher {
+	return &WebhookPublisher{
+		urls:   urls,
+		secret: []byte(secret),
+		client: &http.Client{Timeout: webhookTimeout},
+	}
+}
+
+// Publish posts the given notification to all the webhook URLs. The
+// requests are sent asynchronously, errors are logged.
+func (p *WebhookPublisher) Publish(n integration.LifecycleNotification) error {
+	b, err := json.Marshal(n)
+	if err != nil {
+		return fmt.Errorf("marshal lifecycle notification error: %s", err)
+	}
+
+	for _, url := range p.urls {
+		go func(url string) {
+			if err := p.post(url, b); err != nil {
+				log.WithFields(log.Fields{
+					"url":     url,
+					"entity":  n.Entity,
+					"action":  n.Action,
+					"dev_eui": n.DevEUI,
+				}).Errorf("lifecycle: webhook error: %s", err)
+			}
+		}(url)
+	}
+	return nil
+}
+
+// post posts the given body to the given url.
+func (p *WebhookPublisher) post(url string, b []byte) error {
+	req, err := http.NewRequest("POST", url, bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if len(p.secret) != 0 {
+		req.Header.Set(SignatureHeader, Sign(p.secret, b))
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("post error: %s", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("expected 2xx response, got: %s", strings.TrimSpace(resp.Status))
+	}
+	return nil
+}
+
+// Sign returns the hex encoded HMAC-SHA256 of the given body.
+func Sign(secret, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,61 @@
+package lifecycle
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/brocaar/lora-app-server/integration"
+	"github.com/brocaar/lorawan"
+)
+
+func TestWebhookPublisher(t *testing.T) {
+	Convey("Given a test webhook server", t, func() {
+		type request struct {
+			Signature string
+			Body      []byte
+		}
+		requests := make(chan request, 1)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			b, _ := ioutil.ReadAll(r.Body)
+			requests <- request{Signature: r.Header.Get(SignatureHeader), Body: b}
+		}))
+		defer server.Close()
+
+		n := integration.LifecycleNotification{
+			Entity: integration.LifecycleEntityNode,
+			Action: integration.LifecycleActionCreate,
+			DevEUI: lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+			AppEUI: lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1},
+			Name:   "test-node",
+			Time:   time.Date(2016, 12, 1, 12, 0, 0, 0, time.UTC),
+		}
+
+		Convey("When publishing a notification without secret", func() {
+			So(NewWebhookPublisher([]string{server.URL}, "").Publish(n), ShouldBeNil)
+
+			Convey("Then the notification was posted without signature", func() {
+				req := <-requests
+				So(req.Signature, ShouldEqual, "")
+
+				var received integration.LifecycleNotification
+				So(json.Unmarshal(req.Body, &received), ShouldBeNil)
+				So(received, ShouldResemble, n)
+			})
+		})
+
+		Convey("When publishing a notification with secret", func() {
+			So(NewWebhookPublisher([]string{server.URL}, "secret").Publish(n), ShouldBeNil)
+
+			Convey("Then the notification was signed", func() {
+				req := <-requests
+				So(req.Signature, ShouldEqual, Sign([]byte("secret"), req.Body))
+			})
+		})
+	})
+}
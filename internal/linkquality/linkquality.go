@@ -0,0 +1,191 @@
+// Package linkquality implements the link-quality scoring of the nodes,
+// based on the meta-data of the last received uplinks.
+package linkquality
+
+import (
+	"fmt"
+	"math"
+
+	log "github.com/Sirupsen/logrus"
+
+	"github.com/brocaar/lora-app-server/integration"
+	"github.com/brocaar/lora-app-server/internal/alert"
+	"github.com/brocaar/lora-app-server/internal/common"
+	"github.com/brocaar/lora-app-server/internal/storage"
+)
+
+// WindowSize defines the (max) number of uplinks used for calculating the
+// link-quality score.
+const WindowSize = 20
+
+// Link-quality grades.
+const (
+	GradeGood = "GOOD" // score >= 70
+	GradeFair = "FAIR" // score >= 40
+	GradePoor = "POOR" // score < 40
+)
+
+// Weights of the score components (sum must be 1).
+const (
+	snrWeight             = 0.3
+	rssiWeight            = 0.2
+	snrTrendWeight        = 0.1
+	packetLossWeight      = 0.3
+	retransmissionsWeight = 0.1
+)
+
+// Score contains the link-quality score and the metrics it is based on.
+type Score struct {
+	Score           int     // 0 (worst) - 100 (best)
+	RSSI            float64 // average (best gateway) RSSI
+	LoRaSNR         float64 // average (best gateway) SNR
+	SNRTrend        float64 // difference in average SNR between the newest and oldest half of the uplinks
+	PacketLoss      float64 // fraction of the uplinks that was missed (based on the frame-counter)
+	Retransmissions float64 // fraction of the uplinks that was a retransmission
+}
+
+// Grade returns the grade of the given score.
+func Grade(score int) string {
+	switch {
+	case score >= 70:
+		return GradeGood
+	case score >= 40:
+		return GradeFair
+	default:
+		return GradePoor
+	}
+}
+
+// gradeRank returns the rank of the grade of the given score (higher is
+// better).
+func gradeRank(score int) int {
+	switch Grade(score) {
+	case GradeGood:
+		return 2
+	case GradeFair:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// Calculate calculates the link-quality score given the uplinks, sorted
+// newest first (as returned by storage.GetLastNodeUplinks). It returns
+// false when no uplinks are given.
+func Calculate(uplinks []storage.NodeUplink) (Score, bool) {
+	var s Score
+	if len(uplinks) == 0 {
+		return s, false
+	}
+
+	var rssiSum, snrSum, newSNRSum, oldSNRSum float64
+	var missed, retransmissions, received int
+	half := len(uplinks) / 2
+
+	for i, u := range uplinks {
+		rssiSum += float64(u.RSSI)
+		snrSum += u.LoRaSNR
+		if i < half {
+			newSNRSum += u.LoRaSNR
+		} else if i >= len(uplinks)-half {
+			oldSNRSum += u.LoRaSNR
+		}
+
+		// uplinks are sorted newest first, compare with the previous
+		// (older) uplink
+		if i == len(uplinks)-1 {
+			received++
+			continue
+		}
+		prev := uplinks[i+1]
+		switch {
+		case u.FCnt == prev.FCnt:
+			retransmissions++
+		case u.FCnt > prev.FCnt:
+			received++
+			missed += int(u.FCnt - prev.FCnt - 1)
+		default:
+			// frame-counter reset (e.g. re-join)
+			received++
+		}
+	}
+
+	s.RSSI = rssiSum / float64(len(uplinks))
+	s.LoRaSNR = snrSum / float64(len(uplinks))
+	if half > 0 {
+		s.SNRTrend = (newSNRSum - oldSNRSum) / float64(half)
+	}
+	s.PacketLoss = float64(missed) / float64(received+missed)
+	s.Retransmissions = float64(retransmissions) / float64(len(uplinks))
+
+	score := snrWeight*clamp((s.LoRaSNR+15)/20) +
+		rssiWeight*clamp((s.RSSI+125)/35) +
+		snrTrendWeight*clamp(1+s.SNRTrend/10) +
+		packetLossWeight*(1-s.PacketLoss) +
+		retransmissionsWeight*(1-s.Retransmissions)
+	s.Score = int(math.Floor(score*100 + 0.5))
+
+	return s, true
+}
+
+// UpdateNodeScore calculates and stores the link-quality score of the given
+// node. When the grade of the score degrades, a link-quality notification
+// is sent to the handler.
+func UpdateNodeScore(ctx common.Context, node storage.Node) error {
+	uplinks, err := storage.GetLastNodeUplinks(ctx.DB, node.DevEUI, WindowSize)
+	if err != nil {
+		return err
+	}
+
+	s, ok := Calculate(uplinks)
+	if !ok {
+		return nil
+	}
+
+	if err := storage.UpdateNodeLinkScore(ctx.DB, node.DevEUI, s.Score); err != nil {
+		return err
+	}
+
+	if node.LinkScore == nil || gradeRank(s.Score) >= gradeRank(*node.LinkScore) {
+		return nil
+	}
+
+	log.WithFields(log.Fields{
+		"dev_eui":        node.DevEUI,
+		"score":          s.Score,
+		"previous_score": *node.LinkScore,
+	}).Info("link-quality degraded")
+
+	err = ctx.Handler.SendLinkQualityNotification(node.AppEUI, node.DevEUI, integration.LinkQualityNotification{
+		DevEUI:          node.DevEUI,
+		Score:           s.Score,
+		PreviousScore:   *node.LinkScore,
+		Grade:           Grade(s.Score),
+		PreviousGrade:   Grade(*node.LinkScore),
+		RSSI:            s.RSSI,
+		LoRaSNR:         s.LoRaSNR,
+		SNRTrend:        s.SNRTrend,
+		PacketLoss:      s.PacketLoss,
+		Retransmissions: s.Retransmissions,
+	})
+	if err != nil {
+		return fmt.Errorf("send link-quality notification error: %s", err)
+	}
+
+	if ctx.Alerter != nil {
+		err = ctx.Alerter.Send(alert.Alert{
+			Type:    alert.TypeLinkQuality,
+			AppEUI:  &node.AppEUI,
+			DevEUI:  &node.DevEUI,
+			Message: fmt.Sprintf("Link-quality of node %s (%s) degraded from %s (%d) to %s (%d).", node.Name, node.DevEUI, Grade(*node.LinkScore), *node.LinkScore, Grade(s.Score), s.Score),
+		})
+		if err != nil {
+			return fmt.Errorf("send link-quality alert error: %s", err)
+		}
+	}
+	return nil
+}
+
+func clamp(f float64) float64 {
+	return math.Max(0, math.Min(1, f))
+}
@@ -0,0 +1,71 @@
+package linkquality
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/brocaar/lora-app-server/internal/storage"
+)
+
+func TestCalculate(t *testing.T) {
+	Convey("Given a test table", t, func() {
+		testTable := []struct {
+			Description string
+			Uplinks     []storage.NodeUplink
+			Expected    Score
+			OK          bool
+		}{
+			{
+				Description: "No uplinks",
+				OK:          false,
+			},
+			{
+				Description: "Perfect link",
+				Uplinks: []storage.NodeUplink{
+					{FCnt: 2, RSSI: -80, LoRaSNR: 10},
+					{FCnt: 1, RSSI: -80, LoRaSNR: 10},
+				},
+				Expected: Score{Score: 100, RSSI: -80, LoRaSNR: 10},
+				OK:       true,
+			},
+			{
+				Description: "Half of the uplinks missed",
+				Uplinks: []storage.NodeUplink{
+					{FCnt: 4, RSSI: -80, LoRaSNR: 10},
+					{FCnt: 2, RSSI: -80, LoRaSNR: 10},
+				},
+				Expected: Score{Score: 90, RSSI: -80, LoRaSNR: 10, PacketLoss: 1.0 / 3},
+				OK:       true,
+			},
+			{
+				Description: "Retransmission and degrading SNR",
+				Uplinks: []storage.NodeUplink{
+					{FCnt: 2, RSSI: -125, LoRaSNR: -15},
+					{FCnt: 2, RSSI: -125, LoRaSNR: -15},
+					{FCnt: 1, RSSI: -125, LoRaSNR: -5},
+				},
+				Expected: Score{Score: 42, RSSI: -125, LoRaSNR: -35.0 / 3, SNRTrend: -10, Retransmissions: 1.0 / 3},
+				OK:       true,
+			},
+		}
+
+		for _, test := range testTable {
+			Convey("Test: "+test.Description, func() {
+				s, ok := Calculate(test.Uplinks)
+				So(ok, ShouldEqual, test.OK)
+				So(s, ShouldResemble, test.Expected)
+			})
+		}
+	})
+}
+
+func TestGrade(t *testing.T) {
+	Convey("Then the expected grades are returned", t, func() {
+		So(Grade(100), ShouldEqual, GradeGood)
+		So(Grade(70), ShouldEqual, GradeGood)
+		So(Grade(69), ShouldEqual, GradeFair)
+		So(Grade(40), ShouldEqual, GradeFair)
+		So(Grade(39), ShouldEqual, GradePoor)
+	})
+}
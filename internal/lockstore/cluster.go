@@ -0,0 +1,336 @@
+package lockstore
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/garyburd/redigo/redis"
+)
+
+const (
+	// clusterSlots is the number of hash slots of a Redis Cluster.
+	clusterSlots = 16384
+
+	// clusterMaxRedirects is the max. number of MOVED / ASK redirections
+	// followed for a single command.
+	clusterMaxRedirects = 5
+
+	clusterMaxIdle     = 10
+	clusterIdleTimeout = 240 * time.Second
+)
+
+// ClusterStore implements a Store using a Redis Cluster. Each key is sent
+// to the master serving its hash slot. The slot map is loaded from the
+// cluster on creation and reloaded on a MOVED redirection or when a node
+// can't be reached (e.g. after a failover).
+type ClusterStore struct {
+	seeds   []string
+	options []redis.DialOption
+
+	mu    sync.RWMutex
+	slots [clusterSlots]string // address of the master serving each slot
+	pools map[string]*redis.Pool
+}
+
+// NewClusterStore creates a new ClusterStore, using the given addresses
+// (host:port of one or multiple nodes of the cluster) to load the slot map.
+// The given dial options (e.g. password and TLS) are used for all the
+// nodes.
+func NewClusterStore(addrs []string, options ...redis.DialOption) (*ClusterStore, error) {
+	if len(addrs) == 0 {
+		return nil, errors.New("at least one cluster address is required")
+	}
+
+	s := ClusterStore{
+		seeds:   addrs,
+		options: options,
+		pools:   make(map[string]*redis.Pool),
+	}
+	if err := s.refresh(); err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+// SetNX sets the given key when it does not exist.
+func (s *ClusterStore) SetNX(key, value string, ttl time.Duration) (bool, error) {
+	return setNX(s.doFunc(key), key, value, ttl)
+}
+
+// Get returns the value of the given key.
+func (s *ClusterStore) Get(key string) (string, error) {
+	return get(s.doFunc(key), key)
+}
+
+// Eval evaluates the given script on the master serving the slot of the
+// given keys.
+func (s *ClusterStore) Eval(script *Script, keys []string, args ...interface{}) (interface{}, error) {
+	if len(keys) == 0 {
+		return nil, errors.New("at least one key is required")
+	}
+	slot := HashSlot(keys[0])
+	for _, k := range keys[1:] {
+		if HashSlot(k) != slot {
+			return nil, fmt.Errorf("keys %s and %s are not in the same hash slot", keys[0], k)
+		}
+	}
+	return eval(s.doFunc(keys[0]), script, keys, args)
+}
+
+// Close closes the connection pools of all the nodes.
+func (s *ClusterStore) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for addr, p := range s.pools {
+		p.Close()
+		delete(s.pools, addr)
+	}
+	return nil
+}
+
+// doFunc returns the function executing the commands on the given key.
+func (s *ClusterStore) doFunc(key string) doFunc {
+	return func(cmd string, args ...interface{}) (interface{}, error) {
+		return s.do(key, cmd, args...)
+	}
+}
+
+// do executes the given command on the master serving the slot of the
+// given key, following the MOVED and ASK redirections.
+func (s *ClusterStore) do(key, cmd string, args ...interface{}) (interface{}, error) {
+	s.mu.RLock()
+	addr := s.slots[HashSlot(key)]
+	s.mu.RUnlock()
+
+	var asking, refreshed bool
+	for i := 0; i <= clusterMaxRedirects; i++ {
+		if addr == "" {
+			return nil, fmt.Errorf("no node serves the slot of key %s", key)
+		}
+
+		reply, err := s.doOn(addr, asking, cmd, args...)
+		asking = false
+		if err == nil {
+			return reply, nil
+		}
+
+		if rerr, ok := err.(redis.Error); ok {
+			kind, target, ok := parseRedirect(rerr)
+			if !ok {
+				return reply, err
+			}
+			addr = target
+			switch kind {
+			case "MOVED":
+				// the slot was moved permanently, the slot map must be
+				// reloaded for the next commands
+				if err := s.refresh(); err != nil {
+					log.Errorf("lockstore: reload cluster slots error: %s", err)
+				}
+			case "ASK":
+				asking = true
+			}
+			continue
+		}
+
+		// the node can't be reached, e.g. after a failover the slot is
+		// served by the promoted replica
+		if refreshed {
+			return nil, err
+		}
+		if rerr := s.refresh(); rerr != nil {
+			return nil, err
+		}
+		refreshed = true
+
+		s.mu.RLock()
+		addr = s.slots[HashSlot(key)]
+		s.mu.RUnlock()
+	}
+
+	return nil, fmt.Errorf("too many redirections for key %s", key)
+}
+
+// doOn executes the given command on the node with the given address.
+func (s *ClusterStore) doOn(addr string, asking bool, cmd string, args ...interface{}) (interface{}, error) {
+	c := s.pool(addr).Get()
+	defer c.Close()
+
+	if asking {
+		if _, err := c.Do("ASKING"); err != nil {
+			return nil, err
+		}
+	}
+	return c.Do(cmd, args...)
+}
+
+// pool returns the connection pool of the node with the given address.
+func (s *ClusterStore) pool(addr string) *redis.Pool {
+	s.mu.RLock()
+	p, ok := s.pools[addr]
+	s.mu.RUnlock()
+	if ok {
+		return p
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if p, ok := s.pools[addr]; ok {
+		return p
+	}
+
+	p = &redis.Pool{
+		MaxIdle:     clusterMaxIdle,
+		IdleTimeout: clusterIdleTimeout,
+		Dial: func() (redis.Conn, error) {
+			return redis.Dial("tcp", addr, s.options...)
+		},
+	}
+	s.pools[addr] = p
+	return p
+}
+
+// refresh reloads the slot map, using the first node (seed or known node)
+// which replies.
+func (s *ClusterStore) refresh() error {
+	s.mu.RLock()
+	addrs := append([]string{}, s.seeds...)
+	for addr := range s.pools {
+		addrs = append(addrs, addr)
+	}
+	s.mu.RUnlock()
+
+	var lastErr error
+	for _, addr := range addrs {
+		reply, err := s.doOn(addr, false, "CLUSTER", "SLOTS")
+		if err != nil {
+			lastErr = err
+			continue
+		}
+
+		ranges, err := parseClusterSlots(reply)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+
+		s.mu.Lock()
+		for i := range s.slots {
+			s.slots[i] = ""
+		}
+		for _, r := range ranges {
+			for slot := r.start; slot <= r.end && slot < clusterSlots; slot++ {
+				s.slots[slot] = r.addr
+			}
+		}
+		s.mu.Unlock()
+
+		log.WithFields(log.Fields{
+			"node":   addr,
+			"ranges": len(ranges),
+		}).Info("lockstore: cluster slots loaded")
+		return nil
+	}
+
+	return fmt.Errorf("load cluster slots error: %s", lastErr)
+}
+
+// slotRange contains the address of the master serving a range of slots.
+type slotRange struct {
+	start int
+	end   int
+	addr  string
+}
+
+// parseClusterSlots parses the reply of the CLUSTER SLOTS command. Each item
+// of the reply contains the start and end slot, followed by the master and
+// the replicas (ip, port and id).
+func parseClusterSlots(reply interface{}) ([]slotRange, error) {
+	items, err := redis.Values(reply, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var ranges []slotRange
+	for _, item := range items {
+		fields, err := redis.Values(item, nil)
+		if err != nil {
+			return nil, err
+		}
+		if len(fields) < 3 {
+			return nil, fmt.Errorf("invalid cluster slots item: %v", fields)
+		}
+
+		var r slotRange
+		if r.start, err = redis.Int(fields[0], nil); err != nil {
+			return nil, err
+		}
+		if r.end, err = redis.Int(fields[1], nil); err != nil {
+			return nil, err
+		}
+
+		master, err := redis.Values(fields[2], nil)
+		if err != nil {
+			return nil, err
+		}
+		if len(master) < 2 {
+			return nil, fmt.Errorf("invalid cluster slots master: %v", master)
+		}
+		host, err := redis.String(master[0], nil)
+		if err != nil {
+			return nil, err
+		}
+		port, err := redis.Int(master[1], nil)
+		if err != nil {
+			return nil, err
+		}
+		r.addr = net.JoinHostPort(host, strconv.Itoa(port))
+
+		ranges = append(ranges, r)
+	}
+	return ranges, nil
+}
+
+// parseRedirect parses a MOVED or ASK error (e.g. MOVED 3999 127.0.0.1:6381)
+// and returns the kind of redirection and the address of the target node.
+func parseRedirect(err redis.Error) (string, string, bool) {
+	parts := strings.Fields(string(err))
+	if len(parts) != 3 || (parts[0] != "MOVED" && parts[0] != "ASK") {
+		return "", "", false
+	}
+	return parts[0], parts[2], true
+}
+
+// HashSlot returns the hash slot of the given key. When the key contains a
+// hash tag (e.g. {user1000}.following), only the tag is hashed.
+func HashSlot(key string) int {
+	if start := strings.IndexByte(key, '{'); start != -1 {
+		if end := strings.IndexByte(key[start+1:], '}'); end > 0 {
+			key = key[start+1 : start+1+end]
+		}
+	}
+	return int(crc16(key) % clusterSlots)
+}
+
+// crc16 implements the CRC16 (XMODEM) checksum used by Redis Cluster.
+func crc16(s string) uint16 {
+	var crc uint16
+	for i := 0; i < len(s); i++ {
+		crc ^= uint16(s[i]) << 8
+		for j := 0; j < 8; j++ {
+			if crc&0x8000 != 0 {
+				crc = crc<<1 ^ 0x1021
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return crc
+}
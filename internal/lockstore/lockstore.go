@@ -0,0 +1,138 @@
+// Package lockstore implements the store of the (short-lived) locks,
+// deduplication keys and counters shared by the LoRa App Server instances,
+// e.g. to make sure that a downlink payload received by all the instances is
+// handled only once.
+package lockstore
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/garyburd/redigo/redis"
+)
+
+// ErrNotFound is returned when the key does not exist (or has expired).
+var ErrNotFound = errors.New("key does not exist")
+
+// Store defines the interface of the lock store backend.
+type Store interface {
+	// SetNX sets the given key to the given value, expiring after the given
+	// ttl, only when the key does not exist. It returns false when the key
+	// already exists.
+	SetNX(key, value string, ttl time.Duration) (bool, error)
+
+	// Get returns the value of the given key. It returns ErrNotFound when
+	// the key does not exist.
+	Get(key string) (string, error)
+
+	// Eval evaluates the given script on the given keys and arguments and
+	// returns its reply. With a Redis Cluster, all the keys must be in the
+	// same hash slot (e.g. by using a hash tag).
+	Eval(script *Script, keys []string, args ...interface{}) (interface{}, error)
+}
+
+// Script defines a Lua script, evaluated using its SHA1 hash when it is
+// already loaded by the Redis server.
+type Script struct {
+	src  string
+	hash string
+}
+
+// NewScript creates a new Script.
+func NewScript(src string) *Script {
+	sum := sha1.Sum([]byte(src))
+	return &Script{
+		src:  src,
+		hash: hex.EncodeToString(sum[:]),
+	}
+}
+
+// RedisStore implements a Store using a Redis connection pool (a single
+// Redis server or the master discovered using Redis Sentinel, see
+// storage.NewRedisPoolWithOptions).
+type RedisStore struct {
+	pool *redis.Pool
+}
+
+// NewRedisStore creates a new RedisStore.
+func NewRedisStore(p *redis.Pool) *RedisStore {
+	return &RedisStore{pool: p}
+}
+
+// SetNX sets the given key when it does not exist.
+func (s *RedisStore) SetNX(key, value string, ttl time.Duration) (bool, error) {
+	c := s.pool.Get()
+	defer c.Close()
+
+	return setNX(c.Do, key, value, ttl)
+}
+
+// Get returns the value of the given key.
+func (s *RedisStore) Get(key string) (string, error) {
+	c := s.pool.Get()
+	defer c.Close()
+
+	return get(c.Do, key)
+}
+
+// Eval evaluates the given script.
+func (s *RedisStore) Eval(script *Script, keys []string, args ...interface{}) (interface{}, error) {
+	c := s.pool.Get()
+	defer c.Close()
+
+	return eval(c.Do, script, keys, args)
+}
+
+// doFunc executes the given command.
+type doFunc func(cmd string, args ...interface{}) (interface{}, error)
+
+func setNX(do doFunc, key, value string, ttl time.Duration) (bool, error) {
+	_, err := redis.String(do("SET", key, value, "PX", ttlMillis(ttl), "NX"))
+	if err != nil {
+		if err == redis.ErrNil {
+			return false, nil
+		}
+		return false, fmt.Errorf("set key error: %s", err)
+	}
+	return true, nil
+}
+
+func get(do doFunc, key string) (string, error) {
+	v, err := redis.String(do("GET", key))
+	if err != nil {
+		if err == redis.ErrNil {
+			return "", ErrNotFound
+		}
+		return "", fmt.Errorf("get key error: %s", err)
+	}
+	return v, nil
+}
+
+func eval(do doFunc, script *Script, keys []string, args []interface{}) (interface{}, error) {
+	cmdArgs := make([]interface{}, 0, 2+len(keys)+len(args))
+	cmdArgs = append(cmdArgs, script.hash, len(keys))
+	for _, k := range keys {
+		cmdArgs = append(cmdArgs, k)
+	}
+	cmdArgs = append(cmdArgs, args...)
+
+	reply, err := do("EVALSHA", cmdArgs...)
+	if rerr, ok := err.(redis.Error); ok && strings.HasPrefix(string(rerr), "NOSCRIPT") {
+		cmdArgs[0] = script.src
+		reply, err = do("EVAL", cmdArgs...)
+	}
+	return reply, err
+}
+
+// ttlMillis returns the given ttl in milliseconds, at least 1 as Redis
+// refuses an expiration of 0.
+func ttlMillis(ttl time.Duration) int64 {
+	if ms := int64(ttl / time.Millisecond); ms > 0 {
+		return ms
+	}
+	return 1
+}
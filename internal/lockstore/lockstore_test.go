@@ -0,0 +1,150 @@
+package lockstore
+
+import (
+	"testing"
+	"time"
+
+	"github.com/garyburd/redigo/redis"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lora-app-server/internal/test"
+)
+
+func TestRedisStore(t *testing.T) {
+	conf := test.GetConfig()
+
+	Convey("Given a clean Redis database and a RedisStore", t, func() {
+		p := storage.NewRedisPool(conf.RedisURL)
+		test.MustFlushRedis(p)
+		s := NewRedisStore(p)
+
+		Convey("Then getting a key which does not exist returns ErrNotFound", func() {
+			_, err := s.Get("lora:as:test")
+			So(err, ShouldEqual, ErrNotFound)
+		})
+
+		Convey("When setting a key", func() {
+			ok, err := s.SetNX("lora:as:test", "foo", 100*time.Millisecond)
+			So(err, ShouldBeNil)
+			So(ok, ShouldBeTrue)
+
+			Convey("Then setting it again fails and its value is returned", func() {
+				ok, err := s.SetNX("lora:as:test", "bar", 100*time.Millisecond)
+				So(err, ShouldBeNil)
+				So(ok, ShouldBeFalse)
+
+				v, err := s.Get("lora:as:test")
+				So(err, ShouldBeNil)
+				So(v, ShouldEqual, "foo")
+			})
+
+			Convey("Then a script can read the key", func() {
+				script := NewScript(`return redis.call("GET", KEYS[1])`)
+				v, err := redis.String(s.Eval(script, []string{"lora:as:test"}))
+				So(err, ShouldBeNil)
+				So(v, ShouldEqual, "foo")
+			})
+
+			Convey("Then the key can be set again after it expired", func() {
+				time.Sleep(150 * time.Millisecond)
+				ok, err := s.SetNX("lora:as:test", "bar", 100*time.Millisecond)
+				So(err, ShouldBeNil)
+				So(ok, ShouldBeTrue)
+			})
+		})
+	})
+}
+
+func TestEval(t *testing.T) {
+	Convey("Given a script which is not loaded by the server", t, func() {
+		script := NewScript(`return 1`)
+		var cmds []string
+		do := func(cmd string, args ...interface{}) (interface{}, error) {
+			cmds = append(cmds, cmd)
+			if cmd == "EVALSHA" {
+				So(args[0], ShouldEqual, script.hash)
+				return nil, redis.Error("NOSCRIPT No matching script. Please use EVAL.")
+			}
+			So(args, ShouldResemble, []interface{}{script.src, 2, "a", "b", 10})
+			return int64(1), nil
+		}
+
+		Convey("Then eval falls back to sending the script source", func() {
+			reply, err := eval(do, script, []string{"a", "b"}, []interface{}{10})
+			So(err, ShouldBeNil)
+			So(reply, ShouldEqual, int64(1))
+			So(cmds, ShouldResemble, []string{"EVALSHA", "EVAL"})
+		})
+	})
+
+	Convey("Given a ClusterStore", t, func() {
+		s := ClusterStore{pools: make(map[string]*redis.Pool)}
+
+		Convey("Then evaluating a script on keys of different slots fails", func() {
+			_, err := s.Eval(NewScript(`return 1`), []string{"{a}:1", "{b}:2"})
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
+
+func TestHashSlot(t *testing.T) {
+	Convey("Given a set of keys", t, func() {
+		tests := []struct {
+			Key  string
+			Slot int
+		}{
+			{Key: "123456789", Slot: 12739},
+			{Key: "foo", Slot: 12182},
+			{Key: "{foo}.bar", Slot: 12182},
+			{Key: "bar{foo}", Slot: 12182},
+			{Key: "{}foo", Slot: 9500}, // empty hash tag, the whole key is hashed
+		}
+
+		for _, test := range tests {
+			Convey("Test: "+test.Key, func() {
+				So(HashSlot(test.Key), ShouldEqual, test.Slot)
+			})
+		}
+	})
+}
+
+func TestParseClusterSlots(t *testing.T) {
+	Convey("Given a CLUSTER SLOTS reply", t, func() {
+		reply := []interface{}{
+			[]interface{}{int64(0), int64(5460), []interface{}{[]byte("10.0.0.1"), int64(7000), []byte("id1")}, []interface{}{[]byte("10.0.0.4"), int64(7003), []byte("id4")}},
+			[]interface{}{int64(5461), int64(16383), []interface{}{[]byte("10.0.0.2"), int64(7001), []byte("id2")}},
+		}
+
+		Convey("Then the masters of the slot ranges are returned", func() {
+			ranges, err := parseClusterSlots(reply)
+			So(err, ShouldBeNil)
+			So(ranges, ShouldResemble, []slotRange{
+				{start: 0, end: 5460, addr: "10.0.0.1:7000"},
+				{start: 5461, end: 16383, addr: "10.0.0.2:7001"},
+			})
+		})
+	})
+
+	Convey("Given a set of redirection errors", t, func() {
+		tests := []struct {
+			Error  redis.Error
+			Kind   string
+			Target string
+			OK     bool
+		}{
+			{Error: redis.Error("MOVED 3999 127.0.0.1:6381"), Kind: "MOVED", Target: "127.0.0.1:6381", OK: true},
+			{Error: redis.Error("ASK 3999 127.0.0.1:6381"), Kind: "ASK", Target: "127.0.0.1:6381", OK: true},
+			{Error: redis.Error("ERR unknown command")},
+		}
+
+		for _, test := range tests {
+			Convey("Test: "+string(test.Error), func() {
+				kind, target, ok := parseRedirect(test.Error)
+				So(ok, ShouldEqual, test.OK)
+				So(kind, ShouldEqual, test.Kind)
+				So(target, ShouldEqual, test.Target)
+			})
+		}
+	})
+}
@@ -0,0 +1,119 @@
+// Package mailer implements the sending of (plain-text) emails, with
+// optional attachments, over SMTP.
+package mailer
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"mime"
+	"mime/multipart"
+	"net"
+	"net/smtp"
+	"net/textproto"
+	"strings"
+	"time"
+)
+
+// Attachment defines an email attachment.
+type Attachment struct {
+	Filename    string
+	ContentType string
+	Data        []byte
+}
+
+// Mailer sends emails using the configured SMTP server.
+type Mailer struct {
+	server string
+	from   string
+	auth   smtp.Auth
+}
+
+// New creates a new Mailer given the SMTP server (hostname:port), optional
+// credentials and the from address.
+func New(server, username, password, from string) (*Mailer, error) {
+	host, _, err := net.SplitHostPort(server)
+	if err != nil {
+		return nil, fmt.Errorf("invalid smtp server: %s", err)
+	}
+
+	m := Mailer{
+		server: server,
+		from:   from,
+	}
+	if username != "" {
+		m.auth = smtp.PlainAuth("", username, password, host)
+	}
+	return &m, nil
+}
+
+// Send sends an email with the given subject, body and attachments to the
+// given recipients.
+func (m *Mailer) Send(to []string, subject, body string, attachments ...Attachment) error {
+	if len(to) == 0 {
+		return errors.New("no recipients")
+	}
+
+	b, err := m.message(to, subject, body, time.Now(), attachments)
+	if err != nil {
+		return err
+	}
+
+	if err := smtp.SendMail(m.server, m.auth, m.from, to, b); err != nil {
+		return fmt.Errorf("send mail error: %s", err)
+	}
+	return nil
+}
+
+// message returns the MIME encoded message. Without attachments, a
+// single-part plain-text message is returned.
+func (m *Mailer) message(to []string, subject, body string, date time.Time, attachments []Attachment) ([]byte, error) {
+	var msg bytes.Buffer
+	fmt.Fprintf(&msg, "From: %s\r\n", m.from)
+	fmt.Fprintf(&msg, "To: %s\r\n", strings.Join(to, ", "))
+	fmt.Fprintf(&msg, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", subject))
+	fmt.Fprintf(&msg, "Date: %s\r\n", date.Format(time.RFC1123Z))
+	fmt.Fprintf(&msg, "MIME-Version: 1.0\r\n")
+
+	if len(attachments) == 0 {
+		fmt.Fprintf(&msg, "Content-Type: text/plain; charset=utf-8\r\n")
+		fmt.Fprintf(&msg, "\r\n%s\r\n", body)
+		return msg.Bytes(), nil
+	}
+
+	w := multipart.NewWriter(&msg)
+	fmt.Fprintf(&msg, "Content-Type: multipart/mixed; boundary=%s\r\n\r\n", w.Boundary())
+
+	part, err := w.CreatePart(textproto.MIMEHeader{
+		"Content-Type": {"text/plain; charset=utf-8"},
+	})
+	if err != nil {
+		return nil, err
+	}
+	fmt.Fprintf(part, "%s\r\n", body)
+
+	for _, a := range attachments {
+		part, err := w.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {a.ContentType},
+			"Content-Transfer-Encoding": {"base64"},
+			"Content-Disposition":       {mime.FormatMediaType("attachment", map[string]string{"filename": a.Filename})},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		// base64 encoded, wrapped at 76 characters (RFC 2045)
+		enc := base64.StdEncoding.EncodeToString(a.Data)
+		for len(enc) > 76 {
+			fmt.Fprintf(part, "%s\r\n", enc[:76])
+			enc = enc[76:]
+		}
+		fmt.Fprintf(part, "%s\r\n", enc)
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return msg.Bytes(), nil
+}
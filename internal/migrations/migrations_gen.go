@@ -10,6 +10,21 @@
 // ../../migrations/0007_migrate_channels_to_channel_list.sql
 // ../../migrations/0008_relax_fcnt.sql
 // ../../migrations/0009_adr_interval_and_install_margin.sql
+// ../../migrations/0010_application_signing_key.sql
+// ../../migrations/0011_signing_key_expiry.sql
+// ../../migrations/0012_downlink_fport_policy.sql
+// ../../migrations/0013_node_uplink.sql
+// ../../migrations/0014_node_link_score.sql
+// ../../migrations/0015_node_uplink_rx.sql
+// ../../migrations/0016_gateway_downlink.sql
+// ../../migrations/0017_notification_preference.sql
+// ../../migrations/0018_scheduled_report.sql
+// ../../migrations/0019_http_integration.sql
+// ../../migrations/0020_payload_codec.sql
+// ../../migrations/0021_downlink_delivery.sql
+// ../../migrations/0022_influxdb_integration.sql
+// ../../migrations/0023_multicast_group.sql
+// ../../migrations/0024_fuota_deployment.sql
 // DO NOT EDIT!
 
 package migrations
@@ -277,6 +292,706 @@ func _0009_adr_interval_and_install_marginSql() (*asset, error) {
 	return a, nil
 }
 
+var __0010_application_signing_keySql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x8c\x90\xc1\x6e\xb3\x30\x10\x84\xcf\xec\x53\xec\x91\xe8\x27\xd2\xdf\xf6\xc8\xb5\xaf\xd0\xb3\xb5\xc0\x0a\x56\x98\xb5\xbb\x2c\x49\xe9\xd3\x57\x24\x0d\xad\x54\xa5\xea\xcd\xd6\x7c\x1e\xcf\xcc\xf1\x88\xff\x26\xe9\x8d\x9c\xf1\x25\x43\x6b\xbc\x9d\x9c\x9a\xc8\x48\x39\x47\x69\xc9\x25\x69\x98\xa5\x57\xd1\x3e\x8c\xbc\x62\x09\x85\x74\xd8\x48\x3f\xb3\x09\x45\xcc\x26\x13\xd9\x8a\x23\xaf\x15\x14\x23\xaf\x41\x3a\x3c\x91\xb5\x03\x59\xf9\xf4\x78\x40\x4d\x8e\xba\xc4\x88\x8b\xca\xeb\xc2\x15\x14\x94\x73\xe0\x45\xb0\x59\x9d\x69\xd7\x37\x21\xf6\xc9\xc4\x87\x69\x37\x78\xf8\xff\x65\x50\x41\x91\x4d\x4e\xe4\x7c\x09\xf2\xe3\xf5\x35\x7e\x17\xc8\xd1\x65\xe2\xd9\x69\xca\x78\x16\x1f\x2e\x57\x7c\x4f\xca\x3b\x0e\x87\x1a\x6e\x7d\x45\x3b\x7e\xbb\xd7\x37\xdc\xc2\x26\xbd\x87\x94\x9f\xc8\x66\xf9\x7d\xd1\xe7\x74\x56\xe8\x2c\xe5\xbf\xfd\x50\xc3\x15\xfe\x75\xfe\x1a\x3e\x06\x00\x05\xd9\xa7\x47\xb4\x01\x00\x00")
+
+func _0010_application_signing_keySqlBytes() ([]byte, error) {
+	return bindataRead(
+		__0010_application_signing_keySql,
+		"0010_application_signing_key.sql",
+	)
+}
+
+func _0010_application_signing_keySql() (*asset, error) {
+	bytes, err := _0010_application_signing_keySqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "0010_application_signing_key.sql", size: 436, mode: os.FileMode(420), modTime: time.Unix(1792159344, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __0011_signing_key_expirySql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x8c\xcd\xb1\x0d\x02\x31\x0c\x05\xd0\x9a\x4c\xf1\x7b\x74\x13\x5c\xcb\x0a\xd4\x91\xb9\x58\xc1\x22\xb1\xad\xc4\xe8\x80\xe9\x91\xa8\x90\x68\x28\x5f\xf5\x96\x05\xc7\x2e\x75\x50\x30\xce\x9e\xa8\x05\x0f\x04\x5d\x1a\x83\xdc\x9b\x6c\x14\x62\x9a\xa7\x54\x15\xad\xf9\xc6\xcf\x74\xa0\x52\xb0\x59\xbb\x77\x05\x3f\x5c\x06\xcf\x4c\x81\x90\xce\x33\xa8\x3b\x76\x89\xeb\x87\x78\x99\xf2\x9a\xd2\x77\x72\xb2\x5d\xff\x6b\xca\x30\xff\x7d\xd6\xf4\x1e\x00\xed\x8d\xc9\xd3\xb3\x00\x00\x00")
+
+func _0011_signing_key_expirySqlBytes() ([]byte, error) {
+	return bindataRead(
+		__0011_signing_key_expirySql,
+		"0011_signing_key_expiry.sql",
+	)
+}
+
+func _0011_signing_key_expirySql() (*asset, error) {
+	bytes, err := _0011_signing_key_expirySqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "0011_signing_key_expiry.sql", size: 179, mode: os.FileMode(420), modTime: time.Unix(1792159396, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __0012_downlink_fport_policySql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x8c\x50\xbd\x6e\xc2\x40\x0c\x9e\xe3\xa7\xf0\x18\xd4\x20\xd1\x39\x6b\x5f\xa1\x53\x55\x45\xce\x9d\xa1\x16\x8e\xef\xe4\x3b\xa0\x79\xfb\x8a\x40\xab\x0c\xa8\x62\xb3\xec\xcf\xdf\xdf\x76\x8b\x2f\x93\x1c\x9c\x2a\xe3\x7b\x86\xe0\x7c\x9d\x2a\x8d\xca\x18\xd3\xc5\x54\xec\x38\xec\x73\xf2\x3a\xe4\xa4\x12\x66\x6c\xa1\x91\x88\xa3\x1c\x0a\xbb\x90\x62\x76\x99\xc8\x67\x3c\xf2\xdc\x41\x43\x39\x0f\x7c\x12\x1c\xe7\xca\x84\x96\x2a\xda\x49\xb5\x83\x26\xf2\x79\x75\x70\xde\xb3\xb3\x05\x2e\x68\x29\x32\x26\xc3\xc8\xca\x95\x31\x50\x09\x14\xb9\x83\x66\x51\xc5\x32\x91\xaa\x58\x5d\x73\x65\x17\x0b\x92\x49\x0b\x9e\xc9\xc3\x17\x79\xfb\xba\xdb\x6d\x3e\x3e\xff\x40\xb0\xe9\xe1\x37\x8c\x58\xe4\xef\xc7\x61\x86\xbb\xdd\x5b\xc2\xc5\xc5\x23\x58\x7b\x87\x75\xb8\x6c\xaf\xdc\xeb\xde\xde\xd2\xc5\x20\x7a\xca\xcf\x4b\xf5\x70\x7b\xf8\xa7\xe8\x1e\x7e\x06\x00\x4b\xe2\x9d\x53\x9c\x01\x00\x00")
+
+func _0012_downlink_fport_policySqlBytes() ([]byte, error) {
+	return bindataRead(
+		__0012_downlink_fport_policySql,
+		"0012_downlink_fport_policy.sql",
+	)
+}
+
+func _0012_downlink_fport_policySql() (*asset, error) {
+	bytes, err := _0012_downlink_fport_policySqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "0012_downlink_fport_policy.sql", size: 412, mode: os.FileMode(420), modTime: time.Unix(1792159628, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __0013_node_uplinkSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x8c\x93\xb1\x8e\xdb\x30\x0c\x86\x67\xeb\x29\x38\x26\xa8\x0f\x28\x8a\x76\xf2\xda\x57\xe8\x2c\xd0\x12\x93\x10\x27\x53\x2a\x45\x27\x75\x9f\xbe\x48\x2e\xe8\xd9\x3d\x25\xe8\x66\x9b\x9f\x3e\x53\xfc\xc1\x97\x17\xf8\x34\xf1\x51\xd1\x08\x7e\x14\x87\xc9\x48\xc1\x70\x4c\x04\x92\x23\xb9\x0e\x63\x84\x90\xd3\x3c\x09\xcc\x25\xb1\xbc\x7a\x16\x23\x3d\x63\x82\xeb\xc3\x91\x14\x24\x1b\xc8\x9c\x12\x44\x3a\xe0\x9c\x0c\x3e\x0f\xce\x05\xa5\xab\xf3\x5d\xe5\xdf\x8e\xc3\xce\x75\x1c\x61\xe4\x63\x25\x65\x4c\x50\x94\x27\xd4\x05\x5e\x69\xe9\x5d\x17\xe9\xec\x69\x66\x18\x17\x23\x04\xa5\x03\x29\x49\xa0\x7a\xeb\x06\xb2\x40\xa4\x44\x46\x10\xb0\x06\x8c\xf4\xf7\xdf\xbd\xeb\xb0\x94\xd5\xd1\x55\x41\x29\x10\x9f\x29\x7a\x34\x30\x9e\xa8\x1a\x4e\x05\x2e\x6c\xa7\xdb\x2b\xfc\xce\xb2\x11\x1d\x7c\x10\xbb\x76\xc8\x62\xdb\xef\x25\xab\x41\x9d\x30\xa5\x7f\x4b\x4a\x3f\x67\x92\xb0\x7c\x18\x4a\xef\xba\x29\xc7\x39\xa1\x71\x16\x38\xa3\x86\x13\xea\xee\xeb\x7e\x0d\xd4\xa2\x84\xd1\x1f\x30\x58\xd6\xa6\x7f\x44\x89\x17\x8e\x76\x6a\xf9\x47\xb6\x5b\x7e\x8d\x92\xd6\xca\x4d\x61\xca\x8a\xbe\x8a\x42\xa4\xc0\x13\xa6\xdd\xb7\xfe\xcb\xa6\xa7\x23\x1a\x5d\x70\xf1\x21\xcf\xd2\xb8\xb3\xdb\xbf\x67\xcc\x12\xe9\xd7\x3a\x63\x7f\x4f\xd1\xaf\x27\x9f\x65\x8d\xec\xee\x48\x0f\x2b\x66\x3f\x3c\x56\xde\xd3\x7d\xa6\xbc\x23\xff\xad\x7c\xa2\xda\x1a\xdc\x7a\x4b\xbe\xe7\x8b\xb8\xa8\xb9\x3c\x37\x0e\x8f\x98\xc6\x45\x1e\xb2\x8d\x39\x0e\xee\x0d\xfe\xb0\x58\x83\x6b\x6c\xef\x0d\x6d\xaf\xef\xe0\xfe\x0c\x00\x14\x17\xea\x82\xfc\x03\x00\x00")
+
+func _0013_node_uplinkSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__0013_node_uplinkSql,
+		"0013_node_uplink.sql",
+	)
+}
+
+func _0013_node_uplinkSql() (*asset, error) {
+	bytes, err := _0013_node_uplinkSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "0013_node_uplink.sql", size: 1020, mode: os.FileMode(420), modTime: time.Unix(1792159769, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __0014_node_link_scoreSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x6c\x8e\x41\x0a\x02\x31\x0c\x45\xd7\xfe\x53\x64\xa9\xc8\x9c\xa0\x5b\xaf\xe0\x7a\xa8\x4d\x90\x62\x9a\x0c\x99\x8a\x1e\x5f\xec\xc6\x01\x67\xfb\x79\x2f\x79\xd3\x44\xe7\x56\xef\x91\xbb\xd0\x75\x41\xd6\x2e\x41\x3d\xdf\x54\xc8\x9c\x05\x87\xcc\x4c\xc5\xf5\xd9\x8c\xb4\xda\x63\x5e\x8b\x87\xd0\xda\xb2\x6a\xb5\x9e\x80\x12\xf2\x95\xab\xb1\xbc\x87\x33\x6f\x38\xb7\x31\x1d\x7f\xd3\x29\x01\xdb\xa7\x17\x7f\x19\x38\x7c\xd9\xbf\x90\xb0\xd3\x34\xf0\xbf\xa8\x84\xcf\x00\xf3\xae\x77\x96\xcd\x00\x00\x00")
+
+func _0014_node_link_scoreSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__0014_node_link_scoreSql,
+		"0014_node_link_score.sql",
+	)
+}
+
+func _0014_node_link_scoreSql() (*asset, error) {
+	bytes, err := _0014_node_link_scoreSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "0014_node_link_score.sql", size: 205, mode: os.FileMode(420), modTime: time.Unix(1792159920, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __0015_node_uplink_rxSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x7c\x91\xb1\x4e\x03\x31\x0c\x86\xe7\xe6\x29\x3c\xde\x89\x76\x41\x62\xca\xca\x2b\x30\x9f\x7c\xb1\xa9\x2c\x1c\xe7\xe4\xa4\xa2\xbc\x3d\x8a\x10\x28\x05\x7a\x5b\xec\xff\xcf\xa7\x4f\xc9\xe9\x04\x0f\x59\xce\x8e\x8d\xe1\x65\x0b\xc9\xb9\x9f\x1a\xae\xca\x60\x85\x78\xb9\x6c\x2a\xf6\xb6\xf8\x15\xa6\x70\x18\x37\x42\xb0\xca\x59\xac\x81\xf3\x2b\x3b\x5b\xe2\x3a\x5e\x81\x62\x40\xac\xdc\x18\x12\xd6\x84\xd4\x81\x0d\xec\xa2\x7a\x0c\x87\x8c\x09\xd6\x8f\xc6\x38\x2e\xbd\x56\x81\x9a\x51\xb5\x63\x87\x40\x8b\xe3\x52\xcd\x81\x38\x49\x46\x9d\x9e\x8e\x8f\xf3\x4f\x21\xcc\x31\x7c\x9b\x8b\x11\x5f\x7f\x99\x2f\xe3\x28\xd4\xc5\x6e\x0b\xd3\x6d\x61\x8e\xbb\xb4\xae\xfe\x17\x91\x31\x75\x8d\xf1\x3d\x9f\xcb\xbb\x05\xf2\xb2\xdd\xe5\xc4\x9d\x78\x1c\x85\x62\xf8\xaa\xfe\xf7\x33\x31\x7c\x0e\x00\xe9\x8d\xea\x5c\xc6\x01\x00\x00")
+
+func _0015_node_uplink_rxSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__0015_node_uplink_rxSql,
+		"0015_node_uplink_rx.sql",
+	)
+}
+
+func _0015_node_uplink_rxSql() (*asset, error) {
+	bytes, err := _0015_node_uplink_rxSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "0015_node_uplink_rx.sql", size: 454, mode: os.FileMode(420), modTime: time.Unix(1792160022, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __0016_gateway_downlinkSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x84\x92\x31\x6e\xeb\x30\x0c\x86\xe7\xe8\x14\x1c\x13\xbc\x04\x78\x9d\xbd\xf6\x0a\x9d\x05\x5a\x62\x6c\x22\x12\xe5\xd2\x74\x5c\xf7\xf4\x45\x12\x14\xb5\xeb\xa0\xde\x24\xfc\x9f\x3e\x89\x14\x4f\x27\xf8\x97\xb9\x51\x34\x82\xb7\xce\x05\xa5\xdb\xca\xb0\x4e\x04\x0d\x1a\x8d\x38\xf9\x58\x46\x49\x2c\x17\xd8\xbb\x1d\x47\xa8\xb9\xe9\x49\x19\x13\x74\xca\x19\x75\x82\x0b\x4d\x47\xb7\xcb\x18\xa0\x9e\x8c\x10\xa4\x18\xc8\x90\xd2\xd1\xed\x22\x5d\x3d\x0d\xbc\x0e\x1e\x37\x45\x8f\x06\xc6\x99\x7a\xc3\xdc\xc1\xc8\xd6\xde\xb7\xf0\x59\x84\xe6\xf8\x59\xe9\x7d\x20\x09\x13\xb0\x18\x35\xa4\xf3\xb0\x1f\x6a\x5f\xa3\x44\xb8\xa2\x86\x16\x75\xff\xf2\xff\xb0\xc8\x3b\x25\x8c\xfe\x8c\xc1\x8a\x42\x9f\x31\x25\x16\x9b\x13\xb7\xd3\x23\x47\x6b\x9f\xe9\x91\xf5\xfe\xa6\xdf\x91\x3b\x54\xee\xbb\x63\x2c\x91\x3e\x56\x1d\xf3\x19\x83\x9f\x55\x5a\x64\x85\xec\x33\x86\x23\xfc\x30\x87\x6a\x43\xb9\xa1\x5b\x98\xdc\xfc\x7b\x5f\xcb\x28\x2e\x6a\xe9\xb6\xcd\xd5\x9f\xdc\xb2\xa8\xca\x3d\xe0\xe7\x33\x53\xb9\xaf\x01\x00\xd2\xc7\xa1\x70\x62\x02\x00\x00")
+
+func _0016_gateway_downlinkSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__0016_gateway_downlinkSql,
+		"0016_gateway_downlink.sql",
+	)
+}
+
+func _0016_gateway_downlinkSql() (*asset, error) {
+	bytes, err := _0016_gateway_downlinkSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "0016_gateway_downlink.sql", size: 610, mode: os.FileMode(420), modTime: time.Unix(1792160116, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __0017_notification_preferenceSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x7c\xd0\xc1\x6a\xc3\x30\x0c\x06\xe0\x73\xfc\x14\x3e\x36\x2c\x85\xb4\x90\x5d\x7a\xdd\x2b\xec\x34\x86\x51\x5d\xb5\x31\x91\x65\x4f\x56\x96\x65\x4f\x3f\xca\x4a\x13\x36\xe8\xcd\xf8\xfb\x41\x3f\xff\x76\x6b\x9f\x62\xb8\x08\x28\xda\xd7\x6c\xbc\xe0\xf5\xa5\x70\x24\xb4\x9c\x34\x9c\x83\x07\x0d\x89\x5d\x16\x3c\xa3\x20\x7b\xb4\x1b\x53\x8d\x05\x85\x21\xa2\xfd\x04\xf1\x3d\xc8\x66\xd7\xb6\xb5\xcd\x12\x22\xc8\x6c\x07\x9c\x1b\x53\x01\xa1\xa8\xd3\x39\x63\xb9\xc7\xba\xb6\x7e\x7b\x6f\x4c\xe5\x7b\x60\x46\x5a\x60\x7f\x03\xc8\x99\x6e\x27\x17\xdc\x3d\xff\xe2\x05\x14\x27\x98\xff\x03\x46\x08\x74\xff\xdd\x77\x5d\x7d\x2d\x6f\x79\x24\x6a\x4c\x35\xe1\xb1\x4f\x69\x70\xa3\x90\x55\xfc\xd2\xb5\x15\x02\x3f\xb8\x47\x89\x8f\x31\xa0\xba\x3e\x8d\x52\x5c\x51\x10\xb5\x25\x02\x51\x60\xfd\x83\xc8\xa7\x35\x69\x88\xf8\x9d\x78\x99\xa8\x6b\x97\x56\xa6\x3e\x18\xb3\xde\xfe\x25\x4d\x6c\x4e\x92\xf2\xe3\xed\x0f\xe6\x67\x00\xa5\x5b\x8b\xdf\xb1\x01\x00\x00")
+
+func _0017_notification_preferenceSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__0017_notification_preferenceSql,
+		"0017_notification_preference.sql",
+	)
+}
+
+func _0017_notification_preferenceSql() (*asset, error) {
+	bytes, err := _0017_notification_preferenceSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "0017_notification_preference.sql", size: 433, mode: os.FileMode(420), modTime: time.Unix(1792160608, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __0018_scheduled_reportSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x8c\x92\xcd\x6e\xea\x30\x10\x85\xd7\xf8\x29\x66\x49\x74\x41\xe2\x22\xb1\xca\xb6\xaf\xd0\x55\x55\x59\x93\x64\x4a\x2c\xec\xb1\x35\x99\x14\xd2\xa7\xaf\xf8\x2b\x8e\x08\xa5\xbb\x44\xdf\xf1\x99\x4f\x9a\x59\x2e\xe1\x5f\x70\x5b\x41\x25\x78\x4d\xa6\x16\x3a\x7e\x29\x56\x9e\xa0\xab\x5b\x6a\x7a\x4f\x8d\x15\x4a\x51\x14\xe6\x66\xe6\x1a\xa8\xdc\xb6\x23\x71\xe8\x21\x89\x0b\x28\x03\xec\x68\x58\x98\x19\x63\x20\xf8\x44\xa9\x5b\x94\xf9\xff\xd5\xaa\x00\x8e\x0a\xdc\x7b\xbf\x30\x33\x4c\xc9\x52\xef\xa0\x1a\x94\x30\x07\xe7\x6a\xab\x43\xba\x3d\x5e\x8f\xdf\x7e\x44\x09\xa8\x59\xf5\x88\x5e\x2d\x1f\xf1\x3d\xd1\xae\xc1\x01\xba\x80\xde\x3b\xd6\x9c\xb5\xb1\x97\x49\xa0\x2e\xd0\x57\xe4\x5b\xe9\x66\x5c\x5a\xb7\xc8\x4c\xfe\xd1\x4c\xa1\xda\x25\x47\xac\xdd\x4f\x62\xbd\xd9\x14\x6f\xef\x27\x9f\xaa\x8d\x71\x67\x7b\xf1\xa0\x74\x18\x8d\x65\x3a\xa8\x95\x9e\x2d\x2a\x1c\x15\x3a\xc5\x90\x60\xef\xb4\x3d\xfd\xc2\x49\x29\xcb\x7b\xec\x9e\xe6\x4d\x51\x9a\xeb\x5e\x1d\x37\x74\xb8\xdb\xab\xbd\x2e\x27\xf2\x1d\x9b\x5f\x58\x51\x3e\xe9\xc8\xd5\xa7\x7a\x32\x7e\x14\xca\xef\xee\x25\xee\xd9\x34\x12\xd3\x1f\xba\xcb\x5f\x83\x17\xd9\xd2\x9c\x53\xd3\x67\x5c\x9a\xef\x01\x00\xa9\xca\xfa\x21\xf5\x02\x00\x00")
+
+func _0018_scheduled_reportSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__0018_scheduled_reportSql,
+		"0018_scheduled_report.sql",
+	)
+}
+
+func _0018_scheduled_reportSql() (*asset, error) {
+	bytes, err := _0018_scheduled_reportSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "0018_scheduled_report.sql", size: 757, mode: os.FileMode(420), modTime: time.Unix(1792160758, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __0019_http_integrationSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x84\x8f\x31\x6e\x84\x40\x0c\x45\x6b\x7c\x0a\x97\x89\x02\x27\xa0\xcd\x15\x52\x8f\x0c\x38\xc1\x30\xd8\x23\xe3\x51\xc2\xed\x23\xb6\xa2\xd8\x15\xdd\x97\xde\x93\xbe\x5e\xd7\xe1\xc7\x26\x3f\x4e\xc1\xf8\x55\x60\x74\x3e\x57\xd0\x90\x19\xe7\x88\x92\x44\x83\x4f\x2c\xa6\xf8\x06\x0d\x95\x92\xb8\x0a\x0e\x47\x30\x61\x71\xd9\xc8\x0f\x5c\xf9\x68\xa1\x99\x99\x26\xf6\x1d\x97\xdd\x14\xd5\x02\xb5\xe6\xdc\x42\x33\x51\x50\xaa\x25\x55\xcf\x18\xfc\x17\x57\xb6\x98\x68\x52\x0b\xf9\x96\xf1\x71\xf2\xd4\xa2\x71\xbd\x97\xd8\xdd\xfc\x46\x83\xf7\x1e\xe0\xda\xfc\x69\xbf\x0a\x93\x5b\x79\xd1\xdc\xc3\xff\x00\xde\x88\x40\xd2\x22\x01\x00\x00")
+
+func _0019_http_integrationSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__0019_http_integrationSql,
+		"0019_http_integration.sql",
+	)
+}
+
+func _0019_http_integrationSql() (*asset, error) {
+	bytes, err := _0019_http_integrationSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "0019_http_integration.sql", size: 290, mode: os.FileMode(420), modTime: time.Unix(1792161675, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __0020_payload_codecSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x74\x8e\x31\x0e\xc2\x30\x0c\x00\xe7\xf8\x15\x1e\x5b\xd1\x4a\x88\xb5\x2b\x5f\x60\x8e\xdc\xc4\x82\x88\x34\xb1\x8c\x0b\xe4\xf7\xa8\x4c\x1d\x60\x3b\xe9\x6e\xb8\x71\xc4\xc3\x92\xae\x4a\xc6\x78\x11\x08\xca\x1b\x19\xcd\x99\x51\xa8\xe5\x4a\xd1\x87\x1a\x39\x60\x07\x8e\x44\x3c\xaf\x09\xe7\x66\x4c\x28\x9a\x16\xd2\x86\x77\x6e\x03\xb8\x6f\xe4\xad\x09\xe3\x93\x34\xdc\x48\xbb\xd3\xb1\xc7\x52\x0d\xcb\x9a\xf3\x00\x2e\xf2\xd6\xf8\x47\xd0\x24\x86\xc6\x6f\xdb\x5b\x2e\x7f\x2d\xf4\x13\xc0\x7e\xf4\x5c\x5f\x05\xa2\x56\xf9\x35\x3a\xc1\x67\x00\x64\x67\x80\x6e\xd4\x00\x00\x00")
+
+func _0020_payload_codecSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__0020_payload_codecSql,
+		"0020_payload_codec.sql",
+	)
+}
+
+func _0020_payload_codecSql() (*asset, error) {
+	bytes, err := _0020_payload_codecSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "0020_payload_codec.sql", size: 212, mode: os.FileMode(420), modTime: time.Unix(1792162004, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __0021_downlink_deliverySql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x94\x53\xc1\x8e\x9b\x30\x14\x3c\xe3\xaf\x98\x5b\x40\xf5\x4a\xdb\x5e\xb9\x26\xaa\x7a\x59\xb5\x87\x9c\x91\x63\xbf\x24\xd6\x9a\x67\x6a\x3f\xc2\xa6\x5f\x5f\xd1\xb0\x21\x2b\xda\xee\xe6\x04\x16\x33\xc3\xcc\x3c\xbf\x87\x07\x7c\x6a\xfd\x21\x19\x21\x6c\x3b\x65\x13\x8d\x6f\x62\x76\x81\xe0\xe2\xc0\xc1\xf3\x73\xe3\x28\xf8\x13\xa5\x33\x4a\x55\x78\x87\x9d\x3f\x78\x16\x74\xc9\xb7\x26\x9d\xf1\x4c\x67\xad\x8a\x0b\xd3\x35\x46\x20\xbe\xa5\x2c\xa6\xed\x30\x78\x39\xfe\x39\xe2\x57\x64\x02\x47\x01\xf7\x21\x68\x55\xf4\x9d\xbb\x07\xee\xe8\xd4\x50\xef\xb1\x3b\x0b\x19\x24\xda\x53\x22\xb6\x94\xc1\xd1\x11\x22\xc3\x51\x20\x21\x58\x93\xad\x71\x6f\xa8\x57\x30\x4e\x26\xd9\xa3\x49\xe5\xe7\xc7\xc7\xea\x16\x61\x23\xef\x7d\x6a\xc9\x61\x17\x63\x20\xc3\xb7\x1f\xb3\x18\xe9\xf3\x95\xfb\xe5\x2d\x75\xdf\x58\x96\xa9\x10\xad\x8a\x4c\x2c\xff\x8b\xa4\xaa\x5a\xbd\x76\xec\xd9\xd1\x0b\xbc\x7b\x69\x16\x3d\x37\x53\xdc\x66\xf6\x1e\x79\x39\x8e\x72\x82\xe9\xb9\x90\xaa\xfe\x88\xfc\x25\x53\xf3\xea\xf6\xaf\xda\x17\x8c\xc6\x04\x1a\x8d\x7b\xce\x94\x04\x9e\x25\x2e\x09\x28\xbd\xd3\x98\x6f\x81\xc6\x3c\x62\x8d\xa5\x53\x8d\x6b\xed\x1a\x97\x9f\x55\x63\x81\x81\xac\x60\x94\xe2\x38\x94\xd5\xf5\xf1\x8e\x80\x35\x99\x30\x1c\x89\xd1\x11\x3b\xcf\x07\xc8\x78\x58\x7d\xdf\x3c\xad\xbf\x3d\x7d\x5d\x81\x42\x26\xac\x7e\x6c\x37\xdb\xcd\x7a\x05\x62\xa7\x8a\x7d\x8a\xed\x9c\xe3\x67\x4f\x3d\xd5\x4a\xdd\x2e\xc4\x3a\x0e\xac\x5c\x8a\xdd\x1d\x6d\xd6\xef\x13\x16\xd3\x9d\x38\xff\xd8\xba\x5a\xfd\x1e\x00\x16\xd8\x0b\x3f\xa5\x03\x00\x00")
+
+func _0021_downlink_deliverySqlBytes() ([]byte, error) {
+	return bindataRead(
+		__0021_downlink_deliverySql,
+		"0021_downlink_delivery.sql",
+	)
+}
+
+func _0021_downlink_deliverySql() (*asset, error) {
+	bytes, err := _0021_downlink_deliverySqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "0021_downlink_delivery.sql", size: 933, mode: os.FileMode(420), modTime: time.Unix(1792162869, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __0022_influxdb_integrationSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x7c\x8f\x41\xae\x83\x30\x0c\x44\xd7\xf8\x14\x5e\xfe\xaf\xc2\x09\xd8\xf6\x0a\x5d\xa3\x04\xa6\xc8\x22\x71\xa2\xd4\x51\xa1\xa7\xaf\x50\x37\x15\x48\xdd\x59\xf2\x9b\x19\xbd\xae\xe3\x4b\x94\xb9\x38\x03\xdf\x32\x8d\x05\xfb\x65\xce\x07\xb0\xe8\x3d\xd4\x75\xf2\x83\xa8\x61\x47\x24\x29\xff\x51\xe3\x72\x1e\x50\x85\xfd\x66\x70\x9c\x8b\x44\x57\x36\x5e\xb0\xb5\xd4\x40\xa7\x9c\x44\x8d\x0d\xab\xb1\x26\x63\xad\x21\xb4\xd4\xa4\x32\x3b\x95\xd7\xa7\xe4\xf8\xf4\x75\x5c\x70\xce\x58\x5a\x70\x86\x23\xdc\xa3\x16\x44\x1c\x57\xe8\xbf\x27\xfa\x16\xba\xa6\xa7\xd2\x54\x52\xfe\x21\xd4\xd3\x7b\x00\x9d\xfd\xd3\xd6\x03\x01\x00\x00")
+
+func _0022_influxdb_integrationSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__0022_influxdb_integrationSql,
+		"0022_influxdb_integration.sql",
+	)
+}
+
+func _0022_influxdb_integrationSql() (*asset, error) {
+	bytes, err := _0022_influxdb_integrationSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "0022_influxdb_integration.sql", size: 259, mode: os.FileMode(420), modTime: time.Unix(1792163734, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __0023_multicast_groupSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x8c\x92\xc1\x6e\xfa\x30\x0c\xc6\xcf\xe4\x29\x7c\x2c\xfa\x83\xc4\xff\xdc\xeb\x5e\x61\xe7\xc8\x24\xa6\x8b\x48\x9c\xcc\x49\x61\x7d\xfb\xa9\x40\xa7\xa8\x94\x6e\xb7\xca\x5f\xfd\xd9\xdf\xcf\xd9\xef\xe1\x5f\x70\x9d\x60\x21\x78\x4f\xca\x08\x8d\x5f\x05\x8f\x9e\x20\xf4\xbe\x38\x83\xb9\xe8\x4e\x62\x9f\xa0\x51\x1b\x67\xe1\xe8\xba\x4c\xe2\xd0\x43\x12\x17\x50\x06\x38\xd3\xb0\x53\x1b\xc6\x40\x70\x41\x31\x1f\x28\xcd\xff\xc3\x61\x0b\x1c\x0b\x70\xef\xfd\x4e\x6d\x30\x25\x4d\xbd\x83\xe3\x50\x08\x6b\x21\x18\x8d\xd6\xca\xa2\xc0\xd7\xb3\xce\xfa\x4c\xc3\x72\x5b\x4a\xaf\x54\x2b\x90\x03\x7a\xef\xb8\xd4\xe5\x93\xd0\x67\x4f\x6c\x06\x70\x5c\xa8\x23\xf9\x11\xd5\xb6\x55\x53\x76\xc7\x96\xbe\xe6\xd9\xf5\x14\x20\xf2\x5c\x6a\x1e\x52\x65\xb1\x88\x4f\x73\xb4\x34\x32\x9c\xd7\xef\x4c\xc7\x5d\x85\x4e\x24\xc4\x86\xf2\xbc\x19\x22\x83\x25\x4f\x85\xc0\x60\x36\x68\xa9\x0e\x66\xe9\x52\xd1\xad\x5c\x6e\x23\x57\x5b\xab\x1b\x42\xf3\xbc\xd9\x0e\x1e\xde\xdb\xdf\x11\x8d\xc3\xf4\xb4\xca\x33\xa7\x5b\xfe\x66\xb2\x6b\x95\xaa\x9f\xde\x5b\xbc\xb2\xb2\x12\xd3\x1f\xbc\xdb\xfb\x8f\xaf\x21\xb7\x6a\xcd\xea\x71\xae\x35\x97\x56\x7d\x0f\x00\x48\x19\x00\xc3\x17\x03\x00\x00")
+
+func _0023_multicast_groupSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__0023_multicast_groupSql,
+		"0023_multicast_group.sql",
+	)
+}
+
+func _0023_multicast_groupSql() (*asset, error) {
+	bytes, err := _0023_multicast_groupSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "0023_multicast_group.sql", size: 791, mode: os.FileMode(420), modTime: time.Unix(1792164059, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __0024_fuota_deploymentSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x94\x54\xcb\x8e\xdb\x30\x0c\x3c\x5b\x5f\xc1\xdb\x3a\xa8\x17\x48\x7b\xf5\xb5\xbf\xd0\xb3\xc0\x58\x8c\x57\xa8\x5e\xa0\xe8\x64\xbd\x5f\x5f\xd8\x89\x51\xed\xda\x75\x93\x5b\x82\x19\x0e\x99\x99\x89\x5e\x5f\xe1\x9b\xb7\x3d\xa3\x10\xfc\x4a\xaa\x63\x9a\x3e\x09\x9e\x1c\xc1\x79\x88\x82\xda\x50\x72\x71\xf4\x14\x04\x6a\x55\x59\x03\x27\xdb\x67\x62\x8b\x0e\x12\x5b\x8f\x3c\xc2\x6f\x1a\x1b\x55\xdd\x66\x8d\x46\x01\xb1\x9e\xb2\xa0\x4f\x70\xb5\xf2\x36\x7f\x85\x8f\x18\x08\x42\x14\x08\x83\x73\x8d\xaa\x86\x64\x9e\xa1\x07\xf4\x04\x17\xe4\xee\x0d\xb9\xfe\x7e\x3c\x1e\x4a\x10\x53\xd2\x34\x58\x38\x8d\x42\x58\x02\x7e\x70\x62\x3b\xcc\xa2\x7b\x8e\x43\xd2\xb7\xf3\x6d\x10\x60\x3a\x13\x53\xe8\x28\xc3\x17\x12\xc4\x00\x86\x1c\x09\x41\x87\xb9\x43\xf3\xe9\x8e\x73\x8a\x2c\x90\x3d\x3a\x37\xc9\x94\x88\x65\x7f\x45\xa6\xf5\x15\x86\x72\xc7\x36\x49\xe4\x65\x7b\x39\xc6\xd8\xeb\x6c\x3f\x68\x53\x94\xc9\x0c\xc1\x60\xe8\x46\xb0\x41\xa8\x27\x2e\xd1\x2c\x28\x7f\x5d\xf9\xf1\xd9\x94\x2c\xc8\xf2\xa8\xbd\x13\x27\x0e\xb2\xb5\xc4\x10\x1a\x67\x03\xfd\x53\x47\x1d\x5a\xb5\x34\xc7\x06\x43\xef\xab\xe6\xe8\x25\xa0\x18\x56\x58\x7d\xc7\x0e\xed\x7f\x34\x6e\x3f\x76\x4b\x61\x46\x8a\x23\xb6\xeb\xab\x43\x34\x34\x75\x78\x05\x6c\xb6\xe2\x2b\x6b\xbf\x16\x86\x2e\x45\x03\x0b\x99\x79\xe9\xee\xe8\x93\x7f\x84\xdd\xcc\xc3\x49\xcf\x7d\x62\xea\xc8\x5e\xc8\xac\xf2\x04\x43\x67\x1c\x9c\xc0\xb1\x51\x95\xb7\x39\xdb\xd0\xcf\x23\xfb\x4c\x62\x8e\x0c\x42\xef\xb2\xc6\x5f\x5e\x1a\x55\x15\x4f\x01\xd4\x1b\x06\x37\x70\x77\xe8\xf0\x40\x5b\x26\xcf\xf4\xe2\xe8\x46\xe0\x73\x92\xf5\x22\xd8\x2a\x55\xbe\x62\x3f\xe3\x35\x28\xc3\x31\x3d\xa2\xde\xde\x98\x3b\x85\x69\xd5\xae\xd8\x9c\x46\xbb\x4b\xb9\xf7\x7b\x77\x55\xab\xfe\x0c\x00\x3e\x02\xe1\x63\x89\x05\x00\x00")
+
+func _0024_fuota_deploymentSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__0024_fuota_deploymentSql,
+		"0024_fuota_deployment.sql",
+	)
+}
+
+func _0024_fuota_deploymentSql() (*asset, error) {
+	bytes, err := _0024_fuota_deploymentSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "0024_fuota_deployment.sql", size: 1417, mode: os.FileMode(420), modTime: time.Unix(1792164307, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __0025_gatewaySql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x94\x93\xcf\xce\x9b\x30\x10\xc4\xcf\xf8\x29\xf6\x48\x54\x22\xa5\x67\xae\x7d\x85\x9e\xad\xc5\xde\x12\x2b\xf8\x8f\x76\x97\x06\xfa\xf4\x15\x89\x54\x40\xa2\x7c\xdf\x77\x03\xcd\x4f\x63\x66\x06\x5f\xaf\xf0\x2d\x86\x9e\x51\x09\x7e\x16\xe3\x98\x96\x27\xc5\x6e\x20\xe8\x51\xe9\x89\x33\xd4\xa6\x8a\xe8\xa0\x9b\x95\x10\x0a\x87\x88\x3c\xc3\x83\xe6\xc6\x54\x6f\xde\x5b\x54\xd0\x10\x49\x14\x63\x81\x67\xd0\xfb\xeb\x15\xfe\xe4\x44\x90\xb2\x42\x1a\x87\xa1\x31\xd5\x58\xfc\x57\xf0\x84\x91\xe0\x37\xb2\xbb\x23\xd7\xdf\x6f\xb7\xcb\x56\xf4\x24\x8e\x43\xd1\x90\x13\x28\x4d\xba\xd5\x06\xd4\xa0\xa3\x27\xf0\x79\x5c\x92\x14\x26\x17\x64\x21\xb7\x50\x4e\xfd\xc7\x14\x0e\x9f\xb1\x42\x51\x2b\x44\xe9\x2c\x59\x63\x2a\x51\x54\xb1\x65\xec\x86\x20\xf7\xf3\x1e\xcc\xa5\x35\x87\x73\xd8\x97\xc9\x7e\x14\xa6\x5f\xc4\x94\x1c\xc9\xbf\xd1\x72\x02\x4f\x03\x29\x81\x43\x71\xe8\x77\xc5\xae\x87\xfe\xef\xf8\x2d\xcd\x93\x2d\xe8\x1e\xa4\x02\x21\x29\xf5\xc4\x3b\x55\x24\x58\x19\x23\x74\xa1\x0f\x69\x37\x83\x24\x7e\x29\x67\xd5\xe9\xa9\xb9\x4e\x16\x03\x2f\x1f\x79\x60\xbf\xf9\x15\xa1\x8e\xe8\x9a\x35\xcd\x65\x5b\x5f\x48\x9e\xa6\x7d\x7d\x76\xcd\x9d\xd3\x5e\xaa\x57\x93\xd6\x98\xed\xfd\xf8\x91\x9f\xc9\x78\xce\xe5\xdc\xb1\x7d\x33\x07\xa3\xb5\xe6\x40\x6a\xcd\xdf\x01\x00\xdc\xe5\xcf\xfa\x84\x03\x00\x00")
+
+func _0025_gatewaySqlBytes() ([]byte, error) {
+	return bindataRead(
+		__0025_gatewaySql,
+		"0025_gateway.sql",
+	)
+}
+
+func _0025_gatewaySql() (*asset, error) {
+	bytes, err := _0025_gatewaySqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "0025_gateway.sql", size: 900, mode: os.FileMode(420), modTime: time.Unix(1792165061, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __0026_organizationSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xac\x94\xc1\x92\x9b\x30\x0c\x86\xcf\xf1\x53\xe8\x08\xd3\xec\x4c\xda\x2b\xd7\xbe\x42\xcf\x1e\x05\xab\xac\x66\x8d\xec\x1a\xd3\x2c\x79\xfa\x8e\xc3\x24\x35\x24\x71\x26\x3b\xb9\x81\x25\xfd\xf8\xff\x24\xf1\xf6\x06\xdf\x7a\xee\x02\x46\x82\x5f\x5e\xb5\x81\xd2\x53\xc4\xbd\x25\x70\xa1\x43\xe1\x23\x46\x76\x02\x95\xda\xb0\x81\x3d\x77\x03\x05\x46\x0b\x3e\x70\x8f\x61\x82\x0f\x9a\xb6\x6a\x33\xd7\x19\x8d\x11\x22\xf7\x34\x44\xec\x3d\x1c\x38\xbe\x9f\x5e\xe1\xe8\x84\x40\x5c\x04\x19\xad\xdd\xaa\xcd\xe8\xcd\x33\xe9\x82\x3d\xc1\x5f\x0c\xed\x3b\x86\xea\xfb\x6e\x57\xe7\xc1\x1e\x3f\xb5\x38\x43\x03\xb0\x44\xea\x28\xac\x83\xc6\x1d\xc4\xb2\x7c\x0c\xda\x53\xd0\x06\xa7\xab\x44\x55\x37\xea\x6c\x7d\x14\xfe\x33\x12\xb0\x18\xfa\x5c\x10\xd0\xa7\x5b\x38\x59\x1c\x56\xe9\x30\xab\xbe\x06\xa7\xd1\x7b\xcb\xed\x05\x22\x7a\xaf\x69\x64\xd8\x4f\x91\x70\x45\x71\x51\x37\xd3\x66\x89\x10\xe8\x37\x05\x92\x96\x86\x85\x32\x38\x01\x43\x96\x22\x41\x8b\x43\x8b\x66\xc1\xec\x99\x8e\xe4\xfe\x6f\x18\xcf\x1c\xe8\x45\x80\x0d\x38\xb9\xeb\xb6\x5a\xe5\x96\x31\x8d\x03\x85\x34\x64\xaf\x64\x90\x34\x8b\xb3\x13\x9c\xfd\x1f\xfc\xb1\xab\xbf\x0a\xf0\xf9\x91\xce\x1a\x0f\x6b\x50\x5b\x38\x5f\xbc\x7e\xd0\x99\x94\xa7\x2f\x2e\xd7\xbd\x48\x81\xea\x22\x55\xa4\x7f\xde\x11\xdd\xba\x51\xe2\xab\xfb\x90\x76\x2e\x2d\x7c\x7e\x36\x7f\xe8\xc6\xca\x96\xc9\x18\x9c\x66\x28\xf9\x8f\xeb\xa7\x3b\x88\x32\xc1\xf9\xc7\xd6\x1a\x35\x27\x3e\x82\xd9\xdc\xd5\x4b\x19\x05\x95\xc2\xb2\xdc\xd7\xcc\x8a\x0a\xd2\xc5\x7b\x35\xea\xdf\x00\x50\x33\xb6\xbc\xcb\x05\x00\x00")
+
+func _0026_organizationSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__0026_organizationSql,
+		"0026_organization.sql",
+	)
+}
+
+func _0026_organizationSql() (*asset, error) {
+	bytes, err := _0026_organizationSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "0026_organization.sql", size: 1483, mode: os.FileMode(420), modTime: time.Unix(1792165484, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __0027_api_keySql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x7c\x91\xc1\x6e\x83\x30\x0c\x86\xcf\xe4\x29\x7c\x6c\x35\x2a\x75\xbb\x72\xdd\x2b\xec\x1c\x19\xe2\x81\x45\x70\x22\xe3\xb6\x4b\x9f\x7e\xea\x10\x1a\xf4\xd0\x5b\xa2\xef\xb7\x3f\xd9\x3e\x9d\xe0\x6d\xe2\x5e\xd1\x08\xbe\xb2\xeb\x94\x1e\x2f\xc3\x36\x12\x60\x66\x3f\x52\x81\x83\xab\x38\x40\xcb\xfd\x4c\xca\x18\x21\x2b\x4f\xa8\x05\x46\x2a\xb5\xab\x96\x92\xe0\xd1\xc0\x78\xa2\xd9\x70\xca\x70\x63\x1b\xfe\xbe\x70\x4f\x42\x20\xc9\x40\x2e\x31\xd6\xae\x12\x9c\x08\xae\xa8\xdd\x80\x7a\x78\x3f\x9f\x8f\x5b\x98\xb4\x47\xe1\x3b\x1a\x27\xf1\x8b\x93\xc5\x40\xe9\x9b\x94\xa4\xa3\x19\xb6\x09\x48\x02\x81\x22\x19\x41\x87\x73\x87\x61\x27\xc2\x9c\x3d\x5d\x18\xda\x62\x84\xb5\xab\x34\xc5\x7f\xf1\xc7\xde\x3b\x52\xf1\x03\xce\xc3\x12\xde\x12\xa5\x6b\x1a\x5f\x4f\xe7\x8e\x8d\x5b\x17\xc7\x12\xe8\x67\x5d\x9c\x7f\x1e\x27\xc9\x8a\x0e\x4f\xe8\xd1\x62\x7b\x8a\xcf\x74\x13\x17\x34\xe5\xd7\x1d\x9b\x25\xb3\x3b\x57\xe3\x7e\x07\x00\xe6\x92\x28\x3c\xd4\x01\x00\x00")
+
+func _0027_api_keySqlBytes() ([]byte, error) {
+	return bindataRead(
+		__0027_api_keySql,
+		"0027_api_key.sql",
+	)
+}
+
+func _0027_api_keySql() (*asset, error) {
+	bytes, err := _0027_api_keySqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "0027_api_key.sql", size: 468, mode: os.FileMode(420), modTime: time.Unix(1792165922, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __0028_event_logSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x7c\x91\xc1\x4e\xc4\x30\x0c\x44\xcf\xf5\x57\xf8\xd8\x8a\xae\x84\xb8\xf6\xca\x2f\x70\x8e\xdc\x8d\xd5\x35\xa4\x49\xe4\x7a\xbb\x84\xaf\x47\x40\xa1\x05\xb6\x7b\x4b\xf4\xc6\x93\x99\xf8\x70\xc0\xbb\x51\x06\x25\x63\x7c\xca\x70\x54\xfe\x38\x19\xf5\x81\x91\x67\x8e\xe6\x42\x1a\xb0\x86\x4a\x3c\xf6\x32\x4c\xac\x42\x01\xb3\xca\x48\x5a\xf0\x85\x4b\x0b\xd5\xd7\x90\x77\x64\x68\x32\xf2\x64\x34\x66\xbc\x88\x9d\x3e\xaf\xf8\x96\x22\x63\x4c\x86\xf1\x1c\x42\x0b\x15\xe5\xec\xf8\x2c\xd8\x17\x63\xda\x02\xcf\xf3\x75\x60\x25\x33\xce\xa4\xc7\x13\x69\xfd\x70\xdf\x6c\x59\xa6\x12\x12\x79\x7c\x9e\x52\xec\x7f\x00\x34\x1d\x7c\x97\x91\xe8\xf9\x75\x2d\xe3\x96\x67\xdc\x26\x76\x8a\x2b\xaf\x17\xde\xe2\x2a\x68\xba\x3d\xb3\x3d\x93\x5f\xb3\xb0\xfd\xe5\xc7\x74\x89\xe0\x35\xe5\x1b\x5e\xdd\x75\xc1\xff\xe4\x8b\xf0\xcf\xbe\x3a\x78\x1f\x00\x68\x46\x8b\x6e\xd7\x01\x00\x00")
+
+func _0028_event_logSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__0028_event_logSql,
+		"0028_event_log.sql",
+	)
+}
+
+func _0028_event_logSql() (*asset, error) {
+	bytes, err := _0028_event_logSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "0028_event_log.sql", size: 471, mode: os.FileMode(420), modTime: time.Unix(1792166279, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __0029_cloud_integrationSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x94\x91\x4d\x6e\xeb\x30\x0c\x84\xd7\xd6\x29\xb8\x7c\x0f\x4d\x4e\xe0\x6d\xaf\xd0\x35\x21\xcb\x03\x43\x89\x22\xb1\x24\x85\xd4\xb7\x2f\x5c\x34\x68\xfe\xba\xe8\x4e\xd0\x0c\x86\xdf\x90\xfb\x3d\xbd\x9c\xf2\xa2\xd1\x41\x6f\x12\x92\x62\x7b\x79\x9c\x0a\x68\x49\xc2\xd2\x27\xeb\x13\xe7\xea\xd8\x4c\xb9\x55\xfa\x17\x86\x28\xc2\xe8\x99\xa6\xd5\x11\x49\x34\x9f\xa2\xae\x74\xc4\xba\x0b\x83\x68\x3b\x20\x39\xe7\x99\x1c\x1f\x4e\xb5\x39\xd5\x5e\xca\x2e\x0c\xde\x24\xa7\x87\xdf\xa4\x98\x51\x3d\xc7\x62\x7c\xb0\x56\xbf\x53\x2f\x8e\xf0\x7f\x0c\xb7\x5c\xf1\x6c\x6c\xd5\xfe\x02\xa5\x58\x36\xf4\xfb\xd1\x31\x25\x98\xf1\x11\xeb\x33\x5c\x43\x52\x38\xff\x98\xee\xc8\x2e\x8d\x38\xea\x63\xf4\x7b\x47\x07\x77\x2d\xb7\xca\x57\x9b\xeb\xa5\xbf\xb6\x73\x0d\xb3\x36\xf9\xbd\xdc\x78\xad\x3f\x3f\xca\x18\x3e\x07\x00\x56\xdb\x90\x21\xc9\x01\x00\x00")
+
+func _0029_cloud_integrationSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__0029_cloud_integrationSql,
+		"0029_cloud_integration.sql",
+	)
+}
+
+func _0029_cloud_integrationSql() (*asset, error) {
+	bytes, err := _0029_cloud_integrationSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "0029_cloud_integration.sql", size: 457, mode: os.FileMode(420), modTime: time.Unix(1792166886, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __0030_azure_iot_hub_integrationSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x84\xce\xb1\x8a\xc3\x30\x0c\xc6\xf1\x39\x7a\x0a\x8d\x77\xdc\x65\xe9\x9a\xb5\xaf\xd0\xd9\xc8\xb1\x92\x8a\x3a\x92\x71\x64\x4a\xfa\xf4\xc5\x50\x4a\xb7\x6e\x02\xfd\xe0\xfb\x8f\x23\xfe\x6d\xb2\x56\x72\xc6\x4b\x81\xb9\x72\xbf\x9c\x62\x66\xa4\x47\xab\x1c\xc4\x3c\x5c\x5b\x0c\xa2\xce\xdd\x89\x29\xfe\xc0\x40\xa5\x04\x6e\x82\xf1\x70\x26\x2c\x55\x36\xaa\x07\xde\xf8\xf8\x87\x61\x36\x55\x9e\x5d\x4c\xc3\xee\x55\x74\x7d\x29\x35\x47\x6d\x39\x77\x72\x4a\x81\xb5\xcf\x24\x8c\x66\x99\x49\xdf\x6f\x4c\xbc\x50\xcb\x8e\x0b\xe5\x9d\xe1\x77\x02\xf8\xcc\x3c\xdb\x5d\x21\x55\x2b\xdf\x32\x27\x78\x0e\x00\x87\xc1\xb4\xd8\xde\x00\x00\x00")
+
+func _0030_azure_iot_hub_integrationSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__0030_azure_iot_hub_integrationSql,
+		"0030_azure_iot_hub_integration.sql",
+	)
+}
+
+func _0030_azure_iot_hub_integrationSql() (*asset, error) {
+	bytes, err := _0030_azure_iot_hub_integrationSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "0030_azure_iot_hub_integration.sql", size: 222, mode: os.FileMode(420), modTime: time.Unix(1792167009, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __0031_device_statusSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x74\x90\xb1\x6e\xeb\x30\x0c\x45\xe7\xf0\x2b\x38\xbe\x87\x26\x40\x77\xaf\xfd\x85\xce\x02\x6d\xb1\x29\x11\x8a\x12\x68\x2a\x81\xfb\xf5\x45\xd2\x0c\x49\x60\x8f\xc2\xbd\x57\x38\x3c\x87\x03\xbe\x15\x39\x3a\x05\xe3\x67\x03\xd2\x60\xc7\xa0\x51\x19\xad\x66\x86\x1d\xe5\x8c\x53\xd5\x5e\x0c\x47\x8a\x60\x5f\x70\x2e\xa4\x2a\x16\xfb\xa7\x54\xc5\x4e\xa9\x90\x1f\xc5\x36\x1a\x99\xcf\x32\x71\x9a\x83\xa2\xcf\x89\x02\x43\x0a\xcf\x41\xa5\xe1\x45\xe2\xfb\xf6\xc4\x9f\x6a\x3c\x00\x4c\xce\x57\xa4\x3f\x92\x97\xa1\xb2\x07\xfe\x83\x1d\xb5\x96\xb8\x0b\x8e\x4b\x30\x61\x73\x29\xe4\x0b\x9e\x78\xd9\xc3\xae\x88\xa5\x3b\x6f\x52\x3e\xb3\x62\xae\xfd\xfa\x57\x73\x9e\x64\x96\x6a\x68\x35\xd0\xba\x2a\x66\xfe\xa2\xae\x81\xef\xf7\xdd\xda\x25\x2b\x6d\xf8\x3f\x00\x3c\xfa\xfb\xa8\x17\x83\xec\xb5\x6d\x63\x0f\xb0\xa2\xf8\x36\xd9\x70\xb4\x7f\x8e\x1f\xd0\x5e\x92\x91\x22\xd8\x97\x01\x7e\x07\x00\xd5\x3f\x27\x0e\xd2\x01\x00\x00")
+
+func _0031_device_statusSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__0031_device_statusSql,
+		"0031_device_status.sql",
+	)
+}
+
+func _0031_device_statusSql() (*asset, error) {
+	bytes, err := _0031_device_statusSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "0031_device_status.sql", size: 466, mode: os.FileMode(420), modTime: time.Unix(1792168100, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __0032_node_locationSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x8c\x8f\x4d\xca\x02\x31\x0c\x86\xd7\x5f\x4f\x91\xfd\x37\x73\x82\xd9\x7a\x05\xd7\x12\x9b\x30\x06\xda\xa4\x74\x52\x06\x3d\xbd\x28\x0a\xfe\x8c\xd0\x65\xf2\x3e\x79\xc9\x33\x8e\xf0\x9f\x65\xae\xe8\x0c\xfb\x12\x30\x39\x57\x70\x3c\x26\x06\x35\xe2\xf0\x87\x44\x10\x2d\xb5\xac\x90\xd0\xc5\x1b\x31\x90\xb5\x1b\x50\x2a\x47\x59\xc4\x74\x78\xc7\x4c\xe7\x1e\x0e\x53\x67\x5d\x44\x17\xd3\x03\xc6\xd8\x2a\xc6\x73\x37\xef\xe0\x92\x79\x71\xcc\x05\x56\xf1\xd3\x7d\x84\x8b\x29\x4f\x21\xbc\x8a\xef\x6c\xd5\x0d\x75\xaa\x56\x36\x5a\x87\x5f\xc9\xe3\xbf\x8f\xfc\xa9\xf9\x75\xa6\xf3\xe6\x1e\x5d\xbc\x11\x4f\xe1\x3a\x00\x45\x43\xc6\xd1\x9b\x01\x00\x00")
+
+func _0032_node_locationSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__0032_node_locationSql,
+		"0032_node_location.sql",
+	)
+}
+
+func _0032_node_locationSql() (*asset, error) {
+	bytes, err := _0032_node_locationSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "0032_node_location.sql", size: 411, mode: os.FileMode(420), modTime: time.Unix(1792168771, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __0033_oidc_userSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x8c\x90\xcf\x4a\xc4\x40\x0c\x87\xcf\x93\xa7\xc8\x71\x8b\x5d\x58\x85\x3d\xed\xd5\x57\xf0\x5c\xb2\xd3\xb0\x8d\xce\x3f\x33\x19\x4b\x7d\x7a\xa9\x82\xd4\xe2\x61\x4f\x49\xc8\x17\xf2\xe3\x3b\x1e\xf1\x21\xca\x4d\xc9\x18\x5f\x0a\x78\xe5\xb5\x33\xba\x06\xc6\x2c\xa3\x1f\x5a\x65\xc5\x03\xb8\xb5\x26\x8a\x8c\x1f\xa4\x7e\x22\x3d\x3c\x9e\x4e\x1d\x16\x95\x48\xba\xe0\x1b\x2f\x3d\xb8\xda\xae\xaf\xec\xed\x17\x79\x3a\x9f\x3b\x4c\xd9\x30\xb5\x10\xb0\x25\x79\x6f\xdc\x83\xe3\x48\x12\xfe\x87\x7a\x70\x7f\x9e\xec\x97\x3f\xf9\xc6\x81\x0c\x4d\x22\x57\xa3\x58\x70\x16\x9b\xbe\x47\xfc\xcc\x89\xb7\x78\xa0\x6a\x43\xc8\x37\x49\x77\x5d\x40\x77\x01\xd8\x1a\x79\xce\x73\x82\x51\x73\xd9\x1b\xb9\xc0\xd7\x00\x8f\x8c\x07\x5c\x39\x01\x00\x00")
+
+func _0033_oidc_userSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__0033_oidc_userSql,
+		"0033_oidc_user.sql",
+	)
+}
+
+func _0033_oidc_userSql() (*asset, error) {
+	bytes, err := _0033_oidc_userSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "0033_oidc_user.sql", size: 313, mode: os.FileMode(420), modTime: time.Unix(1792169273, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __0034_downlink_ruleSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x84\x92\xcd\x8e\xdb\x30\x0c\x84\xcf\xd6\x53\xf0\x98\xa0\x5e\x60\x7b\xe8\xc9\xd7\xbe\x42\xcf\x06\x2d\x4d\x36\xda\x95\x28\x97\x92\x36\x9b\x3e\x7d\xe1\xfc\xa0\x72\x8a\x24\x37\x1b\x1f\x45\x0e\x67\xf8\xf2\x42\xdf\xa2\x7f\x53\x2e\xa0\x5f\xb3\xb1\x8a\xe5\xab\xf0\x14\x40\x2e\x1d\x24\x78\xf9\x18\xb5\x06\xd0\xc6\x74\xde\xd1\xe4\xdf\x32\xd4\x73\xa0\x59\x7d\x64\x3d\xd2\x07\x8e\xbd\xe9\x84\x23\xe8\x93\xd5\xee\x59\x37\xdf\x5f\x5f\xb7\x24\xa9\x90\xd4\x10\x7a\xd3\xf1\x3c\x8f\xa8\x9e\xa6\x63\x01\xb7\xc0\xe1\xb3\x01\x8a\x1d\x14\x62\x91\x49\x92\x03\x25\x21\x87\x80\x02\xb2\x9c\x2d\x3b\xf4\xa6\xcb\x76\x0f\x57\xc3\xfd\x59\xc5\x47\xfc\x49\xf2\xaf\xe0\xc7\x9a\xef\xe6\xa4\x85\x72\xe4\x10\xbc\x94\x96\xd8\x24\x3b\xaf\x11\x8e\xa6\x94\x02\x58\x5a\xe8\xb8\xf0\x59\x66\x6f\xba\x34\xbd\xc3\x16\x7a\xcf\x49\xa6\x65\x77\x7c\x95\x51\xab\x8c\x5c\x68\x19\x9f\x0b\xc7\x99\x0e\xbe\xec\x4f\xbf\x74\x92\xd3\xf4\x0a\x9c\x9f\xd6\x9b\xed\x60\xae\x69\x78\x71\xf8\x5a\xa7\x31\x5e\x2d\x4d\xb2\x06\x9b\x0b\xd8\x0e\x8f\x5e\xb7\x8a\xff\xeb\xd0\xc0\x45\x04\x87\x02\xbd\xbd\x88\xdf\x15\x15\xa6\x63\xe7\xc8\xa6\x50\xe3\x4d\x8f\xf1\x7c\x29\x8b\xc1\x4d\xa8\xab\x92\x26\xdd\x8c\xb3\x35\x83\x31\xed\x39\xfe\x4c\x07\x79\x38\xdd\x69\x9a\xef\x8d\x1f\x8c\x39\xe1\x27\xdb\x0f\xf7\xab\x2e\x46\x5e\x1b\xdd\x48\xd0\x1a\x30\x98\xbf\x03\x00\x92\x7a\xf4\x3d\x3e\x03\x00\x00")
+
+func _0034_downlink_ruleSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__0034_downlink_ruleSql,
+		"0034_downlink_rule.sql",
+	)
+}
+
+func _0034_downlink_ruleSql() (*asset, error) {
+	bytes, err := _0034_downlink_ruleSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "0034_downlink_rule.sql", size: 830, mode: os.FileMode(420), modTime: time.Unix(1792169869, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __0035_dead_letterSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x7c\x92\xcd\x4e\x85\x30\x10\x85\xd7\xf4\x29\x66\x09\x91\x9b\x18\xb7\x6c\x7d\x05\xd7\x64\xb8\x3d\x81\xd1\xd2\x36\xc3\xdc\x1f\x7c\x7a\xa3\xa2\xd6\x5c\x71\x07\xfd\xbe\x39\x69\x4f\x7b\x38\xd0\xdd\x2c\xa3\xb2\x81\x9e\xb2\x3b\x2a\xde\xbf\x8c\x87\x00\xf2\x60\xdf\x07\x98\x41\xa9\x76\x95\x78\x1a\x64\x5c\xa0\xc2\x81\xb2\xca\xcc\xba\xd2\x0b\xd6\xd6\x55\x9f\x63\xbe\x67\x23\x93\x19\x8b\xf1\x9c\xe9\x22\x36\x7d\xfc\xd2\x6b\x8a\xa0\x98\x8c\xe2\x29\x84\xd6\x55\x13\x47\x1f\xa0\x74\x66\x3d\x4e\xac\xf5\xc3\x7d\x53\x62\xce\xb9\xc7\x49\x68\x58\x0d\x5c\x02\x8f\xf3\xdf\xc0\xd6\x8c\xbd\xb4\xcc\x6b\x48\xec\xe9\x79\x49\x71\x28\x01\x54\x93\x92\xe1\x6a\xe5\x2a\x9b\x61\xce\xb6\x90\x44\xc3\x08\xfd\x66\xae\xe9\xdc\x57\x3f\x12\x3d\xae\x65\x3f\xfd\xb6\xe7\xbe\x68\x22\xc5\xd2\xa8\x37\xa3\xa5\x1f\xa5\xe9\xf6\x03\xf7\x83\x7e\xcd\xbb\xf2\x02\x1f\xd3\x25\x3a\xaf\x29\xff\x9b\xd7\xed\x29\xb7\x67\xd8\xd4\x9b\xe7\xd0\xb9\xb7\x01\x00\x32\xc9\x0b\xbe\x38\x02\x00\x00")
+
+func _0035_dead_letterSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__0035_dead_letterSql,
+		"0035_dead_letter.sql",
+	)
+}
+
+func _0035_dead_letterSql() (*asset, error) {
+	bytes, err := _0035_dead_letterSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "0035_dead_letter.sql", size: 568, mode: os.FileMode(420), modTime: time.Unix(1792170430, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __0036_thingsboard_integrationSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x7c\x8f\x41\x4a\x06\x31\x0c\x46\xd7\x93\x53\x64\xf7\x2b\x3a\x27\xe8\xd6\x2b\xb8\x1e\xd2\x69\x1c\xab\x9d\xa4\xa4\xe9\xe8\x20\xde\x5d\xaa\x20\x22\xe2\x3a\xe4\x7b\xef\xcd\x33\xde\xec\x79\x33\x72\xc6\xfb\x0a\x54\x9c\x0d\x9d\x62\x61\x14\x4d\x0c\x13\xa5\x84\xab\x96\xbe\x0b\x1e\x64\x79\x5c\x1a\x3e\x35\x95\x88\xa2\x8e\xd2\x4b\xc1\xc4\x0f\xd4\x8b\xe3\xe5\xed\xfd\x12\x00\x56\xe3\x31\xf7\xb5\xe2\x8f\x59\xb6\x16\x95\x2c\x2d\x59\x9c\x07\x2a\xab\xe0\x15\x4c\x54\xeb\xc2\x3d\x63\x3c\x9d\x09\xab\xe5\x9d\xec\xc4\x67\x3e\x6f\x61\x6a\x6c\xc7\x30\xe1\x57\xff\xe6\xc0\x75\x00\xf8\x29\x7c\xa7\x2f\x02\xc9\xb4\xfe\xcf\x0a\xf0\x47\xd7\xe7\xdb\xef\xb0\x00\x1f\x03\x00\x68\x65\x25\x74\x10\x01\x00\x00")
+
+func _0036_thingsboard_integrationSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__0036_thingsboard_integrationSql,
+		"0036_thingsboard_integration.sql",
+	)
+}
+
+func _0036_thingsboard_integrationSql() (*asset, error) {
+	bytes, err := _0036_thingsboard_integrationSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "0036_thingsboard_integration.sql", size: 272, mode: os.FileMode(420), modTime: time.Unix(1792170961, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __0037_handler_backendSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x6c\xcd\x31\x0a\xc2\x40\x10\x46\xe1\x3a\x73\x8a\xbf\x54\x34\x27\x48\xeb\x15\xac\x44\xc2\x24\x3b\xe8\x92\xcd\xec\x30\x4e\xd0\xbd\xbd\x08\x16\x16\x76\xaf\x78\xf0\xf5\x3d\x0e\x6b\xbe\x39\x87\xe0\x6c\x34\xbb\x7c\x2a\x78\x2a\x82\x3b\x6b\x2a\xe2\xe3\xc4\xf3\x22\x9a\xb0\xa3\x8e\xcd\x46\xd9\x32\xa6\x16\xc2\x30\xcf\x2b\x7b\xc3\x22\xed\x48\xdd\x77\x7b\x20\xe4\x15\x97\x2b\xb4\x06\x74\x2b\x85\xf6\x03\xd1\xaf\x73\xaa\x4f\xa5\xe4\xd5\xfe\x3b\x03\xbd\x07\x00\xc9\x1b\x7d\x37\x95\x00\x00\x00")
+
+func _0037_handler_backendSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__0037_handler_backendSql,
+		"0037_handler_backend.sql",
+	)
+}
+
+func _0037_handler_backendSql() (*asset, error) {
+	bytes, err := _0037_handler_backendSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "0037_handler_backend.sql", size: 149, mode: os.FileMode(420), modTime: time.Unix(1792171697, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __0038_downlink_queue_schedulingSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x84\x8f\x3d\x0e\xc2\x30\x0c\x85\x67\x72\x8a\xb7\xd3\x4a\xec\x5d\xb9\x02\x73\x65\x88\x29\x16\x8e\x53\x52\x47\x05\x4e\x8f\xc4\xd4\x76\x80\xd1\x3f\xdf\xd3\xfb\xda\x16\xfb\x24\x43\x21\x67\x9c\xc6\x40\xea\x5c\xe0\x74\x56\x46\xcc\xb3\xa9\xd8\xbd\x7f\x54\xae\x1c\x76\x14\x23\x2e\x59\x6b\x32\x44\x56\x7a\xf5\xd5\x5c\x14\x2e\x89\x27\xa7\x34\x62\x16\xbf\x7d\x47\xbc\xb3\x71\xb3\x22\x12\x3d\xfb\xc2\x5e\x84\x27\x88\x39\x0f\x5c\x60\xd9\x61\x55\x15\x91\xaf\x54\xd5\x71\x58\x33\xff\xff\xbb\x10\x96\x02\xc7\x3c\xdb\x4f\x85\x58\xf2\xb8\x49\x6f\xd6\xdb\x45\xcf\xcd\x65\xe1\xdc\x85\xcf\x00\x2d\xf9\x41\xed\x37\x01\x00\x00")
+
+func _0038_downlink_queue_schedulingSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__0038_downlink_queue_schedulingSql,
+		"0038_downlink_queue_scheduling.sql",
+	)
+}
+
+func _0038_downlink_queue_schedulingSql() (*asset, error) {
+	bytes, err := _0038_downlink_queue_schedulingSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "0038_downlink_queue_scheduling.sql", size: 311, mode: os.FileMode(420), modTime: time.Unix(1792172042, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __0039_event_filterSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x8c\x90\xc1\x4a\xc4\x40\x0c\x86\xcf\xcd\x53\xe4\xd8\x62\x17\x16\xc1\x53\xaf\xbe\x82\x27\x91\x92\x6e\xb3\x6b\x70\x9a\x19\x33\x99\xd5\xbe\xbd\x54\x57\x99\xc5\xcb\xde\x02\xff\x47\xfe\x8f\x7f\xb7\xc3\xbb\x45\x4e\x46\xce\xf8\x94\xe0\x60\xbc\x5d\x4e\x53\x60\xe4\x33\xab\x8f\x47\x09\xce\x86\x2d\x34\x32\xe3\x24\xa7\xcc\x26\x14\x30\x99\x2c\x64\x2b\xbe\xf1\xda\x43\x43\x29\x8d\x5c\x04\xa7\xd5\x99\x50\xa3\xa3\x96\x10\x7a\x68\x44\x9d\xb7\xef\x12\x15\xcf\x64\x87\x57\xb2\xf6\x61\xdf\xd5\xc8\x77\x4d\xfe\x4b\xef\xf7\xdd\xf3\x4b\x9d\x1f\x53\x34\xcf\x98\x17\x0a\x41\xd4\xab\x10\xba\x01\x7e\x95\x8b\xca\x7b\x61\x14\x9d\xf9\xf3\xca\x7c\xbc\xb8\x8d\xb5\x4a\xd4\x2b\xa6\xbd\x30\x3d\x56\xd0\xf6\xbc\x9e\xe7\x31\x7e\x28\xcc\x16\xd3\x8d\x25\x03\xfc\xd0\xff\xc7\x1c\xe0\x6b\x00\xb6\x18\x06\xc1\x77\x01\x00\x00")
+
+func _0039_event_filterSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__0039_event_filterSql,
+		"0039_event_filter.sql",
+	)
+}
+
+func _0039_event_filterSql() (*asset, error) {
+	bytes, err := _0039_event_filterSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "0039_event_filter.sql", size: 375, mode: os.FileMode(420), modTime: time.Unix(1792172269, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __0040_audit_logSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x84\x54\xc1\xae\x9b\x40\x0c\x3c\xb3\x5f\xe1\x43\xa4\x47\xd4\x44\x7a\x3d\xf4\xc4\xad\x6a\xbf\xa0\xea\x19\x19\xd6\x21\x4e\x97\xdd\xad\xf1\x36\xa1\x5f\x5f\x05\x1a\x08\x11\xb4\xb7\xc4\xf6\xcc\xec\x8c\x2d\x8e\x47\xf8\xd0\x72\x23\xa8\x04\xdf\xa3\xa9\x85\xee\xbf\x14\x2b\x47\x80\xc9\xb2\x96\x2e\x34\x90\x9b\x8c\x2d\x54\xdc\x74\x24\x8c\x0e\xa2\x70\x8b\xd2\xc3\x0f\xea\x0f\x26\x1b\x41\xb6\x44\x05\xe5\x96\x3a\xc5\x36\xc2\x95\xf5\x3c\xfc\x85\xdf\xc1\x13\xf8\xa0\xe0\x93\x73\x07\x93\x61\xad\x41\xe0\x17\x4a\x7d\x46\xc9\x3f\xbe\xbf\xef\x5f\xba\x1c\xfc\x66\x3b\x54\x17\xaa\xb5\xd4\x3e\xd2\x34\xf3\x69\x75\x84\xed\x26\x09\xc6\x58\x52\x62\xa8\x7a\x25\x3c\x98\xcc\x92\x22\xbb\x0e\x2e\x5d\xf0\x95\xd9\x17\xe6\x91\x03\x7b\x4b\xb7\x39\x87\xf2\xc9\x69\xf0\x73\x3d\x9f\xeb\xfb\x62\x0b\x3b\xda\x5e\xc0\x86\xd2\x36\x62\x34\xb2\x84\x3c\xf9\x3f\xc0\xe4\xf4\x1f\xaa\x7f\xad\x2e\x75\xc7\xe2\xdd\xe8\xf3\xfe\xbf\x29\x2a\xb5\xe4\xf5\x33\x35\xec\x1f\x8c\xa7\xe4\x6b\xe5\x67\x7c\x89\x31\x92\xb7\x65\xf0\xae\xcf\xf7\x20\xa4\x49\x7c\x07\x2a\xdc\x34\x24\x80\x1d\xec\x76\xa6\x1a\x38\x32\x41\xee\x08\xe8\x56\x53\x1c\x48\xde\x26\x16\xe0\x0e\x46\xa2\xe3\x9d\xe8\xad\x30\xe4\x6d\x61\x76\x3b\x70\xe8\x9b\x84\x0d\x41\x74\xb1\xe9\x7e\xba\x62\xfd\x95\x5f\xbd\x9d\x16\x35\x69\xaf\xbd\xd1\x64\x15\x9d\x82\x10\xa4\x68\xef\x8e\x82\x80\x25\x47\x4a\x8b\x54\x4c\x76\x0a\x02\x84\xf5\x19\x24\x5c\x81\x6e\x54\x27\x25\x88\x12\x6a\xb2\x49\x68\x9d\x3b\x7f\x0d\xf1\x4b\xb8\x7a\x63\x25\xc4\x39\x8f\x35\xdc\x42\xba\x30\x23\xe2\x7f\x51\x3f\xe6\x36\x96\x5c\xac\x77\xc7\x2b\xd9\x68\x0e\x27\xb8\xd1\x9b\xaf\xfa\x21\xfc\xf2\x5d\x28\xcc\x9f\x01\x00\xad\xd0\xdf\x4b\x3f\x04\x00\x00")
+
+func _0040_audit_logSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__0040_audit_logSql,
+		"0040_audit_log.sql",
+	)
+}
+
+func _0040_audit_logSql() (*asset, error) {
+	bytes, err := _0040_audit_logSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "0040_audit_log.sql", size: 1087, mode: os.FileMode(420), modTime: time.Unix(1792172503, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __0041_e2e_encryptionSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x94\x90\x31\x6e\xc3\x30\x0c\x45\xe7\xea\x14\xdc\x9b\x2c\x5d\xbd\xf6\x0a\x9d\x05\xda\xfc\x0e\x84\x30\xa4\xaa\x50\x0d\x7c\xfb\x0e\xe9\x60\xb9\x40\x81\xae\x82\xf8\x1f\xde\x3b\x9f\xe9\xf5\x56\x2e\x8d\x03\xf4\x51\x13\x6b\xa0\x51\xf0\xac\x20\x73\x41\x7a\x61\x11\x5a\x5c\xfb\xcd\x08\x6f\xc8\xb0\xa5\x6d\x35\x8a\x1b\xcd\xee\x0a\x36\x32\x0f\xb2\xae\x4a\x82\x95\xbb\x06\xad\xac\x77\x9c\x86\x53\xae\x35\xdf\xf3\x15\x5b\x86\x7d\x41\xbd\x82\xe6\x2d\xc0\x53\x1a\x90\xe2\x0f\xd3\x62\xd7\xfc\xd9\xd1\x0f\xf0\x27\x18\xf2\x2f\xee\x9a\x17\x0b\x9a\xcb\xa5\x58\x4c\x29\xed\x6d\xdf\xfd\x61\x7f\xc2\xa5\x79\x1d\x76\x4e\xe3\xdb\x4f\x0a\xc8\x41\xe2\xd9\x6d\xff\xf3\xb7\xfd\x71\x6a\x28\x3b\xa5\xef\x01\x00\x46\x54\x26\x33\x96\x01\x00\x00")
+
+func _0041_e2e_encryptionSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__0041_e2e_encryptionSql,
+		"0041_e2e_encryption.sql",
+	)
+}
+
+func _0041_e2e_encryptionSql() (*asset, error) {
+	bytes, err := _0041_e2e_encryptionSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "0041_e2e_encryption.sql", size: 406, mode: os.FileMode(420), modTime: time.Unix(1792174021, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __0042_usage_statsSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x84\x52\x41\x8e\x83\x30\x0c\x3c\x37\xaf\xf0\xb1\xd5\xd2\x17\x70\xdd\x2f\xec\x39\x0a\x60\x51\xab\x90\x44\xb6\xb3\x2d\xfb\xfa\x15\x94\x15\xa9\x4a\xba\x37\xa3\x19\x0f\x9e\x99\x9c\xcf\xf0\x31\x52\xcf\x4e\x11\xbe\xa2\x69\x19\xe7\x49\x5d\x33\x20\x24\x71\x3d\x5a\x51\xa7\x02\x47\x73\xe8\xf0\xdb\x62\x22\x68\x26\x45\x07\x3e\x28\xf8\x34\x0c\x95\x39\xb8\x18\xf7\x01\xa5\x11\x45\xdd\x18\x61\x9b\x6e\xa4\x97\xe5\x13\x7e\x82\xc7\x9c\xcd\x77\x1b\x5d\x7b\x45\x15\x20\xaf\xd8\x23\x3f\xa1\x22\x64\x25\x8d\xd0\x50\x4f\x5e\x73\x48\x3c\x2f\x48\x17\xd2\x7c\x76\x64\x6c\x49\x28\xf8\x9c\xa3\x6f\xc5\x91\x39\xf0\x2e\x12\x99\x46\xc7\x13\x5c\x71\x82\xe3\x9a\x40\x05\xab\xe3\x6a\xf3\x75\x32\xa7\xda\xfc\xc5\x47\xbe\xc3\x7b\x1e\x9f\x5d\x17\xec\x96\x43\xf0\x39\xe1\xb8\xa3\x58\x97\xe5\x8a\x32\xf9\x76\xb1\x4c\x1b\x53\x33\x90\x5c\xe6\x52\x9f\xbb\xcb\xdc\xce\xde\x1f\x34\xec\xac\xd3\xff\x1b\x5c\x02\xc8\x9f\xd3\x67\xb8\x79\xd3\x71\x88\xe5\x0b\x6a\xf3\x20\xbc\x71\x58\x97\x18\xeb\xe5\x2f\xcc\x97\x9f\xd5\xe6\x77\x00\xf2\x8a\xa1\x80\xe5\x02\x00\x00")
+
+func _0042_usage_statsSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__0042_usage_statsSql,
+		"0042_usage_stats.sql",
+	)
+}
+
+func _0042_usage_statsSql() (*asset, error) {
+	bytes, err := _0042_usage_statsSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "0042_usage_stats.sql", size: 741, mode: os.FileMode(420), modTime: time.Unix(1792174289, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __0043_gateway_organizationSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x7c\x8f\x41\xca\xc2\x40\x0c\x85\xd7\x7f\x4e\x91\xe5\x2f\xd2\x13\xcc\xd6\x2b\xb8\x2e\xe9\xcc\x73\x18\x98\x66\x4a\x1a\xa9\x7a\x7a\x51\x28\x68\x29\xae\x5f\xf2\xbe\xf7\x75\x1d\x1f\xc7\x92\x4d\x1c\x7c\x9e\x48\xaa\xc3\xd8\x65\xa8\xe0\x2c\x8e\x45\xee\xf4\x27\x29\x71\x6c\xf5\x3a\x2a\x37\xcb\xa2\xe5\x21\x5e\x9a\xf6\x25\xf1\x50\x72\x51\x67\xc3\x05\x06\x8d\x98\xbf\x2e\xb8\x29\x27\x54\x38\x38\xca\x1c\x25\x21\x10\x45\xc3\x0b\x56\x34\xe1\xb6\x32\xfa\x6d\x6f\xd3\x35\xfa\xdf\x44\x87\x40\xf4\x39\xfa\xd4\x16\xa5\x64\x6d\xfa\xdd\x18\x68\xdf\xed\xfd\xb9\x2f\x17\xe8\x39\x00\xee\xed\x27\x9c\x1d\x01\x00\x00")
+
+func _0043_gateway_organizationSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__0043_gateway_organizationSql,
+		"0043_gateway_organization.sql",
+	)
+}
+
+func _0043_gateway_organizationSql() (*asset, error) {
+	bytes, err := _0043_gateway_organizationSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "0043_gateway_organization.sql", size: 285, mode: os.FileMode(420), modTime: time.Unix(1792178057, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __0044_downlink_overflowSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x74\x8e\x3d\x6e\xc3\x30\x0c\x46\x67\xf3\x14\x1c\x5b\xd4\x3e\x81\xd7\x5e\xa1\xb3\x41\x5b\xac\x43\x58\x12\x05\x9a\x89\xa2\x9c\x3e\x48\x02\x04\x5e\xb2\x7d\x3f\x78\xc0\x1b\x06\xfc\x49\xb2\x1a\x39\xe3\x5f\x81\xc5\xf8\x91\x9c\xe6\xc8\x18\xb4\xe6\x28\x79\x9b\xf4\xc2\xf6\x1f\xb5\xe2\x17\x74\x12\x70\x96\x75\x67\x13\x8a\x58\x4c\x12\x59\xc3\x8d\x5b\x0f\xdd\x0b\x0e\x13\x39\xba\x24\xde\x9d\x52\xc1\x2a\x7e\x7a\x56\xbc\x69\x66\xcc\xea\x98\xcf\x31\xf6\xd0\xb9\x16\x59\xd0\xf9\xea\xc7\xb5\x50\x8b\x4a\x01\xe7\xe6\x4c\xef\x03\xbe\x47\x80\xa3\xeb\xaf\xd6\x0c\xc1\xb4\x7c\x72\x1d\xe1\x3e\x00\xd2\x8b\x14\x4f\xdb\x00\x00\x00")
+
+func _0044_downlink_overflowSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__0044_downlink_overflowSql,
+		"0044_downlink_overflow.sql",
+	)
+}
+
+func _0044_downlink_overflowSql() (*asset, error) {
+	bytes, err := _0044_downlink_overflowSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "0044_downlink_overflow.sql", size: 219, mode: os.FileMode(420), modTime: time.Unix(1792178921, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
 // Asset loads and returns the asset for the given name.
 // It returns an error if the asset could not be found or
 // could not be loaded.
@@ -339,6 +1054,41 @@ var _bindata = map[string]func() (*asset, error){
 	"0007_migrate_channels_to_channel_list.sql": _0007_migrate_channels_to_channel_listSql,
 	"0008_relax_fcnt.sql": _0008_relax_fcntSql,
 	"0009_adr_interval_and_install_margin.sql": _0009_adr_interval_and_install_marginSql,
+	"0010_application_signing_key.sql": _0010_application_signing_keySql,
+	"0011_signing_key_expiry.sql": _0011_signing_key_expirySql,
+	"0012_downlink_fport_policy.sql": _0012_downlink_fport_policySql,
+	"0013_node_uplink.sql": _0013_node_uplinkSql,
+	"0014_node_link_score.sql": _0014_node_link_scoreSql,
+	"0015_node_uplink_rx.sql": _0015_node_uplink_rxSql,
+	"0016_gateway_downlink.sql": _0016_gateway_downlinkSql,
+	"0017_notification_preference.sql": _0017_notification_preferenceSql,
+	"0018_scheduled_report.sql": _0018_scheduled_reportSql,
+	"0019_http_integration.sql": _0019_http_integrationSql,
+	"0020_payload_codec.sql": _0020_payload_codecSql,
+	"0021_downlink_delivery.sql": _0021_downlink_deliverySql,
+	"0022_influxdb_integration.sql": _0022_influxdb_integrationSql,
+	"0023_multicast_group.sql": _0023_multicast_groupSql,
+	"0024_fuota_deployment.sql": _0024_fuota_deploymentSql,
+	"0025_gateway.sql": _0025_gatewaySql,
+	"0026_organization.sql": _0026_organizationSql,
+	"0027_api_key.sql": _0027_api_keySql,
+	"0028_event_log.sql": _0028_event_logSql,
+	"0029_cloud_integration.sql": _0029_cloud_integrationSql,
+	"0030_azure_iot_hub_integration.sql": _0030_azure_iot_hub_integrationSql,
+	"0031_device_status.sql": _0031_device_statusSql,
+	"0032_node_location.sql": _0032_node_locationSql,
+	"0033_oidc_user.sql": _0033_oidc_userSql,
+	"0034_downlink_rule.sql": _0034_downlink_ruleSql,
+	"0035_dead_letter.sql": _0035_dead_letterSql,
+	"0036_thingsboard_integration.sql": _0036_thingsboard_integrationSql,
+	"0037_handler_backend.sql": _0037_handler_backendSql,
+	"0038_downlink_queue_scheduling.sql": _0038_downlink_queue_schedulingSql,
+	"0039_event_filter.sql": _0039_event_filterSql,
+	"0040_audit_log.sql": _0040_audit_logSql,
+	"0041_e2e_encryption.sql": _0041_e2e_encryptionSql,
+	"0042_usage_stats.sql": _0042_usage_statsSql,
+	"0043_gateway_organization.sql": _0043_gateway_organizationSql,
+	"0044_downlink_overflow.sql": _0044_downlink_overflowSql,
 }
 
 // AssetDir returns the file names below a certain
@@ -391,6 +1141,41 @@ var _bintree = &bintree{nil, map[string]*bintree{
 	"0007_migrate_channels_to_channel_list.sql": &bintree{_0007_migrate_channels_to_channel_listSql, map[string]*bintree{}},
 	"0008_relax_fcnt.sql": &bintree{_0008_relax_fcntSql, map[string]*bintree{}},
 	"0009_adr_interval_and_install_margin.sql": &bintree{_0009_adr_interval_and_install_marginSql, map[string]*bintree{}},
+	"0010_application_signing_key.sql": &bintree{_0010_application_signing_keySql, map[string]*bintree{}},
+	"0011_signing_key_expiry.sql": &bintree{_0011_signing_key_expirySql, map[string]*bintree{}},
+	"0012_downlink_fport_policy.sql": &bintree{_0012_downlink_fport_policySql, map[string]*bintree{}},
+	"0013_node_uplink.sql": &bintree{_0013_node_uplinkSql, map[string]*bintree{}},
+	"0014_node_link_score.sql": &bintree{_0014_node_link_scoreSql, map[string]*bintree{}},
+	"0015_node_uplink_rx.sql": &bintree{_0015_node_uplink_rxSql, map[string]*bintree{}},
+	"0016_gateway_downlink.sql": &bintree{_0016_gateway_downlinkSql, map[string]*bintree{}},
+	"0017_notification_preference.sql": &bintree{_0017_notification_preferenceSql, map[string]*bintree{}},
+	"0018_scheduled_report.sql": &bintree{_0018_scheduled_reportSql, map[string]*bintree{}},
+	"0019_http_integration.sql": &bintree{_0019_http_integrationSql, map[string]*bintree{}},
+	"0020_payload_codec.sql": &bintree{_0020_payload_codecSql, map[string]*bintree{}},
+	"0021_downlink_delivery.sql": &bintree{_0021_downlink_deliverySql, map[string]*bintree{}},
+	"0022_influxdb_integration.sql": &bintree{_0022_influxdb_integrationSql, map[string]*bintree{}},
+	"0023_multicast_group.sql": &bintree{_0023_multicast_groupSql, map[string]*bintree{}},
+	"0024_fuota_deployment.sql": &bintree{_0024_fuota_deploymentSql, map[string]*bintree{}},
+	"0025_gateway.sql": &bintree{_0025_gatewaySql, map[string]*bintree{}},
+	"0026_organization.sql": &bintree{_0026_organizationSql, map[string]*bintree{}},
+	"0027_api_key.sql": &bintree{_0027_api_keySql, map[string]*bintree{}},
+	"0028_event_log.sql": &bintree{_0028_event_logSql, map[string]*bintree{}},
+	"0029_cloud_integration.sql": &bintree{_0029_cloud_integrationSql, map[string]*bintree{}},
+	"0030_azure_iot_hub_integration.sql": &bintree{_0030_azure_iot_hub_integrationSql, map[string]*bintree{}},
+	"0031_device_status.sql": &bintree{_0031_device_statusSql, map[string]*bintree{}},
+	"0032_node_location.sql": &bintree{_0032_node_locationSql, map[string]*bintree{}},
+	"0033_oidc_user.sql": &bintree{_0033_oidc_userSql, map[string]*bintree{}},
+	"0034_downlink_rule.sql": &bintree{_0034_downlink_ruleSql, map[string]*bintree{}},
+	"0035_dead_letter.sql": &bintree{_0035_dead_letterSql, map[string]*bintree{}},
+	"0036_thingsboard_integration.sql": &bintree{_0036_thingsboard_integrationSql, map[string]*bintree{}},
+	"0037_handler_backend.sql": &bintree{_0037_handler_backendSql, map[string]*bintree{}},
+	"0038_downlink_queue_scheduling.sql": &bintree{_0038_downlink_queue_schedulingSql, map[string]*bintree{}},
+	"0039_event_filter.sql": &bintree{_0039_event_filterSql, map[string]*bintree{}},
+	"0040_audit_log.sql": &bintree{_0040_audit_logSql, map[string]*bintree{}},
+	"0041_e2e_encryption.sql": &bintree{_0041_e2e_encryptionSql, map[string]*bintree{}},
+	"0042_usage_stats.sql": &bintree{_0042_usage_statsSql, map[string]*bintree{}},
+	"0043_gateway_organization.sql": &bintree{_0043_gateway_organizationSql, map[string]*bintree{}},
+	"0044_downlink_overflow.sql": &bintree{_0044_downlink_overflowSql, map[string]*bintree{}},
 }}
 
 // RestoreAsset restores an asset under the given directory
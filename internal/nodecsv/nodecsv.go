@@ -0,0 +1,274 @@
+// Package nodecsv implements the CSV format used for the bulk import and
+// export of the nodes of an application. The first line contains the column
+// names, which are the field names of the Node API. Only the devEUI, appKey
+// and name columns are required, the other columns default to the (zero)
+// defaults of the Node API when omitted or empty.
+package nodecsv
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	pb "github.com/brocaar/lora-app-server/api"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+// Column names.
+const (
+	ColDevEUI             = "devEUI"
+	ColAppKey             = "appKey"
+	ColName               = "name"
+	ColRXDelay            = "rxDelay"
+	ColRX1DROffset        = "rx1DROffset"
+	ColRXWindow           = "rxWindow"
+	ColRX2DR              = "rx2DR"
+	ColChannelListID      = "channelListID"
+	ColRelaxFCnt          = "relaxFCnt"
+	ColADRInterval        = "adrInterval"
+	ColInstallationMargin = "installationMargin"
+	ColUplinkInterval     = "uplinkInterval"
+)
+
+// Columns contains the supported columns, in the order used by Export.
+var Columns = []string{
+	ColDevEUI,
+	ColAppKey,
+	ColName,
+	ColRXDelay,
+	ColRX1DROffset,
+	ColRXWindow,
+	ColRX2DR,
+	ColChannelListID,
+	ColRelaxFCnt,
+	ColADRInterval,
+	ColInstallationMargin,
+	ColUplinkInterval,
+}
+
+// requiredColumns contains the columns which must be present.
+var requiredColumns = []string{ColDevEUI, ColAppKey, ColName}
+
+// Row contains a parsed row. When the row is invalid, Error is set and Node
+// contains the fields parsed so far.
+type Row struct {
+	Row   int // row number, starting at 1 for the first row after the header
+	Node  storage.Node
+	Error error
+}
+
+// Export returns the given nodes as CSV, including all columns.
+func Export(nodes []storage.Node) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write(Columns); err != nil {
+		return nil, err
+	}
+
+	for _, n := range nodes {
+		var channelListID string
+		if n.ChannelListID != nil {
+			channelListID = strconv.FormatInt(*n.ChannelListID, 10)
+		}
+
+		err := w.Write([]string{
+			n.DevEUI.String(),
+			n.AppKey.String(),
+			n.Name,
+			strconv.Itoa(int(n.RXDelay)),
+			strconv.Itoa(int(n.RX1DROffset)),
+			pb.RXWindow(n.RXWindow).String(),
+			strconv.Itoa(int(n.RX2DR)),
+			channelListID,
+			strconv.FormatBool(n.RelaxFCnt),
+			strconv.FormatUint(uint64(n.ADRInterval), 10),
+			strconv.FormatFloat(n.InstallationMargin, 'f', -1, 64),
+			strconv.FormatUint(uint64(n.UplinkInterval), 10),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Parse parses the given CSV into nodes of the given application. An error
+// is returned when the header is invalid or the CSV is malformed, errors of
+// individual rows are returned as part of the row, so that all rows can be
+// validated in a single pass. A DevEUI used by a previous row is considered
+// invalid.
+func Parse(b []byte, appEUI lorawan.EUI64) ([]Row, error) {
+	r := csv.NewReader(bytes.NewReader(b))
+	r.TrimLeadingSpace = true
+
+	header, err := r.Read()
+	if err == io.EOF {
+		return nil, fmt.Errorf("csv is empty")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read header error: %s", err)
+	}
+
+	index, err := parseHeader(header)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []Row
+	seen := make(map[lorawan.EUI64]int)
+
+	for i := 1; ; i++ {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			if pe, ok := err.(*csv.ParseError); ok && pe.Err == csv.ErrFieldCount {
+				rows = append(rows, Row{Row: i, Error: fmt.Errorf("expected %d fields, got %d", len(header), len(record))})
+				continue
+			}
+			return nil, fmt.Errorf("read row %d error: %s", i, err)
+		}
+
+		row := Row{Row: i}
+		row.Node.AppEUI = appEUI
+		row.Error = parseRecord(&row.Node, index, record)
+
+		if row.Error == nil {
+			if prev, ok := seen[row.Node.DevEUI]; ok {
+				row.Error = fmt.Errorf("devEUI %s is already used by row %d", row.Node.DevEUI, prev)
+			} else {
+				seen[row.Node.DevEUI] = i
+			}
+		}
+
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+// parseHeader returns the index of each column of the given header.
+func parseHeader(header []string) (map[string]int, error) {
+	supported := make(map[string]bool)
+	for _, col := range Columns {
+		supported[col] = true
+	}
+
+	index := make(map[string]int)
+	for i, col := range header {
+		col = strings.TrimSpace(col)
+		if !supported[col] {
+			return nil, fmt.Errorf("unknown column: %s", col)
+		}
+		if _, ok := index[col]; ok {
+			return nil, fmt.Errorf("duplicate column: %s", col)
+		}
+		index[col] = i
+	}
+
+	for _, col := range requiredColumns {
+		if _, ok := index[col]; !ok {
+			return nil, fmt.Errorf("missing column: %s", col)
+		}
+	}
+
+	return index, nil
+}
+
+// parseRecord sets the fields of the given node from the given record.
+func parseRecord(n *storage.Node, index map[string]int, record []string) error {
+	value := func(col string) string {
+		i, ok := index[col]
+		if !ok {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	if err := n.DevEUI.UnmarshalText([]byte(value(ColDevEUI))); err != nil {
+		return fmt.Errorf("%s: %s", ColDevEUI, err)
+	}
+	if err := n.AppKey.UnmarshalText([]byte(value(ColAppKey))); err != nil {
+		return fmt.Errorf("%s: %s", ColAppKey, err)
+	}
+	if n.Name = value(ColName); n.Name == "" {
+		return fmt.Errorf("%s must be set", ColName)
+	}
+
+	if v := value(ColRXWindow); v != "" {
+		w, ok := pb.RXWindow_value[v]
+		if !ok {
+			return fmt.Errorf("%s: invalid value %s", ColRXWindow, v)
+		}
+		n.RXWindow = storage.RXWindow(w)
+	}
+
+	uint8Cols := []struct {
+		col string
+		max uint64
+		val *uint8
+	}{
+		{ColRXDelay, 15, &n.RXDelay},
+		{ColRX1DROffset, 7, &n.RX1DROffset},
+		{ColRX2DR, 15, &n.RX2DR},
+	}
+	for _, c := range uint8Cols {
+		v := value(c.col)
+		if v == "" {
+			continue
+		}
+		i, err := strconv.ParseUint(v, 10, 8)
+		if err != nil || i > c.max {
+			return fmt.Errorf("%s: expected a value between 0 and %d", c.col, c.max)
+		}
+		*c.val = uint8(i)
+	}
+
+	if v := value(ColChannelListID); v != "" {
+		id, err := strconv.ParseInt(v, 10, 64)
+		if err != nil || id <= 0 {
+			return fmt.Errorf("%s: expected a positive integer", ColChannelListID)
+		}
+		n.ChannelListID = &id
+	}
+	if v := value(ColRelaxFCnt); v != "" {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return fmt.Errorf("%s: expected true or false", ColRelaxFCnt)
+		}
+		n.RelaxFCnt = b
+	}
+	if v := value(ColADRInterval); v != "" {
+		i, err := strconv.ParseUint(v, 10, 32)
+		if err != nil {
+			return fmt.Errorf("%s: %s", ColADRInterval, err)
+		}
+		n.ADRInterval = uint32(i)
+	}
+	if v := value(ColInstallationMargin); v != "" {
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return fmt.Errorf("%s: %s", ColInstallationMargin, err)
+		}
+		n.InstallationMargin = f
+	}
+	if v := value(ColUplinkInterval); v != "" {
+		i, err := strconv.ParseUint(v, 10, 32)
+		if err != nil {
+			return fmt.Errorf("%s: %s", ColUplinkInterval, err)
+		}
+		n.UplinkInterval = uint32(i)
+	}
+
+	return nil
+}
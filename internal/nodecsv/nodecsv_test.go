@@ -0,0 +1,122 @@
+package nodecsv
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+func TestExportParse(t *testing.T) {
+	Convey("Given a node", t, func() {
+		appEUI := lorawan.EUI64{9, 9, 9, 9, 9, 9, 9, 9}
+		channelListID := int64(3)
+		node := storage.Node{
+			Name:               "node, 1",
+			DevEUI:             lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+			AppEUI:             appEUI,
+			AppKey:             storage.EncryptedKey{1, 2, 3, 4, 5, 6, 7, 8, 1, 2, 3, 4, 5, 6, 7, 8},
+			RXDelay:            1,
+			RX1DROffset:        2,
+			RXWindow:           storage.RX2,
+			RX2DR:              3,
+			ChannelListID:      &channelListID,
+			RelaxFCnt:          true,
+			ADRInterval:        20,
+			InstallationMargin: 5.5,
+			UplinkInterval:     3600,
+		}
+
+		Convey("When exporting the node", func() {
+			b, err := Export([]storage.Node{node})
+			So(err, ShouldBeNil)
+			So(string(b), ShouldEqual, "devEUI,appKey,name,rxDelay,rx1DROffset,rxWindow,rx2DR,channelListID,relaxFCnt,adrInterval,installationMargin,uplinkInterval\n"+
+				"0102030405060708,01020304050607080102030405060708,\"node, 1\",1,2,RX2,3,3,true,20,5.5,3600\n")
+
+			Convey("Then parsing the CSV returns the same node", func() {
+				rows, err := Parse(b, appEUI)
+				So(err, ShouldBeNil)
+				So(rows, ShouldHaveLength, 1)
+				So(rows[0].Row, ShouldEqual, 1)
+				So(rows[0].Error, ShouldBeNil)
+				So(rows[0].Node, ShouldResemble, node)
+			})
+		})
+	})
+}
+
+func TestParse(t *testing.T) {
+	Convey("Given a CSV with only the required columns", t, func() {
+		b := []byte("devEUI,appKey,name\n" +
+			"0102030405060708,01020304050607080102030405060708,node-1\n" +
+			"0102030405060708,01020304050607080102030405060708,node-2\n" +
+			"01020304,01020304050607080102030405060708,node-3\n" +
+			"0102030405060709,01020304050607080102030405060708,\n" +
+			"0102030405060709,01020304050607080102030405060708\n")
+
+		Convey("Then each row is parsed and validated", func() {
+			rows, err := Parse(b, lorawan.EUI64{9, 9, 9, 9, 9, 9, 9, 9})
+			So(err, ShouldBeNil)
+			So(rows, ShouldHaveLength, 5)
+
+			So(rows[0].Error, ShouldBeNil)
+			So(rows[0].Node.Name, ShouldEqual, "node-1")
+			So(rows[0].Node.ChannelListID, ShouldBeNil)
+
+			So(rows[1].Error, ShouldNotBeNil)
+			So(rows[1].Error.Error(), ShouldEqual, "devEUI 0102030405060708 is already used by row 1")
+
+			for i := 2; i < 5; i++ {
+				So(rows[i].Row, ShouldEqual, i+1)
+				So(rows[i].Error, ShouldNotBeNil)
+			}
+		})
+	})
+
+	Convey("Given a set of invalid optional values", t, func() {
+		tests := []struct {
+			Column string
+			Value  string
+		}{
+			{"rxDelay", "16"},
+			{"rx1DROffset", "8"},
+			{"rxWindow", "RX3"},
+			{"channelListID", "0"},
+			{"relaxFCnt", "yes"},
+			{"installationMargin", "high"},
+		}
+
+		for _, test := range tests {
+			Convey("Testing: "+test.Column, func() {
+				b := []byte("devEUI,appKey,name," + test.Column + "\n" +
+					"0102030405060708,01020304050607080102030405060708,node-1," + test.Value + "\n")
+				rows, err := Parse(b, lorawan.EUI64{})
+				So(err, ShouldBeNil)
+				So(rows, ShouldHaveLength, 1)
+				So(rows[0].Error, ShouldNotBeNil)
+			})
+		}
+	})
+
+	Convey("Given a set of invalid headers", t, func() {
+		tests := []struct {
+			CSV   string
+			Error string
+		}{
+			{"", "csv is empty"},
+			{"devEUI,name\n", "missing column: appKey"},
+			{"devEUI,appKey,name,description\n", "unknown column: description"},
+			{"devEUI,appKey,name,name\n", "duplicate column: name"},
+		}
+
+		for _, test := range tests {
+			Convey("Testing: "+test.Error, func() {
+				_, err := Parse([]byte(test.CSV), lorawan.EUI64{})
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, test.Error)
+			})
+		}
+	})
+}
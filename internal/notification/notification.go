@@ -0,0 +1,150 @@
+// Package notification implements the delivery of the alerts to the users,
+// based on their notification preferences (which alerts, over which
+// channels and outside which quiet hours).
+package notification
+
+import (
+	"fmt"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/brocaar/lora-app-server/internal/alert"
+	"github.com/brocaar/lora-app-server/internal/storage"
+)
+
+// Notification channels.
+const (
+	ChannelEmail   = "EMAIL"
+	ChannelWebhook = "WEBHOOK"
+	ChannelSlack   = "SLACK"
+)
+
+// Channels contains all the notification channels.
+var Channels = []string{
+	ChannelEmail,
+	ChannelWebhook,
+	ChannelSlack,
+}
+
+// Notifier defines the interface of a notification channel.
+type Notifier interface {
+	// Notify notifies the user of the given preference about the given
+	// alert.
+	Notify(pref storage.NotificationPreference, a alert.Alert) error
+}
+
+// Dispatcher sends the alerts to the subscribed users, using the
+// notification channels of their preferences.
+type Dispatcher struct {
+	db        *sqlx.DB
+	notifiers map[string]Notifier
+}
+
+// NewDispatcher creates a new Dispatcher given the notifiers per channel.
+// Channels without notifier are skipped.
+func NewDispatcher(db *sqlx.DB, notifiers map[string]Notifier) *Dispatcher {
+	return &Dispatcher{
+		db:        db,
+		notifiers: notifiers,
+	}
+}
+
+// Send sends the given alert to all the subscribed users, except for the
+// users within their quiet hours. The notifications are sent
+// asynchronously.
+func (d *Dispatcher) Send(a alert.Alert) error {
+	if a.Time.IsZero() {
+		a.Time = time.Now()
+	}
+
+	prefs, err := storage.GetNotificationPreferencesForAlertType(d.db, a.Type)
+	if err != nil {
+		return err
+	}
+
+	for _, pref := range prefs {
+		if !isSubscribed(pref, a) {
+			continue
+		}
+
+		quiet, err := InQuietHours(pref, a.Time)
+		if err != nil {
+			log.WithField("username", pref.Username).Errorf("alert: quiet hours error: %s", err)
+			continue
+		}
+		if quiet {
+			log.WithFields(log.Fields{
+				"username": pref.Username,
+				"type":     a.Type,
+			}).Info("alert: skipping notification during quiet hours")
+			continue
+		}
+
+		for _, channel := range pref.Channels {
+			n, ok := d.notifiers[channel]
+			if !ok {
+				log.WithFields(log.Fields{
+					"username": pref.Username,
+					"channel":  channel,
+				}).Warning("alert: notification channel is not configured")
+				continue
+			}
+
+			go func(n Notifier, pref storage.NotificationPreference, channel string) {
+				if err := n.Notify(pref, a); err != nil {
+					log.WithFields(log.Fields{
+						"username": pref.Username,
+						"channel":  channel,
+						"type":     a.Type,
+					}).Errorf("alert: notify error: %s", err)
+				}
+			}(n, pref, channel)
+		}
+	}
+
+	return nil
+}
+
+// InQuietHours returns if the given time is within the quiet hours of the
+// given preference.
+func InQuietHours(pref storage.NotificationPreference, t time.Time) (bool, error) {
+	if pref.QuietHoursStart == nil || pref.QuietHoursEnd == nil || *pref.QuietHoursStart == *pref.QuietHoursEnd {
+		return false, nil
+	}
+
+	loc, err := time.LoadLocation(pref.Timezone)
+	if err != nil {
+		return false, fmt.Errorf("load timezone %s error: %s", pref.Timezone, err)
+	}
+	t = t.In(loc)
+	minutes := t.Hour()*60 + t.Minute()
+	start, end := *pref.QuietHoursStart, *pref.QuietHoursEnd
+
+	if start < end {
+		return minutes >= start && minutes < end, nil
+	}
+	// quiet hours spanning midnight
+	return minutes >= start || minutes < end, nil
+}
+
+// isSubscribed returns if the user of the given preference is subscribed
+// to the application or gateway of the given alert.
+func isSubscribed(pref storage.NotificationPreference, a alert.Alert) bool {
+	switch {
+	case a.MAC != nil:
+		for _, mac := range pref.Gateways {
+			if mac == "*" || mac == a.MAC.String() {
+				return true
+			}
+		}
+	case a.AppEUI != nil:
+		for _, appEUI := range pref.Applications {
+			if appEUI == a.AppEUI.String() {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,159 @@
+package notification
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/brocaar/lora-app-server/internal/alert"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+func TestInQuietHours(t *testing.T) {
+	Convey("Given a set of tests", t, func() {
+		minutes := func(i int) *int { return &i }
+
+		testTable := []struct {
+			Description string
+			Start       *int
+			End         *int
+			Timezone    string
+			Time        time.Time
+			Expected    bool
+		}{
+			{
+				Description: "No quiet hours",
+				Timezone:    "UTC",
+				Time:        time.Date(2016, 12, 1, 3, 0, 0, 0, time.UTC),
+				Expected:    false,
+			},
+			{
+				Description: "Within quiet hours",
+				Start:       minutes(9 * 60),
+				End:         minutes(17 * 60),
+				Timezone:    "UTC",
+				Time:        time.Date(2016, 12, 1, 12, 0, 0, 0, time.UTC),
+				Expected:    true,
+			},
+			{
+				Description: "At the end of the quiet hours",
+				Start:       minutes(9 * 60),
+				End:         minutes(17 * 60),
+				Timezone:    "UTC",
+				Time:        time.Date(2016, 12, 1, 17, 0, 0, 0, time.UTC),
+				Expected:    false,
+			},
+			{
+				Description: "Within quiet hours spanning midnight",
+				Start:       minutes(22 * 60),
+				End:         minutes(7 * 60),
+				Timezone:    "UTC",
+				Time:        time.Date(2016, 12, 1, 3, 0, 0, 0, time.UTC),
+				Expected:    true,
+			},
+			{
+				Description: "Outside quiet hours spanning midnight",
+				Start:       minutes(22 * 60),
+				End:         minutes(7 * 60),
+				Timezone:    "UTC",
+				Time:        time.Date(2016, 12, 1, 12, 0, 0, 0, time.UTC),
+				Expected:    false,
+			},
+			{
+				Description: "Within quiet hours in a different timezone",
+				Start:       minutes(22 * 60),
+				End:         minutes(7 * 60),
+				Timezone:    "Asia/Tokyo",
+				Time:        time.Date(2016, 12, 1, 14, 0, 0, 0, time.UTC),
+				Expected:    true,
+			},
+		}
+
+		for _, test := range testTable {
+			Convey("Test: "+test.Description, func() {
+				quiet, err := InQuietHours(storage.NotificationPreference{
+					QuietHoursStart: test.Start,
+					QuietHoursEnd:   test.End,
+					Timezone:        test.Timezone,
+				}, test.Time)
+				So(err, ShouldBeNil)
+				So(quiet, ShouldEqual, test.Expected)
+			})
+		}
+	})
+}
+
+func TestIsSubscribed(t *testing.T) {
+	Convey("Given a preference subscribed to an application and gateway", t, func() {
+		appEUI := lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}
+		mac := lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1}
+		pref := storage.NotificationPreference{
+			Applications: []string{appEUI.String()},
+			Gateways:     []string{mac.String()},
+		}
+		otherEUI := lorawan.EUI64{1, 1, 1, 1, 1, 1, 1, 1}
+
+		Convey("Then the user is subscribed to the alerts of the application and gateway", func() {
+			So(isSubscribed(pref, alert.Alert{AppEUI: &appEUI}), ShouldBeTrue)
+			So(isSubscribed(pref, alert.Alert{MAC: &mac}), ShouldBeTrue)
+		})
+
+		Convey("Then the user is not subscribed to the alerts of other applications and gateways", func() {
+			So(isSubscribed(pref, alert.Alert{AppEUI: &otherEUI}), ShouldBeFalse)
+			So(isSubscribed(pref, alert.Alert{MAC: &otherEUI}), ShouldBeFalse)
+		})
+
+		Convey("When subscribed to all gateways", func() {
+			pref.Gateways = []string{"*"}
+
+			Convey("Then the user is subscribed to the alerts of all gateways", func() {
+				So(isSubscribed(pref, alert.Alert{MAC: &otherEUI}), ShouldBeTrue)
+			})
+		})
+	})
+}
+
+func TestWebhookNotifier(t *testing.T) {
+	Convey("Given a test webhook server", t, func() {
+		bodies := make(chan []byte, 1)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			b, _ := ioutil.ReadAll(r.Body)
+			bodies <- b
+		}))
+		defer server.Close()
+
+		pref := storage.NotificationPreference{
+			WebhookURL:      server.URL,
+			SlackWebhookURL: server.URL,
+		}
+		a := alert.Alert{
+			Type:    alert.TypeLinkQuality,
+			Message: "link-quality degraded",
+			Time:    time.Date(2016, 12, 1, 12, 0, 0, 0, time.UTC),
+		}
+
+		Convey("When notifying using the WebhookNotifier", func() {
+			So(NewWebhookNotifier().Notify(pref, a), ShouldBeNil)
+
+			Convey("Then the alert was posted", func() {
+				var received alert.Alert
+				So(json.Unmarshal(<-bodies, &received), ShouldBeNil)
+				So(received, ShouldResemble, a)
+			})
+		})
+
+		Convey("When notifying using the SlackNotifier", func() {
+			So(NewSlackNotifier().Notify(pref, a), ShouldBeNil)
+
+			Convey("Then the message was posted", func() {
+				So(string(<-bodies), ShouldEqual, `{"text":"*LINK_QUALITY*: link-quality degraded"}`)
+			})
+		})
+	})
+}
@@ -0,0 +1,110 @@
+package notification
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/brocaar/lora-app-server/internal/alert"
+	"github.com/brocaar/lora-app-server/internal/mailer"
+	"github.com/brocaar/lora-app-server/internal/storage"
+)
+
+// notifyTimeout defines the timeout of the webhook and Slack requests.
+const notifyTimeout = 10 * time.Second
+
+// EmailNotifier sends the alerts by email.
+type EmailNotifier struct {
+	mailer *mailer.Mailer
+}
+
+// NewEmailNotifier creates a new EmailNotifier.
+func NewEmailNotifier(m *mailer.Mailer) *EmailNotifier {
+	return &EmailNotifier{
+		mailer: m,
+	}
+}
+
+// Notify sends the given alert to the email address of the given
+// preference.
+func (n *EmailNotifier) Notify(pref storage.NotificationPreference, a alert.Alert) error {
+	if pref.Email == "" {
+		return errors.New("email address is not set")
+	}
+	return n.mailer.Send([]string{pref.Email}, fmt.Sprintf("[LoRa App Server] %s alert", a.Type), a.Message)
+}
+
+// WebhookNotifier sends the alerts (JSON encoded) to the webhook URL of the
+// user.
+type WebhookNotifier struct {
+	client *http.Client
+}
+
+// NewWebhookNotifier creates a new WebhookNotifier.
+func NewWebhookNotifier() *WebhookNotifier {
+	return &WebhookNotifier{
+		client: &http.Client{Timeout: notifyTimeout},
+	}
+}
+
+// Notify posts the given alert to the webhook URL of the given preference.
+func (n *WebhookNotifier) Notify(pref storage.NotificationPreference, a alert.Alert) error {
+	if pref.WebhookURL == "" {
+		return errors.New("webhook url is not set")
+	}
+
+	b, err := json.Marshal(a)
+	if err != nil {
+		return err
+	}
+	return post(n.client, pref.WebhookURL, b)
+}
+
+// SlackNotifier sends the alerts to the Slack (incoming webhook) URL of the
+// user.
+type SlackNotifier struct {
+	client *http.Client
+}
+
+// NewSlackNotifier creates a new SlackNotifier.
+func NewSlackNotifier() *SlackNotifier {
+	return &SlackNotifier{
+		client: &http.Client{Timeout: notifyTimeout},
+	}
+}
+
+// Notify posts the given alert to the Slack webhook URL of the given
+// preference.
+func (n *SlackNotifier) Notify(pref storage.NotificationPreference, a alert.Alert) error {
+	if pref.SlackWebhookURL == "" {
+		return errors.New("slack webhook url is not set")
+	}
+
+	b, err := json.Marshal(struct {
+		Text string `json:"text"`
+	}{
+		Text: fmt.Sprintf("*%s*: %s", a.Type, a.Message),
+	})
+	if err != nil {
+		return err
+	}
+	return post(n.client, pref.SlackWebhookURL, b)
+}
+
+// post posts the given JSON payload to the given URL.
+func post(client *http.Client, url string, b []byte) error {
+	resp, err := client.Post(url, "application/json", bytes.NewReader(b))
+	if err != nil {
+		return fmt.Errorf("post error: %s", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("expected 2xx response, got: %s", strings.TrimSpace(resp.Status))
+	}
+	return nil
+}
@@ -0,0 +1,167 @@
+package oidc
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	jwt "github.com/dgrijalva/jwt-go"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/brocaar/lora-app-server/internal/api/auth"
+)
+
+// stateCookie defines the name of the cookie containing the state and
+// nonce of the login request.
+const stateCookie = "oidc_state"
+
+// stateTTL defines the time the user has to login at the provider.
+const stateTTL = 10 * time.Minute
+
+// Handler implements the login and callback endpoints of the authorization
+// code flow. After a successful login, a LoRa App Server API token (signed
+// using the JWT secret) is issued for the user.
+type Handler struct {
+	db         *sqlx.DB
+	provider   *Provider
+	jwtSecret  string
+	tokenTTL   time.Duration
+	uiRedirect string
+}
+
+// NewHandler creates a new Handler. After a successful login, the user is
+// redirected to the given ui redirect url, with the issued token as token
+// query parameter.
+func NewHandler(db *sqlx.DB, provider *Provider, jwtSecret string, tokenTTL time.Duration, uiRedirect string) *Handler {
+	return &Handler{
+		db:         db,
+		provider:   provider,
+		jwtSecret:  jwtSecret,
+		tokenTTL:   tokenTTL,
+		uiRedirect: uiRedirect,
+	}
+}
+
+// Login redirects the user to the provider.
+func (h *Handler) Login(w http.ResponseWriter, r *http.Request) {
+	state, err := randomHex()
+	if err != nil {
+		log.Errorf("oidc: generate state error: %s", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	nonce, err := randomHex()
+	if err != nil {
+		log.Errorf("oidc: generate nonce error: %s", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	u, err := h.provider.AuthCodeURL(state, nonce)
+	if err != nil {
+		log.Errorf("oidc: get authorization url error: %s", err)
+		w.WriteHeader(http.StatusBadGateway)
+		return
+	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     stateCookie,
+		Value:    state + "." + nonce,
+		Path:     "/auth/oidc",
+		MaxAge:   int(stateTTL / time.Second),
+		Secure:   r.TLS != nil,
+		HttpOnly: true,
+	})
+	http.Redirect(w, r, u, http.StatusFound)
+}
+
+// Callback handles the redirect of the provider after the login. It
+// provisions the user and redirects the user to the ui with the issued
+// token.
+func (h *Handler) Callback(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	if e := q.Get("error"); e != "" {
+		log.WithField("description", q.Get("error_description")).Warningf("oidc: login error: %s", e)
+		http.Error(w, fmt.Sprintf("login error: %s", e), http.StatusUnauthorized)
+		return
+	}
+
+	c, err := r.Cookie(stateCookie)
+	if err != nil {
+		http.Error(w, "login state missing", http.StatusBadRequest)
+		return
+	}
+	http.SetCookie(w, &http.Cookie{
+		Name:   stateCookie,
+		Path:   "/auth/oidc",
+		MaxAge: -1,
+	})
+
+	parts := strings.SplitN(c.Value, ".", 2)
+	if len(parts) != 2 || q.Get("state") == "" || q.Get("state") != parts[0] {
+		http.Error(w, "login state mismatch", http.StatusBadRequest)
+		return
+	}
+
+	idToken, err := h.provider.Exchange(q.Get("code"), parts[1])
+	if err != nil {
+		log.Errorf("oidc: exchange code error: %s", err)
+		http.Error(w, "login failed", http.StatusUnauthorized)
+		return
+	}
+
+	config := h.provider.Config()
+	user, err := Provision(h.db, config.RoleMappings, *idToken)
+	if err != nil {
+		log.Errorf("oidc: provision user error: %s", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	token, err := h.issueToken(user.Username, isAdmin(config.AdminGroup, idToken.Groups))
+	if err != nil {
+		log.Errorf("oidc: issue token error: %s", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	log.WithFields(log.Fields{
+		"username": user.Username,
+		"subject":  user.Subject,
+	}).Info("oidc: user logged in")
+
+	sep := "?"
+	if strings.Contains(h.uiRedirect, "?") {
+		sep = "&"
+	}
+	http.Redirect(w, r, h.uiRedirect+sep+"token="+url.QueryEscape(token), http.StatusFound)
+}
+
+// issueToken returns a new (HS256 signed) API token for the given user.
+// The permissions of the user are granted by the organization roles of the
+// user, or by the admin claim.
+func (h *Handler) issueToken(username string, admin bool) (string, error) {
+	now := time.Now()
+	claims := auth.Claims{
+		Admin: admin,
+	}
+	claims.Subject = username
+	claims.IssuedAt = now.Unix()
+	claims.ExpiresAt = now.Add(h.tokenTTL).Unix()
+
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(h.jwtSecret))
+}
+
+// randomHex returns 16 random bytes, hex encoded.
+func randomHex() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
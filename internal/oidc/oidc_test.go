@@ -0,0 +1,246 @@
+package oidc
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	jwt "github.com/dgrijalva/jwt-go"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/brocaar/lora-app-server/internal/storage"
+)
+
+func TestParseRoleMapping(t *testing.T) {
+	Convey("Given a set of tests", t, func() {
+		tests := []struct {
+			Description string
+			Mapping     string
+			Expected    RoleMapping
+			ExpectedErr bool
+		}{
+			{
+				Description: "valid mapping",
+				Mapping:     "iot-admins=1:ADMIN",
+				Expected:    RoleMapping{Group: "iot-admins", OrganizationID: 1, Role: storage.OrganizationRoleAdmin},
+			},
+			{
+				Description: "group containing a = and lowercase role",
+				Mapping:     "cn=operators=12:device_admin",
+				Expected:    RoleMapping{Group: "cn=operators", OrganizationID: 12, Role: storage.OrganizationRoleDeviceAdmin},
+			},
+			{
+				Description: "missing group",
+				Mapping:     "=1:ADMIN",
+				ExpectedErr: true,
+			},
+			{
+				Description: "invalid organization id",
+				Mapping:     "iot-admins=abc:ADMIN",
+				ExpectedErr: true,
+			},
+			{
+				Description: "invalid role",
+				Mapping:     "iot-admins=1:OWNER",
+				ExpectedErr: true,
+			},
+		}
+
+		for _, test := range tests {
+			Convey("Test: "+test.Description, func() {
+				m, err := ParseRoleMapping(test.Mapping)
+				if test.ExpectedErr {
+					So(err, ShouldNotBeNil)
+					return
+				}
+				So(err, ShouldBeNil)
+				So(m, ShouldResemble, test.Expected)
+			})
+		}
+	})
+}
+
+func TestMapRoles(t *testing.T) {
+	Convey("Given a set of role mappings", t, func() {
+		mappings := []RoleMapping{
+			{Group: "readers", OrganizationID: 1, Role: storage.OrganizationRoleReadOnly},
+			{Group: "operators", OrganizationID: 1, Role: storage.OrganizationRoleDeviceAdmin},
+			{Group: "admins", OrganizationID: 2, Role: storage.OrganizationRoleAdmin},
+		}
+
+		Convey("Then the role with the most permissions is granted", func() {
+			So(mapRoles(mappings, []string{"readers", "operators", "other"}), ShouldResemble, map[int64]storage.OrganizationRole{
+				1: storage.OrganizationRoleDeviceAdmin,
+			})
+		})
+
+		Convey("Then no roles are granted without matching groups", func() {
+			So(mapRoles(mappings, []string{"other"}), ShouldResemble, map[int64]storage.OrganizationRole{})
+		})
+
+		Convey("Then the admin group grants the admin permission", func() {
+			So(isAdmin("admins", []string{"readers", "admins"}), ShouldBeTrue)
+			So(isAdmin("admins", []string{"readers"}), ShouldBeFalse)
+			So(isAdmin("", []string{""}), ShouldBeFalse)
+		})
+	})
+}
+
+func TestProvider(t *testing.T) {
+	Convey("Given a test OpenID Connect provider", t, func() {
+		key, err := rsa.GenerateKey(rand.Reader, 2048)
+		So(err, ShouldBeNil)
+
+		var idToken string
+		tokenRequests := make(chan url.Values, 1)
+
+		mux := http.NewServeMux()
+		server := httptest.NewServer(mux)
+		defer server.Close()
+
+		mux.HandleFunc(discoveryPath, func(w http.ResponseWriter, r *http.Request) {
+			json.NewEncoder(w).Encode(discovery{
+				Issuer:                server.URL,
+				AuthorizationEndpoint: server.URL + "/authorize",
+				TokenEndpoint:         server.URL + "/token",
+				JWKSURI:               server.URL + "/jwks",
+			})
+		})
+		mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+			json.NewEncoder(w).Encode(map[string][]jwk{
+				"keys": {
+					{
+						KID: "key-1",
+						KTY: "RSA",
+						N:   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+						E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+					},
+				},
+			})
+		})
+		mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+			r.ParseForm()
+			tokenRequests <- r.PostForm
+			json.NewEncoder(w).Encode(map[string]string{"id_token": idToken})
+		})
+
+		p := NewProvider(Config{
+			Issuer:       server.URL,
+			ClientID:     "lora-app-server",
+			ClientSecret: "secret",
+			RedirectURL:  "http://localhost:8080/auth/oidc/callback",
+			Scopes:       []string{"email", "groups"},
+		})
+
+		sign := func(kid string, claims jwt.MapClaims) string {
+			token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+			token.Header["kid"] = kid
+			s, err := token.SignedString(key)
+			So(err, ShouldBeNil)
+			return s
+		}
+
+		validClaims := func() jwt.MapClaims {
+			return jwt.MapClaims{
+				"iss":    server.URL,
+				"aud":    "lora-app-server",
+				"sub":    "abc123",
+				"exp":    time.Now().Add(time.Minute).Unix(),
+				"nonce":  "nonce",
+				"email":  "user@example.com",
+				"name":   "User",
+				"groups": []string{"operators", "admins"},
+			}
+		}
+
+		Convey("Then the authorization url is returned", func() {
+			u, err := p.AuthCodeURL("state", "nonce")
+			So(err, ShouldBeNil)
+
+			parsed, err := url.Parse(u)
+			So(err, ShouldBeNil)
+			So(parsed.Path, ShouldEqual, "/authorize")
+			So(parsed.Query().Get("scope"), ShouldEqual, "openid email groups")
+			So(parsed.Query().Get("state"), ShouldEqual, "state")
+			So(parsed.Query().Get("nonce"), ShouldEqual, "nonce")
+			So(parsed.Query().Get("client_id"), ShouldEqual, "lora-app-server")
+		})
+
+		Convey("When exchanging a code for a valid id token", func() {
+			idToken = sign("key-1", validClaims())
+			t, err := p.Exchange("code", "nonce")
+			So(err, ShouldBeNil)
+
+			Convey("Then the expected token request was made", func() {
+				req := <-tokenRequests
+				So(req.Get("grant_type"), ShouldEqual, "authorization_code")
+				So(req.Get("code"), ShouldEqual, "code")
+			})
+
+			Convey("Then the claims are returned", func() {
+				So(t, ShouldResemble, &IDToken{
+					Subject:  "abc123",
+					Username: "user@example.com",
+					Email:    "user@example.com",
+					Name:     "User",
+					Groups:   []string{"operators", "admins"},
+				})
+			})
+		})
+
+		Convey("Given a set of invalid id tokens", func() {
+			tests := []struct {
+				Description string
+				KID         string
+				Claims      func(jwt.MapClaims)
+			}{
+				{
+					Description: "unknown key id",
+					KID:         "key-2",
+					Claims:      func(c jwt.MapClaims) {},
+				},
+				{
+					Description: "invalid issuer",
+					KID:         "key-1",
+					Claims:      func(c jwt.MapClaims) { c["iss"] = "https://example.com" },
+				},
+				{
+					Description: "invalid audience",
+					KID:         "key-1",
+					Claims:      func(c jwt.MapClaims) { c["aud"] = []string{"other"} },
+				},
+				{
+					Description: "expired token",
+					KID:         "key-1",
+					Claims:      func(c jwt.MapClaims) { c["exp"] = time.Now().Add(-time.Minute).Unix() },
+				},
+				{
+					Description: "invalid nonce",
+					KID:         "key-1",
+					Claims:      func(c jwt.MapClaims) { c["nonce"] = "other" },
+				},
+				{
+					Description: "missing username claim",
+					KID:         "key-1",
+					Claims:      func(c jwt.MapClaims) { delete(c, "email") },
+				},
+			}
+
+			for _, test := range tests {
+				Convey("Test: "+test.Description, func() {
+					claims := validClaims()
+					test.Claims(claims)
+					_, err := p.Verify(sign(test.KID, claims), "nonce")
+					So(err, ShouldNotBeNil)
+				})
+			}
+		})
+	})
+}
@@ -0,0 +1,387 @@
+// Package oidc implements the OpenID Connect login of the users.
+// The users are authenticated by the OpenID Connect provider (authorization
+// code flow) and provisioned on their first login. On each login, the
+// organization roles of the user are synchronized using the groups of the
+// user and a LoRa App Server API token is issued.
+package oidc
+
+import (
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	jwt "github.com/dgrijalva/jwt-go"
+)
+
+// discoveryPath defines the path of the OpenID Connect discovery document,
+// relative to the issuer.
+const discoveryPath = "/.well-known/openid-configuration"
+
+// Config defines the OpenID Connect configuration.
+type Config struct {
+	// Issuer defines the issuer url of the provider, used for the discovery
+	// of its endpoints and to validate the ID tokens.
+	Issuer string
+
+	// ClientID and ClientSecret define the client credentials registered
+	// at the provider.
+	ClientID     string
+	ClientSecret string
+
+	// RedirectURL defines the callback url of LoRa App Server registered at
+	// the provider (e.g. https://example.com/auth/oidc/callback).
+	RedirectURL string
+
+	// Scopes defines the requested scopes ("openid" is always requested).
+	Scopes []string
+
+	// UsernameClaim defines the ID token claim used as username
+	// (e.g. email or preferred_username).
+	UsernameClaim string
+
+	// GroupsClaim defines the ID token claim containing the groups of the
+	// user.
+	GroupsClaim string
+
+	// AdminGroup defines the group granting the admin (claim) permission.
+	// When empty, no user is granted the admin permission.
+	AdminGroup string
+
+	// RoleMappings defines the organization roles granted by the groups.
+	RoleMappings []RoleMapping
+}
+
+// IDToken contains the validated claims of an ID token.
+type IDToken struct {
+	Subject  string
+	Username string
+	Email    string
+	Name     string
+	Groups   []string
+}
+
+// tokenResponse defines the (relevant part of the) response of the token
+// endpoint.
+type tokenResponse struct {
+	IDToken string `json:"id_token"`
+}
+
+// discovery defines the (relevant part of the) discovery document.
+type discovery struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// jwk defines a (RSA) JSON Web Key.
+type jwk struct {
+	KID string `json:"kid"`
+	KTY string `json:"kty"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+// Provider implements the authorization code flow against an OpenID Connect
+// provider. The endpoints of the provider are discovered on first use and
+// the signing keys are (re)loaded when an unknown key id is encountered.
+type Provider struct {
+	config Config
+	client *http.Client
+
+	mu        sync.Mutex
+	discovery *discovery
+	keys      map[string]*rsa.PublicKey
+}
+
+// NewProvider creates a new Provider.
+func NewProvider(config Config) *Provider {
+	if config.UsernameClaim == "" {
+		config.UsernameClaim = "email"
+	}
+	if config.GroupsClaim == "" {
+		config.GroupsClaim = "groups"
+	}
+
+	return &Provider{
+		config: config,
+		client: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// Config returns the configuration of the provider.
+func (p *Provider) Config() Config {
+	return p.config
+}
+
+// AuthCodeURL returns the url of the provider to which the user must be
+// redirected to login.
+func (p *Provider) AuthCodeURL(state, nonce string) (string, error) {
+	d, err := p.getDiscovery()
+	if err != nil {
+		return "", err
+	}
+
+	scopes := []string{"openid"}
+	for _, s := range p.config.Scopes {
+		if s != "openid" {
+			scopes = append(scopes, s)
+		}
+	}
+
+	v := url.Values{}
+	v.Set("response_type", "code")
+	v.Set("client_id", p.config.ClientID)
+	v.Set("redirect_uri", p.config.RedirectURL)
+	v.Set("scope", strings.Join(scopes, " "))
+	v.Set("state", state)
+	v.Set("nonce", nonce)
+
+	sep := "?"
+	if strings.Contains(d.AuthorizationEndpoint, "?") {
+		sep = "&"
+	}
+	return d.AuthorizationEndpoint + sep + v.Encode(), nil
+}
+
+// Exchange exchanges the given authorization code for the ID token and
+// returns its validated claims. The nonce of the ID token must match the
+// given nonce.
+func (p *Provider) Exchange(code, nonce string) (*IDToken, error) {
+	d, err := p.getDiscovery()
+	if err != nil {
+		return nil, err
+	}
+
+	v := url.Values{}
+	v.Set("grant_type", "authorization_code")
+	v.Set("code", code)
+	v.Set("redirect_uri", p.config.RedirectURL)
+
+	req, err := http.NewRequest("POST", d.TokenEndpoint, strings.NewReader(v.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth(url.QueryEscape(p.config.ClientID), url.QueryEscape(p.config.ClientSecret))
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("token request error: %s", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("expected 200 response from token endpoint, got: %s", strings.TrimSpace(resp.Status))
+	}
+
+	var tr tokenResponse
+	if err := json.NewDecoder(resp.Body).Decode(&tr); err != nil {
+		return nil, fmt.Errorf("decode token response error: %s", err)
+	}
+	if tr.IDToken == "" {
+		return nil, errors.New("token response does not contain an id_token")
+	}
+
+	return p.Verify(tr.IDToken, nonce)
+}
+
+// Verify validates the given (RS256 signed) ID token and returns its claims.
+// The issuer, audience, expiration and nonce are validated.
+func (p *Provider) Verify(idToken, nonce string) (*IDToken, error) {
+	claims := jwt.MapClaims{}
+	_, err := jwt.ParseWithClaims(idToken, claims, func(token *jwt.Token) (interface{}, error) {
+		if token.Header["alg"] != jwt.SigningMethodRS256.Alg() {
+			return nil, fmt.Errorf("unexpected algorithm %s, expected %s", token.Header["alg"], jwt.SigningMethodRS256.Alg())
+		}
+		kid, _ := token.Header["kid"].(string)
+		return p.getKey(kid)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("id token parse error: %s", err)
+	}
+
+	if !claims.VerifyIssuer(p.config.Issuer, true) {
+		return nil, fmt.Errorf("id token issuer %v does not match %s", claims["iss"], p.config.Issuer)
+	}
+	if !verifyAudience(claims["aud"], p.config.ClientID) {
+		return nil, fmt.Errorf("id token audience %v does not contain %s", claims["aud"], p.config.ClientID)
+	}
+	if _, ok := claims["exp"]; !ok {
+		return nil, errors.New("id token does not expire")
+	}
+	if n, _ := claims["nonce"].(string); n != nonce {
+		return nil, errors.New("id token nonce mismatch")
+	}
+
+	t := IDToken{
+		Subject:  stringClaim(claims, "sub"),
+		Username: stringClaim(claims, p.config.UsernameClaim),
+		Email:    stringClaim(claims, "email"),
+		Name:     stringClaim(claims, "name"),
+		Groups:   stringSliceClaim(claims, p.config.GroupsClaim),
+	}
+	if t.Subject == "" {
+		return nil, errors.New("id token does not contain a subject")
+	}
+	if t.Username == "" {
+		return nil, fmt.Errorf("id token does not contain the %s claim", p.config.UsernameClaim)
+	}
+	return &t, nil
+}
+
+// getDiscovery returns the discovery document of the provider.
+func (p *Provider) getDiscovery() (*discovery, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.discovery != nil {
+		return p.discovery, nil
+	}
+
+	var d discovery
+	if err := p.getJSON(strings.TrimSuffix(p.config.Issuer, "/")+discoveryPath, &d); err != nil {
+		return nil, fmt.Errorf("get discovery document error: %s", err)
+	}
+	if d.Issuer != p.config.Issuer {
+		return nil, fmt.Errorf("discovered issuer %s does not match %s", d.Issuer, p.config.Issuer)
+	}
+	if d.AuthorizationEndpoint == "" || d.TokenEndpoint == "" || d.JWKSURI == "" {
+		return nil, errors.New("discovery document is missing the authorization, token or jwks endpoint")
+	}
+
+	p.discovery = &d
+	return p.discovery, nil
+}
+
+// getKey returns the public key matching the given key id. When the key is
+// unknown, the keys are reloaded as the provider might have rotated its
+// keys.
+func (p *Provider) getKey(kid string) (*rsa.PublicKey, error) {
+	p.mu.Lock()
+	key, ok := p.keys[kid]
+	p.mu.Unlock()
+	if ok {
+		return key, nil
+	}
+
+	d, err := p.getDiscovery()
+	if err != nil {
+		return nil, err
+	}
+
+	var set struct {
+		Keys []jwk `json:"keys"`
+	}
+	if err := p.getJSON(d.JWKSURI, &set); err != nil {
+		return nil, fmt.Errorf("get jwks error: %s", err)
+	}
+
+	keys := make(map[string]*rsa.PublicKey)
+	for _, k := range set.Keys {
+		if k.KTY != "RSA" {
+			continue
+		}
+		pub, err := k.publicKey()
+		if err != nil {
+			return nil, fmt.Errorf("jwk %s error: %s", k.KID, err)
+		}
+		keys[k.KID] = pub
+	}
+
+	p.mu.Lock()
+	p.keys = keys
+	p.mu.Unlock()
+
+	if key, ok := keys[kid]; ok {
+		return key, nil
+	}
+	return nil, fmt.Errorf("unknown key id: %s", kid)
+}
+
+// getJSON gets the given url and decodes the JSON response into v.
+func (p *Provider) getJSON(u string, v interface{}) error {
+	resp, err := p.client.Get(u)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("expected 200 response, got: %s", strings.TrimSpace(resp.Status))
+	}
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
+// publicKey returns the RSA public key of the JWK.
+func (k jwk) publicKey() (*rsa.PublicKey, error) {
+	n, err := base64.RawURLEncoding.DecodeString(k.N)
+	if err != nil {
+		return nil, fmt.Errorf("decode modulus error: %s", err)
+	}
+	e, err := base64.RawURLEncoding.DecodeString(k.E)
+	if err != nil {
+		return nil, fmt.Errorf("decode exponent error: %s", err)
+	}
+	if len(e) == 0 || len(e) > 4 {
+		return nil, errors.New("invalid exponent")
+	}
+
+	var exp int
+	for _, b := range e {
+		exp = exp<<8 | int(b)
+	}
+
+	return &rsa.PublicKey{
+		N: new(big.Int).SetBytes(n),
+		E: exp,
+	}, nil
+}
+
+// verifyAudience returns true when the given audience claim (a string or
+// an array of strings) contains the client id.
+func verifyAudience(aud interface{}, clientID string) bool {
+	switch v := aud.(type) {
+	case string:
+		return v == clientID
+	case []interface{}:
+		for _, a := range v {
+			if s, ok := a.(string); ok && s == clientID {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// stringClaim returns the given claim as string.
+func stringClaim(claims jwt.MapClaims, name string) string {
+	s, _ := claims[name].(string)
+	return s
+}
+
+// stringSliceClaim returns the given claim as slice of strings. A single
+// string value is returned as slice of one string.
+func stringSliceClaim(claims jwt.MapClaims, name string) []string {
+	switch v := claims[name].(type) {
+	case string:
+		return []string{v}
+	case []interface{}:
+		var out []string
+		for _, s := range v {
+			if str, ok := s.(string); ok {
+				out = append(out, str)
+			}
+		}
+		return out
+	}
+	return nil
+}
@@ -0,0 +1,169 @@
+package oidc
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/brocaar/lora-app-server/internal/storage"
+)
+
+// rolePriority defines the priority of the organization roles, used when
+// multiple groups of the user grant a role within the same organization.
+var rolePriority = map[storage.OrganizationRole]int{
+	storage.OrganizationRoleReadOnly:    1,
+	storage.OrganizationRoleDeviceAdmin: 2,
+	storage.OrganizationRoleAdmin:       3,
+}
+
+// RoleMapping defines the organization role granted by a group.
+type RoleMapping struct {
+	Group          string
+	OrganizationID int64
+	Role           storage.OrganizationRole
+}
+
+// ParseRoleMapping parses the given role mapping. The mapping has the
+// format group=organizationID:ROLE, e.g. iot-admins=1:ADMIN.
+func ParseRoleMapping(s string) (RoleMapping, error) {
+	var m RoleMapping
+
+	i := strings.LastIndex(s, "=")
+	if i <= 0 {
+		return m, fmt.Errorf("invalid role mapping %s, expected group=organizationID:ROLE", s)
+	}
+	m.Group = s[:i]
+
+	parts := strings.SplitN(s[i+1:], ":", 2)
+	if len(parts) != 2 {
+		return m, fmt.Errorf("invalid role mapping %s, expected group=organizationID:ROLE", s)
+	}
+
+	id, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return m, fmt.Errorf("invalid organization id in role mapping %s", s)
+	}
+	m.OrganizationID = id
+	m.Role = storage.OrganizationRole(strings.ToUpper(parts[1]))
+
+	return m, m.Role.Validate()
+}
+
+// mapRoles returns the organization roles granted by the given groups.
+// When multiple groups grant a role within the same organization, the role
+// with the most permissions is returned.
+func mapRoles(mappings []RoleMapping, groups []string) map[int64]storage.OrganizationRole {
+	member := make(map[string]bool)
+	for _, g := range groups {
+		member[g] = true
+	}
+
+	roles := make(map[int64]storage.OrganizationRole)
+	for _, m := range mappings {
+		if !member[m.Group] {
+			continue
+		}
+		if rolePriority[m.Role] > rolePriority[roles[m.OrganizationID]] {
+			roles[m.OrganizationID] = m.Role
+		}
+	}
+	return roles
+}
+
+// isAdmin returns true when the given groups contain the admin group.
+func isAdmin(adminGroup string, groups []string) bool {
+	if adminGroup == "" {
+		return false
+	}
+	for _, g := range groups {
+		if g == adminGroup {
+			return true
+		}
+	}
+	return false
+}
+
+// Provision provisions the user of the given ID token and returns the user.
+// The user is created on the first login. The username of an existing user
+// is not changed, even when the username claim has been changed at the
+// provider. The organization roles of the user are synchronized for the
+// organizations referenced by the role mappings, memberships of other
+// organizations (e.g. added using the api) are not changed.
+func Provision(db *sqlx.DB, mappings []RoleMapping, t IDToken) (storage.OIDCUser, error) {
+	u, err := storage.GetOIDCUserBySubject(db, t.Subject)
+	if err != nil {
+		return storage.OIDCUser{}, err
+	}
+
+	if u == nil {
+		u = &storage.OIDCUser{
+			Username: t.Username,
+			Subject:  t.Subject,
+			Email:    t.Email,
+			Name:     t.Name,
+		}
+		if err := storage.CreateOIDCUser(db, u); err != nil {
+			return storage.OIDCUser{}, err
+		}
+	} else {
+		u.Email = t.Email
+		u.Name = t.Name
+		if err := storage.UpdateOIDCUserLogin(db, u); err != nil {
+			return storage.OIDCUser{}, err
+		}
+	}
+
+	if err := syncRoles(db, u.Username, mappings, t.Groups); err != nil {
+		return storage.OIDCUser{}, err
+	}
+
+	return *u, nil
+}
+
+// syncRoles synchronizes the organization roles of the given user for the
+// organizations referenced by the role mappings.
+func syncRoles(db *sqlx.DB, username string, mappings []RoleMapping, groups []string) error {
+	current, err := storage.GetUserOrganizationRoles(db, username)
+	if err != nil {
+		return err
+	}
+	roles := mapRoles(mappings, groups)
+
+	managed := make(map[int64]bool)
+	for _, m := range mappings {
+		if managed[m.OrganizationID] {
+			continue
+		}
+		managed[m.OrganizationID] = true
+
+		role, granted := roles[m.OrganizationID]
+		currentRole, member := current[m.OrganizationID]
+
+		ou := storage.OrganizationUser{
+			OrganizationID: m.OrganizationID,
+			Username:       username,
+			Role:           role,
+		}
+
+		switch {
+		case granted && !member:
+			err = storage.CreateOrganizationUser(db, &ou)
+		case granted && currentRole != role:
+			err = storage.UpdateOrganizationUser(db, ou)
+		case !granted && member:
+			err = storage.DeleteOrganizationUser(db, m.OrganizationID, username)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	log.WithFields(log.Fields{
+		"username": username,
+		"roles":    roles,
+	}).Info("oidc: organization roles synchronized")
+	return nil
+}
@@ -0,0 +1,125 @@
+package report
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// PDF layout (A4, Courier 9pt).
+const (
+	pdfPageWidth    = 595
+	pdfPageHeight   = 842
+	pdfMargin       = 40
+	pdfFontSize     = 9
+	pdfLineHeight   = 12
+	pdfLinesPerPage = (pdfPageHeight - 2*pdfMargin) / pdfLineHeight
+	pdfColumnGap    = 2
+)
+
+// renderPDF renders the given table as a (plain, fixed-width) PDF document.
+func renderPDF(t Table) []byte {
+	lines := append([]string{t.Title, ""}, textTable(t)...)
+
+	var pages [][]string
+	for len(lines) > pdfLinesPerPage {
+		pages = append(pages, lines[:pdfLinesPerPage])
+		lines = lines[pdfLinesPerPage:]
+	}
+	pages = append(pages, lines)
+
+	// objects: 1 catalog, 2 pages, 3 font, then a page and content object
+	// per page
+	var objects []string
+	objects = append(objects, "<< /Type /Catalog /Pages 2 0 R >>")
+
+	var kids []string
+	for i := range pages {
+		kids = append(kids, fmt.Sprintf("%d 0 R", 4+2*i))
+	}
+	objects = append(objects, fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(pages)))
+	objects = append(objects, "<< /Type /Font /Subtype /Type1 /BaseFont /Courier >>")
+
+	for i, page := range pages {
+		objects = append(objects, fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %d %d] /Resources << /Font << /F1 3 0 R >> >> /Contents %d 0 R >>", pdfPageWidth, pdfPageHeight, 5+2*i))
+
+		var content bytes.Buffer
+		fmt.Fprintf(&content, "BT\n/F1 %d Tf\n%d TL\n%d %d Td\n", pdfFontSize, pdfLineHeight, pdfMargin, pdfPageHeight-pdfMargin)
+		for _, line := range page {
+			fmt.Fprintf(&content, "(%s) '\n", pdfEscape(line))
+		}
+		content.WriteString("ET")
+		objects = append(objects, fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", content.Len(), content.String()))
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("%PDF-1.4\n")
+	offsets := make([]int, len(objects))
+	for i, obj := range objects {
+		offsets[i] = buf.Len()
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", i+1, obj)
+	}
+
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xref)
+
+	return buf.Bytes()
+}
+
+// textTable returns the given table as fixed-width text lines.
+func textTable(t Table) []string {
+	widths := make([]int, len(t.Header))
+	for _, row := range append([][]string{t.Header}, t.Rows...) {
+		for i, cell := range row {
+			if i < len(widths) && utf8.RuneCountInString(cell) > widths[i] {
+				widths[i] = utf8.RuneCountInString(cell)
+			}
+		}
+	}
+
+	format := func(row []string) string {
+		var cells []string
+		for i, cell := range row {
+			if i < len(widths) {
+				cell += strings.Repeat(" ", widths[i]-utf8.RuneCountInString(cell))
+			}
+			cells = append(cells, cell)
+		}
+		return strings.TrimRight(strings.Join(cells, strings.Repeat(" ", pdfColumnGap)), " ")
+	}
+
+	var separator []string
+	for _, w := range widths {
+		separator = append(separator, strings.Repeat("-", w))
+	}
+
+	lines := []string{format(t.Header), format(separator)}
+	for _, row := range t.Rows {
+		lines = append(lines, format(row))
+	}
+	return lines
+}
+
+// pdfEscape escapes the given string for usage within a PDF string literal.
+// As only the standard (Type1) font is used, non-ASCII characters are
+// replaced by '?'.
+func pdfEscape(s string) string {
+	var buf bytes.Buffer
+	for _, r := range s {
+		switch {
+		case r == '\\' || r == '(' || r == ')':
+			buf.WriteRune('\\')
+			buf.WriteRune(r)
+		case r < 32 || r > 126:
+			buf.WriteRune('?')
+		default:
+			buf.WriteRune(r)
+		}
+	}
+	return buf.String()
+}
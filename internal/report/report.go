@@ -0,0 +1,191 @@
+// Package report implements the generation and delivery of the scheduled
+// (device activity and offline devices) reports of the applications.
+package report
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/brocaar/lora-app-server/internal/storage"
+)
+
+// Report types.
+const (
+	TypeDeviceActivity = "DEVICE_ACTIVITY" // uplink activity of all the nodes
+	TypeOfflineDevices = "OFFLINE_DEVICES" // nodes without recent uplinks
+)
+
+// Report formats.
+const (
+	FormatCSV = "CSV"
+	FormatPDF = "PDF"
+)
+
+// Report schedules.
+const (
+	ScheduleDaily  = "DAILY"
+	ScheduleWeekly = "WEEKLY"
+)
+
+// Delivery channels.
+const (
+	ChannelEmail   = "EMAIL"
+	ChannelWebhook = "WEBHOOK"
+)
+
+// offlineIntervals defines the number of missed uplink intervals after
+// which a node is considered offline. For nodes without uplink interval,
+// the report period is used.
+const offlineIntervals = 3
+
+// timeFormat defines the format of the timestamps in the reports.
+const timeFormat = "2006-01-02 15:04:05 MST"
+
+// Table contains the content of a report.
+type Table struct {
+	Title  string
+	Header []string
+	Rows   [][]string
+}
+
+// File contains a rendered report.
+type File struct {
+	Filename    string
+	ContentType string
+	Data        []byte
+}
+
+// Period returns the period covered by the given report, ending at its
+// scheduled run.
+func Period(r storage.ScheduledReport) (time.Time, time.Time) {
+	end := r.NextRunAt
+	if r.Schedule == ScheduleWeekly {
+		return end.AddDate(0, 0, -7), end
+	}
+	return end.AddDate(0, 0, -1), end
+}
+
+// NextRun returns the first run of the given report after the given time.
+func NextRun(r storage.ScheduledReport, after time.Time) (time.Time, error) {
+	loc, err := time.LoadLocation(r.Timezone)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("load timezone %s error: %s", r.Timezone, err)
+	}
+	t := after.In(loc)
+	next := time.Date(t.Year(), t.Month(), t.Day(), r.Hour, 0, 0, 0, loc)
+
+	switch r.Schedule {
+	case ScheduleDaily:
+		if !next.After(after) {
+			next = next.AddDate(0, 0, 1)
+		}
+	case ScheduleWeekly:
+		next = next.AddDate(0, 0, (r.Weekday-int(next.Weekday())+7)%7)
+		if !next.After(after) {
+			next = next.AddDate(0, 0, 7)
+		}
+	default:
+		return time.Time{}, fmt.Errorf("invalid schedule: %s", r.Schedule)
+	}
+	return next, nil
+}
+
+// Generate generates and renders the given report.
+func Generate(db *sqlx.DB, r storage.ScheduledReport) (File, error) {
+	start, end := Period(r)
+	activity, err := storage.GetNodeActivityForAppEUI(db, r.AppEUI, start, end)
+	if err != nil {
+		return File{}, err
+	}
+
+	t, err := newTable(r, activity, start, end)
+	if err != nil {
+		return File{}, err
+	}
+
+	return Render(t, r.Format, fmt.Sprintf("%s_%s_%s", r.AppEUI, r.ReportType, end.Format("20060102")))
+}
+
+// Render renders the given table in the given format.
+func Render(t Table, format, name string) (File, error) {
+	switch format {
+	case FormatCSV:
+		var buf bytes.Buffer
+		w := csv.NewWriter(&buf)
+		if err := w.Write(t.Header); err != nil {
+			return File{}, err
+		}
+		if err := w.WriteAll(t.Rows); err != nil {
+			return File{}, err
+		}
+		return File{
+			Filename:    name + ".csv",
+			ContentType: "text/csv",
+			Data:        buf.Bytes(),
+		}, nil
+	case FormatPDF:
+		return File{
+			Filename:    name + ".pdf",
+			ContentType: "application/pdf",
+			Data:        renderPDF(t),
+		}, nil
+	default:
+		return File{}, fmt.Errorf("invalid format: %s", format)
+	}
+}
+
+// newTable returns the content of the given report given the activity of
+// the nodes within the given period.
+func newTable(r storage.ScheduledReport, activity []storage.NodeActivity, start, end time.Time) (Table, error) {
+	loc, err := time.LoadLocation(r.Timezone)
+	if err != nil {
+		return Table{}, fmt.Errorf("load timezone %s error: %s", r.Timezone, err)
+	}
+	period := fmt.Sprintf("%s - %s", start.In(loc).Format(timeFormat), end.In(loc).Format(timeFormat))
+
+	lastSeen := func(a storage.NodeActivity) string {
+		if a.LastSeen == nil {
+			return "unknown"
+		}
+		return a.LastSeen.In(loc).Format(timeFormat)
+	}
+
+	switch r.ReportType {
+	case TypeDeviceActivity:
+		t := Table{
+			Title:  fmt.Sprintf("%s: device activity of application %s (%s)", r.Name, r.AppEUI, period),
+			Header: []string{"DevEUI", "Name", "Uplinks", "Expected uplinks", "Last seen"},
+		}
+		for _, a := range activity {
+			var expected string
+			if a.UplinkInterval > 0 {
+				expected = strconv.Itoa(int(end.Sub(start) / (time.Duration(a.UplinkInterval) * time.Second)))
+			}
+			t.Rows = append(t.Rows, []string{a.DevEUI.String(), a.Name, strconv.Itoa(a.Count), expected, lastSeen(a)})
+		}
+		return t, nil
+	case TypeOfflineDevices:
+		t := Table{
+			Title:  fmt.Sprintf("%s: offline devices of application %s (%s)", r.Name, r.AppEUI, period),
+			Header: []string{"DevEUI", "Name", "Uplink interval (s)", "Last seen"},
+		}
+		for _, a := range activity {
+			threshold := end.Sub(start)
+			if a.UplinkInterval > 0 {
+				threshold = offlineIntervals * time.Duration(a.UplinkInterval) * time.Second
+			}
+			if a.LastSeen != nil && !a.LastSeen.Before(end.Add(-threshold)) {
+				continue
+			}
+			t.Rows = append(t.Rows, []string{a.DevEUI.String(), a.Name, strconv.Itoa(int(a.UplinkInterval)), lastSeen(a)})
+		}
+		return t, nil
+	default:
+		return Table{}, fmt.Errorf("invalid report type: %s", r.ReportType)
+	}
+}
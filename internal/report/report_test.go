@@ -0,0 +1,169 @@
+package report
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+func TestNextRun(t *testing.T) {
+	Convey("Given a set of tests", t, func() {
+		testTable := []struct {
+			Description string
+			Report      storage.ScheduledReport
+			After       time.Time
+			Expected    time.Time
+		}{
+			{
+				Description: "Daily report later today",
+				Report:      storage.ScheduledReport{Schedule: ScheduleDaily, Hour: 8, Timezone: "UTC"},
+				After:       time.Date(2016, 12, 1, 6, 0, 0, 0, time.UTC),
+				Expected:    time.Date(2016, 12, 1, 8, 0, 0, 0, time.UTC),
+			},
+			{
+				Description: "Daily report at its scheduled hour",
+				Report:      storage.ScheduledReport{Schedule: ScheduleDaily, Hour: 8, Timezone: "UTC"},
+				After:       time.Date(2016, 12, 1, 8, 0, 0, 0, time.UTC),
+				Expected:    time.Date(2016, 12, 2, 8, 0, 0, 0, time.UTC),
+			},
+			{
+				Description: "Daily report in a different timezone",
+				Report:      storage.ScheduledReport{Schedule: ScheduleDaily, Hour: 8, Timezone: "Asia/Tokyo"},
+				After:       time.Date(2016, 12, 1, 6, 0, 0, 0, time.UTC),
+				Expected:    time.Date(2016, 12, 1, 23, 0, 0, 0, time.UTC),
+			},
+			{
+				Description: "Weekly report later this week",
+				Report:      storage.ScheduledReport{Schedule: ScheduleWeekly, Weekday: int(time.Monday), Hour: 8, Timezone: "UTC"},
+				After:       time.Date(2016, 12, 1, 6, 0, 0, 0, time.UTC), // thursday
+				Expected:    time.Date(2016, 12, 5, 8, 0, 0, 0, time.UTC),
+			},
+			{
+				Description: "Weekly report at its scheduled hour",
+				Report:      storage.ScheduledReport{Schedule: ScheduleWeekly, Weekday: int(time.Thursday), Hour: 8, Timezone: "UTC"},
+				After:       time.Date(2016, 12, 1, 8, 0, 0, 0, time.UTC),
+				Expected:    time.Date(2016, 12, 8, 8, 0, 0, 0, time.UTC),
+			},
+		}
+
+		for _, test := range testTable {
+			Convey("Test: "+test.Description, func() {
+				next, err := NextRun(test.Report, test.After)
+				So(err, ShouldBeNil)
+				So(next.Equal(test.Expected), ShouldBeTrue)
+			})
+		}
+	})
+}
+
+func TestNewTable(t *testing.T) {
+	Convey("Given the activity of three nodes", t, func() {
+		end := time.Date(2016, 12, 2, 8, 0, 0, 0, time.UTC)
+		start := end.AddDate(0, 0, -1)
+		recent := end.Add(-time.Hour)
+		old := end.Add(-4 * time.Hour)
+
+		activity := []storage.NodeActivity{
+			{DevEUI: lorawan.EUI64{1, 1, 1, 1, 1, 1, 1, 1}, Name: "online", UplinkInterval: 3600, Count: 24, LastSeen: &recent},
+			{DevEUI: lorawan.EUI64{2, 2, 2, 2, 2, 2, 2, 2}, Name: "missed-uplinks", UplinkInterval: 3600, Count: 20, LastSeen: &old},
+			{DevEUI: lorawan.EUI64{3, 3, 3, 3, 3, 3, 3, 3}, Name: "never-seen"},
+		}
+		r := storage.ScheduledReport{
+			Name:     "test",
+			Timezone: "UTC",
+		}
+
+		Convey("Then the device activity report contains all nodes", func() {
+			r.ReportType = TypeDeviceActivity
+			table, err := newTable(r, activity, start, end)
+			So(err, ShouldBeNil)
+			So(table.Rows, ShouldResemble, [][]string{
+				{"0101010101010101", "online", "24", "24", "2016-12-02 07:00:00 UTC"},
+				{"0202020202020202", "missed-uplinks", "20", "24", "2016-12-02 04:00:00 UTC"},
+				{"0303030303030303", "never-seen", "0", "", "unknown"},
+			})
+		})
+
+		Convey("Then the offline devices report contains the offline nodes", func() {
+			r.ReportType = TypeOfflineDevices
+			table, err := newTable(r, activity, start, end)
+			So(err, ShouldBeNil)
+			So(table.Rows, ShouldResemble, [][]string{
+				{"0202020202020202", "missed-uplinks", "3600", "2016-12-02 04:00:00 UTC"},
+				{"0303030303030303", "never-seen", "0", "unknown"},
+			})
+		})
+	})
+}
+
+func TestRender(t *testing.T) {
+	Convey("Given a table", t, func() {
+		table := Table{
+			Title:  "test (report)",
+			Header: []string{"DevEUI", "Name"},
+			Rows: [][]string{
+				{"0101010101010101", "node, 1"},
+			},
+		}
+
+		Convey("Then it can be rendered as CSV", func() {
+			f, err := Render(table, FormatCSV, "test")
+			So(err, ShouldBeNil)
+			So(f.Filename, ShouldEqual, "test.csv")
+			So(string(f.Data), ShouldEqual, "DevEUI,Name\n0101010101010101,\"node, 1\"\n")
+		})
+
+		Convey("Then it can be rendered as PDF", func() {
+			f, err := Render(table, FormatPDF, "test")
+			So(err, ShouldBeNil)
+			So(f.Filename, ShouldEqual, "test.pdf")
+			So(bytes.HasPrefix(f.Data, []byte("%PDF-1.4\n")), ShouldBeTrue)
+			So(bytes.HasSuffix(f.Data, []byte("%%EOF\n")), ShouldBeTrue)
+			So(string(f.Data), ShouldContainSubstring, `(test \(report\)) '`)
+		})
+	})
+}
+
+func TestDeliver(t *testing.T) {
+	Convey("Given a test webhook server", t, func() {
+		requests := make(chan *http.Request, 1)
+		bodies := make(chan []byte, 1)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			b, _ := ioutil.ReadAll(r.Body)
+			requests <- r
+			bodies <- b
+		}))
+		defer server.Close()
+
+		Convey("When delivering a report using the webhook channel", func() {
+			s := NewScheduler(nil, nil)
+			f := File{Filename: "test.csv", ContentType: "text/csv", Data: []byte("DevEUI\n")}
+			So(s.Deliver(storage.ScheduledReport{Channel: ChannelWebhook, WebhookURL: server.URL}, f), ShouldBeNil)
+
+			Convey("Then the report was posted", func() {
+				r := <-requests
+				So(r.Header.Get("Content-Type"), ShouldEqual, "text/csv")
+				So(strings.Contains(r.Header.Get("Content-Disposition"), "test.csv"), ShouldBeTrue)
+				So(string(<-bodies), ShouldEqual, "DevEUI\n")
+			})
+		})
+
+		Convey("When delivering a report by email without mailer", func() {
+			s := NewScheduler(nil, nil)
+			err := s.Deliver(storage.ScheduledReport{Channel: ChannelEmail}, File{})
+
+			Convey("Then an error is returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
@@ -0,0 +1,133 @@
+package report
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"strings"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/brocaar/lora-app-server/internal/mailer"
+	"github.com/brocaar/lora-app-server/internal/storage"
+)
+
+// schedulerInterval defines the interval in which the scheduler checks for
+// due reports.
+const schedulerInterval = time.Minute
+
+// webhookTimeout defines the timeout of the webhook requests.
+const webhookTimeout = 30 * time.Second
+
+// Scheduler generates and delivers the due reports.
+type Scheduler struct {
+	db     *sqlx.DB
+	mailer *mailer.Mailer // optional
+	client *http.Client
+}
+
+// NewScheduler creates a new Scheduler. Without mailer, reports using the
+// email channel are not delivered.
+func NewScheduler(db *sqlx.DB, m *mailer.Mailer) *Scheduler {
+	return &Scheduler{
+		db:     db,
+		mailer: m,
+		client: &http.Client{Timeout: webhookTimeout},
+	}
+}
+
+// Run runs the scheduler (blocking).
+func (s *Scheduler) Run() {
+	for {
+		if err := s.runDue(time.Now()); err != nil {
+			log.Errorf("report: run due reports error: %s", err)
+		}
+		time.Sleep(schedulerInterval)
+	}
+}
+
+// runDue generates and delivers the reports which are due at the given time.
+// Each report is claimed first, so that it is delivered only once when
+// running multiple instances.
+func (s *Scheduler) runDue(now time.Time) error {
+	reports, err := storage.GetDueScheduledReports(s.db, now)
+	if err != nil {
+		return err
+	}
+
+	for _, r := range reports {
+		logFields := log.Fields{
+			"id":      r.ID,
+			"app_eui": r.AppEUI,
+		}
+
+		next, err := NextRun(r, now)
+		if err != nil {
+			log.WithFields(logFields).Errorf("report: next run error: %s", err)
+			continue
+		}
+		claimed, err := storage.ClaimScheduledReport(s.db, r, now, next)
+		if err != nil {
+			log.WithFields(logFields).Errorf("report: claim error: %s", err)
+			continue
+		}
+		if !claimed {
+			continue
+		}
+
+		f, err := Generate(s.db, r)
+		if err != nil {
+			log.WithFields(logFields).Errorf("report: generate error: %s", err)
+			continue
+		}
+		if err := s.Deliver(r, f); err != nil {
+			log.WithFields(logFields).Errorf("report: deliver error: %s", err)
+			continue
+		}
+		log.WithFields(logFields).Info("report: report delivered")
+	}
+
+	return nil
+}
+
+// Deliver delivers the given (rendered) report using the channel of the
+// given report.
+func (s *Scheduler) Deliver(r storage.ScheduledReport, f File) error {
+	switch r.Channel {
+	case ChannelEmail:
+		if s.mailer == nil {
+			return errors.New("email is not configured")
+		}
+		subject := fmt.Sprintf("[LoRa App Server] %s", r.Name)
+		body := fmt.Sprintf("Please find attached the %s report of application %s.", strings.ToLower(strings.Replace(r.ReportType, "_", " ", -1)), r.AppEUI)
+		return s.mailer.Send(r.Recipients, subject, body, mailer.Attachment{
+			Filename:    f.Filename,
+			ContentType: f.ContentType,
+			Data:        f.Data,
+		})
+	case ChannelWebhook:
+		req, err := http.NewRequest("POST", r.WebhookURL, bytes.NewReader(f.Data))
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Content-Type", f.ContentType)
+		req.Header.Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": f.Filename}))
+
+		resp, err := s.client.Do(req)
+		if err != nil {
+			return fmt.Errorf("post error: %s", err)
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode < 200 || resp.StatusCode > 299 {
+			return fmt.Errorf("expected 2xx response, got: %s", strings.TrimSpace(resp.Status))
+		}
+		return nil
+	default:
+		return fmt.Errorf("invalid channel: %s", r.Channel)
+	}
+}
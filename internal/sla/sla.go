@@ -0,0 +1,114 @@
+// Package sla implements the availability (SLA) reporting of the nodes and
+// applications, based on the expected and the received number of uplinks.
+package sla
+
+import (
+	"errors"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+// NodeReport contains the availability of a single node.
+// Nodes without uplink interval have an ExpectedUplinks and Availability
+// of 0.
+type NodeReport struct {
+	DevEUI          lorawan.EUI64
+	UplinkInterval  uint32
+	ExpectedUplinks int
+	ReceivedUplinks int
+	Availability    float64 // fraction (0 - 1) of the expected uplinks that was received
+}
+
+// ApplicationReport contains the availability of an application (all its
+// nodes). Nodes without uplink interval are not taken into account for
+// the application availability.
+type ApplicationReport struct {
+	AppEUI          lorawan.EUI64
+	ExpectedUplinks int
+	ReceivedUplinks int
+	Availability    float64
+	Nodes           []NodeReport
+}
+
+// GetNodeReport returns the availability report of the given node for the
+// given period.
+func GetNodeReport(db *sqlx.DB, devEUI lorawan.EUI64, start, end time.Time) (NodeReport, error) {
+	if !end.After(start) {
+		return NodeReport{}, errors.New("end must be after start")
+	}
+
+	count, err := storage.GetNodeUplinkCount(db, devEUI, start, end)
+	if err != nil {
+		return NodeReport{}, err
+	}
+	return newNodeReport(count, start, end), nil
+}
+
+// GetApplicationReport returns the availability report of the given
+// application for the given period.
+func GetApplicationReport(db *sqlx.DB, appEUI lorawan.EUI64, start, end time.Time) (ApplicationReport, error) {
+	report := ApplicationReport{
+		AppEUI: appEUI,
+		Nodes:  []NodeReport{},
+	}
+
+	if !end.After(start) {
+		return report, errors.New("end must be after start")
+	}
+
+	counts, err := storage.GetNodeUplinkCountsForAppEUI(db, appEUI, start, end)
+	if err != nil {
+		return report, err
+	}
+
+	// the number of received uplinks taken into account for the
+	// availability (more uplinks than expected doesn't compensate the
+	// missing uplinks of other nodes)
+	var available int
+
+	for _, count := range counts {
+		nr := newNodeReport(count, start, end)
+		report.Nodes = append(report.Nodes, nr)
+
+		if nr.ExpectedUplinks == 0 {
+			continue
+		}
+		report.ExpectedUplinks += nr.ExpectedUplinks
+		report.ReceivedUplinks += nr.ReceivedUplinks
+		available += min(nr.ReceivedUplinks, nr.ExpectedUplinks)
+	}
+
+	if report.ExpectedUplinks > 0 {
+		report.Availability = float64(available) / float64(report.ExpectedUplinks)
+	}
+	return report, nil
+}
+
+func newNodeReport(count storage.NodeUplinkCount, start, end time.Time) NodeReport {
+	nr := NodeReport{
+		DevEUI:          count.DevEUI,
+		UplinkInterval:  count.UplinkInterval,
+		ReceivedUplinks: count.Count,
+	}
+
+	if count.UplinkInterval == 0 {
+		return nr
+	}
+
+	nr.ExpectedUplinks = int(end.Sub(start) / (time.Duration(count.UplinkInterval) * time.Second))
+	if nr.ExpectedUplinks > 0 {
+		nr.Availability = float64(min(nr.ReceivedUplinks, nr.ExpectedUplinks)) / float64(nr.ExpectedUplinks)
+	}
+	return nr
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,45 @@
+package sla
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/brocaar/lora-app-server/internal/storage"
+)
+
+func TestNewNodeReport(t *testing.T) {
+	Convey("Given a period of one hour", t, func() {
+		start := time.Date(2016, 12, 1, 10, 0, 0, 0, time.UTC)
+		end := start.Add(time.Hour)
+
+		testTable := []struct {
+			Description string
+			Count       storage.NodeUplinkCount
+			Expected    NodeReport
+		}{
+			{
+				Description: "Node without uplink interval",
+				Count:       storage.NodeUplinkCount{Count: 10},
+				Expected:    NodeReport{ReceivedUplinks: 10},
+			},
+			{
+				Description: "Node missing half of the uplinks",
+				Count:       storage.NodeUplinkCount{UplinkInterval: 60, Count: 30},
+				Expected:    NodeReport{UplinkInterval: 60, ExpectedUplinks: 60, ReceivedUplinks: 30, Availability: 0.5},
+			},
+			{
+				Description: "Node sending more uplinks than expected",
+				Count:       storage.NodeUplinkCount{UplinkInterval: 600, Count: 10},
+				Expected:    NodeReport{UplinkInterval: 600, ExpectedUplinks: 6, ReceivedUplinks: 10, Availability: 1},
+			},
+		}
+
+		for _, test := range testTable {
+			Convey("Test: "+test.Description, func() {
+				So(newNodeReport(test.Count, start, end), ShouldResemble, test.Expected)
+			})
+		}
+	})
+}
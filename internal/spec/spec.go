@@ -0,0 +1,270 @@
+// Package spec implements the OpenAPI (Swagger 2.0) specification of the
+// REST API as served by the running server, including the authentication
+// scheme and the schemas of the (MQTT) events.
+package spec
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/brocaar/lora-app-server/integration"
+	"github.com/brocaar/lora-app-server/internal/static"
+)
+
+// Event describes an event published (or consumed) by LoRa App Server.
+type Event struct {
+	Name        string      // name of the event (used as definition name)
+	Topic       string      // MQTT topic
+	Direction   string      // publish (by LoRa App Server) or subscribe
+	Description string      // description of the event
+	Payload     interface{} // (zero value of the) payload type
+}
+
+// Events contains the events published and consumed by LoRa App Server.
+var Events = []Event{
+	{
+		Name:        "DataUp",
+		Topic:       "application/[AppEUI]/node/[DevEUI]/rx",
+		Direction:   "publish",
+		Description: "Data received from the node.",
+		Payload:     integration.DataUpPayload{},
+	},
+	{
+		Name:        "DataDown",
+		Topic:       "application/[AppEUI]/node/[DevEUI]/tx",
+		Direction:   "subscribe",
+		Description: "Data to enqueue for transmission to the node.",
+		Payload:     integration.DataDownPayload{},
+	},
+	{
+		Name:        "Join",
+		Topic:       "application/[AppEUI]/node/[DevEUI]/join",
+		Direction:   "publish",
+		Description: "Node joined the network.",
+		Payload:     integration.JoinNotification{},
+	},
+	{
+		Name:        "ACK",
+		Topic:       "application/[AppEUI]/node/[DevEUI]/ack",
+		Direction:   "publish",
+		Description: "Confirmed downlink acknowledged by the node.",
+		Payload:     integration.ACKNotification{},
+	},
+	{
+		Name:        "Error",
+		Topic:       "application/[AppEUI]/node/[DevEUI]/error",
+		Direction:   "publish",
+		Description: "Error related to the node.",
+		Payload:     integration.ErrorNotification{},
+	},
+	{
+		Name:        "LinkQuality",
+		Topic:       "application/[AppEUI]/node/[DevEUI]/linkquality",
+		Direction:   "publish",
+		Description: "Link-quality grade of the node degraded.",
+		Payload:     integration.LinkQualityNotification{},
+	},
+	{
+		Name:        "Lifecycle",
+		Topic:       "application/[AppEUI]/node/[DevEUI]/lifecycle",
+		Direction:   "publish",
+		Description: "Node created, updated or deleted using the API.",
+		Payload:     integration.LifecycleNotification{},
+	},
+	{
+		Name:        "Firmware",
+		Topic:       "application/[AppEUI]/node/[DevEUI]/firmware",
+		Direction:   "publish",
+		Description: "Firmware update (FUOTA) state of the node changed.",
+		Payload:     integration.FirmwareNotification{},
+	},
+	{
+		Name:        "Status",
+		Topic:       "application/[AppEUI]/node/[DevEUI]/status",
+		Direction:   "publish",
+		Description: "Status (uplink meta-data or reported battery and link margin) of the node.",
+		Payload:     integration.DeviceStatus{},
+	},
+	{
+		Name:        "Location",
+		Topic:       "application/[AppEUI]/node/[DevEUI]/location",
+		Direction:   "publish",
+		Description: "Location of the node resolved (when a geolocation resolver is configured).",
+		Payload:     integration.LocationNotification{},
+	},
+	{
+		Name:        "Scheduled",
+		Topic:       "application/[AppEUI]/node/[DevEUI]/scheduled",
+		Direction:   "publish",
+		Description: "Payload enqueued by a downlink rule transmitted to the node.",
+		Payload:     integration.ScheduledDownlinkNotification{},
+	},
+	{
+		Name:        "GatewayStats",
+		Topic:       "gateway/[MAC]/stats",
+		Direction:   "publish",
+		Description: "Hourly statistics of the gateway (when enabled).",
+		Payload:     integration.GatewayStats{},
+	},
+}
+
+// securityDefinitionName defines the name of the JWT security definition.
+const securityDefinitionName = "jwt"
+
+var (
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	timeType          = reflect.TypeOf(time.Time{})
+)
+
+// Generate returns the (JSON encoded) specification for the given server
+// version. When jwtEnabled is set, all API methods are marked as requiring
+// the JWT security scheme.
+func Generate(version string, jwtEnabled bool) ([]byte, error) {
+	b, err := static.Asset("swagger/api.swagger.json")
+	if err != nil {
+		return nil, fmt.Errorf("get swagger definition error: %s", err)
+	}
+
+	var spec map[string]interface{}
+	if err := json.Unmarshal(b, &spec); err != nil {
+		return nil, fmt.Errorf("unmarshal swagger definition error: %s", err)
+	}
+
+	// the merged definition contains empty values which are not valid
+	// according to the specification
+	if spec["schemes"] == nil {
+		delete(spec, "schemes")
+	}
+	if spec["basePath"] == "" {
+		delete(spec, "basePath")
+	}
+
+	if info, ok := spec["info"].(map[string]interface{}); ok && version != "" {
+		info["version"] = version
+	}
+
+	spec["securityDefinitions"] = map[string]interface{}{
+		securityDefinitionName: map[string]interface{}{
+			"type":        "apiKey",
+			"in":          "header",
+			"name":        "Grpc-Metadata-Authorization",
+			"description": "JWT token (HS256), see https://docs.loraserver.io/lora-app-server/api/",
+		},
+	}
+	if jwtEnabled {
+		spec["security"] = []interface{}{
+			map[string]interface{}{securityDefinitionName: []interface{}{}},
+		}
+	}
+
+	definitions, ok := spec["definitions"].(map[string]interface{})
+	if !ok {
+		definitions = make(map[string]interface{})
+		spec["definitions"] = definitions
+	}
+	var events []interface{}
+	for _, e := range Events {
+		name := "event" + e.Name
+		definitions[name] = Schema(reflect.TypeOf(e.Payload))
+		events = append(events, map[string]interface{}{
+			"name":        e.Name,
+			"topic":       e.Topic,
+			"direction":   e.Direction,
+			"description": e.Description,
+			"schema":      map[string]interface{}{"$ref": "#/definitions/" + name},
+		})
+	}
+	spec["x-events"] = events
+
+	return json.Marshal(spec)
+}
+
+// Schema returns the JSON schema of the given type, based on the way it is
+// encoded by the encoding/json package.
+func Schema(t reflect.Type) map[string]interface{} {
+	if t.Kind() == reflect.Ptr {
+		return Schema(t.Elem())
+	}
+	if t == timeType {
+		return map[string]interface{}{"type": "string", "format": "date-time"}
+	}
+	if t.Implements(textMarshalerType) || reflect.PtrTo(t).Implements(textMarshalerType) {
+		return map[string]interface{}{"type": "string"}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return map[string]interface{}{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint8, reflect.Uint16:
+		return map[string]interface{}{"type": "integer", "format": "int32"}
+	case reflect.Int64, reflect.Uint, reflect.Uint32, reflect.Uint64:
+		return map[string]interface{}{"type": "integer", "format": "int64"}
+	case reflect.Float32:
+		return map[string]interface{}{"type": "number", "format": "float"}
+	case reflect.Float64:
+		return map[string]interface{}{"type": "number", "format": "double"}
+	case reflect.String:
+		return map[string]interface{}{"type": "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 && t.Kind() == reflect.Slice {
+			return map[string]interface{}{"type": "string", "format": "byte"}
+		}
+		return map[string]interface{}{"type": "array", "items": Schema(t.Elem())}
+	case reflect.Map:
+		return map[string]interface{}{"type": "object", "additionalProperties": Schema(t.Elem())}
+	case reflect.Struct:
+		return structSchema(t)
+	default:
+		return map[string]interface{}{}
+	}
+}
+
+// structSchema returns the JSON schema of the given struct type. Fields
+// without omitempty are marked as required.
+func structSchema(t reflect.Type) map[string]interface{} {
+	properties := make(map[string]interface{})
+	var required []string
+
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" {
+			// unexported field
+			continue
+		}
+
+		name := f.Name
+		var omitEmpty bool
+		if tag := f.Tag.Get("json"); tag != "" {
+			parts := strings.Split(tag, ",")
+			if parts[0] == "-" {
+				continue
+			}
+			if parts[0] != "" {
+				name = parts[0]
+			}
+			for _, opt := range parts[1:] {
+				if opt == "omitempty" {
+					omitEmpty = true
+				}
+			}
+		}
+
+		properties[name] = Schema(f.Type)
+		if !omitEmpty {
+			required = append(required, name)
+		}
+	}
+
+	schema := map[string]interface{}{
+		"type":       "object",
+		"properties": properties,
+	}
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+	return schema
+}
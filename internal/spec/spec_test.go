@@ -0,0 +1,67 @@
+package spec
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/brocaar/lorawan"
+)
+
+func TestSchema(t *testing.T) {
+	Convey("Given a struct type", t, func() {
+		type testStruct struct {
+			DevEUI     lorawan.EUI64 `json:"devEUI"`
+			Data       []byte        `json:"data"`
+			Time       *time.Time    `json:"time,omitempty"`
+			Values     []int         `json:"values"`
+			Ignored    string        `json:"-"`
+			unexported string
+		}
+
+		Convey("Then Schema returns the expected schema", func() {
+			So(Schema(reflect.TypeOf(testStruct{})), ShouldResemble, map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"devEUI": map[string]interface{}{"type": "string"},
+					"data":   map[string]interface{}{"type": "string", "format": "byte"},
+					"time":   map[string]interface{}{"type": "string", "format": "date-time"},
+					"values": map[string]interface{}{
+						"type":  "array",
+						"items": map[string]interface{}{"type": "integer", "format": "int32"},
+					},
+				},
+				"required": []string{"devEUI", "data", "values"},
+			})
+		})
+	})
+}
+
+func TestGenerate(t *testing.T) {
+	Convey("When generating the specification with JWT enabled", t, func() {
+		b, err := Generate("1.2.3", true)
+		So(err, ShouldBeNil)
+
+		var spec map[string]interface{}
+		So(json.Unmarshal(b, &spec), ShouldBeNil)
+
+		Convey("Then the version is set", func() {
+			So(spec["info"].(map[string]interface{})["version"], ShouldEqual, "1.2.3")
+		})
+
+		Convey("Then the security definition and requirement are set", func() {
+			So(spec["securityDefinitions"], ShouldContainKey, "jwt")
+			So(spec["security"], ShouldHaveLength, 1)
+		})
+
+		Convey("Then the event definitions are set", func() {
+			So(spec["x-events"], ShouldHaveLength, len(Events))
+			for _, e := range Events {
+				So(spec["definitions"], ShouldContainKey, "event"+e.Name)
+			}
+		})
+	})
+}
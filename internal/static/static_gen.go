@@ -331,7 +331,7 @@ func staticMediaGlyphiconsHalflingsRegularFa277232Woff() (*asset, error) {
 	return a, nil
 }
 
-var _swaggerApiSwaggerJson = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xec\x5b\x5f\x6f\x22\x39\x12\x7f\xbf\x4f\x61\xf9\x4e\xba\x19\xa9\x03\x49\xb4\x5a\x69\x79\x8b\xc2\x24\x42\x37\x97\xcb\x91\x8c\x6e\xa4\x49\x1e\x4c\xbb\x1a\xbc\xe9\xb6\x7b\x6c\x77\x08\x8a\xf8\xee\x27\xdb\x0d\x34\xf4\x1f\x0c\x81\x2c\x13\xf1\x04\x6d\xaa\xed\xf2\xaf\xaa\x7e\x2e\x97\xcd\x2b\x56\x63\x32\x1c\x82\xc4\x1d\x7c\xde\x3a\xc5\x01\x1e\x10\x05\xb7\x44\x8f\x70\x07\xe3\x00\x33\x1e\x09\xdc\x79\xc5\x9a\xe9\x18\x70\x07\x7f\x15\x7d\x82\x2e\xd2\x14\xdd\x81\x7c\x06\x89\xfa\x5f\xee\xee\xd1\xc5\x6d\x0f\x07\xf8\x19\xa4\x62\x82\xe3\x0e\x3e\x6b\x9d\xda\xae\x28\xa8\x50\xb2\x54\xbb\xd6\x07\x7e\x25\x24\x4a\x84\x04\x64\x7a\x95\x09\x31\x3f\x20\x32\x10\x99\x46\x7a\x04\x28\x53\x64\x08\x48\x44\xf6\x61\x75\xa0\x4f\x66\xa4\xcf\x66\xa8\x00\x29\x80\x07\xfe\x63\xa4\x75\xaa\x3a\xed\x36\x15\xa1\x6a\xc5\x42\x12\x65\x25\x5b\x4c\xb4\xcd\xd3\x09\x49\xd3\x13\xd7\xd4\x26\x29\x6b\x3f\x7e\xda\xf0\x85\xcf\xad\x07\x8e\xa7\x01\x56\xe1\x08\x12\x50\xb8\xc3\xb3\x38\x0e\x70\x28\xb8\xca\xec\xf3\x0f\x4c\xd2\x34\x66\xa1\x9d\x47\xfb\x4f\x25\x38\x7e\x0c\x70\x2a\x05\xcd\xc2\x86\xdf\x89\x1e\x29\x03\xa9\x1d\x24\x1c\x11\xce\x21\xfe\xca\x94\x36\x6d\x43\xb0\x1f\x22\x05\x69\xdf\xea\x51\x83\xb9\xf9\x31\xc0\x12\x54\x2a\xb8\x02\xfb\xf2\xf9\xe9\xa9\xf9\x58\x46\x18\xe7\xca\x12\xf3\xd3\x3f\x24\x44\xb8\x83\xff\xde\xa6\x10\x31\xce\x8c\x88\x32\x43\x9a\xde\x2e\x17\xa3\xf6\xf3\x5e\xf1\x74\x6a\xe6\x9a\x25\x09\x91\x93\x7c\x50\x14\x33\xa5\x95\x35\x47\xae\xe7\x89\x6b\x19\xb2\x67\xe0\x88\x70\x24\xa2\x48\x81\x46\x84\x53\x14\xb3\x84\xe9\xd6\x03\xbf\x11\x1a\xdc\x83\x6d\xce\x25\x32\x19\xa3\x94\x48\x92\x28\x44\x24\xf0\x7f\x6a\x44\x99\x4a\x63\x32\x01\x8a\x18\x47\x77\xce\x09\x91\x4a\x21\x54\xd6\xc0\x88\xc4\x4a\x74\x1e\xf8\xcc\x68\x43\xa6\x47\xd9\xa0\x15\x8a\xa4\x3d\x94\x69\x78\x02\xa1\x50\x13\xa5\x21\x7f\x1c\x12\x0d\x63\x32\x69\xa7\x59\x1c\xb7\xcf\xfe\xf8\x03\x07\x58\x93\xa1\x35\x42\x61\xb2\xf8\x71\x1a\xe0\x54\xa8\x0a\x90\x2f\x25\x10\x0d\xd8\xd8\x47\x92\x04\x34\x48\xf3\xf2\x2b\x66\x06\xd8\x81\xa0\x13\x1c\x60\x4e\x12\x58\x3c\x49\xf8\x99\x31\x09\x14\x77\xb4\xcc\xc0\x07\x7a\x37\xc6\x12\xf8\x3f\x33\x50\x1a\x4f\xa7\x8f\x3b\xb3\x6f\xc5\x20\xd5\x16\x76\x82\x28\xb4\x1f\xce\xca\xce\xae\x45\x5b\xb7\x6a\x81\x9c\x06\x25\x0f\x6e\xbf\x32\x3a\x75\x6a\xc7\xa0\xa1\x0c\x72\xd7\xb5\x97\x40\x76\x6c\x80\x3b\x98\x71\xfd\xfb\x6f\x96\x76\x70\xc7\x46\xca\x02\x75\x46\x2b\x30\xd7\x93\xd4\xfc\xa6\xb4\x64\x7c\x88\x77\x88\xa2\xd3\xd4\x03\x45\x27\x88\xdc\x8c\xcb\xb1\x82\x12\xa2\xc3\x11\xe3\xc3\x02\xbe\x8c\xd6\xa3\x1a\x54\x53\xc0\x35\xe8\x5f\x01\xb5\x6b\xf0\xa1\x96\x6b\xd0\x48\x82\xce\x24\xdf\x05\x5e\x69\x56\x81\xd7\xb7\x94\x56\x46\xf3\xae\x20\x0b\x76\x4b\x0c\x4e\xdd\x3d\x13\x43\xc5\x20\xd5\xf6\x71\x82\x28\xb3\x1f\xdb\x13\x03\x15\x63\x1e\x33\xfe\xf4\xdf\x0c\x32\xcb\x05\xd5\xc4\xfb\x85\xff\xb4\x02\x7b\x65\xde\x7c\x90\x6e\x51\xa5\x9e\x86\x64\x1f\x38\xd7\x8f\x55\x0d\x77\x2e\x8f\x08\xa5\x45\xb0\x99\x86\x04\x69\x61\x5b\xac\x40\x11\xf1\xa5\xce\xeb\x30\x6f\xbf\x52\x78\xfe\xf2\xad\x37\x5d\x97\x59\xd4\x05\x49\xee\xed\x95\x51\xe2\xba\x7e\x4f\x72\x31\xca\x96\x40\x55\x9e\xf9\x8b\x41\x53\x99\x4c\x63\x0e\x27\x8a\x84\x2c\xc0\xed\xe6\xb3\x05\xc6\x1f\x6c\xc9\xf3\x76\xdb\x95\x85\x8f\xe4\x1e\x1b\x49\x91\x6c\xe6\xb3\x5c\x50\x78\xc7\xdc\xf7\x46\x50\xf0\x74\x1a\xa3\x99\x3a\xc4\x94\xd6\xcc\xe1\x20\x72\x59\x07\xe6\xbe\x92\xd8\x26\x53\xd5\x66\xaf\xc6\x68\xad\x32\x56\x45\x6f\x5b\x22\xc6\xad\x03\xf7\xb0\xd8\xd1\xa9\xdb\x84\x58\x45\xa6\x6a\xc0\xa8\xca\xb8\xba\x25\x32\x9c\x7b\xdc\x16\xe9\xe9\x61\x01\x75\x0d\x8d\x14\xb0\x9a\x9c\x5a\x88\x66\x4b\x85\x74\xae\x0e\xb4\x09\xa1\xed\x12\xd2\x5d\x80\xb4\x97\xac\x74\x5f\x21\x5e\xec\xdd\x3b\x0f\xdd\xd8\x61\x8b\x61\x7f\x07\xca\x15\xa8\xea\x52\xd1\xf7\xe5\xcd\x5c\x9d\xfd\xd2\xe7\x7c\x90\x2d\x58\xf4\x44\xb9\x97\x5b\xe8\x7e\x04\x06\xe2\x0b\x4a\x25\x4a\x32\xa5\x51\x28\xb8\x26\x79\x36\xa5\x48\x02\xe8\x66\xfc\xd4\xeb\x22\x92\x6f\xe8\x04\x8f\xd8\x30\x93\x40\xd1\x0d\xe8\x5e\xb7\x85\x6e\x0a\xdd\x29\x34\x66\x71\x8c\xe0\x25\x65\x12\x10\xc9\xb4\x48\x88\x66\x21\x89\xe3\x09\x22\x91\x06\xb9\xda\xc7\xfd\xfd\xd7\x55\xcb\xce\x4c\x59\x69\xe0\xf6\x10\x74\x9f\x70\x2a\x92\x5c\xe7\x7a\x8b\x5f\xaf\x4a\xee\x92\x66\x96\x7a\x6e\xe0\x9b\x25\xb9\x39\xf9\x10\x24\x6d\xfb\x1c\x78\x4d\x9e\x66\x4e\xef\xd0\x4e\x25\x44\xec\x05\x31\xae\x05\x22\x61\x28\x32\xae\x37\xc3\xe9\x43\x2f\x83\x6b\x3c\xbf\x66\x35\x9c\x39\xa9\x3f\xc9\x2c\x20\xfe\x38\x8b\xe3\x1a\xec\xaa\xd6\xc8\xb7\x01\xf7\x01\xd7\xcc\x3d\xd2\x7b\xc5\x20\x1b\x56\x72\x96\xe8\xbd\x9e\x33\xa6\x01\x2e\x0c\x6d\x54\x6a\xaa\x62\x1b\x96\x95\xc6\x84\x9a\xb9\x19\xe6\xf5\x22\xfb\xdd\x6e\xc0\xcd\x97\xd2\xc6\x37\x37\x08\xe3\x1a\x86\x20\xf1\x74\xde\x42\xa4\x24\x13\xf3\xec\x8c\x52\x65\xf8\x15\x63\x2e\xde\x15\x83\x3f\x21\xd4\xe6\xe5\xc6\x92\x78\x49\x65\x46\x9b\x74\xf4\x1a\xa7\x98\x30\x95\xfa\x27\x54\xf6\xb8\x06\xf9\x4c\x62\x4f\x30\x48\x9a\x1a\x47\x2e\x79\xca\x08\x5e\x10\xf0\x50\x50\xa0\xe8\xc2\xc9\x04\xeb\x11\xb2\xfd\xfd\x0b\x26\x6b\xfb\x33\x32\x5e\xfd\x15\xea\xff\xbd\xae\x0f\x78\xc1\x2c\x36\x1b\x55\xe8\xce\xe2\xd7\x43\x05\xc6\x95\x26\x71\x6c\x99\xe3\xdf\x44\x0e\x19\x5f\xd2\x83\x8a\x6c\x10\xc3\xe2\x45\x9e\x25\x03\x87\xad\xb7\x63\x99\xd0\x8d\xc9\xcb\xd5\x25\xd7\x4b\xf2\x03\x21\x62\x20\x7c\xf1\xc2\xac\xc1\xbc\xf1\x72\xd6\xed\xff\xc7\xd6\x0a\x3c\x4d\x2d\x5f\xce\xbb\x7d\x6f\xd9\x2e\xc4\x64\xe2\x2d\xfd\x3f\xc6\xa9\x18\x37\xb1\x4a\xff\x7b\x2e\xe3\xe3\xde\x8b\xf8\x69\x96\x5c\x61\xc1\xc3\x8c\x87\x3b\x9f\x80\xb8\xf3\x8f\x88\xab\xd9\x71\xee\x5b\x48\x8f\x2e\x72\xd7\xc6\x28\xc9\xd3\x56\x0f\xbd\x76\x1d\x76\xd1\x25\xb7\x05\x59\xcf\x09\x1a\xf1\x6f\xa9\xa7\xf0\xf6\x21\x3d\x7e\x5a\x6f\xce\x9b\x5c\xc8\x6b\x9a\xc7\xc8\x6f\xd8\x4f\xd6\x10\x40\xc5\xf1\x69\x0d\x01\xbc\x69\xc1\xad\x3f\xa5\x6d\xd4\x6b\x5e\x89\x86\xd5\x73\xa0\x1a\xf5\x96\x3d\xa9\xd7\x9d\x5d\x52\x71\x07\x09\x26\xca\x8b\xae\xb4\xcd\x1c\xea\xcb\xee\x8d\x33\x69\xcc\x35\x76\x1b\xef\x8d\xea\xfb\x2c\x0a\x15\x5b\xb3\xc3\x50\xdc\xd7\xa7\x57\x4d\x54\x91\xf0\x0a\x1e\x31\x99\x40\x85\xd3\xe4\x5b\x09\x7b\x50\x42\xc2\xa7\xc5\x39\x89\x3d\xfe\x08\xfc\x88\x85\x12\x4d\xca\x5d\x0f\x88\x82\xdf\x7f\x9b\x23\x63\x85\x8a\x1d\x4e\x34\xbc\xcb\x72\x70\x2b\xa4\x2e\x77\x77\x65\x9a\x91\x16\x68\x00\x28\x53\x40\x1b\x22\xa5\xc8\xff\x15\x10\x32\xba\x55\xdc\x05\x38\x05\x4e\xcd\xd7\x52\x8f\xa6\x2f\x2d\x09\x57\x09\x73\x9b\x57\xa6\x50\x2e\x8c\x3e\x8d\x09\xd3\xe6\x4b\x24\x64\x6e\xb4\xcf\xbe\x76\x92\x10\x81\x04\x1e\x42\x85\x1f\xb8\xa2\xce\x5c\x02\x7d\x32\xa0\x20\xe1\xbc\x82\x0b\xcd\xa2\xfc\xc6\xd8\xe7\x37\x38\xf7\xba\xf3\xee\xa3\xe7\xee\xcd\x73\x0f\xd8\xf6\xcd\xfc\xb6\x7a\x79\x67\x1f\x0b\x76\xcd\x05\xa1\xbd\x55\x0e\xfc\x94\x7d\x7b\x85\x61\x7e\xa8\xf4\x57\xae\x68\xab\x27\x5b\x87\xb9\xd9\x3a\x16\x1f\x8e\xc5\x87\x2d\xb6\x20\xab\x95\xe9\x03\x08\xb4\x8a\xbc\xf1\x00\xe3\xed\x58\xdc\xf8\x40\xc5\x8d\xc1\xbd\xc9\x56\x3d\x87\x39\x96\x42\xde\xc2\x43\x01\xd6\x2f\xb7\x62\x0c\xd2\xab\xf7\x7a\xa6\x58\x39\x05\x9e\xf3\x96\x9f\x78\x1d\xb5\xec\x3a\x80\x6a\xf4\x2f\xfd\x59\xa4\x86\x76\xed\xe5\x38\xbf\xf5\x57\xac\x75\x86\x4d\x75\xaa\xc3\x48\x82\xca\xe2\x65\xaa\xda\xf4\xe2\x7a\x99\xb9\xb4\xd0\x24\xbe\x14\x19\x7f\xeb\x14\xaa\xae\xb2\xfe\x95\x8b\xda\x9a\xeb\xb5\xe5\x7d\xc0\x0c\xd3\xb5\xe0\x96\x0b\x27\x25\x5c\x1b\x74\x6a\xcc\xab\xdf\xdb\xef\x9a\xf3\xeb\xcd\x1c\x6e\xf9\xea\xd3\x9e\x3c\x6d\xce\x67\xd6\x79\x22\x62\xf5\xc3\xfd\xef\x67\x38\xc0\xc0\xb3\x04\x77\x7e\xe4\x4f\xfd\xef\xe7\xf8\xb1\x32\x85\xa8\xff\x7b\xc2\x2f\xbf\x6f\xab\xff\x4f\x44\x0d\x43\x97\xef\xc4\x1d\x66\xd6\x77\xdc\x65\x1d\x77\x59\x5b\xec\xb2\x2a\x2e\x65\xae\x0d\x84\xe3\x11\xef\x71\x17\x74\x3c\xe2\xfd\x38\x91\xbf\xfe\x38\x6c\x3a\xfd\xdb\xff\x03\x00\x00\xff\xff\xc4\xf1\x59\xf8\x38\x40\x00\x00")
+var _swaggerApiSwaggerJson = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xe4\x7d\xf1\x6f\xdb\x38\xf2\xef\xbf\x42\xe8\x3d\xe0\x25\x0f\x6a\xd2\xdd\x3d\x1c\xee\x02\xdc\x0f\x6e\xd2\xa6\xbe\xed\x76\x73\x71\x72\x8b\x87\xcb\xe2\x81\x96\x68\x9b\x1b\x99\xd4\x92\x54\x52\xb7\xc8\xff\xfe\xc5\x50\x94\x4c\xc9\x94\x4c\xdb\x92\xeb\xf8\xb0\x3f\x74\x63\x51\x9c\xe1\x67\x86\xf3\x19\x8e\x28\xea\x5b\x20\x9f\xf1\x74\x4a\x44\x70\x11\xfc\x78\xf6\x36\x08\x83\x31\x96\xe4\x06\xab\x59\x70\x11\x04\x61\x40\xd9\x84\x07\x17\xdf\x02\x45\x55\x42\x82\x8b\xe0\x13\xbf\xc5\x68\x90\xa6\x68\x44\xc4\x13\x11\xe8\xf6\xfd\xe8\x0e\x0d\x6e\x86\x41\x18\x3c\x11\x21\x29\x67\xc1\x45\xf0\xc3\xd9\x5b\xdd\x55\x4c\x64\x24\x68\xaa\xf2\x5f\x1f\xd8\x07\x2e\xd0\x9c\x0b\x82\xa0\x57\x31\xc7\x70\x01\xe1\x31\xcf\x14\x52\x33\x82\x32\x89\xa7\x04\xf1\x89\xfe\xa3\x2e\xe8\x04\x24\x9d\x82\xa8\x10\x49\x42\x1e\xd8\x7f\x66\x4a\xa5\xf2\xe2\xfc\x3c\xe6\x91\x3c\x4b\xb8\xc0\x52\xb7\x3c\xa3\xfc\x1c\xfe\x7a\x83\xd3\xf4\x4d\xfe\xd3\x39\x4e\xe9\xf9\xef\x27\x1b\xde\x70\x7a\xf6\xc0\x82\x97\x30\x90\xd1\x8c\xcc\x89\x0c\x2e\x58\x96\x24\x61\x10\x71\x26\x33\xfd\xf7\x7f\x02\x9c\xa6\x09\x8d\xf4\x38\xce\xff\x90\x9c\x05\xbf\x87\x41\x2a\x78\x9c\x45\x2d\xd7\xb1\x9a\x49\x80\x54\x0b\xc1\x0c\x27\x0b\x45\x23\x79\x6e\xb7\xfd\x86\xd3\xf4\xfd\xfd\xf0\xe5\x3c\xa6\x52\x09\x3a\xce\x40\x02\xdc\x33\x25\x0a\xfe\xe1\x29\x11\xba\xe5\x30\x0e\x2e\x82\x6b\xa2\x06\xcb\x9b\xaf\xec\x5b\x40\x9c\xc0\x73\xa2\x88\x00\x85\xbe\x05\x39\xee\xc1\x45\x00\x8d\xd8\x54\x5b\x38\xb8\x08\x52\x30\x78\x18\x30\x3c\x07\x23\xe7\xd2\x83\x30\x10\xe4\xcf\x8c\x0a\x12\x07\x17\x4a\x64\x24\x0c\xd4\x22\x25\xcb\x7b\x5f\x7e\x87\x16\x32\xe5\x4c\xc2\x70\xbf\x05\x3f\xbe\x7d\x0b\xff\x54\xcd\x1e\x18\x04\x31\x5c\xfa\xdf\x82\x4c\x82\x8b\xe0\x7f\x9d\xc7\x64\x42\x19\x05\x7d\x61\xe4\xf4\x3e\x4d\x28\x7b\xb4\x55\xbf\x35\x1d\x07\x2f\x2f\x60\x83\x6c\x3e\xc7\x62\xd1\x3a\x58\x24\x88\xca\x04\x93\xda\x7d\x62\xac\xf0\x1b\x81\x15\x41\x98\xc5\x28\x9a\x61\xc6\x48\x82\x6c\x38\x0b\x47\xcb\xb4\x68\x59\xfc\x39\xa5\x4f\x84\x21\xcb\x18\x67\x41\x18\x28\x3c\x05\xf8\x82\x41\x61\xad\xe0\x77\xd0\xaa\x66\xc1\x29\x56\xe4\x19\x2f\xce\xbf\xcd\x71\xe4\x6f\xba\xeb\xfc\xae\x0e\xcc\x36\xc7\xd1\xc1\xda\xcc\x31\xca\x1d\xed\x25\x48\x44\xe8\x13\x89\xd1\x78\x61\x19\xce\xd8\x60\xad\xd1\x52\xfa\x33\x59\xc8\x46\xbb\x7c\xa2\x52\x05\x9d\x21\x05\xbd\x0d\x6e\x86\x3f\x93\x45\x13\x42\xd0\x02\x25\x54\xaa\xdc\x7b\x07\x37\x43\xf4\x48\x16\x35\xa7\xe4\x62\x8a\x19\xfd\xaa\xb5\x44\x27\x94\x45\x49\x16\x53\x36\x85\x16\x0f\x4c\x90\x27\xfe\x48\x62\x7d\xdb\x69\x65\xf8\x5a\x70\xf0\xfb\x4b\x18\xa4\x5c\x3a\xc6\x7a\x29\x08\x56\x64\xd5\xe7\xb4\x87\x8d\x79\xbc\x58\x7a\x98\xf9\xab\xee\x62\xeb\x11\xc8\x65\x14\x18\xfc\x99\x11\xa9\x82\x97\x0e\x7d\xb1\xda\xbf\x1b\xe3\xbc\x0d\x8a\xf4\x3f\xd2\xc2\xd5\xa0\x7d\x86\xee\x66\x04\xf0\x43\x54\x22\xce\x92\x85\x71\x50\x12\x23\xce\x1e\x98\xbe\xaf\x1e\x0f\x0a\x6c\x6b\x7e\x75\xfe\x8d\xc6\x2f\xf9\x50\x12\xa2\xc8\x2a\xe6\xb7\xda\x5a\x2d\xf3\x9c\x32\xf5\xd7\xbf\xb8\xa7\x39\x8d\xf7\x39\xcb\x73\x4d\xdb\x91\xcd\xdb\xa0\xdc\x05\x2b\x1e\x8c\xe6\x58\x45\x33\xe3\xa4\x06\x6e\x1a\xb7\x43\x98\xc5\x54\x7d\xe2\xd3\x7d\xce\x4d\x23\xd2\x73\x76\x62\x68\x8e\x12\x3e\x45\x84\x29\x41\x89\x74\x8d\x72\x42\x13\x20\xdd\x10\x31\xf2\x4c\xa4\x7a\x60\x13\x2a\xa4\x3a\x43\xbf\x51\x35\x83\x84\x27\xe7\xd8\x10\xe1\x28\x22\x52\x22\xc5\x75\xd7\xcf\x33\x9e\xd8\x02\xa8\x44\x85\xa5\x2b\xa0\x15\x18\x59\xb0\x3d\xcb\xd1\xe7\xd1\x90\x29\x32\xcd\xb1\xd2\xb8\x7c\xf7\x19\xff\xdb\xa8\xaa\x55\x8f\x93\x7f\x55\x94\x77\x1c\x18\xfc\x36\x42\xa3\xcf\x23\x44\x97\x77\xfb\xe5\x03\x75\x99\xad\x06\x29\xd3\xba\xb6\xc8\x70\x45\x12\xe2\xb2\xcd\x81\x26\x6e\xb9\xba\xde\xd8\xe7\xcd\x51\x3e\xf8\xee\xb1\x0f\xdd\x11\xe3\x9a\xa8\x57\x03\x28\x24\xf3\xbe\x68\x5e\x13\x55\x49\xa2\xba\x85\x32\xcd\x1c\x50\xde\xa7\x31\xee\xdd\x3d\xc3\x6e\x43\x51\xae\xf3\x5e\x42\x51\xa3\x28\xb7\x01\xf3\xe6\x28\xd3\xff\xf4\x18\x8a\xbe\x66\x82\x0c\xf9\xdd\xc7\x6c\x7c\x70\x04\xe1\x54\xad\x47\x96\x68\x90\xe7\x4f\x15\xd0\x01\x1a\xf2\x3b\xf4\x31\x1b\x6f\x6e\x25\xa7\xf8\xf5\xa6\x3a\x62\xea\xd8\xc8\x20\x2e\xfe\xe8\xc7\x20\x47\x42\x25\x1b\xa1\xbb\xc2\x27\x7d\x41\x7b\x6c\xd4\xb2\xb7\x20\xd6\x2e\xcf\x9f\x64\xfa\x0d\x62\xa6\x7c\x03\xcb\xa6\x3d\xae\xe2\x2e\x97\x52\x3d\x17\x72\x46\xcf\x37\x79\xe1\xc5\x8c\x19\xe8\x76\x22\x89\xd2\x85\xa8\x84\xce\xa9\x3a\x7b\x60\x9f\xb9\x22\xf9\x1f\xfa\x67\xd3\x22\x13\x09\xd2\xce\x2a\x11\x16\x84\xfd\x1f\x05\x05\xab\x34\xc1\x0b\x12\x23\xca\xd0\x28\xaf\xac\x23\x99\x92\x48\xea\xaa\x35\xc2\x89\xe4\x17\x0f\xac\xa8\x44\x4f\xa9\x9a\x65\xe3\xb3\x88\xcf\xcf\xa7\x22\x8d\xde\x90\x88\xcb\x85\x54\xc4\xfc\x59\x14\x14\xd3\x2c\x49\xce\x7f\xf8\xfb\xdf\x2d\x1b\x58\x83\x3d\x88\xd2\x4e\x05\xfc\xbe\xc8\xdb\xc3\xc2\x0e\xc6\xce\xed\x6a\xdb\xda\x76\x66\xab\x4f\xb7\x07\xaf\xad\xe5\xac\xa5\xdd\x83\xa9\xe5\xe4\x9a\x7a\xa0\xe8\xa0\x59\x1b\xbf\xf5\x55\x9d\x2a\xaa\x5b\x71\xe9\xc1\xa0\x76\x4d\x94\x07\x64\x75\xee\xdc\x0d\xaf\xed\x08\x72\x47\xc8\x7a\xe1\xc6\x9e\x03\x83\x43\x88\x37\x0b\x6e\x13\x18\x62\x82\xe3\x4f\x44\x01\xf6\xce\x27\x76\xeb\xf8\xae\xc9\x74\xc6\x06\x3b\xe5\x36\xdd\xa1\x0a\x71\xef\xaa\x1c\xa9\x27\x9b\x02\x34\x28\xd1\x77\x34\x3f\x4d\x0b\x11\x4f\x62\x22\x15\xca\xcb\xa1\x16\xde\x4b\x79\x1b\xc0\x7d\x2e\x08\xf0\x6d\xf3\x4a\xf6\x56\x5f\x7f\xb7\x18\x14\x18\xbe\xa2\xe4\x32\xd7\x7d\x89\x8b\x2c\x86\xd1\xc7\x44\x6a\x11\xe6\xb6\x7e\x15\x59\x94\x1b\x42\x22\x9c\x24\xfe\xde\xb0\x91\xfd\x8f\x8d\x87\xd7\x4f\x30\x07\x0d\x5b\xb0\xae\x67\x95\x0a\xa4\xaf\x9b\x84\x97\x43\x79\xcf\x94\x58\xac\x63\xdf\xed\x61\x6a\xf2\x3c\xcf\x48\xf3\x6a\xd8\xb9\x3e\xdf\xf7\x11\x53\xda\x43\x09\x92\x84\xc5\xb9\xf9\xc8\x13\x61\xaa\x1a\x35\x6c\x8b\xe2\x29\xa6\x0c\x1e\x99\x51\x25\x1f\x98\xbd\x7c\x85\xc5\x59\xc3\x74\xf1\xb0\xf8\x13\x8d\xc8\x48\x61\x95\xc9\x41\x42\x84\x3a\x88\x02\xe9\x55\x5d\xab\x3e\x0c\xd5\x28\xca\x7b\x91\x15\x6b\x35\x91\xd4\xe8\x21\x0c\xf0\x79\x46\xfd\x9a\xcc\x56\x83\x54\xd2\xac\xad\x79\xc0\xcc\xa8\x9d\xa8\xbe\x7b\x32\xf0\xc4\xde\xc9\x09\xdd\x61\xbf\x15\x4b\x1c\x16\xa0\xd7\x44\x79\xa3\xb9\xca\x1b\x5d\x42\x79\x64\x65\xce\xbd\x84\xa2\x46\x51\xde\xcb\xba\x5e\x42\x11\x7f\x66\xb0\xdb\xed\xc3\x0d\x17\xea\x86\x27\x34\xa2\xe4\x30\xe8\x61\x45\xb1\x1e\xf7\x57\x39\x85\x79\x53\x44\xce\x03\x29\x80\xb7\xa8\xe0\xbe\xda\xeb\x3a\xe4\x2b\x3c\x70\x24\xcb\x6d\x7f\x6c\x6b\xeb\xee\xd4\x80\xe2\xe7\xe4\xdb\x80\x7d\x6c\x0b\x2f\x7f\xa8\x1d\x6c\xab\xe1\x5e\x78\xac\x2a\xbc\x90\xfe\x57\x46\x32\xd2\x1c\x48\xde\xb3\x3f\x75\x83\x5e\x23\x89\x11\x52\xc0\xa2\x55\x1a\x2a\x32\xef\x23\x90\x34\xcb\x72\x1b\xc0\xb4\x47\x38\x8e\xa5\x0d\xb5\x22\xf3\x62\xcf\x9c\x6e\xe0\x42\x5e\x0f\xa4\x09\xf3\xf3\x6f\x31\x79\xea\x2b\x84\xe4\x5d\x7f\xaf\x10\x52\x82\x2a\x3d\x23\x08\x85\xb6\xf0\xc4\xaa\x84\x13\x4d\xb8\xb0\xe0\xce\xc7\xb3\x3d\xc6\xe7\x31\x49\xe8\x13\x11\x86\x34\x1b\xe1\xbe\x5a\x36\x7b\x8d\xc0\x2f\xd5\x6f\x03\x7e\xd9\xca\x32\x81\x01\x68\x51\xa4\x2d\x26\x96\x9f\x68\x6b\xc4\xfa\xa1\xa3\x24\x4c\x9d\x3e\xb0\xdc\x58\x2e\xfb\x14\x7b\x4d\x1d\xb5\xd5\xcd\xac\x35\x49\x32\x39\x6b\x0e\x4a\x1f\xf4\xe5\x7e\x0d\xd4\x71\x02\xab\x55\xae\xf8\x6c\x1f\xc1\xcd\x25\xc5\xed\x07\xba\x65\x49\x2b\x45\xcd\xb4\xbf\x79\x78\xa4\x0c\xbe\x96\x3e\x6a\xfc\x8d\x0d\x73\x4c\x04\x9f\x2f\x41\xde\x04\xcf\xdb\x2c\x39\xac\xc4\x1f\x14\xea\x3f\xe3\xcf\xa5\x6c\x98\xea\x17\x98\x21\x91\x25\x4e\x90\xa1\xd7\x26\x8c\x37\x7f\xba\x56\x3c\x8a\x68\x71\xe3\xb6\xc8\xf4\x7d\xd3\xfe\x36\x80\xed\xc1\xd9\x94\x61\x6e\xd5\xf0\x36\x67\xff\x21\xaa\xbc\x29\xb4\x6c\x4d\x95\x44\x8c\xc7\x44\x6e\x6c\x1a\xb8\xab\x64\x8b\x35\x36\xb9\x22\x4f\xdb\xdb\xe4\xfb\xd2\xf9\x7a\x9b\xe4\x83\xf3\xb4\x09\xa0\xb6\x31\xd4\xc7\x1a\xb9\xdb\xb0\x75\x2c\xba\x2a\xb8\xfa\xaf\xbd\x0c\xb4\xaf\xfb\xd1\x17\xd4\x33\x3d\x50\x5b\x29\x65\xee\x08\xd9\xf1\x6c\x41\xe9\x9b\x2a\x5d\x52\xfc\xab\x95\x3b\x99\xa9\x0c\x1a\x99\x5a\x5c\x2e\xa2\x84\x9c\x17\x7b\x06\xf5\x4b\xc8\x8d\xb1\xd9\x7a\x23\xb7\xb8\xb3\xc5\xa8\xc6\x3a\x87\xf0\xd2\xb1\x43\xf1\x96\x09\x51\x6f\xea\x9e\x20\x98\x0a\x45\xe7\x44\x2f\xb2\xe2\x4c\x2d\xde\x44\xba\x6d\xa6\x68\x52\xbc\x6d\x9b\xc2\x36\xce\x6c\xfc\x66\x0c\x6d\x2a\x51\xdd\xe0\x5d\xb1\x51\x21\xce\x32\x90\x7e\xa2\xf9\x21\x7f\x27\xf0\x10\xd2\xc7\xf7\x4b\x7d\xfa\xcb\x1e\x2b\x42\x36\x4c\x1e\xf3\xf7\x27\x6d\x58\xad\xde\x1a\x80\x3d\xc2\xb2\xb0\x07\x84\xb5\x62\x8e\x79\xf1\xb4\x31\x1f\xdc\x14\xd2\x23\x4b\x40\x3c\x00\x75\xe4\x1f\x39\xa8\xeb\xc3\x73\x15\xd0\x63\x22\xd1\x9e\x03\x86\x43\x88\x37\x85\x6e\x67\x9c\x8a\xb7\x7f\xe2\x53\xbf\x05\x4d\x8b\xd5\x0c\xfc\x07\xb4\x90\x79\x6f\x86\xe6\x19\x39\x12\x3e\x9d\x92\x18\x69\x40\x24\x3a\xc9\x0f\x46\xd1\x27\x73\x84\xe8\x0f\x4e\x19\xbc\xac\xfe\x18\x22\x22\x04\x17\x21\x3a\x3b\x3b\x3b\x45\x7c\xf2\xc0\x96\x70\xc3\x0a\xa7\xb9\x08\x59\x68\x53\xc7\x7e\xa4\x04\xc1\xf3\xf5\xb1\x7b\x94\x8d\x01\x85\x31\xd9\xd2\x06\xfb\x0f\xe0\xef\x97\xc3\xd3\xff\x5b\xc7\xbf\x1c\x11\x92\xba\x91\xb5\xf9\x69\x43\xfc\x01\xf9\xb6\x12\x40\xc6\x14\xcd\x6b\x8c\xe0\x80\xb0\xff\x96\x4a\x14\x61\x16\x91\x24\xa9\x1e\x2d\x60\xe9\x6c\x19\x6a\x92\x71\x85\xaf\x48\x9a\xf0\xc5\x1c\xb4\x3b\x84\x14\xe6\xc3\xfd\xaf\x77\x83\xa5\x4e\xfd\xa5\x31\x2b\x82\x36\x4c\x65\xe2\xf2\x56\x1b\xe8\x5a\xaf\x2d\x60\xff\x97\x94\xc2\x3c\x61\x6e\xaa\x86\x2d\xf1\x6a\x99\x07\x4d\xb1\x69\x03\x63\x1c\x5b\x42\xe4\x09\xbb\xab\x28\x53\xde\xd4\xc0\xbd\xfa\x40\x1d\x41\xe6\x98\x32\xca\xa6\xc5\xa3\x2b\x3e\xa9\xdf\x8d\x05\xc4\xa5\x39\x87\xd3\x9c\xaa\xa5\xf9\xb2\xf5\x4a\xa1\x72\xd5\x62\xaf\xbe\xca\xe3\x69\x89\xd5\x3d\x6b\x6b\xcc\xb0\xbd\x9f\x9f\x6b\xd8\x5b\x43\xcd\x67\xdd\xe2\x15\x00\xec\x08\x31\x5a\xf7\x26\x98\xcb\xc1\x59\x41\x26\x3f\xa8\x41\x3f\xa3\x2d\x0f\x2a\xac\x50\xef\xd2\x16\x7e\xd1\xc5\x54\x0f\xf6\x79\x10\x99\xa9\x89\xb4\x0d\xdb\x1a\x71\xa1\xa0\x3d\x1c\xd3\xc3\x41\xbc\x77\x5a\x8e\xa6\x2f\xf2\x5f\x03\x57\x23\xe9\x1b\xe0\xdc\xb8\xd5\xcd\xbf\xac\xd6\x6d\xcd\x2a\x66\xc2\x1c\x42\x8d\x2e\xd7\x75\x0d\x70\x0e\x3e\x31\x68\xb8\xa2\xd8\x2f\x83\xcb\x26\x0f\xdc\x22\xe8\x1f\x10\x56\xcb\x22\xa5\x6f\xb8\x2f\x50\x2a\x76\x80\x98\x84\x9e\xc4\x6d\x20\x6d\x57\x87\xd8\x19\xa7\x5e\x2a\x11\x3d\x4e\xf9\x9a\x00\xef\x0a\xc4\x36\x9e\xeb\x8e\x01\xe7\xc0\x2d\xcd\x74\x70\x4d\x14\x6c\x50\x96\x7d\x1a\xad\x0f\xe7\xd6\x4a\xb7\x78\xb8\xbe\x5e\x71\x73\xc0\x81\x4a\x38\x57\xb5\xe0\xd6\x9d\x40\x8e\xd2\x9b\x6c\x3c\xaa\x1c\x58\x71\x10\x8b\xd8\xeb\xcb\x9b\x15\xc5\x7a\x24\x33\xa7\x34\x6f\x66\xbb\xc9\xc6\xe7\xa3\x2d\x0e\x0c\x71\x0d\x72\x9d\x71\x2a\x0b\xdd\x5e\x58\x71\xff\xab\x5c\x43\x8c\x1b\x18\xc1\xc1\x92\x1d\x1b\xa1\x73\x02\xdd\x3f\xac\x10\x66\x36\xc0\xb4\x4e\xa8\x9d\x03\xda\x3d\xd9\xfa\x62\xda\x0f\xdf\xee\x29\x44\xb5\x49\xf3\x66\xe2\x9e\x42\xd4\x0c\xb3\x38\x21\xe2\x1d\x8e\x1e\xe1\x25\xd5\x3d\x2e\xd7\x3e\x56\x24\x7b\xae\xda\x08\xc3\xe3\x84\xc4\xc8\xa8\x8d\xc6\x46\x6f\x7b\xc4\xd5\x8e\x0f\x62\x31\x57\x1f\x6b\x5f\x34\xe8\x87\xa9\x21\x40\x49\x0c\xa8\x75\x30\xbd\xfc\xaa\x2a\xaa\xd9\xa3\x8e\x97\xee\xfc\xc0\x76\x10\x9d\x37\xde\x21\xc2\x13\x78\x2d\xfc\x79\x46\xa3\x99\xfd\x08\x05\xea\x8a\x69\x36\x4e\xa8\x9c\x91\x18\x5e\x17\x29\x36\x5a\x6f\x39\x3f\x8e\x81\x29\xfd\xcc\x51\xe7\xc8\x6e\x7c\xff\xe8\xa8\xb1\xff\x80\xe5\x96\xe3\x4d\x87\x1d\xc7\x2c\xa5\xd2\x43\x5b\x40\x7d\xbc\xbb\xbb\xb1\x74\xea\x91\x34\xea\x82\x5a\x59\xc3\x5e\x36\x81\x8a\x1b\x27\x24\xb5\x71\xb5\x58\xe1\x88\xa9\xc3\x0f\x72\x07\x77\x74\x04\xf9\x91\x84\x7c\x3f\x18\xeb\x31\xbf\x33\x0c\x8f\x2d\xe8\xf7\x1f\x71\x1a\x04\x79\x87\xfd\x8e\x23\x0e\x65\x93\x24\xfb\x72\xf5\xee\xd0\x62\xff\x70\x55\xaf\xfe\xe2\xbf\x53\x98\x37\x07\x14\x77\x6f\x6c\x15\x87\xd8\x35\x96\x39\x5e\x3e\xd8\xc0\x04\x0e\x4e\xe8\xd8\x04\xc7\xc1\x0d\x1b\x40\x5a\xe7\x87\xce\xf1\x3c\x32\x9e\xd8\x53\x74\x6a\x11\xe6\xcd\x17\x1d\x9b\xb2\x88\x4e\xf3\x2c\x51\x34\xc2\x52\x5d\x0b\x9e\xa5\x07\x41\x19\xbf\x54\x54\xea\x8f\x2d\xea\x72\xbc\x89\x22\x87\xbb\x44\x0e\x4d\xe1\x7e\x1b\xf2\x6a\xcf\xcd\x68\xff\x97\xec\x1a\xf4\x03\xba\x61\xd3\x60\x0d\x66\xbf\xe5\xb1\xb7\x01\x8e\x6d\xa7\xa0\x1f\xd4\x0e\xe6\xad\xc1\xbc\x7e\x9b\xda\x0a\xc4\x5b\x91\xed\xc1\xc0\x77\x4d\x94\x1f\x76\x75\x8a\xed\x02\xb8\xed\x58\x75\x47\xec\x7a\x21\xd4\xfe\x63\xb7\x5b\x8e\x37\x8d\xee\x6e\xae\xb6\x50\x72\x6c\x9b\x31\xab\x83\xdf\x78\x2f\xa6\x46\x03\x61\x29\xe9\x94\xe5\xd5\x7d\x87\x09\xd6\xcd\x0d\x67\x36\x32\x88\x63\xd0\xe6\xd5\xcc\x0e\xa3\xef\x1d\xef\x7f\x82\x34\x8a\x72\xdb\xcd\x34\x37\x56\xb2\x13\x1c\xb0\xde\x36\x36\x5b\x3f\x41\x2a\xef\x71\x35\x51\xef\xad\xde\x6d\xde\xbb\x95\xcb\xae\xcc\x6f\x07\xf2\x6e\xd8\x72\xf4\x1f\x04\x9f\xfb\x99\x72\x79\x8f\xd9\xaa\xbf\x62\xcd\x72\xe7\x7e\x67\xf6\xfc\x73\xcb\xc3\xf1\x0e\x74\x9e\x1a\x7d\x4b\x0c\xac\xd3\x8b\xba\x9f\xa9\x2d\xc2\xdc\x06\x6e\x38\x69\x2f\xc5\x8b\x84\xe3\x32\xbe\x96\x2f\xcd\xe7\x8d\x4d\xbe\x0c\xf6\x97\x0f\x6c\x97\x60\x5c\x38\x02\x74\xb5\xc7\xed\x15\xe0\xd0\x9e\x9b\x2a\x40\x33\x79\x88\xdf\x82\x82\x31\x1c\xc4\xfe\x0d\x50\xa4\x0f\x5f\xb6\x7b\xdf\x70\x21\x5d\x3f\x74\xc7\x60\x65\x7b\x9b\x73\xa1\x7c\x1e\xc9\xa7\x46\x37\x7c\xff\x25\xe5\xe2\xf5\x94\xf9\x72\x75\x5b\x13\xac\xbc\x09\x22\xfa\x1f\x3b\xbf\x6a\x5a\x10\x23\x2c\xd1\xe5\xe8\xdf\x67\xfe\x6e\x38\x9c\xef\x01\xb4\x8e\x23\xf6\x70\x6e\x21\xd7\xbd\x5f\x0f\xe7\x6b\x0d\x93\x37\xa9\x38\xb6\xc3\x30\x97\xa3\x7f\xa3\x67\xaa\x66\x94\xb9\xad\x75\xf6\xc0\x86\xec\x09\x27\x34\x46\x82\x3f\xeb\x08\x85\xe4\x23\x4d\x53\x73\xb4\x64\xf9\xa1\x7b\x2c\xf3\xd7\x8b\x65\xa8\x3b\xaa\xde\xf2\xc0\xa8\xd6\x86\xc4\xe8\x24\x63\x09\x7c\xb6\x3c\x16\x8b\xdb\x8c\xc1\x07\xf3\x25\x51\xa7\xeb\x26\x9a\x4f\x66\xb6\xd3\x83\x89\xfd\xa7\x52\xb9\xba\x6d\xa1\xc9\x51\x0f\x01\x0b\xba\x8a\x20\x57\x2b\xc7\x3b\x96\x73\x6a\x8b\xf2\xc7\x61\x01\x75\x4d\x54\x1b\x4a\xf5\xca\x87\x86\x68\xf5\x15\x97\x16\x84\xba\x7f\x7a\xe0\x0b\x52\xc7\x41\x27\x2f\x2c\xf4\xc5\xa5\x76\xef\xde\x85\x8d\x8d\x1d\xd6\x9e\xf6\x23\x22\xa5\xce\xca\xbe\x7f\xf5\xff\xf3\x52\x9d\x7e\xf3\x94\x52\xc8\x16\xe9\xca\x1b\x99\xdf\x9c\xbf\x3e\x7d\x45\x9e\x06\x71\x2c\xd0\x3c\x93\x0a\x45\x9c\x29\x6c\x82\xbc\xc4\x73\x82\x3e\x3f\x3f\x0e\xaf\x10\x36\x9f\x1c\xe4\x6c\x42\xa7\x99\x20\x31\xfa\x4c\xd4\xf0\xea\x0c\x7d\xb6\xba\x93\xe8\x99\x26\x09\x50\x3c\x15\x04\xe1\x4c\xf1\x39\x86\x14\x3c\x49\x16\x66\xff\x64\xad\x8f\xbb\xbb\x4f\x75\xcb\x9a\x61\xb9\x0d\x7c\x3e\x25\xea\x16\xb3\x98\xcf\x8d\xce\xcd\x16\xbf\xae\xb7\xec\xcc\x04\xf5\x9e\x9b\x2c\x50\x6f\x57\x06\x1f\x8c\x84\xfe\x1d\x15\x17\x14\x7e\x2c\x9c\x3e\x47\x3b\x15\x64\x42\xbf\xc0\xa3\x32\x8e\x70\x14\xf1\x8c\xa9\xcd\x70\x3a\x6a\x1a\x5c\xe3\xf9\x0d\x6c\x58\x38\xa9\x7f\x90\x31\x72\x8e\x8a\x1c\xd7\x60\xe7\xe2\xc8\xdd\x80\x3b\x42\xce\xec\x31\xbc\x3b\x84\x78\x33\xa8\x23\xbc\x7b\xc4\x0c\x45\x27\x26\x83\xbf\x11\x64\x42\x04\x61\xd1\x61\x9c\x3e\xfd\xd9\xa9\x5a\x9f\x9c\xea\x96\xe7\x4d\xaf\x36\x96\x28\x2d\x7b\xa8\xad\xa3\x32\x59\x3d\x72\xd0\x2d\x76\xbd\x89\xce\xbf\x41\x4f\x00\x75\x7f\x41\xbe\x90\xb0\x7e\xae\x75\x1f\xe6\x37\x31\x86\x33\xe2\x77\x6a\x8c\xce\x09\xe0\x7b\x40\xab\x29\x60\x13\x5c\x57\xd9\xa0\x63\x50\xbb\x27\x07\x7f\x5c\x7b\xa2\x87\x7d\x05\xad\x76\x79\xde\xa4\xd1\x53\xd0\xe2\x62\x8a\x99\x39\xd9\x76\x9f\xaf\x32\xfe\x6a\xc9\xf5\xac\xb9\x57\x54\xb5\x07\x69\xf7\x75\x10\xb5\xef\xea\xe0\xfa\xe2\x41\x1f\x08\x1b\x17\x97\x36\x98\x2d\x58\x3a\xdd\xe4\xe8\xce\x81\xf5\x41\xd2\x41\x5d\x36\x28\xae\x9c\x1b\x4e\x3d\x1b\xc2\x57\x69\xad\xea\x6b\x5e\x6f\x65\x5c\x99\x9e\xe2\x16\xf0\xb7\xe2\xb2\x83\x81\xf6\x9a\x78\x4d\xf2\x3a\x75\x55\x40\x5d\x2d\xfa\xd1\xd8\xfe\x86\x85\xfe\xe6\x6f\x26\xf1\xb4\x7c\xfa\xf8\x27\x1c\x5d\x26\x5b\x41\xdd\x8e\xcb\x76\xc4\xb5\x17\x12\xeb\x3b\xce\xb8\xa4\x78\x13\x96\xc7\xec\xd8\x26\xee\xd8\x4f\xe8\xda\x09\x6b\x60\x37\x7c\x05\x13\xa6\x4e\x8b\xb6\xfe\x4d\xb8\xd7\xc7\x69\xd1\x65\x25\xe8\xf0\xc9\x8a\x4d\xb6\x60\xd0\x41\x1c\x5b\xc2\x5e\xcd\x64\x19\xc4\x71\x03\xae\x7d\x4c\x9a\x36\x69\x6e\x23\x56\x61\x75\x6c\x90\xb2\x4c\x59\x6c\xa7\xd8\x99\xbf\x2b\xf3\xa8\xb2\x27\xbc\x89\xd5\xf3\x5d\x3f\xfb\x72\x80\xb2\x2b\xf3\xdb\x4e\x8f\x82\xbb\xb3\x6e\x0e\xc2\x86\x06\x5e\x41\xce\xb1\x6d\xca\xb6\x71\xb1\x7b\xea\x81\xed\x6e\x66\x58\x11\xb4\xc7\xc9\x7b\xdd\xa2\x37\x5b\xf6\x17\x20\xb5\xe2\x4d\x98\x97\x23\xb3\x42\xa2\xc6\xa2\xc8\x14\x76\x8f\x85\xd0\xfd\x6b\x0d\x82\xa0\xfb\x1e\xa2\x5f\x2e\xc6\x6d\x21\x83\x60\x7d\x93\x19\x18\xa9\xbb\x28\x07\xbd\xf9\x96\xe0\xf2\x69\xda\xbb\x55\xcb\xae\xcc\x6f\x3b\x96\x47\xfa\x8c\x6d\x6d\xe6\x5b\xa2\xe5\x88\x66\x00\xfb\xf2\xf8\x66\x4f\x33\xb6\xa6\xe6\xaf\xcd\x2c\xbd\x27\xfc\x7d\xcd\xe0\x26\x49\x6e\x2f\x58\x1a\xa7\x92\xfc\x0b\x9e\x94\x4b\xb2\xa5\x47\x78\x4c\x61\xb3\xc5\xf4\x92\xc7\x24\x3a\x88\xa7\x1b\x37\x96\x42\x7d\xc0\xed\x92\xe2\x5d\xcb\x29\x36\xe4\x46\x70\x5f\x15\x6f\x2b\x9f\xb0\x61\xb7\x05\x35\xc1\xee\x95\x0d\xee\xf4\xbc\x62\xff\x79\x5b\xae\xae\x0f\xcc\x8e\x42\xcf\xce\x30\x77\xfe\x54\x62\xff\x00\x5e\x13\xe5\x83\x5e\xbd\x9c\xd3\x01\x74\xdb\xd5\x6b\xba\x40\xaf\x97\x18\xde\x77\x40\x71\x49\xf1\x2e\xda\x74\x16\x50\x40\xcf\x38\x4b\x48\x7c\x4b\x60\x9b\xe8\x41\x84\xf2\x51\x55\xa7\xfe\xa2\xf9\x8a\x20\xef\x80\x9e\xc7\xee\x12\x3c\x24\x74\x07\x36\xde\xb5\xbe\x5b\x20\xb7\x57\xf8\x95\x90\xde\xb8\x12\x7c\x95\x2f\x7d\x7b\x82\xdd\xf0\xd6\x77\x1d\x6a\xe9\xe5\xf4\x1b\x18\xe1\xd8\x9e\x95\x78\xc2\xed\x60\xd1\x3a\xd4\xeb\x8b\xc2\xab\x30\xbf\xfa\x47\x22\x9e\xf0\xd5\x69\xb4\x1b\xec\xb6\x63\xd2\x1d\xe1\xeb\x85\x44\xf7\x10\xca\x1b\x04\x79\x53\x69\x17\x26\x2b\xa3\x0a\x9d\xc2\x57\x93\x7e\x26\x8b\xc3\x20\xd2\x52\x9d\x1e\x39\xd4\x92\xe1\x45\x9f\x18\x3e\x36\x88\xe0\xa5\x43\xc0\xf8\x91\x2c\xbf\x8a\xd1\x1e\xca\x4b\x39\x6e\xbc\xfd\x98\xb3\x65\xfa\x98\x79\x70\x48\x8c\xb9\x16\xda\xda\xce\x0b\x0b\x54\x4f\x7e\xf4\x05\xf5\x5c\x70\x05\x4e\xdb\xe8\xd4\xb7\x5c\x39\x9d\xfa\x90\xf3\xfc\x5c\xe7\x7e\x27\xc9\xaa\x0c\xb7\x25\xf3\x76\xdb\x4c\x12\xfd\x32\x58\x71\xe0\xf5\x03\xd3\xef\x0a\x54\x0e\x83\x22\x5f\xe0\x9b\x1c\xc6\x2d\x42\x24\xa1\x64\x8b\x15\x5c\x5a\x40\x45\x10\xde\x4d\xc8\xdf\x19\x8b\x33\x61\xc2\xde\x03\x33\xbb\x4f\x9e\x88\x48\x70\xe5\x25\xe0\xf5\x2e\xf3\x48\x16\xc3\xab\xfe\x6a\x12\xba\xfb\x7d\x4e\x45\x93\x4f\xad\x35\xa1\x2b\x95\xb2\x0c\xe8\xa0\x15\x08\x7e\xc3\xab\xf5\xe8\x26\x78\xb3\x35\xc2\x35\xb1\x1f\x36\x1b\x96\xea\x77\x6a\x76\x07\xb7\xa5\xf9\xe8\xd3\xc0\x28\x5f\x83\xda\x35\xc0\x4a\x1e\x86\x9f\x30\x4d\xf0\x98\x26\x54\x2d\x0a\x5e\xf7\x0a\x88\x9f\x06\x35\xe0\x57\x5e\x82\x6c\x42\x1c\xf6\x98\xef\x04\xf5\xfe\x5f\x61\x00\x95\xdb\x30\x5e\x0e\x69\x33\x70\xeb\x2f\x70\x57\x51\x85\x57\x5e\xa7\xf2\x1d\xc7\x22\xb6\x8e\xa0\x3b\x88\x84\xe9\xce\xa9\x5a\x7f\xc9\x53\x93\x3c\xaf\x44\x0a\xf0\xb6\x3a\xd8\xf8\x1c\x40\xb7\xf0\xf5\x86\xaa\xc4\x9f\x5e\x42\xfc\xfe\x83\x4e\xae\xee\x66\xe6\x70\xc4\xfb\x5e\xcc\x71\x1c\x45\xe9\xcd\xb0\xad\xaf\xab\x7b\x02\xf6\xc8\x4a\xd6\xfb\x0b\x5f\xed\xf2\xbc\xd7\xde\xbd\x98\xb5\x08\x5f\x7a\xbf\xa9\xfe\x48\xdd\x2e\xd9\xd3\x2e\x9f\xee\xfb\x2e\x13\xed\xbe\x1c\x76\xcb\xe4\xaa\x0f\xb0\x32\xd9\x34\x70\xae\x8f\xf9\xb5\x99\x63\x29\xd6\xdf\x04\x9e\xa7\xe3\xbd\x2e\x13\x14\x5a\xaf\xb7\x43\x65\x7c\x6b\x2d\x40\x70\x34\xd3\xef\xdd\x56\xcc\x51\xd9\xb4\x5e\x2e\x12\x9f\x67\xf0\x5c\x3f\x25\x82\xf2\x38\x44\x09\x81\xe3\x9c\xb2\x14\xce\x80\x92\xe8\x84\x9c\x4d\xcf\x90\xa4\x09\x7c\xc5\x1b\xfa\x93\xa7\xab\xdf\x56\x5f\x67\xcd\x8d\xb2\xe2\x5d\xec\xb7\xff\xa4\xd8\x77\x0a\xf9\x5b\xae\x39\x31\xae\xc2\xfc\x12\x06\x96\x2e\xa0\xe3\xfa\x83\x02\x21\x69\x16\x80\xba\xa2\xf9\xc0\x0d\x60\x2b\x63\x9f\x91\x2f\x88\x30\x78\x5e\x58\x9c\xc8\x51\xa8\x06\x4a\x05\xa1\xc3\x1c\x35\x88\x43\x28\x23\x5f\x38\x0a\xce\xb5\x76\x2f\xe5\x2f\x7c\xfc\x07\x89\x54\xf0\x12\xb6\x0e\xc4\x20\x7c\xf1\xad\xe9\x36\x7b\x0b\x8a\x15\xb8\x1a\x21\x30\x73\xbe\x15\x02\xf3\x80\xc9\x40\x60\xcd\xa1\xfd\x20\xd1\x38\xa4\x8d\xc0\xb0\xb7\x16\xad\xa0\xe0\xa7\x62\x18\xc0\x16\xa0\xb6\xf9\x60\x0b\xbc\x85\xb6\x2f\xe1\x72\x7b\xd5\x0a\xc6\xc5\x15\x74\x02\xae\x25\x33\xad\x7d\xe1\x69\x83\x9b\x21\x52\xfc\x91\xb0\x53\x1f\x94\xfd\xd0\xab\x6c\x7a\x6a\x82\x6d\x3a\x15\x64\xaa\x21\x83\x85\x8c\x78\xc2\x09\x8c\x38\x26\x13\x9c\x25\xa0\xc2\xcd\xfb\xdb\xe1\xaf\x57\x41\x58\x1b\x8c\xe3\x3e\xa4\x67\xa8\x49\x5f\x68\xf1\x63\x26\x49\xac\x83\x2f\x2e\xee\x28\xea\x68\x31\x85\xe1\x8c\xb3\x82\x2e\x09\xcb\xe6\x30\xf3\x4b\x89\x1f\x7f\xbd\xbf\x0d\xc2\xe0\x6a\xf0\xff\x82\xdf\x57\x20\xc8\xb5\x77\x15\x44\x76\x70\x7a\x3f\x0f\xb7\x17\xf9\xab\xbd\x4e\x04\x8e\x40\x00\x3a\x79\x8b\xde\xa0\x1f\x4e\x0b\x0b\x93\x2f\x29\x89\xe0\xfd\x9f\x82\x6d\x74\xa9\xf1\x19\x43\x90\x8c\x08\x7d\x22\xb1\x2d\x3d\xe6\xd9\x38\x21\x4b\xe9\x2c\x9b\x8f\x89\x00\xe9\xf0\x0d\xab\x15\xa1\x84\xc5\x85\x9c\x9c\xda\xd0\xc9\xed\x87\xcb\x9f\x7e\xfa\xe9\xef\x5e\xfe\x14\x06\x85\x76\xf7\xb9\x72\xab\x12\x72\x05\x40\xc8\xca\x40\x4e\x20\x4c\x4a\x34\xc3\x4f\x50\x23\xc5\xcc\x5c\x28\x7d\xa0\xa2\x42\xe3\x64\x2b\x13\x9e\xaa\xdc\xda\x33\xed\xca\x69\x61\xd5\xd8\x44\x15\x99\xcb\x0d\x6a\x3a\xa5\x0e\x58\x08\xbc\x80\xbf\x0b\x43\x78\x80\x50\x34\xed\x18\x04\xa9\xb0\x50\xab\x20\xe8\x9f\x77\x31\x70\x53\xc0\xc8\x62\xaa\x3e\xf1\xe9\x7b\xa6\xc4\xc2\x31\x71\xb4\x23\xaf\xaa\x53\x38\xb8\x4e\x97\x80\x82\xcf\x4c\xfd\x85\x0b\x74\x65\x4e\xd4\xd4\x07\x74\x9e\x99\x53\x38\xbd\x74\x0c\x03\x1c\x29\x2e\x56\xc5\x65\x92\x88\x50\x47\x48\xa8\x0a\x73\x51\x59\x0f\xe5\x5f\x49\x4c\x89\x80\xfe\xe1\xac\x4e\xf0\x8b\xc8\x9f\xae\x36\x60\xc4\x93\xe7\x19\x61\x48\x90\x04\x2b\xf3\xfd\xc5\x4a\xca\xef\x39\xc8\xfc\x39\x46\x3c\x70\x98\x59\xd1\x39\x91\x0a\xcf\xd3\xd2\xc1\x0d\xd0\x9b\xcd\xe5\x98\x28\x4c\x13\xf9\xcf\xd1\xaf\x9f\x57\x65\xc0\xaf\xe5\xc0\x4c\xcb\xaa\x38\x3f\x21\xd4\x11\x85\x68\x19\x84\x88\x76\x28\x1f\x8f\xcf\xfd\x71\x78\xd5\xd6\x1b\xaf\x50\xe5\x26\x5a\xe6\x77\xde\xe9\x9f\xeb\xfd\x83\x65\x76\x95\xd0\x30\xaf\x2e\x67\x98\x31\x92\x5c\xc2\x61\x4a\xab\xd3\x2a\x2a\x7e\x6e\x0a\x2e\x26\xa6\x78\xe1\x37\x81\x4a\x15\x61\x91\x93\x89\xcc\x25\x74\xf2\xf1\xeb\x69\x4b\x6f\x7a\x3e\xe5\xec\x52\xba\xe0\x9a\x20\x54\xb2\x39\x67\x65\x88\xeb\x24\x24\xe5\x91\x64\x70\x33\xb4\x9e\x3a\xee\xc0\xe8\x0e\xaa\x28\x7e\xb2\xb7\xf4\xc3\xdb\x1a\x32\xe2\x29\x79\x60\x70\x09\xe2\x8c\xe2\xe8\x84\xeb\xb1\xe3\x24\xd4\x9f\x5a\xb5\xfa\x90\xce\x4e\x74\x7c\x20\xf3\x54\x2d\xbc\x10\x28\x56\x72\xdf\x7c\x9a\xda\x82\xd6\xcc\x16\xab\x65\x8b\xd1\x77\x49\x73\xbd\x6c\xb7\x4c\x3c\xb7\xcb\xbe\x1f\x89\xc3\xa7\x8b\x5c\xf9\x91\x2c\x42\x30\xda\x18\xd6\x95\xf9\x49\x9f\x38\x53\x33\x2e\x8c\x9e\x79\x32\xbd\xbb\x1f\xfe\x36\x1a\x7d\x1e\x55\x2a\x78\x4d\x2e\x19\x45\x44\xca\x9f\xc9\xc2\x65\x9c\xfc\xa2\x7e\xd6\xb9\xb4\x53\x24\x48\x4c\x98\xa2\x38\x91\x5d\x53\x95\x5f\x7f\x9a\x9a\xef\x6f\x3f\xad\xf6\x78\x7f\xfb\xa9\xd0\x72\xf4\xaf\x11\xd2\x0d\x01\xed\x88\x33\x99\xcd\x49\xf5\xe8\x6c\xb3\xe1\x56\xe6\x2f\xcb\x94\x73\xc6\x73\x0a\x08\x32\x75\xe6\x18\x83\xdf\x46\x28\xbf\x66\xf2\x0c\x92\xbd\x79\x26\x52\xbd\xf9\xc1\xb3\x63\x49\x22\x41\xd4\xa0\x30\xcb\xaa\x84\xbc\x01\xb2\x6c\xb3\xad\x61\x14\x4f\x69\x34\xb8\x75\xb0\xed\xe0\xf6\x73\x09\xe4\xe7\x11\xd2\x0d\x01\x48\xf3\x19\x67\xfb\xeb\xce\x8a\xf7\xe1\xad\xed\xab\x3f\x73\xdb\xd7\x4c\x90\x21\xbf\xfb\x98\x8d\xbd\x3c\xbd\x63\x37\x8c\x7e\x8c\xdf\xe7\x1f\xb0\x5e\xed\xd3\xa4\xd9\x1a\xa7\x28\xe1\x59\xfc\x46\xf1\x37\x31\x79\xa2\x11\x41\x73\x22\xa1\x46\x54\x86\xe2\xfc\x67\x09\xa1\x60\xc5\x37\x6d\x4d\xc6\x9c\x27\x04\xb3\xa5\x2a\xc5\x0f\xa0\x0b\x67\x8c\xe8\xfc\x62\x94\xeb\xb7\xa2\xd1\xb2\x05\xca\x6d\x02\xe2\x31\x43\x43\x7e\x87\x3e\x66\x63\x24\x67\x18\x4e\xac\x34\x5e\x95\xf2\x84\x46\x0b\x7d\x98\xb1\xd6\xf1\x4a\xeb\x78\x99\xf7\x81\x52\x22\xe6\x54\x1f\xbd\xb6\xbb\xe9\x1b\x6c\xe8\x63\x7f\x93\xad\x40\xcd\xb5\xd1\xe8\x51\xde\x46\xff\x7f\xb9\xd0\x6a\x8a\xe1\x76\x3e\x51\x5b\x62\x79\x33\xde\x4b\xe8\xab\xf1\x72\x88\xdb\xf0\x4c\xab\x9c\xdc\x5c\x50\xca\xcc\xe4\x20\x21\xa2\x19\x9f\xae\x63\xf3\x9c\xb2\x77\x58\x29\x22\x16\x9f\xc8\x13\x49\x56\x3b\x9e\x53\x76\x86\xc6\x79\x13\x94\x40\x1b\x38\x97\x3f\x25\x22\x82\xe2\xf5\xc9\x5b\xf4\x0f\x38\xb1\x5f\x4f\xab\x53\xbf\xc2\xc2\x9c\xb2\x4f\x94\x3d\xfe\x82\xc5\x94\x3a\x02\xb2\x16\xa8\x27\xd5\x5c\xb7\x00\x71\xf1\xbb\x16\x49\x94\xa9\x9f\x7e\x74\x38\xc5\xa6\x88\xfb\xb8\x70\xb1\xea\xfc\x70\xc3\x85\xba\xd1\x93\x6e\x6f\xa6\xda\xa0\xac\x6c\x25\x94\x3a\x57\x4c\xc8\x44\xa1\x71\x82\xd9\xa3\x8e\x0e\x26\x5a\xe8\x3c\x93\x48\xfb\x0b\xff\x4d\x65\x8f\x53\x3f\x15\x27\x37\xa6\x30\x56\xd5\x50\xa3\x05\x62\x04\x01\xcf\x8b\x54\x10\x36\xce\x17\x6b\x4e\xa7\x82\xb2\x88\xa6\x90\xb7\xac\x74\xb9\xbc\x06\x29\x33\x7f\xce\x17\xca\x12\xea\x53\x65\x50\x8e\xb1\xc2\x08\x72\xee\x19\x41\xb9\x0a\x27\xff\xfc\xed\xae\xa8\x88\xca\x10\x71\x81\xe6\x7f\x2a\x55\x3e\xb3\xf9\xe5\x5f\x77\x77\xc5\xb7\xdf\x4f\xed\x4a\x8f\xc7\xd0\xab\x01\xe8\x25\xdc\xd4\x89\xda\xa3\x4b\x75\xf0\xc3\xab\xc2\x44\x66\x91\x6f\x2c\xda\x02\x6b\xa1\xa8\x97\x62\xb7\x59\x42\x3a\x70\x6b\x87\x1f\xd9\x1a\x1a\x95\x56\x54\xd4\xec\x38\xa1\x50\x65\x59\x95\x62\xbf\x79\xa5\xcf\x76\x1e\x13\x24\x21\x12\x61\x89\xca\xdb\x72\xcb\x83\x1f\xf8\xf2\x31\xdc\xb0\x2a\x6c\x8c\x25\xf9\xeb\x5f\xca\x51\x41\x23\x74\x92\x26\x18\x7c\xf4\x8b\x0a\xf3\x93\xa1\xc7\x04\x1a\x88\x45\x0a\x76\x18\x2f\xd0\x27\x7e\x8b\x61\x5e\xa3\x11\x11\x4f\x44\x54\x66\xce\x78\xa1\x88\x6b\xc0\xdb\x3d\x31\x42\x27\xeb\xa6\xed\xe6\x2b\xc5\x75\x33\x38\x93\x04\x9d\x14\xc0\x3f\x64\x6f\xdf\xfe\x44\xd0\xdb\xd3\x16\xc7\xb3\xe6\x73\xc1\xc9\xd5\xae\xe1\xd7\x42\x75\x91\x25\x04\x9d\x14\x0b\xad\xf2\x10\xbe\xe2\x32\xc9\xab\x7c\x71\x99\x6e\x79\x0e\xca\xb8\x7a\x7b\x8d\x2a\x6f\x14\x96\x7f\x8f\x17\xcd\xaf\xf9\xd9\x10\xe7\xd5\x3a\xed\x1b\x54\x6e\x84\x75\xf1\xda\xc3\x2a\x26\x91\xe0\x0c\x8e\x1c\x17\xe6\x94\xe2\x93\x39\x65\x99\x22\x21\x9a\xf1\x4c\x84\x28\xc6\x7a\x0d\x31\xe7\x4c\xcd\xc2\xe2\x1f\xf3\xe3\x33\x21\x8f\x21\xd2\x2b\x99\xb7\xe8\x27\xf4\x7f\xe1\x3f\x4f\x7d\xa0\x26\xf3\x95\x33\x87\x3e\xc3\xc1\xe7\x01\x2a\x2e\x17\x20\x14\xea\x9b\x75\xd3\xfb\x0c\xd8\xef\x7c\x30\x97\x8a\x88\x18\xcf\x43\x64\x1e\xee\xa0\xfb\xbb\x4b\x2f\x0d\x36\x88\x4d\xed\xd1\xb2\xc9\x17\xbd\x04\xbd\x87\x65\xd2\x07\x9a\x28\x22\x3a\x88\x81\x7e\xc8\x13\x90\xe9\x60\x39\xfd\x3b\x02\x98\xa4\x29\x40\xc3\x91\x78\x66\x51\x07\xdf\x13\x11\x5f\x42\xf4\x07\xa7\x2c\x44\x38\x02\xb3\x0b\xc1\x45\x88\xce\xce\xce\x4e\x0d\xf3\x6b\x7f\x2c\xe9\xdd\xee\xaf\xd2\xd3\x4e\x64\x67\xa2\x86\x43\x7f\xcd\xba\x65\x60\x32\x4f\x2a\xf4\x54\xc9\x47\x43\xe5\x52\x05\xa7\xc2\xa6\x83\xf5\xba\x36\x47\x9d\xba\xae\x56\x51\x7f\x55\x61\xeb\x22\x44\xbb\x5c\x4b\x70\xf6\x89\x76\x88\x32\x65\x5a\x93\x60\xd5\x5a\x5b\x09\x96\x25\x40\xee\x3e\x29\x2a\xbe\xba\x5b\x06\x91\xab\x1c\x84\x8d\x90\x7a\x29\xf4\xe1\xfe\xd7\xbb\xc1\x15\x49\x13\xbe\x98\x13\xd6\xbc\x8c\x69\xa5\x19\xa3\xd9\x44\xe0\x29\x74\x62\x8e\xc5\x2b\x56\xe1\x27\x45\x58\xf9\xf1\xed\x0f\xa7\x2d\xfa\x5a\x2e\x00\x59\xc1\x33\x16\x64\x2d\xc3\x17\x0d\x11\x9d\xe3\x29\xf1\x61\xee\xe2\x8e\x2b\xd3\xad\xeb\xb9\x52\xf1\x17\x17\x05\xe8\xa5\x9c\x93\x14\x4b\xb9\xfc\xf2\x5b\xce\xe3\xc5\x97\x2a\x4c\xf0\x97\x44\x65\xa9\xef\x48\x05\x9e\x8e\xe8\x57\xc7\x48\x25\xfd\x4a\xd0\x09\x24\x20\xf2\xb4\xdc\xcb\x54\x40\xec\xd7\x79\xf5\x63\x83\xed\xc5\xe1\xda\x37\xec\x8a\x4f\x70\x14\x2f\x5f\xe4\x03\x55\xdc\x6c\x45\xf4\x70\x3b\x9f\xf4\x21\x2e\x1d\xcf\xee\xd0\xf4\xe0\xe8\x51\x90\x38\x63\x31\x76\x3e\xd4\xb0\x1f\xc1\x16\xad\x4a\xbc\x64\x8b\xc2\x16\x60\xfa\x49\x86\xeb\x01\x5c\xe5\x11\xc7\x52\xeb\xf2\xc1\x86\xe6\x5a\xfd\x78\x24\x34\x01\xf1\x1f\x88\xf1\xe7\x53\xbf\x61\xc1\xcd\x3c\x73\x88\x35\x17\xd0\x09\x65\x48\x92\x88\xb3\x58\x9e\x9a\x8f\x98\x2c\x23\x9d\x31\x0d\x6c\x1a\x88\x69\x0c\x87\x8e\xa3\x88\xcf\x53\xbd\xd1\x1a\xae\xe7\x16\xd3\x67\xb3\x4a\xa2\x20\x4a\xde\x0d\x7f\x79\xff\xeb\xfd\x9d\x0f\x26\x9b\x05\x8f\x1e\x59\xfe\xfa\xf2\xe6\x26\x1b\x8f\xbe\x53\x25\x72\x59\xf8\x6d\x79\x78\x0a\x95\xe2\x09\x5d\x1e\x0e\x24\x89\x80\x2a\x51\xf1\xf1\x94\xe5\x8e\x17\xc3\x8c\x60\x7d\x2f\xf1\xa9\xe0\xeb\x9f\x85\x5e\x73\x3e\x4d\x08\xba\x84\x62\x28\x32\x77\xf8\x75\xaf\x8b\xcf\xed\x13\xb5\xe7\xfa\xb4\xdb\xb8\x4b\x6f\x6a\xb9\x33\xff\x5c\x64\xb3\x27\x24\x8a\xaa\x2c\x76\xc4\xa1\xe2\x0a\x3a\xc9\xf7\x57\x7a\x16\xc3\x2a\x9d\x7c\x5b\x3f\xee\x30\x48\xf0\x52\x05\x0f\x01\x09\x67\xd3\x4d\xda\xcf\x71\xd4\xee\xe8\xbf\x0c\x2e\x0b\x33\x9a\x8f\x6b\xfa\xd8\x6b\x19\xbe\x7b\x7b\x06\x89\xf8\x73\xc9\x2f\x0e\xcd\xb6\x8a\x12\x85\x3b\xf8\xf8\xce\xc7\xbb\xbb\x1b\xaf\x68\x12\x3d\xda\x67\xcd\x3b\x1f\x8c\x11\x16\xa7\x9c\x32\x65\xf6\x6c\x15\xc3\xc2\xd1\x63\xe5\x83\x05\xb0\x95\x58\xd3\x83\xe2\x45\x6d\xd4\x93\x23\xba\x0e\x69\x90\xd4\xdf\xa7\x9b\x8c\xc5\x5e\x0d\x6c\x3b\x0a\xfd\xd1\xc5\x6d\xc1\xd4\x37\x77\x04\xe7\x8c\xe0\xd8\x1c\x1e\x5a\x95\x8d\xe3\x58\x3f\xe0\xc6\x09\x32\x6d\x60\x94\x40\x9c\x9c\xd9\xe7\x75\x17\x9b\xc2\x07\xf6\xc3\xe5\x4a\x0d\xb2\xe9\xa9\x79\xcd\xed\x3e\x6a\x29\xae\x85\x0f\xac\x13\xb7\xc5\x0a\xee\xed\x04\xaa\x97\x70\x93\x19\xe4\x35\xed\xf2\x7a\xed\x3b\x1c\x3d\x12\x16\xef\x8d\xc3\xc7\xb9\x3c\x87\xc9\x21\xd0\x95\x6b\x5e\x53\x4d\x46\x45\xf3\x06\xd2\x73\xd4\x95\x14\xb7\xad\xef\xa1\x51\xd5\xde\x2f\xe1\x06\x98\xf9\xe0\x3c\x64\x93\x24\xfb\x72\xf5\xce\x2b\xc4\x75\x0d\x76\x16\x3d\x12\x47\x42\x9b\xff\x0e\x98\x3e\x0b\x6a\xf2\x53\xed\xbe\xbe\x69\x44\x58\x3a\xfc\x6a\xe7\xc5\x80\x51\x39\x27\xf2\x29\x0a\x5f\x9e\xbe\x38\x3f\x4f\x78\x84\x93\x19\x97\xea\xe2\x6f\x6f\xff\xf6\x57\xcf\x38\x31\x27\x58\x66\x82\xcc\x89\x4b\xa0\x75\xb1\x56\x32\x31\x63\x2a\x96\xbe\x17\xe6\xf7\xd3\xd0\x26\xbb\xa2\x15\x64\xe6\x00\x87\x22\x0c\x90\x51\x33\x2a\x91\xdd\xb5\xcc\x26\x13\xfa\x25\xaf\x70\xfe\x7f\xf1\x25\x08\x37\xa5\xe4\x55\xcd\xab\x34\x9c\xab\x6e\x6c\xe6\xd5\x7b\xbe\x89\x66\xa5\x5b\xfd\xf3\x32\xcf\x85\x8d\x37\xb0\x91\xa5\x28\xbc\x16\x35\x98\xdd\x03\x8f\xd3\xb7\x7d\x26\x85\xe7\x1b\x21\xfe\xc1\xc7\x11\x09\xfc\x0c\x14\xbb\x0a\x0f\x58\xe1\x37\x02\x2b\xb2\xba\x2a\x57\x02\x33\x69\x36\x05\x78\xae\x66\xbd\x77\x00\x76\x22\x6d\x1e\x0d\x62\xd7\x98\x6c\xc8\x96\x02\x70\x1c\x43\xad\xdc\x0f\xaa\x79\x34\x48\xd3\x91\x73\xaf\x4e\x43\xef\x56\x58\x96\x44\x4a\xfa\x3f\xec\x7d\x6d\x53\xe3\x38\xf2\xf8\x57\x51\xe5\x55\xb8\x32\xb3\x3b\xb3\xb7\x5b\x57\x53\x75\x2f\x32\x89\x19\x58\x42\x60\x13\x18\x96\xfa\xef\xbf\x28\x27\x16\xe0\x8b\x63\x67\xfd\x40\xc2\x5d\xf1\xdd\x7f\xd5\x7a\xb0\x65\x5b\x72\xda\x89\x13\xd8\xbb\x79\x35\x4c\x24\x4b\xad\x56\xab\xd5\xea\xc7\x30\x00\x1f\x2b\xec\x6c\xa3\xd5\xbc\xc9\x6c\x01\x4d\x56\x61\x34\x6f\x3e\xd3\x66\x05\x49\x3e\x09\xd3\xca\xec\x7c\x6e\xcc\x71\x44\xad\x3f\xd8\xd5\xc2\xbe\xd5\xf3\xe5\x46\x6a\x54\x09\x82\xbc\xda\x16\x07\x9c\xe5\x72\xe3\x16\xf7\x78\x1f\xd4\x78\xc2\x0f\x06\x3c\x4f\xf8\x3b\xdd\xb4\xa6\x6d\x8c\x88\x38\x10\xe8\x27\x6a\x73\xc3\xa6\x96\xe7\x83\x9e\x08\x78\x73\x0c\xf7\x23\xb8\x4b\x81\xed\x5d\x58\x42\xe1\x35\xd6\x55\xac\x67\xdc\xda\x20\x1a\x45\xf1\x74\x97\x2a\x46\xd3\x12\xcb\xb3\xc8\xe5\x75\xaf\x07\xf5\xd1\x41\xfd\x14\xa7\x4b\x88\xa4\x28\xb9\x7d\xd4\x99\x73\xbd\x20\x4e\x1c\x1f\x5e\xca\x61\x90\xfb\x99\x20\x5e\xbf\x0d\x1e\xa9\xe0\x9f\xbf\x3e\xe9\x07\x49\xa1\x7f\x1d\x54\xd1\xfa\xe3\x60\x7c\xf9\xf0\x10\xd3\xa4\x6e\x47\x15\x2a\x8d\xd6\x9f\x06\x63\x74\xdf\x01\xf5\x9d\x17\x74\xef\x5b\x2f\x70\xc3\x55\xdd\xcb\x62\xfc\xbb\xe8\x03\xe1\x66\x4c\xe0\x50\x0f\x59\x91\x1a\xb2\x60\x9d\xdc\x49\x5b\x55\x32\x4e\x69\xb2\xa2\x34\x0b\x56\x29\xdc\x07\xc2\xe0\xcb\x6e\xf8\x6a\x5a\x13\x2f\x78\xb4\x08\xf8\xfe\xa4\xc1\x3c\x08\x57\x45\x4f\x14\xf3\xfa\x9e\x9d\xc8\x83\x47\x89\x46\x3e\xcf\x9a\x24\x57\x94\x94\x9c\x51\xa2\x6a\xa4\x11\xef\x32\x25\xd4\x9d\xbb\x7a\x5e\xcb\x20\xba\x8d\x0f\x34\xe0\x5c\xdf\xc4\x9c\x0d\xe5\xf4\x42\xbd\xf1\x5a\x41\xa4\x50\x5e\xf5\x3d\x73\xc9\xcd\x37\xa1\xb8\x9c\xe5\x03\x13\xe8\x02\x76\x28\x26\x69\x0c\xcf\x51\x2d\xb7\x39\x6a\x34\xbf\x1d\x3c\x53\x3f\x5c\xd6\x3a\xa3\xab\xdd\x2a\x41\x89\x02\xc2\x55\xe4\x2c\x97\x5c\x90\x76\xc8\xb9\x7d\xae\x11\xde\x72\x29\xd5\x22\x61\xe0\xa3\x97\x03\x57\xc0\x09\x70\xff\x5d\x1d\x21\xdd\xbc\xa8\xb8\x19\xe5\xa2\x68\x37\x0e\x89\x6d\xdf\x33\x0f\xfd\x20\x01\xbf\x3a\xe4\x02\xa1\xfb\xcd\x12\xd9\x79\xfb\x8b\x00\x23\xb3\x49\xc1\xce\xfa\x7e\x5f\x14\xef\x8b\x57\x0b\xcb\xaa\x70\xbc\x2d\xd7\x3b\xe5\x95\x39\xcd\x6c\x0e\xdc\x39\x21\x14\x4b\xc3\xfb\x59\x9b\x70\x80\x48\x42\xae\xd1\x7a\x21\xce\x94\x59\xc5\x86\x67\xa3\xf3\xfb\xdf\x6e\x7a\xc3\xb3\xeb\x3b\x8b\x7c\xed\x5d\xdb\xb7\xbd\xbb\xfb\xc1\xcd\xf5\xdd\x7d\xff\xae\x3f\xb4\x2d\xf2\xa5\x77\x7d\x6d\x8f\xef\xee\x87\x97\xb7\x16\x61\xdd\x2f\x7a\xe3\xaf\x67\x23\xf8\xa1\x70\x17\x20\xe8\xa1\x7c\x50\x15\xa6\x11\xd7\x93\x1d\x97\x4b\x75\x4a\x23\x50\x7b\x08\xcd\x9d\xb8\xd6\xd8\x82\x63\xe0\x3a\x3b\x82\xa7\x3a\x66\x17\x41\x93\x2d\x0a\x42\xc3\x67\x1a\x91\xae\x7d\xd1\x3b\x1b\x5a\xe4\xd6\xfe\x72\x7a\x79\x79\x6e\x91\xc9\xb0\xd7\x3f\xdf\x15\x4d\x90\x2d\x51\x27\x7f\xc0\xcf\xf2\x19\x28\xa6\x26\x02\x32\xe4\xe5\x20\xd4\x28\x1b\x90\x7f\xd1\xeb\x67\x98\x97\x5f\xa8\x58\x17\xbf\x29\x88\x27\xdd\x3f\x3a\x7f\xfb\xa3\xc3\xfe\x04\x47\x1c\xf9\xd5\xae\x98\xf8\x33\xf5\x68\x72\x1a\xa6\x51\x6c\x6f\x08\xd5\x66\x3d\x99\xef\x58\x4c\xba\xa7\xa7\x9f\x2f\x2e\xa4\x59\x99\xb9\xdc\x30\x19\x9b\x26\x48\x34\xe5\xd3\x4e\x10\x41\xc4\xad\x4e\x1d\xfb\xce\x6c\x7e\x4b\xa7\x4f\x61\x38\xd7\x6a\xaf\x59\x07\x28\x7a\x19\x2e\xe0\x6e\x5d\xf1\xae\x24\x8d\x7c\xd2\x65\xd4\xd7\x90\x24\x1a\x7a\xc4\x15\x16\xdb\x92\x53\x5c\x5d\xa6\x07\xf5\x69\x0f\xbd\xb4\x19\x1f\xc0\xe5\x19\x9f\xf1\xc1\xea\xac\x6a\xf0\x5b\x40\xa8\x38\xd7\x8d\x50\xfa\x6a\x6d\xc1\xe7\x31\x77\x44\x21\x76\xd0\x74\x33\x2c\x9c\xb5\x74\x23\x8c\xaf\x68\x34\x70\x34\xf7\xfb\xc2\x59\x7b\x8b\x74\x41\x72\xff\x8f\x4a\x90\x8f\xe2\x87\x4a\x23\x70\xc6\xb4\x60\x37\x79\x84\x42\x1a\xf8\xde\xc2\x2b\xbf\x55\xcd\xf7\xea\xc2\x59\x8f\xf4\x09\x09\xaa\x80\x00\x43\x8f\xb7\x9b\x06\xfd\xae\x7d\xb5\xd0\x48\xce\xb7\xa5\x75\x65\x4f\xb1\x24\x87\xe9\x9e\x6f\xf9\x71\x02\x32\xdd\x4c\x1f\xc2\xcd\x9a\x98\xd8\x40\xba\xfd\xde\x9d\x3d\x1a\xd9\xf7\xc3\xab\x2b\x8b\xf4\x6f\x26\xd7\x97\x17\xf7\xbf\x4e\x8e\x70\x73\xb8\x14\x86\x9a\x30\x68\xab\xd3\xf0\xbf\x81\x45\xe4\x9e\x52\x03\xf6\x45\x97\x39\xcc\x59\x44\xf8\x6f\x3d\xa4\x81\x88\xd8\x6f\x0a\x00\x0d\x9a\x02\x60\x07\x2a\x00\xe1\xf4\x5f\xdb\x4f\xdf\x60\xcf\x31\x67\xbe\x92\x70\x7e\x67\x42\xd1\x88\x54\x38\xb4\x0a\x1e\x58\x9d\xc3\xa5\xbe\xf7\x4c\xa3\x17\xc9\x25\xcb\x52\x11\x72\xdb\x64\x97\xf2\xf0\x22\xf5\x2b\x6f\x26\xdd\xfe\xe4\x9b\x45\xae\x06\x27\xc8\x51\xe1\xa6\xaa\x8e\x09\xbf\x4a\x44\x80\x53\x39\xcb\x2f\xf3\xe9\xa7\x86\x9c\xc6\x7c\x53\x45\x32\x43\x2f\x02\xc2\x88\xce\xbc\xa5\x67\x70\x8e\x56\x45\xbe\x5c\x9b\x93\x7f\xa2\x11\x03\x77\x91\xb7\x38\xdc\x7a\x06\x21\xf6\x01\x3e\x21\xdd\x81\xfd\xed\xac\x6f\xdf\xf7\xfa\xd7\x67\xdf\xd8\x53\xe2\xf2\xe4\x64\x78\x36\xb2\xef\x79\xc3\x64\xe7\xf0\x80\xdc\xf3\x7e\xd0\x3b\x1b\xde\x81\x88\x6d\x9f\x0f\xef\xf6\x23\xd4\xb4\xee\xe6\xbf\x67\x11\x03\x86\xa7\x73\x57\x77\xb7\x8b\x10\x09\x58\x15\xf4\xe1\x57\x69\x0c\xce\x9d\x2f\x12\x87\xd9\x72\x51\xe4\xfe\x6a\x35\x61\x4f\x7b\xbc\x30\xd5\xd4\xe8\x26\x2e\xe8\x3f\x86\x91\x97\x3c\x2d\xaa\x78\x91\x39\xd2\xb3\x2e\xa4\x6b\x4f\x3e\xfd\xfc\x0b\x04\xc8\x9d\xc2\x1f\xf9\x26\xb3\xdf\x91\xfb\xd0\xee\x05\x8d\x5e\xbf\x09\xcd\x73\x7d\xce\x84\xaa\x2b\x3c\x38\x5e\x66\x41\x48\x73\xcf\x95\x0e\xd9\xbf\xde\x4e\x84\x13\x0f\x12\x01\x3c\xf2\xbf\x1e\x01\xa7\xe0\x4f\x27\x52\x04\x74\x99\x86\x90\x67\xa1\x14\x1a\x71\x86\x7e\x30\x01\xb6\x10\x2d\xa0\x4f\xd7\xda\xc2\xb5\x89\xc5\x06\xc4\xc3\x55\xc7\x53\xc0\x22\xbc\x8f\x60\x35\xe0\x68\x11\x7f\xfe\x41\x24\xc1\x9e\x42\x12\xec\x0f\x74\xed\x80\x2f\xf2\x87\x59\xb8\xd8\x1f\x42\x72\x0a\xd2\x7d\x3b\x70\x12\x67\x0c\xc1\xe1\xfa\xac\x3b\x53\x27\x70\x57\x9e\x9b\x3c\x55\x57\x9a\x37\x59\xc6\xe3\xae\x5c\xa5\x53\x2f\x89\x44\xc5\x8f\xd2\x38\xbc\x81\x74\x4f\x26\xe7\x47\xb8\xb1\x5a\xcd\x05\xb4\x08\xdd\xd4\x37\xb8\x82\xe4\x6d\xa4\x3b\xbc\x1c\xf7\x80\x87\x94\xc1\x14\x23\x69\x46\x8e\x97\x11\x75\xdc\x13\x43\x82\x2e\xde\xea\x05\x8f\xc7\x0f\xac\x07\x9f\x01\x89\x81\x37\xcf\x38\x34\xa0\x8e\x3b\xa4\x10\x48\x6f\x4a\x83\xd6\xf2\x81\x83\xa0\xfd\xc5\x32\x89\xeb\xb6\x3d\xeb\x63\xc6\x61\x3e\x60\x93\x94\x62\x3e\x78\x19\x88\xd1\x9b\xa1\x6f\x0f\x96\x6c\xf0\xf4\xac\x0e\xc7\x7e\xd6\xc1\x8b\x1b\x55\xb8\xf6\x55\xc7\x55\xcc\x87\x22\x82\xec\xc1\xf1\xa0\xba\x15\xf8\x4a\xf2\xf7\x40\xee\xfb\x27\x78\x1d\x8b\x43\x0f\x23\xc6\xf3\x90\x58\xf2\xdc\x3a\xcf\x68\x97\x3a\x2e\xf1\x19\xb9\xa1\xf6\x56\x28\x37\x10\xc9\xdc\x44\x4f\xd2\x8d\x41\xfd\x24\x9e\x1e\x8e\x12\xc9\x27\x5d\x5b\x59\x38\x3d\x73\xf5\xc7\x5d\x5e\xf2\x07\x73\x14\xa4\x29\xe0\x11\xd4\x33\x7f\xa6\x0e\x24\xca\x84\xff\x3c\xd0\xd9\xcb\xcc\xa7\x56\x16\xcf\x65\xb1\x44\xce\x69\x6c\x11\xf0\xda\x03\x92\xb5\x32\x41\xcf\x45\xc1\x66\x3c\xd3\x3e\xd5\xe6\xc3\x39\xc8\x9d\xda\x14\xa8\x0d\xf7\x1a\xff\xec\x2d\x93\xf4\xbc\x5a\x5b\x40\x86\x59\x15\x26\xf5\xcc\x4e\x62\xb8\x66\x1a\x0c\x5c\xf9\x9d\xb0\x01\xac\x76\x8e\xf9\xab\x85\x84\x05\x07\xfb\xdb\x24\xad\x79\xb5\x9a\x01\x85\x5a\x8b\x2e\x25\x47\x83\x0d\xc9\x1f\x11\xbb\x66\xe2\xa8\x81\xa7\xc9\x42\x7e\xa3\xa0\xa1\x3e\x4b\xe8\x62\xcb\x75\xb0\x4c\x0b\x04\xf4\x25\x6d\xad\xe5\xb7\x1c\xa2\x26\x2b\xa9\x4d\x46\xd2\xc2\x99\x2d\xce\x83\x81\x0c\x93\x21\xa0\x1e\xb9\xbb\x06\x5b\x6b\xe0\xc0\x00\x8e\x8d\xce\x6e\x01\xab\x35\xb1\x9c\xe6\x8f\xde\x30\x28\xf3\xd5\x6a\x0c\x17\x6a\x45\x1b\xe2\x09\xf7\x14\x6d\xf7\x6a\x61\x60\xc2\x2c\xa0\x12\x92\xf3\xf6\xbb\x51\x01\x09\xb5\x8e\xb7\x88\x12\x7a\xb5\x1a\x40\x84\x59\x85\x36\x4e\xe1\xed\x97\xb2\x45\xf8\x04\xff\x10\x19\x3e\xd1\x02\x3f\x32\x7b\xaa\x9b\xbf\xa9\x75\x39\x6f\xf7\x8d\x5a\x0b\x3b\xc6\x0b\x34\xef\xb9\xc9\x0b\xf4\xc0\x80\x23\x3d\xbd\xe4\x07\x8d\x3c\xbd\xf0\x9e\x11\x2d\x2c\x65\x1b\xdf\x04\xbe\x2a\x94\x6f\x42\x0b\x34\x6e\x32\xcf\x9b\xbf\x78\x03\x3b\xfb\xab\x85\x86\x07\xb3\x02\xac\x0d\xb8\x05\xf4\xd6\xd8\x73\x6a\x3e\xda\x6c\x98\xd9\x68\x97\x40\x06\x00\xbd\x5a\x48\x38\x30\x70\x9b\x54\xe3\x6f\x4f\x23\x5b\x2a\xed\x85\x94\x3f\x10\xf6\xf8\xea\x12\x6a\x92\x0e\x8a\xc2\x57\x31\x2b\xc4\x30\x9b\xf3\x04\xd4\x32\x72\xa0\x63\xe1\xbc\x79\xb1\xca\x53\xd6\x6f\x8b\x6a\x0c\x0f\xfd\x40\x33\x74\xe6\xbc\xf4\x00\x85\xbf\x8e\x99\x19\x80\x66\x4a\x4f\x56\x74\xac\x10\x8d\xd1\xb1\x8c\x87\x44\xd1\xa5\xd7\xeb\x24\x1a\x3d\x1d\xad\x4e\xc6\x4d\xab\x63\x46\x4e\xe0\x86\x0b\x25\x11\x20\x37\xcb\x41\xc5\xed\xd9\x9c\x45\x11\x69\x82\xf8\x91\xf8\x82\xac\x91\x28\x4d\x76\x15\x49\xd9\xd6\x68\x7c\x19\x03\xb4\x33\x23\xd3\x51\xd4\x78\x8c\x70\x95\x25\xe9\xfe\x76\x63\xdf\xd8\x03\x8b\x4c\xec\xd1\xb5\x45\xae\xec\xd1\xe0\x6c\xf4\xd5\x22\xbd\xfe\xf9\xe8\xf2\x76\x68\x0f\xbe\x42\xe3\xa8\xd7\x3f\xb7\x64\x26\x1e\xb0\xb9\xf4\x7b\xa3\xbe\x3d\x1c\xda\x03\x24\x38\xe9\xd2\x45\x91\x67\xa6\x2b\x17\xe0\x81\x73\xc5\x23\x6d\x46\xac\xaf\x56\xed\x19\x55\x94\x1e\xa0\xc0\xd8\x37\xb7\x69\x62\x6f\x90\x41\x29\x6c\xc3\xdf\x22\x95\xae\xcc\xa0\x4b\x5d\xc2\xd0\x54\x73\xc2\x36\x9c\xd7\x2d\x54\x56\x7b\x48\xc9\xbb\x93\x4f\xce\x06\x3a\xca\x14\x4e\x87\x67\xf6\xe8\x7c\xb2\x98\x1c\x73\x2e\xc4\x69\xdc\x04\x89\xce\xef\xbd\x92\x30\x8c\x4c\xe9\x43\x18\x51\x25\xa1\x17\x30\xe2\x2c\x94\x12\x2c\x29\x2a\x0d\xc3\x8f\x6c\xfc\x92\x2b\xa9\x98\x7d\xa7\xc3\x82\x1b\x4f\x44\x3d\xe9\xb6\x02\xb0\x28\x33\xc7\x41\x4a\x32\x4a\x4e\xc6\x17\x42\x46\x94\xd1\x52\xda\xf8\x51\xec\x36\x35\xba\x33\xb3\x28\x45\x31\xb3\x74\x90\x64\x50\xf2\x2c\xb0\x19\x4c\x47\x35\xa7\x49\x39\x97\xb5\x69\x10\xf3\xaa\x23\xdb\x1f\xf2\xad\x2f\xe5\x85\xb3\x1e\xd3\x24\x12\xc7\xa5\x38\xe8\xc2\x59\x7f\x50\xdc\x92\x23\xaa\xde\x8d\x8c\xe5\x39\x4a\x2e\x66\x98\x92\x7b\x5a\x05\x21\x61\x5e\xcb\x48\xe4\x2c\x69\xe0\x6a\x4b\x23\xc0\x72\xd4\x29\x81\xb8\x45\x67\xd2\x5d\x39\x1e\x2b\xfd\xc7\xa2\x2d\x98\x9c\x70\x84\xa5\x86\xad\x05\x11\x55\xfc\x28\xcc\x26\xf0\x99\x4d\x26\xfe\xcf\xe6\x32\x20\xb7\x11\x5e\x31\x88\x34\x30\x49\x51\x31\xad\xc2\x2b\x8d\x22\x7e\x36\xf3\xff\x24\xcb\x6c\x94\x6b\xe4\xaf\xc1\x24\x49\x57\xec\x9b\xbb\x45\x8c\xed\xff\x0c\x57\x7d\x07\x8c\x50\x9c\xdb\x7a\xaf\x8c\xc3\xa7\x2f\x3f\x1c\xbb\x6c\xcc\xc2\xea\x75\x01\xe2\xbb\x4c\x25\xbc\x99\xf7\xb5\xca\x9b\xf6\x70\xe9\x9b\x3a\xb6\xb0\x59\xbb\x3c\xb2\x5f\xad\xa6\xf8\xcf\x37\xae\xb4\x01\x4c\xa0\x8c\x31\x0c\x35\x7b\x73\x71\x86\x06\xac\x25\x67\xec\xf2\x38\xac\x9c\x3c\x8a\x6b\x1f\x4f\x10\xc5\x34\x7b\x98\x27\xec\x96\x19\xe4\x77\x5a\xbb\x20\xe5\x1d\xf3\xbe\x6b\x41\x30\x13\x7c\x19\x84\x96\x0c\xed\x6d\xe5\x85\x2f\xa9\x07\xf6\x9f\x08\x9e\x51\x9a\xb9\xb4\x6e\x13\x97\x4d\xe1\x8f\x28\xe4\x23\x14\x50\x9b\x5e\x1d\x6c\x48\x14\xfa\xbf\xfb\x1f\x6e\xe3\x7f\xc8\x76\x7f\x92\x44\xd4\x59\xb0\x3f\xf7\xcf\x68\xda\x96\x6c\xff\x2b\xf7\x9d\xeb\x46\x77\xda\xd8\x35\xc4\x0c\x81\x3d\x37\x36\x4a\x25\xed\x6e\x2d\x06\x10\xd3\xf5\x3c\x8b\x9f\xab\x60\xf4\x27\xdf\xe4\xbb\x04\xde\x14\x0e\x89\xc2\x15\x94\x22\x14\xb5\x8b\x58\xad\x42\x4d\x38\x8d\x5e\x6c\x32\x40\x57\x72\x33\x02\x7c\x55\xa1\xc3\x93\xac\x64\x5b\xe5\x77\xab\x80\xa2\xa9\x6b\x39\x8f\x0a\xcc\x85\x71\x18\x96\x74\x4f\x7a\x67\x43\x7b\xc0\x38\x02\x2e\x67\x2e\x54\xe9\x8b\x21\xf7\xd1\x49\xe4\x3c\xd6\x69\x0c\x44\xb7\xbc\x4c\x01\xe9\x3a\x31\xd7\xd6\x4b\x50\x8e\x6a\x98\xb1\x72\xcb\x06\x53\x98\x6b\x2c\x8a\xcf\xd7\xcd\x99\xcf\x25\x32\x60\x94\x56\xbb\x25\x00\x0c\x3b\xd5\x79\x45\xf1\x01\xd6\x4a\xba\x67\xa3\xfb\xab\xf1\xe5\xd7\xb1\x3d\x99\x58\xa4\x7f\x79\x71\x35\xb4\xaf\xc1\x18\x22\x30\x1c\x46\xd2\x20\x82\x44\x73\x63\x1b\x88\x00\xa7\x0d\xe3\xc7\x89\x9f\xc6\x4f\x85\xa7\x8c\xf9\x35\xd2\x2a\x0b\x6e\x00\x4f\x7e\xfc\x75\x5f\x08\xbf\x32\x70\x08\x8e\xab\x40\x3b\xcf\x8f\x50\x19\x6d\x32\x1a\x57\x01\x77\x9e\x69\xe4\x3c\x42\xe9\xda\xb1\x44\x6f\x46\x4c\x4b\x48\x9c\x5c\x8c\x4f\x31\x67\x6d\x72\x9e\x1f\xc7\x93\xc9\x99\x79\x06\x68\xdd\x6d\x8a\x68\x7d\xc5\xbb\x63\x0e\x47\x36\x85\x10\x81\x35\x33\x99\x8f\x40\x46\x72\xd5\x19\x5a\x0e\x58\xb2\x3a\xc9\xba\xe7\x45\x30\x61\x75\xae\x2e\x8d\x13\x6f\x01\xb6\xc1\x23\x92\x84\x89\xe3\xe7\xd6\x1c\x87\x7f\x43\xba\x8b\xf8\x08\xb9\x26\x89\x3d\x7b\x01\x09\x8a\xdd\xfa\xe9\x72\x44\x0a\xc5\x0a\x7c\x92\x4f\xdf\x00\x9b\x06\x22\xff\x4a\x93\x4d\x55\xc4\xf1\x97\x6c\x41\xf8\x67\x45\xde\xb3\xba\x3e\x6a\x7e\x64\xe4\x8e\x14\x04\x77\x44\x7f\xac\x22\x40\xfa\x4b\x21\x86\x54\xa1\xc6\x26\x21\x8d\xe8\x73\x38\xd7\xb3\x50\x05\x3b\x60\x75\x12\x3d\x71\xd8\x68\xad\x74\x3c\xec\xf8\xfb\x8a\xd6\xd1\x43\x64\x24\xc7\x5d\xca\xbf\x8b\xfc\x40\x95\x7a\xe4\x42\xd3\x2d\x03\x8f\x91\x14\xfa\x57\x28\x13\xff\xc6\xb5\xe1\xdf\xbe\x60\x3b\x50\x57\xae\xed\x1d\x78\xb0\xe8\x69\x7a\x40\xaa\x07\xdb\x45\x7d\x76\xb2\x25\x8d\xbc\xd0\xcd\x6e\xac\x3c\xd7\x00\xbe\xe2\x95\xbc\xf6\xea\x58\x44\x2f\xbf\x26\xb3\x2c\xa8\xe5\xc4\x9e\xba\xab\x54\x48\xa3\x51\xb2\xe1\x1a\x2e\x2d\xe3\xa8\xb5\x4d\x9b\x0c\x7b\x1b\x9c\x1b\xff\x82\x3b\xf6\xa6\x18\xbd\x81\x4a\x82\x4c\x4e\xfd\x7e\x08\xde\xf5\x21\x78\x87\xb1\xa8\x35\x60\xed\x2e\x42\x62\xe0\xb2\x3a\xb3\x4f\xae\x1d\x40\x06\x6b\x0d\x49\x89\x17\x07\xa3\xa9\x19\xd4\x6e\x3b\x4e\xc2\x63\x97\x05\x45\xe6\x25\x34\xc5\x66\xf1\x9f\x63\xd0\xa1\x55\x6e\x4f\xac\xad\x17\x0a\xa0\x8c\xb4\x2e\xf8\xd0\xa2\xba\xe1\x9f\x85\xd7\xe4\x34\x9d\xe2\x96\x18\x3f\x39\x11\x75\x7b\x52\xd8\x19\x6d\x74\xf2\xe7\x1f\x48\xa1\x46\x78\xe5\x31\x71\x67\x16\x06\x01\xe5\xd9\xd2\xf8\xf8\x5b\x89\x3b\x66\x6a\xd8\x7b\x68\x71\x79\x0e\x13\x99\x89\x24\x49\xf1\xae\x19\xa3\x5b\x7f\x49\x98\xd7\xf5\x3e\xc2\x9f\xbf\xd2\x44\x13\x36\xfc\xc6\x4c\xa6\x36\x90\x79\x9f\x20\x81\xb6\x31\xf8\x02\x99\x3b\xa2\x97\x21\x7d\xd6\x25\xb5\x5b\x78\xc1\x07\x32\xe5\x5d\x88\x0f\x7d\x20\x45\xd2\x92\x46\x33\x66\x40\x02\x17\x07\x51\x13\xcc\x3d\xc2\x69\x55\x16\x5e\x30\xf4\x82\x79\x9e\xa2\x5b\x33\x21\xe3\x4f\x0b\xd6\x03\xa6\x73\xbf\xd4\xcc\xe4\x05\xc9\x4f\x9f\x34\xd4\x5e\x83\xef\xbd\x87\x1b\x57\x26\xd9\x7d\x3f\x35\x0e\x1d\x2a\x16\x04\x40\x15\x00\xad\x3a\xff\x57\xd5\x4c\xee\xc5\xdc\xfd\xc9\x89\x15\x3f\x16\x66\xc7\x85\x0b\x03\x7b\x47\xb4\xec\xd9\xb5\x8d\x32\x5f\xea\x66\x64\x0e\x66\xf9\x7b\xac\xc1\x60\x81\x90\xc4\xc4\x0d\xdd\x38\x3a\x96\x91\x48\x14\xc6\x8b\x65\xb4\xa0\x6a\x1e\xa7\x81\x4e\xa7\x02\x4d\x24\x4a\xf3\x88\x84\xdc\xab\xad\x18\x9b\x40\x21\x23\x77\x94\x62\x17\x27\x79\xbb\xf9\xc2\x8d\x52\x1f\x69\x22\x09\xe8\xda\x04\x3e\x34\x19\xc0\x47\x02\x2a\x4e\x58\xbd\xe5\x50\x74\x42\x0d\x28\xcd\xb2\x55\x60\x67\x51\x18\x10\xba\x5e\x42\xba\x49\xf4\x41\xdb\x32\xd9\x22\x66\x70\x33\x9b\xa9\x04\xdf\xef\x89\x9d\x55\xe6\x79\x4b\x8e\xb6\xe7\xf0\x2a\x97\x3a\xae\xef\xe9\x36\x52\xb6\x48\xd0\x51\xf5\xbc\x33\x35\x28\x7b\x55\x51\xb7\x05\xa6\x23\xe7\xd7\xd7\xcd\xef\x58\xc6\x8d\x56\x58\xd2\x6e\xe5\xec\x9b\xcd\x81\xab\x53\xaf\x8e\x5f\x2d\xcb\xff\x46\x95\xf0\xb1\x9c\xfb\x7b\xc5\xfc\xc3\x57\xcc\x47\x9e\x24\x83\x81\x99\xfd\xac\x9b\x67\xd2\x3f\xb5\x07\x37\x43\x30\x2f\x2b\x66\x67\x88\xb4\x1b\x5c\x8e\xec\x7d\x54\xe6\xc7\x61\x6c\xab\xb8\x3d\xda\x66\xd8\xde\x57\x9a\xbc\xbf\xc4\x2d\x46\xa0\x76\xbf\xa2\x30\x50\x59\x9d\x99\x0f\x69\xab\x6d\x4c\xb5\x12\x43\x7d\xff\x6e\xd9\x7a\x03\x9e\x9b\x5b\xd8\x69\xfe\x8b\xcb\xfd\xc3\x2e\xf3\x6c\x36\x28\xcb\xc6\x7f\x81\x12\x76\x6f\xe5\xf9\x0f\xaf\xdd\x95\x3b\x97\x26\x2f\x7d\x48\xd7\xf8\x7d\xdb\xfe\xa2\xdb\x66\x62\xa9\x11\x8d\x53\xbf\x58\xc0\xce\x84\xdb\x49\x3a\xfd\xe2\x04\xee\x4d\xe2\xf9\xc2\x8a\x5f\xd5\x4c\x6e\x04\xc9\x48\x40\x7b\xc2\x3e\x02\x20\xe3\x6d\xe3\x27\x5e\x92\xba\x1a\x01\x44\xb6\x90\xee\x82\x26\x34\x8a\x91\x0a\xb4\x9a\xe7\x8f\x68\x22\x4e\x92\x0b\x49\xcd\x88\xa1\x44\xe5\xff\xc1\x7c\x01\xb2\xc6\x84\x52\xed\xb3\x3f\x07\x43\x20\x5d\x78\x08\x16\x9d\xa5\x24\x88\x1a\x45\x46\x4c\xd1\xe5\x8c\x7c\x27\xc7\x34\x02\x8f\x7e\x18\x3c\x36\xe9\xbf\xb7\x83\x2d\x65\xf7\xad\xfc\x74\x4c\xb7\xbc\xda\x93\x84\xab\xec\x71\x21\x20\x03\x85\xaa\xb1\x2c\x57\x49\x04\x45\x79\x4d\x8a\xa6\x1d\x48\x6f\xe3\x19\xab\x37\xe3\x7e\xbf\x3a\xde\xe1\xd5\x21\xb6\xac\x85\x6b\x43\x1d\xb0\xc9\x85\xf1\xae\xb2\x1a\xea\xe0\x31\xde\x1b\xb3\xb9\x9a\x89\x4c\xeb\x2d\x45\x03\x77\x19\x7a\x81\x64\xa8\xf2\x8c\x97\x23\x2f\xb3\x60\x25\xc8\x4a\xce\xad\x35\x48\x8a\x6f\xfb\xa9\x04\xf6\x81\x9b\x65\x93\xb5\x88\x1b\x02\x3e\xdc\x7a\x15\xcc\x45\x7f\x5b\x64\x6a\x22\x22\xb7\x06\x84\xc7\x40\xc4\xd5\xb9\x1d\xd7\x65\x12\x92\xe3\x8b\xb2\x23\xf0\x5a\x02\xbe\x2c\x03\x5d\x20\x7e\x9b\xc6\x89\x2c\x36\xd8\x4b\x93\xa7\x30\x12\xec\xbd\x50\xed\xc8\x74\x7e\x4a\x74\x77\xca\x66\xa9\x1e\x24\xab\x03\x29\xd7\xb7\xc5\x15\x7c\xdb\x0a\xaa\x6a\xce\xcf\x3b\xca\xee\xa9\x01\xc7\x78\x9a\x5b\x3e\x48\x53\x70\x88\x0e\x5c\x0d\x29\x81\x34\x91\x29\x1a\x44\xe1\x02\x22\xbb\x1b\x5e\xe6\x55\x4d\x3c\x77\x45\xcc\xa8\x0a\x01\x51\x91\x8e\xcc\x18\x7b\x77\x89\x4d\x4d\x30\x1d\x6c\x2b\x53\xf0\x6d\xaf\x8e\xc7\x7f\x87\x1d\x5b\x45\x5e\x42\x45\xfe\x26\x0f\xaf\x49\xb1\xb2\x63\x5a\x1d\x5c\xae\x98\x64\x27\x39\x2f\xc4\xf3\xf9\x87\x1f\xa0\x76\x82\x0f\x6e\x3d\x9f\xff\xf1\xe3\x3f\x7e\x41\x72\xb7\x05\x75\xe2\x34\xa2\x0b\xaa\x9b\x50\x69\x94\xc4\x29\x58\x3b\x5f\x93\x55\x90\x4e\xc5\x3a\x41\x0d\x06\x8b\x4f\x20\xc6\x36\x24\xc9\x93\x17\x13\x75\xa0\x38\x7d\x78\xf0\xd6\x3c\xea\xea\x3e\x5a\x77\xac\xa6\x32\x74\x15\xce\xa2\xdc\xcc\x01\xe5\x3b\x81\x1b\x9d\xd5\x5f\xad\x0e\xcb\x7e\xce\x33\x60\x38\x69\xf2\x44\x83\xa4\x52\x39\x7e\x47\xe6\x58\x4e\x7d\xbb\x27\x63\x60\x79\x9a\xdd\x4f\x8a\x86\x03\xe1\xd0\xad\x2b\x77\x0f\xb2\xc2\x71\xa4\x28\xf7\x73\x7b\x4b\x21\x6b\x47\xc7\x32\xe2\x40\xd1\xba\x3f\xb0\xab\x57\x6b\x06\xc9\x9a\x48\xf7\xf4\xdf\x47\xad\xcc\x86\x36\x37\xcd\x36\x97\xfa\xcf\x01\x11\xea\x67\x15\x04\x31\x94\x7e\xe8\xe5\x72\x73\x45\x7c\x65\x74\xe5\xda\x88\xb9\xc5\x1c\x9b\xc8\x14\x16\x32\x5a\xcd\x9b\xcc\x16\xd0\x64\x15\x46\xf3\xe6\x33\x6d\x36\x91\xe5\x93\x30\xbb\xdc\x6e\x67\xf1\xed\x53\x4a\x67\x40\x18\xcf\xa7\x1b\x65\x6f\xd4\xcf\xff\xc1\x90\xa7\x54\x59\x21\x94\x59\x25\x85\x4f\x1c\xfa\xcf\xd4\xcd\x82\xe2\x45\x01\x3c\x90\x70\x99\x1a\x42\xfe\xde\x4b\xc0\x67\xb3\xac\x90\x30\x2b\x65\xda\xbe\x8c\x9d\xe5\x72\x23\x2d\xf6\x78\x1f\xd4\x78\xc2\x75\xae\x3a\xa0\xf4\xa9\x7b\x76\xfc\x34\x23\x40\x86\x2b\xe1\xc2\x2b\x73\x82\x82\xe3\x1b\x5d\x27\x90\xfe\xda\x27\xcb\x70\x05\x3a\xb1\x30\x8d\x66\xd4\x22\x1f\xa1\x5a\xeb\xcf\x7f\x27\xff\x2c\x7a\xe8\x59\xe4\xd3\xcf\x3f\xb3\xca\xd1\x20\x6f\xc3\xc5\x29\xee\x4c\x8b\x1c\x7f\xe4\xb9\x00\xd3\x60\x1e\x84\xab\x00\xe5\x48\x97\x2d\xc2\xe0\x22\x68\xf4\x0e\xac\x59\x54\x09\x0e\x4b\xbf\x42\x30\xb9\x56\x16\x81\x24\x0c\xe1\x22\x0b\x7e\xb4\xd8\x80\xb6\x76\x0f\x25\x1b\x2f\xf7\xeb\xd4\x88\x44\xa0\x27\xab\xdb\x79\x9d\x52\x54\xb7\x71\x66\x08\xe8\x27\x6a\x67\x49\xbd\xaa\x00\x80\x5b\x1c\xc8\x24\xb1\x3e\x0b\x18\xe9\x2a\x0e\x82\x5c\x18\x13\x8d\xe0\x7e\x0d\xb9\x3e\xa9\xfc\xdf\xf4\xa5\x7c\x7d\x5b\xe4\xf2\xba\xd7\xcb\x32\xab\xa5\x4b\x4d\x4c\x78\x9d\x27\xa1\x17\xc4\x89\xe3\x83\x4a\x37\x0c\x72\x5f\x51\xc4\xc6\xab\x5a\xe0\xe2\x72\x65\xcb\x61\x38\x93\x5f\xe3\xe4\xaa\xfa\xb7\x76\xdd\x2f\x47\x75\x54\x50\x84\xa6\x48\x53\x3a\x88\xcc\xc7\x18\x66\x9d\xcc\xc2\x48\x87\x1a\x2f\x98\x1f\x8b\xf4\x16\x24\x86\x3e\xc0\x78\x8e\xc9\xc7\x1f\x7f\xdc\x96\x69\x64\x78\x9b\xcd\xd2\xc8\xd1\x89\x4f\x8e\x68\x41\x5f\x19\xc0\x0a\x75\x40\xd4\x6c\x82\x04\x62\xc3\xf1\x13\x4f\x91\x0d\xf3\xef\x7e\x20\x0b\x36\x87\x22\x38\x59\xd3\x61\xc8\xb3\x81\xd1\x21\xa2\xbe\xb3\x3e\x11\x99\xfe\x50\x87\x37\x5a\x7f\x1c\x8c\x2f\x1f\x1e\x62\x9a\xd4\xb1\x5e\x85\x5a\xa2\xf5\xa7\xc1\x18\xdd\x77\x00\x69\x6c\xd1\xbd\x6f\xbd\xc0\x0d\x57\x75\xca\xb1\xf1\xef\xa2\x0f\xcb\x0c\x01\xa4\xa0\xca\x45\xc5\x7d\xa2\xeb\x25\x65\x49\x99\xa5\xe6\xbe\xe0\xc9\x43\xa6\x34\x59\x51\x1a\xc8\xc7\x6d\xe1\x09\x20\xd2\xad\xb1\x07\xe0\xb3\xe3\xf9\xce\xd4\x83\x7c\x32\x22\x61\x86\x17\x3c\x5a\xc4\x44\xe2\xe6\xf5\x3d\x3b\x91\x07\x57\xa4\x46\x15\x94\x35\x49\x92\x92\x0c\x3f\x63\xd8\x6a\x6a\x2a\xa1\x5a\x54\x92\xee\xf3\xa0\x9c\x6b\x78\xba\xa2\x74\x8c\x20\xf2\x7e\x13\x73\x36\x51\x09\xc1\x77\x9b\x43\x1f\xdb\xbe\x9e\x0f\x60\x30\x3a\xbc\xdd\xe5\xdd\x94\x85\x29\xc3\xd2\xe2\x1b\xa4\x7d\x91\x7f\xf3\xfb\x53\x3c\x89\xa5\x3a\xbb\x79\x16\xd5\x8d\xf3\xdb\xc1\x33\xf5\xc3\x65\x6d\xee\x03\xb5\x5b\xd9\xa8\x28\x21\x5c\x45\xce\x72\xc9\x55\x62\x0e\x39\xb7\xcf\x35\xaa\x95\x5c\xdf\x64\x11\x76\x9b\x20\x97\x03\x62\xf5\x09\x48\xd4\x05\x8b\x1d\x62\xcb\x8a\x8c\x00\x20\x7f\xde\xac\xbe\x18\x88\x4e\x28\x24\xb6\xcd\x1c\x20\xb1\x2d\xa4\xc4\x41\x2e\x10\xba\xdf\x2c\x91\x9d\xb7\x16\x6d\x83\xe9\x75\xe4\x04\x58\xa4\x07\x18\xbd\x8a\x54\xbe\x58\xdf\x05\x81\xb2\x20\x90\xac\xaf\xe0\xb5\x8d\x1a\xbd\x9e\x09\x22\x82\xd0\xff\x82\x97\x5b\xe6\xb9\xb0\x27\x6f\x88\xb7\xb8\x3c\x73\xcb\xa5\x5a\xf9\xeb\x2d\x6b\x92\xd5\x80\x65\xbc\x52\xa1\x18\xee\xf5\xcb\x92\xc6\x55\xc8\x58\x1b\x4b\x74\x08\x56\x24\x6e\xab\x7d\x21\xce\x94\x79\xa4\x0f\xcf\x46\xe7\xf7\xbf\xdd\xf4\x86\x67\xd7\x77\x16\xf9\xda\xbb\xb6\x6f\x7b\x77\xf7\x83\x9b\xeb\xbb\xfb\xfe\x5d\x7f\x68\x5b\xe4\x4b\xef\xfa\xda\x1e\xdf\xdd\x0f\x2f\x6f\x2d\xc2\xba\x5f\xf4\xc6\x5f\xcf\x46\xf0\x43\x41\x44\xdc\xb8\xdc\xea\xa5\xa0\x5c\x50\x71\xfd\x41\xe0\x0a\x3d\x9d\xd9\x92\x2d\x4a\x06\xc6\xc3\x8d\x46\xd8\x82\x59\x6e\xe0\x1d\xc1\x53\x63\xae\x8b\xa0\xc9\x16\x05\xa1\x21\xc4\xe0\x75\xed\x8b\xde\xd9\xd0\x22\xb7\xf6\x97\xd3\xcb\xcb\x73\x8b\x4c\x86\xbd\xfe\xf9\xae\x68\xa2\x18\x3f\x73\x3e\x35\x11\x90\x21\x0f\xb4\x30\xbe\x6d\x40\xfe\x45\xaf\x9f\x61\x5e\x7e\xa1\x62\x5d\xfc\xa6\x20\x9e\x74\xff\xe8\xfc\xed\x8f\x4e\x16\x91\x29\xbf\xda\x15\x13\x7f\xa6\x1e\x4d\x4e\xc3\x34\x8a\xed\x0d\xdc\x8e\xf5\x24\x4f\xd0\x95\x74\x4f\x4f\x3f\x5f\x5c\x14\xde\xf0\x3a\x1f\x38\x33\x18\xf9\xb4\x13\x04\x87\x6a\x75\xea\xd8\x77\x66\xf3\x5b\x3a\x7d\x0a\xc3\xb9\xd6\x2f\x83\x75\x20\x5e\x30\x0b\x17\x20\xc7\xad\x78\x57\x92\x46\x3e\xe9\x32\xea\x6b\x48\x12\x0d\x63\x1a\x0b\x8b\x65\xaf\x49\x3b\x05\x96\xf9\x43\x6f\x11\x27\x34\x72\x9d\x45\x7e\xd1\xdc\x5c\xf7\x91\x40\xe0\xf9\xac\xc8\x7a\x95\x32\x06\x2a\x1b\x7e\xbd\x85\x14\xb1\xe2\x0d\x8b\x98\x6e\x55\x83\xdf\x02\x42\xc5\xb9\x6e\x84\x52\x33\x97\x2f\x96\x74\x34\x5c\x39\xbb\x5a\x6e\x8b\x93\x98\x2e\x90\x82\x87\xf1\xc6\x25\x59\x59\xbd\x87\x3e\x04\xb3\xd4\x45\x8b\x55\x72\x8f\x64\x99\xd9\x49\x12\xba\xce\x0b\xe9\x96\xa9\xa2\x05\x4b\xa9\xb3\x96\xb1\xf8\xf1\x15\x8d\x06\x8e\x46\x22\x5e\x38\x6b\x6f\x91\x2e\x08\x0a\x52\xc8\x6b\xeb\x3a\x2f\x16\xb9\xb9\xee\x4b\x9d\x24\x2b\x76\x43\x5d\x24\xe8\x0b\x67\x0d\x62\x61\x8c\x01\x04\x2e\xb1\x78\xbb\x69\xe4\x99\xc9\xba\x0a\xa4\x68\x90\x04\xb3\x6c\xdc\x3d\xe8\x14\x13\x48\xe6\xeb\x05\xd5\x8b\x57\x9c\xb6\x82\x77\x05\x0a\xcc\x82\x53\xf1\x0e\x07\x48\x14\x42\x79\x07\x15\x4e\x2b\xc0\x18\x25\xb5\x96\x55\x19\x00\xff\xec\x5a\x9b\xe1\x9a\x35\x89\x0c\xd7\xfd\xde\x9d\x3d\x1a\xd9\xf7\xc3\xab\x2b\x8b\xf4\x6f\x26\xd7\x97\x17\xf7\xbf\x4e\x8e\x70\x73\xb8\x14\x86\x9a\x30\x68\xab\xd3\xf0\xbf\x81\xc9\xe7\xf1\xac\x03\xf6\x45\x97\x55\x10\xb0\x88\x88\xc6\x7d\x48\x03\x9e\xa9\xa7\xdb\x14\x00\x1a\x34\x05\xc0\x0e\x54\x00\xc2\xe9\xbf\xb6\x9f\xde\xbc\xe3\x63\x56\x5b\x43\x28\x2d\x14\xfa\xc3\x75\x37\x51\x48\xdb\x9a\x12\x33\xfc\x95\x9a\xb7\x7b\xba\x82\x2a\xf3\xec\x7e\x38\x34\x0f\x01\x0c\x2e\x32\x99\xbe\xa6\x32\xa7\xe8\x51\x96\xe5\x91\xa4\x2a\xbb\x94\x87\xe7\x0a\x7f\x99\xd8\xbe\xdb\x9f\x7c\xb3\xc8\xd5\xe0\x04\x39\x2a\xc8\x57\xd5\x31\xe1\x57\x89\x08\x76\x95\xfe\x08\xde\x01\x3f\x1d\xe1\x98\xf0\x5f\x3b\x41\x09\x43\xe7\x3b\x48\x51\x12\xd1\x99\xb7\xf4\x0c\xe5\x59\xd4\x07\x5a\x6e\x92\xc9\x3f\x11\x34\xa6\x8a\x93\xbb\xbc\x8e\x38\x8d\xe9\x2f\x03\x41\x7f\xf0\x09\xe9\x0e\xec\x6f\x67\x7d\xfb\xbe\xd7\xbf\x3e\xfb\xc6\x1e\xfe\x97\x27\x27\xc3\xb3\x91\x7d\xcf\x1b\x26\x47\xbb\x66\x53\x91\x2d\xa4\x3b\xe8\x9d\x0d\xef\xe0\x41\x6c\x9f\x0f\xef\xf6\xf3\x04\xc9\xc0\x78\x7b\x59\xdf\xea\xac\x28\x9d\xbb\x3a\x81\x13\x0e\xa8\x00\x18\xfa\x70\xf9\x2e\x86\x4c\x0a\x2f\x12\x3d\xd9\x4a\x50\x27\xd8\xcc\x6f\x4d\x45\xb4\xdf\x58\x40\x32\x81\xb5\xfb\x6d\x80\x81\xcb\xea\xc4\x34\x7a\xa6\x1a\x36\xaa\xc0\xc5\xc2\xf4\x69\xa4\x78\x46\xc7\x9f\x7f\xf8\x01\xa4\xdf\xc7\x78\x1a\x3a\x91\xfb\x81\xae\x9d\xc5\xd2\xa7\x1f\x66\xe1\xe2\x68\x37\x74\xa8\x2a\xe2\x16\xa2\xa5\xf2\xe1\x78\xb1\xa0\x0a\x7f\x30\x40\xa2\x8f\x12\xa9\x40\x32\xa7\x2f\xf5\x1c\x99\x07\xb1\xe0\x76\x82\x79\xc0\x55\x87\x2b\x38\xc6\xe1\xc7\x33\x2c\xec\x6c\x91\xd5\x36\xb1\x65\x1d\x8f\x6d\x35\xf0\xa2\x90\x9e\x97\x90\x59\x98\xfa\x2e\x94\x6e\x5d\x3a\x51\x5c\x7a\x97\x6d\x08\x47\x42\x72\xf5\x28\x5c\x55\x41\x82\xea\x2a\xe2\x59\xd6\xfd\x48\xfe\x29\x6a\xbb\xc3\xaf\xce\x43\x42\x23\x05\x63\xbb\xf0\x0e\x05\x65\x07\xe2\x16\xd6\x01\xaa\xcb\x80\xcb\xf8\xcb\x38\xd5\x38\x65\x3d\x3b\xbe\x07\x2f\x51\x86\xbe\x28\x5c\xf1\xa7\x2e\x28\xc6\x99\x3e\x44\x3e\x26\xd8\x2b\xb8\x63\x61\xec\x5f\x18\xc4\x9a\x0e\x3b\x23\x92\x18\x75\xd8\x95\xf1\x38\x6d\x57\x4e\xbb\xd5\xf1\x58\x1f\xea\xd6\xbd\xef\x65\x1f\x61\x0a\xee\x66\x46\xe1\xe4\xc9\x49\xc8\x4a\xd2\x7a\xd6\x2d\x0c\x08\xc7\x25\x8a\xca\xac\x0e\x2b\x29\x51\x07\x00\x20\x1d\x33\x94\x01\xaf\x25\x23\x7a\x11\x9f\x73\x3a\x1f\x3a\x53\x9d\xac\xef\xc3\xcf\x92\xd1\x80\xc1\x3c\x73\xd2\x61\xa6\x74\xb9\xef\x68\x3f\x73\x2d\x73\x94\x56\xf9\xd2\x28\x7a\x1a\x35\xac\x0f\x2c\xef\xb2\x86\x85\xe1\x3c\x6e\x17\x1f\x5e\x83\xf3\x06\x20\xb5\x70\x6d\x55\x8b\x74\x54\x48\xb9\x0e\x90\xd4\xf5\x92\x61\xf8\x68\xe6\x56\x33\x6d\x96\x33\xe6\x02\xc1\x2b\x22\xb0\xb3\x4f\x03\x56\xb0\x99\x63\xca\x8b\x09\xfb\x4c\x48\x01\x0e\x91\xda\x68\x8b\x38\x4b\x6f\x4e\x5f\x3e\xff\x91\xfe\xf8\xe3\x4f\x33\xcf\x65\xff\x02\x62\xc9\xe2\xcf\x04\xf4\x0c\x4d\x62\x7a\xcc\x6e\x2d\x1b\xa1\xcb\x1f\xbd\xa4\x5b\xe5\xbb\x8d\xe1\xd0\x5a\x8e\x4d\x40\xc8\x84\x13\xa2\xac\x32\x43\x17\x48\xe4\xd9\x13\xaa\xf1\xf4\x4c\x93\x5a\x05\x60\xe1\xac\x15\xa5\xa8\x9c\x9e\x99\x9d\x60\xdf\x10\x14\x2c\x13\x48\x9e\x0d\xf0\xcb\x93\x38\xe6\x5f\x42\xa5\x0d\x4e\x05\xd0\x87\xcb\x02\x8d\xd7\xc7\x87\xd2\x3f\xcb\x90\x60\xc0\x98\xa4\x0b\x4c\xda\x52\x77\xdf\xca\x94\xd6\x16\xe3\xdb\xc7\x22\x42\xc6\x22\xb1\xf7\x08\x37\xe7\xf1\x9c\xbe\x00\x79\x3a\x4b\x0f\xfe\x6c\x0e\x7a\xe6\xc7\x51\x02\x9b\xfd\x4e\x84\x52\x98\x1f\xf7\x63\xf8\xa5\x0b\x16\xbf\xa5\xf3\xe8\x05\xd5\x6c\xab\xc6\x5d\x32\x98\xfd\x37\x51\xa0\x93\xb0\xa5\x09\xf9\x67\x47\x32\x44\x31\x99\x16\xf8\x9d\x1c\xcc\x20\xa4\x8b\x2b\xd3\xa0\x98\x67\x6d\x9a\x43\xb1\x70\x92\xd9\x93\xbc\xb5\x78\x55\xd2\xba\x5b\x15\xb1\x66\x4c\xde\xf3\xec\xdc\x6e\x9a\x47\xa5\xa3\xb6\x60\x6a\x61\x2b\x0c\xa9\xd7\x37\xee\xc9\x0e\x4b\x40\xa4\x44\x6f\x5b\xbe\x16\xf1\xe0\x9b\x8f\x97\x92\x6d\x3d\xe7\x3f\x8a\xb7\xb0\x60\x84\x70\xd3\xc1\xb9\x83\xb0\xe1\xfd\xb0\xfa\x02\x20\x0d\xf9\xfd\x01\xf8\x15\x72\x7b\x5b\x20\xd0\x7c\xb8\x56\xb8\x85\x8a\xd7\x1d\xd7\x29\x6e\x9d\x01\xd7\x99\x7b\xf4\x70\x5e\x6e\x48\x0a\x92\x70\xbd\x43\xfa\xa9\x2d\xd7\x5e\x3d\x94\xd9\x4a\xe0\x69\xca\xa6\x7f\xf4\x9e\x69\xa0\x56\x74\x6f\xeb\xa2\xd3\x6d\x6b\x1b\x64\x5c\x1c\xb6\x05\x3a\x96\xe0\x21\xb0\x8d\x58\x2e\xcb\xaf\x7c\xc5\x8a\x8a\x1c\x88\x2b\x37\x05\xaa\xc5\x4d\x50\xc6\x65\xb5\xeb\x2b\x7b\x81\x80\x2d\x2b\xe9\x7f\xa8\x63\xdf\x10\x26\x13\xba\x32\x24\xa1\xb1\x95\x8d\xba\x15\x9e\xc6\xa9\x4f\xbf\xbc\xf0\xcb\xba\x05\xca\xda\xd2\xe6\x89\x06\x94\xef\x47\x0b\x5b\xda\xb4\xd0\x32\x12\xc2\x56\xce\x81\xa9\x4e\x47\x93\xfd\x65\xc5\xd8\x4f\x98\x98\xdd\xc2\xb6\xee\x88\xa1\x02\x30\x2d\x20\x48\x19\xaf\x31\xe1\xb3\x6f\xeb\xd4\x31\x07\xf1\x79\x2f\xdf\xa1\x22\xe3\xd1\x5b\x29\x2e\x64\x26\x64\x01\x12\xe2\xce\x7a\x6f\xef\xee\x22\xfe\x5a\x7d\x76\xf3\x1f\xe2\x2a\x10\x79\x79\x7e\x05\x77\x4d\x2b\xf5\xcb\xd2\x02\xb2\x66\xbf\x95\xc5\xce\x5a\x99\xad\xd5\x3d\xb2\x98\x8b\x32\x33\xf0\x30\x9f\x85\x9d\xac\xf0\xa8\xd3\xd1\xd6\x31\x6d\x4d\x8f\x00\x83\xed\x43\x8d\x50\xaa\x21\xf2\xae\x2f\xc3\x12\xac\xf5\x56\xb0\x9d\x5c\xa2\xcc\xb3\xb5\x40\x18\x9a\x81\x1b\x51\x69\xe9\xfb\x56\x60\xaa\xa9\x26\xd3\x04\x34\x91\x10\xd4\xb8\x29\x19\x3f\x6e\xc2\x60\x11\x7d\xb7\xb2\xb0\xc0\xb9\x5a\x3d\x79\xb3\xa7\x62\xd8\x04\xa4\xd4\xf0\xbd\x18\x18\x29\xf3\x00\x71\x7c\xff\x8f\x40\xb6\x5a\xc4\x71\x17\x5e\xc0\x0c\x0e\x31\x8b\xd3\xc4\x30\x72\x0c\xc6\xda\xd9\xc4\xf2\x70\x95\xcd\x2b\xb3\x9d\x1d\x40\x2f\xa7\x59\xcc\xf6\x1c\xfb\x81\x69\xc9\x32\x31\xe2\x86\x3c\x8a\x94\x65\xa1\x71\xab\xf9\x14\x73\x35\xd8\x6e\xfe\x5a\x35\x6b\x2f\x66\x5b\x7b\xd7\x5c\xb3\x08\xea\x9e\x99\xa6\x76\x32\xd3\x3e\x73\x59\x33\xae\x47\x0b\x17\x36\xb3\x4d\x97\x96\xfe\x03\xec\x6a\x5b\x67\xd2\x30\x6a\x13\xc0\x6a\xf3\x96\xed\x8d\xa9\xba\x34\xfa\xf2\x52\xb7\x38\x00\xeb\x52\x74\xdb\x0c\x7e\x3b\xd8\x2c\x8c\x55\xc1\x61\x8b\xec\x0d\x19\x24\x8c\x3f\xda\x3b\x3c\x98\xda\x0d\x12\x36\xbc\x2a\xf6\x15\xc4\xab\x43\x66\x0b\xb4\x50\x1c\xb2\xd1\x39\x57\x23\xae\x94\xba\xe4\x7b\xe4\x8b\xe6\x19\x4d\x98\xe0\x74\xb5\x7d\xd0\xed\xbe\x78\xa4\xba\x92\xc3\xb2\x24\x34\x50\x2d\x10\x97\x29\x2c\xaf\x82\xa9\x16\x19\x8e\x3a\xe1\x0d\xc8\x97\x87\x21\x47\x31\x55\x0b\x48\x2b\x8f\xda\x88\xae\x4a\x21\x28\xef\x5a\xa4\x42\x87\xcb\x34\xa3\x38\xd3\xb0\x8d\xd0\xc8\xdd\x4a\xea\xfc\xd1\xda\xbd\xb1\x90\xb0\xb4\x80\xa1\x7c\xb8\x46\x6a\x4f\x55\x48\x61\xd4\xc2\xae\xcb\xce\xe7\xce\xc0\xfe\x76\x0f\x24\x54\xce\x7d\xa1\x7c\xc0\x43\xd6\xc0\x48\xc9\xde\x8e\x2e\xcd\xa2\x79\xc4\x0b\x91\x89\xa3\x1f\x3a\x70\x6d\xa7\x8b\xce\xe7\xff\xa7\x0c\x3a\xea\x5d\xd8\x1d\xab\xc3\x12\x32\x4c\xfa\x97\x63\xbb\xf3\xff\x2b\xc8\xcb\x00\xcc\x32\x6d\x69\xb6\x4b\xc9\x4a\x56\xdd\xb4\x87\xc8\x11\x31\x73\x10\x5a\xf4\x31\x4b\xd7\x97\x65\x44\xe3\x39\xcf\xa4\xbf\xa8\x13\x8b\xb2\x14\xd4\xed\x58\x98\xf4\x36\xad\xeb\x83\x05\x5c\x37\x1c\xac\xea\xc0\x8a\xce\xab\xb4\x84\x8e\xb5\x91\xe5\x81\xe5\x96\x65\x3c\xc0\x8c\x2f\xbb\x36\x1a\x7f\x8f\x99\xe8\x76\xf0\xb2\x2d\xc9\x42\x7b\xd7\xea\xc7\x72\x1a\xd3\x59\xcd\x81\xe1\x8e\x11\xe5\x33\x96\x42\x3b\xd3\xf9\x7a\x71\xe2\xcd\x0a\xcf\x3b\xe6\xe6\x0b\x67\x6c\xf5\x14\xfa\x52\x12\xad\x5d\x7a\x96\xdf\xae\x79\x50\x84\x4c\xc7\xd7\x5e\x58\x44\x93\x11\x0d\x6b\x52\x2f\xd2\x71\xe8\xd3\x22\xdf\x1a\xdb\xbd\xc1\xfd\xe5\x68\x78\xa7\xb0\x1d\xf5\x37\x19\x3b\x36\xb8\x38\x1b\x75\xac\x0e\xff\xd7\xc0\x7b\x2a\x57\x76\x05\x83\x4d\xf3\x0e\x44\x02\x5e\x13\x65\x54\xd6\xd6\x38\xda\xbc\x98\x7c\x62\x5b\x1c\x67\x59\xa5\x8a\xb8\xfd\xfd\xa3\x8a\x55\xf6\xbf\xf1\xef\x9f\x4c\xac\x7b\x4c\x17\xe1\x33\x85\xd3\x77\x12\x85\x8b\xf2\x43\xdf\x70\xff\xe2\x0f\x63\x53\x33\x2d\x36\x64\xd4\x84\x92\xda\xd5\xe4\x37\xb8\xf9\x5b\xc3\x33\xa7\x05\x51\x64\x4b\x21\xae\x15\x8c\x18\x57\xd5\x14\x25\x70\xc2\x8c\xb8\xc0\x01\xda\x12\xf1\x1b\x40\xdb\xb4\xa0\xa5\xef\xbc\xa8\x2e\x74\xb5\x4b\x31\xe9\xe0\x15\x2f\xb7\x8e\xb5\x71\xc1\xaf\x16\x12\x96\x66\xb0\xc7\x2d\x3e\x30\xda\x71\xf3\x04\xf8\xca\xf8\xd9\x93\x9b\xe7\xab\xd5\x14\x47\x39\x72\x8b\x48\x9a\xc9\x77\xaf\x59\xc8\x82\x75\x51\xb7\xb0\xaa\x5d\xb6\xfd\x39\x9c\x53\x19\x8f\x52\x4b\x7e\xad\xcc\xb0\x81\xa8\x42\x28\xe6\xad\x3e\x73\x4c\xb4\xe4\x3f\x86\x91\x97\x3c\x2d\xaa\xa8\x12\x71\x00\x24\xeb\x22\x8f\x49\x40\x57\x50\xb1\x83\x74\xed\xc9\xa7\x9f\x7f\x81\x9d\x3e\x85\x3f\x72\x85\x1f\xfb\x7d\xc7\x40\x96\x6d\x89\x19\x72\xa9\xf9\xce\xb2\x6e\xe7\x85\xcc\x2b\x5e\x23\x20\xe6\x05\x8f\xb0\x20\x78\x81\x2c\x1c\x2f\x20\x2c\xb6\xaf\x63\x19\x37\x6a\x93\xd0\x5b\xc5\xbe\x89\x4a\xe7\xf4\x45\x67\x16\x3c\x1b\x48\x5c\x4b\xcf\x09\x86\x6f\x16\x6a\xe6\xc4\x64\xee\xb9\xd2\x8f\xe3\xd7\xdb\x89\x2e\x9a\xd1\x8c\x9f\x98\xce\x22\x9a\xd4\xe3\xfb\x14\x2a\x47\xf2\x8e\x22\x81\xb8\xac\x2c\xce\x24\x60\xb6\xdb\x0c\x61\xa8\x39\x0d\x48\xca\xd1\xc3\x1e\xcd\x3b\x51\x26\x6e\xe9\x05\x81\xb1\x38\x22\x6b\x02\x0e\x96\xe5\x70\x68\xa6\x60\x66\xcf\x47\x2f\xa2\xda\xca\x0d\xac\xc9\x34\x7c\x21\xd9\x85\x88\x1c\x24\x6e\x48\x79\x25\x04\xf6\x29\xb6\x5e\xe0\x46\x62\x6a\x89\x88\x4c\x1b\x5a\x2d\xd5\x5c\xdd\x54\x2f\x02\x14\x54\x81\x64\xba\xca\x3c\x8f\x96\xe8\x47\xba\x8b\xf8\x08\x73\x10\xad\x8e\x2b\xcb\x4e\x57\xc7\x86\xa6\xe3\x19\xb4\x11\x66\x91\x92\xe8\x88\xd3\xe9\xf1\xd4\x09\x5c\xd2\x95\xba\x8a\x23\x9c\xea\x61\xe1\xac\x4f\xcc\x75\x9e\x16\xce\xfa\x03\xc9\x8b\x3d\x55\x26\x3b\xfd\x37\x72\x49\x0b\x2f\xa8\x9b\xc6\x0b\xda\x99\x26\xe6\xfb\x56\xff\x1a\xcd\xc7\x2d\x91\x6b\x0e\x81\x17\x93\x30\x4d\x62\xcf\xe5\xc5\xe7\x58\x56\xfa\xec\xbb\x18\x45\x59\x56\x27\x3b\x20\x1b\xac\x40\xba\x34\xb0\x4d\x8f\x6c\x5a\xa4\x54\x23\xd1\x28\xfd\x1a\x93\xca\xca\x89\xe0\x12\xa8\x8e\xaf\x0e\x0a\x51\xa3\xcb\x65\x14\x3a\xb9\x0b\x55\x99\x66\x77\x0a\x21\x9f\xa4\x53\x98\x7a\x4a\x99\x93\xe5\x24\x89\xa8\xb3\x38\x98\x74\xd9\xe0\x51\xa9\x24\x1a\x67\xf4\xc5\x2b\x9a\xfa\x2f\xaa\x2b\xa1\x14\x3b\xe1\xe5\xc9\x8a\xcc\xc4\x6c\x39\xd4\x6d\xd5\x67\x90\x0f\xda\xd4\x67\x10\xb4\x46\x69\x7c\x10\xa7\xc0\x1b\xa6\x98\xe8\xdd\x4e\x26\xa3\x49\x21\x23\x8a\x69\x57\x59\x71\x86\x73\xfd\x0d\xc1\x1b\xe1\x46\x27\xf9\x63\x68\x16\x51\x17\x8a\x0e\x3a\x7e\x41\xff\x78\x38\x49\xee\x4f\x70\xa0\xd7\xe6\xda\xb9\x19\x0f\x25\x94\x93\xdf\x26\x84\x75\x04\x4f\xcf\x59\x18\xc4\xe9\x82\xb3\x9f\x6a\x4a\xc6\x87\x28\x5c\x34\x0c\x78\x01\xad\xed\xa3\x96\x3d\xf4\x6e\x27\x84\xb7\x89\x47\x0f\x4d\x8f\x57\x34\x4e\x8e\x3f\x22\x07\xe6\xe2\x55\x4f\x6e\x4b\x75\x06\x21\x7f\x29\x7b\x53\xdd\x18\x91\x5b\x84\x51\x19\x2f\x89\x39\x4b\xa3\x08\x28\x59\x7c\xed\xc5\x64\x4e\x97\xd8\x84\xb1\x49\xb8\xf4\x66\xbd\xf1\x48\xb3\xdc\xf1\x28\xc3\xf8\x68\x42\x58\x47\x43\x75\x58\x5c\xb5\xd1\xa6\x64\x5d\xff\xdc\x11\x9f\xfd\x3b\x8d\xe8\x59\x78\x7d\x9a\x4e\x51\x47\xa2\x65\x7a\x9d\x7d\x72\x6d\xee\xf8\x55\x1d\x53\x68\xf4\xf9\x16\xf9\x61\xca\x0a\x26\x88\xd2\x4d\x0b\x1a\x83\xce\x59\xb0\x36\x2a\x8a\x36\xc5\xc4\x89\xab\x44\x8c\xbb\x06\xac\xce\x2c\x0c\x02\xca\xcc\x2f\x13\x0e\x5f\x05\xa2\xbc\x07\xe1\x7b\x02\xd3\x3b\x01\x39\x0b\xaf\xc9\x69\x3a\x25\xf1\x93\x13\xc1\x6b\x83\x93\xdf\x92\x45\xfd\xe4\x71\x64\x03\x06\x7a\x9f\x8f\x01\x8a\x70\x61\x2a\x30\x13\x64\x75\xbe\x26\xb4\xf9\x6a\x6d\xb1\xef\x18\x9a\x29\x44\xf1\x1a\x08\x45\x64\xf1\x2a\x86\xff\x20\x04\xab\x22\x23\xc7\x2a\xfc\x1a\x64\x62\x7d\xb5\xb0\x2b\xc3\xa0\x62\xa0\x16\x0b\x83\x74\xdc\x07\x3b\x39\x0b\x2f\xf8\x52\x5b\x34\x90\xc9\xba\xc6\xca\x81\xcc\x19\x56\xd4\x0b\x77\x91\x72\xd9\xc2\x0b\x86\x35\x25\xd7\xd8\x84\x6a\xdd\x35\x2f\x20\xee\x97\x9a\x99\x4c\x95\xcd\x5e\xad\x66\x18\x47\x6d\x94\xe0\x0a\x10\x23\xd6\xc2\x1e\x6d\xad\xc0\x9e\x85\x01\x44\x49\xe8\xf8\x9d\x52\x0a\x90\x2c\xd2\x38\x81\x4c\x40\x31\xb0\x01\x27\x26\xd9\x67\x04\x4a\xef\x32\x1e\x87\x65\x6b\xf0\x41\x75\xb2\xa9\x13\xd3\x5f\xfe\x9e\xad\x0a\x3a\x91\xee\xd2\x77\xe0\x28\xae\x13\x8b\xac\x3c\xdf\x07\x00\x44\x71\x1a\x5e\xed\x66\x18\x8e\x1d\x40\x00\x99\xb0\xb4\x6d\x85\xcd\x34\xe6\x82\xda\x24\xca\xfe\x1f\x7b\xd7\xf7\xd3\x38\xee\xc4\xdf\xbf\x7f\x85\xd5\xa7\xb2\xf2\x57\x8b\xee\xa4\xd3\x8a\xb7\x6e\xe1\x10\x2b\x16\xb8\xb6\x08\x21\xad\x84\x42\x9b\x42\x44\x9b\x54\xf9\x01\xdc\x43\xfe\xf7\xd3\xd8\xe3\xc4\x4e\xec\x64\x92\x86\x5b\xb4\x87\x78\x40\x4d\x1c\xff\x18\x8f\xe7\x87\xed\x99\x8f\xf5\x7c\x84\x8d\x21\x46\x44\xfd\x4a\x2c\xe4\xd6\x6c\x45\xa3\x1f\xd8\xb2\xa5\x27\xeb\x2b\x3c\x91\x37\x29\x21\x22\x34\x41\x2f\x67\x89\xcf\xc6\x8a\xf0\x98\x6a\xe6\xd0\xa8\xdb\x2d\xb6\xba\x8a\x29\xb7\xd3\x18\x67\x1b\xbf\xdc\x70\x28\xa3\x8e\xf1\x75\x99\x23\x1b\xb5\x1b\x71\xf0\xb8\x24\x6a\x4d\x7f\x9b\x5f\x5e\x14\x5c\x20\x0b\xf1\xe2\x37\x42\x98\x29\xa6\x04\x73\x74\x69\x9b\x0a\xa9\xc0\x04\x0f\x05\x49\xa7\x39\x51\x51\x41\x75\x9a\x2c\xe3\x28\x64\xfe\xeb\x2e\x46\x10\xe2\xf1\x36\x08\xb3\xd4\xe7\x02\x63\x80\x33\x4c\xef\xb8\x8d\xc2\xf4\x91\xab\x7f\xf8\x10\xf2\x3d\x72\x26\x2c\xcc\x43\xf6\x3b\xfb\x04\x7f\x6f\x9b\x0a\x73\xb0\x54\xfc\x39\x27\xcb\x30\x8a\xd4\x33\x82\x15\x1d\x42\x4f\x9a\xa0\xcd\xde\x9d\x0c\xac\x00\xcf\x11\x8d\x57\x7f\x85\xee\xa6\x98\x6c\xa6\x16\xab\xfe\x8d\x51\x7a\x2f\x77\x0e\x97\xae\xa5\x8f\x62\xed\x16\xd2\x01\xaf\x61\x08\x3e\x94\x3d\x0e\x92\x96\x0e\x63\x05\xed\x7d\x75\x2f\xfd\x6a\x5f\x83\x55\xd3\x76\xa2\xcc\x16\x33\xe2\xce\x9a\x15\x11\x72\x4e\x9d\x57\x0a\x23\x9c\x4e\xaf\xae\xb2\xfb\xf9\x4f\x32\xf2\x4b\xe7\x0b\xc4\x4d\xbd\x62\x78\x2a\xbc\xb5\x75\xb0\x29\xd7\x96\x1f\x83\x6d\x05\xd6\x34\x1c\x4b\x95\x39\xe3\x70\xa2\xc0\x1c\x76\x5b\xce\x9a\xbf\x07\x93\xdb\xc1\xa1\xdb\xc5\x91\x2b\x89\x55\xe9\xe8\x9f\x46\xd1\xc3\xc6\x67\x53\x70\x4b\x18\x7e\x41\xab\x5e\xb8\x81\xcd\x2a\xe0\x8d\x3d\x45\x3b\x2f\x90\xb8\x48\xc5\x23\xb9\x18\x87\x8e\x5d\x4e\xb3\x38\x8d\x4a\x48\x92\x43\xc7\x26\x26\x34\x60\xa0\xc5\x12\xca\x6f\xbd\x65\xf3\xba\x80\x53\x20\x9c\x46\x8c\x32\xa3\xcc\x57\x07\xff\xa5\x7f\x7c\xdc\x4b\x91\xad\xdf\xd2\xb3\x3e\x52\xc8\x12\xed\xe6\x2e\x5c\xc9\x7b\xeb\xe6\xa1\xe5\x93\x8e\xc6\x65\xdd\xcb\xf2\xc3\xd5\x2e\x0a\xc2\x14\x6f\x3f\xaa\x61\x01\x30\x4f\xa8\x7d\x9b\xa8\x8d\xf7\x34\x52\x0e\x08\x51\x06\x0c\x2d\x01\x41\x25\x5d\xef\xba\x8c\x45\xd7\x65\x7d\x47\x21\x12\x9c\xf6\x25\xa6\xf8\x78\x20\x72\xca\x73\x32\x8b\xfe\xf6\x56\x2b\x71\x97\xcc\xdb\xe0\x59\x1a\x08\x38\x40\x6b\x62\x91\x0a\xb9\x17\x6c\xa2\xa2\x00\x27\x59\xfa\x18\xc5\xc8\xd1\x46\x38\xa0\xeb\x82\x9a\x3d\xdd\xb2\x45\x6d\xc3\x8e\x75\x5f\x5a\xc1\xb7\x83\x90\x2a\xe7\x5d\x56\x10\x69\xd9\xb9\xc2\x3a\xdf\x56\xe5\x13\x83\x3e\x6b\xc1\x9e\x76\xa5\x67\x71\x39\xd2\x48\x9f\x7d\x42\x8f\xcc\xf9\xce\x79\x07\x9a\x51\xe8\x7c\x16\xae\x37\xd9\xeb\xf1\x57\x92\x88\x1b\x9a\xd8\xd9\xf2\xc9\x76\x3d\x41\x3e\x87\x15\xf5\x12\x07\x98\x75\x59\xb0\x2f\xd5\x8c\xe0\x05\xc3\xd7\x2b\x57\x03\x66\xc5\x9a\x28\x93\xb8\x1f\x7d\xfe\x0c\x89\x1f\x36\x8f\x51\x92\x1e\x7d\x39\xfc\xf2\x07\x51\x4e\x6c\x7d\x2f\xc9\x62\x7f\xeb\xdb\x1a\xd4\x5e\x56\x0c\x7e\x1c\xd3\x18\x9d\xad\x23\x7c\x7e\xc0\x75\x65\xa7\x4a\x81\x35\x08\xe4\x48\xe1\x2c\x36\x92\xe7\x62\x7a\xd5\x49\xb6\x5e\x07\xaf\xd2\xf9\xbd\x8b\x5f\x47\xbc\xab\x4a\xae\xf7\xdc\x54\xc3\xb2\xeb\x38\x67\xa4\xda\x05\xb4\x5a\xbd\x5a\xf1\xb8\x34\x8b\xbd\x2c\x7d\x84\xb3\x27\xe5\x93\x77\x3a\xb4\xc9\x79\x57\xde\xa6\x2c\x0a\xea\x15\x5a\xcb\x35\x3a\xd0\x98\xff\x8f\x21\x57\x38\x12\x6c\xab\xea\xea\x7e\xcd\x9e\x8f\x8a\x03\xf7\x7a\x43\xe5\x59\x3c\x9c\xfe\x0f\xd2\x1a\x75\x33\x68\xbb\x6c\x07\x1e\x2a\x3b\x82\xb8\x26\x7a\x17\xb0\x2a\x7b\xd5\x14\xcc\x6d\xad\x76\x4d\xb6\x62\x56\x5b\x7a\x3e\xee\xed\xf2\xe2\xe5\xa9\x4b\x6b\xa1\x9f\xbe\x44\xf1\x53\xf7\x96\xda\xf7\xcf\xca\x46\x1e\x80\xe9\xf6\x66\xfe\x6e\x17\xa7\x25\xdf\x37\x06\x78\xbf\x0b\x38\xf6\xd6\xb9\x9a\xec\x76\x64\xd0\x6a\x3c\xeb\x81\x53\x13\xe9\x8f\xb8\xc6\xd4\xe7\x82\x03\xad\x0b\xfe\x6f\xfe\x49\x01\xa3\x5e\xaf\x16\x36\x91\x41\x52\x26\x76\xdc\x75\x36\xd6\xb6\x39\xe5\xce\x15\xbe\x84\x5d\xd8\x70\xc5\x56\xbe\xb6\x0b\x5e\x31\x45\x38\xbb\x5c\x4c\x26\x4c\x5c\xaf\x00\xf5\xb1\x93\xb9\xfb\x0f\xa8\xfb\xf3\xfd\xb1\xca\xe9\x2e\xe3\x7f\x0b\x56\xfc\x0d\xa3\xba\x70\x67\x5e\xe8\x5b\x2d\x92\x0f\xf1\xb1\x82\xf0\x81\x33\x38\xee\xca\x42\x71\x93\xcb\xe0\x01\xf7\xf8\x54\x8c\x91\xc5\x5a\x2e\x5e\x29\xf1\xa6\x38\xb9\xe0\x44\xed\x2a\xb9\xf2\x92\x34\x80\x1d\x79\x57\x62\xa1\xb0\x59\x5b\xdd\x25\x23\xfe\xaa\x9b\xd5\x5c\x4d\x0b\xd1\x5c\x72\x2e\x25\xff\xfb\x96\x92\xed\x2a\x0d\xb5\xac\x72\xf7\xd6\x05\xa0\x47\x96\x80\x73\x68\x95\x36\x07\x9d\xda\xd7\xf1\x36\x5c\xb3\xa6\x17\xab\xc6\xe6\xa9\x1e\x2a\x78\x8c\xfb\xbf\x99\x27\x30\x38\xa2\x75\x55\x92\x95\x36\x23\x97\x57\xc8\x88\xc3\x01\x15\xf0\x27\x48\xff\x7d\x0f\xfb\x87\x86\x62\x1c\x5e\xcf\xac\xa7\xa1\xc8\x67\x49\x1c\x20\x14\xbf\xde\x11\x0b\xf7\x57\x04\x14\xe3\x4b\x59\x68\xfc\x43\x5f\x98\xfa\x22\xe7\x54\x51\x45\x93\x6d\xe5\x2e\xd0\x55\x71\x7a\xeb\x16\x73\x70\x83\x61\x61\xbf\x5e\x29\xe0\xdd\xf1\x30\xad\xc4\xbd\xf7\xee\x01\x2f\x69\x2c\x02\xd1\xff\xba\x9e\x9c\x0b\x3c\xc1\xd3\xc9\xe2\xe4\x66\x72\x7b\x77\x7c\xbd\xb8\xbd\x9b\xde\x4e\xcf\x4f\x38\xfb\x3a\x59\x2c\x4e\x66\xb7\x77\xe7\x97\x37\x9c\x89\xe2\xdf\x27\xb3\xd3\xb3\x0b\x78\x60\xe8\x02\x02\x3f\x54\x17\xaa\x26\x34\x92\x66\xb6\x6b\xc8\x69\xa2\x43\xda\x83\x94\xd1\xf0\xec\xf7\xec\x9e\x7e\xf9\xc8\xec\x9a\x7a\xa3\x11\x14\x82\x5f\xaa\xe0\xa3\x9c\x09\x28\xf7\x7d\xc9\x24\x00\x29\x5b\x70\x2a\xb1\x69\x1d\x93\x92\xd0\x12\x6e\x6a\xb4\x10\xff\xfb\x64\x5a\x50\x5e\x7d\xa1\x53\x1d\x9f\x69\x84\x67\xe3\x1f\xa3\x4f\x3f\x46\x22\x64\x05\x0e\x75\xd5\x57\xfb\x52\xa2\x44\xf4\x3f\x69\xc9\x88\x34\x28\x9a\x7f\xd9\xec\x9c\x90\x25\x69\xd0\xa6\x93\x8d\xb7\x7c\xba\x69\x00\xbf\x14\x05\x58\x10\x2e\xa3\x2d\xe8\x56\x03\x0b\x53\x70\x5f\x47\x96\xe8\x78\x75\xc1\x18\xec\x40\xb7\x17\xcc\x70\x56\xb7\x8f\x0e\xa5\xa4\xcf\x95\x64\x42\x84\xaa\x17\xdf\x6e\x16\x2c\x55\xc6\x2a\xa1\xb9\x97\x06\xfa\xee\x0d\x2e\x9a\xf3\x1e\x72\x9e\xa2\x23\xf4\x48\x5d\xa7\x66\x20\x6f\x22\x7d\x80\xef\xb7\x81\xef\xe7\x9c\x3c\x19\x5d\xa7\x6f\x90\x18\xf0\x5e\x49\x16\x06\x88\x1b\x77\x0d\x87\x42\x04\x13\x7d\xdf\x41\x80\x0f\xf0\xfd\x5f\x04\x7c\xdf\x36\xe7\x14\x2e\xa9\xe5\xc0\xda\x9b\x51\x2c\x86\x24\x8d\xac\x28\xf9\xeb\x6d\x7c\x00\xd1\x3b\x80\xe8\x95\x78\x71\xeb\xf1\x2e\xa8\xf0\x1f\xc0\xed\xef\x06\xb8\x7d\x40\x7b\xef\x57\x47\x77\x77\x88\x31\x8a\xec\xd3\x76\x61\x7f\xc6\xa5\x80\xf7\x84\xbe\xde\x4c\x90\x36\x62\x82\x69\x7b\x1c\xc0\xc8\xee\xb3\xea\x17\x26\x0d\x91\xb9\x2c\x12\xa6\x34\x38\x31\x3d\x1c\x04\xc2\x28\x66\xd4\xa5\x89\xcb\x06\xc3\xc8\x20\x99\x19\xd4\x22\x52\xe0\xbc\x7a\xe6\xa5\x3e\xb9\xed\xe2\x80\x9b\xd2\xfa\x31\xd6\xee\x68\x3e\xe7\x76\xd2\x55\xd2\xb6\xd5\x79\x6e\x99\x06\x32\xe1\x55\x63\xb7\xa5\x8d\x2e\x6f\x54\xcb\x80\x94\x94\x6d\x7c\x38\x62\x05\xe1\x22\x07\x45\x59\x63\x7c\xe4\x3d\x3f\x40\x0c\xc9\xfc\x62\x56\x6f\xcf\x7b\xf6\x63\x48\x23\x37\xbf\x98\xa9\xa5\xad\xe8\x35\xbe\xf7\x93\x54\xed\x45\x18\x8c\xe8\xde\x14\xf5\x9e\x1f\x66\xf3\xf9\x99\xbb\x1d\x78\x3b\x44\x43\x25\x8c\xb8\x8b\x7e\x58\x82\x44\xa1\xf8\xf5\x0a\xae\x4e\xa5\x8d\x15\x62\x77\x69\x35\xfe\x9b\xd9\x01\x52\x4a\xef\x95\xef\x49\xa2\x48\x9d\xb5\xf3\xfc\x7f\xff\x0c\x00\xd9\x88\xc3\x92\x31\x34\x02\x00")
 
 func swaggerApiSwaggerJsonBytes() ([]byte, error) {
 	return bindataRead(
@@ -346,12 +346,12 @@ func swaggerApiSwaggerJson() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "swagger/api.swagger.json", size: 16440, mode: os.FileMode(420), modTime: time.Unix(1482071704, 0)}
+	info := bindataFileInfo{name: "swagger/api.swagger.json", size: 144433, mode: os.FileMode(420), modTime: time.Unix(1792178577, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _swaggerIndexHtml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xbc\x57\x6d\x6f\xdb\x36\x10\xfe\x9e\x5f\x71\xe5\x8a\x58\x46\x23\x29\x69\x81\xae\x70\x2d\x03\x41\x97\x0d\x5d\xd7\xb5\xc8\x0b\x86\x21\xc8\x8a\x33\x79\x96\xd8\x50\x24\x4b\x52\x76\xdc\xa2\xff\x7d\xa0\x2c\xbf\x35\xc9\xd0\x0d\xce\xf2\x21\x47\x91\x77\xcf\x3d\x7c\xee\x72\x60\x86\x8f\x7e\x7a\xf7\xea\xfc\xcf\xf7\x27\x50\x85\x5a\x8d\xf6\x86\x4b\x43\x28\x46\x7b\x00\xc3\x9a\x02\x02\xaf\xd0\x79\x0a\x05\xbb\x38\xff\x39\x7d\xc1\xda\x83\x20\x83\xa2\xd1\x6f\xe6\x14\xe1\xd8\x5a\x38\x23\x37\x25\x07\xa7\x27\x67\xe7\x70\xfc\xfe\xf5\x30\x5f\x9c\x47\x4f\x25\xf5\x35\x38\x52\x05\x93\xdc\x68\x06\x61\x6e\xa9\x60\xb2\xc6\x92\x72\xab\x4b\x06\x95\xa3\x49\xc1\xf2\x29\x69\x61\x5c\xee\x67\x58\x96\xe4\xf2\xd6\xc1\xe7\x13\x9c\xc6\xb0\xf4\xd9\xd3\x9b\x67\x4f\xb3\xd6\xdf\xcb\xcf\xe4\x0b\xd6\xee\x30\xc8\x77\x98\xe4\xe8\xf9\xcd\xd1\xf3\xad\x24\xed\xce\x56\x92\xbb\x81\xb8\xf7\x79\x98\x5b\x53\x3a\xb4\xd5\x3c\xe3\xde\x33\xa8\x49\x48\x2c\x98\xe7\x8e\x48\xb3\x05\x3b\x1f\xe6\x8a\x7c\x45\x14\x96\x1c\x03\xdd\x84\x18\xce\xbe\x2b\x87\x23\x4f\xe1\xe1\xe0\x17\x60\xff\x27\x7d\xeb\xa4\x0e\x3b\x42\x6f\xb1\xfe\x3b\xba\xe7\x4e\xda\x00\xde\xf1\xdb\xf0\x4a\x8e\xf3\x8f\x9f\x1a\x72\xf3\xf4\x28\x7b\x91\x1d\x66\xb5\xd4\xd9\x47\xbf\x85\xf4\x11\xa7\xb8\xc0\x60\xa3\x61\xbe\x58\xfd\x0b\xe0\xcc\x2b\x29\x28\x98\x87\x80\x9e\xc9\xb2\x54\xf4\x10\xc8\x63\x4c\xc7\xe3\x4f\x3b\x45\xae\x50\x0b\x45\x63\x74\x3e\x7d\x9a\x1d\x66\x87\x3b\x03\x6e\xb4\x20\xe7\xb9\x71\x94\xee\x92\xef\x18\xf9\xf5\xd8\xe8\xdd\x81\x76\x36\x6d\xe4\x6e\x75\x95\x65\xa5\x64\x59\x85\xec\xc7\xec\x59\x66\x91\x5f\xef\xae\x19\xbc\xd1\x24\x64\x30\x6e\xa7\x8c\x6b\x74\xd7\x24\x76\x06\xb7\xd4\xd5\x60\x13\xaa\xef\x43\xdd\x80\xbd\xc7\x77\x0f\x00\xe0\x71\x32\x69\x34\x0f\xd2\x68\x48\xfa\xf0\xa5\xdd\x03\x98\xa2\x83\xc6\x29\x28\x60\x26\xb5\x30\xb3\x4c\x19\x8e\xd1\x29\xf3\x84\x8e\x57\x59\x8d\x81\x57\x49\xde\x38\x55\x24\x97\x7f\xed\x5f\x3d\xe9\xe7\xfd\x97\x5d\xb0\x9c\x40\x12\x83\xf7\xf7\x23\x46\xa6\x48\x97\xa1\x82\x11\x1c\xad\xf1\xa1\x43\x17\xc4\x8d\xa0\x8b\xd3\xd7\xaf\x4c\x6d\x8d\x26\x1d\x62\xe4\xe5\xd1\xd5\x0a\xec\x2b\x90\xf2\x74\x2b\x90\xad\xe4\x41\x2b\xb3\x6e\x9d\xc5\x62\xb2\x55\xe4\x5e\xb7\xe8\xae\xd0\x39\x5d\x48\x28\x40\xd3\x0c\xce\x96\xdf\xc9\x16\xf8\x20\xfe\x3a\x58\xed\x08\x53\x7f\x90\x62\x00\x6c\xdd\xda\x29\x37\x3a\xa0\xd4\xe4\xd8\xda\xcf\x37\xd6\x1a\x17\x48\x9c\x35\xe3\x5a\x86\xb7\x14\x2a\x23\xfc\x00\x2e\x7b\x25\x85\xde\x01\xf4\xac\xf1\x0b\xdb\xb4\x46\x90\xa2\x40\xed\x46\x94\xb2\x77\xb5\x99\x92\x9f\xdc\x58\xd4\x5e\x1a\x3d\x00\xa6\xa4\x0f\x1b\x89\xe2\x1d\x4f\xda\x86\x1d\xc0\x04\x95\xa7\xf5\x11\x5a\xe9\xcf\x22\x09\x37\x00\x86\xca\x56\xb8\x11\x27\x68\x82\x8d\x0a\x6f\x8d\x20\x75\x4a\x71\x9e\x48\x5d\x0e\xa0\xe7\x79\x45\x35\xf6\xd6\x8e\x53\x54\x52\x60\x30\xee\x22\x8a\xa1\x1b\xb5\xa1\x86\xd1\xb1\x50\x91\xf9\x00\x96\x7d\x93\x74\xca\x1c\x5b\x79\x00\x2b\x91\x37\x6b\x0d\x80\x42\x1c\x5b\xf9\x86\xe6\xc7\x4d\xa8\x8c\x93\x9f\xdb\x66\x4a\x56\x55\x8e\xd5\xea\x6c\xff\xe5\xb2\x6e\xab\xc6\xbc\x2f\xfa\xcb\x06\x67\x07\xd7\x34\x87\x02\x48\xdf\xea\xa8\xc7\x49\xef\x07\xa9\x6d\x13\x3e\x60\x8b\xd2\xeb\x5f\x1e\x5e\x65\x53\x54\x0d\x6d\x10\x90\x93\x24\x22\xec\xef\x47\xa0\x2c\x38\x59\x27\x7d\x78\x54\x00\x63\xdb\x57\xb9\xdd\x4f\x59\x6c\x41\xae\x24\xe9\xb0\x45\xd0\x67\x28\x44\xc2\xae\x69\xce\x0e\x36\x3b\xee\x55\xeb\x9a\xdd\x75\x25\xf6\x8b\xb3\x3c\x7d\x4b\x01\x05\x06\x4c\xb7\x0e\xd9\x41\x64\x76\x00\x2c\x3e\x71\xc9\xb1\xfe\x06\xf9\xf8\xc3\x8d\xf6\x46\x51\xa6\x4c\x99\x30\x14\x82\x44\x2b\x09\x83\x27\xd1\xde\x25\x75\x67\x6f\xeb\x93\xf1\x0a\x75\x49\xc9\xdd\xc2\xaf\x2b\x74\x4b\x09\x65\x50\x2c\xab\xfa\xb5\xb5\xeb\x81\x34\xcc\x17\x6f\xf3\xbd\xe1\xd8\x88\x39\x70\x85\xde\x17\xab\x3f\x2b\x4f\x6d\xad\x17\x4f\x74\x21\xa7\x20\x45\xd1\x5b\xdc\xb4\xb7\x98\x54\xed\xee\x37\x51\x8d\x4c\x67\x0e\x6d\x37\xcb\xe2\xc4\xb3\xa8\x63\x28\x53\xa6\x34\xec\x1f\x5e\xf9\xd1\x71\x15\x35\x31\xae\x6e\x13\xa2\x95\x1f\x3c\x29\xe2\xc1\x2c\xd3\x7e\x93\xba\xd7\x2a\xd5\x1b\x0d\x5b\x0b\x56\x21\xa7\xca\x28\x41\xae\x60\xbf\xfe\x71\x0e\xe7\xef\xde\x9c\xfc\xce\x5a\x0a\x9b\x9a\x32\xd0\x58\x53\xc1\x96\x5f\xeb\x91\xcc\xf2\xd1\x30\x17\x72\xba\x22\x93\x47\x36\xdd\x95\x97\x07\xdd\x62\x43\x1b\x56\x93\xf7\x58\x52\x3a\x46\xc7\xee\x93\x05\xda\x2e\xf2\xb3\x34\x38\xd4\x5e\x61\xa0\xd1\xbe\x1e\x7b\xfb\x72\x0d\xbc\x84\xbb\x73\xbc\xdd\x2b\x77\x17\x3f\xcc\x63\x29\xdb\xd2\xb6\xff\x7d\xfd\x1d\x00\x00\xff\xff\x9c\x08\x1a\xf5\x95\x0d\x00\x00")
+var _swaggerIndexHtml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xbc\x96\x7d\x6f\xdb\xb6\x13\xc7\xff\xf7\xab\xb8\xf2\x57\x44\x32\x1a\x49\x49\x0b\xf4\x57\x38\x96\x81\xa0\xcb\x86\xae\xeb\x5a\xe4\x01\xc3\x10\x64\xc5\x59\x3c\x4b\x8c\x29\x92\x25\x29\x3b\xee\xd0\xf7\x3e\x50\x96\x9f\x9a\x64\xe8\x06\x77\x80\x81\xa3\xc9\xbb\xcf\x7d\x75\x3c\x1d\x34\x7c\xf2\xc3\xfb\xd7\x97\xbf\x7f\x38\x83\xca\xd7\x72\xd4\x1b\xae\x0c\x21\x1f\xf5\x00\x86\x35\x79\x84\xa2\x42\xeb\xc8\xe7\xec\xea\xf2\xc7\xe4\x15\x6b\x0f\xbc\xf0\x92\x46\xbf\xe8\x73\x84\x53\x63\xe0\x82\xec\x8c\x2c\x9c\x9f\x5d\x5c\xc2\xe9\x87\x37\xc3\x6c\x79\x1e\x3c\xa5\x50\x53\xb0\x24\x73\x26\x0a\xad\x18\xf8\x85\xa1\x9c\x89\x1a\x4b\xca\x8c\x2a\x19\x54\x96\x26\x39\xcb\x66\xa4\xb8\xb6\x99\x9b\x63\x59\x92\xcd\x5a\x07\x97\x4d\x70\x16\xc2\x92\x17\xcf\xef\x5e\x3c\x4f\x5b\x7f\x27\x3e\x93\xcb\x59\xbb\xc3\x20\xdb\x63\x92\xe3\x97\x77\xc7\x2f\x77\x92\xb4\x3b\x3b\x49\x1e\x06\x15\xce\x65\x7e\x61\x74\x69\xd1\x54\x8b\xb4\x70\x8e\x41\x4d\x5c\x60\xce\x5c\x61\x89\x14\x5b\x96\xc0\xf9\x85\x24\x57\x11\xf9\x95\x46\x4f\x77\x3e\x0b\xfe\xdf\x94\xc3\x92\x23\xff\xfd\xf0\x4b\xad\xff\xa5\x7c\x63\x85\xf2\x7b\xa2\xb7\xac\x7f\x4f\x77\x85\x15\xc6\x83\xb3\xc5\x7d\xbc\x14\xe3\xec\xf6\x53\x43\x76\x91\x1c\xa7\xaf\xd2\xa3\xb4\x16\x2a\xbd\x75\x3b\xa4\x5b\x9c\xe1\x92\xc1\x46\xc3\x6c\xb9\xfa\x07\xe0\xd4\x49\xc1\xc9\xeb\xef\x81\x9e\x8b\xb2\x94\xf4\x3d\xc8\x63\x4c\xc6\xe3\x4f\x7b\x25\x57\xa8\xb8\xa4\x31\x5a\x97\x3c\x4f\x8f\xd2\xa3\xbd\x81\x1b\xc5\xc9\xba\x42\x5b\x4a\xf6\xa9\x77\x8c\xc5\x74\xac\xd5\xfe\xa0\x9d\x4d\x1a\xb1\xdf\xba\x8a\xb2\x92\xa2\xac\x7c\xfa\xff\xf4\x45\x6a\xb0\x98\xee\x0d\x7d\xeb\xb4\x22\x2e\xbc\xb6\x7b\x55\x5c\xa3\x9d\x12\xdf\x1b\xae\x5b\x27\x1a\x1b\x5f\x7d\x1b\x75\x0b\xfb\x88\x6f\x0f\x00\xe0\x69\x3c\x69\x54\xe1\x85\x56\x10\xf7\xe1\xcf\x76\x0f\x60\x86\x16\x1a\x2b\x21\x87\xb9\x50\x5c\xcf\x53\xa9\x0b\x0c\x4e\xa9\x23\xb4\x45\x95\xd6\xe8\x8b\x2a\xce\x1a\x2b\xf3\xf8\xfa\x8f\x83\x9b\x67\xfd\xac\x7f\xd2\x05\x8b\x09\xc4\x21\xf8\xe0\x20\x30\x52\x49\xaa\xf4\x15\x8c\xe0\x78\xc3\x87\x8e\xce\xa9\xd0\x9c\xae\xce\xdf\xbc\xd6\xb5\xd1\x8a\x94\x0f\x91\xd7\xc7\x37\x6b\xd8\x17\x20\xe9\xe8\x5e\x20\xcb\xd0\x88\xcc\x19\x2a\xd8\xda\xb3\xd7\x2d\x3a\xc9\x5d\xcd\xae\x04\xe4\xa0\x68\x0e\x17\xab\xff\xf1\x0e\x6c\x10\x88\x87\xeb\x1d\xae\xeb\x8f\x82\x0f\x80\xad\x4a\xde\x88\xa4\xd0\xca\xa3\x50\x64\xd9\xc6\xcf\x35\xc6\x68\xeb\x89\x5f\x34\xe3\x5a\xf8\x77\xe4\x2b\xcd\xdd\x00\xae\xa3\x92\x7c\x74\x08\x91\xd1\x6e\x69\x9b\xd6\x70\x92\xe4\x29\xac\x4c\x28\x5d\x74\xb3\x9d\xb2\x38\xbb\x33\xa8\x9c\xd0\x6a\x00\x4c\x0a\xe7\xb7\x12\x85\x06\x3d\x6b\x1b\x74\x00\x13\x94\x8e\x36\x47\x68\x84\xbb\x08\x22\xec\x00\x18\x4a\x53\xe1\x56\x1c\xa7\x09\x36\xd2\xbf\xd3\x9c\xe4\x39\x85\xf9\x21\x54\x39\x80\xc8\x15\x15\xd5\x18\x6d\x1c\x67\x28\x05\x47\xaf\xed\x95\x95\x03\x50\x8d\xdc\xaa\x86\x56\xe1\x62\x82\xf2\x01\xac\xfa\x24\xee\x2a\x73\x6a\xc4\x21\x74\xeb\x2b\xb1\x7d\xb7\x00\xc8\xf9\xa9\x11\x6f\x69\x71\xda\xf8\x4a\x5b\xf1\xb9\x6d\x9e\x78\x7d\xab\xe1\xb6\x3a\xdb\x3f\x59\xdd\xdb\xba\x11\x1f\x8b\xde\x64\x08\xfd\x39\xa5\x05\xe4\x40\xea\x5e\x07\x3d\x8d\xa3\xff\x09\x65\x1a\xff\x11\x5b\x4a\xd4\xbf\x3e\xba\x49\x67\x28\x1b\xda\x12\x20\x26\x71\x20\x1c\x1c\xc0\x94\x16\xa9\xb7\xa2\x8e\xfb\xf0\x24\x07\xc6\x76\x1f\xe5\x7e\x3f\xa5\x68\x44\x5a\x48\x41\xca\xef\x08\x74\x29\x72\x1e\xb3\x29\x2d\xd8\xe1\x76\xc7\xbd\x6e\x5d\xd3\x87\x1e\x89\xfd\x64\x4d\x91\xbc\x23\x8f\x1c\x3d\x26\x3b\x87\xec\x30\x28\x3b\x04\x56\x11\x72\xb2\xac\xbf\x25\x3e\xfc\x0a\xad\x9c\x96\x94\x4a\x5d\xc6\x0c\x39\x27\x1e\xfc\x81\xc1\xb3\x60\x1f\x2a\x75\x67\xef\xd7\x27\x2d\x2a\x54\x25\xc5\x0f\x17\xbe\x7f\xf2\xd8\x9b\x95\x4a\x8d\x7c\x75\xab\x5f\x5a\xbb\x19\x40\xc3\x2c\x08\x1f\xf5\x7a\xc3\xb1\xe6\x0b\x28\x24\x3a\x97\xaf\x5f\x2b\x47\xed\xd0\x59\x7e\x92\x73\x31\x03\xc1\xf3\x28\x04\x90\x8d\xc2\x5e\xb7\xfb\x55\x54\x23\x92\xb9\x45\xd3\xcd\xae\x30\xe1\x0c\xaa\x10\xca\xa4\x2e\x35\xfb\x9b\xaf\x7a\x67\x50\xad\xa3\x26\xda\xd6\x21\x2a\x42\x23\x3e\x3a\x92\x54\x78\xbd\x4a\xfb\x55\xea\xa8\xad\x54\x34\x1a\xb6\x16\x8c\xc4\x82\x2a\x2d\x39\xd9\x9c\xfd\xfc\xdb\x25\x5c\xbe\x7f\x7b\xf6\x2b\x0b\x30\xb6\x5d\x53\x06\x0a\x6b\xca\xd9\xea\xdf\x66\x04\xb3\x6c\x34\xcc\xb8\x98\xad\xc5\x64\x41\x4d\xf7\xc8\xab\x83\xce\x63\xab\x36\xac\x26\xe7\xb0\xa4\x64\x8c\x96\x3d\x56\x16\x68\xbb\xc8\xcd\x13\x6f\x51\x39\x89\x9e\x46\x07\x6a\xec\xcc\xc9\x3a\xe3\x1a\xf7\xe0\x78\x7b\x8c\xbb\x52\x3c\xcc\xc2\x55\x8e\x7a\xc3\xac\xf2\xb5\x1c\xf5\xfe\x1a\x00\xc2\x4e\xbf\xa3\x85\x0d\x00\x00")
 
 func swaggerIndexHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -366,7 +366,7 @@ func swaggerIndexHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "swagger/index.html", size: 3477, mode: os.FileMode(420), modTime: time.Unix(1473582000, 0)}
+	info := bindataFileInfo{name: "swagger/index.html", size: 3461, mode: os.FileMode(420), modTime: time.Unix(1792160393, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
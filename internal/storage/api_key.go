@@ -0,0 +1,125 @@
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/brocaar/lorawan"
+)
+
+// APIKey represents an API key of an organization. The key is scoped to
+// the applications of the organization, or to a single application of the
+// organization when AppEUI is set, with the permissions of the given role.
+// Only the hash of the key is stored.
+type APIKey struct {
+	ID             int64            `db:"id"`
+	CreatedAt      time.Time        `db:"created_at"`
+	Name           string           `db:"name"`
+	OrganizationID int64            `db:"organization_id"`
+	AppEUI         *lorawan.EUI64   `db:"app_eui"`
+	Role           OrganizationRole `db:"role"`
+	KeyHash        []byte           `db:"key_hash"`
+	RevokedAt      *time.Time       `db:"revoked_at"`
+}
+
+// Validate validates the data of the APIKey.
+func (k APIKey) Validate() error {
+	if k.Name == "" {
+		return errors.New("name must be set")
+	}
+	return k.Role.Validate()
+}
+
+// CreateAPIKey creates the given APIKey. When the key is scoped to an
+// application, the application must be owned by the organization.
+func CreateAPIKey(db *sqlx.DB, k *APIKey) error {
+	if err := k.Validate(); err != nil {
+		return err
+	}
+
+	if k.AppEUI != nil {
+		o, err := GetOrganizationForAppEUI(db, *k.AppEUI)
+		if err != nil {
+			return err
+		}
+		if o == nil || o.ID != k.OrganizationID {
+			return fmt.Errorf("application %s is not part of organization %d", k.AppEUI, k.OrganizationID)
+		}
+	}
+
+	var appEUI []byte
+	if k.AppEUI != nil {
+		appEUI = k.AppEUI[:]
+	}
+
+	now := time.Now()
+	err := db.Get(&k.ID, `
+		insert into api_key (
+			created_at,
+			name,
+			organization_id,
+			app_eui,
+			role,
+			key_hash
+		) values ($1, $2, $3, $4, $5, $6)
+		returning id`,
+		now,
+		k.Name,
+		k.OrganizationID,
+		appEUI,
+		k.Role,
+		k.KeyHash,
+	)
+	if err != nil {
+		return fmt.Errorf("create api key error: %s", err)
+	}
+	k.CreatedAt = now
+	log.WithFields(log.Fields{
+		"id":              k.ID,
+		"organization_id": k.OrganizationID,
+		"role":            k.Role,
+	}).Info("api key created")
+	return nil
+}
+
+// GetAPIKey returns the APIKey for the given id.
+func GetAPIKey(db *sqlx.DB, id int64) (APIKey, error) {
+	var k APIKey
+	err := db.Get(&k, "select * from api_key where id = $1", id)
+	if err != nil {
+		return k, fmt.Errorf("get api key %d error: %s", id, err)
+	}
+	return k, nil
+}
+
+// GetAPIKeys returns the API keys of the given organization, including the
+// revoked keys.
+func GetAPIKeys(db *sqlx.DB, organizationID int64) ([]APIKey, error) {
+	var keys []APIKey
+	err := db.Select(&keys, "select * from api_key where organization_id = $1 order by id", organizationID)
+	if err != nil {
+		return nil, fmt.Errorf("get api keys error: %s", err)
+	}
+	return keys, nil
+}
+
+// RevokeAPIKey revokes the APIKey matching the given id.
+func RevokeAPIKey(db *sqlx.DB, id int64) error {
+	res, err := db.Exec("update api_key set revoked_at = $2 where id = $1 and revoked_at is null", id, time.Now())
+	if err != nil {
+		return fmt.Errorf("revoke api key %d error: %s", id, err)
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if ra == 0 {
+		return fmt.Errorf("api key %d does not exist or is already revoked", id)
+	}
+	log.WithField("id", id).Info("api key revoked")
+	return nil
+}
@@ -0,0 +1,68 @@
+package storage
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/brocaar/lora-app-server/internal/test"
+	"github.com/brocaar/lorawan"
+)
+
+func TestAPIKey(t *testing.T) {
+	conf := test.GetConfig()
+
+	Convey("Given a clean database with an organization and application", t, func() {
+		db, err := OpenDatabase(conf.PostgresDSN)
+		So(err, ShouldBeNil)
+		test.MustResetDB(db)
+
+		appEUI := lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}
+		o := Organization{Name: "customer-1"}
+		So(CreateOrganization(db, &o), ShouldBeNil)
+		So(AddOrganizationApplication(db, o.ID, appEUI), ShouldBeNil)
+
+		Convey("When creating an API key scoped to the application", func() {
+			k := APIKey{
+				Name:           "integration",
+				OrganizationID: o.ID,
+				AppEUI:         &appEUI,
+				Role:           OrganizationRoleDeviceAdmin,
+				KeyHash:        []byte{1, 2, 3},
+			}
+			So(CreateAPIKey(db, &k), ShouldBeNil)
+
+			Convey("Then it can be retrieved and listed", func() {
+				k2, err := GetAPIKey(db, k.ID)
+				So(err, ShouldBeNil)
+				So(k2.AppEUI, ShouldResemble, &appEUI)
+				So(k2.KeyHash, ShouldResemble, k.KeyHash)
+				So(k2.RevokedAt, ShouldBeNil)
+
+				keys, err := GetAPIKeys(db, o.ID)
+				So(err, ShouldBeNil)
+				So(keys, ShouldHaveLength, 1)
+			})
+
+			Convey("Then it can be revoked once", func() {
+				So(RevokeAPIKey(db, k.ID), ShouldBeNil)
+				So(RevokeAPIKey(db, k.ID), ShouldNotBeNil)
+
+				k2, err := GetAPIKey(db, k.ID)
+				So(err, ShouldBeNil)
+				So(k2.RevokedAt, ShouldNotBeNil)
+			})
+		})
+
+		Convey("Then an API key can not be scoped to an application of an other organization", func() {
+			otherAppEUI := lorawan.EUI64{1}
+			So(CreateAPIKey(db, &APIKey{
+				Name:           "integration",
+				OrganizationID: o.ID,
+				AppEUI:         &otherAppEUI,
+				Role:           OrganizationRoleReadOnly,
+				KeyHash:        []byte{1, 2, 3},
+			}), ShouldNotBeNil)
+		})
+	})
+}
@@ -0,0 +1,157 @@
+package storage
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/brocaar/lorawan"
+)
+
+// Object types of the audit log entries.
+const (
+	AuditObjectNode        = "node"
+	AuditObjectApplication = "application"
+	AuditObjectDownlink    = "downlink"
+	AuditObjectNodeSession = "node-session"
+	AuditObjectSigningKey  = "signing-key"
+	AuditObjectAPIKey      = "api-key"
+)
+
+// AuditAnonymousActor is the actor recorded when the actor is unknown (e.g.
+// when authentication is disabled).
+const AuditAnonymousActor = "anonymous"
+
+// AuditLog contains an administrative or downlink action, performed by the
+// given actor (the subject of the token, the API key or the principal of
+// the handler) on the given object. The audit log is append-only.
+type AuditLog struct {
+	ID         int64          `db:"id"`
+	CreatedAt  time.Time      `db:"created_at"`
+	Actor      string         `db:"actor"`
+	Action     string         `db:"action"`
+	ObjectType string         `db:"object_type"`
+	ObjectID   string         `db:"object_id"`
+	AppEUI     *lorawan.EUI64 `db:"app_eui"` // application of the object (nil when not related to an application)
+	Details    []byte         `db:"details"` // JSON encoded details (optional)
+}
+
+// AuditLogFilter contains the filters for querying the audit log. Zero
+// values are ignored.
+type AuditLogFilter struct {
+	Actor      string         // only entries of this actor
+	ObjectType string         // only entries of this object type
+	ObjectID   string         // only entries of this object id
+	AppEUI     *lorawan.EUI64 // only entries of this application
+	Start      time.Time      // only entries created at or after this time
+	End        time.Time      // only entries created before this time
+}
+
+// CreateAuditLog creates the given audit log entry. When the actor is
+// empty, AuditAnonymousActor is recorded.
+func CreateAuditLog(db *sqlx.DB, e *AuditLog) error {
+	if e.Actor == "" {
+		e.Actor = AuditAnonymousActor
+	}
+
+	var appEUI []byte
+	if e.AppEUI != nil {
+		appEUI = e.AppEUI[:]
+	}
+
+	e.CreatedAt = time.Now()
+	err := db.Get(&e.ID, `
+		insert into audit_log (
+			created_at,
+			actor,
+			action,
+			object_type,
+			object_id,
+			app_eui,
+			details
+		) values ($1, $2, $3, $4, $5, $6, $7)
+		returning id`,
+		e.CreatedAt,
+		e.Actor,
+		e.Action,
+		e.ObjectType,
+		e.ObjectID,
+		appEUI,
+		auditLogDetails(e.Details),
+	)
+	if err != nil {
+		return fmt.Errorf("create audit log error: %s", err)
+	}
+	return nil
+}
+
+// GetAuditLogCount returns the number of audit log entries matching the
+// given filter.
+func GetAuditLogCount(db *sqlx.DB, f AuditLogFilter) (int, error) {
+	var count int
+	err := db.Get(&count, `
+		select count(*)
+		from audit_log
+		where
+			`+auditLogWhere,
+		auditLogArgs(f)...,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("get audit log count error: %s", err)
+	}
+	return count, nil
+}
+
+// GetAuditLogs returns the audit log entries matching the given filter,
+// newest first.
+func GetAuditLogs(db *sqlx.DB, f AuditLogFilter, limit, offset int) ([]AuditLog, error) {
+	var entries []AuditLog
+	err := db.Select(&entries, `
+		select *
+		from audit_log
+		where
+			`+auditLogWhere+`
+		order by created_at desc, id desc
+		limit $7 offset $8`,
+		append(auditLogArgs(f), limit, offset)...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("get audit logs error: %s", err)
+	}
+	return entries, nil
+}
+
+// auditLogWhere contains the conditions of the audit log queries, matching
+// the arguments returned by auditLogArgs.
+const auditLogWhere = `($1 = '' or actor = $1)
+			and ($2 = '' or object_type = $2)
+			and ($3 = '' or object_id = $3)
+			and ($4::bytea is null or app_eui = $4)
+			and ($5::timestamptz is null or created_at >= $5)
+			and ($6::timestamptz is null or created_at < $6)`
+
+// auditLogArgs returns the arguments of the audit log queries for the given
+// filter.
+func auditLogArgs(f AuditLogFilter) []interface{} {
+	var appEUI []byte
+	if f.AppEUI != nil {
+		appEUI = f.AppEUI[:]
+	}
+	return []interface{}{
+		f.Actor,
+		f.ObjectType,
+		f.ObjectID,
+		appEUI,
+		eventLogTime(f.Start),
+		eventLogTime(f.End),
+	}
+}
+
+// auditLogDetails returns nil for empty details, so that null is stored.
+func auditLogDetails(b []byte) interface{} {
+	if len(b) == 0 {
+		return nil
+	}
+	return string(b)
+}
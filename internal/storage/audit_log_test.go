@@ -0,0 +1,81 @@
+package storage
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/brocaar/lora-app-server/internal/test"
+	"github.com/brocaar/lorawan"
+)
+
+func TestAuditLog(t *testing.T) {
+	conf := test.GetConfig()
+
+	Convey("Given a clean database", t, func() {
+		db, err := OpenDatabase(conf.PostgresDSN)
+		So(err, ShouldBeNil)
+		test.MustResetDB(db)
+
+		appEUI := lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}
+
+		Convey("When creating audit log entries", func() {
+			entries := []AuditLog{
+				{Actor: "admin", Action: "Node.Create", ObjectType: AuditObjectNode, ObjectID: "0807060504030201", AppEUI: &appEUI},
+				{Actor: "mqtt", Action: "DownlinkQueue.Enqueue", ObjectType: AuditObjectDownlink, ObjectID: "0807060504030201", AppEUI: &appEUI, Details: []byte(`{"fPort":10}`)},
+				{Action: "APIKey.Create", ObjectType: AuditObjectAPIKey, ObjectID: "1"},
+			}
+			for i := range entries {
+				So(CreateAuditLog(db, &entries[i]), ShouldBeNil)
+			}
+
+			Convey("Then all entries are returned newest first", func() {
+				count, err := GetAuditLogCount(db, AuditLogFilter{})
+				So(err, ShouldBeNil)
+				So(count, ShouldEqual, 3)
+
+				logs, err := GetAuditLogs(db, AuditLogFilter{}, 10, 0)
+				So(err, ShouldBeNil)
+				So(logs, ShouldHaveLength, 3)
+				So(logs[0].ID, ShouldEqual, entries[2].ID)
+				So(logs[0].Actor, ShouldEqual, AuditAnonymousActor)
+				So(logs[0].AppEUI, ShouldBeNil)
+				So(logs[1].ID, ShouldEqual, entries[1].ID)
+				So(string(logs[1].Details), ShouldEqual, `{"fPort": 10}`)
+			})
+
+			Convey("Then the entries can be filtered by actor, object and application", func() {
+				logs, err := GetAuditLogs(db, AuditLogFilter{Actor: "mqtt"}, 10, 0)
+				So(err, ShouldBeNil)
+				So(logs, ShouldHaveLength, 1)
+				So(logs[0].Action, ShouldEqual, "DownlinkQueue.Enqueue")
+
+				logs, err = GetAuditLogs(db, AuditLogFilter{ObjectType: AuditObjectNode, ObjectID: "0807060504030201"}, 10, 0)
+				So(err, ShouldBeNil)
+				So(logs, ShouldHaveLength, 1)
+				So(logs[0].ID, ShouldEqual, entries[0].ID)
+
+				count, err := GetAuditLogCount(db, AuditLogFilter{AppEUI: &appEUI})
+				So(err, ShouldBeNil)
+				So(count, ShouldEqual, 2)
+			})
+
+			Convey("Then the entries can be filtered by time-range", func() {
+				count, err := GetAuditLogCount(db, AuditLogFilter{End: entries[0].CreatedAt})
+				So(err, ShouldBeNil)
+				So(count, ShouldEqual, 0)
+
+				count, err = GetAuditLogCount(db, AuditLogFilter{Start: entries[1].CreatedAt})
+				So(err, ShouldBeNil)
+				So(count, ShouldEqual, 2)
+			})
+
+			Convey("Then the entries can not be updated or deleted", func() {
+				_, err := db.Exec("update audit_log set actor = 'other'")
+				So(err, ShouldNotBeNil)
+				_, err = db.Exec("delete from audit_log")
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
@@ -0,0 +1,124 @@
+package storage
+
+import (
+	"database/sql"
+	"fmt"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/brocaar/lorawan"
+)
+
+// AWSSNSIntegration defines the AWS SNS / SQS integration of an
+// application. The events are published to the given SNS topic and, when
+// a queue URL is set, the downlink payloads are consumed from the given SQS
+// queue. The secret access key is stored encrypted.
+type AWSSNSIntegration struct {
+	AppEUI          lorawan.EUI64   `db:"app_eui"`
+	Region          string          `db:"region"`
+	AccessKeyID     string          `db:"access_key_id"`
+	SecretAccessKey EncryptedString `db:"secret_access_key"`
+	TopicARN        string          `db:"topic_arn"`
+	QueueURL        string          `db:"queue_url"`
+}
+
+// CreateAWSSNSIntegration creates the given AWSSNSIntegration.
+func CreateAWSSNSIntegration(db *sqlx.DB, i AWSSNSIntegration) error {
+	_, err := db.Exec(`
+		insert into aws_sns_integration (
+			app_eui,
+			region,
+			access_key_id,
+			secret_access_key,
+			topic_arn,
+			queue_url
+		) values ($1, $2, $3, $4, $5, $6)`,
+		i.AppEUI[:],
+		i.Region,
+		i.AccessKeyID,
+		i.SecretAccessKey,
+		i.TopicARN,
+		i.QueueURL,
+	)
+	if err != nil {
+		return fmt.Errorf("create aws sns integration error: %s", err)
+	}
+	log.WithField("app_eui", i.AppEUI).Info("aws sns integration created")
+	return nil
+}
+
+// GetAWSSNSIntegration returns the AWSSNSIntegration for the given AppEUI.
+// When the application doesn't have an AWS SNS integration, nil is
+// returned.
+func GetAWSSNSIntegration(db *sqlx.DB, appEUI lorawan.EUI64) (*AWSSNSIntegration, error) {
+	var i AWSSNSIntegration
+	err := db.Get(&i, "select * from aws_sns_integration where app_eui = $1", appEUI[:])
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get aws sns integration %s error: %s", appEUI, err)
+	}
+	return &i, nil
+}
+
+// GetAWSSNSIntegrationsWithQueue returns the AWS SNS integrations having a
+// SQS queue for consuming the downlink payloads.
+func GetAWSSNSIntegrationsWithQueue(db *sqlx.DB) ([]AWSSNSIntegration, error) {
+	var integrations []AWSSNSIntegration
+	err := db.Select(&integrations, "select * from aws_sns_integration where queue_url != '' order by app_eui")
+	if err != nil {
+		return nil, fmt.Errorf("get aws sns integrations error: %s", err)
+	}
+	return integrations, nil
+}
+
+// UpdateAWSSNSIntegration updates the given AWSSNSIntegration.
+func UpdateAWSSNSIntegration(db *sqlx.DB, i AWSSNSIntegration) error {
+	res, err := db.Exec(`
+		update aws_sns_integration set
+			region = $2,
+			access_key_id = $3,
+			secret_access_key = $4,
+			topic_arn = $5,
+			queue_url = $6
+		where app_eui = $1`,
+		i.AppEUI[:],
+		i.Region,
+		i.AccessKeyID,
+		i.SecretAccessKey,
+		i.TopicARN,
+		i.QueueURL,
+	)
+	if err != nil {
+		return fmt.Errorf("update aws sns integration error: %s", err)
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if ra == 0 {
+		return fmt.Errorf("aws sns integration %s does not exist", i.AppEUI)
+	}
+	log.WithField("app_eui", i.AppEUI).Info("aws sns integration updated")
+	return nil
+}
+
+// DeleteAWSSNSIntegration deletes the AWSSNSIntegration of the given
+// AppEUI.
+func DeleteAWSSNSIntegration(db *sqlx.DB, appEUI lorawan.EUI64) error {
+	res, err := db.Exec("delete from aws_sns_integration where app_eui = $1", appEUI[:])
+	if err != nil {
+		return fmt.Errorf("delete aws sns integration error: %s", err)
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if ra == 0 {
+		return fmt.Errorf("aws sns integration %s does not exist", appEUI)
+	}
+	log.WithField("app_eui", appEUI).Info("aws sns integration deleted")
+	return nil
+}
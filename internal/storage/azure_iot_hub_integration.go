@@ -0,0 +1,111 @@
+package storage
+
+import (
+	"database/sql"
+	"fmt"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/brocaar/lorawan"
+)
+
+// AzureIoTHubIntegration defines the Azure IoT Hub integration of an
+// application. The events are sent as device-to-cloud messages of the IoT
+// Hub devices matching the DevEUI of the nodes, using the given (IoT Hub
+// shared access policy) connection string (stored encrypted). When
+// C2DEnabled is set, the cloud-to-device messages of these devices are
+// received as downlink payloads.
+type AzureIoTHubIntegration struct {
+	AppEUI           lorawan.EUI64   `db:"app_eui"`
+	ConnectionString EncryptedString `db:"connection_string"`
+	C2DEnabled       bool            `db:"c2d_enabled"`
+}
+
+// CreateAzureIoTHubIntegration creates the given AzureIoTHubIntegration.
+func CreateAzureIoTHubIntegration(db *sqlx.DB, i AzureIoTHubIntegration) error {
+	_, err := db.Exec(`
+		insert into azure_iot_hub_integration (
+			app_eui,
+			connection_string,
+			c2d_enabled
+		) values ($1, $2, $3)`,
+		i.AppEUI[:],
+		i.ConnectionString,
+		i.C2DEnabled,
+	)
+	if err != nil {
+		return fmt.Errorf("create azure iot hub integration error: %s", err)
+	}
+	log.WithField("app_eui", i.AppEUI).Info("azure iot hub integration created")
+	return nil
+}
+
+// GetAzureIoTHubIntegration returns the AzureIoTHubIntegration for the
+// given AppEUI. When the application doesn't have an Azure IoT Hub
+// integration, nil is returned.
+func GetAzureIoTHubIntegration(db *sqlx.DB, appEUI lorawan.EUI64) (*AzureIoTHubIntegration, error) {
+	var i AzureIoTHubIntegration
+	err := db.Get(&i, "select * from azure_iot_hub_integration where app_eui = $1", appEUI[:])
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get azure iot hub integration %s error: %s", appEUI, err)
+	}
+	return &i, nil
+}
+
+// GetAzureIoTHubIntegrationsWithC2D returns the AzureIoTHubIntegration
+// items having the cloud-to-device messages enabled.
+func GetAzureIoTHubIntegrationsWithC2D(db *sqlx.DB) ([]AzureIoTHubIntegration, error) {
+	var items []AzureIoTHubIntegration
+	err := db.Select(&items, "select * from azure_iot_hub_integration where c2d_enabled = true order by app_eui")
+	if err != nil {
+		return nil, fmt.Errorf("get azure iot hub integrations error: %s", err)
+	}
+	return items, nil
+}
+
+// UpdateAzureIoTHubIntegration updates the given AzureIoTHubIntegration.
+func UpdateAzureIoTHubIntegration(db *sqlx.DB, i AzureIoTHubIntegration) error {
+	res, err := db.Exec(`
+		update azure_iot_hub_integration set
+			connection_string = $2,
+			c2d_enabled = $3
+		where app_eui = $1`,
+		i.AppEUI[:],
+		i.ConnectionString,
+		i.C2DEnabled,
+	)
+	if err != nil {
+		return fmt.Errorf("update azure iot hub integration error: %s", err)
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if ra == 0 {
+		return fmt.Errorf("azure iot hub integration %s does not exist", i.AppEUI)
+	}
+	log.WithField("app_eui", i.AppEUI).Info("azure iot hub integration updated")
+	return nil
+}
+
+// DeleteAzureIoTHubIntegration deletes the AzureIoTHubIntegration of the
+// given AppEUI.
+func DeleteAzureIoTHubIntegration(db *sqlx.DB, appEUI lorawan.EUI64) error {
+	res, err := db.Exec("delete from azure_iot_hub_integration where app_eui = $1", appEUI[:])
+	if err != nil {
+		return fmt.Errorf("delete azure iot hub integration error: %s", err)
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if ra == 0 {
+		return fmt.Errorf("azure iot hub integration %s does not exist", appEUI)
+	}
+	log.WithField("app_eui", appEUI).Info("azure iot hub integration deleted")
+	return nil
+}
@@ -0,0 +1,77 @@
+package storage
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// credentialKey contains the AES-256 key used for encrypting the credentials
+// of the (cloud) integrations.
+var credentialKey []byte
+
+// SetCredentialKey sets the (32 bytes) AES-256 key used for encrypting the
+// credentials of the (cloud) integrations stored in the database. Without
+// key, these integrations can't be stored or read.
+func SetCredentialKey(key []byte) error {
+	if len(key) != 32 {
+		return fmt.Errorf("credential key must be 32 bytes, got: %d", len(key))
+	}
+	credentialKey = key
+	return nil
+}
+
+// EncryptedString implements a string which is stored encrypted (AES-GCM)
+// in the database, using the key set by SetCredentialKey.
+type EncryptedString string
+
+// Scan implements the sql.Scanner interface.
+func (s *EncryptedString) Scan(src interface{}) error {
+	b, ok := src.([]byte)
+	if !ok {
+		return fmt.Errorf("src must be of type []byte, got: %T", src)
+	}
+
+	aead, err := credentialAEAD()
+	if err != nil {
+		return err
+	}
+	if len(b) < aead.NonceSize() {
+		return errors.New("encrypted value is too short")
+	}
+	plaintext, err := aead.Open(nil, b[:aead.NonceSize()], b[aead.NonceSize():], nil)
+	if err != nil {
+		return fmt.Errorf("decrypt error: %s", err)
+	}
+	*s = EncryptedString(plaintext)
+	return nil
+}
+
+// Value implements the driver.Valuer interface. The random nonce is
+// prepended to the ciphertext.
+func (s EncryptedString) Value() (driver.Value, error) {
+	aead, err := credentialAEAD()
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, fmt.Errorf("read random nonce error: %s", err)
+	}
+	return aead.Seal(nonce, nonce, []byte(s), nil), nil
+}
+
+func credentialAEAD() (cipher.AEAD, error) {
+	if credentialKey == nil {
+		return nil, errors.New("credential key is not configured")
+	}
+	block, err := aes.NewCipher(credentialKey)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,49 @@
+package storage
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestEncryptedString(t *testing.T) {
+	Convey("Given no credential key", t, func() {
+		credentialKey = nil
+
+		Convey("Then an EncryptedString can not be encrypted", func() {
+			_, err := EncryptedString("secret").Value()
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("Then an invalid key can not be set", func() {
+			So(SetCredentialKey([]byte{1, 2, 3}), ShouldNotBeNil)
+		})
+	})
+
+	Convey("Given a credential key", t, func() {
+		So(SetCredentialKey(make([]byte, 32)), ShouldBeNil)
+		defer func() { credentialKey = nil }()
+
+		Convey("When encrypting an EncryptedString", func() {
+			v, err := EncryptedString("secret").Value()
+			So(err, ShouldBeNil)
+			b := v.([]byte)
+
+			Convey("Then the value does not contain the plaintext", func() {
+				So(string(b), ShouldNotContainSubstring, "secret")
+			})
+
+			Convey("Then it can be decrypted", func() {
+				var s EncryptedString
+				So(s.Scan(b), ShouldBeNil)
+				So(s, ShouldEqual, EncryptedString("secret"))
+			})
+
+			Convey("Then a modified value can not be decrypted", func() {
+				b[len(b)-1] ^= 0xff
+				var s EncryptedString
+				So(s.Scan(b), ShouldNotBeNil)
+			})
+		})
+	})
+}
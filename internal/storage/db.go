@@ -1,7 +1,11 @@
 package storage
 
 import (
+	"crypto/tls"
 	"fmt"
+	"net"
+	"net/url"
+	"strconv"
 	"time"
 
 	"github.com/garyburd/redigo/redis"
@@ -15,19 +19,67 @@ const (
 	redisIdleTimeoutSec = 240
 )
 
+// RedisOptions contains the options of the Redis connections, overriding
+// the options of the Redis URL.
+type RedisOptions struct {
+	Password  string      // password (optional, overrides the password of the URL)
+	Database  int         // database index (optional, overrides the database of the URL when > 0)
+	TLSConfig *tls.Config // TLS configuration (optional, enables TLS)
+
+	// When SentinelAddrs is set, the address of the master named
+	// SentinelMaster is discovered using these Redis Sentinels (host:port)
+	// and the host of the URL is ignored.
+	SentinelAddrs  []string
+	SentinelMaster string
+}
+
 // NewRedisPool returns a new Redis connection pool.
 func NewRedisPool(redisURL string) *redis.Pool {
+	return NewRedisPoolWithOptions(redisURL, RedisOptions{})
+}
+
+// NewRedisPoolWithOptions returns a new Redis connection pool using the
+// given options. When using Redis Sentinel, the connections are made to
+// the current master and connections to a server which is no longer the
+// master (after a failover) are discarded when borrowed from the pool.
+func NewRedisPoolWithOptions(redisURL string, o RedisOptions) *redis.Pool {
+	var sentinel *redisSentinel
+	if len(o.SentinelAddrs) != 0 {
+		sentinel = newRedisSentinel(o.SentinelAddrs, o.SentinelMaster)
+	}
+
 	return &redis.Pool{
 		MaxIdle:     redisMaxIdle,
 		IdleTimeout: redisIdleTimeoutSec * time.Second,
 		Dial: func() (redis.Conn, error) {
-			c, err := redis.DialURL(redisURL)
+			var addr string
+			if sentinel != nil {
+				var err error
+				if addr, err = sentinel.masterAddr(); err != nil {
+					return nil, fmt.Errorf("redis connection error: %s", err)
+				}
+			}
+
+			u, err := o.url(redisURL, addr)
+			if err != nil {
+				return nil, fmt.Errorf("redis url error: %s", err)
+			}
+			var options []redis.DialOption
+			if o.TLSConfig != nil {
+				options = append(options, redis.DialTLSConfig(o.TLSConfig))
+			}
+
+			c, err := redis.DialURL(u, options...)
 			if err != nil {
 				return nil, fmt.Errorf("redis connection error: %s", err)
 			}
 			return c, err
 		},
 		TestOnBorrow: func(c redis.Conn, t time.Time) error {
+			if sentinel != nil {
+				return checkRedisMaster(c)
+			}
+
 			_, err := c.Do("PING")
 			if err != nil {
 				return fmt.Errorf("ping redis error: %s", err)
@@ -37,6 +89,49 @@ func NewRedisPool(redisURL string) *redis.Pool {
 	}
 }
 
+// DialOptions returns the password, database and TLS options as redigo
+// dial options, e.g. for connecting to the nodes of a Redis Cluster.
+func (o RedisOptions) DialOptions() []redis.DialOption {
+	var options []redis.DialOption
+	if o.Password != "" {
+		options = append(options, redis.DialPassword(o.Password))
+	}
+	if o.Database > 0 {
+		options = append(options, redis.DialDatabase(o.Database))
+	}
+	if o.TLSConfig != nil {
+		// redis.Dial only enables TLS for rediss:// URLs
+		options = append(options, redis.DialNetDial(func(network, addr string) (net.Conn, error) {
+			return tls.Dial(network, addr, o.TLSConfig)
+		}))
+	}
+	return options
+}
+
+// url returns the given Redis URL with the host (when addr is not empty),
+// password, database and scheme replaced according to the options. As
+// redis.DialURL gives precedence to the URL over the dial options, the
+// options are set in the URL.
+func (o RedisOptions) url(redisURL, addr string) (string, error) {
+	u, err := url.Parse(redisURL)
+	if err != nil {
+		return "", err
+	}
+	if addr != "" {
+		u.Host = addr
+	}
+	if o.Password != "" {
+		u.User = url.UserPassword("", o.Password)
+	}
+	if o.Database > 0 {
+		u.Path = "/" + strconv.Itoa(o.Database)
+	}
+	if o.TLSConfig != nil {
+		u.Scheme = "rediss"
+	}
+	return u.String(), nil
+}
+
 // OpenDatabase opens the database and performs a ping to make sure the
 // database is up.
 func OpenDatabase(dsn string) (*sqlx.DB, error) {
@@ -0,0 +1,52 @@
+package storage
+
+import (
+	"crypto/tls"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestRedisOptions(t *testing.T) {
+	Convey("Given a set of Redis options", t, func() {
+		tests := []struct {
+			Description string
+			URL         string
+			Addr        string
+			Options     RedisOptions
+			Expected    string
+		}{
+			{
+				Description: "without options the url is unchanged",
+				URL:         "redis://:secret@localhost:6379/1",
+				Expected:    "redis://:secret@localhost:6379/1",
+			},
+			{
+				Description: "the password and database override the url",
+				URL:         "redis://:secret@localhost:6379/1",
+				Options:     RedisOptions{Password: "other", Database: 2},
+				Expected:    "redis://:other@localhost:6379/2",
+			},
+			{
+				Description: "tls enables the rediss scheme",
+				URL:         "redis://localhost:6379",
+				Options:     RedisOptions{TLSConfig: &tls.Config{}},
+				Expected:    "rediss://localhost:6379",
+			},
+			{
+				Description: "the master address (sentinel) replaces the host",
+				URL:         "redis://:secret@localhost:6379/1",
+				Addr:        "10.0.0.1:6380",
+				Expected:    "redis://:secret@10.0.0.1:6380/1",
+			},
+		}
+
+		for _, test := range tests {
+			Convey("Test: "+test.Description, func() {
+				u, err := test.Options.url(test.URL, test.Addr)
+				So(err, ShouldBeNil)
+				So(u, ShouldEqual, test.Expected)
+			})
+		}
+	})
+}
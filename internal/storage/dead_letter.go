@@ -0,0 +1,146 @@
+package storage
+
+import (
+	"fmt"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/brocaar/lorawan"
+)
+
+// DeadLetter contains an event which could not be delivered by a handler
+// (integration), after all retries.
+type DeadLetter struct {
+	ID        int64         `db:"id" json:"id"`
+	CreatedAt time.Time     `db:"created_at" json:"createdAt"`
+	Handler   string        `db:"handler" json:"handler"` // name of the handler (e.g. mqtt or http)
+	AppEUI    lorawan.EUI64 `db:"app_eui" json:"appEUI"`
+	DevEUI    lorawan.EUI64 `db:"dev_eui" json:"devEUI"`
+	Type      string        `db:"type" json:"type"`
+	Payload   []byte        `db:"payload" json:"payload"` // JSON encoded payload
+	Error     string        `db:"error" json:"error"`     // error of the last attempt
+	Attempts  int           `db:"attempts" json:"attempts"`
+}
+
+// CreateDeadLetter creates the given dead letter.
+func CreateDeadLetter(db *sqlx.DB, d *DeadLetter) error {
+	d.CreatedAt = time.Now()
+	err := db.Get(&d.ID, `
+		insert into dead_letter (
+			created_at,
+			handler,
+			app_eui,
+			dev_eui,
+			type,
+			payload,
+			error,
+			attempts
+		) values ($1, $2, $3, $4, $5, $6, $7, $8)
+		returning id`,
+		d.CreatedAt,
+		d.Handler,
+		d.AppEUI[:],
+		d.DevEUI[:],
+		d.Type,
+		d.Payload,
+		d.Error,
+		d.Attempts,
+	)
+	if err != nil {
+		return fmt.Errorf("create dead letter error: %s", err)
+	}
+	log.WithFields(log.Fields{
+		"id":      d.ID,
+		"handler": d.Handler,
+		"dev_eui": d.DevEUI,
+	}).Info("dead letter created")
+	return nil
+}
+
+// GetDeadLetter returns the dead letter matching the given id.
+func GetDeadLetter(db *sqlx.DB, id int64) (DeadLetter, error) {
+	var d DeadLetter
+	err := db.Get(&d, "select * from dead_letter where id = $1", id)
+	if err != nil {
+		return d, fmt.Errorf("get dead letter %d error: %s", id, err)
+	}
+	return d, nil
+}
+
+// GetDeadLetterCount returns the number of dead letters of the given
+// AppEUI. When handler is not empty, only the dead letters of the given
+// handler are counted.
+func GetDeadLetterCount(db *sqlx.DB, appEUI lorawan.EUI64, handler string) (int, error) {
+	var count int
+	err := db.Get(&count, `
+		select count(*)
+		from dead_letter
+		where
+			app_eui = $1
+			and ($2 = '' or handler = $2)`,
+		appEUI[:],
+		handler,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("get dead letter count error: %s", err)
+	}
+	return count, nil
+}
+
+// GetDeadLetters returns the dead letters of the given AppEUI, oldest
+// first. When handler is not empty, only the dead letters of the given
+// handler are returned.
+func GetDeadLetters(db *sqlx.DB, appEUI lorawan.EUI64, handler string, limit, offset int) ([]DeadLetter, error) {
+	var letters []DeadLetter
+	err := db.Select(&letters, `
+		select *
+		from dead_letter
+		where
+			app_eui = $1
+			and ($2 = '' or handler = $2)
+		order by created_at, id
+		limit $3 offset $4`,
+		appEUI[:],
+		handler,
+		limit,
+		offset,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("get dead letters error: %s", err)
+	}
+	return letters, nil
+}
+
+// DeleteDeadLetter deletes the dead letter matching the given id.
+func DeleteDeadLetter(db *sqlx.DB, id int64) error {
+	res, err := db.Exec("delete from dead_letter where id = $1", id)
+	if err != nil {
+		return fmt.Errorf("delete dead letter error: %s", err)
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if ra == 0 {
+		return fmt.Errorf("dead letter %d does not exist", id)
+	}
+	log.WithField("id", id).Info("dead letter deleted")
+	return nil
+}
+
+// DeleteDeadLettersBefore deletes the dead letters created before the given
+// time.
+func DeleteDeadLettersBefore(db *sqlx.DB, before time.Time) error {
+	res, err := db.Exec("delete from dead_letter where created_at < $1", before)
+	if err != nil {
+		return fmt.Errorf("delete dead letters error: %s", err)
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	log.WithField("count", ra).Info("dead letters deleted")
+	return nil
+}
@@ -0,0 +1,116 @@
+package storage
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/brocaar/lorawan"
+)
+
+// DeviceStatusAlert defines the device status thresholds of an application.
+// An error notification is published when the device status reported by a
+// node of the application crosses one of these thresholds.
+type DeviceStatusAlert struct {
+	AppEUI          lorawan.EUI64 `db:"app_eui"`
+	MinBatteryLevel float64       `db:"min_battery_level"` // battery level in percent (0 = disabled)
+	MinLinkMargin   int           `db:"min_link_margin"`   // link margin in dB (0 = disabled)
+}
+
+// Validate validates the DeviceStatusAlert.
+func (a DeviceStatusAlert) Validate() error {
+	if a.MinBatteryLevel < 0 || a.MinBatteryLevel > 100 {
+		return errors.New("min battery level must be between 0 and 100")
+	}
+	if a.MinLinkMargin < -32 || a.MinLinkMargin > 31 {
+		return errors.New("min link margin must be between -32 and 31")
+	}
+	return nil
+}
+
+// CreateDeviceStatusAlert creates the given DeviceStatusAlert.
+func CreateDeviceStatusAlert(db *sqlx.DB, a DeviceStatusAlert) error {
+	if err := a.Validate(); err != nil {
+		return err
+	}
+
+	_, err := db.Exec(`
+		insert into device_status_alert (
+			app_eui,
+			min_battery_level,
+			min_link_margin
+		) values ($1, $2, $3)`,
+		a.AppEUI[:],
+		a.MinBatteryLevel,
+		a.MinLinkMargin,
+	)
+	if err != nil {
+		return fmt.Errorf("create device status alert error: %s", err)
+	}
+	log.WithField("app_eui", a.AppEUI).Info("device status alert created")
+	return nil
+}
+
+// GetDeviceStatusAlert returns the DeviceStatusAlert for the given AppEUI.
+// When the application doesn't have device status alerts, nil is returned.
+func GetDeviceStatusAlert(db *sqlx.DB, appEUI lorawan.EUI64) (*DeviceStatusAlert, error) {
+	var a DeviceStatusAlert
+	err := db.Get(&a, "select * from device_status_alert where app_eui = $1", appEUI[:])
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get device status alert %s error: %s", appEUI, err)
+	}
+	return &a, nil
+}
+
+// UpdateDeviceStatusAlert updates the given DeviceStatusAlert.
+func UpdateDeviceStatusAlert(db *sqlx.DB, a DeviceStatusAlert) error {
+	if err := a.Validate(); err != nil {
+		return err
+	}
+
+	res, err := db.Exec(`
+		update device_status_alert set
+			min_battery_level = $2,
+			min_link_margin = $3
+		where app_eui = $1`,
+		a.AppEUI[:],
+		a.MinBatteryLevel,
+		a.MinLinkMargin,
+	)
+	if err != nil {
+		return fmt.Errorf("update device status alert error: %s", err)
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if ra == 0 {
+		return fmt.Errorf("device status alert %s does not exist", a.AppEUI)
+	}
+	log.WithField("app_eui", a.AppEUI).Info("device status alert updated")
+	return nil
+}
+
+// DeleteDeviceStatusAlert deletes the DeviceStatusAlert of the given
+// AppEUI.
+func DeleteDeviceStatusAlert(db *sqlx.DB, appEUI lorawan.EUI64) error {
+	res, err := db.Exec("delete from device_status_alert where app_eui = $1", appEUI[:])
+	if err != nil {
+		return fmt.Errorf("delete device status alert error: %s", err)
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if ra == 0 {
+		return fmt.Errorf("device status alert %s does not exist", appEUI)
+	}
+	log.WithField("app_eui", appEUI).Info("device status alert deleted")
+	return nil
+}
@@ -0,0 +1,194 @@
+package storage
+
+import (
+	"fmt"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/brocaar/lorawan"
+)
+
+// Downlink delivery statuses.
+const (
+	DeliveryStatusQueued       = "QUEUED"       // waiting in the downlink queue
+	DeliveryStatusSent         = "SENT"         // unconfirmed payload sent to the network-server
+	DeliveryStatusPending      = "PENDING"      // confirmed payload sent, waiting for the ack
+	DeliveryStatusAcknowledged = "ACKNOWLEDGED" // confirmed payload acknowledged by the node
+	DeliveryStatusNACK         = "NACK"         // confirmed payload not acknowledged within the frame-counter gap
+	DeliveryStatusTimeout      = "TIMEOUT"      // confirmed payload not acknowledged within the timeout
+	DeliveryStatusCancelled    = "CANCELLED"    // removed from the queue before it was acknowledged
+)
+
+// DownlinkDelivery contains the delivery status of a downlink queue item.
+// The ID is equal to the ID of the downlink queue item. Unlike the queue
+// item, the delivery is kept after the payload has been sent.
+type DownlinkDelivery struct {
+	ID        int64         `db:"id"`
+	CreatedAt time.Time     `db:"created_at"`
+	UpdatedAt time.Time     `db:"updated_at"`
+	DevEUI    lorawan.EUI64 `db:"dev_eui"`
+	Reference string        `db:"reference"`
+	Confirmed bool          `db:"confirmed"`
+	Status    string        `db:"status"`
+	FCnt      *uint32       `db:"f_cnt"`   // downlink frame-counter of the first transmission
+	SentAt    *time.Time    `db:"sent_at"` // time of the first transmission
+}
+
+// GetDownlinkDelivery returns the downlink delivery for the given ID.
+func GetDownlinkDelivery(db *sqlx.DB, id int64) (DownlinkDelivery, error) {
+	var d DownlinkDelivery
+	err := db.Get(&d, "select * from downlink_delivery where id = $1", id)
+	if err != nil {
+		return d, fmt.Errorf("get downlink delivery error: %s", err)
+	}
+	return d, nil
+}
+
+// UpdateDownlinkDelivery updates the given downlink delivery.
+func UpdateDownlinkDelivery(db *sqlx.DB, d *DownlinkDelivery) error {
+	d.UpdatedAt = time.Now()
+	res, err := db.Exec(`
+		update downlink_delivery
+		set
+			updated_at = $2,
+			status = $3,
+			f_cnt = $4,
+			sent_at = $5
+		where id = $1`,
+		d.ID,
+		d.UpdatedAt,
+		d.Status,
+		d.FCnt,
+		d.SentAt,
+	)
+	if err != nil {
+		return fmt.Errorf("update downlink delivery error: %s", err)
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if ra == 0 {
+		return fmt.Errorf("downlink delivery %d does not exist", d.ID)
+	}
+	log.WithFields(log.Fields{
+		"id":     d.ID,
+		"status": d.Status,
+	}).Info("downlink delivery updated")
+	return nil
+}
+
+// CancelDownlinkDeliveries sets the status of the queued and pending
+// deliveries of the given DevEUI to cancelled. When id is not 0, only the
+// delivery with the given ID is cancelled.
+func CancelDownlinkDeliveries(db *sqlx.DB, devEUI lorawan.EUI64, id int64) error {
+	_, err := db.Exec(`
+		update downlink_delivery
+		set
+			updated_at = $3,
+			status = $4
+		where
+			dev_eui = $1
+			and ($2 = 0 or id = $2)
+			and status in ($5, $6)`,
+		devEUI[:],
+		id,
+		time.Now(),
+		DeliveryStatusCancelled,
+		DeliveryStatusQueued,
+		DeliveryStatusPending,
+	)
+	if err != nil {
+		return fmt.Errorf("cancel downlink deliveries error: %s", err)
+	}
+	return nil
+}
+
+// GetDownlinkDeliveryCount returns the number of downlink deliveries for the
+// given DevEUI. When reference is not empty, only the deliveries with the
+// given reference are counted.
+func GetDownlinkDeliveryCount(db *sqlx.DB, devEUI lorawan.EUI64, reference string) (int, error) {
+	var count int
+	err := db.Get(&count, `
+		select count(*)
+		from downlink_delivery
+		where
+			dev_eui = $1
+			and ($2 = '' or reference = $2)`,
+		devEUI[:],
+		reference,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("get downlink delivery count error: %s", err)
+	}
+	return count, nil
+}
+
+// GetDownlinkDeliveries returns the downlink deliveries for the given
+// DevEUI, newest first. When reference is not empty, only the deliveries
+// with the given reference are returned.
+func GetDownlinkDeliveries(db *sqlx.DB, devEUI lorawan.EUI64, reference string, limit, offset int) ([]DownlinkDelivery, error) {
+	var deliveries []DownlinkDelivery
+	err := db.Select(&deliveries, `
+		select *
+		from downlink_delivery
+		where
+			dev_eui = $1
+			and ($2 = '' or reference = $2)
+		order by id desc
+		limit $3 offset $4`,
+		devEUI[:],
+		reference,
+		limit,
+		offset,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("get downlink deliveries error: %s", err)
+	}
+	return deliveries, nil
+}
+
+// GetPendingDownlinkDeliveriesSentBefore returns the pending deliveries of
+// which the first transmission was before the given time.
+func GetPendingDownlinkDeliveriesSentBefore(db *sqlx.DB, before time.Time) ([]DownlinkDelivery, error) {
+	var deliveries []DownlinkDelivery
+	err := db.Select(&deliveries, `
+		select *
+		from downlink_delivery
+		where
+			status = $1
+			and sent_at < $2
+		order by id`,
+		DeliveryStatusPending,
+		before,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("get pending downlink deliveries error: %s", err)
+	}
+	return deliveries, nil
+}
+
+// DeleteDownlinkDeliveriesBefore deletes the completed downlink deliveries
+// that were last updated before the given time.
+func DeleteDownlinkDeliveriesBefore(db *sqlx.DB, before time.Time) error {
+	res, err := db.Exec(`
+		delete from downlink_delivery
+		where
+			updated_at < $1
+			and status not in ($2, $3)`,
+		before,
+		DeliveryStatusQueued,
+		DeliveryStatusPending,
+	)
+	if err != nil {
+		return fmt.Errorf("delete downlink deliveries error: %s", err)
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	log.WithField("count", ra).Info("downlink deliveries deleted")
+	return nil
+}
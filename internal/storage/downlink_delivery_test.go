@@ -0,0 +1,105 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/brocaar/lora-app-server/internal/test"
+)
+
+func TestDownlinkDelivery(t *testing.T) {
+	conf := test.GetConfig()
+
+	Convey("Given a clean database with node", t, func() {
+		db, err := OpenDatabase(conf.PostgresDSN)
+		So(err, ShouldBeNil)
+		test.MustResetDB(db)
+
+		node := Node{
+			DevEUI: [8]byte{1, 2, 3, 4, 5, 6, 7, 8},
+		}
+		So(CreateNode(db, node), ShouldBeNil)
+
+		Convey("When creating a confirmed downlink queue item", func() {
+			qi := DownlinkQueueItem{
+				DevEUI:    node.DevEUI,
+				Reference: "abc",
+				Confirmed: true,
+				FPort:     1,
+				Data:      []byte{1, 2, 3},
+			}
+			So(CreateDownlinkQueueItem(db, &qi), ShouldBeNil)
+
+			Convey("Then a queued delivery has been created with the same id", func() {
+				d, err := GetDownlinkDelivery(db, qi.ID)
+				So(err, ShouldBeNil)
+				So(d.DevEUI, ShouldEqual, node.DevEUI)
+				So(d.Reference, ShouldEqual, "abc")
+				So(d.Confirmed, ShouldBeTrue)
+				So(d.Status, ShouldEqual, DeliveryStatusQueued)
+				So(d.FCnt, ShouldBeNil)
+				So(d.SentAt, ShouldBeNil)
+			})
+
+			Convey("Then the delivery is listed by reference", func() {
+				count, err := GetDownlinkDeliveryCount(db, node.DevEUI, "abc")
+				So(err, ShouldBeNil)
+				So(count, ShouldEqual, 1)
+
+				deliveries, err := GetDownlinkDeliveries(db, node.DevEUI, "abc", 10, 0)
+				So(err, ShouldBeNil)
+				So(deliveries, ShouldHaveLength, 1)
+
+				count, err = GetDownlinkDeliveryCount(db, node.DevEUI, "def")
+				So(err, ShouldBeNil)
+				So(count, ShouldEqual, 0)
+			})
+
+			Convey("When the delivery has been set to pending", func() {
+				d, err := GetDownlinkDelivery(db, qi.ID)
+				So(err, ShouldBeNil)
+				fCnt := uint32(10)
+				sentAt := time.Now().Add(-time.Minute)
+				d.FCnt = &fCnt
+				d.SentAt = &sentAt
+				d.Status = DeliveryStatusPending
+				So(UpdateDownlinkDelivery(db, &d), ShouldBeNil)
+
+				Convey("Then it is returned as pending when sent before the given time", func() {
+					deliveries, err := GetPendingDownlinkDeliveriesSentBefore(db, time.Now())
+					So(err, ShouldBeNil)
+					So(deliveries, ShouldHaveLength, 1)
+					So(*deliveries[0].FCnt, ShouldEqual, 10)
+
+					deliveries, err = GetPendingDownlinkDeliveriesSentBefore(db, sentAt.Add(-time.Second))
+					So(err, ShouldBeNil)
+					So(deliveries, ShouldHaveLength, 0)
+				})
+
+				Convey("When cancelling the deliveries of the node", func() {
+					So(CancelDownlinkDeliveries(db, node.DevEUI, 0), ShouldBeNil)
+
+					Convey("Then the delivery has been cancelled", func() {
+						d, err := GetDownlinkDelivery(db, qi.ID)
+						So(err, ShouldBeNil)
+						So(d.Status, ShouldEqual, DeliveryStatusCancelled)
+					})
+
+					Convey("Then it is deleted by DeleteDownlinkDeliveriesBefore", func() {
+						So(DeleteDownlinkDeliveriesBefore(db, time.Now().Add(time.Second)), ShouldBeNil)
+						_, err := GetDownlinkDelivery(db, qi.ID)
+						So(err, ShouldNotBeNil)
+					})
+				})
+
+				Convey("Then it is not deleted by DeleteDownlinkDeliveriesBefore", func() {
+					So(DeleteDownlinkDeliveriesBefore(db, time.Now().Add(time.Second)), ShouldBeNil)
+					_, err := GetDownlinkDelivery(db, qi.ID)
+					So(err, ShouldBeNil)
+				})
+			})
+		})
+	})
+}
@@ -0,0 +1,122 @@
+package storage
+
+import (
+	"fmt"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+
+	"github.com/brocaar/lorawan"
+)
+
+// DownlinkFPortPolicy restricts the downlink transmissions on the given
+// FPort to the given principals. When DevEUI is nil, the policy applies to
+// all the nodes of the application.
+type DownlinkFPortPolicy struct {
+	ID         int64          `db:"id"`
+	AppEUI     lorawan.EUI64  `db:"app_eui"`
+	DevEUI     *lorawan.EUI64 `db:"dev_eui"`
+	FPort      uint8          `db:"fport"`
+	Principals pq.StringArray `db:"principals"`
+}
+
+// CreateDownlinkFPortPolicy creates the given DownlinkFPortPolicy.
+func CreateDownlinkFPortPolicy(db *sqlx.DB, p *DownlinkFPortPolicy) error {
+	var devEUI []byte
+	if p.DevEUI != nil {
+		devEUI = p.DevEUI[:]
+	}
+
+	err := db.Get(&p.ID, `
+		insert into downlink_fport_policy (
+			app_eui,
+			dev_eui,
+			fport,
+			principals
+		) values ($1, $2, $3, $4) returning id`,
+		p.AppEUI[:],
+		devEUI,
+		p.FPort,
+		p.Principals,
+	)
+	if err != nil {
+		return fmt.Errorf("create downlink fport policy error: %s", err)
+	}
+	log.WithFields(log.Fields{
+		"id":      p.ID,
+		"app_eui": p.AppEUI,
+		"fport":   p.FPort,
+	}).Info("downlink fport policy created")
+	return nil
+}
+
+// GetDownlinkFPortPolicy returns the DownlinkFPortPolicy for the given id.
+func GetDownlinkFPortPolicy(db *sqlx.DB, id int64) (DownlinkFPortPolicy, error) {
+	var p DownlinkFPortPolicy
+	err := db.Get(&p, "select * from downlink_fport_policy where id = $1", id)
+	if err != nil {
+		return p, fmt.Errorf("get downlink fport policy %d error: %s", id, err)
+	}
+	return p, nil
+}
+
+// GetDownlinkFPortPoliciesForAppEUI returns the downlink fport policies of
+// the given AppEUI.
+func GetDownlinkFPortPoliciesForAppEUI(db *sqlx.DB, appEUI lorawan.EUI64) ([]DownlinkFPortPolicy, error) {
+	var policies []DownlinkFPortPolicy
+	err := db.Select(&policies, "select * from downlink_fport_policy where app_eui = $1 order by fport, id", appEUI[:])
+	if err != nil {
+		return nil, fmt.Errorf("get downlink fport policies error: %s", err)
+	}
+	return policies, nil
+}
+
+// GetDownlinkFPortPrincipals returns the principals allowed to send
+// downlink data to the given node on the given FPort. When the FPort is not
+// restricted by any policy, nil is returned.
+func GetDownlinkFPortPrincipals(db *sqlx.DB, appEUI, devEUI lorawan.EUI64, fPort uint8) ([]string, error) {
+	var policies []DownlinkFPortPolicy
+	err := db.Select(&policies, `
+		select *
+		from downlink_fport_policy
+		where
+			app_eui = $1
+			and fport = $2
+			and (dev_eui is null or dev_eui = $3)`,
+		appEUI[:],
+		fPort,
+		devEUI[:],
+	)
+	if err != nil {
+		return nil, fmt.Errorf("get downlink fport policies error: %s", err)
+	}
+
+	if len(policies) == 0 {
+		return nil, nil
+	}
+
+	principals := []string{}
+	for _, p := range policies {
+		principals = append(principals, p.Principals...)
+	}
+	return principals, nil
+}
+
+// DeleteDownlinkFPortPolicy deletes the DownlinkFPortPolicy matching the
+// given id.
+func DeleteDownlinkFPortPolicy(db *sqlx.DB, id int64) error {
+	res, err := db.Exec("delete from downlink_fport_policy where id = $1", id)
+	if err != nil {
+		return fmt.Errorf("delete downlink fport policy %d error: %s", id, err)
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if ra == 0 {
+		return fmt.Errorf("downlink fport policy %d does not exist", id)
+	}
+	log.WithField("id", id).Info("downlink fport policy deleted")
+	return nil
+}
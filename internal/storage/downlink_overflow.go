@@ -0,0 +1,67 @@
+package storage
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// DownlinkOverflowItem represents a (raw) downlink message which was
+// received by the MQTT handler while its tx buffer was full. It is stored
+// until handled by the persister of any instance.
+type DownlinkOverflowItem struct {
+	ID        int64     `db:"id"`
+	CreatedAt time.Time `db:"created_at"`
+	Topic     string    `db:"topic"`
+	Payload   []byte    `db:"payload"`
+}
+
+// CreateDownlinkOverflowItem stores the given message, received on the
+// given topic.
+func CreateDownlinkOverflowItem(db sqlx.Execer, topic string, payload []byte) error {
+	_, err := db.Exec(`
+		insert into downlink_overflow (
+			created_at,
+			topic,
+			payload
+		) values ($1, $2, $3)`,
+		time.Now(),
+		topic,
+		payload,
+	)
+	if err != nil {
+		return fmt.Errorf("create downlink overflow item error: %s", err)
+	}
+	return nil
+}
+
+// GetDownlinkOverflowItemsForUpdate returns (at most limit) stored
+// messages, oldest first. The items are locked until the end of the given
+// transaction, items locked by an other transaction are skipped.
+func GetDownlinkOverflowItemsForUpdate(tx *sqlx.Tx, limit int) ([]DownlinkOverflowItem, error) {
+	var items []DownlinkOverflowItem
+	err := tx.Select(&items, `
+		select *
+		from downlink_overflow
+		order by id
+		limit $1
+		for update skip locked`,
+		limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("get downlink overflow items error: %s", err)
+	}
+	return items, nil
+}
+
+// DeleteDownlinkOverflowItems deletes the stored messages matching the
+// given ids.
+func DeleteDownlinkOverflowItems(db sqlx.Execer, ids []int64) error {
+	_, err := db.Exec("delete from downlink_overflow where id = any($1)", pq.Int64Array(ids))
+	if err != nil {
+		return fmt.Errorf("delete downlink overflow items error: %s", err)
+	}
+	return nil
+}
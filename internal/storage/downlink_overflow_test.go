@@ -0,0 +1,74 @@
+package storage
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/brocaar/lora-app-server/internal/test"
+)
+
+func TestDownlinkOverflow(t *testing.T) {
+	conf := test.GetConfig()
+
+	Convey("Given a clean database", t, func() {
+		db, err := OpenDatabase(conf.PostgresDSN)
+		So(err, ShouldBeNil)
+		test.MustResetDB(db)
+
+		Convey("When storing two overflow items", func() {
+			So(CreateDownlinkOverflowItem(db, "application/0102030405060708/node/0807060504030201/tx", []byte("a")), ShouldBeNil)
+			So(CreateDownlinkOverflowItem(db, "application/0102030405060708/node/0807060504030201/tx", []byte("b")), ShouldBeNil)
+
+			Convey("Then the items are returned oldest first", func() {
+				tx, err := db.Beginx()
+				So(err, ShouldBeNil)
+				defer tx.Rollback()
+
+				items, err := GetDownlinkOverflowItemsForUpdate(tx, 10)
+				So(err, ShouldBeNil)
+				So(items, ShouldHaveLength, 2)
+				So(items[0].Topic, ShouldEqual, "application/0102030405060708/node/0807060504030201/tx")
+				So(items[0].Payload, ShouldResemble, []byte("a"))
+				So(items[1].Payload, ShouldResemble, []byte("b"))
+
+				Convey("Then the locked items are skipped by an other transaction", func() {
+					tx2, err := db.Beginx()
+					So(err, ShouldBeNil)
+					defer tx2.Rollback()
+
+					items, err := GetDownlinkOverflowItemsForUpdate(tx2, 10)
+					So(err, ShouldBeNil)
+					So(items, ShouldHaveLength, 0)
+				})
+
+				Convey("When deleting the first item", func() {
+					So(DeleteDownlinkOverflowItems(tx, []int64{items[0].ID}), ShouldBeNil)
+					So(tx.Commit(), ShouldBeNil)
+
+					Convey("Then only the second item is returned", func() {
+						tx, err := db.Beginx()
+						So(err, ShouldBeNil)
+						defer tx.Rollback()
+
+						items, err := GetDownlinkOverflowItemsForUpdate(tx, 10)
+						So(err, ShouldBeNil)
+						So(items, ShouldHaveLength, 1)
+						So(items[0].Payload, ShouldResemble, []byte("b"))
+					})
+				})
+			})
+
+			Convey("Then the number of returned items is limited", func() {
+				tx, err := db.Beginx()
+				So(err, ShouldBeNil)
+				defer tx.Rollback()
+
+				items, err := GetDownlinkOverflowItemsForUpdate(tx, 1)
+				So(err, ShouldBeNil)
+				So(items, ShouldHaveLength, 1)
+				So(items[0].Payload, ShouldResemble, []byte("a"))
+			})
+		})
+	})
+}
@@ -3,6 +3,7 @@ package storage
 import (
 	"database/sql"
 	"fmt"
+	"time"
 
 	log "github.com/Sirupsen/logrus"
 
@@ -12,32 +13,65 @@ import (
 
 // DownlinkQueueItem represents an item in the downlink queue.
 type DownlinkQueueItem struct {
-	ID        int64         `db:"id"`
-	Reference string        `db:"reference"`
-	DevEUI    lorawan.EUI64 `db:"dev_eui"`
-	Confirmed bool          `db:"confirmed"`
-	Pending   bool          `db:"pending"`
-	FPort     uint8         `db:"fport"`
-	Data      []byte        `db:"data"`
+	ID             int64         `db:"id"`
+	Reference      string        `db:"reference"`
+	DevEUI         lorawan.EUI64 `db:"dev_eui"`
+	Confirmed      bool          `db:"confirmed"`
+	Pending        bool          `db:"pending"`
+	FPort          uint8         `db:"fport"`
+	Data           []byte        `db:"data"`
+	DownlinkRuleID *int64        `db:"downlink_rule_id"` // rule which enqueued the item (nil when not enqueued by a rule)
+	DelayUntil     *time.Time    `db:"delay_until"`      // the item is not sent before this time (nil when not delayed)
+	MaxRetries     int           `db:"max_retries"`      // max. number of retransmissions of a confirmed item (0 = no limit)
+	Retries        int           `db:"retries"`          // number of retransmissions of a confirmed item
+	Encrypted      bool          `db:"encrypted"`        // data is encrypted by the application (end-to-end encryption)
+	FCnt           *uint32       `db:"f_cnt"`            // downlink frame-counter used for encrypting the data (nil when not encrypted)
 }
 
-// CreateDownlinkQueueItem adds an item to the downlink queue.
-func CreateDownlinkQueueItem(db *sqlx.DB, item *DownlinkQueueItem) error {
-	err := db.Get(&item.ID, `
-		insert into downlink_queue (
+// CreateDownlinkQueueItem adds an item to the downlink queue. It also
+// creates the DownlinkDelivery (with queued status) for the item.
+func CreateDownlinkQueueItem(db sqlx.Ext, item *DownlinkQueueItem) error {
+	err := sqlx.Get(db, &item.ID, `
+		with qi as (
+			insert into downlink_queue (
+				dev_eui,
+				reference,
+				confirmed,
+				pending,
+				fport,
+				data,
+				downlink_rule_id,
+				delay_until,
+				max_retries,
+				encrypted,
+				f_cnt
+			) values ($1, $2, $3, $4, $5, $6, $9, $10, $11, $12, $13)
+			returning id, dev_eui, reference, confirmed
+		)
+		insert into downlink_delivery (
+			id,
+			created_at,
+			updated_at,
 			dev_eui,
 			reference,
 			confirmed,
-			pending,
-			fport,
-			data
-		) values ($1, $2, $3, $4, $5, $6) returning id`,
+			status
+		)
+		select id, $7, $7, dev_eui, reference, confirmed, $8 from qi
+		returning id`,
 		item.DevEUI[:],
 		item.Reference,
 		item.Confirmed,
 		item.Pending,
 		item.FPort,
 		item.Data,
+		time.Now(),
+		DeliveryStatusQueued,
+		item.DownlinkRuleID,
+		item.DelayUntil,
+		item.MaxRetries,
+		item.Encrypted,
+		item.FCnt,
 	)
 	if err != nil {
 		return fmt.Errorf("enqueue downlink queue item error: %s", err)
@@ -69,6 +103,24 @@ func GetDownlinkQueueSize(db *sqlx.DB, devEUI lorawan.EUI64) (int, error) {
 	return count, nil
 }
 
+// GetReadyDownlinkQueueSize returns the number of items in the downlink
+// queue which are not delayed (anymore).
+func GetReadyDownlinkQueueSize(db *sqlx.DB, devEUI lorawan.EUI64) (int, error) {
+	var count int
+	err := db.Get(&count, `
+		select count(*)
+		from downlink_queue
+		where
+			dev_eui = $1
+			and (delay_until is null or delay_until <= now())`,
+		devEUI[:],
+	)
+	if err != nil {
+		return 0, fmt.Errorf("get ready downlink queue size error: %s", err)
+	}
+	return count, nil
+}
+
 // GetPendingDownlinkQueueItem returns an item from the downlink queue that
 // is pending.
 func GetPendingDownlinkQueueItem(db *sqlx.DB, devEUI lorawan.EUI64) (DownlinkQueueItem, error) {
@@ -90,7 +142,8 @@ func UpdateDownlinkQueueItem(db *sqlx.DB, item DownlinkQueueItem) error {
 			confirmed = $3,
 			pending = $4,
 			fport = $5,
-			data = $6
+			data = $6,
+			retries = $8
 		where id = $7`,
 		item.DevEUI[:],
 		item.Reference,
@@ -99,6 +152,7 @@ func UpdateDownlinkQueueItem(db *sqlx.DB, item DownlinkQueueItem) error {
 		item.FPort,
 		item.Data,
 		item.ID,
+		item.Retries,
 	)
 	if err != nil {
 		return fmt.Errorf("update downlink queue item error: %s", err)
@@ -131,6 +185,54 @@ func DeleteDownlinkQueueItem(db *sqlx.DB, id int64) error {
 	return nil
 }
 
+// DownlinkQueueSize contains the number of downlink queue items of an
+// application.
+type DownlinkQueueSize struct {
+	AppEUI  lorawan.EUI64 `db:"app_eui"`
+	Count   int           `db:"count"`
+	Pending int           `db:"pending"`
+}
+
+// GetDownlinkQueueSizePerApplication returns the number of (pending)
+// downlink queue items per application. Applications without queue items
+// are not returned.
+func GetDownlinkQueueSizePerApplication(db *sqlx.DB) ([]DownlinkQueueSize, error) {
+	var sizes []DownlinkQueueSize
+	err := db.Select(&sizes, `
+		select
+			n.app_eui,
+			count(*) as count,
+			count(*) filter (where q.pending) as pending
+		from downlink_queue q
+		inner join node n
+			on n.dev_eui = q.dev_eui
+		group by n.app_eui
+		order by n.app_eui`,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("get downlink queue size per application error: %s", err)
+	}
+	return sizes, nil
+}
+
+// FlushDownlinkQueue deletes all the downlink queue items for the given
+// DevEUI.
+func FlushDownlinkQueue(db *sqlx.DB, devEUI lorawan.EUI64) error {
+	res, err := db.Exec("delete from downlink_queue where dev_eui = $1", devEUI[:])
+	if err != nil {
+		return fmt.Errorf("flush downlink queue error: %s", err)
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	log.WithFields(log.Fields{
+		"dev_eui": devEUI,
+		"count":   ra,
+	}).Info("downlink queue flushed")
+	return nil
+}
+
 // GetDownlinkQueueItems returns a list of downlink queue items for the
 // given DevEUI.
 func GetDownlinkQueueItems(db *sqlx.DB, devEUI lorawan.EUI64) ([]DownlinkQueueItem, error) {
@@ -144,12 +246,22 @@ func GetDownlinkQueueItems(db *sqlx.DB, devEUI lorawan.EUI64) ([]DownlinkQueueIt
 
 // GetNextDownlinkQueueItem returns the next item from the queue, respecting
 // the given maxPayloadSize. If an item exceeds this size, it is discarded and
-// the next item is retrieved from the queue.
+// the next item is retrieved from the queue. Items delayed until a time in
+// the future are skipped.
 // When the queue is empty, nil is returned.
 func GetNextDownlinkQueueItem(db *sqlx.DB, devEUI lorawan.EUI64, maxPayloadSize int) (*DownlinkQueueItem, error) {
 	for {
 		var qi DownlinkQueueItem
-		err := db.Get(&qi, "select * from downlink_queue where dev_eui = $1 order by id limit 1", devEUI[:])
+		err := db.Get(&qi, `
+			select *
+			from downlink_queue
+			where
+				dev_eui = $1
+				and (delay_until is null or delay_until <= now())
+			order by id
+			limit 1`,
+			devEUI[:],
+		)
 		if err != nil {
 			if err == sql.ErrNoRows {
 				return nil, nil
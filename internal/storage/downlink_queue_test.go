@@ -2,6 +2,7 @@ package storage
 
 import (
 	"testing"
+	"time"
 
 	. "github.com/smartystreets/goconvey/convey"
 
@@ -56,6 +57,29 @@ func TestGetNextDownlinkQeueueItem(t *testing.T) {
 				})
 			})
 		})
+
+		Convey("Given a delayed and a not delayed queue item", func() {
+			delayUntil := time.Now().Add(time.Hour)
+			queue := []*DownlinkQueueItem{
+				{DevEUI: node.DevEUI, Reference: "a", Data: []byte{1, 2, 3}, DelayUntil: &delayUntil},
+				{DevEUI: node.DevEUI, Reference: "b", Data: []byte{1, 2, 3}},
+			}
+			for _, qi := range queue {
+				So(CreateDownlinkQueueItem(db, qi), ShouldBeNil)
+			}
+
+			Convey("Then GetNextDownlinkQueueItem skips the delayed item", func() {
+				qi, err := GetNextDownlinkQueueItem(db, node.DevEUI, 7)
+				So(err, ShouldBeNil)
+				So(qi.Reference, ShouldEqual, "b")
+			})
+
+			Convey("Then GetReadyDownlinkQueueSize only counts the not delayed item", func() {
+				size, err := GetReadyDownlinkQueueSize(db, node.DevEUI)
+				So(err, ShouldBeNil)
+				So(size, ShouldEqual, 1)
+			})
+		})
 	})
 }
 
@@ -139,6 +163,35 @@ func TestDownlinkQueueFuncs(t *testing.T) {
 					So(err, ShouldNotBeNil)
 				})
 			})
+
+			Convey("Given an item in the queue of an other node", func() {
+				other := Node{
+					DevEUI: [8]byte{8, 7, 6, 5, 4, 3, 2, 1},
+				}
+				So(CreateNode(db, other), ShouldBeNil)
+				So(CreateDownlinkQueueItem(db, &DownlinkQueueItem{DevEUI: other.DevEUI, FPort: 10, Data: []byte{1}}), ShouldBeNil)
+
+				Convey("When flushing the downlink queue", func() {
+					So(CreateDownlinkQueueItem(db, &DownlinkQueueItem{DevEUI: node.DevEUI, FPort: 10, Data: []byte{2}}), ShouldBeNil)
+					So(FlushDownlinkQueue(db, qi.DevEUI), ShouldBeNil)
+
+					Convey("Then the queue is empty", func() {
+						size, err := GetDownlinkQueueSize(db, qi.DevEUI)
+						So(err, ShouldBeNil)
+						So(size, ShouldEqual, 0)
+					})
+
+					Convey("Then the queue of the other node is untouched", func() {
+						size, err := GetDownlinkQueueSize(db, other.DevEUI)
+						So(err, ShouldBeNil)
+						So(size, ShouldEqual, 1)
+					})
+
+					Convey("Then flushing the empty queue again does not return an error", func() {
+						So(FlushDownlinkQueue(db, qi.DevEUI), ShouldBeNil)
+					})
+				})
+			})
 		})
 	})
 }
@@ -0,0 +1,212 @@
+package storage
+
+import (
+	"fmt"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/brocaar/lorawan"
+)
+
+// DownlinkRule defines a downlink payload which is periodically enqueued,
+// according to a cron-like schedule, for a node or for all the nodes of an
+// application.
+type DownlinkRule struct {
+	ID        int64          `db:"id"`
+	Name      string         `db:"name"`
+	AppEUI    lorawan.EUI64  `db:"app_eui"`
+	DevEUI    *lorawan.EUI64 `db:"dev_eui"` // nil = all the nodes of the application
+	Schedule  string         `db:"schedule"`
+	Timezone  string         `db:"timezone"`
+	FPort     uint8          `db:"fport"`
+	Confirmed bool           `db:"confirmed"`
+	Data      []byte         `db:"data"`
+	Object    []byte         `db:"object"` // JSON encoded object, encoded by the payload codec when data is empty
+	NextRunAt time.Time      `db:"next_run_at"`
+	LastRunAt *time.Time     `db:"last_run_at"`
+}
+
+// CreateDownlinkRule creates the given DownlinkRule.
+func CreateDownlinkRule(db *sqlx.DB, r *DownlinkRule) error {
+	err := db.Get(&r.ID, `
+		insert into downlink_rule (
+			name,
+			app_eui,
+			dev_eui,
+			schedule,
+			timezone,
+			fport,
+			confirmed,
+			data,
+			object,
+			next_run_at
+		) values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10) returning id`,
+		r.Name,
+		r.AppEUI[:],
+		downlinkRuleDevEUI(r.DevEUI),
+		r.Schedule,
+		r.Timezone,
+		r.FPort,
+		r.Confirmed,
+		r.Data,
+		downlinkRuleObject(r.Object),
+		r.NextRunAt,
+	)
+	if err != nil {
+		return fmt.Errorf("create downlink rule error: %s", err)
+	}
+	log.WithFields(log.Fields{
+		"id":      r.ID,
+		"app_eui": r.AppEUI,
+	}).Info("downlink rule created")
+	return nil
+}
+
+// GetDownlinkRule returns the DownlinkRule for the given id.
+func GetDownlinkRule(db *sqlx.DB, id int64) (DownlinkRule, error) {
+	var r DownlinkRule
+	err := db.Get(&r, "select * from downlink_rule where id = $1", id)
+	if err != nil {
+		return r, fmt.Errorf("get downlink rule %d error: %s", id, err)
+	}
+	return r, nil
+}
+
+// GetDownlinkRulesForAppEUI returns the downlink rules of the given AppEUI,
+// including the rules of the nodes of the application.
+func GetDownlinkRulesForAppEUI(db *sqlx.DB, appEUI lorawan.EUI64) ([]DownlinkRule, error) {
+	var rules []DownlinkRule
+	err := db.Select(&rules, "select * from downlink_rule where app_eui = $1 order by name, id", appEUI[:])
+	if err != nil {
+		return nil, fmt.Errorf("get downlink rules error: %s", err)
+	}
+	return rules, nil
+}
+
+// GetDownlinkRulesForDevEUI returns the downlink rules of the given DevEUI.
+// The rules of the application of the node are not included.
+func GetDownlinkRulesForDevEUI(db *sqlx.DB, devEUI lorawan.EUI64) ([]DownlinkRule, error) {
+	var rules []DownlinkRule
+	err := db.Select(&rules, "select * from downlink_rule where dev_eui = $1 order by name, id", devEUI[:])
+	if err != nil {
+		return nil, fmt.Errorf("get downlink rules error: %s", err)
+	}
+	return rules, nil
+}
+
+// GetDueDownlinkRules returns the downlink rules which are due at the given
+// time.
+func GetDueDownlinkRules(db *sqlx.DB, now time.Time) ([]DownlinkRule, error) {
+	var rules []DownlinkRule
+	err := db.Select(&rules, "select * from downlink_rule where next_run_at <= $1 order by next_run_at, id", now)
+	if err != nil {
+		return nil, fmt.Errorf("get due downlink rules error: %s", err)
+	}
+	return rules, nil
+}
+
+// ClaimDownlinkRule sets the next and last run of the given rule, given
+// that its next run has not been updated in the meantime (e.g. by an other
+// LoRa App Server instance). It returns false when the rule has already
+// been claimed.
+func ClaimDownlinkRule(db *sqlx.DB, r DownlinkRule, lastRunAt, nextRunAt time.Time) (bool, error) {
+	res, err := db.Exec(`
+		update downlink_rule set
+			last_run_at = $3,
+			next_run_at = $4
+		where
+			id = $1
+			and next_run_at = $2`,
+		r.ID,
+		r.NextRunAt,
+		lastRunAt,
+		nextRunAt,
+	)
+	if err != nil {
+		return false, fmt.Errorf("claim downlink rule error: %s", err)
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return ra == 1, nil
+}
+
+// UpdateDownlinkRule updates the given DownlinkRule.
+func UpdateDownlinkRule(db *sqlx.DB, r DownlinkRule) error {
+	res, err := db.Exec(`
+		update downlink_rule set
+			name = $2,
+			app_eui = $3,
+			dev_eui = $4,
+			schedule = $5,
+			timezone = $6,
+			fport = $7,
+			confirmed = $8,
+			data = $9,
+			object = $10,
+			next_run_at = $11
+		where id = $1`,
+		r.ID,
+		r.Name,
+		r.AppEUI[:],
+		downlinkRuleDevEUI(r.DevEUI),
+		r.Schedule,
+		r.Timezone,
+		r.FPort,
+		r.Confirmed,
+		r.Data,
+		downlinkRuleObject(r.Object),
+		r.NextRunAt,
+	)
+	if err != nil {
+		return fmt.Errorf("update downlink rule error: %s", err)
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if ra == 0 {
+		return fmt.Errorf("downlink rule %d does not exist", r.ID)
+	}
+	log.WithField("id", r.ID).Info("downlink rule updated")
+	return nil
+}
+
+// DeleteDownlinkRule deletes the DownlinkRule matching the given id. The
+// items already enqueued by the rule are not removed from the queue.
+func DeleteDownlinkRule(db *sqlx.DB, id int64) error {
+	res, err := db.Exec("delete from downlink_rule where id = $1", id)
+	if err != nil {
+		return fmt.Errorf("delete downlink rule error: %s", err)
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if ra == 0 {
+		return fmt.Errorf("downlink rule %d does not exist", id)
+	}
+	log.WithField("id", id).Info("downlink rule deleted")
+	return nil
+}
+
+// downlinkRuleDevEUI returns the database value of the given (optional)
+// DevEUI.
+func downlinkRuleDevEUI(devEUI *lorawan.EUI64) interface{} {
+	if devEUI == nil {
+		return nil
+	}
+	return devEUI[:]
+}
+
+// downlinkRuleObject returns the database (jsonb) value of the given
+// (optional) object.
+func downlinkRuleObject(object []byte) interface{} {
+	if len(object) == 0 {
+		return nil
+	}
+	return string(object)
+}
@@ -0,0 +1,130 @@
+package storage
+
+import (
+	"fmt"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+
+	"github.com/brocaar/lorawan"
+)
+
+// EventFilter restricts the events of the application which are published
+// to the given integration. When Integration is empty, the filter applies to
+// all the integrations. An empty Events or FPorts list doesn't restrict the
+// event types or the FPorts of the uplink data.
+type EventFilter struct {
+	ID          int64          `db:"id"`
+	AppEUI      lorawan.EUI64  `db:"app_eui"`
+	Integration string         `db:"integration"`
+	Events      pq.StringArray `db:"events"`
+	FPorts      pq.Int64Array  `db:"fports"`
+}
+
+// CreateEventFilter creates the given EventFilter.
+func CreateEventFilter(db *sqlx.DB, f *EventFilter) error {
+	err := db.Get(&f.ID, `
+		insert into event_filter (
+			app_eui,
+			integration,
+			events,
+			fports
+		) values ($1, $2, $3, $4) returning id`,
+		f.AppEUI[:],
+		f.Integration,
+		eventFilterEvents(f.Events),
+		eventFilterFPorts(f.FPorts),
+	)
+	if err != nil {
+		return fmt.Errorf("create event filter error: %s", err)
+	}
+	log.WithFields(log.Fields{
+		"id":          f.ID,
+		"app_eui":     f.AppEUI,
+		"integration": f.Integration,
+	}).Info("event filter created")
+	return nil
+}
+
+// GetEventFilter returns the EventFilter for the given id.
+func GetEventFilter(db *sqlx.DB, id int64) (EventFilter, error) {
+	var f EventFilter
+	err := db.Get(&f, "select * from event_filter where id = $1", id)
+	if err != nil {
+		return f, fmt.Errorf("get event filter %d error: %s", id, err)
+	}
+	return f, nil
+}
+
+// GetEventFiltersForAppEUI returns the event filters of the given AppEUI.
+func GetEventFiltersForAppEUI(db *sqlx.DB, appEUI lorawan.EUI64) ([]EventFilter, error) {
+	var filters []EventFilter
+	err := db.Select(&filters, "select * from event_filter where app_eui = $1 order by integration", appEUI[:])
+	if err != nil {
+		return nil, fmt.Errorf("get event filters error: %s", err)
+	}
+	return filters, nil
+}
+
+// UpdateEventFilter updates the event types and FPorts of the given
+// EventFilter.
+func UpdateEventFilter(db *sqlx.DB, f EventFilter) error {
+	res, err := db.Exec(`
+		update event_filter
+		set
+			events = $2,
+			fports = $3
+		where id = $1`,
+		f.ID,
+		eventFilterEvents(f.Events),
+		eventFilterFPorts(f.FPorts),
+	)
+	if err != nil {
+		return fmt.Errorf("update event filter %d error: %s", f.ID, err)
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if ra == 0 {
+		return fmt.Errorf("event filter %d does not exist", f.ID)
+	}
+	log.WithField("id", f.ID).Info("event filter updated")
+	return nil
+}
+
+// DeleteEventFilter deletes the EventFilter matching the given id.
+func DeleteEventFilter(db *sqlx.DB, id int64) error {
+	res, err := db.Exec("delete from event_filter where id = $1", id)
+	if err != nil {
+		return fmt.Errorf("delete event filter %d error: %s", id, err)
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if ra == 0 {
+		return fmt.Errorf("event filter %d does not exist", id)
+	}
+	log.WithField("id", id).Info("event filter deleted")
+	return nil
+}
+
+// eventFilterEvents returns the given event types, as an empty (not null)
+// array when nil.
+func eventFilterEvents(events pq.StringArray) pq.StringArray {
+	if events == nil {
+		return pq.StringArray{}
+	}
+	return events
+}
+
+// eventFilterFPorts returns the given FPorts, as an empty (not null) array
+// when nil.
+func eventFilterFPorts(fPorts pq.Int64Array) pq.Int64Array {
+	if fPorts == nil {
+		return pq.Int64Array{}
+	}
+	return fPorts
+}
@@ -0,0 +1,128 @@
+package storage
+
+import (
+	"fmt"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+
+	"github.com/brocaar/lorawan"
+)
+
+// EventLog contains an event (uplink data, join, ack, error, ...) of a node
+// as persisted by the event log.
+type EventLog struct {
+	ID        int64         `db:"id"`
+	CreatedAt time.Time     `db:"created_at"`
+	AppEUI    lorawan.EUI64 `db:"app_eui"`
+	DevEUI    lorawan.EUI64 `db:"dev_eui"`
+	Type      string        `db:"type"`
+	Payload   []byte        `db:"payload"` // JSON encoded payload
+}
+
+// EventLogFilter contains the filters for querying the event log of a node.
+// Zero values are ignored.
+type EventLogFilter struct {
+	Start time.Time // only events created at or after this time
+	End   time.Time // only events created before this time
+	Types []string  // only events of these types
+}
+
+// CreateEventLog creates the given event log entry.
+func CreateEventLog(db *sqlx.DB, e *EventLog) error {
+	e.CreatedAt = time.Now()
+	err := db.Get(&e.ID, `
+		insert into event_log (
+			created_at,
+			app_eui,
+			dev_eui,
+			type,
+			payload
+		) values ($1, $2, $3, $4, $5)
+		returning id`,
+		e.CreatedAt,
+		e.AppEUI[:],
+		e.DevEUI[:],
+		e.Type,
+		e.Payload,
+	)
+	if err != nil {
+		return fmt.Errorf("create event log error: %s", err)
+	}
+	return nil
+}
+
+// GetEventLogCount returns the number of event log entries of the given
+// DevEUI matching the given filter.
+func GetEventLogCount(db *sqlx.DB, devEUI lorawan.EUI64, f EventLogFilter) (int, error) {
+	var count int
+	err := db.Get(&count, `
+		select count(*)
+		from event_log
+		where
+			dev_eui = $1
+			and ($2::timestamptz is null or created_at >= $2)
+			and ($3::timestamptz is null or created_at < $3)
+			and (coalesce(array_length($4::varchar[], 1), 0) = 0 or type = any($4))`,
+		devEUI[:],
+		eventLogTime(f.Start),
+		eventLogTime(f.End),
+		pq.Array(f.Types),
+	)
+	if err != nil {
+		return 0, fmt.Errorf("get event log count error: %s", err)
+	}
+	return count, nil
+}
+
+// GetEventLogs returns the event log entries of the given DevEUI matching
+// the given filter, newest first.
+func GetEventLogs(db *sqlx.DB, devEUI lorawan.EUI64, f EventLogFilter, limit, offset int) ([]EventLog, error) {
+	var events []EventLog
+	err := db.Select(&events, `
+		select *
+		from event_log
+		where
+			dev_eui = $1
+			and ($2::timestamptz is null or created_at >= $2)
+			and ($3::timestamptz is null or created_at < $3)
+			and (coalesce(array_length($4::varchar[], 1), 0) = 0 or type = any($4))
+		order by created_at desc, id desc
+		limit $5 offset $6`,
+		devEUI[:],
+		eventLogTime(f.Start),
+		eventLogTime(f.End),
+		pq.Array(f.Types),
+		limit,
+		offset,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("get event logs error: %s", err)
+	}
+	return events, nil
+}
+
+// DeleteEventLogsBefore deletes the event log entries created before the
+// given time.
+func DeleteEventLogsBefore(db *sqlx.DB, before time.Time) error {
+	res, err := db.Exec("delete from event_log where created_at < $1", before)
+	if err != nil {
+		return fmt.Errorf("delete event logs error: %s", err)
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	log.WithField("count", ra).Info("event logs deleted")
+	return nil
+}
+
+// eventLogTime returns nil for the zero time, so that the filter is ignored.
+func eventLogTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
@@ -0,0 +1,74 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/brocaar/lora-app-server/internal/test"
+	"github.com/brocaar/lorawan"
+)
+
+func TestEventLog(t *testing.T) {
+	conf := test.GetConfig()
+
+	Convey("Given a clean database", t, func() {
+		db, err := OpenDatabase(conf.PostgresDSN)
+		So(err, ShouldBeNil)
+		test.MustResetDB(db)
+
+		appEUI := lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}
+		devEUI := lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1}
+
+		Convey("When creating event log entries", func() {
+			events := []EventLog{
+				{AppEUI: appEUI, DevEUI: devEUI, Type: "rx", Payload: []byte(`{"fCnt":1}`)},
+				{AppEUI: appEUI, DevEUI: devEUI, Type: "error", Payload: []byte(`{"error":"boom"}`)},
+				{AppEUI: appEUI, DevEUI: lorawan.EUI64{1}, Type: "rx", Payload: []byte(`{}`)},
+			}
+			for i := range events {
+				So(CreateEventLog(db, &events[i]), ShouldBeNil)
+			}
+
+			Convey("Then the events of the node are returned newest first", func() {
+				count, err := GetEventLogCount(db, devEUI, EventLogFilter{})
+				So(err, ShouldBeNil)
+				So(count, ShouldEqual, 2)
+
+				logs, err := GetEventLogs(db, devEUI, EventLogFilter{}, 10, 0)
+				So(err, ShouldBeNil)
+				So(logs, ShouldHaveLength, 2)
+				So(logs[0].ID, ShouldEqual, events[1].ID)
+				So(logs[1].ID, ShouldEqual, events[0].ID)
+			})
+
+			Convey("Then the events can be filtered by type", func() {
+				logs, err := GetEventLogs(db, devEUI, EventLogFilter{Types: []string{"error"}}, 10, 0)
+				So(err, ShouldBeNil)
+				So(logs, ShouldHaveLength, 1)
+				So(logs[0].Type, ShouldEqual, "error")
+			})
+
+			Convey("Then the events can be filtered by time-range", func() {
+				count, err := GetEventLogCount(db, devEUI, EventLogFilter{End: events[0].CreatedAt})
+				So(err, ShouldBeNil)
+				So(count, ShouldEqual, 0)
+
+				count, err = GetEventLogCount(db, devEUI, EventLogFilter{Start: events[0].CreatedAt})
+				So(err, ShouldBeNil)
+				So(count, ShouldEqual, 2)
+			})
+
+			Convey("When deleting the events created before now", func() {
+				So(DeleteEventLogsBefore(db, time.Now()), ShouldBeNil)
+
+				Convey("Then the event log is empty", func() {
+					count, err := GetEventLogCount(db, devEUI, EventLogFilter{})
+					So(err, ShouldBeNil)
+					So(count, ShouldEqual, 0)
+				})
+			})
+		})
+	})
+}
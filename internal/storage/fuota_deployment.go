@@ -0,0 +1,392 @@
+package storage
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/brocaar/lorawan"
+)
+
+// FUOTA deployment states.
+const (
+	FUOTADeploymentScheduled  = "SCHEDULED"
+	FUOTADeploymentInProgress = "IN_PROGRESS"
+	FUOTADeploymentDone       = "DONE"
+)
+
+// FUOTADeployment defines a firmware update of the nodes of a multicast
+// group.
+type FUOTADeployment struct {
+	ID               int64         `db:"id"`
+	CreatedAt        time.Time     `db:"created_at"`
+	UpdatedAt        time.Time     `db:"updated_at"`
+	Name             string        `db:"name"`
+	AppEUI           lorawan.EUI64 `db:"app_eui"`
+	MulticastGroupID int64         `db:"multicast_group_id"`
+	FPort            uint8         `db:"fport"`
+	Firmware         []byte        `db:"firmware"`
+	FirmwareSize     int           `db:"firmware_size"` // size of the firmware (also set when the firmware is not returned)
+	Descriptor       uint32        `db:"descriptor"`
+	FragSize         int           `db:"frag_size"`
+	Redundancy       int           `db:"redundancy"`
+	State            string        `db:"state"`
+	StartAt          time.Time     `db:"start_at"`
+	Timeout          int           `db:"timeout"`  // in seconds, starting at the start of the deployment
+	Deadline         *time.Time    `db:"deadline"` // set when the deployment is started
+}
+
+// FUOTADeploymentNode defines the firmware update state of a node within
+// a FUOTADeployment. See the integration.FirmwareState constants for the
+// states.
+type FUOTADeploymentNode struct {
+	DeploymentID   int64         `db:"fuota_deployment_id"`
+	DevEUI         lorawan.EUI64 `db:"dev_eui"`
+	UpdatedAt      time.Time     `db:"updated_at"`
+	State          string        `db:"state"`
+	NbFragReceived int           `db:"nb_frag_received"`
+	MissingFrag    int           `db:"missing_frag"`
+	Error          string        `db:"error"`
+}
+
+// CreateFUOTADeployment creates the given FUOTADeployment.
+func CreateFUOTADeployment(db *sqlx.DB, d *FUOTADeployment) error {
+	now := time.Now()
+	err := db.Get(&d.ID, `
+		insert into fuota_deployment (
+			created_at,
+			updated_at,
+			name,
+			app_eui,
+			multicast_group_id,
+			fport,
+			firmware,
+			descriptor,
+			frag_size,
+			redundancy,
+			state,
+			start_at,
+			timeout
+		) values ($1, $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12) returning id`,
+		now,
+		d.Name,
+		d.AppEUI[:],
+		d.MulticastGroupID,
+		d.FPort,
+		d.Firmware,
+		d.Descriptor,
+		d.FragSize,
+		d.Redundancy,
+		d.State,
+		d.StartAt,
+		d.Timeout,
+	)
+	if err != nil {
+		return fmt.Errorf("create fuota deployment error: %s", err)
+	}
+	d.CreatedAt = now
+	d.UpdatedAt = now
+	log.WithFields(log.Fields{
+		"id":      d.ID,
+		"app_eui": d.AppEUI,
+	}).Info("fuota deployment created")
+	return nil
+}
+
+// GetFUOTADeployment returns the FUOTADeployment for the given id.
+func GetFUOTADeployment(db *sqlx.DB, id int64) (FUOTADeployment, error) {
+	var d FUOTADeployment
+	err := db.Get(&d, "select *, octet_length(firmware) as firmware_size from fuota_deployment where id = $1", id)
+	if err != nil {
+		return d, fmt.Errorf("get fuota deployment %d error: %s", id, err)
+	}
+	return d, nil
+}
+
+// GetFUOTADeploymentsForAppEUI returns the FUOTA deployments of the given
+// AppEUI, newest first. The firmware images are not returned.
+func GetFUOTADeploymentsForAppEUI(db *sqlx.DB, appEUI lorawan.EUI64) ([]FUOTADeployment, error) {
+	var deployments []FUOTADeployment
+	err := db.Select(&deployments, `
+		select
+			id,
+			created_at,
+			updated_at,
+			name,
+			app_eui,
+			multicast_group_id,
+			fport,
+			octet_length(firmware) as firmware_size,
+			descriptor,
+			frag_size,
+			redundancy,
+			state,
+			start_at,
+			timeout,
+			deadline
+		from fuota_deployment
+		where app_eui = $1
+		order by created_at desc, id desc`,
+		appEUI[:],
+	)
+	if err != nil {
+		return nil, fmt.Errorf("get fuota deployments error: %s", err)
+	}
+	return deployments, nil
+}
+
+// GetDueFUOTADeployments returns the scheduled FUOTA deployments which must
+// be started at the given time.
+func GetDueFUOTADeployments(db *sqlx.DB, now time.Time) ([]FUOTADeployment, error) {
+	var deployments []FUOTADeployment
+	err := db.Select(&deployments, "select *, octet_length(firmware) as firmware_size from fuota_deployment where state = $1 and start_at <= $2 order by start_at, id", FUOTADeploymentScheduled, now)
+	if err != nil {
+		return nil, fmt.Errorf("get due fuota deployments error: %s", err)
+	}
+	return deployments, nil
+}
+
+// GetExpiredFUOTADeployments returns the FUOTA deployments in progress of
+// which the deadline has passed at the given time.
+func GetExpiredFUOTADeployments(db *sqlx.DB, now time.Time) ([]FUOTADeployment, error) {
+	var deployments []FUOTADeployment
+	err := db.Select(&deployments, `
+		select
+			id,
+			created_at,
+			updated_at,
+			name,
+			app_eui,
+			multicast_group_id,
+			fport,
+			octet_length(firmware) as firmware_size,
+			descriptor,
+			frag_size,
+			redundancy,
+			state,
+			start_at,
+			timeout,
+			deadline
+		from fuota_deployment
+		where state = $1 and deadline <= $2
+		order by deadline, id`,
+		FUOTADeploymentInProgress,
+		now,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("get expired fuota deployments error: %s", err)
+	}
+	return deployments, nil
+}
+
+// ClaimFUOTADeployment sets the given (scheduled) deployment in progress
+// with the given deadline, given that it has not been started in the
+// meantime (e.g. by an other LoRa App Server instance). It returns false
+// when the deployment has already been claimed.
+func ClaimFUOTADeployment(db *sqlx.DB, id int64, deadline time.Time) (bool, error) {
+	res, err := db.Exec(`
+		update fuota_deployment set
+			state = $2,
+			deadline = $3,
+			updated_at = $4
+		where
+			id = $1
+			and state = $5`,
+		id,
+		FUOTADeploymentInProgress,
+		deadline,
+		time.Now(),
+		FUOTADeploymentScheduled,
+	)
+	if err != nil {
+		return false, fmt.Errorf("claim fuota deployment error: %s", err)
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return ra == 1, nil
+}
+
+// SetFUOTADeploymentState sets the state of the given deployment.
+func SetFUOTADeploymentState(db *sqlx.DB, id int64, state string) error {
+	res, err := db.Exec("update fuota_deployment set state = $2, updated_at = $3 where id = $1", id, state, time.Now())
+	if err != nil {
+		return fmt.Errorf("set fuota deployment state error: %s", err)
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if ra == 0 {
+		return fmt.Errorf("fuota deployment %d does not exist", id)
+	}
+	log.WithFields(log.Fields{
+		"id":    id,
+		"state": state,
+	}).Info("fuota deployment state updated")
+	return nil
+}
+
+// DeleteFUOTADeployment deletes the FUOTADeployment matching the given id
+// (including the state of its nodes).
+func DeleteFUOTADeployment(db *sqlx.DB, id int64) error {
+	res, err := db.Exec("delete from fuota_deployment where id = $1", id)
+	if err != nil {
+		return fmt.Errorf("delete fuota deployment error: %s", err)
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if ra == 0 {
+		return fmt.Errorf("fuota deployment %d does not exist", id)
+	}
+	log.WithField("id", id).Info("fuota deployment deleted")
+	return nil
+}
+
+// CreateFUOTADeploymentNodes adds the nodes currently assigned to the
+// multicast group of the given deployment, with the given state. It returns
+// the created FUOTADeploymentNode items.
+func CreateFUOTADeploymentNodes(db *sqlx.DB, d FUOTADeployment, state string) ([]FUOTADeploymentNode, error) {
+	var nodes []FUOTADeploymentNode
+	err := db.Select(&nodes, `
+		insert into fuota_deployment_node (
+			fuota_deployment_id,
+			dev_eui,
+			updated_at,
+			state
+		)
+		select $1, dev_eui, $3, $4
+		from multicast_group_node
+		where multicast_group_id = $2
+		returning *`,
+		d.ID,
+		d.MulticastGroupID,
+		time.Now(),
+		state,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("create fuota deployment nodes error: %s", err)
+	}
+	return nodes, nil
+}
+
+// GetFUOTADeploymentNodes returns the nodes of the given deployment.
+func GetFUOTADeploymentNodes(db *sqlx.DB, id int64) ([]FUOTADeploymentNode, error) {
+	var nodes []FUOTADeploymentNode
+	err := db.Select(&nodes, "select * from fuota_deployment_node where fuota_deployment_id = $1 order by dev_eui", id)
+	if err != nil {
+		return nil, fmt.Errorf("get fuota deployment nodes error: %s", err)
+	}
+	return nodes, nil
+}
+
+// GetInProgressFUOTADeploymentNode returns the FUOTADeploymentNode of the
+// given node in a deployment in progress using the given FPort. When there
+// is no such deployment, nil is returned.
+func GetInProgressFUOTADeploymentNode(db *sqlx.DB, devEUI lorawan.EUI64, fPort uint8) (*FUOTADeploymentNode, error) {
+	var n FUOTADeploymentNode
+	err := db.Get(&n, `
+		select dn.*
+		from fuota_deployment_node dn
+		inner join fuota_deployment d
+			on d.id = dn.fuota_deployment_id
+		where
+			dn.dev_eui = $1
+			and d.state = $2
+			and d.fport = $3
+		order by d.start_at desc
+		limit 1`,
+		devEUI[:],
+		FUOTADeploymentInProgress,
+		fPort,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get in progress fuota deployment node error: %s", err)
+	}
+	return &n, nil
+}
+
+// UpdateFUOTADeploymentNode updates the given FUOTADeploymentNode.
+func UpdateFUOTADeploymentNode(db *sqlx.DB, n *FUOTADeploymentNode) error {
+	n.UpdatedAt = time.Now()
+	res, err := db.Exec(`
+		update fuota_deployment_node set
+			updated_at = $3,
+			state = $4,
+			nb_frag_received = $5,
+			missing_frag = $6,
+			error = $7
+		where fuota_deployment_id = $1 and dev_eui = $2`,
+		n.DeploymentID,
+		n.DevEUI[:],
+		n.UpdatedAt,
+		n.State,
+		n.NbFragReceived,
+		n.MissingFrag,
+		n.Error,
+	)
+	if err != nil {
+		return fmt.Errorf("update fuota deployment node error: %s", err)
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if ra == 0 {
+		return fmt.Errorf("node %s is not part of fuota deployment %d", n.DevEUI, n.DeploymentID)
+	}
+	log.WithFields(log.Fields{
+		"id":      n.DeploymentID,
+		"dev_eui": n.DevEUI,
+		"state":   n.State,
+	}).Info("fuota deployment node updated")
+	return nil
+}
+
+// CreateFUOTAQueueItems adds the given item to the downlink queue of each
+// node of the given deployment (see CreateMulticastQueueItems).
+func CreateFUOTAQueueItems(db sqlx.Ext, id int64, item DownlinkQueueItem) ([]lorawan.EUI64, error) {
+	devEUIs, err := createQueueItems(db, "select dev_eui from fuota_deployment_node where fuota_deployment_id = $1", id, item)
+	if err != nil {
+		return nil, fmt.Errorf("enqueue fuota queue items error: %s", err)
+	}
+	return devEUIs, nil
+}
+
+// FlushFUOTAQueueItems removes the queue items of the given deployment
+// (having a reference starting with referencePrefix) from the queue of the
+// given node and cancels their deliveries.
+func FlushFUOTAQueueItems(db *sqlx.DB, devEUI lorawan.EUI64, referencePrefix string) error {
+	_, err := db.Exec(`
+		with qi as (
+			delete from downlink_queue
+			where
+				dev_eui = $1
+				and reference like $2 || '%'
+				and pending = false
+			returning id
+		)
+		update downlink_delivery
+		set
+			updated_at = $3,
+			status = $4
+		where id in (select id from qi)`,
+		devEUI[:],
+		referencePrefix,
+		time.Now(),
+		DeliveryStatusCancelled,
+	)
+	if err != nil {
+		return fmt.Errorf("flush fuota queue items error: %s", err)
+	}
+	return nil
+}
@@ -0,0 +1,373 @@
+package storage
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+
+	"github.com/brocaar/lorawan"
+)
+
+// Gateway contains the information of a gateway.
+type Gateway struct {
+	MAC         lorawan.EUI64 `db:"mac"`
+	CreatedAt   time.Time     `db:"created_at"`
+	UpdatedAt   time.Time     `db:"updated_at"`
+	Name        string        `db:"name"`
+	Description string        `db:"description"`
+	Latitude    float64       `db:"latitude"`
+	Longitude   float64       `db:"longitude"`
+	Altitude    float64       `db:"altitude"` // meters
+
+	// OrganizationID contains the id of the organization owning the gateway
+	// (nil for the gateways created before the organizations).
+	OrganizationID *int64 `db:"organization_id"`
+
+	// LastSeenAt contains the time of the last uplink received by the
+	// gateway (nil = never).
+	LastSeenAt *time.Time `db:"last_seen_at"`
+
+	// StatsPublishedAt contains the start of the last statistics interval
+	// that was published. It is updated by ClaimGatewayStatsPublish.
+	StatsPublishedAt *time.Time `db:"stats_published_at"`
+}
+
+// GatewayStats contains the aggregated statistics of a gateway. The
+// statistics are stored per hour.
+type GatewayStats struct {
+	MAC       lorawan.EUI64 `db:"mac"`
+	Timestamp time.Time     `db:"timestamp"` // start of the interval
+	RXPackets int           `db:"rx_packets"`
+	RSSISum   int64         `db:"rssi_sum"`
+	SNRSum    float64       `db:"snr_sum"`
+	TXPackets int           `db:"tx_packets"`
+	TXAirtime time.Duration `db:"tx_airtime"` // stored in microseconds
+}
+
+// CreateGateway creates the given Gateway.
+func CreateGateway(db *sqlx.DB, gw *Gateway) error {
+	now := time.Now()
+	_, err := db.Exec(`
+		insert into gateway (
+			mac,
+			created_at,
+			updated_at,
+			name,
+			description,
+			latitude,
+			longitude,
+			altitude,
+			organization_id
+		) values ($1, $2, $2, $3, $4, $5, $6, $7, $8)`,
+		gw.MAC[:],
+		now,
+		gw.Name,
+		gw.Description,
+		gw.Latitude,
+		gw.Longitude,
+		gw.Altitude,
+		gw.OrganizationID,
+	)
+	if err != nil {
+		return fmt.Errorf("create gateway %s error: %s", gw.MAC, err)
+	}
+	gw.CreatedAt = now
+	gw.UpdatedAt = now
+	log.WithField("mac", gw.MAC).Info("gateway created")
+	return nil
+}
+
+// GetGateway returns the Gateway for the given MAC.
+func GetGateway(db *sqlx.DB, mac lorawan.EUI64) (Gateway, error) {
+	var gw Gateway
+	err := db.Get(&gw, "select * from gateway where mac = $1", mac[:])
+	if err != nil {
+		return gw, fmt.Errorf("get gateway %s error: %s", mac, err)
+	}
+	return gw, nil
+}
+
+// GetGatewaysCount returns the total number of gateways.
+func GetGatewaysCount(db *sqlx.DB) (int, error) {
+	var count int
+	err := db.Get(&count, "select count(*) from gateway")
+	if err != nil {
+		return 0, fmt.Errorf("get gateways count error: %s", err)
+	}
+	return count, nil
+}
+
+// GetGateways returns a slice of gateways, sorted by name.
+func GetGateways(db *sqlx.DB, limit, offset int) ([]Gateway, error) {
+	var gws []Gateway
+	err := db.Select(&gws, "select * from gateway order by name, mac limit $1 offset $2", limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("get gateways error: %s", err)
+	}
+	return gws, nil
+}
+
+// GetOrganizationGatewaysCount returns the number of gateways of the given
+// organization.
+func GetOrganizationGatewaysCount(db *sqlx.DB, id int64) (int, error) {
+	var count int
+	err := db.Get(&count, "select count(*) from gateway where organization_id = $1", id)
+	if err != nil {
+		return 0, fmt.Errorf("get organization %d gateways count error: %s", id, err)
+	}
+	return count, nil
+}
+
+// GetOrganizationGateways returns a slice of gateways of the given
+// organization, sorted by name.
+func GetOrganizationGateways(db *sqlx.DB, id int64, limit, offset int) ([]Gateway, error) {
+	var gws []Gateway
+	err := db.Select(&gws, "select * from gateway where organization_id = $1 order by name, mac limit $2 offset $3", id, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("get organization %d gateways error: %s", id, err)
+	}
+	return gws, nil
+}
+
+// GetGatewayOrganizationID returns the id of the organization owning the
+// given gateway. It returns nil when the gateway has no organization or
+// when it is not managed (e.g. for the statistics of a gateway which is
+// only known from the received uplinks).
+func GetGatewayOrganizationID(db *sqlx.DB, mac lorawan.EUI64) (*int64, error) {
+	var id *int64
+	err := db.Get(&id, "select organization_id from gateway where mac = $1", mac[:])
+	if err != nil && err != sql.ErrNoRows {
+		return nil, fmt.Errorf("get gateway %s organization error: %s", mac, err)
+	}
+	return id, nil
+}
+
+// GetGatewaysForMACs returns the gateways matching the given MACs. MACs of
+// gateways that don't exist are ignored.
+func GetGatewaysForMACs(db *sqlx.DB, macs []lorawan.EUI64) ([]Gateway, error) {
+	arr := make(pq.ByteaArray, 0, len(macs))
+	for i := range macs {
+		arr = append(arr, macs[i][:])
+	}
+
+	var gws []Gateway
+	err := db.Select(&gws, "select * from gateway where mac = any($1)", arr)
+	if err != nil {
+		return nil, fmt.Errorf("get gateways for macs error: %s", err)
+	}
+	return gws, nil
+}
+
+// UpdateGateway updates the given Gateway.
+func UpdateGateway(db *sqlx.DB, gw Gateway) error {
+	res, err := db.Exec(`
+		update gateway set
+			updated_at = $2,
+			name = $3,
+			description = $4,
+			latitude = $5,
+			longitude = $6,
+			altitude = $7,
+			organization_id = $8
+		where mac = $1`,
+		gw.MAC[:],
+		time.Now(),
+		gw.Name,
+		gw.Description,
+		gw.Latitude,
+		gw.Longitude,
+		gw.Altitude,
+		gw.OrganizationID,
+	)
+	if err != nil {
+		return fmt.Errorf("update gateway %s error: %s", gw.MAC, err)
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if ra == 0 {
+		return fmt.Errorf("gateway %s does not exist", gw.MAC)
+	}
+	log.WithField("mac", gw.MAC).Info("gateway updated")
+	return nil
+}
+
+// DeleteGateway deletes the Gateway matching the given MAC, including its
+// statistics.
+func DeleteGateway(db *sqlx.DB, mac lorawan.EUI64) error {
+	res, err := db.Exec("delete from gateway where mac = $1", mac[:])
+	if err != nil {
+		return fmt.Errorf("delete gateway %s error: %s", mac, err)
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if ra == 0 {
+		return fmt.Errorf("gateway %s does not exist", mac)
+	}
+	log.WithField("mac", mac).Info("gateway deleted")
+	return nil
+}
+
+// SetGatewayLastSeenAt sets the last seen timestamp of the given gateway,
+// unless it is already more recent. Unknown gateways are ignored.
+func SetGatewayLastSeenAt(db *sqlx.DB, mac lorawan.EUI64, ts time.Time) error {
+	_, err := db.Exec(`
+		update gateway set
+			last_seen_at = $2
+		where
+			mac = $1
+			and (last_seen_at is null or last_seen_at < $2)`,
+		mac[:],
+		ts,
+	)
+	if err != nil {
+		return fmt.Errorf("set gateway %s last seen error: %s", mac, err)
+	}
+	return nil
+}
+
+// AddGatewayStats adds the given statistics to the statistics of the hour
+// containing the timestamp of the given GatewayStats. Statistics of unknown
+// gateways are ignored.
+func AddGatewayStats(db *sqlx.DB, s GatewayStats) error {
+	_, err := db.Exec(`
+		with upd as (
+			update gateway_stats set
+				rx_packets = rx_packets + $3,
+				rssi_sum = rssi_sum + $4,
+				snr_sum = snr_sum + $5,
+				tx_packets = tx_packets + $6,
+				tx_airtime = tx_airtime + $7
+			where
+				mac = $1
+				and timestamp = $2
+			returning 1
+		)
+		insert into gateway_stats (
+			mac,
+			timestamp,
+			rx_packets,
+			rssi_sum,
+			snr_sum,
+			tx_packets,
+			tx_airtime
+		)
+		select $1, $2, $3, $4, $5, $6, $7
+		where
+			not exists (select 1 from upd)
+			and exists (select 1 from gateway where mac = $1)`,
+		s.MAC[:],
+		s.Timestamp.Truncate(time.Hour),
+		s.RXPackets,
+		s.RSSISum,
+		s.SNRSum,
+		s.TXPackets,
+		int64(s.TXAirtime/time.Microsecond),
+	)
+	if err != nil {
+		return fmt.Errorf("add gateway %s stats error: %s", s.MAC, err)
+	}
+	return nil
+}
+
+// GetGatewayStats returns the statistics of the given gateway within the
+// given time range (start inclusive, end exclusive) and aggregated by the
+// given interval.
+func GetGatewayStats(db *sqlx.DB, mac lorawan.EUI64, start, end time.Time, interval AggregationInterval) ([]GatewayStats, error) {
+	timestamp := "$2::timestamp with time zone"
+	groupBy := "mac"
+
+	switch interval {
+	case AggregatePeriod:
+	case AggregateHour, AggregateDay:
+		timestamp = fmt.Sprintf("date_trunc('%s', timestamp)", interval)
+		groupBy = timestamp + ", mac"
+	default:
+		return nil, fmt.Errorf("invalid aggregation interval: %s", interval)
+	}
+
+	var stats []GatewayStats
+	err := db.Select(&stats, fmt.Sprintf(`
+		select
+			mac,
+			%s as timestamp,
+			sum(rx_packets) as rx_packets,
+			sum(rssi_sum) as rssi_sum,
+			sum(snr_sum) as snr_sum,
+			sum(tx_packets) as tx_packets,
+			sum(tx_airtime) as tx_airtime
+		from gateway_stats
+		where
+			mac = $1
+			and timestamp >= $2
+			and timestamp < $3
+		group by %s
+		order by %s`,
+		timestamp,
+		groupBy,
+		groupBy,
+	),
+		mac[:],
+		start,
+		end,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("get gateway %s stats error: %s", mac, err)
+	}
+
+	// the airtime is stored in microseconds
+	for i := range stats {
+		stats[i].TXAirtime *= time.Microsecond
+	}
+	return stats, nil
+}
+
+// ClaimGatewayStatsPublish claims the publishing of the statistics of the
+// given gateway for the interval starting at the given time. It returns
+// false when the statistics were already claimed (e.g. by an other
+// instance).
+func ClaimGatewayStatsPublish(db *sqlx.DB, mac lorawan.EUI64, ts time.Time) (bool, error) {
+	var claimed lorawan.EUI64
+	err := db.Get(&claimed, `
+		update gateway set
+			stats_published_at = $2
+		where
+			mac = $1
+			and (stats_published_at is null or stats_published_at < $2)
+		returning mac`,
+		mac[:],
+		ts,
+	)
+	if err == sql.ErrNoRows {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("claim gateway %s stats publish error: %s", mac, err)
+	}
+	return true, nil
+}
+
+// DeleteGatewayStatsBefore deletes the gateway statistics of the intervals
+// starting before the given time.
+func DeleteGatewayStatsBefore(db *sqlx.DB, before time.Time) error {
+	res, err := db.Exec("delete from gateway_stats where timestamp < $1", before)
+	if err != nil {
+		return fmt.Errorf("delete gateway stats error: %s", err)
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	log.WithFields(log.Fields{
+		"before": before,
+		"count":  ra,
+	}).Info("gateway stats deleted")
+	return nil
+}
@@ -0,0 +1,161 @@
+package storage
+
+import (
+	"fmt"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/brocaar/lorawan"
+)
+
+// GatewayDownlink contains the (estimated) meta-data of a downlink
+// transmission by a gateway.
+type GatewayDownlink struct {
+	ID           int64         `db:"id"`
+	MAC          lorawan.EUI64 `db:"mac"`
+	DevEUI       lorawan.EUI64 `db:"dev_eui"`
+	CreatedAt    time.Time     `db:"created_at"`
+	Frequency    int           `db:"frequency"`
+	SubBand      string        `db:"sub_band"`
+	SpreadFactor int           `db:"spread_factor"`
+	Bandwidth    int           `db:"bandwidth"`
+	Airtime      time.Duration `db:"airtime"` // stored in microseconds
+}
+
+// SubBandAirtime contains the total airtime of the downlinks within a
+// sub-band.
+type SubBandAirtime struct {
+	Timestamp time.Time     `db:"timestamp"`
+	SubBand   string        `db:"sub_band"`
+	Airtime   time.Duration `db:"airtime"` // in microseconds
+}
+
+// CreateGatewayDownlink creates the given GatewayDownlink.
+func CreateGatewayDownlink(db *sqlx.DB, d *GatewayDownlink) error {
+	if d.CreatedAt.IsZero() {
+		d.CreatedAt = time.Now()
+	}
+
+	err := db.Get(&d.ID, `
+		insert into gateway_downlink (
+			mac,
+			dev_eui,
+			created_at,
+			frequency,
+			sub_band,
+			spread_factor,
+			bandwidth,
+			airtime
+		) values ($1, $2, $3, $4, $5, $6, $7, $8) returning id`,
+		d.MAC[:],
+		d.DevEUI[:],
+		d.CreatedAt,
+		d.Frequency,
+		d.SubBand,
+		d.SpreadFactor,
+		d.Bandwidth,
+		int64(d.Airtime/time.Microsecond),
+	)
+	if err != nil {
+		return fmt.Errorf("create gateway downlink error: %s", err)
+	}
+	return nil
+}
+
+// GetGatewaySubBandAirtime returns the total downlink airtime per sub-band
+// of the given gateway, within the given time range (start inclusive, end
+// exclusive) and aggregated by the given interval.
+func GetGatewaySubBandAirtime(db *sqlx.DB, mac lorawan.EUI64, start, end time.Time, interval AggregationInterval) ([]SubBandAirtime, error) {
+	timestamp := "$2::timestamp with time zone"
+	groupBy := "sub_band"
+
+	switch interval {
+	case AggregatePeriod:
+	case AggregateHour, AggregateDay:
+		timestamp = fmt.Sprintf("date_trunc('%s', created_at)", interval)
+		groupBy = timestamp + ", sub_band"
+	default:
+		return nil, fmt.Errorf("invalid aggregation interval: %s", interval)
+	}
+
+	var rows []struct {
+		Timestamp time.Time `db:"timestamp"`
+		SubBand   string    `db:"sub_band"`
+		Airtime   int64     `db:"airtime"`
+	}
+	err := db.Select(&rows, fmt.Sprintf(`
+		select
+			%s as timestamp,
+			sub_band,
+			sum(airtime) as airtime
+		from gateway_downlink
+		where
+			mac = $1
+			and created_at >= $2
+			and created_at < $3
+		group by %s
+		order by %s`,
+		timestamp,
+		groupBy,
+		groupBy,
+	),
+		mac[:],
+		start,
+		end,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("get gateway sub-band airtime error: %s", err)
+	}
+
+	var out []SubBandAirtime
+	for _, row := range rows {
+		out = append(out, SubBandAirtime{
+			Timestamp: row.Timestamp,
+			SubBand:   row.SubBand,
+			Airtime:   time.Duration(row.Airtime) * time.Microsecond,
+		})
+	}
+	return out, nil
+}
+
+// GetGatewaySubBandAirtimeSince returns the total downlink airtime of the
+// given gateway and sub-band since the given time.
+func GetGatewaySubBandAirtimeSince(db *sqlx.DB, mac lorawan.EUI64, subBand string, since time.Time) (time.Duration, error) {
+	var airtime int64
+	err := db.Get(&airtime, `
+		select
+			coalesce(sum(airtime), 0)
+		from gateway_downlink
+		where
+			mac = $1
+			and sub_band = $2
+			and created_at >= $3`,
+		mac[:],
+		subBand,
+		since,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("get gateway sub-band airtime error: %s", err)
+	}
+	return time.Duration(airtime) * time.Microsecond, nil
+}
+
+// DeleteGatewayDownlinksBefore deletes the gateway downlinks created before
+// the given time.
+func DeleteGatewayDownlinksBefore(db *sqlx.DB, before time.Time) error {
+	res, err := db.Exec("delete from gateway_downlink where created_at < $1", before)
+	if err != nil {
+		return fmt.Errorf("delete gateway downlinks error: %s", err)
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	log.WithFields(log.Fields{
+		"before": before,
+		"count":  ra,
+	}).Info("gateway downlinks deleted")
+	return nil
+}
@@ -0,0 +1,162 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/brocaar/lora-app-server/internal/test"
+	"github.com/brocaar/lorawan"
+)
+
+func TestGateway(t *testing.T) {
+	conf := test.GetConfig()
+
+	Convey("Given a clean database", t, func() {
+		db, err := OpenDatabase(conf.PostgresDSN)
+		So(err, ShouldBeNil)
+		test.MustResetDB(db)
+
+		Convey("When creating a gateway", func() {
+			org := Organization{Name: "test-org"}
+			So(CreateOrganization(db, &org), ShouldBeNil)
+
+			gw := Gateway{
+				MAC:            lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+				Name:           "gateway-1",
+				Description:    "rooftop",
+				Latitude:       52.3740364,
+				Longitude:      4.9144401,
+				Altitude:       10,
+				OrganizationID: &org.ID,
+			}
+			So(CreateGateway(db, &gw), ShouldBeNil)
+
+			Convey("Then it can be retrieved and listed", func() {
+				gw2, err := GetGateway(db, gw.MAC)
+				So(err, ShouldBeNil)
+				So(gw2.Name, ShouldEqual, gw.Name)
+				So(gw2.Latitude, ShouldEqual, gw.Latitude)
+				So(gw2.LastSeenAt, ShouldBeNil)
+				So(*gw2.OrganizationID, ShouldEqual, org.ID)
+
+				orgID, err := GetGatewayOrganizationID(db, gw.MAC)
+				So(err, ShouldBeNil)
+				So(*orgID, ShouldEqual, org.ID)
+
+				orgID, err = GetGatewayOrganizationID(db, lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1})
+				So(err, ShouldBeNil)
+				So(orgID, ShouldBeNil)
+
+				count, err := GetGatewaysCount(db)
+				So(err, ShouldBeNil)
+				So(count, ShouldEqual, 1)
+
+				gws, err := GetGateways(db, 10, 0)
+				So(err, ShouldBeNil)
+				So(gws, ShouldHaveLength, 1)
+
+				count, err = GetOrganizationGatewaysCount(db, org.ID)
+				So(err, ShouldBeNil)
+				So(count, ShouldEqual, 1)
+
+				gws, err = GetOrganizationGateways(db, org.ID, 10, 0)
+				So(err, ShouldBeNil)
+				So(gws, ShouldHaveLength, 1)
+
+				gws, err = GetOrganizationGateways(db, org.ID+1, 10, 0)
+				So(err, ShouldBeNil)
+				So(gws, ShouldHaveLength, 0)
+			})
+
+			Convey("Then it can be updated", func() {
+				gw.Name = "gateway-2"
+				So(UpdateGateway(db, gw), ShouldBeNil)
+
+				gw2, err := GetGateway(db, gw.MAC)
+				So(err, ShouldBeNil)
+				So(gw2.Name, ShouldEqual, "gateway-2")
+			})
+
+			Convey("Then it is deleted together with its organization", func() {
+				So(DeleteOrganization(db, org.ID), ShouldBeNil)
+
+				_, err := GetGateway(db, gw.MAC)
+				So(err, ShouldNotBeNil)
+			})
+
+			Convey("Then the last seen timestamp is only moved forward", func() {
+				now := time.Now().Truncate(time.Second)
+				So(SetGatewayLastSeenAt(db, gw.MAC, now), ShouldBeNil)
+				So(SetGatewayLastSeenAt(db, gw.MAC, now.Add(-time.Minute)), ShouldBeNil)
+
+				gw2, err := GetGateway(db, gw.MAC)
+				So(err, ShouldBeNil)
+				So(gw2.LastSeenAt.Equal(now), ShouldBeTrue)
+			})
+
+			Convey("When adding statistics", func() {
+				hour := time.Now().Truncate(time.Hour)
+				for _, s := range []GatewayStats{
+					{MAC: gw.MAC, Timestamp: hour.Add(-time.Hour), RXPackets: 1, RSSISum: -100, SNRSum: 5},
+					{MAC: gw.MAC, Timestamp: hour.Add(time.Minute), RXPackets: 1, RSSISum: -80, SNRSum: 7},
+					{MAC: gw.MAC, Timestamp: hour.Add(2 * time.Minute), TXPackets: 1, TXAirtime: 50 * time.Millisecond},
+					{MAC: lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1}, Timestamp: hour, RXPackets: 1},
+				} {
+					So(AddGatewayStats(db, s), ShouldBeNil)
+				}
+
+				Convey("Then the statistics are aggregated per hour", func() {
+					stats, err := GetGatewayStats(db, gw.MAC, hour.Add(-time.Hour), hour.Add(time.Hour), AggregateHour)
+					So(err, ShouldBeNil)
+					So(stats, ShouldHaveLength, 2)
+					So(stats[1].Timestamp.Equal(hour), ShouldBeTrue)
+					So(stats[1].RXPackets, ShouldEqual, 1)
+					So(stats[1].RSSISum, ShouldEqual, -80)
+					So(stats[1].TXPackets, ShouldEqual, 1)
+					So(stats[1].TXAirtime, ShouldEqual, 50*time.Millisecond)
+				})
+
+				Convey("Then the statistics are aggregated over the period", func() {
+					stats, err := GetGatewayStats(db, gw.MAC, hour.Add(-time.Hour), hour.Add(time.Hour), AggregatePeriod)
+					So(err, ShouldBeNil)
+					So(stats, ShouldHaveLength, 1)
+					So(stats[0].RXPackets, ShouldEqual, 2)
+					So(stats[0].RSSISum, ShouldEqual, -180)
+					So(stats[0].SNRSum, ShouldEqual, 12)
+				})
+
+				Convey("Then the statistics of an unknown gateway are ignored", func() {
+					stats, err := GetGatewayStats(db, lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1}, hour.Add(-time.Hour), hour.Add(time.Hour), AggregatePeriod)
+					So(err, ShouldBeNil)
+					So(stats, ShouldHaveLength, 0)
+				})
+
+				Convey("Then the statistics are deleted after the retention", func() {
+					So(DeleteGatewayStatsBefore(db, hour), ShouldBeNil)
+					stats, err := GetGatewayStats(db, gw.MAC, hour.Add(-time.Hour), hour.Add(time.Hour), AggregateHour)
+					So(err, ShouldBeNil)
+					So(stats, ShouldHaveLength, 1)
+				})
+			})
+
+			Convey("Then the publishing of the statistics can be claimed once", func() {
+				hour := time.Now().Truncate(time.Hour)
+				claimed, err := ClaimGatewayStatsPublish(db, gw.MAC, hour)
+				So(err, ShouldBeNil)
+				So(claimed, ShouldBeTrue)
+
+				claimed, err = ClaimGatewayStatsPublish(db, gw.MAC, hour)
+				So(err, ShouldBeNil)
+				So(claimed, ShouldBeFalse)
+			})
+
+			Convey("Then it can be deleted", func() {
+				So(DeleteGateway(db, gw.MAC), ShouldBeNil)
+				_, err := GetGateway(db, gw.MAC)
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
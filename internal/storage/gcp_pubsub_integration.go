@@ -0,0 +1,102 @@
+package storage
+
+import (
+	"database/sql"
+	"fmt"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/brocaar/lorawan"
+)
+
+// GCPPubSubIntegration defines the Google Cloud Pub/Sub integration of an
+// application. The events are published to the given topic, using the
+// given service-account credentials (stored encrypted).
+type GCPPubSubIntegration struct {
+	AppEUI          lorawan.EUI64   `db:"app_eui"`
+	ProjectID       string          `db:"project_id"`
+	Topic           string          `db:"topic"`
+	CredentialsJSON EncryptedString `db:"credentials_json"`
+}
+
+// CreateGCPPubSubIntegration creates the given GCPPubSubIntegration.
+func CreateGCPPubSubIntegration(db *sqlx.DB, i GCPPubSubIntegration) error {
+	_, err := db.Exec(`
+		insert into gcp_pubsub_integration (
+			app_eui,
+			project_id,
+			topic,
+			credentials_json
+		) values ($1, $2, $3, $4)`,
+		i.AppEUI[:],
+		i.ProjectID,
+		i.Topic,
+		i.CredentialsJSON,
+	)
+	if err != nil {
+		return fmt.Errorf("create gcp pubsub integration error: %s", err)
+	}
+	log.WithField("app_eui", i.AppEUI).Info("gcp pubsub integration created")
+	return nil
+}
+
+// GetGCPPubSubIntegration returns the GCPPubSubIntegration for the given
+// AppEUI. When the application doesn't have a Pub/Sub integration, nil is
+// returned.
+func GetGCPPubSubIntegration(db *sqlx.DB, appEUI lorawan.EUI64) (*GCPPubSubIntegration, error) {
+	var i GCPPubSubIntegration
+	err := db.Get(&i, "select * from gcp_pubsub_integration where app_eui = $1", appEUI[:])
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get gcp pubsub integration %s error: %s", appEUI, err)
+	}
+	return &i, nil
+}
+
+// UpdateGCPPubSubIntegration updates the given GCPPubSubIntegration.
+func UpdateGCPPubSubIntegration(db *sqlx.DB, i GCPPubSubIntegration) error {
+	res, err := db.Exec(`
+		update gcp_pubsub_integration set
+			project_id = $2,
+			topic = $3,
+			credentials_json = $4
+		where app_eui = $1`,
+		i.AppEUI[:],
+		i.ProjectID,
+		i.Topic,
+		i.CredentialsJSON,
+	)
+	if err != nil {
+		return fmt.Errorf("update gcp pubsub integration error: %s", err)
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if ra == 0 {
+		return fmt.Errorf("gcp pubsub integration %s does not exist", i.AppEUI)
+	}
+	log.WithField("app_eui", i.AppEUI).Info("gcp pubsub integration updated")
+	return nil
+}
+
+// DeleteGCPPubSubIntegration deletes the GCPPubSubIntegration of the given
+// AppEUI.
+func DeleteGCPPubSubIntegration(db *sqlx.DB, appEUI lorawan.EUI64) error {
+	res, err := db.Exec("delete from gcp_pubsub_integration where app_eui = $1", appEUI[:])
+	if err != nil {
+		return fmt.Errorf("delete gcp pubsub integration error: %s", err)
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if ra == 0 {
+		return fmt.Errorf("gcp pubsub integration %s does not exist", appEUI)
+	}
+	log.WithField("app_eui", appEUI).Info("gcp pubsub integration deleted")
+	return nil
+}
@@ -0,0 +1,97 @@
+package storage
+
+import (
+	"database/sql"
+	"fmt"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+
+	"github.com/brocaar/lorawan"
+)
+
+// HandlerBackend defines the handler backends (e.g. mqtt and kafka) to
+// which the events of the application are published. Applications without
+// HandlerBackend publish to all the enabled handler backends.
+type HandlerBackend struct {
+	AppEUI   lorawan.EUI64  `db:"app_eui"`
+	Backends pq.StringArray `db:"backends"`
+}
+
+// CreateHandlerBackend creates the given HandlerBackend.
+func CreateHandlerBackend(db *sqlx.DB, b HandlerBackend) error {
+	_, err := db.Exec(`
+		insert into handler_backend (
+			app_eui,
+			backends
+		) values ($1, $2)`,
+		b.AppEUI[:],
+		b.Backends,
+	)
+	if err != nil {
+		return fmt.Errorf("create handler backend error: %s", err)
+	}
+	log.WithFields(log.Fields{
+		"app_eui":  b.AppEUI,
+		"backends": b.Backends,
+	}).Info("handler backend created")
+	return nil
+}
+
+// GetHandlerBackend returns the HandlerBackend for the given AppEUI. When
+// the application doesn't have a HandlerBackend, nil is returned.
+func GetHandlerBackend(db *sqlx.DB, appEUI lorawan.EUI64) (*HandlerBackend, error) {
+	var b HandlerBackend
+	err := db.Get(&b, "select * from handler_backend where app_eui = $1", appEUI[:])
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get handler backend %s error: %s", appEUI, err)
+	}
+	return &b, nil
+}
+
+// UpdateHandlerBackend updates the given HandlerBackend.
+func UpdateHandlerBackend(db *sqlx.DB, b HandlerBackend) error {
+	res, err := db.Exec(`
+		update handler_backend set
+			backends = $2
+		where app_eui = $1`,
+		b.AppEUI[:],
+		b.Backends,
+	)
+	if err != nil {
+		return fmt.Errorf("update handler backend error: %s", err)
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if ra == 0 {
+		return fmt.Errorf("handler backend %s does not exist", b.AppEUI)
+	}
+	log.WithFields(log.Fields{
+		"app_eui":  b.AppEUI,
+		"backends": b.Backends,
+	}).Info("handler backend updated")
+	return nil
+}
+
+// DeleteHandlerBackend deletes the HandlerBackend of the given AppEUI.
+func DeleteHandlerBackend(db *sqlx.DB, appEUI lorawan.EUI64) error {
+	res, err := db.Exec("delete from handler_backend where app_eui = $1", appEUI[:])
+	if err != nil {
+		return fmt.Errorf("delete handler backend error: %s", err)
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if ra == 0 {
+		return fmt.Errorf("handler backend %s does not exist", appEUI)
+	}
+	log.WithField("app_eui", appEUI).Info("handler backend deleted")
+	return nil
+}
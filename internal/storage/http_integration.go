@@ -0,0 +1,132 @@
+package storage
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/brocaar/lorawan"
+)
+
+// HTTPHeaders defines the (additional) headers of the HTTP integration
+// requests.
+type HTTPHeaders map[string]string
+
+// Scan implements the sql.Scanner interface.
+func (h *HTTPHeaders) Scan(src interface{}) error {
+	b, ok := src.([]byte)
+	if !ok {
+		return fmt.Errorf("src must be of type []byte, got: %T", src)
+	}
+	return json.Unmarshal(b, h)
+}
+
+// Value implements the driver.Valuer interface.
+func (h HTTPHeaders) Value() (driver.Value, error) {
+	if h == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(h)
+}
+
+// HTTPIntegration defines the HTTP integration of an application. An empty
+// URL means that the event is not forwarded.
+type HTTPIntegration struct {
+	AppEUI               lorawan.EUI64 `db:"app_eui"`
+	Headers              HTTPHeaders   `db:"headers"`
+	DataUpURL            string        `db:"data_up_url"`
+	JoinNotificationURL  string        `db:"join_notification_url"`
+	ACKNotificationURL   string        `db:"ack_notification_url"`
+	ErrorNotificationURL string        `db:"error_notification_url"`
+}
+
+// CreateHTTPIntegration creates the given HTTPIntegration.
+func CreateHTTPIntegration(db *sqlx.DB, i HTTPIntegration) error {
+	_, err := db.Exec(`
+		insert into http_integration (
+			app_eui,
+			headers,
+			data_up_url,
+			join_notification_url,
+			ack_notification_url,
+			error_notification_url
+		) values ($1, $2, $3, $4, $5, $6)`,
+		i.AppEUI[:],
+		i.Headers,
+		i.DataUpURL,
+		i.JoinNotificationURL,
+		i.ACKNotificationURL,
+		i.ErrorNotificationURL,
+	)
+	if err != nil {
+		return fmt.Errorf("create http integration error: %s", err)
+	}
+	log.WithField("app_eui", i.AppEUI).Info("http integration created")
+	return nil
+}
+
+// GetHTTPIntegration returns the HTTPIntegration for the given AppEUI.
+// When the application doesn't have a HTTP integration, nil is returned.
+func GetHTTPIntegration(db *sqlx.DB, appEUI lorawan.EUI64) (*HTTPIntegration, error) {
+	var i HTTPIntegration
+	err := db.Get(&i, "select * from http_integration where app_eui = $1", appEUI[:])
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get http integration %s error: %s", appEUI, err)
+	}
+	return &i, nil
+}
+
+// UpdateHTTPIntegration updates the given HTTPIntegration.
+func UpdateHTTPIntegration(db *sqlx.DB, i HTTPIntegration) error {
+	res, err := db.Exec(`
+		update http_integration set
+			headers = $2,
+			data_up_url = $3,
+			join_notification_url = $4,
+			ack_notification_url = $5,
+			error_notification_url = $6
+		where app_eui = $1`,
+		i.AppEUI[:],
+		i.Headers,
+		i.DataUpURL,
+		i.JoinNotificationURL,
+		i.ACKNotificationURL,
+		i.ErrorNotificationURL,
+	)
+	if err != nil {
+		return fmt.Errorf("update http integration error: %s", err)
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if ra == 0 {
+		return fmt.Errorf("http integration %s does not exist", i.AppEUI)
+	}
+	log.WithField("app_eui", i.AppEUI).Info("http integration updated")
+	return nil
+}
+
+// DeleteHTTPIntegration deletes the HTTPIntegration of the given AppEUI.
+func DeleteHTTPIntegration(db *sqlx.DB, appEUI lorawan.EUI64) error {
+	res, err := db.Exec("delete from http_integration where app_eui = $1", appEUI[:])
+	if err != nil {
+		return fmt.Errorf("delete http integration error: %s", err)
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if ra == 0 {
+		return fmt.Errorf("http integration %s does not exist", appEUI)
+	}
+	log.WithField("app_eui", appEUI).Info("http integration deleted")
+	return nil
+}
@@ -0,0 +1,112 @@
+package storage
+
+import (
+	"database/sql"
+	"fmt"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/brocaar/lorawan"
+)
+
+// InfluxDBIntegration defines the InfluxDB integration of an application.
+// The decoded uplink payloads and their meta-data are written to the given
+// bucket as points of the given measurement.
+type InfluxDBIntegration struct {
+	AppEUI       lorawan.EUI64 `db:"app_eui"`
+	Endpoint     string        `db:"endpoint"`
+	Organization string        `db:"organization"`
+	Bucket       string        `db:"bucket"`
+	Token        string        `db:"token"`
+	Measurement  string        `db:"measurement"`
+}
+
+// CreateInfluxDBIntegration creates the given InfluxDBIntegration.
+func CreateInfluxDBIntegration(db *sqlx.DB, i InfluxDBIntegration) error {
+	_, err := db.Exec(`
+		insert into influxdb_integration (
+			app_eui,
+			endpoint,
+			organization,
+			bucket,
+			token,
+			measurement
+		) values ($1, $2, $3, $4, $5, $6)`,
+		i.AppEUI[:],
+		i.Endpoint,
+		i.Organization,
+		i.Bucket,
+		i.Token,
+		i.Measurement,
+	)
+	if err != nil {
+		return fmt.Errorf("create influxdb integration error: %s", err)
+	}
+	log.WithField("app_eui", i.AppEUI).Info("influxdb integration created")
+	return nil
+}
+
+// GetInfluxDBIntegration returns the InfluxDBIntegration for the given
+// AppEUI. When the application doesn't have an InfluxDB integration, nil is
+// returned.
+func GetInfluxDBIntegration(db *sqlx.DB, appEUI lorawan.EUI64) (*InfluxDBIntegration, error) {
+	var i InfluxDBIntegration
+	err := db.Get(&i, "select * from influxdb_integration where app_eui = $1", appEUI[:])
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get influxdb integration %s error: %s", appEUI, err)
+	}
+	return &i, nil
+}
+
+// UpdateInfluxDBIntegration updates the given InfluxDBIntegration.
+func UpdateInfluxDBIntegration(db *sqlx.DB, i InfluxDBIntegration) error {
+	res, err := db.Exec(`
+		update influxdb_integration set
+			endpoint = $2,
+			organization = $3,
+			bucket = $4,
+			token = $5,
+			measurement = $6
+		where app_eui = $1`,
+		i.AppEUI[:],
+		i.Endpoint,
+		i.Organization,
+		i.Bucket,
+		i.Token,
+		i.Measurement,
+	)
+	if err != nil {
+		return fmt.Errorf("update influxdb integration error: %s", err)
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if ra == 0 {
+		return fmt.Errorf("influxdb integration %s does not exist", i.AppEUI)
+	}
+	log.WithField("app_eui", i.AppEUI).Info("influxdb integration updated")
+	return nil
+}
+
+// DeleteInfluxDBIntegration deletes the InfluxDBIntegration of the given
+// AppEUI.
+func DeleteInfluxDBIntegration(db *sqlx.DB, appEUI lorawan.EUI64) error {
+	res, err := db.Exec("delete from influxdb_integration where app_eui = $1", appEUI[:])
+	if err != nil {
+		return fmt.Errorf("delete influxdb integration error: %s", err)
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if ra == 0 {
+		return fmt.Errorf("influxdb integration %s does not exist", appEUI)
+	}
+	log.WithField("app_eui", appEUI).Info("influxdb integration deleted")
+	return nil
+}
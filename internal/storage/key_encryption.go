@@ -0,0 +1,253 @@
+package storage
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/brocaar/lora-app-server/internal/keywrap"
+	"github.com/brocaar/lorawan"
+)
+
+// keyBackend contains the backend used for wrapping the root and session
+// keys of the nodes and multicast groups. When nil, the keys are stored in plaintext.
+var keyBackend keywrap.Backend
+
+// SetKeyBackend sets the backend used for wrapping the root key (AppKey)
+// and the session keys (AppSKey and NwkSKey) of the nodes stored in the
+// database.
+func SetKeyBackend(b keywrap.Backend) {
+	keyBackend = b
+}
+
+// EncryptedKey implements an AES-128 key which is stored wrapped by the
+// key encryption backend set by SetKeyBackend. Keys stored in plaintext
+// (before the backend was configured) can still be read.
+type EncryptedKey lorawan.AES128Key
+
+// String implements fmt.Stringer.
+func (k EncryptedKey) String() string {
+	return lorawan.AES128Key(k).String()
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (k EncryptedKey) MarshalText() ([]byte, error) {
+	return lorawan.AES128Key(k).MarshalText()
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (k *EncryptedKey) UnmarshalText(text []byte) error {
+	return (*lorawan.AES128Key)(k).UnmarshalText(text)
+}
+
+// Scan implements the sql.Scanner interface.
+func (k *EncryptedKey) Scan(src interface{}) error {
+	b, ok := src.([]byte)
+	if !ok {
+		return fmt.Errorf("src must be of type []byte, got: %T", src)
+	}
+
+	// plaintext key
+	if len(b) == len(k) {
+		copy(k[:], b)
+		return nil
+	}
+
+	if keyBackend == nil {
+		return errors.New("key is encrypted but the key encryption backend is not configured")
+	}
+
+	var env keywrap.Envelope
+	if err := env.UnmarshalBinary(b); err != nil {
+		return fmt.Errorf("unmarshal key envelope error: %s", err)
+	}
+	key, err := keyBackend.Unwrap(env)
+	if err != nil {
+		return fmt.Errorf("unwrap key error: %s", err)
+	}
+	if len(key) != len(k) {
+		return fmt.Errorf("unwrapped key must be %d bytes, got: %d", len(k), len(key))
+	}
+	copy(k[:], key)
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+func (k EncryptedKey) Value() (driver.Value, error) {
+	if keyBackend == nil {
+		return k[:], nil
+	}
+
+	env, err := keyBackend.Wrap(k[:])
+	if err != nil {
+		return nil, fmt.Errorf("wrap key error: %s", err)
+	}
+	return env.MarshalBinary()
+}
+
+// KeyEnvelope implements a key wrapped by a KEK which is not known to LoRa
+// App Server, e.g. the AppSKey of a node using end-to-end encryption. The
+// envelope is stored as-is and can only be unwrapped by the application.
+type KeyEnvelope keywrap.Envelope
+
+// Scan implements the sql.Scanner interface.
+func (e *KeyEnvelope) Scan(src interface{}) error {
+	b, ok := src.([]byte)
+	if !ok {
+		return fmt.Errorf("src must be of type []byte, got: %T", src)
+	}
+	return (*keywrap.Envelope)(e).UnmarshalBinary(b)
+}
+
+// Value implements the driver.Valuer interface.
+func (e KeyEnvelope) Value() (driver.Value, error) {
+	return keywrap.Envelope(e).MarshalBinary()
+}
+
+// RewrapNodeKeys wraps the root and session keys of the nodes which are
+// stored in plaintext or which are wrapped by an other than the active KEK,
+// e.g. after enabling the key encryption or after a KEK rotation. It
+// returns the number of updated nodes.
+func RewrapNodeKeys(db *sqlx.DB) (int, error) {
+	if keyBackend == nil {
+		return 0, errors.New("the key encryption backend is not configured")
+	}
+
+	var nodes []struct {
+		DevEUI  lorawan.EUI64 `db:"dev_eui"`
+		AppKey  []byte        `db:"app_key"`
+		AppSKey []byte        `db:"app_s_key"`
+		NwkSKey []byte        `db:"nwk_s_key"`
+	}
+	if err := db.Select(&nodes, "select dev_eui, app_key, app_s_key, nwk_s_key from node"); err != nil {
+		return 0, fmt.Errorf("get node keys error: %s", err)
+	}
+
+	var count int
+	for _, n := range nodes {
+		if !keyNeedsWrap(n.AppKey) && !keyNeedsWrap(n.AppSKey) && !keyNeedsWrap(n.NwkSKey) {
+			continue
+		}
+
+		var appKey, appSKey, nwkSKey EncryptedKey
+		for _, k := range []struct {
+			key *EncryptedKey
+			b   []byte
+		}{
+			{&appKey, n.AppKey},
+			{&appSKey, n.AppSKey},
+			{&nwkSKey, n.NwkSKey},
+		} {
+			if err := k.key.Scan(k.b); err != nil {
+				return count, fmt.Errorf("read keys of node %s error: %s", n.DevEUI, err)
+			}
+		}
+
+		// the keys are only updated when they were not changed in the
+		// meantime
+		_, err := db.Exec(`
+			update node set
+				app_key = $2,
+				app_s_key = $3,
+				nwk_s_key = $4
+			where
+				dev_eui = $1
+				and app_key = $5
+				and app_s_key = $6
+				and nwk_s_key = $7`,
+			n.DevEUI[:],
+			appKey,
+			appSKey,
+			nwkSKey,
+			n.AppKey,
+			n.AppSKey,
+			n.NwkSKey,
+		)
+		if err != nil {
+			return count, fmt.Errorf("rewrap keys of node %s error: %s", n.DevEUI, err)
+		}
+		count++
+	}
+
+	log.WithFields(log.Fields{
+		"kek_label": keyBackend.KEKLabel(),
+		"count":     count,
+	}).Info("node keys wrapped")
+	return count, nil
+}
+
+// RewrapMulticastGroupKeys wraps the session keys of the multicast groups
+// which are stored in plaintext or which are wrapped by an other than the
+// active KEK (see RewrapNodeKeys). It returns the number of updated groups.
+func RewrapMulticastGroupKeys(db *sqlx.DB) (int, error) {
+	if keyBackend == nil {
+		return 0, errors.New("the key encryption backend is not configured")
+	}
+
+	var groups []struct {
+		ID        int64  `db:"id"`
+		McNwkSKey []byte `db:"mc_nwk_s_key"`
+		McAppSKey []byte `db:"mc_app_s_key"`
+	}
+	if err := db.Select(&groups, "select id, mc_nwk_s_key, mc_app_s_key from multicast_group"); err != nil {
+		return 0, fmt.Errorf("get multicast group keys error: %s", err)
+	}
+
+	var count int
+	for _, g := range groups {
+		if !keyNeedsWrap(g.McNwkSKey) && !keyNeedsWrap(g.McAppSKey) {
+			continue
+		}
+
+		var mcNwkSKey, mcAppSKey EncryptedKey
+		if err := mcNwkSKey.Scan(g.McNwkSKey); err != nil {
+			return count, fmt.Errorf("read keys of multicast group %d error: %s", g.ID, err)
+		}
+		if err := mcAppSKey.Scan(g.McAppSKey); err != nil {
+			return count, fmt.Errorf("read keys of multicast group %d error: %s", g.ID, err)
+		}
+
+		// the keys are only updated when they were not changed in the
+		// meantime
+		_, err := db.Exec(`
+			update multicast_group set
+				mc_nwk_s_key = $2,
+				mc_app_s_key = $3
+			where
+				id = $1
+				and mc_nwk_s_key = $4
+				and mc_app_s_key = $5`,
+			g.ID,
+			mcNwkSKey,
+			mcAppSKey,
+			g.McNwkSKey,
+			g.McAppSKey,
+		)
+		if err != nil {
+			return count, fmt.Errorf("rewrap keys of multicast group %d error: %s", g.ID, err)
+		}
+		count++
+	}
+
+	log.WithFields(log.Fields{
+		"kek_label": keyBackend.KEKLabel(),
+		"count":     count,
+	}).Info("multicast group keys wrapped")
+	return count, nil
+}
+
+// keyNeedsWrap returns true when the given stored key is in plaintext or
+// is wrapped by an other than the active KEK.
+func keyNeedsWrap(b []byte) bool {
+	if len(b) == len(lorawan.AES128Key{}) {
+		return true
+	}
+	var env keywrap.Envelope
+	if err := env.UnmarshalBinary(b); err != nil {
+		return true
+	}
+	return env.KEKLabel != keyBackend.KEKLabel()
+}
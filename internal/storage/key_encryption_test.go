@@ -0,0 +1,187 @@
+package storage
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/brocaar/lora-app-server/internal/keywrap"
+	"github.com/brocaar/lora-app-server/internal/test"
+	"github.com/brocaar/lorawan"
+)
+
+func TestEncryptedKey(t *testing.T) {
+	key := EncryptedKey{1, 2, 3, 4, 5, 6, 7, 8, 1, 2, 3, 4, 5, 6, 7, 8}
+
+	Convey("Given no key encryption backend", t, func() {
+		keyBackend = nil
+
+		Convey("Then the key is stored in plaintext", func() {
+			v, err := key.Value()
+			So(err, ShouldBeNil)
+			So(v, ShouldResemble, key[:])
+
+			var k EncryptedKey
+			So(k.Scan(v), ShouldBeNil)
+			So(k, ShouldEqual, key)
+		})
+	})
+
+	Convey("Given a local key encryption backend", t, func() {
+		b, err := keywrap.NewLocalBackend(map[string][]byte{
+			"kek-1": make([]byte, 16),
+			"kek-2": make([]byte, 32),
+		}, "kek-2")
+		So(err, ShouldBeNil)
+		SetKeyBackend(b)
+		defer func() { keyBackend = nil }()
+
+		Convey("When storing a key", func() {
+			v, err := key.Value()
+			So(err, ShouldBeNil)
+			bytes := v.([]byte)
+
+			Convey("Then the key is wrapped using the active kek", func() {
+				var env keywrap.Envelope
+				So(env.UnmarshalBinary(bytes), ShouldBeNil)
+				So(env.KEKLabel, ShouldEqual, "kek-2")
+				So(env.Key, ShouldHaveLength, 24)
+			})
+
+			Convey("Then it can be unwrapped", func() {
+				var k EncryptedKey
+				So(k.Scan(bytes), ShouldBeNil)
+				So(k, ShouldEqual, key)
+			})
+
+			Convey("Then a modified key can not be unwrapped", func() {
+				bytes[len(bytes)-1] ^= 0xff
+				var k EncryptedKey
+				So(k.Scan(bytes), ShouldNotBeNil)
+			})
+		})
+
+		Convey("Then a plaintext key can still be read", func() {
+			var k EncryptedKey
+			So(k.Scan(key[:]), ShouldBeNil)
+			So(k, ShouldEqual, key)
+		})
+	})
+}
+
+func TestRewrapNodeKeys(t *testing.T) {
+	conf := test.GetConfig()
+
+	Convey("Given a clean database with a node stored without key encryption backend", t, func() {
+		db, err := OpenDatabase(conf.PostgresDSN)
+		So(err, ShouldBeNil)
+		test.MustResetDB(db)
+
+		keyBackend = nil
+		node := Node{
+			Name:    "test-node",
+			DevEUI:  lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+			AppKey:  EncryptedKey{1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1},
+			AppSKey: EncryptedKey{2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2},
+			NwkSKey: EncryptedKey{3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3},
+		}
+		So(CreateNode(db, node), ShouldBeNil)
+
+		Convey("When rewrapping the keys using a local key encryption backend", func() {
+			b, err := keywrap.NewLocalBackend(map[string][]byte{"kek-1": make([]byte, 16)}, "kek-1")
+			So(err, ShouldBeNil)
+			SetKeyBackend(b)
+			defer func() { keyBackend = nil }()
+
+			count, err := RewrapNodeKeys(db)
+			So(err, ShouldBeNil)
+			So(count, ShouldEqual, 1)
+
+			Convey("Then the root and session keys are stored wrapped", func() {
+				var keys struct {
+					AppKey  []byte `db:"app_key"`
+					AppSKey []byte `db:"app_s_key"`
+					NwkSKey []byte `db:"nwk_s_key"`
+				}
+				So(db.Get(&keys, "select app_key, app_s_key, nwk_s_key from node where dev_eui = $1", node.DevEUI[:]), ShouldBeNil)
+				for _, k := range [][]byte{keys.AppKey, keys.AppSKey, keys.NwkSKey} {
+					var env keywrap.Envelope
+					So(env.UnmarshalBinary(k), ShouldBeNil)
+					So(env.KEKLabel, ShouldEqual, "kek-1")
+				}
+			})
+
+			Convey("Then the keys can be read", func() {
+				n, err := GetNode(db, node.DevEUI)
+				So(err, ShouldBeNil)
+				So(n.AppKey, ShouldEqual, node.AppKey)
+				So(n.AppSKey, ShouldEqual, node.AppSKey)
+				So(n.NwkSKey, ShouldEqual, node.NwkSKey)
+			})
+
+			Convey("Then rewrapping again doesn't update the node", func() {
+				count, err := RewrapNodeKeys(db)
+				So(err, ShouldBeNil)
+				So(count, ShouldEqual, 0)
+			})
+		})
+	})
+}
+
+func TestRewrapMulticastGroupKeys(t *testing.T) {
+	conf := test.GetConfig()
+
+	Convey("Given a clean database with a multicast group stored without key encryption backend", t, func() {
+		db, err := OpenDatabase(conf.PostgresDSN)
+		So(err, ShouldBeNil)
+		test.MustResetDB(db)
+
+		keyBackend = nil
+		g := MulticastGroup{
+			Name:      "test-group",
+			AppEUI:    lorawan.EUI64{1, 1, 1, 1, 1, 1, 1, 1},
+			McAddr:    lorawan.DevAddr{1, 2, 3, 4},
+			McNwkSKey: EncryptedKey{1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1},
+			McAppSKey: EncryptedKey{2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2},
+			Frequency: 868100000,
+		}
+		So(CreateMulticastGroup(db, &g), ShouldBeNil)
+
+		Convey("When rewrapping the keys using a local key encryption backend", func() {
+			b, err := keywrap.NewLocalBackend(map[string][]byte{"kek-1": make([]byte, 16)}, "kek-1")
+			So(err, ShouldBeNil)
+			SetKeyBackend(b)
+			defer func() { keyBackend = nil }()
+
+			count, err := RewrapMulticastGroupKeys(db)
+			So(err, ShouldBeNil)
+			So(count, ShouldEqual, 1)
+
+			Convey("Then the session keys are stored wrapped", func() {
+				var keys struct {
+					McNwkSKey []byte `db:"mc_nwk_s_key"`
+					McAppSKey []byte `db:"mc_app_s_key"`
+				}
+				So(db.Get(&keys, "select mc_nwk_s_key, mc_app_s_key from multicast_group where id = $1", g.ID), ShouldBeNil)
+				for _, k := range [][]byte{keys.McNwkSKey, keys.McAppSKey} {
+					var env keywrap.Envelope
+					So(env.UnmarshalBinary(k), ShouldBeNil)
+					So(env.KEKLabel, ShouldEqual, "kek-1")
+				}
+			})
+
+			Convey("Then the keys can be read", func() {
+				g2, err := GetMulticastGroup(db, g.ID)
+				So(err, ShouldBeNil)
+				So(g2.McNwkSKey, ShouldEqual, g.McNwkSKey)
+				So(g2.McAppSKey, ShouldEqual, g.McAppSKey)
+			})
+
+			Convey("Then rewrapping again doesn't update the group", func() {
+				count, err := RewrapMulticastGroupKeys(db)
+				So(err, ShouldBeNil)
+				So(count, ShouldEqual, 0)
+			})
+		})
+	})
+}
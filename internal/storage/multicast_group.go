@@ -0,0 +1,245 @@
+package storage
+
+import (
+	"fmt"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/brocaar/lorawan"
+)
+
+// MulticastGroup defines a multicast group of an application. The McAddr
+// and session keys are the multicast session shared by the member nodes.
+// The session keys are stored wrapped, like the keys of the nodes.
+type MulticastGroup struct {
+	ID        int64           `db:"id"`
+	Name      string          `db:"name"`
+	AppEUI    lorawan.EUI64   `db:"app_eui"`
+	McAddr    lorawan.DevAddr `db:"mc_addr"`
+	McNwkSKey EncryptedKey    `db:"mc_nwk_s_key"`
+	McAppSKey EncryptedKey    `db:"mc_app_s_key"`
+	DR        int             `db:"dr"`
+	Frequency int             `db:"frequency"`
+}
+
+// CreateMulticastGroup creates the given MulticastGroup.
+func CreateMulticastGroup(db *sqlx.DB, g *MulticastGroup) error {
+	err := db.Get(&g.ID, `
+		insert into multicast_group (
+			name,
+			app_eui,
+			mc_addr,
+			mc_nwk_s_key,
+			mc_app_s_key,
+			dr,
+			frequency
+		) values ($1, $2, $3, $4, $5, $6, $7) returning id`,
+		g.Name,
+		g.AppEUI[:],
+		g.McAddr[:],
+		g.McNwkSKey,
+		g.McAppSKey,
+		g.DR,
+		g.Frequency,
+	)
+	if err != nil {
+		return fmt.Errorf("create multicast group error: %s", err)
+	}
+	log.WithFields(log.Fields{
+		"id":      g.ID,
+		"app_eui": g.AppEUI,
+	}).Info("multicast group created")
+	return nil
+}
+
+// GetMulticastGroup returns the MulticastGroup for the given id.
+func GetMulticastGroup(db *sqlx.DB, id int64) (MulticastGroup, error) {
+	var g MulticastGroup
+	err := db.Get(&g, "select * from multicast_group where id = $1", id)
+	if err != nil {
+		return g, fmt.Errorf("get multicast group %d error: %s", id, err)
+	}
+	return g, nil
+}
+
+// GetMulticastGroupsForAppEUI returns the multicast groups of the given
+// AppEUI.
+func GetMulticastGroupsForAppEUI(db *sqlx.DB, appEUI lorawan.EUI64) ([]MulticastGroup, error) {
+	var groups []MulticastGroup
+	err := db.Select(&groups, "select * from multicast_group where app_eui = $1 order by name, id", appEUI[:])
+	if err != nil {
+		return nil, fmt.Errorf("get multicast groups error: %s", err)
+	}
+	return groups, nil
+}
+
+// UpdateMulticastGroup updates the given MulticastGroup. The AppEUI of a
+// group can't be changed.
+func UpdateMulticastGroup(db *sqlx.DB, g MulticastGroup) error {
+	res, err := db.Exec(`
+		update multicast_group set
+			name = $2,
+			mc_addr = $3,
+			mc_nwk_s_key = $4,
+			mc_app_s_key = $5,
+			dr = $6,
+			frequency = $7
+		where id = $1`,
+		g.ID,
+		g.Name,
+		g.McAddr[:],
+		g.McNwkSKey,
+		g.McAppSKey,
+		g.DR,
+		g.Frequency,
+	)
+	if err != nil {
+		return fmt.Errorf("update multicast group error: %s", err)
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if ra == 0 {
+		return fmt.Errorf("multicast group %d does not exist", g.ID)
+	}
+	log.WithField("id", g.ID).Info("multicast group updated")
+	return nil
+}
+
+// DeleteMulticastGroup deletes the MulticastGroup matching the given id
+// (including its node assignments).
+func DeleteMulticastGroup(db *sqlx.DB, id int64) error {
+	res, err := db.Exec("delete from multicast_group where id = $1", id)
+	if err != nil {
+		return fmt.Errorf("delete multicast group error: %s", err)
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if ra == 0 {
+		return fmt.Errorf("multicast group %d does not exist", id)
+	}
+	log.WithField("id", id).Info("multicast group deleted")
+	return nil
+}
+
+// AddNodeToMulticastGroup assigns the given node to the multicast group.
+// Adding a node which is already assigned is not an error.
+func AddNodeToMulticastGroup(db *sqlx.DB, id int64, devEUI lorawan.EUI64) error {
+	_, err := db.Exec(`
+		insert into multicast_group_node (
+			multicast_group_id,
+			dev_eui
+		)
+		select $1, $2
+		where not exists (
+			select 1 from multicast_group_node
+			where multicast_group_id = $1 and dev_eui = $2
+		)`,
+		id,
+		devEUI[:],
+	)
+	if err != nil {
+		return fmt.Errorf("add node to multicast group error: %s", err)
+	}
+	log.WithFields(log.Fields{
+		"id":      id,
+		"dev_eui": devEUI,
+	}).Info("node added to multicast group")
+	return nil
+}
+
+// RemoveNodeFromMulticastGroup removes the given node from the multicast
+// group.
+func RemoveNodeFromMulticastGroup(db *sqlx.DB, id int64, devEUI lorawan.EUI64) error {
+	res, err := db.Exec("delete from multicast_group_node where multicast_group_id = $1 and dev_eui = $2", id, devEUI[:])
+	if err != nil {
+		return fmt.Errorf("remove node from multicast group error: %s", err)
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if ra == 0 {
+		return fmt.Errorf("node %s is not in multicast group %d", devEUI, id)
+	}
+	log.WithFields(log.Fields{
+		"id":      id,
+		"dev_eui": devEUI,
+	}).Info("node removed from multicast group")
+	return nil
+}
+
+// GetMulticastGroupNodes returns the DevEUIs of the nodes assigned to the
+// given multicast group.
+func GetMulticastGroupNodes(db *sqlx.DB, id int64) ([]lorawan.EUI64, error) {
+	var devEUIs []lorawan.EUI64
+	err := db.Select(&devEUIs, "select dev_eui from multicast_group_node where multicast_group_id = $1 order by dev_eui", id)
+	if err != nil {
+		return nil, fmt.Errorf("get multicast group nodes error: %s", err)
+	}
+	return devEUIs, nil
+}
+
+// CreateMulticastQueueItems adds the given item to the downlink queue of
+// each node assigned to the multicast group (including the DownlinkDelivery
+// of each created item). The DevEUI and ID of the given item are ignored.
+// It returns the DevEUIs of the nodes for which the item was enqueued.
+func CreateMulticastQueueItems(db sqlx.Ext, id int64, item DownlinkQueueItem) ([]lorawan.EUI64, error) {
+	devEUIs, err := createQueueItems(db, "select dev_eui from multicast_group_node where multicast_group_id = $1", id, item)
+	if err != nil {
+		return nil, fmt.Errorf("enqueue multicast queue items error: %s", err)
+	}
+	log.WithFields(log.Fields{
+		"id":    id,
+		"count": len(devEUIs),
+	}).Info("multicast queue items enqueued")
+	return devEUIs, nil
+}
+
+// createQueueItems adds the given item to the downlink queue of each node
+// returned by the given query (selecting dev_eui, with the given id as $1),
+// including the DownlinkDelivery of each created item.
+func createQueueItems(db sqlx.Ext, nodes string, id int64, item DownlinkQueueItem) ([]lorawan.EUI64, error) {
+	var devEUIs []lorawan.EUI64
+	err := sqlx.Select(db, &devEUIs, `
+		with n as (
+			`+nodes+`
+		),
+		qi as (
+			insert into downlink_queue (
+				dev_eui,
+				reference,
+				confirmed,
+				pending,
+				fport,
+				data
+			)
+			select dev_eui, $2, $3, false, $4, $5 from n
+			returning id, dev_eui, reference, confirmed
+		)
+		insert into downlink_delivery (
+			id,
+			created_at,
+			updated_at,
+			dev_eui,
+			reference,
+			confirmed,
+			status
+		)
+		select id, $6, $6, dev_eui, reference, confirmed, $7 from qi
+		returning dev_eui`,
+		id,
+		item.Reference,
+		item.Confirmed,
+		item.FPort,
+		item.Data,
+		time.Now(),
+		DeliveryStatusQueued,
+	)
+	return devEUIs, err
+}
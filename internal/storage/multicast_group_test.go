@@ -0,0 +1,172 @@
+package storage
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/brocaar/lora-app-server/internal/test"
+	"github.com/brocaar/lorawan"
+)
+
+func TestMulticastGroup(t *testing.T) {
+	conf := test.GetConfig()
+
+	Convey("Given a clean database with two nodes", t, func() {
+		db, err := OpenDatabase(conf.PostgresDSN)
+		So(err, ShouldBeNil)
+		test.MustResetDB(db)
+
+		appEUI := lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}
+		node1 := Node{DevEUI: lorawan.EUI64{1, 1, 1, 1, 1, 1, 1, 1}, AppEUI: appEUI}
+		node2 := Node{DevEUI: lorawan.EUI64{2, 2, 2, 2, 2, 2, 2, 2}, AppEUI: appEUI}
+		So(CreateNode(db, node1), ShouldBeNil)
+		So(CreateNode(db, node2), ShouldBeNil)
+
+		Convey("When creating a multicast group", func() {
+			g := MulticastGroup{
+				Name:      "group-1",
+				AppEUI:    appEUI,
+				McAddr:    lorawan.DevAddr{1, 2, 3, 4},
+				McNwkSKey: EncryptedKey{1, 2, 3, 4, 5, 6, 7, 8, 1, 2, 3, 4, 5, 6, 7, 8},
+				McAppSKey: EncryptedKey{8, 7, 6, 5, 4, 3, 2, 1, 8, 7, 6, 5, 4, 3, 2, 1},
+				DR:        5,
+				Frequency: 869525000,
+			}
+			So(CreateMulticastGroup(db, &g), ShouldBeNil)
+			So(g.ID, ShouldNotEqual, 0)
+
+			Convey("Then it can be retrieved by id", func() {
+				g2, err := GetMulticastGroup(db, g.ID)
+				So(err, ShouldBeNil)
+				So(g2, ShouldResemble, g)
+			})
+
+			Convey("Then it is listed for the application only", func() {
+				groups, err := GetMulticastGroupsForAppEUI(db, appEUI)
+				So(err, ShouldBeNil)
+				So(groups, ShouldHaveLength, 1)
+				So(groups[0], ShouldResemble, g)
+
+				groups, err = GetMulticastGroupsForAppEUI(db, lorawan.EUI64{1})
+				So(err, ShouldBeNil)
+				So(groups, ShouldHaveLength, 0)
+			})
+
+			Convey("When updating the multicast group", func() {
+				g.Name = "group-2"
+				g.McAddr = lorawan.DevAddr{4, 3, 2, 1}
+				g.DR = 3
+				g.AppEUI = lorawan.EUI64{1}
+				So(UpdateMulticastGroup(db, g), ShouldBeNil)
+
+				Convey("Then the multicast group has been updated, except for the AppEUI", func() {
+					g2, err := GetMulticastGroup(db, g.ID)
+					So(err, ShouldBeNil)
+					So(g2.Name, ShouldEqual, "group-2")
+					So(g2.McAddr, ShouldEqual, lorawan.DevAddr{4, 3, 2, 1})
+					So(g2.DR, ShouldEqual, 3)
+					So(g2.AppEUI, ShouldEqual, appEUI)
+				})
+			})
+
+			Convey("Then updating an unknown multicast group returns an error", func() {
+				g.ID++
+				So(UpdateMulticastGroup(db, g), ShouldNotBeNil)
+			})
+
+			Convey("When adding both nodes to the multicast group (twice)", func() {
+				So(AddNodeToMulticastGroup(db, g.ID, node2.DevEUI), ShouldBeNil)
+				So(AddNodeToMulticastGroup(db, g.ID, node1.DevEUI), ShouldBeNil)
+				So(AddNodeToMulticastGroup(db, g.ID, node1.DevEUI), ShouldBeNil)
+
+				Convey("Then both nodes are assigned once", func() {
+					devEUIs, err := GetMulticastGroupNodes(db, g.ID)
+					So(err, ShouldBeNil)
+					So(devEUIs, ShouldResemble, []lorawan.EUI64{node1.DevEUI, node2.DevEUI})
+				})
+
+				Convey("When enqueueing a multicast queue item", func() {
+					devEUIs, err := CreateMulticastQueueItems(db, g.ID, DownlinkQueueItem{
+						ID:        123,
+						DevEUI:    lorawan.EUI64{3},
+						Reference: "multicast",
+						FPort:     10,
+						Data:      []byte{1, 2, 3},
+					})
+					So(err, ShouldBeNil)
+					So(devEUIs, ShouldHaveLength, 2)
+
+					Convey("Then the item has been enqueued for each node, including its delivery", func() {
+						for _, devEUI := range []lorawan.EUI64{node1.DevEUI, node2.DevEUI} {
+							items, err := GetDownlinkQueueItems(db, devEUI)
+							So(err, ShouldBeNil)
+							So(items, ShouldHaveLength, 1)
+							So(items[0].DevEUI, ShouldEqual, devEUI)
+							So(items[0].Reference, ShouldEqual, "multicast")
+							So(items[0].Confirmed, ShouldBeFalse)
+							So(items[0].FPort, ShouldEqual, 10)
+							So(items[0].Data, ShouldResemble, []byte{1, 2, 3})
+
+							d, err := GetDownlinkDelivery(db, items[0].ID)
+							So(err, ShouldBeNil)
+							So(d.Status, ShouldEqual, DeliveryStatusQueued)
+						}
+					})
+				})
+
+				Convey("When removing a node from the multicast group", func() {
+					So(RemoveNodeFromMulticastGroup(db, g.ID, node1.DevEUI), ShouldBeNil)
+
+					Convey("Then only the other node is assigned", func() {
+						devEUIs, err := GetMulticastGroupNodes(db, g.ID)
+						So(err, ShouldBeNil)
+						So(devEUIs, ShouldResemble, []lorawan.EUI64{node2.DevEUI})
+					})
+
+					Convey("Then removing it again returns an error", func() {
+						So(RemoveNodeFromMulticastGroup(db, g.ID, node1.DevEUI), ShouldNotBeNil)
+					})
+				})
+
+				Convey("When deleting a node", func() {
+					So(DeleteNode(db, node1.DevEUI), ShouldBeNil)
+
+					Convey("Then it is removed from the multicast group", func() {
+						devEUIs, err := GetMulticastGroupNodes(db, g.ID)
+						So(err, ShouldBeNil)
+						So(devEUIs, ShouldResemble, []lorawan.EUI64{node2.DevEUI})
+					})
+				})
+
+				Convey("When deleting the multicast group", func() {
+					So(DeleteMulticastGroup(db, g.ID), ShouldBeNil)
+
+					Convey("Then it can't be retrieved anymore", func() {
+						_, err := GetMulticastGroup(db, g.ID)
+						So(err, ShouldNotBeNil)
+					})
+
+					Convey("Then its node assignments have been deleted", func() {
+						devEUIs, err := GetMulticastGroupNodes(db, g.ID)
+						So(err, ShouldBeNil)
+						So(devEUIs, ShouldHaveLength, 0)
+					})
+
+					Convey("Then deleting it again returns an error", func() {
+						So(DeleteMulticastGroup(db, g.ID), ShouldNotBeNil)
+					})
+				})
+			})
+
+			Convey("When enqueueing a multicast queue item without nodes", func() {
+				devEUIs, err := CreateMulticastQueueItems(db, g.ID, DownlinkQueueItem{FPort: 10})
+
+				Convey("Then no items are enqueued", func() {
+					So(err, ShouldBeNil)
+					So(devEUIs, ShouldHaveLength, 0)
+				})
+			})
+		})
+	})
+}
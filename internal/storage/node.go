@@ -1,16 +1,23 @@
 package storage
 
 import (
+	"database/sql"
 	"database/sql/driver"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"time"
 
 	log "github.com/Sirupsen/logrus"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 
 	"github.com/brocaar/lorawan"
 )
 
+// ErrNodeDoesNotExist is returned by GetNode when the node does not exist.
+var ErrNodeDoesNotExist = errors.New("node does not exist")
+
 // DevNonceList represents a list of dev nonces
 type DevNonceList [][2]byte
 
@@ -67,17 +74,38 @@ func (r RXWindow) Value() (driver.Value, error) {
 	return int64(r), nil
 }
 
+// NodeVariables defines the variables of a node, used by the integrations
+// (e.g. the ThingsBoard access token of the node).
+type NodeVariables map[string]string
+
+// Scan implements the sql.Scanner interface.
+func (v *NodeVariables) Scan(src interface{}) error {
+	b, ok := src.([]byte)
+	if !ok {
+		return fmt.Errorf("src must be of type []byte, got: %T", src)
+	}
+	return json.Unmarshal(b, v)
+}
+
+// Value implements the driver.Valuer interface.
+func (v NodeVariables) Value() (driver.Value, error) {
+	if v == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(v)
+}
+
 // Node contains the information of a node.
 type Node struct {
-	Name          string            `db:"name"`
-	DevEUI        lorawan.EUI64     `db:"dev_eui"`
-	AppEUI        lorawan.EUI64     `db:"app_eui"`
-	AppKey        lorawan.AES128Key `db:"app_key"`
-	DevAddr       lorawan.DevAddr   `db:"dev_addr"`
-	NwkSKey       lorawan.AES128Key `db:"nwk_s_key"`
-	AppSKey       lorawan.AES128Key `db:"app_s_key"`
-	UsedDevNonces DevNonceList      `db:"used_dev_nonces"`
-	RelaxFCnt     bool              `db:"relax_fcnt"`
+	Name          string          `db:"name"`
+	DevEUI        lorawan.EUI64   `db:"dev_eui"`
+	AppEUI        lorawan.EUI64   `db:"app_eui"`
+	AppKey        EncryptedKey    `db:"app_key"`
+	DevAddr       lorawan.DevAddr `db:"dev_addr"`
+	NwkSKey       EncryptedKey    `db:"nwk_s_key"`
+	AppSKey       EncryptedKey    `db:"app_s_key"`
+	UsedDevNonces DevNonceList    `db:"used_dev_nonces"`
+	RelaxFCnt     bool            `db:"relax_fcnt"`
 
 	RXWindow      RXWindow `db:"rx_window"`
 	RXDelay       uint8    `db:"rx_delay"`
@@ -87,8 +115,54 @@ type Node struct {
 
 	ADRInterval        uint32  `db:"adr_interval"`
 	InstallationMargin float64 `db:"installation_margin"`
+
+	// UplinkInterval defines the expected interval (in seconds) between
+	// the uplink transmissions of the node (0 = unknown).
+	UplinkInterval uint32 `db:"uplink_interval"`
+
+	// LinkScore contains the link-quality score (0 - 100) of the node
+	// (nil = unknown). It is updated by UpdateNodeLinkScore.
+	LinkScore *int `db:"link_score"`
+
+	// Battery, LinkMargin and DeviceStatusAt contain the last device status
+	// (DevStatusAns) reported by the node (nil = unknown). They are updated
+	// by UpdateNodeDeviceStatus. Battery is the raw value, see
+	// BatteryLevel.
+	Battery        *int       `db:"battery"`
+	LinkMargin     *int       `db:"link_margin"`
+	DeviceStatusAt *time.Time `db:"device_status_at"`
+
+	// Latitude, Longitude, Altitude (meters), LocationAccuracy (meters) and
+	// LocationAt contain the last resolved location of the node (nil =
+	// unknown). They are updated by UpdateNodeLocation.
+	Latitude         *float64   `db:"latitude"`
+	Longitude        *float64   `db:"longitude"`
+	Altitude         *float64   `db:"altitude"`
+	LocationAccuracy *float64   `db:"location_accuracy"`
+	LocationAt       *time.Time `db:"location_at"`
+
+	// Variables contains the variables of the node used by the
+	// integrations.
+	Variables NodeVariables `db:"variables"`
+
+	// E2EEncryption defines if the node uses end-to-end encryption. In this
+	// case the payloads are encrypted and decrypted by the application and
+	// AppSKey is not set. AppSKeyEnvelope optionally contains the AppSKey,
+	// wrapped by a KEK of the application (nil = not set).
+	E2EEncryption   bool         `db:"e2e_encryption"`
+	AppSKeyEnvelope *KeyEnvelope `db:"app_s_key_envelope"`
 }
 
+// NodeOrder defines the order of the nodes returned by GetNodes.
+type NodeOrder int
+
+// Available node orders.
+const (
+	OrderByDevEUI    NodeOrder = iota // by DevEUI
+	OrderByName                       // by name
+	OrderByLinkScore                  // by link-quality score (worst first)
+)
+
 // ValidateDevNonce returns if the given dev-nonce is valid.
 // When valid, it will be added to UsedDevNonces. This does
 // not update the Node in the database!
@@ -103,7 +177,7 @@ func (n *Node) ValidateDevNonce(nonce [2]byte) bool {
 }
 
 // CreateNode creates the given Node.
-func CreateNode(db *sqlx.DB, n Node) error {
+func CreateNode(db sqlx.Ext, n Node) error {
 	if n.RXDelay > 15 {
 		return errors.New("max value of RXDelay is 15")
 	}
@@ -124,16 +198,20 @@ func CreateNode(db *sqlx.DB, n Node) error {
 			channel_list_id,
 			relax_fcnt,
 			adr_interval,
-			installation_margin
+			installation_margin,
+			uplink_interval,
+			variables,
+			e2e_encryption,
+			app_s_key_envelope
 		)
-		values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)`,
+		values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19)`,
 		n.Name,
 		n.DevEUI[:],
 		n.AppEUI[:],
-		n.AppKey[:],
+		n.AppKey,
 		n.DevAddr[:],
-		n.AppSKey[:],
-		n.NwkSKey[:],
+		n.AppSKey,
+		n.NwkSKey,
 		n.RXDelay,
 		n.RX1DROffset,
 		n.RXWindow,
@@ -142,6 +220,10 @@ func CreateNode(db *sqlx.DB, n Node) error {
 		n.RelaxFCnt,
 		n.ADRInterval,
 		n.InstallationMargin,
+		n.UplinkInterval,
+		n.Variables,
+		n.E2EEncryption,
+		n.AppSKeyEnvelope,
 	)
 	if err != nil {
 		return fmt.Errorf("create node %s error: %s", n.DevEUI, err)
@@ -151,7 +233,7 @@ func CreateNode(db *sqlx.DB, n Node) error {
 }
 
 // UpdateNode updates the given Node.
-func UpdateNode(db *sqlx.DB, n Node) error {
+func UpdateNode(db sqlx.Ext, n Node) error {
 	if n.RXDelay > 15 {
 		return errors.New("max value of RXDelay is 15")
 	}
@@ -172,14 +254,18 @@ func UpdateNode(db *sqlx.DB, n Node) error {
 			channel_list_id = $12,
 			relax_fcnt = $13,
 			adr_interval = $14,
-			installation_margin = $15
-		where dev_eui = $16`,
+			installation_margin = $15,
+			uplink_interval = $16,
+			variables = $17,
+			e2e_encryption = $19,
+			app_s_key_envelope = $20
+		where dev_eui = $18`,
 		n.Name,
 		n.AppEUI[:],
-		n.AppKey[:],
+		n.AppKey,
 		n.DevAddr[:],
-		n.AppSKey[:],
-		n.NwkSKey[:],
+		n.AppSKey,
+		n.NwkSKey,
 		n.UsedDevNonces,
 		n.RXDelay,
 		n.RX1DROffset,
@@ -189,7 +275,11 @@ func UpdateNode(db *sqlx.DB, n Node) error {
 		n.RelaxFCnt,
 		n.ADRInterval,
 		n.InstallationMargin,
+		n.UplinkInterval,
+		n.Variables,
 		n.DevEUI[:],
+		n.E2EEncryption,
+		n.AppSKeyEnvelope,
 	)
 	if err != nil {
 		return fmt.Errorf("update node %s error: %s", n.DevEUI, err)
@@ -229,6 +319,9 @@ func GetNode(db *sqlx.DB, devEUI lorawan.EUI64) (Node, error) {
 	var node Node
 	err := db.Get(&node, "select * from node where dev_eui = $1", devEUI[:])
 	if err != nil {
+		if err == sql.ErrNoRows {
+			return node, ErrNodeDoesNotExist
+		}
 		return node, fmt.Errorf("get node %s error: %s", devEUI, err)
 	}
 	return node, nil
@@ -246,16 +339,153 @@ func GetNodesCount(db *sqlx.DB) (int, error) {
 	return count.Count, nil
 }
 
-// GetNodes returns a slice of nodes, sorted by DevEUI.
-func GetNodes(db *sqlx.DB, limit, offset int) ([]Node, error) {
+// GetNodes returns a slice of nodes, sorted by the given order.
+func GetNodes(db *sqlx.DB, limit, offset int, order NodeOrder) ([]Node, error) {
+	var orderBy string
+	switch order {
+	case OrderByDevEUI:
+		orderBy = "dev_eui"
+	case OrderByName:
+		orderBy = "name, dev_eui"
+	case OrderByLinkScore:
+		orderBy = "link_score nulls last, dev_eui"
+	default:
+		return nil, fmt.Errorf("invalid node order: %d", order)
+	}
+
 	var nodes []Node
-	err := db.Select(&nodes, "select * from node order by dev_eui limit $1 offset $2", limit, offset)
+	err := db.Select(&nodes, "select * from node order by "+orderBy+" limit $1 offset $2", limit, offset)
 	if err != nil {
 		return nodes, fmt.Errorf("get nodes error: %s", err)
 	}
 	return nodes, nil
 }
 
+// GetNodesForAppEUI returns all the nodes of the given application, sorted
+// by DevEUI.
+func GetNodesForAppEUI(db *sqlx.DB, appEUI lorawan.EUI64) ([]Node, error) {
+	var nodes []Node
+	err := db.Select(&nodes, "select * from node where app_eui = $1 order by dev_eui", appEUI[:])
+	if err != nil {
+		return nil, fmt.Errorf("get nodes for app_eui %s error: %s", appEUI, err)
+	}
+	return nodes, nil
+}
+
+// GetDevEUIsForAppEUI returns the DevEUIs of all the nodes of the given
+// application, sorted by DevEUI.
+func GetDevEUIsForAppEUI(db *sqlx.DB, appEUI lorawan.EUI64) ([]lorawan.EUI64, error) {
+	var devEUIs []lorawan.EUI64
+	err := db.Select(&devEUIs, "select dev_eui from node where app_eui = $1 order by dev_eui", appEUI[:])
+	if err != nil {
+		return nil, fmt.Errorf("get dev_euis for app_eui %s error: %s", appEUI, err)
+	}
+	return devEUIs, nil
+}
+
+// GetExistingDevEUIs returns the DevEUIs of the given slice which are
+// already used by a node.
+func GetExistingDevEUIs(db *sqlx.DB, devEUIs []lorawan.EUI64) ([]lorawan.EUI64, error) {
+	arr := make(pq.ByteaArray, 0, len(devEUIs))
+	for i := range devEUIs {
+		arr = append(arr, devEUIs[i][:])
+	}
+
+	var existing []lorawan.EUI64
+	err := db.Select(&existing, "select dev_eui from node where dev_eui = any($1)", arr)
+	if err != nil {
+		return nil, fmt.Errorf("get existing dev_euis error: %s", err)
+	}
+	return existing, nil
+}
+
+// UpdateNodeLinkScore updates the link-quality score of the given node.
+func UpdateNodeLinkScore(db *sqlx.DB, devEUI lorawan.EUI64, score int) error {
+	res, err := db.Exec("update node set link_score = $1 where dev_eui = $2", score, devEUI[:])
+	if err != nil {
+		return fmt.Errorf("update node %s link score error: %s", devEUI, err)
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if ra == 0 {
+		return fmt.Errorf("node %s does not exist", devEUI)
+	}
+	return nil
+}
+
+// UpdateNodeDeviceStatus updates the device status (battery and link
+// margin) of the given node, as reported at the given time.
+func UpdateNodeDeviceStatus(db *sqlx.DB, devEUI lorawan.EUI64, battery, linkMargin int, ts time.Time) error {
+	res, err := db.Exec(`
+		update node set
+			battery = $2,
+			link_margin = $3,
+			device_status_at = $4
+		where dev_eui = $1`,
+		devEUI[:],
+		battery,
+		linkMargin,
+		ts,
+	)
+	if err != nil {
+		return fmt.Errorf("update node %s device status error: %s", devEUI, err)
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if ra == 0 {
+		return fmt.Errorf("node %s does not exist", devEUI)
+	}
+	return nil
+}
+
+// UpdateNodeLocation updates the location of the given node, as resolved
+// for the uplink received at the given time.
+func UpdateNodeLocation(db *sqlx.DB, devEUI lorawan.EUI64, latitude, longitude, altitude, accuracy float64, ts time.Time) error {
+	res, err := db.Exec(`
+		update node set
+			latitude = $2,
+			longitude = $3,
+			altitude = $4,
+			location_accuracy = $5,
+			location_at = $6
+		where dev_eui = $1`,
+		devEUI[:],
+		latitude,
+		longitude,
+		altitude,
+		accuracy,
+		ts,
+	)
+	if err != nil {
+		return fmt.Errorf("update node %s location error: %s", devEUI, err)
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if ra == 0 {
+		return fmt.Errorf("node %s does not exist", devEUI)
+	}
+	return nil
+}
+
+// BatteryLevel returns the battery level (in percent) of the given battery
+// value, as reported by the node in the DevStatusAns (0 = external power
+// source, 1 - 254 = battery level, 255 = unable to measure). It returns nil
+// when the node is connected to an external power source or was unable to
+// measure the battery level.
+func BatteryLevel(battery int) *float64 {
+	if battery < 1 || battery > 254 {
+		return nil
+	}
+	level := float64(battery-1) / 253 * 100
+	return &level
+}
+
 // GetCFListForNode returns the CFList for the given node if the
 // used ISM band allows using a CFList.
 func GetCFListForNode(db *sqlx.DB, node Node) (*lorawan.CFList, error) {
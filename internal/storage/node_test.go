@@ -120,6 +120,7 @@ func TestNodeMethods(t *testing.T) {
 				RX2DR:              3,
 				ADRInterval:        20,
 				InstallationMargin: 5,
+				Variables:          NodeVariables{"ThingsBoardAccessToken": "secret"},
 			}
 			So(CreateNode(db, node), ShouldBeNil)
 
@@ -131,7 +132,7 @@ func TestNodeMethods(t *testing.T) {
 			})
 
 			Convey("Then get nodes returns a single item", func() {
-				nodes, err := GetNodes(db, 10, 0)
+				nodes, err := GetNodes(db, 10, 0, OrderByDevEUI)
 				So(err, ShouldBeNil)
 				So(nodes, ShouldHaveLength, 1)
 				nodes[0].UsedDevNonces = nil
@@ -0,0 +1,263 @@
+package storage
+
+import (
+	"fmt"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/brocaar/lorawan"
+)
+
+// NodeUplink contains the meta-data of an uplink transmission received
+// from a node.
+type NodeUplink struct {
+	ID           int64         `db:"id"`
+	DevEUI       lorawan.EUI64 `db:"dev_eui"`
+	AppEUI       lorawan.EUI64 `db:"app_eui"`
+	ReceivedAt   time.Time     `db:"received_at"`
+	FCnt         uint32        `db:"f_cnt"`
+	FPort        uint8         `db:"f_port"`
+	Frequency    int           `db:"frequency"`
+	Modulation   string        `db:"modulation"`
+	SpreadFactor int           `db:"spread_factor"`
+	Bandwidth    int           `db:"bandwidth"`
+	Bitrate      int           `db:"bitrate"`
+	RSSI         int           `db:"rssi"`     // best RSSI of the receiving gateways
+	LoRaSNR      float64       `db:"lora_snr"` // best SNR of the receiving gateways
+	GatewayCount int           `db:"gateway_count"`
+
+	// RXInfo contains the receiving gateways. It is stored by
+	// CreateNodeUplink, but not retrieved by the other functions.
+	RXInfo []NodeUplinkRXInfo `db:"-"`
+}
+
+// NodeUplinkRXInfo contains the reception meta-data of a single gateway.
+type NodeUplinkRXInfo struct {
+	MAC     lorawan.EUI64 `db:"mac"`
+	RSSI    int           `db:"rssi"`
+	LoRaSNR float64       `db:"lora_snr"`
+}
+
+// NodeUplinkCount contains the number of received uplinks of a node.
+type NodeUplinkCount struct {
+	DevEUI         lorawan.EUI64 `db:"dev_eui"`
+	UplinkInterval uint32        `db:"uplink_interval"`
+	Count          int           `db:"count"`
+}
+
+// NodeActivity contains the uplink activity of a node.
+type NodeActivity struct {
+	DevEUI         lorawan.EUI64 `db:"dev_eui"`
+	Name           string        `db:"name"`
+	UplinkInterval uint32        `db:"uplink_interval"`
+	Count          int           `db:"count"`     // number of uplinks within the time range
+	LastSeen       *time.Time    `db:"last_seen"` // last (stored) uplink
+}
+
+// CreateNodeUplink creates the given NodeUplink.
+func CreateNodeUplink(db *sqlx.DB, u *NodeUplink) error {
+	if u.ReceivedAt.IsZero() {
+		u.ReceivedAt = time.Now()
+	}
+
+	err := db.Get(&u.ID, `
+		insert into node_uplink (
+			dev_eui,
+			app_eui,
+			received_at,
+			f_cnt,
+			f_port,
+			frequency,
+			modulation,
+			spread_factor,
+			bandwidth,
+			bitrate,
+			rssi,
+			lora_snr,
+			gateway_count
+		) values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13) returning id`,
+		u.DevEUI[:],
+		u.AppEUI[:],
+		u.ReceivedAt,
+		u.FCnt,
+		u.FPort,
+		u.Frequency,
+		u.Modulation,
+		u.SpreadFactor,
+		u.Bandwidth,
+		u.Bitrate,
+		u.RSSI,
+		u.LoRaSNR,
+		u.GatewayCount,
+	)
+	if err != nil {
+		return fmt.Errorf("create node uplink error: %s", err)
+	}
+
+	for _, rxInfo := range u.RXInfo {
+		_, err := db.Exec(`
+			insert into node_uplink_rx (
+				node_uplink_id,
+				mac,
+				rssi,
+				lora_snr
+			) values ($1, $2, $3, $4)`,
+			u.ID,
+			rxInfo.MAC[:],
+			rxInfo.RSSI,
+			rxInfo.LoRaSNR,
+		)
+		if err != nil {
+			return fmt.Errorf("create node uplink rx-info error: %s", err)
+		}
+	}
+	return nil
+}
+
+// GetLastNodeUplinks returns the last (max. limit) uplinks received from the
+// given node, sorted by receive time (newest first).
+func GetLastNodeUplinks(db *sqlx.DB, devEUI lorawan.EUI64, limit int) ([]NodeUplink, error) {
+	var uplinks []NodeUplink
+	err := db.Select(&uplinks, `
+		select *
+		from node_uplink
+		where
+			dev_eui = $1
+		order by received_at desc, id desc
+		limit $2`,
+		devEUI[:],
+		limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("get last node uplinks error: %s", err)
+	}
+	return uplinks, nil
+}
+
+// GetNodeUplinkRXInfo returns the receiving gateways of the given uplink,
+// sorted by RSSI (best first).
+func GetNodeUplinkRXInfo(db *sqlx.DB, nodeUplinkID int64) ([]NodeUplinkRXInfo, error) {
+	var rxInfo []NodeUplinkRXInfo
+	err := db.Select(&rxInfo, `
+		select
+			mac,
+			rssi,
+			lora_snr
+		from node_uplink_rx
+		where
+			node_uplink_id = $1
+		order by rssi desc, lora_snr desc`,
+		nodeUplinkID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("get node uplink rx-info error: %s", err)
+	}
+	return rxInfo, nil
+}
+
+// GetNodeUplinkCount returns the number of uplinks received from the given
+// node within the given time range (start inclusive, end exclusive).
+func GetNodeUplinkCount(db *sqlx.DB, devEUI lorawan.EUI64, start, end time.Time) (NodeUplinkCount, error) {
+	var count NodeUplinkCount
+	err := db.Get(&count, `
+		select
+			n.dev_eui,
+			n.uplink_interval,
+			count(u.id) as count
+		from node n
+		left join node_uplink u
+			on u.dev_eui = n.dev_eui
+			and u.received_at >= $2
+			and u.received_at < $3
+		where
+			n.dev_eui = $1
+		group by n.dev_eui, n.uplink_interval`,
+		devEUI[:],
+		start,
+		end,
+	)
+	if err != nil {
+		return count, fmt.Errorf("get node uplink count error: %s", err)
+	}
+	return count, nil
+}
+
+// GetNodeUplinkCountsForAppEUI returns the number of uplinks received from
+// each node of the given AppEUI within the given time range (start
+// inclusive, end exclusive), sorted by DevEUI.
+func GetNodeUplinkCountsForAppEUI(db *sqlx.DB, appEUI lorawan.EUI64, start, end time.Time) ([]NodeUplinkCount, error) {
+	var counts []NodeUplinkCount
+	err := db.Select(&counts, `
+		select
+			n.dev_eui,
+			n.uplink_interval,
+			count(u.id) as count
+		from node n
+		left join node_uplink u
+			on u.dev_eui = n.dev_eui
+			and u.received_at >= $2
+			and u.received_at < $3
+		where
+			n.app_eui = $1
+		group by n.dev_eui, n.uplink_interval
+		order by n.dev_eui`,
+		appEUI[:],
+		start,
+		end,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("get node uplink counts error: %s", err)
+	}
+	return counts, nil
+}
+
+// GetNodeActivityForAppEUI returns the activity of each node of the given
+// AppEUI: the number of uplinks within the given time range (start
+// inclusive, end exclusive) and the last stored uplink. The result is
+// sorted by name.
+func GetNodeActivityForAppEUI(db *sqlx.DB, appEUI lorawan.EUI64, start, end time.Time) ([]NodeActivity, error) {
+	var activity []NodeActivity
+	err := db.Select(&activity, `
+		select
+			n.dev_eui,
+			n.name,
+			n.uplink_interval,
+			sum(case when u.received_at >= $2 and u.received_at < $3 then 1 else 0 end) as count,
+			max(u.received_at) as last_seen
+		from node n
+		left join node_uplink u
+			on u.dev_eui = n.dev_eui
+			and u.received_at < $3
+		where
+			n.app_eui = $1
+		group by n.dev_eui, n.name, n.uplink_interval
+		order by n.name, n.dev_eui`,
+		appEUI[:],
+		start,
+		end,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("get node activity error: %s", err)
+	}
+	return activity, nil
+}
+
+// DeleteNodeUplinksBefore deletes the node uplinks received before the
+// given time.
+func DeleteNodeUplinksBefore(db *sqlx.DB, before time.Time) error {
+	res, err := db.Exec("delete from node_uplink where received_at < $1", before)
+	if err != nil {
+		return fmt.Errorf("delete node uplinks error: %s", err)
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	log.WithFields(log.Fields{
+		"before": before,
+		"count":  ra,
+	}).Info("node uplinks deleted")
+	return nil
+}
@@ -0,0 +1,148 @@
+package storage
+
+import (
+	"fmt"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// NotificationPreference defines the alerts a user (the subject of the JWT
+// token) is subscribed to and the channels used to notify the user.
+type NotificationPreference struct {
+	Username        string         `db:"username"`
+	AlertTypes      pq.StringArray `db:"alert_types"`
+	Channels        pq.StringArray `db:"channels"`
+	Applications    pq.StringArray `db:"applications"` // AppEUIs of the node alerts
+	Gateways        pq.StringArray `db:"gateways"`     // MACs of the gateway alerts ("*" for all)
+	Email           string         `db:"email"`
+	WebhookURL      string         `db:"webhook_url"`
+	SlackWebhookURL string         `db:"slack_webhook_url"`
+	QuietHoursStart *int           `db:"quiet_hours_start"` // minutes after midnight
+	QuietHoursEnd   *int           `db:"quiet_hours_end"`   // minutes after midnight
+	Timezone        string         `db:"timezone"`          // IANA timezone of the quiet hours
+}
+
+// CreateNotificationPreference creates the given NotificationPreference.
+func CreateNotificationPreference(db *sqlx.DB, p NotificationPreference) error {
+	_, err := db.Exec(`
+		insert into notification_preference (
+			username,
+			alert_types,
+			channels,
+			applications,
+			gateways,
+			email,
+			webhook_url,
+			slack_webhook_url,
+			quiet_hours_start,
+			quiet_hours_end,
+			timezone
+		) values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)`,
+		p.Username,
+		p.AlertTypes,
+		p.Channels,
+		p.Applications,
+		p.Gateways,
+		p.Email,
+		p.WebhookURL,
+		p.SlackWebhookURL,
+		p.QuietHoursStart,
+		p.QuietHoursEnd,
+		p.Timezone,
+	)
+	if err != nil {
+		return fmt.Errorf("create notification preference error: %s", err)
+	}
+	log.WithField("username", p.Username).Info("notification preference created")
+	return nil
+}
+
+// GetNotificationPreference returns the NotificationPreference for the
+// given username.
+func GetNotificationPreference(db *sqlx.DB, username string) (NotificationPreference, error) {
+	var p NotificationPreference
+	err := db.Get(&p, "select * from notification_preference where username = $1", username)
+	if err != nil {
+		return p, fmt.Errorf("get notification preference %s error: %s", username, err)
+	}
+	return p, nil
+}
+
+// GetNotificationPreferencesForAlertType returns the notification
+// preferences subscribed to the given alert type.
+func GetNotificationPreferencesForAlertType(db *sqlx.DB, alertType string) ([]NotificationPreference, error) {
+	var prefs []NotificationPreference
+	err := db.Select(&prefs, `
+		select *
+		from notification_preference
+		where
+			$1 = any(alert_types)
+		order by username`,
+		alertType,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("get notification preferences error: %s", err)
+	}
+	return prefs, nil
+}
+
+// UpdateNotificationPreference updates the given NotificationPreference.
+func UpdateNotificationPreference(db *sqlx.DB, p NotificationPreference) error {
+	res, err := db.Exec(`
+		update notification_preference set
+			alert_types = $2,
+			channels = $3,
+			applications = $4,
+			gateways = $5,
+			email = $6,
+			webhook_url = $7,
+			slack_webhook_url = $8,
+			quiet_hours_start = $9,
+			quiet_hours_end = $10,
+			timezone = $11
+		where username = $1`,
+		p.Username,
+		p.AlertTypes,
+		p.Channels,
+		p.Applications,
+		p.Gateways,
+		p.Email,
+		p.WebhookURL,
+		p.SlackWebhookURL,
+		p.QuietHoursStart,
+		p.QuietHoursEnd,
+		p.Timezone,
+	)
+	if err != nil {
+		return fmt.Errorf("update notification preference error: %s", err)
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if ra == 0 {
+		return fmt.Errorf("notification preference %s does not exist", p.Username)
+	}
+	log.WithField("username", p.Username).Info("notification preference updated")
+	return nil
+}
+
+// DeleteNotificationPreference deletes the NotificationPreference matching
+// the given username.
+func DeleteNotificationPreference(db *sqlx.DB, username string) error {
+	res, err := db.Exec("delete from notification_preference where username = $1", username)
+	if err != nil {
+		return fmt.Errorf("delete notification preference error: %s", err)
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if ra == 0 {
+		return fmt.Errorf("notification preference %s does not exist", username)
+	}
+	log.WithField("username", username).Info("notification preference deleted")
+	return nil
+}
@@ -103,8 +103,8 @@ func Migrate(ctx common.Context) {
 			continue
 		}
 
-		n.AppSKey = ns.AppSKey
-		n.NwkSKey = ns.NwkSKey
+		n.AppSKey = storage.EncryptedKey(ns.AppSKey)
+		n.NwkSKey = storage.EncryptedKey(ns.NwkSKey)
 		n.DevAddr = ns.DevAddr
 
 		if err := storage.UpdateNode(ctx.DB, n); err != nil {
@@ -0,0 +1,112 @@
+package storage
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/jmoiron/sqlx"
+)
+
+// OIDCUser represents a user provisioned on the first login using the
+// OpenID Connect provider. The username is the subject of the API tokens
+// issued to the user and is used for the organization memberships.
+type OIDCUser struct {
+	Username    string    `db:"username"`
+	Subject     string    `db:"subject"`
+	Email       string    `db:"email"`
+	Name        string    `db:"name"`
+	CreatedAt   time.Time `db:"created_at"`
+	LastLoginAt time.Time `db:"last_login_at"`
+}
+
+// Validate validates the data of the OIDCUser.
+func (u OIDCUser) Validate() error {
+	if u.Username == "" {
+		return errors.New("username must be set")
+	}
+	if u.Subject == "" {
+		return errors.New("subject must be set")
+	}
+	return nil
+}
+
+// CreateOIDCUser creates the given OIDCUser.
+func CreateOIDCUser(db *sqlx.DB, u *OIDCUser) error {
+	if err := u.Validate(); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	_, err := db.Exec(`
+		insert into oidc_user (
+			username,
+			subject,
+			email,
+			name,
+			created_at,
+			last_login_at
+		) values ($1, $2, $3, $4, $5, $5)`,
+		u.Username,
+		u.Subject,
+		u.Email,
+		u.Name,
+		now,
+	)
+	if err != nil {
+		return fmt.Errorf("create oidc user %s error: %s", u.Username, err)
+	}
+	u.CreatedAt = now
+	u.LastLoginAt = now
+	log.WithFields(log.Fields{
+		"username": u.Username,
+		"subject":  u.Subject,
+	}).Info("oidc user created")
+	return nil
+}
+
+// GetOIDCUserBySubject returns the OIDCUser matching the given subject of
+// the OpenID Connect provider. It returns nil when the user does not exist.
+func GetOIDCUserBySubject(db *sqlx.DB, subject string) (*OIDCUser, error) {
+	var u OIDCUser
+	err := db.Get(&u, "select * from oidc_user where subject = $1", subject)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get oidc user for subject %s error: %s", subject, err)
+	}
+	return &u, nil
+}
+
+// UpdateOIDCUserLogin updates the email and name of the given OIDCUser and
+// sets the time of the last login.
+func UpdateOIDCUserLogin(db *sqlx.DB, u *OIDCUser) error {
+	now := time.Now()
+	res, err := db.Exec(`
+		update oidc_user
+		set
+			email = $2,
+			name = $3,
+			last_login_at = $4
+		where username = $1`,
+		u.Username,
+		u.Email,
+		u.Name,
+		now,
+	)
+	if err != nil {
+		return fmt.Errorf("update oidc user %s error: %s", u.Username, err)
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if ra == 0 {
+		return fmt.Errorf("oidc user %s does not exist", u.Username)
+	}
+	u.LastLoginAt = now
+	return nil
+}
@@ -0,0 +1,62 @@
+package storage
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/brocaar/lora-app-server/internal/test"
+)
+
+func TestOIDCUser(t *testing.T) {
+	conf := test.GetConfig()
+
+	Convey("Given a clean database", t, func() {
+		db, err := OpenDatabase(conf.PostgresDSN)
+		So(err, ShouldBeNil)
+		test.MustResetDB(db)
+
+		Convey("When the subject does not exist", func() {
+			Convey("Then GetOIDCUserBySubject returns nil", func() {
+				u, err := GetOIDCUserBySubject(db, "abc123")
+				So(err, ShouldBeNil)
+				So(u, ShouldBeNil)
+			})
+		})
+
+		Convey("When creating an oidc user", func() {
+			u := OIDCUser{
+				Username: "user@example.com",
+				Subject:  "abc123",
+				Email:    "user@example.com",
+				Name:     "User",
+			}
+			So(CreateOIDCUser(db, &u), ShouldBeNil)
+
+			Convey("Then it can be retrieved by its subject", func() {
+				u2, err := GetOIDCUserBySubject(db, "abc123")
+				So(err, ShouldBeNil)
+				So(u2, ShouldNotBeNil)
+				So(u2.Username, ShouldEqual, u.Username)
+				So(u2.Email, ShouldEqual, u.Email)
+			})
+
+			Convey("Then an other user with the same subject can not be created", func() {
+				So(CreateOIDCUser(db, &OIDCUser{
+					Username: "other",
+					Subject:  "abc123",
+				}), ShouldNotBeNil)
+			})
+
+			Convey("Then the login can be updated", func() {
+				u.Name = "User 1"
+				So(UpdateOIDCUserLogin(db, &u), ShouldBeNil)
+
+				u2, err := GetOIDCUserBySubject(db, "abc123")
+				So(err, ShouldBeNil)
+				So(u2.Name, ShouldEqual, "User 1")
+				So(u2.LastLoginAt.After(u2.CreatedAt), ShouldBeTrue)
+			})
+		})
+	})
+}
@@ -0,0 +1,535 @@
+package storage
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/brocaar/lorawan"
+)
+
+// OrganizationRole defines the role of a user within an organization.
+type OrganizationRole string
+
+// Organization roles.
+const (
+	OrganizationRoleAdmin       OrganizationRole = "ADMIN"        // all permissions within the organization
+	OrganizationRoleDeviceAdmin OrganizationRole = "DEVICE_ADMIN" // manage the nodes of the applications
+	OrganizationRoleReadOnly    OrganizationRole = "READ_ONLY"    // read the nodes of the applications
+)
+
+// Validate validates the OrganizationRole.
+func (r OrganizationRole) Validate() error {
+	switch r {
+	case OrganizationRoleAdmin, OrganizationRoleDeviceAdmin, OrganizationRoleReadOnly:
+		return nil
+	default:
+		return fmt.Errorf("invalid role: %s", r)
+	}
+}
+
+// Quota errors.
+var (
+	ErrNodeQuotaExceeded     = errors.New("node quota of organization exceeded")
+	ErrDownlinkQuotaExceeded = errors.New("downlink quota of organization exceeded")
+)
+
+// Organization represents an organization (customer) owning applications.
+type Organization struct {
+	ID        int64     `db:"id"`
+	CreatedAt time.Time `db:"created_at"`
+	UpdatedAt time.Time `db:"updated_at"`
+	Name      string    `db:"name"`
+
+	// MaxNodes defines the maximum number of nodes within the applications
+	// of the organization (0 = unlimited).
+	MaxNodes int `db:"max_nodes"`
+
+	// MaxDownlinksPerDay defines the maximum number of downlink payloads
+	// that can be enqueued per day (UTC) for the nodes of the organization
+	// (0 = unlimited).
+	MaxDownlinksPerDay int `db:"max_downlinks_per_day"`
+}
+
+// OrganizationUser represents a user (the subject of the API token) which
+// is a member of an organization.
+type OrganizationUser struct {
+	OrganizationID int64            `db:"organization_id"`
+	Username       string           `db:"username"`
+	Role           OrganizationRole `db:"role"`
+	CreatedAt      time.Time        `db:"created_at"`
+	UpdatedAt      time.Time        `db:"updated_at"`
+}
+
+// Validate validates the data of the OrganizationUser.
+func (u OrganizationUser) Validate() error {
+	if u.Username == "" {
+		return errors.New("username must be set")
+	}
+	return u.Role.Validate()
+}
+
+// CreateOrganization creates the given Organization.
+func CreateOrganization(db *sqlx.DB, o *Organization) error {
+	now := time.Now()
+	err := db.Get(&o.ID, `
+		insert into organization (
+			created_at,
+			updated_at,
+			name,
+			max_nodes,
+			max_downlinks_per_day
+		) values ($1, $1, $2, $3, $4)
+		returning id`,
+		now,
+		o.Name,
+		o.MaxNodes,
+		o.MaxDownlinksPerDay,
+	)
+	if err != nil {
+		return fmt.Errorf("create organization error: %s", err)
+	}
+	o.CreatedAt = now
+	o.UpdatedAt = now
+	log.WithFields(log.Fields{
+		"id":   o.ID,
+		"name": o.Name,
+	}).Info("organization created")
+	return nil
+}
+
+// GetOrganization returns the Organization for the given id.
+func GetOrganization(db *sqlx.DB, id int64) (Organization, error) {
+	var o Organization
+	err := db.Get(&o, "select * from organization where id = $1", id)
+	if err != nil {
+		return o, fmt.Errorf("get organization %d error: %s", id, err)
+	}
+	return o, nil
+}
+
+// GetOrganizationForAppEUI returns the Organization owning the given
+// application. It returns nil when the application is not owned by an
+// organization.
+func GetOrganizationForAppEUI(db sqlx.Ext, appEUI lorawan.EUI64) (*Organization, error) {
+	var o Organization
+	err := sqlx.Get(db, &o, `
+		select o.*
+		from organization o
+		inner join organization_application oa
+			on oa.organization_id = o.id
+		where oa.app_eui = $1`,
+		appEUI[:],
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get organization for app_eui %s error: %s", appEUI, err)
+	}
+	return &o, nil
+}
+
+// GetOrganizationsCount returns the total number of organizations.
+func GetOrganizationsCount(db *sqlx.DB) (int, error) {
+	var count int
+	err := db.Get(&count, "select count(*) from organization")
+	if err != nil {
+		return 0, fmt.Errorf("get organizations count error: %s", err)
+	}
+	return count, nil
+}
+
+// GetOrganizations returns a slice of organizations, sorted by name.
+func GetOrganizations(db *sqlx.DB, limit, offset int) ([]Organization, error) {
+	var orgs []Organization
+	err := db.Select(&orgs, "select * from organization order by name limit $1 offset $2", limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("get organizations error: %s", err)
+	}
+	return orgs, nil
+}
+
+// UpdateOrganization updates the given Organization.
+func UpdateOrganization(db *sqlx.DB, o Organization) error {
+	res, err := db.Exec(`
+		update organization set
+			updated_at = $2,
+			name = $3,
+			max_nodes = $4,
+			max_downlinks_per_day = $5
+		where id = $1`,
+		o.ID,
+		time.Now(),
+		o.Name,
+		o.MaxNodes,
+		o.MaxDownlinksPerDay,
+	)
+	if err != nil {
+		return fmt.Errorf("update organization %d error: %s", o.ID, err)
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if ra == 0 {
+		return fmt.Errorf("organization %d does not exist", o.ID)
+	}
+	log.WithField("id", o.ID).Info("organization updated")
+	return nil
+}
+
+// DeleteOrganization deletes the Organization matching the given id,
+// including its users. The applications of the organization (and their
+// nodes) are not deleted, they are no longer owned by an organization.
+func DeleteOrganization(db *sqlx.DB, id int64) error {
+	res, err := db.Exec("delete from organization where id = $1", id)
+	if err != nil {
+		return fmt.Errorf("delete organization %d error: %s", id, err)
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if ra == 0 {
+		return fmt.Errorf("organization %d does not exist", id)
+	}
+	log.WithField("id", id).Info("organization deleted")
+	return nil
+}
+
+// AddOrganizationApplication assigns the given application to the given
+// organization. An application can be owned by a single organization.
+func AddOrganizationApplication(db *sqlx.DB, id int64, appEUI lorawan.EUI64) error {
+	_, err := db.Exec(`
+		insert into organization_application (
+			app_eui,
+			organization_id,
+			created_at
+		) values ($1, $2, $3)`,
+		appEUI[:],
+		id,
+		time.Now(),
+	)
+	if err != nil {
+		return fmt.Errorf("add application %s to organization %d error: %s", appEUI, id, err)
+	}
+	log.WithFields(log.Fields{
+		"id":      id,
+		"app_eui": appEUI,
+	}).Info("organization application added")
+	return nil
+}
+
+// DeleteOrganizationApplication removes the given application from the
+// given organization.
+func DeleteOrganizationApplication(db *sqlx.DB, id int64, appEUI lorawan.EUI64) error {
+	res, err := db.Exec("delete from organization_application where organization_id = $1 and app_eui = $2", id, appEUI[:])
+	if err != nil {
+		return fmt.Errorf("remove application %s from organization %d error: %s", appEUI, id, err)
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if ra == 0 {
+		return fmt.Errorf("application %s is not part of organization %d", appEUI, id)
+	}
+	log.WithFields(log.Fields{
+		"id":      id,
+		"app_eui": appEUI,
+	}).Info("organization application removed")
+	return nil
+}
+
+// GetOrganizationApplications returns the AppEUIs of the applications of
+// the given organization.
+func GetOrganizationApplications(db *sqlx.DB, id int64) ([]lorawan.EUI64, error) {
+	var appEUIs []lorawan.EUI64
+	err := db.Select(&appEUIs, "select app_eui from organization_application where organization_id = $1 order by app_eui", id)
+	if err != nil {
+		return nil, fmt.Errorf("get organization %d applications error: %s", id, err)
+	}
+	return appEUIs, nil
+}
+
+// CreateOrganizationUser adds the given user to the organization.
+func CreateOrganizationUser(db *sqlx.DB, u *OrganizationUser) error {
+	if err := u.Validate(); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	_, err := db.Exec(`
+		insert into organization_user (
+			organization_id,
+			username,
+			role,
+			created_at,
+			updated_at
+		) values ($1, $2, $3, $4, $4)`,
+		u.OrganizationID,
+		u.Username,
+		u.Role,
+		now,
+	)
+	if err != nil {
+		return fmt.Errorf("add user %s to organization %d error: %s", u.Username, u.OrganizationID, err)
+	}
+	u.CreatedAt = now
+	u.UpdatedAt = now
+	log.WithFields(log.Fields{
+		"id":       u.OrganizationID,
+		"username": u.Username,
+		"role":     u.Role,
+	}).Info("organization user added")
+	return nil
+}
+
+// GetOrganizationUsers returns the users of the given organization, sorted
+// by username.
+func GetOrganizationUsers(db *sqlx.DB, id int64) ([]OrganizationUser, error) {
+	var users []OrganizationUser
+	err := db.Select(&users, "select * from organization_user where organization_id = $1 order by username", id)
+	if err != nil {
+		return nil, fmt.Errorf("get organization %d users error: %s", id, err)
+	}
+	return users, nil
+}
+
+// UpdateOrganizationUser updates the role of the given user.
+func UpdateOrganizationUser(db *sqlx.DB, u OrganizationUser) error {
+	if err := u.Validate(); err != nil {
+		return err
+	}
+
+	res, err := db.Exec(`
+		update organization_user set
+			updated_at = $3,
+			role = $4
+		where
+			organization_id = $1
+			and username = $2`,
+		u.OrganizationID,
+		u.Username,
+		time.Now(),
+		u.Role,
+	)
+	if err != nil {
+		return fmt.Errorf("update organization %d user %s error: %s", u.OrganizationID, u.Username, err)
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if ra == 0 {
+		return fmt.Errorf("user %s is not part of organization %d", u.Username, u.OrganizationID)
+	}
+	log.WithFields(log.Fields{
+		"id":       u.OrganizationID,
+		"username": u.Username,
+		"role":     u.Role,
+	}).Info("organization user updated")
+	return nil
+}
+
+// DeleteOrganizationUser removes the given user from the given
+// organization.
+func DeleteOrganizationUser(db *sqlx.DB, id int64, username string) error {
+	res, err := db.Exec("delete from organization_user where organization_id = $1 and username = $2", id, username)
+	if err != nil {
+		return fmt.Errorf("remove user %s from organization %d error: %s", username, id, err)
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if ra == 0 {
+		return fmt.Errorf("user %s is not part of organization %d", username, id)
+	}
+	log.WithFields(log.Fields{
+		"id":       id,
+		"username": username,
+	}).Info("organization user removed")
+	return nil
+}
+
+// GetUserOrganizationRoles returns the role of the given user for each
+// organization the user is a member of.
+func GetUserOrganizationRoles(db *sqlx.DB, username string) (map[int64]OrganizationRole, error) {
+	var users []OrganizationUser
+	err := db.Select(&users, "select * from organization_user where username = $1", username)
+	if err != nil {
+		return nil, fmt.Errorf("get user %s organization roles error: %s", username, err)
+	}
+
+	roles := make(map[int64]OrganizationRole)
+	for _, u := range users {
+		roles[u.OrganizationID] = u.Role
+	}
+	return roles, nil
+}
+
+// GetUserApplicationRoles returns the role of the given user for each
+// application owned by an organization the user is a member of.
+func GetUserApplicationRoles(db *sqlx.DB, username string) (map[lorawan.EUI64]OrganizationRole, error) {
+	var apps []struct {
+		AppEUI lorawan.EUI64    `db:"app_eui"`
+		Role   OrganizationRole `db:"role"`
+	}
+	err := db.Select(&apps, `
+		select oa.app_eui, ou.role
+		from organization_user ou
+		inner join organization_application oa
+			on oa.organization_id = ou.organization_id
+		where ou.username = $1`,
+		username,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("get user %s application roles error: %s", username, err)
+	}
+
+	roles := make(map[lorawan.EUI64]OrganizationRole)
+	for _, a := range apps {
+		roles[a.AppEUI] = a.Role
+	}
+	return roles, nil
+}
+
+// GetOrganizationNodesCount returns the number of nodes within the
+// applications of the given organization.
+func GetOrganizationNodesCount(db sqlx.Ext, id int64) (int, error) {
+	var count int
+	err := sqlx.Get(db, &count, `
+		select count(*)
+		from node n
+		inner join organization_application oa
+			on oa.app_eui = n.app_eui
+		where oa.organization_id = $1`,
+		id,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("get organization %d nodes count error: %s", id, err)
+	}
+	return count, nil
+}
+
+// GetOrganizationDownlinkCount returns the number of downlink payloads
+// enqueued for the nodes of the given organization on the day (UTC)
+// containing the given time.
+func GetOrganizationDownlinkCount(db *sqlx.DB, id int64, ts time.Time) (int, error) {
+	var count int
+	err := db.Get(&count, `
+		select coalesce(sum(count), 0)
+		from organization_downlink_count
+		where
+			organization_id = $1
+			and day = $2`,
+		id,
+		quotaDay(ts),
+	)
+	if err != nil {
+		return 0, fmt.Errorf("get organization %d downlink count error: %s", id, err)
+	}
+	return count, nil
+}
+
+// CheckNodeQuota returns ErrNodeQuotaExceeded when adding the given number
+// of nodes to the given application would exceed the node quota of the
+// organization owning the application. The organization is locked until
+// the end of the transaction, the nodes must be created within the same
+// transaction so that concurrent requests can't exceed the quota.
+func CheckNodeQuota(db sqlx.Ext, appEUI lorawan.EUI64, n int) error {
+	var o Organization
+	err := sqlx.Get(db, &o, `
+		select o.*
+		from organization o
+		inner join organization_application oa
+			on oa.organization_id = o.id
+		where oa.app_eui = $1
+		for update of o`,
+		appEUI[:],
+	)
+	if err == sql.ErrNoRows {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("lock organization for app_eui %s error: %s", appEUI, err)
+	}
+	if o.MaxNodes == 0 {
+		return nil
+	}
+
+	count, err := GetOrganizationNodesCount(db, o.ID)
+	if err != nil {
+		return err
+	}
+	if count+n > o.MaxNodes {
+		return ErrNodeQuotaExceeded
+	}
+	return nil
+}
+
+// UseDownlinkQuota adds the given number of downlink payloads to today's
+// downlink count of the organization owning the given application. It
+// returns ErrDownlinkQuotaExceeded (without updating the count) when this
+// would exceed the downlink quota of the organization. Within a
+// transaction, the count is only updated when the transaction is
+// committed.
+func UseDownlinkQuota(db sqlx.Ext, appEUI lorawan.EUI64, n int) error {
+	o, err := GetOrganizationForAppEUI(db, appEUI)
+	if err != nil || o == nil || o.MaxDownlinksPerDay == 0 {
+		return err
+	}
+
+	var count int
+	err = sqlx.Get(db, &count, `
+		insert into organization_downlink_count (
+			organization_id,
+			day,
+			count
+		)
+		select $1, $2, $3
+		where $3 <= $4
+		on conflict (organization_id, day) do update set
+			count = organization_downlink_count.count + excluded.count
+		where
+			organization_downlink_count.count + excluded.count <= $4
+		returning count`,
+		o.ID,
+		quotaDay(time.Now()),
+		n,
+		o.MaxDownlinksPerDay,
+	)
+	if err == sql.ErrNoRows {
+		log.WithFields(log.Fields{
+			"id":      o.ID,
+			"app_eui": appEUI,
+		}).Warning("organization downlink quota exceeded")
+		return ErrDownlinkQuotaExceeded
+	}
+	if err != nil {
+		return fmt.Errorf("use organization %d downlink quota error: %s", o.ID, err)
+	}
+	return nil
+}
+
+// DeleteOrganizationDownlinkCountsBefore deletes the downlink counts of the
+// days before the day containing the given time.
+func DeleteOrganizationDownlinkCountsBefore(db *sqlx.DB, before time.Time) error {
+	_, err := db.Exec("delete from organization_downlink_count where day < $1", quotaDay(before))
+	if err != nil {
+		return fmt.Errorf("delete organization downlink counts error: %s", err)
+	}
+	return nil
+}
+
+// quotaDay returns the day (UTC) containing the given time, as used by the
+// downlink quota.
+func quotaDay(ts time.Time) string {
+	return ts.UTC().Format("2006-01-02")
+}
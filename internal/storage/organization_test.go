@@ -0,0 +1,132 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/brocaar/lora-app-server/internal/test"
+	"github.com/brocaar/lorawan"
+)
+
+func TestOrganization(t *testing.T) {
+	conf := test.GetConfig()
+
+	Convey("Given a clean database", t, func() {
+		db, err := OpenDatabase(conf.PostgresDSN)
+		So(err, ShouldBeNil)
+		test.MustResetDB(db)
+
+		Convey("When creating an organization with an application and user", func() {
+			appEUI := lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}
+			o := Organization{
+				Name:               "customer-1",
+				MaxNodes:           1,
+				MaxDownlinksPerDay: 2,
+			}
+			So(CreateOrganization(db, &o), ShouldBeNil)
+			So(AddOrganizationApplication(db, o.ID, appEUI), ShouldBeNil)
+			So(CreateOrganizationUser(db, &OrganizationUser{
+				OrganizationID: o.ID,
+				Username:       "user",
+				Role:           OrganizationRoleDeviceAdmin,
+			}), ShouldBeNil)
+
+			Convey("Then the application can not be added to an other organization", func() {
+				o2 := Organization{Name: "customer-2"}
+				So(CreateOrganization(db, &o2), ShouldBeNil)
+				So(AddOrganizationApplication(db, o2.ID, appEUI), ShouldNotBeNil)
+			})
+
+			Convey("Then the roles of the user can be retrieved", func() {
+				orgRoles, err := GetUserOrganizationRoles(db, "user")
+				So(err, ShouldBeNil)
+				So(orgRoles, ShouldResemble, map[int64]OrganizationRole{o.ID: OrganizationRoleDeviceAdmin})
+
+				appRoles, err := GetUserApplicationRoles(db, "user")
+				So(err, ShouldBeNil)
+				So(appRoles, ShouldResemble, map[lorawan.EUI64]OrganizationRole{appEUI: OrganizationRoleDeviceAdmin})
+			})
+
+			Convey("Then an invalid role is rejected", func() {
+				So(UpdateOrganizationUser(db, OrganizationUser{
+					OrganizationID: o.ID,
+					Username:       "user",
+					Role:           "OWNER",
+				}), ShouldNotBeNil)
+			})
+
+			Convey("Then the node quota is enforced", func() {
+				So(CheckNodeQuota(db, appEUI, 1), ShouldBeNil)
+				So(CreateNode(db, Node{
+					DevEUI: lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1},
+					AppEUI: appEUI,
+				}), ShouldBeNil)
+				So(CheckNodeQuota(db, appEUI, 1), ShouldEqual, ErrNodeQuotaExceeded)
+
+				Convey("Then other applications are not affected", func() {
+					So(CheckNodeQuota(db, lorawan.EUI64{1}, 1), ShouldBeNil)
+				})
+			})
+
+			Convey("Then the organization is locked by the node quota check until the transaction ends", func() {
+				tx, err := db.Beginx()
+				So(err, ShouldBeNil)
+				So(CheckNodeQuota(tx, appEUI, 1), ShouldBeNil)
+
+				checked := make(chan error)
+				go func() {
+					checked <- CheckNodeQuota(db, appEUI, 1)
+				}()
+
+				So(CreateNode(tx, Node{
+					DevEUI: lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1},
+					AppEUI: appEUI,
+				}), ShouldBeNil)
+				So(tx.Commit(), ShouldBeNil)
+
+				So(<-checked, ShouldEqual, ErrNodeQuotaExceeded)
+			})
+
+			Convey("Then the downlink quota is enforced", func() {
+				So(UseDownlinkQuota(db, appEUI, 3), ShouldEqual, ErrDownlinkQuotaExceeded)
+				So(UseDownlinkQuota(db, appEUI, 1), ShouldBeNil)
+				So(UseDownlinkQuota(db, appEUI, 1), ShouldBeNil)
+				So(UseDownlinkQuota(db, appEUI, 1), ShouldEqual, ErrDownlinkQuotaExceeded)
+
+				count, err := GetOrganizationDownlinkCount(db, o.ID, time.Now())
+				So(err, ShouldBeNil)
+				So(count, ShouldEqual, 2)
+
+				Convey("Then the quota used within a rolled back transaction is released", func() {
+					So(UseDownlinkQuota(db, appEUI, 1), ShouldEqual, ErrDownlinkQuotaExceeded)
+					So(DeleteOrganizationDownlinkCountsBefore(db, time.Now().Add(24*time.Hour)), ShouldBeNil)
+
+					tx, err := db.Beginx()
+					So(err, ShouldBeNil)
+					So(UseDownlinkQuota(tx, appEUI, 2), ShouldBeNil)
+					So(tx.Rollback(), ShouldBeNil)
+
+					count, err := GetOrganizationDownlinkCount(db, o.ID, time.Now())
+					So(err, ShouldBeNil)
+					So(count, ShouldEqual, 0)
+				})
+
+				Convey("Then the counts of the previous days can be deleted", func() {
+					So(DeleteOrganizationDownlinkCountsBefore(db, time.Now().Add(24*time.Hour)), ShouldBeNil)
+					count, err := GetOrganizationDownlinkCount(db, o.ID, time.Now())
+					So(err, ShouldBeNil)
+					So(count, ShouldEqual, 0)
+				})
+			})
+
+			Convey("Then deleting the organization keeps the application unowned", func() {
+				So(DeleteOrganization(db, o.ID), ShouldBeNil)
+				org, err := GetOrganizationForAppEUI(db, appEUI)
+				So(err, ShouldBeNil)
+				So(org, ShouldBeNil)
+			})
+		})
+	})
+}
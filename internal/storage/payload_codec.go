@@ -0,0 +1,105 @@
+package storage
+
+import (
+	"database/sql"
+	"fmt"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/brocaar/lorawan"
+)
+
+// PayloadCodec defines the codec used to decode the uplink and encode the
+// downlink payloads of an application.
+type PayloadCodec struct {
+	AppEUI       lorawan.EUI64 `db:"app_eui"`
+	CodecType    string        `db:"codec_type"`
+	DecodeScript string        `db:"decode_script"` // custom JavaScript codec
+	EncodeScript string        `db:"encode_script"` // custom JavaScript codec
+}
+
+// CreatePayloadCodec creates the given PayloadCodec.
+func CreatePayloadCodec(db *sqlx.DB, c PayloadCodec) error {
+	_, err := db.Exec(`
+		insert into payload_codec (
+			app_eui,
+			codec_type,
+			decode_script,
+			encode_script
+		) values ($1, $2, $3, $4)`,
+		c.AppEUI[:],
+		c.CodecType,
+		c.DecodeScript,
+		c.EncodeScript,
+	)
+	if err != nil {
+		return fmt.Errorf("create payload codec error: %s", err)
+	}
+	log.WithFields(log.Fields{
+		"app_eui":    c.AppEUI,
+		"codec_type": c.CodecType,
+	}).Info("payload codec created")
+	return nil
+}
+
+// GetPayloadCodec returns the PayloadCodec for the given AppEUI. When the
+// application doesn't have a payload codec, nil is returned.
+func GetPayloadCodec(db *sqlx.DB, appEUI lorawan.EUI64) (*PayloadCodec, error) {
+	var c PayloadCodec
+	err := db.Get(&c, "select * from payload_codec where app_eui = $1", appEUI[:])
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get payload codec %s error: %s", appEUI, err)
+	}
+	return &c, nil
+}
+
+// UpdatePayloadCodec updates the given PayloadCodec.
+func UpdatePayloadCodec(db *sqlx.DB, c PayloadCodec) error {
+	res, err := db.Exec(`
+		update payload_codec set
+			codec_type = $2,
+			decode_script = $3,
+			encode_script = $4
+		where app_eui = $1`,
+		c.AppEUI[:],
+		c.CodecType,
+		c.DecodeScript,
+		c.EncodeScript,
+	)
+	if err != nil {
+		return fmt.Errorf("update payload codec error: %s", err)
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if ra == 0 {
+		return fmt.Errorf("payload codec %s does not exist", c.AppEUI)
+	}
+	log.WithFields(log.Fields{
+		"app_eui":    c.AppEUI,
+		"codec_type": c.CodecType,
+	}).Info("payload codec updated")
+	return nil
+}
+
+// DeletePayloadCodec deletes the PayloadCodec of the given AppEUI.
+func DeletePayloadCodec(db *sqlx.DB, appEUI lorawan.EUI64) error {
+	res, err := db.Exec("delete from payload_codec where app_eui = $1", appEUI[:])
+	if err != nil {
+		return fmt.Errorf("delete payload codec error: %s", err)
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if ra == 0 {
+		return fmt.Errorf("payload codec %s does not exist", appEUI)
+	}
+	log.WithField("app_eui", appEUI).Info("payload codec deleted")
+	return nil
+}
@@ -0,0 +1,99 @@
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/garyburd/redigo/redis"
+)
+
+// redisSentinelTimeout defines the connect, read and write timeout of the
+// connections to the Redis Sentinels.
+const redisSentinelTimeout = 2 * time.Second
+
+// redisSentinel discovers the address of the master using Redis Sentinel.
+type redisSentinel struct {
+	sync.Mutex
+	addrs  []string
+	master string
+}
+
+func newRedisSentinel(addrs []string, master string) *redisSentinel {
+	return &redisSentinel{
+		addrs:  append([]string{}, addrs...),
+		master: master,
+	}
+}
+
+// masterAddr returns the address (host:port) of the master, asking the
+// Sentinels in turn. The Sentinel which replied is moved to the front, so
+// that it is asked first the next time.
+func (s *redisSentinel) masterAddr() (string, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	var lastErr error
+	for i, addr := range s.addrs {
+		masterAddr, err := s.getMasterAddr(addr)
+		if err != nil {
+			log.WithField("sentinel", addr).Warningf("storage: get redis master from sentinel error: %s", err)
+			lastErr = err
+			continue
+		}
+
+		s.addrs[0], s.addrs[i] = s.addrs[i], s.addrs[0]
+		return masterAddr, nil
+	}
+
+	return "", fmt.Errorf("no sentinel returned the address of master %s: %s", s.master, lastErr)
+}
+
+// getMasterAddr asks the Sentinel with the given address for the address of
+// the master.
+func (s *redisSentinel) getMasterAddr(addr string) (string, error) {
+	c, err := redis.Dial("tcp", addr,
+		redis.DialConnectTimeout(redisSentinelTimeout),
+		redis.DialReadTimeout(redisSentinelTimeout),
+		redis.DialWriteTimeout(redisSentinelTimeout),
+	)
+	if err != nil {
+		return "", err
+	}
+	defer c.Close()
+
+	res, err := redis.Strings(c.Do("SENTINEL", "get-master-addr-by-name", s.master))
+	if err != nil {
+		if err == redis.ErrNil {
+			return "", fmt.Errorf("unknown master %s", s.master)
+		}
+		return "", err
+	}
+	if len(res) != 2 {
+		return "", fmt.Errorf("invalid reply: %v", res)
+	}
+	return net.JoinHostPort(res[0], res[1]), nil
+}
+
+// checkRedisMaster returns an error when the server of the given connection
+// is not (or no longer) the master.
+func checkRedisMaster(c redis.Conn) error {
+	res, err := redis.Values(c.Do("ROLE"))
+	if err != nil {
+		return fmt.Errorf("get redis role error: %s", err)
+	}
+	if len(res) == 0 {
+		return errors.New("get redis role error: empty reply")
+	}
+	role, err := redis.String(res[0], nil)
+	if err != nil {
+		return fmt.Errorf("get redis role error: %s", err)
+	}
+	if role != "master" {
+		return fmt.Errorf("redis server is not the master (role: %s)", role)
+	}
+	return nil
+}
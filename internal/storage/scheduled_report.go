@@ -0,0 +1,192 @@
+package storage
+
+import (
+	"fmt"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+
+	"github.com/brocaar/lorawan"
+)
+
+// ScheduledReport defines a report which is periodically generated for an
+// application and delivered by email or webhook.
+type ScheduledReport struct {
+	ID         int64          `db:"id"`
+	Name       string         `db:"name"`
+	AppEUI     lorawan.EUI64  `db:"app_eui"`
+	ReportType string         `db:"report_type"`
+	Format     string         `db:"format"`
+	Schedule   string         `db:"schedule"`
+	Weekday    int            `db:"weekday"` // 0 = sunday (weekly schedule)
+	Hour       int            `db:"hour"`
+	Timezone   string         `db:"timezone"`
+	Channel    string         `db:"channel"`
+	Recipients pq.StringArray `db:"recipients"` // email addresses (email channel)
+	WebhookURL string         `db:"webhook_url"`
+	NextRunAt  time.Time      `db:"next_run_at"`
+	LastRunAt  *time.Time     `db:"last_run_at"`
+}
+
+// CreateScheduledReport creates the given ScheduledReport.
+func CreateScheduledReport(db *sqlx.DB, r *ScheduledReport) error {
+	err := db.Get(&r.ID, `
+		insert into scheduled_report (
+			name,
+			app_eui,
+			report_type,
+			format,
+			schedule,
+			weekday,
+			hour,
+			timezone,
+			channel,
+			recipients,
+			webhook_url,
+			next_run_at
+		) values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12) returning id`,
+		r.Name,
+		r.AppEUI[:],
+		r.ReportType,
+		r.Format,
+		r.Schedule,
+		r.Weekday,
+		r.Hour,
+		r.Timezone,
+		r.Channel,
+		r.Recipients,
+		r.WebhookURL,
+		r.NextRunAt,
+	)
+	if err != nil {
+		return fmt.Errorf("create scheduled report error: %s", err)
+	}
+	log.WithFields(log.Fields{
+		"id":      r.ID,
+		"app_eui": r.AppEUI,
+	}).Info("scheduled report created")
+	return nil
+}
+
+// GetScheduledReport returns the ScheduledReport for the given id.
+func GetScheduledReport(db *sqlx.DB, id int64) (ScheduledReport, error) {
+	var r ScheduledReport
+	err := db.Get(&r, "select * from scheduled_report where id = $1", id)
+	if err != nil {
+		return r, fmt.Errorf("get scheduled report %d error: %s", id, err)
+	}
+	return r, nil
+}
+
+// GetScheduledReportsForAppEUI returns the scheduled reports of the given
+// AppEUI.
+func GetScheduledReportsForAppEUI(db *sqlx.DB, appEUI lorawan.EUI64) ([]ScheduledReport, error) {
+	var reports []ScheduledReport
+	err := db.Select(&reports, "select * from scheduled_report where app_eui = $1 order by name, id", appEUI[:])
+	if err != nil {
+		return nil, fmt.Errorf("get scheduled reports error: %s", err)
+	}
+	return reports, nil
+}
+
+// GetDueScheduledReports returns the scheduled reports which are due at
+// the given time.
+func GetDueScheduledReports(db *sqlx.DB, now time.Time) ([]ScheduledReport, error) {
+	var reports []ScheduledReport
+	err := db.Select(&reports, "select * from scheduled_report where next_run_at <= $1 order by next_run_at, id", now)
+	if err != nil {
+		return nil, fmt.Errorf("get due scheduled reports error: %s", err)
+	}
+	return reports, nil
+}
+
+// ClaimScheduledReport sets the next and last run of the given report,
+// given that its next run has not been updated in the meantime (e.g. by an
+// other LoRa App Server instance). It returns false when the report has
+// already been claimed.
+func ClaimScheduledReport(db *sqlx.DB, r ScheduledReport, lastRunAt, nextRunAt time.Time) (bool, error) {
+	res, err := db.Exec(`
+		update scheduled_report set
+			last_run_at = $3,
+			next_run_at = $4
+		where
+			id = $1
+			and next_run_at = $2`,
+		r.ID,
+		r.NextRunAt,
+		lastRunAt,
+		nextRunAt,
+	)
+	if err != nil {
+		return false, fmt.Errorf("claim scheduled report error: %s", err)
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return ra == 1, nil
+}
+
+// UpdateScheduledReport updates the given ScheduledReport.
+func UpdateScheduledReport(db *sqlx.DB, r ScheduledReport) error {
+	res, err := db.Exec(`
+		update scheduled_report set
+			name = $2,
+			app_eui = $3,
+			report_type = $4,
+			format = $5,
+			schedule = $6,
+			weekday = $7,
+			hour = $8,
+			timezone = $9,
+			channel = $10,
+			recipients = $11,
+			webhook_url = $12,
+			next_run_at = $13
+		where id = $1`,
+		r.ID,
+		r.Name,
+		r.AppEUI[:],
+		r.ReportType,
+		r.Format,
+		r.Schedule,
+		r.Weekday,
+		r.Hour,
+		r.Timezone,
+		r.Channel,
+		r.Recipients,
+		r.WebhookURL,
+		r.NextRunAt,
+	)
+	if err != nil {
+		return fmt.Errorf("update scheduled report error: %s", err)
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if ra == 0 {
+		return fmt.Errorf("scheduled report %d does not exist", r.ID)
+	}
+	log.WithField("id", r.ID).Info("scheduled report updated")
+	return nil
+}
+
+// DeleteScheduledReport deletes the ScheduledReport matching the given id.
+func DeleteScheduledReport(db *sqlx.DB, id int64) error {
+	res, err := db.Exec("delete from scheduled_report where id = $1", id)
+	if err != nil {
+		return fmt.Errorf("delete scheduled report error: %s", err)
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if ra == 0 {
+		return fmt.Errorf("scheduled report %d does not exist", id)
+	}
+	log.WithField("id", id).Info("scheduled report deleted")
+	return nil
+}
@@ -0,0 +1,170 @@
+package storage
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/brocaar/lorawan"
+)
+
+// SigningKey represents an application key used for signing the events
+// published by LoRa App Server.
+type SigningKey struct {
+	ID         int64         `db:"id"`
+	KeyID      string        `db:"key_id"`
+	AppEUI     lorawan.EUI64 `db:"app_eui"`
+	Algorithm  string        `db:"algorithm"`
+	PrivateKey []byte        `db:"private_key"`
+	CreatedAt  time.Time     `db:"created_at"`
+	ExpiresAt  *time.Time    `db:"expires_at"`
+}
+
+// CreateSigningKey creates the given SigningKey. The given db can be a
+// database or a transaction.
+func CreateSigningKey(db sqlx.Ext, key *SigningKey) error {
+	if key.CreatedAt.IsZero() {
+		key.CreatedAt = time.Now()
+	}
+
+	err := sqlx.Get(db, &key.ID, `
+		insert into application_signing_key (
+			key_id,
+			app_eui,
+			algorithm,
+			private_key,
+			created_at,
+			expires_at
+		) values ($1, $2, $3, $4, $5, $6) returning id`,
+		key.KeyID,
+		key.AppEUI[:],
+		key.Algorithm,
+		key.PrivateKey,
+		key.CreatedAt,
+		key.ExpiresAt,
+	)
+	if err != nil {
+		return fmt.Errorf("create signing key error: %s", err)
+	}
+	log.WithFields(log.Fields{
+		"app_eui": key.AppEUI,
+		"key_id":  key.KeyID,
+	}).Info("signing key created")
+	return nil
+}
+
+// GetSigningKey returns the SigningKey matching the given key ID.
+func GetSigningKey(db *sqlx.DB, keyID string) (SigningKey, error) {
+	var key SigningKey
+	err := db.Get(&key, "select * from application_signing_key where key_id = $1", keyID)
+	if err != nil {
+		return key, fmt.Errorf("get signing key %s error: %s", keyID, err)
+	}
+	return key, nil
+}
+
+// GetSigningKeysForAppEUI returns the signing keys for the given AppEUI,
+// sorted by creation time (newest first).
+func GetSigningKeysForAppEUI(db *sqlx.DB, appEUI lorawan.EUI64) ([]SigningKey, error) {
+	var keys []SigningKey
+	err := db.Select(&keys, "select * from application_signing_key where app_eui = $1 order by created_at desc, id desc", appEUI[:])
+	if err != nil {
+		return nil, fmt.Errorf("get signing keys error: %s", err)
+	}
+	return keys, nil
+}
+
+// GetValidSigningKeysForAppEUI returns the signing keys for the given AppEUI
+// which have not yet expired, sorted by creation time (newest first).
+func GetValidSigningKeysForAppEUI(db *sqlx.DB, appEUI lorawan.EUI64) ([]SigningKey, error) {
+	var keys []SigningKey
+	err := db.Select(&keys, `
+		select *
+		from application_signing_key
+		where
+			app_eui = $1
+			and (expires_at is null or expires_at > now())
+		order by created_at desc, id desc`,
+		appEUI[:],
+	)
+	if err != nil {
+		return nil, fmt.Errorf("get valid signing keys error: %s", err)
+	}
+	return keys, nil
+}
+
+// GetCurrentSigningKey returns the signing key that must be used for
+// signing the events of the given AppEUI (the newest key which has not
+// expired).
+// When the application doesn't have a valid signing key, nil is returned.
+func GetCurrentSigningKey(db *sqlx.DB, appEUI lorawan.EUI64) (*SigningKey, error) {
+	var key SigningKey
+	err := db.Get(&key, `
+		select *
+		from application_signing_key
+		where
+			app_eui = $1
+			and (expires_at is null or expires_at > now())
+		order by created_at desc, id desc
+		limit 1`,
+		appEUI[:],
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get current signing key error: %s", err)
+	}
+	return &key, nil
+}
+
+// ExpireSigningKeys sets the expiration time of the signing keys of the given
+// AppEUI, except for the key matching exceptKeyID. Keys that expire before
+// the given time are not updated. The given db can be a database or a
+// transaction.
+func ExpireSigningKeys(db sqlx.Ext, appEUI lorawan.EUI64, exceptKeyID string, expiresAt time.Time) error {
+	res, err := db.Exec(`
+		update application_signing_key
+		set expires_at = $1
+		where
+			app_eui = $2
+			and key_id != $3
+			and (expires_at is null or expires_at > $1)`,
+		expiresAt,
+		appEUI[:],
+		exceptKeyID,
+	)
+	if err != nil {
+		return fmt.Errorf("expire signing keys error: %s", err)
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	log.WithFields(log.Fields{
+		"app_eui":    appEUI,
+		"expires_at": expiresAt,
+		"count":      ra,
+	}).Info("signing keys expiration updated")
+	return nil
+}
+
+// DeleteSigningKey deletes the SigningKey matching the given key ID.
+func DeleteSigningKey(db *sqlx.DB, keyID string) error {
+	res, err := db.Exec("delete from application_signing_key where key_id = $1", keyID)
+	if err != nil {
+		return fmt.Errorf("delete signing key %s error: %s", keyID, err)
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if ra == 0 {
+		return fmt.Errorf("signing key %s does not exist", keyID)
+	}
+	log.WithField("key_id", keyID).Info("signing key deleted")
+	return nil
+}
@@ -0,0 +1,96 @@
+package storage
+
+import (
+	"database/sql"
+	"fmt"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/brocaar/lorawan"
+)
+
+// ThingsBoardAccessTokenVariable defines the node variable containing the
+// ThingsBoard access token of the node.
+const ThingsBoardAccessTokenVariable = "ThingsBoardAccessToken"
+
+// ThingsBoardIntegration defines the ThingsBoard integration of an
+// application. The telemetry and attributes are pushed to the given server,
+// using the access token of each node (see ThingsBoardAccessTokenVariable).
+type ThingsBoardIntegration struct {
+	AppEUI lorawan.EUI64 `db:"app_eui"`
+	Server string        `db:"server"`
+}
+
+// CreateThingsBoardIntegration creates the given ThingsBoardIntegration.
+func CreateThingsBoardIntegration(db *sqlx.DB, i ThingsBoardIntegration) error {
+	_, err := db.Exec(`
+		insert into thingsboard_integration (
+			app_eui,
+			server
+		) values ($1, $2)`,
+		i.AppEUI[:],
+		i.Server,
+	)
+	if err != nil {
+		return fmt.Errorf("create thingsboard integration error: %s", err)
+	}
+	log.WithField("app_eui", i.AppEUI).Info("thingsboard integration created")
+	return nil
+}
+
+// GetThingsBoardIntegration returns the ThingsBoardIntegration for the given
+// AppEUI. When the application doesn't have a ThingsBoard integration, nil
+// is returned.
+func GetThingsBoardIntegration(db *sqlx.DB, appEUI lorawan.EUI64) (*ThingsBoardIntegration, error) {
+	var i ThingsBoardIntegration
+	err := db.Get(&i, "select * from thingsboard_integration where app_eui = $1", appEUI[:])
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get thingsboard integration %s error: %s", appEUI, err)
+	}
+	return &i, nil
+}
+
+// UpdateThingsBoardIntegration updates the given ThingsBoardIntegration.
+func UpdateThingsBoardIntegration(db *sqlx.DB, i ThingsBoardIntegration) error {
+	res, err := db.Exec(`
+		update thingsboard_integration set
+			server = $2
+		where app_eui = $1`,
+		i.AppEUI[:],
+		i.Server,
+	)
+	if err != nil {
+		return fmt.Errorf("update thingsboard integration error: %s", err)
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if ra == 0 {
+		return fmt.Errorf("thingsboard integration %s does not exist", i.AppEUI)
+	}
+	log.WithField("app_eui", i.AppEUI).Info("thingsboard integration updated")
+	return nil
+}
+
+// DeleteThingsBoardIntegration deletes the ThingsBoardIntegration of the
+// given AppEUI.
+func DeleteThingsBoardIntegration(db *sqlx.DB, appEUI lorawan.EUI64) error {
+	res, err := db.Exec("delete from thingsboard_integration where app_eui = $1", appEUI[:])
+	if err != nil {
+		return fmt.Errorf("delete thingsboard integration error: %s", err)
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if ra == 0 {
+		return fmt.Errorf("thingsboard integration %s does not exist", appEUI)
+	}
+	log.WithField("app_eui", appEUI).Info("thingsboard integration deleted")
+	return nil
+}
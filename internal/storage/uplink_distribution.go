@@ -0,0 +1,132 @@
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/brocaar/lorawan"
+)
+
+// AggregationInterval defines the interval used for aggregating the
+// uplink and downlink statistics.
+type AggregationInterval string
+
+// Available aggregation intervals.
+const (
+	AggregatePeriod AggregationInterval = ""     // the whole period
+	AggregateHour   AggregationInterval = "hour" // per hour
+	AggregateDay    AggregationInterval = "day"  // per day
+)
+
+// DistributionFilter defines the uplinks to take into account for the
+// uplink distributions. Either AppEUI or GatewayMAC must be set.
+type DistributionFilter struct {
+	AppEUI     *lorawan.EUI64
+	GatewayMAC *lorawan.EUI64
+}
+
+// DataRateCount contains the number of uplinks for a data-rate.
+type DataRateCount struct {
+	Timestamp    time.Time `db:"timestamp"`
+	Modulation   string    `db:"modulation"`
+	SpreadFactor int       `db:"spread_factor"`
+	Bandwidth    int       `db:"bandwidth"`
+	Bitrate      int       `db:"bitrate"`
+	Count        int       `db:"count"`
+}
+
+// ChannelCount contains the number of uplinks for a channel (frequency).
+type ChannelCount struct {
+	Timestamp time.Time `db:"timestamp"`
+	Frequency int       `db:"frequency"`
+	Count     int       `db:"count"`
+}
+
+// GetDataRateDistribution returns the number of uplinks per data-rate
+// matching the given filter, within the given time range (start inclusive,
+// end exclusive) and aggregated by the given interval.
+func GetDataRateDistribution(db *sqlx.DB, filter DistributionFilter, start, end time.Time, interval AggregationInterval) ([]DataRateCount, error) {
+	query, args, err := distributionQuery("u.modulation, u.spread_factor, u.bandwidth, u.bitrate", filter, start, end, interval)
+	if err != nil {
+		return nil, err
+	}
+
+	var counts []DataRateCount
+	if err := db.Select(&counts, query, args...); err != nil {
+		return nil, fmt.Errorf("get data-rate distribution error: %s", err)
+	}
+	return counts, nil
+}
+
+// GetChannelDistribution returns the number of uplinks per channel
+// matching the given filter, within the given time range (start inclusive,
+// end exclusive) and aggregated by the given interval.
+func GetChannelDistribution(db *sqlx.DB, filter DistributionFilter, start, end time.Time, interval AggregationInterval) ([]ChannelCount, error) {
+	query, args, err := distributionQuery("u.frequency", filter, start, end, interval)
+	if err != nil {
+		return nil, err
+	}
+
+	var counts []ChannelCount
+	if err := db.Select(&counts, query, args...); err != nil {
+		return nil, fmt.Errorf("get channel distribution error: %s", err)
+	}
+	return counts, nil
+}
+
+// distributionQuery returns the query (and its arguments) for counting the
+// uplinks grouped by the given columns.
+func distributionQuery(columns string, filter DistributionFilter, start, end time.Time, interval AggregationInterval) (string, []interface{}, error) {
+	// when aggregating over the whole period, the start of the period is
+	// used as timestamp
+	timestamp := "$1::timestamp with time zone"
+	groupBy := columns
+
+	switch interval {
+	case AggregatePeriod:
+	case AggregateHour, AggregateDay:
+		timestamp = fmt.Sprintf("date_trunc('%s', u.received_at)", interval)
+		groupBy = timestamp + ", " + columns
+	default:
+		return "", nil, fmt.Errorf("invalid aggregation interval: %s", interval)
+	}
+
+	args := []interface{}{start, end}
+	var join, where string
+	switch {
+	case filter.AppEUI != nil:
+		where = "u.app_eui = $3"
+		args = append(args, filter.AppEUI[:])
+	case filter.GatewayMAC != nil:
+		join = "inner join node_uplink_rx rx on rx.node_uplink_id = u.id"
+		where = "rx.mac = $3"
+		args = append(args, filter.GatewayMAC[:])
+	default:
+		return "", nil, errors.New("AppEUI or GatewayMAC filter must be set")
+	}
+
+	query := fmt.Sprintf(`
+		select
+			%s as timestamp,
+			%s,
+			count(*) as count
+		from node_uplink u
+		%s
+		where
+			%s
+			and u.received_at >= $1
+			and u.received_at < $2
+		group by %s
+		order by %s`,
+		timestamp,
+		columns,
+		join,
+		where,
+		groupBy,
+		groupBy,
+	)
+	return query, args, nil
+}
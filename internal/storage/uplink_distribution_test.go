@@ -0,0 +1,114 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/brocaar/lora-app-server/internal/test"
+	"github.com/brocaar/lorawan"
+)
+
+func TestUplinkDistribution(t *testing.T) {
+	conf := test.GetConfig()
+
+	Convey("Given a clean database with nodes of two applications", t, func() {
+		db, err := OpenDatabase(conf.PostgresDSN)
+		So(err, ShouldBeNil)
+		test.MustResetDB(db)
+
+		appEUI := lorawan.EUI64{1, 1, 1, 1, 1, 1, 1, 1}
+		otherAppEUI := lorawan.EUI64{2, 2, 2, 2, 2, 2, 2, 2}
+		node := Node{DevEUI: lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}, AppEUI: appEUI}
+		otherNode := Node{DevEUI: lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1}, AppEUI: otherAppEUI}
+		So(CreateNode(db, node), ShouldBeNil)
+		So(CreateNode(db, otherNode), ShouldBeNil)
+
+		gw1 := lorawan.EUI64{1, 1, 1, 1, 1, 1, 1, 1}
+		gw2 := lorawan.EUI64{2, 2, 2, 2, 2, 2, 2, 2}
+
+		Convey("When adding uplinks", func() {
+			hour := time.Now().Truncate(time.Hour)
+			for _, u := range []NodeUplink{
+				{DevEUI: node.DevEUI, AppEUI: appEUI, ReceivedAt: hour.Add(-time.Hour + time.Minute), Frequency: 868100000, Modulation: "LORA", SpreadFactor: 12, Bandwidth: 125, RXInfo: []NodeUplinkRXInfo{{MAC: gw1}}},
+				{DevEUI: node.DevEUI, AppEUI: appEUI, ReceivedAt: hour.Add(time.Minute), Frequency: 868100000, Modulation: "LORA", SpreadFactor: 7, Bandwidth: 125, RXInfo: []NodeUplinkRXInfo{{MAC: gw1}, {MAC: gw2}}},
+				{DevEUI: node.DevEUI, AppEUI: appEUI, ReceivedAt: hour.Add(2 * time.Minute), Frequency: 868300000, Modulation: "LORA", SpreadFactor: 7, Bandwidth: 125, RXInfo: []NodeUplinkRXInfo{{MAC: gw2}}},
+				{DevEUI: node.DevEUI, AppEUI: appEUI, ReceivedAt: hour.Add(time.Hour), Frequency: 868500000, Modulation: "LORA", SpreadFactor: 7, Bandwidth: 125, RXInfo: []NodeUplinkRXInfo{{MAC: gw1}}},
+				{DevEUI: otherNode.DevEUI, AppEUI: otherAppEUI, ReceivedAt: hour.Add(time.Minute), Frequency: 868100000, Modulation: "LORA", SpreadFactor: 9, Bandwidth: 125, RXInfo: []NodeUplinkRXInfo{{MAC: gw1}}},
+			} {
+				So(CreateNodeUplink(db, &u), ShouldBeNil)
+			}
+			start := hour.Add(-time.Hour)
+			end := hour.Add(time.Hour)
+
+			Convey("Then the data-rates of the application are aggregated for the whole period", func() {
+				counts, err := GetDataRateDistribution(db, DistributionFilter{AppEUI: &appEUI}, start, end, AggregatePeriod)
+				So(err, ShouldBeNil)
+				So(counts, ShouldHaveLength, 2)
+				So(counts[0].Timestamp.Equal(start), ShouldBeTrue)
+				So(counts[0].Modulation, ShouldEqual, "LORA")
+				So(counts[0].SpreadFactor, ShouldEqual, 7)
+				So(counts[0].Bandwidth, ShouldEqual, 125)
+				So(counts[0].Count, ShouldEqual, 2)
+				So(counts[1].Timestamp.Equal(start), ShouldBeTrue)
+				So(counts[1].SpreadFactor, ShouldEqual, 12)
+				So(counts[1].Count, ShouldEqual, 1)
+			})
+
+			Convey("Then the data-rates of the application are aggregated per hour", func() {
+				counts, err := GetDataRateDistribution(db, DistributionFilter{AppEUI: &appEUI}, start, end, AggregateHour)
+				So(err, ShouldBeNil)
+				So(counts, ShouldHaveLength, 2)
+				So(counts[0].Timestamp.Equal(start), ShouldBeTrue)
+				So(counts[0].SpreadFactor, ShouldEqual, 12)
+				So(counts[0].Count, ShouldEqual, 1)
+				So(counts[1].Timestamp.Equal(hour), ShouldBeTrue)
+				So(counts[1].SpreadFactor, ShouldEqual, 7)
+				So(counts[1].Count, ShouldEqual, 2)
+			})
+
+			Convey("Then the data-rates of a gateway include the uplinks of all applications", func() {
+				counts, err := GetDataRateDistribution(db, DistributionFilter{GatewayMAC: &gw1}, hour, end, AggregatePeriod)
+				So(err, ShouldBeNil)
+				So(counts, ShouldHaveLength, 2)
+				So(counts[0].SpreadFactor, ShouldEqual, 7)
+				So(counts[0].Count, ShouldEqual, 1)
+				So(counts[1].SpreadFactor, ShouldEqual, 9)
+				So(counts[1].Count, ShouldEqual, 1)
+			})
+
+			Convey("Then the channels of the application are aggregated for the whole period", func() {
+				counts, err := GetChannelDistribution(db, DistributionFilter{AppEUI: &appEUI}, start, end, AggregatePeriod)
+				So(err, ShouldBeNil)
+				So(counts, ShouldHaveLength, 2)
+				So(counts[0].Frequency, ShouldEqual, 868100000)
+				So(counts[0].Count, ShouldEqual, 2)
+				So(counts[1].Frequency, ShouldEqual, 868300000)
+				So(counts[1].Count, ShouldEqual, 1)
+			})
+
+			Convey("Then the channels of a gateway only include the uplinks it received", func() {
+				counts, err := GetChannelDistribution(db, DistributionFilter{GatewayMAC: &gw2}, start, end, AggregateDay)
+				So(err, ShouldBeNil)
+
+				var total int
+				for _, c := range counts {
+					total += c.Count
+					So(c.Frequency, ShouldBeIn, []int{868100000, 868300000})
+				}
+				So(total, ShouldEqual, 2)
+			})
+
+			Convey("Then an invalid aggregation interval returns an error", func() {
+				_, err := GetChannelDistribution(db, DistributionFilter{AppEUI: &appEUI}, start, end, AggregationInterval("week"))
+				So(err, ShouldNotBeNil)
+			})
+
+			Convey("Then a filter without AppEUI or GatewayMAC returns an error", func() {
+				_, err := GetDataRateDistribution(db, DistributionFilter{}, start, end, AggregatePeriod)
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
@@ -0,0 +1,262 @@
+package storage
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/brocaar/lorawan"
+)
+
+// UsageStats contains the aggregated usage statistics of a node within an
+// application. The statistics are stored per hour and per application, so
+// that the usage of a node moved to an other application is accounted to
+// the application it belonged to at the time. The statistics of deleted
+// nodes are kept until the retention of the meta-data expires.
+type UsageStats struct {
+	DevEUI    lorawan.EUI64 `db:"dev_eui"` // not set when aggregated per application
+	AppEUI    lorawan.EUI64 `db:"app_eui"`
+	Timestamp time.Time     `db:"timestamp"` // start of the interval
+	RXPackets int           `db:"rx_packets"`
+	RSSISum   int64         `db:"rssi_sum"`
+	SNRSum    float64       `db:"snr_sum"`
+	TXPackets int           `db:"tx_packets"`
+	Errors    int           `db:"errors"`
+
+	// ActiveNodes contains the number of nodes which sent at least one
+	// uplink (only set by the queries aggregating multiple nodes).
+	ActiveNodes int `db:"active_nodes"`
+}
+
+// AddUsageStats adds the given statistics to the statistics of the hour
+// containing the timestamp of the given UsageStats.
+func AddUsageStats(db *sqlx.DB, s UsageStats) error {
+	_, err := db.Exec(`
+		with upd as (
+			update usage_stats set
+				rx_packets = rx_packets + $4,
+				rssi_sum = rssi_sum + $5,
+				snr_sum = snr_sum + $6,
+				tx_packets = tx_packets + $7,
+				errors = errors + $8
+			where
+				dev_eui = $1
+				and app_eui = $2
+				and timestamp = $3
+			returning 1
+		)
+		insert into usage_stats (
+			dev_eui,
+			app_eui,
+			timestamp,
+			rx_packets,
+			rssi_sum,
+			snr_sum,
+			tx_packets,
+			errors
+		)
+		select $1, $2, $3, $4, $5, $6, $7, $8
+		where not exists (select 1 from upd)`,
+		s.DevEUI[:],
+		s.AppEUI[:],
+		s.Timestamp.Truncate(time.Hour),
+		s.RXPackets,
+		s.RSSISum,
+		s.SNRSum,
+		s.TXPackets,
+		s.Errors,
+	)
+	if err != nil {
+		return fmt.Errorf("add node %s usage stats error: %s", s.DevEUI, err)
+	}
+	return nil
+}
+
+// GetNodeUsageStats returns the usage statistics of the given node within
+// the given application and time range (start inclusive, end exclusive),
+// aggregated by the given interval.
+func GetNodeUsageStats(db *sqlx.DB, appEUI, devEUI lorawan.EUI64, start, end time.Time, interval AggregationInterval) ([]UsageStats, error) {
+	stats, err := getUsageStats(db, appEUI, &devEUI, start, end, interval)
+	if err != nil {
+		return nil, fmt.Errorf("get node %s usage stats error: %s", devEUI, err)
+	}
+	return stats, nil
+}
+
+// GetApplicationUsageStats returns the usage statistics of all the nodes of
+// the given application within the given time range (start inclusive, end
+// exclusive), aggregated by the given interval.
+func GetApplicationUsageStats(db *sqlx.DB, appEUI lorawan.EUI64, start, end time.Time, interval AggregationInterval) ([]UsageStats, error) {
+	stats, err := getUsageStats(db, appEUI, nil, start, end, interval)
+	if err != nil {
+		return nil, fmt.Errorf("get application %s usage stats error: %s", appEUI, err)
+	}
+	return stats, nil
+}
+
+// getUsageStats returns the usage statistics of the given application,
+// optionally limited to the given node, aggregated by the given interval.
+func getUsageStats(db *sqlx.DB, appEUI lorawan.EUI64, devEUI *lorawan.EUI64, start, end time.Time, interval AggregationInterval) ([]UsageStats, error) {
+	timestamp := "$2::timestamp with time zone"
+	groupBy := "1"
+
+	switch interval {
+	case AggregatePeriod:
+	case AggregateHour, AggregateDay:
+		timestamp = fmt.Sprintf("date_trunc('%s', timestamp)", interval)
+		groupBy = timestamp
+	default:
+		return nil, fmt.Errorf("invalid aggregation interval: %s", interval)
+	}
+
+	var devEUIb []byte
+	if devEUI != nil {
+		devEUIb = devEUI[:]
+	}
+
+	var stats []UsageStats
+	err := db.Select(&stats, fmt.Sprintf(`
+		select
+			%s as timestamp,
+			sum(rx_packets) as rx_packets,
+			sum(rssi_sum) as rssi_sum,
+			sum(snr_sum) as snr_sum,
+			sum(tx_packets) as tx_packets,
+			sum(errors) as errors,
+			count(distinct dev_eui) filter (where rx_packets > 0) as active_nodes
+		from usage_stats
+		where
+			app_eui = $1
+			and timestamp >= $2
+			and timestamp < $3
+			and ($4::bytea is null or dev_eui = $4)
+		group by %s
+		order by %s`,
+		timestamp,
+		groupBy,
+		groupBy,
+	),
+		appEUI[:],
+		start,
+		end,
+		devEUIb,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range stats {
+		stats[i].AppEUI = appEUI
+		if devEUI != nil {
+			stats[i].DevEUI = *devEUI
+		}
+	}
+	return stats, nil
+}
+
+// GetUsageStatsPerNode returns the usage statistics of each node of the
+// given application within the given time range (start inclusive, end
+// exclusive). This includes the nodes without statistics (e.g. silent
+// nodes) and the nodes which no longer belong to the application. The
+// result is sorted by the number of uplinks (least first) and DevEUI.
+func GetUsageStatsPerNode(db *sqlx.DB, appEUI lorawan.EUI64, start, end time.Time) ([]UsageStats, error) {
+	var stats []UsageStats
+	err := db.Select(&stats, `
+		select
+			n.dev_eui,
+			$1 as app_eui,
+			$2::timestamp with time zone as timestamp,
+			coalesce(sum(s.rx_packets), 0) as rx_packets,
+			coalesce(sum(s.rssi_sum), 0) as rssi_sum,
+			coalesce(sum(s.snr_sum), 0) as snr_sum,
+			coalesce(sum(s.tx_packets), 0) as tx_packets,
+			coalesce(sum(s.errors), 0) as errors
+		from (
+			select dev_eui from node where app_eui = $1
+			union
+			select dev_eui from usage_stats where app_eui = $1 and timestamp >= $2 and timestamp < $3
+		) n
+		left join usage_stats s
+			on s.dev_eui = n.dev_eui
+			and s.app_eui = $1
+			and s.timestamp >= $2
+			and s.timestamp < $3
+		group by n.dev_eui
+		order by rx_packets, n.dev_eui`,
+		appEUI[:],
+		start,
+		end,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("get application %s usage stats per node error: %s", appEUI, err)
+	}
+	return stats, nil
+}
+
+// GetUsageStatsAppEUIs returns the AppEUIs of the applications having
+// nodes or having usage statistics within the given time range (start
+// inclusive, end exclusive), sorted by AppEUI.
+func GetUsageStatsAppEUIs(db *sqlx.DB, start, end time.Time) ([]lorawan.EUI64, error) {
+	var appEUIs []lorawan.EUI64
+	err := db.Select(&appEUIs, `
+		select app_eui from node
+		union
+		select app_eui from usage_stats where timestamp >= $1 and timestamp < $2
+		order by app_eui`,
+		start,
+		end,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("get usage stats app euis error: %s", err)
+	}
+	return appEUIs, nil
+}
+
+// ClaimUsageStatsPublish claims the publishing of the usage statistics of
+// the given application for the interval starting at the given time. It
+// returns false when the statistics were already claimed (e.g. by an other
+// instance).
+func ClaimUsageStatsPublish(db *sqlx.DB, appEUI lorawan.EUI64, ts time.Time) (bool, error) {
+	var claimed lorawan.EUI64
+	err := db.Get(&claimed, `
+		insert into usage_stats_publish (
+			app_eui,
+			published_at
+		) values ($1, $2)
+		on conflict (app_eui) do update set
+			published_at = excluded.published_at
+		where
+			usage_stats_publish.published_at < excluded.published_at
+		returning app_eui`,
+		appEUI[:],
+		ts,
+	)
+	if err == sql.ErrNoRows {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("claim application %s usage stats publish error: %s", appEUI, err)
+	}
+	return true, nil
+}
+
+// DeleteUsageStatsBefore deletes the usage statistics of the intervals
+// starting before the given time.
+func DeleteUsageStatsBefore(db *sqlx.DB, before time.Time) error {
+	res, err := db.Exec("delete from usage_stats where timestamp < $1", before)
+	if err != nil {
+		return fmt.Errorf("delete usage stats error: %s", err)
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	log.WithFields(log.Fields{
+		"before": before,
+		"count":  ra,
+	}).Info("usage stats deleted")
+	return nil
+}
@@ -0,0 +1,91 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/brocaar/lora-app-server/internal/test"
+	"github.com/brocaar/lorawan"
+)
+
+func TestUsageStats(t *testing.T) {
+	conf := test.GetConfig()
+
+	Convey("Given a clean database with two nodes", t, func() {
+		db, err := OpenDatabase(conf.PostgresDSN)
+		So(err, ShouldBeNil)
+		test.MustResetDB(db)
+
+		appEUI := lorawan.EUI64{1, 1, 1, 1, 1, 1, 1, 1}
+		otherAppEUI := lorawan.EUI64{2, 2, 2, 2, 2, 2, 2, 2}
+		active := Node{Name: "active", DevEUI: lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}, AppEUI: appEUI}
+		silent := Node{Name: "silent", DevEUI: lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1}, AppEUI: appEUI}
+		So(CreateNode(db, active), ShouldBeNil)
+		So(CreateNode(db, silent), ShouldBeNil)
+
+		Convey("When adding statistics", func() {
+			hour := time.Now().Truncate(time.Hour)
+			for _, s := range []UsageStats{
+				{DevEUI: active.DevEUI, AppEUI: appEUI, Timestamp: hour.Add(-time.Hour), RXPackets: 1, RSSISum: -100, SNRSum: 5},
+				{DevEUI: active.DevEUI, AppEUI: appEUI, Timestamp: hour.Add(time.Minute), RXPackets: 1, RSSISum: -80, SNRSum: 7},
+				{DevEUI: active.DevEUI, AppEUI: appEUI, Timestamp: hour.Add(2 * time.Minute), TXPackets: 1},
+				{DevEUI: silent.DevEUI, AppEUI: appEUI, Timestamp: hour.Add(3 * time.Minute), Errors: 1},
+				{DevEUI: active.DevEUI, AppEUI: otherAppEUI, Timestamp: hour, RXPackets: 1},
+			} {
+				So(AddUsageStats(db, s), ShouldBeNil)
+			}
+
+			Convey("Then the node statistics are aggregated per hour", func() {
+				stats, err := GetNodeUsageStats(db, appEUI, active.DevEUI, hour.Add(-time.Hour), hour.Add(time.Hour), AggregateHour)
+				So(err, ShouldBeNil)
+				So(stats, ShouldHaveLength, 2)
+				So(stats[0].Timestamp.Equal(hour.Add(-time.Hour)), ShouldBeTrue)
+				So(stats[0].RXPackets, ShouldEqual, 1)
+				So(stats[1].RXPackets, ShouldEqual, 2)
+				So(stats[1].TXPackets, ShouldEqual, 1)
+			})
+
+			Convey("Then the application statistics are aggregated for the whole period", func() {
+				stats, err := GetApplicationUsageStats(db, appEUI, hour.Add(-time.Hour), hour.Add(time.Hour), AggregatePeriod)
+				So(err, ShouldBeNil)
+				So(stats, ShouldHaveLength, 1)
+				So(stats[0].RXPackets, ShouldEqual, 2)
+				So(stats[0].RSSISum, ShouldEqual, -180)
+				So(stats[0].TXPackets, ShouldEqual, 1)
+				So(stats[0].Errors, ShouldEqual, 1)
+				So(stats[0].ActiveNodes, ShouldEqual, 1)
+			})
+
+			Convey("Then the statistics per node list the silent node first", func() {
+				stats, err := GetUsageStatsPerNode(db, appEUI, hour, hour.Add(time.Hour))
+				So(err, ShouldBeNil)
+				So(stats, ShouldHaveLength, 2)
+				So(stats[0].DevEUI, ShouldEqual, silent.DevEUI)
+				So(stats[0].RXPackets, ShouldEqual, 0)
+				So(stats[0].Errors, ShouldEqual, 1)
+				So(stats[1].DevEUI, ShouldEqual, active.DevEUI)
+				So(stats[1].RXPackets, ShouldEqual, 1)
+			})
+
+			Convey("Then the publishing of an interval can only be claimed once", func() {
+				claimed, err := ClaimUsageStatsPublish(db, appEUI, hour)
+				So(err, ShouldBeNil)
+				So(claimed, ShouldBeTrue)
+
+				claimed, err = ClaimUsageStatsPublish(db, appEUI, hour)
+				So(err, ShouldBeNil)
+				So(claimed, ShouldBeFalse)
+			})
+
+			Convey("Then the statistics can be deleted", func() {
+				So(DeleteUsageStatsBefore(db, hour), ShouldBeNil)
+
+				stats, err := GetNodeUsageStats(db, appEUI, active.DevEUI, hour.Add(-time.Hour), hour.Add(time.Hour), AggregateHour)
+				So(err, ShouldBeNil)
+				So(stats, ShouldHaveLength, 1)
+			})
+		})
+	})
+}
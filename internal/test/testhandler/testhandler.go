@@ -1,26 +1,38 @@
 package testhandler
 
 import (
-	"github.com/brocaar/lora-app-server/internal/handler"
+	"github.com/brocaar/lora-app-server/integration"
 	"github.com/brocaar/lorawan"
 )
 
 // TestHandler implements a Handler for testing.
 type TestHandler struct {
-	SendDataUpChan            chan handler.DataUpPayload
-	SendJoinNotificationChan  chan handler.JoinNotification
-	SendACKNotificationChan   chan handler.ACKNotification
-	SendErrorNotificationChan chan handler.ErrorNotification
-	DataDownPayloadChan       chan handler.DataDownPayload
+	SendDataUpChan                        chan integration.DataUpPayload
+	SendJoinNotificationChan              chan integration.JoinNotification
+	SendACKNotificationChan               chan integration.ACKNotification
+	SendErrorNotificationChan             chan integration.ErrorNotification
+	SendLinkQualityNotificationChan       chan integration.LinkQualityNotification
+	SendLifecycleNotificationChan         chan integration.LifecycleNotification
+	SendFirmwareNotificationChan          chan integration.FirmwareNotification
+	SendDeviceStatusChan                  chan integration.DeviceStatus
+	SendLocationNotificationChan          chan integration.LocationNotification
+	SendScheduledDownlinkNotificationChan chan integration.ScheduledDownlinkNotification
+	DataDownPayloadChan                   chan integration.DataDownPayload
 }
 
 func NewTestHandler() *TestHandler {
 	return &TestHandler{
-		SendDataUpChan:            make(chan handler.DataUpPayload, 100),
-		SendJoinNotificationChan:  make(chan handler.JoinNotification, 100),
-		SendACKNotificationChan:   make(chan handler.ACKNotification, 100),
-		SendErrorNotificationChan: make(chan handler.ErrorNotification, 100),
-		DataDownPayloadChan:       make(chan handler.DataDownPayload, 100),
+		SendDataUpChan:                        make(chan integration.DataUpPayload, 100),
+		SendJoinNotificationChan:              make(chan integration.JoinNotification, 100),
+		SendACKNotificationChan:               make(chan integration.ACKNotification, 100),
+		SendErrorNotificationChan:             make(chan integration.ErrorNotification, 100),
+		SendLinkQualityNotificationChan:       make(chan integration.LinkQualityNotification, 100),
+		SendLifecycleNotificationChan:         make(chan integration.LifecycleNotification, 100),
+		SendFirmwareNotificationChan:          make(chan integration.FirmwareNotification, 100),
+		SendDeviceStatusChan:                  make(chan integration.DeviceStatus, 100),
+		SendLocationNotificationChan:          make(chan integration.LocationNotification, 100),
+		SendScheduledDownlinkNotificationChan: make(chan integration.ScheduledDownlinkNotification, 100),
+		DataDownPayloadChan:                   make(chan integration.DataDownPayload, 100),
 	}
 }
 
@@ -28,26 +40,56 @@ func (t *TestHandler) Close() error {
 	return nil
 }
 
-func (t *TestHandler) SendDataUp(appEUI lorawan.EUI64, devEUI lorawan.EUI64, payload handler.DataUpPayload) error {
+func (t *TestHandler) SendDataUp(appEUI lorawan.EUI64, devEUI lorawan.EUI64, payload integration.DataUpPayload) error {
 	t.SendDataUpChan <- payload
 	return nil
 }
 
-func (t *TestHandler) SendJoinNotification(appEUI lorawan.EUI64, devEUI lorawan.EUI64, payload handler.JoinNotification) error {
+func (t *TestHandler) SendJoinNotification(appEUI lorawan.EUI64, devEUI lorawan.EUI64, payload integration.JoinNotification) error {
 	t.SendJoinNotificationChan <- payload
 	return nil
 }
 
-func (t *TestHandler) SendACKNotification(appEUI lorawan.EUI64, devEUI lorawan.EUI64, payload handler.ACKNotification) error {
+func (t *TestHandler) SendACKNotification(appEUI lorawan.EUI64, devEUI lorawan.EUI64, payload integration.ACKNotification) error {
 	t.SendACKNotificationChan <- payload
 	return nil
 }
 
-func (t *TestHandler) SendErrorNotification(appEUI lorawan.EUI64, devEUI lorawan.EUI64, payload handler.ErrorNotification) error {
+func (t *TestHandler) SendErrorNotification(appEUI lorawan.EUI64, devEUI lorawan.EUI64, payload integration.ErrorNotification) error {
 	t.SendErrorNotificationChan <- payload
 	return nil
 }
 
-func (t *TestHandler) DataDownChan() chan handler.DataDownPayload {
+func (t *TestHandler) SendLinkQualityNotification(appEUI lorawan.EUI64, devEUI lorawan.EUI64, payload integration.LinkQualityNotification) error {
+	t.SendLinkQualityNotificationChan <- payload
+	return nil
+}
+
+func (t *TestHandler) SendLifecycleNotification(appEUI lorawan.EUI64, devEUI lorawan.EUI64, payload integration.LifecycleNotification) error {
+	t.SendLifecycleNotificationChan <- payload
+	return nil
+}
+
+func (t *TestHandler) SendFirmwareNotification(appEUI lorawan.EUI64, devEUI lorawan.EUI64, payload integration.FirmwareNotification) error {
+	t.SendFirmwareNotificationChan <- payload
+	return nil
+}
+
+func (t *TestHandler) SendDeviceStatus(appEUI lorawan.EUI64, devEUI lorawan.EUI64, payload integration.DeviceStatus) error {
+	t.SendDeviceStatusChan <- payload
+	return nil
+}
+
+func (t *TestHandler) SendLocationNotification(appEUI lorawan.EUI64, devEUI lorawan.EUI64, payload integration.LocationNotification) error {
+	t.SendLocationNotificationChan <- payload
+	return nil
+}
+
+func (t *TestHandler) SendScheduledDownlinkNotification(appEUI lorawan.EUI64, devEUI lorawan.EUI64, payload integration.ScheduledDownlinkNotification) error {
+	t.SendScheduledDownlinkNotificationChan <- payload
+	return nil
+}
+
+func (t *TestHandler) DataDownChan() chan integration.DataDownPayload {
 	return t.DataDownPayloadChan
 }
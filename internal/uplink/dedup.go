@@ -0,0 +1,171 @@
+// Package uplink implements the deduplication of the uplink data, when the
+// same uplink is received multiple times (e.g. from multiple network-server
+// instances or by multiple LoRa App Server instances).
+package uplink
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/garyburd/redigo/redis"
+
+	"github.com/brocaar/lora-app-server/integration"
+	"github.com/brocaar/lora-app-server/internal/lockstore"
+	"github.com/brocaar/lorawan"
+)
+
+// The keys of an uplink share the same hash tag, so that these are stored
+// in the same hash slot when using a Redis Cluster.
+const (
+	dedupKeyTempl     = "lora:as:uplink:dedup:{%s:%d}"
+	dedupLockKeyTempl = "lora:as:uplink:dedup:{%s:%d}:lock"
+)
+
+// collectScript appends the rx-info (ARGV[1]) of a receipt to the list
+// (KEYS[1]) of the uplink and takes the lock (KEYS[2]) of the uplink, both
+// expiring after ARGV[2] milliseconds. It returns 1 when the lock was taken,
+// meaning that this is the first receipt.
+var collectScript = lockstore.NewScript(`
+redis.call("RPUSH", KEYS[1], ARGV[1])
+redis.call("PEXPIRE", KEYS[1], ARGV[2])
+if redis.call("SET", KEYS[2], "", "PX", ARGV[2], "NX") then
+	return 1
+end
+return 0
+`)
+
+// getScript returns the list of rx-info (KEYS[1]) of the uplink.
+var getScript = lockstore.NewScript(`
+return redis.call("LRANGE", KEYS[1], 0, -1)
+`)
+
+// DeduplicationWindow defines the duration during which the receipts of the
+// same uplink (DevEUI and FCnt) are collected. 0 disables the
+// deduplication.
+var DeduplicationWindow time.Duration
+
+// Deduplicate collects the RXInfo of all the receipts of the uplink with the
+// given DevEUI and FCnt, received within the DeduplicationWindow by any
+// instance. For the first receipt, it waits for the window and calls the
+// given function with the merged RXInfo, its error is returned. The function
+// is not called for the other receipts, these must not be handled. When the
+// deduplication is disabled or when the receipt can't be collected (e.g.
+// the lock store is unavailable), the function is called directly with the
+// given RXInfo.
+//
+// The first receipt is handled synchronously, so that the handling errors
+// are returned to the caller and so that a graceful shutdown of the api
+// waits for the pending uplinks.
+func Deduplicate(locks lockstore.Store, devEUI lorawan.EUI64, fCnt uint32, rxInfo []integration.RXInfo, handle func([]integration.RXInfo) error) error {
+	if DeduplicationWindow == 0 || locks == nil {
+		return handle(rxInfo)
+	}
+
+	first, err := collectRXInfo(locks, devEUI, fCnt, rxInfo)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"dev_eui": devEUI,
+			"f_cnt":   fCnt,
+		}).Errorf("uplink: deduplicate uplink error: %s", err)
+		return handle(rxInfo)
+	}
+	if !first {
+		// an other receipt is collecting the rx-info
+		log.WithFields(log.Fields{
+			"dev_eui": devEUI,
+			"f_cnt":   fCnt,
+		}).Info("uplink: duplicate uplink received")
+		return nil
+	}
+
+	time.Sleep(DeduplicationWindow)
+
+	merged, err := getMergedRXInfo(locks, devEUI, fCnt)
+	if err != nil {
+		// handle the uplink with the rx-info of this receipt
+		log.WithFields(log.Fields{
+			"dev_eui": devEUI,
+			"f_cnt":   fCnt,
+		}).Errorf("uplink: get merged rx-info error: %s", err)
+		merged = rxInfo
+	}
+	return handle(merged)
+}
+
+// collectRXInfo stores the given RXInfo of a receipt of the uplink with the
+// given DevEUI and FCnt. It returns true for the first receipt of the uplink.
+func collectRXInfo(locks lockstore.Store, devEUI lorawan.EUI64, fCnt uint32, rxInfo []integration.RXInfo) (bool, error) {
+	b, err := json.Marshal(rxInfo)
+	if err != nil {
+		return false, fmt.Errorf("marshal rx-info error: %s", err)
+	}
+
+	ttl := int64(2 * DeduplicationWindow / time.Millisecond)
+	first, err := redis.Bool(locks.Eval(collectScript, []string{
+		fmt.Sprintf(dedupKeyTempl, devEUI, fCnt),
+		fmt.Sprintf(dedupLockKeyTempl, devEUI, fCnt),
+	}, b, ttl))
+	if err != nil {
+		return false, fmt.Errorf("store rx-info error: %s", err)
+	}
+	return first, nil
+}
+
+// getMergedRXInfo returns the merged RXInfo of the receipts of the uplink
+// with the given DevEUI and FCnt.
+func getMergedRXInfo(locks lockstore.Store, devEUI lorawan.EUI64, fCnt uint32) ([]integration.RXInfo, error) {
+	values, err := redis.ByteSlices(locks.Eval(getScript, []string{fmt.Sprintf(dedupKeyTempl, devEUI, fCnt)}))
+	if err != nil {
+		return nil, fmt.Errorf("get rx-info error: %s", err)
+	}
+
+	var receipts [][]integration.RXInfo
+	for _, v := range values {
+		var r []integration.RXInfo
+		if err := json.Unmarshal(v, &r); err != nil {
+			return nil, fmt.Errorf("unmarshal rx-info error: %s", err)
+		}
+		receipts = append(receipts, r)
+	}
+
+	merged := MergeRXInfo(receipts...)
+	log.WithFields(log.Fields{
+		"dev_eui":  devEUI,
+		"f_cnt":    fCnt,
+		"receipts": len(receipts),
+		"gateways": len(merged),
+	}).Info("uplink: uplink deduplicated")
+
+	return merged, nil
+}
+
+// MergeRXInfo merges the RXInfo of the given receipts. Per gateway (MAC),
+// the RXInfo with the highest RSSI is kept. The result is sorted by RSSI,
+// strongest first.
+func MergeRXInfo(receipts ...[]integration.RXInfo) []integration.RXInfo {
+	var merged []integration.RXInfo
+	index := make(map[lorawan.EUI64]int)
+
+	for _, rxInfo := range receipts {
+		for _, rx := range rxInfo {
+			i, ok := index[rx.MAC]
+			if !ok {
+				index[rx.MAC] = len(merged)
+				merged = append(merged, rx)
+				continue
+			}
+			if rx.RSSI > merged[i].RSSI {
+				merged[i] = rx
+			}
+		}
+	}
+
+	sort.SliceStable(merged, func(i, j int) bool {
+		return merged[i].RSSI > merged[j].RSSI
+	})
+
+	return merged
+}
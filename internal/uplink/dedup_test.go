@@ -0,0 +1,139 @@
+package uplink
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/brocaar/lora-app-server/integration"
+	"github.com/brocaar/lora-app-server/internal/lockstore"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lora-app-server/internal/test"
+	"github.com/brocaar/lorawan"
+)
+
+func TestMergeRXInfo(t *testing.T) {
+	Convey("Given the rx-info of two receipts", t, func() {
+		gw1 := lorawan.EUI64{1, 1, 1, 1, 1, 1, 1, 1}
+		gw2 := lorawan.EUI64{2, 2, 2, 2, 2, 2, 2, 2}
+		gw3 := lorawan.EUI64{3, 3, 3, 3, 3, 3, 3, 3}
+
+		r1 := []integration.RXInfo{
+			{MAC: gw1, RSSI: -100, LoRaSNR: 2},
+			{MAC: gw2, RSSI: -80, LoRaSNR: 7},
+		}
+		r2 := []integration.RXInfo{
+			{MAC: gw1, RSSI: -90, LoRaSNR: 5},
+			{MAC: gw3, RSSI: -120, LoRaSNR: -5},
+		}
+
+		Convey("Then MergeRXInfo keeps the strongest rx-info per gateway, sorted by RSSI", func() {
+			So(MergeRXInfo(r1, r2), ShouldResemble, []integration.RXInfo{
+				{MAC: gw2, RSSI: -80, LoRaSNR: 7},
+				{MAC: gw1, RSSI: -90, LoRaSNR: 5},
+				{MAC: gw3, RSSI: -120, LoRaSNR: -5},
+			})
+		})
+	})
+}
+
+func TestDeduplicate(t *testing.T) {
+	conf := test.GetConfig()
+
+	Convey("Given a clean Redis database", t, func() {
+		p := storage.NewRedisPool(conf.RedisURL)
+		test.MustFlushRedis(p)
+		locks := lockstore.NewRedisStore(p)
+
+		devEUI := lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}
+		rxInfo := []integration.RXInfo{{MAC: lorawan.EUI64{1, 1, 1, 1, 1, 1, 1, 1}, RSSI: -100}}
+
+		Convey("When the deduplication is disabled", func() {
+			DeduplicationWindow = 0
+
+			Convey("Then the rx-info is handled directly as-is", func() {
+				var handled []integration.RXInfo
+				err := Deduplicate(locks, devEUI, 10, rxInfo, func(merged []integration.RXInfo) error {
+					handled = merged
+					return nil
+				})
+				So(err, ShouldBeNil)
+				So(handled, ShouldResemble, rxInfo)
+			})
+		})
+
+		Convey("Given a deduplication window of 100ms", func() {
+			DeduplicationWindow = 100 * time.Millisecond
+			defer func() { DeduplicationWindow = 0 }()
+
+			Convey("When the same uplink is received by three gateways", func() {
+				handled := make(chan []integration.RXInfo, 3)
+				durations := make(chan time.Duration, 3)
+
+				var wg sync.WaitGroup
+				for i := 1; i <= 3; i++ {
+					wg.Add(1)
+					go func(i int) {
+						defer wg.Done()
+						start := time.Now()
+						err := Deduplicate(locks, devEUI, 10, []integration.RXInfo{
+							{MAC: lorawan.EUI64{byte(i), byte(i), byte(i), byte(i), byte(i), byte(i), byte(i), byte(i)}, RSSI: -100 + i},
+						}, func(merged []integration.RXInfo) error {
+							handled <- merged
+							return nil
+						})
+						if err != nil {
+							t.Error(err)
+						}
+						durations <- time.Since(start)
+					}(i)
+				}
+				wg.Wait()
+				close(durations)
+
+				Convey("Then only the first receipt is blocked by the window", func() {
+					var blocked int
+					for d := range durations {
+						if d >= DeduplicationWindow {
+							blocked++
+						}
+					}
+					So(blocked, ShouldEqual, 1)
+				})
+
+				Convey("Then only one receipt is handled, with the merged rx-info", func() {
+					So(handled, ShouldHaveLength, 1)
+					merged := <-handled
+					So(merged, ShouldHaveLength, 3)
+					So(merged[0].RSSI, ShouldEqual, -97)
+				})
+			})
+
+			Convey("When handling the first receipt fails", func() {
+				err := Deduplicate(locks, devEUI, 12, rxInfo, func(merged []integration.RXInfo) error {
+					return errors.New("handle error")
+				})
+
+				Convey("Then the error is returned", func() {
+					So(err, ShouldResemble, errors.New("handle error"))
+				})
+			})
+
+			Convey("Then an uplink with an other FCnt is handled separately", func() {
+				handled := make(chan []integration.RXInfo, 2)
+				handle := func(merged []integration.RXInfo) error {
+					handled <- merged
+					return nil
+				}
+
+				So(Deduplicate(locks, devEUI, 10, rxInfo, handle), ShouldBeNil)
+				So(Deduplicate(locks, devEUI, 11, rxInfo, handle), ShouldBeNil)
+				So(<-handled, ShouldResemble, rxInfo)
+				So(<-handled, ShouldResemble, rxInfo)
+			})
+		})
+	})
+}
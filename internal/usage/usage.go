@@ -0,0 +1,142 @@
+// Package usage implements the gathering and publishing of the usage
+// statistics of the nodes and applications (e.g. for billing the tenants or
+// spotting silent nodes). The statistics are based on the handled uplinks,
+// the transmitted downlinks and the errors, and are stored per hour.
+package usage
+
+import (
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/brocaar/lora-app-server/integration"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+// publishDelay defines the delay after the end of an interval before its
+// statistics are published, so that the uplinks received at the end of
+// the interval have been processed.
+const publishDelay = time.Minute
+
+// StatsPublisher defines the interface for publishing usage statistics.
+type StatsPublisher interface {
+	SendUsageStats(appEUI lorawan.EUI64, payload integration.UsageStats) error
+}
+
+// RecordUplink adds the given (deduplicated) uplink, received at the given
+// time, to the statistics of the node. The RSSI and SNR of the gateway
+// with the best RSSI are used.
+func RecordUplink(db *sqlx.DB, appEUI, devEUI lorawan.EUI64, rxInfo []integration.RXInfo, ts time.Time) error {
+	s := storage.UsageStats{
+		DevEUI:    devEUI,
+		AppEUI:    appEUI,
+		Timestamp: ts,
+		RXPackets: 1,
+	}
+	for i, rx := range rxInfo {
+		if i == 0 || int64(rx.RSSI) > s.RSSISum {
+			s.RSSISum = int64(rx.RSSI)
+			s.SNRSum = rx.LoRaSNR
+		}
+	}
+	return storage.AddUsageStats(db, s)
+}
+
+// RecordDownlink adds a downlink, transmitted at the given time, to the
+// statistics of the node.
+func RecordDownlink(db *sqlx.DB, appEUI, devEUI lorawan.EUI64, ts time.Time) error {
+	return storage.AddUsageStats(db, storage.UsageStats{
+		DevEUI:    devEUI,
+		AppEUI:    appEUI,
+		Timestamp: ts,
+		TXPackets: 1,
+	})
+}
+
+// RecordError adds an error, reported at the given time, to the statistics
+// of the node.
+func RecordError(db *sqlx.DB, appEUI, devEUI lorawan.EUI64, ts time.Time) error {
+	return storage.AddUsageStats(db, storage.UsageStats{
+		DevEUI:    devEUI,
+		AppEUI:    appEUI,
+		Timestamp: ts,
+		Errors:    1,
+	})
+}
+
+// RunStatsPublisher publishes the statistics of each application after the
+// end of each hour, until the program exits.
+func RunStatsPublisher(db *sqlx.DB, p StatsPublisher) {
+	for {
+		next := time.Now().Truncate(time.Hour).Add(time.Hour)
+		time.Sleep(next.Add(publishDelay).Sub(time.Now()))
+
+		if err := PublishStats(db, p, next.Add(-time.Hour)); err != nil {
+			log.Errorf("usage: publish stats error: %s", err)
+		}
+	}
+}
+
+// PublishStats publishes the statistics of each application for the hour
+// starting at the given time. The statistics of each application are
+// claimed first, so that they are published only once when running
+// multiple instances.
+func PublishStats(db *sqlx.DB, p StatsPublisher, start time.Time) error {
+	appEUIs, err := storage.GetUsageStatsAppEUIs(db, start, start.Add(time.Hour))
+	if err != nil {
+		return err
+	}
+
+	for _, appEUI := range appEUIs {
+		if err := publishApplicationStats(db, p, appEUI, start); err != nil {
+			log.WithField("app_eui", appEUI).Errorf("usage: publish stats error: %s", err)
+		}
+	}
+	return nil
+}
+
+// publishApplicationStats publishes the statistics of the given application
+// for the hour starting at the given time, when not yet claimed.
+func publishApplicationStats(db *sqlx.DB, p StatsPublisher, appEUI lorawan.EUI64, start time.Time) error {
+	claimed, err := storage.ClaimUsageStatsPublish(db, appEUI, start)
+	if err != nil || !claimed {
+		return err
+	}
+
+	stats, err := storage.GetApplicationUsageStats(db, appEUI, start, start.Add(time.Hour), storage.AggregatePeriod)
+	if err != nil {
+		return err
+	}
+
+	// publish empty statistics when the application didn't have any
+	// traffic during the interval
+	s := storage.UsageStats{
+		AppEUI:    appEUI,
+		Timestamp: start,
+	}
+	if len(stats) > 0 {
+		s = stats[0]
+	}
+
+	return p.SendUsageStats(appEUI, NewStatsPayload(s))
+}
+
+// NewStatsPayload returns the published representation of the given
+// statistics.
+func NewStatsPayload(s storage.UsageStats) integration.UsageStats {
+	pl := integration.UsageStats{
+		AppEUI:      s.AppEUI,
+		Time:        s.Timestamp,
+		RXPackets:   s.RXPackets,
+		TXPackets:   s.TXPackets,
+		Errors:      s.Errors,
+		ActiveNodes: s.ActiveNodes,
+	}
+	if s.RXPackets > 0 {
+		pl.AvgRSSI = float64(s.RSSISum) / float64(s.RXPackets)
+		pl.AvgLoRaSNR = s.SNRSum / float64(s.RXPackets)
+	}
+	return pl
+}
@@ -0,0 +1,48 @@
+package usage
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/brocaar/lora-app-server/integration"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+func TestNewStatsPayload(t *testing.T) {
+	Convey("Given usage statistics", t, func() {
+		ts := time.Date(2017, 1, 1, 12, 0, 0, 0, time.UTC)
+		s := storage.UsageStats{
+			AppEUI:      lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+			Timestamp:   ts,
+			RXPackets:   4,
+			RSSISum:     -400,
+			SNRSum:      10,
+			TXPackets:   2,
+			Errors:      1,
+			ActiveNodes: 3,
+		}
+
+		Convey("Then the payload contains the averages", func() {
+			So(NewStatsPayload(s), ShouldResemble, integration.UsageStats{
+				AppEUI:      s.AppEUI,
+				Time:        ts,
+				RXPackets:   4,
+				AvgRSSI:     -100,
+				AvgLoRaSNR:  2.5,
+				TXPackets:   2,
+				Errors:      1,
+				ActiveNodes: 3,
+			})
+		})
+
+		Convey("Then the averages are zero without uplinks", func() {
+			s.RXPackets = 0
+			pl := NewStatsPayload(s)
+			So(pl.AvgRSSI, ShouldEqual, 0)
+			So(pl.AvgLoRaSNR, ShouldEqual, 0)
+		})
+	})
+}
@@ -0,0 +1,16 @@
+-- +migrate Up
+create table application_signing_key (
+	id bigserial primary key,
+	key_id varchar(32) not null unique,
+	app_eui bytea not null,
+	algorithm varchar(10) not null,
+	private_key bytea not null,
+	created_at timestamp with time zone not null
+);
+
+create index application_signing_key_app_eui on application_signing_key(app_eui);
+
+-- +migrate Down
+drop index application_signing_key_app_eui;
+
+drop table application_signing_key;
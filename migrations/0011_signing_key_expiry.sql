@@ -0,0 +1,7 @@
+-- +migrate Up
+alter table application_signing_key
+	add column expires_at timestamp with time zone;
+
+-- +migrate Down
+alter table application_signing_key
+	drop column expires_at;
@@ -0,0 +1,15 @@
+-- +migrate Up
+create table downlink_fport_policy (
+	id bigserial primary key,
+	app_eui bytea not null,
+	dev_eui bytea references node on delete cascade,
+	fport smallint not null,
+	principals varchar(100)[] not null
+);
+
+create index downlink_fport_policy_app_eui_fport on downlink_fport_policy(app_eui, fport);
+
+-- +migrate Down
+drop index downlink_fport_policy_app_eui_fport;
+
+drop table downlink_fport_policy;
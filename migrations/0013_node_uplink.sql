@@ -0,0 +1,34 @@
+-- +migrate Up
+alter table node
+	add column uplink_interval integer not null default 0;
+
+create table node_uplink (
+	id bigserial primary key,
+	dev_eui bytea references node on delete cascade not null,
+	app_eui bytea not null,
+	received_at timestamp with time zone not null,
+	f_cnt bigint not null,
+	f_port smallint not null,
+	frequency integer not null,
+	modulation varchar(4) not null,
+	spread_factor smallint not null,
+	bandwidth integer not null,
+	bitrate integer not null,
+	rssi smallint not null,
+	lora_snr decimal(5,2) not null,
+	gateway_count smallint not null
+);
+
+create index node_uplink_dev_eui_received_at on node_uplink(dev_eui, received_at);
+create index node_uplink_app_eui_received_at on node_uplink(app_eui, received_at);
+create index node_uplink_received_at on node_uplink(received_at);
+
+-- +migrate Down
+drop index node_uplink_received_at;
+drop index node_uplink_app_eui_received_at;
+drop index node_uplink_dev_eui_received_at;
+
+drop table node_uplink;
+
+alter table node
+	drop column uplink_interval;
@@ -0,0 +1,11 @@
+-- +migrate Up
+alter table node
+	add column link_score smallint;
+
+create index node_link_score on node(link_score);
+
+-- +migrate Down
+drop index node_link_score;
+
+alter table node
+	drop column link_score;
@@ -0,0 +1,16 @@
+-- +migrate Up
+create table node_uplink_rx (
+	node_uplink_id bigint references node_uplink on delete cascade not null,
+	mac bytea not null,
+	rssi smallint not null,
+	lora_snr decimal(5,2) not null
+);
+
+create index node_uplink_rx_node_uplink_id on node_uplink_rx(node_uplink_id);
+create index node_uplink_rx_mac on node_uplink_rx(mac);
+
+-- +migrate Down
+drop index node_uplink_rx_mac;
+drop index node_uplink_rx_node_uplink_id;
+
+drop table node_uplink_rx;
@@ -0,0 +1,21 @@
+-- +migrate Up
+create table gateway_downlink (
+	id bigserial primary key,
+	mac bytea not null,
+	dev_eui bytea not null,
+	created_at timestamp with time zone not null,
+	frequency integer not null,
+	sub_band varchar(10) not null,
+	spread_factor smallint not null,
+	bandwidth integer not null,
+	airtime integer not null
+);
+
+create index gateway_downlink_mac_created_at on gateway_downlink(mac, created_at);
+create index gateway_downlink_created_at on gateway_downlink(created_at);
+
+-- +migrate Down
+drop index gateway_downlink_created_at;
+drop index gateway_downlink_mac_created_at;
+
+drop table gateway_downlink;
@@ -0,0 +1,17 @@
+-- +migrate Up
+create table notification_preference (
+	username varchar(100) primary key,
+	alert_types varchar(50)[],
+	channels varchar(20)[],
+	applications varchar(16)[],
+	gateways varchar(16)[],
+	email varchar(255) not null,
+	webhook_url text not null,
+	slack_webhook_url text not null,
+	quiet_hours_start smallint,
+	quiet_hours_end smallint,
+	timezone varchar(50) not null
+);
+
+-- +migrate Down
+drop table notification_preference;
@@ -0,0 +1,26 @@
+-- +migrate Up
+create table scheduled_report (
+	id bigserial primary key,
+	name varchar(100) not null,
+	app_eui bytea not null,
+	report_type varchar(20) not null,
+	format varchar(10) not null,
+	schedule varchar(10) not null,
+	weekday smallint not null,
+	hour smallint not null,
+	timezone varchar(50) not null,
+	channel varchar(10) not null,
+	recipients varchar(255)[],
+	webhook_url text not null,
+	next_run_at timestamp with time zone not null,
+	last_run_at timestamp with time zone
+);
+
+create index scheduled_report_app_eui on scheduled_report(app_eui);
+create index scheduled_report_next_run_at on scheduled_report(next_run_at);
+
+-- +migrate Down
+drop index scheduled_report_next_run_at;
+drop index scheduled_report_app_eui;
+
+drop table scheduled_report;
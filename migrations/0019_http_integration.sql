@@ -0,0 +1,12 @@
+-- +migrate Up
+create table http_integration (
+	app_eui bytea primary key,
+	headers json not null,
+	data_up_url text not null,
+	join_notification_url text not null,
+	ack_notification_url text not null,
+	error_notification_url text not null
+);
+
+-- +migrate Down
+drop table http_integration;
@@ -0,0 +1,10 @@
+-- +migrate Up
+create table payload_codec (
+	app_eui bytea primary key,
+	codec_type varchar(20) not null,
+	decode_script text not null,
+	encode_script text not null
+);
+
+-- +migrate Down
+drop table payload_codec;
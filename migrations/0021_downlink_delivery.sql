@@ -0,0 +1,24 @@
+-- +migrate Up
+create table downlink_delivery (
+	id bigint primary key,
+	created_at timestamp with time zone not null,
+	updated_at timestamp with time zone not null,
+	dev_eui bytea references node on delete cascade not null,
+	reference varchar(100) not null,
+	confirmed boolean not null,
+	status varchar(20) not null,
+	f_cnt bigint,
+	sent_at timestamp with time zone
+);
+
+create index idx_downlink_delivery_dev_eui_reference on downlink_delivery(dev_eui, reference);
+create index idx_downlink_delivery_status_sent_at on downlink_delivery(status, sent_at);
+
+insert into downlink_delivery (id, created_at, updated_at, dev_eui, reference, confirmed, status)
+	select id, now(), now(), dev_eui, reference, confirmed, case when pending then 'PENDING' else 'QUEUED' end
+	from downlink_queue;
+
+-- +migrate Down
+drop index idx_downlink_delivery_status_sent_at;
+drop index idx_downlink_delivery_dev_eui_reference;
+drop table downlink_delivery;
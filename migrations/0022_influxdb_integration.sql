@@ -0,0 +1,12 @@
+-- +migrate Up
+create table influxdb_integration (
+	app_eui bytea primary key,
+	endpoint text not null,
+	organization text not null,
+	bucket text not null,
+	token text not null,
+	measurement text not null
+);
+
+-- +migrate Down
+drop table influxdb_integration;
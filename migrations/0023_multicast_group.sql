@@ -0,0 +1,28 @@
+-- +migrate Up
+create table multicast_group (
+	id bigserial primary key,
+	name varchar(100) not null,
+	app_eui bytea not null,
+	mc_addr bytea not null,
+	mc_nwk_s_key bytea not null,
+	mc_app_s_key bytea not null,
+	dr smallint not null,
+	frequency integer not null
+);
+
+create index multicast_group_app_eui on multicast_group(app_eui);
+
+create table multicast_group_node (
+	multicast_group_id bigint references multicast_group on delete cascade not null,
+	dev_eui bytea references node on delete cascade not null,
+	primary key (multicast_group_id, dev_eui)
+);
+
+create index multicast_group_node_dev_eui on multicast_group_node(dev_eui);
+
+-- +migrate Down
+drop index multicast_group_node_dev_eui;
+drop table multicast_group_node;
+
+drop index multicast_group_app_eui;
+drop table multicast_group;
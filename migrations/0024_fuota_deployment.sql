@@ -0,0 +1,42 @@
+-- +migrate Up
+create table fuota_deployment (
+	id bigserial primary key,
+	created_at timestamp with time zone not null,
+	updated_at timestamp with time zone not null,
+	name varchar(100) not null,
+	app_eui bytea not null,
+	multicast_group_id bigint references multicast_group on delete cascade not null,
+	fport smallint not null,
+	firmware bytea not null,
+	descriptor bigint not null,
+	frag_size smallint not null,
+	redundancy integer not null,
+	state varchar(20) not null,
+	start_at timestamp with time zone not null,
+	timeout integer not null,
+	deadline timestamp with time zone
+);
+
+create index fuota_deployment_app_eui on fuota_deployment(app_eui);
+create index fuota_deployment_state on fuota_deployment(state);
+
+create table fuota_deployment_node (
+	fuota_deployment_id bigint references fuota_deployment on delete cascade not null,
+	dev_eui bytea references node on delete cascade not null,
+	updated_at timestamp with time zone not null,
+	state varchar(20) not null,
+	nb_frag_received integer not null default 0,
+	missing_frag integer not null default 0,
+	error text not null default '',
+	primary key (fuota_deployment_id, dev_eui)
+);
+
+create index fuota_deployment_node_dev_eui on fuota_deployment_node(dev_eui);
+
+-- +migrate Down
+drop index fuota_deployment_node_dev_eui;
+drop table fuota_deployment_node;
+
+drop index fuota_deployment_state;
+drop index fuota_deployment_app_eui;
+drop table fuota_deployment;
@@ -0,0 +1,32 @@
+-- +migrate Up
+create table gateway (
+	mac bytea primary key,
+	created_at timestamp with time zone not null,
+	updated_at timestamp with time zone not null,
+	name varchar(100) not null,
+	description text not null,
+	latitude double precision not null,
+	longitude double precision not null,
+	altitude double precision not null,
+	last_seen_at timestamp with time zone,
+	stats_published_at timestamp with time zone
+);
+
+create table gateway_stats (
+	mac bytea references gateway on delete cascade not null,
+	timestamp timestamp with time zone not null,
+	rx_packets integer not null,
+	rssi_sum bigint not null,
+	snr_sum double precision not null,
+	tx_packets integer not null,
+	tx_airtime bigint not null,
+	primary key (mac, timestamp)
+);
+
+create index gateway_stats_timestamp on gateway_stats(timestamp);
+
+-- +migrate Down
+drop index gateway_stats_timestamp;
+drop table gateway_stats;
+
+drop table gateway;
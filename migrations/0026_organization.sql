@@ -0,0 +1,49 @@
+-- +migrate Up
+create table organization (
+	id bigserial primary key,
+	created_at timestamp with time zone not null,
+	updated_at timestamp with time zone not null,
+	name varchar(100) not null,
+	max_nodes integer not null,
+	max_downlinks_per_day integer not null
+);
+
+create unique index organization_name on organization(name);
+
+create table organization_application (
+	app_eui bytea primary key,
+	organization_id bigint references organization on delete cascade not null,
+	created_at timestamp with time zone not null
+);
+
+create index organization_application_organization_id on organization_application(organization_id);
+
+create table organization_user (
+	organization_id bigint references organization on delete cascade not null,
+	username varchar(100) not null,
+	role varchar(20) not null,
+	created_at timestamp with time zone not null,
+	updated_at timestamp with time zone not null,
+	primary key (organization_id, username)
+);
+
+create index organization_user_username on organization_user(username);
+
+create table organization_downlink_count (
+	organization_id bigint references organization on delete cascade not null,
+	day date not null,
+	count integer not null,
+	primary key (organization_id, day)
+);
+
+-- +migrate Down
+drop table organization_downlink_count;
+
+drop index organization_user_username;
+drop table organization_user;
+
+drop index organization_application_organization_id;
+drop table organization_application;
+
+drop index organization_name;
+drop table organization;
@@ -0,0 +1,17 @@
+-- +migrate Up
+create table api_key (
+	id bigserial primary key,
+	created_at timestamp with time zone not null,
+	name varchar(100) not null,
+	organization_id bigint references organization on delete cascade not null,
+	app_eui bytea,
+	role varchar(20) not null,
+	key_hash bytea not null,
+	revoked_at timestamp with time zone
+);
+
+create index api_key_organization_id on api_key(organization_id);
+
+-- +migrate Down
+drop index api_key_organization_id;
+drop table api_key;
@@ -0,0 +1,17 @@
+-- +migrate Up
+create table event_log (
+	id bigserial primary key,
+	created_at timestamp with time zone not null,
+	app_eui bytea not null,
+	dev_eui bytea not null,
+	type varchar(20) not null,
+	payload jsonb not null
+);
+
+create index event_log_dev_eui_created_at on event_log(dev_eui, created_at);
+create index event_log_created_at on event_log(created_at);
+
+-- +migrate Down
+drop index event_log_created_at;
+drop index event_log_dev_eui_created_at;
+drop table event_log;
@@ -0,0 +1,20 @@
+-- +migrate Up
+create table gcp_pubsub_integration (
+	app_eui bytea primary key,
+	project_id text not null,
+	topic text not null,
+	credentials_json bytea not null
+);
+
+create table aws_sns_integration (
+	app_eui bytea primary key,
+	region text not null,
+	access_key_id text not null,
+	secret_access_key bytea not null,
+	topic_arn text not null,
+	queue_url text not null
+);
+
+-- +migrate Down
+drop table aws_sns_integration;
+drop table gcp_pubsub_integration;
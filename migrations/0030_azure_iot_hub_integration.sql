@@ -0,0 +1,9 @@
+-- +migrate Up
+create table azure_iot_hub_integration (
+	app_eui bytea primary key,
+	connection_string bytea not null,
+	c2d_enabled boolean not null default false
+);
+
+-- +migrate Down
+drop table azure_iot_hub_integration;
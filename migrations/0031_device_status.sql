@@ -0,0 +1,19 @@
+-- +migrate Up
+alter table node
+	add column battery smallint,
+	add column link_margin smallint,
+	add column device_status_at timestamp with time zone;
+
+create table device_status_alert (
+	app_eui bytea primary key,
+	min_battery_level double precision not null default 0,
+	min_link_margin smallint not null default 0
+);
+
+-- +migrate Down
+drop table device_status_alert;
+
+alter table node
+	drop column device_status_at,
+	drop column link_margin,
+	drop column battery;
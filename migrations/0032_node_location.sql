@@ -0,0 +1,15 @@
+-- +migrate Up
+alter table node
+	add column latitude double precision,
+	add column longitude double precision,
+	add column altitude double precision,
+	add column location_accuracy double precision,
+	add column location_at timestamp with time zone;
+
+-- +migrate Down
+alter table node
+	drop column location_at,
+	drop column location_accuracy,
+	drop column altitude,
+	drop column longitude,
+	drop column latitude;
@@ -0,0 +1,12 @@
+-- +migrate Up
+create table oidc_user (
+	username varchar(100) primary key,
+	subject varchar(255) not null unique,
+	email varchar(255) not null,
+	name varchar(255) not null,
+	created_at timestamp with time zone not null,
+	last_login_at timestamp with time zone not null
+);
+
+-- +migrate Down
+drop table oidc_user;
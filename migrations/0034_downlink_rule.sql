@@ -0,0 +1,30 @@
+-- +migrate Up
+create table downlink_rule (
+	id bigserial primary key,
+	name varchar(100) not null,
+	app_eui bytea not null,
+	dev_eui bytea references node on delete cascade,
+	schedule varchar(100) not null,
+	timezone varchar(50) not null,
+	fport smallint not null,
+	confirmed boolean not null,
+	data bytea,
+	object jsonb,
+	next_run_at timestamp with time zone not null,
+	last_run_at timestamp with time zone
+);
+
+create index downlink_rule_app_eui on downlink_rule(app_eui);
+create index downlink_rule_next_run_at on downlink_rule(next_run_at);
+
+alter table downlink_queue
+	add column downlink_rule_id bigint references downlink_rule on delete set null;
+
+-- +migrate Down
+alter table downlink_queue
+	drop column downlink_rule_id;
+
+drop index downlink_rule_next_run_at;
+drop index downlink_rule_app_eui;
+
+drop table downlink_rule;
@@ -0,0 +1,20 @@
+-- +migrate Up
+create table dead_letter (
+	id bigserial primary key,
+	created_at timestamp with time zone not null,
+	handler varchar(20) not null,
+	app_eui bytea not null,
+	dev_eui bytea not null,
+	type varchar(20) not null,
+	payload jsonb not null,
+	error text not null,
+	attempts integer not null
+);
+
+create index dead_letter_app_eui_created_at on dead_letter(app_eui, created_at);
+create index dead_letter_created_at on dead_letter(created_at);
+
+-- +migrate Down
+drop index dead_letter_created_at;
+drop index dead_letter_app_eui_created_at;
+drop table dead_letter;
@@ -0,0 +1,14 @@
+-- +migrate Up
+alter table node
+	add column variables jsonb not null default '{}';
+
+create table thingsboard_integration (
+	app_eui bytea primary key,
+	server text not null
+);
+
+-- +migrate Down
+drop table thingsboard_integration;
+
+alter table node
+	drop column variables;
@@ -0,0 +1,8 @@
+-- +migrate Up
+create table handler_backend (
+	app_eui bytea primary key,
+	backends text[] not null
+);
+
+-- +migrate Down
+drop table handler_backend;
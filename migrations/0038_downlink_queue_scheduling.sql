@@ -0,0 +1,11 @@
+-- +migrate Up
+alter table downlink_queue
+	add column delay_until timestamp with time zone,
+	add column max_retries integer not null default 0,
+	add column retries integer not null default 0;
+
+-- +migrate Down
+alter table downlink_queue
+	drop column retries,
+	drop column max_retries,
+	drop column delay_until;
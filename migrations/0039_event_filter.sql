@@ -0,0 +1,15 @@
+-- +migrate Up
+create table event_filter (
+	id bigserial primary key,
+	app_eui bytea not null,
+	integration varchar(50) not null,
+	events varchar(20)[] not null,
+	fports smallint[] not null
+);
+
+create unique index event_filter_app_eui_integration on event_filter(app_eui, integration);
+
+-- +migrate Down
+drop index event_filter_app_eui_integration;
+
+drop table event_filter;
@@ -0,0 +1,40 @@
+-- +migrate Up
+create table audit_log (
+	id bigserial primary key,
+	created_at timestamp with time zone not null,
+	actor varchar(100) not null,
+	action varchar(100) not null,
+	object_type varchar(50) not null,
+	object_id varchar(100) not null,
+	app_eui bytea,
+	details jsonb
+);
+
+create index audit_log_created_at on audit_log(created_at);
+create index audit_log_actor on audit_log(actor);
+create index audit_log_object on audit_log(object_type, object_id);
+create index audit_log_app_eui on audit_log(app_eui);
+
+-- +migrate StatementBegin
+create function audit_log_append_only() returns trigger as $$
+begin
+	raise exception 'audit_log is append-only';
+end;
+$$ language plpgsql;
+-- +migrate StatementEnd
+
+create trigger audit_log_append_only
+	before update or delete on audit_log
+	for each row execute procedure audit_log_append_only();
+
+-- +migrate Down
+drop trigger audit_log_append_only on audit_log;
+
+drop function audit_log_append_only();
+
+drop index audit_log_app_eui;
+drop index audit_log_object;
+drop index audit_log_actor;
+drop index audit_log_created_at;
+
+drop table audit_log;
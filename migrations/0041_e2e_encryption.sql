@@ -0,0 +1,17 @@
+-- +migrate Up
+alter table node
+	add column e2e_encryption boolean not null default false,
+	add column app_s_key_envelope bytea;
+
+alter table downlink_queue
+	add column encrypted boolean not null default false,
+	add column f_cnt bigint;
+
+-- +migrate Down
+alter table downlink_queue
+	drop column f_cnt,
+	drop column encrypted;
+
+alter table node
+	drop column app_s_key_envelope,
+	drop column e2e_encryption;
@@ -0,0 +1,27 @@
+-- +migrate Up
+create table usage_stats (
+	dev_eui bytea not null,
+	app_eui bytea not null,
+	timestamp timestamp with time zone not null,
+	rx_packets integer not null,
+	rssi_sum bigint not null,
+	snr_sum double precision not null,
+	tx_packets integer not null,
+	errors integer not null,
+	primary key (dev_eui, app_eui, timestamp)
+);
+
+create index usage_stats_app_eui_timestamp on usage_stats(app_eui, timestamp);
+create index usage_stats_timestamp on usage_stats(timestamp);
+
+create table usage_stats_publish (
+	app_eui bytea primary key,
+	published_at timestamp with time zone not null
+);
+
+-- +migrate Down
+drop table usage_stats_publish;
+
+drop index usage_stats_timestamp;
+drop index usage_stats_app_eui_timestamp;
+drop table usage_stats;
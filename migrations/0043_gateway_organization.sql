@@ -0,0 +1,11 @@
+-- +migrate Up
+alter table gateway
+	add column organization_id bigint references organization on delete cascade;
+
+create index gateway_organization_id on gateway(organization_id);
+
+-- +migrate Down
+drop index gateway_organization_id;
+
+alter table gateway
+	drop column organization_id;
@@ -0,0 +1,10 @@
+-- +migrate Up
+create table downlink_overflow (
+	id bigserial primary key,
+	created_at timestamp with time zone not null,
+	topic text not null,
+	payload bytea not null
+);
+
+-- +migrate Down
+drop table downlink_overflow;
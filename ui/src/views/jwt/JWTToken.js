@@ -18,6 +18,14 @@ class JWTToken extends Component {
     this.onSubmit = this.onSubmit.bind(this);
   }
 
+  componentDidMount() {
+    // token issued after the openid connect login
+    if (this.props.location.query.token !== undefined) {
+      tokenStore.setToken(this.props.location.query.token);
+      this.context.router.push("/");
+    }
+  }
+
   onChange(e) {
     this.setState({token: e.target.value});
   }
@@ -45,6 +53,7 @@ class JWTToken extends Component {
                 <p className="help-block">
                   LoRa App Server has support for JWT based token authorization as described in the <a href="https://docs.loraserver.io/lora-app-server/api/">api documentation</a>.
                   When you got redirected to this view, it means you need to enter your token to gain access to your data.
+                  When OpenID Connect login is enabled, you can <a href="/auth/oidc/login">login using your organization account</a> instead.
                 </p>
               </div>
               <hr />